@@ -32,6 +32,22 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// ModuleAccounts returns all the existing module accounts.
 	ModuleAccounts(ctx context.Context, in *QueryModuleAccountsRequest, opts ...grpc.CallOption) (*QueryModuleAccountsResponse, error)
+	// ModuleAccountByName returns the module account info by module name
+	//
+	// Since: cosmos-sdk 0.46
+	ModuleAccountByName(ctx context.Context, in *QueryModuleAccountByNameRequest, opts ...grpc.CallOption) (*QueryModuleAccountByNameResponse, error)
+	// ModuleAccountsByPermission returns the module accounts granted a
+	// permission, e.g. all the minter accounts.
+	//
+	// Since: cosmos-sdk 0.46
+	ModuleAccountsByPermission(ctx context.Context, in *QueryModuleAccountsByPermissionRequest, opts ...grpc.CallOption) (*QueryModuleAccountsByPermissionResponse, error)
+	// ModuleAccountPermissions returns all the module accounts registered in the
+	// app along with their permissions.
+	ModuleAccountPermissions(ctx context.Context, in *QueryModuleAccountPermissionsRequest, opts ...grpc.CallOption) (*QueryModuleAccountPermissionsResponse, error)
+	// ReapCandidates returns the dust accounts which are reaped at the end of
+	// their grace period unless they send a tx or their balance grows above the
+	// dust threshold.
+	ReapCandidates(ctx context.Context, in *QueryReapCandidatesRequest, opts ...grpc.CallOption) (*QueryReapCandidatesResponse, error)
 	// Bech32 queries bech32Prefix
 	Bech32Prefix(ctx context.Context, in *Bech32PrefixRequest, opts ...grpc.CallOption) (*Bech32PrefixResponse, error)
 	// AddressBytesToString converts Account Address bytes to string
@@ -84,6 +100,42 @@ func (c *queryClient) ModuleAccounts(ctx context.Context, in *QueryModuleAccount
 	return out, nil
 }
 
+func (c *queryClient) ModuleAccountByName(ctx context.Context, in *QueryModuleAccountByNameRequest, opts ...grpc.CallOption) (*QueryModuleAccountByNameResponse, error) {
+	out := new(QueryModuleAccountByNameResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/ModuleAccountByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleAccountsByPermission(ctx context.Context, in *QueryModuleAccountsByPermissionRequest, opts ...grpc.CallOption) (*QueryModuleAccountsByPermissionResponse, error) {
+	out := new(QueryModuleAccountsByPermissionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/ModuleAccountsByPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleAccountPermissions(ctx context.Context, in *QueryModuleAccountPermissionsRequest, opts ...grpc.CallOption) (*QueryModuleAccountPermissionsResponse, error) {
+	out := new(QueryModuleAccountPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/ModuleAccountPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReapCandidates(ctx context.Context, in *QueryReapCandidatesRequest, opts ...grpc.CallOption) (*QueryReapCandidatesResponse, error) {
+	out := new(QueryReapCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/ReapCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Bech32Prefix(ctx context.Context, in *Bech32PrefixRequest, opts ...grpc.CallOption) (*Bech32PrefixResponse, error) {
 	out := new(Bech32PrefixResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/Bech32Prefix", in, out, opts...)
@@ -125,6 +177,22 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// ModuleAccounts returns all the existing module accounts.
 	ModuleAccounts(context.Context, *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error)
+	// ModuleAccountByName returns the module account info by module name
+	//
+	// Since: cosmos-sdk 0.46
+	ModuleAccountByName(context.Context, *QueryModuleAccountByNameRequest) (*QueryModuleAccountByNameResponse, error)
+	// ModuleAccountsByPermission returns the module accounts granted a
+	// permission, e.g. all the minter accounts.
+	//
+	// Since: cosmos-sdk 0.46
+	ModuleAccountsByPermission(context.Context, *QueryModuleAccountsByPermissionRequest) (*QueryModuleAccountsByPermissionResponse, error)
+	// ModuleAccountPermissions returns all the module accounts registered in the
+	// app along with their permissions.
+	ModuleAccountPermissions(context.Context, *QueryModuleAccountPermissionsRequest) (*QueryModuleAccountPermissionsResponse, error)
+	// ReapCandidates returns the dust accounts which are reaped at the end of
+	// their grace period unless they send a tx or their balance grows above the
+	// dust threshold.
+	ReapCandidates(context.Context, *QueryReapCandidatesRequest) (*QueryReapCandidatesResponse, error)
 	// Bech32 queries bech32Prefix
 	Bech32Prefix(context.Context, *Bech32PrefixRequest) (*Bech32PrefixResponse, error)
 	// AddressBytesToString converts Account Address bytes to string
@@ -150,6 +218,18 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) ModuleAccounts(context.Context, *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccounts not implemented")
 }
+func (UnimplementedQueryServer) ModuleAccountByName(context.Context, *QueryModuleAccountByNameRequest) (*QueryModuleAccountByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountByName not implemented")
+}
+func (UnimplementedQueryServer) ModuleAccountsByPermission(context.Context, *QueryModuleAccountsByPermissionRequest) (*QueryModuleAccountsByPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountsByPermission not implemented")
+}
+func (UnimplementedQueryServer) ModuleAccountPermissions(context.Context, *QueryModuleAccountPermissionsRequest) (*QueryModuleAccountPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountPermissions not implemented")
+}
+func (UnimplementedQueryServer) ReapCandidates(context.Context, *QueryReapCandidatesRequest) (*QueryReapCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReapCandidates not implemented")
+}
 func (UnimplementedQueryServer) Bech32Prefix(context.Context, *Bech32PrefixRequest) (*Bech32PrefixResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Bech32Prefix not implemented")
 }
@@ -244,6 +324,78 @@ func _Query_ModuleAccounts_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ModuleAccountByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAccountByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAccountByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/ModuleAccountByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAccountByName(ctx, req.(*QueryModuleAccountByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleAccountsByPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAccountsByPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAccountsByPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/ModuleAccountsByPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAccountsByPermission(ctx, req.(*QueryModuleAccountsByPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleAccountPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAccountPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAccountPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/ModuleAccountPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAccountPermissions(ctx, req.(*QueryModuleAccountPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReapCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReapCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReapCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/ReapCandidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReapCandidates(ctx, req.(*QueryReapCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Bech32Prefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Bech32PrefixRequest)
 	if err := dec(in); err != nil {
@@ -321,6 +473,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleAccounts",
 			Handler:    _Query_ModuleAccounts_Handler,
 		},
+		{
+			MethodName: "ModuleAccountByName",
+			Handler:    _Query_ModuleAccountByName_Handler,
+		},
+		{
+			MethodName: "ModuleAccountsByPermission",
+			Handler:    _Query_ModuleAccountsByPermission_Handler,
+		},
+		{
+			MethodName: "ModuleAccountPermissions",
+			Handler:    _Query_ModuleAccountPermissions_Handler,
+		},
+		{
+			MethodName: "ReapCandidates",
+			Handler:    _Query_ReapCandidates_Handler,
+		},
 		{
 			MethodName: "Bech32Prefix",
 			Handler:    _Query_Bech32Prefix_Handler,
@@ -33,6 +33,9 @@ type QueryClient interface {
 	TotalSupply(ctx context.Context, in *QueryTotalSupplyRequest, opts ...grpc.CallOption) (*QueryTotalSupplyResponse, error)
 	// SupplyOf queries the supply of a single coin.
 	SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, opts ...grpc.CallOption) (*QuerySupplyOfResponse, error)
+	// SupplyOffset queries the supply of a single coin along with its supply
+	// offset and the supply adjusted by the offset.
+	SupplyOffset(ctx context.Context, in *QuerySupplyOffsetRequest, opts ...grpc.CallOption) (*QuerySupplyOffsetResponse, error)
 	// Params queries the parameters of x/bank module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// DenomsMetadata queries the client metadata of a given coin denomination.
@@ -43,6 +46,18 @@ type QueryClient interface {
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	DenomOwners(ctx context.Context, in *QueryDenomOwnersRequest, opts ...grpc.CallOption) (*QueryDenomOwnersResponse, error)
+	// AllBalancesStream streams the balances of all the coins of a single
+	// account, one page per message, from the page of the request to the last
+	// one, at the height of the start of the stream.
+	//
+	// Since: cosmos-sdk 0.46
+	AllBalancesStream(ctx context.Context, in *QueryAllBalancesStreamRequest, opts ...grpc.CallOption) (Query_AllBalancesStreamClient, error)
+	// DenomOwnersStream streams the account addresses that own a particular
+	// token denomination, one page per message, from the page of the request to
+	// the last one, at the height of the start of the stream.
+	//
+	// Since: cosmos-sdk 0.46
+	DenomOwnersStream(ctx context.Context, in *QueryDenomOwnersStreamRequest, opts ...grpc.CallOption) (Query_DenomOwnersStreamClient, error)
 }
 
 type queryClient struct {
@@ -98,6 +113,15 @@ func (c *queryClient) SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, op
 	return out, nil
 }
 
+func (c *queryClient) SupplyOffset(ctx context.Context, in *QuerySupplyOffsetRequest, opts ...grpc.CallOption) (*QuerySupplyOffsetResponse, error) {
+	out := new(QuerySupplyOffsetResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/SupplyOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/Params", in, out, opts...)
@@ -134,6 +158,70 @@ func (c *queryClient) DenomOwners(ctx context.Context, in *QueryDenomOwnersReque
 	return out, nil
 }
 
+func (c *queryClient) AllBalancesStream(ctx context.Context, in *QueryAllBalancesStreamRequest, opts ...grpc.CallOption) (Query_AllBalancesStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[0], "/cosmos.bank.v1beta1.Query/AllBalancesStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryAllBalancesStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_AllBalancesStreamClient interface {
+	Recv() (*QueryAllBalancesStreamResponse, error)
+	grpc.ClientStream
+}
+
+type queryAllBalancesStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryAllBalancesStreamClient) Recv() (*QueryAllBalancesStreamResponse, error) {
+	m := new(QueryAllBalancesStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) DenomOwnersStream(ctx context.Context, in *QueryDenomOwnersStreamRequest, opts ...grpc.CallOption) (Query_DenomOwnersStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[1], "/cosmos.bank.v1beta1.Query/DenomOwnersStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryDenomOwnersStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_DenomOwnersStreamClient interface {
+	Recv() (*QueryDenomOwnersStreamResponse, error)
+	grpc.ClientStream
+}
+
+type queryDenomOwnersStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryDenomOwnersStreamClient) Recv() (*QueryDenomOwnersStreamResponse, error) {
+	m := new(QueryDenomOwnersStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -149,6 +237,9 @@ type QueryServer interface {
 	TotalSupply(context.Context, *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error)
 	// SupplyOf queries the supply of a single coin.
 	SupplyOf(context.Context, *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error)
+	// SupplyOffset queries the supply of a single coin along with its supply
+	// offset and the supply adjusted by the offset.
+	SupplyOffset(context.Context, *QuerySupplyOffsetRequest) (*QuerySupplyOffsetResponse, error)
 	// Params queries the parameters of x/bank module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// DenomsMetadata queries the client metadata of a given coin denomination.
@@ -159,6 +250,18 @@ type QueryServer interface {
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	DenomOwners(context.Context, *QueryDenomOwnersRequest) (*QueryDenomOwnersResponse, error)
+	// AllBalancesStream streams the balances of all the coins of a single
+	// account, one page per message, from the page of the request to the last
+	// one, at the height of the start of the stream.
+	//
+	// Since: cosmos-sdk 0.46
+	AllBalancesStream(*QueryAllBalancesStreamRequest, Query_AllBalancesStreamServer) error
+	// DenomOwnersStream streams the account addresses that own a particular
+	// token denomination, one page per message, from the page of the request to
+	// the last one, at the height of the start of the stream.
+	//
+	// Since: cosmos-sdk 0.46
+	DenomOwnersStream(*QueryDenomOwnersStreamRequest, Query_DenomOwnersStreamServer) error
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -181,6 +284,9 @@ func (UnimplementedQueryServer) TotalSupply(context.Context, *QueryTotalSupplyRe
 func (UnimplementedQueryServer) SupplyOf(context.Context, *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SupplyOf not implemented")
 }
+func (UnimplementedQueryServer) SupplyOffset(context.Context, *QuerySupplyOffsetRequest) (*QuerySupplyOffsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyOffset not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -193,6 +299,12 @@ func (UnimplementedQueryServer) DenomsMetadata(context.Context, *QueryDenomsMeta
 func (UnimplementedQueryServer) DenomOwners(context.Context, *QueryDenomOwnersRequest) (*QueryDenomOwnersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomOwners not implemented")
 }
+func (UnimplementedQueryServer) AllBalancesStream(*QueryAllBalancesStreamRequest, Query_AllBalancesStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method AllBalancesStream not implemented")
+}
+func (UnimplementedQueryServer) DenomOwnersStream(*QueryDenomOwnersStreamRequest, Query_DenomOwnersStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method DenomOwnersStream not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -296,6 +408,24 @@ func _Query_SupplyOf_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SupplyOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/SupplyOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyOffset(ctx, req.(*QuerySupplyOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -368,6 +498,48 @@ func _Query_DenomOwners_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AllBalancesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryAllBalancesStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).AllBalancesStream(m, &queryAllBalancesStreamServer{stream})
+}
+
+type Query_AllBalancesStreamServer interface {
+	Send(*QueryAllBalancesStreamResponse) error
+	grpc.ServerStream
+}
+
+type queryAllBalancesStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryAllBalancesStreamServer) Send(m *QueryAllBalancesStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_DenomOwnersStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryDenomOwnersStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).DenomOwnersStream(m, &queryDenomOwnersStreamServer{stream})
+}
+
+type Query_DenomOwnersStreamServer interface {
+	Send(*QueryDenomOwnersStreamResponse) error
+	grpc.ServerStream
+}
+
+type queryDenomOwnersStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryDenomOwnersStreamServer) Send(m *QueryDenomOwnersStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -395,6 +567,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SupplyOf",
 			Handler:    _Query_SupplyOf_Handler,
 		},
+		{
+			MethodName: "SupplyOffset",
+			Handler:    _Query_SupplyOffset_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -412,6 +588,17 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Query_DenomOwners_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AllBalancesStream",
+			Handler:       _Query_AllBalancesStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DenomOwnersStream",
+			Handler:       _Query_DenomOwnersStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
 }
@@ -0,0 +1,455 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: cosmos/base/admin/v1beta1/admin.proto
+
+package adminv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	// LogLevels returns the log levels of the node.
+	LogLevels(ctx context.Context, in *LogLevelsRequest, opts ...grpc.CallOption) (*LogLevelsResponse, error)
+	// SetLogLevels changes the log levels of the node.
+	SetLogLevels(ctx context.Context, in *SetLogLevelsRequest, opts ...grpc.CallOption) (*SetLogLevelsResponse, error)
+	// Snapshots returns the state sync snapshots stored by the node.
+	Snapshots(ctx context.Context, in *SnapshotsRequest, opts ...grpc.CallOption) (*SnapshotsResponse, error)
+	// TakeSnapshot takes a state sync snapshot of the latest committed height,
+	// regardless of the snapshot interval.
+	TakeSnapshot(ctx context.Context, in *TakeSnapshotRequest, opts ...grpc.CallOption) (*TakeSnapshotResponse, error)
+	// Pruning returns the pruning options of the node.
+	Pruning(ctx context.Context, in *PruningRequest, opts ...grpc.CallOption) (*PruningResponse, error)
+	// Prune schedules the pruning of the heights pending pruning at the next
+	// commit, regardless of the pruning interval.
+	Prune(ctx context.Context, in *PruneRequest, opts ...grpc.CallOption) (*PruneResponse, error)
+	// Mempool returns the txs in the mempool of the node.
+	Mempool(ctx context.Context, in *MempoolRequest, opts ...grpc.CallOption) (*MempoolResponse, error)
+	// Peers returns the peers the node is connected to.
+	Peers(ctx context.Context, in *PeersRequest, opts ...grpc.CallOption) (*PeersResponse, error)
+	// QueryCacheStats returns the statistics of the cache of gRPC query responses.
+	QueryCacheStats(ctx context.Context, in *QueryCacheStatsRequest, opts ...grpc.CallOption) (*QueryCacheStatsResponse, error)
+	// Metrics returns a snapshot of the telemetry metrics of the node, for
+	// dashboards which cannot scrape the /metrics endpoint of the API server.
+	Metrics(ctx context.Context, in *MetricsRequest, opts ...grpc.CallOption) (*MetricsResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) LogLevels(ctx context.Context, in *LogLevelsRequest, opts ...grpc.CallOption) (*LogLevelsResponse, error) {
+	out := new(LogLevelsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.AdminService/LogLevels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetLogLevels(ctx context.Context, in *SetLogLevelsRequest, opts ...grpc.CallOption) (*SetLogLevelsResponse, error) {
+	out := new(SetLogLevelsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.AdminService/SetLogLevels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) Snapshots(ctx context.Context, in *SnapshotsRequest, opts ...grpc.CallOption) (*SnapshotsResponse, error) {
+	out := new(SnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.AdminService/Snapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) TakeSnapshot(ctx context.Context, in *TakeSnapshotRequest, opts ...grpc.CallOption) (*TakeSnapshotResponse, error) {
+	out := new(TakeSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.AdminService/TakeSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) Pruning(ctx context.Context, in *PruningRequest, opts ...grpc.CallOption) (*PruningResponse, error) {
+	out := new(PruningResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.AdminService/Pruning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) Prune(ctx context.Context, in *PruneRequest, opts ...grpc.CallOption) (*PruneResponse, error) {
+	out := new(PruneResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.AdminService/Prune", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) Mempool(ctx context.Context, in *MempoolRequest, opts ...grpc.CallOption) (*MempoolResponse, error) {
+	out := new(MempoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.AdminService/Mempool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) Peers(ctx context.Context, in *PeersRequest, opts ...grpc.CallOption) (*PeersResponse, error) {
+	out := new(PeersResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.AdminService/Peers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) QueryCacheStats(ctx context.Context, in *QueryCacheStatsRequest, opts ...grpc.CallOption) (*QueryCacheStatsResponse, error) {
+	out := new(QueryCacheStatsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.AdminService/QueryCacheStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) Metrics(ctx context.Context, in *MetricsRequest, opts ...grpc.CallOption) (*MetricsResponse, error) {
+	out := new(MetricsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.AdminService/Metrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility
+type AdminServiceServer interface {
+	// LogLevels returns the log levels of the node.
+	LogLevels(context.Context, *LogLevelsRequest) (*LogLevelsResponse, error)
+	// SetLogLevels changes the log levels of the node.
+	SetLogLevels(context.Context, *SetLogLevelsRequest) (*SetLogLevelsResponse, error)
+	// Snapshots returns the state sync snapshots stored by the node.
+	Snapshots(context.Context, *SnapshotsRequest) (*SnapshotsResponse, error)
+	// TakeSnapshot takes a state sync snapshot of the latest committed height,
+	// regardless of the snapshot interval.
+	TakeSnapshot(context.Context, *TakeSnapshotRequest) (*TakeSnapshotResponse, error)
+	// Pruning returns the pruning options of the node.
+	Pruning(context.Context, *PruningRequest) (*PruningResponse, error)
+	// Prune schedules the pruning of the heights pending pruning at the next
+	// commit, regardless of the pruning interval.
+	Prune(context.Context, *PruneRequest) (*PruneResponse, error)
+	// Mempool returns the txs in the mempool of the node.
+	Mempool(context.Context, *MempoolRequest) (*MempoolResponse, error)
+	// Peers returns the peers the node is connected to.
+	Peers(context.Context, *PeersRequest) (*PeersResponse, error)
+	// QueryCacheStats returns the statistics of the cache of gRPC query responses.
+	QueryCacheStats(context.Context, *QueryCacheStatsRequest) (*QueryCacheStatsResponse, error)
+	// Metrics returns a snapshot of the telemetry metrics of the node, for
+	// dashboards which cannot scrape the /metrics endpoint of the API server.
+	Metrics(context.Context, *MetricsRequest) (*MetricsResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (UnimplementedAdminServiceServer) LogLevels(context.Context, *LogLevelsRequest) (*LogLevelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogLevels not implemented")
+}
+func (UnimplementedAdminServiceServer) SetLogLevels(context.Context, *SetLogLevelsRequest) (*SetLogLevelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevels not implemented")
+}
+func (UnimplementedAdminServiceServer) Snapshots(context.Context, *SnapshotsRequest) (*SnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Snapshots not implemented")
+}
+func (UnimplementedAdminServiceServer) TakeSnapshot(context.Context, *TakeSnapshotRequest) (*TakeSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakeSnapshot not implemented")
+}
+func (UnimplementedAdminServiceServer) Pruning(context.Context, *PruningRequest) (*PruningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pruning not implemented")
+}
+func (UnimplementedAdminServiceServer) Prune(context.Context, *PruneRequest) (*PruneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prune not implemented")
+}
+func (UnimplementedAdminServiceServer) Mempool(context.Context, *MempoolRequest) (*MempoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mempool not implemented")
+}
+func (UnimplementedAdminServiceServer) Peers(context.Context, *PeersRequest) (*PeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Peers not implemented")
+}
+func (UnimplementedAdminServiceServer) QueryCacheStats(context.Context, *QueryCacheStatsRequest) (*QueryCacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCacheStats not implemented")
+}
+func (UnimplementedAdminServiceServer) Metrics(context.Context, *MetricsRequest) (*MetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Metrics not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_LogLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLevelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).LogLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.AdminService/LogLevels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).LogLevels(ctx, req.(*LogLevelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetLogLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetLogLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.AdminService/SetLogLevels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetLogLevels(ctx, req.(*SetLogLevelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_Snapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Snapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.AdminService/Snapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Snapshots(ctx, req.(*SnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_TakeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakeSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).TakeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.AdminService/TakeSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).TakeSnapshot(ctx, req.(*TakeSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_Pruning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Pruning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.AdminService/Pruning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Pruning(ctx, req.(*PruningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_Prune_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Prune(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.AdminService/Prune",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Prune(ctx, req.(*PruneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_Mempool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MempoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Mempool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.AdminService/Mempool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Mempool(ctx, req.(*MempoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_Peers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Peers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.AdminService/Peers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Peers(ctx, req.(*PeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_QueryCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).QueryCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.AdminService/QueryCacheStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).QueryCacheStats(ctx, req.(*QueryCacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_Metrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Metrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.AdminService/Metrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Metrics(ctx, req.(*MetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.admin.v1beta1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LogLevels",
+			Handler:    _AdminService_LogLevels_Handler,
+		},
+		{
+			MethodName: "SetLogLevels",
+			Handler:    _AdminService_SetLogLevels_Handler,
+		},
+		{
+			MethodName: "Snapshots",
+			Handler:    _AdminService_Snapshots_Handler,
+		},
+		{
+			MethodName: "TakeSnapshot",
+			Handler:    _AdminService_TakeSnapshot_Handler,
+		},
+		{
+			MethodName: "Pruning",
+			Handler:    _AdminService_Pruning_Handler,
+		},
+		{
+			MethodName: "Prune",
+			Handler:    _AdminService_Prune_Handler,
+		},
+		{
+			MethodName: "Mempool",
+			Handler:    _AdminService_Mempool_Handler,
+		},
+		{
+			MethodName: "Peers",
+			Handler:    _AdminService_Peers_Handler,
+		},
+		{
+			MethodName: "QueryCacheStats",
+			Handler:    _AdminService_QueryCacheStats_Handler,
+		},
+		{
+			MethodName: "Metrics",
+			Handler:    _AdminService_Metrics_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/admin/v1beta1/admin.proto",
+}
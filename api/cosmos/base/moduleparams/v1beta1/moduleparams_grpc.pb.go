@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: cosmos/base/moduleparams/v1beta1/moduleparams.proto
+
+package moduleparamsv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ModuleParamsServiceClient is the client API for ModuleParamsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ModuleParamsServiceClient interface {
+	// AllModuleParams returns the params of all the modules whose query service
+	// has a Params method with an empty request.
+	AllModuleParams(ctx context.Context, in *AllModuleParamsRequest, opts ...grpc.CallOption) (*AllModuleParamsResponse, error)
+}
+
+type moduleParamsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewModuleParamsServiceClient(cc grpc.ClientConnInterface) ModuleParamsServiceClient {
+	return &moduleParamsServiceClient{cc}
+}
+
+func (c *moduleParamsServiceClient) AllModuleParams(ctx context.Context, in *AllModuleParamsRequest, opts ...grpc.CallOption) (*AllModuleParamsResponse, error) {
+	out := new(AllModuleParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.moduleparams.v1beta1.ModuleParamsService/AllModuleParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ModuleParamsServiceServer is the server API for ModuleParamsService service.
+// All implementations must embed UnimplementedModuleParamsServiceServer
+// for forward compatibility
+type ModuleParamsServiceServer interface {
+	// AllModuleParams returns the params of all the modules whose query service
+	// has a Params method with an empty request.
+	AllModuleParams(context.Context, *AllModuleParamsRequest) (*AllModuleParamsResponse, error)
+	mustEmbedUnimplementedModuleParamsServiceServer()
+}
+
+// UnimplementedModuleParamsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedModuleParamsServiceServer struct {
+}
+
+func (UnimplementedModuleParamsServiceServer) AllModuleParams(context.Context, *AllModuleParamsRequest) (*AllModuleParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllModuleParams not implemented")
+}
+func (UnimplementedModuleParamsServiceServer) mustEmbedUnimplementedModuleParamsServiceServer() {}
+
+// UnsafeModuleParamsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ModuleParamsServiceServer will
+// result in compilation errors.
+type UnsafeModuleParamsServiceServer interface {
+	mustEmbedUnimplementedModuleParamsServiceServer()
+}
+
+func RegisterModuleParamsServiceServer(s grpc.ServiceRegistrar, srv ModuleParamsServiceServer) {
+	s.RegisterService(&ModuleParamsService_ServiceDesc, srv)
+}
+
+func _ModuleParamsService_AllModuleParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllModuleParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModuleParamsServiceServer).AllModuleParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.moduleparams.v1beta1.ModuleParamsService/AllModuleParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModuleParamsServiceServer).AllModuleParams(ctx, req.(*AllModuleParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ModuleParamsService_ServiceDesc is the grpc.ServiceDesc for ModuleParamsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ModuleParamsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.moduleparams.v1beta1.ModuleParamsService",
+	HandlerType: (*ModuleParamsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AllModuleParams",
+			Handler:    _ModuleParamsService_AllModuleParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/moduleparams/v1beta1/moduleparams.proto",
+}
@@ -29,6 +29,10 @@ type QueryClient interface {
 	// AllowancesByGranter returns all the grants given by an address
 	// Since v0.46
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// ExpiringAllowances returns the grants expiring within a time window.
+	//
+	// Since: cosmos-sdk 0.47
+	ExpiringAllowances(ctx context.Context, in *QueryExpiringAllowancesRequest, opts ...grpc.CallOption) (*QueryExpiringAllowancesResponse, error)
 }
 
 type queryClient struct {
@@ -66,6 +70,15 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) ExpiringAllowances(ctx context.Context, in *QueryExpiringAllowancesRequest, opts ...grpc.CallOption) (*QueryExpiringAllowancesResponse, error) {
+	out := new(QueryExpiringAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/ExpiringAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -77,6 +90,10 @@ type QueryServer interface {
 	// AllowancesByGranter returns all the grants given by an address
 	// Since v0.46
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// ExpiringAllowances returns the grants expiring within a time window.
+	//
+	// Since: cosmos-sdk 0.47
+	ExpiringAllowances(context.Context, *QueryExpiringAllowancesRequest) (*QueryExpiringAllowancesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -93,6 +110,9 @@ func (UnimplementedQueryServer) Allowances(context.Context, *QueryAllowancesRequ
 func (UnimplementedQueryServer) AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (UnimplementedQueryServer) ExpiringAllowances(context.Context, *QueryExpiringAllowancesRequest) (*QueryExpiringAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringAllowances not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -160,6 +180,24 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExpiringAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiringAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiringAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/ExpiringAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiringAllowances(ctx, req.(*QueryExpiringAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -179,6 +217,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "ExpiringAllowances",
+			Handler:    _Query_ExpiringAllowances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
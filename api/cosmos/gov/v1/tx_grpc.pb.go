@@ -33,6 +33,12 @@ type MsgClient interface {
 	VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	// StoreContent defines a method to store a long-form text in the content
+	// store, addressed by its hash.
+	StoreContent(ctx context.Context, in *MsgStoreContent, opts ...grpc.CallOption) (*MsgStoreContentResponse, error)
+	// SetConstitution defines a governance operation setting the hash of the
+	// constitution of the chain.
+	SetConstitution(ctx context.Context, in *MsgSetConstitution, opts ...grpc.CallOption) (*MsgSetConstitutionResponse, error)
 }
 
 type msgClient struct {
@@ -88,6 +94,24 @@ func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) StoreContent(ctx context.Context, in *MsgStoreContent, opts ...grpc.CallOption) (*MsgStoreContentResponse, error) {
+	out := new(MsgStoreContentResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/StoreContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetConstitution(ctx context.Context, in *MsgSetConstitution, opts ...grpc.CallOption) (*MsgSetConstitutionResponse, error) {
+	out := new(MsgSetConstitutionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/SetConstitution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -103,6 +127,12 @@ type MsgServer interface {
 	VoteWeighted(context.Context, *MsgVoteWeighted) (*MsgVoteWeightedResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	// StoreContent defines a method to store a long-form text in the content
+	// store, addressed by its hash.
+	StoreContent(context.Context, *MsgStoreContent) (*MsgStoreContentResponse, error)
+	// SetConstitution defines a governance operation setting the hash of the
+	// constitution of the chain.
+	SetConstitution(context.Context, *MsgSetConstitution) (*MsgSetConstitutionResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -125,6 +155,12 @@ func (UnimplementedMsgServer) VoteWeighted(context.Context, *MsgVoteWeighted) (*
 func (UnimplementedMsgServer) Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
+func (UnimplementedMsgServer) StoreContent(context.Context, *MsgStoreContent) (*MsgStoreContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreContent not implemented")
+}
+func (UnimplementedMsgServer) SetConstitution(context.Context, *MsgSetConstitution) (*MsgSetConstitutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConstitution not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -228,6 +264,42 @@ func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StoreContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStoreContent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StoreContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/StoreContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StoreContent(ctx, req.(*MsgStoreContent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetConstitution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetConstitution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetConstitution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/SetConstitution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetConstitution(ctx, req.(*MsgSetConstitution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -255,6 +327,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Deposit",
 			Handler:    _Msg_Deposit_Handler,
 		},
+		{
+			MethodName: "StoreContent",
+			Handler:    _Msg_StoreContent_Handler,
+		},
+		{
+			MethodName: "SetConstitution",
+			Handler:    _Msg_SetConstitution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
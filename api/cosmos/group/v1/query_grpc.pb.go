@@ -52,6 +52,15 @@ type QueryClient interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ProposalExecutionLogs queries the execution attempts of a proposal, which
+	// include the error of each failed attempt.
+	//
+	// Since: cosmos-sdk 0.47
+	ProposalExecutionLogs(ctx context.Context, in *QueryProposalExecutionLogsRequest, opts ...grpc.CallOption) (*QueryProposalExecutionLogsResponse, error)
+	// WeightDelegationsByGroup queries the voting weight delegations of a group.
+	//
+	// Since: cosmos-sdk 0.47
+	WeightDelegationsByGroup(ctx context.Context, in *QueryWeightDelegationsByGroupRequest, opts ...grpc.CallOption) (*QueryWeightDelegationsByGroupResponse, error)
 }
 
 type queryClient struct {
@@ -179,6 +188,24 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ProposalExecutionLogs(ctx context.Context, in *QueryProposalExecutionLogsRequest, opts ...grpc.CallOption) (*QueryProposalExecutionLogsResponse, error) {
+	out := new(QueryProposalExecutionLogsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/ProposalExecutionLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WeightDelegationsByGroup(ctx context.Context, in *QueryWeightDelegationsByGroupRequest, opts ...grpc.CallOption) (*QueryWeightDelegationsByGroupResponse, error) {
+	out := new(QueryWeightDelegationsByGroupResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/WeightDelegationsByGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -213,6 +240,15 @@ type QueryServer interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ProposalExecutionLogs queries the execution attempts of a proposal, which
+	// include the error of each failed attempt.
+	//
+	// Since: cosmos-sdk 0.47
+	ProposalExecutionLogs(context.Context, *QueryProposalExecutionLogsRequest) (*QueryProposalExecutionLogsResponse, error)
+	// WeightDelegationsByGroup queries the voting weight delegations of a group.
+	//
+	// Since: cosmos-sdk 0.47
+	WeightDelegationsByGroup(context.Context, *QueryWeightDelegationsByGroupRequest) (*QueryWeightDelegationsByGroupResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -259,6 +295,12 @@ func (UnimplementedQueryServer) GroupsByMember(context.Context, *QueryGroupsByMe
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) ProposalExecutionLogs(context.Context, *QueryProposalExecutionLogsRequest) (*QueryProposalExecutionLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalExecutionLogs not implemented")
+}
+func (UnimplementedQueryServer) WeightDelegationsByGroup(context.Context, *QueryWeightDelegationsByGroupRequest) (*QueryWeightDelegationsByGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WeightDelegationsByGroup not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -506,6 +548,42 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalExecutionLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalExecutionLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalExecutionLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/ProposalExecutionLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalExecutionLogs(ctx, req.(*QueryProposalExecutionLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WeightDelegationsByGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWeightDelegationsByGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WeightDelegationsByGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/WeightDelegationsByGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WeightDelegationsByGroup(ctx, req.(*QueryWeightDelegationsByGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -565,6 +643,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ProposalExecutionLogs",
+			Handler:    _Query_ProposalExecutionLogs_Handler,
+		},
+		{
+			MethodName: "WeightDelegationsByGroup",
+			Handler:    _Query_WeightDelegationsByGroup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
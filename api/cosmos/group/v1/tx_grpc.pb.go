@@ -50,6 +50,15 @@ type MsgClient interface {
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// DelegateWeight delegates the voting weight of a group member to another
+	// member of the group until an expiration time.
+	//
+	// Since: cosmos-sdk 0.47
+	DelegateWeight(ctx context.Context, in *MsgDelegateWeight, opts ...grpc.CallOption) (*MsgDelegateWeightResponse, error)
+	// UndelegateWeight revokes the voting weight delegation of a group member.
+	//
+	// Since: cosmos-sdk 0.47
+	UndelegateWeight(ctx context.Context, in *MsgUndelegateWeight, opts ...grpc.CallOption) (*MsgUndelegateWeightResponse, error)
 }
 
 type msgClient struct {
@@ -186,6 +195,24 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) DelegateWeight(ctx context.Context, in *MsgDelegateWeight, opts ...grpc.CallOption) (*MsgDelegateWeightResponse, error) {
+	out := new(MsgDelegateWeightResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/DelegateWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateWeight(ctx context.Context, in *MsgUndelegateWeight, opts ...grpc.CallOption) (*MsgUndelegateWeightResponse, error) {
+	out := new(MsgUndelegateWeightResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/UndelegateWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -218,6 +245,15 @@ type MsgServer interface {
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// DelegateWeight delegates the voting weight of a group member to another
+	// member of the group until an expiration time.
+	//
+	// Since: cosmos-sdk 0.47
+	DelegateWeight(context.Context, *MsgDelegateWeight) (*MsgDelegateWeightResponse, error)
+	// UndelegateWeight revokes the voting weight delegation of a group member.
+	//
+	// Since: cosmos-sdk 0.47
+	UndelegateWeight(context.Context, *MsgUndelegateWeight) (*MsgUndelegateWeightResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -267,6 +303,12 @@ func (UnimplementedMsgServer) Exec(context.Context, *MsgExec) (*MsgExecResponse,
 func (UnimplementedMsgServer) LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (UnimplementedMsgServer) DelegateWeight(context.Context, *MsgDelegateWeight) (*MsgDelegateWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateWeight not implemented")
+}
+func (UnimplementedMsgServer) UndelegateWeight(context.Context, *MsgUndelegateWeight) (*MsgUndelegateWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateWeight not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -532,6 +574,42 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateWeight)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/DelegateWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateWeight(ctx, req.(*MsgDelegateWeight))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateWeight)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/UndelegateWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateWeight(ctx, req.(*MsgUndelegateWeight))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -595,6 +673,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "DelegateWeight",
+			Handler:    _Msg_DelegateWeight_Handler,
+		},
+		{
+			MethodName: "UndelegateWeight",
+			Handler:    _Msg_UndelegateWeight_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
@@ -37,6 +37,19 @@ type QueryClient interface {
 	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// ClassMetadata queries the extended metadata of an NFT class.
+	//
+	// Since: cosmos-sdk 0.47
+	ClassMetadata(ctx context.Context, in *QueryClassMetadataRequest, opts ...grpc.CallOption) (*QueryClassMetadataResponse, error)
+	// NFTAttributes queries the structured attributes of an NFT, ordered by key.
+	//
+	// Since: cosmos-sdk 0.47
+	NFTAttributes(ctx context.Context, in *QueryNFTAttributesRequest, opts ...grpc.CallOption) (*QueryNFTAttributesResponse, error)
+	// RoyaltyInfo queries the royalty receiver and amount owed for a sale of an NFT of the given class,
+	// similar to royaltyInfo in ERC2981.
+	//
+	// Since: cosmos-sdk 0.47
+	RoyaltyInfo(ctx context.Context, in *QueryRoyaltyInfoRequest, opts ...grpc.CallOption) (*QueryRoyaltyInfoResponse, error)
 }
 
 type queryClient struct {
@@ -110,6 +123,33 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) ClassMetadata(ctx context.Context, in *QueryClassMetadataRequest, opts ...grpc.CallOption) (*QueryClassMetadataResponse, error) {
+	out := new(QueryClassMetadataResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/ClassMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NFTAttributes(ctx context.Context, in *QueryNFTAttributesRequest, opts ...grpc.CallOption) (*QueryNFTAttributesResponse, error) {
+	out := new(QueryNFTAttributesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/NFTAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RoyaltyInfo(ctx context.Context, in *QueryRoyaltyInfoRequest, opts ...grpc.CallOption) (*QueryRoyaltyInfoResponse, error) {
+	out := new(QueryRoyaltyInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/RoyaltyInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -129,6 +169,19 @@ type QueryServer interface {
 	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// ClassMetadata queries the extended metadata of an NFT class.
+	//
+	// Since: cosmos-sdk 0.47
+	ClassMetadata(context.Context, *QueryClassMetadataRequest) (*QueryClassMetadataResponse, error)
+	// NFTAttributes queries the structured attributes of an NFT, ordered by key.
+	//
+	// Since: cosmos-sdk 0.47
+	NFTAttributes(context.Context, *QueryNFTAttributesRequest) (*QueryNFTAttributesResponse, error)
+	// RoyaltyInfo queries the royalty receiver and amount owed for a sale of an NFT of the given class,
+	// similar to royaltyInfo in ERC2981.
+	//
+	// Since: cosmos-sdk 0.47
+	RoyaltyInfo(context.Context, *QueryRoyaltyInfoRequest) (*QueryRoyaltyInfoResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -157,6 +210,15 @@ func (UnimplementedQueryServer) Class(context.Context, *QueryClassRequest) (*Que
 func (UnimplementedQueryServer) Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (UnimplementedQueryServer) ClassMetadata(context.Context, *QueryClassMetadataRequest) (*QueryClassMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassMetadata not implemented")
+}
+func (UnimplementedQueryServer) NFTAttributes(context.Context, *QueryNFTAttributesRequest) (*QueryNFTAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFTAttributes not implemented")
+}
+func (UnimplementedQueryServer) RoyaltyInfo(context.Context, *QueryRoyaltyInfoRequest) (*QueryRoyaltyInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoyaltyInfo not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -296,6 +358,60 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClassMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/ClassMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassMetadata(ctx, req.(*QueryClassMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NFTAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NFTAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/NFTAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFTAttributes(ctx, req.(*QueryNFTAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RoyaltyInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoyaltyInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoyaltyInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/RoyaltyInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoyaltyInfo(ctx, req.(*QueryRoyaltyInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -331,6 +447,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "ClassMetadata",
+			Handler:    _Query_ClassMetadata_Handler,
+		},
+		{
+			MethodName: "NFTAttributes",
+			Handler:    _Query_NFTAttributes_Handler,
+		},
+		{
+			MethodName: "RoyaltyInfo",
+			Handler:    _Query_RoyaltyInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
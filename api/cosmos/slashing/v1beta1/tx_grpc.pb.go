@@ -26,6 +26,10 @@ type MsgClient interface {
 	// them into the bonded validator set, so they can begin receiving provisions
 	// and rewards again.
 	Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error)
+	// ExpeditedUnjail defines a method for unjailing a validator jailed for
+	// downtime before the end of its jail period, paying the expedited unjail
+	// fee.
+	ExpeditedUnjail(ctx context.Context, in *MsgExpeditedUnjail, opts ...grpc.CallOption) (*MsgExpeditedUnjailResponse, error)
 }
 
 type msgClient struct {
@@ -45,6 +49,15 @@ func (c *msgClient) Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) ExpeditedUnjail(ctx context.Context, in *MsgExpeditedUnjail, opts ...grpc.CallOption) (*MsgExpeditedUnjailResponse, error) {
+	out := new(MsgExpeditedUnjailResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/ExpeditedUnjail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -53,6 +66,10 @@ type MsgServer interface {
 	// them into the bonded validator set, so they can begin receiving provisions
 	// and rewards again.
 	Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error)
+	// ExpeditedUnjail defines a method for unjailing a validator jailed for
+	// downtime before the end of its jail period, paying the expedited unjail
+	// fee.
+	ExpeditedUnjail(context.Context, *MsgExpeditedUnjail) (*MsgExpeditedUnjailResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -63,6 +80,9 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unjail not implemented")
 }
+func (UnimplementedMsgServer) ExpeditedUnjail(context.Context, *MsgExpeditedUnjail) (*MsgExpeditedUnjailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpeditedUnjail not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +114,24 @@ func _Msg_Unjail_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ExpeditedUnjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExpeditedUnjail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExpeditedUnjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/ExpeditedUnjail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExpeditedUnjail(ctx, req.(*MsgExpeditedUnjail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +143,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Unjail",
 			Handler:    _Msg_Unjail_Handler,
 		},
+		{
+			MethodName: "ExpeditedUnjail",
+			Handler:    _Msg_ExpeditedUnjail_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -48,12 +48,36 @@ type QueryClient interface {
 	// DelegatorValidator queries validator info for given delegator validator
 	// pair.
 	DelegatorValidator(ctx context.Context, in *QueryDelegatorValidatorRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorResponse, error)
+	// UnbondingQueue queries the unbonding delegation entries completing within
+	// a time window, ordered by completion time.
+	UnbondingQueue(ctx context.Context, in *QueryUnbondingQueueRequest, opts ...grpc.CallOption) (*QueryUnbondingQueueResponse, error)
+	// RedelegationQueue queries the redelegation entries completing within a time
+	// window, ordered by completion time.
+	RedelegationQueue(ctx context.Context, in *QueryRedelegationQueueRequest, opts ...grpc.CallOption) (*QueryRedelegationQueueResponse, error)
 	// HistoricalInfo queries the historical info for given height.
 	HistoricalInfo(ctx context.Context, in *QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoResponse, error)
 	// Pool queries the pool info.
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// TotalLiquidStaked queries the tokens delegated by liquid staking providers
+	// and their share of the bonded tokens.
+	TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error)
+	// ValidatorLiquidStaking queries the shares of a validator delegated by
+	// liquid staking providers and their utilization of the validator caps.
+	ValidatorLiquidStaking(ctx context.Context, in *QueryValidatorLiquidStakingRequest, opts ...grpc.CallOption) (*QueryValidatorLiquidStakingResponse, error)
+	// ValidatorsStream streams all the validators matching the given status,
+	// one page per message, from the page of the request to the last one, at the
+	// height of the start of the stream.
+	//
+	// Since: cosmos-sdk 0.46
+	ValidatorsStream(ctx context.Context, in *QueryValidatorsStreamRequest, opts ...grpc.CallOption) (Query_ValidatorsStreamClient, error)
+	// ValidatorDelegationsStream streams the delegations of a validator, one
+	// page per message, from the page of the request to the last one, at the
+	// height of the start of the stream.
+	//
+	// Since: cosmos-sdk 0.46
+	ValidatorDelegationsStream(ctx context.Context, in *QueryValidatorDelegationsStreamRequest, opts ...grpc.CallOption) (Query_ValidatorDelegationsStreamClient, error)
 }
 
 type queryClient struct {
@@ -163,6 +187,24 @@ func (c *queryClient) DelegatorValidator(ctx context.Context, in *QueryDelegator
 	return out, nil
 }
 
+func (c *queryClient) UnbondingQueue(ctx context.Context, in *QueryUnbondingQueueRequest, opts ...grpc.CallOption) (*QueryUnbondingQueueResponse, error) {
+	out := new(QueryUnbondingQueueResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/UnbondingQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedelegationQueue(ctx context.Context, in *QueryRedelegationQueueRequest, opts ...grpc.CallOption) (*QueryRedelegationQueueResponse, error) {
+	out := new(QueryRedelegationQueueResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/RedelegationQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) HistoricalInfo(ctx context.Context, in *QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoResponse, error) {
 	out := new(QueryHistoricalInfoResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/HistoricalInfo", in, out, opts...)
@@ -190,6 +232,88 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error) {
+	out := new(QueryTotalLiquidStakedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/TotalLiquidStaked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorLiquidStaking(ctx context.Context, in *QueryValidatorLiquidStakingRequest, opts ...grpc.CallOption) (*QueryValidatorLiquidStakingResponse, error) {
+	out := new(QueryValidatorLiquidStakingResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/ValidatorLiquidStaking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorsStream(ctx context.Context, in *QueryValidatorsStreamRequest, opts ...grpc.CallOption) (Query_ValidatorsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[0], "/cosmos.staking.v1beta1.Query/ValidatorsStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryValidatorsStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ValidatorsStreamClient interface {
+	Recv() (*QueryValidatorsStreamResponse, error)
+	grpc.ClientStream
+}
+
+type queryValidatorsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryValidatorsStreamClient) Recv() (*QueryValidatorsStreamResponse, error) {
+	m := new(QueryValidatorsStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) ValidatorDelegationsStream(ctx context.Context, in *QueryValidatorDelegationsStreamRequest, opts ...grpc.CallOption) (Query_ValidatorDelegationsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[1], "/cosmos.staking.v1beta1.Query/ValidatorDelegationsStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryValidatorDelegationsStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ValidatorDelegationsStreamClient interface {
+	Recv() (*QueryValidatorDelegationsStreamResponse, error)
+	grpc.ClientStream
+}
+
+type queryValidatorDelegationsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryValidatorDelegationsStreamClient) Recv() (*QueryValidatorDelegationsStreamResponse, error) {
+	m := new(QueryValidatorDelegationsStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -220,12 +344,36 @@ type QueryServer interface {
 	// DelegatorValidator queries validator info for given delegator validator
 	// pair.
 	DelegatorValidator(context.Context, *QueryDelegatorValidatorRequest) (*QueryDelegatorValidatorResponse, error)
+	// UnbondingQueue queries the unbonding delegation entries completing within
+	// a time window, ordered by completion time.
+	UnbondingQueue(context.Context, *QueryUnbondingQueueRequest) (*QueryUnbondingQueueResponse, error)
+	// RedelegationQueue queries the redelegation entries completing within a time
+	// window, ordered by completion time.
+	RedelegationQueue(context.Context, *QueryRedelegationQueueRequest) (*QueryRedelegationQueueResponse, error)
 	// HistoricalInfo queries the historical info for given height.
 	HistoricalInfo(context.Context, *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error)
 	// Pool queries the pool info.
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// TotalLiquidStaked queries the tokens delegated by liquid staking providers
+	// and their share of the bonded tokens.
+	TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error)
+	// ValidatorLiquidStaking queries the shares of a validator delegated by
+	// liquid staking providers and their utilization of the validator caps.
+	ValidatorLiquidStaking(context.Context, *QueryValidatorLiquidStakingRequest) (*QueryValidatorLiquidStakingResponse, error)
+	// ValidatorsStream streams all the validators matching the given status,
+	// one page per message, from the page of the request to the last one, at the
+	// height of the start of the stream.
+	//
+	// Since: cosmos-sdk 0.46
+	ValidatorsStream(*QueryValidatorsStreamRequest, Query_ValidatorsStreamServer) error
+	// ValidatorDelegationsStream streams the delegations of a validator, one
+	// page per message, from the page of the request to the last one, at the
+	// height of the start of the stream.
+	//
+	// Since: cosmos-sdk 0.46
+	ValidatorDelegationsStream(*QueryValidatorDelegationsStreamRequest, Query_ValidatorDelegationsStreamServer) error
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -266,6 +414,12 @@ func (UnimplementedQueryServer) DelegatorValidators(context.Context, *QueryDeleg
 func (UnimplementedQueryServer) DelegatorValidator(context.Context, *QueryDelegatorValidatorRequest) (*QueryDelegatorValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorValidator not implemented")
 }
+func (UnimplementedQueryServer) UnbondingQueue(context.Context, *QueryUnbondingQueueRequest) (*QueryUnbondingQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingQueue not implemented")
+}
+func (UnimplementedQueryServer) RedelegationQueue(context.Context, *QueryRedelegationQueueRequest) (*QueryRedelegationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegationQueue not implemented")
+}
 func (UnimplementedQueryServer) HistoricalInfo(context.Context, *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HistoricalInfo not implemented")
 }
@@ -275,6 +429,18 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidStaked not implemented")
+}
+func (UnimplementedQueryServer) ValidatorLiquidStaking(context.Context, *QueryValidatorLiquidStakingRequest) (*QueryValidatorLiquidStakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorLiquidStaking not implemented")
+}
+func (UnimplementedQueryServer) ValidatorsStream(*QueryValidatorsStreamRequest, Query_ValidatorsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidatorsStream not implemented")
+}
+func (UnimplementedQueryServer) ValidatorDelegationsStream(*QueryValidatorDelegationsStreamRequest, Query_ValidatorDelegationsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidatorDelegationsStream not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -486,6 +652,42 @@ func _Query_DelegatorValidator_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UnbondingQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/UnbondingQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingQueue(ctx, req.(*QueryUnbondingQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedelegationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedelegationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedelegationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/RedelegationQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedelegationQueue(ctx, req.(*QueryRedelegationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_HistoricalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryHistoricalInfoRequest)
 	if err := dec(in); err != nil {
@@ -540,6 +742,84 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TotalLiquidStaked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidStakedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/TotalLiquidStaked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, req.(*QueryTotalLiquidStakedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorLiquidStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorLiquidStakingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorLiquidStaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/ValidatorLiquidStaking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorLiquidStaking(ctx, req.(*QueryValidatorLiquidStakingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryValidatorsStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ValidatorsStream(m, &queryValidatorsStreamServer{stream})
+}
+
+type Query_ValidatorsStreamServer interface {
+	Send(*QueryValidatorsStreamResponse) error
+	grpc.ServerStream
+}
+
+type queryValidatorsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryValidatorsStreamServer) Send(m *QueryValidatorsStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_ValidatorDelegationsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryValidatorDelegationsStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ValidatorDelegationsStream(m, &queryValidatorDelegationsStreamServer{stream})
+}
+
+type Query_ValidatorDelegationsStreamServer interface {
+	Send(*QueryValidatorDelegationsStreamResponse) error
+	grpc.ServerStream
+}
+
+type queryValidatorDelegationsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryValidatorDelegationsStreamServer) Send(m *QueryValidatorDelegationsStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -591,6 +871,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorValidator",
 			Handler:    _Query_DelegatorValidator_Handler,
 		},
+		{
+			MethodName: "UnbondingQueue",
+			Handler:    _Query_UnbondingQueue_Handler,
+		},
+		{
+			MethodName: "RedelegationQueue",
+			Handler:    _Query_RedelegationQueue_Handler,
+		},
 		{
 			MethodName: "HistoricalInfo",
 			Handler:    _Query_HistoricalInfo_Handler,
@@ -603,7 +891,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "TotalLiquidStaked",
+			Handler:    _Query_TotalLiquidStaked_Handler,
+		},
+		{
+			MethodName: "ValidatorLiquidStaking",
+			Handler:    _Query_ValidatorLiquidStaking_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ValidatorsStream",
+			Handler:       _Query_ValidatorsStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ValidatorDelegationsStream",
+			Handler:       _Query_ValidatorDelegationsStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
 }
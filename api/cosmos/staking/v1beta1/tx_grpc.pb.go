@@ -26,6 +26,9 @@ type MsgClient interface {
 	CreateValidator(ctx context.Context, in *MsgCreateValidator, opts ...grpc.CallOption) (*MsgCreateValidatorResponse, error)
 	// EditValidator defines a method for editing an existing validator.
 	EditValidator(ctx context.Context, in *MsgEditValidator, opts ...grpc.CallOption) (*MsgEditValidatorResponse, error)
+	// EditValidatorDescription defines a method for editing only the given fields
+	// of the description of an existing validator.
+	EditValidatorDescription(ctx context.Context, in *MsgEditValidatorDescription, opts ...grpc.CallOption) (*MsgEditValidatorDescriptionResponse, error)
 	// Delegate defines a method for performing a delegation of coins
 	// from a delegator to a validator.
 	Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.CallOption) (*MsgDelegateResponse, error)
@@ -66,6 +69,15 @@ func (c *msgClient) EditValidator(ctx context.Context, in *MsgEditValidator, opt
 	return out, nil
 }
 
+func (c *msgClient) EditValidatorDescription(ctx context.Context, in *MsgEditValidatorDescription, opts ...grpc.CallOption) (*MsgEditValidatorDescriptionResponse, error) {
+	out := new(MsgEditValidatorDescriptionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/EditValidatorDescription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.CallOption) (*MsgDelegateResponse, error) {
 	out := new(MsgDelegateResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/Delegate", in, out, opts...)
@@ -110,6 +122,9 @@ type MsgServer interface {
 	CreateValidator(context.Context, *MsgCreateValidator) (*MsgCreateValidatorResponse, error)
 	// EditValidator defines a method for editing an existing validator.
 	EditValidator(context.Context, *MsgEditValidator) (*MsgEditValidatorResponse, error)
+	// EditValidatorDescription defines a method for editing only the given fields
+	// of the description of an existing validator.
+	EditValidatorDescription(context.Context, *MsgEditValidatorDescription) (*MsgEditValidatorDescriptionResponse, error)
 	// Delegate defines a method for performing a delegation of coins
 	// from a delegator to a validator.
 	Delegate(context.Context, *MsgDelegate) (*MsgDelegateResponse, error)
@@ -135,6 +150,9 @@ func (UnimplementedMsgServer) CreateValidator(context.Context, *MsgCreateValidat
 func (UnimplementedMsgServer) EditValidator(context.Context, *MsgEditValidator) (*MsgEditValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditValidator not implemented")
 }
+func (UnimplementedMsgServer) EditValidatorDescription(context.Context, *MsgEditValidatorDescription) (*MsgEditValidatorDescriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditValidatorDescription not implemented")
+}
 func (UnimplementedMsgServer) Delegate(context.Context, *MsgDelegate) (*MsgDelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delegate not implemented")
 }
@@ -196,6 +214,24 @@ func _Msg_EditValidator_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_EditValidatorDescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditValidatorDescription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EditValidatorDescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/EditValidatorDescription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditValidatorDescription(ctx, req.(*MsgEditValidatorDescription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Delegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDelegate)
 	if err := dec(in); err != nil {
@@ -283,6 +319,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EditValidator",
 			Handler:    _Msg_EditValidator_Handler,
 		},
+		{
+			MethodName: "EditValidatorDescription",
+			Handler:    _Msg_EditValidatorDescription_Handler,
+		},
 		{
 			MethodName: "Delegate",
 			Handler:    _Msg_Delegate_Handler,
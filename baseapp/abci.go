@@ -2,21 +2,28 @@ package baseapp
 
 import (
 	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"sort"
 	"strings"
+	"sync/atomic"
 	"syscall"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
@@ -143,6 +150,9 @@ func (app *BaseApp) FilterPeerByID(info string) abci.ResponseQuery {
 
 // BeginBlock implements the ABCI application interface.
 func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeginBlock) {
+	app.blockExecution = blockExecution{}
+	app.blockResultUsage = resultUsage{}
+	defer func(start time.Time) { app.blockExecution.beginBlock = time.Since(start) }(time.Now())
 
 	if app.cms.TracingEnabled() {
 		app.cms.SetTracingContext(sdk.TraceContext(
@@ -164,7 +174,8 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		// by InitChain. Context is now updated with Header information.
 		app.deliverState.ctx = app.deliverState.ctx.
 			WithBlockHeader(req.Header).
-			WithBlockHeight(req.Header.Height)
+			WithBlockHeight(req.Header.Height).
+			WithLogger(app.blockLogger(req.Header.Height))
 	}
 
 	// add block gas meter
@@ -182,6 +193,13 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		WithHeaderHash(req.Hash).
 		WithConsensusParams(app.GetConsensusParams(app.deliverState.ctx))
 
+	// start the span of the block, parent of the spans of its execution up to Commit
+	blockCtx, blockSpan := telemetry.StartSpan(
+		app.deliverState.ctx.Context(), "Block", attribute.Int64(telemetry.AttributeKeyHeight, req.Header.Height),
+	)
+	app.blockSpan = blockSpan
+	app.deliverState.ctx = app.deliverState.ctx.WithContext(blockCtx)
+
 	// we also set block gas meter to checkState in case the application needs to
 	// verify gas consumption during (Re)CheckTx
 	if app.checkState != nil {
@@ -190,8 +208,12 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 			WithHeaderHash(req.Hash)
 	}
 
+	app.resetBlockGasMeter(app.deliverState.ctx)
+
 	if app.beginBlocker != nil {
-		res = app.beginBlocker(app.deliverState.ctx, req)
+		spanCtx, span := telemetry.StartSpan(blockCtx, "BeginBlock")
+		res = app.beginBlocker(app.deliverState.ctx.WithContext(spanCtx), req)
+		span.End()
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
 	}
 	// set the signed validators for addition to context in deliverTx
@@ -204,18 +226,26 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		}
 	}
 
+	res.Events = app.limitEvents(res.Events, 0, true)
+
 	return res
 }
 
 // EndBlock implements the ABCI interface.
 func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBlock) {
+	defer func(start time.Time) {
+		app.blockExecution.endBlock = time.Since(start)
+		app.blockExecution.emit()
+	}(time.Now())
 
 	if app.deliverState.ms.TracingEnabled() {
 		app.deliverState.ms = app.deliverState.ms.SetTracingContext(nil).(sdk.CacheMultiStore)
 	}
 
 	if app.endBlocker != nil {
-		res = app.endBlocker(app.deliverState.ctx, req)
+		spanCtx, span := telemetry.StartSpan(app.deliverState.ctx.Context(), "EndBlock")
+		res = app.endBlocker(app.deliverState.ctx.WithContext(spanCtx), req)
+		span.End()
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
 	}
 
@@ -223,6 +253,8 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		res.ConsensusParamUpdates = cp
 	}
 
+	app.recordBlockGas(app.deliverState.ctx)
+
 	// call the streaming service hooks with the EndBlock messages
 	for _, streamingListener := range app.abciListeners {
 		if err := streamingListener.ListenEndBlock(app.deliverState.ctx, req, res); err != nil {
@@ -230,6 +262,8 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		}
 	}
 
+	res.Events = app.limitEvents(res.Events, 0, true)
+
 	return res
 }
 
@@ -239,16 +273,20 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 // internal CheckTx state if the AnteHandler passes. Otherwise, the ResponseCheckTx
 // will contain releveant error information. Regardless of tx execution outcome,
 // the ResponseCheckTx will contain relevant gas execution context.
-func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+func (app *BaseApp) CheckTx(req abci.RequestCheckTx) (abciRes abci.ResponseCheckTx) {
+	defer func() {
+		abciRes.Events = app.limitEvents(abciRes.Events, app.resultLimits.MaxTxEventBytes, false)
+		abciRes.Log = app.limitLog(abciRes.Log, false)
+	}()
 
-	var mode runTxMode
+	var mode sdk.ExecMode
 
 	switch {
 	case req.Type == abci.CheckTxType_New:
-		mode = runTxModeCheck
+		mode = sdk.ExecModeCheck
 
 	case req.Type == abci.CheckTxType_Recheck:
-		mode = runTxModeReCheck
+		mode = sdk.ExecModeReCheck
 
 	default:
 		panic(fmt.Sprintf("unknown RequestCheckTx type: %s", req.Type))
@@ -260,7 +298,7 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		return sdkerrors.ResponseCheckTx(err, uint64(res.GasUsed), uint64(res.GasWanted), app.trace)
 	}
 
-	abciRes, err := convertTxResponseToCheckTx(res, checkRes)
+	abciRes, err = convertTxResponseToCheckTx(res, checkRes)
 	if err != nil {
 		return sdkerrors.ResponseCheckTx(err, uint64(res.GasUsed), uint64(res.GasWanted), app.trace)
 	}
@@ -273,9 +311,26 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 // Otherwise, the ResponseDeliverTx will contain releveant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
-func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (abciRes abci.ResponseDeliverTx) {
+	defer func(start time.Time) { app.blockExecution.deliverTx += time.Since(start) }(time.Now())
+
+	defer func() {
+		resultStr := "successful"
+		if !abciRes.IsOK() {
+			resultStr = "failed"
+		}
 
-	var abciRes abci.ResponseDeliverTx
+		telemetry.IncrCounter(1, telemetry.MetricKeyTx, "count")
+		telemetry.IncrCounter(1, telemetry.MetricKeyTx, resultStr)
+		telemetry.SetGauge(float32(abciRes.GasUsed), telemetry.MetricKeyTx, "gas", "used")
+		telemetry.SetGauge(float32(abciRes.GasWanted), telemetry.MetricKeyTx, "gas", "wanted")
+	}()
+	// the events are aggregated, and the result limited, once the ABCI
+	// listeners received all of them
+	defer func() {
+		abciRes.Events = app.limitEvents(aggregateEvents(abciRes.Events, app.eventAggregationThreshold), app.resultLimits.MaxTxEventBytes, true)
+		abciRes.Log = app.limitLog(abciRes.Log, true)
+	}()
 	defer func() {
 		for _, streamingListener := range app.abciListeners {
 			if err := streamingListener.ListenDeliverTx(app.deliverState.ctx, req, abciRes); err != nil {
@@ -284,7 +339,23 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 		}
 	}()
 
-	ctx := app.getContextForTx(runTxModeDeliver, req.Tx)
+	ctx := app.getContextForTx(sdk.ExecModeDeliver, req.Tx)
+	if telemetry.IsTracingEnabled() {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		spanCtx, span := telemetry.StartSpan(
+			sdkCtx.Context(), "DeliverTx", attribute.String(telemetry.AttributeKeyTxHash, fmt.Sprintf("%X", tmtypes.Tx(req.Tx).Hash())),
+		)
+		ctx = sdk.WrapSDKContext(sdkCtx.WithContext(spanCtx))
+
+		defer func() {
+			span.SetAttributes(attribute.Int64(telemetry.AttributeKeyGasUsed, abciRes.GasUsed))
+			if !abciRes.IsOK() {
+				span.SetStatus(otelcodes.Error, abciRes.Log)
+			}
+			span.End()
+		}()
+	}
+
 	res, err := app.txHandler.DeliverTx(ctx, tx.Request{TxBytes: req.Tx})
 	if err != nil {
 		abciRes = sdkerrors.ResponseDeliverTx(err, uint64(res.GasUsed), uint64(res.GasWanted), app.trace)
@@ -293,7 +364,8 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 
 	abciRes, err = convertTxResponseToDeliverTx(res)
 	if err != nil {
-		return sdkerrors.ResponseDeliverTx(err, uint64(res.GasUsed), uint64(res.GasWanted), app.trace)
+		abciRes = sdkerrors.ResponseDeliverTx(err, uint64(res.GasUsed), uint64(res.GasWanted), app.trace)
+		return abciRes
 	}
 
 	return abciRes
@@ -308,15 +380,29 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 // against that height and gracefully halt if it matches the latest committed
 // height.
 func (app *BaseApp) Commit() (res abci.ResponseCommit) {
+	defer telemetry.MeasureSince(time.Now(), "abci", "commit")
 
 	header := app.deliverState.ctx.BlockHeader()
 	retainHeight := app.GetBlockRetentionHeight(header.Height)
 
+	commitCtx, commitSpan := telemetry.StartSpan(app.deliverState.ctx.Context(), "Commit")
+
 	// Write the DeliverTx state into branched storage and commit the MultiStore.
 	// The write to the DeliverTx state writes all state transitions to the root
 	// MultiStore (app.cms) so when Commit() is called is persists those values.
+	_, storeSpan := telemetry.StartSpan(commitCtx, "StoreCommit")
 	app.deliverState.ms.Write()
 	commitID := app.cms.Commit()
+	storeSpan.End()
+
+	// the responses to the queries for the latest height are outdated
+	if app.queryCache != nil {
+		app.queryCache.evictLatest()
+	}
+
+	if atomic.CompareAndSwapInt32(&app.pruneScheduled, 1, 0) {
+		app.pruneStores()
+	}
 	app.logger.Info("commit synced", "commit", fmt.Sprintf("%X", commitID))
 
 	// Reset the Check state to the latest committed.
@@ -346,7 +432,17 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 		app.halt()
 	}
 
-	go app.snapshotManager.SnapshotIfApplicable(header.Height)
+	app.snapshotWG.Add(1)
+	go func() {
+		defer app.snapshotWG.Done()
+		app.snapshotManager.SnapshotIfApplicable(header.Height)
+	}()
+
+	commitSpan.End()
+	if app.blockSpan != nil {
+		app.blockSpan.End()
+		app.blockSpan = nil
+	}
 
 	return abci.ResponseCommit{
 		Data:         commitID.Hash,
@@ -638,6 +734,12 @@ func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, e
 	return ctx, nil
 }
 
+// CreateQueryContext creates a new read-only sdk.Context branched off the
+// committed state at the given height, a zero height meaning the latest one.
+func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, error) {
+	return app.createQueryContext(height, prove)
+}
+
 // GetBlockRetentionHeight returns the height for which all blocks below this height
 // are pruned from Tendermint. Given a commitment height and a non-zero local
 // minRetainBlocks configuration, the retentionHeight is the smallest height that
@@ -745,6 +847,18 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 				Value:     []byte(app.version),
 			}
 
+		case "block_gas":
+			bz, err := json.Marshal(app.BlockGasReport())
+			if err != nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to JSON encode block gas report"), app.trace)
+			}
+
+			return abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
 		default:
 			return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path), app.trace)
 		}
@@ -753,7 +867,7 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 	return sdkerrors.QueryResult(
 		sdkerrors.Wrap(
 			sdkerrors.ErrUnknownRequest,
-			"expected second parameter to be either 'simulate' or 'version', neither was present",
+			"expected second parameter to be either 'simulate', 'version' or 'block_gas', none was present",
 		), app.trace)
 }
 
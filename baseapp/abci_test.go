@@ -1,6 +1,7 @@
 package baseapp_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -12,6 +13,7 @@ import (
 	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/testutil"
 )
 
@@ -165,3 +167,33 @@ func TestBaseAppCreateQueryContext(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockExecutionTelemetry(t *testing.T) {
+	m, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "test"})
+	require.NoError(t, err)
+
+	app := baseapp.NewBaseApp(t.Name(), defaultLogger(), dbm.NewMemDB())
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmprototypes.Header{Height: 1}})
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	gr, err := m.Gather(telemetry.FormatText)
+	require.NoError(t, err)
+	var summary struct {
+		Samples []struct {
+			Name   string
+			Count  int
+			Labels map[string]string
+		}
+	}
+	require.NoError(t, json.Unmarshal(gr.Metrics, &summary))
+
+	var phases []string
+	for _, sample := range summary.Samples {
+		if sample.Name == "test.block.execution" {
+			require.Equal(t, 1, sample.Count)
+			phases = append(phases, sample.Labels["phase"])
+		}
+	}
+	require.ElementsMatch(t, []string{"begin_block", "deliver_tx", "end_block"}, phases)
+}
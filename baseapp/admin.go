@@ -0,0 +1,61 @@
+package baseapp
+
+import (
+	"sync/atomic"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Snapshots returns the state sync snapshots stored by the node, the most recent
+// first.
+func (app *BaseApp) Snapshots() ([]*snapshottypes.Snapshot, error) {
+	if app.snapshotManager == nil {
+		return nil, nil
+	}
+
+	return app.snapshotManager.List()
+}
+
+// TakeSnapshot takes a state sync snapshot of the latest committed height,
+// regardless of the snapshot interval.
+func (app *BaseApp) TakeSnapshot() (*snapshottypes.Snapshot, error) {
+	height := app.LastBlockHeight()
+	if height <= 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "no committed height to snapshot")
+	}
+
+	return app.snapshotManager.Create(uint64(height))
+}
+
+// SchedulePrune schedules the pruning of the heights pending pruning at the next
+// commit, regardless of the pruning interval. The stores are not pruned right
+// away as pruning must not run concurrently with a commit.
+func (app *BaseApp) SchedulePrune() {
+	atomic.StoreInt32(&app.pruneScheduled, 1)
+}
+
+// pruneStores prunes the heights pending pruning from the stores.
+func (app *BaseApp) pruneStores() {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		app.logger.Error("cannot prune stores", "err", "the commit multi-store does not support pruning")
+		return
+	}
+
+	app.logger.Info("pruning stores on demand")
+	if err := rms.PruneStores(); err != nil {
+		app.logger.Error("failed to prune stores", "err", err)
+	}
+}
+
+// QueryCacheStats returns the statistics of the cache of gRPC query responses,
+// and false if no query is cached.
+func (app *BaseApp) QueryCacheStats() (QueryCacheStats, bool) {
+	if app.queryCache == nil {
+		return QueryCacheStats{}, false
+	}
+
+	return app.queryCache.stats(), true
+}
@@ -3,11 +3,15 @@ package baseapp
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
@@ -18,11 +22,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
+// Keys of the fields the loggers of the contexts given to the modules are
+// structured with.
 const (
-	runTxModeCheck    runTxMode = iota // Check a transaction
-	runTxModeReCheck                   // Recheck a (pending) transaction after a commit
-	runTxModeSimulate                  // Simulate a transaction
-	runTxModeDeliver                   // Deliver a transaction
+	logKeyHeight = "height"
+	logKeyTxHash = "tx_hash"
 )
 
 var (
@@ -30,9 +34,6 @@ var (
 )
 
 type (
-	// Enum mode for app.runTx
-	runTxMode uint8
-
 	// StoreLoader defines a customizable function to control how we load the CommitMultiStore
 	// from disk. This is useful for state migration, when loading a datastore written with
 	// an older version of the software. In particular, if a module changed the substore key name
@@ -73,9 +74,29 @@ type BaseApp struct { // nolint: maligned
 	// an inter-block write-through cache provided to the context during deliverState
 	interBlockCache sdk.MultiStorePersistentCache
 
+	// queryCache caches the responses of the gRPC queries, if set
+	queryCache *queryCache
+
+	// pruneScheduled is set to 1 if the stores are to be pruned at the next commit
+	pruneScheduled int32
+
 	// absent validators from begin block
 	voteInfos []abci.VoteInfo
 
+	// blockSpan is the tracing span of the block being executed, started on
+	// BeginBlock and ended on Commit
+	blockSpan trace.Span
+
+	// blockExecution accumulates the execution time of each phase of the block
+	// being executed, emitted on EndBlock
+	blockExecution blockExecution
+
+	// blockGas keeps the gas usage of the recent blocks, recorded on EndBlock,
+	// and blockGasMeterResetHooks are called on BeginBlock once the block gas
+	// meter is set
+	blockGas                blockGasTracker
+	blockGasMeterResetHooks []BlockGasMeterResetHook
+
 	// paramStore is used to query for ABCI consensus parameters from an
 	// application parameter store.
 	paramStore ParamStore
@@ -84,6 +105,10 @@ type BaseApp struct { // nolint: maligned
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
 
+	// maxExecDepth is the maximum depth at which the messages nested in other
+	// messages can be executed, sdk.DefaultMaxExecDepth if 0.
+	maxExecDepth int
+
 	// initialHeight is the initial height at which we start the baseapp
 	initialHeight int64
 
@@ -122,9 +147,27 @@ type BaseApp struct { // nolint: maligned
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
 
+	// eventAggregationThreshold is the number of duplicate events of a tx
+	// result from which they are aggregated into a summary event, 0 disabling
+	// the aggregation
+	eventAggregationThreshold int
+
+	// resultLimits caps the size of the events and the logs of the ABCI
+	// results, and blockResultUsage accounts the size of the ones of the block
+	// being executed
+	resultLimits     ResultLimits
+	blockResultUsage resultUsage
+
 	// abciListeners for hooking into the ABCI message processing of the BaseApp
 	// and exposing the requests and responses to external consumers
 	abciListeners []ABCIListener
+
+	// streamingServices are the streaming services set into the BaseApp, closed
+	// with it
+	streamingServices []StreamingService
+
+	// snapshotWG tracks the snapshots taken in the background after Commit
+	snapshotWG sync.WaitGroup
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -144,6 +187,7 @@ func NewBaseApp(
 		queryRouter:     NewQueryRouter(),
 		grpcQueryRouter: NewGRPCQueryRouter(),
 		fauxMerkleMode:  false,
+		blockGas:        blockGasTracker{window: DefaultBlockGasWindow},
 	}
 
 	for _, option := range options {
@@ -258,12 +302,10 @@ func DefaultStoreLoader(ms sdk.CommitMultiStore) error {
 }
 
 // CommitMultiStore returns the root multi-store.
-// App constructor can use this to access the `cms`.
-// UNSAFE: only safe to use during app initialization.
+// App constructor can use this to access the `cms`, as well as commands operating
+// on the state of a stopped node, e.g. rollback.
+// UNSAFE: must not be used during the ABCI life cycle.
 func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore {
-	if app.sealed {
-		panic("cannot call CommitMultiStore() after baseapp is sealed")
-	}
 	return app.cms
 }
 
@@ -314,6 +356,28 @@ func (app *BaseApp) Init() error {
 	return rms.GetPruning().Validate()
 }
 
+// Close waits for the snapshots being taken and the stores being pruned in the
+// background and closes the streaming services of the app. It should be called
+// once no more blocks are processed, i.e. after Tendermint is stopped.
+func (app *BaseApp) Close() error {
+	app.snapshotWG.Wait()
+	if rms, ok := app.cms.(*rootmulti.Store); ok {
+		rms.StopAsyncPruning()
+	}
+
+	var errs []string
+	for _, s := range app.streamingServices {
+		if err := s.Close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to close streaming services: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
 func (app *BaseApp) setMinGasPrices(gasPrices sdk.DecCoins) {
 	app.minGasPrices = gasPrices
 }
@@ -330,6 +394,30 @@ func (app *BaseApp) setMinRetainBlocks(minRetainBlocks uint64) {
 	app.minRetainBlocks = minRetainBlocks
 }
 
+func (app *BaseApp) setAsyncPruning(queueSize int) {
+	if queueSize <= 0 {
+		return
+	}
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		panic(fmt.Errorf("async pruning requires %T, got: %T", &rootmulti.Store{}, app.cms))
+	}
+	rms.SetAsyncPruning(queueSize)
+}
+
+func (app *BaseApp) setEventAggregationThreshold(threshold int) {
+	app.eventAggregationThreshold = threshold
+}
+
+func (app *BaseApp) setResultLimits(limits ResultLimits) {
+	app.resultLimits = limits
+}
+
+func (app *BaseApp) setMaxExecDepth(depth int) {
+	app.maxExecDepth = depth
+}
+
 func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
@@ -338,6 +426,20 @@ func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
 
+func (app *BaseApp) setQueryCache(size int, routes []string) {
+	if len(routes) == 0 {
+		app.queryCache = nil
+		return
+	}
+
+	cache, err := newQueryCache(size, routes)
+	if err != nil {
+		panic(fmt.Errorf("invalid query cache size %d: %w", size, err))
+	}
+
+	app.queryCache = cache
+}
+
 func (app *BaseApp) setIndexEvents(ie []string) {
 	app.indexEvents = make(map[string]struct{})
 
@@ -363,7 +465,7 @@ func (app *BaseApp) setCheckState(header tmproto.Header) {
 	ms := app.cms.CacheMultiStore()
 	app.checkState = &state{
 		ms:  ms,
-		ctx: sdk.NewContext(ms, header, true, app.logger).WithMinGasPrices(app.minGasPrices),
+		ctx: sdk.NewContext(ms, header, true, app.logger).WithMinGasPrices(app.minGasPrices).WithMaxExecDepth(app.maxExecDepth),
 	}
 }
 
@@ -375,10 +477,16 @@ func (app *BaseApp) setDeliverState(header tmproto.Header) {
 	ms := app.cms.CacheMultiStore()
 	app.deliverState = &state{
 		ms:  ms,
-		ctx: sdk.NewContext(ms, header, false, app.logger),
+		ctx: sdk.NewContext(ms, header, false, app.blockLogger(header.Height)).WithMaxExecDepth(app.maxExecDepth),
 	}
 }
 
+// blockLogger returns the logger of the contexts of the block at the given
+// height, logging the height along with every message.
+func (app *BaseApp) blockLogger(height int64) log.Logger {
+	return app.logger.With(logKeyHeight, height)
+}
+
 // GetConsensusParams returns the current consensus parameters from the BaseApp's
 // ParamStore. If the BaseApp has no ParamStore defined, nil is returned.
 func (app *BaseApp) GetConsensusParams(ctx sdk.Context) *tmproto.ConsensusParams {
@@ -479,29 +587,29 @@ func (app *BaseApp) validateHeight(req abci.RequestBeginBlock) error {
 	return nil
 }
 
-// Returns the applications's deliverState if app is in runTxModeDeliver,
+// Returns the applications's deliverState if app is in ExecModeDeliver,
 // otherwise it returns the application's checkstate.
-func (app *BaseApp) getState(mode runTxMode) *state {
-	if mode == runTxModeDeliver {
+func (app *BaseApp) getState(mode sdk.ExecMode) *state {
+	if mode == sdk.ExecModeDeliver {
 		return app.deliverState
 	}
 
 	return app.checkState
 }
 
-// retrieve the context for the tx w/ txBytes and other memoized values.
-func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) context.Context {
-	ctx := app.getState(mode).ctx.
+// retrieve the context for the tx w/ txBytes and other memoized values, in the
+// given execution mode.
+func (app *BaseApp) getContextForTx(mode sdk.ExecMode, txBytes []byte) context.Context {
+	ctx := app.getState(mode).ctx
+	ctx = ctx.
+		WithExecMode(mode).
 		WithTxBytes(txBytes).
-		WithVoteInfos(app.voteInfos)
+		WithVoteInfos(app.voteInfos).
+		WithLogger(ctx.Logger().With(logKeyTxHash, fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash())))
 
 	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
 
-	if mode == runTxModeReCheck {
-		ctx = ctx.WithIsReCheckTx(true)
-	}
-
-	if mode == runTxModeSimulate {
+	if mode == sdk.ExecModeSimulate {
 		ctx, _ = ctx.CacheContext()
 	}
 
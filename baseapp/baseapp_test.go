@@ -5,9 +5,11 @@ import (
 	"context"
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/rand"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -19,7 +21,11 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -30,8 +36,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata_pulsar"
@@ -1113,6 +1121,74 @@ func TestDeliverTx(t *testing.T) {
 	}
 }
 
+func TestDeliverTxTracing(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	telemetry.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+	t.Cleanup(func() { telemetry.SetTracerProvider(nil) })
+
+	txHandlerOpt := func(bapp *baseapp.BaseApp) {
+		legacyRouter := middleware.NewLegacyRouter()
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, []byte("deliver-key")))
+		legacyRouter.AddRoute(r)
+		txHandler := testTxHandler(
+			middleware.TxHandlerOptions{
+				LegacyRouter:     legacyRouter,
+				MsgServiceRouter: middleware.NewMsgServiceRouter(encCfg.InterfaceRegistry),
+				TxDecoder:        testTxDecoder(encCfg.Amino),
+			},
+			customHandlerTxTest(t, capKey1, []byte("ante-key")),
+		)
+		bapp.SetTxHandler(txHandler)
+	}
+	blockersOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			return abci.ResponseBeginBlock{}
+		})
+		bapp.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+			return abci.ResponseEndBlock{}
+		})
+	}
+
+	app, err := setupBaseApp(t, txHandlerOpt, blockersOpt)
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{})
+
+	txBytes, err := encCfg.Amino.Marshal(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range sr.Ended() {
+		spans[span.Name()] = span
+	}
+
+	block := spans["Block"]
+	require.NotNil(t, block)
+	require.Contains(t, block.Attributes(), attribute.Int64(telemetry.AttributeKeyHeight, 1))
+
+	deliverTx := spans["DeliverTx"]
+	require.NotNil(t, deliverTx)
+	require.Contains(t, deliverTx.Attributes(), attribute.String(telemetry.AttributeKeyTxHash, fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash())))
+	require.Contains(t, deliverTx.Attributes(), attribute.Int64(telemetry.AttributeKeyGasUsed, res.GasUsed))
+
+	msgType := sdk.MsgTypeURL(&msgCounter{})
+	msg := spans[msgType]
+	require.NotNil(t, msg)
+	require.Contains(t, msg.Attributes(), attribute.String(telemetry.AttributeKeyMsgType, msgType))
+	require.Equal(t, deliverTx.SpanContext().SpanID(), msg.Parent().SpanID())
+
+	require.Equal(t, spans["Commit"].SpanContext().SpanID(), spans["StoreCommit"].Parent().SpanID())
+	for _, name := range []string{"BeginBlock", "DeliverTx", "EndBlock", "Commit"} {
+		require.Contains(t, spans, name)
+		require.Equal(t, block.SpanContext().SpanID(), spans[name].Parent().SpanID(), name)
+	}
+}
+
 // Number of messages doesn't matter to CheckTx.
 func TestMultiMsgCheckTx(t *testing.T) {
 	// TODO: ensure we get the same results
@@ -1279,6 +1355,82 @@ func TestSimulateTx(t *testing.T) {
 	}
 }
 
+// Test that the execution mode exposed on the Context matches the ABCI method
+// being processed.
+func TestExecMode(t *testing.T) {
+	var modes []sdk.ExecMode
+	record := func(ctx sdk.Context) { modes = append(modes, ctx.ExecMode()) }
+
+	opts := []func(*baseapp.BaseApp){
+		func(bapp *baseapp.BaseApp) {
+			legacyRouter := middleware.NewLegacyRouter()
+			legacyRouter.AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+				any, err := codectypes.NewAnyWithValue(&testdata.Dog{})
+				if err != nil {
+					return nil, err
+				}
+
+				return &sdk.Result{MsgResponses: []*codectypes.Any{any}}, nil
+			}))
+			txHandler := testTxHandler(
+				middleware.TxHandlerOptions{
+					LegacyRouter:     legacyRouter,
+					MsgServiceRouter: middleware.NewMsgServiceRouter(encCfg.InterfaceRegistry),
+					TxDecoder:        testTxDecoder(encCfg.Amino),
+				},
+				func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+					record(ctx)
+					return ctx, nil
+				},
+			)
+			bapp.SetTxHandler(txHandler)
+		},
+		func(bapp *baseapp.BaseApp) {
+			bapp.SetInitChainer(func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+				record(ctx)
+				return abci.ResponseInitChain{}
+			})
+		},
+		func(bapp *baseapp.BaseApp) {
+			bapp.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+				record(ctx)
+				return abci.ResponseBeginBlock{}
+			})
+		},
+		func(bapp *baseapp.BaseApp) {
+			bapp.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+				record(ctx)
+				return abci.ResponseEndBlock{}
+			})
+		},
+	}
+	app, err := setupBaseApp(t, opts...)
+	require.NoError(t, err)
+
+	txBytes, err := encCfg.Amino.Marshal(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{})
+	require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: txBytes}).IsOK())
+	require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_Recheck}).IsOK())
+	_, _, err = app.Simulate(txBytes)
+	require.NoError(t, err)
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	require.True(t, app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}).IsOK())
+	app.EndBlock(abci.RequestEndBlock{})
+
+	expected := []sdk.ExecMode{
+		sdk.ExecModeFinalize, // InitChain
+		sdk.ExecModeCheck,
+		sdk.ExecModeReCheck,
+		sdk.ExecModeSimulate,
+		sdk.ExecModeFinalize, // BeginBlock
+		sdk.ExecModeDeliver,
+		sdk.ExecModeFinalize, // EndBlock
+	}
+	require.Equal(t, expected, modes)
+}
+
 func TestRunInvalidTransaction(t *testing.T) {
 	txHandlerOpt := func(bapp *baseapp.BaseApp) {
 		legacyRouter := middleware.NewLegacyRouter()
@@ -1588,6 +1740,94 @@ func TestMaxBlockGasLimits(t *testing.T) {
 	}
 }
 
+func TestBlockGasReport(t *testing.T) {
+	ante := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		ctx.GasMeter().ConsumeGas(uint64(tx.(txTest).Counter), "counter-ante")
+		return ctx, nil
+	}
+
+	txHandlerOpt := func(bapp *baseapp.BaseApp) {
+		legacyRouter := middleware.NewLegacyRouter()
+		r := sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			any, err := codectypes.NewAnyWithValue(msg)
+			if err != nil {
+				return nil, err
+			}
+
+			return &sdk.Result{
+				MsgResponses: []*codectypes.Any{any},
+			}, nil
+		})
+		legacyRouter.AddRoute(r)
+		txHandler := testTxHandler(
+			middleware.TxHandlerOptions{
+				LegacyRouter:     legacyRouter,
+				MsgServiceRouter: middleware.NewMsgServiceRouter(encCfg.InterfaceRegistry),
+				TxDecoder:        testTxDecoder(encCfg.Amino),
+			},
+			ante,
+		)
+		bapp.SetTxHandler(txHandler)
+	}
+
+	var blocks, previousBlocks []baseapp.BlockGasUsage
+	hookOpt := func(bapp *baseapp.BaseApp) {
+		bapp.AddBlockGasMeterResetHook(func(block, previous baseapp.BlockGasUsage) {
+			blocks = append(blocks, block)
+			previousBlocks = append(previousBlocks, previous)
+		})
+	}
+
+	app, err := setupBaseApp(t, txHandlerOpt, hookOpt, baseapp.SetBlockGasWindow(2))
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{
+			Block: &tmproto.BlockParams{
+				MaxGas: 100,
+			},
+		},
+	})
+
+	tx := newTxCounter(10, 0)
+	tx.GasLimit = 10
+	for _, numDelivers := range []int{3, 5, 1} {
+		header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		for i := 0; i < numDelivers; i++ {
+			_, _, err := app.SimDeliver(aminoTxEncoder(encCfg.Amino), tx)
+			require.NoError(t, err)
+		}
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+	}
+
+	// the hooks got the new block and the usage of the previous block, none for
+	// the first one
+	require.Equal(t, []baseapp.BlockGasUsage{
+		{Height: 1, GasLimit: 100},
+		{Height: 2, GasLimit: 100},
+		{Height: 3, GasLimit: 100},
+	}, blocks)
+	require.Equal(t, []baseapp.BlockGasUsage{
+		{},
+		{Height: 1, GasUsed: 30, GasLimit: 100},
+		{Height: 2, GasUsed: 50, GasLimit: 100},
+	}, previousBlocks)
+
+	// only the last 2 blocks are reported
+	res := app.Query(abci.RequestQuery{Path: "/app/block_gas"})
+	require.True(t, res.IsOK(), res.Log)
+
+	var report baseapp.BlockGasReport
+	require.NoError(t, json.Unmarshal(res.Value, &report))
+	require.Equal(t, []baseapp.BlockGasUsage{
+		{Height: 2, GasUsed: 50, GasLimit: 100},
+		{Height: 3, GasUsed: 10, GasLimit: 100},
+	}, report.Blocks)
+	require.InDelta(t, 30, report.AverageUtilization, 1e-9)
+	require.Equal(t, report, app.BlockGasReport())
+}
+
 func TestBaseAppMiddleware(t *testing.T) {
 	anteKey := []byte("ante-key")
 	deliverKey := []byte("deliver-key")
@@ -1953,6 +2193,60 @@ func TestListSnapshots(t *testing.T) {
 	}}, resp)
 }
 
+func TestTakeSnapshot(t *testing.T) {
+	setupConfig := &setupConfig{
+		blocks:             5,
+		blockTxs:           4,
+		snapshotInterval:   2,
+		snapshotKeepRecent: 2,
+		pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+	}
+
+	app, err := setupBaseAppWithSnapshots(t, setupConfig)
+	require.NoError(t, err)
+
+	snapshot, err := app.TakeSnapshot()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), snapshot.Height)
+
+	snapshots, err := app.Snapshots()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 3)
+	require.Equal(t, snapshot.Height, snapshots[0].Height)
+
+	// a snapshot of the same height cannot be taken twice
+	_, err = app.TakeSnapshot()
+	require.Error(t, err)
+}
+
+func TestSchedulePrune(t *testing.T) {
+	setupConfig := &setupConfig{
+		blocks:      5,
+		blockTxs:    1,
+		pruningOpts: pruningtypes.NewPruningOptions(pruningtypes.PruningEverything),
+	}
+
+	app, err := setupBaseAppWithSnapshots(t, setupConfig)
+	require.NoError(t, err)
+
+	store := app.CMS().GetCommitKVStore(capKey2).(*iavl.Store)
+	commit := func(height int64) {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+
+	// the pruning interval is not reached yet
+	commit(6)
+	require.True(t, store.VersionExists(1))
+
+	app.SchedulePrune()
+	commit(7)
+	require.False(t, store.VersionExists(1))
+	require.False(t, store.VersionExists(4))
+	require.True(t, store.VersionExists(5))
+}
+
 func TestSnapshotWithPruning(t *testing.T) {
 	testcases := map[string]struct {
 		config            *setupConfig
@@ -2508,3 +2802,184 @@ func TestBaseApp_Init(t *testing.T) {
 		require.Equal(t, tc.expectedSnapshot.KeepRecent, snapshotManager.GetKeepRecent())
 	}
 }
+
+// closeRecorderStreamingService is a streaming service recording whether it is
+// closed.
+type closeRecorderStreamingService struct {
+	closed bool
+	err    error
+}
+
+func (s *closeRecorderStreamingService) Stream(*sync.WaitGroup) error { return nil }
+func (s *closeRecorderStreamingService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+func (s *closeRecorderStreamingService) ListenBeginBlock(sdk.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+func (s *closeRecorderStreamingService) ListenEndBlock(sdk.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+func (s *closeRecorderStreamingService) ListenDeliverTx(sdk.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+func (s *closeRecorderStreamingService) Close() error {
+	s.closed = true
+	return s.err
+}
+
+func TestClose(t *testing.T) {
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), testutil.GetTempDir(t))
+	require.NoError(t, err)
+	app, err := setupBaseApp(t, baseapp.SetSnapshot(snapshotStore, snapshottypes.NewSnapshotOptions(1, 2)))
+	require.NoError(t, err)
+
+	streamingServices := []*closeRecorderStreamingService{{}, {err: errors.New("boom")}}
+	for _, s := range streamingServices {
+		app.SetStreamingService(s)
+	}
+
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	err = app.Close()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "boom")
+
+	// the snapshot taken in the background after the commit is complete
+	snapshot, err := snapshotStore.Get(1, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+
+	for _, s := range streamingServices {
+		require.True(t, s.closed)
+	}
+}
+
+// deliverTxRecorderStreamingService is a streaming service recording the
+// DeliverTx responses it receives.
+type deliverTxRecorderStreamingService struct {
+	closeRecorderStreamingService
+	responses []abci.ResponseDeliverTx
+}
+
+func (s *deliverTxRecorderStreamingService) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	s.responses = append(s.responses, res)
+	return nil
+}
+
+// eventsTxHandler is a tx.Handler emitting the given events and log for each
+// tx.
+type eventsTxHandler struct {
+	events []abci.Event
+	log    string
+}
+
+func (h eventsTxHandler) CheckTx(context.Context, tx.Request, tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	return tx.Response{Events: h.events, Log: h.log}, tx.ResponseCheckTx{}, nil
+}
+
+func (h eventsTxHandler) DeliverTx(context.Context, tx.Request) (tx.Response, error) {
+	return tx.Response{Events: h.events, Log: h.log}, nil
+}
+
+func (h eventsTxHandler) SimulateTx(context.Context, tx.Request) (tx.Response, error) {
+	return tx.Response{Events: h.events}, nil
+}
+
+func TestDeliverTxEventAggregation(t *testing.T) {
+	var events []abci.Event
+	for i := 0; i < 100; i++ {
+		events = append(events, abci.Event{Type: "transfer", Attributes: []abci.EventAttribute{
+			{Key: "recipient", Value: fmt.Sprintf("addr%d", i)},
+			{Key: sdk.AttributeKeyAmount, Value: "1stake"},
+		}})
+	}
+
+	txHandlerOpt := func(bapp *baseapp.BaseApp) { bapp.SetTxHandler(eventsTxHandler{events: events}) }
+	app, err := setupBaseApp(t, txHandlerOpt, baseapp.SetEventAggregation(10))
+	require.NoError(t, err)
+	streamingService := &deliverTxRecorderStreamingService{}
+	app.SetStreamingService(streamingService)
+
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: []byte("tx")})
+	require.True(t, res.IsOK(), res.Log)
+
+	// the transfers are summarized in the result
+	require.Equal(t, []abci.Event{{Type: "transfer", Attributes: []abci.EventAttribute{
+		{Key: sdk.AttributeKeyAmount, Value: "100stake"},
+		{Key: baseapp.AttributeKeyAggregatedCount, Value: "100"},
+		{Key: baseapp.AttributeKeyAggregatedIndex, Value: "0-99"},
+	}}}, res.Events)
+
+	// and streamed in full
+	require.Len(t, streamingService.responses, 1)
+	require.Equal(t, events, streamingService.responses[0].Events)
+}
+
+func TestResultLimits(t *testing.T) {
+	// each event is 10 bytes large
+	events := []abci.Event{
+		{Type: "a", Attributes: []abci.EventAttribute{{Key: "key", Value: "value0"}}},
+		{Type: "b", Attributes: []abci.EventAttribute{{Key: "key", Value: "value1"}}},
+		{Type: "c", Attributes: []abci.EventAttribute{{Key: "key", Value: "value2"}}},
+	}
+	log := strings.Repeat("x", 30)
+	truncated := func(count, size int) abci.Event {
+		return abci.Event{Type: baseapp.EventTypeTruncated, Attributes: []abci.EventAttribute{
+			{Key: baseapp.AttributeKeyTruncatedEvents, Value: strconv.Itoa(count)},
+			{Key: baseapp.AttributeKeyTruncatedBytes, Value: strconv.Itoa(size)},
+		}}
+	}
+
+	txHandlerOpt := func(bapp *baseapp.BaseApp) { bapp.SetTxHandler(eventsTxHandler{events: events, log: log}) }
+	app, err := setupBaseApp(t, txHandlerOpt, baseapp.SetResultLimits(baseapp.ResultLimits{
+		MaxTxEventBytes:    25,
+		MaxTxLogBytes:      20,
+		MaxBlockEventBytes: 50,
+		MaxBlockLogBytes:   30,
+	}))
+	require.NoError(t, err)
+	streamingService := &deliverTxRecorderStreamingService{}
+	app.SetStreamingService(streamingService)
+
+	checkRes := app.CheckTx(abci.RequestCheckTx{Tx: []byte("tx")})
+	require.Equal(t, []abci.Event{events[0], events[1], truncated(1, 10)}, checkRes.Events)
+	require.Equal(t, strings.Repeat("x", 20)+"... (truncated 10 bytes)", checkRes.Log)
+
+	app.InitChain(abci.RequestInitChain{})
+	for height := int64(1); height <= 2; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+
+		// the tx limits apply to the first tx, and the block limits to the
+		// next ones
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: []byte("tx")})
+		require.Equal(t, []abci.Event{events[0], events[1], truncated(1, 10)}, res.Events)
+		require.Equal(t, strings.Repeat("x", 20)+"... (truncated 10 bytes)", res.Log)
+
+		res = app.DeliverTx(abci.RequestDeliverTx{Tx: []byte("tx")})
+		require.Equal(t, []abci.Event{events[0], events[1], truncated(1, 10)}, res.Events)
+		require.Equal(t, strings.Repeat("x", 10)+"... (truncated 20 bytes)", res.Log)
+
+		res = app.DeliverTx(abci.RequestDeliverTx{Tx: []byte("tx")})
+		require.Equal(t, []abci.Event{events[0], truncated(2, 20)}, res.Events)
+		require.Equal(t, "... (truncated 30 bytes)", res.Log)
+
+		res = app.DeliverTx(abci.RequestDeliverTx{Tx: []byte("tx")})
+		require.Equal(t, []abci.Event{truncated(3, 30)}, res.Events)
+
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+
+	// the results are streamed in full
+	require.Len(t, streamingService.responses, 8)
+	for _, res := range streamingService.responses {
+		require.Equal(t, events, res.Events)
+		require.Equal(t, log, res.Log)
+	}
+}
@@ -0,0 +1,150 @@
+package baseapp
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultBlockGasWindow is the default number of recent blocks whose gas usage
+// is kept by BaseApp.
+const DefaultBlockGasWindow = 100
+
+// BlockGasUsage is the gas used by a block, along with its gas limit.
+type BlockGasUsage struct {
+	Height int64 `json:"height"`
+	// GasUsed is the gas consumed by the txs of the block, capped to its limit.
+	GasUsed uint64 `json:"gas_used"`
+	// GasLimit is the gas limit of the block, 0 if its gas is unlimited.
+	GasLimit uint64 `json:"gas_limit"`
+}
+
+// Utilization returns the percentage of the gas limit used by the block, 0 if
+// its gas is unlimited.
+func (u BlockGasUsage) Utilization() float64 {
+	if u.GasLimit == 0 {
+		return 0
+	}
+	return float64(u.GasUsed) * 100 / float64(u.GasLimit)
+}
+
+// BlockGasReport is the gas usage of the recent blocks executed by the node.
+type BlockGasReport struct {
+	// Blocks are the gas usages of the recent blocks, oldest first.
+	Blocks []BlockGasUsage `json:"blocks"`
+	// AverageUtilization is the average percentage of the gas limit used by
+	// the blocks with a gas limit, 0 if there is none.
+	AverageUtilization float64 `json:"average_utilization"`
+}
+
+// BlockGasMeterResetHook is called on BeginBlock once the block gas meter of
+// the new block is set, with the height and gas limit of the new block, and the
+// gas usage of the previous block executed by the node, zero if there is none,
+// e.g. on the first block after a restart. As the previous usage is node-local,
+// the hook is not given the block context and must not affect the state, it is
+// meant for node-local settings such as the minimum gas prices.
+type BlockGasMeterResetHook func(block, previous BlockGasUsage)
+
+// blockGasTracker keeps the gas usage of the last blocks executed, up to the
+// window size, and of the last one regardless of the window. It is read by the
+// queries concurrently with the execution of the blocks.
+type blockGasTracker struct {
+	mtx       sync.RWMutex
+	window    int
+	blocks    []BlockGasUsage
+	lastBlock BlockGasUsage
+}
+
+func (t *blockGasTracker) setWindow(window int) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if window < 0 {
+		window = 0
+	}
+	t.window = window
+	if len(t.blocks) > window {
+		t.blocks = t.blocks[len(t.blocks)-window:]
+	}
+}
+
+// record records the gas usage of a block, dropping the oldest one if the
+// window is full.
+func (t *blockGasTracker) record(usage BlockGasUsage) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.lastBlock = usage
+	if t.window <= 0 {
+		return
+	}
+	if len(t.blocks) == t.window {
+		t.blocks = append(t.blocks[:0], t.blocks[1:]...)
+	}
+	t.blocks = append(t.blocks, usage)
+}
+
+// last returns the gas usage of the last block recorded, zero if none.
+func (t *blockGasTracker) last() BlockGasUsage {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	return t.lastBlock
+}
+
+func (t *blockGasTracker) report() BlockGasReport {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	report := BlockGasReport{Blocks: make([]BlockGasUsage, len(t.blocks))}
+	copy(report.Blocks, t.blocks)
+
+	var limited int
+	for _, usage := range t.blocks {
+		if usage.GasLimit > 0 {
+			report.AverageUtilization += usage.Utilization()
+			limited++
+		}
+	}
+	if limited > 0 {
+		report.AverageUtilization /= float64(limited)
+	}
+
+	return report
+}
+
+// BlockGasReport returns the gas usage of the recent blocks executed by the
+// node, up to the block gas window.
+func (app *BaseApp) BlockGasReport() BlockGasReport {
+	return app.blockGas.report()
+}
+
+func (app *BaseApp) setBlockGasWindow(window int) {
+	app.blockGas.setWindow(window)
+}
+
+// resetBlockGasMeter calls the block gas meter reset hooks with the new block
+// and the gas usage of the previous block, once the block gas meter of the new
+// block is set.
+func (app *BaseApp) resetBlockGasMeter(ctx sdk.Context) {
+	block := BlockGasUsage{
+		Height:   ctx.BlockHeight(),
+		GasLimit: ctx.BlockGasLimit(),
+	}
+	previous := app.blockGas.last()
+	for _, hook := range app.blockGasMeterResetHooks {
+		hook(block, previous)
+	}
+}
+
+// recordBlockGas records the gas usage of the block being executed, and emits
+// it to telemetry.
+func (app *BaseApp) recordBlockGas(ctx sdk.Context) {
+	usage := BlockGasUsage{
+		Height:   ctx.BlockHeight(),
+		GasUsed:  ctx.BlockGasUsed(),
+		GasLimit: ctx.BlockGasLimit(),
+	}
+	app.blockGas.record(usage)
+	emitBlockGas(usage)
+}
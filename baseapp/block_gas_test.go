@@ -0,0 +1,38 @@
+package baseapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockGasTracker(t *testing.T) {
+	tracker := blockGasTracker{window: 3}
+	require.Equal(t, BlockGasReport{Blocks: []BlockGasUsage{}}, tracker.report())
+	require.Equal(t, BlockGasUsage{}, tracker.last())
+
+	blocks := []BlockGasUsage{
+		{Height: 1, GasUsed: 10, GasLimit: 100},
+		{Height: 2, GasUsed: 50, GasLimit: 0},
+		{Height: 3, GasUsed: 30, GasLimit: 100},
+		{Height: 4, GasUsed: 80, GasLimit: 200},
+	}
+	for _, usage := range blocks {
+		tracker.record(usage)
+	}
+
+	// the oldest block was dropped, the block without limit isn't averaged
+	report := tracker.report()
+	require.Equal(t, blocks[1:], report.Blocks)
+	require.InDelta(t, 35, report.AverageUtilization, 1e-9)
+	require.Equal(t, blocks[3], tracker.last())
+
+	tracker.setWindow(1)
+	require.Equal(t, blocks[3:], tracker.report().Blocks)
+
+	// the last block is still kept without window
+	tracker.setWindow(0)
+	tracker.record(blocks[0])
+	require.Empty(t, tracker.report().Blocks)
+	require.Equal(t, blocks[0], tracker.last())
+}
@@ -0,0 +1,174 @@
+package baseapp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// AttributeKeyAggregatedCount is the attribute of an aggregated event
+	// holding the number of events it summarizes.
+	AttributeKeyAggregatedCount = "aggregated_count"
+	// AttributeKeyAggregatedIndex is the attribute of an aggregated event
+	// holding the positions of the events it summarizes in the full tx result
+	// events, as comma separated ranges, e.g. "0-99,104".
+	AttributeKeyAggregatedIndex = "aggregated_index"
+)
+
+// aggregateEvents aggregates the duplicate events of a tx result, i.e. the
+// events of the same type with the same attribute keys, occurring at least
+// threshold times. Each set of duplicate events is replaced, at the position of
+// its first event, by a single event of the same type summarizing them:
+//
+//   - the attributes whose values are all coins, e.g. transfer amounts, are
+//     summed up,
+//   - the other attributes with the same value in all the events are kept,
+//   - the remaining attributes are dropped,
+//
+// and the AttributeKeyAggregatedCount and AttributeKeyAggregatedIndex
+// attributes are added to map the summary back to the full events. The
+// aggregation is deterministic and the given events are left untouched. No
+// event is aggregated if threshold is 0.
+func aggregateEvents(events []abci.Event, threshold int) []abci.Event {
+	if threshold <= 0 || len(events) < threshold {
+		return events
+	}
+
+	// group the duplicate events, in the order of their first occurrence
+	groups := make(map[string][]int)
+	var signatures []string
+	for i, event := range events {
+		signature := eventSignature(event)
+		if _, ok := groups[signature]; !ok {
+			signatures = append(signatures, signature)
+		}
+		groups[signature] = append(groups[signature], i)
+	}
+
+	// aggregated maps the position of the first event of each aggregated group
+	// to its summary, the other events of the group being skipped
+	aggregated := make(map[int]*abci.Event)
+	skipped := make(map[int]bool)
+	for _, signature := range signatures {
+		positions := groups[signature]
+		if len(positions) < threshold {
+			continue
+		}
+
+		aggregated[positions[0]] = summarizeEvents(events, positions)
+		for _, i := range positions[1:] {
+			skipped[i] = true
+		}
+	}
+
+	if len(aggregated) == 0 {
+		return events
+	}
+
+	res := make([]abci.Event, 0, len(events)-len(skipped))
+	for i, event := range events {
+		switch {
+		case skipped[i]:
+		case aggregated[i] != nil:
+			res = append(res, *aggregated[i])
+		default:
+			res = append(res, event)
+		}
+	}
+
+	return res
+}
+
+// eventSignature returns the type and the ordered attribute keys of event,
+// identifying the duplicate events.
+func eventSignature(event abci.Event) string {
+	var sb strings.Builder
+	sb.WriteString(event.Type)
+	for _, attr := range event.Attributes {
+		sb.WriteByte(0)
+		sb.WriteString(attr.Key)
+	}
+
+	return sb.String()
+}
+
+// summarizeEvents returns the event summarizing the duplicate events at the
+// given positions.
+func summarizeEvents(events []abci.Event, positions []int) *abci.Event {
+	first := events[positions[0]]
+	summary := &abci.Event{Type: first.Type}
+
+	for j, attr := range first.Attributes {
+		if value, ok := summarizeAttribute(events, positions, j); ok {
+			summary.Attributes = append(summary.Attributes, abci.EventAttribute{
+				Key:   attr.Key,
+				Value: value,
+				Index: attr.Index,
+			})
+		}
+	}
+
+	summary.Attributes = append(summary.Attributes,
+		abci.EventAttribute{Key: AttributeKeyAggregatedCount, Value: strconv.Itoa(len(positions))},
+		abci.EventAttribute{Key: AttributeKeyAggregatedIndex, Value: formatPositions(positions)},
+	)
+
+	return summary
+}
+
+// summarizeAttribute returns the summary of the j-th attribute of the duplicate
+// events at the given positions, and false if the attribute is dropped.
+func summarizeAttribute(events []abci.Event, positions []int, j int) (string, bool) {
+	if total, ok := sumCoins(events, positions, j); ok {
+		return total.String(), true
+	}
+
+	value := events[positions[0]].Attributes[j].Value
+	for _, i := range positions[1:] {
+		if events[i].Attributes[j].Value != value {
+			return "", false
+		}
+	}
+
+	return value, true
+}
+
+// sumCoins returns the sum of the j-th attribute of the duplicate events at the
+// given positions, and false if any of them is not coins.
+func sumCoins(events []abci.Event, positions []int, j int) (sdk.Coins, bool) {
+	total := sdk.NewCoins()
+	for _, i := range positions {
+		coins, err := sdk.ParseCoinsNormalized(events[i].Attributes[j].Value)
+		if err != nil {
+			return nil, false
+		}
+		total = total.Add(coins...)
+	}
+
+	return total, true
+}
+
+// formatPositions formats the sorted positions as comma separated ranges.
+func formatPositions(positions []int) string {
+	var ranges []string
+	for start := 0; start < len(positions); {
+		end := start
+		for end+1 < len(positions) && positions[end+1] == positions[end]+1 {
+			end++
+		}
+
+		if start == end {
+			ranges = append(ranges, strconv.Itoa(positions[start]))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", positions[start], positions[end]))
+		}
+		start = end + 1
+	}
+
+	return strings.Join(ranges, ",")
+}
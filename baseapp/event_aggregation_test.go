@@ -0,0 +1,78 @@
+package baseapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func newTestEvent(typ string, attrs ...string) abci.Event {
+	event := abci.Event{Type: typ}
+	for i := 0; i < len(attrs); i += 2 {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: attrs[i], Value: attrs[i+1], Index: true})
+	}
+
+	return event
+}
+
+func TestAggregateEvents(t *testing.T) {
+	events := []abci.Event{
+		newTestEvent("transfer", "recipient", "addr1", "sender", "addr0", "amount", "1stake"),
+		newTestEvent("transfer", "recipient", "addr2", "sender", "addr0", "amount", "2stake,1atom"),
+		newTestEvent("message", "action", "send"),
+		newTestEvent("transfer", "recipient", "addr3", "sender", "addr0", "amount", "3stake"),
+		// the same type with other attribute keys is not a duplicate
+		newTestEvent("transfer", "recipient", "addr4", "amount", "4stake"),
+		newTestEvent("message", "action", "send"),
+	}
+
+	testCases := []struct {
+		name      string
+		threshold int
+		expEvents []abci.Event
+	}{
+		{"disabled", 0, events},
+		{"no duplicates above the threshold", 4, events},
+		{
+			"duplicates aggregated", 2,
+			[]abci.Event{
+				{Type: "transfer", Attributes: []abci.EventAttribute{
+					{Key: "sender", Value: "addr0", Index: true},
+					{Key: "amount", Value: "1atom,6stake", Index: true},
+					{Key: AttributeKeyAggregatedCount, Value: "3"},
+					{Key: AttributeKeyAggregatedIndex, Value: "0-1,3"},
+				}},
+				{Type: "message", Attributes: []abci.EventAttribute{
+					{Key: "action", Value: "send", Index: true},
+					{Key: AttributeKeyAggregatedCount, Value: "2"},
+					{Key: AttributeKeyAggregatedIndex, Value: "2,5"},
+				}},
+				events[4],
+			},
+		},
+		{
+			"duplicates below the threshold kept", 3,
+			[]abci.Event{
+				{Type: "transfer", Attributes: []abci.EventAttribute{
+					{Key: "sender", Value: "addr0", Index: true},
+					{Key: "amount", Value: "1atom,6stake", Index: true},
+					{Key: AttributeKeyAggregatedCount, Value: "3"},
+					{Key: AttributeKeyAggregatedIndex, Value: "0-1,3"},
+				}},
+				events[2], events[4], events[5],
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			original := append([]abci.Event{}, events...)
+			require.Equal(t, tc.expEvents, aggregateEvents(events, tc.threshold))
+			// the given events are left untouched
+			require.Equal(t, original, events)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/encoding"
 
+	"github.com/cosmos/cosmos-sdk/client/grpc/moduleparams"
 	"github.com/cosmos/cosmos-sdk/client/grpc/reflection"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -108,7 +109,7 @@ func (qrt *GRPCQueryRouter) RegisterService(sd *grpc.ServiceDesc, handler interf
 }
 
 // SetInterfaceRegistry sets the interface registry for the router. This will
-// also register the interface reflection gRPC service.
+// also register the interface reflection and the module params gRPC services.
 func (qrt *GRPCQueryRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
 	// instantiate the codec
 	qrt.cdc = codec.NewProtoCodec(interfaceRegistry).GRPCCodec()
@@ -118,4 +119,22 @@ func (qrt *GRPCQueryRouter) SetInterfaceRegistry(interfaceRegistry codectypes.In
 		qrt,
 		reflection.NewReflectionServiceServer(interfaceRegistry),
 	)
+	moduleparams.RegisterModuleParamsServiceServer(
+		qrt,
+		moduleparams.NewModuleParamsServiceServer(qrt.queryServices, interfaceRegistry),
+	)
+}
+
+// queryServices returns the registered query services along with their
+// handlers.
+func (qrt *GRPCQueryRouter) queryServices() []moduleparams.QueryService {
+	services := make([]moduleparams.QueryService, len(qrt.serviceData))
+	for i, data := range qrt.serviceData {
+		services[i] = moduleparams.QueryService{
+			Desc:    data.serviceDesc,
+			Handler: data.handler,
+		}
+	}
+
+	return services
 }
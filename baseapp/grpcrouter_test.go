@@ -8,10 +8,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata_pulsar"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/grpc/moduleparams"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -78,6 +81,44 @@ func TestRegisterQueryServiceTwice(t *testing.T) {
 	})
 }
 
+func TestAllModuleParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	handler := app.GRPCQueryRouter().Route("/cosmos.base.moduleparams.v1beta1.ModuleParamsService/AllModuleParams")
+	require.NotNil(t, handler)
+
+	bz, err := app.AppCodec().Marshal(&moduleparams.AllModuleParamsRequest{})
+	require.NoError(t, err)
+	res, err := handler(ctx, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var resp moduleparams.AllModuleParamsResponse
+	require.NoError(t, app.AppCodec().Unmarshal(res.Value, &resp))
+
+	params := make(map[string]string)
+	for _, p := range resp.Params {
+		params[p.Service] = p.Params
+	}
+
+	// the Params methods with an empty request are called
+	for _, module := range []string{"auth", "bank", "distribution", "mint", "slashing", "staking"} {
+		require.Contains(t, params, "cosmos."+module+".v1beta1.Query")
+	}
+	require.Contains(t, params["cosmos.bank.v1beta1.Query"], `"default_send_enabled":true`)
+	require.Contains(t, params["cosmos.staking.v1beta1.Query"], `"bond_denom":"stake"`)
+
+	// and the other ones are skipped
+	require.NotContains(t, params, "cosmos.params.v1beta1.Query")
+	require.NotContains(t, params, "cosmos.gov.v1beta1.Query")
+
+	// sorted by module name
+	for i := 1; i < len(resp.Params); i++ {
+		require.LessOrEqual(t, resp.Params[i-1].Module, resp.Params[i].Module)
+	}
+	require.Equal(t, "auth", resp.Params[0].Module)
+}
+
 // Tests that we don't have data races per
 // https://github.com/cosmos/cosmos-sdk/issues/10324
 // but with the same client connection being used concurrently.
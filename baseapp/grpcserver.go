@@ -2,6 +2,7 @@ package baseapp
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
@@ -12,11 +13,30 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 )
 
+// queryGasLimitKey is the key of the gas limit of a gRPC query in its context.
+type queryGasLimitKey struct{}
+
+// ContextWithQueryGasLimit returns a copy of the context of a gRPC query in which
+// the gas the query consumes reading the stores is limited. The query fails with
+// the ResourceExhausted code if it runs out of gas. It is meant to be called by
+// the interceptors of the gRPC server.
+func ContextWithQueryGasLimit(ctx context.Context, limit uint64) context.Context {
+	return context.WithValue(ctx, queryGasLimitKey{}, limit)
+}
+
+// QueryGasLimitFromContext returns the gas limit of a gRPC query set in its
+// context with ContextWithQueryGasLimit, if any.
+func QueryGasLimitFromContext(ctx context.Context) (uint64, bool) {
+	limit, ok := ctx.Value(queryGasLimitKey{}).(uint64)
+	return limit, ok
+}
+
 // GRPCQueryRouter returns the GRPCQueryRouter of a BaseApp.
 func (app *BaseApp) GRPCQueryRouter() *GRPCQueryRouter { return app.grpcQueryRouter }
 
@@ -24,25 +44,28 @@ func (app *BaseApp) GRPCQueryRouter() *GRPCQueryRouter { return app.grpcQueryRou
 func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 	// Define an interceptor for all gRPC queries: this interceptor will create
 	// a new sdk.Context, and pass it into the query handler.
-	interceptor := func(grpcCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		// If there's some metadata in the context, retrieve it.
-		md, ok := metadata.FromIncomingContext(grpcCtx)
-		if !ok {
-			return nil, status.Error(codes.Internal, "unable to retrieve metadata")
+	interceptor := func(grpcCtx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		height, err := queryHeight(grpcCtx)
+		if err != nil {
+			return nil, err
 		}
 
-		// Get height header from the request context, if present.
-		var height int64
-		if heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader); len(heightHeaders) == 1 {
-			height, err = strconv.ParseInt(heightHeaders[0], 10, 64)
-			if err != nil {
-				return nil, sdkerrors.Wrapf(
-					sdkerrors.ErrInvalidRequest,
-					"Baseapp.RegisterGRPCServer: invalid height header %q: %v", grpctypes.GRPCBlockHeightHeader, err)
+		latest := height == 0
+		cached := app.queryCache != nil && app.queryCache.caches(info.FullMethod)
+		if cached {
+			cacheHeight := height
+			if latest {
+				cacheHeight = app.LastBlockHeight()
 			}
-			if err := checkNegativeHeight(height); err != nil {
-				return nil, err
+
+			if key, ok := app.queryCache.key(info.FullMethod, req, cacheHeight); ok {
+				if res, ok := app.queryCache.get(key); ok {
+					telemetry.IncrCounter(1, "query_cache", "hit")
+					app.setGRPCHeightHeader(grpcCtx, cacheHeight)
+					return res, nil
+				}
 			}
+			telemetry.IncrCounter(1, "query_cache", "miss")
 		}
 
 		// Create the sdk.Context. Passing false as 2nd arg, as we can't
@@ -53,19 +76,26 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 		}
 
 		// Add relevant gRPC headers
-		if height == 0 {
+		if latest {
 			height = sdkCtx.BlockHeight() // If height was not set in the request, set it to the latest
 		}
 
+		if gasLimit, ok := QueryGasLimitFromContext(grpcCtx); ok && gasLimit > 0 {
+			sdkCtx = sdkCtx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+		}
+
 		// Attach the sdk.Context into the gRPC's context.Context.
 		grpcCtx = context.WithValue(grpcCtx, sdk.SdkContextKey, sdkCtx)
+		app.setGRPCHeightHeader(grpcCtx, height)
 
-		md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
-		if err = grpc.SetHeader(grpcCtx, md); err != nil {
-			app.logger.Error("failed to set gRPC header", "err", err)
+		res, err := handleGasLimitedQuery(grpcCtx, req, handler)
+		if err == nil && cached {
+			if key, ok := app.queryCache.key(info.FullMethod, req, height); ok {
+				app.queryCache.add(key, res, latest)
+			}
 		}
 
-		return handler(grpcCtx, req)
+		return res, err
 	}
 
 	// Loop through all services and methods, add the interceptor, and register
@@ -78,23 +108,181 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			methodHandler := method.Handler
 			newMethods[i] = grpc.MethodDesc{
 				MethodName: method.MethodName,
-				Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
-					return methodHandler(srv, ctx, dec, grpcmiddleware.ChainUnaryServer(
-						grpcrecovery.UnaryServerInterceptor(),
-						interceptor,
-					))
+				Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, serverInterceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+					interceptors := []grpc.UnaryServerInterceptor{grpcrecovery.UnaryServerInterceptor(), interceptor}
+					// the interceptors of the server, e.g. limiting the requests, run first
+					if serverInterceptor != nil {
+						interceptors = append([]grpc.UnaryServerInterceptor{serverInterceptor}, interceptors...)
+					}
+					return methodHandler(srv, ctx, dec, grpcmiddleware.ChainUnaryServer(interceptors...))
 				},
 			}
 		}
 
+		newStreams := make([]grpc.StreamDesc, len(desc.Streams))
+		for i, stream := range desc.Streams {
+			streamHandler := stream.Handler
+			info := &grpc.StreamServerInfo{
+				FullMethod:     fmt.Sprintf("/%s/%s", desc.ServiceName, stream.StreamName),
+				IsClientStream: stream.ClientStreams,
+				IsServerStream: stream.ServerStreams,
+			}
+			newStreams[i] = grpc.StreamDesc{
+				StreamName: stream.StreamName,
+				Handler: func(srv interface{}, stream grpc.ServerStream) error {
+					return grpcrecovery.StreamServerInterceptor()(srv, stream, info, func(srv interface{}, stream grpc.ServerStream) error {
+						queryStream, err := app.newQueryServerStream(stream)
+						if err != nil {
+							return err
+						}
+						return handleGasLimitedQueryStream(srv, queryStream, streamHandler)
+					})
+				},
+				ServerStreams: stream.ServerStreams,
+				ClientStreams: stream.ClientStreams,
+			}
+		}
+
 		newDesc := &grpc.ServiceDesc{
 			ServiceName: desc.ServiceName,
 			HandlerType: desc.HandlerType,
 			Methods:     newMethods,
-			Streams:     desc.Streams,
+			Streams:     newStreams,
 			Metadata:    desc.Metadata,
 		}
 
 		server.RegisterService(newDesc, data.handler)
 	}
 }
+
+// queryHeight returns the height of the height header of a gRPC query, 0 for the
+// latest height if not set.
+func queryHeight(grpcCtx context.Context) (int64, error) {
+	// If there's some metadata in the context, retrieve it.
+	md, ok := metadata.FromIncomingContext(grpcCtx)
+	if !ok {
+		return 0, status.Error(codes.Internal, "unable to retrieve metadata")
+	}
+
+	// Get height header from the request context, if present.
+	var height int64
+	if heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader); len(heightHeaders) == 1 {
+		var err error
+		height, err = strconv.ParseInt(heightHeaders[0], 10, 64)
+		if err != nil {
+			return 0, sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"Baseapp.RegisterGRPCServer: invalid height header %q: %v", grpctypes.GRPCBlockHeightHeader, err)
+		}
+		if err := checkNegativeHeight(height); err != nil {
+			return 0, err
+		}
+	}
+
+	return height, nil
+}
+
+// queryServerStream is the server stream of a streaming gRPC query, whose
+// context carries the sdk.Context of the query.
+type queryServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+
+	// gasMeter is the gas meter of the sdk.Context if the query gas is limited,
+	// reset each time a message is sent so that the limit applies to each page.
+	gasMeter sdk.GasMeter
+}
+
+// Context implements grpc.ServerStream.
+func (s *queryServerStream) Context() context.Context { return s.ctx }
+
+// SendMsg implements grpc.ServerStream.
+func (s *queryServerStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	if s.gasMeter != nil {
+		s.gasMeter.RefundGas(s.gasMeter.GasConsumed(), "query stream page")
+	}
+	return nil
+}
+
+// newQueryServerStream returns the stream of a streaming gRPC query, with the
+// sdk.Context of the query at the height of its height header, or the latest
+// one. All the messages of the stream are queried at this height, even if new
+// blocks are committed meanwhile. The gas limit of the query, if any, applies to
+// each message.
+func (app *BaseApp) newQueryServerStream(stream grpc.ServerStream) (grpc.ServerStream, error) {
+	height, err := queryHeight(stream.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx, err := app.createQueryContext(height, false)
+	if err != nil {
+		return nil, err
+	}
+	if height == 0 {
+		height = sdkCtx.BlockHeight()
+	}
+
+	md := metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+	if err := stream.SetHeader(md); err != nil {
+		app.logger.Error("failed to set gRPC header", "err", err)
+	}
+
+	var gasMeter sdk.GasMeter
+	if gasLimit, ok := QueryGasLimitFromContext(stream.Context()); ok && gasLimit > 0 {
+		gasMeter = sdk.NewGasMeter(gasLimit)
+		sdkCtx = sdkCtx.WithGasMeter(gasMeter)
+	}
+
+	return &queryServerStream{
+		ServerStream: stream,
+		ctx:          context.WithValue(stream.Context(), sdk.SdkContextKey, sdkCtx),
+		gasMeter:     gasMeter,
+	}, nil
+}
+
+// handleGasLimitedQuery handles a gRPC query, returning a ResourceExhausted error
+// if it runs out of gas.
+func handleGasLimitedQuery(grpcCtx context.Context, req interface{}, handler grpc.UnaryHandler) (res interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			res, err = nil, queryOutOfGasError(r)
+		}
+	}()
+
+	return handler(grpcCtx, req)
+}
+
+// handleGasLimitedQueryStream handles a streaming gRPC query, returning a
+// ResourceExhausted error if a message runs out of gas.
+func handleGasLimitedQueryStream(srv interface{}, stream grpc.ServerStream, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = queryOutOfGasError(r)
+		}
+	}()
+
+	return handler(srv, stream)
+}
+
+// queryOutOfGasError returns the error of a query which panicked with r,
+// repanicking if it didn't run out of gas.
+func queryOutOfGasError(r interface{}) error {
+	outOfGas, ok := r.(sdk.ErrorOutOfGas)
+	if !ok {
+		panic(r)
+	}
+	return status.Errorf(codes.ResourceExhausted, "query out of gas in location: %v", outOfGas.Descriptor)
+}
+
+// setGRPCHeightHeader sets the height a gRPC query is served at in the header of
+// the response.
+func (app *BaseApp) setGRPCHeightHeader(grpcCtx context.Context, height int64) {
+	md := metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+	if err := grpc.SetHeader(grpcCtx, md); err != nil {
+		app.logger.Error("failed to set gRPC header", "err", err)
+	}
+}
@@ -0,0 +1,84 @@
+package baseapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestHandleGasLimitedQuery(t *testing.T) {
+	consumeGas := func(amount uint64) func(context.Context, interface{}) (interface{}, error) {
+		return func(ctx context.Context, _ interface{}) (interface{}, error) {
+			sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(amount, "query")
+			return "res", nil
+		}
+	}
+	grpcCtx := context.WithValue(context.Background(), sdk.SdkContextKey, sdk.Context{}.WithGasMeter(sdk.NewGasMeter(100)))
+
+	res, err := handleGasLimitedQuery(grpcCtx, nil, consumeGas(100))
+	require.NoError(t, err)
+	require.Equal(t, "res", res)
+
+	res, err = handleGasLimitedQuery(grpcCtx, nil, consumeGas(1))
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Nil(t, res)
+
+	require.Panics(t, func() {
+		handleGasLimitedQuery(grpcCtx, nil, func(context.Context, interface{}) (interface{}, error) { panic("other") })
+	})
+}
+
+// sendServerStream is a server stream counting the messages sent.
+type sendServerStream struct {
+	grpc.ServerStream
+	sent int
+}
+
+func (s *sendServerStream) SendMsg(interface{}) error {
+	s.sent++
+	return nil
+}
+
+func TestHandleGasLimitedQueryStream(t *testing.T) {
+	newStream := func() *queryServerStream {
+		gasMeter := sdk.NewGasMeter(100)
+		return &queryServerStream{
+			ServerStream: &sendServerStream{},
+			ctx:          context.WithValue(context.Background(), sdk.SdkContextKey, sdk.Context{}.WithGasMeter(gasMeter)),
+			gasMeter:     gasMeter,
+		}
+	}
+	// streamPages sends pages of messages each consuming gas
+	streamPages := func(pages int, gasPerPage uint64) grpc.StreamHandler {
+		return func(_ interface{}, stream grpc.ServerStream) error {
+			ctx := sdk.UnwrapSDKContext(stream.Context())
+			for i := 0; i < pages; i++ {
+				ctx.GasMeter().ConsumeGas(gasPerPage, "query")
+				if err := stream.SendMsg(nil); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	// the gas limit applies to each page
+	stream := newStream()
+	require.NoError(t, handleGasLimitedQueryStream(nil, stream, streamPages(3, 100)))
+	require.Equal(t, 3, stream.ServerStream.(*sendServerStream).sent)
+
+	stream = newStream()
+	err := handleGasLimitedQueryStream(nil, stream, streamPages(3, 101))
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Zero(t, stream.ServerStream.(*sendServerStream).sent)
+
+	require.Panics(t, func() {
+		handleGasLimitedQueryStream(nil, newStream(), func(interface{}, grpc.ServerStream) error { panic("other") })
+	})
+}
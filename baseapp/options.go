@@ -60,11 +60,58 @@ func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
 }
 
+// SetEventAggregation provides a BaseApp option function that aggregates the
+// duplicate events of the tx results, i.e. the events of the same type with the
+// same attribute keys, occurring at least threshold times into a summary event.
+// The ABCI listeners, e.g. the streaming services, still receive all the events.
+// A threshold of 0 disables the aggregation.
+func SetEventAggregation(threshold int) func(*BaseApp) {
+	return func(app *BaseApp) { app.setEventAggregationThreshold(threshold) }
+}
+
+// SetResultLimits provides a BaseApp option function that caps the size of the
+// events and the logs of the ABCI results, truncating them deterministically
+// and appending a truncation marker to them. The ABCI listeners, e.g. the
+// streaming services, still receive the full results.
+func SetResultLimits(limits ResultLimits) func(*BaseApp) {
+	return func(app *BaseApp) { app.setResultLimits(limits) }
+}
+
+// SetBlockGasWindow provides a BaseApp option function that sets the number of
+// recent blocks whose gas usage is kept and reported by the block gas query,
+// DefaultBlockGasWindow by default. A window of 0 keeps none.
+func SetBlockGasWindow(window int) func(*BaseApp) {
+	return func(app *BaseApp) { app.setBlockGasWindow(window) }
+}
+
+// SetMaxExecDepth provides a BaseApp option function that sets the maximum
+// depth at which the messages nested in other messages, e.g. by authz MsgExec
+// or the gov and group proposals, can be executed, sdk.DefaultMaxExecDepth by
+// default. It must be the same for all the nodes of a chain.
+func SetMaxExecDepth(depth int) func(*BaseApp) {
+	return func(app *BaseApp) { app.setMaxExecDepth(depth) }
+}
+
 // SetIAVLCacheSize provides a BaseApp option function that sets the size of IAVL cache.
 func SetIAVLCacheSize(size int) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLCacheSize(size) }
 }
 
+// SetAsyncPruning provides a BaseApp option function that prunes the stores in
+// the background, with up to queueSize pruning runs pending before the commits
+// wait for them. A queueSize of 0 prunes the stores in the commit.
+func SetAsyncPruning(queueSize int) func(*BaseApp) {
+	return func(app *BaseApp) { app.setAsyncPruning(queueSize) }
+}
+
+// SetQueryCache provides a BaseApp option function that caches up to size
+// responses of the gRPC queries to the given routes, either full gRPC methods,
+// e.g. "/cosmos.bank.v1beta1.Query/AllBalances", or service prefixes ending with
+// "/", e.g. "/cosmos.bank.v1beta1.Query/". No query is cached if routes is empty.
+func SetQueryCache(size int, routes []string) func(*BaseApp) {
+	return func(app *BaseApp) { app.setQueryCache(size, routes) }
+}
+
 // SetInterBlockCache provides a BaseApp option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
@@ -76,6 +123,12 @@ func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOpti
 	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
 }
 
+// SetSnapshotTransport sets the remote storage the snapshots are pushed to. It
+// must follow the SetSnapshot option.
+func SetSnapshotTransport(transport snapshottypes.SnapshotTransport) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotTransport(transport) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -146,6 +199,17 @@ func (app *BaseApp) SetEndBlocker(endBlocker sdk.EndBlocker) {
 	app.endBlocker = endBlocker
 }
 
+// AddBlockGasMeterResetHook adds a hook called on BeginBlock once the block gas
+// meter is reset for the new block, with the gas usage of the previous block.
+// The hooks must not affect the state, see BlockGasMeterResetHook.
+func (app *BaseApp) AddBlockGasMeterResetHook(hook BlockGasMeterResetHook) {
+	if app.sealed {
+		panic("AddBlockGasMeterResetHook() on sealed BaseApp")
+	}
+
+	app.blockGasMeterResetHooks = append(app.blockGasMeterResetHooks, hook)
+}
+
 func (app *BaseApp) SetTxHandler(txHandler tx.Handler) {
 	if app.sealed {
 		panic("SetTxHandler() on sealed BaseApp")
@@ -206,6 +270,18 @@ func (app *BaseApp) SetSnapshot(snapshotStore *snapshots.Store, opts snapshottyp
 	app.snapshotManager = snapshots.NewManager(snapshotStore, opts, app.cms, nil, app.logger)
 }
 
+// SetSnapshotTransport sets the remote storage the snapshots are pushed to once
+// taken. It is a no-op if the snapshots are disabled.
+func (app *BaseApp) SetSnapshotTransport(transport snapshottypes.SnapshotTransport) {
+	if app.sealed {
+		panic("SetSnapshotTransport() on sealed BaseApp")
+	}
+	if app.snapshotManager == nil || transport == nil {
+		return
+	}
+	app.snapshotManager.SetTransport(transport)
+}
+
 // SetInterfaceRegistry sets the InterfaceRegistry.
 func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.interfaceRegistry = registry
@@ -221,4 +297,5 @@ func (app *BaseApp) SetStreamingService(s StreamingService) {
 	// register the StreamingService within the BaseApp
 	// BaseApp will pass BeginBlock, DeliverTx, and EndBlock requests and responses to the streaming services to update their ABCI context
 	app.abciListeners = append(app.abciListeners, s)
+	app.streamingServices = append(app.streamingServices, s)
 }
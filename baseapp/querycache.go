@@ -0,0 +1,119 @@
+package baseapp
+
+import (
+	"strings"
+	"sync/atomic"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// queryCache caches the responses of the gRPC queries to the configured routes,
+// so that repeated heavy queries are served once per height. As the state at a
+// given height is immutable, responses are keyed by the height they were served
+// at, and the responses to queries for the latest height are evicted once a new
+// block is committed.
+type queryCache struct {
+	// routes are the full gRPC methods to cache, or the service prefixes, ending
+	// with "/", of the methods to cache.
+	routes   []string
+	entries  *lru.Cache
+	capacity int
+
+	hits   uint64
+	misses uint64
+}
+
+// QueryCacheStats defines the statistics of the cache of gRPC query responses.
+type QueryCacheStats struct {
+	// Hits is the number of queries served from the cache.
+	Hits uint64
+	// Misses is the number of cacheable queries not served from the cache.
+	Misses uint64
+	// Size is the number of cached responses.
+	Size int
+	// Capacity is the maximum number of cached responses.
+	Capacity int
+}
+
+type queryCacheKey struct {
+	method string
+	req    string
+	height int64
+}
+
+type queryCacheEntry struct {
+	res    interface{}
+	latest bool // whether the query was for the latest height
+}
+
+func newQueryCache(size int, routes []string) (*queryCache, error) {
+	entries, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryCache{routes: routes, entries: entries, capacity: size}, nil
+}
+
+// caches returns true if the responses to the given gRPC method are cached.
+func (c *queryCache) caches(method string) bool {
+	for _, route := range c.routes {
+		if method == route || (strings.HasSuffix(route, "/") && strings.HasPrefix(method, route)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// key returns the key of the response to a request at the given height, and
+// false if the request cannot be cached.
+func (c *queryCache) key(method string, req interface{}, height int64) (queryCacheKey, bool) {
+	msg, ok := req.(codec.ProtoMarshaler)
+	if !ok {
+		return queryCacheKey{}, false
+	}
+
+	bz, err := msg.Marshal()
+	if err != nil {
+		return queryCacheKey{}, false
+	}
+
+	return queryCacheKey{method: method, req: string(bz), height: height}, true
+}
+
+func (c *queryCache) get(key queryCacheKey) (interface{}, bool) {
+	entry, ok := c.entries.Get(key)
+	if !ok {
+		atomic.AddUint64(&c.misses, 1)
+		return nil, false
+	}
+
+	atomic.AddUint64(&c.hits, 1)
+	return entry.(queryCacheEntry).res, true
+}
+
+func (c *queryCache) add(key queryCacheKey, res interface{}, latest bool) {
+	c.entries.Add(key, queryCacheEntry{res: res, latest: latest})
+}
+
+// evictLatest evicts the responses to the queries for the latest height, called
+// once a new block is committed.
+func (c *queryCache) evictLatest() {
+	for _, key := range c.entries.Keys() {
+		if entry, ok := c.entries.Peek(key); ok && entry.(queryCacheEntry).latest {
+			c.entries.Remove(key)
+		}
+	}
+}
+
+func (c *queryCache) stats() QueryCacheStats {
+	return QueryCacheStats{
+		Hits:     atomic.LoadUint64(&c.hits),
+		Misses:   atomic.LoadUint64(&c.misses),
+		Size:     c.entries.Len(),
+		Capacity: c.capacity,
+	}
+}
@@ -0,0 +1,83 @@
+package baseapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+const echoMethod = "/testdata.Query/Echo"
+
+func TestQueryCache_Caches(t *testing.T) {
+	cache, err := newQueryCache(10, []string{echoMethod, "/cosmos.bank.v1beta1.Query/"})
+	require.NoError(t, err)
+
+	require.True(t, cache.caches(echoMethod))
+	require.True(t, cache.caches("/cosmos.bank.v1beta1.Query/AllBalances"))
+	require.False(t, cache.caches("/testdata.Query/SayHello"))
+	require.False(t, cache.caches("/cosmos.bank.v1beta1.Query"))
+
+	_, err = newQueryCache(0, []string{echoMethod})
+	require.Error(t, err)
+}
+
+func TestQueryCache_Key(t *testing.T) {
+	cache, err := newQueryCache(10, []string{echoMethod})
+	require.NoError(t, err)
+
+	key, ok := cache.key(echoMethod, &testdata.EchoRequest{Message: "foo"}, 1)
+	require.True(t, ok)
+
+	sameKey, ok := cache.key(echoMethod, &testdata.EchoRequest{Message: "foo"}, 1)
+	require.True(t, ok)
+	require.Equal(t, key, sameKey)
+
+	otherReq, ok := cache.key(echoMethod, &testdata.EchoRequest{Message: "bar"}, 1)
+	require.True(t, ok)
+	require.NotEqual(t, key, otherReq)
+
+	otherHeight, ok := cache.key(echoMethod, &testdata.EchoRequest{Message: "foo"}, 2)
+	require.True(t, ok)
+	require.NotEqual(t, key, otherHeight)
+
+	_, ok = cache.key(echoMethod, "not a proto message", 1)
+	require.False(t, ok)
+}
+
+func TestQueryCache_EvictLatest(t *testing.T) {
+	cache, err := newQueryCache(10, []string{echoMethod})
+	require.NoError(t, err)
+
+	latestKey, _ := cache.key(echoMethod, &testdata.EchoRequest{Message: "latest"}, 5)
+	pastKey, _ := cache.key(echoMethod, &testdata.EchoRequest{Message: "past"}, 5)
+	latestRes := &testdata.EchoResponse{Message: "latest"}
+	pastRes := &testdata.EchoResponse{Message: "past"}
+	cache.add(latestKey, latestRes, true)
+	cache.add(pastKey, pastRes, false)
+
+	res, ok := cache.get(latestKey)
+	require.True(t, ok)
+	require.Equal(t, latestRes, res)
+
+	cache.evictLatest()
+
+	_, ok = cache.get(latestKey)
+	require.False(t, ok)
+
+	res, ok = cache.get(pastKey)
+	require.True(t, ok)
+	require.Equal(t, pastRes, res)
+}
+
+func TestSetQueryCache(t *testing.T) {
+	app := &BaseApp{}
+	SetQueryCache(10, nil)(app)
+	require.Nil(t, app.queryCache)
+
+	SetQueryCache(10, []string{echoMethod})(app)
+	require.NotNil(t, app.queryCache)
+
+	require.Panics(t, func() { SetQueryCache(0, []string{echoMethod})(app) })
+}
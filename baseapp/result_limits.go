@@ -0,0 +1,160 @@
+package baseapp
+
+import (
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// EventTypeTruncated is the type of the event replacing the events dropped
+	// from an ABCI result to fit the result limits.
+	EventTypeTruncated = "truncated"
+	// AttributeKeyTruncatedEvents is the attribute of the truncated event
+	// holding the number of events dropped.
+	AttributeKeyTruncatedEvents = "dropped_events"
+	// AttributeKeyTruncatedBytes is the attribute of the truncated event
+	// holding the size of the events dropped.
+	AttributeKeyTruncatedBytes = "dropped_bytes"
+)
+
+// ResultLimits defines the caps on the size of the events and the logs of the
+// ABCI results, bounding the results a block can make the node store and
+// serve. The size of an event is the length of its type plus the lengths of
+// its attribute keys and values. The tx limits apply to the CheckTx and
+// DeliverTx results, and the block limits to the BeginBlock, DeliverTx and
+// EndBlock results of a block, in their execution order. 0 disables a limit.
+//
+// The events and the logs are not part of the results hash, so that nodes
+// with different limits still agree on the blocks.
+type ResultLimits struct {
+	// MaxTxEventBytes is the maximum size of the events of a tx result.
+	MaxTxEventBytes int
+	// MaxTxLogBytes is the maximum size of the log of a tx result.
+	MaxTxLogBytes int
+	// MaxBlockEventBytes is the maximum size of the events of all the results
+	// of a block.
+	MaxBlockEventBytes int
+	// MaxBlockLogBytes is the maximum size of the logs of all the tx results
+	// of a block.
+	MaxBlockLogBytes int
+}
+
+// resultUsage is the size of the events and the logs of the ABCI results of
+// the block being executed.
+type resultUsage struct {
+	eventBytes int
+	logBytes   int
+}
+
+// limitEvents truncates the events of an ABCI result to fit maxBytes and,
+// for the results of the block being executed, the block event limit.
+func (app *BaseApp) limitEvents(events []abci.Event, maxBytes int, inBlock bool) []abci.Event {
+	if !inBlock {
+		events, _ = truncateEvents(events, resultBudget(maxBytes, 0, 0))
+		return events
+	}
+
+	events, size := truncateEvents(events, resultBudget(maxBytes, app.resultLimits.MaxBlockEventBytes, app.blockResultUsage.eventBytes))
+	app.blockResultUsage.eventBytes += size
+
+	return events
+}
+
+// limitLog truncates the log of a tx result to fit the tx log limit and, for
+// the results of the block being executed, the block log limit.
+func (app *BaseApp) limitLog(log string, inBlock bool) string {
+	if !inBlock {
+		log, _ = truncateLog(log, resultBudget(app.resultLimits.MaxTxLogBytes, 0, 0))
+		return log
+	}
+
+	log, size := truncateLog(log, resultBudget(app.resultLimits.MaxTxLogBytes, app.resultLimits.MaxBlockLogBytes, app.blockResultUsage.logBytes))
+	app.blockResultUsage.logBytes += size
+
+	return log
+}
+
+// resultBudget returns the number of bytes a result may use given its own
+// limit and the block limit, of which blockUsed bytes are used already, and
+// -1 if neither is set.
+func resultBudget(maxBytes, blockMaxBytes, blockUsed int) int {
+	budget := -1
+	if maxBytes > 0 {
+		budget = maxBytes
+	}
+
+	if blockMaxBytes > 0 {
+		left := blockMaxBytes - blockUsed
+		if left < 0 {
+			left = 0
+		}
+		if budget < 0 || left < budget {
+			budget = left
+		}
+	}
+
+	return budget
+}
+
+// truncateEvents returns the longest prefix of events fitting budget bytes,
+// followed by a truncated event summarizing the events dropped if any, and
+// the size of the events kept. The events are left untouched, and kept in
+// full if budget is negative.
+func truncateEvents(events []abci.Event, budget int) ([]abci.Event, int) {
+	size := 0
+	for i, event := range events {
+		if n := eventSize(event); budget < 0 || size+n <= budget {
+			size += n
+			continue
+		}
+
+		dropped := 0
+		for _, event := range events[i:] {
+			dropped += eventSize(event)
+		}
+
+		res := make([]abci.Event, i, i+1)
+		copy(res, events[:i])
+		res = append(res, abci.Event{
+			Type: EventTypeTruncated,
+			Attributes: []abci.EventAttribute{
+				{Key: AttributeKeyTruncatedEvents, Value: strconv.Itoa(len(events) - i)},
+				{Key: AttributeKeyTruncatedBytes, Value: strconv.Itoa(dropped)},
+			},
+		})
+
+		return res, size
+	}
+
+	return events, size
+}
+
+// eventSize returns the size of event accounted in the result limits.
+func eventSize(event abci.Event) int {
+	size := len(event.Type)
+	for _, attr := range event.Attributes {
+		size += len(attr.Key) + len(attr.Value)
+	}
+
+	return size
+}
+
+// truncateLog returns the longest prefix of log fitting budget bytes without
+// splitting a UTF-8 character, followed by a truncation marker if the log is
+// cut, and the size of the prefix. The log is kept in full if budget is
+// negative.
+func truncateLog(log string, budget int) (string, int) {
+	if budget < 0 || len(log) <= budget {
+		return log, len(log)
+	}
+
+	n := budget
+	for n > 0 && !utf8.RuneStart(log[n]) {
+		n--
+	}
+
+	return fmt.Sprintf("%s... (truncated %d bytes)", log[:n], len(log)-n), n
+}
@@ -0,0 +1,63 @@
+package baseapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestResultBudget(t *testing.T) {
+	require.Equal(t, -1, resultBudget(0, 0, 0))
+	require.Equal(t, 10, resultBudget(10, 0, 0))
+	require.Equal(t, 10, resultBudget(10, 100, 50))
+	require.Equal(t, 5, resultBudget(10, 100, 95))
+	require.Equal(t, 5, resultBudget(0, 100, 95))
+	require.Equal(t, 0, resultBudget(10, 100, 120))
+}
+
+func TestTruncateEvents(t *testing.T) {
+	events := []abci.Event{
+		newTestEvent("a", "key", "value0"),
+		newTestEvent("b", "key", "value1"),
+		newTestEvent("c", "key", "value2"),
+	}
+
+	res, size := truncateEvents(events, -1)
+	require.Equal(t, events, res)
+	require.Equal(t, 30, size)
+
+	res, size = truncateEvents(events, 30)
+	require.Equal(t, events, res)
+	require.Equal(t, 30, size)
+
+	res, size = truncateEvents(events, 19)
+	require.Equal(t, []abci.Event{events[0], {Type: EventTypeTruncated, Attributes: []abci.EventAttribute{
+		{Key: AttributeKeyTruncatedEvents, Value: "2"},
+		{Key: AttributeKeyTruncatedBytes, Value: "20"},
+	}}}, res)
+	require.Equal(t, 10, size)
+
+	// the given events are left untouched
+	require.Len(t, events, 3)
+	require.Equal(t, "b", events[1].Type)
+}
+
+func TestTruncateLog(t *testing.T) {
+	res, size := truncateLog("hello", -1)
+	require.Equal(t, "hello", res)
+	require.Equal(t, 5, size)
+
+	res, size = truncateLog("hello", 5)
+	require.Equal(t, "hello", res)
+	require.Equal(t, 5, size)
+
+	res, size = truncateLog("hello", 2)
+	require.Equal(t, "he... (truncated 3 bytes)", res)
+	require.Equal(t, 2, size)
+
+	// the 2 bytes é isn't split
+	res, size = truncateLog("héllo", 2)
+	require.Equal(t, "h... (truncated 5 bytes)", res)
+	require.Equal(t, 1, size)
+}
@@ -0,0 +1,60 @@
+package baseapp
+
+import (
+	"time"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// Phases of the execution of a block the block metrics are labeled with.
+const (
+	blockPhaseBeginBlock = "begin_block"
+	blockPhaseDeliverTx  = "deliver_tx"
+	blockPhaseEndBlock   = "end_block"
+)
+
+// blockExecution is the breakdown of the execution time of a block, the
+// DeliverTx phase being the total time spent executing its txs.
+type blockExecution struct {
+	beginBlock time.Duration
+	deliverTx  time.Duration
+	endBlock   time.Duration
+}
+
+// emit emits the execution time of each phase of the block, allowing to compare
+// them within a block.
+func (be blockExecution) emit() {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	for _, phase := range []struct {
+		name     string
+		duration time.Duration
+	}{
+		{blockPhaseBeginBlock, be.beginBlock},
+		{blockPhaseDeliverTx, be.deliverTx},
+		{blockPhaseEndBlock, be.endBlock},
+	} {
+		telemetry.MeasureDurationWithLabels(
+			[]string{telemetry.MetricKeyBlock, telemetry.MetricKeyExecution},
+			phase.duration,
+			[]metrics.Label{telemetry.NewLabel(telemetry.MetricLabelNamePhase, phase.name)},
+		)
+	}
+}
+
+// emitBlockGas emits the gas used by a block and, if its gas is limited, the
+// percentage of its gas limit used.
+func emitBlockGas(usage BlockGasUsage) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	telemetry.SetGauge(float32(usage.GasUsed), telemetry.MetricKeyBlock, telemetry.MetricKeyGas, "used")
+	if usage.GasLimit > 0 {
+		telemetry.SetGauge(float32(usage.Utilization()), telemetry.MetricKeyBlock, telemetry.MetricKeyGas, "utilization")
+	}
+}
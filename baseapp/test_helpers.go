@@ -19,7 +19,7 @@ func (app *BaseApp) SimCheck(txEncoder sdk.TxEncoder, sdkTx sdk.Tx) (sdk.GasInfo
 		return sdk.GasInfo{}, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s", err)
 	}
 
-	ctx := app.getContextForTx(runTxModeDeliver, bz)
+	ctx := app.getContextForTx(sdk.ExecModeDeliver, bz)
 	res, _, err := app.txHandler.CheckTx(ctx, tx.Request{Tx: sdkTx, TxBytes: bz}, tx.RequestCheckTx{Type: abci.CheckTxType_New})
 	gInfo := sdk.GasInfo{GasWanted: uint64(res.GasWanted), GasUsed: uint64(res.GasUsed)}
 	if err != nil {
@@ -36,7 +36,7 @@ func (app *BaseApp) SimCheck(txEncoder sdk.TxEncoder, sdkTx sdk.Tx) (sdk.GasInfo
 
 // Simulate executes a tx in simulate mode to get result and gas info.
 func (app *BaseApp) Simulate(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
-	ctx := app.getContextForTx(runTxModeSimulate, txBytes)
+	ctx := app.getContextForTx(sdk.ExecModeSimulate, txBytes)
 	res, err := app.txHandler.SimulateTx(ctx, tx.Request{TxBytes: txBytes})
 	gasInfo := sdk.GasInfo{
 		GasWanted: res.GasWanted,
@@ -63,7 +63,7 @@ func (app *BaseApp) SimDeliver(txEncoder sdk.TxEncoder, sdkTx sdk.Tx) (sdk.GasIn
 		return sdk.GasInfo{}, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s", err)
 	}
 
-	ctx := app.getContextForTx(runTxModeDeliver, bz)
+	ctx := app.getContextForTx(sdk.ExecModeDeliver, bz)
 	res, err := app.txHandler.DeliverTx(ctx, tx.Request{Tx: sdkTx, TxBytes: bz})
 	gInfo := sdk.GasInfo{GasWanted: uint64(res.GasWanted), GasUsed: uint64(res.GasUsed)}
 	if err != nil {
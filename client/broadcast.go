@@ -2,14 +2,18 @@ package client
 
 import (
 	"context"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	tmtypes "github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
@@ -145,6 +149,126 @@ func (ctx Context) BroadcastTxAsync(txBytes []byte) (*sdk.TxResponse, error) {
 	return sdk.NewResponseFormatBroadcastTx(res), err
 }
 
+const (
+	// DefaultAwaitInclusionTimeout is the time BroadcastTxAwaitInclusion waits
+	// for the inclusion of a tx when no timeout is given.
+	DefaultAwaitInclusionTimeout = time.Minute
+	// MaxAwaitInclusionTimeout is the longest time the tx service waits for
+	// the inclusion of a tx.
+	MaxAwaitInclusionTimeout = 5 * time.Minute
+)
+
+// AwaitInclusionPollInterval is the interval at which BroadcastTxAwaitInclusion
+// polls the node for the inclusion of the tx.
+var AwaitInclusionPollInterval = 500 * time.Millisecond
+
+// BroadcastTxAwaitInclusion broadcasts transaction bytes to a Tendermint node
+// synchronously, and then polls the node until the tx is included in a block,
+// returning its full result. It stops waiting once goCtx is done, the timeout
+// is reached or the timeout height of the tx is passed, returning the result
+// of the broadcast along with the error.
+//
+// Unlike BroadcastTxCommit, the tx is still looked up once the node stops
+// waiting for it, and the node doesn't hold a subscription while waiting.
+func (ctx Context) BroadcastTxAwaitInclusion(goCtx context.Context, txBytes []byte, timeout time.Duration) (*sdk.TxResponse, error) {
+	res, err := ctx.BroadcastTxSync(txBytes)
+	if err != nil || res.Code != sdkerrors.SuccessABCICode {
+		return res, err
+	}
+
+	hash, err := hex.DecodeString(res.TxHash)
+	if err != nil {
+		return res, err
+	}
+
+	var timeoutHeight uint64
+	if ctx.TxConfig != nil {
+		if decoded, err := ctx.TxConfig.TxDecoder()(txBytes); err == nil {
+			if txWithTimeout, ok := decoded.(sdk.TxWithTimeoutHeight); ok {
+				timeoutHeight = txWithTimeout.GetTimeoutHeight()
+			}
+		}
+	}
+
+	node, err := ctx.GetNode()
+	if err != nil {
+		return res, err
+	}
+
+	if timeout <= 0 {
+		timeout = DefaultAwaitInclusionTimeout
+	}
+	goCtx, cancel := context.WithTimeout(goCtx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(AwaitInclusionPollInterval)
+	defer ticker.Stop()
+
+	for {
+		// the latest height is fetched before looking the tx up, so that a tx
+		// included at the timeout height is still found
+		var latestHeight int64
+		if timeoutHeight > 0 {
+			status, err := node.Status(goCtx)
+			if err == nil {
+				latestHeight = status.SyncInfo.LatestBlockHeight
+			}
+		}
+
+		included, err := ctx.queryIncludedTx(goCtx, hash)
+		if err != nil {
+			return res, err
+		}
+		if included != nil {
+			return included, nil
+		}
+
+		if timeoutHeight > 0 && latestHeight >= int64(timeoutHeight) {
+			return res, sdkerrors.Wrapf(sdkerrors.ErrTxTimeoutHeight, "tx %s not included by height %d", res.TxHash, timeoutHeight)
+		}
+
+		select {
+		case <-goCtx.Done():
+			return res, sdkerrors.Wrapf(goCtx.Err(), "tx %s not included after %s", res.TxHash, timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// queryIncludedTx returns the full result of the tx with the given hash, and
+// nil if the tx isn't included in a block yet.
+func (ctx Context) queryIncludedTx(goCtx context.Context, hash []byte) (*sdk.TxResponse, error) {
+	node, err := ctx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	resTx, err := node.Tx(goCtx, hash, false)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") || goCtx.Err() != nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	resBlock, err := node.Block(goCtx, &resTx.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := ctx.TxConfig.TxDecoder()(resTx.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := decoded.(interface{ AsAny() *codectypes.Any })
+	if !ok {
+		return nil, fmt.Errorf("expecting a type implementing AsAny, got: %T", decoded)
+	}
+
+	return sdk.NewResponseResultTx(resTx, p.AsAny(), resBlock.Block.Time.Format(time.RFC3339)), nil
+}
+
 // TxServiceBroadcast is a helper function to broadcast a Tx with the correct gRPC types
 // from the tx service. Calls `clientCtx.BroadcastTx` under the hood.
 func TxServiceBroadcast(grpcCtx context.Context, clientCtx Context, req *tx.BroadcastTxRequest) (*tx.BroadcastTxResponse, error) {
@@ -152,6 +276,10 @@ func TxServiceBroadcast(grpcCtx context.Context, clientCtx Context, req *tx.Broa
 		return nil, status.Error(codes.InvalidArgument, "invalid empty tx")
 	}
 
+	if req.Mode == tx.BroadcastMode_BROADCAST_MODE_AWAIT_INCLUSION {
+		return txServiceBroadcastAwaitInclusion(grpcCtx, clientCtx, req)
+	}
+
 	clientCtx = clientCtx.WithBroadcastMode(normalizeBroadcastMode(req.Mode))
 	resp, err := clientCtx.BroadcastTx(req.TxBytes)
 	if err != nil {
@@ -163,6 +291,30 @@ func TxServiceBroadcast(grpcCtx context.Context, clientCtx Context, req *tx.Broa
 	}, nil
 }
 
+// txServiceBroadcastAwaitInclusion broadcasts a Tx from the tx service and
+// waits for its inclusion, for at most the timeout of the request.
+func txServiceBroadcastAwaitInclusion(grpcCtx context.Context, clientCtx Context, req *tx.BroadcastTxRequest) (*tx.BroadcastTxResponse, error) {
+	timeout := DefaultAwaitInclusionTimeout
+	if req.AwaitTimeout != nil {
+		timeout = *req.AwaitTimeout
+	}
+	if timeout <= 0 || timeout > MaxAwaitInclusionTimeout {
+		return nil, status.Errorf(codes.InvalidArgument, "await timeout must be positive and at most %s, got %s", MaxAwaitInclusionTimeout, timeout)
+	}
+
+	resp, err := clientCtx.BroadcastTxAwaitInclusion(grpcCtx, req.TxBytes, timeout)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, sdkerrors.ErrTxTimeoutHeight):
+		return nil, status.Error(codes.DeadlineExceeded, err.Error())
+	case err != nil:
+		return nil, err
+	}
+
+	return &tx.BroadcastTxResponse{
+		TxResponse: resp,
+	}, nil
+}
+
 // normalizeBroadcastMode converts a broadcast mode into a normalized string
 // to be passed into the clientCtx.
 func normalizeBroadcastMode(mode tx.BroadcastMode) string {
@@ -0,0 +1,827 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/moduleparams/v1beta1/moduleparams.proto
+
+package moduleparams
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AllModuleParamsRequest is the request type of the AllModuleParams RPC.
+type AllModuleParamsRequest struct {
+}
+
+func (m *AllModuleParamsRequest) Reset()         { *m = AllModuleParamsRequest{} }
+func (m *AllModuleParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*AllModuleParamsRequest) ProtoMessage()    {}
+func (*AllModuleParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e917d6b528b037e, []int{0}
+}
+func (m *AllModuleParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllModuleParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllModuleParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllModuleParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllModuleParamsRequest.Merge(m, src)
+}
+func (m *AllModuleParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllModuleParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllModuleParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllModuleParamsRequest proto.InternalMessageInfo
+
+// AllModuleParamsResponse is the response type of the AllModuleParams RPC.
+type AllModuleParamsResponse struct {
+	// params are the params of the modules, sorted by module name.
+	Params []*ModuleParams `protobuf:"bytes,1,rep,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *AllModuleParamsResponse) Reset()         { *m = AllModuleParamsResponse{} }
+func (m *AllModuleParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*AllModuleParamsResponse) ProtoMessage()    {}
+func (*AllModuleParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e917d6b528b037e, []int{1}
+}
+func (m *AllModuleParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllModuleParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllModuleParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllModuleParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllModuleParamsResponse.Merge(m, src)
+}
+func (m *AllModuleParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllModuleParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllModuleParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllModuleParamsResponse proto.InternalMessageInfo
+
+func (m *AllModuleParamsResponse) GetParams() []*ModuleParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// ModuleParams holds the params of a module.
+type ModuleParams struct {
+	// module is the name of the module, e.g. bank.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// service is the full name of the query service of the module, e.g.
+	// cosmos.bank.v1beta1.Query.
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	// params is the JSON encoded response of the Params method of the query
+	// service.
+	Params string `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *ModuleParams) Reset()         { *m = ModuleParams{} }
+func (m *ModuleParams) String() string { return proto.CompactTextString(m) }
+func (*ModuleParams) ProtoMessage()    {}
+func (*ModuleParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e917d6b528b037e, []int{2}
+}
+func (m *ModuleParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleParams.Merge(m, src)
+}
+func (m *ModuleParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleParams proto.InternalMessageInfo
+
+func (m *ModuleParams) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ModuleParams) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *ModuleParams) GetParams() string {
+	if m != nil {
+		return m.Params
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*AllModuleParamsRequest)(nil), "cosmos.base.moduleparams.v1beta1.AllModuleParamsRequest")
+	proto.RegisterType((*AllModuleParamsResponse)(nil), "cosmos.base.moduleparams.v1beta1.AllModuleParamsResponse")
+	proto.RegisterType((*ModuleParams)(nil), "cosmos.base.moduleparams.v1beta1.ModuleParams")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/moduleparams/v1beta1/moduleparams.proto", fileDescriptor_1e917d6b528b037e)
+}
+
+var fileDescriptor_1e917d6b528b037e = []byte{
+	// 316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x4a, 0xc3, 0x30,
+	0x18, 0xc7, 0x97, 0x0d, 0x2a, 0x46, 0x41, 0x88, 0x30, 0xcb, 0x90, 0x50, 0x7a, 0x2a, 0x82, 0x89,
+	0xdb, 0x10, 0xf4, 0xa8, 0x07, 0x6f, 0xa2, 0xcc, 0x8b, 0x78, 0x4b, 0xbb, 0x50, 0x8b, 0x6d, 0x53,
+	0x9b, 0x74, 0x0f, 0xe0, 0x13, 0x08, 0xbe, 0x8c, 0x8f, 0xe0, 0xb1, 0xe0, 0xc5, 0xa3, 0xb4, 0x3e,
+	0x88, 0xac, 0xc9, 0x60, 0x55, 0xa1, 0xe0, 0x29, 0xfc, 0xbf, 0x2f, 0xbf, 0x7f, 0xfe, 0xf9, 0x12,
+	0x38, 0x0d, 0x84, 0x4c, 0x84, 0xa4, 0x3e, 0x93, 0x9c, 0x26, 0x62, 0x5e, 0xc4, 0x3c, 0x63, 0x39,
+	0x4b, 0x24, 0x5d, 0x8c, 0x7d, 0xae, 0xd8, 0xb8, 0x55, 0x24, 0x59, 0x2e, 0x94, 0x40, 0x8e, 0x86,
+	0xc8, 0x12, 0x22, 0xad, 0xbe, 0x81, 0x46, 0xfb, 0xa1, 0x10, 0x61, 0xcc, 0x29, 0xcb, 0x22, 0xca,
+	0xd2, 0x54, 0x28, 0xa6, 0x22, 0x91, 0x1a, 0xde, 0xb5, 0xe1, 0xf0, 0x2c, 0x8e, 0x2f, 0x1b, 0xf0,
+	0xba, 0x01, 0x67, 0xfc, 0xb1, 0xe0, 0x52, 0xb9, 0x0c, 0xee, 0xfd, 0xea, 0xc8, 0x4c, 0xa4, 0x92,
+	0xa3, 0x0b, 0x68, 0xe9, 0x43, 0x6c, 0xe0, 0x0c, 0xbc, 0xad, 0x09, 0x21, 0x5d, 0x29, 0x48, 0xcb,
+	0xc7, 0xd0, 0xee, 0x2d, 0xdc, 0x5e, 0xaf, 0xa3, 0x21, 0xb4, 0x34, 0x6c, 0x03, 0x07, 0x78, 0x9b,
+	0x33, 0xa3, 0x90, 0x0d, 0x37, 0x24, 0xcf, 0x17, 0x51, 0xc0, 0xed, 0x7e, 0xd3, 0x58, 0xc9, 0x25,
+	0x61, 0x92, 0x0c, 0x34, 0xa1, 0xd5, 0xa4, 0x04, 0x70, 0x77, 0xdd, 0xfa, 0xc6, 0xec, 0x7f, 0x05,
+	0x70, 0xe7, 0xc7, 0xad, 0xd0, 0x49, 0x77, 0xfa, 0xbf, 0x47, 0x34, 0x3a, 0xfd, 0x07, 0xa9, 0x47,
+	0xe8, 0x1e, 0x3d, 0xbd, 0x7f, 0xbd, 0xf4, 0x0f, 0x90, 0x47, 0x3b, 0x5f, 0x5d, 0xcb, 0xf3, 0xab,
+	0xb7, 0x0a, 0x83, 0xb2, 0xc2, 0xe0, 0xb3, 0xc2, 0xe0, 0xb9, 0xc6, 0xbd, 0xb2, 0xc6, 0xbd, 0x8f,
+	0x1a, 0xf7, 0xee, 0x8e, 0xc3, 0x48, 0xdd, 0x17, 0x3e, 0x09, 0x44, 0xb2, 0x72, 0xd3, 0xcb, 0xa1,
+	0x9c, 0x3f, 0xd0, 0x20, 0x8e, 0x78, 0xaa, 0x68, 0x98, 0x67, 0x41, 0xcb, 0xdf, 0xb7, 0x9a, 0x1f,
+	0x30, 0xfd, 0x1e, 0x00, 0x23, 0xcb, 0x48, 0xc8, 0x78, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ModuleParamsServiceClient is the client API for ModuleParamsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ModuleParamsServiceClient interface {
+	// AllModuleParams returns the params of all the modules whose query service
+	// has a Params method with an empty request.
+	AllModuleParams(ctx context.Context, in *AllModuleParamsRequest, opts ...grpc.CallOption) (*AllModuleParamsResponse, error)
+}
+
+type moduleParamsServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewModuleParamsServiceClient(cc grpc1.ClientConn) ModuleParamsServiceClient {
+	return &moduleParamsServiceClient{cc}
+}
+
+func (c *moduleParamsServiceClient) AllModuleParams(ctx context.Context, in *AllModuleParamsRequest, opts ...grpc.CallOption) (*AllModuleParamsResponse, error) {
+	out := new(AllModuleParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.moduleparams.v1beta1.ModuleParamsService/AllModuleParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ModuleParamsServiceServer is the server API for ModuleParamsService service.
+type ModuleParamsServiceServer interface {
+	// AllModuleParams returns the params of all the modules whose query service
+	// has a Params method with an empty request.
+	AllModuleParams(context.Context, *AllModuleParamsRequest) (*AllModuleParamsResponse, error)
+}
+
+// UnimplementedModuleParamsServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedModuleParamsServiceServer struct {
+}
+
+func (*UnimplementedModuleParamsServiceServer) AllModuleParams(ctx context.Context, req *AllModuleParamsRequest) (*AllModuleParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllModuleParams not implemented")
+}
+
+func RegisterModuleParamsServiceServer(s grpc1.Server, srv ModuleParamsServiceServer) {
+	s.RegisterService(&_ModuleParamsService_serviceDesc, srv)
+}
+
+func _ModuleParamsService_AllModuleParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllModuleParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModuleParamsServiceServer).AllModuleParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.moduleparams.v1beta1.ModuleParamsService/AllModuleParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModuleParamsServiceServer).AllModuleParams(ctx, req.(*AllModuleParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ModuleParamsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.moduleparams.v1beta1.ModuleParamsService",
+	HandlerType: (*ModuleParamsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AllModuleParams",
+			Handler:    _ModuleParamsService_AllModuleParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/moduleparams/v1beta1/moduleparams.proto",
+}
+
+func (m *AllModuleParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllModuleParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllModuleParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AllModuleParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllModuleParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllModuleParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModuleparams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		i -= len(m.Params)
+		copy(dAtA[i:], m.Params)
+		i = encodeVarintModuleparams(dAtA, i, uint64(len(m.Params)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintModuleparams(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintModuleparams(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintModuleparams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovModuleparams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AllModuleParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllModuleParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovModuleparams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovModuleparams(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovModuleparams(uint64(l))
+	}
+	l = len(m.Params)
+	if l > 0 {
+		n += 1 + l + sovModuleparams(uint64(l))
+	}
+	return n
+}
+
+func sovModuleparams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozModuleparams(x uint64) (n int) {
+	return sovModuleparams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AllModuleParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModuleparams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllModuleParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllModuleParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModuleparams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModuleparams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllModuleParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModuleparams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllModuleParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllModuleParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleparams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModuleparams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleparams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, &ModuleParams{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModuleparams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModuleparams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModuleparams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleparams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleparams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleparams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleparams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleparams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleparams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleparams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleparams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleparams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModuleparams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModuleparams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipModuleparams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowModuleparams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowModuleparams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowModuleparams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthModuleparams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupModuleparams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthModuleparams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthModuleparams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowModuleparams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupModuleparams = fmt.Errorf("proto: unexpected end of group")
+)
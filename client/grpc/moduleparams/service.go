@@ -0,0 +1,144 @@
+package moduleparams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/gogo/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// paramsMethodName is the name of the method of the query services returning
+// the params of their module.
+const paramsMethodName = "Params"
+
+// versionRegex matches the version component of the service names, e.g.
+// v1beta1.
+var versionRegex = regexp.MustCompile(`^v[0-9]+((alpha|beta)[0-9]+)?$`)
+
+// errParamsRequestNotEmpty is returned to the Params method handlers whose
+// request is not empty, so that they are not called.
+var errParamsRequestNotEmpty = errors.New("params request is not empty")
+
+// QueryService is a gRPC query service registered on the app, along with its
+// handler.
+type QueryService struct {
+	Desc    *grpc.ServiceDesc
+	Handler interface{}
+}
+
+type moduleParamsServiceServer struct {
+	queryServices     func() []QueryService
+	interfaceRegistry types.InterfaceRegistry
+}
+
+// NewModuleParamsServiceServer creates a new ModuleParamsServiceServer,
+// collecting the params from the query services returned by queryServices.
+func NewModuleParamsServiceServer(queryServices func() []QueryService, interfaceRegistry types.InterfaceRegistry) ModuleParamsServiceServer {
+	return &moduleParamsServiceServer{
+		queryServices:     queryServices,
+		interfaceRegistry: interfaceRegistry,
+	}
+}
+
+var _ ModuleParamsServiceServer = (*moduleParamsServiceServer)(nil)
+
+// AllModuleParams implements the AllModuleParams method of the
+// ModuleParamsServiceServer interface. It calls the Params method of each
+// query service whose request is empty, in the context of the query.
+func (s moduleParamsServiceServer) AllModuleParams(ctx context.Context, _ *AllModuleParamsRequest) (*AllModuleParamsResponse, error) {
+	var params []*ModuleParams
+	for _, service := range s.queryServices() {
+		for _, method := range service.Desc.Methods {
+			if method.MethodName != paramsMethodName {
+				continue
+			}
+
+			res, err := method.Handler(service.Handler, ctx, func(req interface{}) error {
+				if !isEmptyRequest(req) {
+					return errParamsRequestNotEmpty
+				}
+				return nil
+			}, nil)
+			if errors.Is(err, errParamsRequestNotEmpty) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to query the params of %s: %w", service.Desc.ServiceName, err)
+			}
+
+			msg, ok := res.(proto.Message)
+			if !ok {
+				return nil, fmt.Errorf("expected a proto message from %s, got %T", service.Desc.ServiceName, res)
+			}
+
+			bz, err := codec.ProtoMarshalJSON(msg, s.interfaceRegistry)
+			if err != nil {
+				return nil, err
+			}
+
+			params = append(params, &ModuleParams{
+				Module:  moduleName(service.Desc.ServiceName),
+				Service: service.Desc.ServiceName,
+				Params:  string(bz),
+			})
+		}
+	}
+
+	sort.Slice(params, func(i, j int) bool {
+		if params[i].Module != params[j].Module {
+			return params[i].Module < params[j].Module
+		}
+		return params[i].Service < params[j].Service
+	})
+
+	return &AllModuleParamsResponse{Params: params}, nil
+}
+
+// isEmptyRequest returns whether req is a pointer to a message without fields,
+// the gogoproto XXX_ fields aside.
+func isEmptyRequest(req interface{}) bool {
+	v := reflect.ValueOf(req)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return false
+	}
+
+	t := v.Elem().Type()
+	for i := 0; i < t.NumField(); i++ {
+		if !strings.HasPrefix(t.Field(i).Name, "XXX_") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// moduleName returns the name of the module of a query service, i.e. the
+// component of its name preceding the version, e.g. bank for
+// cosmos.bank.v1beta1.Query, and the full service name if it is unversioned.
+func moduleName(serviceName string) string {
+	parts := strings.Split(serviceName, ".")
+	for i := 1; i < len(parts); i++ {
+		if versionRegex.MatchString(parts[i]) {
+			return parts[i-1]
+		}
+	}
+
+	return serviceName
+}
+
+// RegisterGRPCGatewayRoutes mounts the module params service's GRPC-gateway
+// routes on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterModuleParamsServiceHandlerClient(context.Background(), mux, NewModuleParamsServiceClient(clientConn))
+}
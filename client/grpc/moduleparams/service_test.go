@@ -0,0 +1,22 @@
+package moduleparams
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+func TestModuleName(t *testing.T) {
+	require.Equal(t, "bank", moduleName("cosmos.bank.v1beta1.Query"))
+	require.Equal(t, "group", moduleName("cosmos.group.v1.Query"))
+	require.Equal(t, "transfer", moduleName("ibc.applications.transfer.v1.Query"))
+	require.Equal(t, "foo.Query", moduleName("foo.Query"))
+}
+
+func TestIsEmptyRequest(t *testing.T) {
+	require.True(t, isEmptyRequest(&AllModuleParamsRequest{}))
+	require.False(t, isEmptyRequest(&testdata.EchoRequest{}))
+	require.False(t, isEmptyRequest(AllModuleParamsRequest{}))
+}
@@ -31,6 +31,8 @@ const (
 	flagNoSort      = "nosort"
 	flagHDPath      = "hd-path"
 
+	flagMultisigPubKey = "multisig-pubkey"
+
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
 )
@@ -58,12 +60,23 @@ sorted by address, unless the flag --nosort is set.
 Example:
 
     keys add mymultisig --multisig "keyname1,keyname2,keyname3" --multisig-threshold 2
+
+The signers of a multisig don't need to be in the keyring: --multisig also accepts the
+addresses of the signers, whose public keys are queried from the chain through --node when
+they aren't in the keyring, and --multisig-pubkey adds a signer by its public key in JSON
+format, e.g. for an account which hasn't signed any transaction yet. The public keys of such
+signers are stored as offline keys named after the multisig key and their address, e.g.
+mymultisig-cosmos1...
+Example:
+
+    keys add mymultisig --multisig "keyname1,cosmos1..." --multisig-pubkey '{"@type":...}' --multisig-threshold 2
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmdPrepare,
 	}
 	f := cmd.Flags()
-	f.StringSlice(flagMultisig, nil, "List of key names stored in keyring to construct a public legacy multisig key")
+	f.StringSlice(flagMultisig, nil, "List of key names stored in keyring, or of signer addresses, to construct a public legacy multisig key")
+	f.StringArray(flagMultisigPubKey, nil, "Public key in JSON format of a signer of the multisig key, which can be repeated. For use in conjunction with --multisig-threshold")
 	f.Int(flagMultiSigThreshold, 1, "K out of N required signatures. For use in conjunction with --multisig")
 	f.Bool(flagNoSort, false, "Keys passed to --multisig are taken in the order they're supplied")
 	f.String(FlagPublicKey, "", "Parse a public key in JSON format and saves key info to <name> file.")
@@ -77,6 +90,7 @@ Example:
 	f.Uint32(flagAccount, 0, "Account number for HD derivation (less than equal 2147483647)")
 	f.Uint32(flagIndex, 0, "Address index number for HD derivation (less than equal 2147483647)")
 	f.String(flags.FlagKeyAlgorithm, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for")
+	f.String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain, to query the public keys of the multisig signers")
 
 	return cmd
 }
@@ -140,24 +154,16 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		}
 
 		multisigKeys, _ := cmd.Flags().GetStringSlice(flagMultisig)
-		if len(multisigKeys) != 0 {
-			pks := make([]cryptotypes.PubKey, len(multisigKeys))
+		multisigPubKeys, _ := cmd.Flags().GetStringArray(flagMultisigPubKey)
+		if len(multisigKeys)+len(multisigPubKeys) != 0 {
 			multisigThreshold, _ := cmd.Flags().GetInt(flagMultiSigThreshold)
-			if err := validateMultisigThreshold(multisigThreshold, len(multisigKeys)); err != nil {
+			if err := validateMultisigThreshold(multisigThreshold, len(multisigKeys)+len(multisigPubKeys)); err != nil {
 				return err
 			}
 
-			for i, keyname := range multisigKeys {
-				k, err := kb.Key(keyname)
-				if err != nil {
-					return err
-				}
-
-				key, err := k.GetPubKey()
-				if err != nil {
-					return err
-				}
-				pks[i] = key
+			pks, offline, err := multisigSignerPubKeys(ctx, kb, multisigKeys, multisigPubKeys)
+			if err != nil {
+				return err
 			}
 
 			if noSort, _ := cmd.Flags().GetBool(flagNoSort); !noSort {
@@ -172,6 +178,13 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 				return err
 			}
 
+			for _, signerPk := range offline {
+				signerName := fmt.Sprintf("%s-%s", name, sdk.AccAddress(signerPk.Address()))
+				if _, err := kb.SaveOfflineKey(signerName, signerPk); err != nil {
+					return err
+				}
+			}
+
 			return printCreate(cmd, k, false, "", outputFormat)
 		}
 	}
@@ -288,6 +301,88 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	return printCreate(cmd, k, showMnemonic, mnemonic, outputFormat)
 }
 
+// multisigSignerPubKeys returns the public keys of the signers of a multisig
+// key, given by key name or address in keys and by public key in JSON format
+// in pubKeys, in this order. The signers which aren't in the keyring are
+// looked up on chain by address, and are returned in offline too, once each,
+// to be stored as offline keys.
+func multisigSignerPubKeys(ctx client.Context, kb keyring.Keyring, keys, pubKeys []string) (pks, offline []cryptotypes.PubKey, err error) {
+	pks = make([]cryptotypes.PubKey, 0, len(keys)+len(pubKeys))
+	seen := make(map[string]bool)
+	addOffline := func(pk cryptotypes.PubKey) {
+		addr := sdk.AccAddress(pk.Address())
+		if _, err := kb.KeyByAddress(addr); err != nil && !seen[addr.String()] {
+			seen[addr.String()] = true
+			offline = append(offline, pk)
+		}
+	}
+
+	for _, nameOrAddr := range keys {
+		pk, local, err := multisigSignerPubKey(ctx, kb, nameOrAddr)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !local {
+			addOffline(pk)
+		}
+		pks = append(pks, pk)
+	}
+
+	for _, pubKey := range pubKeys {
+		var pk cryptotypes.PubKey
+		if err := ctx.Codec.UnmarshalInterfaceJSON([]byte(pubKey), &pk); err != nil {
+			return nil, nil, fmt.Errorf("invalid multisig signer public key %s: %w", pubKey, err)
+		}
+		addOffline(pk)
+		pks = append(pks, pk)
+	}
+
+	return pks, offline, nil
+}
+
+// multisigSignerPubKey returns the public key of a signer of a multisig key,
+// given by key name or address, and whether it is in the keyring. The public
+// key of an address which isn't in the keyring is queried from the chain, and
+// must have been set by a transaction of the account.
+func multisigSignerPubKey(ctx client.Context, kb keyring.Keyring, nameOrAddr string) (pk cryptotypes.PubKey, local bool, err error) {
+	k, err := kb.Key(nameOrAddr)
+	if err != nil {
+		addr, addrErr := sdk.AccAddressFromBech32(nameOrAddr)
+		if addrErr != nil {
+			// not an address, return the key lookup error
+			return nil, false, err
+		}
+
+		k, err = kb.KeyByAddress(addr)
+		if err != nil {
+			pk, err = queryPubKey(ctx, addr)
+			return pk, false, err
+		}
+	}
+
+	pk, err = k.GetPubKey()
+	return pk, true, err
+}
+
+// queryPubKey queries the public key of the account addr from the chain.
+func queryPubKey(ctx client.Context, addr sdk.AccAddress) (cryptotypes.PubKey, error) {
+	if ctx.AccountRetriever == nil {
+		return nil, fmt.Errorf("key %s not found in keyring and no account retriever to query it", addr)
+	}
+
+	acc, err := ctx.AccountRetriever.GetAccount(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("key %s not found in keyring, failed to query its account: %w", addr, err)
+	}
+
+	pk := acc.GetPubKey()
+	if pk == nil {
+		return nil, fmt.Errorf("account %s has no public key on chain, pass it with --%s", addr, flagMultisigPubKey)
+	}
+
+	return pk, nil
+}
+
 func printCreate(cmd *cobra.Command, k *keyring.Record, showMnemonic bool, mnemonic, outputFormat string) error {
 	switch outputFormat {
 	case OutputFormatText:
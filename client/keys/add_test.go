@@ -14,6 +14,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -232,6 +234,144 @@ func Test_runAddCmdDryRun(t *testing.T) {
 	}
 }
 
+func Test_runAddCmdMultisigSigners(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+
+	chainPk := secp256k1.GenPrivKey().PubKey()
+	chainAddr := sdk.AccAddress(chainPk.Address())
+	noPkAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	unknownAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	rawPk := secp256k1.GenPrivKey().PubKey()
+	rawPkJSON, err := cdc.MarshalInterfaceJSON(rawPk)
+	require.NoError(t, err)
+
+	path := sdk.GetConfig().GetFullBIP44Path()
+	otherPath := hd.CreateHDPath(sdk.CoinType, 0, 1).String()
+	otherKey, err := keyring.NewInMemory(cdc).NewAccount("other", testdata.TestMnemonic, "", otherPath, hd.Secp256k1)
+	require.NoError(t, err)
+	otherAddr, err := otherKey.GetAddress()
+	require.NoError(t, err)
+
+	accounts := client.TestAccountRetriever{Accounts: map[string]client.TestAccount{
+		chainAddr.String(): {Address: chainAddr, PubKey: chainPk},
+		noPkAddr.String():  {Address: noPkAddr},
+	}}
+
+	testData := []struct {
+		name    string
+		args    []string
+		nKeys   int
+		offline []sdk.AccAddress
+		expErr  string
+	}{
+		{
+			name: "local key by name and address",
+			args: []string{
+				fmt.Sprintf("--%s=%s", flagMultisig, "local"),
+				fmt.Sprintf("--%s=%s", flagMultisig, otherAddr),
+			},
+			nKeys: 2,
+		},
+		{
+			name: "signers queried from chain and raw public key",
+			args: []string{
+				fmt.Sprintf("--%s=%s,%s", flagMultisig, "local", chainAddr),
+				fmt.Sprintf("--%s=%s", flagMultisigPubKey, rawPkJSON),
+			},
+			nKeys:   3,
+			offline: []sdk.AccAddress{chainAddr, sdk.AccAddress(rawPk.Address())},
+		},
+		{
+			name: "duplicated signer stored once",
+			args: []string{
+				fmt.Sprintf("--%s=%s,%s", flagMultisig, chainAddr, chainAddr),
+			},
+			nKeys:   2,
+			offline: []sdk.AccAddress{chainAddr},
+		},
+		{
+			name: "account without public key",
+			args: []string{
+				fmt.Sprintf("--%s=%s,%s", flagMultisig, "local", noPkAddr),
+			},
+			expErr: "has no public key on chain",
+		},
+		{
+			name: "unknown account",
+			args: []string{
+				fmt.Sprintf("--%s=%s,%s", flagMultisig, "local", unknownAddr),
+			},
+			expErr: "failed to query its account",
+		},
+		{
+			name: "unknown key name",
+			args: []string{
+				fmt.Sprintf("--%s=%s,%s", flagMultisig, "local", "unknown"),
+			},
+			expErr: "key not found",
+		},
+		{
+			name: "invalid public key",
+			args: []string{
+				fmt.Sprintf("--%s=%s", flagMultisig, "local"),
+				fmt.Sprintf("--%s=%s", flagMultisigPubKey, "{}"),
+			},
+			expErr: "invalid multisig signer public key",
+		},
+	}
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := AddKeyCommand()
+			cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+
+			kbHome := t.TempDir()
+			mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+
+			kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc)
+			require.NoError(t, err)
+
+			clientCtx := client.Context{}.
+				WithCodec(cdc).
+				WithKeyringDir(kbHome).
+				WithKeyring(kb).
+				WithAccountRetriever(accounts)
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+			_, err = kb.NewAccount("local", testdata.TestMnemonic, "", path, hd.Secp256k1)
+			require.NoError(t, err)
+			_, err = kb.NewAccount("other", testdata.TestMnemonic, "", otherPath, hd.Secp256k1)
+			require.NoError(t, err)
+
+			cmd.SetArgs(append([]string{"multi"}, tt.args...))
+
+			err = cmd.ExecuteContext(ctx)
+			if tt.expErr != "" {
+				require.ErrorContains(t, err, tt.expErr)
+				_, err = kb.Key("multi")
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			k, err := kb.Key("multi")
+			require.NoError(t, err)
+			pk, err := k.GetPubKey()
+			require.NoError(t, err)
+			require.Len(t, pk.(*multisig.LegacyAminoPubKey).PubKeys, tt.nKeys)
+
+			records, err := kb.List()
+			require.NoError(t, err)
+			require.Len(t, records, 3+len(tt.offline))
+			for _, addr := range tt.offline {
+				k, err := kb.Key("multi-" + addr.String())
+				require.NoError(t, err)
+				require.Equal(t, keyring.TypeOffline, k.GetType())
+			}
+		})
+	}
+}
+
 func TestAddRecoverFileBackend(t *testing.T) {
 	cmd := AddKeyCommand()
 	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
@@ -15,6 +15,7 @@ var (
 // TestAccount represents a client Account that can be used in unit tests
 type TestAccount struct {
 	Address sdk.AccAddress
+	PubKey  cryptotypes.PubKey
 	Num     uint64
 	Seq     uint64
 }
@@ -26,7 +27,7 @@ func (t TestAccount) GetAddress() sdk.AccAddress {
 
 // GetPubKey implements client Account.GetPubKey
 func (t TestAccount) GetPubKey() cryptotypes.PubKey {
-	return nil
+	return t.PubKey
 }
 
 // GetAccountNumber implements client Account.GetAccountNumber
@@ -15,6 +15,10 @@ func ConvertTxToStdTx(codec *codec.LegacyAmino, tx signing.Tx) (legacytx.StdTx,
 		return stdTx, nil
 	}
 
+	if !tx.GetTimeoutTimestamp().IsZero() {
+		return legacytx.StdTx{}, fmt.Errorf("%T does not support timeout timestamps", legacytx.StdTx{})
+	}
+
 	aminoTxConfig := legacytx.StdTxConfig{Cdc: codec}
 	builder := aminoTxConfig.NewTxBuilder()
 
@@ -61,6 +65,9 @@ func CopyTx(tx signing.Tx, builder client.TxBuilder, ignoreSignatureError bool)
 	builder.SetFeeAmount(tx.GetFee())
 	builder.SetGasLimit(tx.GetGas())
 	builder.SetTimeoutHeight(tx.GetTimeoutHeight())
+	if timeoutTimestamp := tx.GetTimeoutTimestamp(); !timeoutTimestamp.IsZero() {
+		builder.SetTimeoutTimestamp(timeoutTimestamp)
+	}
 
 	return nil
 }
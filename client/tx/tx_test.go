@@ -4,6 +4,7 @@ import (
 	gocontext "context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -149,6 +150,12 @@ func TestBuildUnsignedTx(t *testing.T) {
 	sigs, err := tx.GetTx().(signing.SigVerifiableTx).GetSignaturesV2()
 	require.NoError(t, err)
 	require.Empty(t, sigs)
+	require.True(t, tx.GetTx().GetTimeoutTimestamp().IsZero())
+
+	timeout := time.Unix(1000, 0)
+	tx, err = txf.WithTimeoutTimestamp(timeout).BuildUnsignedTx(msg)
+	require.NoError(t, err)
+	require.True(t, timeout.Equal(tx.GetTx().GetTimeoutTimestamp()))
 }
 
 func TestSign(t *testing.T) {
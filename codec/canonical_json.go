@@ -0,0 +1,275 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+// maxCanonicalExponent is the largest magnitude of the exponent of the numbers
+// accepted by CanonicalizeJSON, whose canonical form has no exponent.
+const maxCanonicalExponent = 1000
+
+// MarshalCanonicalJSON returns the canonical JSON encoding of o, see
+// CanonicalizeJSON.
+//
+// As with MarshalJSON of the ProtoCodec, all the fields are emitted, including
+// the ones with default values, and the 64-bit integers, sdk.Int and sdk.Dec
+// are encoded as strings, the latter with their full precision. Unlike
+// MarshalJSON, the nil sdk.Dec are also encoded with their full precision,
+// like the zero ones, so that o is encoded the same way once decoded: o is
+// decoded from its JSON encoding, which sets its nil sdk.Dec to zero, before
+// being encoded again.
+func MarshalCanonicalJSON(cdc JSONCodec, o proto.Message) ([]byte, error) {
+	bz, err := cdc.MarshalJSON(o)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := reflect.New(reflect.TypeOf(o).Elem()).Interface().(proto.Message)
+	if err := cdc.UnmarshalJSON(bz, decoded); err != nil {
+		return nil, err
+	}
+	bz, err = cdc.MarshalJSON(decoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return CanonicalizeJSON(bz)
+}
+
+// MustMarshalCanonicalJSON is like MarshalCanonicalJSON but panics if an error
+// occurs.
+func MustMarshalCanonicalJSON(cdc JSONCodec, o proto.Message) []byte {
+	bz, err := MarshalCanonicalJSON(cdc, o)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// CanonicalizeJSON returns the canonical form of a JSON document, which is the
+// same for all the documents holding the same values:
+//   - the keys of the objects are sorted, and duplicated keys are rejected,
+//   - there are no white-spaces,
+//   - the numbers are written in decimal notation, without exponent, leading
+//     or trailing zeros, e.g. 1.50e2 is written 150 and -0.0 is written 0,
+//   - the strings are UTF-8 encoded, the invalid bytes being replaced by the
+//     replacement character U+FFFD, and only the quotes, the backslashes and
+//     the control characters are escaped.
+//
+// Unlike sdk.SortJSON, the numbers are not converted to float64, so that they
+// keep their precision, and the HTML characters are not escaped.
+func CanonicalizeJSON(bz []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	var buf bytes.Buffer
+	if err := canonicalizeValue(dec, &buf); err != nil {
+		return nil, err
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("invalid JSON: unexpected data after the top-level value")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// canonicalizeValue writes the canonical form of the next value of dec to buf.
+func canonicalizeValue(dec *json.Decoder, buf *bytes.Buffer) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	switch tok := tok.(type) {
+	case json.Delim:
+		if tok == '{' {
+			return canonicalizeObject(dec, buf)
+		}
+		return canonicalizeArray(dec, buf)
+
+	case string:
+		writeCanonicalString(buf, tok)
+
+	case json.Number:
+		num, err := canonicalNumber(string(tok))
+		if err != nil {
+			return err
+		}
+		buf.WriteString(num)
+
+	case bool:
+		buf.WriteString(strconv.FormatBool(tok))
+
+	case nil:
+		buf.WriteString("null")
+	}
+
+	return nil
+}
+
+// canonicalizeObject writes the canonical form of the object whose opening
+// delimiter was just read from dec to buf.
+func canonicalizeObject(dec *json.Decoder, buf *bytes.Buffer) error {
+	type field struct {
+		key   string
+		value []byte
+	}
+
+	var fields []field
+	keys := make(map[string]bool)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("invalid JSON: %w", err)
+		}
+		key := tok.(string)
+		if keys[key] {
+			return fmt.Errorf("invalid JSON: duplicated key %q", key)
+		}
+		keys[key] = true
+
+		var value bytes.Buffer
+		if err := canonicalizeValue(dec, &value); err != nil {
+			return err
+		}
+		fields = append(fields, field{key, value.Bytes()})
+	}
+	// read the closing delimiter
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	sort.Slice(fields, func(i, j int) bool { return fields[i].key < fields[j].key })
+
+	buf.WriteByte('{')
+	for i, f := range fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		writeCanonicalString(buf, f.key)
+		buf.WriteByte(':')
+		buf.Write(f.value)
+	}
+	buf.WriteByte('}')
+
+	return nil
+}
+
+// canonicalizeArray writes the canonical form of the array whose opening
+// delimiter was just read from dec to buf.
+func canonicalizeArray(dec *json.Decoder, buf *bytes.Buffer) error {
+	buf.WriteByte('[')
+	for i := 0; dec.More(); i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := canonicalizeValue(dec, buf); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte(']')
+
+	// read the closing delimiter
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	return nil
+}
+
+// writeCanonicalString writes s to buf as a JSON string, escaping only the
+// quotes, the backslashes and the control characters.
+func writeCanonicalString(buf *bytes.Buffer, s string) {
+	const hex = "0123456789abcdef"
+
+	buf.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\\':
+			buf.WriteByte('\\')
+			buf.WriteByte(c)
+		case c == '\n':
+			buf.WriteString(`\n`)
+		case c == '\r':
+			buf.WriteString(`\r`)
+		case c == '\t':
+			buf.WriteString(`\t`)
+		case c == '\b':
+			buf.WriteString(`\b`)
+		case c == '\f':
+			buf.WriteString(`\f`)
+		case c < 0x20:
+			buf.WriteString(`\u00`)
+			buf.WriteByte(hex[c>>4])
+			buf.WriteByte(hex[c&0xf])
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	buf.WriteByte('"')
+}
+
+// canonicalNumber returns the canonical form of a JSON number, in decimal
+// notation without exponent, leading or trailing zeros.
+func canonicalNumber(num string) (string, error) {
+	neg := strings.HasPrefix(num, "-")
+	num = strings.TrimPrefix(num, "-")
+
+	exp := 0
+	if i := strings.IndexAny(num, "eE"); i >= 0 {
+		var err error
+		exp, err = strconv.Atoi(num[i+1:])
+		if err != nil || exp > maxCanonicalExponent || exp < -maxCanonicalExponent {
+			return "", fmt.Errorf("invalid JSON: exponent of number %s out of range", num)
+		}
+		num = num[:i]
+	}
+
+	intPart, fracPart := num, ""
+	if i := strings.IndexByte(num, '.'); i >= 0 {
+		intPart, fracPart = num[:i], num[i+1:]
+	}
+
+	// the digits of the number, with the decimal point at point
+	digits := intPart + fracPart
+	point := len(intPart) + exp
+
+	trimmed := strings.TrimLeft(digits, "0")
+	point -= len(digits) - len(trimmed)
+	digits = strings.TrimRight(trimmed, "0")
+	if digits == "" {
+		return "0", nil
+	}
+
+	var sb strings.Builder
+	if neg {
+		sb.WriteByte('-')
+	}
+	switch {
+	case point <= 0:
+		sb.WriteString("0.")
+		sb.WriteString(strings.Repeat("0", -point))
+		sb.WriteString(digits)
+	case point >= len(digits):
+		sb.WriteString(digits)
+		sb.WriteString(strings.Repeat("0", point-len(digits)))
+	default:
+		sb.WriteString(digits[:point])
+		sb.WriteByte('.')
+		sb.WriteString(digits[point:])
+	}
+
+	return sb.String(), nil
+}
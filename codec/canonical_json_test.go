@@ -0,0 +1,86 @@
+package codec_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestCanonicalizeJSON(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+		expErr   bool
+	}{
+		{"sorted keys", `{"b": 1, "a": {"d": [3, 1], "c": null}}`, `{"a":{"c":null,"d":[3,1]},"b":1}`, false},
+		{"literals", ` [true, false, null, "", {}, []] `, `[true,false,null,"",{},[]]`, false},
+		{"integers", `[0, -0, 10, 1e2, 1.50E+2, 12345678901234567890123]`, `[0,0,10,100,150,12345678901234567890123]`, false},
+		{"decimals", `[0.5, -0.050, 1.5e-3, 12.30, 5e-1]`, `[0.5,-0.05,0.0015,12.3,0.5]`, false},
+		{"escaping", `"<a href=\"x\">&amp;</a>é\t\u0001\/"`, `"<a href=\"x\">&amp;</a>é\t\u0001/"`, false},
+		{"duplicated keys", `{"a": 1, "a": 2}`, "", true},
+		{"exponent out of range", `1e100000`, "", true},
+		{"trailing data", `{} {}`, "", true},
+		{"invalid JSON", `{"a": }`, "", true},
+		{"empty", ``, "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := codec.CanonicalizeJSON([]byte(tc.input))
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(bz))
+
+			// the canonical form is a fixed point
+			bz2, err := codec.CanonicalizeJSON(bz)
+			require.NoError(t, err)
+			require.Equal(t, bz, bz2)
+		})
+	}
+}
+
+func TestMarshalCanonicalJSON(t *testing.T) {
+	registry := types.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	any, err := types.NewAnyWithValue(&testdata.Dog{Name: "Spot"})
+	require.NoError(t, err)
+
+	bz, err := codec.MarshalCanonicalJSON(cdc, &testdata.HasAnimal{Animal: any, X: 3})
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"animal":{"@type":"/testdata.Dog","name":"Spot","size":""},"x":"3"}`,
+		string(bz))
+}
+
+func TestMarshalCanonicalJSONNilDec(t *testing.T) {
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+
+	// the nil decimals are only encoded with their full precision in the
+	// canonical encoding
+	minter := minttypes.Minter{AnnualProvisions: sdk.NewDec(5)}
+	bz, err := cdc.MarshalJSON(&minter)
+	require.NoError(t, err)
+	require.Equal(t, `{"inflation":"0","annual_provisions":"5.000000000000000000"}`, string(bz))
+
+	bz, err = codec.MarshalCanonicalJSON(cdc, &minter)
+	require.NoError(t, err)
+	require.Equal(t, `{"annual_provisions":"5.000000000000000000","inflation":"0.000000000000000000"}`, string(bz))
+
+	var decoded minttypes.Minter
+	require.NoError(t, cdc.UnmarshalJSON(bz, &decoded))
+	bz2, err := codec.MarshalCanonicalJSON(cdc, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, bz, bz2)
+}
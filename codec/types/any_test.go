@@ -67,3 +67,42 @@ func BenchmarkNewAnyWithCustomTypeURLWithErrorReturned(b *testing.B) {
 	}
 	sink = (interface{})(nil)
 }
+
+func BenchmarkUnpackAny(b *testing.B) {
+	registry := testdata.NewTestInterfaceRegistry()
+	any, err := types.NewAnyWithValue(&testdata.Dog{Name: "Spot", Size_: "small"})
+	if err != nil {
+		b.Fatal(err)
+	}
+	bz, err := any.Marshal()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("UnpackAny", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var decoded types.Any
+			if err := decoded.Unmarshal(bz); err != nil {
+				b.Fatal(err)
+			}
+			var animal testdata.Animal
+			if err := registry.UnpackAny(&decoded, &animal); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("UnpackAnyAs", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var decoded types.Any
+			if err := decoded.Unmarshal(bz); err != nil {
+				b.Fatal(err)
+			}
+			if _, err := types.UnpackAnyAs[testdata.Animal](registry, &decoded); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
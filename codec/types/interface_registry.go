@@ -81,17 +81,40 @@ type UnpackInterfacesMessage interface {
 type interfaceRegistry struct {
 	interfaceNames map[string]reflect.Type
 	interfaceImpls map[reflect.Type]interfaceMap
-	typeURLMap     map[string]reflect.Type
+	typeURLMap     map[string]implementation
 }
 
-type interfaceMap = map[string]reflect.Type
+// implementation is a concrete type registered under a type URL.
+type implementation struct {
+	typ reflect.Type
+	// new returns a new value of the concrete type, resolved once at
+	// registration instead of on every unpacking.
+	new func() proto.Message
+}
+
+func newImplementation(implType reflect.Type) implementation {
+	if implType.Kind() != reflect.Ptr {
+		// only the values pointed to can be unmarshaled into
+		return implementation{typ: implType, new: func() proto.Message { return nil }}
+	}
+
+	elem := implType.Elem()
+	return implementation{
+		typ: implType,
+		new: func() proto.Message {
+			return reflect.New(elem).Interface().(proto.Message)
+		},
+	}
+}
+
+type interfaceMap = map[string]implementation
 
 // NewInterfaceRegistry returns a new InterfaceRegistry
 func NewInterfaceRegistry() InterfaceRegistry {
 	return &interfaceRegistry{
 		interfaceNames: map[string]reflect.Type{},
 		interfaceImpls: map[reflect.Type]interfaceMap{},
-		typeURLMap:     map[string]reflect.Type{},
+		typeURLMap:     map[string]implementation{},
 	}
 }
 
@@ -134,7 +157,7 @@ func (registry *interfaceRegistry) registerImpl(iface interface{}, typeURL strin
 	ityp := reflect.TypeOf(iface).Elem()
 	imap, found := registry.interfaceImpls[ityp]
 	if !found {
-		imap = map[string]reflect.Type{}
+		imap = interfaceMap{}
 	}
 
 	implType := reflect.TypeOf(impl)
@@ -146,22 +169,23 @@ func (registry *interfaceRegistry) registerImpl(iface interface{}, typeURL strin
 	// okay to register the same concrete type again, but if we are registering
 	// a new concrete type under the same typeURL, then we throw an error (here,
 	// we panic).
-	foundImplType, found := imap[typeURL]
-	if found && foundImplType != implType {
+	foundImpl, found := imap[typeURL]
+	if found && foundImpl.typ != implType {
 		panic(
 			fmt.Errorf(
 				"concrete type %s has already been registered under typeURL %s, cannot register %s under same typeURL. "+
 					"This usually means that there are conflicting modules registering different concrete types "+
 					"for a same interface implementation",
-				foundImplType,
+				foundImpl.typ,
 				typeURL,
 				implType,
 			),
 		)
 	}
 
-	imap[typeURL] = implType
-	registry.typeURLMap[typeURL] = implType
+	implementation := newImplementation(implType)
+	imap[typeURL] = implementation
+	registry.typeURLMap[typeURL] = implementation
 
 	registry.interfaceImpls[ityp] = imap
 }
@@ -219,55 +243,110 @@ func (registry *interfaceRegistry) UnpackAny(any *Any, iface interface{}) error
 		}
 	}
 
+	msg, err := registry.unpackAny(any, rt, iface)
+	if err != nil {
+		return err
+	}
+
+	rv.Elem().Set(reflect.ValueOf(msg))
+
+	return nil
+}
+
+// unpackAny unpacks and caches the value of any, which must be registered as
+// an implementation of the interface rt, iface being a pointer to rt.
+func (registry *interfaceRegistry) unpackAny(any *Any, rt reflect.Type, iface interface{}) (proto.Message, error) {
 	imap, found := registry.interfaceImpls[rt]
 	if !found {
-		return fmt.Errorf("no registered implementations of type %+v", rt)
+		return nil, fmt.Errorf("no registered implementations of type %+v", rt)
 	}
 
-	typ, found := imap[any.TypeUrl]
+	impl, found := imap[any.TypeUrl]
 	if !found {
-		return fmt.Errorf("no concrete type registered for type URL %s against interface %T", any.TypeUrl, iface)
+		return nil, fmt.Errorf("no concrete type registered for type URL %s against interface %T", any.TypeUrl, iface)
 	}
 
-	msg, ok := reflect.New(typ.Elem()).Interface().(proto.Message)
-	if !ok {
-		return fmt.Errorf("can't proto unmarshal %T", msg)
+	msg := impl.new()
+	if msg == nil {
+		return nil, fmt.Errorf("can't proto unmarshal %s", impl.typ)
 	}
 
 	err := proto.Unmarshal(any.Value, msg)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = UnpackInterfaces(msg, registry)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	rv.Elem().Set(reflect.ValueOf(msg))
-
 	any.cachedValue = msg
 
-	return nil
+	return msg, nil
 }
 
 // Resolve returns the proto message given its typeURL. It works with types
 // registered with RegisterInterface/RegisterImplementations, as well as those
 // registered with RegisterWithCustomTypeURL.
 func (registry *interfaceRegistry) Resolve(typeURL string) (proto.Message, error) {
-	typ, found := registry.typeURLMap[typeURL]
+	impl, found := registry.typeURLMap[typeURL]
 	if !found {
 		return nil, fmt.Errorf("unable to resolve type URL %s", typeURL)
 	}
 
-	msg, ok := reflect.New(typ.Elem()).Interface().(proto.Message)
-	if !ok {
+	msg := impl.new()
+	if msg == nil {
 		return nil, fmt.Errorf("can't resolve type URL %s", typeURL)
 	}
 
 	return msg, nil
 }
 
+// UnpackAnyAs unpacks the value packed in any as a T, like UnpackAny does into
+// a *T. With the InterfaceRegistry, it returns the zero T if any is nil or has
+// no type URL, and it saves the reflection needed by UnpackAny to set the
+// interface value, which dominates the unpacking of small messages.
+func UnpackAnyAs[T any](unpacker AnyUnpacker, any *Any) (T, error) {
+	// the other unpackers, e.g. the amino ones, are always called, as they
+	// may set the value of any
+	iface := (*T)(nil)
+	rt := reflect.TypeOf(iface).Elem()
+	registry, ok := unpacker.(*interfaceRegistry)
+	if !ok || rt.Kind() != reflect.Interface {
+		return unpackAnyInto[T](unpacker, any)
+	}
+
+	var value T
+	if any == nil || any.TypeUrl == "" {
+		return value, nil
+	}
+
+	if cached, ok := any.cachedValue.(T); ok {
+		return cached, nil
+	}
+
+	msg, err := registry.unpackAny(any, rt, iface)
+	if err != nil {
+		return value, err
+	}
+
+	value, ok = msg.(T)
+	if !ok {
+		return value, fmt.Errorf("type %T doesn't implement interface %+v", msg, rt)
+	}
+
+	return value, nil
+}
+
+// unpackAnyInto unpacks any with UnpackAny, separately from UnpackAnyAs so that
+// its value does not escape to the heap.
+func unpackAnyInto[T any](unpacker AnyUnpacker, any *Any) (T, error) {
+	var value T
+	err := unpacker.UnpackAny(any, &value)
+	return value, err
+}
+
 // UnpackInterfaces is a convenience function that calls UnpackInterfaces
 // on x if x implements UnpackInterfacesMessage
 func UnpackInterfaces(x interface{}, unpacker AnyUnpacker) error {
@@ -88,6 +88,67 @@ func TestRegister(t *testing.T) {
 	)
 }
 
+func TestUnpackAnyAs(t *testing.T) {
+	registry := testdata.NewTestInterfaceRegistry()
+
+	spot := &testdata.Dog{Name: "Spot"}
+	any, err := types.NewAnyWithValue(spot)
+	require.NoError(t, err)
+	bz, err := any.Marshal()
+	require.NoError(t, err)
+
+	// without cache
+	var decoded types.Any
+	require.NoError(t, decoded.Unmarshal(bz))
+	animal, err := types.UnpackAnyAs[testdata.Animal](registry, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, spot, animal)
+	require.Equal(t, spot, decoded.GetCachedValue())
+
+	// with cache
+	animal, err = types.UnpackAnyAs[testdata.Animal](registry, any)
+	require.NoError(t, err)
+	require.Same(t, spot, animal)
+
+	// nil and empty anys are unpacked to the zero value
+	animal, err = types.UnpackAnyAs[testdata.Animal](registry, nil)
+	require.NoError(t, err)
+	require.Nil(t, animal)
+	animal, err = types.UnpackAnyAs[testdata.Animal](registry, &types.Any{})
+	require.NoError(t, err)
+	require.Nil(t, animal)
+
+	// the type URL must be registered against the interface
+	_, err = types.UnpackAnyAs[TestI](registry, &decoded)
+	require.EqualError(t, err, "no registered implementations of type types_test.TestI")
+	_, err = types.UnpackAnyAs[testdata.Animal](registry, &types.Any{TypeUrl: "/testdata.Unknown"})
+	require.EqualError(t, err, "no concrete type registered for type URL /testdata.Unknown against interface *testdata.Animal")
+}
+
+// countingUnpacker is an AnyUnpacker counting its calls.
+type countingUnpacker struct {
+	calls int
+}
+
+func (c *countingUnpacker) UnpackAny(*types.Any, interface{}) error {
+	c.calls++
+	return nil
+}
+
+func TestUnpackAnyAsOtherUnpackers(t *testing.T) {
+	any, err := types.NewAnyWithValue(&testdata.Dog{Name: "Spot"})
+	require.NoError(t, err)
+
+	// the amino unpackers set the values of the anys, which are cached or
+	// have no type URL when decoded from amino JSON
+	unpacker := &countingUnpacker{}
+	_, err = types.UnpackAnyAs[testdata.Animal](unpacker, any)
+	require.NoError(t, err)
+	_, err = types.UnpackAnyAs[testdata.Animal](unpacker, &types.Any{})
+	require.NoError(t, err)
+	require.Equal(t, 2, unpacker.calls)
+}
+
 func TestUnpackInterfaces(t *testing.T) {
 	registry := testdata.NewTestInterfaceRegistry()
 
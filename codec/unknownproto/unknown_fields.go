@@ -39,10 +39,42 @@ func RejectUnknownFieldsStrict(bz []byte, msg proto.Message, resolver jsonpb.Any
 // This function traverses inside of messages nested via google.protobuf.Any. It does not do any deserialization of the proto.Message.
 // An AnyResolver must be provided for traversing inside google.protobuf.Any's.
 func RejectUnknownFields(bz []byte, msg proto.Message, allowUnknownNonCriticals bool, resolver jsonpb.AnyResolver) (hasUnknownNonCriticals bool, err error) {
+	return RejectUnknownFieldsWithLimits(bz, msg, allowUnknownNonCriticals, resolver, Limits{})
+}
+
+// Limits defines the limits enforced on the bytes traversed by RejectUnknownFieldsWithLimits,
+// protecting the callers against payloads crafted to exhaust the stack or the memory of the node
+// once unmarshaled. A zero limit means unlimited.
+type Limits struct {
+	// MaxDepth is the maximum nesting depth of the messages, the root message being at depth 1.
+	// The value of a google.protobuf.Any is at the depth of the Any.
+	MaxDepth int
+	// MaxAnyDepth is the maximum number of google.protobuf.Any nested inside of each other, e.g.
+	// messages executed by an authz MsgExec submitted in a gov proposal.
+	MaxAnyDepth int
+	// MaxRepeatedFieldLength is the maximum number of elements of a repeated field of a message.
+	MaxRepeatedFieldLength int
+}
+
+// ErrLimitExceeded is returned by RejectUnknownFieldsWithLimits when the bytes exceed its limits.
+var ErrLimitExceeded = errors.New("decode limit exceeded")
+
+// RejectUnknownFieldsWithLimits is like RejectUnknownFields, but it also rejects the bytes bz with
+// an error wrapping ErrLimitExceeded if they exceed the provided limits, before traversing deeper.
+func RejectUnknownFieldsWithLimits(bz []byte, msg proto.Message, allowUnknownNonCriticals bool, resolver jsonpb.AnyResolver, limits Limits) (hasUnknownNonCriticals bool, err error) {
+	return rejectUnknownFields(bz, msg, allowUnknownNonCriticals, resolver, limits, 1, 0)
+}
+
+func rejectUnknownFields(bz []byte, msg proto.Message, allowUnknownNonCriticals bool, resolver jsonpb.AnyResolver, limits Limits, depth, anyDepth int) (hasUnknownNonCriticals bool, err error) {
 	if len(bz) == 0 {
 		return hasUnknownNonCriticals, nil
 	}
 
+	if limits.MaxDepth > 0 && depth > limits.MaxDepth {
+		return hasUnknownNonCriticals, fmt.Errorf("%w: %T is nested at depth %d, max %d",
+			ErrLimitExceeded, msg, depth, limits.MaxDepth)
+	}
+
 	desc, ok := msg.(descriptorIface)
 	if !ok {
 		return hasUnknownNonCriticals, fmt.Errorf("%T does not have a Descriptor() method", msg)
@@ -53,6 +85,9 @@ func RejectUnknownFields(bz []byte, msg proto.Message, allowUnknownNonCriticals
 		return hasUnknownNonCriticals, err
 	}
 
+	// repeatedFieldLengths counts the elements of the repeated fields, if limited.
+	var repeatedFieldLengths map[protowire.Number]int
+
 	for len(bz) > 0 {
 		tagNum, wireType, m := protowire.ConsumeTag(bz)
 		if m < 0 {
@@ -100,6 +135,17 @@ func RejectUnknownFields(bz []byte, msg proto.Message, allowUnknownNonCriticals
 		fieldBytes := bz[:n]
 		bz = bz[n:]
 
+		if limits.MaxRepeatedFieldLength > 0 && fieldDescProto != nil && fieldDescProto.IsRepeated() {
+			if repeatedFieldLengths == nil {
+				repeatedFieldLengths = make(map[protowire.Number]int)
+			}
+			repeatedFieldLengths[tagNum] += repeatedFieldElements(fieldDescProto, wireType, fieldBytes)
+			if length := repeatedFieldLengths[tagNum]; length > limits.MaxRepeatedFieldLength {
+				return hasUnknownNonCriticals, fmt.Errorf("%w: repeated field %d of %T has more than %d elements",
+					ErrLimitExceeded, tagNum, msg, limits.MaxRepeatedFieldLength)
+			}
+		}
+
 		// An unknown but non-critical field or just a scalar type (aka *INT and BYTES like).
 		if fieldDescProto == nil || fieldDescProto.IsScalar() {
 			continue
@@ -124,12 +170,19 @@ func RejectUnknownFields(bz []byte, msg proto.Message, allowUnknownNonCriticals
 		_, o := protowire.ConsumeVarint(fieldBytes)
 		fieldBytes = fieldBytes[o:]
 
-		var msg proto.Message
+		var childMsg proto.Message
 		var err error
 
+		childAnyDepth := anyDepth
 		if protoMessageName == ".google.protobuf.Any" {
+			childAnyDepth++
+			if limits.MaxAnyDepth > 0 && childAnyDepth > limits.MaxAnyDepth {
+				return hasUnknownNonCriticals, fmt.Errorf("%w: google.protobuf.Any nested at depth %d in %T, max %d",
+					ErrLimitExceeded, childAnyDepth, msg, limits.MaxAnyDepth)
+			}
+
 			// Firstly typecheck types.Any to ensure nothing snuck in.
-			hasUnknownNonCriticalsChild, err := RejectUnknownFields(fieldBytes, (*types.Any)(nil), allowUnknownNonCriticals, resolver)
+			hasUnknownNonCriticalsChild, err := rejectUnknownFields(fieldBytes, (*types.Any)(nil), allowUnknownNonCriticals, resolver, limits, depth+1, anyDepth)
 			hasUnknownNonCriticals = hasUnknownNonCriticals || hasUnknownNonCriticalsChild
 			if err != nil {
 				return hasUnknownNonCriticals, err
@@ -141,18 +194,18 @@ func RejectUnknownFields(bz []byte, msg proto.Message, allowUnknownNonCriticals
 			}
 			protoMessageName = any.TypeUrl
 			fieldBytes = any.Value
-			msg, err = resolver.Resolve(protoMessageName)
+			childMsg, err = resolver.Resolve(protoMessageName)
 			if err != nil {
 				return hasUnknownNonCriticals, err
 			}
 		} else {
-			msg, err = protoMessageForTypeName(protoMessageName[1:])
+			childMsg, err = protoMessageForTypeName(protoMessageName[1:])
 			if err != nil {
 				return hasUnknownNonCriticals, err
 			}
 		}
 
-		hasUnknownNonCriticalsChild, err := RejectUnknownFields(fieldBytes, msg, allowUnknownNonCriticals, resolver)
+		hasUnknownNonCriticalsChild, err := rejectUnknownFields(fieldBytes, childMsg, allowUnknownNonCriticals, resolver, limits, depth+1, childAnyDepth)
 		hasUnknownNonCriticals = hasUnknownNonCriticals || hasUnknownNonCriticalsChild
 		if err != nil {
 			return hasUnknownNonCriticals, err
@@ -162,6 +215,35 @@ func RejectUnknownFields(bz []byte, msg proto.Message, allowUnknownNonCriticals
 	return hasUnknownNonCriticals, nil
 }
 
+// repeatedFieldElements returns the number of elements of a repeated field encoded in fieldBytes,
+// which is more than one only for the packed repeated fields of numeric types.
+func repeatedFieldElements(fieldDescProto *descriptor.FieldDescriptorProto, wireType protowire.Type, fieldBytes []byte) int {
+	if wireType != protowire.BytesType || !fieldDescProto.IsScalar() {
+		return 1
+	}
+
+	packed, n := protowire.ConsumeBytes(fieldBytes)
+	if n < 0 {
+		return 1
+	}
+
+	switch fieldDescProto.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_FIXED32, descriptor.FieldDescriptorProto_TYPE_SFIXED32, descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return len(packed) / 4
+	case descriptor.FieldDescriptorProto_TYPE_FIXED64, descriptor.FieldDescriptorProto_TYPE_SFIXED64, descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		return len(packed) / 8
+	default:
+		// each varint ends with a byte without its most significant bit set
+		var elements int
+		for _, b := range packed {
+			if b < 0x80 {
+				elements++
+			}
+		}
+		return elements
+	}
+}
+
 var protoMessageForTypeNameMu sync.RWMutex
 var protoMessageForTypeNameCache = make(map[string]proto.Message)
 
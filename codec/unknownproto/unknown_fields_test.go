@@ -664,6 +664,77 @@ func TestPackedEncoding(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRejectUnknownFieldsWithLimits(t *testing.T) {
+	nestedAnys := func(depth int) *testdata.TestVersion1 {
+		msg := &testdata.TestVersion1{X: 1}
+		for i := 0; i < depth; i++ {
+			any, err := types.NewAnyWithValue(msg)
+			require.NoError(t, err)
+			msg = &testdata.TestVersion1{G: any}
+		}
+		return msg
+	}
+	nestedMsgs := func(depth int) *testdata.TestVersion1 {
+		msg := &testdata.TestVersion1{X: 1}
+		for i := 1; i < depth; i++ {
+			msg = &testdata.TestVersion1{A: msg}
+		}
+		return msg
+	}
+
+	tests := []struct {
+		name    string
+		in      proto.Message
+		recv    proto.Message
+		limits  Limits
+		wantErr bool
+	}{
+		{"unlimited", nestedAnys(10), new(testdata.TestVersion1), Limits{}, false},
+		{"depth within the limit", nestedMsgs(5), new(testdata.TestVersion1), Limits{MaxDepth: 5}, false},
+		{"depth exceeding the limit", nestedMsgs(6), new(testdata.TestVersion1), Limits{MaxDepth: 5}, true},
+		{"any depth within the limit", nestedAnys(3), new(testdata.TestVersion1), Limits{MaxAnyDepth: 3}, false},
+		{"any depth exceeding the limit", nestedAnys(4), new(testdata.TestVersion1), Limits{MaxAnyDepth: 3}, true},
+		{"anys counted in the depth", nestedAnys(3), new(testdata.TestVersion1), Limits{MaxDepth: 3}, true},
+		{
+			"repeated field within the limit",
+			&testdata.TestVersion1{C: []*testdata.TestVersion1{{X: 1}, {X: 2}}, H: []*testdata.TestVersion1{{X: 3}, {X: 4}}},
+			new(testdata.TestVersion1), Limits{MaxRepeatedFieldLength: 2}, false,
+		},
+		{
+			"repeated field exceeding the limit",
+			&testdata.TestVersion1{C: []*testdata.TestVersion1{{X: 1}, {X: 2}, {X: 3}}},
+			new(testdata.TestVersion1), Limits{MaxRepeatedFieldLength: 2}, true,
+		},
+		{
+			"packed repeated field within the limit",
+			&testdata.TestRepeatedUints{Nums: []uint64{1, 1 << 20, 1 << 40}},
+			new(testdata.TestRepeatedUints), Limits{MaxRepeatedFieldLength: 3}, false,
+		},
+		{
+			"packed repeated field exceeding the limit",
+			&testdata.TestRepeatedUints{Nums: []uint64{1, 1 << 20, 1 << 40, 2}},
+			new(testdata.TestRepeatedUints), Limits{MaxRepeatedFieldLength: 3}, true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RejectUnknownFieldsWithLimits(mustMarshal(tt.in), tt.recv, false, DefaultAnyResolver{}, tt.limits)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrLimitExceeded)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// the error names the message holding the Any nested too deep
+	_, err := RejectUnknownFieldsWithLimits(mustMarshal(nestedAnys(4)), new(testdata.TestVersion1), false, DefaultAnyResolver{}, Limits{MaxAnyDepth: 3})
+	require.ErrorIs(t, err, ErrLimitExceeded)
+	require.Contains(t, err.Error(), "in *testdata.TestVersion1,")
+}
+
 func mustMarshal(msg proto.Message) []byte {
 	blob, err := proto.Marshal(msg)
 	if err != nil {
@@ -35,6 +35,10 @@
 // 			v0.38.1. It stores the keyring encrypted within the app's configuration directory.
 // 			This keyring will request a password each time it is accessed, which may occur
 // 			multiple times in a single command resulting in repeated password prompts.
+// 			The keys are encrypted with AES-256-GCM, the encryption key being derived from
+// 			the password with Argon2id, whose parameters can be set with the
+// 			FileArgon2Params option. The keys written by previous versions, or derived
+// 			with other parameters, are re-encrypted when the keyring is first unlocked.
 // 	kwallet	This backend uses KDE Wallet Manager as a credentials management application:
 // 			https://github.com/KDE/kwallet
 // 	pass	This backend uses the pass command line utility to store and retrieve keys:
@@ -0,0 +1,393 @@
+package keyring
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/99designs/keyring"
+	jose "github.com/dvsekhvalnov/jose2go"
+	"github.com/mtibben/percent"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	// fileRecordVersion is the version of the format of the records written by
+	// the file backend. Version 1 is the JWE format of
+	// github.com/99designs/keyring, encrypted with a key derived from the
+	// passphrase with fixed PBKDF2 parameters.
+	fileRecordVersion = 2
+
+	fileRecordKDF       = "argon2id"
+	fileRecordSaltLen   = 16
+	fileRecordKeyLen    = 32
+	fileRecordTmpSuffix = ".tmp"
+)
+
+// Argon2Params defines the parameters of the Argon2id key derivation of the
+// file backend, deriving the encryption key of the records from the keyring
+// passphrase.
+type Argon2Params struct {
+	// Time is the number of passes over the memory.
+	Time uint32 `json:"time"`
+	// Memory is the size of the memory in KiB.
+	Memory uint32 `json:"memory"`
+	// Threads is the number of threads, or lanes.
+	Threads uint8 `json:"threads"`
+}
+
+// DefaultArgon2Params returns the default Argon2id parameters of the file
+// backend, following the second recommended option of RFC 9106.
+func DefaultArgon2Params() Argon2Params {
+	return Argon2Params{
+		Time:    3,
+		Memory:  64 * 1024,
+		Threads: 4,
+	}
+}
+
+// Validate checks that the Argon2id parameters are usable.
+func (p Argon2Params) Validate() error {
+	if p.Time == 0 {
+		return fmt.Errorf("argon2 time must be positive")
+	}
+
+	if p.Threads == 0 {
+		return fmt.Errorf("argon2 threads must be positive")
+	}
+
+	if p.Memory < 8*uint32(p.Threads) {
+		return fmt.Errorf("argon2 memory must be at least 8 KiB per thread, got %d KiB for %d threads", p.Memory, p.Threads)
+	}
+
+	return nil
+}
+
+// fileRecord is a record of the file backend in the version 2 format: the JSON
+// encoded keyring item encrypted with AES-256-GCM, the key being derived from
+// the passphrase with Argon2id and the item key being authenticated.
+type fileRecord struct {
+	Version    int          `json:"version"`
+	KDF        string       `json:"kdf"`
+	KDFParams  Argon2Params `json:"kdf_params"`
+	Salt       []byte       `json:"salt"`
+	Nonce      []byte       `json:"nonce"`
+	Ciphertext []byte       `json:"ciphertext"`
+}
+
+// fileKeyring is the file backend, storing each item in its own encrypted file.
+// It reads the records of both versions and writes the version 2 ones, and on
+// its first unlock re-encrypts the version 1 records and the records derived
+// with other Argon2id parameters than the configured ones.
+type fileKeyring struct {
+	dir          string
+	passwordFunc keyring.PromptFunc
+	params       Argon2Params
+
+	password string
+	// salt is the salt of the records written by this instance, generated
+	// once so that they share their derived key
+	salt []byte
+	// derivedKeys caches the derived keys by salt and parameters
+	derivedKeys map[string][]byte
+}
+
+var _ keyring.Keyring = &fileKeyring{}
+
+func newFileKeyring(cfg keyring.Config, params Argon2Params) (*fileKeyring, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if cfg.FileDir == "" {
+		return nil, fmt.Errorf("no directory provided for file keyring")
+	}
+
+	return &fileKeyring{
+		dir:          cfg.FileDir,
+		passwordFunc: cfg.FilePasswordFunc,
+		params:       params,
+		derivedKeys:  make(map[string][]byte),
+	}, nil
+}
+
+// Get implements keyring.Keyring.
+func (k *fileKeyring) Get(key string) (keyring.Item, error) {
+	filename, err := k.filename(key)
+	if err != nil {
+		return keyring.Item{}, err
+	}
+
+	if _, err := os.Stat(filename); os.IsNotExist(err) {
+		return keyring.Item{}, keyring.ErrKeyNotFound
+	}
+
+	if err := k.unlock(); err != nil {
+		return keyring.Item{}, err
+	}
+
+	item, _, err := k.read(key, filename)
+	return item, err
+}
+
+// GetMetadata implements keyring.Keyring. All the data of the items being
+// encrypted, only the modification time is returned.
+func (k *fileKeyring) GetMetadata(key string) (keyring.Metadata, error) {
+	filename, err := k.filename(key)
+	if err != nil {
+		return keyring.Metadata{}, err
+	}
+
+	stat, err := os.Stat(filename)
+	if os.IsNotExist(err) {
+		return keyring.Metadata{}, keyring.ErrKeyNotFound
+	} else if err != nil {
+		return keyring.Metadata{}, err
+	}
+
+	return keyring.Metadata{ModificationTime: stat.ModTime()}, nil
+}
+
+// Set implements keyring.Keyring.
+func (k *fileKeyring) Set(item keyring.Item) error {
+	if err := k.unlock(); err != nil {
+		return err
+	}
+
+	filename, err := k.filename(item.Key)
+	if err != nil {
+		return err
+	}
+
+	return k.write(item, filename)
+}
+
+// Remove implements keyring.Keyring.
+func (k *fileKeyring) Remove(key string) error {
+	filename, err := k.filename(key)
+	if err != nil {
+		return err
+	}
+
+	return os.Remove(filename)
+}
+
+// Keys implements keyring.Keyring.
+func (k *fileKeyring) Keys() ([]string, error) {
+	dir, err := k.resolveDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasSuffix(entry.Name(), fileRecordTmpSuffix) {
+			continue
+		}
+
+		keys = append(keys, percent.Decode(entry.Name()))
+	}
+
+	return keys, nil
+}
+
+// unlock prompts for the passphrase if not done yet, and then re-encrypts the
+// outdated records.
+func (k *fileKeyring) unlock() error {
+	if k.password != "" {
+		return nil
+	}
+
+	dir, err := k.resolveDir()
+	if err != nil {
+		return err
+	}
+
+	password, err := k.passwordFunc(fmt.Sprintf("Enter passphrase to unlock %s", dir))
+	if err != nil {
+		return err
+	}
+	k.password = password
+
+	return k.upgrade()
+}
+
+// upgrade re-encrypts the version 1 records, and the version 2 records derived
+// with other parameters than the configured ones. The files which aren't
+// records, e.g. the passphrase hash, are left untouched.
+func (k *fileKeyring) upgrade() error {
+	keys, err := k.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		filename, err := k.filename(key)
+		if err != nil {
+			return err
+		}
+
+		item, upToDate, err := k.read(key, filename)
+		if err != nil || upToDate {
+			continue
+		}
+
+		if err := k.write(item, filename); err != nil {
+			return fmt.Errorf("failed to re-encrypt %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// read decrypts the record of key stored in filename, of either version, and
+// returns whether it is a version 2 record derived with the configured
+// parameters.
+func (k *fileKeyring) read(key, filename string) (keyring.Item, bool, error) {
+	bz, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return keyring.Item{}, false, keyring.ErrKeyNotFound
+	} else if err != nil {
+		return keyring.Item{}, false, err
+	}
+
+	var record fileRecord
+	if err := json.Unmarshal(bz, &record); err != nil || record.Version != fileRecordVersion {
+		// version 1 record
+		payload, _, err := jose.Decode(string(bz), k.password)
+		if err != nil {
+			return keyring.Item{}, false, err
+		}
+
+		var item keyring.Item
+		err = json.Unmarshal([]byte(payload), &item)
+		return item, false, err
+	}
+
+	if record.KDF != fileRecordKDF {
+		return keyring.Item{}, false, fmt.Errorf("unsupported key derivation function %s", record.KDF)
+	}
+
+	aead, err := k.aead(record.Salt, record.KDFParams)
+	if err != nil {
+		return keyring.Item{}, false, err
+	}
+
+	if len(record.Nonce) != aead.NonceSize() {
+		return keyring.Item{}, false, fmt.Errorf("invalid nonce length %d", len(record.Nonce))
+	}
+
+	payload, err := aead.Open(nil, record.Nonce, record.Ciphertext, []byte(key))
+	if err != nil {
+		return keyring.Item{}, false, fmt.Errorf("failed to decrypt %s: %w", key, err)
+	}
+
+	var item keyring.Item
+	if err := json.Unmarshal(payload, &item); err != nil {
+		return keyring.Item{}, false, err
+	}
+
+	return item, record.KDFParams == k.params, nil
+}
+
+// write encrypts item in a version 2 record with the configured parameters,
+// and atomically replaces filename with it.
+func (k *fileKeyring) write(item keyring.Item, filename string) error {
+	payload, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	if k.salt == nil {
+		k.salt = tmcrypto.CRandBytes(fileRecordSaltLen)
+	}
+
+	aead, err := k.aead(k.salt, k.params)
+	if err != nil {
+		return err
+	}
+
+	nonce := tmcrypto.CRandBytes(aead.NonceSize())
+	bz, err := json.Marshal(fileRecord{
+		Version:    fileRecordVersion,
+		KDF:        fileRecordKDF,
+		KDFParams:  k.params,
+		Salt:       k.salt,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, payload, []byte(item.Key)),
+	})
+	if err != nil {
+		return err
+	}
+
+	tmp := filename + fileRecordTmpSuffix
+	if err := os.WriteFile(tmp, bz, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, filename)
+}
+
+// aead returns the AES-256-GCM cipher keyed with the key derived from the
+// passphrase with the given salt and parameters.
+func (k *fileKeyring) aead(salt []byte, params Argon2Params) (cipher.AEAD, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	cacheKey := fmt.Sprintf("%x/%d/%d/%d", salt, params.Time, params.Memory, params.Threads)
+	key, ok := k.derivedKeys[cacheKey]
+	if !ok {
+		key = argon2.IDKey([]byte(k.password), salt, params.Time, params.Memory, params.Threads, fileRecordKeyLen)
+		k.derivedKeys[cacheKey] = key
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func (k *fileKeyring) resolveDir() (string, error) {
+	dir := k.dir
+
+	// expand tilde for home directory
+	if strings.HasPrefix(dir, "~") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = strings.Replace(dir, "~", home, 1)
+	}
+
+	stat, err := os.Stat(dir)
+	switch {
+	case os.IsNotExist(err):
+		err = os.MkdirAll(dir, 0o700)
+	case err == nil && !stat.IsDir():
+		err = fmt.Errorf("%s is a file, not a directory", dir)
+	}
+
+	return dir, err
+}
+
+func (k *fileKeyring) filename(key string) (string, error) {
+	dir, err := k.resolveDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, percent.Encode(key, "/")), nil
+}
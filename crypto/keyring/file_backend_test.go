@@ -0,0 +1,166 @@
+package keyring
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/99designs/keyring"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// testArgon2Params are cheap Argon2id parameters for the tests.
+var testArgon2Params = Argon2Params{Time: 1, Memory: 64, Threads: 1}
+
+func newTestFileKeyring(t *testing.T, dir, password string, params Argon2Params) *fileKeyring {
+	kr, err := newFileKeyring(keyring.Config{
+		FileDir:          dir,
+		FilePasswordFunc: func(string) (string, error) { return password, nil },
+	}, params)
+	require.NoError(t, err)
+
+	return kr
+}
+
+func readFileRecord(t *testing.T, dir, key string) fileRecord {
+	bz, err := os.ReadFile(filepath.Join(dir, key))
+	require.NoError(t, err)
+
+	var record fileRecord
+	require.NoError(t, json.Unmarshal(bz, &record))
+
+	return record
+}
+
+func TestFileKeyring(t *testing.T) {
+	dir := t.TempDir()
+	kr := newTestFileKeyring(t, dir, "password", testArgon2Params)
+
+	item := keyring.Item{Key: "foo.info", Data: []byte("secret")}
+	require.NoError(t, kr.Set(item))
+	require.NoError(t, kr.Set(keyring.Item{Key: "bar.info", Data: []byte("other secret")}))
+
+	record := readFileRecord(t, dir, "foo.info")
+	require.Equal(t, fileRecordVersion, record.Version)
+	require.Equal(t, fileRecordKDF, record.KDF)
+	require.Equal(t, testArgon2Params, record.KDFParams)
+	require.NotContains(t, string(record.Ciphertext), "secret")
+
+	got, err := kr.Get("foo.info")
+	require.NoError(t, err)
+	require.Equal(t, item, got)
+
+	keys, err := kr.Keys()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"foo.info", "bar.info"}, keys)
+
+	_, err = kr.Get("baz.info")
+	require.ErrorIs(t, err, keyring.ErrKeyNotFound)
+
+	// a record can't be read with another passphrase, nor moved to another key
+	_, err = newTestFileKeyring(t, dir, "wrong", testArgon2Params).Get("foo.info")
+	require.Error(t, err)
+
+	require.NoError(t, os.Rename(filepath.Join(dir, "foo.info"), filepath.Join(dir, "baz.info")))
+	_, err = kr.Get("baz.info")
+	require.Error(t, err)
+
+	require.NoError(t, kr.Remove("bar.info"))
+	_, err = kr.Get("bar.info")
+	require.ErrorIs(t, err, keyring.ErrKeyNotFound)
+}
+
+func TestFileKeyringUpgrade(t *testing.T) {
+	dir := t.TempDir()
+
+	// version 1 records written by github.com/99designs/keyring, next to a file
+	// which isn't a record
+	v1, err := keyring.Open(keyring.Config{
+		AllowedBackends:  []keyring.BackendType{keyring.FileBackend},
+		FileDir:          dir,
+		FilePasswordFunc: func(string) (string, error) { return "password", nil },
+	})
+	require.NoError(t, err)
+	require.NoError(t, v1.Set(keyring.Item{Key: "foo.info", Data: []byte("foo")}))
+	require.NoError(t, v1.Set(keyring.Item{Key: "bar.info", Data: []byte("bar")}))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "keyhash"), []byte("hash"), 0o600))
+
+	// the version 1 records are re-encrypted on the first unlock
+	kr := newTestFileKeyring(t, dir, "password", testArgon2Params)
+	item, err := kr.Get("foo.info")
+	require.NoError(t, err)
+	require.Equal(t, []byte("foo"), item.Data)
+
+	for _, key := range []string{"foo.info", "bar.info"} {
+		require.Equal(t, testArgon2Params, readFileRecord(t, dir, key).KDFParams)
+	}
+
+	hash, err := os.ReadFile(filepath.Join(dir, "keyhash"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("hash"), hash)
+
+	// the records are re-encrypted with the new parameters once they change
+	newParams := Argon2Params{Time: 2, Memory: 128, Threads: 2}
+	kr = newTestFileKeyring(t, dir, "password", newParams)
+	item, err = kr.Get("bar.info")
+	require.NoError(t, err)
+	require.Equal(t, []byte("bar"), item.Data)
+
+	for _, key := range []string{"foo.info", "bar.info"} {
+		require.Equal(t, newParams, readFileRecord(t, dir, key).KDFParams)
+	}
+
+	keys, err := kr.Keys()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"foo.info", "bar.info", "keyhash"}, keys)
+}
+
+func TestFileKeyringArgon2ParamsOption(t *testing.T) {
+	dir := t.TempDir()
+	mockIn := strings.NewReader("password\npassword\n")
+
+	params := Argon2Params{Time: 1, Memory: 128, Threads: 1}
+	kr, err := New("cosmos", BackendFile, dir, mockIn, getCodec(), func(options *Options) {
+		options.FileArgon2Params = &params
+	})
+	require.NoError(t, err)
+
+	_, _, err = kr.NewMnemonic("foo", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	require.Equal(t, params, readFileRecord(t, filepath.Join(dir, keyringFileDirName), "foo.info").KDFParams)
+
+	invalid := Argon2Params{Time: 1, Memory: 4, Threads: 1}
+	_, err = New("cosmos", BackendFile, dir, mockIn, getCodec(), func(options *Options) {
+		options.FileArgon2Params = &invalid
+	})
+	require.Error(t, err)
+}
+
+func TestArgon2ParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		params    Argon2Params
+		expectErr bool
+	}{
+		{"default", DefaultArgon2Params(), false},
+		{"zero time", Argon2Params{Time: 0, Memory: 64, Threads: 1}, true},
+		{"zero threads", Argon2Params{Time: 1, Memory: 64, Threads: 0}, true},
+		{"too little memory", Argon2Params{Time: 1, Memory: 15, Threads: 2}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
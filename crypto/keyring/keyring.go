@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/go-bip39"
 )
 
@@ -114,6 +115,14 @@ type Signer interface {
 
 	// SignByAddress sign byte messages with a user key providing the address.
 	SignByAddress(address sdk.Address, msg []byte) ([]byte, types.PubKey, error)
+
+	// SignByAddressWithMode sign byte messages, encoded in the given sign mode,
+	// with a user key providing the address.
+	SignByAddressWithMode(address sdk.Address, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error)
+
+	// SignModesByAddress returns the sign modes a user key providing the address
+	// can sign in, by order of preference, or nil if it can sign in any sign mode.
+	SignModesByAddress(address sdk.Address) ([]signing.SignMode, error)
 }
 
 // Importer is implemented by key stores that support import of public and private keys.
@@ -158,6 +167,8 @@ type Options struct {
 	SupportedAlgos SigningAlgoList
 	// supported signing algorithms for Ledger
 	SupportedAlgosLedger SigningAlgoList
+	// Argon2id parameters of the file backend, DefaultArgon2Params if unset
+	FileArgon2Params *Argon2Params
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -184,7 +195,7 @@ func New(
 	case BackendTest:
 		db, err = keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
 	case BackendFile:
-		db, err = keyring.Open(newFileBackendKeyringConfig(appName, rootDir, userInput))
+		db, err = newFileKeyring(newFileBackendKeyringConfig(appName, rootDir, userInput), fileArgon2Params(opts...))
 	case BackendOS:
 		db, err = keyring.Open(newOSBackendKeyringConfig(appName, rootDir, userInput))
 	case BackendKWallet:
@@ -229,6 +240,21 @@ func newKeystore(kr keyring.Keyring, cdc codec.Codec, backend string, opts ...Op
 	}
 }
 
+// fileArgon2Params returns the Argon2id parameters of the file backend set by
+// the options.
+func fileArgon2Params(opts ...Option) Argon2Params {
+	var options Options
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	if options.FileArgon2Params == nil {
+		return DefaultArgon2Params()
+	}
+
+	return *options.FileArgon2Params
+}
+
 // Backend returns the keyring backend option used in the config
 func (ks keystore) Backend() string {
 	return ks.backend
@@ -345,6 +371,52 @@ func (ks keystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
 		return nil, nil, err
 	}
 
+	return ks.sign(k, msg, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+}
+
+func (ks keystore) SignByAddress(address sdk.Address, msg []byte) ([]byte, types.PubKey, error) {
+	k, err := ks.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ks.Sign(k.Name, msg)
+}
+
+func (ks keystore) SignByAddressWithMode(address sdk.Address, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	k, err := ks.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ks.sign(k, msg, signMode)
+}
+
+// SignModesByAddress returns the sign modes a user key providing the address
+// can sign in. Local keys sign in any sign mode while Ledger keys negotiate the
+// sign modes with the device: only newer device apps support SIGN_MODE_TEXTUAL.
+func (ks keystore) SignModesByAddress(address sdk.Address) ([]signing.SignMode, error) {
+	k, err := ks.KeyByAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case k.GetLocal() != nil:
+		return nil, nil
+
+	case k.GetLedger() != nil:
+		return ledger.SignModes()
+
+		// multi or offline record
+	default:
+		return nil, errors.New("cannot sign with offline keys")
+	}
+}
+
+// sign signs a message with a key. The sign mode the message is encoded in is
+// only relevant to Ledger keys, as the device app displays the message.
+func (ks keystore) sign(k *Record, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
 	switch {
 	case k.GetLocal() != nil:
 		priv, err := extractPrivKeyFromLocal(k.GetLocal())
@@ -360,7 +432,7 @@ func (ks keystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
 		return sig, priv.PubKey(), nil
 
 	case k.GetLedger() != nil:
-		return SignWithLedger(k, msg)
+		return signWithLedger(k, msg, signMode)
 
 		// multi or offline record
 	default:
@@ -373,15 +445,6 @@ func (ks keystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
 	}
 }
 
-func (ks keystore) SignByAddress(address sdk.Address, msg []byte) ([]byte, types.PubKey, error) {
-	k, err := ks.KeyByAddress(address)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return ks.Sign(k.Name, msg)
-}
-
 func (ks keystore) SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coinType, account, index uint32) (*Record, error) {
 	if !ks.options.SupportedAlgosLedger.Contains(algo) {
 		return nil, fmt.Errorf(
@@ -611,10 +674,14 @@ func (ks keystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
 	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
 }
 
-// SignWithLedger signs a binary message with the ledger device referenced by an Info object
+// SignWithLedger signs an amino JSON message with the ledger device referenced by an Info object
 // and returns the signed bytes and the public key. It returns an error if the device could
 // not be queried or it returned an error.
 func SignWithLedger(k *Record, msg []byte) (sig []byte, pub types.PubKey, err error) {
+	return signWithLedger(k, msg, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+}
+
+func signWithLedger(k *Record, msg []byte, signMode signing.SignMode) (sig []byte, pub types.PubKey, err error) {
 	ledgerInfo := k.GetLedger()
 	if ledgerInfo == nil {
 		return nil, nil, errors.New("not a ledger object")
@@ -627,7 +694,12 @@ func SignWithLedger(k *Record, msg []byte) (sig []byte, pub types.PubKey, err er
 		return
 	}
 
-	sig, err = priv.Sign(msg)
+	ledgerPriv, ok := priv.(ledger.PrivKeyLedgerSecp256k1)
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected ledger key type %T", priv)
+	}
+
+	sig, err = ledgerPriv.SignWithMode(msg, signMode)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 func TestInMemoryCreateLedger(t *testing.T) {
@@ -92,6 +93,34 @@ func TestSignVerifyKeyRingWithLedger(t *testing.T) {
 	require.Equal(t, "not a ledger object", err.Error())
 }
 
+func TestSignByAddressWithModeWithLedger(t *testing.T) {
+	kb := NewInMemory(getCodec())
+
+	k, err := kb.SaveLedgerKey("key", hd.Secp256k1, "cosmos", 118, 0, 0)
+	if err != nil {
+		require.Equal(t, "ledger nano S: support for ledger devices is not available in this executable", err.Error())
+		t.Skip("ledger nano S: support for ledger devices is not available in this executable")
+		return
+	}
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+
+	// the mock device app supports SIGN_MODE_TEXTUAL
+	signModes, err := kb.SignModesByAddress(addr)
+	require.NoError(t, err)
+	require.Equal(t, []signing.SignMode{signing.SignMode_SIGN_MODE_TEXTUAL, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}, signModes)
+
+	msg := []byte("my first message")
+	for _, signMode := range signModes {
+		sig, pub, err := kb.SignByAddressWithMode(addr, msg, signMode)
+		require.NoError(t, err)
+		require.True(t, pub.VerifySignature(msg, sig))
+	}
+
+	_, _, err = kb.SignByAddressWithMode(addr, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.Error(t, err)
+}
+
 func TestAltKeyring_SaveLedgerKey(t *testing.T) {
 	dir := t.TempDir()
 	cdc := getCodec()
@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 const (
@@ -1172,6 +1173,38 @@ func TestAltKeyring_SignByAddress(t *testing.T) {
 	require.True(t, key.VerifySignature(msg, sign))
 }
 
+func TestAltKeyring_SignByAddressWithMode(t *testing.T) {
+	cdc := getCodec()
+	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	mnemonic, _, err := kr.NewMnemonic("jack", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := mnemonic.GetAddress()
+	require.NoError(t, err)
+
+	// local keys sign in any sign mode
+	signModes, err := kr.SignModesByAddress(addr)
+	require.NoError(t, err)
+	require.Nil(t, signModes)
+
+	msg := []byte("some message")
+	sign, key, err := kr.SignByAddressWithMode(addr, msg, signing.SignMode_SIGN_MODE_TEXTUAL)
+	require.NoError(t, err)
+	require.True(t, key.VerifySignature(msg, sign))
+
+	// offline keys can't sign
+	offline, err := kr.SaveOfflineKey("offline", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	addr, err = offline.GetAddress()
+	require.NoError(t, err)
+
+	_, err = kr.SignModesByAddress(addr)
+	require.Error(t, err)
+	_, _, err = kr.SignByAddressWithMode(addr, msg, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	require.Error(t, err)
+}
+
 func TestAltKeyring_ImportExportPrivKey(t *testing.T) {
 	cdc := getCodec()
 	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
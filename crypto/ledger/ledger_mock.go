@@ -111,6 +111,11 @@ func (mock LedgerSECP256K1Mock) SignSECP256K1(derivationPath []uint32, message [
 	return sig2.Serialize(), nil
 }
 
+// SignSECP256K1Textual mocks a ledger device app supporting SIGN_MODE_TEXTUAL
+func (mock LedgerSECP256K1Mock) SignSECP256K1Textual(derivationPath []uint32, message []byte) ([]byte, error) {
+	return mock.SignSECP256K1(derivationPath, message)
+}
+
 // ShowAddressSECP256K1 shows the address for the corresponding bip32 derivation path
 func (mock LedgerSECP256K1Mock) ShowAddressSECP256K1(bip32Path []uint32, hrp string) error {
 	fmt.Printf("Request to show address for %v at %v", hrp, bip32Path)
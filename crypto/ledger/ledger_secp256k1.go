@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 var (
@@ -37,6 +38,15 @@ type (
 		SignSECP256K1([]uint32, []byte) ([]byte, error)
 	}
 
+	// SECP256K1Textual reflects an interface a Ledger API must implement, on top
+	// of SECP256K1, for device apps supporting SIGN_MODE_TEXTUAL. Older device
+	// apps only support amino JSON.
+	SECP256K1Textual interface {
+		SECP256K1
+		// Signs a SIGN_MODE_TEXTUAL payload (requires user confirmation)
+		SignSECP256K1Textual([]uint32, []byte) ([]byte, error)
+	}
+
 	// PrivKeyLedgerSecp256k1 implements PrivKey, calling the ledger nano we
 	// cache the PubKey from the first call to use it later.
 	PrivKeyLedgerSecp256k1 struct {
@@ -90,15 +100,35 @@ func (pkl PrivKeyLedgerSecp256k1) PubKey() types.PubKey {
 	return pkl.CachedPubKey
 }
 
-// Sign returns a secp256k1 signature for the corresponding message
+// Sign returns a secp256k1 signature for the corresponding amino JSON message
 func (pkl PrivKeyLedgerSecp256k1) Sign(message []byte) ([]byte, error) {
+	return pkl.SignWithMode(message, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+}
+
+// SignWithMode returns a secp256k1 signature for the corresponding message,
+// encoded in the given sign mode. It returns an error if the sign mode is not
+// supported by the device app, see SignModes.
+func (pkl PrivKeyLedgerSecp256k1) SignWithMode(message []byte, signMode signing.SignMode) ([]byte, error) {
 	device, err := getDevice()
 	if err != nil {
 		return nil, err
 	}
 	defer warnIfErrors(device.Close)
 
-	return sign(device, pkl, message)
+	return sign(device, pkl, message, signMode)
+}
+
+// SignModes returns the sign modes supported by the app of the connected Ledger
+// device, by order of preference. Newer device apps support SIGN_MODE_TEXTUAL,
+// older ones fall back to SIGN_MODE_LEGACY_AMINO_JSON.
+func SignModes() ([]signing.SignMode, error) {
+	device, err := getDevice()
+	if err != nil {
+		return nil, err
+	}
+	defer warnIfErrors(device.Close)
+
+	return signModes(device), nil
 }
 
 // ShowAddress triggers a ledger device to show the corresponding address.
@@ -212,13 +242,27 @@ func validateKey(device SECP256K1, pkl PrivKeyLedgerSecp256k1) error {
 // Communication is checked on NewPrivKeyLedger and PrivKeyFromBytes, returning
 // an error, so this should only trigger if the private key is held in memory
 // for a while before use.
-func sign(device SECP256K1, pkl PrivKeyLedgerSecp256k1, msg []byte) ([]byte, error) {
+func sign(device SECP256K1, pkl PrivKeyLedgerSecp256k1, msg []byte, signMode signing.SignMode) ([]byte, error) {
 	err := validateKey(device, pkl)
 	if err != nil {
 		return nil, err
 	}
 
-	sig, err := device.SignSECP256K1(pkl.Path.DerivationPath(), msg)
+	var sig []byte
+	switch signMode {
+	case signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
+		sig, err = device.SignSECP256K1(pkl.Path.DerivationPath(), msg)
+
+	case signing.SignMode_SIGN_MODE_TEXTUAL:
+		textualDevice, ok := device.(SECP256K1Textual)
+		if !ok {
+			return nil, fmt.Errorf("sign mode %s not supported by the Ledger device app, please update it", signMode)
+		}
+		sig, err = textualDevice.SignSECP256K1Textual(pkl.Path.DerivationPath(), msg)
+
+	default:
+		return nil, fmt.Errorf("sign mode %s not supported by Ledger", signMode)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -226,6 +270,16 @@ func sign(device SECP256K1, pkl PrivKeyLedgerSecp256k1, msg []byte) ([]byte, err
 	return convertDERtoBER(sig)
 }
 
+// signModes returns the sign modes supported by the app of a ledger device, by
+// order of preference.
+func signModes(device SECP256K1) []signing.SignMode {
+	if _, ok := device.(SECP256K1Textual); ok {
+		return []signing.SignMode{signing.SignMode_SIGN_MODE_TEXTUAL, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}
+	}
+
+	return []signing.SignMode{signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}
+}
+
 // getPubKeyUnsafe reads the pubkey from a ledger device
 //
 // This function is marked as unsafe as it will retrieve a pubkey without user verification
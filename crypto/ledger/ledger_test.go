@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 func TestErrorHandling(t *testing.T) {
@@ -207,6 +208,47 @@ func TestSignaturesHD(t *testing.T) {
 	}
 }
 
+func TestSignModes(t *testing.T) {
+	msg := getFakeTx(0)
+	path := *hd.NewFundraiserParams(0, sdk.CoinType, 0)
+	priv, err := NewPrivKeySecp256k1Unsafe(path)
+	require.NoError(t, err)
+	pkl := priv.(PrivKeyLedgerSecp256k1)
+
+	signModes, err := SignModes()
+	require.NoError(t, err)
+	require.Equal(t, []signing.SignMode{signing.SignMode_SIGN_MODE_TEXTUAL, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}, signModes)
+
+	sig, err := pkl.SignWithMode(msg, signing.SignMode_SIGN_MODE_TEXTUAL)
+	require.NoError(t, err)
+	require.True(t, pkl.PubKey().VerifySignature(msg, sig))
+
+	_, err = pkl.SignWithMode(msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.Error(t, err)
+
+	// older device apps, not supporting SIGN_MODE_TEXTUAL, fall back to amino JSON
+	discover := discoverLedger
+	t.Cleanup(func() { discoverLedger = discover })
+	discoverLedger = func() (SECP256K1, error) {
+		device, err := discover()
+		if err != nil {
+			return nil, err
+		}
+		return struct{ SECP256K1 }{device}, nil
+	}
+
+	signModes, err = SignModes()
+	require.NoError(t, err)
+	require.Equal(t, []signing.SignMode{signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}, signModes)
+
+	_, err = pkl.SignWithMode(msg, signing.SignMode_SIGN_MODE_TEXTUAL)
+	require.Error(t, err)
+
+	sig, err = pkl.SignWithMode(msg, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	require.NoError(t, err)
+	require.True(t, pkl.PubKey().VerifySignature(msg, sig))
+}
+
 func TestRealDeviceSecp256k1(t *testing.T) {
 	msg := getFakeTx(50)
 	path := *hd.NewFundraiserParams(0, sdk.CoinType, 0)
@@ -0,0 +1,11 @@
+#!/bin/bash -eu
+
+# oss-fuzz-build.sh builds the native Go fuzz targets of fuzz/tests with the
+# OSS-Fuzz toolchain. Every FuzzXxx function of the package is built as a fuzzer
+# named after it, so new targets are picked up without changing this script.
+
+cd "$(dirname "$0")/.."
+
+for target in $(go test -list '^Fuzz' ./fuzz/tests | grep '^Fuzz'); do
+  compile_native_go_fuzzer github.com/cosmos/cosmos-sdk/fuzz/tests "$target" "$target"
+done
@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+var addressSeeds = []string{
+	"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+	"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xx",
+	"COSMOS1QYPQXPQ9QCRSSZG2PVXQ6RS0ZQG3YYC5LZV7XU",
+	"cosmosvaloper1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5ew6jg8",
+	"cosmos1",
+	"",
+}
+
+func FuzzAccAddressFromBech32(f *testing.F) {
+	for _, s := range addressSeeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		addr, err := sdk.AccAddressFromBech32(s)
+		if err != nil {
+			return
+		}
+
+		addr2, err := sdk.AccAddressFromBech32(addr.String())
+		if err != nil {
+			t.Fatalf("%q decoded as %s, which is not decoded: %v", s, addr, err)
+		}
+		if !addr.Equals(addr2) {
+			t.Fatalf("%q decoded as %s, which is decoded as %s", s, addr, addr2)
+		}
+	})
+}
+
+func FuzzBech32DecodeAndConvert(f *testing.F) {
+	for _, s := range addressSeeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		hrp, bz, err := bech32.DecodeAndConvert(s)
+		if err != nil {
+			return
+		}
+
+		// the decoded bytes are encoded back to the input, whose case is
+		// ignored by bech32
+		encoded, err := bech32.ConvertAndEncode(hrp, bz)
+		if err != nil {
+			t.Fatalf("%q decoded as %s %X, which is not encoded: %v", s, hrp, bz, err)
+		}
+		if !strings.EqualFold(encoded, s) {
+			t.Fatalf("%q decoded as %s %X, which is encoded as %q", s, hrp, bz, encoded)
+		}
+		_, bz2, err := bech32.DecodeAndConvert(encoded)
+		if err != nil || !bytes.Equal(bz, bz2) {
+			t.Fatalf("%q encoded as %q, which is decoded as %X: %v", s, encoded, bz2, err)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var coinSeeds = []string{"", "1stake", "10stake,5atom", "1.5stake", "0.000000000000000001stake", "10ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", "1 stake", "-1stake"}
+
+func FuzzParseCoinsNormalized(f *testing.F) {
+	for _, s := range coinSeeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		coins, err := sdk.ParseCoinsNormalized(s)
+		if err != nil {
+			return
+		}
+
+		if !coins.IsValid() && !coins.Empty() {
+			t.Fatalf("%q parsed as invalid coins %s", s, coins)
+		}
+		coins2, err := sdk.ParseCoinsNormalized(coins.String())
+		if err != nil {
+			t.Fatalf("%q parsed as %s, which is not parsed: %v", s, coins, err)
+		}
+		if !coins.IsEqual(coins2) {
+			t.Fatalf("%q parsed as %s, which is parsed as %s", s, coins, coins2)
+		}
+	})
+}
+
+func FuzzParseDecCoins(f *testing.F) {
+	for _, s := range coinSeeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		coins, err := sdk.ParseDecCoins(s)
+		if err != nil {
+			return
+		}
+
+		coins2, err := sdk.ParseDecCoins(coins.String())
+		if err != nil {
+			t.Fatalf("%q parsed as %s, which is not parsed: %v", s, coins, err)
+		}
+		if !coins.IsEqual(coins2) {
+			t.Fatalf("%q parsed as %s, which is parsed as %s", s, coins, coins2)
+		}
+	})
+}
@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func FuzzNewDecFromStr(f *testing.F) {
+	for _, s := range []string{"0", "1", "-1", "0.5", ".5", "123.456", "-0.000000000000000001", "1.0000000000000000001", "1e10", ""} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		d, err := sdk.NewDecFromStr(s)
+		if err != nil {
+			return
+		}
+
+		// a parsed Dec is parsed back from its string representation
+		d2, err := sdk.NewDecFromStr(d.String())
+		if err != nil {
+			t.Fatalf("%q parsed as %s, which is not parsed: %v", s, d, err)
+		}
+		if !d.Equal(d2) {
+			t.Fatalf("%q parsed as %s, which is parsed as %s", s, d, d2)
+		}
+	})
+}
+
+func FuzzNewIntFromString(f *testing.F) {
+	for _, s := range []string{"0", "1", "-1", "+1", "115792089237316195423570985008687907853269984665640564039457584007913129639935", "0x10", ""} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		i, ok := sdk.NewIntFromString(s)
+		if !ok {
+			return
+		}
+
+		i2, ok := sdk.NewIntFromString(i.String())
+		if !ok {
+			t.Fatalf("%q parsed as %s, which is not parsed", s, i)
+		}
+		if !i.Equal(i2) {
+			t.Fatalf("%q parsed as %s, which is parsed as %s", s, i, i2)
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// seedTx returns a MsgSend tx signed by a random key, encoded with the proto
+// and JSON encoders of SimApp.
+func seedTx(f *testing.F) ([]byte, []byte) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	priv := secp256k1.GenPrivKey()
+	from := sdk.AccAddress(priv.PubKey().Address())
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))); err != nil {
+		f.Fatal(err)
+	}
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	txBuilder.SetGasLimit(200000)
+	txBuilder.SetMemo("memo")
+	err := txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: []byte("signature")},
+		Sequence: 1,
+	})
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	bz, err := encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		f.Fatal(err)
+	}
+	jsonBz, err := encCfg.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	return bz, jsonBz
+}
+
+func FuzzTxDecode(f *testing.F) {
+	bz, _ := seedTx(f)
+	f.Add(bz)
+	f.Add([]byte{})
+
+	encCfg := simapp.MakeTestEncodingConfig()
+	decode, encode := encCfg.TxConfig.TxDecoder(), encCfg.TxConfig.TxEncoder()
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		tx, err := decode(bz)
+		if err != nil {
+			return
+		}
+
+		// a decoded tx is encoded again, and its signers are resolved as done
+		// by the tx middlewares
+		if _, err := encode(tx); err != nil {
+			t.Fatalf("decoded tx not encoded: %v", err)
+		}
+		if sigTx, ok := tx.(authsigning.SigVerifiableTx); ok {
+			_, _ = sigTx.GetPubKeys()
+			_, _ = sigTx.GetSignaturesV2()
+		}
+	})
+}
+
+func FuzzTxJSONDecode(f *testing.F) {
+	_, jsonBz := seedTx(f)
+	f.Add(jsonBz)
+	f.Add([]byte("{}"))
+
+	encCfg := simapp.MakeTestEncodingConfig()
+	decode, encode := encCfg.TxConfig.TxJSONDecoder(), encCfg.TxConfig.TxJSONEncoder()
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		tx, err := decode(bz)
+		if err != nil {
+			return
+		}
+
+		if _, err := encode(tx); err != nil {
+			t.Fatalf("decoded tx not encoded: %v", err)
+		}
+	})
+}
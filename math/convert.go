@@ -0,0 +1,43 @@
+package math
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/apd/v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// legacyContext is used to round a Dec to LegacyPrecision decimal places. Its
+// precision fits any LegacyDec, larger values are rejected when parsed.
+var legacyContext = apd.Context{
+	Precision:   1000,
+	MaxExponent: apd.MaxExponent,
+	MinExponent: apd.MinExponent,
+	Traps:       apd.DefaultTraps,
+	Rounding:    apd.RoundHalfEven,
+}
+
+// LegacyDecToDec converts a LegacyDec to a Dec. The conversion is exact, the
+// trailing zeros of the LegacyDec are dropped.
+func LegacyDecToDec(d LegacyDec) Dec {
+	var res Dec
+	res.dec.Reduce(apd.NewWithBigInt(d.BigInt(), -LegacyPrecision))
+	return res
+}
+
+// DecToLegacyDec converts a Dec to a LegacyDec, rounding it half to even to
+// LegacyPrecision decimal places as the LegacyDec operations do. An error is
+// returned if the value is out of the LegacyDec range.
+func DecToLegacyDec(d Dec) (LegacyDec, error) {
+	var rounded apd.Decimal
+	if _, err := legacyContext.Quantize(&rounded, &d.dec, -LegacyPrecision); err != nil {
+		return LegacyDec{}, fmt.Errorf("decimal %s out of legacy decimal range: %w", d, err)
+	}
+
+	res, err := sdk.NewDecFromStr(rounded.Text('f'))
+	if err != nil {
+		return LegacyDec{}, fmt.Errorf("decimal %s out of legacy decimal range: %w", d, err)
+	}
+	return res, nil
+}
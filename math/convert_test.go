@@ -0,0 +1,155 @@
+package math
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestLegacyDecToDec(t *testing.T) {
+	testCases := []struct {
+		legacy string
+		exp    string
+	}{
+		{"0", "0"},
+		{"1", "1"},
+		{"-1", "-1"},
+		{"1.5", "1.5"},
+		{"0.000000000000000001", "0.000000000000000001"},
+		{"-123456789.123456789123456789", "-123456789.123456789123456789"},
+		{"100", "100"},
+	}
+
+	for _, tc := range testCases {
+		legacy := LegacyMustNewDecFromStr(tc.legacy)
+		d := LegacyDecToDec(legacy)
+		require.Equal(t, tc.exp, d.String(), tc.legacy)
+
+		back, err := DecToLegacyDec(d)
+		require.NoError(t, err)
+		require.True(t, legacy.Equal(back), tc.legacy)
+	}
+
+	// largest LegacyDec
+	max := LegacyNewDecFromBigIntWithPrec(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 315), big.NewInt(1)), LegacyPrecision)
+	back, err := DecToLegacyDec(LegacyDecToDec(max))
+	require.NoError(t, err)
+	require.True(t, max.Equal(back))
+}
+
+func TestDecToLegacyDec(t *testing.T) {
+	testCases := []struct {
+		dec    string
+		exp    string
+		expErr bool
+	}{
+		{"0", "0", false},
+		{"1e3", "1000", false},
+		{"-0.5", "-0.5", false},
+		// half to even at the 18th decimal place
+		{"0.0000000000000000005", "0", false},
+		{"0.0000000000000000015", "0.000000000000000002", false},
+		{"0.0000000000000000025", "0.000000000000000002", false},
+		{"-0.0000000000000000025", "-0.000000000000000002", false},
+		{"0.00000000000000000251", "0.000000000000000003", false},
+		{"1e-100", "0", false},
+		{"1e100", "", true},
+		{"NaN", "", true},
+		{"Infinity", "", true},
+	}
+
+	for _, tc := range testCases {
+		d, err := NewDecFromString(tc.dec)
+		require.NoError(t, err)
+
+		legacy, err := DecToLegacyDec(d)
+		if tc.expErr {
+			require.Error(t, err, tc.dec)
+			continue
+		}
+		require.NoError(t, err, tc.dec)
+		require.True(t, LegacyMustNewDecFromStr(tc.exp).Equal(legacy), "%s: %s", tc.dec, legacy)
+	}
+}
+
+// TestRoundingCompatibility documents where the LegacyDec and Dec operations
+// round differently.
+func TestRoundingCompatibility(t *testing.T) {
+	testCases := []struct {
+		name         string
+		x, y         string
+		op           string
+		legacy, conv string
+	}{
+		{"exact mul", "2", "3", "mul", "6", "6"},
+		{"mul half to even", "0.000000000000000005", "0.5", "mul", "0.000000000000000002", "0.000000000000000002"},
+		{"mul 34 digits", "1234567890123456.123456789012345678", "1.000000000000000001", "mul", "1234567890123456.124691356902469134", "1234567890123456.124691356902469134"},
+		{"quo repeating", "1", "3", "quo", "0.333333333333333333", "0.333333333333333333"},
+		{"quo rounding up", "2", "3", "quo", "0.666666666666666667", "0.666666666666666667"},
+		// beyond 34 significant digits the Dec operations lose decimal places
+		{"mul large", "99999999999999999999.999999999999999999", "0.999999999999999999", "mul", "99999999999999999899.999999999999999999", "99999999999999999900"},
+		{"quo large", "99999999999999999999.999999999999999999", "0.999999999999999999", "quo", "100000000000000000100.000000000000000099", "100000000000000000100"},
+	}
+
+	for _, tc := range testCases {
+		x, y := LegacyMustNewDecFromStr(tc.x), LegacyMustNewDecFromStr(tc.y)
+
+		var (
+			legacy LegacyDec
+			res    Dec
+			err    error
+		)
+		switch tc.op {
+		case "mul":
+			legacy = x.Mul(y)
+			res, err = LegacyDecToDec(x).Mul(LegacyDecToDec(y))
+		case "quo":
+			legacy = x.Quo(y)
+			res, err = LegacyDecToDec(x).Quo(LegacyDecToDec(y))
+		}
+		require.NoError(t, err, tc.name)
+
+		conv, err := DecToLegacyDec(res)
+		require.NoError(t, err, tc.name)
+		require.True(t, LegacyMustNewDecFromStr(tc.legacy).Equal(legacy), "%s: legacy %s", tc.name, legacy)
+		require.True(t, LegacyMustNewDecFromStr(tc.conv).Equal(conv), "%s: converted %s", tc.name, conv)
+	}
+}
+
+// TestRandomRoundingCompatibility checks that the operations on values below
+// 10^6 with 18 decimal places agree: addition and subtraction are exact, and
+// multiplication and division differ at most by the last decimal place since
+// their results fit in 34 significant digits.
+func TestRandomRoundingCompatibility(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	ulp := LegacySmallestDec()
+	limit := new(big.Int).Mul(big.NewInt(1_000_000), sdk.OneDec().BigInt())
+	randDec := func(min int64) LegacyDec {
+		i := new(big.Int).Rand(r, limit)
+		d := LegacyNewDecFromBigIntWithPrec(i, LegacyPrecision).Add(LegacyNewDec(min))
+		if r.Intn(2) == 0 {
+			d = d.Neg()
+		}
+		return d
+	}
+	convert := func(d Dec, err error) LegacyDec {
+		require.NoError(t, err)
+		legacy, err := DecToLegacyDec(d)
+		require.NoError(t, err)
+		return legacy
+	}
+
+	for i := 0; i < 10000; i++ {
+		x, y := randDec(0), randDec(1)
+		dx, dy := LegacyDecToDec(x), LegacyDecToDec(y)
+
+		require.True(t, x.Add(y).Equal(convert(dx.Add(dy))), "%s + %s", x, y)
+		require.True(t, x.Sub(y).Equal(convert(dx.Sub(dy))), "%s - %s", x, y)
+		require.True(t, x.Mul(y).Sub(convert(dx.Mul(dy))).Abs().LTE(ulp), "%s * %s", x, y)
+		require.True(t, x.Quo(y).Sub(convert(dx.Quo(dy))).Abs().LTE(ulp), "%s / %s", x, y)
+	}
+}
@@ -0,0 +1,107 @@
+package math
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/apd/v2"
+)
+
+// Dec is an arbitrary precision decimal wrapping apd.Decimal. Its operations
+// never mutate their arguments, each of them creating a new apd.Decimal, since
+// copied apd.Decimal values may share their underlying big.Int.
+//
+// Addition and subtraction are exact. Multiplication and division are rounded
+// half up to 34 significant digits, as decimal128.
+type Dec struct {
+	dec apd.Decimal
+}
+
+// dec128Context is the context of the operations that can't be exact.
+var dec128Context = apd.Context{
+	Precision:   34,
+	MaxExponent: apd.MaxExponent,
+	MinExponent: apd.MinExponent,
+	Traps:       apd.DefaultTraps,
+}
+
+// NewDecFromString parses a Dec from its decimal or scientific notation.
+func NewDecFromString(s string) (Dec, error) {
+	d, _, err := apd.NewFromString(s)
+	if err != nil {
+		return Dec{}, fmt.Errorf("invalid decimal string %q: %w", s, err)
+	}
+	return Dec{*d}, nil
+}
+
+// NewDecFromInt64 returns a Dec of value x.
+func NewDecFromInt64(x int64) Dec {
+	var res Dec
+	res.dec.SetInt64(x)
+	return res
+}
+
+// String returns the decimal notation of x, without exponent.
+func (x Dec) String() string {
+	return x.dec.Text('f')
+}
+
+// Add returns a new Dec with value `x+y`.
+func (x Dec) Add(y Dec) (Dec, error) {
+	var z Dec
+	_, err := apd.BaseContext.Add(&z.dec, &x.dec, &y.dec)
+	return z, wrapErr(err, "decimal addition error")
+}
+
+// Sub returns a new Dec with value `x-y`.
+func (x Dec) Sub(y Dec) (Dec, error) {
+	var z Dec
+	_, err := apd.BaseContext.Sub(&z.dec, &x.dec, &y.dec)
+	return z, wrapErr(err, "decimal subtraction error")
+}
+
+// Mul returns a new Dec with value `x*y` rounded to 34 significant digits.
+func (x Dec) Mul(y Dec) (Dec, error) {
+	var z Dec
+	_, err := dec128Context.Mul(&z.dec, &x.dec, &y.dec)
+	return z, wrapErr(err, "decimal multiplication error")
+}
+
+// Quo returns a new Dec with value `x/y` rounded to 34 significant digits.
+func (x Dec) Quo(y Dec) (Dec, error) {
+	var z Dec
+	_, err := dec128Context.Quo(&z.dec, &x.dec, &y.dec)
+	return z, wrapErr(err, "decimal quotient error")
+}
+
+// Cmp compares x and y and returns -1, 0 or +1.
+func (x Dec) Cmp(y Dec) int {
+	return x.dec.Cmp(&y.dec)
+}
+
+// IsEqual returns whether x and y have the same value, whatever their
+// exponents.
+func (x Dec) IsEqual(y Dec) bool {
+	return x.dec.Cmp(&y.dec) == 0
+}
+
+// IsZero returns whether x is 0.
+func (x Dec) IsZero() bool {
+	return x.dec.IsZero()
+}
+
+// IsNegative returns whether x is strictly negative.
+func (x Dec) IsNegative() bool {
+	return x.dec.Negative && !x.dec.IsZero()
+}
+
+// IsPositive returns whether x is strictly positive.
+func (x Dec) IsPositive() bool {
+	return !x.dec.Negative && !x.dec.IsZero()
+}
+
+func wrapErr(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
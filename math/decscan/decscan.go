@@ -0,0 +1,149 @@
+// Package decscan reports the usages of the sdk.Dec constructors in Go source
+// code, along with their math.Legacy* replacement, so that downstream code
+// can be migrated ahead of the move of the decimal types to the math module.
+package decscan
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TypesImportPath is the import path of the package declaring sdk.Dec.
+const TypesImportPath = "github.com/cosmos/cosmos-sdk/types"
+
+// Replacements maps the deprecated sdk.Dec constructors to the functions of
+// the math package replacing them.
+var Replacements = map[string]string{
+	"ZeroDec":                  "math.LegacyZeroDec",
+	"OneDec":                   "math.LegacyOneDec",
+	"SmallestDec":              "math.LegacySmallestDec",
+	"NewDec":                   "math.LegacyNewDec",
+	"NewDecWithPrec":           "math.LegacyNewDecWithPrec",
+	"NewDecFromBigInt":         "math.LegacyNewDecFromBigInt",
+	"NewDecFromBigIntWithPrec": "math.LegacyNewDecFromBigIntWithPrec",
+	"NewDecFromInt":            "math.LegacyNewDecFromInt",
+	"NewDecFromIntWithPrec":    "math.LegacyNewDecFromIntWithPrec",
+	"NewDecFromStr":            "math.LegacyNewDecFromStr",
+	"MustNewDecFromStr":        "math.LegacyMustNewDecFromStr",
+}
+
+// Usage is a reference to a deprecated constructor.
+type Usage struct {
+	Pos         token.Position
+	Name        string
+	Replacement string
+}
+
+// String formats the usage as a compiler diagnostic.
+func (u Usage) String() string {
+	return u.Pos.String() + ": sdk." + u.Name + " is deprecated, use " + u.Replacement
+}
+
+// ScanFile returns the usages of the deprecated constructors in f through its
+// import of TypesImportPath. Calls as well as references to the functions are
+// reported, dot imports are not supported.
+func ScanFile(fset *token.FileSet, f *ast.File) []Usage {
+	var (
+		usages []Usage
+		report = func(id *ast.Ident) {
+			if repl, ok := Replacements[id.Name]; ok {
+				usages = append(usages, Usage{Pos: fset.Position(id.Pos()), Name: id.Name, Replacement: repl})
+			}
+		}
+	)
+
+	alias := typesAlias(f)
+	if alias == "" {
+		return nil
+	}
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		// a resolved identifier is a local variable shadowing the import
+		if x, ok := sel.X.(*ast.Ident); ok && x.Name == alias && x.Obj == nil {
+			report(sel.Sel)
+		}
+		return true
+	})
+	return usages
+}
+
+// Scan parses the Go file at path and returns its usages of the deprecated
+// constructors.
+func Scan(path string) ([]Usage, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	return ScanFile(fset, f), nil
+}
+
+// ScanDir walks dir recursively and returns the usages of the deprecated
+// constructors in its Go files, sorted by position. The vendor and testdata
+// directories as well as the hidden ones are skipped.
+func ScanDir(dir string) ([]Usage, error) {
+	var usages []Usage
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			name := d.Name()
+			if path != dir && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+
+		fileUsages, err := Scan(path)
+		if err != nil {
+			return err
+		}
+		usages = append(usages, fileUsages...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(usages, func(i, j int) bool {
+		a, b := usages[i].Pos, usages[j].Pos
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		return a.Offset < b.Offset
+	})
+	return usages, nil
+}
+
+// typesAlias returns the name under which f imports TypesImportPath, or an
+// empty string if it doesn't or imports it with a dot or blank identifier.
+func typesAlias(f *ast.File) string {
+	for _, imp := range f.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil || path != TypesImportPath {
+			continue
+		}
+		if imp.Name == nil {
+			return "types"
+		}
+		if imp.Name.Name == "_" || imp.Name.Name == "." {
+			return ""
+		}
+		return imp.Name.Name
+	}
+	return ""
+}
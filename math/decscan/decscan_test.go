@@ -0,0 +1,119 @@
+package decscan_test
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/math/decscan"
+)
+
+const src = `package example
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var rate = sdk.NewDecWithPrec(5, 2)
+
+func f(sdk int) {
+	_ = sdk.NewDec(1) // shadowed import
+}
+
+func g() sdk.Dec {
+	parse := sdk.MustNewDecFromStr
+	return sdk.OneDec().Add(parse("1.5")).Mul(sdk.NewDecFromInt(sdk.NewInt(2)))
+}
+`
+
+func TestScanFile(t *testing.T) {
+	testCases := []struct {
+		name   string
+		src    string
+		expect []string
+	}{
+		{
+			"aliased import",
+			src,
+			[]string{
+				"example.go:7:16: sdk.NewDecWithPrec is deprecated, use math.LegacyNewDecWithPrec",
+				"example.go:14:15: sdk.MustNewDecFromStr is deprecated, use math.LegacyMustNewDecFromStr",
+				"example.go:15:13: sdk.OneDec is deprecated, use math.LegacyOneDec",
+				"example.go:15:48: sdk.NewDecFromInt is deprecated, use math.LegacyNewDecFromInt",
+			},
+		},
+		{
+			"default import name",
+			"package example\n\nimport \"github.com/cosmos/cosmos-sdk/types\"\n\nvar d = types.ZeroDec()\n",
+			[]string{"example.go:5:15: sdk.ZeroDec is deprecated, use math.LegacyZeroDec"},
+		},
+		{
+			"other package",
+			"package example\n\nimport sdk \"github.com/cosmos/cosmos-sdk/math\"\n\nvar d = sdk.NewDec(1)\n",
+			nil,
+		},
+		{
+			"blank import",
+			"package example\n\nimport _ \"github.com/cosmos/cosmos-sdk/types\"\n",
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			f, err := parser.ParseFile(fset, "example.go", tc.src, 0)
+			require.NoError(t, err)
+
+			var usages []string
+			for _, u := range decscan.ScanFile(fset, f) {
+				usages = append(usages, u.String())
+			}
+			require.Equal(t, tc.expect, usages)
+		})
+	}
+}
+
+func TestScanDir(t *testing.T) {
+	dir := t.TempDir()
+	write := func(path, content string) {
+		path = filepath.Join(dir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	write("b.go", src)
+	write("a/a.go", "package a\n\nimport sdk \"github.com/cosmos/cosmos-sdk/types\"\n\nvar d = sdk.NewDec(1)\n")
+	write("vendor/v.go", src)
+	write("testdata/t.go", src)
+	write("README.md", "sdk.NewDec(1)")
+
+	usages, err := decscan.ScanDir(dir)
+	require.NoError(t, err)
+	require.Len(t, usages, 5)
+	require.Equal(t, filepath.Join(dir, "a/a.go"), usages[0].Pos.Filename)
+	require.Equal(t, "NewDec", usages[0].Name)
+	require.Equal(t, "math.LegacyNewDec", usages[0].Replacement)
+	for _, u := range usages[1:] {
+		require.Equal(t, filepath.Join(dir, "b.go"), u.Pos.Filename)
+	}
+
+	write("c.go", "package broken\n\nfunc {")
+	_, err = decscan.ScanDir(dir)
+	require.Error(t, err)
+}
+
+func TestReplacementsExist(t *testing.T) {
+	// every replacement is declared by the math package
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "../legacy_dec.go", nil, 0)
+	require.NoError(t, err)
+
+	for name, repl := range decscan.Replacements {
+		require.NotNil(t, f.Scope.Lookup(repl[len("math."):]), name)
+	}
+}
@@ -0,0 +1,11 @@
+// Package math contains the decimal types of the SDK as they move to a
+// standalone math module.
+//
+// Dec is an arbitrary precision decimal backed by apd, which rounds only the
+// operations that cannot be represented exactly. LegacyDec is the fixed 18
+// decimal places sdk.Dec used by the state machine today. The Legacy*
+// constructors forward to the sdk.Dec ones so that callers can switch their
+// imports ahead of the move, and LegacyDecToDec and DecToLegacyDec convert
+// between both representations. The decscan subpackage reports the usages of
+// the sdk.Dec constructors that have a Legacy* counterpart.
+package math
@@ -0,0 +1,51 @@
+package math
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LegacyDec is the fixed 18 decimal places decimal type of the SDK.
+type LegacyDec = sdk.Dec
+
+// LegacyPrecision is the number of decimal places of a LegacyDec.
+const LegacyPrecision = sdk.Precision
+
+// LegacyZeroDec returns a LegacyDec of value 0.
+func LegacyZeroDec() LegacyDec { return sdk.ZeroDec() }
+
+// LegacyOneDec returns a LegacyDec of value 1.
+func LegacyOneDec() LegacyDec { return sdk.OneDec() }
+
+// LegacySmallestDec returns the smallest positive LegacyDec, 10^-18.
+func LegacySmallestDec() LegacyDec { return sdk.SmallestDec() }
+
+// LegacyNewDec returns a LegacyDec from an int64.
+func LegacyNewDec(i int64) LegacyDec { return sdk.NewDec(i) }
+
+// LegacyNewDecWithPrec returns a LegacyDec of value i * 10^-prec.
+func LegacyNewDecWithPrec(i, prec int64) LegacyDec { return sdk.NewDecWithPrec(i, prec) }
+
+// LegacyNewDecFromBigInt returns a LegacyDec from a big integer.
+func LegacyNewDecFromBigInt(i *big.Int) LegacyDec { return sdk.NewDecFromBigInt(i) }
+
+// LegacyNewDecFromBigIntWithPrec returns a LegacyDec of value i * 10^-prec.
+func LegacyNewDecFromBigIntWithPrec(i *big.Int, prec int64) LegacyDec {
+	return sdk.NewDecFromBigIntWithPrec(i, prec)
+}
+
+// LegacyNewDecFromInt returns a LegacyDec from an sdk.Int.
+func LegacyNewDecFromInt(i sdk.Int) LegacyDec { return sdk.NewDecFromInt(i) }
+
+// LegacyNewDecFromIntWithPrec returns a LegacyDec of value i * 10^-prec.
+func LegacyNewDecFromIntWithPrec(i sdk.Int, prec int64) LegacyDec {
+	return sdk.NewDecFromIntWithPrec(i, prec)
+}
+
+// LegacyNewDecFromStr parses a LegacyDec from its decimal string
+// representation.
+func LegacyNewDecFromStr(str string) (LegacyDec, error) { return sdk.NewDecFromStr(str) }
+
+// LegacyMustNewDecFromStr is like LegacyNewDecFromStr but panics on error.
+func LegacyMustNewDecFromStr(str string) LegacyDec { return sdk.MustNewDecFromStr(str) }
@@ -172,6 +172,65 @@ func (m *Manager) HandleHeightSnapshot(height int64) {
 	}
 }
 
+// RollbackHeights discards the heights greater than the target height from the heights
+// to be pruned and the snapshot heights, and flushes the updates to disk. It must be
+// called when the store is rolled back to the target height, since the heights after it
+// are to be committed again.
+func (m *Manager) RollbackHeights(target int64) error {
+	pruneHeights, err := loadPruningHeights(m.db)
+	if err != nil {
+		return err
+	}
+	pruneSnapshotHeights, err := loadPruningSnapshotHeights(m.db)
+	if err != nil {
+		return err
+	}
+
+	m.pruneHeightsMx.Lock()
+	defer m.pruneHeightsMx.Unlock()
+
+	m.pruneSnapshotHeightsMx.Lock()
+	defer m.pruneSnapshotHeightsMx.Unlock()
+
+	// the heights are only loaded in memory when pruning, otherwise the ones persisted
+	// by a previous strategy are rolled back
+	if m.opts.GetPruningStrategy() != types.PruningNothing {
+		pruneHeights, pruneSnapshotHeights = m.pruneHeights, m.pruneSnapshotHeights
+	}
+
+	keptHeights := make([]int64, 0, len(pruneHeights))
+	for _, h := range pruneHeights {
+		if h <= target {
+			keptHeights = append(keptHeights, h)
+		}
+	}
+
+	keptSnapshotHeights := list.New()
+	for e := pruneSnapshotHeights.Front(); e != nil; e = e.Next() {
+		if h := e.Value.(int64); h <= target {
+			keptSnapshotHeights.PushBack(h)
+		}
+	}
+
+	batch := m.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(pruneHeightsKey, int64SliceToBytes(keptHeights)); err != nil {
+		return err
+	}
+	if err := batch.Set(pruneSnapshotHeightsKey, listToBytes(keptSnapshotHeights)); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	if m.opts.GetPruningStrategy() != types.PruningNothing {
+		m.pruneHeights = keptHeights
+		m.pruneSnapshotHeights = keptSnapshotHeights
+	}
+	return nil
+}
+
 // SetSnapshotInterval sets the interval at which the snapshots are taken.
 func (m *Manager) SetSnapshotInterval(snapshotInterval uint64) {
 	m.snapshotInterval = snapshotInterval
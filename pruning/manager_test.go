@@ -366,6 +366,49 @@ func TestHandleHeightSnapshot_FlushLoadFromDisk(t *testing.T) {
 	}
 }
 
+func TestRollbackHeights(t *testing.T) {
+	testcases := map[string]struct {
+		strategy types.PruningStrategy
+	}{
+		"pruning - in memory heights are rolled back":         {strategy: types.PruningEverything},
+		"pruning nothing - persisted heights are rolled back": {strategy: types.PruningNothing},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			db := db.NewMemDB()
+			require.NoError(t, db.Set(pruning.PruneHeightsKey, pruning.Int64SliceToBytes([]int64{3, 8, 9, 12})))
+			snapshotHeights := list.New()
+			snapshotHeights.PushBack(int64(5))
+			snapshotHeights.PushBack(int64(10))
+			require.NoError(t, db.Set(pruning.PruneSnapshotHeightsKey, pruning.ListToBytes(snapshotHeights)))
+
+			manager := pruning.NewManager(db, log.NewNopLogger())
+			manager.SetOptions(types.NewPruningOptions(tc.strategy))
+			require.NoError(t, manager.LoadPruningHeights(db))
+
+			require.NoError(t, manager.RollbackHeights(9))
+
+			loadedHeights, err := pruning.LoadPruningHeights(db)
+			require.NoError(t, err)
+			require.Equal(t, []int64{3, 8, 9}, loadedHeights)
+
+			loadedSnapshotHeights, err := pruning.LoadPruningSnapshotHeights(db)
+			require.NoError(t, err)
+			require.Equal(t, 1, loadedSnapshotHeights.Len())
+			require.Equal(t, int64(5), loadedSnapshotHeights.Front().Value)
+
+			heights, err := manager.GetFlushAndResetPruningHeights()
+			require.NoError(t, err)
+			if tc.strategy == types.PruningNothing {
+				require.Empty(t, heights)
+			} else {
+				require.Equal(t, []int64{3, 8, 9}, heights)
+			}
+		})
+	}
+}
+
 func TestHandleHeightSnapshot_DbErr_Panic(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
@@ -93,6 +93,22 @@ func NewCustomPruningOptions(keepRecent, interval uint64) PruningOptions {
 	}
 }
 
+// String returns the name of the pruning strategy, as set in app.toml.
+func (ps PruningStrategy) String() string {
+	switch ps {
+	case PruningDefault:
+		return PruningOptionDefault
+	case PruningEverything:
+		return PruningOptionEverything
+	case PruningNothing:
+		return PruningOptionNothing
+	case PruningCustom:
+		return PruningOptionCustom
+	default:
+		return "undefined"
+	}
+}
+
 func (po PruningOptions) GetPruningStrategy() PruningStrategy {
 	return po.Strategy
 }
@@ -0,0 +1,520 @@
+// Package openapi generates the OpenAPI v2 document of the gRPC-gateway routes of
+// the gRPC services compiled into a binary, from the google.api.http annotations
+// of their methods, so that the document describes exactly the REST API served
+// by the node, including the routes of custom modules.
+package openapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	// nolint: staticcheck
+	protov1 "github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Document is an OpenAPI v2 document.
+type Document struct {
+	Swagger     string              `json:"swagger"`
+	Info        Info                `json:"info"`
+	Consumes    []string            `json:"consumes"`
+	Produces    []string            `json:"produces"`
+	Paths       map[string]PathItem `json:"paths"`
+	Definitions map[string]*Schema  `json:"definitions"`
+}
+
+// Info is the metadata of the API.
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// PathItem are the operations of a path, by lower case HTTP method.
+type PathItem map[string]*Operation
+
+// Operation is an operation on a path, i.e. a gRPC-gateway route.
+type Operation struct {
+	Summary     string              `json:"summary,omitempty"`
+	OperationID string              `json:"operationId"`
+	Tags        []string            `json:"tags,omitempty"`
+	Parameters  []*Parameter        `json:"parameters,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+// Parameter is a parameter of an operation, in its path, query or body.
+type Parameter struct {
+	Name             string   `json:"name"`
+	In               string   `json:"in"`
+	Required         bool     `json:"required,omitempty"`
+	Type             string   `json:"type,omitempty"`
+	Format           string   `json:"format,omitempty"`
+	Items            *Schema  `json:"items,omitempty"`
+	CollectionFormat string   `json:"collectionFormat,omitempty"`
+	Enum             []string `json:"enum,omitempty"`
+	Schema           *Schema  `json:"schema,omitempty"`
+}
+
+// Response is a response of an operation.
+type Response struct {
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema,omitempty"`
+}
+
+// Schema is the schema of a value.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+}
+
+const (
+	anyType       = ".google.protobuf.Any"
+	statusType    = ".google.rpc.Status"
+	timestampType = ".google.protobuf.Timestamp"
+	durationType  = ".google.protobuf.Duration"
+)
+
+// ServiceCollector collects the descriptions of the gRPC services registered to
+// it, e.g. by BaseApp.RegisterGRPCServer, without serving them.
+type ServiceCollector struct {
+	services []*grpc.ServiceDesc
+}
+
+// RegisterService implements the gogogrpc.Server interface.
+func (c *ServiceCollector) RegisterService(sd *grpc.ServiceDesc, _ interface{}) {
+	c.services = append(c.services, sd)
+}
+
+// Services returns the descriptions of the services registered to the collector.
+func (c *ServiceCollector) Services() []*grpc.ServiceDesc {
+	return c.services
+}
+
+// Handler returns the handler serving the JSON encoded document.
+func Handler(doc *Document) (http.Handler, error) {
+	bz, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(bz)
+	}), nil
+}
+
+// Generate generates the document of the gRPC-gateway routes of the services,
+// i.e. of their methods annotated with google.api.http. The files describing
+// the services must be registered in the gogoproto or the protobuf registry.
+func Generate(info Info, services []*grpc.ServiceDesc) (*Document, error) {
+	g := &generator{
+		doc: &Document{
+			Swagger:     "2.0",
+			Info:        info,
+			Consumes:    []string{"application/json"},
+			Produces:    []string{"application/json"},
+			Paths:       make(map[string]PathItem),
+			Definitions: make(map[string]*Schema),
+		},
+		files:    make(map[string]*dpb.FileDescriptorProto),
+		messages: make(map[string]*dpb.DescriptorProto),
+		enums:    make(map[string]*dpb.EnumDescriptorProto),
+	}
+
+	// the services are sorted so that the operations of the same path are
+	// generated in a deterministic order
+	sorted := make([]*grpc.ServiceDesc, len(services))
+	copy(sorted, services)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ServiceName < sorted[j].ServiceName })
+
+	for _, sd := range sorted {
+		if err := g.addService(sd); err != nil {
+			return nil, fmt.Errorf("failed to describe %s: %w", sd.ServiceName, err)
+		}
+	}
+
+	return g.doc, nil
+}
+
+type generator struct {
+	doc      *Document
+	files    map[string]*dpb.FileDescriptorProto
+	messages map[string]*dpb.DescriptorProto     // by fully qualified name, e.g. ".cosmos.bank.v1beta1.QueryBalanceRequest"
+	enums    map[string]*dpb.EnumDescriptorProto // by fully qualified name
+}
+
+// loadFile loads the descriptor of the file, and of its dependencies, indexing
+// their messages and enums.
+func (g *generator) loadFile(name string) (*dpb.FileDescriptorProto, error) {
+	if fd, ok := g.files[name]; ok {
+		return fd, nil
+	}
+
+	// the well known types are not registered into the gogoproto registry but
+	// into the protobuf one, so we need to check both
+	enc := gogoproto.FileDescriptor(name)
+	if len(enc) == 0 {
+		enc = protov1.FileDescriptor(name) // nolint: staticcheck
+	}
+	if len(enc) == 0 {
+		return nil, fmt.Errorf("file descriptor not found for %s", name)
+	}
+
+	fd, err := decodeFileDesc(enc)
+	if err != nil {
+		return nil, err
+	}
+	g.files[name] = fd
+
+	prefix := ""
+	if fd.GetPackage() != "" {
+		prefix = "." + fd.GetPackage()
+	}
+	for _, msg := range fd.MessageType {
+		g.indexMessage(prefix, msg)
+	}
+	for _, enum := range fd.EnumType {
+		g.enums[prefix+"."+enum.GetName()] = enum
+	}
+
+	for _, dep := range fd.Dependency {
+		// the dependencies which are not registered, e.g. only defining
+		// options, cannot define the types of the messages of the services
+		_, _ = g.loadFile(dep)
+	}
+
+	return fd, nil
+}
+
+func (g *generator) indexMessage(prefix string, msg *dpb.DescriptorProto) {
+	name := prefix + "." + msg.GetName()
+	g.messages[name] = msg
+	for _, nested := range msg.NestedType {
+		g.indexMessage(name, nested)
+	}
+	for _, enum := range msg.EnumType {
+		g.enums[name+"."+enum.GetName()] = enum
+	}
+}
+
+func (g *generator) addService(sd *grpc.ServiceDesc) error {
+	fileName, ok := sd.Metadata.(string)
+	if !ok {
+		return fmt.Errorf("unexpected service metadata %T", sd.Metadata)
+	}
+
+	fd, err := g.loadFile(fileName)
+	if err != nil {
+		return err
+	}
+
+	for _, service := range fd.Service {
+		if fd.GetPackage()+"."+service.GetName() != sd.ServiceName {
+			continue
+		}
+
+		for _, method := range service.Method {
+			rule, ok := proto.GetExtension(method.GetOptions(), annotations.E_Http).(*annotations.HttpRule)
+			if !ok || rule == nil {
+				continue
+			}
+
+			operationID := sd.ServiceName + "." + method.GetName()
+			for i, binding := range append([]*annotations.HttpRule{rule}, rule.AdditionalBindings...) {
+				id := operationID
+				if i > 0 {
+					id = fmt.Sprintf("%s%d", operationID, i)
+				}
+				if err := g.addOperation(sd.ServiceName, id, method, binding); err != nil {
+					return fmt.Errorf("%s: %w", method.GetName(), err)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("service not found in %s", fileName)
+}
+
+// pathParam matches the parameters of a path template, e.g. "{address}" or "{denom=**}".
+var pathParam = regexp.MustCompile(`\{([^}=]+)(=[^}]*)?\}`)
+
+func (g *generator) addOperation(tag, id string, method *dpb.MethodDescriptorProto, rule *annotations.HttpRule) error {
+	var httpMethod, template string
+	switch pattern := rule.Pattern.(type) {
+	case *annotations.HttpRule_Get:
+		httpMethod, template = "get", pattern.Get
+	case *annotations.HttpRule_Post:
+		httpMethod, template = "post", pattern.Post
+	case *annotations.HttpRule_Put:
+		httpMethod, template = "put", pattern.Put
+	case *annotations.HttpRule_Delete:
+		httpMethod, template = "delete", pattern.Delete
+	case *annotations.HttpRule_Patch:
+		httpMethod, template = "patch", pattern.Patch
+	case *annotations.HttpRule_Custom:
+		httpMethod, template = strings.ToLower(pattern.Custom.GetKind()), pattern.Custom.GetPath()
+	default:
+		return fmt.Errorf("unsupported http rule pattern %T", rule.Pattern)
+	}
+
+	req, ok := g.messages[method.GetInputType()]
+	if !ok {
+		return fmt.Errorf("unknown request type %s", method.GetInputType())
+	}
+
+	op := &Operation{
+		Summary:     method.GetName(),
+		OperationID: id,
+		Tags:        []string{tag},
+		Responses: map[string]Response{
+			"200": {Description: "A successful response.", Schema: g.schemaForMessage(method.GetOutputType())},
+			"default": {
+				Description: "An unexpected error response.",
+				Schema:      g.schemaForMessage(statusType),
+			},
+		},
+	}
+
+	// the fields of the request bound to the path or the body are not query parameters
+	bound := make(map[string]bool)
+	path := pathParam.ReplaceAllStringFunc(template, func(param string) string {
+		name := pathParam.FindStringSubmatch(param)[1]
+		bound[name] = true
+		p := &Parameter{Name: name, In: "path", Required: true, Type: "string"}
+		if field := g.fieldByPath(req, name); field != nil {
+			g.setParamType(p, field)
+		}
+		op.Parameters = append(op.Parameters, p)
+		return "{" + name + "}"
+	})
+
+	switch rule.Body {
+	case "":
+		op.Parameters = append(op.Parameters, g.queryParams("", req, bound, map[string]bool{method.GetInputType(): true})...)
+	case "*":
+		op.Parameters = append(op.Parameters, &Parameter{
+			Name: "body", In: "body", Required: true, Schema: g.schemaForMessage(method.GetInputType()),
+		})
+	default:
+		field := g.fieldByPath(req, rule.Body)
+		if field == nil {
+			return fmt.Errorf("unknown body field %s", rule.Body)
+		}
+		bound[rule.Body] = true
+		op.Parameters = append(op.Parameters, &Parameter{Name: rule.Body, In: "body", Required: true, Schema: g.schemaForField(field)})
+		op.Parameters = append(op.Parameters, g.queryParams("", req, bound, map[string]bool{method.GetInputType(): true})...)
+	}
+
+	item, ok := g.doc.Paths[path]
+	if !ok {
+		item = make(PathItem)
+		g.doc.Paths[path] = item
+	}
+	item[httpMethod] = op
+
+	return nil
+}
+
+// fieldByPath returns the field of the message at the dotted path, e.g.
+// "pagination.key", or nil if there is none.
+func (g *generator) fieldByPath(msg *dpb.DescriptorProto, path string) *dpb.FieldDescriptorProto {
+	name, rest, nested := strings.Cut(path, ".")
+	for _, field := range msg.Field {
+		if field.GetName() != name {
+			continue
+		}
+		if !nested {
+			return field
+		}
+		fieldMsg, ok := g.messages[field.GetTypeName()]
+		if !ok {
+			return nil
+		}
+		return g.fieldByPath(fieldMsg, rest)
+	}
+
+	return nil
+}
+
+// queryParams returns the query parameters of the fields of the message which
+// are not bound, the fields of the nested messages being flattened, e.g.
+// "pagination.limit".
+func (g *generator) queryParams(prefix string, msg *dpb.DescriptorProto, bound, visiting map[string]bool) []*Parameter {
+	var params []*Parameter
+	for _, field := range msg.Field {
+		name := prefix + field.GetName()
+		if bound[name] {
+			continue
+		}
+
+		if field.GetType() == dpb.FieldDescriptorProto_TYPE_MESSAGE {
+			switch typeName := field.GetTypeName(); typeName {
+			case timestampType, durationType:
+			default:
+				nested, ok := g.messages[typeName]
+				// repeated messages, maps and recursive messages cannot be query parameters
+				if !ok || field.GetLabel() == dpb.FieldDescriptorProto_LABEL_REPEATED || visiting[typeName] || typeName == anyType {
+					continue
+				}
+				visiting[typeName] = true
+				params = append(params, g.queryParams(name+".", nested, bound, visiting)...)
+				delete(visiting, typeName)
+				continue
+			}
+		}
+
+		p := &Parameter{Name: name, In: "query"}
+		g.setParamType(p, field)
+		params = append(params, p)
+	}
+
+	return params
+}
+
+// setParamType sets the type of a path or query parameter bound to the field.
+func (g *generator) setParamType(p *Parameter, field *dpb.FieldDescriptorProto) {
+	schema := g.scalarSchema(field)
+	if field.GetLabel() == dpb.FieldDescriptorProto_LABEL_REPEATED && p.In == "query" {
+		p.Type, p.Items, p.CollectionFormat = "array", schema, "multi"
+		return
+	}
+
+	p.Type, p.Format, p.Enum = schema.Type, schema.Format, schema.Enum
+}
+
+// schemaForMessage returns the schema referencing the definition of the
+// message, adding the definition if it is not defined yet.
+func (g *generator) schemaForMessage(typeName string) *Schema {
+	switch typeName {
+	case timestampType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case durationType:
+		return &Schema{Type: "string"}
+	}
+
+	name := strings.TrimPrefix(typeName, ".")
+	ref := &Schema{Ref: "#/definitions/" + name}
+	if _, ok := g.doc.Definitions[name]; ok {
+		return ref
+	}
+
+	def := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	// the definition is added before its properties so that recursive messages
+	// reference it instead of being defined again
+	g.doc.Definitions[name] = def
+
+	switch typeName {
+	case anyType:
+		// the gRPC-gateway encodes the value of an Any inline, along its type URL
+		def.Properties["@type"] = &Schema{Type: "string"}
+		def.AdditionalProperties = &Schema{}
+		return ref
+	case statusType:
+		// google.rpc.Status is not registered, it is the body of the error responses
+		def.Properties["code"] = &Schema{Type: "integer", Format: "int32"}
+		def.Properties["message"] = &Schema{Type: "string"}
+		def.Properties["details"] = &Schema{Type: "array", Items: g.schemaForMessage(anyType)}
+		return ref
+	}
+
+	msg, ok := g.messages[typeName]
+	if !ok {
+		return ref
+	}
+	for _, field := range msg.Field {
+		def.Properties[field.GetName()] = g.schemaForField(field)
+	}
+
+	return ref
+}
+
+// schemaForField returns the schema of the value of the field.
+func (g *generator) schemaForField(field *dpb.FieldDescriptorProto) *Schema {
+	if field.GetLabel() != dpb.FieldDescriptorProto_LABEL_REPEATED {
+		return g.valueSchema(field)
+	}
+
+	// maps are repeated fields of map entries
+	if entry, ok := g.messages[field.GetTypeName()]; ok && entry.GetOptions().GetMapEntry() {
+		return &Schema{Type: "object", AdditionalProperties: g.valueSchema(entry.Field[1])}
+	}
+
+	return &Schema{Type: "array", Items: g.valueSchema(field)}
+}
+
+func (g *generator) valueSchema(field *dpb.FieldDescriptorProto) *Schema {
+	if field.GetType() == dpb.FieldDescriptorProto_TYPE_MESSAGE {
+		return g.schemaForMessage(field.GetTypeName())
+	}
+
+	return g.scalarSchema(field)
+}
+
+// scalarSchema returns the schema of a field which is not a message, in its
+// proto3 JSON encoding.
+func (g *generator) scalarSchema(field *dpb.FieldDescriptorProto) *Schema {
+	switch field.GetType() {
+	case dpb.FieldDescriptorProto_TYPE_DOUBLE:
+		return &Schema{Type: "number", Format: "double"}
+	case dpb.FieldDescriptorProto_TYPE_FLOAT:
+		return &Schema{Type: "number", Format: "float"}
+	case dpb.FieldDescriptorProto_TYPE_INT64, dpb.FieldDescriptorProto_TYPE_SINT64, dpb.FieldDescriptorProto_TYPE_SFIXED64:
+		return &Schema{Type: "string", Format: "int64"}
+	case dpb.FieldDescriptorProto_TYPE_UINT64, dpb.FieldDescriptorProto_TYPE_FIXED64:
+		return &Schema{Type: "string", Format: "uint64"}
+	case dpb.FieldDescriptorProto_TYPE_INT32, dpb.FieldDescriptorProto_TYPE_SINT32, dpb.FieldDescriptorProto_TYPE_SFIXED32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case dpb.FieldDescriptorProto_TYPE_UINT32, dpb.FieldDescriptorProto_TYPE_FIXED32:
+		return &Schema{Type: "integer", Format: "int64"}
+	case dpb.FieldDescriptorProto_TYPE_BOOL:
+		return &Schema{Type: "boolean"}
+	case dpb.FieldDescriptorProto_TYPE_BYTES:
+		return &Schema{Type: "string", Format: "byte"}
+	case dpb.FieldDescriptorProto_TYPE_ENUM:
+		schema := &Schema{Type: "string"}
+		if enum, ok := g.enums[field.GetTypeName()]; ok {
+			for _, value := range enum.Value {
+				schema.Enum = append(schema.Enum, value.GetName())
+			}
+		}
+		return schema
+	default:
+		return &Schema{Type: "string"}
+	}
+}
+
+// decodeFileDesc decompresses and unmarshals the given file descriptor.
+func decodeFileDesc(enc []byte) (*dpb.FileDescriptorProto, error) {
+	r, err := gzip.NewReader(bytes.NewReader(enc))
+	if err != nil {
+		return nil, fmt.Errorf("bad gzipped descriptor: %w", err)
+	}
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("bad gzipped descriptor: %w", err)
+	}
+
+	fd := new(dpb.FileDescriptorProto)
+	if err := proto.Unmarshal(raw, fd); err != nil {
+		return nil, fmt.Errorf("bad descriptor: %w", err)
+	}
+	return fd, nil
+}
@@ -0,0 +1,90 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server/api/openapi"
+	"github.com/cosmos/cosmos-sdk/server/grpc/admin"
+	"github.com/cosmos/cosmos-sdk/simapp"
+)
+
+func generate(t *testing.T) *openapi.Document {
+	app := simapp.Setup(t, false)
+	services := &openapi.ServiceCollector{}
+	app.RegisterGRPCServer(services)
+	admin.RegisterAdminServiceServer(services, nil)
+
+	doc, err := openapi.Generate(openapi.Info{Title: "simapp", Version: "v1"}, services.Services())
+	require.NoError(t, err)
+	return doc
+}
+
+func TestGenerate(t *testing.T) {
+	doc := generate(t)
+	require.Equal(t, "2.0", doc.Swagger)
+
+	// path parameters and flattened query parameters
+	op := doc.Paths["/cosmos/bank/v1beta1/balances/{address}"]["get"]
+	require.NotNil(t, op)
+	require.Equal(t, "cosmos.bank.v1beta1.Query.AllBalances", op.OperationID)
+	require.Equal(t, []string{"cosmos.bank.v1beta1.Query"}, op.Tags)
+	params := make(map[string]*openapi.Parameter)
+	for _, p := range op.Parameters {
+		params[p.Name] = p
+	}
+	require.Equal(t, &openapi.Parameter{Name: "address", In: "path", Required: true, Type: "string"}, params["address"])
+	require.Equal(t, &openapi.Parameter{Name: "pagination.limit", In: "query", Type: "string", Format: "uint64"}, params["pagination.limit"])
+	require.Equal(t, &openapi.Parameter{Name: "pagination.key", In: "query", Type: "string", Format: "byte"}, params["pagination.key"])
+	require.Equal(t, "#/definitions/cosmos.bank.v1beta1.QueryAllBalancesResponse", op.Responses["200"].Schema.Ref)
+
+	balances := doc.Definitions["cosmos.bank.v1beta1.QueryAllBalancesResponse"].Properties["balances"]
+	require.Equal(t, &openapi.Schema{Type: "array", Items: &openapi.Schema{Ref: "#/definitions/cosmos.base.v1beta1.Coin"}}, balances)
+
+	// enums
+	op = doc.Paths["/cosmos/gov/v1/proposals"]["get"]
+	require.NotNil(t, op)
+	var status *openapi.Parameter
+	for _, p := range op.Parameters {
+		if p.Name == "proposal_status" {
+			status = p
+		}
+	}
+	require.NotNil(t, status)
+	require.Equal(t, "string", status.Type)
+	require.Contains(t, status.Enum, "PROPOSAL_STATUS_PASSED")
+
+	// routes with a body, of the services registered along the ones of the app
+	op = doc.Paths["/cosmos/base/admin/v1beta1/log_levels"]["post"]
+	require.NotNil(t, op)
+	require.Equal(t, "body", op.Parameters[0].In)
+	require.Equal(t, "#/definitions/cosmos.base.admin.v1beta1.SetLogLevelsRequest", op.Parameters[0].Schema.Ref)
+
+	// all the references are defined
+	bz, err := json.Marshal(doc)
+	require.NoError(t, err)
+	for _, ref := range strings.Split(string(bz), `"$ref":"#/definitions/`)[1:] {
+		name := ref[:strings.Index(ref, `"`)]
+		require.Contains(t, doc.Definitions, name)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	handler, err := openapi.Handler(generate(t))
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var doc openapi.Document
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+	require.Equal(t, "simapp", doc.Info.Title)
+	require.NotEmpty(t, doc.Paths)
+}
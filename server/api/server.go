@@ -90,7 +90,7 @@ func New(clientCtx client.Context, logger log.Logger) *Server {
 // non-blocking, so an external signal handler must be used.
 func (s *Server) Start(cfg config.Config) error {
 	s.mtx.Lock()
-	if cfg.Telemetry.Enabled {
+	if s.metrics == nil && cfg.Telemetry.Enabled {
 		m, err := telemetry.New(cfg.Telemetry)
 		if err != nil {
 			s.mtx.Unlock()
@@ -98,6 +98,8 @@ func (s *Server) Start(cfg config.Config) error {
 		}
 
 		s.metrics = m
+	}
+	if s.metrics != nil {
 		s.registerMetrics()
 	}
 
@@ -129,10 +131,21 @@ func (s *Server) Start(cfg config.Config) error {
 	return tmrpcserver.Serve(s.listener, s.Router, s.logger, tmCfg)
 }
 
+// SetTelemetry sets the metrics served at /metrics, instead of the ones Start
+// creates if telemetry is enabled. It must be called before Start.
+func (s *Server) SetTelemetry(m *telemetry.Metrics) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.metrics = m
+}
+
 // Close closes the API server.
 func (s *Server) Close() error {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
+	if s.listener == nil {
+		return nil
+	}
 	return s.listener.Close()
 }
 
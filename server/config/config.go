@@ -2,7 +2,9 @@ package config
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -23,6 +25,18 @@ const (
 
 	// DefaultGRPCWebAddress defines the default address to bind the gRPC-web server to.
 	DefaultGRPCWebAddress = "0.0.0.0:9091"
+
+	// DefaultGRPCMaxStreamDuration defines the default max duration of the
+	// streaming gRPC queries.
+	DefaultGRPCMaxStreamDuration = 10 * time.Minute
+
+	// DefaultGRPCMaxConcurrentStreams defines the default max number of
+	// concurrent streaming gRPC queries.
+	DefaultGRPCMaxConcurrentStreams = 16
+
+	// DefaultTracingEndpoint defines the default address of the OTLP gRPC collector
+	// to export traces to.
+	DefaultTracingEndpoint = "localhost:4317"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -36,6 +50,11 @@ type BaseConfig struct {
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
 
+	// PruningAsyncQueueSize defines the number of pruning runs which can be
+	// pending before the commits wait for the stores pruned in the background.
+	// 0 prunes the stores in the commit.
+	PruningAsyncQueueSize uint `mapstructure:"pruning-async-queue-size"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -71,12 +90,30 @@ type BaseConfig struct {
 	// IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	IndexEvents []string `mapstructure:"index-events"`
+
+	// EventAggregationThreshold defines the number of duplicate events of a tx
+	// result, i.e. events of the same type with the same attribute keys, from
+	// which they are aggregated into a summary event. 0 disables the aggregation.
+	EventAggregationThreshold uint `mapstructure:"event-aggregation-threshold"`
+
+	// MaxTxEventBytes, MaxTxLogBytes, MaxBlockEventBytes and MaxBlockLogBytes
+	// cap the size of the events and the logs of the ABCI results of a tx and
+	// of a block, which are truncated beyond. 0 disables a limit.
+	MaxTxEventBytes    uint `mapstructure:"max-tx-event-bytes"`
+	MaxTxLogBytes      uint `mapstructure:"max-tx-log-bytes"`
+	MaxBlockEventBytes uint `mapstructure:"max-block-event-bytes"`
+	MaxBlockLogBytes   uint `mapstructure:"max-block-log-bytes"`
+
 	// IavlCacheSize set the size of the iavl tree cache.
 	IAVLCacheSize uint64 `mapstructure:"iavl-cache-size"`
 
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the Tendermint config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
+
+	// ShutdownTimeout defines the time, in seconds, each component of the node
+	// (e.g. the API and gRPC servers) is given to shut down gracefully.
+	ShutdownTimeout uint `mapstructure:"shutdown-timeout"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -87,6 +124,10 @@ type APIConfig struct {
 	// Swagger defines if swagger documentation should automatically be registered.
 	Swagger bool `mapstructure:"swagger"`
 
+	// OpenAPI defines if the OpenAPI document of the REST API, generated from
+	// the gRPC services of the app, should be served at /openapi.json.
+	OpenAPI bool `mapstructure:"openapi"`
+
 	// EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk)
 	EnableUnsafeCORS bool `mapstructure:"enabled-unsafe-cors"`
 
@@ -140,6 +181,24 @@ type GRPCConfig struct {
 
 	// Address defines the API server to listen on
 	Address string `mapstructure:"address"`
+
+	// EnableAdmin defines if the admin service, e.g. changing the log levels at
+	// runtime, should be registered. It must not be exposed publicly.
+	EnableAdmin bool `mapstructure:"enable-admin"`
+
+	// AdminToken defines the token the requests to the admin service must be
+	// authorized with, in the "authorization" header as "Bearer <token>".
+	AdminToken string `mapstructure:"admin-token"`
+
+	// MaxStreamDuration defines the max duration of a streaming query, e.g.
+	// the bank AllBalancesStream query, after which it is canceled with the
+	// DeadlineExceeded code. 0 doesn't limit it.
+	MaxStreamDuration time.Duration `mapstructure:"max-stream-duration"`
+
+	// MaxConcurrentStreams defines the max number of concurrent streaming
+	// queries, the streams exceeding it failing with the ResourceExhausted code.
+	// 0 doesn't limit it.
+	MaxConcurrentStreams uint32 `mapstructure:"max-concurrent-streams"`
 }
 
 // GRPCWebConfig defines configuration for the gRPC-web server.
@@ -163,6 +222,131 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// RemoteURL is the location of the snapshots in an S3-compatible object
+	// storage, s3://<bucket>[/<prefix>], the snapshots taken being pushed to it.
+	// Empty disables the remote snapshots.
+	RemoteURL string `mapstructure:"remote-url"`
+
+	// RemoteEndpoint is the URL of the S3-compatible API of the object storage,
+	// AWS S3 if empty.
+	RemoteEndpoint string `mapstructure:"remote-endpoint"`
+
+	// RemoteRegion is the region of the bucket of the remote snapshots.
+	RemoteRegion string `mapstructure:"remote-region"`
+}
+
+// QueryCacheConfig defines the configuration of the cache of gRPC query
+// responses.
+type QueryCacheConfig struct {
+	// Size defines the maximum number of cached responses.
+	Size int `mapstructure:"size"`
+
+	// Routes defines the gRPC methods whose responses are cached, or the service
+	// prefixes, ending with "/", of these methods. No query is cached if empty.
+	Routes []string `mapstructure:"routes"`
+}
+
+// MempoolConfig defines the limits of the txs pending in the mempool of the
+// node, enforced by the app in CheckTx.
+type MempoolConfig struct {
+	// MaxTxsPerSender defines the maximum number of txs of a sender pending in
+	// the mempool. 0 means unlimited.
+	MaxTxsPerSender int `mapstructure:"max-txs-per-sender"`
+
+	// TxTTL defines the number of blocks the txs without a timeout height are
+	// kept in the mempool. 0 means no TTL.
+	TxTTL uint64 `mapstructure:"tx-ttl"`
+}
+
+// QueryLimits defines the limits of the gRPC requests to a method.
+type QueryLimits struct {
+	// GasLimit defines the gas budget of a query, consumed by the store reads,
+	// or of each page of a streaming query. 0 means unlimited.
+	GasLimit uint64 `mapstructure:"gas-limit"`
+
+	// MaxConcurrency defines the maximum number of requests served
+	// concurrently. 0 means unlimited.
+	MaxConcurrency int `mapstructure:"max-concurrency"`
+
+	// RateLimit defines the maximum number of requests per second, on average.
+	// 0 means unlimited.
+	RateLimit float64 `mapstructure:"rate-limit"`
+
+	// RateBurst defines the maximum number of requests in a burst, defaulting to
+	// the rate limit if 0.
+	RateBurst int `mapstructure:"rate-burst"`
+}
+
+// QueryLimitsConfig defines the limits of the gRPC requests, by method.
+type QueryLimitsConfig struct {
+	// QueryLimits are the limits of the methods without overrides.
+	QueryLimits `mapstructure:",squash"`
+
+	// Methods overrides the limits of gRPC methods, or of the methods of the
+	// service prefixes ending with "/", as
+	// "<method>:<limit>=<value>,<limit>=<value>", e.g.
+	// "/cosmos.bank.v1beta1.Query/AllBalances:gas-limit=100000,rate-limit=10".
+	// The limits not overridden are the default ones.
+	Methods []string `mapstructure:"methods"`
+}
+
+// MethodLimits returns the limits of the methods, or service prefixes, whose
+// limits are overridden.
+func (c QueryLimitsConfig) MethodLimits() (map[string]QueryLimits, error) {
+	methods := make(map[string]QueryLimits, len(c.Methods))
+	for _, override := range c.Methods {
+		method, limitsStr, ok := strings.Cut(override, ":")
+		if !ok || method == "" {
+			return nil, fmt.Errorf("invalid query limits %q: expected <method>:<limit>=<value>,...", override)
+		}
+
+		limits := c.QueryLimits
+		for _, limit := range strings.Split(limitsStr, ",") {
+			key, value, ok := strings.Cut(strings.TrimSpace(limit), "=")
+			if !ok {
+				return nil, fmt.Errorf("invalid query limit %q of %s: expected <limit>=<value>", limit, method)
+			}
+
+			var err error
+			switch key {
+			case "gas-limit":
+				limits.GasLimit, err = strconv.ParseUint(value, 10, 64)
+			case "max-concurrency":
+				limits.MaxConcurrency, err = strconv.Atoi(value)
+			case "rate-limit":
+				limits.RateLimit, err = strconv.ParseFloat(value, 64)
+			case "rate-burst":
+				limits.RateBurst, err = strconv.Atoi(value)
+			default:
+				return nil, fmt.Errorf("unknown query limit %q of %s", key, method)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s of %s: %w", key, method, err)
+			}
+		}
+
+		if err := limits.validate(); err != nil {
+			return nil, fmt.Errorf("invalid query limits of %s: %w", method, err)
+		}
+		methods[method] = limits
+	}
+
+	return methods, nil
+}
+
+func (l QueryLimits) validate() error {
+	if l.MaxConcurrency < 0 {
+		return fmt.Errorf("max concurrency must not be negative, got %d", l.MaxConcurrency)
+	}
+	if l.RateLimit < 0 {
+		return fmt.Errorf("rate limit must not be negative, got %v", l.RateLimit)
+	}
+	if l.RateBurst < 0 {
+		return fmt.Errorf("rate burst must not be negative, got %d", l.RateBurst)
+	}
+
+	return nil
 }
 
 // Config defines the server's top level configuration
@@ -170,12 +354,16 @@ type Config struct {
 	BaseConfig `mapstructure:",squash"`
 
 	// Telemetry defines the application telemetry configuration
-	Telemetry telemetry.Config `mapstructure:"telemetry"`
-	API       APIConfig        `mapstructure:"api"`
-	GRPC      GRPCConfig       `mapstructure:"grpc"`
-	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
-	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
-	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Telemetry   telemetry.Config        `mapstructure:"telemetry"`
+	Tracing     telemetry.TracingConfig `mapstructure:"tracing"`
+	API         APIConfig               `mapstructure:"api"`
+	GRPC        GRPCConfig              `mapstructure:"grpc"`
+	Rosetta     RosettaConfig           `mapstructure:"rosetta"`
+	GRPCWeb     GRPCWebConfig           `mapstructure:"grpc-web"`
+	StateSync   StateSyncConfig         `mapstructure:"state-sync"`
+	QueryCache  QueryCacheConfig        `mapstructure:"query-cache"`
+	QueryLimits QueryLimitsConfig       `mapstructure:"query-limits"`
+	Mempool     MempoolConfig           `mapstructure:"mempool"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -215,25 +403,38 @@ func DefaultConfig() *Config {
 			PruningKeepRecent: "0",
 			PruningInterval:   "0",
 			MinRetainBlocks:   0,
-			IndexEvents:       make([]string, 0),
-			IAVLCacheSize:     781250, // 50 MB
-			AppDBBackend:      "",
+			// pruning in the background avoids commit latency spikes
+			PruningAsyncQueueSize: 10,
+			IndexEvents:           make([]string, 0),
+			IAVLCacheSize:         781250, // 50 MB
+			AppDBBackend:          "",
+			ShutdownTimeout:       10,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
 			GlobalLabels: [][]string{},
 		},
+		Tracing: telemetry.TracingConfig{
+			Enabled:     false,
+			Endpoint:    DefaultTracingEndpoint,
+			Insecure:    false,
+			ServiceName: "cosmos-sdk",
+			SampleRate:  1,
+		},
 		API: APIConfig{
 			Enable:             false,
 			Swagger:            false,
+			OpenAPI:            true,
 			Address:            DefaultAPIAddress,
 			MaxOpenConnections: 1000,
 			RPCReadTimeout:     10,
 			RPCMaxBodyBytes:    1000000,
 		},
 		GRPC: GRPCConfig{
-			Enable:  true,
-			Address: DefaultGRPCAddress,
+			Enable:               true,
+			Address:              DefaultGRPCAddress,
+			MaxStreamDuration:    DefaultGRPCMaxStreamDuration,
+			MaxConcurrentStreams: DefaultGRPCMaxConcurrentStreams,
 		},
 		Rosetta: RosettaConfig{
 			Enable:     false,
@@ -251,6 +452,17 @@ func DefaultConfig() *Config {
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		QueryCache: QueryCacheConfig{
+			Size:   1000,
+			Routes: []string{},
+		},
+		QueryLimits: QueryLimitsConfig{
+			Methods: []string{},
+		},
+		Mempool: MempoolConfig{
+			MaxTxsPerSender: 0,
+			TxTTL:           0,
+		},
 	}
 }
 
@@ -267,17 +479,24 @@ func GetConfig(v *viper.Viper) Config {
 
 	return Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:      v.GetString("minimum-gas-prices"),
-			InterBlockCache:   v.GetBool("inter-block-cache"),
-			Pruning:           v.GetString("pruning"),
-			PruningKeepRecent: v.GetString("pruning-keep-recent"),
-			PruningInterval:   v.GetString("pruning-interval"),
-			HaltHeight:        v.GetUint64("halt-height"),
-			HaltTime:          v.GetUint64("halt-time"),
-			IndexEvents:       v.GetStringSlice("index-events"),
-			MinRetainBlocks:   v.GetUint64("min-retain-blocks"),
-			IAVLCacheSize:     v.GetUint64("iavl-cache-size"),
-			AppDBBackend:      v.GetString("app-db-backend"),
+			MinGasPrices:              v.GetString("minimum-gas-prices"),
+			InterBlockCache:           v.GetBool("inter-block-cache"),
+			Pruning:                   v.GetString("pruning"),
+			PruningKeepRecent:         v.GetString("pruning-keep-recent"),
+			PruningInterval:           v.GetString("pruning-interval"),
+			PruningAsyncQueueSize:     v.GetUint("pruning-async-queue-size"),
+			HaltHeight:                v.GetUint64("halt-height"),
+			HaltTime:                  v.GetUint64("halt-time"),
+			IndexEvents:               v.GetStringSlice("index-events"),
+			EventAggregationThreshold: v.GetUint("event-aggregation-threshold"),
+			MaxTxEventBytes:           v.GetUint("max-tx-event-bytes"),
+			MaxTxLogBytes:             v.GetUint("max-tx-log-bytes"),
+			MaxBlockEventBytes:        v.GetUint("max-block-event-bytes"),
+			MaxBlockLogBytes:          v.GetUint("max-block-log-bytes"),
+			MinRetainBlocks:           v.GetUint64("min-retain-blocks"),
+			IAVLCacheSize:             v.GetUint64("iavl-cache-size"),
+			AppDBBackend:              v.GetString("app-db-backend"),
+			ShutdownTimeout:           v.GetUint("shutdown-timeout"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -288,9 +507,17 @@ func GetConfig(v *viper.Viper) Config {
 			PrometheusRetentionTime: v.GetInt64("telemetry.prometheus-retention-time"),
 			GlobalLabels:            globalLabels,
 		},
+		Tracing: telemetry.TracingConfig{
+			Enabled:     v.GetBool("tracing.enabled"),
+			Endpoint:    v.GetString("tracing.endpoint"),
+			Insecure:    v.GetBool("tracing.insecure"),
+			ServiceName: v.GetString("tracing.service-name"),
+			SampleRate:  v.GetFloat64("tracing.sample-rate"),
+		},
 		API: APIConfig{
 			Enable:             v.GetBool("api.enable"),
 			Swagger:            v.GetBool("api.swagger"),
+			OpenAPI:            v.GetBool("api.openapi"),
 			Address:            v.GetString("api.address"),
 			MaxOpenConnections: v.GetUint("api.max-open-connections"),
 			RPCReadTimeout:     v.GetUint("api.rpc-read-timeout"),
@@ -307,8 +534,13 @@ func GetConfig(v *viper.Viper) Config {
 			Offline:    v.GetBool("rosetta.offline"),
 		},
 		GRPC: GRPCConfig{
-			Enable:  v.GetBool("grpc.enable"),
-			Address: v.GetString("grpc.address"),
+			Enable:      v.GetBool("grpc.enable"),
+			Address:     v.GetString("grpc.address"),
+			EnableAdmin: v.GetBool("grpc.enable-admin"),
+			AdminToken:  v.GetString("grpc.admin-token"),
+			// streaming queries
+			MaxStreamDuration:    v.GetDuration("grpc.max-stream-duration"),
+			MaxConcurrentStreams: v.GetUint32("grpc.max-concurrent-streams"),
 		},
 		GRPCWeb: GRPCWebConfig{
 			Enable:           v.GetBool("grpc-web.enable"),
@@ -318,6 +550,26 @@ func GetConfig(v *viper.Viper) Config {
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
+			RemoteURL:          v.GetString("state-sync.remote-url"),
+			RemoteEndpoint:     v.GetString("state-sync.remote-endpoint"),
+			RemoteRegion:       v.GetString("state-sync.remote-region"),
+		},
+		QueryCache: QueryCacheConfig{
+			Size:   v.GetInt("query-cache.size"),
+			Routes: v.GetStringSlice("query-cache.routes"),
+		},
+		QueryLimits: QueryLimitsConfig{
+			QueryLimits: QueryLimits{
+				GasLimit:       v.GetUint64("query-limits.gas-limit"),
+				MaxConcurrency: v.GetInt("query-limits.max-concurrency"),
+				RateLimit:      v.GetFloat64("query-limits.rate-limit"),
+				RateBurst:      v.GetInt("query-limits.rate-burst"),
+			},
+			Methods: v.GetStringSlice("query-limits.methods"),
+		},
+		Mempool: MempoolConfig{
+			MaxTxsPerSender: v.GetInt("mempool.max-txs-per-sender"),
+			TxTTL:           v.GetUint64("mempool.tx-ttl"),
 		},
 	}
 }
@@ -333,5 +585,29 @@ func (c Config) ValidateBasic() error {
 		)
 	}
 
+	if c.GRPC.EnableAdmin && c.GRPC.AdminToken == "" {
+		return sdkerrors.ErrAppConfig.Wrap("the gRPC admin service requires an admin token")
+	}
+
+	if len(c.QueryCache.Routes) > 0 && c.QueryCache.Size <= 0 {
+		return sdkerrors.ErrAppConfig.Wrapf("query cache size must be positive, got %d", c.QueryCache.Size)
+	}
+
+	if err := c.QueryLimits.validate(); err != nil {
+		return sdkerrors.ErrAppConfig.Wrapf("invalid query limits: %s", err)
+	}
+
+	if _, err := c.QueryLimits.MethodLimits(); err != nil {
+		return sdkerrors.ErrAppConfig.Wrap(err.Error())
+	}
+
+	if c.Mempool.MaxTxsPerSender < 0 {
+		return sdkerrors.ErrAppConfig.Wrapf("mempool max txs per sender must not be negative, got %d", c.Mempool.MaxTxsPerSender)
+	}
+
+	if c.Tracing.Enabled && (c.Tracing.SampleRate < 0 || c.Tracing.SampleRate > 1) {
+		return sdkerrors.ErrAppConfig.Wrapf("tracing sample rate must be between 0 and 1, got %v", c.Tracing.SampleRate)
+	}
+
 	return nil
 }
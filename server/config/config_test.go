@@ -128,3 +128,31 @@ func TestSetConfigTemplate(t *testing.T) {
 	actual := setBuffer.String()
 	require.Equal(t, expected, actual, "resulting config strings")
 }
+
+func TestQueryLimitsMethodLimits(t *testing.T) {
+	cfg := QueryLimitsConfig{
+		QueryLimits: QueryLimits{GasLimit: 1000, RateLimit: 100},
+		Methods: []string{
+			"/cosmos.bank.v1beta1.Query/AllBalances:gas-limit=10, max-concurrency=2",
+			"/cosmos.staking.v1beta1.Query/:rate-limit=0.5,rate-burst=3",
+		},
+	}
+	limits, err := cfg.MethodLimits()
+	require.NoError(t, err)
+	require.Equal(t, map[string]QueryLimits{
+		"/cosmos.bank.v1beta1.Query/AllBalances": {GasLimit: 10, MaxConcurrency: 2, RateLimit: 100},
+		"/cosmos.staking.v1beta1.Query/":         {GasLimit: 1000, RateLimit: 0.5, RateBurst: 3},
+	}, limits)
+
+	for _, method := range []string{
+		"/cosmos.bank.v1beta1.Query/AllBalances",
+		":gas-limit=10",
+		"/cosmos.bank.v1beta1.Query/AllBalances:gas-limit",
+		"/cosmos.bank.v1beta1.Query/AllBalances:gas-limit=-1",
+		"/cosmos.bank.v1beta1.Query/AllBalances:max-concurrency=-1",
+		"/cosmos.bank.v1beta1.Query/AllBalances:timeout=10",
+	} {
+		_, err := QueryLimitsConfig{Methods: []string{method}}.MethodLimits()
+		require.Error(t, err, method)
+	}
+}
@@ -0,0 +1,149 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"text/template"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
+)
+
+// KeyMigration migrates a key of app.toml written by a previous version: the
+// value of From is moved to To, or dropped if To is empty.
+type KeyMigration struct {
+	From string
+	To   string
+}
+
+// KeyMigrations are the migrations applied by Migrate to the keys of app.toml
+// files written by previous versions.
+var KeyMigrations = []KeyMigration{
+	// the snapshots are kept independently of the pruning since v0.46
+	{From: "pruning-keep-every"},
+}
+
+// MigrationReport lists the changes made to app.toml by Migrate.
+type MigrationReport struct {
+	// Moved are the keys whose value was moved to another key.
+	Moved []KeyMigration
+	// Removed are the keys which are not part of the config anymore, or are
+	// unknown, e.g. misspelled.
+	Removed []string
+	// Added are the keys which were absent, set to their default value.
+	Added []string
+}
+
+// Migrate migrates the contents of an app.toml file written by a previous
+// version, or edited by hand, to the current template: the keys are migrated
+// with KeyMigrations, the settings of the current config and of the registered
+// sections keep their value, and the missing ones are set to their default
+// value. The template and default config of the app are customAppTemplate and
+// customAppConfig, or DefaultConfigTemplate and DefaultConfig if empty.
+func Migrate(contents []byte, customAppTemplate string, customAppConfig interface{}) ([]byte, MigrationReport, error) {
+	var report MigrationReport
+
+	appTemplate, appConfig := DefaultConfigTemplate, interface{}(DefaultConfig())
+	if customAppTemplate != "" {
+		appTemplate, appConfig = customAppTemplate, customAppConfig
+	}
+	tmpl, err := template.New("appConfigFileTemplate").Parse(appTemplate)
+	if err != nil {
+		return nil, report, err
+	}
+
+	old, err := readTOML(contents)
+	if err != nil {
+		return nil, report, fmt.Errorf("failed to parse app.toml: %w", err)
+	}
+
+	// the settings of the current template, set to their default value
+	defaults, err := renderConfig(tmpl, appConfig, nil)
+	if err != nil {
+		return nil, report, err
+	}
+	current, err := readTOML(defaults)
+	if err != nil {
+		return nil, report, err
+	}
+	known := make(map[string]bool)
+	for _, key := range current.AllKeys() {
+		known[key] = true
+	}
+
+	set := make(map[string]bool)
+	migrated := make(map[string]bool)
+	for _, migration := range KeyMigrations {
+		if !old.IsSet(migration.From) {
+			continue
+		}
+		migrated[migration.From] = true
+		if migration.To == "" {
+			report.Removed = append(report.Removed, migration.From)
+			continue
+		}
+		current.Set(migration.To, old.Get(migration.From))
+		set[migration.To] = true
+		report.Moved = append(report.Moved, migration)
+	}
+
+	for _, key := range old.AllKeys() {
+		switch {
+		case migrated[key]:
+		case known[key]:
+			if !set[key] {
+				current.Set(key, old.Get(key))
+				set[key] = true
+			}
+		default:
+			report.Removed = append(report.Removed, key)
+		}
+	}
+	for key := range known {
+		if !set[key] {
+			report.Added = append(report.Added, key)
+		}
+	}
+	sort.Strings(report.Removed)
+	sort.Strings(report.Added)
+
+	// decode the migrated settings into a copy of the default configs
+	configType := reflect.TypeOf(appConfig)
+	if configType.Kind() == reflect.Ptr {
+		configType = configType.Elem()
+	}
+	config := reflect.New(configType)
+	config.Elem().Set(reflect.Indirect(reflect.ValueOf(appConfig)))
+	squash := func(c *mapstructure.DecoderConfig) { c.Squash = true } // the custom configs embed Config
+	if err := current.Unmarshal(config.Interface(), squash); err != nil {
+		return nil, report, fmt.Errorf("failed to decode the migrated config: %w", err)
+	}
+
+	sectionConfigs := make(map[string]SectionConfig)
+	for _, section := range RegisteredSections() {
+		sectionConfig, err := section.decode(current.Get(section.Name))
+		if err != nil {
+			return nil, report, fmt.Errorf("failed to decode the migrated config: %w", err)
+		}
+		sectionConfigs[section.Name] = sectionConfig
+	}
+
+	migratedContents, err := renderConfig(tmpl, config.Elem().Interface(), sectionConfigs)
+	if err != nil {
+		return nil, report, err
+	}
+
+	return migratedContents, report, nil
+}
+
+func readTOML(contents []byte) (*viper.Viper, error) {
+	v := viper.New()
+	v.SetConfigType("toml")
+	if err := v.ReadConfig(bytes.NewReader(contents)); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
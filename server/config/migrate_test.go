@@ -0,0 +1,71 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	withTestSection(t)
+
+	old := []byte(`
+minimum-gas-prices = "0.1stake"
+pruning = "custom"
+pruning-keep-every = "100"
+typo = true
+
+[api]
+enable = true
+unknown = 1
+
+[test]
+limit = 5
+`)
+
+	migrated, report, err := Migrate(old, "", nil)
+	require.NoError(t, err)
+	require.Empty(t, report.Moved)
+	require.Equal(t, []string{"api.unknown", "pruning-keep-every", "typo"}, report.Removed)
+	require.Contains(t, report.Added, "grpc.address")
+	require.Contains(t, report.Added, "test.names")
+	require.NotContains(t, report.Added, "api.enable")
+
+	v, err := readTOML(migrated)
+	require.NoError(t, err)
+	require.Equal(t, "0.1stake", v.GetString("minimum-gas-prices"))
+	require.Equal(t, "custom", v.GetString("pruning"))
+	require.True(t, v.GetBool("api.enable"))
+	require.Equal(t, DefaultGRPCAddress, v.GetString("grpc.address"))
+	require.Equal(t, 5, v.GetInt("test.limit"))
+	require.Equal(t, []interface{}{"a"}, v.Get("test.names"))
+	require.False(t, v.IsSet("typo"))
+	require.False(t, v.IsSet("pruning-keep-every"))
+
+	// migrating again changes nothing
+	remigrated, report, err := Migrate(migrated, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, string(migrated), string(remigrated))
+	require.Empty(t, report.Removed)
+	require.Empty(t, report.Added)
+}
+
+func TestMigrateMovedKey(t *testing.T) {
+	migrations := KeyMigrations
+	t.Cleanup(func() { KeyMigrations = migrations })
+	KeyMigrations = append(KeyMigrations, KeyMigration{From: "api.rpc-timeout", To: "api.rpc-read-timeout"})
+
+	type customAppConfig struct {
+		Config
+	}
+
+	migrated, report, err := Migrate([]byte("[api]\nrpc-timeout = 42\n"), DefaultConfigTemplate, customAppConfig{Config: *DefaultConfig()})
+	require.NoError(t, err)
+	require.Equal(t, []KeyMigration{{From: "api.rpc-timeout", To: "api.rpc-read-timeout"}}, report.Moved)
+	require.Empty(t, report.Removed)
+
+	v, err := readTOML(migrated)
+	require.NoError(t, err)
+	require.Equal(t, 42, v.GetInt("api.rpc-read-timeout"))
+	require.False(t, v.IsSet("api.rpc-timeout"))
+}
@@ -0,0 +1,152 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sync"
+	"text/template"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// SectionConfig is the config of an app.toml section registered by a module.
+type SectionConfig interface {
+	// ValidateBasic returns an error if the config is invalid.
+	ValidateBasic() error
+}
+
+// Section is a section of app.toml holding the config of a module, e.g. the
+// [wasm] section of the wasm module. The registered sections are written to
+// app.toml after the sections of the server config, and validated when the node
+// starts.
+type Section struct {
+	// Name is the name of the section, i.e. the key of its table in app.toml.
+	Name string
+	// Template is the TOML template of the keys of the section, excluding the
+	// table header, executed with the config of the section.
+	Template string
+	// Default is the default config of the section, a struct whose fields are
+	// tagged with their mapstructure key.
+	Default SectionConfig
+
+	tmpl *template.Template
+}
+
+var (
+	sectionsMtx sync.RWMutex
+	sections    []Section
+)
+
+// reservedSections are the sections of the server config.
+var reservedSections = map[string]bool{
+	"telemetry": true, "tracing": true, "api": true, "rosetta": true, "grpc": true, "grpc-web": true,
+	"state-sync": true, "query-cache": true, "query-limits": true, "mempool": true, "store": true, "streamers": true,
+}
+
+// RegisterSection registers a section of app.toml. It panics if the section is
+// invalid, or if a section with the same name is already registered.
+func RegisterSection(section Section) {
+	if section.Name == "" || reservedSections[section.Name] {
+		panic(fmt.Sprintf("invalid app.toml section name %q", section.Name))
+	}
+	if section.Default == nil || reflect.TypeOf(section.Default).Kind() != reflect.Struct {
+		panic(fmt.Sprintf("the default config of the app.toml section %q must be a struct", section.Name))
+	}
+
+	tmpl, err := template.New(section.Name).Parse(section.Template)
+	if err != nil {
+		panic(fmt.Sprintf("invalid template of the app.toml section %q: %s", section.Name, err))
+	}
+	section.tmpl = tmpl
+
+	sectionsMtx.Lock()
+	defer sectionsMtx.Unlock()
+
+	for _, s := range sections {
+		if s.Name == section.Name {
+			panic(fmt.Sprintf("app.toml section %q already registered", section.Name))
+		}
+	}
+	sections = append(sections, section)
+}
+
+// RegisteredSections returns the registered sections of app.toml, in the order
+// of their registration.
+func RegisteredSections() []Section {
+	sectionsMtx.RLock()
+	defer sectionsMtx.RUnlock()
+
+	return append([]Section(nil), sections...)
+}
+
+// render renders the section with its config.
+func (s Section) render(config SectionConfig) ([]byte, error) {
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "\n[%s]\n\n", s.Name)
+	if err := s.tmpl.Execute(&buffer, config); err != nil {
+		return nil, fmt.Errorf("failed to render the app.toml section %q: %w", s.Name, err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// decode decodes the raw value of the section in app.toml, nil if the section
+// is absent, over its default config.
+func (s Section) decode(raw interface{}) (SectionConfig, error) {
+	config := reflect.New(reflect.TypeOf(s.Default))
+	config.Elem().Set(reflect.ValueOf(s.Default))
+
+	if raw != nil {
+		decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+			DecodeHook: mapstructure.ComposeDecodeHookFunc(
+				mapstructure.StringToTimeDurationHookFunc(),
+				mapstructure.StringToSliceHookFunc(","),
+			),
+			WeaklyTypedInput: true,
+			Result:           config.Interface(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := decoder.Decode(raw); err != nil {
+			return nil, fmt.Errorf("[%s] %w", s.Name, err)
+		}
+	}
+
+	return config.Elem().Interface().(SectionConfig), nil
+}
+
+// ReadSection reads the config of the registered section name from the app
+// options, e.g. in the constructor of the app, into config, a pointer to a value
+// of the type of the default config of the section. The settings absent from
+// app.toml keep their default value, and the config is validated.
+func ReadSection(opts interface{ Get(string) interface{} }, name string, config SectionConfig) error {
+	var section *Section
+	for _, s := range RegisteredSections() {
+		if s.Name == name {
+			s := s
+			section = &s
+			break
+		}
+	}
+	if section == nil {
+		return fmt.Errorf("app.toml section %q is not registered", name)
+	}
+
+	target := reflect.ValueOf(config)
+	if target.Kind() != reflect.Ptr || target.Elem().Type() != reflect.TypeOf(section.Default) {
+		return fmt.Errorf("the config of the app.toml section %q must be a %T pointer, got %T", name, section.Default, config)
+	}
+
+	decoded, err := section.decode(opts.Get(name))
+	if err != nil {
+		return err
+	}
+	if err := decoded.ValidateBasic(); err != nil {
+		return fmt.Errorf("[%s] %w", name, err)
+	}
+
+	target.Elem().Set(reflect.ValueOf(decoded))
+	return nil
+}
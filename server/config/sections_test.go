@@ -0,0 +1,100 @@
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+type testSectionConfig struct {
+	Limit uint64   `mapstructure:"limit"`
+	Names []string `mapstructure:"names"`
+}
+
+func (c testSectionConfig) ValidateBasic() error {
+	if c.Limit == 0 {
+		return errors.New("limit must be positive")
+	}
+	return nil
+}
+
+var testSection = Section{
+	Name: "test",
+	Template: `limit = {{ .Limit }}
+names = [{{ range .Names }}{{ printf "%q, " . }}{{end}}]
+`,
+	Default: testSectionConfig{Limit: 10, Names: []string{"a"}},
+}
+
+// withTestSection registers testSection for the duration of the test.
+func withTestSection(t *testing.T) {
+	registered := sections
+	t.Cleanup(func() { sections = registered })
+	RegisterSection(testSection)
+}
+
+func TestRegisterSection(t *testing.T) {
+	withTestSection(t)
+
+	require.Panics(t, func() { RegisterSection(testSection) })
+	require.Panics(t, func() { RegisterSection(Section{Name: "api", Default: testSectionConfig{}}) })
+	require.Panics(t, func() { RegisterSection(Section{Name: "other", Default: &testSectionConfig{}}) })
+	require.Panics(t, func() { RegisterSection(Section{Name: "other", Template: "{{", Default: testSectionConfig{}}) })
+
+	require.Len(t, RegisteredSections(), 1)
+	require.Equal(t, "test", RegisteredSections()[0].Name)
+}
+
+func TestReadSection(t *testing.T) {
+	withTestSection(t)
+
+	v := viper.New()
+	var config testSectionConfig
+	require.NoError(t, ReadSection(v, "test", &config))
+	require.Equal(t, testSection.Default, config)
+
+	v.Set("test", map[string]interface{}{"limit": "5", "names": []interface{}{"b", "c"}})
+	require.NoError(t, ReadSection(v, "test", &config))
+	require.Equal(t, testSectionConfig{Limit: 5, Names: []string{"b", "c"}}, config)
+
+	v.Set("test", map[string]interface{}{"limit": 0})
+	require.EqualError(t, ReadSection(v, "test", &config), "[test] limit must be positive")
+
+	require.Error(t, ReadSection(v, "other", &config))
+	require.Error(t, ReadSection(v, "test", config))
+}
+
+func TestValidate(t *testing.T) {
+	withTestSection(t)
+
+	contents, err := renderConfig(configTemplate, DefaultConfig(), nil)
+	require.NoError(t, err)
+	v, err := readTOML(contents)
+	require.NoError(t, err)
+	v.Set("minimum-gas-prices", "0stake")
+	require.NoError(t, Validate(v))
+
+	v.Set("minimum-gas-prices", "")
+	v.Set("telemetry.prometheus-retention-time", "abc")
+	v.Set("test.limit", 0)
+	err = Validate(v)
+	require.Error(t, err)
+	lines := strings.Split(err.Error(), "\n")
+	require.Len(t, lines, 4)
+	require.Contains(t, lines[1], "'telemetry.prometheus-retention-time'")
+	require.Contains(t, lines[2], "min gas price")
+	require.Equal(t, "  * [test] limit must be positive", lines[3])
+}
+
+func TestWriteConfigFileWithSections(t *testing.T) {
+	withTestSection(t)
+
+	contents, err := renderConfig(configTemplate, DefaultConfig(), map[string]SectionConfig{
+		"test": testSectionConfig{Limit: 3},
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasSuffix(string(contents), "\n[test]\n\nlimit = 3\nnames = []\n"))
+}
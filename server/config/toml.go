@@ -32,6 +32,11 @@ pruning = "{{ .BaseConfig.Pruning }}"
 pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# PruningAsyncQueueSize defines the number of pruning runs which can be pending
+# before the commits wait for the stores pruned in the background. 0 prunes the
+# stores in the commit.
+pruning-async-queue-size = {{ .BaseConfig.PruningAsyncQueueSize }}
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -71,6 +76,26 @@ inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 # ["message.sender", "message.recipient"]
 index-events = [{{ range .BaseConfig.IndexEvents }}{{ printf "%q, " . }}{{end}}]
 
+# EventAggregationThreshold defines the number of duplicate events of a tx result,
+# i.e. events of the same type with the same attribute keys, from which they are
+# aggregated into a single event summarizing them, keeping their identical
+# attributes, summing up their coin attributes and listing their positions in
+# the "aggregated_index" attribute. The streaming services still receive all the
+# events. 0 disables the aggregation.
+event-aggregation-threshold = {{ .BaseConfig.EventAggregationThreshold }}
+
+# MaxTxEventBytes and MaxTxLogBytes define the maximum size of the events and of
+# the log of a tx result, and MaxBlockEventBytes and MaxBlockLogBytes the maximum
+# size of the events and of the logs of all the results of a block. The size of
+# an event is the length of its type, attribute keys and attribute values. The
+# events beyond are replaced by a "truncated" event and the logs are cut, in
+# the execution order. The streaming services still receive the full results.
+# 0 disables a limit.
+max-tx-event-bytes = {{ .BaseConfig.MaxTxEventBytes }}
+max-tx-log-bytes = {{ .BaseConfig.MaxTxLogBytes }}
+max-block-event-bytes = {{ .BaseConfig.MaxBlockEventBytes }}
+max-block-log-bytes = {{ .BaseConfig.MaxBlockLogBytes }}
+
 # IavlCacheSize set the size of the iavl tree cache. 
 # Default cache size is 50mb.
 iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
@@ -81,6 +106,10 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Second fallback (if the types.DBBackend also isn't set), is the db-backend value set in Tendermint's config.toml.
 app-db-backend = "{{ .BaseConfig.AppDBBackend }}"
 
+# ShutdownTimeout defines the time, in seconds, each component of the node (e.g. the API
+# and gRPC servers) is given to shut down gracefully, before the next one is stopped.
+shutdown-timeout = {{ .BaseConfig.ShutdownTimeout }}
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -116,6 +145,28 @@ global-labels = [{{ range $k, $v := .Telemetry.GlobalLabels }}
   ["{{index $v 0 }}", "{{ index $v 1}}"],{{ end }}
 ]
 
+###############################################################################
+###                          Tracing Configuration                          ###
+###############################################################################
+
+[tracing]
+
+# Enabled enables the export of OpenTelemetry traces of the ABCI calls, txs,
+# messages and store commits to an OTLP collector, e.g. Jaeger or Tempo.
+enabled = {{ .Tracing.Enabled }}
+
+# Endpoint is the address of the OTLP gRPC collector.
+endpoint = "{{ .Tracing.Endpoint }}"
+
+# Insecure disables TLS for the connection to the collector.
+insecure = {{ .Tracing.Insecure }}
+
+# ServiceName is the service name the traces are reported under.
+service-name = "{{ .Tracing.ServiceName }}"
+
+# SampleRate is the fraction of the blocks which are traced, between 0 and 1.
+sample-rate = {{ .Tracing.SampleRate }}
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -128,6 +179,10 @@ enable = {{ .API.Enable }}
 # Swagger defines if swagger documentation should automatically be registered.
 swagger = {{ .API.Swagger }}
 
+# OpenAPI defines if the OpenAPI document of the REST API, generated from the gRPC
+# services compiled into the app, should be served at /openapi.json.
+openapi = {{ .API.OpenAPI }}
+
 # Address defines the API server to listen on.
 address = "{{ .API.Address }}"
 
@@ -182,6 +237,25 @@ enable = {{ .GRPC.Enable }}
 # Address defines the gRPC server address to bind to.
 address = "{{ .GRPC.Address }}"
 
+# EnableAdmin defines if the admin service, e.g. changing the log levels at
+# runtime, taking snapshots or inspecting the mempool and peers, should be
+# enabled, along with its REST endpoints if the API server is enabled. Only
+# enable it if the gRPC and API servers are not exposed publicly.
+enable-admin = {{ .GRPC.EnableAdmin }}
+
+# AdminToken is the token the requests to the admin service must be authorized
+# with, in the "authorization" header as "Bearer <token>". It is required if the
+# admin service is enabled.
+admin-token = "{{ .GRPC.AdminToken }}"
+
+# MaxStreamDuration defines the max duration of a streaming query, e.g. the bank
+# AllBalancesStream query, after which it is canceled. 0 doesn't limit it.
+max-stream-duration = "{{ .GRPC.MaxStreamDuration }}"
+
+# MaxConcurrentStreams defines the max number of concurrent streaming queries,
+# the streams exceeding it being rejected. 0 doesn't limit it.
+max-concurrent-streams = {{ .GRPC.MaxConcurrentStreams }}
+
 ###############################################################################
 ###                        gRPC Web Configuration                           ###
 ###############################################################################
@@ -212,6 +286,90 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+# remote-url is the location of the snapshots in an S3-compatible object storage, in the
+# s3://<bucket>[/<prefix>] form. The snapshots taken are pushed to it, and the "snapshots pull"
+# command downloads them into the local snapshot store, so that they can be shared across the
+# nodes of a fleet without the p2p snapshot discovery. The remote snapshots aren't pruned, which
+# is left to the object storage, e.g. to the lifecycle rules of the bucket. The credentials are
+# read from the standard AWS sources, e.g. the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY
+# environment variables. Empty disables the remote snapshots.
+remote-url = "{{ .StateSync.RemoteURL }}"
+
+# remote-endpoint is the URL of the S3-compatible API of the object storage, e.g.
+# https://storage.googleapis.com for GCS, AWS S3 if empty.
+remote-endpoint = "{{ .StateSync.RemoteEndpoint }}"
+
+# remote-region is the region of the bucket of the remote snapshots, us-east-1 if empty.
+remote-region = "{{ .StateSync.RemoteRegion }}"
+
+###############################################################################
+###                        Query Cache Configuration                        ###
+###############################################################################
+
+# The query cache serves the responses of repeated gRPC queries, also made through
+# the gRPC-gateway REST API, from memory. Responses are cached per height, and the
+# ones to queries for the latest height are evicted once a new block is committed.
+[query-cache]
+
+# size is the maximum number of cached responses.
+size = {{ .QueryCache.Size }}
+
+# routes are the gRPC methods whose responses are cached, e.g.
+# "/cosmos.bank.v1beta1.Query/AllBalances", or the service prefixes, ending with
+# "/", of these methods, e.g. "/cosmos.bank.v1beta1.Query/". No query is cached
+# if empty.
+routes = [{{ range .QueryCache.Routes }}{{ printf "%q, " . }}{{end}}]
+
+###############################################################################
+###                        Query Limits Configuration                       ###
+###############################################################################
+
+# The query limits protect public gRPC endpoints, also serving the gRPC-gateway
+# REST API, from unbounded queries. The limits apply to each method separately,
+# and the requests exceeding them fail with the ResourceExhausted code. A
+# streaming query counts as a single request.
+[query-limits]
+
+# gas-limit is the gas budget of a query, consumed by the store reads, e.g. when
+# iterating over large pages, of each page of the streaming queries (0 for
+# unlimited).
+gas-limit = {{ .QueryLimits.GasLimit }}
+
+# max-concurrency is the maximum number of requests to a method served
+# concurrently (0 for unlimited).
+max-concurrency = {{ .QueryLimits.MaxConcurrency }}
+
+# rate-limit is the maximum number of requests to a method per second, on
+# average (0 for unlimited).
+rate-limit = {{ .QueryLimits.RateLimit }}
+
+# rate-burst is the maximum number of requests to a method in a burst (0 for the
+# rate limit).
+rate-burst = {{ .QueryLimits.RateBurst }}
+
+# methods overrides the limits of gRPC methods, or of the methods of the service
+# prefixes ending with "/", e.g.
+# "/cosmos.bank.v1beta1.Query/AllBalances:gas-limit=100000,rate-limit=10". The
+# limits not overridden are the ones above.
+methods = [{{ range .QueryLimits.Methods }}{{ printf "%q, " . }}{{end}}]
+
+###############################################################################
+###                           Mempool Configuration                         ###
+###############################################################################
+
+# The mempool limits prevent single accounts from flooding the mempool of the
+# node. They are enforced by the app in CheckTx, in addition to the settings of
+# the [mempool] section of config.toml.
+[mempool]
+
+# max-txs-per-sender is the maximum number of txs of a sender, i.e. of the first
+# signer of the txs, pending in the mempool. 0 means unlimited.
+max-txs-per-sender = {{ .Mempool.MaxTxsPerSender }}
+
+# tx-ttl is the number of blocks the txs without a timeout height are kept in the
+# mempool, after which they are evicted on recheck. 0 means no TTL.
+tx-ttl = {{ .Mempool.TxTTL }}
 `
 
 var configTemplate *template.Template
@@ -247,16 +405,40 @@ func SetConfigTemplate(customTemplate string) {
 	}
 }
 
-// WriteConfigFile renders config using the template and writes it to
-// configFilePath.
+// WriteConfigFile renders config using the template, followed by the default
+// config of the registered sections, and writes it to configFilePath.
 func WriteConfigFile(configFilePath string, config interface{}) {
+	contents, err := renderConfig(configTemplate, config, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	mustWriteFile(configFilePath, contents, 0644)
+}
+
+// renderConfig renders config using tmpl, followed by the registered sections
+// with their config in sectionConfigs, or their default config if absent.
+func renderConfig(tmpl *template.Template, config interface{}, sectionConfigs map[string]SectionConfig) ([]byte, error) {
 	var buffer bytes.Buffer
 
-	if err := configTemplate.Execute(&buffer, config); err != nil {
-		panic(err)
+	if err := tmpl.Execute(&buffer, config); err != nil {
+		return nil, err
+	}
+
+	for _, section := range RegisteredSections() {
+		sectionConfig, ok := sectionConfigs[section.Name]
+		if !ok {
+			sectionConfig = section.Default
+		}
+
+		contents, err := section.render(sectionConfig)
+		if err != nil {
+			return nil, err
+		}
+		buffer.Write(contents)
 	}
 
-	mustWriteFile(configFilePath, buffer.Bytes(), 0644)
+	return buffer.Bytes(), nil
 }
 
 func mustWriteFile(filePath string, contents []byte, mode os.FileMode) {
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
+)
+
+// Validate validates the app config read by v, i.e. the types and the values
+// of the settings of app.toml and of the registered sections, returning an
+// error listing all the invalid settings.
+func Validate(v *viper.Viper) error {
+	var errs []string
+
+	if _, err := ParseConfig(v); err != nil {
+		errs = append(errs, decodeErrors(err)...)
+	}
+	if err := GetConfig(v).ValidateBasic(); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	for _, section := range RegisteredSections() {
+		config, err := section.decode(v.Get(section.Name))
+		if err != nil {
+			errs = append(errs, decodeErrors(err)...)
+			continue
+		}
+		if err := config.ValidateBasic(); err != nil {
+			errs = append(errs, fmt.Sprintf("[%s] %s", section.Name, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid app.toml, fix the following settings:\n  * %s", strings.Join(errs, "\n  * "))
+	}
+
+	return nil
+}
+
+// decodeErrors returns the errors of each setting which failed to be decoded.
+func decodeErrors(err error) []string {
+	var decodeErr *mapstructure.Error
+	if !errors.As(err, &decodeErr) {
+		return []string{err.Error()}
+	}
+
+	prefix := strings.TrimSuffix(err.Error(), decodeErr.Error())
+	errs := make([]string, len(decodeErr.Errors))
+	for i, e := range decodeErr.Errors {
+		errs[i] = prefix + e
+	}
+	return errs
+}
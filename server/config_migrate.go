@@ -0,0 +1,71 @@
+package server
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// FlagDryRun prints the migrated app.toml instead of writing it.
+const FlagDryRun = "dry-run"
+
+// MigrateAppConfigCmd creates a command to migrate the app.toml file of the node
+// to the current version of the template, customAppTemplate and customAppConfig
+// being the same as the ones given to InterceptConfigsPreRunHandler.
+func MigrateAppConfigCmd(customAppTemplate string, customAppConfig interface{}) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate app.toml to the current version",
+		Long: `Migrate app.toml to the current version of its template: the keys renamed or removed since
+a previous version are migrated, the settings still part of the config keep their value, the missing ones
+are added with their default value, and the unknown ones are removed. The original file is kept as
+app.toml.bak.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			appCfgFilePath := filepath.Join(ctx.Config.RootDir, "config", "app.toml")
+
+			contents, err := ioutil.ReadFile(appCfgFilePath)
+			if err != nil {
+				return err
+			}
+
+			migrated, report, err := config.Migrate(contents, customAppTemplate, customAppConfig)
+			if err != nil {
+				return err
+			}
+
+			if dryRun, _ := cmd.Flags().GetBool(FlagDryRun); dryRun {
+				cmd.Print(string(migrated))
+				return nil
+			}
+
+			if err := ioutil.WriteFile(appCfgFilePath+".bak", contents, 0o644); err != nil {
+				return err
+			}
+			if err := ioutil.WriteFile(appCfgFilePath, migrated, 0o644); err != nil {
+				return err
+			}
+
+			for _, moved := range report.Moved {
+				cmd.Printf("moved %s to %s\n", moved.From, moved.To)
+			}
+			for _, key := range report.Removed {
+				cmd.Printf("removed %s\n", key)
+			}
+			for _, key := range report.Added {
+				cmd.Printf("added %s\n", key)
+			}
+			cmd.Printf("migrated %s, the original file is kept as %s.bak\n", appCfgFilePath, appCfgFilePath)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(FlagDryRun, false, "Print the migrated app.toml instead of writing it")
+	return cmd
+}
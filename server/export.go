@@ -3,7 +3,10 @@ package server
 // DONTCOVER
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -19,6 +22,7 @@ const (
 	FlagHeight           = "height"
 	FlagForZeroHeight    = "for-zero-height"
 	FlagJailAllowedAddrs = "jail-allowed-addrs"
+	FlagStream           = "stream"
 )
 
 // ExportCmd dumps app state to JSON.
@@ -76,22 +80,10 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 				return err
 			}
 
-			doc.AppState = exported.AppState
-			doc.Validators = exported.Validators
-			doc.InitialHeight = exported.Height
-			doc.ConsensusParams = &tmtypes.ConsensusParams{
-				Block: tmtypes.BlockParams{
-					MaxBytes: exported.ConsensusParams.Block.MaxBytes,
-					MaxGas:   exported.ConsensusParams.Block.MaxGas,
-				},
-				Evidence: tmtypes.EvidenceParams{
-					MaxAgeNumBlocks: exported.ConsensusParams.Evidence.MaxAgeNumBlocks,
-					MaxAgeDuration:  exported.ConsensusParams.Evidence.MaxAgeDuration,
-					MaxBytes:        exported.ConsensusParams.Evidence.MaxBytes,
-				},
-				Validator: tmtypes.ValidatorParams{
-					PubKeyTypes: exported.ConsensusParams.Validator.PubKeyTypes,
-				},
+			setExportedApp(doc, exported)
+
+			if exported.WriteAppState != nil {
+				return writeGenesisDocStream(cmd.OutOrStderr(), doc, exported.WriteAppState)
 			}
 
 			// NOTE: Tendermint uses a custom JSON decoder for GenesisDoc
@@ -111,6 +103,52 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 	cmd.Flags().Int64(FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
 	cmd.Flags().Bool(FlagForZeroHeight, false, "Export state to start at height zero (perform preproccessing)")
 	cmd.Flags().StringSlice(FlagJailAllowedAddrs, []string{}, "Comma-separated list of operator addresses of jailed validators to unjail")
+	cmd.Flags().Bool(FlagStream, false, "Stream the app state to the output instead of holding it in memory, if supported by the app (the output keys are not sorted)")
 
 	return cmd
 }
+
+// setExportedApp sets the app state, validators, initial height and consensus params
+// of doc to the exported ones.
+func setExportedApp(doc *tmtypes.GenesisDoc, exported types.ExportedApp) {
+	doc.AppState = exported.AppState
+	doc.Validators = exported.Validators
+	doc.InitialHeight = exported.Height
+	doc.ConsensusParams = &tmtypes.ConsensusParams{
+		Block: tmtypes.BlockParams{
+			MaxBytes: exported.ConsensusParams.Block.MaxBytes,
+			MaxGas:   exported.ConsensusParams.Block.MaxGas,
+		},
+		Evidence: tmtypes.EvidenceParams{
+			MaxAgeNumBlocks: exported.ConsensusParams.Evidence.MaxAgeNumBlocks,
+			MaxAgeDuration:  exported.ConsensusParams.Evidence.MaxAgeDuration,
+			MaxBytes:        exported.ConsensusParams.Evidence.MaxBytes,
+		},
+		Validator: tmtypes.ValidatorParams{
+			PubKeyTypes: exported.ConsensusParams.Validator.PubKeyTypes,
+		},
+	}
+}
+
+// writeGenesisDocStream writes doc to w, with the app state written by writeAppState
+// instead of doc.AppState.
+func writeGenesisDocStream(w io.Writer, doc *tmtypes.GenesisDoc, writeAppState func(io.Writer) error) error {
+	doc.AppState = nil
+	encoded, err := tmjson.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	// app_state is omitted from the encoded doc when empty, append it to the doc object
+	if _, err := fmt.Fprintf(bw, `%s,"app_state":`, bytes.TrimSuffix(encoded, []byte("}"))); err != nil {
+		return err
+	}
+	if err := writeAppState(bw); err != nil {
+		return err
+	}
+	if _, err := bw.WriteString("}\n"); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
@@ -9,6 +9,7 @@ import (
 	"path"
 	"testing"
 
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 
@@ -119,6 +120,32 @@ func TestExportCmd_Height(t *testing.T) {
 
 }
 
+func TestExportCmd_Stream(t *testing.T) {
+	tempDir := t.TempDir()
+	app, ctx, _, cmd := setupApp(t, tempDir)
+
+	// the stream flag is bound to the app options by the root command of the app
+	serverCtx := ctx.Value(server.ServerContextKey).(*server.Context)
+	serverCtx.Viper.Set(server.FlagStream, true)
+
+	output := &bytes.Buffer{}
+	cmd.SetOut(output)
+	cmd.SetArgs([]string{fmt.Sprintf("--%s=%s", flags.FlagHome, tempDir), fmt.Sprintf("--%s", server.FlagStream)})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	var exportedGenDoc tmtypes.GenesisDoc
+	err := tmjson.Unmarshal(output.Bytes(), &exportedGenDoc)
+	if err != nil {
+		t.Fatalf("error unmarshaling exported genesis doc: %s", err)
+	}
+
+	exported, err := app.ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+	require.JSONEq(t, string(exported.AppState), string(exportedGenDoc.AppState))
+	require.Equal(t, exported.Height, exportedGenDoc.InitialHeight)
+	require.Equal(t, "theChainId", exportedGenDoc.ChainID)
+}
+
 func setupApp(t *testing.T, tempDir string) (*simapp.SimApp, context.Context, *tmtypes.GenesisDoc, *cobra.Command) {
 	t.Helper()
 
@@ -169,6 +196,9 @@ func setupApp(t *testing.T, tempDir string) (*simapp.SimApp, context.Context, *t
 				simApp = simapp.NewSimApp(logger, db, nil, true, map[int64]bool{}, "", 0, encCfg, appOptons)
 			}
 
+			if cast.ToBool(appOptons.Get(server.FlagStream)) {
+				return simApp.StreamAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
+			}
 			return simApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
 		}, tempDir)
 
@@ -0,0 +1,311 @@
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	serverlog "github.com/cosmos/cosmos-sdk/server/log"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// defaultMempoolLimit is the number of txs returned by the Mempool RPC if the
+// request does not set a limit.
+const defaultMempoolLimit = 30
+
+// App defines the operations of the app exposed by the admin service. They are
+// implemented by BaseApp.
+type App interface {
+	Snapshots() ([]*snapshottypes.Snapshot, error)
+	TakeSnapshot() (*snapshottypes.Snapshot, error)
+	CommitMultiStore() sdk.CommitMultiStore
+	SchedulePrune()
+	QueryCacheStats() (baseapp.QueryCacheStats, bool)
+}
+
+var _ App = (*baseapp.BaseApp)(nil)
+
+// NodeClient defines the Tendermint RPC calls made by the admin service.
+type NodeClient interface {
+	UnconfirmedTxs(ctx context.Context, limit *int) (*coretypes.ResultUnconfirmedTxs, error)
+	NetInfo(context.Context) (*coretypes.ResultNetInfo, error)
+}
+
+// Config defines what the admin service administers.
+type Config struct {
+	// Token is the token the requests must be authorized with, in the
+	// "authorization" header as "Bearer <token>". All requests are denied if empty.
+	Token string
+
+	// LogLevels are the log levels of the node.
+	LogLevels *serverlog.Levels
+
+	// App is the app of the node, nil if it does not implement App.
+	App App
+
+	// Node is the RPC client of the node, nil if Tendermint does not run in process.
+	Node NodeClient
+
+	// Metrics are the telemetry metrics of the node, nil if telemetry is disabled.
+	Metrics *telemetry.Metrics
+}
+
+// Register registers the admin service, administering what cfg defines, to the
+// provided *grpc.Server.
+func Register(srv *grpc.Server, cfg Config) {
+	RegisterAdminServiceServer(srv, adminServiceServer{cfg: cfg})
+}
+
+type adminServiceServer struct {
+	cfg Config
+}
+
+var _ AdminServiceServer = adminServiceServer{}
+
+// authorize returns an error if the request is not authorized with the admin
+// token.
+func (s adminServiceServer) authorize(ctx context.Context) error {
+	if s.cfg.Token == "" {
+		return status.Error(codes.Unauthenticated, "no admin token configured")
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, header := range md.Get("authorization") {
+		token := strings.TrimPrefix(header, "Bearer ")
+		if token != header && subtle.ConstantTimeCompare([]byte(token), []byte(s.cfg.Token)) == 1 {
+			return nil
+		}
+	}
+
+	return status.Error(codes.Unauthenticated, "invalid admin token")
+}
+
+func (s adminServiceServer) app() (App, error) {
+	if s.cfg.App == nil {
+		return nil, status.Error(codes.Unimplemented, "not supported by the app")
+	}
+
+	return s.cfg.App, nil
+}
+
+func (s adminServiceServer) node() (NodeClient, error) {
+	if s.cfg.Node == nil {
+		return nil, status.Error(codes.Unavailable, "no node running in process")
+	}
+
+	return s.cfg.Node, nil
+}
+
+// LogLevels implements the AdminServiceServer.LogLevels method.
+func (s adminServiceServer) LogLevels(ctx context.Context, _ *LogLevelsRequest) (*LogLevelsResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	return &LogLevelsResponse{LogLevels: s.cfg.LogLevels.String()}, nil
+}
+
+// SetLogLevels implements the AdminServiceServer.SetLogLevels method.
+func (s adminServiceServer) SetLogLevels(ctx context.Context, req *SetLogLevelsRequest) (*SetLogLevelsResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := s.cfg.LogLevels.Set(req.LogLevels); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &SetLogLevelsResponse{}, nil
+}
+
+// Snapshots implements the AdminServiceServer.Snapshots method.
+func (s adminServiceServer) Snapshots(ctx context.Context, _ *SnapshotsRequest) (*SnapshotsResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	app, err := s.app()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := app.Snapshots()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &SnapshotsResponse{Snapshots: snapshots}, nil
+}
+
+// TakeSnapshot implements the AdminServiceServer.TakeSnapshot method.
+func (s adminServiceServer) TakeSnapshot(ctx context.Context, _ *TakeSnapshotRequest) (*TakeSnapshotResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	app, err := s.app()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := app.TakeSnapshot()
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &TakeSnapshotResponse{Snapshot: snapshot}, nil
+}
+
+// Pruning implements the AdminServiceServer.Pruning method.
+func (s adminServiceServer) Pruning(ctx context.Context, _ *PruningRequest) (*PruningResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	app, err := s.app()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := app.CommitMultiStore().GetPruning()
+	return &PruningResponse{
+		Strategy:   opts.GetPruningStrategy().String(),
+		KeepRecent: opts.KeepRecent,
+		Interval:   opts.Interval,
+	}, nil
+}
+
+// Prune implements the AdminServiceServer.Prune method.
+func (s adminServiceServer) Prune(ctx context.Context, _ *PruneRequest) (*PruneResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	app, err := s.app()
+	if err != nil {
+		return nil, err
+	}
+
+	app.SchedulePrune()
+	return &PruneResponse{}, nil
+}
+
+// Mempool implements the AdminServiceServer.Mempool method.
+func (s adminServiceServer) Mempool(ctx context.Context, req *MempoolRequest) (*MempoolResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	node, err := s.node()
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultMempoolLimit
+	}
+
+	res, err := node.UnconfirmedTxs(ctx, &limit)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	txs := make([]*MempoolTx, len(res.Txs))
+	for i, tx := range res.Txs {
+		txs[i] = &MempoolTx{Hash: fmt.Sprintf("%X", tx.Hash()), Tx: tx}
+	}
+
+	return &MempoolResponse{Total: int64(res.Total), TotalBytes: res.TotalBytes, Txs: txs}, nil
+}
+
+// Peers implements the AdminServiceServer.Peers method.
+func (s adminServiceServer) Peers(ctx context.Context, _ *PeersRequest) (*PeersResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	node, err := s.node()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := node.NetInfo(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	peers := make([]*Peer, len(res.Peers))
+	for i, peer := range res.Peers {
+		peers[i] = &Peer{NodeId: string(peer.ID), Url: peer.URL}
+	}
+
+	return &PeersResponse{Peers: peers}, nil
+}
+
+// QueryCacheStats implements the AdminServiceServer.QueryCacheStats method.
+func (s adminServiceServer) QueryCacheStats(ctx context.Context, _ *QueryCacheStatsRequest) (*QueryCacheStatsResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	app, err := s.app()
+	if err != nil {
+		return nil, err
+	}
+
+	stats, enabled := app.QueryCacheStats()
+	return &QueryCacheStatsResponse{
+		Enabled:  enabled,
+		Hits:     stats.Hits,
+		Misses:   stats.Misses,
+		Entries:  uint64(stats.Size),
+		Capacity: uint64(stats.Capacity),
+	}, nil
+}
+
+// Metrics implements the AdminServiceServer.Metrics method.
+func (s adminServiceServer) Metrics(ctx context.Context, req *MetricsRequest) (*MetricsResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	switch req.Format {
+	case telemetry.FormatDefault, telemetry.FormatText, telemetry.FormatPrometheus:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported metrics format: %s", req.Format)
+	}
+
+	if s.cfg.Metrics == nil {
+		return nil, status.Error(codes.FailedPrecondition, "telemetry is not enabled")
+	}
+
+	gr, err := s.cfg.Metrics.Gather(req.Format)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &MetricsResponse{ContentType: gr.ContentType, Metrics: gr.Metrics}, nil
+}
@@ -0,0 +1,194 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	serverlog "github.com/cosmos/cosmos-sdk/server/log"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const testToken = "secret"
+
+type mockApp struct {
+	cms            sdk.CommitMultiStore
+	snapshots      []*snapshottypes.Snapshot
+	pruneScheduled bool
+}
+
+func (a *mockApp) Snapshots() ([]*snapshottypes.Snapshot, error) { return a.snapshots, nil }
+
+func (a *mockApp) TakeSnapshot() (*snapshottypes.Snapshot, error) {
+	snapshot := &snapshottypes.Snapshot{Height: uint64(len(a.snapshots) + 1), Format: snapshottypes.CurrentFormat}
+	a.snapshots = append([]*snapshottypes.Snapshot{snapshot}, a.snapshots...)
+	return snapshot, nil
+}
+
+func (a *mockApp) CommitMultiStore() sdk.CommitMultiStore { return a.cms }
+
+func (a *mockApp) SchedulePrune() { a.pruneScheduled = true }
+
+func (a *mockApp) QueryCacheStats() (baseapp.QueryCacheStats, bool) {
+	return baseapp.QueryCacheStats{Hits: 3, Misses: 1, Size: 1, Capacity: 10}, true
+}
+
+type mockNodeClient struct {
+	txs []tmtypes.Tx
+}
+
+func (c mockNodeClient) UnconfirmedTxs(_ context.Context, limit *int) (*coretypes.ResultUnconfirmedTxs, error) {
+	txs := c.txs
+	if len(txs) > *limit {
+		txs = txs[:*limit]
+	}
+
+	return &coretypes.ResultUnconfirmedTxs{Count: len(txs), Total: len(c.txs), TotalBytes: 6, Txs: txs}, nil
+}
+
+func (c mockNodeClient) NetInfo(context.Context) (*coretypes.ResultNetInfo, error) {
+	return &coretypes.ResultNetInfo{
+		NPeers: 1,
+		Peers:  []coretypes.Peer{{ID: "abcd", URL: "tcp://abcd@127.0.0.1:26656"}},
+	}, nil
+}
+
+func authorizedCtx(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestAdminService_Authorize(t *testing.T) {
+	srv := adminServiceServer{cfg: Config{Token: testToken}}
+	require.NoError(t, srv.authorize(authorizedCtx(testToken)))
+
+	for _, ctx := range []context.Context{
+		context.Background(),
+		authorizedCtx("wrong"),
+		metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", testToken)),
+	} {
+		require.Equal(t, codes.Unauthenticated, status.Code(srv.authorize(ctx)))
+	}
+
+	srv = adminServiceServer{cfg: Config{}}
+	require.Equal(t, codes.Unauthenticated, status.Code(srv.authorize(authorizedCtx(""))))
+}
+
+func TestAdminService_LogLevels(t *testing.T) {
+	levels, err := serverlog.ParseLevels("info")
+	require.NoError(t, err)
+	srv := adminServiceServer{cfg: Config{Token: testToken, LogLevels: levels}}
+	ctx := authorizedCtx(testToken)
+
+	_, err = srv.LogLevels(context.Background(), &LogLevelsRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	res, err := srv.LogLevels(ctx, &LogLevelsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "info", res.LogLevels)
+
+	_, err = srv.SetLogLevels(ctx, &SetLogLevelsRequest{LogLevels: "x/staking:debug,*:error"})
+	require.NoError(t, err)
+
+	res, err = srv.LogLevels(ctx, &LogLevelsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "x/staking:debug,*:error", res.LogLevels)
+
+	_, err = srv.SetLogLevels(ctx, &SetLogLevelsRequest{LogLevels: "x/staking:verbose"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, "x/staking:debug,*:error", levels.String())
+}
+
+func TestAdminService_App(t *testing.T) {
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	cms.SetPruning(pruningtypes.NewCustomPruningOptions(100, 10))
+	app := &mockApp{cms: cms}
+	srv := adminServiceServer{cfg: Config{Token: testToken, App: app}}
+	ctx := authorizedCtx(testToken)
+
+	takeRes, err := srv.TakeSnapshot(ctx, &TakeSnapshotRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), takeRes.Snapshot.Height)
+
+	snapshotsRes, err := srv.Snapshots(ctx, &SnapshotsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*snapshottypes.Snapshot{takeRes.Snapshot}, snapshotsRes.Snapshots)
+
+	pruningRes, err := srv.Pruning(ctx, &PruningRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &PruningResponse{Strategy: "custom", KeepRecent: 100, Interval: 10}, pruningRes)
+
+	_, err = srv.Prune(ctx, &PruneRequest{})
+	require.NoError(t, err)
+	require.True(t, app.pruneScheduled)
+
+	statsRes, err := srv.QueryCacheStats(ctx, &QueryCacheStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &QueryCacheStatsResponse{Enabled: true, Hits: 3, Misses: 1, Entries: 1, Capacity: 10}, statsRes)
+
+	srv = adminServiceServer{cfg: Config{Token: testToken}}
+	_, err = srv.TakeSnapshot(ctx, &TakeSnapshotRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestAdminService_Node(t *testing.T) {
+	node := mockNodeClient{txs: []tmtypes.Tx{[]byte("tx1"), []byte("tx2")}}
+	srv := adminServiceServer{cfg: Config{Token: testToken, Node: node}}
+	ctx := authorizedCtx(testToken)
+
+	mempoolRes, err := srv.Mempool(ctx, &MempoolRequest{Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), mempoolRes.Total)
+	require.Equal(t, int64(6), mempoolRes.TotalBytes)
+	require.Len(t, mempoolRes.Txs, 1)
+	require.Equal(t, []byte("tx1"), mempoolRes.Txs[0].Tx)
+	require.Equal(t, fmt.Sprintf("%X", node.txs[0].Hash()), mempoolRes.Txs[0].Hash)
+
+	peersRes, err := srv.Peers(ctx, &PeersRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*Peer{{NodeId: "abcd", Url: "tcp://abcd@127.0.0.1:26656"}}, peersRes.Peers)
+
+	srv = adminServiceServer{cfg: Config{Token: testToken}}
+	_, err = srv.Peers(ctx, &PeersRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestAdminService_Metrics(t *testing.T) {
+	ctx := authorizedCtx(testToken)
+
+	srv := adminServiceServer{cfg: Config{Token: testToken}}
+	_, err := srv.Metrics(ctx, &MetricsRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	metrics, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "test"})
+	require.NoError(t, err)
+	telemetry.IncrCounter(1, "admin_test")
+	srv = adminServiceServer{cfg: Config{Token: testToken, Metrics: metrics}}
+
+	_, err = srv.Metrics(context.Background(), &MetricsRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	res, err := srv.Metrics(ctx, &MetricsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "application/json", res.ContentType)
+	require.Contains(t, string(res.Metrics), "admin_test")
+
+	_, err = srv.Metrics(ctx, &MetricsRequest{Format: telemetry.FormatPrometheus})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = srv.Metrics(ctx, &MetricsRequest{Format: "xml"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
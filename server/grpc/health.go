@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// healthCheckInterval is the interval at which the serving status of the health
+// service is updated from the sync status of the node.
+const healthCheckInterval = 5 * time.Second
+
+// statusClient is the part of the Tendermint client needed to check whether the
+// node is catching up.
+type statusClient interface {
+	Status(context.Context) (*coretypes.ResultStatus, error)
+}
+
+// watchNodeHealth sets the serving status of the gRPC server to SERVING while
+// the node is synced, and to NOT_SERVING while it is catching up or its status
+// is unknown, until ctx is done.
+func watchNodeHealth(ctx context.Context, healthSrv *health.Server, client statusClient, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		healthSrv.SetServingStatus("", nodeServingStatus(ctx, client))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// nodeServingStatus returns the serving status corresponding to the sync status
+// of the node.
+func nodeServingStatus(ctx context.Context, client statusClient) healthpb.HealthCheckResponse_ServingStatus {
+	status, err := client.Status(ctx)
+	if err != nil || status.SyncInfo.CatchingUp {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	return healthpb.HealthCheckResponse_SERVING
+}
@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type mockStatusClient struct {
+	statuses chan *coretypes.ResultStatus
+}
+
+func (c mockStatusClient) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	select {
+	case status := <-c.statuses:
+		if status == nil {
+			return nil, errors.New("node unavailable")
+		}
+		return status, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func TestWatchNodeHealth(t *testing.T) {
+	healthSrv := health.NewServer()
+	client := mockStatusClient{statuses: make(chan *coretypes.ResultStatus)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		watchNodeHealth(ctx, healthSrv, client, time.Millisecond)
+		close(done)
+	}()
+
+	requireStatus := func(status *coretypes.ResultStatus, expected healthpb.HealthCheckResponse_ServingStatus) {
+		client.statuses <- status
+		require.Eventually(t, func() bool {
+			res, err := healthSrv.Check(context.Background(), &healthpb.HealthCheckRequest{})
+			return err == nil && res.Status == expected
+		}, time.Second, time.Millisecond)
+	}
+
+	requireStatus(&coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{CatchingUp: true}}, healthpb.HealthCheckResponse_NOT_SERVING)
+	requireStatus(&coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{CatchingUp: false}}, healthpb.HealthCheckResponse_SERVING)
+	requireStatus(nil, healthpb.HealthCheckResponse_NOT_SERVING)
+	requireStatus(&coretypes.ResultStatus{}, healthpb.HealthCheckResponse_SERVING)
+
+	cancel()
+	<-done
+}
@@ -0,0 +1,203 @@
+package grpc
+
+import (
+	"context"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// methodLimiter limits the requests to a method.
+type methodLimiter struct {
+	limits config.QueryLimits
+	slots  chan struct{} // nil if the concurrency is unlimited
+	rate   *rate.Limiter // nil if the rate is unlimited
+}
+
+func newMethodLimiter(limits config.QueryLimits) *methodLimiter {
+	l := &methodLimiter{limits: limits}
+	if limits.MaxConcurrency > 0 {
+		l.slots = make(chan struct{}, limits.MaxConcurrency)
+	}
+	if limits.RateLimit > 0 {
+		burst := limits.RateBurst
+		if burst == 0 {
+			burst = int(math.Ceil(limits.RateLimit))
+		}
+		l.rate = rate.NewLimiter(rate.Limit(limits.RateLimit), burst)
+	}
+
+	return l
+}
+
+// queryLimiter limits the requests to each method of the gRPC server.
+type queryLimiter struct {
+	defaults config.QueryLimits
+	methods  map[string]config.QueryLimits
+
+	mtx      sync.Mutex
+	limiters map[string]*methodLimiter
+}
+
+// NewQueryLimitsInterceptor returns an interceptor of the gRPC server limiting
+// the requests to each method, with the limits of the method in methods, of its
+// service prefix ending with "/", or the default ones. The requests exceeding
+// the concurrency or rate limits fail with the ResourceExhausted code, and the
+// gas the queries to the app may consume is limited.
+func NewQueryLimitsInterceptor(defaults config.QueryLimits, methods map[string]config.QueryLimits) grpc.UnaryServerInterceptor {
+	limiter := &queryLimiter{
+		defaults: defaults,
+		methods:  methods,
+		limiters: make(map[string]*methodLimiter),
+	}
+
+	return limiter.intercept
+}
+
+// limiter returns the limiter of a method, created on its first request.
+func (q *queryLimiter) limiter(method string) *methodLimiter {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	if l, ok := q.limiters[method]; ok {
+		return l
+	}
+
+	limits, ok := q.methods[method]
+	if !ok {
+		// the limits of the longest matching service prefix apply
+		prefix := ""
+		for route, routeLimits := range q.methods {
+			if strings.HasSuffix(route, "/") && strings.HasPrefix(method, route) && len(route) > len(prefix) {
+				prefix, limits, ok = route, routeLimits, true
+			}
+		}
+	}
+	if !ok {
+		limits = q.defaults
+	}
+
+	l := newMethodLimiter(limits)
+	q.limiters[method] = l
+	return l
+}
+
+// NewQueryLimitsStreamInterceptor returns a stream interceptor of the gRPC
+// server applying the limits of NewQueryLimitsInterceptor to the streaming
+// queries: a stream counts as a single request for the rate limit, holds a
+// concurrency slot until it ends, and the gas limit applies to each page
+// streamed.
+func NewQueryLimitsStreamInterceptor(defaults config.QueryLimits, methods map[string]config.QueryLimits) grpc.StreamServerInterceptor {
+	limiter := &queryLimiter{
+		defaults: defaults,
+		methods:  methods,
+		limiters: make(map[string]*methodLimiter),
+	}
+
+	return limiter.interceptStream
+}
+
+// acquire checks the rate and concurrency limits of a request to method,
+// returning the limits of the method and a function releasing its concurrency
+// slot once the request is handled.
+func (q *queryLimiter) acquire(method string) (config.QueryLimits, func(), error) {
+	l := q.limiter(method)
+
+	if l.rate != nil && !l.rate.Allow() {
+		telemetry.IncrCounter(1, "query_limits", "rate_limited")
+		return config.QueryLimits{}, nil, status.Errorf(codes.ResourceExhausted, "rate limit of %s exceeded", method)
+	}
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+			return l.limits, func() { <-l.slots }, nil
+		default:
+			telemetry.IncrCounter(1, "query_limits", "concurrency_limited")
+			return config.QueryLimits{}, nil, status.Errorf(codes.ResourceExhausted, "too many concurrent requests to %s", method)
+		}
+	}
+
+	return l.limits, func() {}, nil
+}
+
+func (q *queryLimiter) intercept(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	limits, release, err := q.acquire(info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if limits.GasLimit > 0 {
+		ctx = baseapp.ContextWithQueryGasLimit(ctx, limits.GasLimit)
+	}
+
+	return handler(ctx, req)
+}
+
+func (q *queryLimiter) interceptStream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	limits, release, err := q.acquire(info.FullMethod)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if limits.GasLimit > 0 {
+		stream = &contextServerStream{ServerStream: stream, ctx: baseapp.ContextWithQueryGasLimit(stream.Context(), limits.GasLimit)}
+	}
+
+	return handler(srv, stream)
+}
+
+// NewStreamLimitsInterceptor returns a stream interceptor of the gRPC server
+// limiting the streaming queries: the context of a stream is canceled after
+// maxDuration, the query.StreamPages queries stopping before their next page
+// with the DeadlineExceeded code, and the streams exceeding maxConcurrent
+// concurrent streams fail with the ResourceExhausted code. A zero limit doesn't
+// limit the streams.
+func NewStreamLimitsInterceptor(maxDuration time.Duration, maxConcurrent uint32) grpc.StreamServerInterceptor {
+	var slots chan struct{}
+	if maxConcurrent > 0 {
+		slots = make(chan struct{}, maxConcurrent)
+	}
+
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if slots != nil {
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			default:
+				telemetry.IncrCounter(1, "query_limits", "streams_limited")
+				return status.Errorf(codes.ResourceExhausted, "too many concurrent streams, calling %s", info.FullMethod)
+			}
+		}
+
+		if maxDuration > 0 {
+			ctx, cancel := context.WithTimeout(stream.Context(), maxDuration)
+			defer cancel()
+			stream = &contextServerStream{ServerStream: stream, ctx: ctx}
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+// contextServerStream is a server stream with the context of an interceptor,
+// e.g. with a deadline or a query gas limit.
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context implements grpc.ServerStream.
+func (s *contextServerStream) Context() context.Context { return s.ctx }
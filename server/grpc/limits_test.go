@@ -0,0 +1,178 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+const (
+	balanceMethod  = "/cosmos.bank.v1beta1.Query/Balance"
+	balancesMethod = "/cosmos.bank.v1beta1.Query/AllBalances"
+
+	balancesStreamMethod = "/cosmos.bank.v1beta1.Query/AllBalancesStream"
+)
+
+func okHandler(context.Context, interface{}) (interface{}, error) { return "res", nil }
+
+func TestQueryLimitsInterceptor_Rate(t *testing.T) {
+	intercept := NewQueryLimitsInterceptor(config.QueryLimits{}, map[string]config.QueryLimits{
+		balanceMethod: {RateLimit: 0.001, RateBurst: 2},
+	})
+	info := &grpc.UnaryServerInfo{FullMethod: balanceMethod}
+
+	for i := 0; i < 2; i++ {
+		res, err := intercept(context.Background(), nil, info, okHandler)
+		require.NoError(t, err)
+		require.Equal(t, "res", res)
+	}
+	_, err := intercept(context.Background(), nil, info, okHandler)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// the limits apply to each method separately, and the other methods are unlimited
+	for i := 0; i < 3; i++ {
+		_, err = intercept(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: balancesMethod}, okHandler)
+		require.NoError(t, err)
+	}
+}
+
+func TestQueryLimitsInterceptor_Concurrency(t *testing.T) {
+	intercept := NewQueryLimitsInterceptor(config.QueryLimits{MaxConcurrency: 1}, nil)
+	info := &grpc.UnaryServerInfo{FullMethod: balanceMethod}
+
+	started, release := make(chan struct{}), make(chan struct{})
+	done := make(chan error)
+	go func() {
+		_, err := intercept(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) {
+			close(started)
+			<-release
+			return nil, nil
+		})
+		done <- err
+	}()
+	<-started
+
+	_, err := intercept(context.Background(), nil, info, okHandler)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	_, err = intercept(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: balancesMethod}, okHandler)
+	require.NoError(t, err)
+
+	close(release)
+	require.NoError(t, <-done)
+	_, err = intercept(context.Background(), nil, info, okHandler)
+	require.NoError(t, err)
+}
+
+func TestQueryLimitsInterceptor_GasLimit(t *testing.T) {
+	intercept := NewQueryLimitsInterceptor(config.QueryLimits{GasLimit: 1000}, map[string]config.QueryLimits{
+		"/cosmos.bank.v1beta1.Query/": {GasLimit: 10},
+		balanceMethod:                 {},
+	})
+
+	gasLimit := func(method string) (limit uint64, ok bool) {
+		_, err := intercept(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			limit, ok = baseapp.QueryGasLimitFromContext(ctx)
+			return nil, nil
+		})
+		require.NoError(t, err)
+		return limit, ok
+	}
+
+	limit, ok := gasLimit("/cosmos.staking.v1beta1.Query/Validators")
+	require.True(t, ok)
+	require.Equal(t, uint64(1000), limit)
+
+	// the limits of the method override the ones of its service
+	limit, ok = gasLimit(balancesMethod)
+	require.True(t, ok)
+	require.Equal(t, uint64(10), limit)
+
+	_, ok = gasLimit(balanceMethod)
+	require.False(t, ok)
+}
+
+// testServerStream is a server stream with a context.
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s testServerStream) Context() context.Context { return s.ctx }
+
+func TestStreamLimitsInterceptor_Concurrency(t *testing.T) {
+	intercept := NewStreamLimitsInterceptor(0, 1)
+	info := &grpc.StreamServerInfo{FullMethod: balancesStreamMethod, IsServerStream: true}
+	stream := testServerStream{ctx: context.Background()}
+	okStreamHandler := func(interface{}, grpc.ServerStream) error { return nil }
+
+	started, release := make(chan struct{}), make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- intercept(nil, stream, info, func(interface{}, grpc.ServerStream) error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	err := intercept(nil, stream, info, okStreamHandler)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	close(release)
+	require.NoError(t, <-done)
+	require.NoError(t, intercept(nil, stream, info, okStreamHandler))
+}
+
+func TestStreamLimitsInterceptor_Duration(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: balancesStreamMethod, IsServerStream: true}
+	stream := testServerStream{ctx: context.Background()}
+
+	deadline := func(intercept grpc.StreamServerInterceptor) (deadline time.Time, ok bool) {
+		err := intercept(nil, stream, info, func(_ interface{}, stream grpc.ServerStream) error {
+			deadline, ok = stream.Context().Deadline()
+			return nil
+		})
+		require.NoError(t, err)
+		return deadline, ok
+	}
+
+	d, ok := deadline(NewStreamLimitsInterceptor(time.Minute, 0))
+	require.True(t, ok)
+	require.WithinDuration(t, time.Now().Add(time.Minute), d, time.Second)
+
+	_, ok = deadline(NewStreamLimitsInterceptor(0, 0))
+	require.False(t, ok)
+}
+
+func TestQueryLimitsStreamInterceptor(t *testing.T) {
+	intercept := NewQueryLimitsStreamInterceptor(config.QueryLimits{}, map[string]config.QueryLimits{
+		balancesStreamMethod: {RateLimit: 0.001, RateBurst: 1, GasLimit: 10},
+	})
+	info := &grpc.StreamServerInfo{FullMethod: balancesStreamMethod, IsServerStream: true}
+	stream := testServerStream{ctx: context.Background()}
+
+	// the gas limit of the method is set in the context of the stream
+	var limit uint64
+	var ok bool
+	err := intercept(nil, stream, info, func(_ interface{}, stream grpc.ServerStream) error {
+		limit, ok = baseapp.QueryGasLimitFromContext(stream.Context())
+		return nil
+	})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(10), limit)
+
+	// and a stream counts as a request for the rate limit
+	err = intercept(nil, stream, info, func(interface{}, grpc.ServerStream) error { return nil })
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
@@ -1,25 +1,35 @@
 package grpc
 
 import (
+	"context"
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"net"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/grpc/admin"
 	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
 	reflection "github.com/cosmos/cosmos-sdk/server/grpc/reflection/v2alpha1"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// StartGRPCServer starts a gRPC server on the given address.
-func StartGRPCServer(clientCtx client.Context, app types.Application, address string) (*grpc.Server, error) {
-	grpcSrv := grpc.NewServer(
+// StartGRPCServer starts a gRPC server on the given address. Along with the
+// services of the app, it serves the standard reflection and health checking
+// services, the latter reporting the server as not serving while the node of the
+// client context, if any, is catching up. The admin service is registered if
+// adminCfg is not nil. The options, e.g. the interceptor returned by
+// NewQueryLimitsInterceptor, configure the server.
+func StartGRPCServer(clientCtx client.Context, app types.Application, address string, adminCfg *admin.Config, opts ...grpc.ServerOption) (*grpc.Server, error) {
+	opts = append([]grpc.ServerOption{
 		grpc.ForceServerCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
-	)
+	}, opts...)
+	grpcSrv := grpc.NewServer(opts...)
 
 	app.RegisterGRPCServer(grpcSrv)
 	// reflection allows consumers to build dynamic clients that can write
@@ -42,13 +52,30 @@ func StartGRPCServer(clientCtx client.Context, app types.Application, address st
 	// Reflection allows external clients to see what services and methods
 	// the gRPC server exposes.
 	gogoreflection.Register(grpcSrv)
+	if adminCfg != nil {
+		admin.Register(grpcSrv, *adminCfg)
+	}
+
+	// the health service reports whether the node is synced, so that load
+	// balancers only route requests to nodes serving up to date state
+	healthSrv := health.NewServer()
+	healthpb.RegisterHealthServer(grpcSrv, healthSrv)
+
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	if clientCtx.Client != nil {
+		go watchNodeHealth(healthCtx, healthSrv, clientCtx.Client, healthCheckInterval)
+	}
+
 	listener, err := net.Listen("tcp", address)
 	if err != nil {
+		stopHealth()
 		return nil, err
 	}
 
 	errCh := make(chan error)
 	go func() {
+		defer stopHealth()
+
 		err = grpcSrv.Serve(listener)
 		if err != nil {
 			errCh <- fmt.Errorf("failed to serve: %w", err)
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"io"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 
@@ -28,6 +30,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -112,6 +115,65 @@ func (s *IntegrationTestSuite) TestGRPCServer_BankBalance() {
 	s.Require().Equal([]string{"1"}, blockHeight)
 }
 
+func (s *IntegrationTestSuite) TestGRPCServer_StreamingQueries() {
+	val0 := s.network.Validators[0]
+
+	// the balances are streamed one page per message
+	bankClient := banktypes.NewQueryClient(s.conn)
+	balancesStream, err := bankClient.AllBalancesStream(
+		context.Background(),
+		&banktypes.QueryAllBalancesStreamRequest{Address: val0.Address.String(), Pagination: &query.PageRequest{Limit: 1}},
+	)
+	s.Require().NoError(err)
+
+	var balances sdk.Coins
+	pages := 0
+	for {
+		res, err := balancesStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		s.Require().NoError(err)
+		s.Require().Len(res.Balances, 1)
+		balances = append(balances, res.Balances...)
+		pages++
+	}
+	s.Require().Equal(2, pages)
+	balancesRes, err := bankClient.AllBalances(context.Background(), &banktypes.QueryAllBalancesRequest{Address: val0.Address.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(balancesRes.Balances, balances)
+
+	// the stream is queried at the height of its header
+	header, err := balancesStream.Header()
+	s.Require().NoError(err)
+	s.Require().NotEmpty(header.Get(grpctypes.GRPCBlockHeightHeader))
+
+	stakingClient := stakingtypes.NewQueryClient(s.conn)
+	validatorsStream, err := stakingClient.ValidatorsStream(
+		metadata.AppendToOutgoingContext(context.Background(), grpctypes.GRPCBlockHeightHeader, "1"),
+		&stakingtypes.QueryValidatorsStreamRequest{},
+	)
+	s.Require().NoError(err)
+	res, err := validatorsStream.Recv()
+	s.Require().NoError(err)
+	s.Require().Len(res.Validators, 1)
+	s.Require().Equal(val0.ValAddress.String(), res.Validators[0].OperatorAddress)
+	_, err = validatorsStream.Recv()
+	s.Require().Equal(io.EOF, err)
+	header, err = validatorsStream.Header()
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"1"}, header.Get(grpctypes.GRPCBlockHeightHeader))
+
+	// an invalid height header fails the stream
+	validatorsStream, err = stakingClient.ValidatorsStream(
+		metadata.AppendToOutgoingContext(context.Background(), grpctypes.GRPCBlockHeightHeader, "-1"),
+		&stakingtypes.QueryValidatorsStreamRequest{},
+	)
+	s.Require().NoError(err)
+	_, err = validatorsStream.Recv()
+	s.Require().ErrorContains(err, "height < 0")
+}
+
 func (s *IntegrationTestSuite) TestGRPCServer_Reflection() {
 	// Test server reflection
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
@@ -135,6 +197,26 @@ func (s *IntegrationTestSuite) TestGRPCServer_Reflection() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGRPCServer_Health() {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	// the node of the network is synced
+	res, err := healthpb.NewHealthClient(s.conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(healthpb.HealthCheckResponse_SERVING, res.Status)
+
+	// the health service is exposed by reflection along with the services of the app
+	rc := grpcreflect.NewClient(ctx, rpb.NewServerReflectionClient(s.conn))
+	services, err := rc.ListServices()
+	s.Require().NoError(err)
+	s.Require().Contains(services, "grpc.health.v1.Health")
+	s.Require().Contains(services, "cosmos.bank.v1beta1.Query")
+
+	_, err = rc.ResolveService("grpc.health.v1.Health")
+	s.Require().NoError(err)
+}
+
 func (s *IntegrationTestSuite) TestGRPCServer_InterfaceReflection() {
 	// this tests the application reflection capabilities and compatibility between v1 and v2
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	pvm "github.com/tendermint/tendermint/privval"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	FlagVotingPeriod         = "voting-period"
+	FlagDowntimeJailDuration = "downtime-jail-duration"
+)
+
+// InPlaceTestnetCmd creates a command turning the state of a node into the genesis of
+// a single validator testnet, operated with the node's validator key.
+func InPlaceTestnetCmd(testnetExporter types.InPlaceTestnetExporter, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "in-place-testnet [new-chain-id] [operator-address]",
+		Short: "Turn the state of this node into a single validator testnet",
+		Long: `Turn the latest state of this node, e.g. synced with a mainnet, into the genesis of a
+new chain with the given chain ID, validated by this node alone, to rehearse upgrades
+against a fork of the mainnet state.
+
+The validator set is replaced by a validator using the consensus key of this node, operated
+by the given account address, whose self-delegation is minted. The governance voting and
+deposit periods, and the downtime jail duration are shortened for fast testing.
+
+The genesis file is replaced by the one of the testnet, and the data directory is moved,
+along with the previous genesis file, to a backup directory next to it. Start the node to
+boot the testnet.
+`,
+		Example: fmt.Sprintf("%s in-place-testnet testnet-1 cosmos1... --%s=2m", version.AppName, FlagVotingPeriod),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			chainID := args[0]
+			operatorAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			votingPeriod, _ := cmd.Flags().GetDuration(FlagVotingPeriod)
+			downtimeJailDuration, _ := cmd.Flags().GetDuration(FlagDowntimeJailDuration)
+
+			privValidator, err := pvm.LoadFilePV(config.PrivValidator.KeyFile(), config.PrivValidator.StateFile())
+			if err != nil {
+				return err
+			}
+			pk, err := privValidator.GetPubKey(cmd.Context())
+			if err != nil {
+				return err
+			}
+			valPubKey, err := cryptocodec.FromTmPubKeyInterface(pk)
+			if err != nil {
+				return err
+			}
+
+			doc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(config.RootDir, GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+
+			traceWriterFile, _ := cmd.Flags().GetString(flagTraceStore)
+			traceWriter, err := openTraceWriter(traceWriterFile)
+			if err != nil {
+				return err
+			}
+
+			exported, err := testnetExporter(serverCtx.Logger, db, traceWriter, types.InPlaceTestnetConfig{
+				ValidatorPubKey:      valPubKey,
+				OperatorAddress:      operatorAddr,
+				VotingPeriod:         votingPeriod,
+				DowntimeJailDuration: downtimeJailDuration,
+			}, serverCtx.Viper)
+			if err != nil {
+				return fmt.Errorf("error exporting testnet state: %v", err)
+			}
+			if err := db.Close(); err != nil {
+				return err
+			}
+
+			doc.ChainID = chainID
+			setExportedApp(doc, exported)
+			if err := doc.ValidateAndComplete(); err != nil {
+				return err
+			}
+
+			// keep the state of the node in a backup, the testnet starts from genesis
+			backupDir := fmt.Sprintf("%s.bak-%d", config.DBDir(), exported.Height-1)
+			if _, err := os.Stat(backupDir); !os.IsNotExist(err) {
+				return fmt.Errorf("backup directory %s already exists", backupDir)
+			}
+			if err := os.Rename(config.DBDir(), backupDir); err != nil {
+				return err
+			}
+			if err := os.Rename(config.GenesisFile(), filepath.Join(backupDir, filepath.Base(config.GenesisFile()))); err != nil {
+				return err
+			}
+			if err := os.MkdirAll(config.DBDir(), 0o700); err != nil {
+				return err
+			}
+			if err := doc.SaveAs(config.GenesisFile()); err != nil {
+				return err
+			}
+
+			// the validator signing state was moved to the backup if kept in the data
+			// directory, reset it for the testnet
+			privValidator, err = pvm.LoadFilePVEmptyState(config.PrivValidator.KeyFile(), config.PrivValidator.StateFile())
+			if err != nil {
+				return err
+			}
+			privValidator.Save()
+
+			cmd.Printf("Created testnet %s at height %d, the previous state of the node is backed up in %s\n", chainID, exported.Height, backupDir)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Duration(FlagVotingPeriod, time.Minute, "Governance voting and maximum deposit period of the testnet")
+	cmd.Flags().Duration(FlagDowntimeJailDuration, time.Minute, "Duration validators of the testnet are jailed for downtime")
+
+	return cmd
+}
@@ -0,0 +1,77 @@
+package server_test
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	pvm "github.com/tendermint/tendermint/privval"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestInPlaceTestnetCmd(t *testing.T) {
+	tempDir := t.TempDir()
+	app, ctx, _, _ := setupApp(t, tempDir)
+
+	serverCtx := ctx.Value(server.ServerContextKey).(*server.Context)
+	config := serverCtx.Config
+	config.SetRoot(tempDir)
+	require.NoError(t, os.MkdirAll(config.DBDir(), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(config.DBDir(), "marker"), nil, 0o600))
+	privValidator, err := pvm.GenFilePV(config.PrivValidator.KeyFile(), config.PrivValidator.StateFile(), ed25519.KeyType)
+	require.NoError(t, err)
+	privValidator.Save()
+
+	var exportCfg types.InPlaceTestnetConfig
+	cmd := server.InPlaceTestnetCmd(
+		func(_ log.Logger, _ dbm.DB, _ io.Writer, cfg types.InPlaceTestnetConfig, _ types.AppOptions) (types.ExportedApp, error) {
+			exportCfg = cfg
+			return app.ExportAppStateAndValidatorsForTestnet(cfg)
+		}, tempDir)
+
+	operatorAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	cmd.SetArgs([]string{
+		"testnet-1", operatorAddr.String(),
+		fmt.Sprintf("--%s=%s", flags.FlagHome, tempDir),
+		fmt.Sprintf("--%s=2m", server.FlagVotingPeriod),
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	require.Equal(t, operatorAddr, exportCfg.OperatorAddress)
+	require.Equal(t, 2*time.Minute, exportCfg.VotingPeriod)
+	require.Equal(t, time.Minute, exportCfg.DowntimeJailDuration)
+
+	// the genesis of the testnet is validated by the node's validator key
+	doc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+	require.NoError(t, err)
+	require.Equal(t, "testnet-1", doc.ChainID)
+	require.Equal(t, app.LastBlockHeight()+1, doc.InitialHeight)
+	require.Len(t, doc.Validators, 1)
+	pk, err := privValidator.GetPubKey(ctx)
+	require.NoError(t, err)
+	require.Equal(t, pk, doc.Validators[0].PubKey)
+
+	// the state of the node and its genesis are backed up
+	backupDir := fmt.Sprintf("%s.bak-%d", config.DBDir(), app.LastBlockHeight())
+	require.FileExists(t, filepath.Join(backupDir, "marker"))
+	require.FileExists(t, filepath.Join(backupDir, filepath.Base(config.GenesisFile())))
+	require.NoFileExists(t, filepath.Join(config.DBDir(), "marker"))
+
+	// the validator signing state is reset
+	privValidator, err = pvm.LoadFilePV(config.PrivValidator.KeyFile(), config.PrivValidator.StateFile())
+	require.NoError(t, err)
+	require.Zero(t, privValidator.LastSignState.Height)
+}
@@ -0,0 +1,236 @@
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// State is the state of a component of the node.
+type State string
+
+const (
+	StateStarting State = "starting"
+	StateRunning  State = "running"
+	StateStopping State = "stopping"
+	StateStopped  State = "stopped"
+	StateFailed   State = "failed"
+)
+
+// StopFunc stops a component. It should return once the component is stopped or
+// ctx is done, whichever comes first.
+type StopFunc func(ctx context.Context) error
+
+// ComponentStatus is the status of a component, as reported by the readiness
+// and liveness endpoints.
+type ComponentStatus struct {
+	Name  string `json:"name"`
+	State State  `json:"state"`
+	Error string `json:"error,omitempty"`
+}
+
+type component struct {
+	name  string
+	stop  StopFunc
+	state State
+	err   error
+}
+
+// Manager tracks the state of the components of the node, e.g. the Tendermint
+// node and the API and gRPC servers, and shuts them down in the reverse order
+// of their registration, so that a component is stopped before the components
+// it was started after, and thus may depend on.
+type Manager struct {
+	logger  log.Logger
+	timeout time.Duration
+
+	mtx          sync.Mutex
+	components   []*component
+	shuttingDown bool
+}
+
+// NewManager returns a Manager giving each component the timeout to shut down,
+// unlimited if zero.
+func NewManager(logger log.Logger, timeout time.Duration) *Manager {
+	return &Manager{logger: logger, timeout: timeout}
+}
+
+// Register registers a component in the starting state, to be stopped by stop
+// on shutdown. stop may be nil if the component has nothing to stop.
+func (m *Manager) Register(name string, stop StopFunc) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.components = append(m.components, &component{name: name, stop: stop, state: StateStarting})
+}
+
+// SetRunning sets a component in the running state once it is started.
+func (m *Manager) SetRunning(name string) {
+	m.setState(name, StateRunning, nil)
+}
+
+// Fail sets a component in the failed state, e.g. if it stopped unexpectedly.
+func (m *Manager) Fail(name string, err error) {
+	m.logger.Error("component failed", "component", name, "err", err)
+	m.setState(name, StateFailed, err)
+}
+
+func (m *Manager) setState(name string, state State, err error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	for _, c := range m.components {
+		if c.name == name {
+			c.state, c.err = state, err
+			return
+		}
+	}
+}
+
+// Status returns the status of the components, in the order of registration.
+func (m *Manager) Status() []ComponentStatus {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	statuses := make([]ComponentStatus, len(m.components))
+	for i, c := range m.components {
+		statuses[i] = ComponentStatus{Name: c.name, State: c.state}
+		if c.err != nil {
+			statuses[i].Error = c.err.Error()
+		}
+	}
+
+	return statuses
+}
+
+// Ready returns whether all the components are running and the node is not
+// shutting down.
+func (m *Manager) Ready() bool {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.shuttingDown {
+		return false
+	}
+	for _, c := range m.components {
+		if c.state != StateRunning {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Alive returns whether none of the components failed.
+func (m *Manager) Alive() bool {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	for _, c := range m.components {
+		if c.state == StateFailed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Shutdown stops the components in the reverse order of their registration,
+// waiting at most the timeout of the manager for each of them. A component
+// failing or timing out to stop is set in the failed state and the next ones
+// are still stopped. Shutdown only stops the components once, subsequent calls
+// return nil.
+func (m *Manager) Shutdown() error {
+	m.mtx.Lock()
+	if m.shuttingDown {
+		m.mtx.Unlock()
+		return nil
+	}
+	m.shuttingDown = true
+	components := make([]*component, len(m.components))
+	copy(components, m.components)
+	m.mtx.Unlock()
+
+	var failed []string
+	for i := len(components) - 1; i >= 0; i-- {
+		c := components[i]
+		m.setState(c.name, StateStopping, nil)
+		m.logger.Info("stopping component", "component", c.name)
+
+		if err := m.stop(c); err != nil {
+			m.Fail(c.name, fmt.Errorf("failed to stop: %w", err))
+			failed = append(failed, fmt.Sprintf("%s: %s", c.name, err))
+			continue
+		}
+		m.setState(c.name, StateStopped, nil)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to stop components: %s", strings.Join(failed, "; "))
+	}
+
+	return nil
+}
+
+// stop stops a component, returning an error if it does not stop within the
+// timeout, even if its StopFunc ignores the context.
+func (m *Manager) stop(c *component) error {
+	if c.stop == nil {
+		return nil
+	}
+
+	ctx := context.Background()
+	if m.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.timeout)
+		defer cancel()
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- c.stop(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("timed out after %s", m.timeout)
+	}
+}
+
+// ReadinessHandler returns the handler of the readiness endpoint, replying with
+// the status of the components and the 200 status code if the node is ready,
+// 503 otherwise.
+func (m *Manager) ReadinessHandler() http.Handler {
+	return m.statusHandler(m.Ready)
+}
+
+// LivenessHandler returns the handler of the liveness endpoint, replying with
+// the status of the components and the 200 status code if the node is alive,
+// 503 otherwise.
+func (m *Manager) LivenessHandler() http.Handler {
+	return m.statusHandler(m.Alive)
+}
+
+func (m *Manager) statusHandler(check func() bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		ok := check()
+		res := struct {
+			OK         bool              `json:"ok"`
+			Components []ComponentStatus `json:"components"`
+		}{OK: ok, Components: m.Status()}
+
+		w.Header().Set("Content-Type", "application/json")
+		if !ok {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	})
+}
@@ -0,0 +1,90 @@
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestManagerShutdown(t *testing.T) {
+	m := NewManager(log.NewNopLogger(), 50*time.Millisecond)
+
+	var stopped []string
+	stop := func(name string, err error) StopFunc {
+		return func(context.Context) error {
+			stopped = append(stopped, name)
+			return err
+		}
+	}
+	m.Register("app", stop("app", nil))
+	m.Register("tendermint", stop("tendermint", errors.New("boom")))
+	m.Register("grpc", func(ctx context.Context) error {
+		<-ctx.Done()
+		return nil
+	})
+	m.Register("api", nil)
+
+	for _, name := range []string{"app", "tendermint", "grpc", "api"} {
+		m.SetRunning(name)
+	}
+	require.True(t, m.Ready())
+	require.True(t, m.Alive())
+
+	err := m.Shutdown()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "tendermint: boom")
+	require.Contains(t, err.Error(), "grpc: timed out")
+	require.Equal(t, []string{"tendermint", "app"}, stopped)
+
+	require.Equal(t, []ComponentStatus{
+		{Name: "app", State: StateStopped},
+		{Name: "tendermint", State: StateFailed, Error: "failed to stop: boom"},
+		{Name: "grpc", State: StateFailed, Error: "failed to stop: timed out after 50ms"},
+		{Name: "api", State: StateStopped},
+	}, m.Status())
+	require.False(t, m.Ready())
+	require.False(t, m.Alive())
+
+	// the components are only stopped once
+	require.NoError(t, m.Shutdown())
+	require.Len(t, stopped, 2)
+}
+
+func TestManagerHandlers(t *testing.T) {
+	m := NewManager(log.NewNopLogger(), 0)
+	m.Register("tendermint", nil)
+	m.Register("api", nil)
+	m.SetRunning("tendermint")
+
+	check := func(handler http.Handler, code int, ok bool) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		require.Equal(t, code, rec.Code)
+
+		var res struct {
+			OK         bool              `json:"ok"`
+			Components []ComponentStatus `json:"components"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, ok, res.OK)
+		require.Equal(t, m.Status(), res.Components)
+	}
+
+	// the api is still starting
+	check(m.ReadinessHandler(), http.StatusServiceUnavailable, false)
+	check(m.LivenessHandler(), http.StatusOK, true)
+
+	m.SetRunning("api")
+	check(m.ReadinessHandler(), http.StatusOK, true)
+
+	m.Fail("api", errors.New("listener closed"))
+	check(m.ReadinessHandler(), http.StatusServiceUnavailable, false)
+	check(m.LivenessHandler(), http.StatusServiceUnavailable, false)
+}
@@ -0,0 +1,107 @@
+package log
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// DefaultModule is the module whose level applies to the logs of the modules
+// without a level of their own.
+const DefaultModule = "*"
+
+// Levels defines the minimum level of the logs of each module, i.e. of the logs
+// with a "module" field, and the default level of the other logs. It is safe for
+// concurrent use, so that the levels can be changed while the node is running.
+type Levels struct {
+	mtx          sync.RWMutex
+	defaultLevel zerolog.Level
+	modules      map[string]zerolog.Level
+}
+
+// ParseLevels parses log levels, either a single level applied to all the logs,
+// e.g. "info", or a comma separated list of <module>:<level> pairs, e.g.
+// "x/staking:debug,consensus:error,*:info", where "*" sets the level of the
+// modules not listed. The default level is info if not set.
+func ParseLevels(s string) (*Levels, error) {
+	l := &Levels{}
+	if err := l.Set(s); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Set replaces all the levels by the ones parsed from s, see ParseLevels.
+func (l *Levels) Set(s string) error {
+	defaultLevel := zerolog.InfoLevel
+	modules := make(map[string]zerolog.Level)
+
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		module, levelStr := DefaultModule, entry
+		if i := strings.LastIndex(entry, ":"); i >= 0 {
+			module, levelStr = strings.TrimSpace(entry[:i]), strings.TrimSpace(entry[i+1:])
+			if module == "" || levelStr == "" {
+				return fmt.Errorf("invalid log level %q, expected <module>:<level>", entry)
+			}
+		}
+
+		level, err := zerolog.ParseLevel(levelStr)
+		if err != nil {
+			return fmt.Errorf("failed to parse log level (%s): %w", entry, err)
+		}
+
+		if module == DefaultModule {
+			defaultLevel = level
+		} else {
+			modules[module] = level
+		}
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.defaultLevel = defaultLevel
+	l.modules = modules
+	return nil
+}
+
+// Enabled returns true if logs of the given level are enabled for module. An
+// empty module means a log without module.
+func (l *Levels) Enabled(module string, level zerolog.Level) bool {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+
+	minLevel, ok := l.modules[module]
+	if !ok {
+		minLevel = l.defaultLevel
+	}
+
+	return minLevel != zerolog.Disabled && level >= minLevel
+}
+
+// String returns the levels in the format parsed by ParseLevels.
+func (l *Levels) String() string {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+
+	if len(l.modules) == 0 {
+		return l.defaultLevel.String()
+	}
+
+	entries := make([]string, 0, len(l.modules)+1)
+	for module, level := range l.modules {
+		entries = append(entries, module+":"+level.String())
+	}
+	sort.Strings(entries)
+
+	return strings.Join(append(entries, DefaultModule+":"+l.defaultLevel.String()), ",")
+}
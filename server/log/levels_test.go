@@ -0,0 +1,71 @@
+package log_test
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	serverlog "github.com/cosmos/cosmos-sdk/server/log"
+)
+
+func TestParseLevels(t *testing.T) {
+	testCases := []struct {
+		name      string
+		levels    string
+		expString string
+		expErr    bool
+	}{
+		{"single level", "debug", "debug", false},
+		{"empty", "", "info", false},
+		{"default module", "*:error", "error", false},
+		{"modules", "x/staking:debug, consensus:error,*:warn", "consensus:error,x/staking:debug,*:warn", false},
+		{"modules without default", "x/staking:debug", "x/staking:debug,*:info", false},
+		{"bare default level", "x/bank:trace,disabled", "x/bank:trace,*:disabled", false},
+		{"invalid level", "x/staking:verbose", "", true},
+		{"missing module", ":debug", "", true},
+		{"missing level", "x/staking:", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			levels, err := serverlog.ParseLevels(tc.levels)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expString, levels.String())
+
+			// the string representation parses to the same levels
+			reparsed, err := serverlog.ParseLevels(levels.String())
+			require.NoError(t, err)
+			require.Equal(t, tc.expString, reparsed.String())
+		})
+	}
+}
+
+func TestLevels_Enabled(t *testing.T) {
+	levels, err := serverlog.ParseLevels("x/staking:debug,p2p:disabled,*:error")
+	require.NoError(t, err)
+
+	require.True(t, levels.Enabled("x/staking", zerolog.DebugLevel))
+	require.True(t, levels.Enabled("x/staking", zerolog.InfoLevel))
+	require.False(t, levels.Enabled("x/staking", zerolog.TraceLevel))
+	require.False(t, levels.Enabled("p2p", zerolog.ErrorLevel))
+	require.False(t, levels.Enabled("x/bank", zerolog.InfoLevel))
+	require.True(t, levels.Enabled("x/bank", zerolog.ErrorLevel))
+	require.True(t, levels.Enabled("", zerolog.ErrorLevel))
+
+	// an invalid update keeps the levels as they are
+	require.Error(t, levels.Set("x/bank:verbose"))
+	require.Equal(t, "p2p:disabled,x/staking:debug,*:error", levels.String())
+
+	require.NoError(t, levels.Set("x/bank:info"))
+	require.True(t, levels.Enabled("x/bank", zerolog.InfoLevel))
+	require.False(t, levels.Enabled("x/staking", zerolog.DebugLevel))
+	require.True(t, levels.Enabled("x/staking", zerolog.InfoLevel))
+	require.False(t, levels.Enabled("p2p", zerolog.DebugLevel))
+	require.True(t, levels.Enabled("p2p", zerolog.InfoLevel))
+}
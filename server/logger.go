@@ -3,20 +3,36 @@ package server
 import (
 	"github.com/rs/zerolog"
 	tmlog "github.com/tendermint/tendermint/libs/log"
+
+	serverlog "github.com/cosmos/cosmos-sdk/server/log"
 )
 
 var _ tmlog.Logger = (*ZeroLogWrapper)(nil)
 
+// logKeyModule is the key of the module of a logger, which log levels are
+// filtered by.
+const logKeyModule = "module"
+
 // ZeroLogWrapper provides a wrapper around a zerolog.Logger instance. It implements
 // Tendermint's Logger interface.
 type ZeroLogWrapper struct {
 	zerolog.Logger
+
+	// Levels, if set, filters the logs by the level of their module, set with the
+	// "module" key in With.
+	Levels *serverlog.Levels
+
+	module string
 }
 
 // Info implements Tendermint's Logger interface and logs with level INFO. A set
 // of key/value tuples may be provided to add context to the log. The number of
 // tuples must be even and the key of the tuple must be a string.
 func (z ZeroLogWrapper) Info(msg string, keyVals ...interface{}) {
+	if !z.enabled(zerolog.InfoLevel) {
+		return
+	}
+
 	z.Logger.Info().Fields(getLogFields(keyVals...)).Msg(msg)
 }
 
@@ -24,6 +40,10 @@ func (z ZeroLogWrapper) Info(msg string, keyVals ...interface{}) {
 // of key/value tuples may be provided to add context to the log. The number of
 // tuples must be even and the key of the tuple must be a string.
 func (z ZeroLogWrapper) Error(msg string, keyVals ...interface{}) {
+	if !z.enabled(zerolog.ErrorLevel) {
+		return
+	}
+
 	z.Logger.Error().Fields(getLogFields(keyVals...)).Msg(msg)
 }
 
@@ -31,6 +51,10 @@ func (z ZeroLogWrapper) Error(msg string, keyVals ...interface{}) {
 // of key/value tuples may be provided to add context to the log. The number of
 // tuples must be even and the key of the tuple must be a string.
 func (z ZeroLogWrapper) Debug(msg string, keyVals ...interface{}) {
+	if !z.enabled(zerolog.DebugLevel) {
+		return
+	}
+
 	z.Logger.Debug().Fields(getLogFields(keyVals...)).Msg(msg)
 }
 
@@ -38,7 +62,19 @@ func (z ZeroLogWrapper) Debug(msg string, keyVals ...interface{}) {
 // of key/value tuples. The number of tuples must be even and the key of the
 // tuple must be a string.
 func (z ZeroLogWrapper) With(keyVals ...interface{}) tmlog.Logger {
-	return ZeroLogWrapper{z.Logger.With().Fields(getLogFields(keyVals...)).Logger()}
+	fields := getLogFields(keyVals...)
+	if module, ok := fields[logKeyModule].(string); ok {
+		z.module = module
+	}
+
+	z.Logger = z.Logger.With().Fields(fields).Logger()
+	return z
+}
+
+// enabled returns true if the logs of the given level are enabled for the module
+// of the logger.
+func (z ZeroLogWrapper) enabled(level zerolog.Level) bool {
+	return z.Levels == nil || z.Levels.Enabled(z.module, level)
 }
 
 func getLogFields(keyVals ...interface{}) map[string]interface{} {
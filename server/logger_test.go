@@ -0,0 +1,50 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	serverlog "github.com/cosmos/cosmos-sdk/server/log"
+)
+
+func TestZeroLogWrapper_Levels(t *testing.T) {
+	levels, err := serverlog.ParseLevels("x/staking:debug,*:error")
+	require.NoError(t, err)
+
+	buf := new(bytes.Buffer)
+	logger := server.ZeroLogWrapper{Logger: zerolog.New(buf), Levels: levels}
+	stakingLogger := logger.With("module", "x/staking", "height", 10)
+
+	logger.Info("dropped")
+	logger.Error("no module")
+	stakingLogger.Debug("staking debug")
+	logger.With("module", "x/bank").Info("dropped")
+
+	// the levels can be changed at runtime
+	require.NoError(t, levels.Set("x/bank:info"))
+	stakingLogger.Debug("dropped")
+	stakingLogger.Info("staking info")
+	logger.With("module", "x/bank").Info("bank info")
+
+	var logs []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var log map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &log))
+		logs = append(logs, log)
+	}
+
+	require.Len(t, logs, 4)
+	require.Equal(t, "no module", logs[0]["message"])
+	require.Equal(t, "error", logs[0]["level"])
+	require.Equal(t, "staking debug", logs[1]["message"])
+	require.Equal(t, "x/staking", logs[1]["module"])
+	require.Equal(t, float64(10), logs[1]["height"])
+	require.Equal(t, "staking info", logs[2]["message"])
+	require.Equal(t, "bank info", logs[3]["message"])
+}
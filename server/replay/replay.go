@@ -0,0 +1,294 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// Phase is the ABCI call in which two targets diverged.
+type Phase string
+
+const (
+	PhaseInitChain  Phase = "init_chain"
+	PhaseBeginBlock Phase = "begin_block"
+	PhaseDeliverTx  Phase = "deliver_tx"
+	PhaseEndBlock   Phase = "end_block"
+	PhaseCommit     Phase = "commit"
+)
+
+// Divergence is the first difference between the ABCI responses, or the store
+// writes, of two targets.
+type Divergence struct {
+	Height int64
+	Phase  Phase
+	// TxIndex and TxHash identify the divergent tx of the PhaseDeliverTx
+	// divergences.
+	TxIndex int
+	TxHash  []byte
+	// Diff describes the difference.
+	Diff string
+}
+
+func (d Divergence) String() string {
+	if d.Phase == PhaseDeliverTx {
+		return fmt.Sprintf("divergence at height %d in tx %d (%X):\n%s", d.Height, d.TxIndex, d.TxHash, d.Diff)
+	}
+
+	return fmt.Sprintf("divergence at height %d in %s:\n%s", d.Height, d.Phase, d.Diff)
+}
+
+// Replayer replays the blocks of a BlockSource against two targets, e.g. two
+// versions of an application, and compares their ABCI responses and store writes
+// to find the first ABCI call in which they diverge.
+type Replayer struct {
+	source BlockSource
+	a, b   *Target
+	logger log.Logger
+}
+
+// NewReplayer returns a Replayer of the blocks of source against the started
+// targets a and b.
+func NewReplayer(source BlockSource, a, b *Target, logger log.Logger) *Replayer {
+	return &Replayer{
+		source: source,
+		a:      a,
+		b:      b,
+		logger: logger,
+	}
+}
+
+// Replay replays the blocks up to the given height against the targets, from the
+// genesis if they are empty or else from the height following the last one they
+// committed. It returns the first divergence between the targets, or nil if they
+// agree on every replayed block.
+func (r *Replayer) Replay(ctx context.Context, to int64) (*Divergence, error) {
+	genDoc, err := r.source.Genesis(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the genesis: %w", err)
+	}
+	if err := genDoc.ValidateAndComplete(); err != nil {
+		return nil, err
+	}
+
+	infoA, err := r.a.client.InfoSync(ctx, abci.RequestInfo{})
+	if err != nil {
+		return nil, err
+	}
+	infoB, err := r.b.client.InfoSync(ctx, abci.RequestInfo{})
+	if err != nil {
+		return nil, err
+	}
+	if infoA.LastBlockHeight != infoB.LastBlockHeight {
+		return nil, fmt.Errorf("%s is at height %d but %s is at height %d", r.a.name, infoA.LastBlockHeight, r.b.name, infoB.LastBlockHeight)
+	}
+
+	from := infoA.LastBlockHeight + 1
+	if infoA.LastBlockHeight == 0 {
+		if div, err := r.initChain(ctx, genDoc); div != nil || err != nil {
+			return div, err
+		}
+		from = genDoc.InitialHeight
+	} else if !bytes.Equal(infoA.LastBlockAppHash, infoB.LastBlockAppHash) {
+		return &Divergence{
+			Height: infoA.LastBlockHeight,
+			Phase:  PhaseCommit,
+			Diff:   fmt.Sprintf("%s: app hash %X\n%s: app hash %X", r.a.name, infoA.LastBlockAppHash, r.b.name, infoB.LastBlockAppHash),
+		}, nil
+	}
+
+	for height := from; height <= to; height++ {
+		div, err := r.replayBlock(ctx, height, genDoc.InitialHeight)
+		if div != nil || err != nil {
+			return div, err
+		}
+		r.logger.Info("replayed block", "height", height)
+	}
+
+	return nil, nil
+}
+
+func (r *Replayer) initChain(ctx context.Context, genDoc *tmtypes.GenesisDoc) (*Divergence, error) {
+	validators := make([]*tmtypes.Validator, len(genDoc.Validators))
+	for i, val := range genDoc.Validators {
+		validators[i] = tmtypes.NewValidator(val.PubKey, val.Power)
+	}
+	pbParams := genDoc.ConsensusParams.ToProto()
+	req := abci.RequestInitChain{
+		Time:            genDoc.GenesisTime,
+		ChainId:         genDoc.ChainID,
+		InitialHeight:   genDoc.InitialHeight,
+		ConsensusParams: &pbParams,
+		Validators:      tmtypes.TM2PB.ValidatorUpdates(tmtypes.NewValidatorSet(validators)),
+		AppStateBytes:   genDoc.AppState,
+	}
+
+	resA, err := r.a.client.InitChainSync(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resB, err := r.b.client.InitChainSync(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.compare(genDoc.InitialHeight, PhaseInitChain, resA, resB), nil
+}
+
+func (r *Replayer) replayBlock(ctx context.Context, height, initialHeight int64) (*Divergence, error) {
+	block, err := r.source.Block(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the block at height %d: %w", height, err)
+	}
+
+	req := abci.RequestBeginBlock{
+		Hash:   block.Hash(),
+		Header: *block.Header.ToProto(),
+	}
+	if height > initialHeight {
+		vals, err := r.source.Validators(ctx, height-1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the validators at height %d: %w", height-1, err)
+		}
+		req.LastCommitInfo, err = lastCommitInfo(block, vals)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, ev := range block.Evidence.Evidence {
+		req.ByzantineValidators = append(req.ByzantineValidators, ev.ABCI()...)
+	}
+
+	beginA, err := r.a.client.BeginBlockSync(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	beginB, err := r.b.client.BeginBlockSync(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if div := r.compare(height, PhaseBeginBlock, beginA, beginB); div != nil {
+		return div, nil
+	}
+
+	for i, tx := range block.Txs {
+		resA, err := r.a.client.DeliverTxSync(ctx, abci.RequestDeliverTx{Tx: tx})
+		if err != nil {
+			return nil, err
+		}
+		resB, err := r.b.client.DeliverTxSync(ctx, abci.RequestDeliverTx{Tx: tx})
+		if err != nil {
+			return nil, err
+		}
+
+		// the logs are not part of the consensus, and differ between versions
+		resA.Log, resA.Info = "", ""
+		resB.Log, resB.Info = "", ""
+		if div := r.compare(height, PhaseDeliverTx, resA, resB); div != nil {
+			div.TxIndex, div.TxHash = i, tx.Hash()
+			return div, nil
+		}
+	}
+
+	endA, err := r.a.client.EndBlockSync(ctx, abci.RequestEndBlock{Height: height})
+	if err != nil {
+		return nil, err
+	}
+	endB, err := r.b.client.EndBlockSync(ctx, abci.RequestEndBlock{Height: height})
+	if err != nil {
+		return nil, err
+	}
+	if div := r.compare(height, PhaseEndBlock, endA, endB); div != nil {
+		return div, nil
+	}
+
+	commitA, err := r.a.client.CommitSync(ctx)
+	if err != nil {
+		return nil, err
+	}
+	commitB, err := r.b.client.CommitSync(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.compare(height, PhaseCommit, commitA, commitB), nil
+}
+
+// compare returns the divergence between the responses of the targets to an ABCI
+// call, and the store writes they made in it, nil if there is none.
+func (r *Replayer) compare(height int64, phase Phase, resA, resB proto.Message) *Divergence {
+	var diffs []string
+
+	bzA, errA := proto.Marshal(resA)
+	bzB, errB := proto.Marshal(resB)
+	if errA != nil || errB != nil || !bytes.Equal(bzA, bzB) {
+		diffs = append(diffs, fmt.Sprintf("%s: %v\n%s: %v", r.a.name, resA, r.b.name, resB))
+	}
+
+	writesA, writesB := r.a.takeWrites(), r.b.takeWrites()
+	if r.a.writes != nil && r.b.writes != nil {
+		if diff := r.diffWrites(writesA, writesB); diff != "" {
+			diffs = append(diffs, diff)
+		}
+	}
+
+	if len(diffs) == 0 {
+		return nil
+	}
+
+	return &Divergence{
+		Height: height,
+		Phase:  phase,
+		Diff:   strings.Join(diffs, "\n"),
+	}
+}
+
+// diffWrites describes the first difference between the store writes of the
+// targets, or returns an empty string if they are the same.
+func (r *Replayer) diffWrites(writesA, writesB []StoreWrite) string {
+	for i := 0; i < len(writesA) || i < len(writesB); i++ {
+		switch {
+		case i >= len(writesA):
+			return fmt.Sprintf("write %d only made by %s: %s", i, r.b.name, writesB[i])
+		case i >= len(writesB):
+			return fmt.Sprintf("write %d only made by %s: %s", i, r.a.name, writesA[i])
+		}
+
+		a, b := writesA[i], writesB[i]
+		if a.StoreKey != b.StoreKey || !bytes.Equal(a.Key, b.Key) || !bytes.Equal(a.Value, b.Value) || a.Delete != b.Delete {
+			return fmt.Sprintf("write %d differs\n%s: %s\n%s: %s", i, r.a.name, a, r.b.name, b)
+		}
+	}
+
+	return ""
+}
+
+// lastCommitInfo returns the votes of the validators for the block preceding
+// block, as built by Tendermint.
+func lastCommitInfo(block *tmtypes.Block, vals []*tmtypes.Validator) (abci.LastCommitInfo, error) {
+	if len(block.LastCommit.Signatures) != len(vals) {
+		return abci.LastCommitInfo{}, fmt.Errorf(
+			"the last commit of block %d has %d signatures for %d validators",
+			block.Height, len(block.LastCommit.Signatures), len(vals),
+		)
+	}
+
+	votes := make([]abci.VoteInfo, len(vals))
+	for i, val := range vals {
+		votes[i] = abci.VoteInfo{
+			Validator:       tmtypes.TM2PB.Validator(val),
+			SignedLastBlock: block.LastCommit.Signatures[i].BlockIDFlag != tmtypes.BlockIDFlagAbsent,
+		}
+	}
+
+	return abci.LastCommitInfo{
+		Round: block.LastCommit.Round,
+		Votes: votes,
+	}, nil
+}
@@ -0,0 +1,233 @@
+package replay_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/server/replay"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+const chainID = "replay-test"
+
+// memSource is a BlockSource of a chain of a single validator.
+type memSource struct {
+	genDoc *tmtypes.GenesisDoc
+	blocks map[int64]*tmtypes.Block
+	val    *tmtypes.Validator
+}
+
+func (s *memSource) Genesis(context.Context) (*tmtypes.GenesisDoc, error) {
+	return s.genDoc, nil
+}
+
+func (s *memSource) Block(_ context.Context, height int64) (*tmtypes.Block, error) {
+	return s.blocks[height], nil
+}
+
+func (s *memSource) Validators(context.Context, int64) ([]*tmtypes.Validator, error) {
+	return []*tmtypes.Validator{s.val}, nil
+}
+
+// newSource returns a chain of 3 blocks, the second one holding 2 bank sends.
+func newSource(t *testing.T) (*memSource, []tmtypes.Tx) {
+	pubKey, err := mock.NewPV().GetPubKey(context.Background())
+	require.NoError(t, err)
+	val := tmtypes.NewValidator(pubKey, 1)
+
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	app := simapp.SetupWithGenesisValSet(t, tmtypes.NewValidatorSet([]*tmtypes.Validator{val}),
+		[]authtypes.GenesisAccount{authtypes.NewBaseAccount(addr, priv.PubKey(), 0, 0)},
+		banktypes.Balance{Address: addr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000000000000))},
+	)
+	accNum := app.AccountKeeper.GetAccount(app.BaseApp.NewContext(true, tmproto.Header{}), addr).GetAccountNumber()
+	exported, err := app.ExportAppStateAndValidators(true, nil)
+	require.NoError(t, err)
+
+	// the test validator is bonded without the hooks creating its signing info
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exported.AppState, &appState))
+	var slashingGenState slashingtypes.GenesisState
+	app.AppCodec().MustUnmarshalJSON(appState[slashingtypes.ModuleName], &slashingGenState)
+	consAddr := sdk.ConsAddress(val.Address)
+	slashingGenState.SigningInfos = append(slashingGenState.SigningInfos, slashingtypes.SigningInfo{
+		Address:              consAddr.String(),
+		ValidatorSigningInfo: slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0),
+	})
+	appState[slashingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(&slashingGenState)
+	exported.AppState, err = json.Marshal(appState)
+	require.NoError(t, err)
+
+	genesisTime := time.Now().UTC()
+	source := &memSource{
+		genDoc: &tmtypes.GenesisDoc{
+			ChainID:       chainID,
+			GenesisTime:   genesisTime,
+			InitialHeight: 1,
+			Validators:    exported.Validators,
+			AppState:      exported.AppState,
+		},
+		blocks: map[int64]*tmtypes.Block{},
+		val:    val,
+	}
+
+	encCfg := simapp.MakeTestEncodingConfig()
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	var txs []tmtypes.Tx
+	for seq := uint64(0); seq < 2; seq++ {
+		tx, err := helpers.GenSignedMockTx(encCfg.TxConfig,
+			[]sdk.Msg{banktypes.NewMsgSend(addr, to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))},
+			sdk.NewCoins(), helpers.DefaultGenTxGas, chainID, []uint64{accNum}, []uint64{seq}, priv,
+		)
+		require.NoError(t, err)
+		bz, err := encCfg.TxConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		txs = append(txs, bz)
+	}
+
+	for height := int64(1); height <= 3; height++ {
+		lastCommit := &tmtypes.Commit{}
+		if height > 1 {
+			lastCommit = &tmtypes.Commit{
+				Height:     height - 1,
+				Signatures: []tmtypes.CommitSig{{BlockIDFlag: tmtypes.BlockIDFlagCommit, ValidatorAddress: val.Address, Timestamp: genesisTime}},
+			}
+		}
+
+		var blockTxs []tmtypes.Tx
+		if height == 2 {
+			blockTxs = txs
+		}
+		block := tmtypes.MakeBlock(height, blockTxs, lastCommit, nil)
+		block.ChainID = chainID
+		block.Time = genesisTime.Add(time.Duration(height) * time.Second)
+		block.ProposerAddress = val.Address
+		source.blocks[height] = block
+	}
+
+	return source, txs
+}
+
+// divergingApp is a SimApp whose DeliverTx diverges for the tx at txIndex in the
+// order of delivery.
+type divergingApp struct {
+	*simapp.SimApp
+
+	txIndex int
+	diverge func(app *simapp.SimApp, res *abci.ResponseDeliverTx)
+	n       int
+}
+
+func (app *divergingApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := app.SimApp.DeliverTx(req)
+	if app.n == app.txIndex {
+		app.diverge(app.SimApp, &res)
+	}
+	app.n++
+
+	return res
+}
+
+func newTarget(t *testing.T, name string, wrap func(*simapp.SimApp) servertypes.Application) *replay.Target {
+	app := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0, simapp.MakeTestEncodingConfig(), simapp.EmptyAppOptions{})
+	var abciApp servertypes.Application = app
+	if wrap != nil {
+		abciApp = wrap(app)
+	}
+
+	target := replay.NewAppTarget(name, abciApp)
+	require.NoError(t, target.Start())
+	t.Cleanup(func() { _ = target.Stop() })
+
+	return target
+}
+
+func TestReplay(t *testing.T) {
+	source, txs := newSource(t)
+
+	testCases := []struct {
+		name    string
+		wrap    func(*simapp.SimApp) servertypes.Application
+		expDiv  bool
+		expTx   int
+		expDiff string
+	}{
+		{
+			name: "same apps",
+		},
+		{
+			name: "divergent tx result",
+			wrap: func(app *simapp.SimApp) servertypes.Application {
+				return &divergingApp{SimApp: app, txIndex: 1, diverge: func(_ *simapp.SimApp, res *abci.ResponseDeliverTx) {
+					res.GasUsed++
+				}}
+			},
+			expDiv:  true,
+			expTx:   1,
+			expDiff: "gas_used",
+		},
+		{
+			name: "divergent tx writes",
+			wrap: func(app *simapp.SimApp) servertypes.Application {
+				return &divergingApp{SimApp: app, txIndex: 0, diverge: func(app *simapp.SimApp, _ *abci.ResponseDeliverTx) {
+					app.CommitMultiStore().GetKVStore(app.GetKey(banktypes.StoreKey)).Set([]byte("diverge"), []byte{1})
+				}}
+			},
+			expDiv:  true,
+			expTx:   0,
+			expDiff: "only made by b: bank: set 64697665726765 = 01",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, b := newTarget(t, "a", nil), newTarget(t, "b", tc.wrap)
+
+			div, err := replay.NewReplayer(source, a, b, log.NewNopLogger()).Replay(context.Background(), 3)
+			require.NoError(t, err)
+			if !tc.expDiv {
+				require.Nil(t, div)
+				return
+			}
+
+			require.NotNil(t, div)
+			require.Equal(t, int64(2), div.Height)
+			require.Equal(t, replay.PhaseDeliverTx, div.Phase)
+			require.Equal(t, tc.expTx, div.TxIndex)
+			require.Equal(t, txs[tc.expTx].Hash(), div.TxHash)
+			require.Contains(t, div.Diff, tc.expDiff)
+		})
+	}
+}
+
+func TestReplayResumesFromLastHeight(t *testing.T) {
+	source, _ := newSource(t)
+	a, b := newTarget(t, "a", nil), newTarget(t, "b", nil)
+	replayer := replay.NewReplayer(source, a, b, log.NewNopLogger())
+
+	div, err := replayer.Replay(context.Background(), 2)
+	require.NoError(t, err)
+	require.Nil(t, div)
+
+	div, err = replayer.Replay(context.Background(), 3)
+	require.NoError(t, err)
+	require.Nil(t, div)
+}
@@ -0,0 +1,73 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+
+	tmclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// BlockSource provides the blocks replayed against the targets, typically from
+// the block store of a node.
+type BlockSource interface {
+	// Genesis returns the genesis document of the chain.
+	Genesis(ctx context.Context) (*tmtypes.GenesisDoc, error)
+	// Block returns the block at the given height.
+	Block(ctx context.Context, height int64) (*tmtypes.Block, error)
+	// Validators returns the validator set at the given height, in the order of
+	// the signatures of the commit of the block at that height.
+	Validators(ctx context.Context, height int64) ([]*tmtypes.Validator, error)
+}
+
+// validatorsPerPage is the number of validators queried per page, the maximum
+// allowed by Tendermint.
+const validatorsPerPage = 100
+
+type rpcBlockSource struct {
+	client tmclient.Client
+}
+
+// NewRPCBlockSource returns a BlockSource querying the block store of a node
+// through its RPC client. The node must retain the replayed blocks, and the
+// validator sets at their heights.
+func NewRPCBlockSource(client tmclient.Client) BlockSource {
+	return rpcBlockSource{client: client}
+}
+
+func (s rpcBlockSource) Genesis(ctx context.Context) (*tmtypes.GenesisDoc, error) {
+	res, err := s.client.Genesis(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Genesis, nil
+}
+
+func (s rpcBlockSource) Block(ctx context.Context, height int64) (*tmtypes.Block, error) {
+	res, err := s.client.Block(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+	if res.Block == nil {
+		return nil, fmt.Errorf("no block at height %d", height)
+	}
+
+	return res.Block, nil
+}
+
+func (s rpcBlockSource) Validators(ctx context.Context, height int64) ([]*tmtypes.Validator, error) {
+	var vals []*tmtypes.Validator
+	perPage := validatorsPerPage
+	for page := 1; ; page++ {
+		res, err := s.client.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, res.Validators...)
+		if len(vals) >= res.Total || len(res.Validators) == 0 {
+			return vals, nil
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package replay
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	abciclient "github.com/tendermint/tendermint/abci/client"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Target is an application the blocks are replayed against.
+type Target struct {
+	name   string
+	client abciclient.Client
+	// writes records the store writes of the application, nil if they cannot be
+	// observed.
+	writes *writeRecorder
+}
+
+// NewAppTarget returns a target replaying the blocks against the in-process app,
+// whose store writes are recorded and compared.
+func NewAppTarget(name string, app servertypes.Application) *Target {
+	t := &Target{
+		name:   name,
+		client: abciclient.NewLocalClient(nil, app),
+	}
+
+	cms, ok := app.CommitMultiStore().(interface {
+		storetypes.CommitMultiStore
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if ok {
+		t.writes = &writeRecorder{}
+		for _, key := range cms.StoreKeysByName() {
+			cms.AddListeners(key, []storetypes.WriteListener{t.writes})
+		}
+	}
+
+	return t
+}
+
+// NewSocketTarget returns a target replaying the blocks against the application
+// serving ABCI on the socket address addr, e.g. a binary started with
+// --with-tendermint=false. Its store writes cannot be observed, so only its ABCI
+// responses are compared.
+func NewSocketTarget(name, addr string) *Target {
+	return &Target{
+		name:   name,
+		client: abciclient.NewSocketClient(addr, true),
+	}
+}
+
+// Name returns the name of the target.
+func (t *Target) Name() string {
+	return t.name
+}
+
+// Start connects to the application of the target.
+func (t *Target) Start() error {
+	if err := t.client.Start(); err != nil {
+		return fmt.Errorf("failed to start %s: %w", t.name, err)
+	}
+
+	return nil
+}
+
+// Stop disconnects from the application of the target.
+func (t *Target) Stop() error {
+	return t.client.Stop()
+}
+
+// takeWrites returns the store writes recorded since the last call, grouped by
+// store. The writes to a store are made in a deterministic order, but the stores
+// are written in a random one.
+func (t *Target) takeWrites() []StoreWrite {
+	if t.writes == nil {
+		return nil
+	}
+
+	writes := t.writes.take()
+	sort.SliceStable(writes, func(i, j int) bool {
+		return writes[i].StoreKey < writes[j].StoreKey
+	})
+
+	return writes
+}
+
+// StoreWrite is a write to a store of an application.
+type StoreWrite struct {
+	StoreKey string
+	Key      []byte
+	Value    []byte
+	Delete   bool
+}
+
+func (w StoreWrite) String() string {
+	if w.Delete {
+		return fmt.Sprintf("%s: delete %X", w.StoreKey, w.Key)
+	}
+
+	return fmt.Sprintf("%s: set %X = %X", w.StoreKey, w.Key, w.Value)
+}
+
+var _ storetypes.WriteListener = (*writeRecorder)(nil)
+
+// writeRecorder is a WriteListener recording the writes to the stores.
+type writeRecorder struct {
+	mtx    sync.Mutex
+	writes []StoreWrite
+}
+
+// OnWrite implements storetypes.WriteListener.
+func (r *writeRecorder) OnWrite(storeKey storetypes.StoreKey, key []byte, value []byte, delete bool) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.writes = append(r.writes, StoreWrite{
+		StoreKey: storeKey.Name(),
+		Key:      append([]byte(nil), key...),
+		Value:    append([]byte(nil), value...),
+		Delete:   delete,
+	})
+
+	return nil
+}
+
+func (r *writeRecorder) take() []StoreWrite {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	writes := r.writes
+	r.writes = nil
+
+	return writes
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/replay"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	// FlagReplayTo is the height the blocks are replayed up to.
+	FlagReplayTo = "to"
+	// FlagAppA and FlagAppB are the ABCI socket addresses of the applications
+	// the blocks are replayed against.
+	FlagAppA = "app-a"
+	FlagAppB = "app-b"
+)
+
+// NewReplayDiffCmd creates a command replaying the blocks of a node against two
+// applications and reporting the first divergence between them.
+func NewReplayDiffCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-diff [node]",
+		Short: "Replay the blocks of a node against two applications and report the first divergence",
+		Long: `
+Replay the blocks of the node at the given RPC address, from the genesis, against two
+applications, typically two versions of the same binary, and report the first ABCI call
+in which their responses diverge.
+
+The applications are given by their ABCI socket addresses with --app-a and --app-b, e.g.
+binaries started with --with-tendermint=false on a fresh home. Without --app-a, the blocks
+are replayed against this binary in-process, and its store writes are reported as well.
+The node must retain the replayed blocks and their validator sets.
+`,
+		Example: fmt.Sprintf("%s replay-diff tcp://localhost:26657 --app-b tcp://localhost:26658 --to 1000", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+
+			addrA, _ := cmd.Flags().GetString(FlagAppA)
+			addrB, _ := cmd.Flags().GetString(FlagAppB)
+			if addrB == "" {
+				return fmt.Errorf("--%s is required", FlagAppB)
+			}
+
+			client, err := rpchttp.New(args[0])
+			if err != nil {
+				return err
+			}
+
+			to, _ := cmd.Flags().GetInt64(FlagReplayTo)
+			if to <= 0 {
+				status, err := client.Status(cmd.Context())
+				if err != nil {
+					return err
+				}
+				to = status.SyncInfo.LatestBlockHeight
+			}
+
+			var a *replay.Target
+			if addrA == "" {
+				app := appCreator(ctx.Logger, dbm.NewMemDB(), nil, ctx.Viper)
+				a = replay.NewAppTarget("a", app)
+			} else {
+				a = replay.NewSocketTarget("a", addrA)
+			}
+			b := replay.NewSocketTarget("b", addrB)
+
+			for _, target := range []*replay.Target{a, b} {
+				if err := target.Start(); err != nil {
+					return err
+				}
+				defer target.Stop() //nolint:errcheck
+			}
+
+			div, err := replay.NewReplayer(replay.NewRPCBlockSource(client), a, b, ctx.Logger).Replay(cmd.Context(), to)
+			if err != nil {
+				return err
+			}
+			if div == nil {
+				cmd.Printf("No divergence up to height %d\n", to)
+				return nil
+			}
+
+			cmd.Println(div.String())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagReplayTo, 0, "Height to replay the blocks up to, defaults to the latest height of the node")
+	cmd.Flags().String(FlagAppA, "", "ABCI socket address of the first application, defaults to this binary in-process")
+	cmd.Flags().String(FlagAppB, "", "ABCI socket address of the second application")
+	return cmd
+}
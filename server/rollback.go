@@ -3,14 +3,18 @@ package server
 import (
 	"fmt"
 
-	"github.com/cosmos/cosmos-sdk/client/flags"
-	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	"github.com/spf13/cobra"
 	tmcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
 )
 
+// FlagHard rolls the application state back without Tendermint.
+const FlagHard = "hard"
+
 // NewRollbackCmd creates a command to rollback tendermint and multistore state by one height.
-func NewRollbackCmd(defaultNodeHome string) *cobra.Command {
+func NewRollbackCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "rollback",
 		Short: "rollback cosmos-sdk and tendermint state by one height",
@@ -21,29 +25,61 @@ progress. Rollback overwrites a state at height n with the state at height n - 1
 The application also roll back to height n - 1. No blocks are removed, so upon
 restarting Tendermint the transactions in block n will be re-executed against the
 application.
+
+With --hard, only the application state is rolled back, to the height given by --height
+(n - 1 by default), which must be retained by the pruning strategy. Tendermint is left
+untouched, so upon restarting it replays the blocks after that height against the
+application.
 `,
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := GetServerContextFromCmd(cmd)
 			cfg := ctx.Config
 			home := cfg.RootDir
+
+			hard, _ := cmd.Flags().GetBool(FlagHard)
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			if !hard && height != 0 {
+				return fmt.Errorf("--%s requires --%s", FlagHeight, FlagHard)
+			}
+
 			db, err := openDB(home, GetAppDBBackend(ctx.Viper))
 			if err != nil {
 				return err
 			}
-			// rollback tendermint state
-			height, hash, err := tmcmd.RollbackState(ctx.Config)
-			if err != nil {
-				return fmt.Errorf("failed to rollback tendermint state: %w", err)
+			defer db.Close()
+
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+			cms := app.CommitMultiStore()
+
+			var hash []byte
+			if hard {
+				if height == 0 {
+					height = cms.LastCommitID().Version - 1
+				}
+			} else {
+				// rollback tendermint state
+				height, hash, err = tmcmd.RollbackState(ctx.Config)
+				if err != nil {
+					return fmt.Errorf("failed to rollback tendermint state: %w", err)
+				}
 			}
+
 			// rollback the multistore
-			cms := rootmulti.NewStore(db, ctx.Logger)
-			cms.RollbackToVersion(height)
+			if err := cms.RollbackToVersion(height); err != nil {
+				return fmt.Errorf("failed to rollback to version: %w", err)
+			}
+			if hard {
+				hash = cms.LastCommitID().Hash
+			}
 
-			fmt.Printf("Rolled back state to height %d and hash %X", height, hash)
+			cmd.Printf("Rolled back state to height %d and hash %X\n", height, hash)
 			return nil
 		},
 	}
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Bool(FlagHard, false, "Roll the application state back to --height without rolling Tendermint back")
+	cmd.Flags().Int64(FlagHeight, 0, "Height to roll the application state back to with --hard, defaults to the latest height - 1")
 	return cmd
 }
@@ -0,0 +1,87 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+)
+
+func TestRollbackCmd_Hard(t *testing.T) {
+	tempDir := t.TempDir()
+	encCfg := simapp.MakeTestEncodingConfig()
+	dataDir := filepath.Join(tempDir, "data")
+	newApp := func(db dbm.DB) *simapp.SimApp {
+		return simapp.NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, tempDir, 0, encCfg, simapp.EmptyAppOptions{})
+	}
+
+	db, err := dbm.NewDB("application", dbm.GoLevelDBBackend, dataDir)
+	require.NoError(t, err)
+	app := newApp(db)
+	stateBytes, err := tmjson.MarshalIndent(simapp.GenesisStateWithSingleValidator(t, app), "", " ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	app.Commit()
+
+	hashes := map[int64][]byte{1: app.LastCommitID().Hash}
+	for height := int64(2); height <= 5; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+		hashes[height] = app.LastCommitID().Hash
+	}
+	require.NoError(t, db.Close())
+
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.SetRoot(tempDir)
+	ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+
+	rollback := func(args ...string) error {
+		cmd := server.NewRollbackCmd(func(_ log.Logger, db dbm.DB, _ io.Writer, _ types.AppOptions) types.Application {
+			return newApp(db)
+		}, tempDir)
+		cmd.SetOut(io.Discard)
+		cmd.SetArgs(append(args, fmt.Sprintf("--%s=%s", flags.FlagHome, tempDir)))
+		return cmd.ExecuteContext(ctx)
+	}
+
+	// the height can only be given with --hard
+	require.Error(t, rollback(fmt.Sprintf("--%s=2", server.FlagHeight)))
+
+	// roll back by one height
+	require.NoError(t, rollback(fmt.Sprintf("--%s", server.FlagHard)))
+	// roll back to an arbitrary height
+	require.NoError(t, rollback(fmt.Sprintf("--%s", server.FlagHard), fmt.Sprintf("--%s=2", server.FlagHeight)))
+	// a height after the latest one can't be rolled back to
+	require.Error(t, rollback(fmt.Sprintf("--%s", server.FlagHard), fmt.Sprintf("--%s=4", server.FlagHeight)))
+
+	db, err = dbm.NewDB("application", dbm.GoLevelDBBackend, dataDir)
+	require.NoError(t, err)
+	app = newApp(db)
+	require.Equal(t, int64(2), app.LastBlockHeight())
+	require.Equal(t, hashes[2], app.LastCommitID().Hash)
+
+	// the rolled back heights are committed again
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 3}})
+	app.EndBlock(abci.RequestEndBlock{Height: 3})
+	app.Commit()
+	require.Equal(t, hashes[3], app.LastCommitID().Hash)
+	require.NoError(t, db.Close())
+}
@@ -71,12 +71,7 @@ func NewClient(cfg *Config) (*Client, error) {
 		}
 	}
 
-	supportedOperations = append(
-		supportedOperations,
-		bank.EventTypeCoinSpent,
-		bank.EventTypeCoinReceived,
-		bank.EventTypeCoinBurn,
-	)
+	supportedOperations = append(supportedOperations, eventOpsTypes()...)
 
 	return &Client{
 		supportedOperations: supportedOperations,
@@ -439,20 +434,24 @@ func (c *Client) blockTxs(ctx context.Context, height *int64) (crgtypes.BlockTra
 		panic("block results transactions do now match block transactions")
 	}
 	// process begin and end block txs
+	beginBlockOps, err := c.converter.ToRosetta().EventOps(StatusTxSuccess, blockResults.BeginBlockEvents)
+	if err != nil {
+		return crgtypes.BlockTransactionsResponse{}, err
+	}
+
 	beginBlockTx := &rosettatypes.Transaction{
 		TransactionIdentifier: &rosettatypes.TransactionIdentifier{Hash: c.converter.ToRosetta().BeginBlockTxHash(blockInfo.BlockID.Hash)},
-		Operations: AddOperationIndexes(
-			nil,
-			c.converter.ToRosetta().BalanceOps(StatusTxSuccess, blockResults.BeginBlockEvents),
-		),
+		Operations:            AddOperationIndexes(nil, beginBlockOps),
+	}
+
+	endBlockOps, err := c.converter.ToRosetta().EventOps(StatusTxSuccess, blockResults.EndBlockEvents)
+	if err != nil {
+		return crgtypes.BlockTransactionsResponse{}, err
 	}
 
 	endBlockTx := &rosettatypes.Transaction{
 		TransactionIdentifier: &rosettatypes.TransactionIdentifier{Hash: c.converter.ToRosetta().EndBlockTxHash(blockInfo.BlockID.Hash)},
-		Operations: AddOperationIndexes(
-			nil,
-			c.converter.ToRosetta().BalanceOps(StatusTxSuccess, blockResults.EndBlockEvents),
-		),
+		Operations:            AddOperationIndexes(nil, endBlockOps),
 	}
 
 	deliverTx := make([]*rosettatypes.Transaction, len(blockInfo.Block.Txs))
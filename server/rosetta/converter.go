@@ -72,6 +72,8 @@ type ToRosettaConverter interface {
 	TxIdentifiers(txs []tmtypes.Tx) []*rosettatypes.TransactionIdentifier
 	// BalanceOps converts events to balance operations
 	BalanceOps(status string, events []abci.Event) []*rosettatypes.Operation
+	// EventOps converts events to operations, using the registered EventOpsParsers
+	EventOps(status string, events []abci.Event) ([]*rosettatypes.Operation, error)
 	// SyncStatus converts a tendermint status to sync status
 	SyncStatus(status *tmcoretypes.ResultStatus) *rosettatypes.SyncStatus
 	// Peers converts tendermint peers to rosetta
@@ -293,15 +295,18 @@ func (c converter) Tx(rawTx tmtypes.Tx, txResult *abci.ResponseDeliverTx) (*rose
 		rawTxOps = append(rawTxOps, ops...)
 	}
 
-	// now get balance events from response deliver tx
-	var balanceOps []*rosettatypes.Operation
+	// now get the operations of the events from response deliver tx
+	var eventOps []*rosettatypes.Operation
 	// tx result might be nil, in case we're querying an unconfirmed tx from the mempool
 	if txResult != nil {
-		balanceOps = c.BalanceOps(status, txResult.Events)
+		eventOps, err = c.EventOps(status, txResult.Events)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// now normalize indexes
-	totalOps := AddOperationIndexes(rawTxOps, balanceOps)
+	totalOps := AddOperationIndexes(rawTxOps, eventOps)
 
 	return &rosettatypes.Transaction{
 		TransactionIdentifier: &rosettatypes.TransactionIdentifier{Hash: fmt.Sprintf("%X", rawTx.Hash())},
@@ -309,102 +314,47 @@ func (c converter) Tx(rawTx tmtypes.Tx, txResult *abci.ResponseDeliverTx) (*rose
 	}, nil
 }
 
+// BalanceOps converts the bank events to balance operations. It panics if an
+// event is malformed because it might mean the sdk spec has changed and rosetta
+// needs to reflect those changes too.
 func (c converter) BalanceOps(status string, events []abci.Event) []*rosettatypes.Operation {
 	var ops []*rosettatypes.Operation
 
 	for _, e := range events {
-		balanceOps, ok := sdkEventToBalanceOperations(status, e)
-		if !ok {
+		switch e.Type {
+		case banktypes.EventTypeCoinSpent, banktypes.EventTypeCoinReceived, banktypes.EventTypeCoinBurn:
+		default:
 			continue
 		}
+
+		balanceOps, err := bankEventOps(status, e)
+		if err != nil {
+			panic(err)
+		}
 		ops = append(ops, balanceOps...)
 	}
 
 	return ops
 }
 
-// sdkEventToBalanceOperations converts an event to a rosetta balance operation
-// it will panic if the event is malformed because it might mean the sdk spec
-// has changed and rosetta needs to reflect those changes too.
-// The balance operations are multiple, one for each denom.
-func sdkEventToBalanceOperations(status string, event abci.Event) (operations []*rosettatypes.Operation, isBalanceEvent bool) {
-
-	var (
-		accountIdentifier string
-		coinChange        sdk.Coins
-		isSub             bool
-	)
-
-	switch event.Type {
-	default:
-		return nil, false
-	case banktypes.EventTypeCoinSpent:
-		spender, err := sdk.AccAddressFromBech32(event.Attributes[0].Value)
-		if err != nil {
-			panic(err)
-		}
-		coins, err := sdk.ParseCoinsNormalized(event.Attributes[1].Value)
-		if err != nil {
-			panic(err)
-		}
-
-		isSub = true
-		coinChange = coins
-		accountIdentifier = spender.String()
+// EventOps converts the events with a registered EventOpsParser to operations.
+func (c converter) EventOps(status string, events []abci.Event) ([]*rosettatypes.Operation, error) {
+	var ops []*rosettatypes.Operation
 
-	case banktypes.EventTypeCoinReceived:
-		receiver, err := sdk.AccAddressFromBech32(event.Attributes[0].Value)
-		if err != nil {
-			panic(err)
-		}
-		coins, err := sdk.ParseCoinsNormalized(event.Attributes[1].Value)
-		if err != nil {
-			panic(err)
+	for _, e := range events {
+		parse, ok := eventOpsParsers[e.Type]
+		if !ok {
+			continue
 		}
 
-		isSub = false
-		coinChange = coins
-		accountIdentifier = receiver.String()
-
-	// rosetta does not have the concept of burning coins, so we need to mock
-	// the burn as a send to an address that cannot be resolved to anything
-	case banktypes.EventTypeCoinBurn:
-		coins, err := sdk.ParseCoinsNormalized(event.Attributes[1].Value)
+		eventOps, err := parse(status, e)
 		if err != nil {
-			panic(err)
+			return nil, crgerrs.WrapError(crgerrs.ErrCodec, fmt.Sprintf("invalid %s event: %s", e.Type, err))
 		}
-
-		coinChange = coins
-		accountIdentifier = BurnerAddressIdentifier
+		ops = append(ops, eventOps...)
 	}
 
-	operations = make([]*rosettatypes.Operation, len(coinChange))
-
-	for i, coin := range coinChange {
-
-		value := coin.Amount.String()
-		// in case the event is a subtract balance one the rewrite value with
-		// the negative coin identifier
-		if isSub {
-			value = "-" + value
-		}
-
-		op := &rosettatypes.Operation{
-			Type:    event.Type,
-			Status:  &status,
-			Account: &rosettatypes.AccountIdentifier{Address: accountIdentifier},
-			Amount: &rosettatypes.Amount{
-				Value: value,
-				Currency: &rosettatypes.Currency{
-					Symbol:   coin.Denom,
-					Decimals: 0,
-				},
-			},
-		}
-
-		operations[i] = op
-	}
-	return operations, true
+	return ops, nil
 }
 
 // Amounts converts []sdk.Coin to rosetta amounts
@@ -22,6 +22,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type ConverterTestSuite struct {
@@ -343,6 +345,77 @@ func (s *ConverterTestSuite) TestBalanceOps() {
 	})
 }
 
+func (s *ConverterTestSuite) TestEventOps() {
+	delegator := sdk.AccAddress("delegator").String()
+	validator := sdk.ValAddress("validator").String()
+
+	s.Run("bank and staking events", func() {
+		events := []abci.Event{
+			{Type: "not-an-op"},
+			abci.Event(bank.NewCoinSpentEvent(sdk.AccAddress("delegator"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))),
+			abci.Event(sdk.NewEvent(
+				staking.EventTypeDelegate,
+				sdk.NewAttribute(staking.AttributeKeyValidator, validator),
+				sdk.NewAttribute(staking.AttributeKeyDelegator, delegator),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, "10stake"),
+			)),
+		}
+
+		ops, err := s.c.ToRosetta().EventOps(rosetta.StatusTxSuccess, events)
+		s.Require().NoError(err)
+		s.Require().Len(ops, 2)
+
+		s.Require().Equal(bank.EventTypeCoinSpent, ops[0].Type)
+		s.Require().Equal(delegator, ops[0].Account.Address)
+		s.Require().Equal("-10", ops[0].Amount.Value)
+
+		s.Require().Equal(staking.EventTypeDelegate, ops[1].Type)
+		s.Require().Equal(rosetta.StatusTxSuccess, *ops[1].Status)
+		s.Require().Equal(delegator, ops[1].Account.Address)
+		s.Require().Nil(ops[1].Amount)
+		s.Require().Equal(map[string]interface{}{
+			staking.AttributeKeyValidator: validator,
+			staking.AttributeKeyDelegator: delegator,
+			sdk.AttributeKeyAmount:        "10stake",
+		}, ops[1].Metadata)
+	})
+
+	s.Run("attributes in any order", func() {
+		event := abci.Event(sdk.NewEvent(
+			bank.EventTypeCoinReceived,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "10stake"),
+			sdk.NewAttribute(bank.AttributeKeyReceiver, delegator),
+		))
+
+		ops, err := s.c.ToRosetta().EventOps("", []abci.Event{event})
+		s.Require().NoError(err)
+		s.Require().Len(ops, 1)
+		s.Require().Equal(delegator, ops[0].Account.Address)
+		s.Require().Equal("10", ops[0].Amount.Value)
+	})
+
+	s.Run("malformed event", func() {
+		_, err := s.c.ToRosetta().EventOps("", []abci.Event{{Type: bank.EventTypeCoinSpent}})
+		s.Require().ErrorIs(err, crgerrs.ErrCodec)
+	})
+
+	s.Run("typed event", func() {
+		typedEvent, err := sdk.TypedEventToEvent(&group.EventSubmitProposal{ProposalId: 1})
+		s.Require().NoError(err)
+
+		ops, err := s.c.ToRosetta().EventOps("", []abci.Event{abci.Event(typedEvent)})
+		s.Require().NoError(err)
+		s.Require().Empty(ops, "typed events are only parsed once registered")
+
+		rosetta.RegisterEventOpsParser(typedEvent.Type, rosetta.TypedEventOps)
+		ops, err = s.c.ToRosetta().EventOps("", []abci.Event{abci.Event(typedEvent)})
+		s.Require().NoError(err)
+		s.Require().Len(ops, 1)
+		s.Require().Equal("cosmos.group.v1.EventSubmitProposal", ops[0].Type)
+		s.Require().Equal(map[string]interface{}{"proposal_id": "1"}, ops[0].Metadata)
+	})
+}
+
 func TestConverterTestSuite(t *testing.T) {
 	suite.Run(t, new(ConverterTestSuite))
 }
@@ -0,0 +1,183 @@
+package rosetta
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	rosettatypes "github.com/coinbase/rosetta-sdk-go/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// EventOpsParser parses the rosetta operations, with the given status, of an
+// event. Only the operations changing the balance of an account must have an
+// amount, the balance changes of the sdk being represented by the operations of
+// the bank events.
+type EventOpsParser func(status string, event abci.Event) ([]*rosettatypes.Operation, error)
+
+// eventOpsParsers are the parsers of the operations of the events, by event type.
+var eventOpsParsers = map[string]EventOpsParser{
+	bank.EventTypeCoinSpent:                    bankEventOps,
+	bank.EventTypeCoinReceived:                 bankEventOps,
+	bank.EventTypeCoinBurn:                     bankEventOps,
+	staking.EventTypeDelegate:                  stakingEventOps,
+	staking.EventTypeUnbond:                    stakingEventOps,
+	staking.EventTypeRedelegate:                stakingEventOps,
+	staking.EventTypeCancelUnbondingDelegation: stakingEventOps,
+	staking.EventTypeCompleteUnbonding:         stakingEventOps,
+	staking.EventTypeCompleteRedelegation:      stakingEventOps,
+}
+
+// RegisterEventOpsParser registers the parser of the operations of the events of
+// the given type, replacing the default one if any, so that the events of custom
+// modules are represented by rosetta operations. It must be called before the
+// rosetta client is created.
+func RegisterEventOpsParser(eventType string, parser EventOpsParser) {
+	eventOpsParsers[eventType] = parser
+}
+
+// eventOpsTypes returns the sorted types of the events parsed into operations.
+func eventOpsTypes() []string {
+	types := make([]string, 0, len(eventOpsParsers))
+	for eventType := range eventOpsParsers {
+		types = append(types, eventType)
+	}
+	sort.Strings(types)
+
+	return types
+}
+
+// eventAttribute returns the value of the attribute of event with the given key.
+func eventAttribute(event abci.Event, key string) (string, bool) {
+	for _, attr := range event.Attributes {
+		if attr.Key == key {
+			return attr.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// bankEventOps parses the balance operations of a bank event, one for each denom.
+func bankEventOps(status string, event abci.Event) ([]*rosettatypes.Operation, error) {
+	var (
+		accountKey string
+		isSub      bool
+	)
+
+	switch event.Type {
+	case bank.EventTypeCoinSpent:
+		accountKey, isSub = bank.AttributeKeySpender, true
+	case bank.EventTypeCoinReceived:
+		accountKey = bank.AttributeKeyReceiver
+	case bank.EventTypeCoinBurn:
+		accountKey = bank.AttributeKeyBurner
+	default:
+		return nil, fmt.Errorf("not a bank balance event: %s", event.Type)
+	}
+
+	amount, ok := eventAttribute(event, sdk.AttributeKeyAmount)
+	if !ok {
+		return nil, fmt.Errorf("%s event without %s", event.Type, sdk.AttributeKeyAmount)
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// rosetta does not have the concept of burning coins, so we need to mock
+	// the burn as a send to an address that cannot be resolved to anything
+	accountIdentifier := BurnerAddressIdentifier
+	if event.Type != bank.EventTypeCoinBurn {
+		account, ok := eventAttribute(event, accountKey)
+		if !ok {
+			return nil, fmt.Errorf("%s event without %s", event.Type, accountKey)
+		}
+
+		addr, err := sdk.AccAddressFromBech32(account)
+		if err != nil {
+			return nil, err
+		}
+		accountIdentifier = addr.String()
+	}
+
+	operations := make([]*rosettatypes.Operation, len(coins))
+	for i, coin := range coins {
+		value := coin.Amount.String()
+		// in case the event is a subtract balance one the rewrite value with
+		// the negative coin identifier
+		if isSub {
+			value = "-" + value
+		}
+
+		operations[i] = &rosettatypes.Operation{
+			Type:    event.Type,
+			Status:  &status,
+			Account: &rosettatypes.AccountIdentifier{Address: accountIdentifier},
+			Amount: &rosettatypes.Amount{
+				Value: value,
+				Currency: &rosettatypes.Currency{
+					Symbol:   coin.Denom,
+					Decimals: 0,
+				},
+			},
+		}
+	}
+
+	return operations, nil
+}
+
+// stakingEventOps parses the operation of a staking event, on the account of the
+// delegator, with the attributes of the event as metadata. It has no amount as
+// the coins delegated and undelegated are moved by the bank.
+func stakingEventOps(status string, event abci.Event) ([]*rosettatypes.Operation, error) {
+	meta := make(map[string]interface{}, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		meta[attr.Key] = attr.Value
+	}
+
+	op := &rosettatypes.Operation{
+		Type:     event.Type,
+		Status:   &status,
+		Metadata: meta,
+	}
+	if delegator, ok := eventAttribute(event, staking.AttributeKeyDelegator); ok {
+		op.Account = &rosettatypes.AccountIdentifier{Address: delegator}
+	}
+
+	return []*rosettatypes.Operation{op}, nil
+}
+
+// TypedEventOps parses the operation of a typed event, i.e. an event emitted with
+// EmitTypedEvent, with the JSON encoded event as metadata. It can be registered
+// with RegisterEventOpsParser for the typed events of a module, e.g.
+//
+//	rosetta.RegisterEventOpsParser(proto.MessageName(&group.EventExec{}), rosetta.TypedEventOps)
+func TypedEventOps(status string, event abci.Event) ([]*rosettatypes.Operation, error) {
+	typedEvent, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.ProtoMarshalJSON(typedEvent, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var meta map[string]interface{}
+	if err := json.Unmarshal(bz, &meta); err != nil {
+		return nil, err
+	}
+
+	return []*rosettatypes.Operation{{
+		Type:     event.Type,
+		Status:   &status,
+		Metadata: meta,
+	}}, nil
+}
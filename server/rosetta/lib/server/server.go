@@ -1,6 +1,8 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -34,12 +36,21 @@ type Settings struct {
 }
 
 type Server struct {
-	h    http.Handler
-	addr string
+	srv *http.Server
 }
 
 func (h Server) Start() error {
-	return http.ListenAndServe(h.addr, h.h)
+	err := h.srv.ListenAndServe()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// Shutdown gracefully shuts down the server, waiting for the active requests
+// until ctx is done.
+func (h Server) Shutdown(ctx context.Context) error {
+	return h.srv.Shutdown(ctx)
 }
 
 func NewServer(settings Settings) (Server, error) {
@@ -76,8 +87,7 @@ func NewServer(settings Settings) (Server, error) {
 	)
 
 	return Server{
-		h:    h,
-		addr: settings.Listen,
+		srv: &http.Server{Addr: settings.Listen, Handler: h},
 	}, nil
 }
 
@@ -0,0 +1,188 @@
+package server
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/snapshots/objstore"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+// GetSnapshotTransport returns the transport to the remote snapshots
+// configured in appOpts, or nil if none is.
+func GetSnapshotTransport(appOpts types.AppOptions) (snapshottypes.SnapshotTransport, error) {
+	url := cast.ToString(appOpts.Get(FlagStateSyncRemoteURL))
+	if url == "" {
+		return nil, nil
+	}
+
+	transport, err := objstore.NewS3Transport(objstore.Config{
+		URL:      url,
+		Endpoint: cast.ToString(appOpts.Get(FlagStateSyncRemoteEndpoint)),
+		Region:   cast.ToString(appOpts.Get(FlagStateSyncRemoteRegion)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transport, nil
+}
+
+// NewSnapshotsCmd creates the commands moving the snapshots between the local
+// snapshot store and the remote snapshots configured in state-sync.remote-url.
+func NewSnapshotsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage the remote state sync snapshots",
+		Long: `Manage the state sync snapshots stored in the S3-compatible object storage configured
+in state-sync.remote-url. A pulled snapshot is saved in the local snapshot store, from which
+the node serves it to the state syncing peers once started.`,
+	}
+
+	cmd.AddCommand(
+		listRemoteSnapshotsCmd(),
+		pushSnapshotCmd(),
+		pullSnapshotCmd(),
+	)
+
+	return cmd
+}
+
+func listRemoteSnapshotsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list-remote",
+		Short: "List the remote snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			transport, err := snapshotTransportFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			remote, err := transport.List()
+			if err != nil {
+				return err
+			}
+			for _, snapshot := range remote {
+				cmd.Printf("height: %d format: %d chunks: %d hash: %X\n", snapshot.Height, snapshot.Format, snapshot.Chunks, snapshot.Hash)
+			}
+
+			return nil
+		},
+	}
+}
+
+func pushSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "push [height] [format]",
+		Short: "Push a snapshot of the local snapshot store to the remote snapshots",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			transport, err := snapshotTransportFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			store, db, err := openSnapshotStore(cmd)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			return snapshots.PushSnapshot(store, transport, height, format)
+		},
+	}
+}
+
+func pullSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "pull [height] [format]",
+		Short: "Pull a remote snapshot into the local snapshot store",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			transport, err := snapshotTransportFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			store, db, err := openSnapshotStore(cmd)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			snapshot, err := snapshots.PullSnapshot(store, transport, height, format)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("pulled snapshot at height %d in format %d with %d chunks\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+
+			return nil
+		},
+	}
+}
+
+// snapshotTransportFromCmd returns the transport to the remote snapshots
+// configured for the node of cmd.
+func snapshotTransportFromCmd(cmd *cobra.Command) (snapshottypes.SnapshotTransport, error) {
+	transport, err := GetSnapshotTransport(GetServerContextFromCmd(cmd).Viper)
+	if err != nil {
+		return nil, err
+	}
+	if transport == nil {
+		return nil, fmt.Errorf("no remote snapshots configured, see %s", FlagStateSyncRemoteURL)
+	}
+
+	return transport, nil
+}
+
+// openSnapshotStore opens the local snapshot store of the node of cmd. The
+// caller must close the returned database when done.
+func openSnapshotStore(cmd *cobra.Command) (*snapshots.Store, dbm.DB, error) {
+	ctx := GetServerContextFromCmd(cmd)
+
+	snapshotDir := filepath.Join(ctx.Config.RootDir, "data", "snapshots")
+	db, err := dbm.NewDB("metadata", GetAppDBBackend(ctx.Viper), snapshotDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	store, err := snapshots.NewStore(db, snapshotDir)
+	if err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+
+	return store, db, nil
+}
+
+func parseSnapshotArgs(args []string) (uint64, uint32, error) {
+	height, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid snapshot height %q: %w", args[0], err)
+	}
+
+	format, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid snapshot format %q: %w", args[1], err)
+	}
+
+	return height, uint32(format), nil
+}
@@ -3,7 +3,9 @@ package server
 // DONTCOVER
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"os"
@@ -18,6 +20,7 @@ import (
 	tmservice "github.com/tendermint/tendermint/libs/service"
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/rpc/client/local"
+	"github.com/tendermint/tendermint/rpc/coretypes"
 	tmtypes "github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -27,11 +30,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
 	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/server/api/openapi"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+	"github.com/cosmos/cosmos-sdk/server/grpc/admin"
+	"github.com/cosmos/cosmos-sdk/server/lifecycle"
 	"github.com/cosmos/cosmos-sdk/server/rosetta"
 	crgserver "github.com/cosmos/cosmos-sdk/server/rosetta/lib/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/cosmos/cosmos-sdk/version"
 )
 
 const (
@@ -46,22 +54,41 @@ const (
 	FlagHaltTime           = "halt-time"
 	FlagInterBlockCache    = "inter-block-cache"
 	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
+	FlagAutoStoreUpgrades  = "auto-store-upgrades"
 	FlagTrace              = "trace"
 	FlagInvCheckPeriod     = "inv-check-period"
 
-	FlagPruning           = "pruning"
-	FlagPruningKeepRecent = "pruning-keep-recent"
-	FlagPruningInterval   = "pruning-interval"
-	FlagIndexEvents       = "index-events"
-	FlagMinRetainBlocks   = "min-retain-blocks"
+	FlagPruning            = "pruning"
+	FlagPruningKeepRecent  = "pruning-keep-recent"
+	FlagPruningInterval    = "pruning-interval"
+	FlagPruningAsyncQueue  = "pruning-async-queue-size"
+	FlagIndexEvents        = "index-events"
+	FlagEventAggregation   = "event-aggregation-threshold"
+	FlagMaxTxEventBytes    = "max-tx-event-bytes"
+	FlagMaxTxLogBytes      = "max-tx-log-bytes"
+	FlagMaxBlockEventBytes = "max-block-event-bytes"
+	FlagMaxBlockLogBytes   = "max-block-log-bytes"
+	FlagMinRetainBlocks    = "min-retain-blocks"
 
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncRemoteURL          = "state-sync.remote-url"
+	FlagStateSyncRemoteEndpoint     = "state-sync.remote-endpoint"
+	FlagStateSyncRemoteRegion       = "state-sync.remote-region"
+
+	// query cache-related flags
+	FlagQueryCacheSize   = "query-cache.size"
+	FlagQueryCacheRoutes = "query-cache.routes"
+
+	// mempool-related flags
+	FlagMempoolMaxTxsPerSender = "mempool.max-txs-per-sender"
+	FlagMempoolTxTTL           = "mempool.tx-ttl"
 
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
 	FlagAPISwagger            = "api.swagger"
+	FlagAPIOpenAPI            = "api.openapi"
 	FlagAPIAddress            = "api.address"
 	FlagAPIMaxOpenConnections = "api.max-open-connections"
 	FlagRPCReadTimeout        = "api.rpc-read-timeout"
@@ -71,8 +98,10 @@ const (
 
 	// gRPC-related flags
 	flagGRPCOnly       = "grpc-only"
+	FlagQueryNode      = "query-node"
 	flagGRPCEnable     = "grpc.enable"
 	flagGRPCAddress    = "grpc.address"
+	flagGRPCAdmin      = "grpc.enable-admin"
 	flagGRPCWebEnable  = "grpc-web.enable"
 	flagGRPCWebAddress = "grpc-web.address"
 )
@@ -109,6 +138,13 @@ The node may be started in a 'query only' mode where only the gRPC and JSON HTTP
 API services are enabled via the 'grpc-only' flag. In this mode, Tendermint is
 bypassed and can be used when legacy queries are needed after an on-chain upgrade
 is performed. Note, when enabled, gRPC will also be automatically enabled.
+
+The '--query-node' flag starts the node as a query node: on top of the 'grpc-only'
+mode, the application database is opened read-only and no state sync snapshots
+are taken. The queries are served at the heights retained in the database, which
+may be shared by several query nodes, scaling the query infrastructure off a
+single synced data directory. The data directory must not be used by a running
+full node, and only the goleveldb backend is supported.
 `,
 		PreRunE: func(cmd *cobra.Command, _ []string) error {
 			serverCtx := GetServerContextFromCmd(cmd)
@@ -130,7 +166,7 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 			}
 
 			withTM, _ := cmd.Flags().GetBool(flagWithTendermint)
-			if !withTM {
+			if !withTM && !serverCtx.Viper.GetBool(FlagQueryNode) {
 				serverCtx.Logger.Info("starting ABCI without Tendermint")
 				return startStandAlone(serverCtx, appCreator)
 			}
@@ -154,6 +190,7 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
 	cmd.Flags().String(FlagMinGasPrices, "", "Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)")
 	cmd.Flags().IntSlice(FlagUnsafeSkipUpgrades, []int{}, "Skip a set of upgrade heights to continue the old binary")
+	cmd.Flags().Bool(FlagAutoStoreUpgrades, false, "Apply the store upgrades planned from the module version map diff of a pending upgrade, instead of only logging them")
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
@@ -162,11 +199,13 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().String(FlagPruning, pruningtypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Uint(FlagPruningAsyncQueue, 10, "Number of pruning runs pending before the commits wait for the stores pruned in the background (0 prunes the stores in the commit)")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune Tendermint blocks")
 
 	cmd.Flags().Bool(FlagAPIEnable, false, "Define if the API server should be enabled")
 	cmd.Flags().Bool(FlagAPISwagger, false, "Define if swagger documentation should automatically be registered (Note: api must also be enabled.)")
+	cmd.Flags().Bool(FlagAPIOpenAPI, true, "Define if the OpenAPI document of the REST API should be served at /openapi.json (Note: api must also be enabled.)")
 	cmd.Flags().String(FlagAPIAddress, config.DefaultAPIAddress, "the API server address to listen on")
 	cmd.Flags().Uint(FlagAPIMaxOpenConnections, 1000, "Define the number of maximum open connections")
 	cmd.Flags().Uint(FlagRPCReadTimeout, 10, "Define the Tendermint RPC read timeout (in seconds)")
@@ -175,14 +214,25 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Bool(FlagAPIEnableUnsafeCORS, false, "Define if CORS should be enabled (unsafe - use it at your own risk)")
 
 	cmd.Flags().Bool(flagGRPCOnly, false, "Start the node in gRPC query only mode (no Tendermint process is started)")
+	cmd.Flags().Bool(FlagQueryNode, false, "Start the node in gRPC query only mode with the application database opened read-only (no Tendermint process is started)")
 	cmd.Flags().Bool(flagGRPCEnable, true, "Define if the gRPC server should be enabled")
 	cmd.Flags().String(flagGRPCAddress, config.DefaultGRPCAddress, "the gRPC server address to listen on")
+	cmd.Flags().Bool(flagGRPCAdmin, false, "Define if the gRPC admin service, e.g. changing the log levels at runtime, should be enabled, authorized with grpc.admin-token in app.toml (unsafe - do not expose the gRPC and API servers publicly)")
 
 	cmd.Flags().Bool(flagGRPCWebEnable, true, "Define if the gRPC-Web server should be enabled. (Note: gRPC must also be enabled.)")
 	cmd.Flags().String(flagGRPCWebAddress, config.DefaultGRPCWebAddress, "The gRPC-Web server address to listen on")
 
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().String(FlagStateSyncRemoteURL, "", "S3-compatible location the state sync snapshots are pushed to, s3://<bucket>[/<prefix>]")
+	cmd.Flags().String(FlagStateSyncRemoteEndpoint, "", "Endpoint of the S3-compatible API of the remote snapshots, AWS S3 if empty")
+	cmd.Flags().String(FlagStateSyncRemoteRegion, "", "Region of the bucket of the remote snapshots")
+
+	cmd.Flags().Int(FlagQueryCacheSize, 1000, "Maximum number of cached gRPC query responses")
+	cmd.Flags().StringSlice(FlagQueryCacheRoutes, []string{}, "gRPC methods, or service prefixes ending with '/', whose query responses are cached")
+
+	cmd.Flags().Int(FlagMempoolMaxTxsPerSender, 0, "Maximum number of txs of a sender pending in the mempool (0 means unlimited)")
+	cmd.Flags().Uint64(FlagMempoolTxTTL, 0, "Number of blocks the txs without a timeout height are kept in the mempool (0 means no TTL)")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -254,7 +304,13 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		}
 	}
 
-	db, err := openDB(home, GetAppDBBackend(ctx.Viper))
+	queryNode := ctx.Viper.GetBool(FlagQueryNode)
+	openAppDB := openDB
+	if queryNode {
+		openAppDB = openReadOnlyDB
+	}
+
+	db, err := openAppDB(home, GetAppDBBackend(ctx.Viper))
 	if err != nil {
 		return err
 	}
@@ -265,12 +321,53 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		return err
 	}
 
+	if err := config.Validate(ctx.Viper); err != nil {
+		return err
+	}
 	config := config.GetConfig(ctx.Viper)
-	if err := config.ValidateBasic(); err != nil {
+
+	shutdownTracing, err := telemetry.InitTracing(context.Background(), config.Tracing)
+	if err != nil {
 		return err
 	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			ctx.Logger.Error("failed to shutdown tracing", "err", err)
+		}
+	}()
+
+	// The metrics are shared by the /metrics endpoint of the API server and the
+	// admin service, which would otherwise each register their own sinks.
+	metrics, err := telemetry.New(config.Telemetry)
+	if err != nil {
+		return err
+	}
+
+	// The components of the node are registered as they are started, and shut
+	// down in the reverse order when the node exits: the servers first, then
+	// Tendermint and finally the app.
+	lc := lifecycle.NewManager(ctx.Logger.With("module", "lifecycle"), time.Duration(config.ShutdownTimeout)*time.Second)
+	defer func() {
+		if err := lc.Shutdown(); err != nil {
+			ctx.Logger.Error("failed to shut down gracefully", "err", err)
+		}
+
+		if cpuProfileCleanup != nil {
+			cpuProfileCleanup()
+		}
+
+		ctx.Logger.Info("exiting...")
+	}()
 
 	app := appCreator(ctx.Logger, db, traceWriter, ctx.Viper)
+	lc.Register("app", func(context.Context) error {
+		// wait for the background snapshots and close the streaming services
+		if closer, ok := app.(io.Closer); ok {
+			return closer.Close()
+		}
+		return nil
+	})
+	lc.SetRunning("app")
 
 	genDoc, err := tmtypes.GenesisDocFromFile(cfg.GenesisFile())
 	if err != nil {
@@ -279,10 +376,14 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 
 	var (
 		tmNode   tmservice.Service
-		gRPCOnly = ctx.Viper.GetBool(flagGRPCOnly)
+		gRPCOnly = ctx.Viper.GetBool(flagGRPCOnly) || queryNode
 	)
 	if gRPCOnly {
-		ctx.Logger.Info("starting node in gRPC only mode; Tendermint is disabled")
+		if queryNode {
+			ctx.Logger.Info("starting query node; the application database is read-only and Tendermint is disabled")
+		} else {
+			ctx.Logger.Info("starting node in gRPC only mode; Tendermint is disabled")
+		}
 		config.GRPC.Enable = true
 	} else {
 		ctx.Logger.Info("starting node with ABCI Tendermint in-process")
@@ -292,9 +393,16 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			return err
 		}
 
+		lc.Register("tendermint", func(context.Context) error {
+			if tmNode.IsRunning() {
+				return tmNode.Stop()
+			}
+			return nil
+		})
 		if err := tmNode.Start(); err != nil {
 			return err
 		}
+		lc.SetRunning("tendermint")
 	}
 
 	// Add the tx service to the gRPC router. We only need to register this
@@ -315,6 +423,13 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 
 		app.RegisterTxService(clientCtx)
 		app.RegisterTendermintService(clientCtx)
+
+		if config.Telemetry.Enabled {
+			mempoolCtx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			go emitMempoolMetrics(mempoolCtx, localNode, mempoolMetricsInterval)
+		}
 	}
 
 	var apiSrv *api.Server
@@ -349,11 +464,29 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		}
 
 		apiSrv = api.New(clientCtx, ctx.Logger.With("module", "api-server"))
+		apiSrv.SetTelemetry(metrics)
 		app.RegisterAPIRoutes(apiSrv, config.API)
-		errCh := make(chan error)
+		if config.GRPC.Enable && config.GRPC.EnableAdmin {
+			if err := admin.RegisterAdminServiceHandlerClient(context.Background(), apiSrv.GRPCGatewayRouter, admin.NewAdminServiceClient(clientCtx)); err != nil {
+				return err
+			}
+		}
+		if config.API.OpenAPI {
+			if err := registerOpenAPI(apiSrv, app, config.GRPC.Enable && config.GRPC.EnableAdmin); err != nil {
+				return err
+			}
+		}
+		apiSrv.Router.Handle("/readyz", lc.ReadinessHandler()).Methods("GET")
+		apiSrv.Router.Handle("/livez", lc.LivenessHandler()).Methods("GET")
+
+		lc.Register("api", func(context.Context) error {
+			return apiSrv.Close()
+		})
+		errCh := make(chan error, 1)
 
 		go func() {
 			if err := apiSrv.Start(config); err != nil {
+				lc.Fail("api", err)
 				errCh <- err
 			}
 		}()
@@ -363,6 +496,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			return err
 
 		case <-time.After(types.ServerStartTime): // assume server started successfully
+			lc.SetRunning("api")
 		}
 	}
 
@@ -372,10 +506,37 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	)
 
 	if config.GRPC.Enable {
-		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, app, config.GRPC.Address)
+		var adminCfg *admin.Config
+		if config.GRPC.EnableAdmin {
+			adminApp, _ := app.(admin.App)
+			adminCfg = &admin.Config{
+				Token:     config.GRPC.AdminToken,
+				LogLevels: ctx.LogLevels,
+				App:       adminApp,
+				Node:      clientCtx.Client,
+				Metrics:   metrics,
+			}
+		}
+
+		methodLimits, err := config.QueryLimits.MethodLimits()
+		if err != nil {
+			return err
+		}
+		queryLimits := grpc.ChainUnaryInterceptor(servergrpc.NewQueryLimitsInterceptor(config.QueryLimits.QueryLimits, methodLimits))
+		streamLimits := grpc.ChainStreamInterceptor(
+			servergrpc.NewStreamLimitsInterceptor(config.GRPC.MaxStreamDuration, config.GRPC.MaxConcurrentStreams),
+			servergrpc.NewQueryLimitsStreamInterceptor(config.QueryLimits.QueryLimits, methodLimits),
+		)
+
+		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, app, config.GRPC.Address, adminCfg, queryLimits, streamLimits)
 		if err != nil {
 			return err
 		}
+		lc.Register("grpc", func(ctx context.Context) error {
+			stopGRPCServer(ctx, grpcSrv)
+			return nil
+		})
+		lc.SetRunning("grpc")
 
 		if config.GRPCWeb.Enable {
 			grpcWebSrv, err = servergrpc.StartGRPCWeb(grpcSrv, config)
@@ -383,6 +544,8 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 				ctx.Logger.Error("failed to start grpc-web http server: ", err)
 				return err
 			}
+			lc.Register("grpc-web", grpcWebSrv.Shutdown)
+			lc.SetRunning("grpc-web")
 		}
 	}
 
@@ -420,9 +583,11 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			return err
 		}
 
-		errCh := make(chan error)
+		lc.Register("rosetta", rosettaSrv.Shutdown)
+		errCh := make(chan error, 1)
 		go func() {
 			if err := rosettaSrv.Start(); err != nil {
+				lc.Fail("rosetta", err)
 				errCh <- err
 			}
 		}()
@@ -432,34 +597,88 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			return err
 
 		case <-time.After(types.ServerStartTime): // assume server started successfully
+			lc.SetRunning("rosetta")
 		}
 	}
 
-	defer func() {
-		if tmNode.IsRunning() {
-			_ = tmNode.Stop()
-		}
+	// wait for signal capture and gracefully return
+	return WaitForQuitSignals()
+}
 
-		if cpuProfileCleanup != nil {
-			cpuProfileCleanup()
-		}
+// stopGRPCServer gracefully stops the gRPC server, waiting for the pending
+// requests until ctx is done, then stops it.
+func stopGRPCServer(ctx context.Context, grpcSrv *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		grpcSrv.GracefulStop()
+		close(stopped)
+	}()
 
-		if apiSrv != nil {
-			_ = apiSrv.Close()
-		}
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		grpcSrv.Stop()
+	}
+}
 
-		if grpcSrv != nil {
-			grpcSrv.Stop()
-			if grpcWebSrv != nil {
-				if err := grpcWebSrv.Close(); err != nil {
-					ctx.Logger.Error("failed to close grpc-web http server: ", err)
-				}
-			}
-		}
+// registerOpenAPI registers the route serving the OpenAPI document of the REST
+// API, generated from the gRPC services of the app, and of the admin service if
+// it is served.
+func registerOpenAPI(apiSrv *api.Server, app types.Application, withAdmin bool) error {
+	services := &openapi.ServiceCollector{}
+	app.RegisterGRPCServer(services)
+	if withAdmin {
+		admin.RegisterAdminServiceServer(services, nil)
+	}
 
-		ctx.Logger.Info("exiting...")
-	}()
+	title := "REST API"
+	if version.Name != "" {
+		title = version.Name + " " + title
+	}
+	doc, err := openapi.Generate(openapi.Info{
+		Title:       title,
+		Description: "The gRPC-gateway routes of the gRPC services of the app",
+		Version:     version.Version,
+	}, services.Services())
+	if err != nil {
+		return fmt.Errorf("failed to generate the OpenAPI document: %w", err)
+	}
 
-	// wait for signal capture and gracefully return
-	return WaitForQuitSignals()
+	handler, err := openapi.Handler(doc)
+	if err != nil {
+		return err
+	}
+	apiSrv.Router.Handle("/openapi.json", handler).Methods("GET")
+	return nil
+}
+
+// mempoolMetricsInterval is the interval at which the size of the mempool is emitted.
+const mempoolMetricsInterval = 5 * time.Second
+
+// mempoolClient is the part of the Tendermint client needed to monitor the mempool.
+type mempoolClient interface {
+	NumUnconfirmedTxs(context.Context) (*coretypes.ResultUnconfirmedTxs, error)
+}
+
+// emitMempoolMetrics emits the number of txs in the mempool and their size at
+// every interval, until the context is done.
+func emitMempoolMetrics(ctx context.Context, client mempoolClient, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			res, err := client.NumUnconfirmedTxs(ctx)
+			if err != nil {
+				continue
+			}
+
+			telemetry.SetGauge(float32(res.Total), telemetry.MetricKeyMempool, "size")
+			telemetry.SetGauge(float32(res.TotalBytes), telemetry.MetricKeyMempool, "size_bytes")
+		}
+	}
 }
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	dbm "github.com/tendermint/tm-db"
+)
+
+type mockMempoolClient struct {
+	calls chan struct{}
+}
+
+func (c mockMempoolClient) NumUnconfirmedTxs(context.Context) (*coretypes.ResultUnconfirmedTxs, error) {
+	c.calls <- struct{}{}
+	return &coretypes.ResultUnconfirmedTxs{Total: 3, TotalBytes: 120}, nil
+}
+
+func TestEmitMempoolMetrics(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	conf := metrics.DefaultConfig("test")
+	conf.EnableHostname = false
+	conf.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(conf, sink)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client := mockMempoolClient{calls: make(chan struct{})}
+	done := make(chan struct{})
+	go func() {
+		emitMempoolMetrics(ctx, client, time.Millisecond)
+		close(done)
+	}()
+
+	<-client.calls
+	<-client.calls
+	cancel()
+	<-done
+
+	gauges := sink.Data()[0].Gauges
+	require.Equal(t, float32(3), gauges["test.mempool.size"].Value)
+	require.Equal(t, float32(120), gauges["test.mempool.size_bytes"].Value)
+}
+
+func TestOpenReadOnlyDB(t *testing.T) {
+	home := t.TempDir()
+
+	// the database must exist
+	_, err := openReadOnlyDB(home, dbm.GoLevelDBBackend)
+	require.Error(t, err)
+
+	db, err := openDB(home, dbm.GoLevelDBBackend)
+	require.NoError(t, err)
+	require.NoError(t, db.Set([]byte("key"), []byte("value")))
+	require.NoError(t, db.Close())
+
+	// several processes can open the database read-only
+	db1, err := openReadOnlyDB(home, dbm.GoLevelDBBackend)
+	require.NoError(t, err)
+	defer db1.Close()
+	db2, err := openReadOnlyDB(home, dbm.GoLevelDBBackend)
+	require.NoError(t, err)
+	defer db2.Close()
+
+	value, err := db1.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.Error(t, db1.Set([]byte("key"), []byte("other")))
+
+	_, err = openReadOnlyDB(filepath.Join(home, "other"), dbm.MemDBBackend)
+	require.Error(t, err)
+}
@@ -14,8 +14,10 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ServerStartTime defines the time duration that the server need to stay running after startup
@@ -52,6 +54,10 @@ type (
 
 		// RegisterTendermintService registers the gRPC Query service for tendermint queries.
 		RegisterTendermintService(clientCtx client.Context)
+
+		// CommitMultiStore returns the multistore of the application, e.g. to roll
+		// its state back.
+		CommitMultiStore() sdk.CommitMultiStore
 	}
 
 	// AppCreator is a function that allows us to lazily initialize an
@@ -66,6 +72,10 @@ type (
 	ExportedApp struct {
 		// AppState is the application state as JSON.
 		AppState json.RawMessage
+		// WriteAppState, if set, writes the application state as JSON to the given
+		// writer. It is set instead of AppState by apps streaming their state, so that
+		// it isn't held in memory.
+		WriteAppState func(io.Writer) error
 		// Validators is the exported validator set.
 		Validators []tmtypes.GenesisValidator
 		// Height is the app's latest block height.
@@ -77,4 +87,24 @@ type (
 	// AppExporter is a function that dumps all app state to
 	// JSON-serializable structure and returns the current validator set.
 	AppExporter func(log.Logger, dbm.DB, io.Writer, int64, bool, []string, AppOptions) (ExportedApp, error)
+
+	// InPlaceTestnetConfig defines the changes applied to the app state by an
+	// InPlaceTestnetExporter, to turn it into the state of a single validator
+	// testnet.
+	InPlaceTestnetConfig struct {
+		// ValidatorPubKey is the consensus public key of the validator replacing
+		// the validator set.
+		ValidatorPubKey cryptotypes.PubKey
+		// OperatorAddress is the address of the account operating the validator.
+		OperatorAddress sdk.AccAddress
+		// VotingPeriod is the governance voting and maximum deposit period.
+		VotingPeriod time.Duration
+		// DowntimeJailDuration is the duration validators are jailed for downtime.
+		DowntimeJailDuration time.Duration
+	}
+
+	// InPlaceTestnetExporter is a function that applies the changes of an
+	// InPlaceTestnetConfig to the latest app state, without committing them, and
+	// exports the resulting app state like an AppExporter.
+	InPlaceTestnetExporter func(log.Logger, dbm.DB, io.Writer, InPlaceTestnetConfig, AppOptions) (ExportedApp, error)
 )
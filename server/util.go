@@ -20,6 +20,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb/opt"
 	tmcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
 	tmcfg "github.com/tendermint/tendermint/config"
 	tmlog "github.com/tendermint/tendermint/libs/log"
@@ -27,6 +28,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	serverlog "github.com/cosmos/cosmos-sdk/server/log"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -43,6 +45,10 @@ type Context struct {
 	Viper  *viper.Viper
 	Config *tmcfg.Config
 	Logger tmlog.Logger
+
+	// LogLevels are the levels Logger filters the logs with, if any. They can be
+	// changed at runtime.
+	LogLevels *serverlog.Levels
 }
 
 // ErrorCode contains the exit code for server exit.
@@ -58,12 +64,12 @@ func NewDefaultContext() *Context {
 	return NewContext(
 		viper.New(),
 		tmcfg.DefaultConfig(),
-		ZeroLogWrapper{log.Logger},
+		ZeroLogWrapper{Logger: log.Logger},
 	)
 }
 
 func NewContext(v *viper.Viper, config *tmcfg.Config, logger tmlog.Logger) *Context {
-	return &Context{v, config, logger}
+	return &Context{Viper: v, Config: config, Logger: logger}
 }
 
 func bindFlags(basename string, cmd *cobra.Command, v *viper.Viper) (err error) {
@@ -153,12 +159,16 @@ func InterceptConfigsPreRunHandler(cmd *cobra.Command, customAppConfigTemplate s
 	}
 
 	logLvlStr := serverCtx.Viper.GetString(flags.FlagLogLevel)
-	logLvl, err := zerolog.ParseLevel(logLvlStr)
+	logLevels, err := serverlog.ParseLevels(logLvlStr)
 	if err != nil {
-		return fmt.Errorf("failed to parse log level (%s): %w", logLvlStr, err)
+		return err
 	}
 
-	serverCtx.Logger = ZeroLogWrapper{zerolog.New(logWriter).Level(logLvl).With().Timestamp().Logger()}
+	serverCtx.LogLevels = logLevels
+	serverCtx.Logger = ZeroLogWrapper{
+		Logger: zerolog.New(logWriter).With().Timestamp().Logger(),
+		Levels: logLevels,
+	}
 
 	return SetCmdServerContext(cmd, serverCtx)
 }
@@ -293,7 +303,9 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		tendermintCmd,
 		ExportCmd(appExport, defaultNodeHome),
 		version.NewVersionCommand(),
-		NewRollbackCmd(defaultNodeHome),
+		NewRollbackCmd(appCreator, defaultNodeHome),
+		NewReplayDiffCmd(appCreator, defaultNodeHome),
+		NewSnapshotsCmd(),
 	)
 }
 
@@ -405,6 +417,22 @@ func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	return dbm.NewDB("application", backendType, dataDir)
 }
 
+// openReadOnlyDB opens the application database read-only, so that several
+// processes can share it. Only the goleveldb backend supports it.
+func openReadOnlyDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	if backendType != dbm.GoLevelDBBackend {
+		return nil, fmt.Errorf("the %s database backend can't be opened read-only, only %s can", backendType, dbm.GoLevelDBBackend)
+	}
+
+	dataDir := filepath.Join(rootDir, "data")
+	db, err := dbm.NewGoLevelDBWithOpts("application", dataDir, &opt.Options{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
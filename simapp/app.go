@@ -1,7 +1,8 @@
 package simapp
 
 import (
-	"encoding/json"
+	"bytes"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
@@ -13,10 +14,12 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/moduleparams"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -31,6 +34,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/accounts"
+	"github.com/cosmos/cosmos-sdk/x/accounts/defaults/spendinglimit"
+	accountskeeper "github.com/cosmos/cosmos-sdk/x/accounts/keeper"
+	accountsmodule "github.com/cosmos/cosmos-sdk/x/accounts/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
@@ -48,6 +55,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/capability"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	circuitkeeper "github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	circuitmodule "github.com/cosmos/cosmos-sdk/x/circuit/module"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
@@ -55,12 +65,18 @@ import (
 	distrclient "github.com/cosmos/cosmos-sdk/x/distribution/client"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	epochskeeper "github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	epochsmodule "github.com/cosmos/cosmos-sdk/x/epochs/module"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	feemarketkeeper "github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarketmodule "github.com/cosmos/cosmos-sdk/x/feemarket/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
@@ -83,12 +99,18 @@ import (
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+	protocolpoolkeeper "github.com/cosmos/cosmos-sdk/x/protocolpool/keeper"
+	protocolpoolmodule "github.com/cosmos/cosmos-sdk/x/protocolpool/module"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	tokenfactorykeeper "github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	tokenfactorymodule "github.com/cosmos/cosmos-sdk/x/tokenfactory/module"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
@@ -128,18 +150,27 @@ var (
 		groupmodule.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 		nftmodule.AppModuleBasic{},
+		circuitmodule.AppModuleBasic{},
+		epochsmodule.AppModuleBasic{},
+		protocolpoolmodule.AppModuleBasic{},
+		accountsmodule.AppModuleBasic{},
+		tokenfactorymodule.AppModuleBasic{},
+		feemarketmodule.AppModuleBasic{},
 	)
 
 	// module account permissions
-	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		nft.ModuleName:                 nil,
-	}
+	modulePermissions = authtypes.NewModulePermissionsRegistry(
+		authtypes.NewModulePermissions(authtypes.FeeCollectorName),
+		authtypes.NewModulePermissions(distrtypes.ModuleName),
+		authtypes.NewModulePermissions(minttypes.ModuleName, authtypes.Minter),
+		authtypes.NewModulePermissions(stakingtypes.BondedPoolName, authtypes.Burner, authtypes.Staking),
+		authtypes.NewModulePermissions(stakingtypes.NotBondedPoolName, authtypes.Burner, authtypes.Staking),
+		authtypes.NewModulePermissions(govtypes.ModuleName, authtypes.Burner),
+		authtypes.NewModulePermissions(nft.ModuleName),
+		authtypes.NewModulePermissions(tokenfactory.ModuleName, authtypes.Minter, authtypes.Burner),
+		authtypes.NewModulePermissions(feemarket.ModuleName, authtypes.Burner),
+	)
+	maccPerms = modulePermissions.Map()
 )
 
 var (
@@ -166,22 +197,28 @@ type SimApp struct {
 	memKeys map[string]*storetypes.MemoryStoreKey
 
 	// keepers
-	AccountKeeper    authkeeper.AccountKeeper
-	BankKeeper       bankkeeper.Keeper
-	CapabilityKeeper *capabilitykeeper.Keeper
-	StakingKeeper    stakingkeeper.Keeper
-	SlashingKeeper   slashingkeeper.Keeper
-	MintKeeper       mintkeeper.Keeper
-	DistrKeeper      distrkeeper.Keeper
-	GovKeeper        govkeeper.Keeper
-	CrisisKeeper     crisiskeeper.Keeper
-	UpgradeKeeper    upgradekeeper.Keeper
-	ParamsKeeper     paramskeeper.Keeper
-	AuthzKeeper      authzkeeper.Keeper
-	EvidenceKeeper   evidencekeeper.Keeper
-	FeeGrantKeeper   feegrantkeeper.Keeper
-	GroupKeeper      groupkeeper.Keeper
-	NFTKeeper        nftkeeper.Keeper
+	AccountKeeper      authkeeper.AccountKeeper
+	BankKeeper         bankkeeper.Keeper
+	CapabilityKeeper   *capabilitykeeper.Keeper
+	StakingKeeper      stakingkeeper.Keeper
+	SlashingKeeper     slashingkeeper.Keeper
+	MintKeeper         mintkeeper.Keeper
+	DistrKeeper        distrkeeper.Keeper
+	GovKeeper          govkeeper.Keeper
+	CrisisKeeper       crisiskeeper.Keeper
+	UpgradeKeeper      upgradekeeper.Keeper
+	ParamsKeeper       paramskeeper.Keeper
+	AuthzKeeper        authzkeeper.Keeper
+	EvidenceKeeper     evidencekeeper.Keeper
+	FeeGrantKeeper     feegrantkeeper.Keeper
+	GroupKeeper        groupkeeper.Keeper
+	NFTKeeper          nftkeeper.Keeper
+	CircuitKeeper      circuitkeeper.Keeper
+	EpochsKeeper       epochskeeper.Keeper
+	ProtocolPoolKeeper protocolpoolkeeper.Keeper
+	AccountsKeeper     accountskeeper.Keeper
+	TokenFactoryKeeper tokenfactorykeeper.Keeper
+	FeeMarketKeeper    feemarketkeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -191,6 +228,9 @@ type SimApp struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// background invariant runner, only set when enabled through app options
+	invRunner *crisiskeeper.InvariantRunner
 }
 
 func init() {
@@ -208,6 +248,13 @@ func NewSimApp(
 	homePath string, invCheckPeriod uint, encodingConfig simappparams.EncodingConfig,
 	appOpts servertypes.AppOptions, baseAppOptions ...func(*baseapp.BaseApp),
 ) *SimApp {
+	// the modules wired below panic at runtime without their module account
+	if err := modulePermissions.Validate(
+		authtypes.FeeCollectorName, distrtypes.ModuleName, minttypes.ModuleName,
+		stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, govtypes.ModuleName,
+	); err != nil {
+		panic(err)
+	}
 
 	appCodec := encodingConfig.Codec
 	legacyAmino := encodingConfig.Amino
@@ -223,7 +270,9 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, capabilitytypes.StoreKey,
-		authzkeeper.StoreKey, nftkeeper.StoreKey, group.StoreKey,
+		authzkeeper.StoreKey, nftkeeper.StoreKey, group.StoreKey, circuit.StoreKey,
+		epochs.StoreKey, protocolpool.StoreKey, accounts.StoreKey, tokenfactory.StoreKey,
+		feemarket.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	// NOTE: The testingkey is just mounted for testing purposes. Actual applications should
@@ -277,8 +326,16 @@ func NewSimApp(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, authtypes.FeeCollectorName,
 	)
-	app.SlashingKeeper = slashingkeeper.NewKeeper(
+	// the dust accounts are reaped into the community pool
+	app.AccountKeeper.SetReapKeepers(app.BankKeeper, app.DistrKeeper)
+	slashingKeeper := slashingkeeper.NewKeeper(
 		appCodec, keys[slashingtypes.StoreKey], &stakingKeeper, app.GetSubspace(slashingtypes.ModuleName),
+		app.BankKeeper, authtypes.FeeCollectorName,
+	)
+	app.SlashingKeeper = *slashingKeeper.SetHooks(
+		slashingtypes.NewMultiSlashingHooks(
+		// register the slashing hooks
+		),
 	)
 	app.CrisisKeeper = crisiskeeper.NewKeeper(
 		app.GetSubspace(crisistypes.ModuleName), invCheckPeriod, app.BankKeeper, authtypes.FeeCollectorName,
@@ -324,7 +381,44 @@ func NewSimApp(
 	)
 	// set the governance module account as the authority for conducting upgrades
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp, authtypes.NewModuleAddress(govtypes.ModuleName).String())
-	app.NFTKeeper = nftkeeper.NewKeeper(keys[nftkeeper.StoreKey], appCodec, app.AccountKeeper, app.BankKeeper)
+	app.NFTKeeper = nftkeeper.NewKeeper(keys[nftkeeper.StoreKey], appCodec, app.AccountKeeper, app.BankKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+
+	// set the governance module account as the authority for granting circuit breaker permissions,
+	// and let the circuit breaker guard every message routed by the msg service router
+	app.CircuitKeeper = circuitkeeper.NewKeeper(keys[circuit.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	app.msgSvcRouter.SetCircuit(app.CircuitKeeper)
+
+	epochsKeeper := epochskeeper.NewKeeper(keys[epochs.StoreKey], appCodec)
+	// set the governance module account as the authority for managing continuous funds
+	app.ProtocolPoolKeeper = protocolpoolkeeper.NewKeeper(
+		keys[protocolpool.StoreKey], appCodec, app.DistrKeeper, epochsKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	app.EpochsKeeper = *epochsKeeper.SetHooks(
+		epochs.NewMultiEpochHooks(
+			// insert epoch hooks receivers here
+			app.ProtocolPoolKeeper.Hooks(),
+		),
+	)
+
+	// register the account implementations which can be instantiated through x/accounts
+	app.AccountsKeeper = accountskeeper.NewKeeper(
+		keys[accounts.StoreKey], appCodec, app.msgSvcRouter, app.AccountKeeper,
+		map[string]accounts.Account{
+			spendinglimit.AccountType: spendinglimit.NewAccount(app.BankKeeper),
+		},
+	)
+
+	// set the governance module account as the authority for updating the tokenfactory params
+	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
+		keys[tokenfactory.StoreKey], appCodec, app.BankKeeper, app.DistrKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// the base fees are burnt, set the governance module account as the authority for
+	// updating the feemarket params
+	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
+		keys[feemarket.StoreKey], appCodec, app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName, "",
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
@@ -363,6 +457,12 @@ func NewSimApp(
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		groupmodule.NewAppModule(appCodec, app.GroupKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		circuitmodule.NewAppModule(appCodec, app.CircuitKeeper),
+		epochsmodule.NewAppModule(appCodec, app.EpochsKeeper),
+		protocolpoolmodule.NewAppModule(appCodec, app.ProtocolPoolKeeper),
+		accountsmodule.NewAppModule(appCodec, app.AccountsKeeper),
+		tokenfactorymodule.NewAppModule(appCodec, app.TokenFactoryKeeper),
+		feemarketmodule.NewAppModule(appCodec, app.FeeMarketKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -370,12 +470,14 @@ func NewSimApp(
 	// CanWithdrawInvariant invariant.
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	// NOTE: capability module's beginblocker must come before any modules using capabilities (e.g. IBC)
+	// NOTE: epochs module's beginblocker must come before the modules subscribing to epoch hooks
 	app.mm.SetOrderBeginBlockers(
-		upgradetypes.ModuleName, capabilitytypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
+		upgradetypes.ModuleName, capabilitytypes.ModuleName, epochs.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
 		evidencetypes.ModuleName, stakingtypes.ModuleName,
 		authtypes.ModuleName, banktypes.ModuleName, govtypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName,
 		authz.ModuleName, feegrant.ModuleName, nft.ModuleName, group.ModuleName,
-		paramstypes.ModuleName, vestingtypes.ModuleName,
+		paramstypes.ModuleName, vestingtypes.ModuleName, circuit.ModuleName,
+		protocolpool.ModuleName, accounts.ModuleName, tokenfactory.ModuleName, feemarket.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName,
@@ -383,7 +485,9 @@ func NewSimApp(
 		slashingtypes.ModuleName, minttypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, nft.ModuleName, group.ModuleName,
-		paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
+		paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName, circuit.ModuleName,
+		epochs.ModuleName, protocolpool.ModuleName, accounts.ModuleName, tokenfactory.ModuleName,
+		feemarket.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -397,12 +501,20 @@ func NewSimApp(
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, nft.ModuleName, group.ModuleName,
-		paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
+		paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName, circuit.ModuleName,
+		epochs.ModuleName, protocolpool.ModuleName, accounts.ModuleName, tokenfactory.ModuleName,
+		feemarket.ModuleName,
 	)
 
 	// Uncomment if you want to set a custom migration order here.
 	// app.mm.SetOrderMigrations(custom order)
 
+	// check the orders above satisfy the ordering dependencies declared by the modules,
+	// app specific dependencies can be added with app.mm.AddOrderingDependencies
+	if err := app.mm.ValidateOrdering(); err != nil {
+		panic(err)
+	}
+
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.legacyRouter, app.QueryRouter(), encodingConfig.Amino)
 	app.configurator = module.NewConfigurator(app.appCodec, app.msgSvcRouter, app.GRPCQueryRouter())
@@ -443,18 +555,46 @@ func NewSimApp(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
-	app.setTxHandler(encodingConfig.TxConfig, cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents)))
+	app.setTxHandler(encodingConfig.TxConfig, cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents)), authmiddleware.MempoolLimits{
+		MaxTxsPerSender: cast.ToInt(appOpts.Get(server.FlagMempoolMaxTxsPerSender)),
+		TxTTL:           cast.ToUint64(appOpts.Get(server.FlagMempoolTxTTL)),
+	})
+
+	app.setupStoreUpgrades(cast.ToBool(appOpts.Get(server.FlagAutoStoreUpgrades)))
+
+	// optionally assert the crisis invariants in the background against the
+	// latest committed state instead of blocking EndBlock, the broken ones being
+	// queried with the /custom/crisis/broken_invariants path
+	if interval := cast.ToDuration(appOpts.Get(crisis.FlagBackgroundInvariantsInterval)); interval > 0 {
+		app.invRunner = crisiskeeper.NewInvariantRunner(
+			&app.CrisisKeeper,
+			func() (sdk.Context, error) { return app.CreateQueryContext(0, false) },
+			interval,
+			cast.ToDuration(appOpts.Get(crisis.FlagBackgroundInvariantsBudget)),
+			logger,
+		)
+		app.QueryRouter().AddRoute(crisistypes.QuerierRoute, app.invRunner.Querier())
+	}
 
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		// Rebuild the in-memory capabilities from the persistent state before any
+		// request is served, so that they are available on restart even to CheckTx
+		// and queries running before the first BeginBlock.
+		app.CapabilityKeeper.InitMemStore(app.BaseApp.NewUncachedContext(true, tmproto.Header{}))
+	}
+
+	if app.invRunner != nil {
+		app.invRunner.Start()
 	}
 
 	return app
 }
 
-func (app *SimApp) setTxHandler(txConfig client.TxConfig, indexEventsStr []string) {
+func (app *SimApp) setTxHandler(txConfig client.TxConfig, indexEventsStr []string, mempoolLimits authmiddleware.MempoolLimits) {
 	indexEvents := map[string]struct{}{}
 	for _, e := range indexEventsStr {
 		indexEvents[e] = struct{}{}
@@ -470,6 +610,8 @@ func (app *SimApp) setTxHandler(txConfig client.TxConfig, indexEventsStr []strin
 		SignModeHandler:  txConfig.SignModeHandler(),
 		SigGasConsumer:   authmiddleware.DefaultSigVerificationGasConsumer,
 		TxDecoder:        txConfig.TxDecoder(),
+		TxFeeChecker:     app.FeeMarketKeeper.CheckTxFee,
+		MempoolLimits:    mempoolLimits,
 	})
 	if err != nil {
 		panic(err)
@@ -478,6 +620,33 @@ func (app *SimApp) setTxHandler(txConfig client.TxConfig, indexEventsStr []strin
 	app.SetTxHandler(txHandler)
 }
 
+// setupStoreUpgrades plans the store upgrades of a pending upgrade from the
+// module version map diff between the chain and the app, and logs them. They
+// are applied to the store loader only if apply is set, otherwise the
+// operator is expected to set them in the upgrade handler.
+func (app *SimApp) setupStoreUpgrades(apply bool) {
+	upgradeInfo, storeUpgrades, err := app.UpgradeKeeper.PlanStoreUpgrades(app.mm.GetVersionMap(), map[string][]string{
+		authtypes.ModuleName: {authtypes.StoreKey},
+		accounts.ModuleName:  {accounts.StoreKey},
+	})
+	if err != nil {
+		tmos.Exit(fmt.Sprintf("failed to read upgrade info from disk: %s", err))
+	}
+
+	if len(storeUpgrades.Added) == 0 && len(storeUpgrades.Deleted) == 0 {
+		return
+	}
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	app.Logger().Info("planned store upgrades", "upgrade", upgradeInfo.Name, "height", upgradeInfo.Height,
+		"added", storeUpgrades.Added, "deleted", storeUpgrades.Deleted, "applied", apply)
+	if apply {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, storeUpgrades))
+	}
+}
+
 // Name returns the name of the App
 func (app *SimApp) Name() string { return app.BaseApp.Name() }
 
@@ -493,12 +662,12 @@ func (app *SimApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.Re
 
 // InitChainer application update at chain initialization
 func (app *SimApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
-	var genesisState GenesisState
-	if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
+	res, err := app.mm.InitGenesisFromReader(ctx, app.appCodec, bytes.NewReader(req.AppStateBytes))
+	if err != nil {
 		panic(err)
 	}
-	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
-	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+	return res
 }
 
 // LoadHeight loads a particular height
@@ -506,6 +675,15 @@ func (app *SimApp) LoadHeight(height int64) error {
 	return app.LoadVersion(height)
 }
 
+// Close stops the background invariant runner, if any, before closing the
+// BaseApp, so that it doesn't check the invariants against a closed database.
+func (app *SimApp) Close() error {
+	if app.invRunner != nil {
+		app.invRunner.Stop()
+	}
+	return app.BaseApp.Close()
+}
+
 // ModuleAccountAddrs returns all the app's module account addresses.
 func (app *SimApp) ModuleAccountAddrs() map[string]bool {
 	modAccAddrs := make(map[string]bool)
@@ -532,6 +710,13 @@ func (app *SimApp) AppCodec() codec.Codec {
 	return app.appCodec
 }
 
+// MsgServiceRouter returns SimApp's MsgServiceRouter
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *SimApp) MsgServiceRouter() *authmiddleware.MsgServiceRouter {
+	return app.msgSvcRouter
+}
+
 // InterfaceRegistry returns SimApp's InterfaceRegistry
 func (app *SimApp) InterfaceRegistry() types.InterfaceRegistry {
 	return app.interfaceRegistry
@@ -579,6 +764,8 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	authtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register new tendermint queries routes from grpc-gateway.
 	tmservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// Register the module params query route from grpc-gateway.
+	moduleparams.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
 	// Register grpc-gateway routes for all modules.
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
@@ -2,7 +2,9 @@ package simapp
 
 import (
 	"encoding/json"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -12,9 +14,14 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	accountsmodule "github.com/cosmos/cosmos-sdk/x/accounts/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
@@ -24,15 +31,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/capability"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	epochsmodule "github.com/cosmos/cosmos-sdk/x/epochs/module"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	feemarketmodule "github.com/cosmos/cosmos-sdk/x/feemarket/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	group "github.com/cosmos/cosmos-sdk/x/group/module"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	protocolpoolmodule "github.com/cosmos/cosmos-sdk/x/protocolpool/module"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	tokenfactorymodule "github.com/cosmos/cosmos-sdk/x/tokenfactory/module"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
@@ -67,6 +79,124 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+func TestSimAppExportForTestnet(t *testing.T) {
+	encCfg := MakeTestEncodingConfig()
+	db := dbm.NewMemDB()
+	app := NewSimappWithCustomOptions(t, false, SetupOptions{
+		Logger:             log.NewNopLogger(),
+		DB:                 db,
+		InvCheckPeriod:     0,
+		EncConfig:          encCfg,
+		HomePath:           DefaultNodeHome,
+		SkipUpgradeHeights: map[int64]bool{},
+		AppOpts:            EmptyAppOptions{},
+	})
+	app.Commit()
+
+	valPubKey := ed25519.GenPrivKey().PubKey()
+	operatorAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	app2 := NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg, EmptyAppOptions{})
+	exported, err := app2.ExportAppStateAndValidatorsForTestnet(servertypes.InPlaceTestnetConfig{
+		ValidatorPubKey:      valPubKey,
+		OperatorAddress:      operatorAddr,
+		VotingPeriod:         time.Minute,
+		DowntimeJailDuration: 2 * time.Minute,
+	})
+	require.NoError(t, err)
+
+	// the testnet is validated by the new validator alone
+	require.Len(t, exported.Validators, 1)
+	require.Equal(t, valPubKey.Bytes(), exported.Validators[0].PubKey.Bytes())
+
+	// the testnet starts from the exported state
+	app3 := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg, EmptyAppOptions{})
+	res := app3.InitChain(abci.RequestInitChain{
+		ChainId:         "testnet",
+		ConsensusParams: exported.ConsensusParams,
+		AppStateBytes:   exported.AppState,
+		InitialHeight:   exported.Height,
+	})
+	require.Len(t, res.Validators, 1)
+	app3.Commit()
+
+	ctx := app3.NewUncachedContext(false, tmproto.Header{Height: exported.Height})
+	require.Equal(t, time.Minute, *app3.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+	require.Equal(t, time.Minute, *app3.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod)
+	require.Equal(t, 2*time.Minute, app3.SlashingKeeper.GetParams(ctx).DowntimeJailDuration)
+
+	val, found := app3.StakingKeeper.GetValidator(ctx, sdk.ValAddress(operatorAddr))
+	require.True(t, found)
+	require.True(t, val.IsBonded())
+	for _, val := range app3.StakingKeeper.GetAllValidators(ctx) {
+		if val.GetOperator().Equals(sdk.ValAddress(operatorAddr)) {
+			continue
+		}
+		require.True(t, val.IsJailed())
+	}
+}
+
+func TestSimAppWithoutLegacyAmino(t *testing.T) {
+	encCfg := MakeEncodingConfigWithoutLegacyAmino()
+	require.NotContains(t, encCfg.TxConfig.SignModeHandler().Modes(), signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+
+	app := NewSimappWithCustomOptions(t, false, SetupOptions{
+		Logger:             log.NewNopLogger(),
+		DB:                 dbm.NewMemDB(),
+		InvCheckPeriod:     0,
+		EncConfig:          encCfg,
+		HomePath:           DefaultNodeHome,
+		SkipUpgradeHeights: map[int64]bool{},
+		AppOpts:            EmptyAppOptions{},
+	})
+	app.Commit()
+
+	_, err := app.ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+}
+
+// mapAppOptions is an AppOptions backed by a map.
+type mapAppOptions map[string]interface{}
+
+func (o mapAppOptions) Get(key string) interface{} { return o[key] }
+
+// invariantRunsLogger counts the rounds of the background invariant runner.
+type invariantRunsLogger struct {
+	log.Logger
+	runs *int64
+}
+
+func (l invariantRunsLogger) Info(msg string, keyvals ...interface{}) {
+	if msg == "checked invariants in the background" {
+		atomic.AddInt64(l.runs, 1)
+	}
+}
+
+func (l invariantRunsLogger) With(keyvals ...interface{}) log.Logger { return l }
+
+func TestSimAppCloseStopsInvariantRunner(t *testing.T) {
+	var runs int64
+	logger := invariantRunsLogger{Logger: log.NewNopLogger(), runs: &runs}
+	app := NewSimappWithCustomOptions(t, false, SetupOptions{
+		Logger:             logger,
+		DB:                 dbm.NewMemDB(),
+		InvCheckPeriod:     0,
+		EncConfig:          MakeTestEncodingConfig(),
+		HomePath:           DefaultNodeHome,
+		SkipUpgradeHeights: map[int64]bool{},
+		AppOpts:            mapAppOptions{crisis.FlagBackgroundInvariantsInterval: time.Millisecond},
+	})
+	// the invariants are checked once the genesis block is committed
+	time.Sleep(20 * time.Millisecond)
+	require.Zero(t, atomic.LoadInt64(&runs))
+	app.Commit()
+	require.Eventually(t, func() bool { return atomic.LoadInt64(&runs) > 0 }, 10*time.Second, time.Millisecond)
+
+	require.NoError(t, app.Close())
+	closedRuns := atomic.LoadInt64(&runs)
+	time.Sleep(20 * time.Millisecond)
+	require.Equal(t, closedRuns, atomic.LoadInt64(&runs))
+}
+
 func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
@@ -189,6 +319,11 @@ func TestRunMigrations(t *testing.T) {
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
 					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"epochs":       epochsmodule.AppModule{}.ConsensusVersion(),
+					"protocolpool": protocolpoolmodule.AppModule{}.ConsensusVersion(),
+					"accounts":     accountsmodule.AppModule{}.ConsensusVersion(),
+					"tokenfactory": tokenfactorymodule.AppModule{}.ConsensusVersion(),
+					"feemarket":    feemarketmodule.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -213,7 +348,7 @@ func TestInitGenesisOnMigration(t *testing.T) {
 	// adding during a migration.
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
-	mockModule := mocks.NewMockAppModule(mockCtrl)
+	mockModule := mocks.NewMockAppModuleWithAllExtensions(mockCtrl)
 	mockDefaultGenesis := json.RawMessage(`{"key": "value"}`)
 	mockModule.EXPECT().DefaultGenesis(gomock.Eq(app.appCodec)).Times(1).Return(mockDefaultGenesis)
 	mockModule.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(app.appCodec), gomock.Eq(mockDefaultGenesis)).Times(1).Return(nil)
@@ -241,11 +376,66 @@ func TestInitGenesisOnMigration(t *testing.T) {
 			"crisis":       crisis.AppModule{}.ConsensusVersion(),
 			"genutil":      genutil.AppModule{}.ConsensusVersion(),
 			"capability":   capability.AppModule{}.ConsensusVersion(),
+			"epochs":       epochsmodule.AppModule{}.ConsensusVersion(),
+			"protocolpool": protocolpoolmodule.AppModule{}.ConsensusVersion(),
+			"accounts":     accountsmodule.AppModule{}.ConsensusVersion(),
+			"tokenfactory": tokenfactorymodule.AppModule{}.ConsensusVersion(),
+			"feemarket":    feemarketmodule.AppModule{}.ConsensusVersion(),
 		},
 	)
 	require.NoError(t, err)
 }
 
+func TestDryRunMigrations(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// clear the epochs state, so that running the epochs InitGenesis grows its store
+	for _, info := range app.EpochsKeeper.AllEpochInfos(ctx) {
+		app.EpochsKeeper.DeleteEpochInfo(ctx, info.Identifier)
+	}
+
+	// "mock" migrates from version 1 to 2 and epochs is added as a new module
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+	mockModule := mocks.NewMockAppModule(mockCtrl)
+	mockModule.EXPECT().Name().AnyTimes().Return("mock")
+	mockModule.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(2))
+	app.mm.Modules["mock"] = mockModule
+	called := 0
+	require.NoError(t, app.configurator.RegisterMigration("mock", 1, func(sdk.Context) error {
+		called++
+		return nil
+	}))
+
+	fromVM := app.mm.GetVersionMap()
+	fromVM["mock"] = 1
+	delete(fromVM, epochs.ModuleName)
+
+	report, err := app.mm.DryRunMigrations(ctx, app.configurator, fromVM, app.GetKey(epochs.StoreKey))
+	require.NoError(t, err)
+	require.Equal(t, 1, called)
+
+	require.Equal(t, []module.ModuleMigration{
+		{Module: epochs.ModuleName, ToVersion: epochsmodule.AppModule{}.ConsensusVersion(), InitGenesis: true},
+		{Module: "mock", FromVersion: 1, ToVersion: 2},
+	}, report.Migrations)
+	require.Equal(t, app.mm.GetVersionMap(), report.VersionMap)
+
+	require.Len(t, report.StoreSizeDeltas, 1)
+	require.Equal(t, epochs.StoreKey, report.StoreSizeDeltas[0].StoreKey)
+	require.Zero(t, report.StoreSizeDeltas[0].SizeBefore)
+	require.Positive(t, report.StoreSizeDeltas[0].Delta())
+
+	// nothing is committed
+	require.Empty(t, app.EpochsKeeper.AllEpochInfos(ctx))
+
+	// failing migrations are reported
+	fromVM["mock"] = 0
+	_, err = app.mm.DryRunMigrations(ctx, app.configurator, fromVM)
+	require.Error(t, err)
+}
+
 func TestUpgradeStateOnGenesis(t *testing.T) {
 	encCfg := MakeTestEncodingConfig()
 	db := dbm.NewMemDB()
@@ -28,6 +28,7 @@ var (
 	FlagVerboseValue     bool
 	FlagPeriodValue      uint
 	FlagGenesisTimeValue int64
+	FlagShrinkRunsValue  int
 )
 
 // GetSimulatorFlags gets the values of all the available simulation flags
@@ -54,6 +55,7 @@ func GetSimulatorFlags() {
 	flag.BoolVar(&FlagVerboseValue, "Verbose", false, "verbose log output")
 	flag.UintVar(&FlagPeriodValue, "Period", 0, "run slow invariants only once every period assertions")
 	flag.Int64Var(&FlagGenesisTimeValue, "GenesisTime", 0, "override genesis UNIX time instead of using a random UNIX time")
+	flag.IntVar(&FlagShrinkRunsValue, "ShrinkRuns", 200, "maximum number of simulations run to shrink the operations of a failing simulation")
 }
 
 // NewConfigFromFlags creates a simulation from the retrieved values of the flags.
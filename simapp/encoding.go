@@ -1,8 +1,11 @@
 package simapp
 
 import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	"github.com/cosmos/cosmos-sdk/std"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 )
 
 // MakeTestEncodingConfig creates an EncodingConfig for testing. This function
@@ -17,3 +20,23 @@ func MakeTestEncodingConfig() simappparams.EncodingConfig {
 	ModuleBasics.RegisterInterfaces(encodingConfig.InterfaceRegistry)
 	return encodingConfig
 }
+
+// MakeEncodingConfigWithoutLegacyAmino creates an EncodingConfig for an app
+// which never needs the legacy amino JSON signing: its TxConfig does not enable
+// SIGN_MODE_LEGACY_AMINO_JSON, so that the amino signed txs are rejected, and
+// no type is registered with its legacy amino codec.
+func MakeEncodingConfigWithoutLegacyAmino() simappparams.EncodingConfig {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	ModuleBasics.RegisterInterfaces(interfaceRegistry)
+	protoCodec := codec.NewProtoCodec(interfaceRegistry)
+
+	return simappparams.EncodingConfig{
+		InterfaceRegistry: interfaceRegistry,
+		Codec:             protoCodec,
+		TxConfig:          authtx.NewTxConfig(protoCodec, authtx.DefaultSignModesWithoutLegacyAmino),
+		// the legacy amino codec is still needed by the params subspaces,
+		// which do not need the registrations
+		Amino: codec.NewLegacyAmino(),
+	}
+}
@@ -1,12 +1,15 @@
 package simapp
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -19,32 +22,76 @@ import (
 func (app *SimApp) ExportAppStateAndValidators(
 	forZeroHeight bool, jailAllowedAddrs []string,
 ) (servertypes.ExportedApp, error) {
-	// as if they could withdraw from the start of the next block
-	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	ctx, height := app.exportContext(forZeroHeight, jailAllowedAddrs)
+	return app.exportAppState(ctx, height)
+}
 
-	// We export at last height + 1, because that's the height at which
-	// Tendermint will start InitChain.
-	height := app.LastBlockHeight() + 1
-	if forZeroHeight {
-		height = 0
-		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+// exportAppState exports the state of the application at the given context for a
+// genesis file at the given height.
+func (app *SimApp) exportAppState(ctx sdk.Context, height int64) (servertypes.ExportedApp, error) {
+	genState := app.mm.ExportGenesis(ctx, app.appCodec)
+	bz, err := json.Marshal(genState)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
 	}
 
-	genState := app.mm.ExportGenesis(ctx, app.appCodec)
-	appState, err := json.MarshalIndent(genState, "", "  ")
+	// the keys of the maps and the numbers of the module states are not
+	// necessarily encoded the same way by all the modules
+	bz, err = codec.CanonicalizeJSON(bz)
 	if err != nil {
 		return servertypes.ExportedApp{}, err
 	}
 
+	var appState bytes.Buffer
+	if err := json.Indent(&appState, bz, "", "  "); err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
 	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
 	return servertypes.ExportedApp{
-		AppState:        appState,
+		AppState:        appState.Bytes(),
 		Validators:      validators,
 		Height:          height,
 		ConsensusParams: app.BaseApp.GetConsensusParams(ctx),
 	}, err
 }
 
+// StreamAppStateAndValidators exports the state of the application for a genesis
+// file like ExportAppStateAndValidators, but the app state is streamed by the
+// WriteAppState function of the returned ExportedApp instead of held in memory.
+func (app *SimApp) StreamAppStateAndValidators(
+	forZeroHeight bool, jailAllowedAddrs []string,
+) (servertypes.ExportedApp, error) {
+	ctx, height := app.exportContext(forZeroHeight, jailAllowedAddrs)
+
+	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
+	return servertypes.ExportedApp{
+		WriteAppState: func(w io.Writer) error {
+			return app.mm.ExportGenesisToWriter(ctx, app.appCodec, w)
+		},
+		Validators:      validators,
+		Height:          height,
+		ConsensusParams: app.BaseApp.GetConsensusParams(ctx),
+	}, err
+}
+
+// exportContext returns the context to export the state of the application from, and
+// the height of the exported genesis.
+func (app *SimApp) exportContext(forZeroHeight bool, jailAllowedAddrs []string) (sdk.Context, int64) {
+	// as if they could withdraw from the start of the next block
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	// We export at last height + 1, because that's the height at which
+	// Tendermint will start InitChain.
+	height := app.LastBlockHeight() + 1
+	if forZeroHeight {
+		height = 0
+		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+	}
+
+	return ctx, height
+}
+
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 //      in favour of export at a block height
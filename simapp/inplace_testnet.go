@@ -0,0 +1,75 @@
+package simapp
+
+import (
+	"fmt"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ExportAppStateAndValidatorsForTestnet exports the state of the application for the
+// genesis of an in-place testnet: the validators are jailed and replaced by a single
+// validator with the given consensus key, operated by the given account, and the
+// governance and slashing parameters are adjusted for fast testing.
+func (app *SimApp) ExportAppStateAndValidatorsForTestnet(cfg servertypes.InPlaceTestnetConfig) (servertypes.ExportedApp, error) {
+	ctx, height := app.exportContext(false, nil)
+
+	// the new validator gets as much power as the previous validator set
+	selfDelegation := app.StakingKeeper.TotalBondedTokens(ctx)
+	if !selfDelegation.IsPositive() {
+		selfDelegation = sdk.DefaultPowerReduction
+	}
+
+	for _, val := range app.StakingKeeper.GetLastValidators(ctx) {
+		if val.IsJailed() {
+			continue
+		}
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+		app.StakingKeeper.Jail(ctx, consAddr)
+	}
+
+	coin := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), selfDelegation)
+	if err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	if err := app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, cfg.OperatorAddress, sdk.NewCoins(coin)); err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	commissionRate := app.StakingKeeper.MinCommissionRate(ctx)
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(cfg.OperatorAddress), cfg.ValidatorPubKey, coin,
+		stakingtypes.NewDescription("testnet", "", "", "", ""),
+		stakingtypes.NewCommissionRates(commissionRate, sdk.OneDec(), sdk.OneDec()),
+		sdk.OneInt(),
+	)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	if _, err := stakingkeeper.NewMsgServerImpl(app.StakingKeeper).CreateValidator(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return servertypes.ExportedApp{}, fmt.Errorf("failed to create the testnet validator: %w", err)
+	}
+	if _, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx); err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.VotingPeriod = &cfg.VotingPeriod
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.MaxDepositPeriod = &cfg.VotingPeriod
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+	slashingParams := app.SlashingKeeper.GetParams(ctx)
+	slashingParams.DowntimeJailDuration = cfg.DowntimeJailDuration
+	app.SlashingKeeper.SetParams(ctx, slashingParams)
+
+	return app.exportAppState(ctx, height)
+}
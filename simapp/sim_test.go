@@ -5,33 +5,22 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
-	"runtime/debug"
 	"strings"
 	"testing"
 
-	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
-	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	"github.com/cosmos/cosmos-sdk/store"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
-	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
-	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
-	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
-	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
-	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
-	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation/importexport"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -40,12 +29,6 @@ func init() {
 	GetSimulatorFlags()
 }
 
-type StoreKeysPrefixes struct {
-	A        storetypes.StoreKey
-	B        storetypes.StoreKey
-	Prefixes [][]byte
-}
-
 // fauxMerkleModeOpt returns a BaseApp option to use a dbStoreAdapter instead of
 // an IAVLStore for faster simulation speed.
 func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
@@ -96,10 +79,13 @@ func TestFullAppSimulation(t *testing.T) {
 	}
 }
 
-func TestAppImportExport(t *testing.T) {
-	config, db, dir, logger, skip, err := SetupSimulation("leveldb-app-sim", "Simulation")
+// TestAppSimulationShrink runs the simulation of the flags, checking the
+// invariants as its post-condition, and shrinks its operations to a minimal
+// sequence reproducing its failure if it fails.
+func TestAppSimulationShrink(t *testing.T) {
+	config, db, dir, _, skip, err := SetupSimulation("leveldb-app-sim", "Simulation")
 	if skip {
-		t.Skip("skipping application import/export simulation")
+		t.Skip("skipping application simulation shrinking")
 	}
 	require.NoError(t, err, "simulation setup failed")
 
@@ -108,99 +94,91 @@ func TestAppImportExport(t *testing.T) {
 		require.NoError(t, os.RemoveAll(dir))
 	}()
 
-	app := NewSimApp(logger, db, nil, true, map[int64]bool{}, DefaultNodeHome, FlagPeriodValue, MakeTestEncodingConfig(), EmptyAppOptions{}, fauxMerkleModeOpt)
-	require.Equal(t, "SimApp", app.Name())
-
-	// Run randomized simulation
-	_, simParams, simErr := simulation.SimulateFromSeed(
-		t,
-		os.Stdout,
-		app.BaseApp,
-		AppStateFn(app.AppCodec(), app.SimulationManager()),
-		simtypes.RandomAccounts, // Replace with own random account function if using keys other than secp256k1
-		SimulationOperations(app, app.AppCodec(), config),
-		app.ModuleAccountAddrs(),
-		config,
-		app.AppCodec(),
-	)
-
-	// export state and simParams before the simulation error is checked
-	err = CheckExportSimulation(app, config, simParams)
-	require.NoError(t, err)
-	require.NoError(t, simErr)
-
-	if config.Commit {
-		PrintStats(db)
+	newApp := func() simulation.ShrinkApp {
+		// the invariants are checked as the post-condition of the simulation
+		app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeTestEncodingConfig(), EmptyAppOptions{}, fauxMerkleModeOpt)
+		return simulation.ShrinkApp{
+			App:          app.BaseApp,
+			AppStateFn:   AppStateFn(app.AppCodec(), app.SimulationManager()),
+			RandAccFn:    simtypes.RandomAccounts,
+			Ops:          SimulationOperations(app, app.AppCodec(), config),
+			BlockedAddrs: app.ModuleAccountAddrs(),
+			Cdc:          app.AppCodec(),
+			Invariants:   app.CrisisKeeper.Routes(),
+		}
 	}
 
-	fmt.Printf("exporting genesis...\n")
-
-	exported, err := app.ExportAppStateAndValidators(false, []string{})
-	require.NoError(t, err)
-
-	fmt.Printf("importing genesis...\n")
+	if repro := simulation.ShrinkFailure(t, os.Stdout, newApp, config, FlagShrinkRunsValue); repro != nil {
+		t.Fatal(repro)
+	}
+}
 
-	_, newDB, newDir, _, _, err := SetupSimulation("leveldb-app-sim-2", "Simulation-2")
+func TestAppImportExport(t *testing.T) {
+	config, db, dir, logger, skip, err := SetupSimulation("leveldb-app-sim", "Simulation")
+	if skip {
+		t.Skip("skipping application import/export simulation")
+	}
 	require.NoError(t, err, "simulation setup failed")
 
 	defer func() {
-		require.NoError(t, newDB.Close())
-		require.NoError(t, os.RemoveAll(newDir))
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
 	}()
 
-	newApp := NewSimApp(log.NewNopLogger(), newDB, nil, true, map[int64]bool{}, DefaultNodeHome, FlagPeriodValue, MakeTestEncodingConfig(), EmptyAppOptions{}, fauxMerkleModeOpt)
-	require.Equal(t, "SimApp", newApp.Name())
-
-	var genesisState GenesisState
-	err = json.Unmarshal(exported.AppState, &genesisState)
-	require.NoError(t, err)
-
-	defer func() {
-		if r := recover(); r != nil {
-			err := fmt.Sprintf("%v", r)
-			if !strings.Contains(err, "validator set is empty after InitGenesis") {
-				panic(r)
+	result, err := importexport.Run(t, config, importexport.Options{
+		NewApp: func(logger log.Logger, db dbm.DB) (importexport.App, *baseapp.BaseApp) {
+			app := NewSimApp(logger, db, nil, true, map[int64]bool{}, DefaultNodeHome, FlagPeriodValue, MakeTestEncodingConfig(), EmptyAppOptions{}, fauxMerkleModeOpt)
+			require.Equal(t, "SimApp", app.Name())
+			return app, app.BaseApp
+		},
+		AppStateFn: func(app importexport.App) simtypes.AppStateFn {
+			return AppStateFn(app.AppCodec(), app.SimulationManager())
+		},
+		Operations: func(app importexport.App, config simtypes.Config) []simtypes.WeightedOperation {
+			return SimulationOperations(app.(*SimApp), app.AppCodec(), config)
+		},
+		NewDB: func(name string) (dbm.DB, error) {
+			if name == "simulated" {
+				return db, nil
 			}
-			logger.Info("Skipping simulation as all validators have been unbonded")
-			logger.Info("err", err, "stacktrace", string(debug.Stack()))
-		}
-	}()
-
-	ctxA := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
-	ctxB := newApp.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
-	newApp.mm.InitGenesis(ctxB, app.AppCodec(), genesisState)
-	newApp.StoreConsensusParams(ctxB, exported.ConsensusParams)
 
-	fmt.Printf("comparing stores...\n")
-
-	storeKeysPrefixes := []StoreKeysPrefixes{
-		{app.keys[authtypes.StoreKey], newApp.keys[authtypes.StoreKey], [][]byte{}},
-		{app.keys[stakingtypes.StoreKey], newApp.keys[stakingtypes.StoreKey],
-			[][]byte{
+			_, newDB, newDir, _, _, err := SetupSimulation("leveldb-app-sim-2", "Simulation-2")
+			if err != nil {
+				return nil, err
+			}
+			t.Cleanup(func() {
+				require.NoError(t, newDB.Close())
+				require.NoError(t, os.RemoveAll(newDir))
+			})
+			return newDB, nil
+		},
+		Logger: logger,
+		Output: os.Stdout,
+		SkippedPrefixes: map[string][][]byte{
+			// ordering may change but it doesn't matter
+			stakingtypes.StoreKey: {
 				stakingtypes.UnbondingQueueKey, stakingtypes.RedelegationQueueKey, stakingtypes.ValidatorQueueKey,
 				stakingtypes.HistoricalInfoKey,
-			}}, // ordering may change but it doesn't matter
-		{app.keys[slashingtypes.StoreKey], newApp.keys[slashingtypes.StoreKey], [][]byte{}},
-		{app.keys[minttypes.StoreKey], newApp.keys[minttypes.StoreKey], [][]byte{}},
-		{app.keys[distrtypes.StoreKey], newApp.keys[distrtypes.StoreKey], [][]byte{}},
-		{app.keys[banktypes.StoreKey], newApp.keys[banktypes.StoreKey], [][]byte{banktypes.BalancesPrefix}},
-		{app.keys[paramtypes.StoreKey], newApp.keys[paramtypes.StoreKey], [][]byte{}},
-		{app.keys[govtypes.StoreKey], newApp.keys[govtypes.StoreKey], [][]byte{}},
-		{app.keys[evidencetypes.StoreKey], newApp.keys[evidencetypes.StoreKey], [][]byte{}},
-		{app.keys[capabilitytypes.StoreKey], newApp.keys[capabilitytypes.StoreKey], [][]byte{}},
-		{app.keys[authzkeeper.StoreKey], newApp.keys[authzkeeper.StoreKey], [][]byte{authzkeeper.GrantKey, authzkeeper.GrantQueuePrefix}},
-	}
-
-	for _, skp := range storeKeysPrefixes {
-		storeA := ctxA.KVStore(skp.A)
-		storeB := ctxB.KVStore(skp.B)
+			},
+			banktypes.StoreKey:   {banktypes.BalancesPrefix},
+			authzkeeper.StoreKey: {authzkeeper.GrantKey, authzkeeper.GrantQueuePrefix},
+		},
+	})
 
-		failedKVAs, failedKVBs := sdk.DiffKVStores(storeA, storeB, skp.Prefixes)
-		require.Equal(t, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare")
+	// export state and simParams before the simulation error is checked
+	if result.App != nil {
+		require.NoError(t, CheckExportSimulation(result.App.(*SimApp), config, result.SimParams))
+	}
+	if config.Commit {
+		PrintStats(db)
+	}
+	require.NoError(t, err)
 
-		fmt.Printf("compared %d different key/value pairs between %s and %s\n", len(failedKVAs), skp.A, skp.B)
-		require.Equal(t, 0, len(failedKVAs), GetSimulationLog(skp.A.Name(), app.SimulationManager().StoreDecoders, failedKVAs, failedKVBs))
+	if result.Skipped {
+		logger.Info("Skipping simulation as all validators have been unbonded")
+		return
 	}
+	fmt.Printf("compared stores %s\n", strings.Join(result.ComparedStores, ", "))
 }
 
 func TestAppSimulationAfterImport(t *testing.T) {
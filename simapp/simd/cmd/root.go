@@ -96,24 +96,53 @@ func initTendermintConfig() *tmcfg.Config {
 	return cfg
 }
 
+// WASMConfig defines configuration for the wasm module.
+type WASMConfig struct {
+	// This is the maximum sdk gas (wasm and storage) that we allow for any x/wasm "smart" queries
+	QueryGasLimit uint64 `mapstructure:"query_gas_limit"`
+
+	// This is the number of wasm vm instances we keep cached in memory for speed-up
+	LruSize uint64 `mapstructure:"lru_size"`
+}
+
+// ValidateBasic implements the serverconfig.SectionConfig interface.
+func (c WASMConfig) ValidateBasic() error {
+	if c.QueryGasLimit == 0 {
+		return errors.New("query_gas_limit must be positive")
+	}
+	return nil
+}
+
+func init() {
+	// The following code snippet is just for reference.
+
+	// Modules register the section of their config in app.toml, with its
+	// template and default values. The section is validated when the node
+	// starts, and read with serverconfig.ReadSection in the app constructor.
+	serverconfig.RegisterSection(serverconfig.Section{
+		Name: "wasm",
+		Template: `# This is the maximum sdk gas (wasm and storage) that we allow for any x/wasm "smart" queries
+query_gas_limit = {{ .QueryGasLimit }}
+# This is the number of wasm vm instances we keep cached in memory for speed-up
+# Warning: this is currently unstable and may lead to crashes, best to keep for 0 unless testing locally
+lru_size = {{ .LruSize }}
+`,
+		Default: WASMConfig{
+			LruSize:       0,
+			QueryGasLimit: 300000,
+		},
+	})
+}
+
 // initAppConfig helps to override default appConfig template and configs.
 // return "", nil if no custom configuration is required for the application.
 func initAppConfig() (string, interface{}) {
 	// The following code snippet is just for reference.
 
-	// WASMConfig defines configuration for the wasm module.
-	type WASMConfig struct {
-		// This is the maximum sdk gas (wasm and storage) that we allow for any x/wasm "smart" queries
-		QueryGasLimit uint64 `mapstructure:"query_gas_limit"`
-
-		// Address defines the gRPC-web server to listen on
-		LruSize uint64 `mapstructure:"lru_size"`
-	}
-
+	// CustomAppConfig extends the server config with the settings of the app,
+	// the settings of the modules belonging to the sections they register.
 	type CustomAppConfig struct {
 		serverconfig.Config
-
-		WASM WASMConfig `mapstructure:"wasm"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -135,19 +164,9 @@ func initAppConfig() (string, interface{}) {
 
 	customAppConfig := CustomAppConfig{
 		Config: *srvCfg,
-		WASM: WASMConfig{
-			LruSize:       1,
-			QueryGasLimit: 300000,
-		},
 	}
 
-	customAppTemplate := serverconfig.DefaultConfigTemplate + `
-[wasm]
-# This is the maximum sdk gas (wasm and storage) that we allow for any x/wasm "smart" queries
-query_gas_limit = 300000
-# This is the number of wasm vm instances we keep cached in memory for speed-up
-# Warning: this is currently unstable and may lead to crashes, best to keep for 0 unless testing locally
-lru_size = 0`
+	customAppTemplate := serverconfig.DefaultConfigTemplate
 
 	return customAppTemplate, customAppConfig
 }
@@ -166,11 +185,12 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		tmcli.NewCompletionCmd(rootCmd, true),
 		NewTestnetCmd(simapp.ModuleBasics, banktypes.GenesisBalancesIterator{}),
 		debug.Cmd(),
-		config.Cmd(),
+		configCmd(),
 	)
 
 	a := appCreator{encodingConfig}
 	server.AddCommands(rootCmd, simapp.DefaultNodeHome, a.newApp, a.appExport, addModuleInitFlags)
+	rootCmd.AddCommand(server.InPlaceTestnetCmd(a.appTestnetExport, simapp.DefaultNodeHome))
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
@@ -184,6 +204,14 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	rootCmd.AddCommand(server.RosettaCommand(encodingConfig.InterfaceRegistry, encodingConfig.Codec))
 }
 
+// configCmd returns the command managing the client config, and migrating the
+// app config with its migrate subcommand.
+func configCmd() *cobra.Command {
+	cmd := config.Cmd()
+	cmd.AddCommand(server.MigrateAppConfigCmd(initAppConfig()))
+	return cmd
+}
+
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 }
@@ -261,14 +289,18 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		panic(err)
 	}
 
-	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
-	snapshotDB, err := dbm.NewDB("metadata", server.GetAppDBBackend(appOpts), snapshotDir)
-	if err != nil {
-		panic(err)
-	}
-	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
-	if err != nil {
-		panic(err)
+	// A query node opens the data directory read-only and takes no snapshots.
+	var snapshotStore *snapshots.Store
+	if !cast.ToBool(appOpts.Get(server.FlagQueryNode)) {
+		snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
+		snapshotDB, err := dbm.NewDB("metadata", server.GetAppDBBackend(appOpts), snapshotDir)
+		if err != nil {
+			panic(err)
+		}
+		snapshotStore, err = snapshots.NewStore(snapshotDB, snapshotDir)
+		if err != nil {
+			panic(err)
+		}
 	}
 
 	snapshotOptions := snapshottypes.NewSnapshotOptions(
@@ -276,6 +308,11 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent)),
 	)
 
+	snapshotTransport, err := server.GetSnapshotTransport(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
 	return simapp.NewSimApp(
 		logger, db, traceStore, true, skipUpgradeHeights,
 		cast.ToString(appOpts.Get(flags.FlagHome)),
@@ -287,10 +324,20 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(server.FlagHaltHeight))),
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(server.FlagHaltTime))),
 		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks))),
+		baseapp.SetAsyncPruning(cast.ToInt(appOpts.Get(server.FlagPruningAsyncQueue))),
 		baseapp.SetInterBlockCache(cache),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
+		baseapp.SetEventAggregation(cast.ToInt(appOpts.Get(server.FlagEventAggregation))),
+		baseapp.SetResultLimits(baseapp.ResultLimits{
+			MaxTxEventBytes:    cast.ToInt(appOpts.Get(server.FlagMaxTxEventBytes)),
+			MaxTxLogBytes:      cast.ToInt(appOpts.Get(server.FlagMaxTxLogBytes)),
+			MaxBlockEventBytes: cast.ToInt(appOpts.Get(server.FlagMaxBlockEventBytes)),
+			MaxBlockLogBytes:   cast.ToInt(appOpts.Get(server.FlagMaxBlockLogBytes)),
+		}),
+		baseapp.SetQueryCache(cast.ToInt(appOpts.Get(server.FlagQueryCacheSize)), cast.ToStringSlice(appOpts.Get(server.FlagQueryCacheRoutes))),
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
+		baseapp.SetSnapshotTransport(snapshotTransport),
 	)
 }
 
@@ -316,5 +363,23 @@ func (a appCreator) appExport(
 		simApp = simapp.NewSimApp(logger, db, traceStore, true, map[int64]bool{}, homePath, uint(1), a.encCfg, appOpts)
 	}
 
+	if cast.ToBool(appOpts.Get(server.FlagStream)) {
+		return simApp.StreamAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
+	}
 	return simApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
 }
+
+// appTestnetExport creates a new simapp at the latest height and exports its state
+// for an in-place testnet.
+func (a appCreator) appTestnetExport(
+	logger log.Logger, db dbm.DB, traceStore io.Writer, cfg servertypes.InPlaceTestnetConfig,
+	appOpts servertypes.AppOptions) (servertypes.ExportedApp, error) {
+
+	homePath, ok := appOpts.Get(flags.FlagHome).(string)
+	if !ok || homePath == "" {
+		return servertypes.ExportedApp{}, errors.New("application home not set")
+	}
+
+	simApp := simapp.NewSimApp(logger, db, traceStore, true, map[int64]bool{}, homePath, uint(1), a.encCfg, appOpts)
+	return simApp.ExportAppStateAndValidatorsForTestnet(cfg)
+}
@@ -9,10 +9,12 @@ import (
 	"math"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -37,6 +39,9 @@ type Manager struct {
 	// multistore is the store from which snapshots are taken.
 	multistore types.Snapshotter
 	logger     log.Logger
+	// transport is the remote storage the created snapshots are pushed to, if
+	// any.
+	transport types.SnapshotTransport
 
 	mtx                sync.Mutex
 	operation          operation
@@ -96,6 +101,13 @@ func (m *Manager) RegisterExtensions(extensions ...types.ExtensionSnapshotter) e
 	return nil
 }
 
+// SetTransport sets the remote storage the snapshots are pushed to once
+// created. The remote snapshots aren't pruned, which is left to the storage,
+// e.g. to the lifecycle rules of a bucket.
+func (m *Manager) SetTransport(transport types.SnapshotTransport) {
+	m.transport = transport
+}
+
 // begin starts an operation, or errors if one is in progress. It manages the mutex itself.
 func (m *Manager) begin(op operation) error {
 	m.mtx.Lock()
@@ -175,11 +187,20 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	defer telemetry.MeasureSince(time.Now(), telemetry.MetricKeySnapshot, "create")
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
 	go m.createSnapshot(height, ch)
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+	snapshot, err := m.store.Save(height, types.CurrentFormat, ch)
+	if err != nil {
+		return nil, err
+	}
+
+	telemetry.SetGauge(float32(snapshot.Height), telemetry.MetricKeySnapshot, "create", "height")
+	telemetry.SetGauge(float32(snapshot.Chunks), telemetry.MetricKeySnapshot, "create", "chunks")
+	return snapshot, nil
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
@@ -299,6 +320,10 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	m.chRestoreDone = chDone
 	m.restoreChunkHashes = snapshot.Metadata.ChunkHashes
 	m.restoreChunkIndex = 0
+
+	telemetry.SetGauge(float32(snapshot.Height), telemetry.MetricKeySnapshot, "restore", "height")
+	telemetry.SetGauge(float32(snapshot.Chunks), telemetry.MetricKeySnapshot, "restore", "chunks_total")
+	telemetry.SetGauge(0, telemetry.MetricKeySnapshot, "restore", "chunks")
 	return nil
 }
 
@@ -373,6 +398,7 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	// Pass the chunk to the restore, and wait for completion if it was the final one.
 	m.chRestore <- io.NopCloser(bytes.NewReader(chunk))
 	m.restoreChunkIndex++
+	telemetry.SetGauge(float32(m.restoreChunkIndex), telemetry.MetricKeySnapshot, "restore", "chunks")
 
 	if int(m.restoreChunkIndex) >= len(m.restoreChunkHashes) {
 		close(m.chRestore)
@@ -445,6 +471,14 @@ func (m *Manager) snapshot(height int64) {
 
 	m.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
 
+	if m.transport != nil {
+		if err := PushSnapshot(m.store, m.transport, snapshot.Height, snapshot.Format); err != nil {
+			m.logger.Error("failed to push state snapshot", "height", height, "err", err)
+		} else {
+			m.logger.Info("pushed state snapshot", "height", height, "format", snapshot.Format)
+		}
+	}
+
 	if m.opts.KeepRecent > 0 {
 		m.logger.Debug("pruning state snapshots")
 
@@ -0,0 +1,253 @@
+package objstore
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// URLScheme is the scheme of the URLs of the snapshots stored in an
+	// S3-compatible object storage.
+	URLScheme = "s3"
+
+	// DefaultRegion is the region of the buckets when none is configured.
+	DefaultRegion = "us-east-1"
+
+	metadataObject = "metadata"
+)
+
+// Config defines the location of the snapshots in an S3-compatible object
+// storage. The credentials are read from the standard AWS sources, e.g. the
+// AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables.
+type Config struct {
+	// URL is the location of the snapshots, s3://<bucket>[/<prefix>].
+	URL string
+	// Endpoint is the URL of the S3-compatible API, e.g.
+	// https://storage.googleapis.com for GCS, AWS S3 if empty.
+	Endpoint string
+	// Region is the region of the bucket, DefaultRegion if empty.
+	Region string
+}
+
+// S3Transport is a snapshot transport storing the snapshots in an
+// S3-compatible object storage, e.g. AWS S3, GCS through its XML API, or
+// MinIO. The snapshot at height H in format F is stored as the objects
+// <prefix>/H/F/<chunk index> of its chunks, and <prefix>/H/F/metadata of its
+// metadata, uploaded last.
+type S3Transport struct {
+	client *s3.S3
+	bucket string
+	prefix string
+}
+
+var _ types.SnapshotTransport = (*S3Transport)(nil)
+
+// NewS3Transport creates a new S3Transport.
+func NewS3Transport(cfg Config) (*S3Transport, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshots URL %q: %w", cfg.URL, err)
+	}
+	if u.Scheme != URLScheme || u.Host == "" {
+		return nil, fmt.Errorf("invalid snapshots URL %q, expected %s://<bucket>[/<prefix>]", cfg.URL, URLScheme)
+	}
+
+	region := cfg.Region
+	if region == "" {
+		region = DefaultRegion
+	}
+
+	awsCfg := aws.NewConfig().WithRegion(region)
+	if cfg.Endpoint != "" {
+		// the S3-compatible storages don't all support the virtual-hosted
+		// style requests
+		awsCfg = awsCfg.WithEndpoint(cfg.Endpoint).WithS3ForcePathStyle(true)
+	}
+
+	sess, err := session.NewSession(awsCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &S3Transport{
+		client: s3.New(sess),
+		bucket: u.Host,
+		prefix: strings.Trim(u.Path, "/"),
+	}, nil
+}
+
+// Push implements types.SnapshotTransport.
+func (t *S3Transport) Push(snapshot *types.Snapshot, chunks <-chan io.ReadCloser) error {
+	index := uint32(0)
+	for chunk := range chunks {
+		bz, err := io.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to read snapshot chunk %v", index)
+		}
+
+		if err := t.put(t.key(snapshot.Height, snapshot.Format, strconv.FormatUint(uint64(index), 10)), bz); err != nil {
+			return sdkerrors.Wrapf(err, "failed to upload snapshot chunk %v", index)
+		}
+		index++
+	}
+
+	if index != snapshot.Chunks {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunks, but %v were uploaded", snapshot.Chunks, index)
+	}
+
+	bz, err := proto.Marshal(snapshot)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to encode snapshot metadata")
+	}
+
+	return t.put(t.key(snapshot.Height, snapshot.Format, metadataObject), bz)
+}
+
+// List implements types.SnapshotTransport.
+func (t *S3Transport) List() ([]*types.Snapshot, error) {
+	prefix := t.prefix
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	var keys []string
+	err := t.client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(t.bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, object := range page.Contents {
+			if key := aws.StringValue(object.Key); path.Base(key) == metadataObject {
+				keys = append(keys, key)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to list snapshots")
+	}
+
+	snapshots := make([]*types.Snapshot, 0, len(keys))
+	for _, key := range keys {
+		snapshot, err := t.getMetadata(key)
+		if err != nil {
+			return nil, err
+		}
+		if snapshot != nil {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		if snapshots[i].Height != snapshots[j].Height {
+			return snapshots[i].Height > snapshots[j].Height
+		}
+		return snapshots[i].Format > snapshots[j].Format
+	})
+
+	return snapshots, nil
+}
+
+// Pull implements types.SnapshotTransport.
+func (t *S3Transport) Pull(height uint64, format uint32) (*types.Snapshot, <-chan io.ReadCloser, error) {
+	snapshot, err := t.getMetadata(t.key(height, format, metadataObject))
+	if snapshot == nil || err != nil {
+		return nil, nil, err
+	}
+
+	ch := make(chan io.ReadCloser)
+	go func() {
+		defer close(ch)
+		for i := uint32(0); i < snapshot.Chunks; i++ {
+			pr, pw := io.Pipe()
+			ch <- pr
+			chunk, err := t.get(t.key(height, format, strconv.FormatUint(uint64(i), 10)))
+			if err == nil && chunk == nil {
+				err = fmt.Errorf("snapshot chunk %v not found", i)
+			}
+			if err != nil {
+				_ = pw.CloseWithError(err)
+				return
+			}
+			_, err = io.Copy(pw, chunk)
+			chunk.Close()
+			if err != nil {
+				_ = pw.CloseWithError(err)
+				return
+			}
+			pw.Close()
+		}
+	}()
+
+	return snapshot, ch, nil
+}
+
+// key returns the key of an object of the snapshot at height in format.
+func (t *S3Transport) key(height uint64, format uint32, object string) string {
+	return path.Join(t.prefix, strconv.FormatUint(height, 10), strconv.FormatUint(uint64(format), 10), object)
+}
+
+// getMetadata fetches the snapshot metadata stored at key, or returns nil if
+// it does not exist.
+func (t *S3Transport) getMetadata(key string) (*types.Snapshot, error) {
+	body, err := t.get(key)
+	if body == nil || err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	bz, err := io.ReadAll(body)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to read snapshot metadata %s", key)
+	}
+
+	snapshot := &types.Snapshot{}
+	if err := proto.Unmarshal(bz, snapshot); err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to decode snapshot metadata %s", key)
+	}
+
+	return snapshot, nil
+}
+
+// get fetches the object stored at key, or returns nil if it does not exist.
+// The caller must call Close() on it when done.
+func (t *S3Transport) get(key string) (io.ReadCloser, error) {
+	out, err := t.client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(t.bucket),
+		Key:    aws.String(key),
+	})
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to download %s", key)
+	}
+
+	return out.Body, nil
+}
+
+// put stores bz at key.
+func (t *S3Transport) put(key string, bz []byte) error {
+	_, err := t.client.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String(t.bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(bz),
+	})
+
+	return err
+}
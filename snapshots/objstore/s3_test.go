@@ -0,0 +1,179 @@
+package objstore_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/objstore"
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+// fakeS3 is an in-memory S3-compatible object storage serving the path-style
+// requests on the objects of a single bucket.
+type fakeS3 struct {
+	bucket string
+
+	mtx     sync.Mutex
+	objects map[string][]byte
+}
+
+type listBucketResult struct {
+	XMLName     xml.Name `xml:"ListBucketResult"`
+	Name        string   `xml:"Name"`
+	Prefix      string   `xml:"Prefix"`
+	KeyCount    int      `xml:"KeyCount"`
+	IsTruncated bool     `xml:"IsTruncated"`
+	Contents    []struct {
+		Key  string `xml:"Key"`
+		Size int    `xml:"Size"`
+	} `xml:"Contents"`
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	if path != s.bucket && !strings.HasPrefix(path, s.bucket+"/") {
+		http.Error(w, "unknown bucket", http.StatusNotFound)
+		return
+	}
+	key := strings.TrimPrefix(strings.TrimPrefix(path, s.bucket), "/")
+
+	switch {
+	case r.Method == http.MethodPut:
+		bz, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		s.objects[key] = bz
+
+	case r.Method == http.MethodGet && r.URL.Query().Get("list-type") == "2":
+		res := listBucketResult{Name: s.bucket, Prefix: r.URL.Query().Get("prefix")}
+		var keys []string
+		for key := range s.objects {
+			if strings.HasPrefix(key, res.Prefix) {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			res.Contents = append(res.Contents, struct {
+				Key  string `xml:"Key"`
+				Size int    `xml:"Size"`
+			}{key, len(s.objects[key])})
+		}
+		res.KeyCount = len(keys)
+		w.Header().Set("Content-Type", "application/xml")
+		_ = xml.NewEncoder(w).Encode(res)
+
+	case r.Method == http.MethodGet:
+		bz, ok := s.objects[key]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<Error><Code>NoSuchKey</Code><Message>not found</Message></Error>`))
+			return
+		}
+		_, _ = w.Write(bz)
+
+	default:
+		http.Error(w, "unsupported request", http.StatusMethodNotAllowed)
+	}
+}
+
+func setupS3Transport(t *testing.T) (*objstore.S3Transport, *fakeS3) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "access")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+
+	storage := &fakeS3{bucket: "snapshots", objects: make(map[string][]byte)}
+	server := httptest.NewServer(storage)
+	t.Cleanup(server.Close)
+
+	transport, err := objstore.NewS3Transport(objstore.Config{
+		URL:      "s3://snapshots/chain-1",
+		Endpoint: server.URL,
+	})
+	require.NoError(t, err)
+
+	return transport, storage
+}
+
+func chunksOf(chunks ...[]byte) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+
+	return ch
+}
+
+func TestNewS3Transport(t *testing.T) {
+	for _, url := range []string{"", "snapshots", "http://snapshots/chain-1", "s3:///chain-1"} {
+		_, err := objstore.NewS3Transport(objstore.Config{URL: url})
+		require.Error(t, err, url)
+	}
+}
+
+func TestS3Transport(t *testing.T) {
+	transport, storage := setupS3Transport(t)
+
+	snapshots, err := transport.List()
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+
+	snapshot, chunks, err := transport.Pull(1, 1)
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+	require.Nil(t, chunks)
+
+	snapshot1 := &types.Snapshot{Height: 1, Format: 1, Chunks: 2, Hash: []byte{1}}
+	require.NoError(t, transport.Push(snapshot1, chunksOf([]byte{1, 1}, []byte{1, 2})))
+	snapshot2 := &types.Snapshot{Height: 2, Format: 1, Chunks: 1, Hash: []byte{2}}
+	require.NoError(t, transport.Push(snapshot2, chunksOf([]byte{2, 1})))
+
+	require.Equal(t, []byte{1, 2}, storage.objects["chain-1/1/1/1"])
+	require.Contains(t, storage.objects, "chain-1/1/1/metadata")
+
+	// a snapshot whose chunks don't match its metadata isn't listed
+	require.Error(t, transport.Push(&types.Snapshot{Height: 3, Format: 1, Chunks: 2}, chunksOf([]byte{3, 1})))
+
+	snapshots, err = transport.List()
+	require.NoError(t, err)
+	require.Equal(t, []*types.Snapshot{snapshot2, snapshot1}, snapshots)
+
+	snapshot, chunks, err = transport.Pull(1, 1)
+	require.NoError(t, err)
+	require.Equal(t, snapshot1, snapshot)
+
+	var bodies [][]byte
+	for chunk := range chunks {
+		bz, err := io.ReadAll(chunk)
+		require.NoError(t, err)
+		require.NoError(t, chunk.Close())
+		bodies = append(bodies, bz)
+	}
+	require.Equal(t, [][]byte{{1, 1}, {1, 2}}, bodies)
+
+	// a missing chunk fails the pull
+	delete(storage.objects, "chain-1/1/1/1")
+	_, chunks, err = transport.Pull(1, 1)
+	require.NoError(t, err)
+	chunk := <-chunks
+	_, err = io.ReadAll(chunk)
+	require.NoError(t, err)
+	chunk = <-chunks
+	_, err = io.ReadAll(chunk)
+	require.Error(t, err)
+}
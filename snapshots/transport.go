@@ -0,0 +1,65 @@
+package snapshots
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// PushSnapshot uploads a snapshot of the store to the transport.
+func PushSnapshot(store *Store, transport types.SnapshotTransport, height uint64, format uint32) error {
+	snapshot, chunks, err := store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot at height %v format %v", height, format)
+	}
+	defer DrainChunks(chunks)
+
+	return transport.Push(snapshot, chunks)
+}
+
+// PullSnapshot downloads a snapshot from the transport into the store, and
+// returns it. The snapshot is deleted from the store if its chunks don't match
+// the hashes of its remote metadata.
+func PullSnapshot(store *Store, transport types.SnapshotTransport, height uint64, format uint32) (*types.Snapshot, error) {
+	remote, chunks, err := transport.Pull(height, format)
+	if err != nil {
+		return nil, err
+	}
+	if remote == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "remote snapshot at height %v format %v", height, format)
+	}
+
+	snapshot, err := store.Save(height, format, chunks)
+	if err != nil {
+		return nil, err
+	}
+
+	if !sameChunks(snapshot, remote) {
+		if err := store.Delete(height, format); err != nil {
+			return nil, err
+		}
+		return nil, sdkerrors.Wrapf(types.ErrChunkHashMismatch,
+			"remote snapshot at height %v format %v doesn't match its metadata", height, format)
+	}
+
+	return snapshot, nil
+}
+
+// sameChunks returns whether the chunks of the snapshots have the same hashes.
+func sameChunks(a, b *types.Snapshot) bool {
+	if a.Chunks != b.Chunks || !bytes.Equal(a.Hash, b.Hash) || len(a.Metadata.ChunkHashes) != len(b.Metadata.ChunkHashes) {
+		return false
+	}
+
+	for i, hash := range a.Metadata.ChunkHashes {
+		if !bytes.Equal(hash, b.Metadata.ChunkHashes[i]) {
+			return false
+		}
+	}
+
+	return true
+}
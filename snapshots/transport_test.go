@@ -0,0 +1,107 @@
+package snapshots_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+// memTransport is an in-memory snapshot transport.
+type memTransport struct {
+	snapshots map[uint64]*types.Snapshot
+	chunks    map[uint64][][]byte
+}
+
+func newMemTransport() *memTransport {
+	return &memTransport{snapshots: make(map[uint64]*types.Snapshot), chunks: make(map[uint64][][]byte)}
+}
+
+func (t *memTransport) Push(snapshot *types.Snapshot, chunks <-chan io.ReadCloser) error {
+	t.chunks[snapshot.Height] = readChunks(chunks)
+	t.snapshots[snapshot.Height] = snapshot
+	return nil
+}
+
+func (t *memTransport) List() ([]*types.Snapshot, error) {
+	var snapshots []*types.Snapshot
+	for _, snapshot := range t.snapshots {
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+func (t *memTransport) Pull(height uint64, _ uint32) (*types.Snapshot, <-chan io.ReadCloser, error) {
+	snapshot, ok := t.snapshots[height]
+	if !ok {
+		return nil, nil, nil
+	}
+	return snapshot, makeChunks(t.chunks[height]), nil
+}
+
+func TestPushPullSnapshot(t *testing.T) {
+	store := setupStore(t)
+	transport := newMemTransport()
+
+	require.Error(t, snapshots.PushSnapshot(store, transport, 9, 1))
+
+	require.NoError(t, snapshots.PushSnapshot(store, transport, 2, 2))
+	require.Equal(t, [][]byte{{2, 2, 0}, {2, 2, 1}, {2, 2, 2}}, transport.chunks[2])
+
+	snapshot, err := store.Get(2, 2)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, transport.snapshots[2])
+
+	// the snapshot is pulled into another store
+	target, err := snapshots.NewStore(db.NewMemDB(), testutil.GetTempDir(t))
+	require.NoError(t, err)
+
+	_, err = snapshots.PullSnapshot(target, transport, 9, 1)
+	require.Error(t, err)
+
+	pulled, err := snapshots.PullSnapshot(target, transport, 2, 2)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, pulled)
+
+	_, chunks, err := target.Load(2, 2)
+	require.NoError(t, err)
+	require.Equal(t, transport.chunks[2], readChunks(chunks))
+
+	// a snapshot whose chunks don't match its metadata isn't kept
+	transport.chunks[2][1] = []byte{9}
+	target, err = snapshots.NewStore(db.NewMemDB(), testutil.GetTempDir(t))
+	require.NoError(t, err)
+
+	_, err = snapshots.PullSnapshot(target, transport, 2, 2)
+	require.ErrorIs(t, err, types.ErrChunkHashMismatch)
+
+	snapshot, err = target.Get(2, 2)
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+}
+
+func TestManager_SnapshotIfApplicablePush(t *testing.T) {
+	store := setupStore(t)
+	snapshotter := &mockSnapshotter{
+		items:         [][]byte{{1, 2, 3}},
+		prunedHeights: make(map[int64]struct{}),
+	}
+	manager := snapshots.NewManager(store, opts, snapshotter, nil, log.NewNopLogger())
+	transport := newMemTransport()
+	manager.SetTransport(transport)
+
+	manager.SnapshotIfApplicable(1000)
+	require.Empty(t, transport.snapshots)
+
+	manager.SnapshotIfApplicable(1500)
+	snapshot, err := store.Get(1500, snapshotter.SnapshotFormat())
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+	require.Equal(t, map[uint64]*types.Snapshot{1500: snapshot}, transport.snapshots)
+}
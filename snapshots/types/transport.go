@@ -0,0 +1,19 @@
+package types
+
+import "io"
+
+// SnapshotTransport is a remote storage of snapshots, e.g. an object storage
+// bucket, the snapshots of a node are pushed to and pulled from, so that they
+// can be shared across nodes without the p2p snapshot discovery.
+type SnapshotTransport interface {
+	// Push uploads a snapshot, consuming and closing its chunks. The snapshot
+	// must not be listed before all its chunks are uploaded.
+	Push(snapshot *Snapshot, chunks <-chan io.ReadCloser) error
+
+	// List lists the snapshots available, newest first.
+	List() ([]*Snapshot, error)
+
+	// Pull downloads a snapshot (both metadata and binary chunks). The chunks
+	// must be consumed and closed. Returns nil if the snapshot does not exist.
+	Pull(height uint64, format uint32) (*Snapshot, <-chan io.ReadCloser, error)
+}
@@ -163,6 +163,17 @@ func (store *Store) CacheWrapWithListeners(storeKey types.StoreKey, listeners []
 //----------------------------------------
 // Iteration
 
+// EstimateKeyCount implements types.KeyCountEstimator. The estimate is the
+// one of the parent, regardless of the writes cached by the store.
+func (store *Store) EstimateKeyCount(start, end []byte) (uint64, bool) {
+	estimator, ok := store.parent.(types.KeyCountEstimator)
+	if !ok {
+		return 0, false
+	}
+
+	return estimator.EstimateKeyCount(start, end)
+}
+
 // Iterator implements types.KVStore.
 func (store *Store) Iterator(start, end []byte) types.Iterator {
 	return store.iterator(start, end, true)
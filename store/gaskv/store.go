@@ -82,6 +82,18 @@ func (gs *Store) ReverseIterator(start, end []byte) types.Iterator {
 	return gs.iterator(start, end, false)
 }
 
+// EstimateKeyCount implements types.KeyCountEstimator, consuming the gas of a
+// read.
+func (gs *Store) EstimateKeyCount(start, end []byte) (uint64, bool) {
+	estimator, ok := gs.parent.(types.KeyCountEstimator)
+	if !ok {
+		return 0, false
+	}
+
+	gs.gasMeter.ConsumeGas(gs.gasConfig.ReadCostFlat, types.GasReadCostFlatDesc)
+	return estimator.EstimateKeyCount(start, end)
+}
+
 // Implements KVStore.
 func (gs *Store) CacheWrap() types.CacheWrap {
 	panic("cannot CacheWrap a GasKVStore")
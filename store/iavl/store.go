@@ -202,6 +202,12 @@ func (st *Store) DeleteVersions(versions ...int64) error {
 	return st.tree.DeleteVersions(versions...)
 }
 
+// LoadVersionForOverwriting loads the tree at the given committed version and
+// deletes all the versions after it, so that the next commit overwrites them.
+func (st *Store) LoadVersionForOverwriting(targetVersion int64) (int64, error) {
+	return st.tree.LoadVersionForOverwriting(targetVersion)
+}
+
 // Implements types.KVStore.
 func (st *Store) Iterator(start, end []byte) types.Iterator {
 	var iTree *iavl.ImmutableTree
@@ -230,6 +236,38 @@ func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
 	return newIAVLIterator(iTree, start, end, false)
 }
 
+// EstimateKeyCount implements types.KeyCountEstimator. The count is exact, the
+// indexes of the bounds of the domain being looked up in the tree.
+func (st *Store) EstimateKeyCount(start, end []byte) (uint64, bool) {
+	var iTree *iavl.ImmutableTree
+
+	switch tree := st.tree.(type) {
+	case *immutableTree:
+		iTree = tree.ImmutableTree
+	case *iavl.MutableTree:
+		iTree = tree.ImmutableTree
+	}
+	if iTree == nil {
+		return 0, false
+	}
+
+	var first int64
+	if start != nil {
+		first, _ = iTree.Get(start)
+	}
+
+	last := iTree.Size()
+	if end != nil {
+		last, _ = iTree.Get(end)
+	}
+
+	if last < first {
+		return 0, true
+	}
+
+	return uint64(last - first), true
+}
+
 // SetInitialVersion sets the initial version of the IAVL tree. It is used when
 // starting a new chain at an arbitrary height.
 func (st *Store) SetInitialVersion(version int64) {
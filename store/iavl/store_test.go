@@ -270,6 +270,39 @@ func TestIAVLIterator(t *testing.T) {
 	require.Equal(t, len(expected), i)
 }
 
+func TestIAVLEstimateKeyCount(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree, _ := newAlohaTree(t, db)
+	iavlStore := UnsafeNewStore(tree)
+
+	testCases := []struct {
+		name       string
+		start, end []byte
+		expCount   uint64
+	}{
+		{"whole store", nil, nil, 2},
+		{"from an existing key", []byte("hello"), nil, 1},
+		{"to an existing key", nil, []byte("hello"), 1},
+		{"between missing keys", []byte("a"), []byte("z"), 2},
+		{"empty domain", []byte("b"), []byte("c"), 0},
+		{"reversed domain", []byte("z"), []byte("a"), 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			count, ok := iavlStore.EstimateKeyCount(tc.start, tc.end)
+			require.True(t, ok)
+			require.Equal(t, tc.expCount, count)
+		})
+	}
+
+	// the uncommitted writes are counted
+	iavlStore.Set([]byte("bonjour"), []byte("au revoir"))
+	count, ok := iavlStore.EstimateKeyCount(nil, nil)
+	require.True(t, ok)
+	require.Equal(t, uint64(3), count)
+}
+
 func TestIAVLReverseIterator(t *testing.T) {
 	db := dbm.NewMemDB()
 
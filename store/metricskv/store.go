@@ -0,0 +1,100 @@
+package metricskv
+
+import (
+	"io"
+	"time"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+var _ types.KVStore = &Store{}
+
+// Store implements the KVStore interface with telemetry enabled. The duration of
+// each core KVStore call is emitted as a metric labeled with the name of the store,
+// which also counts the reads and writes of the store.
+type Store struct {
+	parent types.KVStore
+	labels []metrics.Label
+}
+
+// NewStore returns a reference to a new metrics KVStore given a parent KVStore
+// implementation and the name of the store the metrics are labeled with.
+func NewStore(parent types.KVStore, storeName string) *Store {
+	return &Store{
+		parent: parent,
+		labels: []metrics.Label{telemetry.NewLabel(telemetry.MetricLabelNameStore, storeName)},
+	}
+}
+
+// Get implements the KVStore interface. It measures a read operation and
+// delegates a Get call to the parent KVStore.
+func (s *Store) Get(key []byte) []byte {
+	defer s.measureSince(time.Now(), "get")
+	return s.parent.Get(key)
+}
+
+// Set implements the KVStore interface. It measures a write operation and
+// delegates the Set call to the parent KVStore.
+func (s *Store) Set(key []byte, value []byte) {
+	defer s.measureSince(time.Now(), "set")
+	s.parent.Set(key, value)
+}
+
+// Delete implements the KVStore interface. It measures a write operation and
+// delegates the Delete call to the parent KVStore.
+func (s *Store) Delete(key []byte) {
+	defer s.measureSince(time.Now(), "delete")
+	s.parent.Delete(key)
+}
+
+// Has implements the KVStore interface. It measures a read operation and
+// delegates the Has call to the parent KVStore.
+func (s *Store) Has(key []byte) bool {
+	defer s.measureSince(time.Now(), "has")
+	return s.parent.Has(key)
+}
+
+// Iterator implements the KVStore interface. It measures the creation of the
+// iterator and delegates the Iterator call to the parent KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	defer s.measureSince(time.Now(), "iterator")
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface. It measures the creation of
+// the iterator and delegates the ReverseIterator call to the parent KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	defer s.measureSince(time.Now(), "iterator")
+	return s.parent.ReverseIterator(start, end)
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface. It panics as a Store
+// cannot be cache wrapped.
+func (s *Store) CacheWrap() types.CacheWrap {
+	panic("cannot CacheWrap a MetricsKVStore")
+}
+
+// CacheWrapWithTrace implements the KVStore interface. It panics as a
+// Store cannot be cache wrapped.
+func (s *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	panic("cannot CacheWrapWithTrace a MetricsKVStore")
+}
+
+// CacheWrapWithListeners implements the KVStore interface. It panics as a
+// Store cannot be cache wrapped.
+func (s *Store) CacheWrapWithListeners(_ types.StoreKey, _ []types.WriteListener) types.CacheWrap {
+	panic("cannot CacheWrapWithListeners a MetricsKVStore")
+}
+
+func (s *Store) measureSince(start time.Time, op string) {
+	telemetry.MeasureSinceWithLabels([]string{telemetry.MetricKeyStore, op}, start, s.labels)
+}
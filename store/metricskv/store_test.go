@@ -0,0 +1,43 @@
+package metricskv_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/metricskv"
+)
+
+func TestMetricsKVStore(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	conf := metrics.DefaultConfig("test")
+	conf.EnableHostname = false
+	conf.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(conf, sink)
+	require.NoError(t, err)
+
+	store := metricskv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}, "bank")
+
+	store.Set([]byte("key1"), []byte("value1"))
+	store.Set([]byte("key2"), []byte("value2"))
+	require.Equal(t, []byte("value1"), store.Get([]byte("key1")))
+	require.True(t, store.Has([]byte("key2")))
+	store.Delete([]byte("key2"))
+
+	iter := store.Iterator(nil, nil)
+	require.True(t, iter.Valid())
+	require.Equal(t, []byte("key1"), iter.Key())
+	require.NoError(t, iter.Close())
+
+	intervals := sink.Data()
+	require.Len(t, intervals, 1)
+	for op, count := range map[string]int{"get": 1, "set": 2, "has": 1, "delete": 1, "iterator": 1} {
+		sample, ok := intervals[0].Samples["test.store."+op+";store=bank"]
+		require.True(t, ok, op)
+		require.Equal(t, count, sample.Count, op)
+	}
+}
@@ -120,6 +120,21 @@ func (s Store) ReverseIterator(start, end []byte) types.Iterator {
 	return newPrefixIterator(s.prefix, start, end, iter)
 }
 
+// EstimateKeyCount implements types.KeyCountEstimator.
+func (s Store) EstimateKeyCount(start, end []byte) (uint64, bool) {
+	estimator, ok := s.parent.(types.KeyCountEstimator)
+	if !ok {
+		return 0, false
+	}
+
+	newend := cpIncr(s.prefix)
+	if end != nil {
+		newend = cloneAppend(s.prefix, end)
+	}
+
+	return estimator.EstimateKeyCount(cloneAppend(s.prefix, start), newend)
+}
+
 var _ types.Iterator = (*prefixIterator)(nil)
 
 type prefixIterator struct {
@@ -97,6 +97,42 @@ func TestIAVLStorePrefix(t *testing.T) {
 	testPrefixStore(t, iavlStore, []byte("test"))
 }
 
+func TestPrefixStoreEstimateKeyCount(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree, err := tiavl.NewMutableTree(db, cacheSize)
+	require.NoError(t, err)
+	iavlStore := iavl.UnsafeNewStore(tree)
+
+	for _, key := range []string{"a", "test1", "test2", "test3", "u"} {
+		iavlStore.Set(bz(key), bz("value"))
+	}
+
+	prefixStore := NewStore(iavlStore, bz("test"))
+	count, ok := prefixStore.EstimateKeyCount(nil, nil)
+	require.True(t, ok)
+	require.Equal(t, uint64(3), count)
+
+	count, ok = prefixStore.EstimateKeyCount(bz("2"), nil)
+	require.True(t, ok)
+	require.Equal(t, uint64(2), count)
+
+	count, ok = prefixStore.EstimateKeyCount(nil, bz("2"))
+	require.True(t, ok)
+	require.Equal(t, uint64(1), count)
+
+	// the estimates are forwarded by the branches and the gas stores
+	meter := types.NewGasMeter(100000000)
+	gasStore := gaskv.NewStore(cachekv.NewStore(prefixStore), meter, types.KVGasConfig())
+	count, ok = gasStore.EstimateKeyCount(nil, nil)
+	require.True(t, ok)
+	require.Equal(t, uint64(3), count)
+	require.Equal(t, types.KVGasConfig().ReadCostFlat, meter.GasConsumed())
+
+	// and the stores which cannot estimate their keys report it
+	_, ok = NewStore(dbadapter.Store{DB: dbm.NewMemDB()}, bz("test")).EstimateKeyCount(nil, nil)
+	require.False(t, ok)
+}
+
 func TestPrefixKVStoreNoNilSet(t *testing.T) {
 	meter := types.NewGasMeter(100000000)
 	mem := dbadapter.Store{DB: dbm.NewMemDB()}
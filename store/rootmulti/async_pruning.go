@@ -0,0 +1,107 @@
+package rootmulti
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// asyncPruning prunes the stores in a background goroutine, so that pruning
+// doesn't add to the commit latency. The heights to prune at each pruning
+// interval are queued in a bounded queue and the commits block when the queue
+// is full, until the background pruning catches up.
+type asyncPruning struct {
+	queue chan []int64
+	done  chan struct{}
+	// lag is the number of heights queued and not pruned yet.
+	lag int64
+}
+
+// SetAsyncPruning prunes the stores in a background goroutine instead of in
+// Commit, with up to queueSize pruning runs pending before Commit blocks. A
+// queueSize of 0 prunes the stores in Commit. StopAsyncPruning must be called
+// to wait for the pending pruning runs before the store is discarded.
+func (rs *Store) SetAsyncPruning(queueSize int) {
+	rs.StopAsyncPruning()
+	if queueSize <= 0 {
+		return
+	}
+
+	rs.asyncPruning = &asyncPruning{
+		queue: make(chan []int64, queueSize),
+		done:  make(chan struct{}),
+	}
+	go rs.pruneInBackground(rs.asyncPruning)
+}
+
+// StopAsyncPruning waits for the pending pruning runs to complete and stops
+// the background pruning, the stores being pruned in Commit afterwards. It is a
+// no-op if the stores are already pruned in Commit.
+func (rs *Store) StopAsyncPruning() {
+	if rs.asyncPruning == nil {
+		return
+	}
+
+	close(rs.asyncPruning.queue)
+	<-rs.asyncPruning.done
+	rs.asyncPruning = nil
+}
+
+// PruningLag returns the number of heights queued for pruning in the
+// background and not pruned yet.
+func (rs *Store) PruningLag() int64 {
+	if rs.asyncPruning == nil {
+		return 0
+	}
+
+	return atomic.LoadInt64(&rs.asyncPruning.lag)
+}
+
+// queuePruning queues the heights pending pruning to be pruned in the
+// background, blocking while the queue is full.
+func (rs *Store) queuePruning() error {
+	pruningHeights, err := rs.pruningManager.GetFlushAndResetPruningHeights()
+	if err != nil {
+		return err
+	}
+
+	if len(pruningHeights) == 0 {
+		rs.logger.Debug("pruning skipped; no heights to prune")
+		return nil
+	}
+
+	ap := rs.asyncPruning
+	lag := atomic.AddInt64(&ap.lag, int64(len(pruningHeights)))
+	telemetry.SetGauge(float32(lag), "store", "pruning", "lag")
+
+	select {
+	case ap.queue <- pruningHeights:
+	default:
+		rs.logger.Info("pruning is lagging behind; waiting for the pending pruning runs", "lag", lag)
+		telemetry.IncrCounter(1, "store", "pruning", "backpressure")
+
+		start := time.Now()
+		ap.queue <- pruningHeights
+		telemetry.MeasureSince(start, "store", "pruning", "backpressure_wait")
+	}
+
+	return nil
+}
+
+// pruneInBackground prunes the heights queued until the queue is closed.
+func (rs *Store) pruneInBackground(ap *asyncPruning) {
+	defer close(ap.done)
+
+	for pruningHeights := range ap.queue {
+		start := time.Now()
+		rs.logger.Debug("pruning heights in the background", "heights", pruningHeights)
+		if err := rs.pruneHeights(pruningHeights); err != nil {
+			rs.logger.Error("failed to prune stores", "heights", pruningHeights, "err", err)
+		}
+		telemetry.MeasureSince(start, "store", "pruning", "async")
+
+		lag := atomic.AddInt64(&ap.lag, -int64(len(pruningHeights)))
+		telemetry.SetGauge(float32(lag), "store", "pruning", "lag")
+	}
+}
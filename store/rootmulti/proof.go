@@ -17,11 +17,15 @@ func RequireProof(subpath string) bool {
 
 //-----------------------------------------------------------------------------
 
-// XXX: This should be managed by the rootMultiStore which may want to register
-// more proof ops?
+// DefaultProofRuntime returns a ProofRuntime supporting IAVL and simple merkle
+// proofs, along with the proof ops of the custom store types registered with
+// storetypes.RegisterProofOpDecoder.
 func DefaultProofRuntime() (prt *merkle.ProofRuntime) {
 	prt = merkle.NewProofRuntime()
 	prt.RegisterOpDecoder(storetypes.ProofOpIAVLCommitment, storetypes.CommitmentOpDecoder)
 	prt.RegisterOpDecoder(storetypes.ProofOpSimpleMerkleCommitment, storetypes.CommitmentOpDecoder)
+	for opType, decoder := range storetypes.ProofOpDecoders() {
+		prt.RegisterOpDecoder(opType, decoder)
+	}
 	return
 }
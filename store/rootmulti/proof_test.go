@@ -3,6 +3,7 @@ package rootmulti
 import (
 	"testing"
 
+	ics23 "github.com/confio/ics23/go"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -147,3 +148,89 @@ func TestVerifyMultiStoreQueryProofAbsence(t *testing.T) {
 	err = prt.VerifyValue(res.ProofOps, cid.Hash, "/iavlStoreKey/MYABSENTKEY", []byte(""))
 	require.NotNil(t, err)
 }
+
+const (
+	storeTypeIndex   types.StoreType = 1000
+	proofOpIndexIAVL                 = "index:iavl"
+)
+
+func init() {
+	types.RegisterProofOpDecoder(proofOpIndexIAVL, types.NewCommitmentOpDecoder(proofOpIndexIAVL, ics23.IavlSpec))
+}
+
+// indexStore is a custom store type proving its entries with its own proof op
+// type, through the /index subpath.
+type indexStore struct {
+	*iavl.Store
+}
+
+func (s indexStore) GetStoreType() types.StoreType {
+	return storeTypeIndex
+}
+
+func (s indexStore) Query(req abci.RequestQuery) abci.ResponseQuery {
+	if req.Path != "/index" {
+		return abci.ResponseQuery{Code: 1}
+	}
+
+	req.Path = "/key"
+	res := s.Store.Query(req)
+	if res.ProofOps != nil {
+		for i, pop := range res.ProofOps.Ops {
+			op, err := types.CommitmentOpDecoder(pop)
+			if err != nil {
+				panic(err)
+			}
+			commitmentOp := op.(types.CommitmentOp)
+			commitmentOp.Type = proofOpIndexIAVL
+			res.ProofOps.Ops[i] = commitmentOp.ProofOp()
+		}
+	}
+
+	return res
+}
+
+func TestVerifyCustomStoreQueryProof(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := NewStore(db, log.NewNopLogger())
+	store.RegisterStoreType(storeTypeIndex, func(_ types.StoreKey, db dbm.DB, id types.CommitID) (types.CommitKVStore, error) {
+		iavlStore, err := iavl.LoadStore(db, id, false, iavl.DefaultIAVLCacheSize)
+		if err != nil {
+			return nil, err
+		}
+		return indexStore{iavlStore.(*iavl.Store)}, nil
+	})
+	require.Panics(t, func() { store.RegisterStoreType(storeTypeIndex, nil) })
+	require.Panics(t, func() { store.RegisterStoreType(types.StoreTypeIAVL, nil) })
+
+	indexStoreKey := types.NewKVStoreKey("indexStoreKey")
+	store.MountStoreWithDB(indexStoreKey, storeTypeIndex, nil)
+	require.NoError(t, store.LoadVersion(0))
+
+	store.GetCommitKVStore(indexStoreKey).Set([]byte("MYKEY"), []byte("MYVALUE"))
+	cid := store.Commit()
+
+	res := store.Query(abci.RequestQuery{
+		Path:  "/indexStoreKey/index",
+		Data:  []byte("MYKEY"),
+		Prove: true,
+	})
+	require.NotNil(t, res.ProofOps)
+	require.Len(t, res.ProofOps.Ops, 2)
+	require.Equal(t, proofOpIndexIAVL, res.ProofOps.Ops[0].Type)
+
+	prt := DefaultProofRuntime()
+	require.NoError(t, prt.VerifyValue(res.ProofOps, cid.Hash, "/indexStoreKey/MYKEY", []byte("MYVALUE")))
+	require.Error(t, prt.VerifyValue(res.ProofOps, cid.Hash, "/indexStoreKey/MYKEY", []byte("MYVALUE_NOT")))
+
+	res = store.Query(abci.RequestQuery{
+		Path:  "/indexStoreKey/index",
+		Data:  []byte("MYABSENTKEY"),
+		Prove: true,
+	})
+	require.NoError(t, prt.VerifyAbsence(res.ProofOps, cid.Hash, "/indexStoreKey/MYABSENTKEY"))
+
+	// the ics23 commitment types are reserved
+	require.Panics(t, func() { types.RegisterProofOpDecoder(types.ProofOpIAVLCommitment, types.CommitmentOpDecoder) })
+	require.Panics(t, func() { types.RegisterProofOpDecoder(proofOpIndexIAVL, types.CommitmentOpDecoder) })
+}
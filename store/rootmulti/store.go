@@ -58,6 +58,14 @@ type Store struct {
 	interBlockCache types.MultiStorePersistentCache
 
 	listeners map[types.StoreKey][]types.WriteListener
+
+	// storeConstructors load the stores of the custom store types.
+	storeConstructors map[types.StoreType]StoreConstructor
+
+	// pruningMtx serializes the pruning of the stores with their commit, as
+	// the stores may be pruned in the background.
+	pruningMtx   sync.Mutex
+	asyncPruning *asyncPruning
 }
 
 var (
@@ -65,6 +73,12 @@ var (
 	_ types.Queryable        = (*Store)(nil)
 )
 
+// StoreConstructor loads the store of a custom store type from its database at
+// the commit id. The store must return its custom type from GetStoreType and,
+// to serve proven queries, proof ops whose decoders are registered with
+// types.RegisterProofOpDecoder.
+type StoreConstructor func(key types.StoreKey, db dbm.DB, id types.CommitID) (types.CommitKVStore, error)
+
 // NewStore returns a reference to a new Store object with the provided DB. The
 // store will be created with a PruneNothing pruning strategy by default. After
 // a store is created, KVStores must be mounted and finally LoadLatestVersion or
@@ -80,7 +94,27 @@ func NewStore(db dbm.DB, logger log.Logger) *Store {
 		listeners:      make(map[types.StoreKey][]types.WriteListener),
 		removalMap:     make(map[types.StoreKey]bool),
 		pruningManager: pruning.NewManager(db, logger),
+
+		storeConstructors: make(map[types.StoreType]StoreConstructor),
+	}
+}
+
+// RegisterStoreType registers the constructor of the stores of the custom store
+// type typ, so that they can be mounted. It must be called before loading the
+// stores, and panics if typ is a built-in store type or is already registered.
+// The custom stores are committed and proven like the IAVL stores, but are not
+// included in the state sync snapshots.
+func (rs *Store) RegisterStoreType(typ types.StoreType, constructor StoreConstructor) {
+	switch typ {
+	case types.StoreTypeMulti, types.StoreTypeDB, types.StoreTypeIAVL, types.StoreTypeTransient,
+		types.StoreTypeMemory, types.StoreTypeSMT, types.StoreTypePersistent:
+		panic(fmt.Sprintf("store type %v is built in", typ))
+	}
+	if _, ok := rs.storeConstructors[typ]; ok {
+		panic(fmt.Sprintf("store type %v already registered", typ))
 	}
+
+	rs.storeConstructors[typ] = constructor
 }
 
 // GetPruning fetches the pruning strategy from the root store.
@@ -416,6 +450,7 @@ func (rs *Store) Commit() types.CommitID {
 		version = previousHeight + 1
 	}
 
+	rs.pruningMtx.Lock()
 	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
 	defer rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
 
@@ -429,6 +464,7 @@ func (rs *Store) Commit() types.CommitID {
 	}
 	// reset the removalMap
 	rs.removalMap = make(map[types.StoreKey]bool)
+	rs.pruningMtx.Unlock()
 
 	if err := rs.handlePruning(version); err != nil {
 		panic(err)
@@ -538,12 +574,18 @@ func (rs *Store) handlePruning(version int64) error {
 	if !rs.pruningManager.ShouldPruneAtHeight(version) {
 		return nil
 	}
+	if rs.asyncPruning != nil {
+		return rs.queuePruning()
+	}
+
 	rs.logger.Info("prune start", "height", version)
 	defer rs.logger.Info("prune end", "height", version)
-	return rs.pruneStores()
+	return rs.PruneStores()
 }
 
-func (rs *Store) pruneStores() error {
+// PruneStores prunes the heights pending pruning from the stores, regardless of
+// the pruning interval. It must not be called concurrently with Commit.
+func (rs *Store) PruneStores() error {
 	pruningHeights, err := rs.pruningManager.GetFlushAndResetPruningHeights()
 	if err != nil {
 		return err
@@ -555,7 +597,15 @@ func (rs *Store) pruneStores() error {
 	}
 
 	rs.logger.Debug("pruning heights", "heights", pruningHeights)
+	return rs.pruneHeights(pruningHeights)
+}
 
+// pruneHeights deletes the given heights from the IAVL stores. Each store is
+// pruned while holding the pruning lock, so that the commits wait for at most
+// the pruning of a single store.
+func (rs *Store) pruneHeights(pruningHeights []int64) error {
+	rs.pruningMtx.Lock()
+	var iavlStores []*iavl.Store
 	for key, store := range rs.stores {
 		// If the store is wrapped with an inter-block cache, we must first unwrap
 		// it to get the underlying IAVL store.
@@ -563,9 +613,14 @@ func (rs *Store) pruneStores() error {
 			continue
 		}
 
-		store = rs.GetCommitKVStore(key)
+		iavlStores = append(iavlStores, rs.GetCommitKVStore(key).(*iavl.Store))
+	}
+	rs.pruningMtx.Unlock()
 
-		err := store.(*iavl.Store).DeleteVersions(pruningHeights...)
+	for _, store := range iavlStores {
+		rs.pruningMtx.Lock()
+		err := store.DeleteVersions(pruningHeights...)
+		rs.pruningMtx.Unlock()
 		if err == nil {
 			continue
 		}
@@ -615,7 +670,9 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 	req.Path = subpath
 	res := queryable.Query(req)
 
-	if !req.Prove || !RequireProof(subpath) {
+	// The custom stores may prove the queries of other subpaths, in which case
+	// their proof ops are completed with the proof of the store.
+	if !req.Prove || (!RequireProof(subpath) && (res.ProofOps == nil || len(res.ProofOps.Ops) == 0)) {
 		return res
 	}
 
@@ -903,6 +960,10 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 		return mem.NewStore(), nil
 
 	default:
+		if constructor, ok := rs.storeConstructors[params.typ]; ok {
+			return constructor(key, db, id)
+		}
+
 		panic(fmt.Sprintf("unrecognized store type %v", params.typ))
 	}
 }
@@ -924,30 +985,74 @@ func (rs *Store) buildCommitInfo(version int64) *types.CommitInfo {
 	}
 }
 
-// RollbackToVersion delete the versions after `target` and update the latest version.
-func (rs *Store) RollbackToVersion(target int64) int64 {
-	if target < 0 {
-		panic("Negative rollback target")
+// RollbackToVersion deletes the versions after the target version and sets it as the
+// latest version, so that the following commits overwrite the deleted versions. The
+// target version must be retained by all the loaded IAVL stores, and the pruning
+// heights after it are discarded.
+func (rs *Store) RollbackToVersion(target int64) error {
+	if target <= 0 {
+		return fmt.Errorf("invalid rollback height target: %d", target)
 	}
-	current := getLatestVersion(rs.db)
-	if target >= current {
-		return current
-	}
-	for ; current > target; current-- {
-		rs.pruningManager.HandleHeight(current)
+
+	latest := getLatestVersion(rs.db)
+	if target > latest {
+		return fmt.Errorf("cannot rollback to height %d after the latest height %d", target, latest)
 	}
-	if err := rs.pruneStores(); err != nil {
-		panic(err)
+	if len(rs.stores) == 0 {
+		return errors.New("cannot rollback unloaded stores")
 	}
 
-	// update latest height
-	bz, err := gogotypes.StdInt64Marshal(current)
+	cInfo, err := getCommitInfo(rs.db, target)
 	if err != nil {
-		panic(err)
+		return errors.Wrapf(err, "height %d is not retained", target)
+	}
+	infos := make(map[string]types.StoreInfo, len(cInfo.StoreInfos))
+	for _, storeInfo := range cInfo.StoreInfos {
+		infos[storeInfo.Name] = storeInfo
+	}
+
+	// check all the stores can be rolled back before overwriting any of them
+	iavlStores := make(map[types.StoreKey]*iavl.Store)
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		iavlStore := rs.GetCommitKVStore(key).(*iavl.Store)
+		if _, ok := infos[key.Name()]; !ok {
+			return fmt.Errorf("store %s does not exist at height %d", key.Name(), target)
+		}
+		if !iavlStore.VersionExists(target) {
+			return fmt.Errorf("height %d is not retained by store %s", target, key.Name())
+		}
+		iavlStores[key] = iavlStore
+	}
+
+	for key, store := range iavlStores {
+		if _, err := store.LoadVersionForOverwriting(target); err != nil {
+			return errors.Wrapf(err, "failed to rollback store %s", key.Name())
+		}
+	}
+
+	if err := rs.pruningManager.RollbackHeights(target); err != nil {
+		return err
+	}
+
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+	for version := target + 1; version <= latest; version++ {
+		if err := batch.Delete([]byte(fmt.Sprintf(commitInfoKeyFmt, version))); err != nil {
+			return err
+		}
+	}
+	flushLatestVersion(batch, target)
+	if err := batch.WriteSync(); err != nil {
+		return err
 	}
 
-	rs.db.Set([]byte(latestVersionKey), bz)
-	return current
+	return rs.LoadLatestVersion()
 }
 
 func (rs *Store) flushMetadata(db dbm.DB, version int64, cInfo *types.CommitInfo) {
@@ -508,6 +508,32 @@ func TestMultiStore_Pruning(t *testing.T) {
 	}
 }
 
+func TestMultiStore_AsyncPruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ms.SetAsyncPruning(1)
+	for i := 0; i < 10; i++ {
+		ms.Commit()
+	}
+	ms.StopAsyncPruning()
+	require.Zero(t, ms.PruningLag())
+
+	// the stores are pruned in the commit once the background pruning stopped
+	lastCommitInfo := ms.Commit()
+	require.Equal(t, int64(11), lastCommitInfo.Version)
+
+	for v := int64(1); v <= 8; v++ {
+		err := ms.LoadVersion(v)
+		require.Error(t, err, "expected error when loading pruned height: %d", v)
+	}
+	for v := int64(9); v <= 11; v++ {
+		err := ms.LoadVersion(v)
+		require.NoError(t, err, "expected no error when loading height: %d", v)
+	}
+}
+
 func TestMultiStore_Pruning_SameHeightsTwice(t *testing.T) {
 	const (
 		numVersions int64  = 10
@@ -608,6 +634,59 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 	}
 }
 
+func TestMultiStore_RollbackToVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	commitIDs := make([]types.CommitID, 0, 5)
+	for i := 1; i <= 5; i++ {
+		ms.GetKVStore(testStoreKey1).Set([]byte("key"), []byte(fmt.Sprintf("value%d", i)))
+		commitIDs = append(commitIDs, ms.Commit())
+	}
+
+	require.Error(t, ms.RollbackToVersion(0))
+	require.Error(t, ms.RollbackToVersion(6))
+
+	require.NoError(t, ms.RollbackToVersion(3))
+	checkStore(t, ms, commitIDs[2], ms.LastCommitID())
+	require.Equal(t, []byte("value3"), ms.GetKVStore(testStoreKey1).Get([]byte("key")))
+
+	// the rolled back versions are overwritten
+	ms.GetKVStore(testStoreKey1).Set([]byte("key"), []byte("overwritten"))
+	commitID := ms.Commit()
+	require.Equal(t, int64(4), commitID.Version)
+	require.NotEqual(t, commitIDs[3], commitID)
+
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+	checkStore(t, ms, commitID, ms.LastCommitID())
+	require.Equal(t, []byte("overwritten"), ms.GetKVStore(testStoreKey1).Get([]byte("key")))
+	require.Error(t, ms.LoadVersion(5))
+}
+
+func TestMultiStore_RollbackToVersion_Pruned(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+	require.NoError(t, ms.LoadLatestVersion())
+	for i := 0; i < 10; i++ {
+		ms.Commit()
+	}
+
+	// unloaded stores can't be rolled back
+	require.Error(t, newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1)).RollbackToVersion(9))
+
+	err := ms.RollbackToVersion(5)
+	require.ErrorContains(t, err, "height 5 is not retained")
+	require.Equal(t, int64(10), ms.LastCommitID().Version)
+
+	require.NoError(t, ms.RollbackToVersion(8))
+	require.Equal(t, int64(8), ms.LastCommitID().Version)
+	ms.Commit()
+	ms.Commit()
+	require.Equal(t, int64(10), ms.LastCommitID().Version)
+}
+
 func TestSetInitialVersion(t *testing.T) {
 	db := dbm.NewMemDB()
 	multi := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
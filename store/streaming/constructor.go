@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/postgres"
 	"github.com/cosmos/cosmos-sdk/store/types"
 
 	"github.com/spf13/cast"
@@ -23,6 +24,7 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	Postgres
 	// add more in the future
 )
 
@@ -31,6 +33,8 @@ func ServiceTypeFromString(name string) ServiceType {
 	switch strings.ToLower(name) {
 	case "file", "f":
 		return File
+	case "postgres", "pg":
+		return Postgres
 	default:
 		return Unknown
 	}
@@ -41,6 +45,8 @@ func (sst ServiceType) String() string {
 	switch sst {
 	case File:
 		return "file"
+	case Postgres:
+		return "postgres"
 	default:
 		return "unknown"
 	}
@@ -48,7 +54,8 @@ func (sst ServiceType) String() string {
 
 // ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to streaming.ServiceConstructors
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
-	File: NewFileStreamingService,
+	File:     NewFileStreamingService,
+	Postgres: NewPostgresStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding to the provided name
@@ -70,6 +77,20 @@ func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey,
 	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller)
 }
 
+// NewPostgresStreamingService is the streaming.ServiceConstructor function for creating a PostgresStreamingService
+func NewPostgresStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
+	dsn := cast.ToString(opts.Get("streamers.postgres.dsn"))
+	if dsn == "" {
+		return nil, fmt.Errorf("streamers.postgres.dsn is required by the postgres streaming service")
+	}
+	// the txs are decoded and their messages encoded to JSON using the interface registry of the codec
+	cdc, ok := marshaller.(codec.ProtoCodecMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("the postgres streaming service requires a protobuf codec, got %T", marshaller)
+	}
+	return postgres.NewStreamingService(dsn, keys, cdc)
+}
+
 // LoadStreamingServices is a function for loading StreamingServices onto the BaseApp using the provided AppOptions, codec, and keys
 // It returns the WaitGroup and quit channel used to synchronize with the streaming services and any error that occurs during the setup
 func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions, appCodec codec.BinaryCodec, keys map[string]*types.KVStoreKey) ([]baseapp.StreamingService, *sync.WaitGroup, error) {
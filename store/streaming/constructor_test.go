@@ -41,3 +41,12 @@ func TestStreamingServiceConstructor(t *testing.T) {
 		require.True(t, ok)
 	}
 }
+
+func TestPostgresStreamingServiceConstructor(t *testing.T) {
+	constructor, err := NewServiceConstructor("postgres")
+	require.Nil(t, err)
+
+	// the data source name is required
+	_, err = constructor(mockOptions, mockKeys, testMarshaller)
+	require.Error(t, err)
+}
@@ -0,0 +1,104 @@
+package postgres
+
+// schema is the PostgreSQL schema the StreamingService writes to. It is created
+// if it does not exist when the service is created.
+//
+// The rows of the events, balance_changes and state_changes tables have a stage,
+// one of begin_block, tx and end_block, and the index of their tx in the block
+// if their stage is tx, NULL otherwise.
+const schema = `
+CREATE TABLE IF NOT EXISTS blocks (
+	height   BIGINT PRIMARY KEY,
+	hash     BYTEA NOT NULL,
+	chain_id TEXT NOT NULL,
+	time     TIMESTAMPTZ NOT NULL,
+	proposer BYTEA NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS txs (
+	height     BIGINT NOT NULL,
+	tx_index   BIGINT NOT NULL,
+	hash       TEXT NOT NULL,
+	raw        BYTEA NOT NULL,
+	code       BIGINT NOT NULL,
+	codespace  TEXT NOT NULL,
+	log        TEXT NOT NULL,
+	gas_wanted BIGINT NOT NULL,
+	gas_used   BIGINT NOT NULL,
+	memo       TEXT NOT NULL,
+	fee        TEXT NOT NULL,
+	PRIMARY KEY (height, tx_index)
+);
+
+CREATE INDEX IF NOT EXISTS txs_hash ON txs (hash);
+
+CREATE TABLE IF NOT EXISTS messages (
+	height    BIGINT NOT NULL,
+	tx_index  BIGINT NOT NULL,
+	msg_index BIGINT NOT NULL,
+	type_url  TEXT NOT NULL,
+	body      JSONB NOT NULL,
+	PRIMARY KEY (height, tx_index, msg_index)
+);
+
+CREATE INDEX IF NOT EXISTS messages_type_url ON messages (type_url);
+
+CREATE TABLE IF NOT EXISTS events (
+	id          BIGSERIAL PRIMARY KEY,
+	height      BIGINT NOT NULL,
+	stage       TEXT NOT NULL,
+	tx_index    BIGINT,
+	event_index BIGINT NOT NULL,
+	type        TEXT NOT NULL,
+	attributes  JSONB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS events_height ON events (height);
+CREATE INDEX IF NOT EXISTS events_type ON events (type);
+
+CREATE TABLE IF NOT EXISTS balance_changes (
+	id       BIGSERIAL PRIMARY KEY,
+	height   BIGINT NOT NULL,
+	stage    TEXT NOT NULL,
+	tx_index BIGINT,
+	address  TEXT NOT NULL,
+	denom    TEXT NOT NULL,
+	amount   NUMERIC NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS balance_changes_height ON balance_changes (height);
+CREATE INDEX IF NOT EXISTS balance_changes_address ON balance_changes (address, denom);
+
+CREATE TABLE IF NOT EXISTS state_changes (
+	id        BIGSERIAL PRIMARY KEY,
+	height    BIGINT NOT NULL,
+	stage     TEXT NOT NULL,
+	tx_index  BIGINT,
+	store_key TEXT NOT NULL,
+	key       BYTEA NOT NULL,
+	value     BYTEA,
+	deleted   BOOLEAN NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS state_changes_height ON state_changes (height);
+`
+
+// tables are the tables of the schema, the rows of a block being deleted from
+// them before it is written so that a block replayed after a crash is not
+// written twice.
+var tables = []string{"blocks", "txs", "messages", "events", "balance_changes", "state_changes"}
+
+const (
+	insertBlock = `INSERT INTO blocks (height, hash, chain_id, time, proposer) VALUES ($1, $2, $3, $4, $5)`
+
+	insertTx = `INSERT INTO txs (height, tx_index, hash, raw, code, codespace, log, gas_wanted, gas_used, memo, fee)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+	insertMessage = `INSERT INTO messages (height, tx_index, msg_index, type_url, body) VALUES ($1, $2, $3, $4, $5)`
+
+	insertEvent = `INSERT INTO events (height, stage, tx_index, event_index, type, attributes) VALUES ($1, $2, $3, $4, $5, $6)`
+
+	insertBalanceChange = `INSERT INTO balance_changes (height, stage, tx_index, address, denom, amount) VALUES ($1, $2, $3, $4, $5, $6)`
+
+	insertStateChange = `INSERT INTO state_changes (height, stage, tx_index, store_key, key, value, deleted) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+)
@@ -0,0 +1,382 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	// registers the "postgres" database/sql driver
+	_ "github.com/lib/pq"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// stages of the ABCI message processing the events and state changes occur in
+const (
+	stageBeginBlock = "begin_block"
+	stageTx         = "tx"
+	stageEndBlock   = "end_block"
+)
+
+// StreamingService is a concrete implementation of StreamingService that indexes the blocks,
+// decoded txs and messages, events, balance changes and state changes into a PostgreSQL database.
+// The data of a block is written in a single database transaction when the block ends
+type StreamingService struct {
+	db           *sql.DB                                  // the database the blocks are written to
+	listeners    map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	codec        codec.ProtoCodecMarshaler                // codec used to encode the messages to JSON
+	txDecoder    sdk.TxDecoder                            // decoder of the delivered txs
+	stateChanges []stateChange                            // the state changes of the current stage, in the order they are received
+	block        *block                                   // the data of the current block
+	lock         *sync.Mutex                              // mutex for the state changes and the current block
+	streaming    bool                                     // whether Stream has been called
+}
+
+// block is the data of a block written to the database.
+type block struct {
+	height         int64
+	hash           []byte
+	chainID        string
+	time           time.Time
+	proposer       []byte
+	txs            []txRecord
+	events         []eventRecord
+	balanceChanges []balanceChange
+	stateChanges   []stateChange
+}
+
+type txRecord struct {
+	index     int64
+	hash      string
+	raw       []byte
+	code      uint32
+	codespace string
+	log       string
+	gasWanted int64
+	gasUsed   int64
+	memo      string
+	fee       string
+	msgs      []msgRecord
+}
+
+type msgRecord struct {
+	typeURL string
+	body    []byte
+}
+
+type eventRecord struct {
+	stage      string
+	txIndex    sql.NullInt64
+	index      int64
+	eventType  string
+	attributes []byte
+}
+
+type eventAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type balanceChange struct {
+	stage   string
+	txIndex sql.NullInt64
+	address string
+	denom   string
+	amount  string
+}
+
+type stateChange struct {
+	stage    string
+	txIndex  sql.NullInt64
+	storeKey string
+	key      []byte
+	value    []byte
+	delete   bool
+}
+
+// execer executes the statements writing a block, implemented by *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// NewStreamingService creates a new StreamingService writing to the PostgreSQL database at the provided dsn
+// the state changes of the provided storeKeys, creating the schema if it does not exist
+func NewStreamingService(dsn string, storeKeys []types.StoreKey, c codec.ProtoCodecMarshaler) (*StreamingService, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	// create the schema here so that we catch a database we cannot write to at initialization
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create the schema: %w", err)
+	}
+	pss := newStreamingService(storeKeys, c)
+	pss.db = db
+	return pss, nil
+}
+
+func newStreamingService(storeKeys []types.StoreKey, c codec.ProtoCodecMarshaler) *StreamingService {
+	pss := &StreamingService{
+		codec:     c,
+		txDecoder: authtx.DefaultTxDecoder(c),
+		lock:      new(sync.Mutex),
+	}
+	pss.listeners = make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	// in this case, we are using the same listener for each Store
+	for _, key := range storeKeys {
+		pss.listeners[key] = append(pss.listeners[key], pss)
+	}
+	return pss
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the StreamingService's underlying WriteListeners
+// Use for registering the underlying WriteListeners with the BaseApp
+func (pss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return pss.listeners
+}
+
+// OnWrite satisfies the types.WriteListener interface
+// It caches the state change until the end of the current stage
+func (pss *StreamingService) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	pss.lock.Lock()
+	defer pss.lock.Unlock()
+	pss.stateChanges = append(pss.stateChanges, stateChange{
+		storeKey: storeKey.Name(),
+		key:      key,
+		value:    value,
+		delete:   delete,
+	})
+	return nil
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It starts a new block with the received BeginBlock request, events and the resulting state changes
+func (pss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	pss.lock.Lock()
+	defer pss.lock.Unlock()
+	pss.block = &block{
+		height:   req.Header.Height,
+		hash:     req.Hash,
+		chainID:  req.Header.ChainID,
+		time:     req.Header.Time,
+		proposer: req.Header.ProposerAddress,
+	}
+	return pss.addStage(stageBeginBlock, sql.NullInt64{}, res.Events)
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It adds the received tx, decoded if possible, its events and the resulting state changes to the current block
+func (pss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	pss.lock.Lock()
+	defer pss.lock.Unlock()
+	if pss.block == nil {
+		pss.stateChanges = nil
+		return errors.New("tx delivered outside of a block")
+	}
+	txIndex := int64(len(pss.block.txs))
+	record := txRecord{
+		index:     txIndex,
+		hash:      fmt.Sprintf("%X", tmtypes.Tx(req.Tx).Hash()),
+		raw:       req.Tx,
+		code:      res.Code,
+		codespace: res.Codespace,
+		log:       res.Log,
+		gasWanted: res.GasWanted,
+		gasUsed:   res.GasUsed,
+	}
+	// txs which cannot be decoded are still indexed, without their messages
+	if tx, err := pss.txDecoder(req.Tx); err == nil {
+		if memoTx, ok := tx.(sdk.TxWithMemo); ok {
+			record.memo = memoTx.GetMemo()
+		}
+		if feeTx, ok := tx.(sdk.FeeTx); ok {
+			record.fee = feeTx.GetFee().String()
+		}
+		for _, msg := range tx.GetMsgs() {
+			body, err := pss.codec.MarshalJSON(msg)
+			if err != nil {
+				return err
+			}
+			record.msgs = append(record.msgs, msgRecord{typeURL: sdk.MsgTypeURL(msg), body: body})
+		}
+	}
+	pss.block.txs = append(pss.block.txs, record)
+	return pss.addStage(stageTx, sql.NullInt64{Int64: txIndex, Valid: true}, res.Events)
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It adds the received EndBlock events and the resulting state changes to the current block
+// and writes the block to the database
+func (pss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	pss.lock.Lock()
+	defer pss.lock.Unlock()
+	if pss.block == nil {
+		pss.stateChanges = nil
+		return errors.New("block ended before it began")
+	}
+	b := pss.block
+	pss.block = nil
+	if err := pss.addStage(stageEndBlock, sql.NullInt64{}, res.Events); err != nil {
+		return err
+	}
+	dbTx, err := pss.db.BeginTx(ctx.Context(), nil)
+	if err != nil {
+		return err
+	}
+	if err := writeBlock(ctx.Context(), dbTx, b); err != nil {
+		dbTx.Rollback()
+		return fmt.Errorf("failed to write block %d: %w", b.height, err)
+	}
+	return dbTx.Commit()
+}
+
+// addStage adds the events of a stage, the balance changes they record, and the
+// cached state changes to the current block, resetting the cache.
+func (pss *StreamingService) addStage(stage string, txIndex sql.NullInt64, events []abci.Event) error {
+	for _, change := range pss.stateChanges {
+		change.stage, change.txIndex = stage, txIndex
+		pss.block.stateChanges = append(pss.block.stateChanges, change)
+	}
+	pss.stateChanges = nil
+
+	for i, event := range events {
+		attributes := make([]eventAttribute, len(event.Attributes))
+		for j, attr := range event.Attributes {
+			attributes[j] = eventAttribute{Key: attr.Key, Value: attr.Value}
+		}
+		bz, err := json.Marshal(attributes)
+		if err != nil {
+			return err
+		}
+		pss.block.events = append(pss.block.events, eventRecord{
+			stage:      stage,
+			txIndex:    txIndex,
+			index:      int64(i),
+			eventType:  event.Type,
+			attributes: bz,
+		})
+
+		changes, err := balanceChanges(event)
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			change.stage, change.txIndex = stage, txIndex
+			pss.block.balanceChanges = append(pss.block.balanceChanges, change)
+		}
+	}
+	return nil
+}
+
+// balanceChanges returns the balance changes recorded by a bank event, one for
+// each denom, and none if the event is not a coin_spent or coin_received one.
+// The burns are not balance changes as the burned coins are spent first.
+func balanceChanges(event abci.Event) ([]balanceChange, error) {
+	var accountKey, sign string
+	switch event.Type {
+	case banktypes.EventTypeCoinSpent:
+		accountKey, sign = banktypes.AttributeKeySpender, "-"
+	case banktypes.EventTypeCoinReceived:
+		accountKey = banktypes.AttributeKeyReceiver
+	default:
+		return nil, nil
+	}
+
+	var address, amount string
+	for _, attr := range event.Attributes {
+		switch attr.Key {
+		case accountKey:
+			address = attr.Value
+		case sdk.AttributeKeyAmount:
+			amount = attr.Value
+		}
+	}
+	coins, err := sdk.ParseCoinsNormalized(amount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s event amount: %w", event.Type, err)
+	}
+
+	changes := make([]balanceChange, len(coins))
+	for i, coin := range coins {
+		changes[i] = balanceChange{address: address, denom: coin.Denom, amount: sign + coin.Amount.String()}
+	}
+	return changes, nil
+}
+
+// writeBlock writes the block, replacing the rows previously written at its height if any.
+func writeBlock(ctx context.Context, db execer, b *block) error {
+	for _, table := range tables {
+		if _, err := db.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE height = $1", table), b.height); err != nil {
+			return err
+		}
+	}
+	if _, err := db.ExecContext(ctx, insertBlock, b.height, b.hash, b.chainID, b.time, b.proposer); err != nil {
+		return err
+	}
+	for _, tx := range b.txs {
+		if _, err := db.ExecContext(ctx, insertTx, b.height, tx.index, tx.hash, tx.raw, int64(tx.code), tx.codespace,
+			tx.log, tx.gasWanted, tx.gasUsed, tx.memo, tx.fee); err != nil {
+			return err
+		}
+		for i, msg := range tx.msgs {
+			if _, err := db.ExecContext(ctx, insertMessage, b.height, tx.index, int64(i), msg.typeURL, string(msg.body)); err != nil {
+				return err
+			}
+		}
+	}
+	for _, event := range b.events {
+		if _, err := db.ExecContext(ctx, insertEvent, b.height, event.stage, event.txIndex, event.index,
+			event.eventType, string(event.attributes)); err != nil {
+			return err
+		}
+	}
+	for _, change := range b.balanceChanges {
+		if _, err := db.ExecContext(ctx, insertBalanceChange, b.height, change.stage, change.txIndex,
+			change.address, change.denom, change.amount); err != nil {
+			return err
+		}
+	}
+	for _, change := range b.stateChanges {
+		if _, err := db.ExecContext(ctx, insertStateChange, b.height, change.stage, change.txIndex,
+			change.storeKey, change.key, change.value, change.delete); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// The blocks are written synchronously with the message processing so there is no loop to spin up
+// returns an error if it is called twice
+func (pss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	pss.lock.Lock()
+	defer pss.lock.Unlock()
+	if pss.streaming {
+		return errors.New("`Stream` has already been called. The stream needs to be closed before it can be started again")
+	}
+	pss.streaming = true
+	return nil
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+func (pss *StreamingService) Close() error {
+	pss.lock.Lock()
+	pss.streaming = false
+	pss.lock.Unlock()
+	return pss.db.Close()
+}
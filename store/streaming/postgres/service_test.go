@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type execution struct {
+	query string
+	args  []interface{}
+}
+
+// recordingExecer records the statements executed instead of executing them.
+type recordingExecer struct {
+	executions []execution
+}
+
+func (e *recordingExecer) ExecContext(_ context.Context, query string, args ...interface{}) (sql.Result, error) {
+	e.executions = append(e.executions, execution{query: query, args: args})
+	return nil, nil
+}
+
+func (e *recordingExecer) inserted(table string) [][]interface{} {
+	var rows [][]interface{}
+	for _, execution := range e.executions {
+		if strings.HasPrefix(execution.query, "INSERT INTO "+table+" ") {
+			rows = append(rows, execution.args)
+		}
+	}
+	return rows
+}
+
+func testTx(t *testing.T, cdc codec.ProtoCodecMarshaler) ([]byte, *testdata.TestMsg) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr)
+	txBuilder := authtx.NewTxConfig(cdc, authtx.DefaultSignModes).NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	txBuilder.SetMemo("memo")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	txBytes, err := authtx.DefaultTxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	return txBytes, msg
+}
+
+func TestStreamingService(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	storeKey := sdk.NewKVStoreKey("bank")
+	pss := newStreamingService([]types.StoreKey{storeKey}, cdc)
+	require.Equal(t, map[types.StoreKey][]types.WriteListener{storeKey: {pss}}, pss.Listeners())
+
+	_, _, spender := testdata.KeyTestPubAddr()
+	_, _, receiver := testdata.KeyTestPubAddr()
+	blockTime := time.Unix(1000, 0).UTC()
+	txBytes, msg := testTx(t, cdc)
+
+	// begin block
+	require.NoError(t, pss.OnWrite(storeKey, []byte("k1"), []byte("v1"), false))
+	require.NoError(t, pss.ListenBeginBlock(sdk.Context{}, abci.RequestBeginBlock{
+		Hash:   []byte{1, 2, 3},
+		Header: tmproto.Header{Height: 7, ChainID: "test-chain", Time: blockTime, ProposerAddress: []byte{4, 5}},
+	}, abci.ResponseBeginBlock{Events: []abci.Event{{Type: "mint"}}}))
+
+	// a decodable tx and an undecodable one
+	require.NoError(t, pss.OnWrite(storeKey, []byte("k2"), nil, true))
+	require.NoError(t, pss.ListenDeliverTx(sdk.Context{}, abci.RequestDeliverTx{Tx: txBytes}, abci.ResponseDeliverTx{
+		GasWanted: 200,
+		GasUsed:   100,
+		Events: []abci.Event{
+			abci.Event(banktypes.NewCoinSpentEvent(spender, sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 3)))),
+			abci.Event(banktypes.NewCoinReceivedEvent(receiver, sdk.NewCoins(sdk.NewInt64Coin("atom", 5)))),
+		},
+	}))
+	require.NoError(t, pss.ListenDeliverTx(sdk.Context{}, abci.RequestDeliverTx{Tx: []byte("invalid")}, abci.ResponseDeliverTx{
+		Code:      2,
+		Codespace: "sdk",
+		Log:       "tx parse error",
+	}))
+
+	b := pss.block
+	require.NotNil(t, b)
+	db := &recordingExecer{}
+	require.NoError(t, writeBlock(context.Background(), db, b))
+
+	for i, table := range tables {
+		require.Equal(t, execution{query: fmt.Sprintf("DELETE FROM %s WHERE height = $1", table), args: []interface{}{int64(7)}}, db.executions[i])
+	}
+
+	require.Equal(t, [][]interface{}{{int64(7), []byte{1, 2, 3}, "test-chain", blockTime, []byte{4, 5}}}, db.inserted("blocks"))
+
+	msgJSON, err := cdc.MarshalJSON(msg)
+	require.NoError(t, err)
+	require.Equal(t, [][]interface{}{
+		{int64(7), int64(0), fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash()), txBytes, int64(0), "", "", int64(200), int64(100), "memo", "10stake"},
+		{int64(7), int64(1), fmt.Sprintf("%X", tmtypes.Tx([]byte("invalid")).Hash()), []byte("invalid"), int64(2), "sdk", "tx parse error", int64(0), int64(0), "", ""},
+	}, db.inserted("txs"))
+	require.Equal(t, [][]interface{}{{int64(7), int64(0), int64(0), sdk.MsgTypeURL(msg), string(msgJSON)}}, db.inserted("messages"))
+
+	tx0 := sql.NullInt64{Int64: 0, Valid: true}
+	events := db.inserted("events")
+	require.Len(t, events, 3)
+	require.Equal(t, []interface{}{int64(7), stageBeginBlock, sql.NullInt64{}, int64(0), "mint", "[]"}, events[0])
+	require.Equal(t, []interface{}{int64(7), stageTx, tx0, int64(1), banktypes.EventTypeCoinReceived,
+		fmt.Sprintf(`[{"key":"receiver","value":"%s"},{"key":"amount","value":"5atom"}]`, receiver)}, events[2])
+
+	require.Equal(t, [][]interface{}{
+		{int64(7), stageTx, tx0, spender.String(), "atom", "-5"},
+		{int64(7), stageTx, tx0, spender.String(), "stake", "-3"},
+		{int64(7), stageTx, tx0, receiver.String(), "atom", "5"},
+	}, db.inserted("balance_changes"))
+
+	require.Equal(t, [][]interface{}{
+		{int64(7), stageBeginBlock, sql.NullInt64{}, "bank", []byte("k1"), []byte("v1"), false},
+		{int64(7), stageTx, tx0, "bank", []byte("k2"), []byte(nil), true},
+	}, db.inserted("state_changes"))
+}
+
+func TestStreamingService_TxOutsideOfBlock(t *testing.T) {
+	pss := newStreamingService(nil, codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+	require.Error(t, pss.ListenDeliverTx(sdk.Context{}, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{}))
+	require.Error(t, pss.ListenEndBlock(sdk.Context{}, abci.RequestEndBlock{}, abci.ResponseEndBlock{}))
+}
+
+func TestBalanceChanges(t *testing.T) {
+	changes, err := balanceChanges(abci.Event{Type: banktypes.EventTypeCoinBurn})
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	_, err = balanceChanges(abci.Event{
+		Type:       banktypes.EventTypeCoinSpent,
+		Attributes: []abci.EventAttribute{{Key: sdk.AttributeKeyAmount, Value: "invalid!"}},
+	})
+	require.Error(t, err)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"sync"
 
 	ics23 "github.com/confio/ics23/go"
 	"github.com/tendermint/tendermint/crypto/merkle"
@@ -18,6 +19,44 @@ const (
 	ProofOpSMTCommitment          = "ics23:smt"
 )
 
+var (
+	proofOpDecodersMtx sync.RWMutex
+	proofOpDecoders    = make(map[string]merkle.OpDecoder)
+)
+
+// RegisterProofOpDecoder registers the decoder of the proof ops of type opType
+// produced by the queries of a custom store type, so that the default proof
+// runtimes of the multistores verify them end to end. It panics if opType is
+// one of the ics23 commitment types or is already registered.
+func RegisterProofOpDecoder(opType string, decoder merkle.OpDecoder) {
+	switch opType {
+	case ProofOpIAVLCommitment, ProofOpSimpleMerkleCommitment, ProofOpSMTCommitment:
+		panic(fmt.Sprintf("proof op type %s is reserved", opType))
+	}
+
+	proofOpDecodersMtx.Lock()
+	defer proofOpDecodersMtx.Unlock()
+
+	if _, ok := proofOpDecoders[opType]; ok {
+		panic(fmt.Sprintf("proof op decoder for type %s already registered", opType))
+	}
+	proofOpDecoders[opType] = decoder
+}
+
+// ProofOpDecoders returns the proof op decoders registered with
+// RegisterProofOpDecoder, by proof op type.
+func ProofOpDecoders() map[string]merkle.OpDecoder {
+	proofOpDecodersMtx.RLock()
+	defer proofOpDecodersMtx.RUnlock()
+
+	decoders := make(map[string]merkle.OpDecoder, len(proofOpDecoders))
+	for opType, decoder := range proofOpDecoders {
+		decoders[opType] = decoder
+	}
+
+	return decoders
+}
+
 // CommitmentOp implements merkle.ProofOperator by wrapping an ics23 CommitmentProof
 // It also contains a Key field to determine which key the proof is proving.
 // NOTE: CommitmentProof currently can either be ExistenceProof or NonexistenceProof
@@ -91,6 +130,29 @@ func CommitmentOpDecoder(pop tmmerkle.ProofOp) (merkle.ProofOperator, error) {
 	return op, nil
 }
 
+// NewCommitmentOpDecoder returns a decoder of the CommitmentOps of type opType
+// whose proofs follow spec, for the custom store types proving their entries
+// with ics23 commitment proofs.
+func NewCommitmentOpDecoder(opType string, spec *ics23.ProofSpec) merkle.OpDecoder {
+	return func(pop tmmerkle.ProofOp) (merkle.ProofOperator, error) {
+		if pop.Type != opType {
+			return nil, sdkerrors.Wrapf(ErrInvalidProof, "unexpected ProofOp.Type; got %s, want %s", pop.Type, opType)
+		}
+
+		proof := &ics23.CommitmentProof{}
+		if err := proof.Unmarshal(pop.Data); err != nil {
+			return nil, err
+		}
+
+		return CommitmentOp{
+			Type:  pop.Type,
+			Key:   pop.Key,
+			Spec:  spec,
+			Proof: proof,
+		}, nil
+	}
+}
+
 func (op CommitmentOp) GetKey() []byte {
 	return op.Key
 }
@@ -179,6 +179,11 @@ type CommitMultiStore interface {
 	// StoreKeys to CommitKVStores.
 	SetInterBlockCache(MultiStorePersistentCache)
 
+	// RollbackToVersion deletes the versions after the given version and sets it as
+	// the latest version, so that the next commit overwrites the version after it.
+	// The version must be retained by all the stores.
+	RollbackToVersion(version int64) error
+
 	// SetInitialVersion sets the initial version of the IAVL tree. It is used when
 	// starting a new chain at an arbitrary height.
 	SetInitialVersion(version int64) error
@@ -226,6 +231,15 @@ type KVStore interface {
 	ReverseIterator(start, end []byte) Iterator
 }
 
+// KeyCountEstimator is implemented by the KVStores able to estimate the number
+// of keys in a domain without iterating over it.
+type KeyCountEstimator interface {
+	// EstimateKeyCount returns the estimated number of keys in the domain
+	// [start, end), a nil start or end meaning the beginning or the end of the
+	// store, and false if the store cannot estimate it.
+	EstimateKeyCount(start, end []byte) (count uint64, ok bool)
+}
+
 // Iterator is an alias db's Iterator for convenience.
 type Iterator = dbm.Iterator
 
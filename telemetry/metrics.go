@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/armon/go-metrics"
@@ -112,6 +113,7 @@ func New(cfg Config) (*Metrics, error) {
 	if _, err := metrics.NewGlobal(metricsConf, fanout); err != nil {
 		return nil, err
 	}
+	atomic.StoreInt32(&enabled, 1)
 
 	return m, nil
 }
@@ -0,0 +1,115 @@
+package telemetry
+
+import (
+	"context"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the name of the tracer creating the spans of the SDK.
+const tracerName = "github.com/cosmos/cosmos-sdk"
+
+// Common span attribute keys.
+const (
+	AttributeKeyHeight  = "block.height"
+	AttributeKeyTxHash  = "tx.hash"
+	AttributeKeyMsgType = "msg.type"
+	AttributeKeyGasUsed = "gas.used"
+)
+
+// tracingEnabled is set to 1 once a tracer provider is registered.
+var tracingEnabled int32
+
+// TracingConfig defines the configuration options for OpenTelemetry tracing.
+type TracingConfig struct {
+	// Enabled enables the export of traces to an OTLP collector.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Endpoint is the address of the OTLP gRPC collector, e.g. Jaeger or Tempo.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Insecure disables TLS for the connection to the collector.
+	Insecure bool `mapstructure:"insecure"`
+
+	// ServiceName is the service name the traces are reported under.
+	ServiceName string `mapstructure:"service-name"`
+
+	// SampleRate is the fraction of the traces which are sampled, between 0 and 1.
+	SampleRate float64 `mapstructure:"sample-rate"`
+}
+
+// IsTracingEnabled returns true if a tracer provider is registered, i.e. spans
+// are recorded.
+func IsTracingEnabled() bool {
+	return atomic.LoadInt32(&tracingEnabled) == 1
+}
+
+// InitTracing registers a tracer provider exporting spans to the OTLP collector
+// configured, if tracing is enabled. The returned function flushes and stops the
+// export of spans, it must be called on shutdown.
+func InitTracing(ctx context.Context, cfg TracingConfig) (func(context.Context) error, error) {
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(cfg.ServiceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRate))),
+	)
+	SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+// SetTracerProvider registers tp as the global tracer provider and enables the
+// spans of the SDK. A nil tp disables them.
+func SetTracerProvider(tp trace.TracerProvider) {
+	if tp == nil {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		atomic.StoreInt32(&tracingEnabled, 0)
+		return
+	}
+
+	otel.SetTracerProvider(tp)
+	atomic.StoreInt32(&tracingEnabled, 1)
+}
+
+// StartSpan starts a span with the given name and attributes, child of the span
+// of ctx if any. If tracing is disabled, ctx and its span are returned as is, so
+// that ending the span is a no-op.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !IsTracingEnabled() {
+		return ctx, trace.SpanFromContext(ctx)
+	}
+
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan ends span, marking it as failed with err if not nil.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,52 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestInitTracing_Disabled(t *testing.T) {
+	shutdown, err := InitTracing(context.Background(), TracingConfig{Enabled: false})
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+	require.False(t, IsTracingEnabled())
+
+	ctx, span := StartSpan(context.Background(), "test")
+	require.Equal(t, context.Background(), ctx)
+	require.False(t, span.SpanContext().IsValid())
+}
+
+func TestStartSpan(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+	t.Cleanup(func() { SetTracerProvider(nil) })
+	require.True(t, IsTracingEnabled())
+
+	ctx, parent := StartSpan(context.Background(), "parent", attribute.Int64(AttributeKeyHeight, 1))
+	_, child := StartSpan(ctx, "child")
+	EndSpan(child, errors.New("failure"))
+	EndSpan(parent, nil)
+
+	spans := sr.Ended()
+	require.Len(t, spans, 2)
+	require.Equal(t, "child", spans[0].Name())
+	require.Equal(t, codes.Error, spans[0].Status().Code)
+	require.Equal(t, "failure", spans[0].Status().Description)
+	require.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	require.Equal(t, "parent", spans[1].Name())
+	require.Equal(t, codes.Unset, spans[1].Status().Code)
+	require.Equal(t, []attribute.KeyValue{attribute.Int64(AttributeKeyHeight, 1)}, spans[1].Attributes())
+
+	SetTracerProvider(nil)
+	require.False(t, IsTracingEnabled())
+	_, span := StartSpan(trace.ContextWithSpan(context.Background(), parent), "disabled")
+	require.Equal(t, parent, span)
+}
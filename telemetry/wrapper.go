@@ -1,6 +1,7 @@
 package telemetry
 
 import (
+	"sync/atomic"
 	"time"
 
 	"github.com/armon/go-metrics"
@@ -10,9 +11,32 @@ import (
 const (
 	MetricKeyBeginBlocker = "begin_blocker"
 	MetricKeyEndBlocker   = "end_blocker"
-	MetricLabelNameModule = "module"
+	MetricKeyStore        = "store"
+	MetricKeyTx           = "tx"
+	MetricKeyMsg          = "msg"
+	MetricKeyAnteHandler  = "ante_handler"
+	MetricKeyMempool      = "mempool"
+	MetricKeySnapshot     = "snapshot"
+	MetricKeyBlock        = "block"
+	MetricKeyExecution    = "execution"
+	MetricKeyGas          = "gas"
+
+	MetricLabelNameModule   = "module"
+	MetricLabelNameStore    = "store"
+	MetricLabelNameMsgType  = "msg_type"
+	MetricLabelNameExecMode = "mode"
+	MetricLabelNamePhase    = "phase"
 )
 
+// enabled is set once the global metrics are registered by New.
+var enabled int32
+
+// IsTelemetryEnabled returns true if the application telemetry is enabled. It
+// allows to skip the collection of metrics which are costly to compute.
+func IsTelemetryEnabled() bool {
+	return atomic.LoadInt32(&enabled) == 1
+}
+
 // NewLabel creates a new instance of Label with name and value
 func NewLabel(name, value string) metrics.Label {
 	return metrics.Label{Name: name, Value: value}
@@ -69,3 +93,24 @@ func SetGaugeWithLabels(keys []string, val float32, labels []metrics.Label) {
 func MeasureSince(start time.Time, keys ...string) {
 	metrics.MeasureSinceWithLabels(keys, start.UTC(), globalLabels)
 }
+
+// MeasureSinceWithLabels provides a wrapper functionality for emitting a time
+// measure metric with global labels (if any) along with the provided labels.
+func MeasureSinceWithLabels(keys []string, start time.Time, labels []metrics.Label) {
+	metrics.MeasureSinceWithLabels(keys, start.UTC(), append(labels, globalLabels...))
+}
+
+// MeasureDurationWithLabels provides a wrapper functionality for emitting a
+// time measure metric of an already computed duration, with global labels (if
+// any) along with the provided labels. The duration is emitted in milliseconds
+// like the measures of MeasureSince.
+func MeasureDurationWithLabels(keys []string, d time.Duration, labels []metrics.Label) {
+	metrics.AddSampleWithLabels(keys, float32(d)/float32(time.Millisecond), append(labels, globalLabels...))
+}
+
+// AddSampleWithLabels provides a wrapper functionality for emitting a sample
+// metric, e.g. an amount of gas, with global labels (if any) along with the
+// provided labels.
+func AddSampleWithLabels(keys []string, val float32, labels []metrics.Label) {
+	metrics.AddSampleWithLabels(keys, val, append(labels, globalLabels...))
+}
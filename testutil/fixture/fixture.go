@@ -0,0 +1,224 @@
+/*
+Package fixture synthesizes large, realistic states deterministically from a
+seed, to benchmark the end blockers and queries of the modules at scale.
+
+A state is made of accounts with heavy-tailed balances, validators, and a number
+of delegations of every account, the first validators being self-delegated by
+the accounts of the same index. The same Config always generates the same state:
+
+	cfg := fixture.DefaultConfig()
+	cfg.Accounts, cfg.Validators, cfg.DelegationsPerAccount = 10000, 300, 3
+	app, state := fixture.Setup(b, cfg)
+*/
+package fixture
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Config is the configuration of a generated state.
+type Config struct {
+	// Seed seeds the generation, the same seed generating the same state.
+	Seed int64
+	// Accounts is the number of accounts, which must be at least the number of
+	// validators.
+	Accounts int
+	// Validators is the number of validators, of which the ones with the most
+	// tokens are bonded up to MaxValidators.
+	Validators    int
+	MaxValidators uint32
+	// DelegationsPerAccount is the number of validators each account delegates
+	// to, capped by the number of validators.
+	DelegationsPerAccount int
+	// BondDenom is the denomination of the balances and delegations.
+	BondDenom string
+	// MinBalance and MinDelegation are the smallest amounts of the balances and
+	// the delegations, which follow a Pareto distribution of index ParetoAlpha:
+	// the lower it is, the heavier the tail of the distribution.
+	MinBalance    sdk.Int
+	MinDelegation sdk.Int
+	ParetoAlpha   float64
+}
+
+// DefaultConfig returns a Config of a small state, 1000 accounts delegating to 2
+// of 100 validators.
+func DefaultConfig() Config {
+	return Config{
+		Seed:                  1,
+		Accounts:              1000,
+		Validators:            100,
+		MaxValidators:         stakingtypes.DefaultMaxValidators,
+		DelegationsPerAccount: 2,
+		BondDenom:             sdk.DefaultBondDenom,
+		MinBalance:            sdk.NewInt(1000),
+		MinDelegation:         sdk.DefaultPowerReduction,
+		// the 80-20 rule: 20% of the accounts hold 80% of the tokens
+		ParetoAlpha: 1.16,
+	}
+}
+
+// Validate returns an error if the Config cannot generate a state.
+func (cfg Config) Validate() error {
+	switch {
+	case cfg.Validators <= 0:
+		return fmt.Errorf("the number of validators must be positive: %d", cfg.Validators)
+	case cfg.Accounts < cfg.Validators:
+		return fmt.Errorf("the number of accounts must be at least the number of validators: %d < %d", cfg.Accounts, cfg.Validators)
+	case cfg.MaxValidators == 0:
+		return fmt.Errorf("the maximum number of validators must be positive")
+	case cfg.DelegationsPerAccount < 0:
+		return fmt.Errorf("the number of delegations per account cannot be negative: %d", cfg.DelegationsPerAccount)
+	case cfg.MinBalance.IsNil() || !cfg.MinBalance.IsPositive():
+		return fmt.Errorf("the minimum balance must be positive")
+	case cfg.MinDelegation.IsNil() || !cfg.MinDelegation.IsPositive():
+		return fmt.Errorf("the minimum delegation must be positive")
+	case cfg.ParetoAlpha <= 0:
+		return fmt.Errorf("the Pareto index must be positive: %v", cfg.ParetoAlpha)
+	}
+
+	return sdk.ValidateDenom(cfg.BondDenom)
+}
+
+// Account is a generated account.
+type Account struct {
+	PrivKey cryptotypes.PrivKey
+	Address sdk.AccAddress
+	// Balance is the balance of the account, without its delegated tokens.
+	Balance sdk.Coins
+}
+
+// State is a generated state.
+type State struct {
+	// BondDenom is the denomination of the delegated tokens.
+	BondDenom string
+	Accounts  []Account
+	// Validators are unbonded, their bonding being left to the staking module.
+	Validators  []stakingtypes.Validator
+	Delegations []stakingtypes.Delegation
+	// ConsPrivKeys are the consensus keys of the validators.
+	ConsPrivKeys []cryptotypes.PrivKey
+}
+
+// Generate generates the state of the Config.
+func Generate(cfg Config) (*State, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	rng := rand.New(rand.NewSource(cfg.Seed))
+	state := &State{
+		BondDenom:    cfg.BondDenom,
+		Accounts:     make([]Account, cfg.Accounts),
+		Validators:   make([]stakingtypes.Validator, cfg.Validators),
+		ConsPrivKeys: make([]cryptotypes.PrivKey, cfg.Validators),
+	}
+
+	for i := range state.Accounts {
+		privKey := secp256k1.GenPrivKeyFromSecret(secret(cfg.Seed, "account", i))
+		state.Accounts[i] = Account{
+			PrivKey: privKey,
+			Address: sdk.AccAddress(privKey.PubKey().Address()),
+			Balance: sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, pareto(rng, cfg.MinBalance, cfg.ParetoAlpha))),
+		}
+	}
+
+	for i := range state.Validators {
+		consPrivKey := ed25519.GenPrivKeyFromSecret(secret(cfg.Seed, "validator", i))
+		pkAny, err := codectypes.NewAnyWithValue(consPrivKey.PubKey())
+		if err != nil {
+			return nil, err
+		}
+
+		commission := sdk.NewDecWithPrec(rng.Int63n(21), 2)
+		state.ConsPrivKeys[i] = consPrivKey
+		state.Validators[i] = stakingtypes.Validator{
+			OperatorAddress: sdk.ValAddress(state.Accounts[i].Address).String(),
+			ConsensusPubkey: pkAny,
+			Status:          stakingtypes.Unbonded,
+			Tokens:          sdk.ZeroInt(),
+			DelegatorShares: sdk.ZeroDec(),
+			Description:     stakingtypes.NewDescription(fmt.Sprintf("validator-%d", i), "", "", "", ""),
+			UnbondingTime:   time.Unix(0, 0).UTC(),
+			Commission: stakingtypes.NewCommission(
+				commission, sdk.MaxDec(commission, sdk.NewDecWithPrec(20, 2)), sdk.NewDecWithPrec(1, 2),
+			),
+			MinSelfDelegation: sdk.OneInt(),
+		}
+	}
+
+	delegations := cfg.DelegationsPerAccount
+	if delegations > cfg.Validators {
+		delegations = cfg.Validators
+	}
+	for i, acc := range state.Accounts {
+		for _, v := range pickValidators(rng, i, delegations, cfg.Validators) {
+			amount := pareto(rng, cfg.MinDelegation, cfg.ParetoAlpha)
+			val := &state.Validators[v]
+			val.Tokens = val.Tokens.Add(amount)
+			val.DelegatorShares = val.DelegatorShares.Add(amount.ToDec())
+
+			state.Delegations = append(state.Delegations, stakingtypes.NewDelegation(
+				acc.Address, val.GetOperator(), amount.ToDec(),
+			))
+		}
+	}
+
+	return state, nil
+}
+
+// pickValidators returns the indexes of n distinct validators out of total
+// delegated to by the account of index acc, self-delegating first.
+func pickValidators(rng *rand.Rand, acc, n, total int) []int {
+	picked := make([]int, 0, n)
+	seen := make(map[int]bool, n)
+	if acc < total && n > 0 {
+		picked = append(picked, acc)
+		seen[acc] = true
+	}
+
+	for len(picked) < n {
+		v := rng.Intn(total)
+		if !seen[v] {
+			picked = append(picked, v)
+			seen[v] = true
+		}
+	}
+
+	return picked
+}
+
+// maxParetoFactor caps the samples of the Pareto distribution to a multiple of
+// its minimum, as its variance is infinite for the indexes lower than 2.
+const maxParetoFactor = 1e9
+
+// pareto samples a Pareto distribution of minimum min and index alpha.
+func pareto(rng *rand.Rand, min sdk.Int, alpha float64) sdk.Int {
+	// 1 - Float64() is in (0, 1]
+	factor := math.Pow(1-rng.Float64(), -1/alpha)
+	if factor > maxParetoFactor {
+		factor = maxParetoFactor
+	}
+
+	return min.ToDec().Mul(sdk.MustNewDecFromStr(fmt.Sprintf("%.6f", factor))).TruncateInt()
+}
+
+// secret returns the secret of the key of the given kind and index generated
+// from seed.
+func secret(seed int64, kind string, i int) []byte {
+	bz := make([]byte, 16, 16+len(kind))
+	binary.BigEndian.PutUint64(bz, uint64(seed))
+	binary.BigEndian.PutUint64(bz[8:], uint64(i))
+
+	return append(bz, kind...)
+}
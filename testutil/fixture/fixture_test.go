@@ -0,0 +1,117 @@
+package fixture_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/fixture"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func smallConfig() fixture.Config {
+	cfg := fixture.DefaultConfig()
+	cfg.Accounts, cfg.Validators, cfg.MaxValidators, cfg.DelegationsPerAccount = 50, 10, 4, 3
+	return cfg
+}
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	cfg := smallConfig()
+
+	state1, err := fixture.Generate(cfg)
+	require.NoError(t, err)
+	state2, err := fixture.Generate(cfg)
+	require.NoError(t, err)
+	require.Equal(t, state1, state2)
+
+	cfg.Seed++
+	state3, err := fixture.Generate(cfg)
+	require.NoError(t, err)
+	require.NotEqual(t, state1.Accounts[0].Address, state3.Accounts[0].Address)
+	require.NotEqual(t, state1.Delegations, state3.Delegations)
+}
+
+func TestGenerate(t *testing.T) {
+	cfg := smallConfig()
+	state, err := fixture.Generate(cfg)
+	require.NoError(t, err)
+
+	require.Len(t, state.Accounts, cfg.Accounts)
+	require.Len(t, state.Validators, cfg.Validators)
+	require.Len(t, state.Delegations, cfg.Accounts*cfg.DelegationsPerAccount)
+
+	for _, acc := range state.Accounts {
+		require.True(t, acc.Balance.AmountOf(cfg.BondDenom).GTE(cfg.MinBalance))
+	}
+
+	tokens := make(map[string]sdk.Int)
+	for i, del := range state.Delegations {
+		require.True(t, del.Shares.TruncateInt().GTE(cfg.MinDelegation))
+		if i%cfg.DelegationsPerAccount == 0 && i/cfg.DelegationsPerAccount < cfg.Validators {
+			// the first delegations of the first accounts are self-delegations
+			require.Equal(t, sdk.ValAddress(del.GetDelegatorAddr()).String(), del.ValidatorAddress)
+		}
+
+		if _, ok := tokens[del.ValidatorAddress]; !ok {
+			tokens[del.ValidatorAddress] = sdk.ZeroInt()
+		}
+		tokens[del.ValidatorAddress] = tokens[del.ValidatorAddress].Add(del.Shares.TruncateInt())
+	}
+	for _, val := range state.Validators {
+		require.True(t, tokens[val.OperatorAddress].Equal(val.Tokens), val.OperatorAddress)
+		require.NoError(t, val.Commission.Validate())
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(*fixture.Config)
+		expErr   bool
+	}{
+		{"default", func(*fixture.Config) {}, false},
+		{"no validators", func(cfg *fixture.Config) { cfg.Validators = 0 }, true},
+		{"fewer accounts than validators", func(cfg *fixture.Config) { cfg.Accounts = cfg.Validators - 1 }, true},
+		{"no max validators", func(cfg *fixture.Config) { cfg.MaxValidators = 0 }, true},
+		{"negative delegations", func(cfg *fixture.Config) { cfg.DelegationsPerAccount = -1 }, true},
+		{"zero min balance", func(cfg *fixture.Config) { cfg.MinBalance = sdk.ZeroInt() }, true},
+		{"nil min delegation", func(cfg *fixture.Config) { cfg.MinDelegation = sdk.Int{} }, true},
+		{"zero pareto index", func(cfg *fixture.Config) { cfg.ParetoAlpha = 0 }, true},
+		{"invalid bond denom", func(cfg *fixture.Config) { cfg.BondDenom = "1" }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := fixture.DefaultConfig()
+			tc.malleate(&cfg)
+
+			err := cfg.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSetup(t *testing.T) {
+	cfg := smallConfig()
+	app, state := fixture.Setup(t, cfg)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	require.Len(t, app.StakingKeeper.GetAllValidators(ctx), cfg.Validators)
+	require.Len(t, app.StakingKeeper.GetBondedValidatorsByPower(ctx), int(cfg.MaxValidators))
+	require.Len(t, app.StakingKeeper.GetAllDelegations(ctx), len(state.Delegations))
+
+	for _, acc := range state.Accounts {
+		require.Equal(t, acc.Balance, app.BankKeeper.GetAllBalances(ctx, acc.Address))
+	}
+
+	// the generated state is consistent
+	app.CrisisKeeper.AssertInvariants(ctx)
+	require.Equal(t, cfg.BondDenom, app.StakingKeeper.BondDenom(ctx))
+	require.Equal(t, stakingtypes.Bonded, app.StakingKeeper.GetBondedValidatorsByPower(ctx)[0].Status)
+}
@@ -0,0 +1,125 @@
+package fixture
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GenesisState sets the accounts, balances, validators and delegations of the
+// state in the auth, bank and staking genesis states of genesis, keeping their
+// params.
+func (s *State) GenesisState(cdc codec.JSONCodec, genesis map[string]json.RawMessage) (map[string]json.RawMessage, error) {
+	var authGenesis authtypes.GenesisState
+	err := cdc.UnmarshalJSON(genesis[authtypes.ModuleName], &authGenesis)
+	if err != nil {
+		return nil, err
+	}
+	var bankGenesis banktypes.GenesisState
+	if err := cdc.UnmarshalJSON(genesis[banktypes.ModuleName], &bankGenesis); err != nil {
+		return nil, err
+	}
+	var stakingGenesis stakingtypes.GenesisState
+	if err := cdc.UnmarshalJSON(genesis[stakingtypes.ModuleName], &stakingGenesis); err != nil {
+		return nil, err
+	}
+
+	accounts := make([]authtypes.GenesisAccount, len(s.Accounts))
+	balances := make([]banktypes.Balance, 0, len(s.Accounts)+1)
+	supply := sdk.NewCoins()
+	for i, acc := range s.Accounts {
+		accounts[i] = authtypes.NewBaseAccount(acc.Address, acc.PrivKey.PubKey(), uint64(i), 0)
+		balances = append(balances, banktypes.Balance{Address: acc.Address.String(), Coins: acc.Balance})
+		supply = supply.Add(acc.Balance...)
+	}
+
+	// the validators are unbonded, so the delegated tokens are held by the not
+	// bonded pool until the staking module bonds them
+	delegated := sdk.ZeroInt()
+	for _, val := range s.Validators {
+		delegated = delegated.Add(val.Tokens)
+	}
+	if delegated.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(s.BondDenom, delegated))
+		balances = append(balances, banktypes.Balance{
+			Address: authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String(),
+			Coins:   coins,
+		})
+		supply = supply.Add(coins...)
+	}
+
+	authGenesis.Accounts, err = authtypes.PackAccounts(accounts)
+	if err != nil {
+		return nil, err
+	}
+	bankGenesis.Balances, bankGenesis.Supply = balances, supply
+	stakingGenesis.Validators, stakingGenesis.Delegations = s.Validators, s.Delegations
+
+	genesis[authtypes.ModuleName] = cdc.MustMarshalJSON(&authGenesis)
+	genesis[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenesis)
+	genesis[stakingtypes.ModuleName] = cdc.MustMarshalJSON(&stakingGenesis)
+
+	return genesis, nil
+}
+
+// Setup generates the state of cfg and returns a SimApp initialized with it,
+// at the beginning of the block following the genesis. The genesis invariants
+// are not asserted, as they are slow to check on a large state.
+func Setup(tb testing.TB, cfg Config) (*simapp.SimApp, *State) {
+	tb.Helper()
+
+	state, err := Generate(cfg)
+	require.NoError(tb, err)
+
+	encCfg := simapp.MakeTestEncodingConfig()
+	app := simapp.NewSimApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, tb.TempDir(), 0, encCfg,
+		appOptions{crisis.FlagSkipGenesisInvariants: true},
+	)
+
+	genesis := simapp.NewDefaultGenesisState(encCfg.Codec)
+	var stakingGenesis stakingtypes.GenesisState
+	encCfg.Codec.MustUnmarshalJSON(genesis[stakingtypes.ModuleName], &stakingGenesis)
+	stakingGenesis.Params.BondDenom = cfg.BondDenom
+	stakingGenesis.Params.MaxValidators = cfg.MaxValidators
+	genesis[stakingtypes.ModuleName] = encCfg.Codec.MustMarshalJSON(&stakingGenesis)
+
+	genesis, err = state.GenesisState(encCfg.Codec, genesis)
+	require.NoError(tb, err)
+	stateBytes, err := json.Marshal(genesis)
+	require.NoError(tb, err)
+
+	app.InitChain(abci.RequestInitChain{
+		ChainId:         "fixture",
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{
+		ChainID: "fixture",
+		Height:  app.LastBlockHeight() + 1,
+		AppHash: app.LastCommitID().Hash,
+	}})
+
+	return app, state
+}
+
+// appOptions are the options of the SimApp of the fixtures.
+type appOptions map[string]interface{}
+
+func (o appOptions) Get(key string) interface{} {
+	return o[key]
+}
@@ -13,11 +13,15 @@ Validator will also provide its RPC, P2P, and API addresses that can be useful
 for integration testing. In addition, a Tendermint local RPC client is also provided
 which can be handy for making direct RPC calls to Tendermint.
 
-Note, due to limitations in concurrency and the design of the RPC layer in
-Tendermint, only the first Validator object will have an RPC and API client
-exposed. Due to this exact same limitation, only a single test network can exist
-at a time. A caller must be certain it calls Cleanup after it no longer needs
-the network.
+Every Validator object has a Tendermint RPC client and a gRPC client to query
+its node, but only the first Validator object will have an API server exposed.
+Only a single test network can exist at a time. A caller must be certain it
+calls Cleanup after it no longer needs the network.
+
+Consensus-dependent features, such as downtime slashing or upgrades, can be
+tested by configuring the consensus power of each validator with
+Config.ValidatorPowers, and by stopping and restarting validators with
+Network.StopValidator and Network.StartValidator.
 
 A typical testing flow might look like the following:
 
@@ -87,6 +87,7 @@ type Config struct {
 	AccountTokens    sdk.Int                    // the amount of unique validator tokens (e.g. 1000node0)
 	StakingTokens    sdk.Int                    // the amount of tokens each validator has available to stake
 	BondedTokens     sdk.Int                    // the amount of tokens each validator stakes
+	ValidatorPowers  []int64                    // the consensus power of each validator, overriding BondedTokens
 	PruningStrategy  string                     // the pruning strategy each validator will have
 	EnableTMLogging  bool                       // enable Tendermint logging to STDOUT
 	CleanupDir       bool                       // remove base temporary directory during cleanup
@@ -133,11 +134,10 @@ type (
 	// clients. Typically, this test network would be used in client and integration
 	// testing where user input is expected.
 	//
-	// Note, due to Tendermint constraints in regards to RPC functionality, there
-	// may only be one test network running at a time. Thus, any caller must be
-	// sure to Cleanup after testing is finished in order to allow other tests
-	// to create networks. In addition, only the first validator will have a valid
-	// RPC and API server/client.
+	// Note, there may only be one test network running at a time. Thus, any
+	// caller must be sure to Cleanup after testing is finished in order to allow
+	// other tests to create networks. Every validator has an RPC client and a
+	// gRPC server/client, but only the first validator has an API server.
 	Network struct {
 		Logger     Logger
 		BaseDir    string
@@ -163,6 +163,7 @@ type (
 		Address    sdk.AccAddress
 		ValAddress sdk.ValAddress
 		RPCClient  tmclient.Client
+		GRPCClient *grpc.ClientConn
 
 		tmNode  service.Service
 		api     *api.Server
@@ -238,11 +239,11 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 		tmCfg.Consensus.TimeoutCommit = cfg.TimeoutCommit
 		tmCfg.Mode = config.ModeValidator
 
-		// Only allow the first validator to expose an RPC, API and gRPC
-		// server/client due to Tendermint in-process constraints.
+		// Only allow the first validator to expose an API and gRPC-web server,
+		// and to listen on the addresses of the configuration.
 		apiAddr := ""
 		tmCfg.RPC.ListenAddress = ""
-		appCfg.GRPC.Enable = false
+		appCfg.GRPC.Enable = true
 		appCfg.GRPCWeb.Enable = false
 		apiListenAddr := ""
 		if i == 0 {
@@ -282,7 +283,6 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 				}
 				appCfg.GRPC.Address = fmt.Sprintf("0.0.0.0:%s", grpcPort)
 			}
-			appCfg.GRPC.Enable = true
 
 			_, grpcWebPort, err := server.FreeTCPAddr()
 			if err != nil {
@@ -290,6 +290,18 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 			}
 			appCfg.GRPCWeb.Address = fmt.Sprintf("0.0.0.0:%s", grpcWebPort)
 			appCfg.GRPCWeb.Enable = true
+		} else {
+			rpcAddr, _, err := server.FreeTCPAddr()
+			if err != nil {
+				return nil, err
+			}
+			tmCfg.RPC.ListenAddress = rpcAddr
+
+			_, grpcPort, err := server.FreeTCPAddr()
+			if err != nil {
+				return nil, err
+			}
+			appCfg.GRPC.Address = fmt.Sprintf("0.0.0.0:%s", grpcPort)
 		}
 
 		logger := server.ZeroLogWrapper{Logger: zerolog.Nop()}
@@ -381,9 +393,14 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 			return nil, err
 		}
 
+		bondedTokens := cfg.BondedTokens
+		if i < len(cfg.ValidatorPowers) {
+			bondedTokens = sdk.TokensFromConsensusPower(cfg.ValidatorPowers[i], sdk.DefaultPowerReduction)
+		}
+
 		balances := sdk.NewCoins(
 			sdk.NewCoin(fmt.Sprintf("%stoken", nodeDirName), cfg.AccountTokens),
-			sdk.NewCoin(cfg.BondDenom, cfg.StakingTokens),
+			sdk.NewCoin(cfg.BondDenom, sdk.MaxInt(cfg.StakingTokens, bondedTokens)),
 		)
 
 		genFiles = append(genFiles, tmCfg.GenesisFile())
@@ -398,7 +415,7 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 		createValMsg, err := stakingtypes.NewMsgCreateValidator(
 			sdk.ValAddress(addr),
 			valPubKeys[i],
-			sdk.NewCoin(cfg.BondDenom, cfg.BondedTokens),
+			sdk.NewCoin(cfg.BondDenom, bondedTokens),
 			stakingtypes.NewDescription(nodeDirName, "", "", "", ""),
 			stakingtypes.NewCommissionRates(commission, sdk.OneDec(), sdk.OneDec()),
 			sdk.OneInt(),
@@ -505,13 +522,14 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 }
 
 // LatestHeight returns the latest height of the network or an error if the
-// query fails or no validators exist.
+// query fails or no validators are running.
 func (n *Network) LatestHeight() (int64, error) {
-	if len(n.Validators) == 0 {
-		return 0, errors.New("no validators available")
+	val, err := n.runningValidator()
+	if err != nil {
+		return 0, err
 	}
 
-	status, err := n.Validators[0].RPCClient.Status(context.Background())
+	status, err := val.RPCClient.Status(context.Background())
 	if err != nil {
 		return 0, err
 	}
@@ -535,12 +553,12 @@ func (n *Network) WaitForHeightWithTimeout(h int64, t time.Duration) (int64, err
 	timeout := time.NewTimer(t)
 	defer timeout.Stop()
 
-	if len(n.Validators) == 0 {
-		return 0, errors.New("no validators available")
+	val, err := n.runningValidator()
+	if err != nil {
+		return 0, err
 	}
 
 	var latestHeight int64
-	val := n.Validators[0]
 
 	for {
 		select {
@@ -574,6 +592,59 @@ func (n *Network) WaitForNextBlock() error {
 	return err
 }
 
+// StopValidator stops the Tendermint node and the servers of the i-th validator,
+// e.g. to test the downtime of a validator. The validator can be restarted with
+// StartValidator.
+func (n *Network) StopValidator(i int) error {
+	if i < 0 || i >= len(n.Validators) {
+		return fmt.Errorf("no validator %d", i)
+	}
+
+	val := n.Validators[i]
+	if !val.IsRunning() {
+		return fmt.Errorf("validator %d is not running", i)
+	}
+
+	n.Logger.Log("stopping validator", i)
+	stopInProcess(val)
+
+	return nil
+}
+
+// StartValidator restarts the i-th validator stopped by StopValidator. Its
+// application is created anew and catches up with the blocks committed before
+// and while it was stopped.
+func (n *Network) StartValidator(i int) error {
+	if i < 0 || i >= len(n.Validators) {
+		return fmt.Errorf("no validator %d", i)
+	}
+
+	val := n.Validators[i]
+	if val.IsRunning() {
+		return fmt.Errorf("validator %d is already running", i)
+	}
+
+	n.Logger.Log("restarting validator", i)
+
+	return startInProcess(n.Config, val)
+}
+
+// IsRunning returns whether the Tendermint node of the validator is running.
+func (v *Validator) IsRunning() bool {
+	return v.tmNode != nil && v.tmNode.IsRunning()
+}
+
+// runningValidator returns the first running validator of the network.
+func (n *Network) runningValidator() (*Validator, error) {
+	for _, val := range n.Validators {
+		if val.IsRunning() {
+			return val, nil
+		}
+	}
+
+	return nil, errors.New("no validators available")
+}
+
 // Cleanup removes the root testing (temporary) directory and stops both the
 // Tendermint and API services. It allows other callers to create and start
 // test networks. This method must be called when a test is finished, typically
@@ -587,20 +658,7 @@ func (n *Network) Cleanup() {
 	n.Logger.Log("cleaning up test network...")
 
 	for _, v := range n.Validators {
-		if v.tmNode != nil && v.tmNode.IsRunning() {
-			_ = v.tmNode.Stop()
-		}
-
-		if v.api != nil {
-			_ = v.api.Close()
-		}
-
-		if v.grpc != nil {
-			v.grpc.Stop()
-			if v.grpcWeb != nil {
-				_ = v.grpcWeb.Close()
-			}
-		}
+		stopInProcess(v)
 	}
 
 	// Give a brief pause for things to finish closing in other processes. Hopefully this helps with the address-in-use errors.
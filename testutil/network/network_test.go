@@ -3,12 +3,17 @@
 package network_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type IntegrationTestSuite struct {
@@ -41,3 +46,50 @@ func (s *IntegrationTestSuite) TestNetwork_Liveness() {
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
+
+func TestNetwork_ValidatorDowntime(t *testing.T) {
+	cfg := network.DefaultConfig()
+	cfg.TimeoutCommit = 500 * time.Millisecond
+	cfg.ValidatorPowers = []int64{10, 100, 100, 100}
+
+	var slashingGenState slashingtypes.GenesisState
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[slashingtypes.ModuleName], &slashingGenState)
+	slashingGenState.Params.SignedBlocksWindow = 10
+	slashingGenState.Params.MinSignedPerWindow = sdk.NewDecWithPrec(5, 1)
+	cfg.GenesisState[slashingtypes.ModuleName] = cfg.Codec.MustMarshalJSON(&slashingGenState)
+
+	n, err := network.New(t, t.TempDir(), cfg)
+	require.NoError(t, err)
+	defer n.Cleanup()
+
+	_, err = n.WaitForHeight(1)
+	require.NoError(t, err)
+
+	// every validator serves gRPC queries
+	down := n.Validators[0]
+	for _, val := range n.Validators {
+		res, err := stakingtypes.NewQueryClient(val.GRPCClient).Validator(context.Background(), &stakingtypes.QueryValidatorRequest{ValidatorAddr: down.ValAddress.String()})
+		require.NoError(t, err)
+		require.Equal(t, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction), res.Validator.Tokens)
+	}
+
+	// the network keeps committing blocks without the smallest validator, which
+	// is jailed for downtime
+	require.NoError(t, n.StopValidator(0))
+	require.False(t, down.IsRunning())
+	require.Error(t, n.StopValidator(0))
+
+	h, err := n.WaitForHeightWithTimeout(20, time.Minute)
+	require.NoError(t, err, "stalled at height %d", h)
+
+	res, err := stakingtypes.NewQueryClient(n.Validators[1].GRPCClient).Validator(context.Background(), &stakingtypes.QueryValidatorRequest{ValidatorAddr: down.ValAddress.String()})
+	require.NoError(t, err)
+	require.True(t, res.Validator.Jailed)
+
+	// the restarted validator catches up with the network
+	require.NoError(t, n.StartValidator(0))
+	require.Eventually(t, func() bool {
+		status, err := down.RPCClient.Status(context.Background())
+		return err == nil && status.SyncInfo.LatestBlockHeight >= h
+	}, time.Minute, time.Second)
+}
@@ -12,7 +12,10 @@ import (
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/rpc/client/local"
 	"github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	srvtypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -97,7 +100,7 @@ func startInProcess(cfg Config, val *Validator) error {
 	}
 
 	if val.AppConfig.GRPC.Enable {
-		grpcSrv, err := servergrpc.StartGRPCServer(val.ClientCtx, app, val.AppConfig.GRPC.Address)
+		grpcSrv, err := servergrpc.StartGRPCServer(val.ClientCtx, app, val.AppConfig.GRPC.Address, nil)
 		if err != nil {
 			return err
 		}
@@ -110,10 +113,45 @@ func startInProcess(cfg Config, val *Validator) error {
 				return err
 			}
 		}
+
+		val.GRPCClient, err = grpc.Dial(
+			val.AppConfig.GRPC.Address,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(cfg.InterfaceRegistry).GRPCCodec())),
+		)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// stopInProcess stops the Tendermint node and the servers of the validator.
+func stopInProcess(val *Validator) {
+	if val.tmNode != nil && val.tmNode.IsRunning() {
+		_ = val.tmNode.Stop()
+	}
+
+	if val.api != nil {
+		_ = val.api.Close()
+		val.api = nil
+	}
+
+	if val.GRPCClient != nil {
+		_ = val.GRPCClient.Close()
+		val.GRPCClient = nil
+	}
+
+	if val.grpc != nil {
+		val.grpc.Stop()
+		val.grpc = nil
+		if val.grpcWeb != nil {
+			_ = val.grpcWeb.Close()
+			val.grpcWeb = nil
+		}
+	}
+}
+
 func collectGenFiles(cfg Config, vals []*Validator, outputDir string) error {
 	genTime := tmtime.Now()
 
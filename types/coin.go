@@ -384,6 +384,61 @@ func (coins Coins) DenomsSubsetOf(coinsB Coins) bool {
 	return true
 }
 
+// Filter returns the coins for which keep returns true, in order.
+func (coins Coins) Filter(keep func(Coin) bool) Coins {
+	res := Coins{}
+	for _, coin := range coins {
+		if keep(coin) {
+			res = append(res, coin)
+		}
+	}
+
+	return res
+}
+
+// Denoms returns the denoms of the coins, in order.
+func (coins Coins) Denoms() []string {
+	denoms := make([]string, len(coins))
+	for i, coin := range coins {
+		denoms[i] = coin.Denom
+	}
+
+	return denoms
+}
+
+// AllowDenoms returns the coins whose denom is in the allowlist denoms.
+func (coins Coins) AllowDenoms(denoms ...string) Coins {
+	return coins.Filter(DenomIn(denoms...))
+}
+
+// DenyDenoms returns the coins whose denom is not in the denylist denoms.
+func (coins Coins) DenyDenoms(denoms ...string) Coins {
+	return coins.Filter(DenomNotIn(denoms...))
+}
+
+// DenomIn returns a Coins.Filter predicate keeping the coins whose denom is in
+// denoms.
+func DenomIn(denoms ...string) func(Coin) bool {
+	set := denomSet(denoms)
+	return func(coin Coin) bool { return set[coin.Denom] }
+}
+
+// DenomNotIn returns a Coins.Filter predicate keeping the coins whose denom is
+// not in denoms.
+func DenomNotIn(denoms ...string) func(Coin) bool {
+	set := denomSet(denoms)
+	return func(coin Coin) bool { return !set[coin.Denom] }
+}
+
+func denomSet(denoms []string) map[string]bool {
+	set := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		set[denom] = true
+	}
+
+	return set
+}
+
 // Sub subtracts a set of coins from another.
 //
 // e.g.
@@ -847,5 +902,10 @@ func ParseCoinsNormalized(coinStr string) (Coins, error) {
 	if err != nil {
 		return Coins{}, err
 	}
-	return NormalizeCoins(coins), nil
+	normalized := NormalizeCoins(coins)
+	if len(normalized) > 0 {
+		// coins smaller than the smallest unit are truncated to zero
+		normalized = removeZeroCoins(normalized)
+	}
+	return normalized, nil
 }
@@ -120,6 +120,51 @@ func (m *DecCoin) GetDenom() string {
 	return ""
 }
 
+// SignedCoinsProto defines a Protobuf wrapper around a SignedCoins object, a
+// set of coins whose amounts can be negative.
+type SignedCoinsProto struct {
+	Coins SignedCoins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=SignedCoins" json:"coins"`
+}
+
+func (m *SignedCoinsProto) Reset()      { *m = SignedCoinsProto{} }
+func (*SignedCoinsProto) ProtoMessage() {}
+func (*SignedCoinsProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_189a96714eafc2df, []int{2}
+}
+func (m *SignedCoinsProto) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedCoinsProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedCoinsProto.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignedCoinsProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedCoinsProto.Merge(m, src)
+}
+func (m *SignedCoinsProto) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedCoinsProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedCoinsProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedCoinsProto proto.InternalMessageInfo
+
+func (m *SignedCoinsProto) GetCoins() SignedCoins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
 // IntProto defines a Protobuf wrapper around an Int object.
 type IntProto struct {
 	Int Int `protobuf:"bytes,1,opt,name=int,proto3,customtype=Int" json:"int"`
@@ -128,7 +173,7 @@ type IntProto struct {
 func (m *IntProto) Reset()      { *m = IntProto{} }
 func (*IntProto) ProtoMessage() {}
 func (*IntProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_189a96714eafc2df, []int{2}
+	return fileDescriptor_189a96714eafc2df, []int{3}
 }
 func (m *IntProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,7 +210,7 @@ type DecProto struct {
 func (m *DecProto) Reset()      { *m = DecProto{} }
 func (*DecProto) ProtoMessage() {}
 func (*DecProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_189a96714eafc2df, []int{3}
+	return fileDescriptor_189a96714eafc2df, []int{4}
 }
 func (m *DecProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -197,6 +242,7 @@ var xxx_messageInfo_DecProto proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*Coin)(nil), "cosmos.base.v1beta1.Coin")
 	proto.RegisterType((*DecCoin)(nil), "cosmos.base.v1beta1.DecCoin")
+	proto.RegisterType((*SignedCoinsProto)(nil), "cosmos.base.v1beta1.SignedCoinsProto")
 	proto.RegisterType((*IntProto)(nil), "cosmos.base.v1beta1.IntProto")
 	proto.RegisterType((*DecProto)(nil), "cosmos.base.v1beta1.DecProto")
 }
@@ -204,25 +250,29 @@ func init() {
 func init() { proto.RegisterFile("cosmos/base/v1beta1/coin.proto", fileDescriptor_189a96714eafc2df) }
 
 var fileDescriptor_189a96714eafc2df = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4a, 0x2c, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0xce, 0xcf, 0xcc, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xc8, 0xeb, 0x81,
-	0xe4, 0xf5, 0xa0, 0xf2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x79, 0x7d, 0x10, 0x0b, 0xa2,
-	0x54, 0x4a, 0x12, 0xa2, 0x34, 0x1e, 0x22, 0x01, 0xd5, 0x07, 0xe6, 0x28, 0x85, 0x72, 0xb1, 0x38,
-	0xe7, 0x67, 0xe6, 0x09, 0x89, 0x70, 0xb1, 0xa6, 0xa4, 0xe6, 0xe5, 0xe7, 0x4a, 0x30, 0x2a, 0x30,
-	0x6a, 0x70, 0x06, 0x41, 0x38, 0x42, 0x86, 0x5c, 0x6c, 0x89, 0xb9, 0xf9, 0xa5, 0x79, 0x25, 0x12,
-	0x4c, 0x20, 0x61, 0x27, 0xc9, 0x13, 0xf7, 0xe4, 0x19, 0x6e, 0xdd, 0x93, 0x67, 0xf6, 0xcc, 0x2b,
-	0xb9, 0xb4, 0x45, 0x97, 0x0b, 0x6a, 0x94, 0x67, 0x5e, 0x49, 0x10, 0x54, 0xa1, 0x15, 0xcb, 0x8b,
-	0x05, 0xf2, 0x8c, 0x4a, 0x11, 0x5c, 0xec, 0x2e, 0xa9, 0xc9, 0xe4, 0x98, 0xec, 0x92, 0x9a, 0x8c,
-	0x64, 0xb2, 0x4b, 0x6a, 0x32, 0x9a, 0xc9, 0xe6, 0x5c, 0x1c, 0x9e, 0x79, 0x25, 0x01, 0xe0, 0x20,
-	0xd0, 0xe6, 0x62, 0xce, 0xcc, 0x2b, 0x81, 0x18, 0x8c, 0xcf, 0x6d, 0x20, 0x55, 0x20, 0x8d, 0x2e,
-	0xa9, 0xc9, 0x70, 0x8d, 0x29, 0xa9, 0xc9, 0xe8, 0x1a, 0x31, 0xad, 0x06, 0xa9, 0x72, 0x72, 0xb9,
-	0xf1, 0x50, 0x8e, 0xa1, 0xe1, 0x91, 0x1c, 0xc3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31,
-	0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb,
-	0x31, 0x44, 0x29, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0x42, 0x43, 0x17,
-	0x4a, 0xe9, 0x16, 0xa7, 0x64, 0xeb, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xc3, 0xdb,
-	0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x33, 0x9a, 0x20, 0xf6, 0xd7, 0x01, 0x00, 0x00,
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0x3f, 0x4b, 0x3b, 0x31,
+	0x18, 0xc7, 0x93, 0x5f, 0xff, 0xfc, 0x34, 0x5d, 0xa4, 0xed, 0x70, 0xed, 0x90, 0x2b, 0x37, 0x15,
+	0xa4, 0x39, 0xaa, 0x83, 0xe0, 0x58, 0xb3, 0x74, 0x93, 0x8a, 0x20, 0x2e, 0xd2, 0xcb, 0x85, 0xf3,
+	0x90, 0x4b, 0x8a, 0x49, 0x05, 0x37, 0x5f, 0x82, 0x2f, 0xc1, 0xd9, 0xd9, 0x17, 0xd1, 0xb1, 0x38,
+	0x15, 0x87, 0xaa, 0xd7, 0xc5, 0x97, 0x21, 0xb9, 0x04, 0xa9, 0x54, 0x10, 0x9c, 0xee, 0x9e, 0x7c,
+	0xbf, 0xdf, 0x4f, 0xf2, 0x3c, 0x0f, 0xc2, 0x4c, 0xaa, 0x4c, 0xaa, 0x30, 0x1a, 0x2b, 0x1e, 0xde,
+	0xf4, 0x23, 0xae, 0xc7, 0xfd, 0x90, 0xc9, 0x54, 0x90, 0xc9, 0xb5, 0xd4, 0xb2, 0xde, 0xb0, 0x3a,
+	0x31, 0x3a, 0x71, 0x7a, 0xbb, 0x99, 0xc8, 0x44, 0x16, 0x7a, 0x68, 0xfe, 0xac, 0xb5, 0xdd, 0xb2,
+	0xd6, 0x0b, 0x2b, 0xb8, 0x5c, 0x51, 0x04, 0xa7, 0xa8, 0x7c, 0x24, 0x53, 0x51, 0x6f, 0xa2, 0x4a,
+	0xcc, 0x85, 0xcc, 0x3c, 0xd8, 0x81, 0xdd, 0xed, 0x91, 0x2d, 0xea, 0x7d, 0x54, 0x1d, 0x67, 0x72,
+	0x2a, 0xb4, 0xf7, 0xcf, 0x1c, 0x0f, 0x5a, 0xb3, 0xa5, 0x0f, 0x5e, 0x96, 0x7e, 0x69, 0x28, 0xf4,
+	0xf3, 0x53, 0x0f, 0x39, 0xd4, 0x50, 0xe8, 0x91, 0x33, 0x1e, 0x96, 0x3f, 0x1e, 0x7c, 0x18, 0x9c,
+	0xa1, 0xff, 0x94, 0xb3, 0xbf, 0x90, 0x29, 0x67, 0x6b, 0x64, 0xca, 0xd9, 0x06, 0x79, 0xe7, 0x24,
+	0x4d, 0x04, 0x8f, 0x0d, 0x5c, 0x1d, 0x17, 0xa3, 0xa0, 0xa8, 0x62, 0x06, 0xa3, 0x3c, 0xd8, 0x29,
+	0x75, 0x6b, 0x7b, 0x2d, 0xf2, 0xc3, 0x68, 0x88, 0xf1, 0x0f, 0x1a, 0xe6, 0x9a, 0xc7, 0x57, 0xbf,
+	0xb6, 0xc6, 0x18, 0xd9, 0x70, 0x70, 0x80, 0xb6, 0x86, 0x42, 0x5b, 0xe2, 0x2e, 0x2a, 0xa5, 0x42,
+	0x7b, 0xf0, 0xfb, 0xdb, 0x36, 0xbb, 0x36, 0x2e, 0x13, 0xa4, 0x9c, 0x7d, 0x05, 0x63, 0xce, 0x3c,
+	0xf8, 0x5b, 0x53, 0xc6, 0x35, 0xa0, 0x8b, 0x77, 0x0c, 0xee, 0x72, 0x0c, 0x66, 0x39, 0x86, 0xf3,
+	0x1c, 0xc3, 0xb7, 0x1c, 0xc3, 0xfb, 0x15, 0x06, 0xf3, 0x15, 0x06, 0x8b, 0x15, 0x06, 0xe7, 0x41,
+	0x92, 0xea, 0xcb, 0x69, 0x44, 0x98, 0xcc, 0xdc, 0xde, 0xdc, 0xa7, 0xa7, 0xe2, 0xab, 0x50, 0xdf,
+	0x4e, 0xb8, 0x8a, 0xaa, 0xc5, 0x26, 0xf7, 0x3f, 0x07, 0x00, 0xa3, 0xdd, 0x95, 0x8c, 0x31, 0x02,
+	0x00, 0x00,
 }
 
 func (this *Coin) Equal(that interface{}) bool {
@@ -359,6 +409,43 @@ func (m *DecCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SignedCoinsProto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedCoinsProto) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedCoinsProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *IntProto) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -466,6 +553,21 @@ func (m *DecCoin) Size() (n int) {
 	return n
 }
 
+func (m *SignedCoinsProto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovCoin(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *IntProto) Size() (n int) {
 	if m == nil {
 		return 0
@@ -726,6 +828,90 @@ func (m *DecCoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SignedCoinsProto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedCoinsProto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedCoinsProto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *IntProto) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -733,6 +733,25 @@ func (s *coinTestSuite) TestMinMax() {
 	}
 }
 
+func (s *coinTestSuite) TestCoinsFilter() {
+	coins := sdk.Coins{s.ca1, s.cm2}
+
+	s.Require().Equal(sdk.Coins{s.cm2}, coins.Filter(func(coin sdk.Coin) bool { return coin.Amount.GT(sdk.OneInt()) }))
+	s.Require().Equal(sdk.Coins{}, coins.Filter(func(sdk.Coin) bool { return false }))
+	s.Require().Equal(sdk.Coins{}, sdk.Coins{}.Filter(func(sdk.Coin) bool { return true }))
+
+	s.Require().Equal([]string{testDenom1, testDenom2}, coins.Denoms())
+	s.Require().Equal(sdk.Coins{s.ca1}, coins.AllowDenoms(testDenom1, "other"))
+	s.Require().Equal(sdk.Coins{}, coins.AllowDenoms())
+	s.Require().Equal(sdk.Coins{s.cm2}, coins.DenyDenoms(testDenom1, "other"))
+	s.Require().Equal(coins, coins.DenyDenoms())
+
+	s.Require().True(sdk.DenomIn(testDenom1)(s.ca1))
+	s.Require().False(sdk.DenomIn(testDenom1)(s.cm1))
+	s.Require().False(sdk.DenomNotIn(testDenom1)(s.ca1))
+	s.Require().True(sdk.DenomNotIn(testDenom1)(s.cm1))
+}
+
 func (s *coinTestSuite) TestCoinsGT() {
 	one := sdk.OneInt()
 	two := sdk.NewInt(2)
@@ -797,6 +816,7 @@ func (s *coinTestSuite) TestParseCoins() {
 		{"2 3foo, 97 bar", false, nil},                      // 3foo is invalid coin name
 		{"11me coin, 12you coin", false, nil},               // no spaces in coin names
 		{"1.2btc", true, sdk.Coins{{"btc", sdk.NewInt(1)}}}, // amount can be decimal, will get truncated
+		{"0.5btc,1foo", true, sdk.Coins{{"foo", one}}},      // coins truncated to zero are removed
 		{"5foo:bar", true, sdk.Coins{{"foo:bar", sdk.NewInt(5)}}},
 		{"10atom10", true, sdk.Coins{{"atom10", sdk.NewInt(10)}}},
 		{"200transfer/channelToA/uatom", true, sdk.Coins{{"transfer/channelToA/uatom", sdk.NewInt(200)}}},
@@ -2,6 +2,8 @@ package types
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -11,9 +13,50 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/store/gaskv"
+	"github.com/cosmos/cosmos-sdk/store/metricskv"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
+// ExecMode is the mode in which BaseApp executes with a Context.
+type ExecMode uint8
+
+const (
+	ExecModeCheck    ExecMode = iota // Check a new transaction, in CheckTx
+	ExecModeReCheck                  // Recheck a pending transaction after a commit, in CheckTx
+	ExecModeSimulate                 // Simulate a transaction
+	ExecModeDeliver                  // Deliver a transaction, in DeliverTx
+	ExecModeFinalize                 // Execute a block outside of its transactions, in InitChain, BeginBlock and EndBlock
+)
+
+var execModeNames = map[ExecMode]string{
+	ExecModeCheck:    "check",
+	ExecModeReCheck:  "recheck",
+	ExecModeSimulate: "simulate",
+	ExecModeDeliver:  "deliver",
+	ExecModeFinalize: "finalize",
+}
+
+// String implements fmt.Stringer.
+func (m ExecMode) String() string {
+	if name, ok := execModeNames[m]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("unknown(%d)", uint8(m))
+}
+
+// IsCheck returns true for the modes in which a transaction is checked for
+// the mempool, i.e. ExecModeCheck and ExecModeReCheck.
+func (m ExecMode) IsCheck() bool {
+	return m == ExecModeCheck || m == ExecModeReCheck
+}
+
+// commitsState returns true for the modes whose state is committed.
+func (m ExecMode) commitsState() bool {
+	return m == ExecModeDeliver || m == ExecModeFinalize
+}
+
 /*
 Context is an immutable object contains all information needed to
 process a request.
@@ -35,9 +78,12 @@ type Context struct {
 	blockGasMeter GasMeter
 	checkTx       bool
 	recheckTx     bool // if recheckTx == true, then checkTx must also be true
+	execMode      ExecMode
 	minGasPrice   DecCoins
 	consParams    *tmproto.ConsensusParams
 	eventManager  *EventManager
+	execDepth     int
+	maxExecDepth  int
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -56,8 +102,10 @@ func (c Context) GasMeter() GasMeter          { return c.gasMeter }
 func (c Context) BlockGasMeter() GasMeter     { return c.blockGasMeter }
 func (c Context) IsCheckTx() bool             { return c.checkTx }
 func (c Context) IsReCheckTx() bool           { return c.recheckTx }
+func (c Context) ExecMode() ExecMode          { return c.execMode }
 func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
 func (c Context) EventManager() *EventManager { return c.eventManager }
+func (c Context) ExecDepth() int              { return c.execDepth }
 
 // clone the header before returning
 func (c Context) BlockHeader() tmproto.Header {
@@ -72,6 +120,33 @@ func (c Context) HeaderHash() tmbytes.HexBytes {
 	return hash
 }
 
+// BlockGasUsed returns the gas consumed so far by the block being executed,
+// capped to its gas limit, or 0 without block gas meter.
+func (c Context) BlockGasUsed() uint64 {
+	if c.blockGasMeter == nil {
+		return 0
+	}
+	return c.blockGasMeter.GasConsumedToLimit()
+}
+
+// BlockGasLimit returns the gas limit of the block being executed, or 0 if its
+// gas is unlimited or without block gas meter.
+func (c Context) BlockGasLimit() uint64 {
+	if c.blockGasMeter == nil || c.blockGasMeter.Limit() == math.MaxUint64 {
+		return 0
+	}
+	return c.blockGasMeter.Limit()
+}
+
+// MaxExecDepth returns the maximum depth at which the messages nested in other
+// messages can be executed, DefaultMaxExecDepth if unset.
+func (c Context) MaxExecDepth() int {
+	if c.maxExecDepth <= 0 {
+		return DefaultMaxExecDepth
+	}
+	return c.maxExecDepth
+}
+
 func (c Context) ConsensusParams() *tmproto.ConsensusParams {
 	return proto.Clone(c.consParams).(*tmproto.ConsensusParams)
 }
@@ -88,8 +163,14 @@ func (c Context) Err() error {
 	return c.baseCtx.Err()
 }
 
-// create a new context
+// create a new context, in ExecModeCheck if isCheckTx and in ExecModeFinalize
+// otherwise
 func NewContext(ms MultiStore, header tmproto.Header, isCheckTx bool, logger log.Logger) Context {
+	execMode := ExecModeFinalize
+	if isCheckTx {
+		execMode = ExecModeCheck
+	}
+
 	// https://github.com/gogo/protobuf/issues/519
 	header.Time = header.Time.UTC()
 	return Context{
@@ -98,6 +179,7 @@ func NewContext(ms MultiStore, header tmproto.Header, isCheckTx bool, logger log
 		header:       header,
 		chainID:      header.ChainID,
 		checkTx:      isCheckTx,
+		execMode:     execMode,
 		logger:       logger,
 		gasMeter:     storetypes.NewInfiniteGasMeter(),
 		minGasPrice:  DecCoins{},
@@ -193,21 +275,43 @@ func (c Context) WithBlockGasMeter(meter GasMeter) Context {
 }
 
 // WithIsCheckTx enables or disables CheckTx value for verifying transactions and returns an updated Context
+// The execution mode is switched to ExecModeCheck, or to ExecModeDeliver, if it
+// doesn't match the CheckTx value.
 func (c Context) WithIsCheckTx(isCheckTx bool) Context {
 	c.checkTx = isCheckTx
+	switch {
+	case isCheckTx && c.execMode.commitsState():
+		c.execMode = ExecModeCheck
+	case !isCheckTx && !c.execMode.commitsState():
+		c.execMode = ExecModeDeliver
+	}
 	return c
 }
 
 // WithIsRecheckTx called with true will also set true on checkTx in order to
 // enforce the invariant that if recheckTx = true then checkTx = true as well.
+// The execution mode is switched to ExecModeReCheck, or back to ExecModeCheck.
 func (c Context) WithIsReCheckTx(isRecheckTx bool) Context {
 	if isRecheckTx {
 		c.checkTx = true
+		c.execMode = ExecModeReCheck
+	} else if c.execMode == ExecModeReCheck {
+		c.execMode = ExecModeCheck
 	}
 	c.recheckTx = isRecheckTx
 	return c
 }
 
+// WithExecMode returns a Context with an updated execution mode, and the
+// CheckTx and ReCheckTx values matching it. CheckTx is enabled in
+// ExecModeSimulate, transactions being simulated on the CheckTx state.
+func (c Context) WithExecMode(mode ExecMode) Context {
+	c.execMode = mode
+	c.checkTx = !mode.commitsState()
+	c.recheckTx = mode == ExecModeReCheck
+	return c
+}
+
 // WithMinGasPrices returns a Context with an updated minimum gas price value
 func (c Context) WithMinGasPrices(gasPrices DecCoins) Context {
 	c.minGasPrice = gasPrices
@@ -226,6 +330,20 @@ func (c Context) WithEventManager(em *EventManager) Context {
 	return c
 }
 
+// WithExecDepth returns a Context with an updated depth of the messages
+// executed, 0 for the messages of a tx.
+func (c Context) WithExecDepth(depth int) Context {
+	c.execDepth = depth
+	return c
+}
+
+// WithMaxExecDepth returns a Context with an updated maximum depth of the
+// nested messages executed.
+func (c Context) WithMaxExecDepth(depth int) Context {
+	c.maxExecDepth = depth
+	return c
+}
+
 // TODO: remove???
 func (c Context) IsZero() bool {
 	return c.ms == nil
@@ -248,9 +366,14 @@ func (c Context) Value(key interface{}) interface{} {
 // Store / Caching
 // ----------------------------------------------------------------------------
 
-// KVStore fetches a KVStore from the MultiStore.
+// KVStore fetches a KVStore from the MultiStore. The accesses to the store are
+// measured if telemetry is enabled.
 func (c Context) KVStore(key storetypes.StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), storetypes.KVGasConfig())
+	store := c.MultiStore().GetKVStore(key)
+	if telemetry.IsTelemetryEnabled() {
+		store = metricskv.NewStore(store, key.Name())
+	}
+	return gaskv.NewStore(store, c.GasMeter(), storetypes.KVGasConfig())
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
@@ -156,6 +156,70 @@ func (s *contextTestSuite) TestContextHeader() {
 	s.Require().Equal(proposer.Bytes(), ctx.BlockHeader().ProposerAddress)
 }
 
+func (s *contextTestSuite) TestContextExecMode() {
+	ctx := types.NewContext(nil, tmproto.Header{}, true, nil)
+	s.Require().Equal(types.ExecModeCheck, ctx.ExecMode())
+
+	ctx = types.NewContext(nil, tmproto.Header{}, false, nil)
+	s.Require().Equal(types.ExecModeFinalize, ctx.ExecMode())
+
+	cases := []struct {
+		mode      types.ExecMode
+		name      string
+		isCheck   bool
+		checkTx   bool
+		recheckTx bool
+	}{
+		{types.ExecModeCheck, "check", true, true, false},
+		{types.ExecModeReCheck, "recheck", true, true, true},
+		{types.ExecModeSimulate, "simulate", false, true, false},
+		{types.ExecModeDeliver, "deliver", false, false, false},
+		{types.ExecModeFinalize, "finalize", false, false, false},
+	}
+	for _, tc := range cases {
+		ctx := ctx.WithExecMode(tc.mode)
+		s.Require().Equal(tc.mode, ctx.ExecMode())
+		s.Require().Equal(tc.name, tc.mode.String())
+		s.Require().Equal(tc.isCheck, tc.mode.IsCheck())
+		s.Require().Equal(tc.checkTx, ctx.IsCheckTx(), tc.name)
+		s.Require().Equal(tc.recheckTx, ctx.IsReCheckTx(), tc.name)
+	}
+	s.Require().Equal("unknown(9)", types.ExecMode(9).String())
+
+	// the CheckTx and ReCheckTx values keep the execution mode consistent
+	ctx = ctx.WithIsReCheckTx(true)
+	s.Require().Equal(types.ExecModeReCheck, ctx.ExecMode())
+	ctx = ctx.WithIsReCheckTx(false)
+	s.Require().Equal(types.ExecModeCheck, ctx.ExecMode())
+	ctx = ctx.WithIsCheckTx(false)
+	s.Require().Equal(types.ExecModeDeliver, ctx.ExecMode())
+	ctx = ctx.WithIsCheckTx(true)
+	s.Require().Equal(types.ExecModeCheck, ctx.ExecMode())
+
+	ctx = ctx.WithExecMode(types.ExecModeSimulate).WithIsCheckTx(true)
+	s.Require().Equal(types.ExecModeSimulate, ctx.ExecMode())
+}
+
+func (s *contextTestSuite) TestContextBlockGas() {
+	ctx := types.NewContext(nil, tmproto.Header{}, false, nil)
+	s.Require().Zero(ctx.BlockGasUsed())
+	s.Require().Zero(ctx.BlockGasLimit())
+
+	ctx = ctx.WithBlockGasMeter(types.NewInfiniteGasMeter())
+	ctx.BlockGasMeter().ConsumeGas(30, "test")
+	s.Require().Equal(uint64(30), ctx.BlockGasUsed())
+	s.Require().Zero(ctx.BlockGasLimit())
+
+	ctx = ctx.WithBlockGasMeter(types.NewGasMeter(100))
+	ctx.BlockGasMeter().ConsumeGas(40, "test")
+	s.Require().Equal(uint64(40), ctx.BlockGasUsed())
+	s.Require().Equal(uint64(100), ctx.BlockGasLimit())
+
+	// the gas used is capped to the limit
+	s.Require().Panics(func() { ctx.BlockGasMeter().ConsumeGas(70, "test") })
+	s.Require().Equal(uint64(100), ctx.BlockGasUsed())
+}
+
 func (s *contextTestSuite) TestContextHeaderClone() {
 	cases := map[string]struct {
 		h tmproto.Header
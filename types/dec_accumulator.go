@@ -0,0 +1,99 @@
+package types
+
+import (
+	"math/big"
+)
+
+// DecAccumulator evaluates a chain of decimal operations with Precision extra
+// decimal places, so that the result is rounded only once when finalized
+// instead of at each step, e.g. for reward and commission math:
+//
+//	reward := NewDecAccumulator(stake).Mul(rate).Quo(totalStake).Finalize()
+//
+// The operations mutate the accumulator and return it to be chained. The
+// accumulator is not safe for concurrent use.
+type DecAccumulator struct {
+	// i is the value multiplied by 10^(2*Precision)
+	i *big.Int
+}
+
+// NewDecAccumulator creates a new DecAccumulator starting from d.
+func NewDecAccumulator(d Dec) *DecAccumulator {
+	return &DecAccumulator{i: new(big.Int).Mul(d.i, precisionReuse)}
+}
+
+// Add adds d to the accumulator.
+func (a *DecAccumulator) Add(d Dec) *DecAccumulator {
+	a.i.Add(a.i, new(big.Int).Mul(d.i, precisionReuse))
+	return a
+}
+
+// Sub subtracts d from the accumulator.
+func (a *DecAccumulator) Sub(d Dec) *DecAccumulator {
+	a.i.Sub(a.i, new(big.Int).Mul(d.i, precisionReuse))
+	return a
+}
+
+// Mul multiplies the accumulator by d, rounding the extra decimal places.
+func (a *DecAccumulator) Mul(d Dec) *DecAccumulator {
+	a.i.Mul(a.i, d.i)
+	chopPrecisionAndRound(a.i)
+	return a
+}
+
+// MulInt multiplies the accumulator by i.
+func (a *DecAccumulator) MulInt(i Int) *DecAccumulator {
+	a.i.Mul(a.i, i.i)
+	return a
+}
+
+// Quo divides the accumulator by d, rounding the extra decimal places.
+func (a *DecAccumulator) Quo(d Dec) *DecAccumulator {
+	// multiply precision twice
+	a.i.Mul(a.i, precisionReuse)
+	a.i.Mul(a.i, precisionReuse)
+	a.i.Quo(a.i, d.i)
+
+	chopPrecisionAndRound(a.i)
+	return a
+}
+
+// QuoInt divides the accumulator by i, rounding the extra decimal places.
+func (a *DecAccumulator) QuoInt(i Int) *DecAccumulator {
+	a.i.Mul(a.i, precisionReuse)
+	a.i.Quo(a.i, i.i)
+
+	chopPrecisionAndRound(a.i)
+	return a
+}
+
+// Finalize returns the value of the accumulator rounded to Precision decimal
+// places with banker's rounding, like the Dec operations. The accumulator is
+// left untouched and can keep being used.
+func (a *DecAccumulator) Finalize() Dec {
+	return a.finalize(chopPrecisionAndRound)
+}
+
+// FinalizeTruncate returns the value of the accumulator truncated to Precision
+// decimal places.
+func (a *DecAccumulator) FinalizeTruncate() Dec {
+	return a.finalize(func(d *big.Int) *big.Int {
+		chopPrecisionAndTruncate(d)
+		return d
+	})
+}
+
+// FinalizeRoundUp returns the value of the accumulator rounded up to
+// Precision decimal places.
+func (a *DecAccumulator) FinalizeRoundUp() Dec {
+	return a.finalize(chopPrecisionAndRoundUp)
+}
+
+func (a *DecAccumulator) finalize(chop func(*big.Int) *big.Int) Dec {
+	chopped := chop(new(big.Int).Set(a.i))
+	if chopped.BitLen() > maxDecBitLen {
+		panic("Int overflow")
+	}
+
+	return Dec{chopped}
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDecAccumulator(t *testing.T) {
+	third := sdk.OneDec().Quo(sdk.NewDec(3))
+	// each Dec operation rounds
+	require.Equal(t, "0.999999999999999999", sdk.OneDec().Quo(sdk.NewDec(3)).Mul(sdk.NewDec(3)).String())
+
+	testCases := []struct {
+		name        string
+		acc         *sdk.DecAccumulator
+		expRound    string
+		expTruncate string
+		expRoundUp  string
+	}{
+		{"quo then mul", sdk.NewDecAccumulator(sdk.OneDec()).Quo(sdk.NewDec(3)).Mul(sdk.NewDec(3)), "1.000000000000000000", "0.999999999999999999", "1.000000000000000000"},
+		{"quo int then mul int", sdk.NewDecAccumulator(sdk.NewDec(2)).QuoInt(sdk.NewInt(3)).MulInt(sdk.NewInt(3)), "2.000000000000000000", "2.000000000000000000", "2.000000000000000001"},
+		{"third", sdk.NewDecAccumulator(sdk.OneDec()).Quo(sdk.NewDec(3)), "0.333333333333333333", "0.333333333333333333", "0.333333333333333334"},
+		{"two thirds", sdk.NewDecAccumulator(sdk.NewDec(2)).Quo(sdk.NewDec(3)), "0.666666666666666667", "0.666666666666666666", "0.666666666666666667"},
+		{"negative two thirds", sdk.NewDecAccumulator(sdk.NewDec(-2)).Quo(sdk.NewDec(3)), "-0.666666666666666667", "-0.666666666666666666", "-0.666666666666666666"},
+		{"add and sub", sdk.NewDecAccumulator(third).Add(third).Add(third).Sub(sdk.OneDec()), "-0.000000000000000001", "-0.000000000000000001", "-0.000000000000000001"},
+		{"commission", sdk.NewDecAccumulator(sdk.NewDec(100)).Mul(sdk.NewDecWithPrec(5, 2)).Quo(sdk.NewDec(7)).Mul(sdk.NewDec(7)), "5.000000000000000000", "5.000000000000000000", "5.000000000000000001"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expRound, tc.acc.Finalize().String())
+			require.Equal(t, tc.expTruncate, tc.acc.FinalizeTruncate().String())
+			require.Equal(t, tc.expRoundUp, tc.acc.FinalizeRoundUp().String())
+			// finalizing does not consume the accumulator
+			require.Equal(t, tc.expRound, tc.acc.Finalize().String())
+		})
+	}
+}
+
+func TestDecAccumulatorSingleOperation(t *testing.T) {
+	a, b := sdk.NewDecWithPrec(123456789, 5), sdk.NewDecWithPrec(987654321, 7)
+
+	require.Equal(t, a.Mul(b), sdk.NewDecAccumulator(a).Mul(b).Finalize())
+	require.Equal(t, a.Quo(b), sdk.NewDecAccumulator(a).Quo(b).Finalize())
+	require.Equal(t, a.MulInt64(7), sdk.NewDecAccumulator(a).MulInt(sdk.NewInt(7)).Finalize())
+	require.Equal(t, a.QuoInt64(7), sdk.NewDecAccumulator(a).QuoInt(sdk.NewInt(7)).Finalize())
+	require.Equal(t, a.Add(b), sdk.NewDecAccumulator(a).Add(b).Finalize())
+	require.Equal(t, a.Sub(b), sdk.NewDecAccumulator(a).Sub(b).Finalize())
+	require.Equal(t, a.MulTruncate(b), sdk.NewDecAccumulator(a).Mul(b).FinalizeTruncate())
+	require.Equal(t, a.QuoRoundUp(b), sdk.NewDecAccumulator(a).Quo(b).FinalizeRoundUp())
+}
+
+func TestDecAccumulatorOverflow(t *testing.T) {
+	large := sdk.NewDecFromBigInt(new(big.Int).Lsh(big.NewInt(1), 200))
+	acc := sdk.NewDecAccumulator(large).Mul(large)
+	require.Panics(t, func() { acc.Finalize() })
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"math/bits"
 	"strconv"
 	"strings"
 	"testing"
@@ -43,6 +44,7 @@ var (
 	precisionMultipliers []*big.Int
 	zeroInt              = big.NewInt(0)
 	oneInt               = big.NewInt(1)
+	precisionUint64      = precisionReuse.Uint64()
 	tenInt               = big.NewInt(10)
 )
 
@@ -218,6 +220,101 @@ func (d Dec) Abs() Dec          { return Dec{new(big.Int).Abs(d.i)} } // absolut
 func (d Dec) Set(d2 Dec) Dec    { d.i.Set(d2.i); return d }           // set to existing dec value
 func (d Dec) Clone() Dec        { return Dec{new(big.Int).Set(d.i)} } // clone new dec
 
+// CmpInt64 compares the decimal with an int64 without allocating, returning -1,
+// 0 or 1 if the decimal is respectively less than, equal to or greater than x.
+func (d Dec) CmpInt64(x int64) int {
+	if x < 0 {
+		// the magnitude of x, which also holds for math.MinInt64
+		return d.cmpSigned(-1, ^uint64(x)+1)
+	}
+
+	return d.cmpSigned(1, uint64(x))
+}
+
+// CmpUint64 compares the decimal with an uint64 without allocating, returning
+// -1, 0 or 1 if the decimal is respectively less than, equal to or greater than
+// x.
+func (d Dec) CmpUint64(x uint64) int {
+	return d.cmpSigned(1, x)
+}
+
+// cmpSigned compares the decimal with sign*x, sign being 1 or -1.
+func (d Dec) cmpSigned(sign int, x uint64) int {
+	ds := d.i.Sign()
+	switch {
+	case x == 0:
+		return ds
+	case ds < sign:
+		return -1
+	case ds > sign:
+		return 1
+	}
+
+	hi, lo := bits.Mul64(x, precisionUint64)
+	return sign * cmpAbsUint128(d.i, hi, lo)
+}
+
+func (d Dec) LTInt64(x int64) bool { return d.CmpInt64(x) < 0 } // less than an int64
+func (d Dec) GTInt64(x int64) bool { return d.CmpInt64(x) > 0 } // greater than an int64
+
+// Sign returns -1, 0 or 1 if the decimal is respectively negative, zero or
+// positive.
+func (d Dec) Sign() int {
+	return d.i.Sign()
+}
+
+// IsBetween returns true if the decimal is between lower and upper, the bounds
+// included if inclusive.
+func (d Dec) IsBetween(lower, upper Dec, inclusive bool) bool {
+	if inclusive {
+		return d.i.Cmp(lower.i) >= 0 && d.i.Cmp(upper.i) <= 0
+	}
+
+	return d.i.Cmp(lower.i) > 0 && d.i.Cmp(upper.i) < 0
+}
+
+// IsOne returns true if the decimal is equal to one.
+func (d Dec) IsOne() bool {
+	return d.i.Cmp(precisionReuse) == 0
+}
+
+// IsNegativeOne returns true if the decimal is equal to minus one.
+func (d Dec) IsNegativeOne() bool {
+	return d.i.Sign() == -1 && d.i.CmpAbs(precisionReuse) == 0
+}
+
+// cmpAbsUint128 compares the absolute value of i with the 128 bits unsigned
+// integer hi*2^64+lo without allocating.
+func cmpAbsUint128(i *big.Int, hi, lo uint64) int {
+	if i.BitLen() > 128 {
+		return 1
+	}
+
+	var iHi, iLo uint64
+	for n, w := range i.Bits() {
+		if shift := uint(n * bits.UintSize); shift < 64 {
+			iLo |= uint64(w) << shift
+		} else {
+			iHi |= uint64(w) << (shift - 64)
+		}
+	}
+
+	switch {
+	case iHi != hi:
+		if iHi < hi {
+			return -1
+		}
+		return 1
+	case iLo != lo:
+		if iLo < lo {
+			return -1
+		}
+		return 1
+	default:
+		return 0
+	}
+}
+
 // BigInt returns a copy of the underlying big.Int.
 func (d Dec) BigInt() *big.Int {
 	if d.IsNil() {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/big"
 	"strings"
 	"testing"
@@ -567,6 +568,18 @@ func (s *decimalTestSuite) TestDecEncoding() {
 	}
 }
 
+func (s *decimalTestSuite) TestDecNilJSON() {
+	// the nil decimals are encoded as "0", which the legacy amino sign bytes
+	// depend on
+	bz, err := json.Marshal(sdk.Dec{})
+	s.Require().NoError(err)
+	s.Require().Equal("\"0\"", string(bz))
+
+	var other sdk.Dec
+	s.Require().NoError(json.Unmarshal(bz, &other))
+	s.Require().True(other.IsZero())
+}
+
 // Showcase that different orders of operations causes different results.
 func (s *decimalTestSuite) TestOperationOrders() {
 	n1 := sdk.NewDec(10)
@@ -607,3 +620,80 @@ func BenchmarkMarshalTo(b *testing.B) {
 		}
 	}
 }
+
+func (s *decimalTestSuite) TestCmpNative() {
+	decs := []sdk.Dec{
+		sdk.ZeroDec(), sdk.OneDec(), sdk.OneDec().Neg(), sdk.SmallestDec(), sdk.SmallestDec().Neg(),
+		sdk.NewDec(1).Add(sdk.SmallestDec()), sdk.NewDec(-1).Sub(sdk.SmallestDec()),
+		sdk.NewDec(math.MaxInt64), sdk.NewDec(math.MinInt64), sdk.NewDecFromBigInt(new(big.Int).SetUint64(math.MaxUint64)),
+		sdk.NewDec(math.MaxInt64).Add(sdk.SmallestDec()), sdk.NewDec(math.MinInt64).Sub(sdk.SmallestDec()),
+		s.mustNewDecFromStr("123456789012345678901234567890.5"), s.mustNewDecFromStr("-123456789012345678901234567890.5"),
+	}
+	int64s := []int64{0, 1, -1, 2, -2, math.MaxInt64, math.MinInt64, math.MaxInt64 - 1, math.MinInt64 + 1}
+	uint64s := []uint64{0, 1, 2, math.MaxInt64, math.MaxUint64, math.MaxUint64 - 1}
+
+	for _, d := range decs {
+		for _, x := range int64s {
+			exp := d.BigInt().Cmp(sdk.NewDec(x).BigInt())
+			s.Require().Equal(exp, d.CmpInt64(x), "%s cmp %d", d, x)
+			s.Require().Equal(exp < 0, d.LTInt64(x), "%s < %d", d, x)
+			s.Require().Equal(exp > 0, d.GTInt64(x), "%s > %d", d, x)
+		}
+		for _, x := range uint64s {
+			exp := d.BigInt().Cmp(sdk.NewDecFromBigInt(new(big.Int).SetUint64(x)).BigInt())
+			s.Require().Equal(exp, d.CmpUint64(x), "%s cmp %d", d, x)
+		}
+		s.Require().Equal(d.Equal(sdk.OneDec()), d.IsOne(), d.String())
+		s.Require().Equal(d.Equal(sdk.OneDec().Neg()), d.IsNegativeOne(), d.String())
+	}
+
+	d := s.mustNewDecFromStr("12.5")
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = d.CmpInt64(12)
+		_ = d.CmpUint64(13)
+		_ = d.IsOne()
+		_ = d.IsNegativeOne()
+	})
+	s.Require().Zero(allocs)
+}
+
+func (s *decimalTestSuite) TestSign() {
+	s.Require().Equal(0, sdk.ZeroDec().Sign())
+	s.Require().Equal(1, sdk.SmallestDec().Sign())
+	s.Require().Equal(-1, sdk.SmallestDec().Neg().Sign())
+	s.Require().Equal(1, s.mustNewDecFromStr("123456789012345678901234567890.5").Sign())
+}
+
+func (s *decimalTestSuite) TestIsBetween() {
+	zero, one := sdk.ZeroDec(), sdk.OneDec()
+	testCases := []struct {
+		d         sdk.Dec
+		inclusive bool
+		expected  bool
+	}{
+		{zero, true, true},
+		{zero, false, false},
+		{one, true, true},
+		{one, false, false},
+		{s.mustNewDecFromStr("0.5"), false, true},
+		{sdk.SmallestDec(), false, true},
+		{sdk.SmallestDec().Neg(), true, false},
+		{one.Add(sdk.SmallestDec()), true, false},
+	}
+
+	for _, tc := range testCases {
+		s.Require().Equal(tc.expected, tc.d.IsBetween(zero, one, tc.inclusive), "%s in [0, 1] inclusive: %t", tc.d, tc.inclusive)
+	}
+
+	// a degenerate range contains its bound only if inclusive, and a reversed
+	// range contains nothing
+	s.Require().True(one.IsBetween(one, one, true))
+	s.Require().False(one.IsBetween(one, one, false))
+	s.Require().False(s.mustNewDecFromStr("0.5").IsBetween(one, zero, true))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = one.IsBetween(zero, one, true)
+		_ = one.Sign()
+	})
+	s.Require().Zero(allocs)
+}
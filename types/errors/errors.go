@@ -162,6 +162,14 @@ var (
 	// ErrAppConfig defines an error occurred if min-gas-prices field in BaseConfig is empty.
 	ErrAppConfig = Register(RootCodespace, 40, "error in app.toml")
 
+	// ErrTxTimeout defines an error for when a tx is rejected out due to a
+	// timeout timestamp.
+	ErrTxTimeout = Register(RootCodespace, 41, "tx timeout")
+
+	// ErrMaxExecDepth defines an error for when a message nested in other
+	// messages, e.g. by authz MsgExec, exceeds the max execution depth.
+	ErrMaxExecDepth = Register(RootCodespace, 42, "max message execution depth exceeded")
+
 	// ErrPanic is only set when we recover from a panic, so we know to
 	// redact potentially sensitive system info
 	ErrPanic = errorsmod.ErrPanic
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
-	"sort"
 	"strings"
 
 	"github.com/gogo/protobuf/jsonpb"
@@ -12,6 +11,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/util/collection"
 )
 
 // ----------------------------------------------------------------------------
@@ -87,11 +87,12 @@ func TypedEventToEvent(tev proto.Message) (Event, error) {
 		return Event{}, err
 	}
 
+	// sort the keys to emit the attributes in a deterministic order
 	attrs := make([]abci.EventAttribute, 0, len(attrMap))
-	for k, v := range attrMap {
+	for _, k := range collection.SortedKeys(attrMap) {
 		attrs = append(attrs, abci.EventAttribute{
 			Key:   k,
-			Value: string(v),
+			Value: string(attrMap[k]),
 		})
 	}
 
@@ -224,6 +225,12 @@ var (
 	AttributeKeyModule = "module"
 	AttributeKeySender = "sender"
 	AttributeKeyAmount = "amount"
+
+	EventTypeMsgResponse = "msg_response"
+
+	AttributeKeyMsgIndex    = "msg_index"
+	AttributeKeyTypeURL     = "type_url"
+	AttributeKeyMsgResponse = "response"
 )
 
 type (
@@ -253,17 +260,11 @@ func (se StringEvents) Flatten() StringEvents {
 	for _, e := range se {
 		flatEvents[e.Type] = append(flatEvents[e.Type], e.Attributes...)
 	}
-	keys := make([]string, 0, len(flatEvents))
-	res := make(StringEvents, 0, len(flatEvents)) // appeneded to keys, same length of what is allocated to keys
-
-	for ty := range flatEvents {
-		keys = append(keys, ty)
-	}
-
-	sort.Strings(keys)
-	for _, ty := range keys {
-		res = append(res, StringEvent{Type: ty, Attributes: flatEvents[ty]})
-	}
+	res := make(StringEvents, 0, len(flatEvents))
+	collection.DeterministicRange(flatEvents, func(ty string, attrs []Attribute) bool {
+		res = append(res, StringEvent{Type: ty, Attributes: attrs})
+		return false
+	})
 
 	return res
 }
@@ -90,6 +90,10 @@ func (s *eventsTestSuite) TestEventManagerTypedEvents() {
 	s.Require().NoError(em.EmitTypedEvent(&hasAnimal))
 	s.Require().Len(em.Events(), 2)
 
+	// the attributes are sorted by key
+	s.Require().Equal("amount", em.Events()[0].Attributes[0].Key)
+	s.Require().Equal("denom", em.Events()[0].Attributes[1].Key)
+
 	msg1, err := sdk.ParseTypedEvent(em.Events().ToABCIEvents()[0])
 	s.Require().NoError(err)
 	s.Require().Equal(coin.String(), msg1.String())
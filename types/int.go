@@ -17,6 +17,38 @@ func newIntegerFromString(s string) (*big.Int, bool) {
 
 func equal(i *big.Int, i2 *big.Int) bool { return i.Cmp(i2) == 0 }
 
+// cmpInt64 compares i with x without allocating.
+func cmpInt64(i *big.Int, x int64) int {
+	if !i.IsInt64() {
+		return i.Sign()
+	}
+
+	switch v := i.Int64(); {
+	case v < x:
+		return -1
+	case v > x:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// cmpUint64 compares i with x without allocating.
+func cmpUint64(i *big.Int, x uint64) int {
+	if !i.IsUint64() {
+		return i.Sign()
+	}
+
+	switch v := i.Uint64(); {
+	case v < x:
+		return -1
+	case v > x:
+		return 1
+	default:
+		return 0
+	}
+}
+
 func gt(i *big.Int, i2 *big.Int) bool { return i.Cmp(i2) == 1 }
 
 func gte(i *big.Int, i2 *big.Int) bool { return i.Cmp(i2) >= 0 }
@@ -230,6 +262,38 @@ func (i Int) LTE(i2 Int) bool {
 	return lte(i.i, i2.i)
 }
 
+// CmpInt64 compares the Int with an int64 without allocating, returning -1, 0
+// or 1 if the Int is respectively less than, equal to or greater than x.
+func (i Int) CmpInt64(x int64) int {
+	return cmpInt64(i.i, x)
+}
+
+// CmpUint64 compares the Int with an uint64 without allocating, returning -1, 0
+// or 1 if the Int is respectively less than, equal to or greater than x.
+func (i Int) CmpUint64(x uint64) int {
+	return cmpUint64(i.i, x)
+}
+
+// LTInt64 returns true if the Int is less than x, without allocating.
+func (i Int) LTInt64(x int64) bool {
+	return i.CmpInt64(x) < 0
+}
+
+// GTInt64 returns true if the Int is greater than x, without allocating.
+func (i Int) GTInt64(x int64) bool {
+	return i.CmpInt64(x) > 0
+}
+
+// IsOne returns true if Int is one
+func (i Int) IsOne() bool {
+	return i.CmpInt64(1) == 0
+}
+
+// IsNegativeOne returns true if Int is minus one
+func (i Int) IsNegativeOne() bool {
+	return i.CmpInt64(-1) == 0
+}
+
 // Add adds Int from another
 func (i Int) Add(i2 Int) (res Int) {
 	res = Int{add(i.i, i2.i)}
@@ -306,6 +370,62 @@ func (i Int) ModRaw(i2 int64) Int {
 	return i.Mod(NewInt(i2))
 }
 
+// ModInv returns the modular multiplicative inverse of Int modulo m, in
+// [0, m). It panics if m is not positive or Int is not invertible modulo m.
+func (i Int) ModInv(m Int) Int {
+	if m.i.Sign() <= 0 {
+		panic("modulus must be positive")
+	}
+
+	res := new(big.Int).ModInverse(mod(i.i, m.i), m.i)
+	if res == nil {
+		panic("Int not invertible")
+	}
+	return Int{res}
+}
+
+// ExpMod returns Int to the power of e modulo m, in [0, m). It panics if e is
+// negative or m is not positive.
+func (i Int) ExpMod(e, m Int) Int {
+	if e.i.Sign() < 0 {
+		panic("negative exponent")
+	}
+	if m.i.Sign() <= 0 {
+		panic("modulus must be positive")
+	}
+
+	return Int{new(big.Int).Exp(mod(i.i, m.i), e.i, m.i)}
+}
+
+// Sqrt returns the integer square root of Int, i.e. the largest integer whose
+// square is at most Int. It panics if Int is negative.
+func (i Int) Sqrt() Int {
+	if i.i.Sign() < 0 {
+		panic("square root of negative Int")
+	}
+	return Int{new(big.Int).Sqrt(i.i)}
+}
+
+// BitLen returns the length of the absolute value of Int in bits.
+func (i Int) BitLen() int {
+	return i.i.BitLen()
+}
+
+// Lsh shifts Int left by n bits, i.e. multiplies it by 2^n.
+func (i Int) Lsh(n uint) Int {
+	// Check overflow
+	if i.i.Sign() != 0 && n > maxBitLen-uint(i.i.BitLen()) {
+		panic("Int overflow")
+	}
+	return Int{new(big.Int).Lsh(i.i, n)}
+}
+
+// Rsh shifts Int right by n bits, i.e. divides it by 2^n rounding towards
+// negative infinity.
+func (i Int) Rsh(n uint) Int {
+	return Int{new(big.Int).Rsh(i.i, n)}
+}
+
 // Neg negates Int
 func (i Int) Neg() (res Int) {
 	return Int{neg(i.i)}
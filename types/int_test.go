@@ -2,11 +2,13 @@ package types_test
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"math/rand"
 	"strconv"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -386,6 +388,49 @@ func (s *intTestSuite) TestIntMod() {
 	}
 }
 
+func (s *intTestSuite) TestIntModArith() {
+	// 3 * 5 = 15 = 1 mod 7
+	s.Require().Equal(sdk.NewInt(5), sdk.NewInt(3).ModInv(sdk.NewInt(7)))
+	s.Require().Equal(sdk.NewInt(5), sdk.NewInt(-4).ModInv(sdk.NewInt(7)))
+	s.Require().Panics(func() { sdk.NewInt(2).ModInv(sdk.NewInt(4)) })
+	s.Require().Panics(func() { sdk.NewInt(3).ModInv(sdk.ZeroInt()) })
+	s.Require().Panics(func() { sdk.NewInt(3).ModInv(sdk.NewInt(-7)) })
+
+	s.Require().Equal(sdk.NewInt(445), sdk.NewInt(4).ExpMod(sdk.NewInt(13), sdk.NewInt(497)))
+	s.Require().Equal(sdk.NewInt(1), sdk.NewInt(4).ExpMod(sdk.ZeroInt(), sdk.NewInt(497)))
+	s.Require().Equal(sdk.NewInt(4), sdk.NewInt(-3).ExpMod(sdk.OneInt(), sdk.NewInt(7)))
+	s.Require().Panics(func() { sdk.NewInt(4).ExpMod(sdk.NewInt(-1), sdk.NewInt(497)) })
+	s.Require().Panics(func() { sdk.NewInt(4).ExpMod(sdk.OneInt(), sdk.ZeroInt()) })
+
+	// the result is bounded by the modulus whatever the exponent
+	maxInt := sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)))
+	s.Require().True(maxInt.ExpMod(maxInt, maxInt.SubRaw(2)).LT(maxInt.SubRaw(2)))
+}
+
+func (s *intTestSuite) TestIntSqrt() {
+	for _, tc := range []struct{ x, sqrt int64 }{{0, 0}, {1, 1}, {15, 3}, {16, 4}, {17, 4}, {math.MaxInt64, 3037000499}} {
+		s.Require().Equal(sdk.NewInt(tc.sqrt), sdk.NewInt(tc.x).Sqrt(), "sqrt(%d)", tc.x)
+	}
+	s.Require().Panics(func() { sdk.NewInt(-1).Sqrt() })
+}
+
+func (s *intTestSuite) TestIntBits() {
+	s.Require().Equal(0, sdk.ZeroInt().BitLen())
+	s.Require().Equal(4, sdk.NewInt(-8).BitLen())
+
+	s.Require().Equal(sdk.NewInt(40), sdk.NewInt(5).Lsh(3))
+	s.Require().Equal(sdk.NewInt(-40), sdk.NewInt(-5).Lsh(3))
+	s.Require().Equal(sdk.NewInt(5), sdk.NewInt(40).Rsh(3))
+	s.Require().Equal(sdk.NewInt(-3), sdk.NewInt(-5).Rsh(1))
+
+	s.Require().NotPanics(func() { sdk.OneInt().Lsh(255) })
+	s.Require().Panics(func() { sdk.OneInt().Lsh(256) })
+	s.Require().Panics(func() { sdk.NewInt(-1).Lsh(256) })
+	s.Require().NotPanics(func() { sdk.ZeroInt().Lsh(1000) })
+	s.Require().Panics(func() { sdk.OneInt().Lsh(math.MaxUint) })
+	s.Require().Panics(func() { sdk.OneInt().Lsh(math.MaxUint - 1) })
+}
+
 func (s *intTestSuite) TestIntEq() {
 	_, resp, _, _, _ := sdk.IntEq(s.T(), sdk.ZeroInt(), sdk.ZeroInt())
 	s.Require().True(resp)
@@ -425,3 +470,39 @@ func TestRoundTripMarshalToInt(t *testing.T) {
 		})
 	}
 }
+
+func (s *intTestSuite) TestCmpNative() {
+	ints := []sdk.Int{
+		sdk.ZeroInt(), sdk.OneInt(), sdk.NewInt(-1), sdk.NewInt(math.MaxInt64), sdk.NewInt(math.MinInt64),
+		sdk.NewIntFromUint64(math.MaxUint64), sdk.NewInt(math.MaxInt64).AddRaw(1), sdk.NewInt(math.MinInt64).SubRaw(1),
+		sdk.NewIntFromUint64(math.MaxUint64).AddRaw(1), sdk.NewIntFromUint64(math.MaxUint64).AddRaw(1).Neg(),
+	}
+	int64s := []int64{0, 1, -1, math.MaxInt64, math.MinInt64}
+	uint64s := []uint64{0, 1, math.MaxInt64, math.MaxUint64}
+
+	for _, i := range ints {
+		for _, x := range int64s {
+			exp := i.BigInt().Cmp(big.NewInt(x))
+			s.Require().Equal(exp, i.CmpInt64(x), "%s cmp %d", i, x)
+			s.Require().Equal(exp < 0, i.LTInt64(x), "%s < %d", i, x)
+			s.Require().Equal(exp > 0, i.GTInt64(x), "%s > %d", i, x)
+		}
+		for _, x := range uint64s {
+			exp := i.BigInt().Cmp(new(big.Int).SetUint64(x))
+			s.Require().Equal(exp, i.CmpUint64(x), "%s cmp %d", i, x)
+		}
+		s.Require().Equal(i.Equal(sdk.OneInt()), i.IsOne(), i.String())
+		s.Require().Equal(i.Equal(sdk.NewInt(-1)), i.IsNegativeOne(), i.String())
+	}
+}
+
+func TestIntCmpNativeAllocs(t *testing.T) {
+	i := sdk.NewInt(12)
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = i.CmpInt64(12)
+		_ = i.CmpUint64(13)
+		_ = i.IsOne()
+		_ = i.IsNegativeOne()
+	})
+	require.Zero(t, allocs)
+}
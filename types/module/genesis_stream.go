@@ -0,0 +1,415 @@
+package module
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// HasStreamingGenesis is the extension interface for modules able to import and export
+// their genesis state as a JSON stream, without holding it in memory. It is used by
+// Manager.InitGenesisFromReader and Manager.ExportGenesisToWriter instead of the
+// InitGenesis and ExportGenesis methods of HasGenesis, which the module must implement
+// too.
+type HasStreamingGenesis interface {
+	HasGenesis
+
+	// InitGenesisFromReader initializes the module state from the JSON genesis state
+	// read from r. r reaches EOF at the end of the module genesis state.
+	InitGenesisFromReader(sdk.Context, codec.JSONCodec, io.Reader) ([]abci.ValidatorUpdate, error)
+	// ExportGenesisToWriter writes the JSON genesis state of the module to w.
+	ExportGenesisToWriter(sdk.Context, codec.JSONCodec, io.Writer) error
+}
+
+// InitGenesisFromReader performs init genesis functionality for modules from the JSON
+// object read from r, mapping module names to their genesis state, like InitGenesis.
+//
+// The genesis state of modules implementing HasStreamingGenesis is streamed to the
+// module, while the one of other modules is held in memory while the module is
+// initialized. Modules are initialized in the order defined in OrderInitGenesis: the
+// genesis state of a module listed in r before a module it must be initialized after is
+// spooled to a temporary file until the module is initialized. Exporting with
+// ExportGenesisToWriter and an OrderExportGenesis matching OrderInitGenesis avoids
+// spooling.
+func (m *Manager) InitGenesisFromReader(ctx sdk.Context, cdc codec.JSONCodec, r io.Reader) (abci.ResponseInitChain, error) {
+	ctx.Logger().Info("initializing blockchain state from genesis stream")
+
+	order := make(map[string]int, len(m.OrderInitGenesis))
+	for i, moduleName := range m.OrderInitGenesis {
+		if _, ok := m.Modules[moduleName].(HasGenesis); ok {
+			order[moduleName] = i
+		}
+	}
+
+	spooled := make(map[string]*os.File)
+	defer func() {
+		for _, f := range spooled {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+
+	var validatorUpdates []abci.ValidatorUpdate
+	initModule := func(moduleName string, r io.Reader) error {
+		ctx.Logger().Debug("running initialization for module", "module", moduleName)
+
+		moduleValUpdates, err := initGenesisFromReader(ctx, cdc, m.Modules[moduleName].(HasGenesis), r)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to initialize genesis of module %s", moduleName)
+		}
+
+		// use these validator updates if provided, the module manager assumes
+		// only one module will update the validator set
+		if len(moduleValUpdates) > 0 {
+			if len(validatorUpdates) > 0 {
+				return sdkerrors.ErrLogic.Wrap("validator InitGenesis updates already set by a previous module")
+			}
+			validatorUpdates = moduleValUpdates
+		}
+		return nil
+	}
+
+	// next is the position in OrderInitGenesis of the next module to initialize
+	next := 0
+	initSpooled := func() error {
+		for ; next < len(m.OrderInitGenesis); next++ {
+			moduleName := m.OrderInitGenesis[next]
+			if _, ok := order[moduleName]; !ok {
+				continue
+			}
+			f, ok := spooled[moduleName]
+			if !ok {
+				return nil
+			}
+			if _, err := f.Seek(0, io.SeekStart); err != nil {
+				return err
+			}
+			if err := initModule(moduleName, bufio.NewReader(f)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	seen := make(map[string]bool)
+	err := DecodeJSONObject(dec, func(moduleName string) error {
+		i, ok := order[moduleName]
+		if !ok {
+			return SkipJSONValue(dec)
+		}
+		if seen[moduleName] {
+			return fmt.Errorf("duplicate genesis state for module %s", moduleName)
+		}
+		seen[moduleName] = true
+
+		if err := initSpooled(); err != nil {
+			return err
+		}
+		if i > next {
+			// modules initialized before this one might still follow in the stream
+			return spoolJSONValue(dec, moduleName, spooled)
+		}
+
+		if _, ok := m.Modules[moduleName].(HasStreamingGenesis); !ok {
+			var bz json.RawMessage
+			if err := dec.Decode(&bz); err != nil {
+				return err
+			}
+			if err := initModule(moduleName, bytes.NewReader(bz)); err != nil {
+				return err
+			}
+			next++
+			return nil
+		}
+
+		pr, pw := io.Pipe()
+		go func() {
+			pw.CloseWithError(CopyJSONValue(dec, pw))
+		}()
+		err := initModule(moduleName, pr)
+		// drain the module genesis state left unread, so that the copy is over and dec
+		// is positioned after it
+		if _, drainErr := io.Copy(io.Discard, pr); err == nil {
+			err = drainErr
+		}
+		if err != nil {
+			return err
+		}
+
+		next++
+		return nil
+	})
+	if err != nil {
+		return abci.ResponseInitChain{}, err
+	}
+
+	// modules absent from the stream are skipped, like by InitGenesis
+	for ; next < len(m.OrderInitGenesis); next++ {
+		if err := initSpooled(); err != nil {
+			return abci.ResponseInitChain{}, err
+		}
+	}
+
+	// a chain must initialize with a non-empty validator set
+	if len(validatorUpdates) == 0 {
+		return abci.ResponseInitChain{}, fmt.Errorf("validator set is empty after InitGenesis, please ensure at least one validator is initialized with a delegation greater than or equal to the DefaultPowerReduction (%d)", sdk.DefaultPowerReduction)
+	}
+
+	return abci.ResponseInitChain{
+		Validators: validatorUpdates,
+	}, nil
+}
+
+// initGenesisFromReader initializes the genesis of module from r, streaming it if the
+// module implements HasStreamingGenesis.
+func initGenesisFromReader(ctx sdk.Context, cdc codec.JSONCodec, module HasGenesis, r io.Reader) ([]abci.ValidatorUpdate, error) {
+	if module, ok := module.(HasStreamingGenesis); ok {
+		return module.InitGenesisFromReader(ctx, cdc, r)
+	}
+
+	bz, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return module.InitGenesis(ctx, cdc, bz), nil
+}
+
+// spoolJSONValue copies the next JSON value read by dec to a temporary file, added to
+// spooled.
+func spoolJSONValue(dec *json.Decoder, moduleName string, spooled map[string]*os.File) error {
+	f, err := os.CreateTemp("", fmt.Sprintf("genesis-%s-*.json", moduleName))
+	if err != nil {
+		return err
+	}
+	spooled[moduleName] = f
+
+	w := bufio.NewWriter(f)
+	if err := CopyJSONValue(dec, w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// ExportGenesisToWriter performs export genesis functionality for modules, writing the
+// JSON object mapping module names to their genesis state to w, like ExportGenesis.
+//
+// The genesis state of modules implementing HasStreamingGenesis is streamed to w, while
+// the one of other modules is held in memory until written.
+func (m *Manager) ExportGenesisToWriter(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error {
+	ow := NewJSONObjectWriter(w)
+	for _, moduleName := range m.OrderExportGenesis {
+		switch module := m.Modules[moduleName].(type) {
+		case HasStreamingGenesis:
+			err := ow.WriteFieldFunc(moduleName, func(w io.Writer) error {
+				return module.ExportGenesisToWriter(ctx, cdc, w)
+			})
+			if err != nil {
+				return sdkerrors.Wrapf(err, "failed to export genesis of module %s", moduleName)
+			}
+		case HasGenesis:
+			if err := ow.WriteField(moduleName, module.ExportGenesis(ctx, cdc)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return ow.Close()
+}
+
+// JSONObjectWriter writes a JSON object to an io.Writer field by field, so that the
+// object is never held in memory as a whole.
+type JSONObjectWriter struct {
+	w      io.Writer
+	fields int
+}
+
+// NewJSONObjectWriter creates a new JSONObjectWriter writing to w. The object must be
+// terminated by Close.
+func NewJSONObjectWriter(w io.Writer) *JSONObjectWriter {
+	return &JSONObjectWriter{w: w}
+}
+
+// WriteField writes a field of the object with the given JSON value, null if empty.
+func (ow *JSONObjectWriter) WriteField(key string, value json.RawMessage) error {
+	if len(value) == 0 {
+		value = json.RawMessage("null")
+	}
+	return ow.WriteFieldFunc(key, func(w io.Writer) error {
+		_, err := w.Write(value)
+		return err
+	})
+}
+
+// WriteFieldFunc writes a field of the object, whose JSON value is written by write.
+func (ow *JSONObjectWriter) WriteFieldFunc(key string, write func(io.Writer) error) error {
+	delim := ","
+	if ow.fields == 0 {
+		delim = "{"
+	}
+	ow.fields++
+
+	bz, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(ow.w, "%s%s:", delim, bz); err != nil {
+		return err
+	}
+	return write(ow.w)
+}
+
+// WriteArrayField writes a field of the object whose value is a JSON array. each is
+// called with a function writing an element of the array, for every element.
+func (ow *JSONObjectWriter) WriteArrayField(key string, each func(writeElem func(json.RawMessage) error) error) error {
+	return ow.WriteFieldFunc(key, func(w io.Writer) error {
+		if _, err := io.WriteString(w, "["); err != nil {
+			return err
+		}
+
+		elems := 0
+		err := each(func(elem json.RawMessage) error {
+			if elems > 0 {
+				if _, err := io.WriteString(w, ","); err != nil {
+					return err
+				}
+			}
+			elems++
+			_, err := w.Write(elem)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = io.WriteString(w, "]")
+		return err
+	})
+}
+
+// Close terminates the object.
+func (ow *JSONObjectWriter) Close() error {
+	if ow.fields == 0 {
+		_, err := io.WriteString(ow.w, "{}")
+		return err
+	}
+	_, err := io.WriteString(ow.w, "}")
+	return err
+}
+
+// DecodeJSONObject reads a JSON object with dec, calling fn for each of its keys. fn must
+// read the value of the key with dec, e.g. with dec.Decode, DecodeJSONArray or
+// SkipJSONValue.
+func DecodeJSONObject(dec *json.Decoder, fn func(key string) error) error {
+	if err := expectJSONDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("expected a JSON object key, got %v", tok)
+		}
+		if err := fn(key); err != nil {
+			return err
+		}
+	}
+
+	return expectJSONDelim(dec, '}')
+}
+
+// DecodeJSONArray reads a JSON array with dec, calling fn for each of its elements. fn
+// must read the element with dec, e.g. with dec.Decode.
+func DecodeJSONArray(dec *json.Decoder, fn func() error) error {
+	if err := expectJSONDelim(dec, '['); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+
+	return expectJSONDelim(dec, ']')
+}
+
+// SkipJSONValue reads the next JSON value with dec, discarding it.
+func SkipJSONValue(dec *json.Decoder) error {
+	return CopyJSONValue(dec, io.Discard)
+}
+
+// CopyJSONValue reads the next JSON value with dec and writes it to w token by token, so
+// that the value is never held in memory as a whole. dec should be configured with
+// UseNumber, for numbers to be copied without loss of precision.
+func CopyJSONValue(dec *json.Decoder, w io.Writer) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		bz, err := json.Marshal(tok)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(bz)
+		return err
+	}
+
+	if _, err := io.WriteString(w, delim.String()); err != nil {
+		return err
+	}
+	for i := 0; dec.More(); i++ {
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if delim == '{' {
+			if err := CopyJSONValue(dec, w); err != nil {
+				return err
+			}
+			if _, err := io.WriteString(w, ":"); err != nil {
+				return err
+			}
+		}
+		if err := CopyJSONValue(dec, w); err != nil {
+			return err
+		}
+	}
+
+	// the closing delimiter, validated by dec
+	tok, err = dec.Token()
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, tok.(json.Delim).String())
+	return err
+}
+
+func expectJSONDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return fmt.Errorf("expected %s, got %v", delim, tok)
+	}
+	return nil
+}
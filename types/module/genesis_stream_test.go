@@ -0,0 +1,142 @@
+package module_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/tests/mocks"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+func TestManager_ExportGenesisToWriter(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModuleWithStreamingGenesis(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockAppModule3 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().AnyTimes().Return("module1")
+	mockAppModule2.EXPECT().Name().AnyTimes().Return("module2")
+	mockAppModule3.EXPECT().Name().AnyTimes().Return("module3")
+	mm := module.NewManager(mockAppModule1, mockAppModule2, mockAppModule3)
+	mm.SetOrderExportGenesis("module2", "module3", "module1")
+
+	ctx := sdk.Context{}
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	mockAppModule1.EXPECT().ExportGenesisToWriter(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Any()).Times(1).DoAndReturn(
+		func(_ sdk.Context, _ codec.JSONCodec, w io.Writer) error {
+			_, err := io.WriteString(w, `{"key1":"value1"}`)
+			return err
+		})
+	mockAppModule2.EXPECT().ExportGenesis(gomock.Eq(ctx), gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{"key2":"value2"}`))
+
+	var buf bytes.Buffer
+	require.NoError(t, mm.ExportGenesisToWriter(ctx, cdc, &buf))
+	require.Equal(t, `{"module2":{"key2":"value2"},"module1":{"key1":"value1"}}`, buf.String())
+
+	// the streamed genesis is the one exported by ExportGenesis
+	mockAppModule1.EXPECT().ExportGenesis(gomock.Eq(ctx), gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{"key1":"value1"}`))
+	mockAppModule2.EXPECT().ExportGenesis(gomock.Eq(ctx), gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{"key2":"value2"}`))
+	var genesisData map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &genesisData))
+	require.Equal(t, mm.ExportGenesis(ctx, cdc), genesisData)
+}
+
+func TestManager_InitGenesisFromReader(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModuleWithStreamingGenesis(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockAppModule3 := mocks.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockAppModule1.EXPECT().Name().AnyTimes().Return("module1")
+	mockAppModule2.EXPECT().Name().AnyTimes().Return("module2")
+	mockAppModule3.EXPECT().Name().AnyTimes().Return("module3")
+	mm := module.NewManager(mockAppModule1, mockAppModule2, mockAppModule3)
+	mm.SetOrderInitGenesis("module1", "module2", "module3")
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+
+	// module3 is spooled until module1 is initialized, module2 is absent and the unknown
+	// module is ignored
+	genesis := `{
+		"module3": {"key3": "value3"},
+		"unknown": [1, {"a": null}],
+		"module1": {"key1": [12345678901234567890, 1.5e-7, true, "é\"<>"], "empty": {}}
+	}`
+	gomock.InOrder(
+		mockAppModule1.EXPECT().InitGenesisFromReader(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Any()).Times(1).DoAndReturn(
+			func(_ sdk.Context, _ codec.JSONCodec, r io.Reader) ([]abci.ValidatorUpdate, error) {
+				bz, err := io.ReadAll(r)
+				require.NoError(t, err)
+				require.JSONEq(t, `{"key1": [12345678901234567890, 1.5e-7, true, "é\"<>"], "empty": {}}`, string(bz))
+				return nil, nil
+			}),
+		mockAppModule3.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Any()).Times(1).DoAndReturn(
+			func(_ sdk.Context, _ codec.JSONCodec, bz json.RawMessage) []abci.ValidatorUpdate {
+				require.JSONEq(t, `{"key3": "value3"}`, string(bz))
+				return []abci.ValidatorUpdate{{Power: 1}}
+			}),
+	)
+
+	res, err := mm.InitGenesisFromReader(ctx, cdc, strings.NewReader(genesis))
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{{Power: 1}}, res.Validators)
+
+	// a streaming module may leave its genesis unread
+	mockAppModule1.EXPECT().InitGenesisFromReader(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Any()).Times(1).Return([]abci.ValidatorUpdate{{Power: 2}}, nil)
+	mockAppModule2.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(json.RawMessage(`{}`))).Times(1).Return(nil)
+	res, err = mm.InitGenesisFromReader(ctx, cdc, strings.NewReader(`{"module1": {"key1": [1, 2]}, "module2": {}}`))
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{{Power: 2}}, res.Validators)
+
+	// the validator set must be non empty
+	mockAppModule2.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(json.RawMessage(`{}`))).Times(1).Return(nil)
+	_, err = mm.InitGenesisFromReader(ctx, cdc, strings.NewReader(`{"module2": {}}`))
+	require.ErrorContains(t, err, "validator set is empty after InitGenesis")
+
+	// a module genesis can't be duplicated
+	_, err = mm.InitGenesisFromReader(ctx, cdc, strings.NewReader(`{"module2": {}, "module2": {}}`))
+	require.EqualError(t, err, "duplicate genesis state for module module2")
+
+	_, err = mm.InitGenesisFromReader(ctx, cdc, strings.NewReader(`[]`))
+	require.Error(t, err)
+}
+
+func TestCopyJSONValue(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input string
+		exp   string
+	}{
+		{"number", ` 12345678901234567890 `, `12345678901234567890`},
+		{"string", `"a\"bé"`, `"a\"bé"`},
+		{"empty object", `{ }`, `{}`},
+		{"empty array", `[ ]`, `[]`},
+		{"nested", `{"a": [1, {"b": null, "c": false}], "d": "e"}`, `{"a":[1,{"b":null,"c":false}],"d":"e"}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dec := json.NewDecoder(strings.NewReader(tc.input))
+			dec.UseNumber()
+
+			var buf bytes.Buffer
+			require.NoError(t, module.CopyJSONValue(dec, &buf))
+			require.Equal(t, tc.exp, buf.String())
+		})
+	}
+}
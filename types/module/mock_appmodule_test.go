@@ -0,0 +1,27 @@
+package module_test
+
+import "github.com/cosmos/cosmos-sdk/types/module"
+
+// AppModuleBasicWithAllExtensions is solely here for the purpose of generating
+// mocks to be used in module tests.
+type AppModuleBasicWithAllExtensions interface {
+	module.AppModuleBasic
+	module.HasGenesisBasics
+	module.HasGRPCGateway
+	module.HasCLI
+}
+
+// AppModuleWithAllExtensions is solely here for the purpose of generating
+// mocks to be used in module tests.
+type AppModuleWithAllExtensions interface {
+	module.AppModule
+	module.HasGenesis
+	module.HasInvariants
+}
+
+// AppModuleWithStreamingGenesis is solely here for the purpose of generating
+// mocks to be used in module tests.
+type AppModuleWithStreamingGenesis interface {
+	module.AppModule
+	module.HasStreamingGenesis
+}
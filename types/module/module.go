@@ -32,7 +32,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -40,21 +42,37 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/util/collection"
 )
 
 // AppModuleBasic is the standard form for basic non-dependant elements of an application module.
+//
+// Genesis, gRPC gateway and CLI functionality are optional and provided by implementing
+// HasGenesisBasics, HasGRPCGateway and HasCLI respectively, so that modules used by
+// server-only binaries don't need to depend on cobra or grpc-gateway.
 type AppModuleBasic interface {
 	Name() string
 	RegisterLegacyAminoCodec(*codec.LegacyAmino)
 	RegisterInterfaces(codectypes.InterfaceRegistry)
+}
 
+// HasGenesisBasics is the extension interface for stateless genesis methods.
+type HasGenesisBasics interface {
 	DefaultGenesis(codec.JSONCodec) json.RawMessage
 	ValidateGenesis(codec.JSONCodec, client.TxEncodingConfig, json.RawMessage) error
+}
 
-	// client functionality
+// HasGRPCGateway is the extension interface for modules exposing gRPC gateway routes.
+type HasGRPCGateway interface {
 	RegisterGRPCGatewayRoutes(client.Context, *runtime.ServeMux)
+}
+
+// HasCLI is the extension interface for modules exposing tx and query commands.
+type HasCLI interface {
 	GetTxCmd() *cobra.Command
 	GetQueryCmd() *cobra.Command
 }
@@ -89,7 +107,9 @@ func (bm BasicManager) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 func (bm BasicManager) DefaultGenesis(cdc codec.JSONCodec) map[string]json.RawMessage {
 	genesis := make(map[string]json.RawMessage)
 	for _, b := range bm {
-		genesis[b.Name()] = b.DefaultGenesis(cdc)
+		if mod, ok := b.(HasGenesisBasics); ok {
+			genesis[b.Name()] = mod.DefaultGenesis(cdc)
+		}
 	}
 
 	return genesis
@@ -98,8 +118,10 @@ func (bm BasicManager) DefaultGenesis(cdc codec.JSONCodec) map[string]json.RawMe
 // ValidateGenesis performs genesis state validation for all modules
 func (bm BasicManager) ValidateGenesis(cdc codec.JSONCodec, txEncCfg client.TxEncodingConfig, genesis map[string]json.RawMessage) error {
 	for _, b := range bm {
-		if err := b.ValidateGenesis(cdc, txEncCfg, genesis[b.Name()]); err != nil {
-			return err
+		if mod, ok := b.(HasGenesisBasics); ok {
+			if err := mod.ValidateGenesis(cdc, txEncCfg, genesis[b.Name()]); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -109,7 +131,9 @@ func (bm BasicManager) ValidateGenesis(cdc codec.JSONCodec, txEncCfg client.TxEn
 // RegisterGRPCGatewayRoutes registers all module rest routes
 func (bm BasicManager) RegisterGRPCGatewayRoutes(clientCtx client.Context, rtr *runtime.ServeMux) {
 	for _, b := range bm {
-		b.RegisterGRPCGatewayRoutes(clientCtx, rtr)
+		if mod, ok := b.(HasGRPCGateway); ok {
+			mod.RegisterGRPCGatewayRoutes(clientCtx, rtr)
+		}
 	}
 }
 
@@ -119,8 +143,10 @@ func (bm BasicManager) RegisterGRPCGatewayRoutes(clientCtx client.Context, rtr *
 // REF: https://github.com/cosmos/cosmos-sdk/issues/6571
 func (bm BasicManager) AddTxCommands(rootTxCmd *cobra.Command) {
 	for _, b := range bm {
-		if cmd := b.GetTxCmd(); cmd != nil {
-			rootTxCmd.AddCommand(cmd)
+		if mod, ok := b.(HasCLI); ok {
+			if cmd := mod.GetTxCmd(); cmd != nil {
+				rootTxCmd.AddCommand(cmd)
+			}
 		}
 	}
 }
@@ -131,26 +157,36 @@ func (bm BasicManager) AddTxCommands(rootTxCmd *cobra.Command) {
 // REF: https://github.com/cosmos/cosmos-sdk/issues/6571
 func (bm BasicManager) AddQueryCommands(rootQueryCmd *cobra.Command) {
 	for _, b := range bm {
-		if cmd := b.GetQueryCmd(); cmd != nil {
-			rootQueryCmd.AddCommand(cmd)
+		if mod, ok := b.(HasCLI); ok {
+			if cmd := mod.GetQueryCmd(); cmd != nil {
+				rootQueryCmd.AddCommand(cmd)
+			}
 		}
 	}
 }
 
+// HasGenesis is the extension interface for stateful genesis methods.
+type HasGenesis interface {
+	HasGenesisBasics
+	InitGenesis(sdk.Context, codec.JSONCodec, json.RawMessage) []abci.ValidatorUpdate
+	ExportGenesis(sdk.Context, codec.JSONCodec) json.RawMessage
+}
+
+// HasInvariants is the extension interface for modules registering invariants.
+type HasInvariants interface {
+	RegisterInvariants(sdk.InvariantRegistry)
+}
+
 // AppModuleGenesis is the standard form for an application module genesis functions
 type AppModuleGenesis interface {
 	AppModuleBasic
-
-	InitGenesis(sdk.Context, codec.JSONCodec, json.RawMessage) []abci.ValidatorUpdate
-	ExportGenesis(sdk.Context, codec.JSONCodec) json.RawMessage
+	HasGenesis
 }
 
-// AppModule is the standard form for an application module
+// AppModule is the standard form for an application module. Genesis and invariants
+// are optional and provided by implementing HasGenesis and HasInvariants respectively.
 type AppModule interface {
-	AppModuleGenesis
-
-	// registers
-	RegisterInvariants(sdk.InvariantRegistry)
+	AppModuleBasic
 
 	// Deprecated: use RegisterServices
 	Route() sdk.Route
@@ -187,9 +223,6 @@ func NewGenesisOnlyAppModule(amg AppModuleGenesis) AppModule {
 	}
 }
 
-// RegisterInvariants is a placeholder function register no invariants
-func (GenesisOnlyAppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
-
 // Route empty module message route
 func (GenesisOnlyAppModule) Route() sdk.Route { return sdk.Route{} }
 
@@ -213,6 +246,14 @@ func (GenesisOnlyAppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []ab
 	return []abci.ValidatorUpdate{}
 }
 
+// OrderingDependencies returns the ordering dependencies declared by the wrapped module
+func (gam GenesisOnlyAppModule) OrderingDependencies() OrderingDependencies {
+	if module, ok := gam.AppModuleGenesis.(HasOrderingDependencies); ok {
+		return module.OrderingDependencies()
+	}
+	return OrderingDependencies{}
+}
+
 // Manager defines a module manager that provides the high level utility for managing and executing
 // operations for a group of modules
 type Manager struct {
@@ -222,6 +263,9 @@ type Manager struct {
 	OrderBeginBlockers []string
 	OrderEndBlockers   []string
 	OrderMigrations    []string
+
+	// the ordering dependencies added by the app, see AddOrderingDependencies
+	orderingDependencies map[string]OrderingDependencies
 }
 
 // NewManager creates a new Manager object
@@ -274,11 +318,15 @@ func (m *Manager) SetOrderMigrations(moduleNames ...string) {
 	m.OrderMigrations = moduleNames
 }
 
-// RegisterInvariants registers all module invariants
+// RegisterInvariants registers all module invariants, in ascending order of the
+// module names so that the invariants are asserted in a deterministic order.
 func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
-	for _, module := range m.Modules {
-		module.RegisterInvariants(ir)
-	}
+	collection.DeterministicRange(m.Modules, func(_ string, module AppModule) bool {
+		if module, ok := module.(HasInvariants); ok {
+			module.RegisterInvariants(ir)
+		}
+		return false
+	})
 }
 
 // RegisterRoutes registers all module routes and module querier routes
@@ -310,9 +358,13 @@ func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, genesisData
 		if genesisData[moduleName] == nil {
 			continue
 		}
+		module, ok := m.Modules[moduleName].(HasGenesis)
+		if !ok {
+			continue
+		}
 		ctx.Logger().Debug("running initialization for module", "module", moduleName)
 
-		moduleValUpdates := m.Modules[moduleName].InitGenesis(ctx, cdc, genesisData[moduleName])
+		moduleValUpdates := module.InitGenesis(ctx, cdc, genesisData[moduleName])
 
 		// use these validator updates if provided, the module manager assumes
 		// only one module will update the validator set
@@ -338,7 +390,9 @@ func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, genesisData
 func (m *Manager) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) map[string]json.RawMessage {
 	genesisData := make(map[string]json.RawMessage)
 	for _, moduleName := range m.OrderExportGenesis {
-		genesisData[moduleName] = m.Modules[moduleName].ExportGenesis(ctx, cdc)
+		if module, ok := m.Modules[moduleName].(HasGenesis); ok {
+			genesisData[moduleName] = module.ExportGenesis(ctx, cdc)
+		}
 	}
 
 	return genesisData
@@ -422,13 +476,8 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM Version
 	if !ok {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", configurator{}, cfg)
 	}
-	var modules = m.OrderMigrations
-	if modules == nil {
-		modules = DefaultMigrationsOrder(m.ModuleNames())
-	}
-
 	updatedVM := VersionMap{}
-	for _, moduleName := range modules {
+	for _, moduleName := range m.migrationsOrder() {
 		module := m.Modules[moduleName]
 		fromVersion, exists := fromVM[moduleName]
 		toVersion := module.ConsensusVersion()
@@ -448,11 +497,13 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM Version
 			}
 		} else {
 			ctx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
-			moduleValUpdates := module.InitGenesis(ctx, c.cdc, module.DefaultGenesis(c.cdc))
-			// The module manager assumes only one module will update the
-			// validator set, and it can't be a new module.
-			if len(moduleValUpdates) > 0 {
-				return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "validator InitGenesis update is already set by another module")
+			if module, ok := module.(HasGenesis); ok {
+				moduleValUpdates := module.InitGenesis(ctx, c.cdc, module.DefaultGenesis(c.cdc))
+				// The module manager assumes only one module will update the
+				// validator set, and it can't be a new module.
+				if len(moduleValUpdates) > 0 {
+					return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "validator InitGenesis update is already set by another module")
+				}
 			}
 		}
 
@@ -462,6 +513,114 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM Version
 	return updatedVM, nil
 }
 
+// ModuleMigration describes the migration of one module by RunMigrations.
+type ModuleMigration struct {
+	Module      string
+	FromVersion uint64
+	ToVersion   uint64
+	// InitGenesis is true for a module absent from the fromVM, whose InitGenesis is run
+	// instead of its migrations.
+	InitGenesis bool
+}
+
+// StoreSizeDelta describes the size change of a store, in bytes of keys and values.
+type StoreSizeDelta struct {
+	StoreKey   string
+	SizeBefore int64
+	SizeAfter  int64
+}
+
+// Delta returns the size change of the store, negative if the store shrank.
+func (d StoreSizeDelta) Delta() int64 {
+	return d.SizeAfter - d.SizeBefore
+}
+
+// MigrationReport is the outcome of a migrations dry-run.
+type MigrationReport struct {
+	// Migrations are the modules that would migrate, in migrations order. Modules already
+	// at their latest ConsensusVersion are omitted.
+	Migrations []ModuleMigration
+	// StoreSizeDeltas are the size changes of the inspected stores, in the order they
+	// were given.
+	StoreSizeDeltas []StoreSizeDelta
+	// VersionMap is the VersionMap RunMigrations would return.
+	VersionMap VersionMap
+}
+
+// DryRunMigrations executes RunMigrations against a cache of the ctx multistore and reports
+// which modules would migrate, from and to which versions, and the size changes of the
+// given stores. Nothing is committed: the state of ctx is left unchanged.
+//
+// Measuring the size of a store iterates over all of its entries, so storeKeys should
+// only contain the stores of interest when dry-running against a large state.
+//
+// Example:
+//   report, err := app.mm.DryRunMigrations(ctx, cfg, fromVM, app.GetKey(banktypes.StoreKey))
+//   if err != nil {
+//       return err
+//   }
+//   for _, migration := range report.Migrations {
+//       ctx.Logger().Info("module would migrate", "module", migration.Module, "from", migration.FromVersion, "to", migration.ToVersion)
+//   }
+func (m Manager) DryRunMigrations(ctx sdk.Context, cfg Configurator, fromVM VersionMap, storeKeys ...storetypes.StoreKey) (MigrationReport, error) {
+	var migrations []ModuleMigration
+	for _, moduleName := range m.migrationsOrder() {
+		fromVersion, exists := fromVM[moduleName]
+		toVersion := m.Modules[moduleName].ConsensusVersion()
+		if exists && fromVersion >= toVersion {
+			continue
+		}
+		migrations = append(migrations, ModuleMigration{
+			Module:      moduleName,
+			FromVersion: fromVersion,
+			ToVersion:   toVersion,
+			InitGenesis: !exists,
+		})
+	}
+
+	deltas := make([]StoreSizeDelta, len(storeKeys))
+	for i, key := range storeKeys {
+		deltas[i] = StoreSizeDelta{StoreKey: key.Name(), SizeBefore: storeSize(ctx.KVStore(key))}
+	}
+
+	// the cache is never written, discarding all the changes of the migrations
+	cacheCtx, _ := ctx.CacheContext()
+	updatedVM, err := m.RunMigrations(cacheCtx, cfg, fromVM)
+	if err != nil {
+		return MigrationReport{}, err
+	}
+
+	for i, key := range storeKeys {
+		deltas[i].SizeAfter = storeSize(cacheCtx.KVStore(key))
+	}
+
+	return MigrationReport{
+		Migrations:      migrations,
+		StoreSizeDeltas: deltas,
+		VersionMap:      updatedVM,
+	}, nil
+}
+
+// storeSize returns the total size of the keys and values of a store
+func storeSize(store sdk.KVStore) int64 {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var size int64
+	for ; iterator.Valid(); iterator.Next() {
+		size += int64(len(iterator.Key()) + len(iterator.Value()))
+	}
+	return size
+}
+
+// migrationsOrder returns the order in which the modules are migrated
+func (m Manager) migrationsOrder() []string {
+	if m.OrderMigrations != nil {
+		return m.OrderMigrations
+	}
+	return DefaultMigrationsOrder(m.ModuleNames())
+}
+
 // BeginBlock performs begin block functionality for all modules. It creates a
 // child context with an event manager to aggregate events emitted from all
 // modules.
@@ -469,7 +628,9 @@ func (m *Manager) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) abci.R
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 
 	for _, moduleName := range m.OrderBeginBlockers {
+		measure := startModuleMeasure(ctx, telemetry.MetricKeyBeginBlocker, moduleName)
 		m.Modules[moduleName].BeginBlock(ctx, req)
+		measure()
 	}
 
 	return abci.ResponseBeginBlock{
@@ -485,7 +646,9 @@ func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) abci.Respo
 	validatorUpdates := []abci.ValidatorUpdate{}
 
 	for _, moduleName := range m.OrderEndBlockers {
+		measure := startModuleMeasure(ctx, telemetry.MetricKeyEndBlocker, moduleName)
 		moduleValUpdates := m.Modules[moduleName].EndBlock(ctx, req)
+		measure()
 
 		// use these validator updates if provided, the module manager assumes
 		// only one module will update the validator set
@@ -504,6 +667,30 @@ func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) abci.Respo
 	}
 }
 
+// startModuleMeasure starts measuring the begin or end blocker of a module and
+// returns the function emitting its execution time and the gas it consumed,
+// labeled with the module name, once it has run.
+func startModuleMeasure(ctx sdk.Context, blocker, moduleName string) func() {
+	if !telemetry.IsTelemetryEnabled() {
+		return func() {}
+	}
+
+	start := time.Now()
+	gasConsumed := func() uint64 {
+		if ctx.GasMeter() == nil {
+			return 0
+		}
+		return ctx.GasMeter().GasConsumed()
+	}
+	gasBefore := gasConsumed()
+
+	return func() {
+		labels := []metrics.Label{telemetry.NewLabel(telemetry.MetricLabelNameModule, moduleName)}
+		telemetry.MeasureSinceWithLabels([]string{blocker, telemetry.MetricKeyExecution}, start, labels)
+		telemetry.AddSampleWithLabels([]string{blocker, telemetry.MetricKeyGas}, float32(gasConsumed()-gasBefore), labels)
+	}
+}
+
 // GetVersionMap gets consensus version from all modules
 func (m *Manager) GetVersionMap() VersionMap {
 	vermap := make(VersionMap)
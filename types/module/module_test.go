@@ -15,8 +15,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
@@ -33,7 +35,7 @@ func TestBasicManager(t *testing.T) {
 	clientCtx = clientCtx.WithLegacyAmino(legacyAmino)
 	wantDefaultGenesis := map[string]json.RawMessage{"mockAppModuleBasic1": json.RawMessage(``)}
 
-	mockAppModuleBasic1 := mocks.NewMockAppModuleBasic(mockCtrl)
+	mockAppModuleBasic1 := mocks.NewMockAppModuleBasicWithAllExtensions(mockCtrl)
 
 	mockAppModuleBasic1.EXPECT().Name().AnyTimes().Return("mockAppModuleBasic1")
 	mockAppModuleBasic1.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(json.RawMessage(``))
@@ -70,7 +72,6 @@ func TestGenesisOnlyAppModule(t *testing.T) {
 	t.Cleanup(mockCtrl.Finish)
 
 	mockModule := mocks.NewMockAppModuleGenesis(mockCtrl)
-	mockInvariantRegistry := mocks.NewMockInvariantRegistry(mockCtrl)
 	goam := module.NewGenesisOnlyAppModule(mockModule)
 
 	require.True(t, goam.Route().Empty())
@@ -78,7 +79,6 @@ func TestGenesisOnlyAppModule(t *testing.T) {
 	require.Nil(t, goam.LegacyQuerierHandler(nil))
 
 	// no-op
-	goam.RegisterInvariants(mockInvariantRegistry)
 	goam.BeginBlock(sdk.Context{}, abci.RequestBeginBlock{})
 	require.Equal(t, []abci.ValidatorUpdate{}, goam.EndBlock(sdk.Context{}, abci.RequestEndBlock{}))
 }
@@ -116,8 +116,8 @@ func TestManager_RegisterInvariants(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
 
-	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
-	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1 := mocks.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModuleWithAllExtensions(mockCtrl)
 	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
 	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
 	mm := module.NewManager(mockAppModule1, mockAppModule2)
@@ -189,8 +189,8 @@ func TestManager_InitGenesis(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
 
-	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
-	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1 := mocks.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModuleWithAllExtensions(mockCtrl)
 	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
 	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
 	mm := module.NewManager(mockAppModule1, mockAppModule2)
@@ -219,8 +219,8 @@ func TestManager_ExportGenesis(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
 
-	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
-	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1 := mocks.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModuleWithAllExtensions(mockCtrl)
 	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
 	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
 	mm := module.NewManager(mockAppModule1, mockAppModule2)
@@ -239,6 +239,46 @@ func TestManager_ExportGenesis(t *testing.T) {
 	require.Equal(t, want, mm.ExportGenesis(ctx, cdc))
 }
 
+func TestManager_OptionalExtensions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	// module2 implements none of the optional extensions, so the mock fails the
+	// test if the manager calls any genesis, invariant, gateway or CLI method on it
+	mockAppModule1 := mocks.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().AnyTimes().Return("module1")
+	mockAppModule2.EXPECT().Name().AnyTimes().Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+
+	mockInvariantRegistry := mocks.NewMockInvariantRegistry(mockCtrl)
+	mockAppModule1.EXPECT().RegisterInvariants(gomock.Eq(mockInvariantRegistry)).Times(1)
+	mm.RegisterInvariants(mockInvariantRegistry)
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	genesisData := map[string]json.RawMessage{
+		"module1": json.RawMessage(`{"key": "value"}`),
+		"module2": json.RawMessage(`{"key": "value"}`)}
+	mockAppModule1.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(genesisData["module1"])).Times(1).Return([]abci.ValidatorUpdate{{}})
+	require.Equal(t, []abci.ValidatorUpdate{{}}, mm.InitGenesis(ctx, cdc, genesisData).Validators)
+
+	mockAppModule1.EXPECT().ExportGenesis(gomock.Eq(ctx), gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{"key1": "value1"}`))
+	require.Equal(t, map[string]json.RawMessage{"module1": json.RawMessage(`{"key1": "value1"}`)}, mm.ExportGenesis(ctx, cdc))
+
+	mockAppModuleBasic := mocks.NewMockAppModuleBasic(mockCtrl)
+	mockAppModuleBasic.EXPECT().Name().AnyTimes().Return("mockAppModuleBasic")
+	bm := module.NewBasicManager(mockAppModuleBasic)
+	require.Empty(t, bm.DefaultGenesis(cdc))
+	require.NoError(t, bm.ValidateGenesis(cdc, nil, genesisData))
+	bm.RegisterGRPCGatewayRoutes(client.Context{}, nil)
+	mockCmd := &cobra.Command{Use: "root"}
+	bm.AddTxCommands(mockCmd)
+	bm.AddQueryCommands(mockCmd)
+	require.False(t, mockCmd.HasSubCommands())
+}
+
 func TestManager_BeginBlock(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -282,3 +322,114 @@ func TestManager_EndBlock(t *testing.T) {
 	mockAppModule2.EXPECT().EndBlock(gomock.Any(), gomock.Eq(req)).Times(1).Return([]abci.ValidatorUpdate{{}})
 	require.Panics(t, func() { mm.EndBlock(sdk.Context{}, req) })
 }
+
+// orderedAppModule is an AppModule declaring ordering dependencies
+type orderedAppModule struct {
+	*mocks.MockAppModuleWithAllExtensions
+	deps module.OrderingDependencies
+}
+
+func (m orderedAppModule) OrderingDependencies() module.OrderingDependencies {
+	return m.deps
+}
+
+func TestManager_ValidateOrdering(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockAppModule3 := mocks.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockAppModule1.EXPECT().Name().AnyTimes().Return("module1")
+	mockAppModule2.EXPECT().Name().AnyTimes().Return("module2")
+	mockAppModule3.EXPECT().Name().AnyTimes().Return("module3")
+
+	// module2 runs after module1 in BeginBlock, and after an absent module in EndBlock
+	module2 := orderedAppModule{
+		MockAppModuleWithAllExtensions: mockAppModule2,
+		deps: module.OrderingDependencies{
+			BeginBlock: []string{"module1"},
+			EndBlock:   []string{"absent"},
+		},
+	}
+	// module3 is a genesis only module running after module2 in InitGenesis
+	module3 := module.NewGenesisOnlyAppModule(orderedAppModule{
+		MockAppModuleWithAllExtensions: mockAppModule3,
+		deps:                           module.OrderingDependencies{InitGenesis: []string{"module2"}},
+	})
+
+	mm := module.NewManager(mockAppModule1, module2, module3)
+	mm.SetOrderBeginBlockers("module1", "module2", "module3")
+	mm.SetOrderInitGenesis("module1", "module2", "module3")
+	require.NoError(t, mm.ValidateOrdering())
+
+	mm.SetOrderBeginBlockers("module2", "module3", "module1")
+	mm.SetOrderInitGenesis("module3", "module1", "module2")
+	err := mm.ValidateOrdering()
+	require.ErrorIs(t, err, sdkerrors.ErrLogic)
+	require.Contains(t, err.Error(), "BeginBlock: module2 must run after module1, but runs at position 0 before module1 at position 2")
+	require.Contains(t, err.Error(), "InitGenesis: module3 must run after module2, but runs at position 0 before module2 at position 2")
+
+	// dependencies added by the app
+	mm.SetOrderBeginBlockers("module1", "module2", "module3")
+	mm.SetOrderInitGenesis("module1", "module2", "module3")
+	mm.AddOrderingDependencies("module1", module.OrderingDependencies{EndBlock: []string{"module3"}})
+	err = mm.ValidateOrdering()
+	require.ErrorIs(t, err, sdkerrors.ErrLogic)
+	require.Contains(t, err.Error(), "EndBlock: module1 must run after module3, but runs at position 0 before module3 at position 2")
+
+	mm.SetOrderEndBlockers("module3", "module2", "module1")
+	require.NoError(t, mm.ValidateOrdering())
+}
+
+func TestManager_BlockersTelemetry(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	m, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "test"})
+	require.NoError(t, err)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+
+	ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+	mockAppModule1.EXPECT().BeginBlock(gomock.Any(), gomock.Any()).Times(1).Do(func(ctx sdk.Context, _ abci.RequestBeginBlock) {
+		ctx.GasMeter().ConsumeGas(10, "test")
+	})
+	mockAppModule2.EXPECT().BeginBlock(gomock.Any(), gomock.Any()).Times(1)
+	mockAppModule1.EXPECT().EndBlock(gomock.Any(), gomock.Any()).Times(1)
+	mockAppModule2.EXPECT().EndBlock(gomock.Any(), gomock.Any()).Times(1)
+	mm.BeginBlock(ctx, abci.RequestBeginBlock{})
+	mm.EndBlock(ctx, abci.RequestEndBlock{})
+
+	gr, err := m.Gather(telemetry.FormatText)
+	require.NoError(t, err)
+	var summary struct {
+		Samples []struct {
+			Name   string
+			Count  int
+			Sum    float64
+			Labels map[string]string
+		}
+	}
+	require.NoError(t, json.Unmarshal(gr.Metrics, &summary))
+
+	gas := make(map[string]float64)
+	for _, sample := range summary.Samples {
+		switch sample.Name {
+		case "test.begin_blocker.execution", "test.end_blocker.execution":
+			require.Equal(t, 1, sample.Count)
+		case "test.begin_blocker.gas", "test.end_blocker.gas":
+			gas[sample.Name+"/"+sample.Labels["module"]] = sample.Sum
+		}
+	}
+	require.Equal(t, map[string]float64{
+		"test.begin_blocker.gas/module1": 10,
+		"test.begin_blocker.gas/module2": 0,
+		"test.end_blocker.gas/module1":   0,
+		"test.end_blocker.gas/module2":   0,
+	}, gas)
+}
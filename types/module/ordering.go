@@ -0,0 +1,98 @@
+package module
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// OrderingDependencies lists, for each ordered phase of the module manager, the modules
+// which must run before a module.
+type OrderingDependencies struct {
+	BeginBlock  []string
+	EndBlock    []string
+	InitGenesis []string
+}
+
+// HasOrderingDependencies is implemented by the modules which must run after other modules
+// in some phases, e.g. a module distributing the tokens minted by another module in
+// BeginBlock. The declared dependencies are checked by Manager.ValidateOrdering.
+type HasOrderingDependencies interface {
+	OrderingDependencies() OrderingDependencies
+}
+
+// AddOrderingDependencies declares that the module moduleName must run after the given
+// modules, in addition to the dependencies declared by the module itself. It lets apps
+// enforce the ordering constraints of modules which do not declare them.
+func (m *Manager) AddOrderingDependencies(moduleName string, deps OrderingDependencies) {
+	if m.orderingDependencies == nil {
+		m.orderingDependencies = map[string]OrderingDependencies{}
+	}
+	existing := m.orderingDependencies[moduleName]
+	m.orderingDependencies[moduleName] = OrderingDependencies{
+		BeginBlock:  append(existing.BeginBlock, deps.BeginBlock...),
+		EndBlock:    append(existing.EndBlock, deps.EndBlock...),
+		InitGenesis: append(existing.InitGenesis, deps.InitGenesis...),
+	}
+}
+
+// ValidateOrdering checks the configured BeginBlock, EndBlock and InitGenesis orders
+// satisfy the ordering dependencies declared by the modules and added with
+// AddOrderingDependencies. It returns an error listing every violated dependency, apps
+// should call it once all orders are set and fail to start on error.
+//
+// Dependencies on modules absent from the manager are ignored, so that modules can declare
+// dependencies on optional modules.
+func (m *Manager) ValidateOrdering() error {
+	moduleNames := m.ModuleNames()
+	sort.Strings(moduleNames)
+
+	var violations []string
+	for _, moduleName := range moduleNames {
+		deps := m.orderingDependencies[moduleName]
+		if module, ok := m.Modules[moduleName].(HasOrderingDependencies); ok {
+			declared := module.OrderingDependencies()
+			deps.BeginBlock = append(append([]string{}, declared.BeginBlock...), deps.BeginBlock...)
+			deps.EndBlock = append(append([]string{}, declared.EndBlock...), deps.EndBlock...)
+			deps.InitGenesis = append(append([]string{}, declared.InitGenesis...), deps.InitGenesis...)
+		}
+
+		violations = append(violations, m.orderViolations("BeginBlock", m.OrderBeginBlockers, moduleName, deps.BeginBlock)...)
+		violations = append(violations, m.orderViolations("EndBlock", m.OrderEndBlockers, moduleName, deps.EndBlock)...)
+		violations = append(violations, m.orderViolations("InitGenesis", m.OrderInitGenesis, moduleName, deps.InitGenesis)...)
+	}
+
+	if len(violations) != 0 {
+		return sdkerrors.ErrLogic.Wrapf("invalid module ordering:\n%s", strings.Join(violations, "\n"))
+	}
+	return nil
+}
+
+// orderViolations returns a diagnostic for every dependency of moduleName not running
+// before it in order
+func (m *Manager) orderViolations(phase string, order []string, moduleName string, deps []string) []string {
+	positions := make(map[string]int, len(order))
+	for i, name := range order {
+		positions[name] = i
+	}
+
+	var violations []string
+	for _, dep := range deps {
+		if _, ok := m.Modules[dep]; !ok {
+			continue
+		}
+		depPos, depFound := positions[dep]
+		pos, found := positions[moduleName]
+		switch {
+		case !found:
+			violations = append(violations, fmt.Sprintf("%s: %s must run after %s, but %s is missing from the order", phase, moduleName, dep, moduleName))
+		case !depFound:
+			violations = append(violations, fmt.Sprintf("%s: %s must run after %s, but %s is missing from the order", phase, moduleName, dep, dep))
+		case depPos > pos:
+			violations = append(violations, fmt.Sprintf("%s: %s must run after %s, but runs at position %d before %s at position %d", phase, moduleName, dep, pos, dep, depPos))
+		}
+	}
+	return violations
+}
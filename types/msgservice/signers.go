@@ -0,0 +1,221 @@
+package msgservice
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+	proto2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// descriptorMessage is a message generated by the gogoproto compiler, exposing
+// its descriptor.
+type descriptorMessage interface {
+	proto.Message
+	Descriptor() ([]byte, []int)
+}
+
+// signerFieldsCache caches the signer fields of the message types, by
+// reflect.Type.
+var signerFieldsCache sync.Map
+
+// GetMsgSigners returns the signers of msg, read from the fields named by its
+// cosmos.msg.v1.signer option. A signer field holds the bech32 account or
+// validator operator address of a signer, or a message whose own signer
+// fields hold the signers, and may be repeated. The signers of the messages
+// which don't declare any signer field are returned by their GetSigners
+// method.
+func GetMsgSigners(msg sdk.Msg) ([]sdk.AccAddress, error) {
+	fields, err := signerFields(msg)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return msg.GetSigners(), nil
+	}
+
+	return extractSigners(msg, fields)
+}
+
+// signerFields returns the names of the signer fields of msg declared by its
+// cosmos.msg.v1.signer option.
+func signerFields(msg proto.Message) ([]string, error) {
+	typ := reflect.TypeOf(msg)
+	if fields, ok := signerFieldsCache.Load(typ); ok {
+		return fields.([]string), nil
+	}
+
+	dm, ok := msg.(descriptorMessage)
+	if !ok {
+		signerFieldsCache.Store(typ, []string(nil))
+		return nil, nil
+	}
+
+	gz, path := dm.Descriptor()
+	fdRaw := &descriptorpb.FileDescriptorProto{}
+	if err := proto2.Unmarshal(unzip(gz), fdRaw); err != nil {
+		return nil, fmt.Errorf("invalid file descriptor of %T: %w", msg, err)
+	}
+
+	md := fdRaw.MessageType[path[0]]
+	for _, i := range path[1:] {
+		md = md.NestedType[i]
+	}
+
+	// the extension is not registered with the protobuf API v2, so that it is
+	// left in the unknown fields of the options
+	var fields []string
+	if opts := md.GetOptions(); opts != nil {
+		unknown := opts.ProtoReflect().GetUnknown()
+		for len(unknown) > 0 {
+			num, wireType, n := protowire.ConsumeTag(unknown)
+			if n < 0 {
+				return nil, fmt.Errorf("invalid options of %T: %w", msg, protowire.ParseError(n))
+			}
+			unknown = unknown[n:]
+
+			if num == protowire.Number(E_Signer.Field) && wireType == protowire.BytesType {
+				field, n := protowire.ConsumeString(unknown)
+				if n < 0 {
+					return nil, fmt.Errorf("invalid signer option of %T: %w", msg, protowire.ParseError(n))
+				}
+				fields = append(fields, field)
+				unknown = unknown[n:]
+				continue
+			}
+
+			n = protowire.ConsumeFieldValue(num, wireType, unknown)
+			if n < 0 {
+				return nil, fmt.Errorf("invalid options of %T: %w", msg, protowire.ParseError(n))
+			}
+			unknown = unknown[n:]
+		}
+	}
+
+	signerFieldsCache.Store(typ, fields)
+
+	return fields, nil
+}
+
+// extractSigners returns the signers held by the signer fields of msg.
+func extractSigners(msg proto.Message, fields []string) ([]sdk.AccAddress, error) {
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, fmt.Errorf("nil %T", msg)
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("unsupported message type %T", msg)
+	}
+
+	var signers []sdk.AccAddress
+	for _, name := range fields {
+		field, ok := fieldByProtoName(v, name)
+		if !ok {
+			return nil, fmt.Errorf("signer field %s not found in %T", name, msg)
+		}
+
+		fieldSigners, err := signersOf(field, name, msg)
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, fieldSigners...)
+	}
+
+	return signers, nil
+}
+
+// signersOf returns the signers held by the value of the signer field name of
+// msg.
+func signersOf(field reflect.Value, name string, msg proto.Message) ([]sdk.AccAddress, error) {
+	switch field.Kind() {
+	case reflect.String:
+		signer, err := signerAddress(field.String())
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "signer field %s of %T", name, msg)
+		}
+		return []sdk.AccAddress{signer}, nil
+
+	case reflect.Slice:
+		if field.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+
+		var signers []sdk.AccAddress
+		for i := 0; i < field.Len(); i++ {
+			elemSigners, err := signersOf(field.Index(i), name, msg)
+			if err != nil {
+				return nil, err
+			}
+			signers = append(signers, elemSigners...)
+		}
+		return signers, nil
+
+	case reflect.Ptr, reflect.Struct:
+		if field.Kind() == reflect.Ptr && field.IsNil() {
+			return nil, fmt.Errorf("signer field %s of %T is empty", name, msg)
+		}
+		if field.Kind() == reflect.Struct {
+			ptr := reflect.New(field.Type())
+			ptr.Elem().Set(field)
+			field = ptr
+		}
+
+		nested, ok := field.Interface().(proto.Message)
+		if !ok {
+			break
+		}
+
+		nestedFields, err := signerFields(nested)
+		if err != nil {
+			return nil, err
+		}
+		if len(nestedFields) == 0 {
+			return nil, fmt.Errorf("signer field %s of %T holds %T, which declares no signer field", name, msg, nested)
+		}
+
+		return extractSigners(nested, nestedFields)
+	}
+
+	return nil, fmt.Errorf("signer field %s of %T has unsupported type %s", name, msg, field.Type())
+}
+
+// fieldByProtoName returns the field of the message struct v whose protobuf
+// name is name.
+func fieldByProtoName(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		for _, part := range strings.Split(t.Field(i).Tag.Get("protobuf"), ",") {
+			if part == "name="+name {
+				return v.Field(i), true
+			}
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// signerAddress decodes the bech32 account or validator operator address of a
+// signer.
+func signerAddress(addr string) (sdk.AccAddress, error) {
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err == nil {
+		return accAddr, nil
+	}
+
+	valAddr, valErr := sdk.ValAddressFromBech32(addr)
+	if valErr != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address %q: %s", addr, err)
+	}
+
+	return sdk.AccAddress(valAddr), nil
+}
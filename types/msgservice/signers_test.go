@@ -0,0 +1,88 @@
+package msgservice_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestGetMsgSigners(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	testCases := []struct {
+		name      string
+		msg       sdk.Msg
+		expected  []sdk.AccAddress
+		expErrMsg string
+	}{
+		{
+			"string field",
+			banktypes.NewMsgSend(addr1, addr2, coins),
+			[]sdk.AccAddress{addr1},
+			"",
+		},
+		{
+			"repeated message field",
+			banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(addr1, coins), banktypes.NewInput(addr2, coins)},
+				[]banktypes.Output{banktypes.NewOutput(addr1, coins.Add(coins...))},
+			),
+			[]sdk.AccAddress{addr1, addr2},
+			"",
+		},
+		{
+			"validator operator address",
+			slashingtypes.NewMsgUnjail(sdk.ValAddress(addr1)),
+			[]sdk.AccAddress{addr1},
+			"",
+		},
+		{
+			"group exec",
+			&group.MsgExec{ProposalId: 1, Executor: addr2.String()},
+			[]sdk.AccAddress{addr2},
+			"",
+		},
+		{
+			"no signer option",
+			testdata.NewTestMsg(addr2, addr1),
+			[]sdk.AccAddress{addr2, addr1},
+			"",
+		},
+		{
+			"invalid address",
+			&banktypes.MsgSend{FromAddress: "foo", ToAddress: addr2.String(), Amount: coins},
+			nil,
+			"invalid signer address",
+		},
+		{
+			"empty address",
+			&stakingtypes.MsgDelegate{ValidatorAddress: sdk.ValAddress(addr1).String()},
+			nil,
+			"signer field delegator_address",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			signers, err := msgservice.GetMsgSigners(tc.msg)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, signers)
+			require.Equal(t, tc.msg.GetSigners(), signers)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"strconv"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultMaxExecDepth is the default maximum depth at which the messages
+// nested in other messages can be executed, e.g. the messages of an authz
+// MsgExec nested in a MsgExec executed by a gov proposal are at depth 3.
+const DefaultMaxExecDepth = 5
+
+// Event type and attribute keys of the nested messages executed.
+const (
+	EventTypeNestedMsg = "nested_msg"
+
+	AttributeKeyExecDepth = "exec_depth"
+	AttributeKeyGasUsed   = "gas_used"
+)
+
+// ExecNestedMsg executes with handler msg nested in the message executed by
+// ctx, e.g. by an authz MsgExec, a gov or a group proposal, one exec depth
+// deeper. It fails with ErrMaxExecDepth, without executing msg, if it would
+// exceed the max exec depth of ctx.
+//
+// Once msg is executed, a nested_msg event is emitted with its type URL, its
+// exec depth and the gas it used, including the gas of the messages nested in
+// it, so that the gas of a tx can be attributed to each level of nesting.
+func ExecNestedMsg(ctx Context, msg Msg, handler func(ctx Context, msg Msg) (*Result, error)) (*Result, error) {
+	depth := ctx.ExecDepth() + 1
+	if depth > ctx.MaxExecDepth() {
+		return nil, sdkerrors.ErrMaxExecDepth.Wrapf("%s at depth %d, max %d", MsgTypeURL(msg), depth, ctx.MaxExecDepth())
+	}
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	res, err := handler(ctx.WithExecDepth(depth), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(NewEvent(EventTypeNestedMsg,
+		NewAttribute(AttributeKeyTypeURL, MsgTypeURL(msg)),
+		NewAttribute(AttributeKeyExecDepth, strconv.Itoa(depth)),
+		NewAttribute(AttributeKeyGasUsed, strconv.FormatUint(ctx.GasMeter().GasConsumed()-gasBefore, 10)),
+	))
+
+	return res, nil
+}
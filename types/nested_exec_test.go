@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestExecNestedMsg(t *testing.T) {
+	msg := testdata.NewTestMsg()
+
+	// the handler consumes 10 gas and executes the msg again, nested in
+	// itself, until the given depth
+	nestedHandler := func(depth int) func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		var handler func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error)
+		handler = func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			ctx.GasMeter().ConsumeGas(10, "test")
+			if ctx.ExecDepth() < depth {
+				return sdk.ExecNestedMsg(ctx, msg, handler)
+			}
+			return &sdk.Result{}, nil
+		}
+		return handler
+	}
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+	require.Equal(t, sdk.DefaultMaxExecDepth, ctx.MaxExecDepth())
+
+	_, err := sdk.ExecNestedMsg(ctx, msg, nestedHandler(3))
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), ctx.GasMeter().GasConsumed())
+	require.Zero(t, ctx.ExecDepth())
+
+	// the events of the deepest msgs come first, with the gas of their level
+	// and of the levels below
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 3)
+	for i, expected := range []struct{ depth, gas string }{{"3", "10"}, {"2", "20"}, {"1", "30"}} {
+		require.Equal(t, sdk.EventTypeNestedMsg, events[i].Type)
+		require.Equal(t, sdk.MsgTypeURL(msg), string(events[i].Attributes[0].Value))
+		require.Equal(t, expected.depth, string(events[i].Attributes[1].Value))
+		require.Equal(t, expected.gas, string(events[i].Attributes[2].Value))
+	}
+
+	ctx = sdk.NewContext(nil, tmproto.Header{}, false, nil).WithMaxExecDepth(2)
+	_, err = sdk.ExecNestedMsg(ctx, msg, nestedHandler(3))
+	require.ErrorIs(t, err, sdkerrors.ErrMaxExecDepth)
+	require.Equal(t, uint64(20), ctx.GasMeter().GasConsumed())
+	require.Empty(t, ctx.EventManager().Events())
+}
@@ -0,0 +1,189 @@
+package query
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	// cursorVersion is the version of the layout of the cursors returned by
+	// GenericPaginate.
+	cursorVersion byte = 1
+	// cursorReverse is the flag of the cursors of the reverse paginations.
+	cursorReverse byte = 1 << 0
+	// cursorPrefixLen is the length of the hash of the prefix of the store a
+	// cursor was returned for.
+	cursorPrefixLen = 8
+	// cursorHeaderLen is the length of the version, the flags and the hash of
+	// the prefix preceding the key of a cursor.
+	cursorHeaderLen = 2 + cursorPrefixLen
+)
+
+// GenericPaginate does pagination of all the values stored under prefixBz in
+// store based on the provided PageRequest, decoding them into a slice of T.
+//
+// Unlike Paginate, the NextKey it returns is an opaque cursor, which encodes
+// the direction of the pagination and the prefix it was returned for, so that
+// it is rejected if used to paginate another prefix or in the other direction.
+// It is not compatible with the keys returned by Paginate.
+//
+// If the total count is requested and the store can estimate the number of its
+// keys, e.g. when it is backed by an IAVL tree, the values after the page are
+// not iterated over and the total is an estimate, not taking into account the
+// writes pending in the branches of the store. The total is then also returned
+// when paginating with a key.
+func GenericPaginate[T any, PT interface {
+	*T
+	codec.ProtoMarshaler
+}](
+	cdc codec.BinaryCodec,
+	store types.KVStore,
+	prefixBz []byte,
+	pageRequest *PageRequest,
+) ([]T, *PageResponse, error) {
+	// if the PageRequest is nil, use default PageRequest
+	if pageRequest == nil {
+		pageRequest = &PageRequest{}
+	}
+
+	offset := pageRequest.Offset
+	limit := pageRequest.Limit
+	countTotal := pageRequest.CountTotal
+	reverse := pageRequest.Reverse
+
+	if offset > 0 && pageRequest.Key != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+	}
+
+	if limit == 0 {
+		limit = DefaultLimit
+
+		// count total results when the limit is zero/not supplied
+		countTotal = true
+	}
+
+	var key []byte
+	if len(pageRequest.Key) != 0 {
+		var err error
+		key, err = decodeCursor(pageRequest.Key, prefixBz, reverse)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	prefixStore := prefix.NewStore(store, prefixBz)
+
+	var (
+		total     uint64
+		estimated bool
+	)
+	if countTotal {
+		total, estimated = prefixStore.EstimateKeyCount(nil, nil)
+	}
+
+	var iterator types.Iterator
+	switch {
+	case key == nil && reverse:
+		iterator = prefixStore.ReverseIterator(nil, nil)
+	case key == nil:
+		iterator = prefixStore.Iterator(nil, nil)
+	case reverse:
+		// the cursor is the first key of the page, the end of the domain of
+		// the iterator being exclusive
+		iterator = prefixStore.ReverseIterator(nil, append(append([]byte{}, key...), 0))
+	default:
+		iterator = prefixStore.Iterator(key, nil)
+	}
+	defer iterator.Close()
+
+	var (
+		results []T
+		nextKey []byte
+		count   uint64
+	)
+	end := offset + limit
+
+	for ; iterator.Valid(); iterator.Next() {
+		if err := iterator.Error(); err != nil {
+			return nil, nil, err
+		}
+
+		count++
+
+		if count <= offset {
+			continue
+		}
+		if count > end {
+			nextKey = encodeCursor(iterator.Key(), prefixBz, reverse)
+
+			// the offset paginations count the values after the page, unless
+			// their count is estimated
+			if key != nil || !countTotal || estimated {
+				break
+			}
+			continue
+		}
+
+		var result T
+		if err := cdc.Unmarshal(iterator.Value(), PT(&result)); err != nil {
+			return nil, nil, err
+		}
+		results = append(results, result)
+	}
+
+	res := &PageResponse{NextKey: nextKey}
+	switch {
+	case estimated:
+		res.Total = total
+	case countTotal && key == nil:
+		res.Total = count
+	}
+
+	return results, res, nil
+}
+
+// cursorPrefix returns the hash of the prefix a cursor is returned for.
+func cursorPrefix(prefixBz []byte) []byte {
+	hash := sha256.Sum256(prefixBz)
+	return hash[:cursorPrefixLen]
+}
+
+// encodeCursor returns the cursor of the pagination of the values stored under
+// prefixBz, in reverse order or not, starting at key.
+func encodeCursor(key, prefixBz []byte, reverse bool) []byte {
+	var flags byte
+	if reverse {
+		flags |= cursorReverse
+	}
+
+	cursor := make([]byte, 0, cursorHeaderLen+len(key))
+	cursor = append(cursor, cursorVersion, flags)
+	cursor = append(cursor, cursorPrefix(prefixBz)...)
+	return append(cursor, key...)
+}
+
+// decodeCursor returns the key a cursor starts the pagination at, and an error
+// if it was not returned by the pagination of the values stored under prefixBz,
+// in reverse order or not.
+func decodeCursor(cursor, prefixBz []byte, reverse bool) ([]byte, error) {
+	if len(cursor) <= cursorHeaderLen || cursor[0] != cursorVersion || cursor[1]&^cursorReverse != 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+	}
+
+	if (cursor[1]&cursorReverse != 0) != reverse {
+		return nil, status.Error(codes.InvalidArgument, "pagination key was returned for the other direction")
+	}
+
+	if !bytes.Equal(cursor[2:cursorHeaderLen], cursorPrefix(prefixBz)) {
+		return nil, status.Error(codes.InvalidArgument, "pagination key was returned for another query")
+	}
+
+	return cursor[cursorHeaderLen:], nil
+}
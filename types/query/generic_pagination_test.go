@@ -0,0 +1,132 @@
+package query_test
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+const numCoins = 25
+
+var (
+	coinsPrefix = []byte("coins/")
+	otherPrefix = []byte("other/")
+)
+
+// setupCoinsStore returns an IAVL store holding numCoins coins under
+// coinsPrefix, sorted by denom, and a coin under otherPrefix.
+func (s *paginationTestSuite) setupCoinsStore() (storetypes.KVStore, []sdk.Coin) {
+	key := sdk.NewKVStoreKey("coins")
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	s.Require().NoError(ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	kvStore := ctx.KVStore(key)
+	cdc := simapp.MakeTestEncodingConfig().Codec
+
+	coins := make([]sdk.Coin, numCoins)
+	for i := range coins {
+		coins[i] = sdk.NewInt64Coin(fmt.Sprintf("denom%02d", i), int64(i+1))
+		kvStore.Set(append(coinsPrefix, coins[i].Denom...), cdc.MustMarshal(&coins[i]))
+	}
+	other := sdk.NewInt64Coin("other", 1)
+	kvStore.Set(append(otherPrefix, other.Denom...), cdc.MustMarshal(&other))
+
+	return kvStore, coins
+}
+
+func (s *paginationTestSuite) TestGenericPaginate() {
+	kvStore, coins := s.setupCoinsStore()
+	cdc := simapp.MakeTestEncodingConfig().Codec
+
+	s.T().Log("verify empty page request decodes defaultLimit values and counts total values")
+	res, pageRes, err := query.GenericPaginate[sdk.Coin](cdc, kvStore, coinsPrefix, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(coins, res)
+	s.Require().Nil(pageRes.NextKey)
+	s.Require().Equal(uint64(numCoins), pageRes.Total)
+
+	s.T().Log("verify paginating with offset and limit")
+	res, pageRes, err = query.GenericPaginate[sdk.Coin](cdc, kvStore, coinsPrefix, &query.PageRequest{Offset: 5, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Equal(coins[5:15], res)
+	s.Require().NotNil(pageRes.NextKey)
+	s.Require().Equal(uint64(0), pageRes.Total)
+
+	for _, reverse := range []bool{false, true} {
+		s.T().Logf("verify paginating with the returned keys, reverse: %t", reverse)
+		var all []sdk.Coin
+		pageReq := &query.PageRequest{Limit: 7, CountTotal: true, Reverse: reverse}
+		for {
+			res, pageRes, err = query.GenericPaginate[sdk.Coin](cdc, kvStore, coinsPrefix, pageReq)
+			s.Require().NoError(err)
+			s.Require().LessOrEqual(len(res), 7)
+			// the total is estimated, and thus returned with a key too
+			s.Require().Equal(uint64(numCoins), pageRes.Total)
+
+			all = append(all, res...)
+			if pageRes.NextKey == nil {
+				break
+			}
+			pageReq.Key = pageRes.NextKey
+		}
+
+		expected := append([]sdk.Coin{}, coins...)
+		if reverse {
+			for i, j := 0, len(expected)-1; i < j; i, j = i+1, j-1 {
+				expected[i], expected[j] = expected[j], expected[i]
+			}
+		}
+		s.Require().Equal(expected, all)
+	}
+
+	s.T().Log("verify the total is counted when the store cannot estimate it")
+	memStore := dbadapter.Store{DB: dbm.NewMemDB()}
+	for i := range coins {
+		memStore.Set(append(coinsPrefix, coins[i].Denom...), cdc.MustMarshal(&coins[i]))
+	}
+	res, pageRes, err = query.GenericPaginate[sdk.Coin](cdc, memStore, coinsPrefix, &query.PageRequest{Limit: 10, CountTotal: true})
+	s.Require().NoError(err)
+	s.Require().Equal(coins[:10], res)
+	s.Require().Equal(uint64(numCoins), pageRes.Total)
+}
+
+func (s *paginationTestSuite) TestGenericPaginateInvalidKey() {
+	kvStore, _ := s.setupCoinsStore()
+	cdc := simapp.MakeTestEncodingConfig().Codec
+
+	_, pageRes, err := query.GenericPaginate[sdk.Coin](cdc, kvStore, coinsPrefix, &query.PageRequest{Limit: 10})
+	s.Require().NoError(err)
+	nextKey := pageRes.NextKey
+
+	testCases := []struct {
+		name    string
+		prefix  []byte
+		pageReq *query.PageRequest
+	}{
+		{"key and offset", coinsPrefix, &query.PageRequest{Key: nextKey, Offset: 1}},
+		{"other direction", coinsPrefix, &query.PageRequest{Key: nextKey, Reverse: true}},
+		{"other prefix", otherPrefix, &query.PageRequest{Key: nextKey}},
+		{"raw key", coinsPrefix, &query.PageRequest{Key: []byte("denom10")}},
+		{"truncated key", coinsPrefix, &query.PageRequest{Key: nextKey[:10]}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, _, err := query.GenericPaginate[sdk.Coin](cdc, kvStore, tc.prefix, tc.pageReq)
+			s.Require().Error(err)
+			s.Require().Equal(codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
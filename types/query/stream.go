@@ -0,0 +1,48 @@
+package query
+
+import (
+	"context"
+
+	"google.golang.org/grpc/status"
+)
+
+// StreamPages streams the pages of a paginated query over a gRPC server stream,
+// from the page of pageReq to the last one. queryPage queries the page of its
+// page request, sends it over the stream and returns its page response. The
+// pages following the first one are queried by key with the limit of pageReq,
+// DefaultLimit if not set, the offset and count_total of pageReq only applying
+// to the first page.
+//
+// A page is only queried once the previous one is sent, sending blocking while
+// the client doesn't receive the pages, so that the pages of a slow client are
+// not buffered. The stream stops when ctx is done, e.g. on the deadline of the
+// stream or when the client cancels it.
+func StreamPages(ctx context.Context, pageReq *PageRequest, queryPage func(pageReq *PageRequest) (*PageResponse, error)) error {
+	if pageReq == nil {
+		pageReq = &PageRequest{}
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+
+	req := *pageReq
+	req.Limit = limit
+	for {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		pageRes, err := queryPage(&req)
+		if err != nil {
+			return err
+		}
+
+		if pageRes == nil || len(pageRes.NextKey) == 0 {
+			return nil
+		}
+
+		req = PageRequest{Key: pageRes.NextKey, Limit: limit, Reverse: pageReq.Reverse}
+	}
+}
@@ -0,0 +1,105 @@
+package query_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// pagedItems pages the items by key, the key of an item being its index.
+func pagedItems(items []string, pageReq *query.PageRequest) ([]string, *query.PageResponse) {
+	start := int(pageReq.Offset)
+	if len(pageReq.Key) > 0 {
+		fmt.Sscan(string(pageReq.Key), &start)
+	}
+
+	end := start + int(pageReq.Limit)
+	if end >= len(items) {
+		return items[start:], &query.PageResponse{}
+	}
+	return items[start:end], &query.PageResponse{NextKey: []byte(fmt.Sprint(end))}
+}
+
+func TestStreamPages(t *testing.T) {
+	items := make([]string, 250)
+	for i := range items {
+		items[i] = fmt.Sprint(i)
+	}
+
+	testCases := []struct {
+		msg      string
+		pageReq  *query.PageRequest
+		expPages []int
+	}{
+		{"nil page request", nil, []int{100, 100, 50}},
+		{"limit", &query.PageRequest{Limit: 120}, []int{120, 120, 10}},
+		{"offset of the first page", &query.PageRequest{Offset: 200, Limit: 30}, []int{30, 20}},
+		{"single page", &query.PageRequest{Limit: 300}, []int{250}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			var pages [][]string
+			err := query.StreamPages(context.Background(), tc.pageReq, func(pageReq *query.PageRequest) (*query.PageResponse, error) {
+				page, pageRes := pagedItems(items, pageReq)
+				pages = append(pages, page)
+				return pageRes, nil
+			})
+			require.NoError(t, err)
+
+			var streamed []string
+			for i, page := range pages {
+				require.Len(t, page, tc.expPages[i])
+				streamed = append(streamed, page...)
+			}
+			require.Len(t, pages, len(tc.expPages))
+
+			var offset uint64
+			if tc.pageReq != nil {
+				offset = tc.pageReq.Offset
+			}
+			require.Equal(t, items[offset:], streamed)
+		})
+	}
+}
+
+func TestStreamPages_Stop(t *testing.T) {
+	items := make([]string, 250)
+
+	// the error of a page stops the stream
+	errSend := errors.New("send failed")
+	calls := 0
+	err := query.StreamPages(context.Background(), nil, func(pageReq *query.PageRequest) (*query.PageResponse, error) {
+		calls++
+		return nil, errSend
+	})
+	require.ErrorIs(t, err, errSend)
+	require.Equal(t, 1, calls)
+
+	// the stream stops before the next page once its context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	calls = 0
+	err = query.StreamPages(ctx, nil, func(pageReq *query.PageRequest) (*query.PageResponse, error) {
+		calls++
+		cancel()
+		_, pageRes := pagedItems(items, pageReq)
+		return pageRes, nil
+	})
+	require.Equal(t, codes.Canceled, status.Code(err))
+	require.Equal(t, 1, calls)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	err = query.StreamPages(ctx, nil, func(pageReq *query.PageRequest) (*query.PageResponse, error) {
+		t.Fatal("no page expected after the deadline")
+		return nil, nil
+	})
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
@@ -0,0 +1,165 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// SignedCoins is a set of Coin, one per currency, whose amounts can be
+// negative, e.g. to track the per denom deltas of fee refunds or rebalancing.
+// A valid SignedCoins is sorted, has unique and valid denominations and no
+// zero amounts.
+type SignedCoins []Coin
+
+// NewSignedCoins constructs a new signed coin set. The provided coins will be
+// sanitized by removing zero coins and sorting the coin set. A panic will occur
+// if the coin set is not valid.
+func NewSignedCoins(coins ...Coin) SignedCoins {
+	newCoins := SignedCoins(sanitizeCoins(coins))
+	if err := newCoins.Validate(); err != nil {
+		panic(fmt.Errorf("invalid signed coin set %s: %w", newCoins, err))
+	}
+
+	return newCoins
+}
+
+// SignedCoinsFromCoins returns the signed coin set of the same value as coins.
+func SignedCoinsFromCoins(coins Coins) SignedCoins {
+	return append(SignedCoins(nil), removeZeroCoins(coins)...)
+}
+
+// ToCoins converts the signed coin set to Coins. It returns an error if the
+// set is invalid or has a negative amount.
+func (coins SignedCoins) ToCoins() (Coins, error) {
+	if err := coins.Validate(); err != nil {
+		return nil, err
+	}
+	if Coins(coins).IsAnyNegative() {
+		return nil, fmt.Errorf("coin set %s has a negative amount", coins)
+	}
+
+	return append(Coins(nil), coins...), nil
+}
+
+// Split returns the positive and the negated negative amounts of the set, so
+// that coins equals positive - negative.
+func (coins SignedCoins) Split() (positive, negative Coins) {
+	for _, coin := range coins {
+		switch {
+		case coin.IsPositive():
+			positive = append(positive, coin)
+		case coin.IsNegative():
+			negative = append(negative, Coin{coin.Denom, coin.Amount.Neg()})
+		}
+	}
+
+	return positive, negative
+}
+
+type signedCoinsJSON SignedCoins
+
+// MarshalJSON implements a custom JSON marshaller for the SignedCoins type to
+// allow nil SignedCoins to be encoded as an empty array.
+func (coins SignedCoins) MarshalJSON() ([]byte, error) {
+	if coins == nil {
+		return json.Marshal(signedCoinsJSON(SignedCoins{}))
+	}
+
+	return json.Marshal(signedCoinsJSON(coins))
+}
+
+func (coins SignedCoins) String() string {
+	return Coins(coins).String()
+}
+
+// Validate checks that the SignedCoins are sorted, have non-zero amounts, with
+// a valid and unique denomination (i.e no duplicates). Otherwise, it returns
+// an error.
+func (coins SignedCoins) Validate() error {
+	lowDenom := ""
+	for i, coin := range coins {
+		if err := ValidateDenom(coin.Denom); err != nil {
+			return err
+		}
+		if coin.IsNil() || coin.IsZero() {
+			return fmt.Errorf("coin %s amount is zero", coin.Denom)
+		}
+		if i > 0 {
+			if coin.Denom == lowDenom {
+				return fmt.Errorf("duplicate denomination %s", coin.Denom)
+			}
+			if coin.Denom < lowDenom {
+				return fmt.Errorf("denomination %s is not sorted", coin.Denom)
+			}
+		}
+
+		lowDenom = coin.Denom
+	}
+
+	return nil
+}
+
+// IsValid calls Validate and returns true when the SignedCoins are sorted,
+// have non-zero amounts, with a valid and unique denomination.
+func (coins SignedCoins) IsValid() bool {
+	return coins.Validate() == nil
+}
+
+// Add adds two sets of signed coins, removing the denominations whose sum is
+// zero.
+//
+// e.g.
+// {2A} + {-A, 2B} = {A, 2B}
+// {2A} + {-2A} = {}
+//
+// The function panics if `coins` or `coinsB` are not sorted (ascending).
+func (coins SignedCoins) Add(coinsB ...Coin) SignedCoins {
+	return SignedCoins(Coins(coins).safeAdd(coinsB))
+}
+
+// Sub subtracts a set of signed coins from another, removing the
+// denominations whose difference is zero.
+//
+// e.g.
+// {2A, 3B} - {A} = {A, 3B}
+// {A} - {2A, B} = {-A, -B}
+//
+// The function panics if `coins` or `coinsB` are not sorted (ascending).
+func (coins SignedCoins) Sub(coinsB ...Coin) SignedCoins {
+	return coins.Add(Coins(coinsB).negative()...)
+}
+
+// Neg returns the set of coins with all amounts negated.
+func (coins SignedCoins) Neg() SignedCoins {
+	if coins == nil {
+		return nil
+	}
+
+	return SignedCoins(Coins(coins).negative())
+}
+
+// IsZero returns true if there are no coins or all coins are zero.
+func (coins SignedCoins) IsZero() bool {
+	return Coins(coins).IsZero()
+}
+
+// IsEqual returns true if the two sets of signed coins have the same value.
+func (coins SignedCoins) IsEqual(coinsB SignedCoins) bool {
+	return Coins(coins).IsEqual(Coins(coinsB))
+}
+
+// AmountOf returns the signed amount of a denom from coins.
+func (coins SignedCoins) AmountOf(denom string) Int {
+	return Coins(coins).AmountOf(denom)
+}
+
+// Sort is a helper function to sort the set of signed coins in-place.
+func (coins SignedCoins) Sort() SignedCoins {
+	sort.Sort(Coins(coins))
+	return coins
+}
+
+func (scp SignedCoinsProto) String() string {
+	return scp.Coins.String()
+}
@@ -0,0 +1,121 @@
+package types_test
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *coinTestSuite) TestSignedCoinsValidate() {
+	neg := func(denom string, amount int64) sdk.Coin { return sdk.Coin{denom, sdk.NewInt(amount)} }
+
+	testCases := []struct {
+		name   string
+		coins  sdk.SignedCoins
+		expErr bool
+	}{
+		{"empty", sdk.SignedCoins{}, false},
+		{"positive", sdk.SignedCoins{s.ca1, s.cm2}, false},
+		{"negative", sdk.SignedCoins{neg(testDenom1, -1), neg(testDenom2, -2)}, false},
+		{"mixed", sdk.SignedCoins{neg(testDenom1, -1), s.cm1}, false},
+		{"zero", sdk.SignedCoins{s.ca0}, true},
+		{"nil amount", sdk.SignedCoins{{Denom: testDenom1}}, true},
+		{"unsorted", sdk.SignedCoins{s.cm1, neg(testDenom1, -1)}, true},
+		{"duplicate", sdk.SignedCoins{neg(testDenom1, -1), s.ca1}, true},
+		{"invalid denom", sdk.SignedCoins{neg("1atom", -1)}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.coins.Validate()
+		if tc.expErr {
+			s.Require().Error(err, tc.name)
+		} else {
+			s.Require().NoError(err, tc.name)
+		}
+	}
+
+	s.Require().Panics(func() { sdk.NewSignedCoins(neg(testDenom1, -1), neg(testDenom1, 2)) })
+	s.Require().Equal(sdk.SignedCoins{neg(testDenom1, -1), s.cm1}, sdk.NewSignedCoins(s.cm1, s.ca0, neg(testDenom1, -1)))
+}
+
+func (s *coinTestSuite) TestSignedCoinsArithmetic() {
+	neg := func(denom string, amount int64) sdk.Coin { return sdk.Coin{denom, sdk.NewInt(amount)} }
+
+	testCases := []struct {
+		a, b   sdk.SignedCoins
+		expAdd sdk.SignedCoins
+		expSub sdk.SignedCoins
+	}{
+		{nil, nil, nil, nil},
+		{sdk.SignedCoins{s.ca2}, sdk.SignedCoins{neg(testDenom1, -1), s.cm2}, sdk.SignedCoins{s.ca1, s.cm2}, sdk.SignedCoins{neg(testDenom1, 3), neg(testDenom2, -2)}},
+		{sdk.SignedCoins{s.ca2}, sdk.SignedCoins{neg(testDenom1, -2)}, nil, sdk.SignedCoins{neg(testDenom1, 4)}},
+		{sdk.SignedCoins{s.ca1}, sdk.SignedCoins{s.ca2, s.cm1}, sdk.SignedCoins{neg(testDenom1, 3), s.cm1}, sdk.SignedCoins{neg(testDenom1, -1), neg(testDenom2, -1)}},
+		{sdk.SignedCoins{neg(testDenom2, -1)}, nil, sdk.SignedCoins{neg(testDenom2, -1)}, sdk.SignedCoins{neg(testDenom2, -1)}},
+	}
+
+	for i, tc := range testCases {
+		add := tc.a.Add(tc.b...)
+		sub := tc.a.Sub(tc.b...)
+		s.Require().True(add.IsEqual(tc.expAdd), "%d: %s + %s = %s", i, tc.a, tc.b, add)
+		s.Require().True(sub.IsEqual(tc.expSub), "%d: %s - %s = %s", i, tc.a, tc.b, sub)
+		s.Require().True(add.IsValid(), i)
+		s.Require().True(sub.IsValid(), i)
+		s.Require().True(sub.Neg().IsEqual(tc.b.Sub(tc.a...)), i)
+		s.Require().True(tc.a.Neg().Neg().IsEqual(tc.a), i)
+	}
+
+	s.Require().Panics(func() { sdk.SignedCoins{s.cm1, s.ca1}.Add(s.ca1) })
+	s.Require().Panics(func() { sdk.SignedCoins{s.ca1}.Sub(s.cm1, s.ca1) })
+	s.Require().Nil(sdk.SignedCoins(nil).Neg())
+}
+
+func (s *coinTestSuite) TestSignedCoinsConversion() {
+	coins := sdk.NewCoins(s.ca1, s.cm2)
+	signed := sdk.SignedCoinsFromCoins(coins)
+	s.Require().Equal(sdk.SignedCoins{s.ca1, s.cm2}, signed)
+	s.Require().Equal(sdk.NewInt(2), signed.AmountOf(testDenom2))
+
+	back, err := signed.ToCoins()
+	s.Require().NoError(err)
+	s.Require().Equal(coins, back)
+
+	// the conversion doesn't share the underlying array
+	signed[0].Amount = sdk.NewInt(5)
+	s.Require().Equal(s.ca1, coins[0])
+
+	delta := sdk.SignedCoinsFromCoins(sdk.NewCoins(s.ca1)).Sub(s.ca2, s.cm1)
+	s.Require().Equal(sdk.NewInt(-1), delta.AmountOf(testDenom1))
+	_, err = delta.ToCoins()
+	s.Require().Error(err)
+	_, err = sdk.SignedCoins{s.ca0}.ToCoins()
+	s.Require().Error(err)
+
+	positive, negative := delta.Add(sdk.NewInt64Coin("btc", 3)).Split()
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("btc", 3)), positive)
+	s.Require().Equal(sdk.NewCoins(s.ca1, s.cm1), negative)
+	s.Require().True(sdk.SignedCoinsFromCoins(positive).Sub(negative...).IsEqual(delta.Add(sdk.NewInt64Coin("btc", 3))))
+}
+
+func (s *coinTestSuite) TestSignedCoinsEncoding() {
+	coins := sdk.SignedCoins{sdk.Coin{testDenom1, sdk.NewInt(-10)}, s.cm2}
+
+	bz, err := json.Marshal(coins)
+	s.Require().NoError(err)
+	s.Require().Equal(`[{"denom":"atom","amount":"-10"},{"denom":"muon","amount":"2"}]`, string(bz))
+
+	var decoded sdk.SignedCoins
+	s.Require().NoError(json.Unmarshal(bz, &decoded))
+	s.Require().Equal(coins, decoded)
+
+	bz, err = json.Marshal(sdk.SignedCoins(nil))
+	s.Require().NoError(err)
+	s.Require().Equal("[]", string(bz))
+
+	bz, err = (&sdk.SignedCoinsProto{Coins: coins}).Marshal()
+	s.Require().NoError(err)
+
+	var decodedProto sdk.SignedCoinsProto
+	s.Require().NoError(decodedProto.Unmarshal(bz))
+	s.Require().Equal(coins, decodedProto.Coins)
+	s.Require().Equal("-10atom,2muon", decodedProto.String())
+}
@@ -45,8 +45,7 @@ func GetMsgs(anys []*types.Any, name string) ([]sdk.Msg, error) {
 // UnpackInterfaces unpacks Any's to sdk.Msg's.
 func UnpackInterfaces(unpacker types.AnyUnpacker, anys []*types.Any) error {
 	for _, any := range anys {
-		var msg sdk.Msg
-		err := unpacker.UnpackAny(any, &msg)
+		_, err := types.UnpackAnyAs[sdk.Msg](unpacker, any)
 		if err != nil {
 			return err
 		}
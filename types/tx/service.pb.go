@@ -8,22 +8,27 @@ import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types1 "github.com/tendermint/tendermint/proto/tendermint/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -78,6 +83,14 @@ const (
 	// BROADCAST_MODE_ASYNC defines a tx broadcasting mode where the client returns
 	// immediately.
 	BroadcastMode_BROADCAST_MODE_ASYNC BroadcastMode = 3
+	// BROADCAST_MODE_AWAIT_INCLUSION defines a tx broadcasting mode where the
+	// client waits for a CheckTx execution response, and then for the tx to be
+	// included in a block, until the await timeout or the tx timeout height
+	// expires. Unlike BROADCAST_MODE_BLOCK, it doesn't hold a Tendermint RPC
+	// connection open until the tx is committed, but requires the tx indexer.
+	//
+	// Since: cosmos-sdk 0.46
+	BroadcastMode_BROADCAST_MODE_AWAIT_INCLUSION BroadcastMode = 4
 )
 
 var BroadcastMode_name = map[int32]string{
@@ -85,13 +98,15 @@ var BroadcastMode_name = map[int32]string{
 	1: "BROADCAST_MODE_BLOCK",
 	2: "BROADCAST_MODE_SYNC",
 	3: "BROADCAST_MODE_ASYNC",
+	4: "BROADCAST_MODE_AWAIT_INCLUSION",
 }
 
 var BroadcastMode_value = map[string]int32{
-	"BROADCAST_MODE_UNSPECIFIED": 0,
-	"BROADCAST_MODE_BLOCK":       1,
-	"BROADCAST_MODE_SYNC":        2,
-	"BROADCAST_MODE_ASYNC":       3,
+	"BROADCAST_MODE_UNSPECIFIED":     0,
+	"BROADCAST_MODE_BLOCK":           1,
+	"BROADCAST_MODE_SYNC":            2,
+	"BROADCAST_MODE_ASYNC":           3,
+	"BROADCAST_MODE_AWAIT_INCLUSION": 4,
 }
 
 func (x BroadcastMode) String() string {
@@ -107,9 +122,18 @@ func (BroadcastMode) EnumDescriptor() ([]byte, []int) {
 type GetTxsEventRequest struct {
 	// events is the list of transaction event type.
 	Events []string `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	// pagination defines a pagination for the request.
+	// pagination defines a pagination for the request. The txs are paginated
+	// either by offset, or by cursor if the key is set, the key being the
+	// next_key of the previous page.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	OrderBy    OrderBy            `protobuf:"varint,3,opt,name=order_by,json=orderBy,proto3,enum=cosmos.tx.v1beta1.OrderBy" json:"order_by,omitempty"`
+	// order_by defines the order of the txs, by height and then by index in the
+	// block.
+	OrderBy OrderBy `protobuf:"varint,3,opt,name=order_by,json=orderBy,proto3,enum=cosmos.tx.v1beta1.OrderBy" json:"order_by,omitempty"`
+	// filter is a structured filter on the events of the txs, combined with the
+	// events with AND.
+	//
+	// Since: cosmos-sdk 0.46
+	Filter *EventFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
 func (m *GetTxsEventRequest) Reset()         { *m = GetTxsEventRequest{} }
@@ -166,6 +190,147 @@ func (m *GetTxsEventRequest) GetOrderBy() OrderBy {
 	return OrderBy_ORDER_BY_UNSPECIFIED
 }
 
+func (m *GetTxsEventRequest) GetFilter() *EventFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+// EventFilter filters the txs by their events. It either matches an event
+// attribute, or composes its sub-filters with AND or OR.
+//
+// Since: cosmos-sdk 0.46
+type EventFilter struct {
+	// match matches the txs with an event attribute.
+	Match *EventAttributeMatch `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	// all_of matches the txs matched by all the sub-filters.
+	AllOf []*EventFilter `protobuf:"bytes,2,rep,name=all_of,json=allOf,proto3" json:"all_of,omitempty"`
+	// any_of matches the txs matched by any of the sub-filters.
+	AnyOf []*EventFilter `protobuf:"bytes,3,rep,name=any_of,json=anyOf,proto3" json:"any_of,omitempty"`
+}
+
+func (m *EventFilter) Reset()         { *m = EventFilter{} }
+func (m *EventFilter) String() string { return proto.CompactTextString(m) }
+func (*EventFilter) ProtoMessage()    {}
+func (*EventFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{1}
+}
+func (m *EventFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFilter.Merge(m, src)
+}
+func (m *EventFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFilter proto.InternalMessageInfo
+
+func (m *EventFilter) GetMatch() *EventAttributeMatch {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (m *EventFilter) GetAllOf() []*EventFilter {
+	if m != nil {
+		return m.AllOf
+	}
+	return nil
+}
+
+func (m *EventFilter) GetAnyOf() []*EventFilter {
+	if m != nil {
+		return m.AnyOf
+	}
+	return nil
+}
+
+// EventAttributeMatch matches the txs with an event attribute of the given
+// value.
+//
+// Since: cosmos-sdk 0.46
+type EventAttributeMatch struct {
+	// event_type is the type of the event, e.g. message.
+	EventType string `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// attribute_key is the key of the attribute, e.g. action.
+	AttributeKey string `protobuf:"bytes,2,opt,name=attribute_key,json=attributeKey,proto3" json:"attribute_key,omitempty"`
+	// value is the value of the attribute.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *EventAttributeMatch) Reset()         { *m = EventAttributeMatch{} }
+func (m *EventAttributeMatch) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeMatch) ProtoMessage()    {}
+func (*EventAttributeMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{2}
+}
+func (m *EventAttributeMatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeMatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAttributeMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeMatch.Merge(m, src)
+}
+func (m *EventAttributeMatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributeMatch proto.InternalMessageInfo
+
+func (m *EventAttributeMatch) GetEventType() string {
+	if m != nil {
+		return m.EventType
+	}
+	return ""
+}
+
+func (m *EventAttributeMatch) GetAttributeKey() string {
+	if m != nil {
+		return m.AttributeKey
+	}
+	return ""
+}
+
+func (m *EventAttributeMatch) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 // GetTxsEventResponse is the response type for the Service.TxsByEvents
 // RPC method.
 type GetTxsEventResponse struct {
@@ -181,7 +346,7 @@ func (m *GetTxsEventResponse) Reset()         { *m = GetTxsEventResponse{} }
 func (m *GetTxsEventResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTxsEventResponse) ProtoMessage()    {}
 func (*GetTxsEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{1}
+	return fileDescriptor_e0b00a618705eca7, []int{3}
 }
 func (m *GetTxsEventResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -237,13 +402,18 @@ type BroadcastTxRequest struct {
 	// tx_bytes is the raw transaction.
 	TxBytes []byte        `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
 	Mode    BroadcastMode `protobuf:"varint,2,opt,name=mode,proto3,enum=cosmos.tx.v1beta1.BroadcastMode" json:"mode,omitempty"`
+	// await_timeout is the maximum duration to wait for the tx to be included in
+	// a block with BROADCAST_MODE_AWAIT_INCLUSION, 1 minute if unset.
+	//
+	// Since: cosmos-sdk 0.46
+	AwaitTimeout *time.Duration `protobuf:"bytes,3,opt,name=await_timeout,json=awaitTimeout,proto3,stdduration" json:"await_timeout,omitempty"`
 }
 
 func (m *BroadcastTxRequest) Reset()         { *m = BroadcastTxRequest{} }
 func (m *BroadcastTxRequest) String() string { return proto.CompactTextString(m) }
 func (*BroadcastTxRequest) ProtoMessage()    {}
 func (*BroadcastTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{2}
+	return fileDescriptor_e0b00a618705eca7, []int{4}
 }
 func (m *BroadcastTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,6 +456,13 @@ func (m *BroadcastTxRequest) GetMode() BroadcastMode {
 	return BroadcastMode_BROADCAST_MODE_UNSPECIFIED
 }
 
+func (m *BroadcastTxRequest) GetAwaitTimeout() *time.Duration {
+	if m != nil {
+		return m.AwaitTimeout
+	}
+	return nil
+}
+
 // BroadcastTxResponse is the response type for the
 // Service.BroadcastTx method.
 type BroadcastTxResponse struct {
@@ -297,7 +474,7 @@ func (m *BroadcastTxResponse) Reset()         { *m = BroadcastTxResponse{} }
 func (m *BroadcastTxResponse) String() string { return proto.CompactTextString(m) }
 func (*BroadcastTxResponse) ProtoMessage()    {}
 func (*BroadcastTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{3}
+	return fileDescriptor_e0b00a618705eca7, []int{5}
 }
 func (m *BroadcastTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,7 +526,7 @@ func (m *SimulateRequest) Reset()         { *m = SimulateRequest{} }
 func (m *SimulateRequest) String() string { return proto.CompactTextString(m) }
 func (*SimulateRequest) ProtoMessage()    {}
 func (*SimulateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{4}
+	return fileDescriptor_e0b00a618705eca7, []int{6}
 }
 func (m *SimulateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +583,7 @@ func (m *SimulateResponse) Reset()         { *m = SimulateResponse{} }
 func (m *SimulateResponse) String() string { return proto.CompactTextString(m) }
 func (*SimulateResponse) ProtoMessage()    {}
 func (*SimulateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{5}
+	return fileDescriptor_e0b00a618705eca7, []int{7}
 }
 func (m *SimulateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,7 +637,7 @@ func (m *GetTxRequest) Reset()         { *m = GetTxRequest{} }
 func (m *GetTxRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTxRequest) ProtoMessage()    {}
 func (*GetTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{6}
+	return fileDescriptor_e0b00a618705eca7, []int{8}
 }
 func (m *GetTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,7 +685,7 @@ func (m *GetTxResponse) Reset()         { *m = GetTxResponse{} }
 func (m *GetTxResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTxResponse) ProtoMessage()    {}
 func (*GetTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{7}
+	return fileDescriptor_e0b00a618705eca7, []int{9}
 }
 func (m *GetTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -566,7 +743,7 @@ func (m *GetBlockWithTxsRequest) Reset()         { *m = GetBlockWithTxsRequest{}
 func (m *GetBlockWithTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsRequest) ProtoMessage()    {}
 func (*GetBlockWithTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{8}
+	return fileDescriptor_e0b00a618705eca7, []int{10}
 }
 func (m *GetBlockWithTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,7 +802,7 @@ func (m *GetBlockWithTxsResponse) Reset()         { *m = GetBlockWithTxsResponse
 func (m *GetBlockWithTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsResponse) ProtoMessage()    {}
 func (*GetBlockWithTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{9}
+	return fileDescriptor_e0b00a618705eca7, []int{11}
 }
 func (m *GetBlockWithTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,6 +863,8 @@ func init() {
 	proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
 	proto.RegisterEnum("cosmos.tx.v1beta1.BroadcastMode", BroadcastMode_name, BroadcastMode_value)
 	proto.RegisterType((*GetTxsEventRequest)(nil), "cosmos.tx.v1beta1.GetTxsEventRequest")
+	proto.RegisterType((*EventFilter)(nil), "cosmos.tx.v1beta1.EventFilter")
+	proto.RegisterType((*EventAttributeMatch)(nil), "cosmos.tx.v1beta1.EventAttributeMatch")
 	proto.RegisterType((*GetTxsEventResponse)(nil), "cosmos.tx.v1beta1.GetTxsEventResponse")
 	proto.RegisterType((*BroadcastTxRequest)(nil), "cosmos.tx.v1beta1.BroadcastTxRequest")
 	proto.RegisterType((*BroadcastTxResponse)(nil), "cosmos.tx.v1beta1.BroadcastTxResponse")
@@ -700,68 +879,81 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 962 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xd1, 0x6e, 0x1a, 0x47,
-	0x14, 0xf5, 0x2e, 0xb6, 0x21, 0x17, 0x3b, 0x21, 0xe3, 0xd4, 0x26, 0x24, 0xc5, 0x64, 0x53, 0x6c,
-	0x07, 0xc9, 0xbb, 0x0a, 0x4d, 0xa5, 0xaa, 0xaa, 0x54, 0x79, 0x81, 0xb8, 0xa8, 0x4d, 0x88, 0x06,
-	0x57, 0x51, 0xaa, 0x4a, 0x68, 0x81, 0x09, 0xac, 0x62, 0x76, 0xf0, 0xce, 0x60, 0x2d, 0x72, 0xac,
-	0x4a, 0x7d, 0xec, 0x53, 0xd5, 0x3e, 0xf4, 0x23, 0xfa, 0x23, 0x7d, 0xb4, 0xd4, 0x97, 0x3e, 0x56,
-	0x76, 0x3f, 0xa0, 0x9f, 0x50, 0xed, 0xec, 0x00, 0x0b, 0x5e, 0xe2, 0xd4, 0xea, 0x8b, 0x3d, 0xc3,
-	0x9c, 0x7b, 0xef, 0x99, 0x33, 0x73, 0xee, 0x2c, 0x6c, 0xb6, 0x28, 0xeb, 0x51, 0x66, 0x70, 0xcf,
-	0x38, 0x7e, 0xdc, 0x24, 0xdc, 0x7a, 0x6c, 0x30, 0xe2, 0x1e, 0xdb, 0x2d, 0xa2, 0xf7, 0x5d, 0xca,
-	0x29, 0xba, 0x1d, 0x00, 0x74, 0xee, 0xe9, 0x12, 0x90, 0xb9, 0xdf, 0xa1, 0xb4, 0x73, 0x48, 0x0c,
-	0xab, 0x6f, 0x1b, 0x96, 0xe3, 0x50, 0x6e, 0x71, 0x9b, 0x3a, 0x2c, 0x08, 0xc8, 0x3c, 0x94, 0x19,
-	0x9b, 0x16, 0x23, 0x86, 0xd5, 0x6c, 0xd9, 0xe3, 0xc4, 0xfe, 0x44, 0x82, 0x32, 0x97, 0xcb, 0x72,
-	0x4f, 0xae, 0x15, 0xc2, 0x09, 0x8e, 0x06, 0xc4, 0x1d, 0x8e, 0x31, 0x7d, 0xab, 0x63, 0x3b, 0xa2,
-	0x9a, 0xc4, 0xde, 0xe7, 0xc4, 0x69, 0x13, 0xb7, 0x67, 0x3b, 0xdc, 0xe0, 0xc3, 0x3e, 0x61, 0x46,
-	0xf3, 0x90, 0xb6, 0xde, 0xcc, 0x5d, 0x15, 0x7f, 0x83, 0x55, 0xed, 0x37, 0x05, 0xd0, 0x3e, 0xe1,
-	0x07, 0x1e, 0xab, 0x1c, 0x13, 0x87, 0x63, 0x72, 0x34, 0x20, 0x8c, 0xa3, 0x75, 0x58, 0x26, 0xfe,
-	0x9c, 0xa5, 0x95, 0x5c, 0x6c, 0xe7, 0x06, 0x96, 0x33, 0xf4, 0x14, 0x60, 0x52, 0x3e, 0xad, 0xe6,
-	0x94, 0x9d, 0x64, 0x71, 0x4b, 0x97, 0xea, 0xf8, 0x5c, 0x75, 0xc1, 0x75, 0xa4, 0x92, 0xfe, 0xc2,
-	0xea, 0x10, 0x99, 0x13, 0x87, 0x22, 0xd1, 0x27, 0x90, 0xa0, 0x6e, 0x9b, 0xb8, 0x8d, 0xe6, 0x30,
-	0x1d, 0xcb, 0x29, 0x3b, 0x37, 0x8b, 0x19, 0xfd, 0x92, 0xc6, 0x7a, 0xcd, 0x87, 0x98, 0x43, 0x1c,
-	0xa7, 0xc1, 0x40, 0x3b, 0x53, 0x60, 0x6d, 0x8a, 0x2d, 0xeb, 0x53, 0x87, 0x11, 0xb4, 0x0d, 0x31,
-	0xee, 0x05, 0x5c, 0x93, 0xc5, 0x0f, 0x22, 0x32, 0x1d, 0x78, 0xd8, 0x47, 0xa0, 0x7d, 0x58, 0xe1,
-	0x5e, 0xc3, 0x95, 0x71, 0x2c, 0xad, 0x8a, 0x88, 0x8f, 0xa6, 0x76, 0x20, 0x4e, 0x28, 0x14, 0x28,
-	0xc1, 0x38, 0xc9, 0xc7, 0x63, 0x3f, 0x51, 0x58, 0x88, 0x98, 0x10, 0x62, 0xfb, 0x4a, 0x21, 0x64,
-	0xa6, 0x50, 0xa8, 0x46, 0x00, 0x99, 0x2e, 0xb5, 0xda, 0x2d, 0x8b, 0x71, 0xbf, 0x58, 0xa0, 0xff,
-	0x5d, 0x48, 0x70, 0xaf, 0xd1, 0x1c, 0x72, 0xe2, 0xef, 0x4a, 0xd9, 0x59, 0xc1, 0x71, 0xee, 0x99,
-	0xfe, 0x14, 0x3d, 0x81, 0xc5, 0x1e, 0x6d, 0x13, 0x21, 0xfe, 0xcd, 0x62, 0x2e, 0x62, 0xb3, 0xe3,
-	0x7c, 0xcf, 0x68, 0x9b, 0x60, 0x81, 0xd6, 0xbe, 0x83, 0xb5, 0xa9, 0x32, 0x52, 0xb8, 0x0a, 0x24,
-	0x43, 0x7a, 0x88, 0x52, 0xef, 0x2b, 0x07, 0x4c, 0xe4, 0xd0, 0x5e, 0xc2, 0xad, 0xba, 0xdd, 0x1b,
-	0x1c, 0x5a, 0x7c, 0x74, 0xda, 0xe8, 0x11, 0xa8, 0xdc, 0x93, 0x09, 0xa3, 0x4f, 0xc4, 0x54, 0xd3,
-	0x0a, 0x56, 0xb9, 0x37, 0xb5, 0x59, 0x75, 0x6a, 0xb3, 0xda, 0x8f, 0x0a, 0xa4, 0x26, 0x99, 0x25,
-	0xe9, 0xcf, 0x21, 0xd1, 0xb1, 0x58, 0xc3, 0x76, 0x5e, 0x53, 0x59, 0xe0, 0xc1, 0x7c, 0xc6, 0xfb,
-	0x16, 0xab, 0x3a, 0xaf, 0x29, 0x8e, 0x77, 0x82, 0x01, 0xfa, 0x14, 0x96, 0x5d, 0xc2, 0x06, 0x87,
-	0x5c, 0x5e, 0xdf, 0xdc, 0xfc, 0x58, 0x2c, 0x70, 0x58, 0xe2, 0x35, 0x0d, 0x56, 0xc4, 0xe5, 0x1b,
-	0x6d, 0x11, 0xc1, 0x62, 0xd7, 0x62, 0x5d, 0xc1, 0xe1, 0x06, 0x16, 0x63, 0xed, 0x14, 0x56, 0x25,
-	0x46, 0x92, 0xcd, 0x5f, 0xa9, 0x83, 0xd0, 0x60, 0xe6, 0x20, 0xd4, 0x6b, 0x1e, 0x84, 0x07, 0xeb,
-	0xfb, 0x84, 0x9b, 0xbe, 0xfd, 0x5f, 0xda, 0xbc, 0x7b, 0xe0, 0xb1, 0x90, 0xa3, 0xbb, 0xc4, 0xee,
-	0x74, 0xb9, 0xe0, 0x12, 0xc3, 0x72, 0xf6, 0x7f, 0x39, 0x5a, 0xfb, 0x47, 0x81, 0x8d, 0x4b, 0xa5,
-	0xff, 0xab, 0x3d, 0x9f, 0x40, 0x42, 0xb4, 0xae, 0x86, 0xdd, 0x96, 0x54, 0xee, 0xea, 0x93, 0xf6,
-	0xa5, 0x07, 0x8d, 0x4b, 0x94, 0xa8, 0x96, 0x71, 0x5c, 0x40, 0xab, 0x6d, 0xb4, 0x0b, 0x4b, 0x62,
-	0x28, 0x6d, 0xb8, 0x31, 0x27, 0x04, 0x07, 0xa8, 0x19, 0xeb, 0x2e, 0x5e, 0xdb, 0xba, 0x85, 0x2f,
-	0x21, 0x2e, 0x3b, 0x14, 0x4a, 0xc3, 0x9d, 0x1a, 0x2e, 0x57, 0x70, 0xc3, 0x7c, 0xd5, 0xf8, 0xe6,
-	0x79, 0xfd, 0x45, 0xa5, 0x54, 0x7d, 0x5a, 0xad, 0x94, 0x53, 0x0b, 0x28, 0x05, 0x2b, 0xe3, 0x95,
-	0xbd, 0x7a, 0x29, 0xa5, 0xa0, 0xdb, 0xb0, 0x3a, 0xfe, 0xa5, 0x5c, 0xa9, 0x97, 0x52, 0x6a, 0xe1,
-	0x2d, 0xac, 0x4e, 0x99, 0x16, 0x65, 0x21, 0x63, 0xe2, 0xda, 0x5e, 0xb9, 0xb4, 0x57, 0x3f, 0x68,
-	0x3c, 0xab, 0x95, 0x2b, 0x33, 0x59, 0xd3, 0x70, 0x67, 0x66, 0xdd, 0xfc, 0xba, 0x56, 0xfa, 0x2a,
-	0xa5, 0xa0, 0x0d, 0x58, 0x9b, 0x59, 0xa9, 0xbf, 0x7a, 0x5e, 0x4a, 0xa9, 0x11, 0x21, 0x7b, 0x62,
-	0x25, 0x56, 0xfc, 0x79, 0x09, 0xe2, 0xf5, 0xe0, 0xbd, 0x43, 0x27, 0x90, 0x18, 0xf9, 0x0d, 0x69,
-	0x11, 0x27, 0x35, 0x63, 0xf3, 0xcc, 0xc3, 0x77, 0x62, 0xe4, 0xad, 0xdc, 0xfa, 0xe1, 0x8f, 0xbf,
-	0x7f, 0x51, 0x73, 0xda, 0x3d, 0x23, 0xe2, 0xa1, 0x95, 0xe0, 0xcf, 0x94, 0x02, 0x3a, 0x82, 0x25,
-	0x61, 0x1e, 0xb4, 0x19, 0x91, 0x35, 0x6c, 0xbd, 0x4c, 0x6e, 0x3e, 0x40, 0xd6, 0xcc, 0x8b, 0x9a,
-	0x9b, 0xe8, 0x43, 0x23, 0xea, 0x95, 0x65, 0xc6, 0x89, 0x6f, 0xd7, 0x53, 0xf4, 0x3d, 0x24, 0x43,
-	0x7d, 0x11, 0xe5, 0xdf, 0xd5, 0x4e, 0x27, 0xe5, 0xb7, 0xae, 0x82, 0x49, 0x12, 0x0f, 0x04, 0x89,
-	0x7b, 0xda, 0x7a, 0x34, 0x09, 0x7f, 0xcf, 0x6f, 0x21, 0x19, 0x7a, 0xd1, 0x22, 0x09, 0x5c, 0x7e,
-	0x9f, 0x23, 0x09, 0x44, 0x3c, 0x8c, 0x5a, 0x56, 0x10, 0x48, 0xa3, 0x39, 0x04, 0xd0, 0xaf, 0x0a,
-	0xdc, 0x9a, 0x71, 0x2d, 0x7a, 0x14, 0x9d, 0x3b, 0xa2, 0xa9, 0x64, 0x0a, 0xef, 0x03, 0x95, 0x54,
-	0x76, 0x05, 0x95, 0x6d, 0x94, 0x9f, 0x73, 0x20, 0xc2, 0x9c, 0xc6, 0x49, 0xd0, 0x96, 0x4e, 0xcd,
-	0x2f, 0x7e, 0x3f, 0xcf, 0x2a, 0x67, 0xe7, 0x59, 0xe5, 0xaf, 0xf3, 0xac, 0xf2, 0xd3, 0x45, 0x76,
-	0xe1, 0xec, 0x22, 0xbb, 0xf0, 0xe7, 0x45, 0x76, 0xe1, 0xdb, 0x7c, 0xc7, 0xe6, 0xdd, 0x41, 0x53,
-	0x6f, 0xd1, 0xde, 0x28, 0x55, 0xf0, 0x6f, 0x97, 0xb5, 0xdf, 0x8c, 0x3e, 0x71, 0xbc, 0xe6, 0xb2,
-	0xf8, 0xc0, 0xf9, 0xf8, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x11, 0xc0, 0x8c, 0xdd, 0x09,
-	0x00, 0x00,
+	// 1181 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x4e, 0x1b, 0x47,
+	0x14, 0x66, 0xfd, 0x83, 0xe1, 0xd8, 0x24, 0xce, 0x40, 0xc1, 0x38, 0x89, 0x71, 0x96, 0xf2, 0x13,
+	0x24, 0x76, 0x15, 0x9a, 0x54, 0x55, 0x15, 0xa9, 0xf2, 0x1f, 0xd4, 0x4a, 0xc0, 0xd1, 0xd8, 0x11,
+	0x4a, 0x55, 0x69, 0xb5, 0xb6, 0xc7, 0xf6, 0x0a, 0x7b, 0xd7, 0xec, 0x8e, 0xe9, 0x5a, 0x14, 0x55,
+	0xea, 0x65, 0xaf, 0xaa, 0xf6, 0xa2, 0xbd, 0xec, 0x7d, 0x9f, 0xa0, 0x6f, 0xd0, 0x4b, 0xa4, 0xde,
+	0xf4, 0xae, 0x15, 0xf4, 0x01, 0xfa, 0x08, 0xd5, 0xce, 0x8e, 0xcd, 0xda, 0xac, 0x03, 0x8d, 0x72,
+	0x63, 0xcf, 0xec, 0xf9, 0xce, 0x39, 0xdf, 0x9c, 0xbf, 0x19, 0x58, 0xa9, 0x19, 0x56, 0xc7, 0xb0,
+	0x64, 0x6a, 0xcb, 0x27, 0x4f, 0xaa, 0x84, 0xaa, 0x4f, 0x64, 0x8b, 0x98, 0x27, 0x5a, 0x8d, 0x48,
+	0x5d, 0xd3, 0xa0, 0x06, 0xba, 0xe7, 0x02, 0x24, 0x6a, 0x4b, 0x1c, 0x90, 0x5c, 0x68, 0x1a, 0x4d,
+	0x83, 0x49, 0x65, 0x67, 0xe5, 0x02, 0x93, 0x0f, 0x9a, 0x86, 0xd1, 0x6c, 0x13, 0x59, 0xed, 0x6a,
+	0xb2, 0xaa, 0xeb, 0x06, 0x55, 0xa9, 0x66, 0xe8, 0x16, 0x97, 0xa6, 0xb8, 0x94, 0xed, 0xaa, 0xbd,
+	0x86, 0x5c, 0xef, 0x99, 0x0c, 0xc0, 0xe5, 0xab, 0x9c, 0x47, 0x55, 0xb5, 0x88, 0xac, 0x56, 0x6b,
+	0xda, 0x90, 0x8e, 0xb3, 0xe1, 0xa0, 0xe4, 0x75, 0xb2, 0xd4, 0xe6, 0xb2, 0x2d, 0xaf, 0x81, 0xe3,
+	0x1e, 0x31, 0xfb, 0x43, 0x4c, 0x57, 0x6d, 0x6a, 0xba, 0xd7, 0xd9, 0x03, 0x4a, 0xf4, 0x3a, 0x31,
+	0x3b, 0x9a, 0x4e, 0x65, 0xda, 0xef, 0x12, 0x4b, 0xae, 0xb6, 0x8d, 0xda, 0xd1, 0x44, 0x29, 0xfb,
+	0x75, 0xa5, 0xe2, 0xa5, 0x00, 0x68, 0x8f, 0xd0, 0x8a, 0x6d, 0x15, 0x4e, 0x88, 0x4e, 0x31, 0x39,
+	0xee, 0x11, 0x8b, 0xa2, 0x45, 0x98, 0x26, 0xce, 0xde, 0x4a, 0x08, 0xe9, 0xe0, 0xe6, 0x2c, 0xe6,
+	0x3b, 0xb4, 0x0b, 0x70, 0xe5, 0x3e, 0x11, 0x48, 0x0b, 0x9b, 0xd1, 0x9d, 0x75, 0x89, 0xc7, 0xd4,
+	0xe1, 0x2a, 0x31, 0xae, 0x83, 0xd8, 0x4a, 0xaf, 0xd4, 0x26, 0xe1, 0x36, 0xb1, 0x47, 0x13, 0x3d,
+	0x83, 0x19, 0xc3, 0xac, 0x13, 0x53, 0xa9, 0xf6, 0x13, 0xc1, 0xb4, 0xb0, 0x79, 0x67, 0x27, 0x29,
+	0x5d, 0xcb, 0x8c, 0x54, 0x72, 0x20, 0xd9, 0x3e, 0x8e, 0x18, 0xee, 0x02, 0x7d, 0x0c, 0xd3, 0x0d,
+	0xad, 0x4d, 0x89, 0x99, 0x08, 0x31, 0xd7, 0x29, 0x1f, 0x25, 0x76, 0x8e, 0x5d, 0x86, 0xc2, 0x1c,
+	0x2d, 0xfe, 0x26, 0x40, 0xd4, 0xf3, 0x1d, 0x3d, 0x87, 0x70, 0x47, 0xa5, 0xb5, 0x56, 0x42, 0x18,
+	0x3d, 0xc1, 0xb8, 0x99, 0x0c, 0xa5, 0xa6, 0x56, 0xed, 0x51, 0xb2, 0xef, 0xa0, 0xb1, 0xab, 0x84,
+	0x9e, 0xc1, 0xb4, 0xda, 0x6e, 0x2b, 0x46, 0x23, 0x11, 0x48, 0x07, 0x6f, 0xc1, 0x22, 0xac, 0xb6,
+	0xdb, 0xa5, 0x06, 0x53, 0xd3, 0xfb, 0x8e, 0x5a, 0xf0, 0x96, 0x6a, 0x7a, 0xbf, 0xd4, 0x10, 0x0d,
+	0x98, 0xf7, 0xe1, 0x82, 0x1e, 0x02, 0xb0, 0x9c, 0x28, 0x4e, 0x36, 0xd9, 0x39, 0x66, 0xf1, 0x2c,
+	0xfb, 0x52, 0xe9, 0x77, 0x09, 0x5a, 0x85, 0x39, 0x75, 0xa0, 0xa0, 0x1c, 0x91, 0x3e, 0xcb, 0xd5,
+	0x2c, 0x8e, 0x0d, 0x3f, 0xbe, 0x20, 0x7d, 0xb4, 0x00, 0xe1, 0x13, 0xb5, 0xdd, 0x23, 0x2c, 0x05,
+	0xb3, 0xd8, 0xdd, 0x88, 0xe7, 0x02, 0xcc, 0x8f, 0x94, 0x84, 0xd5, 0x35, 0x74, 0x8b, 0xa0, 0x0d,
+	0x08, 0x52, 0xdb, 0x2d, 0x88, 0xe8, 0xce, 0x07, 0x3e, 0xe4, 0x2b, 0x36, 0x76, 0x10, 0x68, 0x0f,
+	0x62, 0xd4, 0x56, 0x4c, 0xae, 0x67, 0xf1, 0x28, 0x7d, 0x38, 0x52, 0x26, 0xac, 0x0d, 0x3c, 0x8a,
+	0x1c, 0x8c, 0xa3, 0x74, 0xb8, 0x76, 0x0c, 0x79, 0xab, 0x2d, 0xc8, 0x72, 0xb5, 0x71, 0x63, 0xb5,
+	0x71, 0x4b, 0x1e, 0x55, 0xf1, 0x57, 0x01, 0x50, 0xd6, 0x34, 0xd4, 0x7a, 0x4d, 0xb5, 0x68, 0xc5,
+	0xe6, 0x15, 0x89, 0x96, 0x61, 0x86, 0xda, 0x4a, 0xb5, 0x4f, 0x89, 0xc5, 0x22, 0x18, 0xc3, 0x11,
+	0x6a, 0x67, 0x9d, 0x2d, 0x7a, 0x0a, 0xa1, 0x8e, 0x51, 0x27, 0x2c, 0x6c, 0x77, 0x76, 0xd2, 0x3e,
+	0xa7, 0x1d, 0xda, 0xdb, 0x37, 0xea, 0x04, 0x33, 0x34, 0xca, 0xc3, 0x9c, 0xfa, 0x95, 0xaa, 0x51,
+	0x85, 0x6a, 0x1d, 0x62, 0xf4, 0x28, 0xe7, 0xbc, 0x2c, 0xb9, 0xe3, 0x42, 0x1a, 0x8c, 0x0b, 0x29,
+	0xcf, 0xc7, 0x45, 0x36, 0xf4, 0xf3, 0x5f, 0x2b, 0x02, 0x8e, 0x31, 0xad, 0x8a, 0xab, 0x24, 0x7e,
+	0x09, 0xf3, 0x23, 0x64, 0x79, 0xfc, 0x0b, 0x10, 0xf5, 0x84, 0x95, 0x97, 0xee, 0xed, 0xa2, 0x0a,
+	0x57, 0x51, 0x15, 0x0f, 0xe1, 0x6e, 0x59, 0xeb, 0xf4, 0xda, 0x2a, 0x1d, 0x74, 0x26, 0x7a, 0x0c,
+	0x01, 0x6a, 0x73, 0x83, 0xfe, 0x89, 0xcd, 0x06, 0x12, 0x02, 0x0e, 0x50, 0x7b, 0x24, 0x64, 0x81,
+	0x91, 0x90, 0x89, 0xdf, 0x09, 0x10, 0xbf, 0xb2, 0xcc, 0x49, 0x3f, 0x87, 0x99, 0xa6, 0x6a, 0x29,
+	0x9a, 0xde, 0x30, 0xb8, 0x83, 0x47, 0x93, 0x19, 0xef, 0xa9, 0x56, 0x51, 0x6f, 0x18, 0x38, 0xd2,
+	0x74, 0x17, 0xe8, 0x13, 0x98, 0x36, 0x89, 0xd5, 0x6b, 0x53, 0x3e, 0x6a, 0xd2, 0x93, 0x75, 0x31,
+	0xc3, 0x61, 0x8e, 0x17, 0x45, 0x88, 0xb1, 0x1a, 0x1e, 0x1c, 0x11, 0x41, 0xa8, 0xa5, 0x5a, 0x2d,
+	0xde, 0x28, 0x6c, 0x2d, 0x9e, 0xc1, 0x1c, 0xc7, 0x70, 0xb2, 0x6b, 0x37, 0xc6, 0x81, 0xc5, 0x60,
+	0x2c, 0x11, 0x81, 0x77, 0x4c, 0x84, 0x0d, 0x8b, 0x7b, 0x84, 0x66, 0x9d, 0x51, 0x7d, 0xa8, 0xd1,
+	0x56, 0xc5, 0xb6, 0x3c, 0xd3, 0xb7, 0x45, 0xb4, 0x66, 0x8b, 0x32, 0x2e, 0x41, 0xcc, 0x77, 0xef,
+	0x6b, 0xfa, 0x8a, 0xff, 0x0a, 0xb0, 0x74, 0xcd, 0xf5, 0xff, 0xed, 0xf2, 0xa7, 0x30, 0xc3, 0xae,
+	0x19, 0x45, 0xab, 0x73, 0x2a, 0xcb, 0xd2, 0xd5, 0x55, 0x23, 0xb9, 0x97, 0x0c, 0x73, 0x51, 0xcc,
+	0xe3, 0x08, 0x83, 0x16, 0xeb, 0x68, 0x1b, 0xc2, 0x6c, 0xc9, 0x3b, 0x63, 0x69, 0x82, 0x0a, 0x76,
+	0x51, 0x63, 0x13, 0x20, 0xf4, 0xce, 0x13, 0x60, 0xeb, 0x73, 0x88, 0xf0, 0xdb, 0x04, 0x25, 0x60,
+	0xa1, 0x84, 0xf3, 0x05, 0xac, 0x64, 0xdf, 0x28, 0xaf, 0x0f, 0xca, 0xaf, 0x0a, 0xb9, 0xe2, 0x6e,
+	0xb1, 0x90, 0x8f, 0x4f, 0xa1, 0x38, 0xc4, 0x86, 0x92, 0x4c, 0x39, 0x17, 0x17, 0xd0, 0x3d, 0x98,
+	0x1b, 0x7e, 0xc9, 0x17, 0xca, 0xb9, 0x78, 0x60, 0xeb, 0x17, 0x01, 0xe6, 0x46, 0x7a, 0x1f, 0xa5,
+	0x20, 0x99, 0xc5, 0xa5, 0x4c, 0x3e, 0x97, 0x29, 0x57, 0x94, 0xfd, 0x52, 0xbe, 0x30, 0x66, 0x36,
+	0x01, 0x0b, 0x63, 0xf2, 0xec, 0xcb, 0x52, 0xee, 0x45, 0x5c, 0x40, 0x4b, 0x30, 0x3f, 0x26, 0x29,
+	0xbf, 0x39, 0xc8, 0xc5, 0x03, 0x3e, 0x2a, 0x19, 0x26, 0x09, 0x22, 0x11, 0x52, 0xe3, 0x92, 0xc3,
+	0x4c, 0xb1, 0xa2, 0x14, 0x0f, 0x72, 0x2f, 0x5f, 0x97, 0x8b, 0xa5, 0x83, 0x78, 0x68, 0xe7, 0x87,
+	0x30, 0x44, 0xca, 0xee, 0xb3, 0x07, 0x9d, 0xc2, 0xcc, 0xa0, 0x29, 0x91, 0xe8, 0x93, 0xce, 0xb1,
+	0x59, 0x90, 0x5c, 0x7d, 0x2b, 0x86, 0x97, 0xee, 0xfa, 0xb7, 0x7f, 0xfc, 0xf3, 0x63, 0x20, 0x2d,
+	0xde, 0x97, 0x7d, 0xde, 0x5b, 0x1c, 0xfc, 0xa9, 0xb0, 0x85, 0x8e, 0x21, 0xcc, 0x3a, 0x0c, 0xad,
+	0xf8, 0x58, 0xf5, 0xf6, 0x67, 0x32, 0x3d, 0x19, 0xc0, 0x7d, 0xae, 0x31, 0x9f, 0x2b, 0xe8, 0xa1,
+	0xec, 0xf7, 0x6c, 0xb2, 0xe4, 0x53, 0xa7, 0xa7, 0xcf, 0xd0, 0x37, 0x10, 0xf5, 0x0c, 0x4f, 0xb4,
+	0xf6, 0xb6, 0xc9, 0x7d, 0xe5, 0x7e, 0xfd, 0x26, 0x18, 0x27, 0xf1, 0x88, 0x91, 0xb8, 0x2f, 0x2e,
+	0xfa, 0x93, 0x70, 0xce, 0xfc, 0x35, 0x44, 0x3d, 0xb7, 0xa7, 0x2f, 0x81, 0xeb, 0x0f, 0xae, 0xe4,
+	0xfa, 0x4d, 0x30, 0x4e, 0x20, 0xc5, 0x08, 0x24, 0xd0, 0x04, 0x02, 0xe8, 0x27, 0x01, 0xee, 0x8e,
+	0xb5, 0x36, 0x7a, 0xec, 0x6f, 0xdb, 0x67, 0xf2, 0x24, 0xb7, 0x6e, 0x03, 0xe5, 0x54, 0xb6, 0x19,
+	0x95, 0x0d, 0xb4, 0x36, 0x21, 0x21, 0xac, 0x83, 0xe5, 0x53, 0x77, 0x76, 0x9d, 0x65, 0x3f, 0xfb,
+	0xfd, 0x22, 0x25, 0x9c, 0x5f, 0xa4, 0x84, 0xbf, 0x2f, 0x52, 0xc2, 0xf7, 0x97, 0xa9, 0xa9, 0xf3,
+	0xcb, 0xd4, 0xd4, 0x9f, 0x97, 0xa9, 0xa9, 0x2f, 0xd6, 0x9a, 0x1a, 0x6d, 0xf5, 0xaa, 0x52, 0xcd,
+	0xe8, 0x0c, 0x4c, 0xb9, 0x7f, 0xdb, 0x56, 0xfd, 0x68, 0xf0, 0x66, 0xb5, 0xab, 0xd3, 0xec, 0xf6,
+	0xfc, 0xe8, 0xbf, 0x01, 0x00, 0x87, 0xcc, 0x72, 0x97, 0xe4, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1022,6 +1214,18 @@ func (m *GetTxsEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.OrderBy != 0 {
 		i = encodeVarintService(dAtA, i, uint64(m.OrderBy))
 		i--
@@ -1051,6 +1255,113 @@ func (m *GetTxsEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AnyOf) > 0 {
+		for iNdEx := len(m.AnyOf) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnyOf[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllOf) > 0 {
+		for iNdEx := len(m.AllOf) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllOf[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Match != nil {
+		{
+			size, err := m.Match.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeMatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeMatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeMatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AttributeKey) > 0 {
+		i -= len(m.AttributeKey)
+		copy(dAtA[i:], m.AttributeKey)
+		i = encodeVarintService(dAtA, i, uint64(len(m.AttributeKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EventType) > 0 {
+		i -= len(m.EventType)
+		copy(dAtA[i:], m.EventType)
+		i = encodeVarintService(dAtA, i, uint64(len(m.EventType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GetTxsEventResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1134,6 +1445,16 @@ func (m *BroadcastTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AwaitTimeout != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.AwaitTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.AwaitTimeout):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintService(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Mode != 0 {
 		i = encodeVarintService(dAtA, i, uint64(m.Mode))
 		i--
@@ -1493,6 +1814,56 @@ func (m *GetTxsEventRequest) Size() (n int) {
 	if m.OrderBy != 0 {
 		n += 1 + sovService(uint64(m.OrderBy))
 	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Match != nil {
+		l = m.Match.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.AllOf) > 0 {
+		for _, e := range m.AllOf {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.AnyOf) > 0 {
+		for _, e := range m.AnyOf {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventAttributeMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EventType)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.AttributeKey)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
 	return n
 }
 
@@ -1534,6 +1905,10 @@ func (m *BroadcastTxRequest) Size() (n int) {
 	if m.Mode != 0 {
 		n += 1 + sovService(uint64(m.Mode))
 	}
+	if m.AwaitTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.AwaitTimeout)
+		n += 1 + l + sovService(uint64(l))
+	}
 	return n
 }
 
@@ -1779,26 +2154,362 @@ func (m *GetTxsEventRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &EventFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Match == nil {
+				m.Match = &EventAttributeMatch{}
+			}
+			if err := m.Match.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllOf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllOf = append(m.AllOf, &EventFilter{})
+			if err := m.AllOf[len(m.AllOf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnyOf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnyOf = append(m.AnyOf, &EventFilter{})
+			if err := m.AnyOf[len(m.AnyOf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -2036,6 +2747,42 @@ func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwaitTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AwaitTimeout == nil {
+				m.AwaitTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.AwaitTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -13,15 +13,19 @@ import (
 	signing "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -360,6 +364,12 @@ type TxBody struct {
 	// timeout is the block height after which this transaction will not
 	// be processed by the chain
 	TimeoutHeight uint64 `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// timeout_timestamp is the block time after which this transaction will not
+	// be processed by the chain. It is meant for the clients which can't predict
+	// block heights.
+	//
+	// Since: cosmos-sdk 0.46
+	TimeoutTimestamp *time.Time `protobuf:"bytes,4,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3,stdtime" json:"timeout_timestamp,omitempty"`
 	// extension_options are arbitrary options that can be added by chains
 	// when the default options are not sufficient. If any of these are present
 	// and can't be handled, the transaction will be rejected
@@ -424,6 +434,13 @@ func (m *TxBody) GetTimeoutHeight() uint64 {
 	return 0
 }
 
+func (m *TxBody) GetTimeoutTimestamp() *time.Time {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return nil
+}
+
 func (m *TxBody) GetExtensionOptions() []*types.Any {
 	if m != nil {
 		return m.ExtensionOptions
@@ -1012,71 +1029,73 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 1015 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x66, 0x6d, 0xc7, 0x7e, 0x4d, 0xda, 0x74, 0x14, 0x21, 0xc7, 0x51, 0xdd, 0xe0, 0xaa,
-	0xe0, 0x4b, 0x76, 0xd3, 0xf4, 0x40, 0x41, 0x08, 0xb0, 0x1b, 0xaa, 0x54, 0xa5, 0x20, 0x4d, 0x72,
-	0xea, 0x65, 0x35, 0x5e, 0x4f, 0xd6, 0xa3, 0x7a, 0x67, 0x96, 0x9d, 0x59, 0xb0, 0xff, 0x08, 0xa4,
-	0x0a, 0x09, 0x71, 0xe1, 0xc0, 0x99, 0x33, 0x7f, 0x44, 0x4f, 0xa8, 0xe2, 0xc4, 0x09, 0xaa, 0xe4,
-	0x88, 0xc4, 0xbf, 0x00, 0x9a, 0xd9, 0xd9, 0x4d, 0x5a, 0x5c, 0x1b, 0x04, 0xea, 0x69, 0xe7, 0xc7,
-	0xf7, 0xbe, 0xf9, 0xde, 0xbc, 0x6f, 0xdf, 0x40, 0x3b, 0x14, 0x32, 0x16, 0xd2, 0x57, 0x53, 0xff,
-	0x8b, 0x5b, 0x43, 0xaa, 0xc8, 0x2d, 0x5f, 0x4d, 0xbd, 0x24, 0x15, 0x4a, 0xa0, 0xab, 0xf9, 0x9e,
-	0xa7, 0xa6, 0x9e, 0xdd, 0x6b, 0x6f, 0x46, 0x22, 0x12, 0x66, 0xd7, 0xd7, 0xa3, 0x1c, 0xd8, 0xde,
-	0xb5, 0x24, 0x61, 0x3a, 0x4b, 0x94, 0xf0, 0xe3, 0x6c, 0xa2, 0x98, 0x64, 0x51, 0xc9, 0x58, 0x2c,
-	0x58, 0x78, 0xc7, 0xc2, 0x87, 0x44, 0xd2, 0x12, 0x13, 0x0a, 0xc6, 0xed, 0xfe, 0xdb, 0xe7, 0x9a,
-	0x24, 0x8b, 0x38, 0xe3, 0xe7, 0x4c, 0x76, 0x6e, 0x81, 0x5b, 0x91, 0x10, 0xd1, 0x84, 0xfa, 0x66,
-	0x36, 0xcc, 0x4e, 0x7c, 0xc2, 0x67, 0xc5, 0x56, 0xce, 0x11, 0xe4, 0x5a, 0x6d, 0x22, 0x66, 0xd2,
-	0xfd, 0xca, 0x81, 0x95, 0xe3, 0x29, 0xda, 0x85, 0xea, 0x50, 0x8c, 0x66, 0x2d, 0x67, 0xc7, 0xe9,
-	0x5d, 0xda, 0xdf, 0xf2, 0xfe, 0x96, 0xac, 0x77, 0x3c, 0x1d, 0x88, 0xd1, 0x0c, 0x1b, 0x18, 0xba,
-	0x03, 0x4d, 0x92, 0xa9, 0x71, 0xc0, 0xf8, 0x89, 0x68, 0xad, 0x98, 0x98, 0xed, 0x39, 0x31, 0xfd,
-	0x4c, 0x8d, 0xef, 0xf3, 0x13, 0x81, 0x1b, 0xc4, 0x8e, 0x50, 0x07, 0x40, 0xcb, 0x26, 0x2a, 0x4b,
-	0xa9, 0x6c, 0xb9, 0x3b, 0x6e, 0x6f, 0x0d, 0x5f, 0x58, 0xe9, 0x72, 0xa8, 0x1d, 0x4f, 0x31, 0xf9,
-	0x12, 0x5d, 0x03, 0xd0, 0x47, 0x05, 0xc3, 0x99, 0xa2, 0xd2, 0xe8, 0x5a, 0xc3, 0x4d, 0xbd, 0x32,
-	0xd0, 0x0b, 0xe8, 0x2d, 0xb8, 0x52, 0x2a, 0xb0, 0x98, 0x15, 0x83, 0x59, 0x2f, 0x8e, 0xca, 0x71,
-	0xcb, 0xce, 0xfb, 0xda, 0x81, 0xd5, 0x23, 0x16, 0xf1, 0x03, 0x11, 0xfe, 0x5f, 0x47, 0x6e, 0x41,
-	0x23, 0x1c, 0x13, 0xc6, 0x03, 0x36, 0x6a, 0xb9, 0x3b, 0x4e, 0xaf, 0x89, 0x57, 0xcd, 0xfc, 0xfe,
-	0x08, 0xdd, 0x84, 0xcb, 0x24, 0x0c, 0x45, 0xc6, 0x55, 0xc0, 0xb3, 0x78, 0x48, 0xd3, 0x56, 0x75,
-	0xc7, 0xe9, 0x55, 0xf1, 0xba, 0x5d, 0xfd, 0xd4, 0x2c, 0x76, 0xff, 0x70, 0x60, 0xc3, 0x8a, 0x3a,
-	0x60, 0x29, 0x0d, 0x55, 0x3f, 0x9b, 0x2e, 0x53, 0x77, 0x1b, 0x20, 0xc9, 0x86, 0x13, 0x16, 0x06,
-	0x8f, 0xe9, 0xcc, 0xd6, 0x64, 0xd3, 0xcb, 0x3d, 0xe1, 0x15, 0x9e, 0xf0, 0xfa, 0x7c, 0x86, 0x9b,
-	0x39, 0xee, 0x01, 0x9d, 0xfd, 0x77, 0xa9, 0xa8, 0x0d, 0x0d, 0x49, 0x3f, 0xcf, 0x28, 0x0f, 0x69,
-	0xab, 0x66, 0x00, 0xe5, 0x1c, 0xf5, 0xc0, 0x55, 0x2c, 0x69, 0xd5, 0x8d, 0x96, 0x37, 0xe6, 0x79,
-	0x8a, 0x25, 0x58, 0x43, 0xba, 0xdf, 0xac, 0x40, 0x3d, 0x37, 0x18, 0xda, 0x83, 0x46, 0x4c, 0xa5,
-	0x24, 0x91, 0x49, 0xd2, 0x7d, 0x65, 0x16, 0x25, 0x0a, 0x21, 0xa8, 0xc6, 0x34, 0xce, 0x7d, 0xd8,
-	0xc4, 0x66, 0xac, 0xd5, 0x2b, 0x16, 0x53, 0x91, 0xa9, 0x60, 0x4c, 0x59, 0x34, 0x56, 0x26, 0xbd,
-	0x2a, 0x5e, 0xb7, 0xab, 0x87, 0x66, 0x11, 0x0d, 0xe0, 0x2a, 0x9d, 0x2a, 0xca, 0x25, 0x13, 0x3c,
-	0x10, 0x89, 0x62, 0x82, 0xcb, 0xd6, 0x9f, 0xab, 0x0b, 0x8e, 0xdd, 0x28, 0xf1, 0x9f, 0xe5, 0x70,
-	0xf4, 0x08, 0x3a, 0x5c, 0xf0, 0x20, 0x4c, 0x99, 0x62, 0x21, 0x99, 0x04, 0x73, 0x08, 0xaf, 0x2c,
-	0x20, 0xdc, 0xe6, 0x82, 0xdf, 0xb5, 0xb1, 0x1f, 0xbf, 0xc4, 0xdd, 0xfd, 0xde, 0x81, 0x46, 0xf1,
-	0x13, 0xa1, 0x8f, 0x60, 0x4d, 0x1b, 0x97, 0xa6, 0xc6, 0x81, 0xc5, 0xed, 0x5c, 0x9b, 0x73, 0xaf,
-	0x47, 0x06, 0x66, 0xfe, 0xbc, 0x4b, 0xb2, 0x1c, 0x4b, 0x5d, 0x90, 0x13, 0x4a, 0xad, 0x39, 0xe6,
-	0x15, 0xe4, 0x1e, 0xa5, 0x58, 0x43, 0x8a, 0xd2, 0xb9, 0xcb, 0x4b, 0xf7, 0xad, 0x03, 0x70, 0x7e,
-	0xde, 0x4b, 0x36, 0x74, 0xfe, 0x99, 0x0d, 0xef, 0x40, 0x33, 0x16, 0x23, 0xba, 0xac, 0x9d, 0x3c,
-	0x14, 0x23, 0x9a, 0xb7, 0x93, 0xd8, 0x8e, 0x5e, 0xb0, 0x9f, 0xfb, 0xa2, 0xfd, 0xba, 0xcf, 0x57,
-	0xa0, 0x51, 0x84, 0xa0, 0xf7, 0xa1, 0x2e, 0x19, 0x8f, 0x26, 0xd4, 0x6a, 0xea, 0x2e, 0xe0, 0xf7,
-	0x8e, 0x0c, 0xf2, 0xb0, 0x82, 0x6d, 0x0c, 0x7a, 0x17, 0x6a, 0xa6, 0x6d, 0x5b, 0x71, 0x6f, 0x2e,
-	0x0a, 0x7e, 0xa8, 0x81, 0x87, 0x15, 0x9c, 0x47, 0xb4, 0xfb, 0x50, 0xcf, 0xe9, 0xd0, 0x3b, 0x50,
-	0xd5, 0xba, 0x8d, 0x80, 0xcb, 0xfb, 0x37, 0x2e, 0x70, 0x14, 0x8d, 0xfc, 0x62, 0xfd, 0x34, 0x1f,
-	0x36, 0x01, 0xed, 0x27, 0x0e, 0xd4, 0x0c, 0x2b, 0x7a, 0x00, 0x8d, 0x21, 0x53, 0x24, 0x4d, 0x49,
-	0x71, 0xb7, 0x7e, 0x41, 0x93, 0x3f, 0x37, 0x5e, 0xf9, 0xba, 0x14, 0x5c, 0x77, 0x45, 0x9c, 0x90,
-	0x50, 0x0d, 0x98, 0xea, 0xeb, 0x30, 0x5c, 0x12, 0xa0, 0xf7, 0x00, 0xca, 0x5b, 0xd7, 0xad, 0xcc,
-	0x5d, 0x76, 0xed, 0xcd, 0xe2, 0xda, 0xe5, 0xa0, 0x06, 0xae, 0xcc, 0xe2, 0xee, 0xef, 0x0e, 0xb8,
-	0xf7, 0x28, 0x45, 0x21, 0xd4, 0x49, 0xac, 0xbb, 0x82, 0x35, 0x65, 0xf9, 0x80, 0xe8, 0x57, 0xed,
-	0x82, 0x14, 0xc6, 0x07, 0x7b, 0x4f, 0x7f, 0xbd, 0x5e, 0xf9, 0xe1, 0xb7, 0xeb, 0xbd, 0x88, 0xa9,
-	0x71, 0x36, 0xf4, 0x42, 0x11, 0xfb, 0xc5, 0x8b, 0x69, 0x3e, 0xbb, 0x72, 0xf4, 0xd8, 0x57, 0xb3,
-	0x84, 0x4a, 0x13, 0x20, 0xb1, 0xa5, 0x46, 0xdb, 0xd0, 0x8c, 0x88, 0x0c, 0x26, 0x2c, 0x66, 0xca,
-	0x14, 0xa2, 0x8a, 0x1b, 0x11, 0x91, 0x9f, 0xe8, 0x39, 0xf2, 0xa0, 0x96, 0x90, 0x19, 0x4d, 0xf3,
-	0x36, 0x36, 0x68, 0xfd, 0xfc, 0xe3, 0xee, 0xa6, 0xd5, 0xd0, 0x1f, 0x8d, 0x52, 0x2a, 0xe5, 0x91,
-	0x4a, 0x19, 0x8f, 0x70, 0x0e, 0x43, 0xfb, 0xb0, 0x1a, 0xa5, 0x84, 0x2b, 0xdb, 0xd7, 0x16, 0x45,
-	0x14, 0xc0, 0xee, 0x77, 0x0e, 0xb8, 0xc7, 0x2c, 0x79, 0x3d, 0xd9, 0xee, 0x41, 0x5d, 0xb1, 0x24,
-	0xa1, 0x69, 0xde, 0xd7, 0x16, 0xe8, 0xb3, 0xb8, 0xee, 0x4f, 0x0e, 0xac, 0xf7, 0xb3, 0x69, 0xfe,
-	0x33, 0x1e, 0x10, 0x45, 0x74, 0x92, 0x24, 0x87, 0x1a, 0xb7, 0x2c, 0x4c, 0xd2, 0x02, 0xd1, 0x07,
-	0xd0, 0xd0, 0x76, 0x0c, 0x46, 0x22, 0xb4, 0x6e, 0xbf, 0xf1, 0x8a, 0x0e, 0x73, 0xf1, 0x75, 0xc2,
-	0xab, 0xd2, 0x3e, 0xa2, 0x85, 0xcb, 0xdd, 0x7f, 0xe9, 0x72, 0xb4, 0x01, 0xae, 0x64, 0x91, 0xa9,
-	0xc6, 0x1a, 0xd6, 0xc3, 0xc1, 0x87, 0x4f, 0x4f, 0x3b, 0xce, 0xb3, 0xd3, 0x8e, 0xf3, 0xfc, 0xb4,
-	0xe3, 0x3c, 0x39, 0xeb, 0x54, 0x9e, 0x9d, 0x75, 0x2a, 0xbf, 0x9c, 0x75, 0x2a, 0x8f, 0x6e, 0x2e,
-	0xbf, 0x4e, 0x5f, 0x4d, 0x87, 0x75, 0xd3, 0x70, 0x6e, 0xff, 0x15, 0x00, 0x00, 0xff, 0xff, 0x4d,
-	0x26, 0x62, 0x70, 0xd8, 0x09, 0x00, 0x00,
+	// 1052 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xc1, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf6, 0x7a, 0x6d, 0xc7, 0x7e, 0x4d, 0xda, 0x64, 0x14, 0xfd, 0xb4, 0x71, 0x54, 0x27, 0x3f,
+	0x57, 0x05, 0x5f, 0xb2, 0x9b, 0xa6, 0x07, 0x0a, 0x42, 0x80, 0xdd, 0x50, 0xa5, 0x2a, 0x01, 0x69,
+	0x93, 0x53, 0x2f, 0xab, 0xf1, 0x7a, 0xb2, 0x1e, 0xd5, 0x3b, 0xb3, 0xec, 0xcc, 0x82, 0xfd, 0x47,
+	0x20, 0x45, 0x5c, 0xb8, 0x70, 0xe0, 0xcc, 0x99, 0x3f, 0xa2, 0x27, 0x54, 0x71, 0xe2, 0x44, 0xab,
+	0x84, 0x1b, 0x12, 0xff, 0x02, 0x68, 0x66, 0x67, 0x37, 0x69, 0x9a, 0xc4, 0x20, 0x10, 0xa7, 0xdd,
+	0x79, 0xf3, 0xbd, 0x6f, 0xbe, 0x37, 0xf3, 0xcd, 0x3c, 0x68, 0x87, 0x5c, 0xc4, 0x5c, 0x78, 0x72,
+	0xea, 0x7d, 0x71, 0x6f, 0x48, 0x24, 0xbe, 0xe7, 0xc9, 0xa9, 0x9b, 0xa4, 0x5c, 0x72, 0xb4, 0x92,
+	0xcf, 0xb9, 0x72, 0xea, 0x9a, 0xb9, 0xf6, 0x6a, 0xc4, 0x23, 0xae, 0x67, 0x3d, 0xf5, 0x97, 0x03,
+	0xdb, 0x5b, 0x86, 0x24, 0x4c, 0x67, 0x89, 0xe4, 0x5e, 0x9c, 0x4d, 0x24, 0x15, 0x34, 0x2a, 0x19,
+	0x8b, 0x80, 0x81, 0x77, 0x0c, 0x7c, 0x88, 0x05, 0x29, 0x31, 0x21, 0xa7, 0xcc, 0xcc, 0xbf, 0x7d,
+	0xa6, 0x49, 0xd0, 0x88, 0x51, 0x76, 0xc6, 0x64, 0xc6, 0x06, 0xb8, 0x16, 0x71, 0x1e, 0x4d, 0x88,
+	0xa7, 0x47, 0xc3, 0xec, 0xc8, 0xc3, 0x6c, 0x66, 0xa6, 0x36, 0x2e, 0x4e, 0x49, 0x1a, 0x13, 0x21,
+	0x71, 0x9c, 0x14, 0xb9, 0xf9, 0x22, 0x41, 0x5e, 0x8c, 0xa9, 0x54, 0x0f, 0xba, 0x5f, 0x59, 0x50,
+	0x3d, 0x9c, 0xa2, 0x2d, 0xa8, 0x0d, 0xf9, 0x68, 0xe6, 0x58, 0x9b, 0x56, 0xef, 0xc6, 0xce, 0x9a,
+	0xfb, 0xc6, 0x6e, 0xb8, 0x87, 0xd3, 0x01, 0x1f, 0xcd, 0x7c, 0x0d, 0x43, 0x0f, 0xa0, 0x85, 0x33,
+	0x39, 0x0e, 0x28, 0x3b, 0xe2, 0x4e, 0x55, 0xe7, 0xac, 0x5f, 0x92, 0xd3, 0xcf, 0xe4, 0xf8, 0x31,
+	0x3b, 0xe2, 0x7e, 0x13, 0x9b, 0x3f, 0xd4, 0x01, 0x50, 0x75, 0x61, 0x99, 0xa5, 0x44, 0x38, 0xf6,
+	0xa6, 0xdd, 0x5b, 0xf4, 0xcf, 0x45, 0xba, 0x0c, 0xea, 0x87, 0x53, 0x1f, 0x7f, 0x89, 0x6e, 0x03,
+	0xa8, 0xa5, 0x82, 0xe1, 0x4c, 0x12, 0xa1, 0x75, 0x2d, 0xfa, 0x2d, 0x15, 0x19, 0xa8, 0x00, 0x7a,
+	0x0b, 0x6e, 0x95, 0x0a, 0x0c, 0xa6, 0xaa, 0x31, 0x4b, 0xc5, 0x52, 0x39, 0x6e, 0xde, 0x7a, 0x5f,
+	0x5b, 0xb0, 0x70, 0x40, 0x23, 0xb6, 0xcb, 0xc3, 0x7f, 0x6b, 0xc9, 0x35, 0x68, 0x86, 0x63, 0x4c,
+	0x59, 0x40, 0x47, 0x8e, 0xbd, 0x69, 0xf5, 0x5a, 0xfe, 0x82, 0x1e, 0x3f, 0x1e, 0xa1, 0xbb, 0x70,
+	0x13, 0x87, 0x21, 0xcf, 0x98, 0x0c, 0x58, 0x16, 0x0f, 0x49, 0xea, 0xd4, 0x36, 0xad, 0x5e, 0xcd,
+	0x5f, 0x32, 0xd1, 0x4f, 0x75, 0xb0, 0xfb, 0xbb, 0x05, 0xcb, 0x46, 0xd4, 0x2e, 0x4d, 0x49, 0x28,
+	0xfb, 0xd9, 0x74, 0x9e, 0xba, 0xfb, 0x00, 0x49, 0x36, 0x9c, 0xd0, 0x30, 0x78, 0x46, 0x66, 0xe6,
+	0x4c, 0x56, 0xdd, 0xdc, 0x19, 0x6e, 0xe1, 0x0c, 0xb7, 0xcf, 0x66, 0x7e, 0x2b, 0xc7, 0x3d, 0x21,
+	0xb3, 0x7f, 0x2e, 0x15, 0xb5, 0xa1, 0x29, 0xc8, 0xe7, 0x19, 0x61, 0x21, 0x71, 0xea, 0x1a, 0x50,
+	0x8e, 0x51, 0x0f, 0x6c, 0x49, 0x13, 0xa7, 0xa1, 0xb5, 0xfc, 0xef, 0x32, 0x4f, 0xd1, 0xc4, 0x57,
+	0x90, 0xee, 0xaf, 0x55, 0x68, 0xe4, 0x06, 0x43, 0xdb, 0xd0, 0x8c, 0x89, 0x10, 0x38, 0xd2, 0x45,
+	0xda, 0x57, 0x56, 0x51, 0xa2, 0x10, 0x82, 0x5a, 0x4c, 0xe2, 0xdc, 0x87, 0x2d, 0x5f, 0xff, 0x2b,
+	0xf5, 0xea, 0x12, 0xf0, 0x4c, 0x06, 0x63, 0x42, 0xa3, 0xb1, 0xd4, 0xe5, 0xd5, 0xfc, 0x25, 0x13,
+	0xdd, 0xd3, 0x41, 0xb4, 0x0f, 0x2b, 0x05, 0xac, 0xbc, 0x33, 0xba, 0xce, 0x1b, 0x3b, 0xed, 0x37,
+	0x56, 0x3d, 0x2c, 0x10, 0x83, 0xda, 0xf1, 0xcb, 0x0d, 0xcb, 0x5f, 0x36, 0xa9, 0x65, 0x1c, 0x0d,
+	0x60, 0x85, 0x4c, 0x25, 0x61, 0x82, 0x72, 0x16, 0xf0, 0x44, 0x52, 0xce, 0x84, 0xf3, 0xc7, 0xc2,
+	0x35, 0x55, 0x2c, 0x97, 0xf8, 0xcf, 0x72, 0x38, 0x7a, 0x0a, 0x1d, 0xc6, 0x59, 0x10, 0xa6, 0x54,
+	0xd2, 0x10, 0x4f, 0x82, 0x4b, 0x08, 0x6f, 0x5d, 0x43, 0xb8, 0xce, 0x38, 0x7b, 0x68, 0x72, 0x3f,
+	0xbe, 0xc0, 0xdd, 0xfd, 0xce, 0x82, 0x66, 0x71, 0x27, 0xd1, 0x47, 0xb0, 0xa8, 0xee, 0x01, 0x49,
+	0xb5, 0xa1, 0x8b, 0xcd, 0xbe, 0x7d, 0xc9, 0x31, 0x1d, 0x68, 0x98, 0xbe, 0xc8, 0x37, 0x44, 0xf9,
+	0x2f, 0xd4, 0xf9, 0x1e, 0x11, 0xe2, 0x54, 0xaf, 0x3c, 0xdf, 0x47, 0x84, 0xf8, 0x0a, 0x52, 0x38,
+	0xc1, 0x9e, 0xef, 0x84, 0x6f, 0x2c, 0x80, 0xb3, 0xf5, 0x2e, 0xb8, 0xda, 0xfa, 0x6b, 0xae, 0x7e,
+	0x00, 0xad, 0x98, 0x8f, 0xc8, 0xbc, 0xd7, 0x69, 0x9f, 0x8f, 0x48, 0xfe, 0x3a, 0xc5, 0xe6, 0xef,
+	0x35, 0x37, 0xdb, 0xaf, 0xbb, 0xb9, 0xfb, 0xaa, 0x0a, 0xcd, 0x22, 0x05, 0xbd, 0x0f, 0x0d, 0x41,
+	0x59, 0x34, 0x21, 0x46, 0x53, 0xf7, 0x1a, 0x7e, 0xf7, 0x40, 0x23, 0xf7, 0x2a, 0xbe, 0xc9, 0x41,
+	0xef, 0x42, 0x5d, 0xb7, 0x09, 0x23, 0xee, 0xff, 0xd7, 0x25, 0xef, 0x2b, 0xe0, 0x5e, 0xc5, 0xcf,
+	0x33, 0xda, 0x7d, 0x68, 0xe4, 0x74, 0xe8, 0x1d, 0xa8, 0x29, 0xdd, 0x5a, 0xc0, 0xcd, 0x9d, 0x3b,
+	0xe7, 0x38, 0x8a, 0xc6, 0x71, 0xfe, 0xfc, 0x14, 0x9f, 0xaf, 0x13, 0xda, 0xc7, 0x16, 0xd4, 0x35,
+	0x2b, 0x7a, 0x02, 0xcd, 0x21, 0x95, 0x38, 0x4d, 0x71, 0xb1, 0xb7, 0x5e, 0x41, 0x93, 0xb7, 0x37,
+	0xb7, 0xec, 0x66, 0x05, 0xd7, 0x43, 0x1e, 0x27, 0x38, 0x94, 0x03, 0x2a, 0xfb, 0x2a, 0xcd, 0x2f,
+	0x09, 0xd0, 0x7b, 0x00, 0xe5, 0xae, 0xab, 0x97, 0xd1, 0x9e, 0xb7, 0xed, 0xad, 0x62, 0xdb, 0xc5,
+	0xa0, 0x0e, 0xb6, 0xc8, 0xe2, 0xee, 0x6f, 0x16, 0xd8, 0x8f, 0x08, 0x41, 0x21, 0x34, 0x70, 0xac,
+	0x1e, 0x19, 0x63, 0xca, 0xb2, 0x1f, 0xa9, 0x2e, 0x7a, 0x4e, 0x0a, 0x65, 0x83, 0xed, 0xe7, 0xbf,
+	0x6c, 0x54, 0xbe, 0x7f, 0xb9, 0xd1, 0x8b, 0xa8, 0x1c, 0x67, 0x43, 0x37, 0xe4, 0xb1, 0x57, 0x74,
+	0x68, 0xfd, 0xd9, 0x12, 0xa3, 0x67, 0x9e, 0x9c, 0x25, 0x44, 0xe8, 0x04, 0xe1, 0x1b, 0x6a, 0xb4,
+	0x0e, 0xad, 0x08, 0x8b, 0x60, 0x42, 0x63, 0x2a, 0xf5, 0x41, 0xd4, 0xfc, 0x66, 0x84, 0xc5, 0x27,
+	0x6a, 0x8c, 0x5c, 0xa8, 0x27, 0x78, 0x46, 0xd2, 0xfc, 0x55, 0x1c, 0x38, 0x3f, 0xfd, 0xb0, 0xb5,
+	0x6a, 0x34, 0xf4, 0x47, 0xa3, 0x94, 0x08, 0x71, 0x20, 0x53, 0xca, 0x22, 0x3f, 0x87, 0xa1, 0x1d,
+	0x58, 0x88, 0x52, 0xcc, 0xa4, 0x79, 0x26, 0xaf, 0xcb, 0x28, 0x80, 0xdd, 0x6f, 0x2d, 0xb0, 0x0f,
+	0x69, 0xf2, 0xdf, 0x54, 0xbb, 0x0d, 0x0d, 0x49, 0x93, 0x84, 0xa4, 0x4e, 0x75, 0x8e, 0x3e, 0x83,
+	0xeb, 0xfe, 0x68, 0xc1, 0x52, 0x3f, 0x9b, 0xe6, 0x97, 0x71, 0x17, 0x4b, 0xac, 0x8a, 0xc4, 0x39,
+	0xd4, 0xb1, 0xe6, 0x90, 0x14, 0x40, 0xf4, 0x01, 0x34, 0x95, 0x1d, 0x83, 0x11, 0x0f, 0x8d, 0xdb,
+	0xef, 0x5c, 0xf1, 0xc2, 0x9c, 0x6f, 0x76, 0xfe, 0x82, 0xc8, 0x23, 0xa5, 0xcb, 0xed, 0xbf, 0xe9,
+	0x72, 0xb4, 0x0c, 0xb6, 0xa0, 0x91, 0x3e, 0x8d, 0x45, 0x5f, 0xfd, 0x0e, 0x3e, 0x7c, 0x7e, 0xd2,
+	0xb1, 0x5e, 0x9c, 0x74, 0xac, 0x57, 0x27, 0x1d, 0xeb, 0xf8, 0xb4, 0x53, 0x79, 0x71, 0xda, 0xa9,
+	0xfc, 0x7c, 0xda, 0xa9, 0x3c, 0xbd, 0x3b, 0x7f, 0x3b, 0x3d, 0x39, 0x1d, 0x36, 0xf4, 0x83, 0x73,
+	0xff, 0xcf, 0x01, 0x00, 0x77, 0x4f, 0xd3, 0x62, 0x48, 0x0a, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1353,6 +1372,16 @@ func (m *TxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xfa
 		}
 	}
+	if m.TimeoutTimestamp != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimeoutTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimeoutTimestamp):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.TimeoutHeight != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutHeight))
 		i--
@@ -1931,6 +1960,10 @@ func (m *TxBody) Size() (n int) {
 	if m.TimeoutHeight != 0 {
 		n += 1 + sovTx(uint64(m.TimeoutHeight))
 	}
+	if m.TimeoutTimestamp != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimeoutTimestamp)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	if len(m.ExtensionOptions) > 0 {
 		for _, e := range m.ExtensionOptions {
 			l = e.Size()
@@ -2944,6 +2977,42 @@ func (m *TxBody) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimeoutTimestamp == nil {
+				m.TimeoutTimestamp = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TimeoutTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 1023:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionOptions", wireType)
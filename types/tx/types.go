@@ -30,8 +30,18 @@ func (t *Tx) GetMsgs() []sdk.Msg {
 	return res
 }
 
+// MsgSignersFunc returns the signers of a message. It panics if they are
+// invalid, like the GetSigners method of the messages.
+type MsgSignersFunc func(msg sdk.Msg) []sdk.AccAddress
+
 // ValidateBasic implements the ValidateBasic method on sdk.Tx.
 func (t *Tx) ValidateBasic() error {
+	return t.ValidateBasicWith(sdk.Msg.GetSigners)
+}
+
+// ValidateBasicWith is ValidateBasic, the signers of the messages being
+// returned by msgSigners.
+func (t *Tx) ValidateBasicWith(msgSigners MsgSignersFunc) error {
 	if t == nil {
 		return fmt.Errorf("bad Tx")
 	}
@@ -85,10 +95,10 @@ func (t *Tx) ValidateBasic() error {
 		return sdkerrors.ErrNoSignatures
 	}
 
-	if len(sigs) != len(t.GetSigners()) {
+	if signers := t.GetSignersWith(msgSigners); len(sigs) != len(signers) {
 		return sdkerrors.Wrapf(
 			sdkerrors.ErrUnauthorized,
-			"wrong number of signers; expected %d, got %d", len(t.GetSigners()), len(sigs),
+			"wrong number of signers; expected %d, got %d", len(signers), len(sigs),
 		)
 	}
 
@@ -99,11 +109,17 @@ func (t *Tx) ValidateBasic() error {
 // This includes all unique signers of the messages (in order),
 // as well as the FeePayer (if specified and not already included).
 func (t *Tx) GetSigners() []sdk.AccAddress {
+	return t.GetSignersWith(sdk.Msg.GetSigners)
+}
+
+// GetSignersWith is GetSigners, the signers of the messages being returned by
+// msgSigners.
+func (t *Tx) GetSignersWith(msgSigners MsgSignersFunc) []sdk.AccAddress {
 	var signers []sdk.AccAddress
 	seen := map[string]bool{}
 
 	for _, msg := range t.GetMsgs() {
-		for _, addr := range msg.GetSigners() {
+		for _, addr := range msgSigners(msg) {
 			if !seen[addr.String()] {
 				signers = append(signers, addr)
 				seen[addr.String()] = true
@@ -201,7 +217,8 @@ func (m *AuthInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 
 // UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
 func (m *SignerInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
-	return unpacker.UnpackAny(m.PublicKey, new(cryptotypes.PubKey))
+	_, err := codectypes.UnpackAnyAs[cryptotypes.PubKey](unpacker, m.PublicKey)
+	return err
 }
 
 // RegisterInterfaces registers the sdk.Tx and MsgResponse interfaces.
@@ -0,0 +1,36 @@
+// Package collection provides helpers to iterate over maps in a deterministic
+// order. Go randomizes the iteration order of maps, so state machine code, e.g.
+// genesis export, invariants or event emission, must not range over a map
+// directly whenever the order is observable.
+package collection
+
+import "sort"
+
+// Ordered is a constraint for the types supporting the < operator.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+// SortedKeys returns the keys of a map in ascending order.
+func SortedKeys[K Ordered, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	return keys
+}
+
+// DeterministicRange calls cb for each key and value of a map, in ascending
+// order of the keys, until cb returns true.
+func DeterministicRange[K Ordered, V any](m map[K]V, cb func(key K, value V) (stop bool)) {
+	for _, k := range SortedKeys(m) {
+		if cb(k, m[k]) {
+			return
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/util/collection"
+)
+
+func TestSortedKeys(t *testing.T) {
+	require.Empty(t, collection.SortedKeys(map[string]int(nil)))
+	require.Equal(t, []string{"a", "b", "c"}, collection.SortedKeys(map[string]int{"c": 3, "a": 1, "b": 2}))
+	require.Equal(t, []int64{-1, 0, 7}, collection.SortedKeys(map[int64]bool{7: true, -1: true, 0: false}))
+}
+
+func TestDeterministicRange(t *testing.T) {
+	m := map[string]int{"c": 3, "a": 1, "d": 4, "b": 2}
+
+	var keys []string
+	var values []int
+	collection.DeterministicRange(m, func(k string, v int) bool {
+		keys = append(keys, k)
+		values = append(values, v)
+		return false
+	})
+	require.Equal(t, []string{"a", "b", "c", "d"}, keys)
+	require.Equal(t, []int{1, 2, 3, 4}, values)
+
+	keys = nil
+	collection.DeterministicRange(m, func(k string, _ int) bool {
+		keys = append(keys, k)
+		return k == "b"
+	})
+	require.Equal(t, []string{"a", "b"}, keys)
+}
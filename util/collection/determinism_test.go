@@ -0,0 +1,100 @@
+package collection_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// deterministicFiles are the files of the state machine whose iteration order
+// is observable: genesis export, invariants and event emission.
+var deterministicFiles = []string{
+	"../../x/*/genesis.go",
+	"../../x/*/keeper/genesis.go",
+	"../../x/*/keeper/invariants.go",
+	"../../types/events.go",
+}
+
+// stubImporter imports empty packages, so that the packages are type checked
+// on their own. The types of the other packages are unknown, but the maps
+// declared by the package itself are still recognized.
+type stubImporter struct{}
+
+func (stubImporter) Import(importPath string) (*types.Package, error) {
+	pkg := types.NewPackage(importPath, path.Base(importPath))
+	pkg.MarkComplete()
+	return pkg, nil
+}
+
+// TestNoMapRange is a vet-style check that reports the ranges over maps in the
+// files whose iteration order is observable. They must iterate over the keys
+// returned by SortedKeys or use DeterministicRange instead.
+func TestNoMapRange(t *testing.T) {
+	var files []string
+	for _, pattern := range deterministicFiles {
+		matches, err := filepath.Glob(pattern)
+		require.NoError(t, err)
+		files = append(files, matches...)
+	}
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		for _, pos := range mapRanges(t, file) {
+			t.Errorf("%s: range over a map, use collection.SortedKeys or collection.DeterministicRange", pos)
+		}
+	}
+}
+
+// mapRanges type checks the package of a file and returns the positions of the
+// ranges over maps in the file.
+func mapRanges(t *testing.T, file string) []token.Position {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, filepath.Dir(file), func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+
+	var positions []token.Position
+	for _, pkg := range pkgs {
+		target, ok := pkg.Files[file]
+		if !ok {
+			continue
+		}
+
+		pkgFiles := make([]*ast.File, 0, len(pkg.Files))
+		for _, f := range pkg.Files {
+			pkgFiles = append(pkgFiles, f)
+		}
+
+		info := &types.Info{Types: make(map[ast.Expr]types.TypeAndValue)}
+		conf := types.Config{
+			Importer: stubImporter{},
+			// the other packages being stubbed, type errors are expected
+			Error: func(error) {},
+		}
+		_, _ = conf.Check(pkg.Name, fset, pkgFiles, info)
+
+		ast.Inspect(target, func(n ast.Node) bool {
+			rangeStmt, ok := n.(*ast.RangeStmt)
+			if !ok {
+				return true
+			}
+			if typ := info.TypeOf(rangeStmt.X); typ != nil {
+				if _, ok := typ.Underlying().(*types.Map); ok {
+					positions = append(positions, fset.Position(rangeStmt.For))
+				}
+			}
+			return true
+		})
+	}
+
+	return positions
+}
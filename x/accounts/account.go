@@ -0,0 +1,99 @@
+package accounts
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AccountMsg is a message handled by an account implementation: an init, execute or query
+// message, or their responses. Account implementations register their messages as
+// implementations of AccountMsg, so that they can be packed into and unpacked from Any.
+type AccountMsg interface {
+	proto.Message
+}
+
+// Account is an account implementation, it defines the behavior of all the accounts of a
+// given account type. The state of each account is only reachable through the store of
+// the Context, which is isolated from the state of the other accounts.
+type Account interface {
+	// Init initializes the state of a new account.
+	Init(ctx Context, msg AccountMsg) (AccountMsg, error)
+	// Execute handles a state transition message sent to the account. Account
+	// implementations authenticate the sender of the message with Context.Sender.
+	Execute(ctx Context, msg AccountMsg) (AccountMsg, error)
+	// Query handles a query message sent to the account, it must not write state.
+	Query(ctx Context, req AccountMsg) (AccountMsg, error)
+}
+
+// TxHooks is implemented by account implementations running logic before and after each
+// execute message sent to the account, e.g. to enforce spending limits. An error returned
+// by a hook fails the execution and reverts its state changes.
+type TxHooks interface {
+	// PreTx is called before the account executes the message.
+	PreTx(ctx Context, msg AccountMsg) error
+	// PostTx is called after the account executed the message successfully.
+	PostTx(ctx Context, msg AccountMsg, resp AccountMsg) error
+}
+
+// MsgRouter routes the Msgs sent by accounts to the module Msg services.
+type MsgRouter interface {
+	Handler(msg sdk.Msg) func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error)
+}
+
+// Context is the context in which an account implementation handles a message, it gives
+// access to the account's own state and lets the account send Msgs to the modules.
+type Context struct {
+	sdk.Context
+
+	self   sdk.AccAddress
+	sender sdk.AccAddress
+	store  sdk.KVStore
+	router MsgRouter
+}
+
+// NewContext creates the Context of the account self handling a message sent by sender.
+func NewContext(ctx sdk.Context, self, sender sdk.AccAddress, store sdk.KVStore, router MsgRouter) Context {
+	return Context{
+		Context: ctx,
+		self:    self,
+		sender:  sender,
+		store:   store,
+		router:  router,
+	}
+}
+
+// Self returns the address of the account.
+func (c Context) Self() sdk.AccAddress {
+	return c.self
+}
+
+// Sender returns the address of the sender of the message, it is empty for queries.
+func (c Context) Sender() sdk.AccAddress {
+	return c.sender
+}
+
+// Store returns the store of the account state.
+func (c Context) Store() sdk.KVStore {
+	return c.store
+}
+
+// ExecModule routes a Msg to its module Msg service on behalf of the account, which must
+// be the only signer of the Msg.
+func (c Context) ExecModule(msg sdk.Msg) (*sdk.Result, error) {
+	for _, signer := range msg.GetSigners() {
+		if !c.self.Equals(signer) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("msg %s must be signed by the account %s, got %s", sdk.MsgTypeURL(msg), c.self, signer)
+		}
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	handler := c.router.Handler(msg)
+	if handler == nil {
+		return nil, sdkerrors.ErrUnknownRequest.Wrapf("no message handler found for %q", sdk.MsgTypeURL(msg))
+	}
+	return handler(c.Context, msg)
+}
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/accounts"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        accounts.ModuleName,
+		Short:                      "Querying commands for the accounts module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryAccount(),
+		GetCmdQueryAccountType(),
+		GetCmdQueryAccountTypes(),
+	)
+	return queryCmd
+}
+
+// GetCmdQueryAccount implements the query account command.
+func GetCmdQueryAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account [target] [json-request]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "run a query against an account",
+		Example: fmt.Sprintf(`$ %s query %s account <target> '{"@type":"/cosmos.accounts.defaults.spendinglimit.v1.QueryState"}'`, version.AppName, accounts.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			req, err := parseAccountMsg(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+			queryClient := accounts.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountQuery(cmd.Context(), &accounts.AccountQueryRequest{
+				Target:  args[0],
+				Request: req,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAccountType implements the query account-type command.
+func GetCmdQueryAccountType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account-type [address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the account type of an account",
+		Example: fmt.Sprintf(`$ %s query %s account-type <address>`, version.AppName, accounts.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := accounts.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountType(cmd.Context(), &accounts.AccountTypeRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAccountTypes implements the query account-types command.
+func GetCmdQueryAccountTypes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account-types",
+		Short:   "query the registered account types",
+		Example: fmt.Sprintf(`$ %s query %s account-types`, version.AppName, accounts.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := accounts.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountTypes(cmd.Context(), &accounts.AccountTypesRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
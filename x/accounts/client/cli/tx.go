@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/accounts"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        accounts.ModuleName,
+		Short:                      "accounts transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCmdInit(),
+		NewCmdExecute(),
+	)
+
+	return txCmd
+}
+
+func NewCmdInit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init [account-type] [json-message] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "create a new account of the given account type",
+		Long: strings.TrimSpace(fmt.Sprintf(`Create a new account of the given account type, initialized with the given init message.
+The message is the JSON of an account message, including its "@type".
+
+Example:
+			$ %s tx %s init spending-limit '{"@type":"/cosmos.accounts.defaults.spendinglimit.v1.MsgInit","owner":"<owner>","limit":[{"denom":"stake","amount":"100"}],"period":"86400s"}' --from <sender>`, version.AppName, accounts.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			anyMsg, err := parseAccountMsg(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := accounts.MsgInit{
+				Sender:      clientCtx.GetFromAddress().String(),
+				AccountType: args[0],
+				Message:     anyMsg,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdExecute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute [target] [json-message] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "send a state transition message to an account",
+		Long: strings.TrimSpace(fmt.Sprintf(`Send a state transition message to an account.
+The message is the JSON of an account message, including its "@type".
+
+Example:
+			$ %s tx %s execute <target> '{"@type":"/cosmos.accounts.defaults.spendinglimit.v1.MsgUpdateLimit","limit":[{"denom":"stake","amount":"200"}],"period":"86400s"}' --from <sender>`, version.AppName, accounts.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			anyMsg, err := parseAccountMsg(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := accounts.MsgExecute{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Target:  args[0],
+				Message: anyMsg,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseAccountMsg parses the JSON of an account message and packs it into an Any
+func parseAccountMsg(clientCtx client.Context, jsonMsg string) (*types.Any, error) {
+	var msg accounts.AccountMsg
+	if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(jsonMsg), &msg); err != nil {
+		return nil, fmt.Errorf("invalid account message: %w", err)
+	}
+	return types.NewAnyWithValue(msg)
+}
@@ -0,0 +1,16 @@
+package accounts
+
+import (
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgInit{},
+		&MsgExecute{},
+	)
+	registry.RegisterInterface("cosmos.accounts.v1.AccountMsg", (*AccountMsg)(nil))
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
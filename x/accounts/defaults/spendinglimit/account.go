@@ -0,0 +1,181 @@
+package spendinglimit
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/accounts"
+)
+
+// AccountType is the account type of the spending limit account
+const AccountType = "spending-limit"
+
+var (
+	stateKey           = []byte{0x01}
+	preTxBalancePrefix = []byte{0x02}
+)
+
+// BankKeeper defines the contract required for the balances of the account
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+var (
+	_ accounts.Account = Account{}
+	_ accounts.TxHooks = Account{}
+)
+
+// Account is an account executing Msgs on behalf of its owner, while limiting the amount of
+// the limited denoms leaving the account per period. Denoms absent from the limit are not
+// limited.
+type Account struct {
+	bk BankKeeper
+}
+
+// NewAccount creates the spending limit account implementation
+func NewAccount(bk BankKeeper) Account {
+	return Account{bk: bk}
+}
+
+// Init initializes the account with its owner and spending limit
+func (a Account) Init(ctx accounts.Context, msg accounts.AccountMsg) (accounts.AccountMsg, error) {
+	m, ok := msg.(*MsgInit)
+	if !ok {
+		return nil, sdkerrors.Wrapf(accounts.ErrInvalidMessage, "got %T", msg)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", m.Owner)
+	}
+	if err := validateLimit(m.Limit, m.Period); err != nil {
+		return nil, err
+	}
+
+	a.setState(ctx, State{
+		Owner:       m.Owner,
+		Limit:       m.Limit,
+		Period:      m.Period,
+		Spent:       sdk.Coins{},
+		PeriodStart: ctx.BlockTime(),
+	})
+	return &MsgInitResponse{}, nil
+}
+
+// Execute executes Msgs on behalf of the owner, or updates the spending limit
+func (a Account) Execute(ctx accounts.Context, msg accounts.AccountMsg) (accounts.AccountMsg, error) {
+	state := a.getState(ctx)
+	if ctx.Sender().String() != state.Owner {
+		return nil, sdkerrors.Wrapf(accounts.ErrUnauthorized, "%s is not the owner of the account", ctx.Sender())
+	}
+
+	switch m := msg.(type) {
+	case *MsgExecute:
+		msgs, err := m.GetMessages()
+		if err != nil {
+			return nil, err
+		}
+		var responses []*types.Any
+		for i, msg := range msgs {
+			res, err := ctx.ExecModule(msg)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "message %s at position %d", sdk.MsgTypeURL(msg), i)
+			}
+			responses = append(responses, res.MsgResponses...)
+		}
+		return &MsgExecuteResponse{Responses: responses}, nil
+
+	case *MsgUpdateLimit:
+		if err := validateLimit(m.Limit, m.Period); err != nil {
+			return nil, err
+		}
+		state.Limit = m.Limit
+		state.Period = m.Period
+		a.setState(ctx, state)
+		return &MsgUpdateLimitResponse{}, nil
+
+	default:
+		return nil, sdkerrors.Wrapf(accounts.ErrInvalidMessage, "got %T", msg)
+	}
+}
+
+// Query returns the state of the account
+func (a Account) Query(ctx accounts.Context, req accounts.AccountMsg) (accounts.AccountMsg, error) {
+	if _, ok := req.(*QueryState); !ok {
+		return nil, sdkerrors.Wrapf(accounts.ErrInvalidMessage, "got %T", req)
+	}
+	return &QueryStateResponse{State: a.getState(ctx)}, nil
+}
+
+// PreTx starts a new period if the current one elapsed, and records the balances of the
+// limited denoms before the execution
+func (a Account) PreTx(ctx accounts.Context, _ accounts.AccountMsg) error {
+	state := a.getState(ctx)
+	if !ctx.BlockTime().Before(state.PeriodStart.Add(state.Period)) {
+		state.Spent = sdk.Coins{}
+		state.PeriodStart = ctx.BlockTime()
+		a.setState(ctx, state)
+	}
+
+	for _, limit := range state.Limit {
+		balance := a.bk.GetBalance(ctx.Context, ctx.Self(), limit.Denom)
+		bz, err := balance.Amount.Marshal()
+		if err != nil {
+			return err
+		}
+		ctx.Store().Set(preTxBalanceKey(limit.Denom), bz)
+	}
+	return nil
+}
+
+// PostTx adds the amounts of the limited denoms that left the account during the execution
+// to the amount spent in the period, and fails if it exceeds the limit
+func (a Account) PostTx(ctx accounts.Context, _ accounts.AccountMsg, _ accounts.AccountMsg) error {
+	state := a.getState(ctx)
+	for _, limit := range state.Limit {
+		key := preTxBalanceKey(limit.Denom)
+		bz := ctx.Store().Get(key)
+		if bz == nil {
+			// the denom was added to the limit during the execution
+			continue
+		}
+		ctx.Store().Delete(key)
+
+		var before sdk.Int
+		if err := before.Unmarshal(bz); err != nil {
+			return err
+		}
+		after := a.bk.GetBalance(ctx.Context, ctx.Self(), limit.Denom).Amount
+		if before.GT(after) {
+			state.Spent = state.Spent.Add(sdk.NewCoin(limit.Denom, before.Sub(after)))
+		}
+	}
+
+	for _, spent := range state.Spent {
+		if limit := state.Limit.AmountOf(spent.Denom); !limit.IsZero() && spent.Amount.GT(limit) {
+			return sdkerrors.Wrapf(ErrLimitExceeded, "spent %s of %s%s in the current period", spent, limit, spent.Denom)
+		}
+	}
+	a.setState(ctx, state)
+	return nil
+}
+
+func (a Account) getState(ctx accounts.Context) State {
+	var state State
+	if bz := ctx.Store().Get(stateKey); bz != nil {
+		if err := state.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+	}
+	return state
+}
+
+func (a Account) setState(ctx accounts.Context, state State) {
+	bz, err := state.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.Store().Set(stateKey, bz)
+}
+
+func preTxBalanceKey(denom string) []byte {
+	return append(preTxBalancePrefix, denom...)
+}
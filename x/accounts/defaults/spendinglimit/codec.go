@@ -0,0 +1,20 @@
+package spendinglimit
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/accounts"
+)
+
+// RegisterInterfaces registers the messages of the spending limit account as account messages
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*accounts.AccountMsg)(nil),
+		&MsgInit{},
+		&MsgInitResponse{},
+		&MsgExecute{},
+		&MsgExecuteResponse{},
+		&MsgUpdateLimit{},
+		&MsgUpdateLimitResponse{},
+		&QueryState{},
+		&QueryStateResponse{},
+	)
+}
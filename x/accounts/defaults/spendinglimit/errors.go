@@ -0,0 +1,14 @@
+package spendinglimit
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// codespace is the codespace of the spending limit account errors
+const codespace = "accounts/spendinglimit"
+
+// spending limit account sentinel errors
+var (
+	ErrLimitExceeded = sdkerrors.Register(codespace, 2, "spending limit exceeded")
+	ErrInvalidLimit  = sdkerrors.Register(codespace, 3, "invalid spending limit")
+)
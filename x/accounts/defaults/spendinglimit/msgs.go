@@ -0,0 +1,32 @@
+package spendinglimit
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ types.UnpackInterfacesMessage = &MsgExecute{}
+
+// GetMessages returns the cached values of the Msgs to execute
+func (m MsgExecute) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(m.Msgs, "spendinglimit.MsgExecute")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgExecute) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Msgs)
+}
+
+func validateLimit(limit sdk.Coins, period time.Duration) error {
+	if limit.Empty() || !limit.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidLimit, "invalid limit %s", limit)
+	}
+	if period <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidLimit, "period must be positive, got %s", period)
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package accounts
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/accounts module sentinel errors
+var (
+	ErrUnknownAccountType = sdkerrors.Register(ModuleName, 2, "unknown account type")
+	ErrAccountNotFound    = sdkerrors.Register(ModuleName, 3, "account not found")
+	ErrInvalidMessage     = sdkerrors.Register(ModuleName, 4, "invalid account message")
+	ErrUnauthorized       = sdkerrors.Register(ModuleName, 5, "unauthorized account message sender")
+)
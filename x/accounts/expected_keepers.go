@@ -0,0 +1,15 @@
+package accounts
+
+//go:generate go run github.com/golang/mock/mockgen -source=expected_keepers.go -package testutil -destination testutil/expected_keepers_mocks.go
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AccountKeeper defines the contract required for account APIs.
+type AccountKeeper interface {
+	NewAccount(ctx sdk.Context, acc authtypes.AccountI) authtypes.AccountI
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+	SetAccount(ctx sdk.Context, acc authtypes.AccountI)
+}
@@ -0,0 +1,31 @@
+package accounts
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateGenesis check the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	addrs := make(map[string]bool, len(data.Accounts))
+	for _, account := range data.Accounts {
+		if _, err := sdk.AccAddressFromBech32(account.Address); err != nil {
+			return err
+		}
+		if addrs[account.Address] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate account %s", account.Address)
+		}
+		addrs[account.Address] = true
+		if strings.TrimSpace(account.AccountType) == "" {
+			return sdkerrors.Wrapf(ErrUnknownAccountType, "account type of %s cannot be empty", account.Address)
+		}
+	}
+	return nil
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
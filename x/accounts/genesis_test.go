@@ -0,0 +1,28 @@
+package accounts_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/accounts"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, accounts.ValidateGenesis(*accounts.DefaultGenesisState()))
+
+	addr := sdk.AccAddress("addr1_______________").String()
+	account := accounts.GenesisAccount{Address: addr, AccountType: "spending-limit"}
+	require.NoError(t, accounts.ValidateGenesis(accounts.GenesisState{Accounts: []accounts.GenesisAccount{account}}))
+
+	duplicate := accounts.GenesisState{Accounts: []accounts.GenesisAccount{account, account}}
+	require.ErrorIs(t, accounts.ValidateGenesis(duplicate), sdkerrors.ErrInvalidRequest)
+
+	noType := accounts.GenesisState{Accounts: []accounts.GenesisAccount{{Address: addr}}}
+	require.ErrorIs(t, accounts.ValidateGenesis(noType), accounts.ErrUnknownAccountType)
+
+	invalidAddr := accounts.GenesisState{Accounts: []accounts.GenesisAccount{{Address: "invalid", AccountType: "spending-limit"}}}
+	require.Error(t, accounts.ValidateGenesis(invalidAddr))
+}
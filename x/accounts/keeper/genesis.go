@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/accounts"
+)
+
+// InitGenesis new accounts genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *accounts.GenesisState) {
+	k.setAccountNumber(ctx, data.AccountNumber)
+
+	for _, account := range data.Accounts {
+		if _, found := k.accounts[account.AccountType]; !found {
+			panic(fmt.Errorf("unknown account type %s of account %s", account.AccountType, account.Address))
+		}
+		addr, err := sdk.AccAddressFromBech32(account.Address)
+		if err != nil {
+			panic(err)
+		}
+		ctx.KVStore(k.storeKey).Set(accountTypeKey(addr), []byte(account.AccountType))
+
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), accountStatePrefix(addr))
+		for _, kv := range account.State {
+			store.Set(kv.Key, kv.Value)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *accounts.GenesisState {
+	var genAccounts []accounts.GenesisAccount
+	k.IterateAccounts(ctx, func(addr sdk.AccAddress, accountType string) bool {
+		genAccounts = append(genAccounts, accounts.GenesisAccount{
+			Address:     addr.String(),
+			AccountType: accountType,
+			State:       k.exportAccountState(ctx, addr),
+		})
+		return false
+	})
+
+	return &accounts.GenesisState{
+		AccountNumber: k.nextAccountNumber(ctx),
+		Accounts:      genAccounts,
+	}
+}
+
+func (k Keeper) exportAccountState(ctx sdk.Context, addr sdk.AccAddress) []accounts.KVPair {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), accountStatePrefix(addr))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var state []accounts.KVPair
+	for ; iterator.Valid(); iterator.Next() {
+		state = append(state, accounts.KVPair{Key: iterator.Key(), Value: iterator.Value()})
+	}
+	return state
+}
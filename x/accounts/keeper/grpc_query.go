@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/accounts"
+)
+
+var _ accounts.QueryServer = Keeper{}
+
+// AccountQuery runs a query against an account
+func (k Keeper) AccountQuery(goCtx context.Context, r *accounts.AccountQueryRequest) (*accounts.AccountQueryResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	target, err := sdk.AccAddressFromBech32(r.Target)
+	if err != nil {
+		return nil, err
+	}
+	req, err := k.unpackAccountMsg(r.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp, err := k.Query(ctx, target, req)
+	if err != nil {
+		return nil, err
+	}
+	anyResp, err := packAccountMsg(resp)
+	if err != nil {
+		return nil, err
+	}
+	return &accounts.AccountQueryResponse{Response: anyResp}, nil
+}
+
+// AccountType returns the account type of an account
+func (k Keeper) AccountType(goCtx context.Context, r *accounts.AccountTypeRequest) (*accounts.AccountTypeResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(r.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	accountType, found := k.GetAccountType(ctx, addr)
+	if !found {
+		return nil, sdkerrors.Wrapf(accounts.ErrAccountNotFound, "%s", r.Address)
+	}
+	return &accounts.AccountTypeResponse{AccountType: accountType}, nil
+}
+
+// AccountTypes returns the registered account types
+func (k Keeper) AccountTypes(goCtx context.Context, r *accounts.AccountTypesRequest) (*accounts.AccountTypesResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	return &accounts.AccountTypesResponse{AccountTypes: k.RegisteredAccountTypes()}, nil
+}
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/util/collection"
+	"github.com/cosmos/cosmos-sdk/x/accounts"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Keeper of the accounts store
+type Keeper struct {
+	cdc      codec.Codec
+	storeKey storetypes.StoreKey
+	router   accounts.MsgRouter
+	ak       accounts.AccountKeeper
+
+	// the registered account implementations by account type
+	accounts map[string]accounts.Account
+}
+
+// NewKeeper creates a new accounts Keeper instance, impls maps the account types that
+// can be created to their account implementation
+func NewKeeper(key storetypes.StoreKey, cdc codec.Codec, router accounts.MsgRouter, ak accounts.AccountKeeper,
+	impls map[string]accounts.Account,
+) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: key,
+		router:   router,
+		ak:       ak,
+		accounts: impls,
+	}
+}
+
+// RegisteredAccountTypes returns the registered account types in lexicographic order
+func (k Keeper) RegisteredAccountTypes() []string {
+	return collection.SortedKeys(k.accounts)
+}
+
+// GetAccountType returns the account type of the account with the given address
+func (k Keeper) GetAccountType(ctx sdk.Context, addr sdk.AccAddress) (string, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(accountTypeKey(addr))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// IterateAccounts iterates over all accounts, until cb returns true
+func (k Keeper) IterateAccounts(ctx sdk.Context, cb func(addr sdk.AccAddress, accountType string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), AccountTypePrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// skip the address length prefix
+		if cb(sdk.AccAddress(iterator.Key()[1:]), string(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// Init creates a new account of the given account type and initializes its state with msg
+func (k Keeper) Init(ctx sdk.Context, accountType string, sender sdk.AccAddress, msg accounts.AccountMsg) (sdk.AccAddress, accounts.AccountMsg, error) {
+	impl, found := k.accounts[accountType]
+	if !found {
+		return nil, nil, sdkerrors.Wrapf(accounts.ErrUnknownAccountType, "%s", accountType)
+	}
+
+	addr := k.newAccountAddress(ctx, accountType)
+	acc := k.ak.NewAccount(ctx, &authtypes.ModuleAccount{
+		BaseAccount: &authtypes.BaseAccount{
+			Address: addr.String(),
+		},
+		Name: addr.String(),
+	})
+	k.ak.SetAccount(ctx, acc)
+	ctx.KVStore(k.storeKey).Set(accountTypeKey(addr), []byte(accountType))
+
+	resp, err := impl.Init(k.accountContext(ctx, addr, sender), msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return addr, resp, nil
+}
+
+// Execute sends a state transition message from sender to the account target, running the
+// TxHooks of the account implementation around it. Modules can call Execute to interact
+// with accounts.
+func (k Keeper) Execute(ctx sdk.Context, target, sender sdk.AccAddress, msg accounts.AccountMsg) (accounts.AccountMsg, error) {
+	impl, err := k.getAccountImpl(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	accCtx := k.accountContext(ctx, target, sender)
+	hooks, hasHooks := impl.(accounts.TxHooks)
+	if hasHooks {
+		if err := hooks.PreTx(accCtx, msg); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := impl.Execute(accCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if hasHooks {
+		if err := hooks.PostTx(accCtx, msg, resp); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// Query runs a query message against the account target
+func (k Keeper) Query(ctx sdk.Context, target sdk.AccAddress, req accounts.AccountMsg) (accounts.AccountMsg, error) {
+	impl, err := k.getAccountImpl(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	// discard any state change made by the query
+	cacheCtx, _ := ctx.CacheContext()
+	return impl.Query(k.accountContext(cacheCtx, target, nil), req)
+}
+
+func (k Keeper) getAccountImpl(ctx sdk.Context, addr sdk.AccAddress) (accounts.Account, error) {
+	accountType, found := k.GetAccountType(ctx, addr)
+	if !found {
+		return nil, sdkerrors.Wrapf(accounts.ErrAccountNotFound, "%s", addr)
+	}
+	impl, found := k.accounts[accountType]
+	if !found {
+		return nil, sdkerrors.Wrapf(accounts.ErrUnknownAccountType, "%s", accountType)
+	}
+	return impl, nil
+}
+
+func (k Keeper) accountContext(ctx sdk.Context, addr, sender sdk.AccAddress) accounts.Context {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), accountStatePrefix(addr))
+	return accounts.NewContext(ctx, addr, sender, store, k.router)
+}
+
+// newAccountAddress returns the address of the next account, skipping the rare addresses
+// colliding with an existing account
+func (k Keeper) newAccountAddress(ctx sdk.Context, accountType string) sdk.AccAddress {
+	for {
+		accountNumber := k.nextAccountNumber(ctx)
+		k.setAccountNumber(ctx, accountNumber+1)
+
+		addr := accountAddress(accountType, accountNumber)
+		if k.ak.GetAccount(ctx, addr) == nil {
+			return addr
+		}
+	}
+}
+
+func (k Keeper) nextAccountNumber(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(AccountNumberKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setAccountNumber(ctx sdk.Context, accountNumber uint64) {
+	ctx.KVStore(k.storeKey).Set(AccountNumberKey, sdk.Uint64ToBigEndian(accountNumber))
+}
+
+// unpackAccountMsg returns the account message packed in any
+func (k Keeper) unpackAccountMsg(any *types.Any) (accounts.AccountMsg, error) {
+	if any == nil {
+		return nil, sdkerrors.Wrap(accounts.ErrInvalidMessage, "message cannot be empty")
+	}
+	if msg, ok := any.GetCachedValue().(accounts.AccountMsg); ok {
+		return msg, nil
+	}
+	var msg accounts.AccountMsg
+	if err := k.cdc.UnpackAny(any, &msg); err != nil {
+		return nil, sdkerrors.Wrap(accounts.ErrInvalidMessage, err.Error())
+	}
+	return msg, nil
+}
+
+// packAccountMsg packs an account message into an Any, a nil message is packed into nil
+func packAccountMsg(msg accounts.AccountMsg) (*types.Any, error) {
+	if msg == nil {
+		return nil, nil
+	}
+	return types.NewAnyWithValue(msg)
+}
@@ -0,0 +1,222 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/accounts"
+	"github.com/cosmos/cosmos-sdk/x/accounts/defaults/spendinglimit"
+	"github.com/cosmos/cosmos-sdk/x/accounts/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	msgServer   accounts.MsgServer
+	queryClient accounts.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	accounts.RegisterQueryServer(queryHelper, app.AccountsKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.msgServer = keeper.NewMsgServerImpl(app.AccountsKeeper)
+	s.queryClient = accounts.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) pack(msg accounts.AccountMsg) *types.Any {
+	any, err := types.NewAnyWithValue(msg)
+	s.Require().NoError(err)
+	return any
+}
+
+// initSpendingLimit creates a spending limit account owned by addrs[0], funded with 1000stake
+// and limited to 100stake per day
+func (s *TestSuite) initSpendingLimit() sdk.AccAddress {
+	require := s.Require()
+	res, err := s.msgServer.Init(s.ctx, &accounts.MsgInit{
+		Sender:      s.addrs[0].String(),
+		AccountType: spendinglimit.AccountType,
+		Message: s.pack(&spendinglimit.MsgInit{
+			Owner:  s.addrs[0].String(),
+			Limit:  sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			Period: 24 * time.Hour,
+		}),
+	})
+	require.NoError(err)
+
+	addr, err := sdk.AccAddressFromBech32(res.AccountAddress)
+	require.NoError(err)
+	require.NoError(s.app.BankKeeper.SendCoins(s.ctx, s.addrs[0], addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))))
+	return addr
+}
+
+func (s *TestSuite) send(sender, account sdk.AccAddress, amount int64) error {
+	_, err := s.msgServer.Execute(s.ctx, &accounts.MsgExecute{
+		Sender: sender.String(),
+		Target: account.String(),
+		Message: s.pack(&spendinglimit.MsgExecute{
+			Msgs: []*types.Any{s.pack(&banktypes.MsgSend{
+				FromAddress: account.String(),
+				ToAddress:   s.addrs[2].String(),
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)),
+			})},
+		}),
+	})
+	return err
+}
+
+func (s *TestSuite) state(account sdk.AccAddress) spendinglimit.State {
+	res, err := s.queryClient.AccountQuery(s.ctx, &accounts.AccountQueryRequest{
+		Target:  account.String(),
+		Request: s.pack(&spendinglimit.QueryState{}),
+	})
+	s.Require().NoError(err)
+	var resp accounts.AccountMsg
+	s.Require().NoError(s.app.AppCodec().UnpackAny(res.Response, &resp))
+	return resp.(*spendinglimit.QueryStateResponse).State
+}
+
+func (s *TestSuite) TestInit() {
+	require := s.Require()
+
+	addr := s.initSpendingLimit()
+	require.NotNil(s.app.AccountKeeper.GetAccount(s.ctx, addr))
+
+	other := s.initSpendingLimit()
+	require.NotEqual(addr, other)
+
+	res, err := s.queryClient.AccountType(s.ctx, &accounts.AccountTypeRequest{Address: addr.String()})
+	require.NoError(err)
+	require.Equal(spendinglimit.AccountType, res.AccountType)
+
+	types, err := s.queryClient.AccountTypes(s.ctx, &accounts.AccountTypesRequest{})
+	require.NoError(err)
+	require.Equal([]string{spendinglimit.AccountType}, types.AccountTypes)
+
+	state := s.state(addr)
+	require.Equal(s.addrs[0].String(), state.Owner)
+	require.True(state.Spent.IsZero())
+
+	_, err = s.msgServer.Init(s.ctx, &accounts.MsgInit{
+		Sender:      s.addrs[0].String(),
+		AccountType: "unknown",
+		Message:     s.pack(&spendinglimit.MsgInit{}),
+	})
+	require.ErrorIs(err, accounts.ErrUnknownAccountType)
+
+	_, err = s.msgServer.Init(s.ctx, &accounts.MsgInit{
+		Sender:      s.addrs[0].String(),
+		AccountType: spendinglimit.AccountType,
+		Message: s.pack(&spendinglimit.MsgInit{
+			Owner:  s.addrs[0].String(),
+			Period: 24 * time.Hour,
+		}),
+	})
+	require.ErrorIs(err, spendinglimit.ErrInvalidLimit)
+
+	_, err = s.queryClient.AccountType(s.ctx, &accounts.AccountTypeRequest{Address: s.addrs[1].String()})
+	require.ErrorIs(err, accounts.ErrAccountNotFound)
+}
+
+func (s *TestSuite) TestExecute() {
+	require := s.Require()
+	addr := s.initSpendingLimit()
+	before := s.app.BankKeeper.GetBalance(s.ctx, s.addrs[2], sdk.DefaultBondDenom).Amount
+
+	require.NoError(s.send(s.addrs[0], addr, 60))
+	require.Equal(before.AddRaw(60), s.app.BankKeeper.GetBalance(s.ctx, s.addrs[2], sdk.DefaultBondDenom).Amount)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60)), s.state(addr).Spent)
+
+	// only the owner can execute messages
+	require.ErrorIs(s.send(s.addrs[1], addr, 10), accounts.ErrUnauthorized)
+
+	// the account cannot execute messages signed by other addresses
+	_, err := s.msgServer.Execute(s.ctx, &accounts.MsgExecute{
+		Sender: s.addrs[0].String(),
+		Target: addr.String(),
+		Message: s.pack(&spendinglimit.MsgExecute{
+			Msgs: []*types.Any{s.pack(&banktypes.MsgSend{
+				FromAddress: s.addrs[1].String(),
+				ToAddress:   s.addrs[2].String(),
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			})},
+		}),
+	})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.msgServer.Execute(s.ctx, &accounts.MsgExecute{
+		Sender:  s.addrs[0].String(),
+		Target:  s.addrs[1].String(),
+		Message: s.pack(&spendinglimit.MsgExecute{}),
+	})
+	require.ErrorIs(err, accounts.ErrAccountNotFound)
+}
+
+func (s *TestSuite) TestSpendingLimit() {
+	require := s.Require()
+	addr := s.initSpendingLimit()
+
+	require.NoError(s.send(s.addrs[0], addr, 60))
+	cacheCtx, _ := s.ctx.CacheContext()
+	ctx := s.ctx
+	s.ctx = cacheCtx
+	require.ErrorIs(s.send(s.addrs[0], addr, 50), spendinglimit.ErrLimitExceeded)
+	s.ctx = ctx
+	require.NoError(s.send(s.addrs[0], addr, 40))
+
+	// the spent amount is reset once the period elapsed
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(24 * time.Hour))
+	require.NoError(s.send(s.addrs[0], addr, 100))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), s.state(addr).Spent)
+
+	// the owner can update the limit
+	_, err := s.msgServer.Execute(s.ctx, &accounts.MsgExecute{
+		Sender: s.addrs[0].String(),
+		Target: addr.String(),
+		Message: s.pack(&spendinglimit.MsgUpdateLimit{
+			Limit:  sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)),
+			Period: 24 * time.Hour,
+		}),
+	})
+	require.NoError(err)
+	require.NoError(s.send(s.addrs[0], addr, 100))
+}
+
+func (s *TestSuite) TestGenesis() {
+	require := s.Require()
+	addr := s.initSpendingLimit()
+	require.NoError(s.send(s.addrs[0], addr, 60))
+
+	genesis := s.app.AccountsKeeper.ExportGenesis(s.ctx)
+	require.NoError(accounts.ValidateGenesis(*genesis))
+	require.Len(genesis.Accounts, 1)
+
+	ctx := s.app.BaseApp.NewContext(false, tmproto.Header{})
+	s.app.AccountsKeeper.InitGenesis(ctx, genesis)
+	require.Equal(genesis, s.app.AccountsKeeper.ExportGenesis(ctx))
+
+	_, err := s.app.AccountsKeeper.AccountQuery(s.ctx, nil)
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/accounts"
+)
+
+var (
+	AccountNumberKey   = []byte{0x01}
+	AccountTypePrefix  = []byte{0x02}
+	AccountStatePrefix = []byte{0x03}
+)
+
+// accountTypeKey returns the byte representation of the account type key of an account
+// Items are stored with the following key: values
+// 0x02<address length (1 Byte)><address>
+func accountTypeKey(addr sdk.AccAddress) []byte {
+	return append(AccountTypePrefix, address.MustLengthPrefix(addr)...)
+}
+
+// accountStatePrefix returns the prefix of the state of an account
+// Items are stored with the following key: values
+// 0x03<address length (1 Byte)><address><key>
+func accountStatePrefix(addr sdk.AccAddress) []byte {
+	return append(AccountStatePrefix, address.MustLengthPrefix(addr)...)
+}
+
+// accountAddress derives the address of the account with the given type and number.
+func accountAddress(accountType string, accountNumber uint64) sdk.AccAddress {
+	parent := address.Module(accounts.ModuleName, []byte(accountType))
+	return address.Derive(parent, sdk.Uint64ToBigEndian(accountNumber))
+}
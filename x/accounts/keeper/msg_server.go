@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/accounts"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the accounts MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) accounts.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ accounts.MsgServer = msgServer{}
+
+// Init creates a new account of the given account type
+func (k msgServer) Init(goCtx context.Context, msg *accounts.MsgInit) (*accounts.MsgInitResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	initMsg, err := k.unpackAccountMsg(msg.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, resp, err := k.Keeper.Init(ctx, msg.AccountType, sender, initMsg)
+	if err != nil {
+		return nil, err
+	}
+	anyResp, err := packAccountMsg(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&accounts.EventInit{
+		Address:     addr.String(),
+		AccountType: msg.AccountType,
+		Sender:      msg.Sender,
+	}); err != nil {
+		return nil, err
+	}
+	return &accounts.MsgInitResponse{AccountAddress: addr.String(), Response: anyResp}, nil
+}
+
+// Execute sends a state transition message to an account
+func (k msgServer) Execute(goCtx context.Context, msg *accounts.MsgExecute) (*accounts.MsgExecuteResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	target, err := sdk.AccAddressFromBech32(msg.Target)
+	if err != nil {
+		return nil, err
+	}
+	execMsg, err := k.unpackAccountMsg(msg.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp, err := k.Keeper.Execute(ctx, target, sender, execMsg)
+	if err != nil {
+		return nil, err
+	}
+	anyResp, err := packAccountMsg(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&accounts.EventExecute{
+		Target:     msg.Target,
+		Sender:     msg.Sender,
+		MsgTypeUrl: msg.Message.TypeUrl,
+	}); err != nil {
+		return nil, err
+	}
+	return &accounts.MsgExecuteResponse{Response: anyResp}, nil
+}
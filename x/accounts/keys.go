@@ -0,0 +1,13 @@
+package accounts
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "accounts"
+
+	// StoreKey is the store key string for accounts, it differs from the module name
+	// since store keys cannot be prefixes of each other and "acc" is taken by x/auth
+	StoreKey = "_" + ModuleName
+
+	// RouterKey is the message route for accounts
+	RouterKey = ModuleName
+)
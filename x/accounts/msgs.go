@@ -0,0 +1,80 @@
+package accounts
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgInit{}
+	_ sdk.Msg = &MsgExecute{}
+
+	_ types.UnpackInterfacesMessage = &MsgInit{}
+	_ types.UnpackInterfacesMessage = &MsgExecute{}
+	_ types.UnpackInterfacesMessage = &AccountQueryRequest{}
+)
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgInit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", m.Sender)
+	}
+
+	if strings.TrimSpace(m.AccountType) == "" {
+		return sdkerrors.Wrap(ErrUnknownAccountType, "account type cannot be empty")
+	}
+
+	if m.Message == nil {
+		return sdkerrors.Wrap(ErrInvalidMessage, "message cannot be empty")
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgInit) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgInit) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var msg AccountMsg
+	return unpacker.UnpackAny(m.Message, &msg)
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgExecute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", m.Sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Target); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid target address (%s)", m.Target)
+	}
+
+	if m.Message == nil {
+		return sdkerrors.Wrap(ErrInvalidMessage, "message cannot be empty")
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgExecute) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgExecute) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var msg AccountMsg
+	return unpacker.UnpackAny(m.Message, &msg)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m AccountQueryRequest) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var req AccountMsg
+	return unpacker.UnpackAny(m.Request, &req)
+}
@@ -0,0 +1,17 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// EndBlocker reaps the dust accounts at the end of their grace period.
+func EndBlocker(ctx sdk.Context, ak keeper.AccountKeeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	ak.ReapDustAccounts(ctx)
+}
@@ -44,6 +44,10 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountsCmd(),
 		QueryParamsCmd(),
 		QueryModuleAccountsCmd(),
+		QueryModuleAccountByNameCmd(),
+		QueryModuleAccountsByPermissionCmd(),
+		QueryModuleAccountPermissionsCmd(),
+		QueryReapCandidatesCmd(),
 	)
 
 	return cmd
@@ -156,6 +160,43 @@ func GetAccountsCmd() *cobra.Command {
 	return cmd
 }
 
+// QueryReapCandidatesCmd returns a query command that will display the dust
+// accounts pending reaping
+func QueryReapCandidatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reap-candidates",
+		Short: "Query the dust accounts pending reaping",
+		Long: `Query the dust accounts which are reaped at the end of their grace period,
+their balances being rolled into the community pool, unless they send a tx or
+their balances grow above the dust threshold.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ReapCandidates(cmd.Context(), &types.QueryReapCandidatesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "reap candidates")
+
+	return cmd
+}
+
 // QueryAllModuleAccountsCmd returns a list of all the existing module accounts with their account information and permissions
 func QueryModuleAccountsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -183,6 +224,97 @@ func QueryModuleAccountsCmd() *cobra.Command {
 	return cmd
 }
 
+// QueryModuleAccountByNameCmd returns a command to query the module account of a module by its name
+func QueryModuleAccountByNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "module-account [module-name]",
+		Short:   "Query module account info by module name",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s q auth module-account distribution", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			moduleName := args[0]
+			if len(moduleName) == 0 {
+				return fmt.Errorf("module name should not be empty")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ModuleAccountByName(cmd.Context(), &types.QueryModuleAccountByNameRequest{Name: moduleName})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryModuleAccountsByPermissionCmd returns a command to query the module accounts granted a permission
+func QueryModuleAccountsByPermissionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "module-accounts-by-permission [permission]",
+		Short:   "Query the module accounts granted a permission, e.g. minter",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s q auth module-accounts-by-permission %s", version.AppName, types.Minter),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ModuleAccountsByPermission(cmd.Context(), &types.QueryModuleAccountsByPermissionRequest{Permission: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryModuleAccountPermissionsCmd returns the module accounts registered in the app with their permissions
+func QueryModuleAccountPermissionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-account-permissions",
+		Short: "Query the permissions of all the registered module accounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ModuleAccountPermissions(cmd.Context(), &types.QueryModuleAccountPermissionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // QueryTxsByEventsCmd returns a command to search through transactions by events.
 func QueryTxsByEventsCmd() *cobra.Command {
 	cmd := &cobra.Command{
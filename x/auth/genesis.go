@@ -12,6 +12,7 @@ import (
 // a genesis port script to the new fee collector account
 func InitGenesis(ctx sdk.Context, ak keeper.AccountKeeper, data types.GenesisState) {
 	ak.SetParams(ctx, data.Params)
+	ak.SetReapParams(ctx, data.ReapParams)
 
 	accounts, err := types.UnpackAccounts(data.Accounts)
 	if err != nil {
@@ -38,5 +39,7 @@ func ExportGenesis(ctx sdk.Context, ak keeper.AccountKeeper) *types.GenesisState
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	genState := types.NewGenesisState(params, genAccounts)
+	genState.ReapParams = ak.GetReapParams(ctx)
+	return genState
 }
@@ -3,7 +3,6 @@ package keeper
 import (
 	"context"
 	"errors"
-	"sort"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -14,6 +13,7 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/util/collection"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -83,8 +83,9 @@ func (ak AccountKeeper) Params(c context.Context, req *types.QueryParamsRequest)
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 	params := ak.GetParams(ctx)
+	reapParams := ak.GetReapParams(ctx)
 
-	return &types.QueryParamsResponse{Params: params}, nil
+	return &types.QueryParamsResponse{Params: params, ReapParams: reapParams}, nil
 }
 
 // ModuleAccounts returns all the existing Module Accounts
@@ -96,11 +97,7 @@ func (ak AccountKeeper) ModuleAccounts(c context.Context, req *types.QueryModule
 	ctx := sdk.UnwrapSDKContext(c)
 
 	// For deterministic output, sort the permAddrs by module name.
-	sortedPermAddrs := make([]string, 0, len(ak.permAddrs))
-	for moduleName := range ak.permAddrs {
-		sortedPermAddrs = append(sortedPermAddrs, moduleName)
-	}
-	sort.Strings(sortedPermAddrs)
+	sortedPermAddrs := collection.SortedKeys(ak.permAddrs)
 
 	modAccounts := make([]*codectypes.Any, 0, len(ak.permAddrs))
 
@@ -119,6 +116,97 @@ func (ak AccountKeeper) ModuleAccounts(c context.Context, req *types.QueryModule
 	return &types.QueryModuleAccountsResponse{Accounts: modAccounts}, nil
 }
 
+// ModuleAccountByName returns the module account of a registered module by its name
+func (ak AccountKeeper) ModuleAccountByName(c context.Context, req *types.QueryModuleAccountByNameRequest) (*types.QueryModuleAccountByNameResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Name) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "module name is empty")
+	}
+
+	if _, ok := ak.permAddrs[req.Name]; !ok {
+		return nil, status.Errorf(codes.NotFound, "module account %s not registered", req.Name)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	account := ak.GetModuleAccount(ctx, req.Name)
+	if account == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Name)
+	}
+
+	any, err := codectypes.NewAnyWithValue(account)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &types.QueryModuleAccountByNameResponse{Account: any}, nil
+}
+
+// ModuleAccountsByPermission returns the module accounts granted a permission,
+// sorted by module name.
+func (ak AccountKeeper) ModuleAccountsByPermission(c context.Context, req *types.QueryModuleAccountsByPermissionRequest) (*types.QueryModuleAccountsByPermissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Permission) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "permission is empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// For deterministic output, sort the permAddrs by module name.
+	sortedPermAddrs := collection.SortedKeys(ak.permAddrs)
+
+	var modAccounts []*codectypes.Any
+	for _, moduleName := range sortedPermAddrs {
+		if !ak.permAddrs[moduleName].HasPermission(req.Permission) {
+			continue
+		}
+
+		account := ak.GetModuleAccount(ctx, moduleName)
+		if account == nil {
+			return nil, status.Errorf(codes.NotFound, "account %s not found", moduleName)
+		}
+		any, err := codectypes.NewAnyWithValue(account)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		modAccounts = append(modAccounts, any)
+	}
+
+	return &types.QueryModuleAccountsByPermissionResponse{Accounts: modAccounts}, nil
+}
+
+// ModuleAccountPermissions returns all the module accounts registered in the
+// keeper along with their permissions.
+func (ak AccountKeeper) ModuleAccountPermissions(c context.Context, req *types.QueryModuleAccountPermissionsRequest) (*types.QueryModuleAccountPermissionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// For deterministic output, sort the permAddrs by module name.
+	sortedPermAddrs := collection.SortedKeys(ak.permAddrs)
+
+	modAccounts := make([]types.ModuleAccountPermission, 0, len(ak.permAddrs))
+	for _, moduleName := range sortedPermAddrs {
+		permAddr := ak.permAddrs[moduleName]
+		addr, err := ak.addressCdc.BytesToString(permAddr.GetAddress())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		modAccounts = append(modAccounts, types.ModuleAccountPermission{
+			Name:        moduleName,
+			Address:     addr,
+			Permissions: permAddr.GetPermissions(),
+		})
+	}
+
+	return &types.QueryModuleAccountPermissionsResponse{ModuleAccounts: modAccounts}, nil
+}
+
 func (ak AccountKeeper) Bech32Prefix(ctx context.Context, req *types.Bech32PrefixRequest) (*types.Bech32PrefixResponse, error) {
 	bech32Prefix, err := ak.getBech32Prefix()
 	if err != nil {
@@ -161,3 +249,34 @@ func (ak AccountKeeper) AddressStringToBytes(ctx context.Context, req *types.Add
 
 	return &types.AddressStringToBytesResponse{AddressBytes: bz}, nil
 }
+
+// ReapCandidates returns the dust accounts pending reaping.
+func (ak AccountKeeper) ReapCandidates(c context.Context, req *types.QueryReapCandidatesRequest) (*types.QueryReapCandidatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	gracePeriod := ak.GetReapParams(ctx).GracePeriod
+	candidatesStore := prefix.NewStore(ctx.KVStore(ak.key), types.ReapCandidatePrefix)
+
+	var candidates []types.ReapCandidate
+	pageRes, err := query.Paginate(candidatesStore, req.Pagination, func(key, value []byte) error {
+		dustSince, err := sdk.ParseTimeBytes(value)
+		if err != nil {
+			return err
+		}
+
+		candidates = append(candidates, types.ReapCandidate{
+			Address:   sdk.AccAddress(key).String(),
+			DustSince: dustSince,
+			ReapTime:  dustSince.Add(gracePeriod),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+
+	return &types.QueryReapCandidatesResponse{Candidates: candidates, Pagination: pageRes}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -291,6 +292,105 @@ func (suite *KeeperTestSuite) TestGRPCQueryModuleAccounts() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryModuleAccountByName() {
+	testCases := []struct {
+		msg     string
+		req     *types.QueryModuleAccountByNameRequest
+		expPass bool
+	}{
+		{"empty module name", &types.QueryModuleAccountByNameRequest{}, false},
+		{"unregistered module", &types.QueryModuleAccountByNameRequest{Name: "unknown"}, false},
+		{"success", &types.QueryModuleAccountByNameRequest{Name: "mint"}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			res, err := suite.queryClient.ModuleAccountByName(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+				return
+			}
+
+			suite.Require().NoError(err)
+			var account types.AccountI
+			suite.Require().NoError(suite.app.InterfaceRegistry().UnpackAny(res.Account, &account))
+			moduleAccount, ok := account.(types.ModuleAccountI)
+			suite.Require().True(ok)
+			suite.Require().Equal(tc.req.Name, moduleAccount.GetName())
+			suite.Require().Equal(types.NewModuleAddress(tc.req.Name), moduleAccount.GetAddress())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryModuleAccountsByPermission() {
+	testCases := []struct {
+		msg     string
+		req     *types.QueryModuleAccountsByPermissionRequest
+		expPass bool
+	}{
+		{"empty permission", &types.QueryModuleAccountsByPermissionRequest{}, false},
+		{"unknown permission", &types.QueryModuleAccountsByPermissionRequest{Permission: "unknown"}, true},
+		{"minter", &types.QueryModuleAccountsByPermissionRequest{Permission: types.Minter}, true},
+		{"burner", &types.QueryModuleAccountsByPermissionRequest{Permission: types.Burner}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			res, err := suite.queryClient.ModuleAccountsByPermission(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			var expNames []string
+			for name, perms := range simapp.GetMaccPerms() {
+				for _, perm := range perms {
+					if perm == tc.req.Permission {
+						expNames = append(expNames, name)
+					}
+				}
+			}
+			sort.Strings(expNames)
+
+			var moduleNames []string
+			for _, any := range res.Accounts {
+				var account types.AccountI
+				suite.Require().NoError(suite.app.InterfaceRegistry().UnpackAny(any, &account))
+				moduleAccount, ok := account.(types.ModuleAccountI)
+				suite.Require().True(ok)
+				suite.Require().True(moduleAccount.HasPermission(tc.req.Permission))
+				moduleNames = append(moduleNames, moduleAccount.GetName())
+			}
+			suite.Require().Equal(expNames, moduleNames)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryModuleAccountPermissions() {
+	suite.SetupTest() // reset
+
+	res, err := suite.queryClient.ModuleAccountPermissions(sdk.WrapSDKContext(suite.ctx), &types.QueryModuleAccountPermissionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.ModuleAccounts, len(simapp.GetMaccPerms()))
+
+	var moduleNames []string
+	for _, modAcc := range res.ModuleAccounts {
+		moduleNames = append(moduleNames, modAcc.Name)
+		suite.Require().Equal(types.NewModuleAddress(modAcc.Name).String(), modAcc.Address)
+		suite.Require().Equal(simapp.GetMaccPerms()[modAcc.Name], modAcc.Permissions)
+	}
+	// Make sure output is sorted alphabetically.
+	suite.Require().True(sort.StringsAreSorted(moduleNames))
+}
+
 func (suite *KeeperTestSuite) TestBech32Prefix() {
 	suite.SetupTest() // reset
 	req := &types.Bech32PrefixRequest{}
@@ -62,6 +62,10 @@ type AccountKeeper struct {
 	// The prototypical AccountI constructor.
 	proto      func() types.AccountI
 	addressCdc address.Codec
+
+	// the keepers used to reap the dust accounts, if set
+	reapBankKeeper types.ReapBankKeeper
+	distrKeeper    types.DistributionKeeper
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"encoding/base64"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type msgServer struct {
+	AccountKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the auth MsgServer interface
+// for the provided AccountKeeper.
+func NewMsgServerImpl(ak AccountKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: ak}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// ChangePubKey replaces the public key of an account, consuming the
+// PubKeyChangeCost param in gas. The previous key can no longer sign for the
+// account afterwards.
+func (s msgServer) ChangePubKey(goCtx context.Context, msg *types.MsgChangePubKey) (*types.MsgChangePubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := msg.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	acc := s.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+	if _, ok := acc.(types.ModuleAccountI); ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot change the pubkey of module account %s", msg.Address)
+	}
+
+	oldPubKey := acc.GetPubKey()
+	if oldPubKey != nil && oldPubKey.Equals(pubKey) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "new pubkey is the current pubkey of the account")
+	}
+
+	ctx.GasMeter().ConsumeGas(s.GetParams(ctx).PubKeyChangeCost, "pubkey change")
+
+	if err := acc.SetPubKey(pubKey); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	s.SetAccount(ctx, acc)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangePubKey,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyOldPubKey, pubKeyToBase64(oldPubKey)),
+			sdk.NewAttribute(types.AttributeKeyNewPubKey, pubKeyToBase64(pubKey)),
+		),
+	)
+
+	return &types.MsgChangePubKeyResponse{}, nil
+}
+
+func pubKeyToBase64(pk cryptotypes.PubKey) string {
+	if pk == nil {
+		return ""
+	}
+
+	return base64.StdEncoding.EncodeToString(pk.Bytes())
+}
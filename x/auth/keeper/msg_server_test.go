@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgChangePubKey() {
+	_, oldPubKey, addr := testdata.KeyTestPubAddr()
+	_, newPubKey, _ := testdata.KeyTestPubAddr()
+	_, _, unknownAddr := testdata.KeyTestPubAddr()
+	moduleAddr := types.NewModuleAddress(types.FeeCollectorName)
+
+	testCases := []struct {
+		name   string
+		addr   sdk.AccAddress
+		expErr error
+	}{
+		{"success", addr, nil},
+		{"unknown account", unknownAddr, sdkerrors.ErrUnknownAddress},
+		{"module account", moduleAddr, sdkerrors.ErrUnauthorized},
+		{"same pubkey", addr, sdkerrors.ErrInvalidPubKey},
+	}
+
+	suite.SetupTest() // reset
+	ak := suite.app.AccountKeeper
+	msgServer := keeper.NewMsgServerImpl(ak)
+
+	acc := ak.NewAccountWithAddress(suite.ctx, addr)
+	suite.Require().NoError(acc.SetPubKey(oldPubKey))
+	ak.SetAccount(suite.ctx, acc)
+	ak.GetModuleAccount(suite.ctx, types.FeeCollectorName)
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager()).WithGasMeter(sdk.NewInfiniteGasMeter())
+			msg, err := types.NewMsgChangePubKey(tc.addr, newPubKey)
+			suite.Require().NoError(err)
+
+			_, err = msgServer.ChangePubKey(sdk.WrapSDKContext(ctx), msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			pubKey, err := ak.GetPubKey(ctx, tc.addr)
+			suite.Require().NoError(err)
+			suite.Require().True(newPubKey.Equals(pubKey))
+			suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), ak.GetParams(ctx).PubKeyChangeCost)
+
+			events := ctx.EventManager().Events()
+			suite.Require().Len(events, 1)
+			suite.Require().Equal(types.EventTypeChangePubKey, events[0].Type)
+		})
+	}
+}
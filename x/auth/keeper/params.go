@@ -15,3 +15,15 @@ func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	ak.paramSubspace.GetParamSet(ctx, &params)
 	return
 }
+
+// SetReapParams sets the parameters of the reaping of the dust accounts.
+func (ak AccountKeeper) SetReapParams(ctx sdk.Context, params types.ReapParams) {
+	ak.paramSubspace.Set(ctx, types.KeyReapParams, params)
+}
+
+// GetReapParams gets the parameters of the reaping of the dust accounts, the
+// zero ones disabling the reaping if unset.
+func (ak AccountKeeper) GetReapParams(ctx sdk.Context) (params types.ReapParams) {
+	ak.paramSubspace.GetIfExists(ctx, types.KeyReapParams, &params)
+	return
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SetReapKeepers sets the keepers used to reap the dust accounts, rolling their
+// balances into the community pool. The dust accounts are not reaped if unset.
+func (ak *AccountKeeper) SetReapKeepers(bk types.ReapBankKeeper, dk types.DistributionKeeper) *AccountKeeper {
+	if ak.reapBankKeeper != nil {
+		panic("cannot set reap keepers twice")
+	}
+
+	ak.reapBankKeeper = bk
+	ak.distrKeeper = dk
+
+	return ak
+}
+
+// ReapDustAccounts checks the next ScanLimit accounts, resuming after the
+// last account checked at the previous block, and reaps the dust accounts which
+// never sent a tx at the end of their grace period, the accounts without any
+// balance not being dust: their balances are rolled
+// into the community pool and they are removed. The grace period starts when an
+// account is first found dust, and restarts if it is found not dust in between.
+func (ak AccountKeeper) ReapDustAccounts(ctx sdk.Context) {
+	if ak.reapBankKeeper == nil {
+		return
+	}
+
+	params := ak.GetReapParams(ctx)
+	if !params.Enabled() {
+		return
+	}
+
+	for _, acc := range ak.nextAccountsToCheck(ctx, params.ScanLimit) {
+		ak.checkDustAccount(ctx, params, acc)
+	}
+}
+
+// nextAccountsToCheck returns up to limit accounts following the last account
+// checked for reaping, wrapping around the account store, and moves the cursor
+// to the last account returned.
+func (ak AccountKeeper) nextAccountsToCheck(ctx sdk.Context, limit uint64) []types.AccountI {
+	store := ctx.KVStore(ak.key)
+	cursor := store.Get(types.ReapCursorKey)
+
+	var accounts []types.AccountI
+	collect := func(start, end []byte) {
+		iterator := store.Iterator(start, end)
+		defer iterator.Close()
+
+		for ; iterator.Valid() && uint64(len(accounts)) < limit; iterator.Next() {
+			accounts = append(accounts, ak.decodeAccount(iterator.Value()))
+		}
+	}
+
+	if cursor == nil {
+		collect(types.AddressStoreKeyPrefix, sdk.PrefixEndBytes(types.AddressStoreKeyPrefix))
+	} else {
+		// the accounts after the cursor, then the ones before it, the cursor
+		// included
+		afterCursor := append(types.AddressStoreKey(cursor), 0x00)
+		collect(afterCursor, sdk.PrefixEndBytes(types.AddressStoreKeyPrefix))
+		collect(types.AddressStoreKeyPrefix, afterCursor)
+	}
+
+	if len(accounts) > 0 {
+		store.Set(types.ReapCursorKey, accounts[len(accounts)-1].GetAddress())
+	}
+
+	return accounts
+}
+
+// checkDustAccount starts the grace period of acc if it is dust, reaps it at the
+// end of its grace period, and stops its grace period if it isn't dust anymore.
+func (ak AccountKeeper) checkDustAccount(ctx sdk.Context, params types.ReapParams, acc types.AccountI) {
+	addr := acc.GetAddress()
+	balances := ak.reapBankKeeper.GetAllBalances(ctx, addr)
+
+	// only the base accounts which never sent a tx are reaped, and not the
+	// module or vesting accounts
+	_, isBaseAccount := acc.(*types.BaseAccount)
+	if !isBaseAccount || acc.GetSequence() != 0 || !params.IsDust(balances) {
+		ak.deleteReapCandidate(ctx, addr)
+		return
+	}
+
+	dustSince, found := ak.getReapCandidate(ctx, addr)
+	if !found {
+		ak.setReapCandidate(ctx, addr, ctx.BlockTime())
+		return
+	}
+
+	if ctx.BlockTime().Before(dustSince.Add(params.GracePeriod)) {
+		return
+	}
+
+	// the account is left untouched if its balances can't be rolled into the
+	// community pool
+	cacheCtx, write := ctx.CacheContext()
+	if err := ak.distrKeeper.FundCommunityPool(cacheCtx, balances, addr); err != nil {
+		ak.Logger(ctx).Error("failed to reap dust account", "address", addr.String(), "err", err)
+		return
+	}
+
+	ak.RemoveAccount(cacheCtx, acc)
+	ak.deleteReapCandidate(cacheCtx, addr)
+	write()
+
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReapAccount,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, balances.String()),
+		),
+	)
+}
+
+// getReapCandidate returns the time the account addr was first found dust.
+func (ak AccountKeeper) getReapCandidate(ctx sdk.Context, addr sdk.AccAddress) (time.Time, bool) {
+	bz := ctx.KVStore(ak.key).Get(types.ReapCandidateKey(addr))
+	if bz == nil {
+		return time.Time{}, false
+	}
+
+	dustSince, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return dustSince, true
+}
+
+func (ak AccountKeeper) setReapCandidate(ctx sdk.Context, addr sdk.AccAddress, dustSince time.Time) {
+	ctx.KVStore(ak.key).Set(types.ReapCandidateKey(addr), sdk.FormatTimeBytes(dustSince))
+}
+
+func (ak AccountKeeper) deleteReapCandidate(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(ak.key).Delete(types.ReapCandidateKey(addr))
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+)
+
+func (suite *KeeperTestSuite) TestReapDustAccounts() {
+	suite.SetupTest() // reset
+	app, ak := suite.app, suite.app.AccountKeeper
+
+	ak.SetReapParams(suite.ctx, types.NewReapParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), time.Hour, 100))
+
+	newAccount := func(balances sdk.Coins, sequence uint64) sdk.AccAddress {
+		_, _, addr := testdata.KeyTestPubAddr()
+		acc := ak.NewAccountWithAddress(suite.ctx, addr)
+		suite.Require().NoError(acc.SetSequence(sequence))
+		ak.SetAccount(suite.ctx, acc)
+		suite.Require().NoError(banktestutil.FundAccount(app.BankKeeper, suite.ctx, addr, balances))
+		return addr
+	}
+
+	dust := newAccount(sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), 0)
+	// e.g. an account whose funds are all delegated
+	empty := newAccount(sdk.NewCoins(), 0)
+	rich := newAccount(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), 0)
+	noThreshold := newAccount(sdk.NewCoins(sdk.NewInt64Coin("atom", 5)), 0)
+	active := newAccount(sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), 1)
+
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithBlockTime(start)
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	// the dust accounts become candidates
+	ak.ReapDustAccounts(ctx)
+	res, err := suite.queryClient.ReapCandidates(sdk.WrapSDKContext(ctx), &types.QueryReapCandidatesRequest{})
+	suite.Require().NoError(err)
+	var candidates []string
+	for _, candidate := range res.Candidates {
+		candidates = append(candidates, candidate.Address)
+		suite.Require().Equal(start, candidate.DustSince)
+		suite.Require().Equal(start.Add(time.Hour), candidate.ReapTime)
+	}
+	suite.Require().ElementsMatch([]string{dust.String()}, candidates)
+
+	// and are reaped at the end of their grace period
+	ctx = ctx.WithBlockTime(start.Add(time.Hour - time.Second)).WithEventManager(sdk.NewEventManager())
+	ak.ReapDustAccounts(ctx)
+	suite.Require().True(ak.HasAccount(ctx, dust))
+
+	ctx = ctx.WithBlockTime(start.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	ak.ReapDustAccounts(ctx)
+	suite.Require().False(ak.HasAccount(ctx, dust))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, dust).IsZero())
+	suite.Require().Equal(
+		communityPool.Add(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 5))...),
+		app.DistrKeeper.GetFeePoolCommunityCoins(ctx),
+	)
+
+	var reaped []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeReapAccount {
+			reaped = append(reaped, string(event.Attributes[0].Value))
+		}
+	}
+	suite.Require().ElementsMatch([]string{dust.String()}, reaped)
+
+	for _, addr := range []sdk.AccAddress{empty, rich, noThreshold, active} {
+		suite.Require().True(ak.HasAccount(ctx, addr))
+	}
+
+	res, err = suite.queryClient.ReapCandidates(sdk.WrapSDKContext(ctx), &types.QueryReapCandidatesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Candidates)
+}
+
+func (suite *KeeperTestSuite) TestReapDustAccountsGracePeriodRestart() {
+	suite.SetupTest() // reset
+	app, ak := suite.app, suite.app.AccountKeeper
+
+	ak.SetReapParams(suite.ctx, types.NewReapParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), time.Hour, 100))
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	ak.SetAccount(suite.ctx, ak.NewAccountWithAddress(suite.ctx, addr))
+
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithBlockTime(start)
+	ak.ReapDustAccounts(ctx)
+
+	// the grace period stops once the account isn't dust anymore
+	suite.Require().NoError(banktestutil.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	ak.ReapDustAccounts(ctx)
+	suite.Require().True(ak.HasAccount(ctx, addr))
+
+	res, err := suite.queryClient.ReapCandidates(sdk.WrapSDKContext(ctx), &types.QueryReapCandidatesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Candidates)
+}
+
+func (suite *KeeperTestSuite) TestReapDustAccountsScanLimit() {
+	suite.SetupTest() // reset
+	ak := suite.app.AccountKeeper
+
+	ak.SetReapParams(suite.ctx, types.NewReapParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), time.Hour, 2))
+
+	for i := 0; i < 3; i++ {
+		_, _, addr := testdata.KeyTestPubAddr()
+		ak.SetAccount(suite.ctx, ak.NewAccountWithAddress(suite.ctx, addr))
+		suite.Require().NoError(banktestutil.FundAccount(suite.app.BankKeeper, suite.ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+	}
+
+	var numAccounts int
+	ak.IterateAccounts(suite.ctx, func(types.AccountI) bool {
+		numAccounts++
+		return false
+	})
+
+	candidates := func() []types.ReapCandidate {
+		res, err := suite.queryClient.ReapCandidates(sdk.WrapSDKContext(suite.ctx), &types.QueryReapCandidatesRequest{})
+		suite.Require().NoError(err)
+		return res.Candidates
+	}
+
+	// each block checks the next 2 accounts
+	ak.ReapDustAccounts(suite.ctx)
+	suite.Require().LessOrEqual(len(candidates()), 2)
+
+	// until all the accounts are checked, wrapping around
+	for i := 2; i < numAccounts; i += 2 {
+		ak.ReapDustAccounts(suite.ctx)
+	}
+	suite.Require().Len(candidates(), 3)
+}
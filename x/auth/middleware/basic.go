@@ -150,6 +150,65 @@ func (txh txTimeoutHeightTxHandler) SimulateTx(ctx context.Context, req tx.Reque
 	return txh.next.SimulateTx(ctx, req)
 }
 
+var _ tx.Handler = txTimeoutTimestampTxHandler{}
+
+type txTimeoutTimestampTxHandler struct {
+	next tx.Handler
+}
+
+// TxTimeoutTimestampMiddleware defines a middleware that checks for a tx
+// timeout timestamp, compared to the block time, for the clients which can't
+// predict block heights.
+func TxTimeoutTimestampMiddleware(txh tx.Handler) tx.Handler {
+	return txTimeoutTimestampTxHandler{
+		next: txh,
+	}
+}
+
+func checkTimeoutTimestamp(ctx context.Context, tx sdk.Tx) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	timeoutTx, ok := tx.(sdk.TxWithTimeoutTimestamp)
+	if !ok {
+		return sdkerrors.Wrap(sdkerrors.ErrTxDecode, "expected tx to implement TxWithTimeoutTimestamp")
+	}
+
+	timeoutTimestamp := timeoutTx.GetTimeoutTimestamp()
+	if !timeoutTimestamp.IsZero() && sdkCtx.BlockTime().After(timeoutTimestamp) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrTxTimeout, "block time: %s, timeout timestamp: %s", sdkCtx.BlockTime(), timeoutTimestamp,
+		)
+	}
+
+	return nil
+}
+
+// CheckTx implements tx.Handler.CheckTx.
+func (txh txTimeoutTimestampTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	if err := checkTimeoutTimestamp(ctx, req.Tx); err != nil {
+		return tx.Response{}, tx.ResponseCheckTx{}, err
+	}
+
+	return txh.next.CheckTx(ctx, req, checkReq)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx.
+func (txh txTimeoutTimestampTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if err := checkTimeoutTimestamp(ctx, req.Tx); err != nil {
+		return tx.Response{}, err
+	}
+
+	return txh.next.DeliverTx(ctx, req)
+}
+
+// SimulateTx implements tx.Handler.SimulateTx.
+func (txh txTimeoutTimestampTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if err := checkTimeoutTimestamp(ctx, req.Tx); err != nil {
+		return tx.Response{}, err
+	}
+
+	return txh.next.SimulateTx(ctx, req)
+}
+
 type validateMemoTxHandler struct {
 	ak   AccountKeeper
 	next tx.Handler
@@ -176,13 +235,14 @@ func (vmm validateMemoTxHandler) checkForValidMemo(ctx context.Context, tx sdk.T
 		return sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
-	params := vmm.ak.GetParams(sdkCtx)
+	params := getTxParams(sdkCtx, vmm.ak)
+	maxMemoCharacters := params.MaxMemoCharactersFor(tx.GetMsgs())
 
 	memoLength := len(memoTx.GetMemo())
-	if uint64(memoLength) > params.MaxMemoCharacters {
+	if uint64(memoLength) > maxMemoCharacters {
 		return sdkerrors.Wrapf(sdkerrors.ErrMemoTooLarge,
 			"maximum number of characters is %d but received %d characters",
-			params.MaxMemoCharacters, memoLength,
+			maxMemoCharacters, memoLength,
 		)
 	}
 
@@ -243,7 +303,7 @@ func ConsumeTxSizeGasMiddleware(ak AccountKeeper) tx.Middleware {
 
 func (cgts consumeTxSizeGasTxHandler) simulateSigGasCost(ctx context.Context, tx sdk.Tx) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	params := cgts.ak.GetParams(sdkCtx)
+	params := getTxParams(sdkCtx, cgts.ak)
 
 	sigTx, ok := tx.(authsigning.SigVerifiableTx)
 	if !ok {
@@ -256,6 +316,7 @@ func (cgts consumeTxSizeGasTxHandler) simulateSigGasCost(ctx context.Context, tx
 		return err
 	}
 	n := len(sigs)
+	txSizeCostPerByte := params.TxSizeCostPerByteFor(tx.GetMsgs())
 
 	for i, signer := range sigTx.GetSigners() {
 		// if signature is already filled in, no need to simulate gas cost
@@ -289,17 +350,17 @@ func (cgts consumeTxSizeGasTxHandler) simulateSigGasCost(ctx context.Context, tx
 			cost *= params.TxSigLimit
 		}
 
-		sdkCtx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*cost, "txSize")
+		sdkCtx.GasMeter().ConsumeGas(txSizeCostPerByte*cost, "txSize")
 	}
 
 	return nil
 }
 
 //nolint:unparam
-func (cgts consumeTxSizeGasTxHandler) consumeTxSizeGas(ctx context.Context, _ sdk.Tx, txBytes []byte) error {
+func (cgts consumeTxSizeGasTxHandler) consumeTxSizeGas(ctx context.Context, tx sdk.Tx, txBytes []byte) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	params := cgts.ak.GetParams(sdkCtx)
-	sdkCtx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*sdk.Gas(len(txBytes)), "txSize")
+	params := getTxParams(sdkCtx, cgts.ak)
+	sdkCtx.GasMeter().ConsumeGas(params.TxSizeCostPerByteFor(tx.GetMsgs())*sdk.Gas(len(txBytes)), "txSize")
 
 	return nil
 }
@@ -2,14 +2,17 @@ package middleware_test
 
 import (
 	"strings"
+	"time"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func (s *MWTestSuite) TestValidateBasic() {
@@ -250,3 +253,104 @@ func (s *MWTestSuite) TestTxHeightTimeoutMiddleware() {
 		})
 	}
 }
+
+func (s *MWTestSuite) TestTxTimeoutTimestampMiddleware() {
+	ctx := s.SetupTest(true)
+
+	txHandler := middleware.ComposeMiddlewares(noopTxHandler, middleware.TxTimeoutTimestampMiddleware)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+
+	blockTime := time.Unix(1000, 0)
+
+	testCases := []struct {
+		name      string
+		timeout   time.Time
+		expectErr bool
+	}{
+		{"default value", time.Time{}, false},
+		{"no timeout (later timestamp)", blockTime.Add(time.Second), false},
+		{"no timeout (same timestamp)", blockTime, false},
+		{"timeout (earlier timestamp)", blockTime.Add(-time.Second), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+
+			s.Require().NoError(txBuilder.SetMsgs(msg))
+
+			txBuilder.SetFeeAmount(feeAmount)
+			txBuilder.SetGasLimit(gasLimit)
+			txBuilder.SetTimeoutTimestamp(tc.timeout)
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			testTx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+			s.Require().NoError(err)
+
+			ctx := ctx.WithBlockTime(blockTime)
+
+			// DeliverTx
+			_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+			s.Require().Equal(tc.expectErr, err != nil, err)
+			if tc.expectErr {
+				s.Require().ErrorIs(err, sdkerrors.ErrTxTimeout)
+			}
+
+			// SimulateTx
+			_, err = txHandler.SimulateTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+			s.Require().Equal(tc.expectErr, err != nil, err)
+		})
+	}
+}
+
+func (s *MWTestSuite) TestValidateMemoPerMsgType() {
+	ctx := s.SetupTest(true) // setup
+	txHandler := middleware.ComposeMiddlewares(noopTxHandler, middleware.ValidateMemoMiddleware(s.app.AccountKeeper))
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr1)
+
+	params := s.app.AccountKeeper.GetParams(ctx)
+	params.MsgTypeLimits = []authtypes.MsgTypeLimits{{MsgTypeUrl: sdk.MsgTypeURL(msg), MaxMemoCharacters: 10}}
+	s.app.AccountKeeper.SetParams(ctx, params)
+
+	testCases := []struct {
+		name   string
+		memo   string
+		expErr bool
+	}{
+		{"memo within the msg type limit", strings.Repeat("a", 10), false},
+		{"memo above the msg type limit", strings.Repeat("a", 11), true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(txBuilder.SetMsgs(msg))
+			txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			txBuilder.SetMemo(tc.memo)
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			testTx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+			s.Require().NoError(err)
+
+			_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+			if tc.expErr {
+				s.Require().ErrorIs(err, sdkerrors.ErrMemoTooLarge)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ tx.Handler = circuitTxHandler{}
+
+type circuitTxHandler struct {
+	msr  *MsgServiceRouter
+	next tx.Handler
+}
+
+// CircuitBreakerMiddleware rejects the txs holding messages disabled by the
+// circuit breaker of the MsgServiceRouter, so that they don't enter the mempool
+// nor pay any fee. The messages nested in other messages are only checked when
+// routed by the MsgServiceRouter.
+func CircuitBreakerMiddleware(msr *MsgServiceRouter) tx.Middleware {
+	return func(txh tx.Handler) tx.Handler {
+		return circuitTxHandler{
+			msr:  msr,
+			next: txh,
+		}
+	}
+}
+
+func (txh circuitTxHandler) checkCircuit(ctx context.Context, req tx.Request) error {
+	if txh.msr == nil {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, msg := range req.Tx.GetMsgs() {
+		if txh.msr.Handler(msg) == nil {
+			continue
+		}
+		if err := txh.msr.checkCircuit(sdkCtx, msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CheckTx implements tx.Handler.CheckTx.
+func (txh circuitTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	if err := txh.checkCircuit(ctx, req); err != nil {
+		return tx.Response{}, tx.ResponseCheckTx{}, err
+	}
+
+	return txh.next.CheckTx(ctx, req, checkReq)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx.
+func (txh circuitTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if err := txh.checkCircuit(ctx, req); err != nil {
+		return tx.Response{}, err
+	}
+
+	return txh.next.DeliverTx(ctx, req)
+}
+
+// SimulateTx implements tx.Handler.SimulateTx.
+func (txh circuitTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if err := txh.checkCircuit(ctx, req); err != nil {
+		return tx.Response{}, err
+	}
+
+	return txh.next.SimulateTx(ctx, req)
+}
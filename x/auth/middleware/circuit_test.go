@@ -0,0 +1,54 @@
+package middleware_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+)
+
+// msgsTx is a dummy tx holding msgs.
+type msgsTx struct {
+	msgs []sdk.Msg
+}
+
+var _ sdk.Tx = msgsTx{}
+
+func (t msgsTx) GetMsgs() []sdk.Msg   { return t.msgs }
+func (t msgsTx) ValidateBasic() error { return nil }
+
+func TestCircuitBreakerMiddleware(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	msr := middleware.NewMsgServiceRouter(encCfg.InterfaceRegistry)
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	testdata.RegisterMsgServer(msr, testdata.MsgServerImpl{})
+
+	cb := mockCircuitBreaker{disabled: map[string]bool{}}
+	msr.SetCircuit(cb)
+	txHandler := middleware.ComposeMiddlewares(noopTxHandler, middleware.CircuitBreakerMiddleware(msr))
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	// the msgs without a Msg service route are not checked
+	req := tx.Request{Tx: msgsTx{msgs: []sdk.Msg{testdata.NewTestMsg(), msg}}}
+	ctx := sdk.NewContext(nil, tmproto.Header{}, true, log.NewNopLogger()).WithGasMeter(sdk.NewGasMeter(1000))
+
+	_, _, err := txHandler.CheckTx(sdk.WrapSDKContext(ctx), req, tx.RequestCheckTx{})
+	require.NoError(t, err)
+	require.Zero(t, ctx.GasMeter().GasConsumed())
+
+	cb.disabled[sdk.MsgTypeURL(msg)] = true
+	_, _, err = txHandler.CheckTx(sdk.WrapSDKContext(ctx), req, tx.RequestCheckTx{})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = txHandler.SimulateTx(sdk.WrapSDKContext(ctx), req)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), req)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
@@ -30,7 +30,7 @@ type deductFeeTxHandler struct {
 // CONTRACT: Tx must implement FeeTx interface to use deductFeeTxHandler
 func DeductFeeMiddleware(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker) tx.Middleware {
 	if tfc == nil {
-		tfc = checkTxFeeWithValidatorMinGasPrices
+		tfc = CheckTxFeeWithValidatorMinGasPrices
 	}
 	return func(txh tx.Handler) tx.Handler {
 		return deductFeeTxHandler{
@@ -0,0 +1,194 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// MempoolLimits defines the limits of the txs pending in the mempool of the
+// node, enforced in CheckTx.
+type MempoolLimits struct {
+	// MaxTxsPerSender is the maximum number of txs of a sender, i.e. of the
+	// first signer of the txs, pending in the mempool. 0 means unlimited.
+	MaxTxsPerSender int
+	// TxTTL is the number of blocks the txs without a timeout height are kept
+	// in the mempool, as if their timeout height was set to the height they
+	// were checked at plus TxTTL. 0 means no TTL.
+	TxTTL uint64
+}
+
+// pendingTx is a tx pending in the mempool.
+type pendingTx struct {
+	sender string
+	// expiry is the height after which the tx is evicted, 0 if none
+	expiry uint64
+	// checked is the height at which the tx was last (re)checked
+	checked int64
+}
+
+// mempoolTracker tracks the txs pending in the mempool, by hash and sender.
+type mempoolTracker struct {
+	mtx      sync.Mutex
+	txs      map[string]pendingTx
+	senders  map[string]int
+	sweptAt  int64
+	maxTxs   int
+	ttl      uint64
+	disabled bool
+}
+
+func newMempoolTracker(limits MempoolLimits) *mempoolTracker {
+	return &mempoolTracker{
+		txs:      make(map[string]pendingTx),
+		senders:  make(map[string]int),
+		maxTxs:   limits.MaxTxsPerSender,
+		ttl:      limits.TxTTL,
+		disabled: limits.MaxTxsPerSender <= 0 && limits.TxTTL == 0,
+	}
+}
+
+func (t *mempoolTracker) add(hash string, tx pendingTx) {
+	if _, ok := t.txs[hash]; !ok {
+		t.senders[tx.sender]++
+	}
+	t.txs[hash] = tx
+}
+
+func (t *mempoolTracker) remove(hash string) {
+	tx, ok := t.txs[hash]
+	if !ok {
+		return
+	}
+
+	delete(t.txs, hash)
+	if t.senders[tx.sender]--; t.senders[tx.sender] <= 0 {
+		delete(t.senders, tx.sender)
+	}
+}
+
+// sweep removes the txs which were not rechecked after the previous block, as
+// they were evicted from the mempool, e.g. because it was full, and the txs
+// expired at height, so that they are not counted against MaxTxsPerSender even
+// if the node doesn't recheck its mempool. It is a no-op if the txs were already
+// swept at height.
+func (t *mempoolTracker) sweep(height int64) {
+	if height <= t.sweptAt {
+		return
+	}
+	t.sweptAt = height
+
+	for hash, tx := range t.txs {
+		if tx.checked < height-1 || (tx.expiry > 0 && uint64(height) > tx.expiry) {
+			t.remove(hash)
+		}
+	}
+}
+
+type mempoolLimitsTxHandler struct {
+	tracker *mempoolTracker
+	next    tx.Handler
+}
+
+// NewMempoolLimitsMiddleware defines a middleware enforcing the limits of the
+// txs pending in the mempool in CheckTx: it rejects the txs of the senders
+// having too many pending txs, and evicts on ReCheckTx the txs pending for
+// longer than their timeout height, or the TTL if they have none. The txs are
+// tracked from the time they pass CheckTx until they are delivered, fail
+// ReCheckTx, are not rechecked after a block, or expire. The tracked txs are
+// swept on the first CheckTx or DeliverTx of each height, so that the expired
+// txs stop counting against MaxTxsPerSender during a run of empty blocks or
+// when recheck is disabled. DeliverTx is never rejected.
+func NewMempoolLimitsMiddleware(limits MempoolLimits) tx.Middleware {
+	tracker := newMempoolTracker(limits)
+	return func(txh tx.Handler) tx.Handler {
+		return mempoolLimitsTxHandler{
+			tracker: tracker,
+			next:    txh,
+		}
+	}
+}
+
+var _ tx.Handler = mempoolLimitsTxHandler{}
+
+// CheckTx implements tx.Handler.CheckTx.
+func (mlh mempoolLimitsTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	sigTx, ok := req.Tx.(authsigning.SigVerifiableTx)
+	if mlh.tracker.disabled || !ok || len(sigTx.GetSigners()) == 0 {
+		return mlh.next.CheckTx(ctx, req, checkReq)
+	}
+
+	t := mlh.tracker
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	hash := string(tmhash.Sum(req.TxBytes))
+
+	t.mtx.Lock()
+	pending, tracked := t.txs[hash]
+	if !tracked {
+		pending = pendingTx{sender: sigTx.GetSigners()[0].String()}
+		if timeoutTx, ok := req.Tx.(sdk.TxWithTimeoutHeight); ok {
+			pending.expiry = timeoutTx.GetTimeoutHeight()
+		}
+		if pending.expiry == 0 && t.ttl > 0 {
+			pending.expiry = uint64(height) + t.ttl
+		}
+	}
+	// sweeping after the lookup, so that an expired tx is still rejected on
+	// ReCheckTx
+	t.sweep(height)
+
+	switch {
+	case checkReq.Type == abci.CheckTxType_Recheck && pending.expiry > 0 && uint64(height) > pending.expiry:
+		t.remove(hash)
+		t.mtx.Unlock()
+		return tx.Response{}, tx.ResponseCheckTx{}, sdkerrors.Wrapf(
+			sdkerrors.ErrTxTimeoutHeight, "tx expired from the mempool at height %d, block height: %d", pending.expiry, height,
+		)
+
+	case !tracked && checkReq.Type == abci.CheckTxType_New && t.maxTxs > 0 && t.senders[pending.sender] >= t.maxTxs:
+		t.mtx.Unlock()
+		return tx.Response{}, tx.ResponseCheckTx{}, sdkerrors.Wrapf(
+			sdkerrors.ErrMempoolIsFull, "%s has %d pending txs, the maximum per sender", pending.sender, t.maxTxs,
+		)
+	}
+	t.mtx.Unlock()
+
+	res, checkRes, err := mlh.next.CheckTx(ctx, req, checkReq)
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	if err != nil {
+		// the txs failing ReCheckTx are evicted from the mempool
+		t.remove(hash)
+		return res, checkRes, err
+	}
+
+	pending.checked = height
+	t.add(hash, pending)
+	return res, checkRes, nil
+}
+
+// DeliverTx implements tx.Handler.DeliverTx.
+func (mlh mempoolLimitsTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if !mlh.tracker.disabled {
+		t := mlh.tracker
+		t.mtx.Lock()
+		t.sweep(sdk.UnwrapSDKContext(ctx).BlockHeight())
+		t.remove(string(tmhash.Sum(req.TxBytes)))
+		t.mtx.Unlock()
+	}
+
+	return mlh.next.DeliverTx(ctx, req)
+}
+
+// SimulateTx implements tx.Handler.SimulateTx.
+func (mlh mempoolLimitsTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	return mlh.next.SimulateTx(ctx, req)
+}
@@ -0,0 +1,139 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+)
+
+func (s *MWTestSuite) TestMempoolLimitsMiddleware() {
+	ctx := s.SetupTest(true)
+
+	// the next handler fails the txs whose bytes are in failing
+	failing := make(map[string]bool)
+	next := customTxHandler{func(_ context.Context, req tx.Request) (tx.Response, error) {
+		if failing[string(req.TxBytes)] {
+			return tx.Response{}, errors.New("failed")
+		}
+		return tx.Response{}, nil
+	}}
+	txHandler := middleware.ComposeMiddlewares(next, middleware.NewMempoolLimitsMiddleware(middleware.MempoolLimits{
+		MaxTxsPerSender: 2,
+		TxTTL:           5,
+	}))
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, addr2 := testdata.KeyTestPubAddr()
+	newTx := func(priv cryptotypes.PrivKey, addr sdk.AccAddress, seq, timeout uint64) tx.Request {
+		txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		txBuilder.SetTimeoutHeight(timeout)
+		testTx, txBytes, err := s.createTestTx(txBuilder, []cryptotypes.PrivKey{priv}, []uint64{0}, []uint64{seq}, ctx.ChainID())
+		s.Require().NoError(err)
+		return tx.Request{Tx: testTx, TxBytes: txBytes}
+	}
+	checkTx := func(height int64, req tx.Request, typ abci.CheckTxType) error {
+		_, _, err := txHandler.CheckTx(sdk.WrapSDKContext(ctx.WithBlockHeight(height)), req, tx.RequestCheckTx{Type: typ})
+		return err
+	}
+
+	tx1, tx2, tx3 := newTx(priv1, addr1, 0, 0), newTx(priv1, addr1, 1, 20), newTx(priv1, addr1, 2, 0)
+	other := newTx(priv2, addr2, 0, 0)
+
+	// the sender can have 2 pending txs
+	s.Require().NoError(checkTx(10, tx1, abci.CheckTxType_New))
+	s.Require().NoError(checkTx(10, tx2, abci.CheckTxType_New))
+	err := checkTx(10, tx3, abci.CheckTxType_New)
+	s.Require().ErrorIs(err, sdkerrors.ErrMempoolIsFull)
+	s.Require().NoError(checkTx(10, other, abci.CheckTxType_New))
+
+	// the txs are pending until delivered
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx.WithBlockHeight(11)), tx1)
+	s.Require().NoError(err)
+	s.Require().NoError(checkTx(11, tx2, abci.CheckTxType_Recheck))
+	s.Require().NoError(checkTx(11, other, abci.CheckTxType_Recheck))
+	s.Require().NoError(checkTx(11, tx3, abci.CheckTxType_New))
+
+	// or until they fail ReCheckTx
+	failing[string(tx3.TxBytes)] = true
+	s.Require().Error(checkTx(12, tx3, abci.CheckTxType_Recheck))
+	delete(failing, string(tx3.TxBytes))
+	s.Require().NoError(checkTx(12, tx2, abci.CheckTxType_Recheck))
+	s.Require().NoError(checkTx(12, other, abci.CheckTxType_Recheck))
+	s.Require().NoError(checkTx(12, tx3, abci.CheckTxType_New))
+
+	// the txs without timeout height expire after the TTL, the others at their
+	// timeout height
+	s.Require().NoError(checkTx(17, tx3, abci.CheckTxType_Recheck))
+	err = checkTx(18, tx3, abci.CheckTxType_Recheck)
+	s.Require().ErrorIs(err, sdkerrors.ErrTxTimeoutHeight)
+	s.Require().NoError(checkTx(18, tx2, abci.CheckTxType_Recheck))
+	s.Require().NoError(checkTx(20, tx2, abci.CheckTxType_Recheck))
+	err = checkTx(21, tx2, abci.CheckTxType_Recheck)
+	s.Require().ErrorIs(err, sdkerrors.ErrTxTimeoutHeight)
+
+	// the txs not rechecked after a block were evicted
+	s.Require().NoError(checkTx(21, tx1, abci.CheckTxType_New))
+	s.Require().NoError(checkTx(21, tx3, abci.CheckTxType_New))
+	s.Require().ErrorIs(checkTx(21, newTx(priv1, addr1, 3, 0), abci.CheckTxType_New), sdkerrors.ErrMempoolIsFull)
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx.WithBlockHeight(23)), other)
+	s.Require().NoError(err)
+	s.Require().NoError(checkTx(23, newTx(priv1, addr1, 3, 0), abci.CheckTxType_New))
+}
+
+func (s *MWTestSuite) TestMempoolLimitsMiddlewareSweepsOnCheckTx() {
+	ctx := s.SetupTest(true)
+	txHandler := middleware.ComposeMiddlewares(noopTxHandler, middleware.NewMempoolLimitsMiddleware(middleware.MempoolLimits{
+		MaxTxsPerSender: 1,
+	}))
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	newTx := func(seq, timeout uint64) tx.Request {
+		txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+		txBuilder.SetTimeoutHeight(timeout)
+		testTx, txBytes, err := s.createTestTx(txBuilder, []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{seq}, ctx.ChainID())
+		s.Require().NoError(err)
+		return tx.Request{Tx: testTx, TxBytes: txBytes}
+	}
+	checkTx := func(height int64, req tx.Request) error {
+		_, _, err := txHandler.CheckTx(sdk.WrapSDKContext(ctx.WithBlockHeight(height)), req, tx.RequestCheckTx{Type: abci.CheckTxType_New})
+		return err
+	}
+
+	// the txs not rechecked are swept after a run of empty blocks, without any
+	// DeliverTx
+	s.Require().NoError(checkTx(10, newTx(0, 0)))
+	s.Require().ErrorIs(checkTx(11, newTx(1, 0)), sdkerrors.ErrMempoolIsFull)
+	s.Require().NoError(checkTx(12, newTx(1, 0)))
+
+	// and so are the expired txs
+	s.Require().NoError(checkTx(20, newTx(2, 20)))
+	s.Require().NoError(checkTx(21, newTx(3, 0)))
+}
+
+func (s *MWTestSuite) TestMempoolLimitsMiddlewareDisabled() {
+	ctx := s.SetupTest(true)
+	txHandler := middleware.ComposeMiddlewares(noopTxHandler, middleware.NewMempoolLimitsMiddleware(middleware.MempoolLimits{}))
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	for seq := uint64(0); seq < 5; seq++ {
+		txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+		testTx, txBytes, err := s.createTestTx(txBuilder, []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{seq}, ctx.ChainID())
+		s.Require().NoError(err)
+
+		_, _, err = txHandler.CheckTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx, TxBytes: txBytes}, tx.RequestCheckTx{Type: abci.CheckTxType_New})
+		s.Require().NoError(err)
+	}
+}
@@ -50,6 +50,7 @@ type TxHandlerOptions struct {
 	SigGasConsumer         func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
 	ExtensionOptionChecker ExtensionOptionChecker
 	TxFeeChecker           TxFeeChecker
+	MempoolLimits          MempoolLimits
 }
 
 // NewDefaultTxHandler defines a TxHandler middleware stacks that should work
@@ -73,6 +74,9 @@ func NewDefaultTxHandler(options TxHandlerOptions) (tx.Handler, error) {
 
 	return ComposeMiddlewares(
 		NewRunMsgsTxHandler(options.MsgServiceRouter, options.LegacyRouter),
+		// Measure the duration of the middlewares run before the messages if
+		// telemetry is enabled.
+		TelemetryTxMiddleware,
 		NewTxDecoderMiddleware(options.TxDecoder),
 		// Set a new GasMeter on sdk.Context.
 		//
@@ -89,7 +93,21 @@ func NewDefaultTxHandler(options TxHandlerOptions) (tx.Handler, error) {
 		// Reject all extension options other than the ones needed by the feemarket.
 		NewExtensionOptionsMiddleware(options.ExtensionOptionChecker),
 		ValidateBasicMiddleware,
+		// Reject the txs holding messages disabled by the circuit breaker of
+		// the MsgServiceRouter before they enter the mempool.
+		CircuitBreakerMiddleware(options.MsgServiceRouter),
+		// Reject the txs of the senders with too many pending txs, and evict the
+		// expired ones. Make sure it is outside of the middlewares that can
+		// reject a tx on ReCheckTx, so that the evicted txs are not tracked.
+		NewMempoolLimitsMiddleware(options.MempoolLimits),
+		// Reject the txs with too many messages or signatures before any work
+		// is done on them.
+		TxLimitsMiddleware(options.AccountKeeper),
+		// Reject the txs signed with the sign modes disabled by the app, e.g.
+		// SIGN_MODE_LEGACY_AMINO_JSON.
+		ValidateSignModesMiddleware(options.SignModeHandler),
 		TxTimeoutHeightMiddleware,
+		TxTimeoutTimestampMiddleware,
 		ValidateMemoMiddleware(options.AccountKeeper),
 		ConsumeTxSizeGasMiddleware(options.AccountKeeper),
 		// No gas should be consumed in any middleware above in a "post" handler part. See
@@ -17,21 +17,105 @@ import (
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	circuitBreaker    CircuitBreaker
+	postProcessors    map[string]MsgResponsePostProcessor
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
 
+// CircuitBreaker decides whether a Msg service message is allowed to be executed.
+// It is consulted for every message routed by the MsgServiceRouter, including
+// the messages nested in other messages (e.g. authz MsgExec).
+type CircuitBreaker interface {
+	IsAllowed(ctx sdk.Context, typeURL string) (bool, error)
+}
+
+// MsgResponsePostProcessor transforms the response of a message of a tx
+// before it is written to the result of the tx, e.g. to strip the large
+// responses bloating the results. It is only called for the messages of the
+// tx, not for the ones nested in other messages.
+type MsgResponsePostProcessor func(ctx sdk.Context, msg sdk.Msg, res *codectypes.Any) (*codectypes.Any, error)
+
 // NewMsgServiceRouter creates a new MsgServiceRouter.
 func NewMsgServiceRouter(registry codectypes.InterfaceRegistry) *MsgServiceRouter {
 	return &MsgServiceRouter{
 		interfaceRegistry: registry,
 		routes:            map[string]MsgServiceHandler{},
+		postProcessors:    map[string]MsgResponsePostProcessor{},
 	}
 }
 
 // MsgServiceHandler defines a function type which handles Msg service message.
 type MsgServiceHandler = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error)
 
+// SetCircuit sets the circuit breaker consulted before executing a message.
+func (msr *MsgServiceRouter) SetCircuit(cb CircuitBreaker) {
+	msr.circuitBreaker = cb
+}
+
+// checkCircuit returns an error if the circuit breaker disables the execution
+// of msg. The circuit breaker is consulted on an infinite gas meter, so that
+// its lookups are not charged to the tx of msg.
+func (msr *MsgServiceRouter) checkCircuit(ctx sdk.Context, msg sdk.Msg) error {
+	if msr.circuitBreaker == nil {
+		return nil
+	}
+
+	typeURL := sdk.MsgTypeURL(msg)
+	isAllowed, err := msr.circuitBreaker.IsAllowed(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), typeURL)
+	if err != nil {
+		return err
+	}
+	if !isAllowed {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "circuit breaker disables execution of this message: %s", typeURL)
+	}
+
+	return nil
+}
+
+// SetMsgResponsePostProcessor sets the post-processor of the responses of the
+// messages of the given type URL. The responses it transforms are emitted in
+// full in a msg_response event.
+func (msr *MsgServiceRouter) SetMsgResponsePostProcessor(typeURL string, postProcessor MsgResponsePostProcessor) {
+	msr.postProcessors[typeURL] = postProcessor
+}
+
+// postProcessMsgResponse returns the response of msg to write to the result of
+// its tx, and whether it was transformed.
+func (msr *MsgServiceRouter) postProcessMsgResponse(ctx sdk.Context, msg sdk.Msg, res *codectypes.Any) (*codectypes.Any, bool, error) {
+	postProcessor, ok := msr.postProcessors[sdk.MsgTypeURL(msg)]
+	if !ok {
+		return res, false, nil
+	}
+
+	processed, err := postProcessor(ctx, msg, res)
+	if err != nil {
+		return nil, false, err
+	}
+	if processed == nil {
+		return nil, false, sdkerrors.ErrLogic.Wrapf("got nil post-processed Msg response for msg %s", sdk.MsgTypeURL(msg))
+	}
+
+	return processed, processed != res, nil
+}
+
+// StripMsgResponse is a MsgResponsePostProcessor replacing the responses with
+// empty ones of the same type.
+func StripMsgResponse(_ sdk.Context, _ sdk.Msg, res *codectypes.Any) (*codectypes.Any, error) {
+	return &codectypes.Any{TypeUrl: res.TypeUrl}, nil
+}
+
+// StripLargeMsgResponse returns a MsgResponsePostProcessor replacing the
+// responses larger than maxSize bytes with empty ones of the same type.
+func StripLargeMsgResponse(maxSize int) MsgResponsePostProcessor {
+	return func(ctx sdk.Context, msg sdk.Msg, res *codectypes.Any) (*codectypes.Any, error) {
+		if len(res.Value) <= maxSize {
+			return res, nil
+		}
+		return StripMsgResponse(ctx, msg, res)
+	}
+}
+
 // Handler returns the MsgServiceHandler for a given msg or nil if not found.
 func (msr *MsgServiceRouter) Handler(msg sdk.Msg) MsgServiceHandler {
 	return msr.routes[sdk.MsgTypeURL(msg)]
@@ -108,6 +192,10 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 		}
 
 		msr.routes[requestTypeName] = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+			if err := msr.checkCircuit(ctx, req); err != nil {
+				return nil, err
+			}
+
 			ctx = ctx.WithEventManager(sdk.NewEventManager())
 			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 				goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
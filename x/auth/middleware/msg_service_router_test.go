@@ -4,9 +4,14 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
 )
 
@@ -53,3 +58,35 @@ func TestRegisterMsgServiceTwice(t *testing.T) {
 		)
 	})
 }
+
+type mockCircuitBreaker struct {
+	disabled map[string]bool
+}
+
+// IsAllowed consumes the gas of a store read, which must not be charged to
+// the tx.
+func (cb mockCircuitBreaker) IsAllowed(ctx sdk.Context, typeURL string) (bool, error) {
+	ctx.GasMeter().ConsumeGas(storetypes.KVGasConfig().ReadCostFlat, "circuit")
+	return !cb.disabled[typeURL], nil
+}
+
+func TestMsgServiceRouterCircuitBreaker(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	msr := middleware.NewMsgServiceRouter(encCfg.InterfaceRegistry)
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	testdata.RegisterMsgServer(msr, testdata.MsgServerImpl{})
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger()).WithGasMeter(sdk.NewGasMeter(1000))
+	cb := mockCircuitBreaker{disabled: map[string]bool{}}
+	msr.SetCircuit(cb)
+
+	_, err := msr.Handler(msg)(ctx, msg)
+	require.NoError(t, err)
+	require.Zero(t, ctx.GasMeter().GasConsumed())
+
+	cb.disabled[sdk.MsgTypeURL(msg)] = true
+	_, err = msr.Handler(msg)(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Contains(t, err.Error(), "circuit breaker disables execution of this message")
+}
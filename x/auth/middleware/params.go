@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// txParamsKey is the context key of the auth params read once per tx.
+type txParamsKey struct{}
+
+// withTxParams sets the auth params of the tx in the context, so that the
+// middlewares below don't read them again from the store, each read of the
+// params being charged to the tx.
+func withTxParams(sdkCtx sdk.Context, params types.Params) context.Context {
+	return sdk.WrapSDKContext(sdkCtx.WithContext(context.WithValue(sdkCtx.Context(), txParamsKey{}, params)))
+}
+
+// getTxParams returns the auth params of the tx set by TxLimitsMiddleware,
+// reading them from ak if the middleware is not in the stack.
+func getTxParams(sdkCtx sdk.Context, ak AccountKeeper) types.Params {
+	if params, ok := sdkCtx.Context().Value(txParamsKey{}).(types.Params); ok {
+		return params
+	}
+
+	return ak.GetParams(sdkCtx)
+}
@@ -2,9 +2,13 @@ package middleware
 
 import (
 	"context"
+	"encoding/base64"
+	"strconv"
 	"strings"
+	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
@@ -27,18 +31,26 @@ var _ tx.Handler = runMsgsTxHandler{}
 
 // CheckTx implements tx.Handler.CheckTx method.
 func (txh runMsgsTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	measureAnteHandler(sdk.UnwrapSDKContext(ctx), execModeCheck)
+
 	// Don't run Msgs during CheckTx.
 	return tx.Response{}, tx.ResponseCheckTx{}, nil
 }
 
 // DeliverTx implements tx.Handler.DeliverTx method.
 func (txh runMsgsTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
-	return txh.runMsgs(sdk.UnwrapSDKContext(ctx), req.Tx.GetMsgs(), req.TxBytes)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	measureAnteHandler(sdkCtx, execModeDeliver)
+
+	return txh.runMsgs(sdkCtx, req.Tx.GetMsgs(), req.TxBytes)
 }
 
 // SimulateTx implements tx.Handler.SimulateTx method.
 func (txh runMsgsTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
-	return txh.runMsgs(sdk.UnwrapSDKContext(ctx), req.Tx.GetMsgs(), req.TxBytes)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	measureAnteHandler(sdkCtx, execModeSimulate)
+
+	return txh.runMsgs(sdkCtx, req.Tx.GetMsgs(), req.TxBytes)
 }
 
 // runMsgs iterates through a list of messages and executes them with the provided
@@ -57,35 +69,19 @@ func (txh runMsgsTxHandler) runMsgs(sdkCtx sdk.Context, msgs []sdk.Msg, txBytes
 	// NOTE: GasWanted is determined by the Gas TxHandler and GasUsed by the GasMeter.
 	for i, msg := range msgs {
 		var (
-			msgResult    *sdk.Result
-			eventMsgName string // name to use as value in event `message.action`
-			err          error
+			start     = time.Now()
+			gasBefore = sdkCtx.GasMeter().GasConsumed()
 		)
 
-		if handler := txh.msgServiceRouter.Handler(msg); handler != nil {
-			// ADR 031 request type routing
-			msgResult, err = handler(sdkCtx, msg)
-			eventMsgName = sdk.MsgTypeURL(msg)
-		} else if legacyMsg, ok := msg.(legacytx.LegacyMsg); ok {
-			// legacy sdk.Msg routing
-			// Assuming that the app developer has migrated all their Msgs to
-			// proto messages and has registered all `Msg services`, then this
-			// path should never be called, because all those Msgs should be
-			// registered within the `MsgServiceRouter` already.
-			msgRoute := legacyMsg.Route()
-			eventMsgName = legacyMsg.Type()
-			handler := txh.legacyRouter.Route(sdkCtx, msgRoute)
-			if handler == nil {
-				return tx.Response{}, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
-			}
-
-			msgResult, err = handler(sdkCtx, msg)
-		} else {
-			return tx.Response{}, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %+v", msg)
+		msgCtx, span := startMsgSpan(sdkCtx, msg)
+		msgResult, eventMsgName, err := txh.handleMsg(msgCtx, msg, i)
+		telemetry.EndSpan(span, err)
+		if err != nil {
+			return tx.Response{}, err
 		}
 
-		if err != nil {
-			return tx.Response{}, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		if telemetry.IsTelemetryEnabled() {
+			measureMsg(sdk.MsgTypeURL(msg), start, sdkCtx.GasMeter().GasConsumed()-gasBefore)
 		}
 
 		msgEvents := sdk.Events{
@@ -104,8 +100,23 @@ func (txh runMsgsTxHandler) runMsgs(sdkCtx sdk.Context, msgs []sdk.Msg, txBytes
 		if msgResponse == nil {
 			return tx.Response{}, sdkerrors.ErrLogic.Wrapf("got nil Msg response at index %d for msg %s", i, sdk.MsgTypeURL(msg))
 		}
-		msgResponses[i] = msgResponse
 		msgLogs = append(msgLogs, sdk.NewABCIMessageLog(uint32(i), msgResult.Log, msgEvents))
+
+		// The responses transformed before being written to the tx result
+		// are kept in full in the events, which are not part of the results
+		// hash. They are not added to the logs, which would be as large.
+		processed, ok, err := txh.msgServiceRouter.postProcessMsgResponse(sdkCtx, msg, msgResponse)
+		if err != nil {
+			return tx.Response{}, sdkerrors.Wrapf(err, "failed to post-process message response; message index: %d", i)
+		}
+		if ok {
+			events = events.AppendEvent(sdk.NewEvent(sdk.EventTypeMsgResponse,
+				sdk.NewAttribute(sdk.AttributeKeyMsgIndex, strconv.Itoa(i)),
+				sdk.NewAttribute(sdk.AttributeKeyTypeURL, msgResponse.TypeUrl),
+				sdk.NewAttribute(sdk.AttributeKeyMsgResponse, base64.StdEncoding.EncodeToString(msgResponse.Value)),
+			))
+		}
+		msgResponses[i] = processed
 	}
 
 	return tx.Response{
@@ -115,3 +126,42 @@ func (txh runMsgsTxHandler) runMsgs(sdkCtx sdk.Context, msgs []sdk.Msg, txBytes
 		MsgResponses: msgResponses,
 	}, nil
 }
+
+// handleMsg routes the message at index i of a tx to its handler and executes
+// it. It returns the result of the message along with the name to use as value
+// in the event `message.action`.
+func (txh runMsgsTxHandler) handleMsg(sdkCtx sdk.Context, msg sdk.Msg, i int) (*sdk.Result, string, error) {
+	var (
+		msgResult    *sdk.Result
+		eventMsgName string // name to use as value in event `message.action`
+		err          error
+	)
+
+	if handler := txh.msgServiceRouter.Handler(msg); handler != nil {
+		// ADR 031 request type routing
+		msgResult, err = handler(sdkCtx, msg)
+		eventMsgName = sdk.MsgTypeURL(msg)
+	} else if legacyMsg, ok := msg.(legacytx.LegacyMsg); ok {
+		// legacy sdk.Msg routing
+		// Assuming that the app developer has migrated all their Msgs to
+		// proto messages and has registered all `Msg services`, then this
+		// path should never be called, because all those Msgs should be
+		// registered within the `MsgServiceRouter` already.
+		msgRoute := legacyMsg.Route()
+		eventMsgName = legacyMsg.Type()
+		handler := txh.legacyRouter.Route(sdkCtx, msgRoute)
+		if handler == nil {
+			return nil, "", sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
+		}
+
+		msgResult, err = handler(sdkCtx, msg)
+	} else {
+		return nil, "", sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %+v", msg)
+	}
+
+	if err != nil {
+		return nil, "", sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+	}
+
+	return msgResult, eventMsgName, nil
+}
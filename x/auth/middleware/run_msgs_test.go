@@ -1,9 +1,11 @@
 package middleware_test
 
 import (
+	"encoding/base64"
 	"fmt"
 
 	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -38,3 +40,71 @@ func (s *MWTestSuite) TestRunMsgs() {
 	_, err = txHandler.SimulateTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx, TxBytes: txBytes})
 	s.Require().NoError(err)
 }
+
+func (s *MWTestSuite) TestRunMsgsPostProcessor() {
+	ctx := s.SetupTest(true) // setup
+
+	msr := middleware.NewMsgServiceRouter(s.clientCtx.InterfaceRegistry)
+	testdata.RegisterMsgServer(msr, testdata.MsgServerImpl{})
+	txHandler := middleware.NewRunMsgsTxHandler(msr, nil)
+
+	spot := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	rex := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Rex, a dog with a long name"}}
+	fullResponse := func(name string) []byte {
+		bz, err := proto.Marshal(&testdata.MsgCreateDogResponse{Name: name})
+		s.Require().NoError(err)
+		return bz
+	}
+
+	testCases := []struct {
+		name          string
+		postProcessor middleware.MsgResponsePostProcessor
+		expStripped   []bool
+	}{
+		{"no post-processor", nil, []bool{false, false}},
+		{"strip", middleware.StripMsgResponse, []bool{true, true}},
+		{"strip large", middleware.StripLargeMsgResponse(10), []bool{false, true}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			if tc.postProcessor != nil {
+				msr.SetMsgResponsePostProcessor(sdk.MsgTypeURL(spot), tc.postProcessor)
+			}
+
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(txBuilder.SetMsgs(spot, rex))
+
+			res, err := txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: txBuilder.GetTx()})
+			s.Require().NoError(err)
+			s.Require().Len(res.MsgResponses, 2)
+
+			var responseEvents []abci.Event
+			for _, event := range res.Events {
+				if event.Type == sdk.EventTypeMsgResponse {
+					responseEvents = append(responseEvents, event)
+				}
+			}
+
+			for i, msg := range []*testdata.MsgCreateDog{spot, rex} {
+				full := fullResponse(msg.Dog.Name)
+				s.Require().Equal(fmt.Sprintf("/%s", proto.MessageName(&testdata.MsgCreateDogResponse{})), res.MsgResponses[i].TypeUrl)
+				if !tc.expStripped[i] {
+					s.Require().Equal(full, res.MsgResponses[i].Value)
+					continue
+				}
+
+				// the stripped response is kept in full in the events
+				s.Require().Empty(res.MsgResponses[i].Value)
+				s.Require().NotEmpty(responseEvents)
+				event := responseEvents[0]
+				responseEvents = responseEvents[1:]
+				s.Require().Equal(fmt.Sprint(i), string(event.Attributes[0].Value))
+				s.Require().Equal(base64.StdEncoding.EncodeToString(full), string(event.Attributes[2].Value))
+			}
+			s.Require().Empty(responseEvents)
+		})
+	}
+}
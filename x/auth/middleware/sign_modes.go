@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var _ tx.Handler = signModesTxHandler{}
+
+type signModesTxHandler struct {
+	modes map[signing.SignMode]bool
+	next  tx.Handler
+}
+
+// ValidateSignModesMiddleware rejects the txs with signatures, including the
+// ones of the multisigs, whose sign mode is not enabled by the
+// SignModeHandler. In particular, an app whose SignModeHandler does not enable
+// SIGN_MODE_LEGACY_AMINO_JSON, e.g. built with
+// authtx.DefaultSignModesWithoutLegacyAmino, rejects the amino signed txs
+// before any fee is deducted or signature verified.
+// CONTRACT: Tx must implement SigVerifiableTx interface
+func ValidateSignModesMiddleware(signModeHandler authsigning.SignModeHandler) tx.Middleware {
+	modes := make(map[signing.SignMode]bool)
+	for _, mode := range signModeHandler.Modes() {
+		modes[mode] = true
+	}
+
+	return func(txh tx.Handler) tx.Handler {
+		return signModesTxHandler{
+			modes: modes,
+			next:  txh,
+		}
+	}
+}
+
+func (txh signModesTxHandler) checkSignModes(req tx.Request, simulate bool) error {
+	sigTx, ok := req.Tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a sigTx")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+
+	for _, sig := range sigs {
+		if err := txh.checkSignMode(sig.Data, simulate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkSignMode checks the sign modes of the signature data and of its
+// signatures for the multisigs. The simulated txs, whose signatures are not
+// verified, may have no sign mode.
+func (txh signModesTxHandler) checkSignMode(sigData signing.SignatureData, simulate bool) error {
+	switch data := sigData.(type) {
+	case *signing.SingleSignatureData:
+		if simulate && data.SignMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+			return nil
+		}
+		if !txh.modes[data.SignMode] {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "sign mode %s is not enabled", data.SignMode)
+		}
+	case *signing.MultiSignatureData:
+		for _, sig := range data.Signatures {
+			if err := txh.checkSignMode(sig, simulate); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckTx implements tx.Handler.CheckTx.
+func (txh signModesTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	if err := txh.checkSignModes(req, false); err != nil {
+		return tx.Response{}, tx.ResponseCheckTx{}, err
+	}
+
+	return txh.next.CheckTx(ctx, req, checkReq)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx.
+func (txh signModesTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if err := txh.checkSignModes(req, false); err != nil {
+		return tx.Response{}, err
+	}
+
+	return txh.next.DeliverTx(ctx, req)
+}
+
+// SimulateTx implements tx.Handler.SimulateTx.
+func (txh signModesTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if err := txh.checkSignModes(req, true); err != nil {
+		return tx.Response{}, err
+	}
+
+	return txh.next.SimulateTx(ctx, req)
+}
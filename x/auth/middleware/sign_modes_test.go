@@ -0,0 +1,76 @@
+package middleware_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+func (s *MWTestSuite) TestValidateSignModesMiddleware() {
+	ctx := s.SetupTest(true) // setup
+	txConfig := authtx.NewTxConfig(s.app.AppCodec().(*codec.ProtoCodec), authtx.DefaultSignModesWithoutLegacyAmino)
+	txHandler := middleware.ComposeMiddlewares(noopTxHandler, middleware.ValidateSignModesMiddleware(txConfig.SignModeHandler()))
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, _ := testdata.KeyTestPubAddr()
+	multisigKey := multisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{priv1.PubKey(), priv2.PubKey()})
+
+	single := func(mode signing.SignMode) signing.SignatureV2 {
+		return signing.SignatureV2{
+			PubKey: priv1.PubKey(),
+			Data:   &signing.SingleSignatureData{SignMode: mode},
+		}
+	}
+	multi := func(modes ...signing.SignMode) signing.SignatureV2 {
+		data := &signing.MultiSignatureData{}
+		for _, mode := range modes {
+			data.Signatures = append(data.Signatures, &signing.SingleSignatureData{SignMode: mode})
+		}
+		return signing.SignatureV2{PubKey: multisigKey, Data: data}
+	}
+
+	testCases := []struct {
+		name        string
+		sig         signing.SignatureV2
+		expErr      bool
+		expSimulErr bool
+	}{
+		{"direct", single(signing.SignMode_SIGN_MODE_DIRECT), false, false},
+		{"direct aux", single(signing.SignMode_SIGN_MODE_DIRECT_AUX), false, false},
+		{"legacy amino", single(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON), true, true},
+		{"unspecified", single(signing.SignMode_SIGN_MODE_UNSPECIFIED), true, false},
+		{"multisig direct", multi(signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_DIRECT), false, false},
+		{"multisig with legacy amino", multi(signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON), true, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			txBuilder := txConfig.NewTxBuilder()
+			s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+			s.Require().NoError(txBuilder.SetSignatures(tc.sig))
+
+			_, err := txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: txBuilder.GetTx()})
+			if tc.expErr {
+				s.Require().ErrorIs(err, sdkerrors.ErrNotSupported)
+			} else {
+				s.Require().NoError(err)
+			}
+
+			_, err = txHandler.SimulateTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: txBuilder.GetTx()})
+			if tc.expSimulErr {
+				s.Require().ErrorIs(err, sdkerrors.ErrNotSupported)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
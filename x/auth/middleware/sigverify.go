@@ -73,20 +73,26 @@ func (spkm setPubKeyTxHandler) setPubKey(ctx context.Context, req tx.Request, si
 			}
 			pk = simSecp256k1Pubkey
 		}
-		// Only make check if simulate=false
-		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
-				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
-		}
 
 		acc, err := GetSignerAcc(sdkCtx, spkm.ak, signers[i])
 		if err != nil {
 			return err
 		}
-		// account already has pubkey set,no need to reset
-		if acc.GetPubKey() != nil {
+		// account already has pubkey set, no need to reset. The tx pubkey must
+		// be the account one, which may not match the address of the account
+		// after a MsgChangePubKey.
+		// Only make checks if simulate=false
+		if accPubKey := acc.GetPubKey(); accPubKey != nil {
+			if !simulate && !accPubKey.Equals(pk) {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
+					"pubKey does not match the pubKey of signer %s with signer index: %d", signers[i], i)
+			}
 			continue
 		}
+		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
+				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
+		}
 		err = acc.SetPubKey(pk)
 		if err != nil {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
@@ -178,7 +184,7 @@ func (vscd validateSigCountTxHandler) checkSigCount(ctx context.Context, req tx.
 		return sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a sigTx")
 	}
 
-	params := vscd.ak.GetParams(sdkCtx)
+	params := getTxParams(sdkCtx, vscd.ak)
 	pubKeys, err := sigTx.GetPubKeys()
 	if err != nil {
 		return err
@@ -320,7 +326,7 @@ func (sgcm sigGasConsumeTxHandler) sigGasConsume(ctx context.Context, req tx.Req
 		return sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
-	params := sgcm.ak.GetParams(sdkCtx)
+	params := getTxParams(sdkCtx, sgcm.ak)
 	sigs, err := sigTx.GetSignaturesV2()
 	if err != nil {
 		return err
@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
@@ -72,6 +73,56 @@ func (s *MWTestSuite) TestSetPubKey() {
 	require.NoError(err)
 }
 
+func (s *MWTestSuite) TestSetPubKeyChanged() {
+	ctx := s.SetupTest(true) // setup
+	require := s.Require()
+	txHandler := middleware.ComposeMiddlewares(
+		noopTxHandler,
+		middleware.SetPubKeyMiddleware(s.app.AccountKeeper),
+		middleware.SigVerificationMiddleware(
+			s.app.AccountKeeper,
+			s.clientCtx.TxConfig.SignModeHandler(),
+		),
+	)
+
+	// the pubkey of the account was changed from pub1 to pub2
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, pub2, _ := testdata.KeyTestPubAddr()
+	acc := s.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	require.NoError(acc.SetPubKey(pub2))
+	s.app.AccountKeeper.SetAccount(ctx, acc)
+
+	testCases := []struct {
+		name   string
+		priv   cryptotypes.PrivKey
+		expErr error
+	}{
+		{"old key", priv1, sdkerrors.ErrInvalidPubKey},
+		{"new key", priv2, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+			txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{tc.priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}
+			testTx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+			require.NoError(err)
+
+			_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+			if tc.expErr != nil {
+				require.ErrorIs(err, tc.expErr)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+}
+
 func (s *MWTestSuite) TestConsumeSignatureVerificationGas() {
 	params := types.DefaultParams()
 	msg := []byte{1, 2, 3, 4}
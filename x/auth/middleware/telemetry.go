@@ -0,0 +1,123 @@
+package middleware
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// Execution modes the tx metrics are labeled with.
+const (
+	execModeCheck    = "check"
+	execModeDeliver  = "deliver"
+	execModeSimulate = "simulate"
+)
+
+// protoVersionRegex matches the version components of proto packages, e.g.
+// v1beta1.
+var protoVersionRegex = regexp.MustCompile(`^v[0-9]+((alpha|beta)[0-9]*)?$`)
+
+type txStartKey struct{}
+
+type telemetryTxHandler struct {
+	next tx.Handler
+}
+
+// TelemetryTxMiddleware defines a middleware recording the time at which a tx
+// starts being handled, if telemetry is enabled. Once the middlewares stack
+// reaches the messages of the tx, the duration of all the middlewares run
+// before them, i.e. the ante handler, is emitted.
+//
+// It should be the outermost middleware.
+func TelemetryTxMiddleware(txh tx.Handler) tx.Handler {
+	return telemetryTxHandler{next: txh}
+}
+
+var _ tx.Handler = telemetryTxHandler{}
+
+// CheckTx implements tx.Handler.CheckTx method.
+func (txh telemetryTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	return txh.next.CheckTx(withTxStart(ctx), req, checkReq)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx method.
+func (txh telemetryTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	return txh.next.DeliverTx(withTxStart(ctx), req)
+}
+
+// SimulateTx implements tx.Handler.SimulateTx method.
+func (txh telemetryTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	return txh.next.SimulateTx(withTxStart(ctx), req)
+}
+
+// withTxStart records the current time in the context if telemetry is enabled.
+func withTxStart(ctx context.Context) context.Context {
+	if !telemetry.IsTelemetryEnabled() {
+		return ctx
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdk.WrapSDKContext(sdkCtx.WithContext(context.WithValue(sdkCtx.Context(), txStartKey{}, time.Now())))
+}
+
+// measureAnteHandler emits the time elapsed since the start of the tx recorded by
+// TelemetryTxMiddleware, if any.
+func measureAnteHandler(sdkCtx sdk.Context, mode string) {
+	start, ok := sdkCtx.Context().Value(txStartKey{}).(time.Time)
+	if !ok {
+		return
+	}
+
+	telemetry.MeasureSinceWithLabels(
+		[]string{telemetry.MetricKeyTx, telemetry.MetricKeyAnteHandler},
+		start,
+		[]metrics.Label{telemetry.NewLabel(telemetry.MetricLabelNameExecMode, mode)},
+	)
+}
+
+// measureMsg emits the execution time and the gas consumed by a message, labeled
+// with its type and its module.
+func measureMsg(msgType string, start time.Time, gasUsed uint64) {
+	labels := []metrics.Label{
+		telemetry.NewLabel(telemetry.MetricLabelNameMsgType, msgType),
+		telemetry.NewLabel(telemetry.MetricLabelNameModule, msgModule(msgType)),
+	}
+	telemetry.MeasureSinceWithLabels([]string{telemetry.MetricKeyTx, telemetry.MetricKeyMsg, telemetry.MetricKeyExecution}, start, labels)
+	telemetry.AddSampleWithLabels([]string{telemetry.MetricKeyTx, telemetry.MetricKeyMsg, telemetry.MetricKeyGas}, float32(gasUsed), labels)
+}
+
+// msgModule returns the module of a message type URL, i.e. the last component
+// of its proto package which is not a version, e.g. bank for
+// /cosmos.bank.v1beta1.MsgSend.
+func msgModule(msgType string) string {
+	components := strings.Split(strings.TrimPrefix(msgType, "/"), ".")
+	// the last component is the message name
+	for i := len(components) - 2; i >= 0; i-- {
+		if !protoVersionRegex.MatchString(components[i]) {
+			return components[i]
+		}
+	}
+
+	return ""
+}
+
+// startMsgSpan starts the tracing span of the execution of a message, child of
+// the span of the tx, and returns the context to execute the message with.
+func startMsgSpan(sdkCtx sdk.Context, msg sdk.Msg) (sdk.Context, trace.Span) {
+	if !telemetry.IsTracingEnabled() {
+		return sdkCtx, trace.SpanFromContext(sdkCtx.Context())
+	}
+
+	msgType := sdk.MsgTypeURL(msg)
+	ctx, span := telemetry.StartSpan(sdkCtx.Context(), msgType, attribute.String(telemetry.AttributeKeyMsgType, msgType))
+	return sdkCtx.WithContext(ctx), span
+}
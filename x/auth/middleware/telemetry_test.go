@@ -0,0 +1,61 @@
+package middleware_test
+
+import (
+	"encoding/json"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+)
+
+func (s *MWTestSuite) TestTelemetryTxMiddleware() {
+	ctx := s.SetupTest(true) // setup
+
+	m, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "test"})
+	s.Require().NoError(err)
+	s.Require().True(telemetry.IsTelemetryEnabled())
+
+	msr := middleware.NewMsgServiceRouter(s.clientCtx.InterfaceRegistry)
+	testdata.RegisterMsgServer(msr, testdata.MsgServerImpl{})
+	txHandler := middleware.ComposeMiddlewares(middleware.NewRunMsgsTxHandler(msr, nil), middleware.TelemetryTxMiddleware)
+
+	priv, _, _ := testdata.KeyTestPubAddr()
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	txBuilder.SetMsgs(&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}})
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv}, []uint64{0}, []uint64{0}
+	testTx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+	s.Require().NoError(err)
+
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+	s.Require().NoError(err)
+	_, _, err = txHandler.CheckTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx}, tx.RequestCheckTx{})
+	s.Require().NoError(err)
+
+	gr, err := m.Gather(telemetry.FormatText)
+	s.Require().NoError(err)
+	var summary struct {
+		Samples []struct {
+			Name   string
+			Count  int
+			Labels map[string]string
+		}
+	}
+	s.Require().NoError(json.Unmarshal(gr.Metrics, &summary))
+
+	samples := make(map[string]map[string]string)
+	for _, sample := range summary.Samples {
+		for name, value := range sample.Labels {
+			if samples[sample.Name] == nil {
+				samples[sample.Name] = make(map[string]string)
+			}
+			samples[sample.Name][name] = value
+		}
+	}
+	msgLabels := map[string]string{"msg_type": sdk.MsgTypeURL(&testdata.MsgCreateDog{}), "module": "testdata"}
+	s.Require().Equal(msgLabels, samples["test.tx.msg.execution"])
+	s.Require().Equal(msgLabels, samples["test.tx.msg.gas"])
+	s.Require().Contains(samples, "test.tx.ante_handler")
+}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var _ tx.Handler = txLimitsTxHandler{}
+
+type txLimitsTxHandler struct {
+	ak   AccountKeeper
+	next tx.Handler
+}
+
+// TxLimitsMiddleware rejects the txs with more messages than the MaxMsgsPerTx
+// param, or with more signatures than the TxSigLimit param. Unlike
+// ValidateSigCountMiddleware, which counts the keys of the multisig public keys
+// once they are set, it only reads the tx, so that pathological txs are
+// rejected before any fee is deducted or signature verified.
+//
+// It reads the auth params once for the tx and passes them down in the context
+// to the middlewares below, which would otherwise read them again each.
+// CONTRACT: Tx must implement SigVerifiableTx interface
+func TxLimitsMiddleware(ak AccountKeeper) tx.Middleware {
+	return func(txh tx.Handler) tx.Handler {
+		return txLimitsTxHandler{
+			ak:   ak,
+			next: txh,
+		}
+	}
+}
+
+// checkTxLimits checks the limits of the tx, returning the context holding the
+// auth params of the tx.
+func (txh txLimitsTxHandler) checkTxLimits(ctx context.Context, req tx.Request) (context.Context, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	sigTx, ok := req.Tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a sigTx")
+	}
+
+	params := getTxParams(sdkCtx, txh.ak)
+	if msgCount := len(sigTx.GetMsgs()); params.MaxMsgsPerTx > 0 && uint64(msgCount) > params.MaxMsgsPerTx {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest,
+			"too many messages: %d, limit: %d", msgCount, params.MaxMsgsPerTx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(sigs)) > params.TxSigLimit {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrTooManySignatures,
+			"signatures: %d, limit: %d", len(sigs), params.TxSigLimit)
+	}
+
+	return withTxParams(sdkCtx, params), nil
+}
+
+// CheckTx implements tx.Handler.CheckTx.
+func (txh txLimitsTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	ctx, err := txh.checkTxLimits(ctx, req)
+	if err != nil {
+		return tx.Response{}, tx.ResponseCheckTx{}, err
+	}
+
+	return txh.next.CheckTx(ctx, req, checkReq)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx.
+func (txh txLimitsTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	ctx, err := txh.checkTxLimits(ctx, req)
+	if err != nil {
+		return tx.Response{}, err
+	}
+
+	return txh.next.DeliverTx(ctx, req)
+}
+
+// SimulateTx implements tx.Handler.SimulateTx.
+func (txh txLimitsTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	ctx, err := txh.checkTxLimits(ctx, req)
+	if err != nil {
+		return tx.Response{}, err
+	}
+
+	return txh.next.SimulateTx(ctx, req)
+}
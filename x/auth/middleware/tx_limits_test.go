@@ -0,0 +1,117 @@
+package middleware_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+)
+
+func (s *MWTestSuite) TestTxLimitsMiddleware() {
+	ctx := s.SetupTest(true) // setup
+	txHandler := middleware.ComposeMiddlewares(noopTxHandler, middleware.TxLimitsMiddleware(s.app.AccountKeeper))
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, addr2 := testdata.KeyTestPubAddr()
+	priv3, _, addr3 := testdata.KeyTestPubAddr()
+
+	testCases := []struct {
+		name         string
+		maxMsgsPerTx uint64
+		txSigLimit   uint64
+		msgs         []sdk.Msg
+		privs        []cryptotypes.PrivKey
+		expErr       error
+	}{
+		{
+			"no msgs limit", 0, 7,
+			[]sdk.Msg{testdata.NewTestMsg(addr1), testdata.NewTestMsg(addr1), testdata.NewTestMsg(addr1)},
+			[]cryptotypes.PrivKey{priv1}, nil,
+		},
+		{
+			"msgs within the limit", 2, 7,
+			[]sdk.Msg{testdata.NewTestMsg(addr1), testdata.NewTestMsg(addr1)},
+			[]cryptotypes.PrivKey{priv1}, nil,
+		},
+		{
+			"too many msgs", 2, 7,
+			[]sdk.Msg{testdata.NewTestMsg(addr1), testdata.NewTestMsg(addr1), testdata.NewTestMsg(addr1)},
+			[]cryptotypes.PrivKey{priv1}, sdkerrors.ErrInvalidRequest,
+		},
+		{
+			"signatures within the limit", 0, 2,
+			[]sdk.Msg{testdata.NewTestMsg(addr1, addr2)},
+			[]cryptotypes.PrivKey{priv1, priv2}, nil,
+		},
+		{
+			"too many signatures", 0, 2,
+			[]sdk.Msg{testdata.NewTestMsg(addr1, addr2, addr3)},
+			[]cryptotypes.PrivKey{priv1, priv2, priv3}, sdkerrors.ErrTooManySignatures,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			params := s.app.AccountKeeper.GetParams(ctx)
+			params.MaxMsgsPerTx, params.TxSigLimit = tc.maxMsgsPerTx, tc.txSigLimit
+			s.app.AccountKeeper.SetParams(ctx, params)
+
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(txBuilder.SetMsgs(tc.msgs...))
+			txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			accNums, accSeqs := make([]uint64, len(tc.privs)), make([]uint64, len(tc.privs))
+			testTx, _, err := s.createTestTx(txBuilder, tc.privs, accNums, accSeqs, ctx.ChainID())
+			s.Require().NoError(err)
+
+			_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+			} else {
+				s.Require().NoError(err)
+			}
+
+			_, err = txHandler.SimulateTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *MWTestSuite) TestTxLimitsMiddlewareReadsParamsOnce() {
+	ctx := s.SetupTest(true) // setup
+	txHandler := middleware.ComposeMiddlewares(
+		noopTxHandler,
+		middleware.TxLimitsMiddleware(s.app.AccountKeeper),
+		middleware.ValidateMemoMiddleware(s.app.AccountKeeper),
+		middleware.ConsumeTxSizeGasMiddleware(s.app.AccountKeeper),
+	)
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	testTx, txBytes, err := s.createTestTx(txBuilder, []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}, ctx.ChainID())
+	s.Require().NoError(err)
+
+	paramsCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	params := s.app.AccountKeeper.GetParams(paramsCtx)
+	paramsGas := paramsCtx.GasMeter().GasConsumed()
+
+	// the params are read by TxLimitsMiddleware only, the middlewares below
+	// getting them from the context
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx, TxBytes: txBytes})
+	s.Require().NoError(err)
+	s.Require().Equal(paramsGas+params.TxSizeCostPerByte*sdk.Gas(len(txBytes)), ctx.GasMeter().GasConsumed())
+}
@@ -1,6 +1,8 @@
 package legacytx
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -170,6 +172,12 @@ func (tx StdTx) GetTimeoutHeight() uint64 {
 	return tx.TimeoutHeight
 }
 
+// GetTimeoutTimestamp returns the zero time, as StdTx doesn't support timeout
+// timestamps.
+func (tx StdTx) GetTimeoutTimestamp() time.Time {
+	return time.Time{}
+}
+
 // GetSignatures returns the signature of signers who signed the Msg.
 // CONTRACT: Length returned is same as length of
 // pubkeys returned from MsgKeySigners, and the order
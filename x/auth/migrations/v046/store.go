@@ -0,0 +1,30 @@
+package v046
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.45 to v0.46.
+// The migration includes:
+//
+// - Setting the MaxMsgsPerTx, MsgTypeLimits, PubKeyChangeCost and ReapParams
+//   params in the paramstore
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	migrateParamsStore(ctx, paramstore)
+
+	return nil
+}
+
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	defaultParams := types.DefaultParams()
+	paramstore.Set(ctx, types.KeyMaxMsgsPerTx, defaultParams.MaxMsgsPerTx)
+	paramstore.Set(ctx, types.KeyMsgTypeLimits, defaultParams.MsgTypeLimits)
+	paramstore.Set(ctx, types.KeyPubKeyChangeCost, defaultParams.PubKeyChangeCost)
+	paramstore.Set(ctx, types.KeyReapParams, types.DefaultReapParams())
+}
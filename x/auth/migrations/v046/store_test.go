@@ -0,0 +1,38 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046auth "github.com/cosmos/cosmos-sdk/x/auth/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	authKey := sdk.NewKVStoreKey("auth")
+	tAuthKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(authKey, tAuthKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, authKey, tAuthKey, types.ModuleName)
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.KeyMaxMsgsPerTx))
+	require.False(t, paramstore.Has(ctx, types.KeyMsgTypeLimits))
+	require.False(t, paramstore.Has(ctx, types.KeyPubKeyChangeCost))
+	require.False(t, paramstore.Has(ctx, types.KeyReapParams))
+
+	// Run migrations.
+	err := v046auth.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.KeyMaxMsgsPerTx))
+	require.True(t, paramstore.Has(ctx, types.KeyMsgTypeLimits))
+	require.True(t, paramstore.Has(ctx, types.KeyPubKeyChangeCost))
+	require.True(t, paramstore.Has(ctx, types.KeyReapParams))
+}
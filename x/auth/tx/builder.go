@@ -1,6 +1,9 @@
 package tx
 
 import (
+	"sync/atomic"
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -9,6 +12,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
@@ -31,6 +35,10 @@ type wrapper struct {
 	authInfoBz []byte
 
 	txBodyHasUnknownNonCriticals bool
+
+	// signers caches the signers of the tx, resolved on the first call of
+	// GetSigners as the middlewares look them up several times.
+	signers atomic.Value
 }
 
 var (
@@ -67,7 +75,7 @@ func (w *wrapper) GetMsgs() []sdk.Msg {
 }
 
 func (w *wrapper) ValidateBasic() error {
-	return w.tx.ValidateBasic()
+	return w.tx.ValidateBasicWith(msgSigners)
 }
 
 func (w *wrapper) getBodyBytes() []byte {
@@ -103,7 +111,33 @@ func (w *wrapper) getAuthInfoBytes() []byte {
 }
 
 func (w *wrapper) GetSigners() []sdk.AccAddress {
-	return w.tx.GetSigners()
+	if signers, ok := w.signers.Load().([]sdk.AccAddress); ok && signers != nil {
+		return signers
+	}
+
+	// the capacity is capped so that appending to the signers returned to a
+	// caller does not write into the cache
+	signers := w.tx.GetSignersWith(msgSigners)
+	signers = signers[:len(signers):len(signers)]
+	w.signers.Store(signers)
+	return signers
+}
+
+// msgSigners returns the signers of msg declared by its cosmos.msg.v1.signer
+// option, or returned by its GetSigners method if it declares none.
+func msgSigners(msg sdk.Msg) []sdk.AccAddress {
+	signers, err := msgservice.GetMsgSigners(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return signers
+}
+
+// resetSigners clears the cached signers of the tx when its messages or fee
+// payer change.
+func (w *wrapper) resetSigners() {
+	w.signers.Store([]sdk.AccAddress(nil))
 }
 
 func (w *wrapper) GetPubKeys() ([]cryptotypes.PubKey, error) {
@@ -175,6 +209,15 @@ func (w *wrapper) GetTimeoutHeight() uint64 {
 	return w.tx.Body.TimeoutHeight
 }
 
+// GetTimeoutTimestamp returns the transaction's timeout timestamp (if set).
+func (w *wrapper) GetTimeoutTimestamp() time.Time {
+	if w.tx.Body.TimeoutTimestamp == nil {
+		return time.Time{}
+	}
+
+	return *w.tx.Body.TimeoutTimestamp
+}
+
 func (w *wrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
 	signerInfos := w.tx.AuthInfo.SignerInfos
 	sigs := w.tx.Signatures
@@ -216,6 +259,7 @@ func (w *wrapper) SetMsgs(msgs ...sdk.Msg) error {
 	}
 
 	w.tx.Body.Messages = anys
+	w.resetSigners()
 
 	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
 	w.bodyBz = nil
@@ -231,6 +275,19 @@ func (w *wrapper) SetTimeoutHeight(height uint64) {
 	w.bodyBz = nil
 }
 
+// SetTimeoutTimestamp sets the transaction's block time timeout, the zero time
+// unsetting it.
+func (w *wrapper) SetTimeoutTimestamp(timestamp time.Time) {
+	if timestamp.IsZero() {
+		w.tx.Body.TimeoutTimestamp = nil
+	} else {
+		w.tx.Body.TimeoutTimestamp = &timestamp
+	}
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	w.bodyBz = nil
+}
+
 func (w *wrapper) SetMemo(memo string) {
 	w.tx.Body.Memo = memo
 
@@ -273,6 +330,7 @@ func (w *wrapper) SetFeePayer(feePayer sdk.AccAddress) {
 	}
 
 	w.tx.AuthInfo.Fee.Payer = feePayer.String()
+	w.resetSigners()
 
 	// set authInfoBz to nil because the cached authInfoBz no longer matches tx.AuthInfo
 	w.authInfoBz = nil
@@ -401,6 +459,9 @@ func (w *wrapper) AddAuxSignerData(data tx.AuxSignerData) error {
 	if w.tx.Body.TimeoutHeight != 0 && w.tx.Body.TimeoutHeight != body.TimeoutHeight {
 		return sdkerrors.ErrInvalidRequest.Wrapf("TxBuilder has timeout height %d, got %d in AuxSignerData", w.tx.Body.TimeoutHeight, body.TimeoutHeight)
 	}
+	if w.tx.Body.TimeoutTimestamp != nil && (body.TimeoutTimestamp == nil || !w.tx.Body.TimeoutTimestamp.Equal(*body.TimeoutTimestamp)) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("TxBuilder has timeout timestamp %s, got %v in AuxSignerData", w.tx.Body.TimeoutTimestamp, body.TimeoutTimestamp)
+	}
 	if len(w.tx.Body.ExtensionOptions) != 0 {
 		if len(w.tx.Body.ExtensionOptions) != len(body.ExtensionOptions) {
 			return sdkerrors.ErrInvalidRequest.Wrapf("TxBuilder has %d extension options, got %d in AuxSignerData", len(w.tx.Body.ExtensionOptions), len(body.ExtensionOptions))
@@ -442,6 +503,7 @@ func (w *wrapper) AddAuxSignerData(data tx.AuxSignerData) error {
 
 	w.SetMemo(body.Memo)
 	w.SetTimeoutHeight(body.TimeoutHeight)
+	w.tx.Body.TimeoutTimestamp = body.TimeoutTimestamp
 	w.SetExtensionOptions(body.ExtensionOptions...)
 	w.SetNonCriticalExtensionOptions(body.NonCriticalExtensionOptions...)
 	msgs := make([]sdk.Msg, len(body.Messages))
@@ -2,6 +2,7 @@ package tx
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -313,3 +314,46 @@ func TestBuilderFeeGranter(t *testing.T) {
 	txBuilder.SetFeeGranter(addr1)
 	require.Equal(t, addr1, txBuilder.GetTx().FeeGranter())
 }
+
+func TestBuilderTimeoutTimestamp(t *testing.T) {
+	txBuilder := newBuilder(nil)
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	require.True(t, txBuilder.GetTx().GetTimeoutTimestamp().IsZero())
+
+	timeout := time.Unix(1000, 0).UTC()
+	txBuilder.SetTimeoutTimestamp(timeout)
+	require.Equal(t, timeout, txBuilder.GetTx().GetTimeoutTimestamp())
+
+	// the timeout timestamp is part of the body bytes
+	var body txtypes.TxBody
+	require.NoError(t, body.Unmarshal(txBuilder.getBodyBytes()))
+	require.Equal(t, timeout, *body.TimeoutTimestamp)
+
+	// the zero time unsets the timeout timestamp
+	txBuilder.SetTimeoutTimestamp(time.Time{})
+	require.True(t, txBuilder.GetTx().GetTimeoutTimestamp().IsZero())
+	require.Nil(t, txBuilder.tx.Body.TimeoutTimestamp)
+}
+
+func TestBuilderSignersCache(t *testing.T) {
+	// keys and addresses
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	_, _, addr3 := testdata.KeyTestPubAddr()
+
+	txBuilder := newBuilder(nil)
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	require.Equal(t, []sdk.AccAddress{addr1}, txBuilder.GetSigners())
+
+	// appending to the returned signers does not alter the cached ones
+	signers := append(txBuilder.GetSigners(), addr3)
+	require.Len(t, signers, 2)
+	require.Equal(t, []sdk.AccAddress{addr1}, txBuilder.GetSigners())
+
+	// the cached signers are reset with the msgs and the fee payer
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr1, addr2)))
+	require.Equal(t, []sdk.AccAddress{addr1, addr2}, txBuilder.GetSigners())
+
+	txBuilder.SetFeePayer(addr3)
+	require.Equal(t, []sdk.AccAddress{addr1, addr2, addr3}, txBuilder.GetSigners())
+}
@@ -12,9 +12,59 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
-// DefaultTxDecoder returns a default protobuf TxDecoder using the provided Marshaler.
+// DecodeLimits defines the limits enforced by the tx decoder on the tx bytes, protecting the node
+// against txs crafted to exhaust its stack or memory when decoded, e.g. deeply nested messages
+// executed by authz, gov or group. A zero limit means unlimited. The limits must be the same for
+// all the nodes of a chain, as the txs exceeding them are rejected in DeliverTx.
+type DecodeLimits struct {
+	// MaxTxBytes is the maximum size of the tx bytes.
+	MaxTxBytes int
+	// MaxDepth is the maximum nesting depth of the messages of the tx, TxRaw, TxBody and
+	// AuthInfo being at depth 1.
+	MaxDepth int
+	// MaxAnyDepth is the maximum number of google.protobuf.Any nested inside of each other,
+	// the messages of the tx being at depth 1.
+	MaxAnyDepth int
+	// MaxRepeatedFieldLength is the maximum number of elements of a repeated field of the
+	// messages of the tx, e.g. of the messages of a TxBody or of the outputs of a MsgMultiSend.
+	MaxRepeatedFieldLength int
+}
+
+// DefaultDecodeLimits returns the limits enforced by DefaultTxDecoder. The size of the txs is
+// left to the max tx bytes of Tendermint.
+func DefaultDecodeLimits() DecodeLimits {
+	return DecodeLimits{
+		MaxTxBytes:             0,
+		MaxDepth:               64,
+		MaxAnyDepth:            8,
+		MaxRepeatedFieldLength: 10_000,
+	}
+}
+
+func (l DecodeLimits) unknownprotoLimits() unknownproto.Limits {
+	return unknownproto.Limits{
+		MaxDepth:               l.MaxDepth,
+		MaxAnyDepth:            l.MaxAnyDepth,
+		MaxRepeatedFieldLength: l.MaxRepeatedFieldLength,
+	}
+}
+
+// DefaultTxDecoder returns a default protobuf TxDecoder using the provided Marshaler, enforcing
+// the DefaultDecodeLimits.
 func DefaultTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
+	return DefaultTxDecoderWithLimits(cdc, DefaultDecodeLimits())
+}
+
+// DefaultTxDecoderWithLimits returns a default protobuf TxDecoder using the provided Marshaler,
+// rejecting the txs exceeding the provided limits before unmarshaling them.
+func DefaultTxDecoderWithLimits(cdc codec.ProtoCodecMarshaler, limits DecodeLimits) sdk.TxDecoder {
+	protoLimits := limits.unknownprotoLimits()
+
 	return func(txBytes []byte) (sdk.Tx, error) {
+		if limits.MaxTxBytes > 0 && len(txBytes) > limits.MaxTxBytes {
+			return nil, sdkerrors.ErrTxTooLarge.Wrapf("tx is %d bytes, max %d", len(txBytes), limits.MaxTxBytes)
+		}
+
 		// Make sure txBytes follow ADR-027.
 		err := rejectNonADR027TxRaw(txBytes)
 		if err != nil {
@@ -24,7 +74,7 @@ func DefaultTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
 		var raw tx.TxRaw
 
 		// reject all unknown proto fields in the root TxRaw
-		err = unknownproto.RejectUnknownFieldsStrict(txBytes, &raw, cdc.InterfaceRegistry())
+		_, err = unknownproto.RejectUnknownFieldsWithLimits(txBytes, &raw, false, cdc.InterfaceRegistry(), protoLimits)
 		if err != nil {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
 		}
@@ -37,7 +87,7 @@ func DefaultTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
 		var body tx.TxBody
 
 		// allow non-critical unknown fields in TxBody
-		txBodyHasUnknownNonCriticals, err := unknownproto.RejectUnknownFields(raw.BodyBytes, &body, true, cdc.InterfaceRegistry())
+		txBodyHasUnknownNonCriticals, err := unknownproto.RejectUnknownFieldsWithLimits(raw.BodyBytes, &body, true, cdc.InterfaceRegistry(), protoLimits)
 		if err != nil {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
 		}
@@ -50,7 +100,7 @@ func DefaultTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
 		var authInfo tx.AuthInfo
 
 		// reject all unknown proto fields in AuthInfo
-		err = unknownproto.RejectUnknownFieldsStrict(raw.AuthInfoBytes, &authInfo, cdc.InterfaceRegistry())
+		_, err = unknownproto.RejectUnknownFieldsWithLimits(raw.AuthInfoBytes, &authInfo, false, cdc.InterfaceRegistry(), protoLimits)
 		if err != nil {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
 		}
@@ -0,0 +1,98 @@
+package tx
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// benchmarkTx returns the encoding of a signed tx of numMsgs messages, signed
+// by numSigners accounts, and the codec to decode it with.
+func benchmarkTx(b *testing.B, numMsgs, numSigners int) ([]byte, *codec.ProtoCodec) {
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	testdata.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	privs := make([]cryptotypes.PrivKey, numSigners)
+	for i := range privs {
+		privs[i] = secp256k1.GenPrivKey()
+	}
+
+	msgs := make([]sdk.Msg, numMsgs)
+	for i := range msgs {
+		msgs[i] = testdata.NewTestMsg(sdk.AccAddress(privs[i%numSigners].PubKey().Address()))
+	}
+
+	builder := newBuilder(cdc)
+	require.NoError(b, builder.SetMsgs(msgs...))
+	builder.SetFeeAmount(testdata.NewTestFeeAmount())
+	builder.SetGasLimit(testdata.NewTestGasLimit())
+	builder.SetMemo("benchmark")
+
+	sigs := make([]signingtypes.SignatureV2, numSigners)
+	for i, priv := range privs {
+		sigs[i] = signingtypes.SignatureV2{
+			PubKey:   priv.PubKey(),
+			Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT, Signature: make([]byte, 64)},
+			Sequence: uint64(i),
+		}
+	}
+	require.NoError(b, builder.SetSignatures(sigs...))
+
+	txBytes, err := DefaultTxEncoder()(builder.GetTx())
+	require.NoError(b, err)
+
+	return txBytes, cdc
+}
+
+var benchmarkSizes = []struct{ msgs, signers int }{{1, 1}, {10, 1}, {10, 5}, {100, 7}}
+
+func BenchmarkTxDecode(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		b.Run(fmt.Sprintf("msgs=%d/signers=%d", size.msgs, size.signers), func(b *testing.B) {
+			txBytes, cdc := benchmarkTx(b, size.msgs, size.signers)
+			decoder := DefaultTxDecoder(cdc)
+
+			b.SetBytes(int64(len(txBytes)))
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := decoder(txBytes); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkTxGetSigners measures the lookups of the signers of a decoded tx by
+// the middlewares, the signers being resolved once and then cached.
+func BenchmarkTxGetSigners(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		b.Run(fmt.Sprintf("msgs=%d/signers=%d", size.msgs, size.signers), func(b *testing.B) {
+			txBytes, cdc := benchmarkTx(b, size.msgs, size.signers)
+			decoded, err := DefaultTxDecoder(cdc)(txBytes)
+			require.NoError(b, err)
+			sigTx := decoded.(*wrapper)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if len(sigTx.GetSigners()) != size.signers {
+					b.Fatal("unexpected signers")
+				}
+			}
+		})
+	}
+}
@@ -39,6 +39,31 @@ func TestDefaultTxDecoderError(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestDefaultTxDecoderWithLimits(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	builder := newBuilder(nil)
+	require.NoError(t, builder.SetMsgs(testdata.NewTestMsg(), testdata.NewTestMsg(), testdata.NewTestMsg()))
+	txBz, err := DefaultTxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	_, err = DefaultTxDecoder(cdc)(txBz)
+	require.NoError(t, err)
+
+	_, err = DefaultTxDecoderWithLimits(cdc, DecodeLimits{MaxTxBytes: len(txBz) - 1})(txBz)
+	require.ErrorIs(t, err, sdkerrors.ErrTxTooLarge)
+
+	_, err = DefaultTxDecoderWithLimits(cdc, DecodeLimits{MaxRepeatedFieldLength: 2})(txBz)
+	require.ErrorIs(t, err, sdkerrors.ErrTxDecode)
+	require.Contains(t, err.Error(), "more than 2 elements")
+
+	_, err = DefaultTxDecoderWithLimits(cdc, DecodeLimits{MaxDepth: 1})(txBz)
+	require.ErrorIs(t, err, sdkerrors.ErrTxDecode)
+	require.Contains(t, err.Error(), "nested at depth 2")
+}
+
 func TestUnknownFields(t *testing.T) {
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
@@ -0,0 +1,135 @@
+package tx
+
+import (
+	"fmt"
+	"strings"
+
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// MaxEventQueries is the maximum number of Tendermint queries the events and
+// the event filter of a GetTxsEvent request are expanded into.
+const MaxEventQueries = 10
+
+// eventQueries expands the events and the event filter, combined with AND,
+// into Tendermint queries: the txs matched are the ones matched by any of the
+// queries, each query being an AND of event attribute matches. The filter is
+// rewritten in disjunctive normal form, which must not have more than
+// MaxEventQueries terms.
+func eventQueries(events []string, filter *txtypes.EventFilter) ([]string, error) {
+	conjunctions := [][]string{events}
+	if filter != nil {
+		filterConjunctions, err := expandEventFilter(filter)
+		if err != nil {
+			return nil, err
+		}
+
+		conjunctions, err = andConjunctions(conjunctions, filterConjunctions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	queries := make([]string, len(conjunctions))
+	for i, conjunction := range conjunctions {
+		queries[i] = strings.Join(conjunction, " AND ")
+	}
+
+	return queries, nil
+}
+
+// expandEventFilter returns the conditions of the disjunctive normal form of
+// the filter, i.e. the ORed lists of ANDed conditions.
+func expandEventFilter(filter *txtypes.EventFilter) ([][]string, error) {
+	if filter == nil {
+		return nil, fmt.Errorf("event filter cannot be nil")
+	}
+
+	set := 0
+	for _, isSet := range []bool{filter.Match != nil, len(filter.AllOf) > 0, len(filter.AnyOf) > 0} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, fmt.Errorf("event filter must set exactly one of match, all_of and any_of")
+	}
+
+	switch {
+	case filter.Match != nil:
+		condition, err := eventMatchCondition(filter.Match)
+		if err != nil {
+			return nil, err
+		}
+
+		return [][]string{{condition}}, nil
+
+	case len(filter.AllOf) > 0:
+		conjunctions := [][]string{{}}
+		for _, sub := range filter.AllOf {
+			subConjunctions, err := expandEventFilter(sub)
+			if err != nil {
+				return nil, err
+			}
+
+			conjunctions, err = andConjunctions(conjunctions, subConjunctions)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return conjunctions, nil
+
+	default:
+		var conjunctions [][]string
+		for _, sub := range filter.AnyOf {
+			subConjunctions, err := expandEventFilter(sub)
+			if err != nil {
+				return nil, err
+			}
+
+			conjunctions = append(conjunctions, subConjunctions...)
+			if len(conjunctions) > MaxEventQueries {
+				return nil, errTooManyEventQueries
+			}
+		}
+
+		return conjunctions, nil
+	}
+}
+
+var errTooManyEventQueries = fmt.Errorf("event filter expands into more than %d queries", MaxEventQueries)
+
+// andConjunctions returns the conjunctions of each of a with each of b.
+func andConjunctions(a, b [][]string) ([][]string, error) {
+	if len(a)*len(b) > MaxEventQueries {
+		return nil, errTooManyEventQueries
+	}
+
+	res := make([][]string, 0, len(a)*len(b))
+	for _, x := range a {
+		for _, y := range b {
+			conjunction := make([]string, 0, len(x)+len(y))
+			conjunction = append(conjunction, x...)
+			res = append(res, append(conjunction, y...))
+		}
+	}
+
+	return res, nil
+}
+
+// eventMatchCondition returns the Tendermint query condition of match,
+// rejecting the characters the query syntax can't hold.
+func eventMatchCondition(match *txtypes.EventAttributeMatch) (string, error) {
+	for _, part := range []string{match.EventType, match.AttributeKey} {
+		if part == "" || strings.ContainsAny(part, " \t\r\n\\()\"'=<>") {
+			return "", fmt.Errorf("invalid event attribute %s.%s", match.EventType, match.AttributeKey)
+		}
+	}
+
+	if strings.ContainsAny(match.Value, "\"'") {
+		return "", fmt.Errorf("invalid value of event attribute %s.%s: quotes are not supported", match.EventType, match.AttributeKey)
+	}
+
+	return fmt.Sprintf("%s.%s='%s'", match.EventType, match.AttributeKey, match.Value), nil
+}
@@ -0,0 +1,136 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func matchFilter(eventType, key, value string) *txtypes.EventFilter {
+	return &txtypes.EventFilter{
+		Match: &txtypes.EventAttributeMatch{EventType: eventType, AttributeKey: key, Value: value},
+	}
+}
+
+func TestEventQueries(t *testing.T) {
+	sendFilter := matchFilter("message", "action", "send")
+	bankFilter := matchFilter("message", "module", "bank")
+
+	manyFilters := make([]*txtypes.EventFilter, MaxEventQueries+1)
+	for i := range manyFilters {
+		manyFilters[i] = sendFilter
+	}
+
+	testCases := []struct {
+		name      string
+		events    []string
+		filter    *txtypes.EventFilter
+		expected  []string
+		expErrMsg string
+	}{
+		{
+			"events only",
+			[]string{"message.action='send'", "message.module='bank'"},
+			nil,
+			[]string{"message.action='send' AND message.module='bank'"},
+			"",
+		},
+		{
+			"match",
+			nil,
+			sendFilter,
+			[]string{"message.action='send'"},
+			"",
+		},
+		{
+			"events and any_of",
+			[]string{"tx.height=5"},
+			&txtypes.EventFilter{AnyOf: []*txtypes.EventFilter{sendFilter, bankFilter}},
+			[]string{"tx.height=5 AND message.action='send'", "tx.height=5 AND message.module='bank'"},
+			"",
+		},
+		{
+			"all_of of any_of",
+			nil,
+			&txtypes.EventFilter{AllOf: []*txtypes.EventFilter{
+				{AnyOf: []*txtypes.EventFilter{sendFilter, bankFilter}},
+				matchFilter("transfer", "recipient", "cosmos1"),
+			}},
+			[]string{
+				"message.action='send' AND transfer.recipient='cosmos1'",
+				"message.module='bank' AND transfer.recipient='cosmos1'",
+			},
+			"",
+		},
+		{
+			"empty filter",
+			nil,
+			&txtypes.EventFilter{},
+			nil,
+			"must set exactly one of",
+		},
+		{
+			"match and any_of",
+			nil,
+			&txtypes.EventFilter{Match: sendFilter.Match, AnyOf: []*txtypes.EventFilter{bankFilter}},
+			nil,
+			"must set exactly one of",
+		},
+		{
+			"invalid attribute key",
+			nil,
+			matchFilter("message", "action='send' OR a", "b"),
+			nil,
+			"invalid event attribute",
+		},
+		{
+			"quoted value",
+			nil,
+			matchFilter("message", "action", "send' OR message.action='vote"),
+			nil,
+			"quotes are not supported",
+		},
+		{
+			"too many queries",
+			nil,
+			&txtypes.EventFilter{AnyOf: manyFilters},
+			nil,
+			"more than 10 queries",
+		},
+		{
+			"too many queries from all_of",
+			nil,
+			&txtypes.EventFilter{AllOf: []*txtypes.EventFilter{
+				{AnyOf: []*txtypes.EventFilter{sendFilter, bankFilter, sendFilter, bankFilter}},
+				{AnyOf: []*txtypes.EventFilter{sendFilter, bankFilter, sendFilter}},
+			}},
+			nil,
+			"more than 10 queries",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			queries, err := eventQueries(tc.events, tc.filter)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, queries)
+		})
+	}
+}
+
+func TestTxCursor(t *testing.T) {
+	cursor := txCursor{height: 12, index: 3}
+	parsed, err := parseTxCursor(cursor.bytes())
+	require.NoError(t, err)
+	require.Equal(t, cursor, parsed)
+
+	_, err = parseTxCursor([]byte("foo"))
+	require.Error(t, err)
+}
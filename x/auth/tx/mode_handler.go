@@ -14,6 +14,15 @@ var DefaultSignModes = []signingtypes.SignMode{
 	signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
 }
 
+// DefaultSignModesWithoutLegacyAmino are the default sign modes without
+// SIGN_MODE_LEGACY_AMINO_JSON, for the apps which never need the legacy amino
+// JSON signing. The txs signed with it are then rejected by
+// middleware.ValidateSignModesMiddleware.
+var DefaultSignModesWithoutLegacyAmino = []signingtypes.SignMode{
+	signingtypes.SignMode_SIGN_MODE_DIRECT,
+	signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
+}
+
 // makeSignModeHandler returns the default protobuf SignModeHandler supporting
 // SIGN_MODE_DIRECT, SIGN_MODE_DIRECT_AUX and SIGN_MODE_LEGACY_AMINO_JSON.
 func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler {
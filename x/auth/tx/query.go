@@ -2,9 +2,11 @@ package tx
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -64,6 +66,134 @@ func QueryTxsByEvents(clientCtx client.Context, events []string, page, limit int
 	return result, nil
 }
 
+// txSearchPerPage is the number of txs fetched per Tendermint tx search,
+// which is the maximum allowed by Tendermint.
+const txSearchPerPage = 100
+
+// txCursor is the position of a tx, by height and index in the block, after
+// which the txs are paginated by cursor. It is encoded as the pagination key.
+type txCursor struct {
+	height int64
+	index  uint32
+}
+
+func (c txCursor) bytes() []byte {
+	bz := make([]byte, 12)
+	binary.BigEndian.PutUint64(bz, uint64(c.height))
+	binary.BigEndian.PutUint32(bz[8:], c.index)
+
+	return bz
+}
+
+func parseTxCursor(bz []byte) (txCursor, error) {
+	if len(bz) != 12 {
+		return txCursor{}, fmt.Errorf("invalid pagination key length %d, expected 12", len(bz))
+	}
+
+	return txCursor{
+		height: int64(binary.BigEndian.Uint64(bz)),
+		index:  binary.BigEndian.Uint32(bz[8:]),
+	}, nil
+}
+
+// precedes returns whether the cursor comes before resTx in the given order.
+func (c txCursor) precedes(resTx *coretypes.ResultTx, desc bool) bool {
+	if desc {
+		return resTx.Height < c.height || (resTx.Height == c.height && resTx.Index < c.index)
+	}
+
+	return resTx.Height > c.height || (resTx.Height == c.height && resTx.Index > c.index)
+}
+
+// queryTxsByQueries searches the txs matched by any of the Tendermint queries,
+// ordered by height and then by index in the block, starting after the cursor
+// if not nil. It skips offset txs and returns the next limit ones, along with
+// the cursor of the last one returned if more txs follow it. Each query being
+// searched separately, offset+limit txs are fetched for each of them.
+func queryTxsByQueries(clientCtx client.Context, queries []string, after *txCursor, offset, limit int, orderBy string) ([]*sdk.TxResponse, *txCursor, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	desc := orderBy == "desc"
+	// one more tx than needed is fetched to know if more txs follow
+	n := offset + limit + 1
+
+	seen := make(map[string]bool)
+	var resTxs []*coretypes.ResultTx
+	for _, query := range queries {
+		if after != nil {
+			op := ">="
+			if desc {
+				op = "<="
+			}
+			query = fmt.Sprintf("%s AND tx.height %s %d", query, op, after.height)
+		}
+
+		fetched := 0
+		for page := 1; fetched < n; page++ {
+			perPage := txSearchPerPage
+			res, err := node.TxSearch(context.Background(), query, false, &page, &perPage, orderBy)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			for _, resTx := range res.Txs {
+				if after != nil && !after.precedes(resTx, desc) {
+					continue
+				}
+
+				fetched++
+				if !seen[string(resTx.Hash)] {
+					seen[string(resTx.Hash)] = true
+					resTxs = append(resTxs, resTx)
+				}
+				if fetched == n {
+					break
+				}
+			}
+
+			if page*perPage >= res.TotalCount {
+				break
+			}
+		}
+	}
+
+	sort.Slice(resTxs, func(i, j int) bool {
+		a, b := resTxs[i], resTxs[j]
+		if a.Height != b.Height {
+			return (a.Height < b.Height) != desc
+		}
+
+		return (a.Index < b.Index) != desc
+	})
+
+	var next *txCursor
+	if len(resTxs) > offset+limit {
+		resTxs = resTxs[:offset+limit]
+		last := resTxs[len(resTxs)-1]
+		next = &txCursor{height: last.Height, index: last.Index}
+	}
+	if offset < len(resTxs) {
+		resTxs = resTxs[offset:]
+	} else {
+		resTxs = nil
+	}
+
+	resBlocks, err := getBlocksForTxResults(clientCtx, resTxs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txs, err := formatTxResults(clientCtx.TxConfig, resTxs, resBlocks)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return txs, next, nil
+}
+
 // QueryTx queries for a single transaction by a hash string in hex format. An
 // error is returned if the transaction does not exist or cannot be queried.
 func QueryTx(clientCtx client.Context, hashHexStr string) (*sdk.TxResponse, error) {
@@ -57,7 +57,7 @@ func (s txServer) GetTxsEvent(ctx context.Context, req *txtypes.GetTxsEventReque
 	}
 	orderBy := parseOrderBy(req.OrderBy)
 
-	if len(req.Events) == 0 {
+	if len(req.Events) == 0 && req.Filter == nil {
 		return nil, status.Error(codes.InvalidArgument, "must declare at least one event to search")
 	}
 
@@ -67,21 +67,23 @@ func (s txServer) GetTxsEvent(ctx context.Context, req *txtypes.GetTxsEventReque
 		}
 	}
 
-	result, err := QueryTxsByEvents(s.clientCtx, req.Events, page, limit, orderBy)
+	queries, err := eventQueries(req.Events, req.Filter)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// Create a proto codec, we need it to unmarshal the tx bytes.
-	txsList := make([]*txtypes.Tx, len(result.Txs))
+	if len(queries) > 1 || len(req.Pagination.GetKey()) > 0 {
+		return s.getTxsEventByQueries(queries, req.Pagination, limit, orderBy)
+	}
 
-	for i, tx := range result.Txs {
-		protoTx, ok := tx.Tx.GetCachedValue().(*txtypes.Tx)
-		if !ok {
-			return nil, status.Errorf(codes.Internal, "expected %T, got %T", txtypes.Tx{}, tx.Tx.GetCachedValue())
-		}
+	result, err := QueryTxsByEvents(s.clientCtx, queries, page, limit, orderBy)
+	if err != nil {
+		return nil, err
+	}
 
-		txsList[i] = protoTx
+	txsList, err := protoTxs(result.Txs)
+	if err != nil {
+		return nil, err
 	}
 
 	return &txtypes.GetTxsEventResponse{
@@ -93,6 +95,69 @@ func (s txServer) GetTxsEvent(ctx context.Context, req *txtypes.GetTxsEventReque
 	}, nil
 }
 
+// maxTxsEventByQueriesOffset is the maximum offset+limit of the GetTxsEvent
+// requests paginated by offset with several queries, each query fetching that
+// many txs.
+const maxTxsEventByQueriesOffset = 1000
+
+// getTxsEventByQueries returns the txs matched by any of the queries,
+// paginated either by offset or by cursor. The total is not counted.
+func (s txServer) getTxsEventByQueries(queries []string, pageReq *pagination.PageRequest, limit int, orderBy string) (*txtypes.GetTxsEventResponse, error) {
+	var after *txCursor
+	offset := int(pageReq.GetOffset())
+	if key := pageReq.GetKey(); len(key) > 0 {
+		if offset != 0 {
+			return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+		}
+
+		cursor, err := parseTxCursor(key)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		after = &cursor
+	}
+
+	if offset+limit > maxTxsEventByQueriesOffset {
+		return nil, status.Errorf(codes.InvalidArgument, "offset + limit must be at most %d with several queries, use the pagination key instead", maxTxsEventByQueriesOffset)
+	}
+
+	txs, next, err := queryTxsByQueries(s.clientCtx, queries, after, offset, limit, orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	txsList, err := protoTxs(txs)
+	if err != nil {
+		return nil, err
+	}
+
+	pageRes := &pagination.PageResponse{}
+	if next != nil {
+		pageRes.NextKey = next.bytes()
+	}
+
+	return &txtypes.GetTxsEventResponse{
+		Txs:         txsList,
+		TxResponses: txs,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// protoTxs returns the protobuf txs of the tx responses.
+func protoTxs(txResponses []*sdk.TxResponse) ([]*txtypes.Tx, error) {
+	txsList := make([]*txtypes.Tx, len(txResponses))
+	for i, tx := range txResponses {
+		protoTx, ok := tx.Tx.GetCachedValue().(*txtypes.Tx)
+		if !ok {
+			return nil, status.Errorf(codes.Internal, "expected %T, got %T", txtypes.Tx{}, tx.Tx.GetCachedValue())
+		}
+
+		txsList[i] = protoTx
+	}
+
+	return txsList, nil
+}
+
 // Simulate implements the ServiceServer.Simulate RPC method.
 func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*txtypes.SimulateResponse, error) {
 	if req == nil {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -258,6 +259,39 @@ func (s IntegrationTestSuite) TestGetTxEvents_GRPC() {
 			},
 			false, "",
 		},
+		{
+			"with filter",
+			&tx.GetTxsEventRequest{
+				Filter: &tx.EventFilter{AnyOf: []*tx.EventFilter{
+					{Match: &tx.EventAttributeMatch{EventType: "message", AttributeKey: "action", Value: "foobar"}},
+					{Match: &tx.EventAttributeMatch{EventType: "message", AttributeKey: "action", Value: sdk.MsgTypeURL(&banktypes.MsgSend{})}},
+				}},
+			},
+			false, "",
+		},
+		{
+			"with events and filter",
+			&tx.GetTxsEventRequest{
+				Events: []string{"message.module='bank'"},
+				Filter: &tx.EventFilter{Match: &tx.EventAttributeMatch{EventType: "message", AttributeKey: "action", Value: sdk.MsgTypeURL(&banktypes.MsgSend{})}},
+			},
+			false, "",
+		},
+		{
+			"with invalid filter",
+			&tx.GetTxsEventRequest{
+				Filter: &tx.EventFilter{},
+			},
+			true, "event filter must set exactly one of match, all_of and any_of",
+		},
+		{
+			"with offset and key",
+			&tx.GetTxsEventRequest{
+				Events:     []string{bankMsgSendEventAction},
+				Pagination: &query.PageRequest{Offset: 1, Key: []byte("key")},
+			},
+			true, "either offset or key is expected, got both",
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
@@ -281,6 +315,70 @@ func (s IntegrationTestSuite) TestGetTxEvents_GRPC() {
 	}
 }
 
+func (s IntegrationTestSuite) TestGetTxEvents_GRPCCursor() {
+	filter := &tx.EventFilter{AnyOf: []*tx.EventFilter{
+		{Match: &tx.EventAttributeMatch{EventType: "message", AttributeKey: "module", Value: "bank"}},
+		{Match: &tx.EventAttributeMatch{EventType: "message", AttributeKey: "action", Value: sdk.MsgTypeURL(&banktypes.MsgSend{})}},
+	}}
+
+	for _, orderBy := range []tx.OrderBy{tx.OrderBy_ORDER_BY_ASC, tx.OrderBy_ORDER_BY_DESC} {
+		s.Run(orderBy.String(), func() {
+			all, err := s.queryClient.GetTxsEvent(context.Background(), &tx.GetTxsEventRequest{
+				Filter:  filter,
+				OrderBy: orderBy,
+			})
+			s.Require().NoError(err)
+			s.Require().NotEmpty(all.TxResponses)
+			s.Require().Empty(all.Pagination.NextKey)
+
+			// the txs matched by both queries are returned once, in order
+			var hashes []string
+			for i, txRes := range all.TxResponses {
+				hashes = append(hashes, txRes.TxHash)
+				if i > 0 {
+					prev := all.TxResponses[i-1]
+					if orderBy == tx.OrderBy_ORDER_BY_ASC {
+						s.Require().LessOrEqual(prev.Height, txRes.Height)
+					} else {
+						s.Require().GreaterOrEqual(prev.Height, txRes.Height)
+					}
+				}
+			}
+
+			// the same txs are returned page by page with the cursor
+			var pagedHashes []string
+			var key []byte
+			for {
+				res, err := s.queryClient.GetTxsEvent(context.Background(), &tx.GetTxsEventRequest{
+					Filter:     filter,
+					OrderBy:    orderBy,
+					Pagination: &query.PageRequest{Key: key, Limit: 1},
+				})
+				s.Require().NoError(err)
+				s.Require().Len(res.TxResponses, 1)
+				pagedHashes = append(pagedHashes, res.TxResponses[0].TxHash)
+
+				key = res.Pagination.NextKey
+				if key == nil {
+					break
+				}
+			}
+			s.Require().Equal(hashes, pagedHashes)
+
+			// and by offset
+			res, err := s.queryClient.GetTxsEvent(context.Background(), &tx.GetTxsEventRequest{
+				Filter:     filter,
+				OrderBy:    orderBy,
+				Pagination: &query.PageRequest{Offset: uint64(len(hashes) - 1), Limit: 1},
+			})
+			s.Require().NoError(err)
+			s.Require().Len(res.TxResponses, 1)
+			s.Require().Equal(hashes[len(hashes)-1], res.TxResponses[0].TxHash)
+			s.Require().Empty(res.Pagination.NextKey)
+		})
+	}
+}
+
 func (s IntegrationTestSuite) TestGetTxEvents_GRPCGateway() {
 	val := s.network.Validators[0]
 	testCases := []struct {
@@ -470,6 +568,35 @@ func (s IntegrationTestSuite) TestBroadcastTx_GRPC() {
 	}
 }
 
+func (s IntegrationTestSuite) TestBroadcastTx_GRPCAwaitInclusion() {
+	val := s.network.Validators[0]
+	txBuilder := s.mkTxBuilder()
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	tooLong := client.MaxAwaitInclusionTimeout + time.Second
+	_, err = s.queryClient.BroadcastTx(context.Background(), &tx.BroadcastTxRequest{
+		Mode:         tx.BroadcastMode_BROADCAST_MODE_AWAIT_INCLUSION,
+		TxBytes:      txBytes,
+		AwaitTimeout: &tooLong,
+	})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "await timeout")
+
+	grpcRes, err := s.queryClient.BroadcastTx(context.Background(), &tx.BroadcastTxRequest{
+		Mode:    tx.BroadcastMode_BROADCAST_MODE_AWAIT_INCLUSION,
+		TxBytes: txBytes,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(0), grpcRes.TxResponse.Code, grpcRes.TxResponse.RawLog)
+
+	// the full tx response of the included tx is returned
+	s.Require().Positive(grpcRes.TxResponse.Height)
+	s.Require().NotEmpty(grpcRes.TxResponse.Timestamp)
+	s.Require().NotNil(grpcRes.TxResponse.Tx)
+	s.Require().NotEmpty(grpcRes.TxResponse.Logs)
+}
+
 func (s IntegrationTestSuite) TestBroadcastTx_GRPCGateway() {
 	val := s.network.Validators[0]
 	txBuilder := s.mkTxBuilder()
@@ -7,17 +7,23 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -113,6 +119,14 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// max_msgs_per_tx is the maximum number of messages of a tx, 0 meaning no
+	// limit.
+	MaxMsgsPerTx uint64 `protobuf:"varint,6,opt,name=max_msgs_per_tx,json=maxMsgsPerTx,proto3" json:"max_msgs_per_tx,omitempty"`
+	// msg_type_limits override the tx size cost and the memo limit of the txs
+	// holding messages of the given types.
+	MsgTypeLimits []MsgTypeLimits `protobuf:"bytes,7,rep,name=msg_type_limits,json=msgTypeLimits,proto3" json:"msg_type_limits"`
+	// pub_key_change_cost is the gas consumed by a MsgChangePubKey.
+	PubKeyChangeCost uint64 `protobuf:"varint,8,opt,name=pub_key_change_cost,json=pubKeyChangeCost,proto3" json:"pub_key_change_cost,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -182,55 +196,229 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxMsgsPerTx() uint64 {
+	if m != nil {
+		return m.MaxMsgsPerTx
+	}
+	return 0
+}
+
+func (m *Params) GetMsgTypeLimits() []MsgTypeLimits {
+	if m != nil {
+		return m.MsgTypeLimits
+	}
+	return nil
+}
+
+func (m *Params) GetPubKeyChangeCost() uint64 {
+	if m != nil {
+		return m.PubKeyChangeCost
+	}
+	return 0
+}
+
+// ReapParams defines the parameters of the reaping of the dust accounts. They
+// are stored apart from Params, which are read by every tx.
+type ReapParams struct {
+	// dust_threshold is the balance below which the accounts which never sent a
+	// tx are reaped, their balances being rolled into the community pool. An
+	// account is dust if each of its balances is below the threshold of its
+	// denom, the denoms without a threshold never being dust. The accounts are
+	// not reaped if empty.
+	DustThreshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=dust_threshold,json=dustThreshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"dust_threshold"`
+	// grace_period is the duration an account stays dust before being reaped.
+	GracePeriod time.Duration `protobuf:"bytes,2,opt,name=grace_period,json=gracePeriod,proto3,stdduration" json:"grace_period"`
+	// scan_limit is the number of accounts checked for reaping at the end of
+	// each block.
+	ScanLimit uint64 `protobuf:"varint,3,opt,name=scan_limit,json=scanLimit,proto3" json:"scan_limit,omitempty"`
+}
+
+func (m *ReapParams) Reset()      { *m = ReapParams{} }
+func (*ReapParams) ProtoMessage() {}
+func (*ReapParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{3}
+}
+func (m *ReapParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReapParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReapParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReapParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReapParams.Merge(m, src)
+}
+func (m *ReapParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReapParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReapParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReapParams proto.InternalMessageInfo
+
+func (m *ReapParams) GetDustThreshold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DustThreshold
+	}
+	return nil
+}
+
+func (m *ReapParams) GetGracePeriod() time.Duration {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return 0
+}
+
+func (m *ReapParams) GetScanLimit() uint64 {
+	if m != nil {
+		return m.ScanLimit
+	}
+	return 0
+}
+
+// MsgTypeLimits overrides the limits of the txs holding messages of a type. The
+// txs holding messages of several types get the highest tx size cost and the
+// lowest memo limit of the types.
+type MsgTypeLimits struct {
+	// msg_type_url is the type URL of the messages, e.g.
+	// /cosmos.bank.v1beta1.MsgSend.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// tx_size_cost_per_byte overrides the tx size cost per byte if non-zero.
+	TxSizeCostPerByte uint64 `protobuf:"varint,2,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
+	// max_memo_characters overrides the max memo characters if non-zero.
+	MaxMemoCharacters uint64 `protobuf:"varint,3,opt,name=max_memo_characters,json=maxMemoCharacters,proto3" json:"max_memo_characters,omitempty"`
+}
+
+func (m *MsgTypeLimits) Reset()         { *m = MsgTypeLimits{} }
+func (m *MsgTypeLimits) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeLimits) ProtoMessage()    {}
+func (*MsgTypeLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
+}
+func (m *MsgTypeLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypeLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypeLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypeLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeLimits.Merge(m, src)
+}
+func (m *MsgTypeLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypeLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypeLimits proto.InternalMessageInfo
+
+func (m *MsgTypeLimits) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgTypeLimits) GetTxSizeCostPerByte() uint64 {
+	if m != nil {
+		return m.TxSizeCostPerByte
+	}
+	return 0
+}
+
+func (m *MsgTypeLimits) GetMaxMemoCharacters() uint64 {
+	if m != nil {
+		return m.MaxMemoCharacters
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*ReapParams)(nil), "cosmos.auth.v1beta1.ReapParams")
+	proto.RegisterType((*MsgTypeLimits)(nil), "cosmos.auth.v1beta1.MsgTypeLimits")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 609 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0x8e, 0xdb, 0xfc, 0xfa, 0xe7, 0xd2, 0x56, 0xea, 0x35, 0xbf, 0xe2, 0x66, 0xb0, 0xad, 0x4a,
-	0x48, 0x41, 0x22, 0x0e, 0x09, 0x2a, 0x12, 0xdd, 0xea, 0x82, 0x50, 0x05, 0x85, 0xca, 0x11, 0x0c,
-	0x2c, 0xd6, 0xd9, 0x79, 0xeb, 0x9e, 0x9a, 0xf3, 0x19, 0xdf, 0xb9, 0x8a, 0xfb, 0x09, 0x18, 0x19,
-	0x19, 0xfb, 0x01, 0x18, 0x3b, 0x33, 0xa3, 0x4e, 0x15, 0x13, 0x53, 0x84, 0xd2, 0x01, 0xc4, 0xa7,
-	0x40, 0xb9, 0x73, 0xaa, 0x16, 0x75, 0xf2, 0xbd, 0xcf, 0xf3, 0xdc, 0xf3, 0xfe, 0xf3, 0x21, 0x2b,
-	0xe2, 0x82, 0x71, 0xd1, 0x26, 0xb9, 0x3c, 0x6a, 0x9f, 0x74, 0x42, 0x90, 0xa4, 0xa3, 0x02, 0x37,
-	0xcd, 0xb8, 0xe4, 0x78, 0x4d, 0xf3, 0xae, 0x82, 0x4a, 0xbe, 0xb1, 0xa1, 0xc1, 0x40, 0x49, 0xda,
-	0xa5, 0x42, 0x05, 0x8d, 0x7a, 0xcc, 0x63, 0xae, 0xf1, 0xc9, 0xa9, 0x44, 0x37, 0x62, 0xce, 0xe3,
-	0x01, 0xb4, 0x55, 0x14, 0xe6, 0x87, 0x6d, 0x92, 0x14, 0x9a, 0xda, 0xfc, 0x65, 0xa0, 0x9a, 0x47,
-	0x04, 0xec, 0x44, 0x11, 0xcf, 0x13, 0x89, 0xbb, 0x68, 0x9e, 0xf4, 0xfb, 0x19, 0x08, 0x61, 0x1a,
-	0x8e, 0xd1, 0x5c, 0xf4, 0xcc, 0xef, 0xe7, 0xad, 0x7a, 0x99, 0x63, 0x47, 0x33, 0x3d, 0x99, 0xd1,
-	0x24, 0xf6, 0xa7, 0x42, 0xfc, 0x02, 0xcd, 0xa7, 0x79, 0x18, 0x1c, 0x43, 0x61, 0xce, 0x38, 0x46,
-	0xb3, 0xd6, 0xad, 0xbb, 0x3a, 0xa1, 0x3b, 0x4d, 0xe8, 0xee, 0x24, 0x85, 0x67, 0xfe, 0x19, 0xd9,
-	0xf5, 0x34, 0x0f, 0x07, 0x34, 0x9a, 0x68, 0x1f, 0x72, 0x46, 0x25, 0xb0, 0x54, 0x16, 0xfe, 0x5c,
-	0x9a, 0x87, 0x2f, 0xa1, 0xc0, 0xf7, 0xd1, 0x0a, 0xd1, 0x75, 0x04, 0x49, 0xce, 0x42, 0xc8, 0xcc,
-	0x59, 0xc7, 0x68, 0x56, 0xfd, 0xe5, 0x12, 0x7d, 0xad, 0x40, 0xdc, 0x40, 0x0b, 0x02, 0x3e, 0xe4,
-	0x90, 0x44, 0x60, 0x56, 0x95, 0xe0, 0x3a, 0xde, 0x36, 0x3f, 0x9e, 0xd9, 0x95, 0xcf, 0x67, 0x76,
-	0xe5, 0xf7, 0x99, 0x5d, 0xb9, 0x38, 0x6f, 0x2d, 0x94, 0x8d, 0xed, 0x6d, 0x7e, 0x31, 0xd0, 0xf2,
-	0x3e, 0xef, 0xe7, 0x83, 0xeb, 0x5e, 0xf7, 0xd0, 0x52, 0x48, 0x04, 0x04, 0xa5, 0xbb, 0x6a, 0xb8,
-	0xd6, 0x75, 0xdc, 0x3b, 0x66, 0xee, 0xde, 0x98, 0x91, 0x57, 0xbd, 0x1c, 0xd9, 0x86, 0x5f, 0x0b,
-	0x6f, 0x8c, 0x0d, 0xa3, 0x6a, 0x42, 0x18, 0xa8, 0xfe, 0x17, 0x7d, 0x75, 0xc6, 0x0e, 0xaa, 0xa5,
-	0x90, 0x31, 0x2a, 0x04, 0xe5, 0x89, 0x30, 0x67, 0x9d, 0xd9, 0xe6, 0xa2, 0x7f, 0x13, 0xda, 0x6e,
-	0x4c, 0x8b, 0xbd, 0x38, 0x6f, 0xad, 0xdc, 0xaa, 0x6d, 0x6f, 0xf3, 0xeb, 0x0c, 0x9a, 0x3b, 0x20,
-	0x19, 0x61, 0x02, 0xbb, 0x68, 0x8d, 0x91, 0x61, 0xc0, 0x80, 0xf1, 0x20, 0x3a, 0x22, 0x19, 0x89,
-	0x24, 0x64, 0x7a, 0x3f, 0x55, 0x7f, 0x95, 0x91, 0xe1, 0x3e, 0x30, 0xbe, 0x7b, 0x4d, 0x60, 0x07,
-	0x2d, 0xc9, 0x61, 0x20, 0x68, 0x1c, 0x0c, 0x28, 0xa3, 0x52, 0x15, 0x55, 0xf5, 0x91, 0x1c, 0xf6,
-	0x68, 0xfc, 0x6a, 0x82, 0xe0, 0x47, 0xe8, 0x7f, 0xa5, 0x38, 0x85, 0x20, 0xe2, 0x42, 0x06, 0x29,
-	0x64, 0x41, 0x58, 0x48, 0x28, 0xe7, 0xbd, 0x3a, 0x91, 0x9e, 0xc2, 0x2e, 0x17, 0xf2, 0x00, 0x32,
-	0xaf, 0x90, 0x80, 0xdf, 0xa0, 0x7b, 0x13, 0xc3, 0x13, 0xc8, 0xe8, 0x61, 0xa1, 0x2f, 0x41, 0xbf,
-	0xbb, 0xb5, 0xd5, 0x79, 0xaa, 0x57, 0xe0, 0x99, 0xe3, 0x91, 0x5d, 0xef, 0xd1, 0xf8, 0x9d, 0x52,
-	0x4c, 0xae, 0x3e, 0x7f, 0xa6, 0x78, 0xbf, 0x2e, 0x6e, 0xa1, 0xfa, 0x16, 0x7e, 0x8b, 0x36, 0xfe,
-	0x35, 0x14, 0x10, 0xa5, 0xdd, 0xad, 0x27, 0xc7, 0x1d, 0xf3, 0x3f, 0x65, 0xd9, 0x18, 0x8f, 0xec,
-	0xf5, 0x5b, 0x96, 0xbd, 0xa9, 0xc2, 0x5f, 0x17, 0x77, 0xe2, 0xdb, 0x0b, 0xe5, 0xee, 0x0d, 0x6f,
-	0xf7, 0xdb, 0xd8, 0x32, 0x2e, 0xc7, 0x96, 0xf1, 0x73, 0x6c, 0x19, 0x9f, 0xae, 0xac, 0xca, 0xe5,
-	0x95, 0x55, 0xf9, 0x71, 0x65, 0x55, 0xde, 0x3f, 0x88, 0xa9, 0x3c, 0xca, 0x43, 0x37, 0xe2, 0xac,
-	0x7c, 0x3d, 0xe5, 0xa7, 0x25, 0xfa, 0xc7, 0xed, 0xa1, 0x7e, 0x8c, 0xb2, 0x48, 0x41, 0x84, 0x73,
-	0xea, 0x0f, 0x7e, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x35, 0x25, 0xef, 0x58, 0xa8, 0x03, 0x00,
-	0x00,
+	// 864 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x37, 0xa1, 0x4d, 0x27, 0x69, 0x97, 0x9d, 0x86, 0xc5, 0x89, 0x84, 0x6d, 0x45, 0x5a,
+	0x29, 0x48, 0xc4, 0xd9, 0x06, 0x15, 0x89, 0xde, 0xea, 0x2c, 0xa0, 0x0a, 0x0a, 0x91, 0xdb, 0xe5,
+	0xc0, 0xc5, 0x1a, 0xdb, 0xb3, 0x8e, 0xd5, 0xd8, 0x63, 0x66, 0xc6, 0xab, 0x78, 0x3f, 0x01, 0x47,
+	0x8e, 0xcb, 0xad, 0x67, 0xc4, 0xb1, 0x1f, 0x62, 0xb5, 0xa7, 0x8a, 0x13, 0xa7, 0x2c, 0x4a, 0x25,
+	0x40, 0xdc, 0xf8, 0x06, 0x68, 0xc6, 0x93, 0x28, 0x5d, 0x1a, 0x4e, 0xf1, 0xfc, 0xde, 0x9f, 0xf9,
+	0xbd, 0xdf, 0x7b, 0x6f, 0x02, 0x8c, 0x80, 0xb0, 0x84, 0xb0, 0x01, 0xca, 0xf9, 0x64, 0xf0, 0xfc,
+	0xc0, 0xc7, 0x1c, 0x1d, 0xc8, 0x83, 0x9d, 0x51, 0xc2, 0x09, 0xdc, 0x2f, 0xed, 0xb6, 0x84, 0x94,
+	0xbd, 0xd3, 0x2e, 0x41, 0x4f, 0xba, 0x0c, 0x94, 0x87, 0x3c, 0x74, 0x5a, 0x11, 0x89, 0x48, 0x89,
+	0x8b, 0x2f, 0x85, 0xb6, 0x23, 0x42, 0xa2, 0x29, 0x1e, 0xc8, 0x93, 0x9f, 0x3f, 0x1b, 0xa0, 0xb4,
+	0x50, 0x26, 0xe3, 0x6d, 0x53, 0x98, 0x53, 0xc4, 0x63, 0x92, 0x2e, 0xed, 0x8a, 0xa0, 0x8f, 0x18,
+	0x5e, 0x11, 0x0c, 0x48, 0xac, 0xec, 0xdd, 0x3f, 0x35, 0xd0, 0x70, 0x10, 0xc3, 0xc7, 0x41, 0x40,
+	0xf2, 0x94, 0xc3, 0x21, 0xd8, 0x46, 0x61, 0x48, 0x31, 0x63, 0xba, 0x66, 0x69, 0xbd, 0x1d, 0x47,
+	0xff, 0xf5, 0xaa, 0xdf, 0x52, 0x1c, 0x8f, 0x4b, 0xcb, 0x19, 0xa7, 0x71, 0x1a, 0xb9, 0x4b, 0x47,
+	0xf8, 0x05, 0xd8, 0xce, 0x72, 0xdf, 0xbb, 0xc0, 0x85, 0x7e, 0xcf, 0xd2, 0x7a, 0x8d, 0x61, 0xcb,
+	0x2e, 0x59, 0xd9, 0x4b, 0x56, 0xf6, 0x71, 0x5a, 0x38, 0xfa, 0xdf, 0x73, 0xb3, 0x95, 0xe5, 0xfe,
+	0x34, 0x0e, 0x84, 0xef, 0x47, 0x24, 0x89, 0x39, 0x4e, 0x32, 0x5e, 0xb8, 0x5b, 0x59, 0xee, 0x7f,
+	0x89, 0x0b, 0xf8, 0x08, 0xec, 0xa1, 0x92, 0x87, 0x97, 0xe6, 0x89, 0x8f, 0xa9, 0x5e, 0xb5, 0xb4,
+	0x5e, 0xcd, 0xdd, 0x55, 0xe8, 0xd7, 0x12, 0x84, 0x1d, 0x50, 0x67, 0xf8, 0xfb, 0x1c, 0xa7, 0x01,
+	0xd6, 0x6b, 0xd2, 0x61, 0x75, 0x3e, 0xd2, 0x7f, 0xb8, 0x34, 0x2b, 0x2f, 0x2f, 0xcd, 0xca, 0x5f,
+	0x97, 0x66, 0xe5, 0xf5, 0x55, 0xbf, 0xae, 0x0a, 0x3b, 0xe9, 0xfe, 0xa2, 0x81, 0xdd, 0x53, 0x12,
+	0xe6, 0xd3, 0x55, 0xad, 0x27, 0xa0, 0x29, 0x64, 0xf1, 0x54, 0x76, 0x59, 0x70, 0x63, 0x68, 0xd9,
+	0x77, 0xf4, 0xcc, 0x5e, 0xd3, 0xc8, 0xa9, 0x5d, 0xcf, 0x4d, 0xcd, 0x6d, 0xf8, 0x6b, 0xb2, 0x41,
+	0x50, 0x4b, 0x51, 0x82, 0x65, 0xfd, 0x3b, 0xae, 0xfc, 0x86, 0x16, 0x68, 0x64, 0x98, 0x26, 0x31,
+	0x63, 0x31, 0x49, 0x99, 0x5e, 0xb5, 0xaa, 0xbd, 0x1d, 0x77, 0x1d, 0x3a, 0xea, 0x2c, 0xc9, 0xbe,
+	0xbe, 0xea, 0xef, 0xdd, 0xe2, 0x76, 0xd2, 0xfd, 0xa3, 0x0a, 0xb6, 0xc6, 0x88, 0xa2, 0x84, 0x41,
+	0x1b, 0xec, 0x27, 0x68, 0xe6, 0x25, 0x38, 0x21, 0x5e, 0x30, 0x41, 0x14, 0x05, 0x1c, 0xd3, 0xb2,
+	0x3f, 0x35, 0xf7, 0x41, 0x82, 0x66, 0xa7, 0x38, 0x21, 0xa3, 0x95, 0x01, 0x5a, 0xa0, 0xc9, 0x67,
+	0x1e, 0x8b, 0x23, 0x6f, 0x1a, 0x27, 0x31, 0x97, 0xa4, 0x6a, 0x2e, 0xe0, 0xb3, 0xb3, 0x38, 0xfa,
+	0x4a, 0x20, 0xf0, 0x31, 0x78, 0x4f, 0x7a, 0xbc, 0xc0, 0x5e, 0x40, 0x18, 0xf7, 0x32, 0x4c, 0x3d,
+	0xbf, 0xe0, 0x58, 0xe9, 0xfd, 0x40, 0xb8, 0xbe, 0xc0, 0x23, 0xc2, 0xf8, 0x18, 0x53, 0xa7, 0xe0,
+	0x18, 0x7e, 0x03, 0xde, 0x17, 0x09, 0x9f, 0x63, 0x1a, 0x3f, 0x2b, 0xca, 0x20, 0x1c, 0x0e, 0x0f,
+	0x0f, 0x0f, 0x3e, 0x2d, 0x5b, 0xe0, 0xe8, 0x8b, 0xb9, 0xd9, 0x3a, 0x8b, 0xa3, 0x6f, 0xa5, 0x87,
+	0x08, 0xfd, 0xec, 0x89, 0xb4, 0xbb, 0x2d, 0x76, 0x0b, 0x2d, 0xa3, 0xe0, 0x53, 0xd0, 0x7e, 0x3b,
+	0x21, 0xc3, 0x41, 0x36, 0x3c, 0xfc, 0xe4, 0xe2, 0x40, 0x7f, 0x47, 0xa6, 0xec, 0x2c, 0xe6, 0xe6,
+	0xc3, 0x5b, 0x29, 0xcf, 0x96, 0x1e, 0xee, 0x43, 0x76, 0x27, 0x0e, 0x1f, 0x81, 0xfb, 0x52, 0x2b,
+	0x16, 0x31, 0x59, 0x15, 0x9f, 0xe9, 0x5b, 0xb2, 0xa6, 0xa6, 0xd0, 0x89, 0x45, 0x6c, 0x8c, 0xe9,
+	0xf9, 0x0c, 0x8e, 0xc1, 0xfd, 0x84, 0x45, 0x1e, 0x2f, 0x32, 0x5c, 0x8a, 0xc4, 0xf4, 0x6d, 0xab,
+	0xda, 0x6b, 0x0c, 0xbb, 0x77, 0x76, 0xff, 0x94, 0x45, 0xe7, 0x45, 0x86, 0xa5, 0x78, 0xcc, 0xa9,
+	0xbd, 0x9a, 0x9b, 0x15, 0x77, 0x37, 0x59, 0x07, 0x61, 0x1f, 0xec, 0xab, 0x25, 0x10, 0x3d, 0x4a,
+	0xa3, 0x52, 0x59, 0xbd, 0x2e, 0x2f, 0x7f, 0xb7, 0x1c, 0xf0, 0x91, 0x34, 0x08, 0xc2, 0x47, 0x75,
+	0x35, 0xa3, 0x5a, 0xf7, 0x1f, 0x0d, 0x00, 0x17, 0xa3, 0x4c, 0x35, 0x9b, 0x82, 0xbd, 0x30, 0x67,
+	0xdc, 0xe3, 0x13, 0x8a, 0xd9, 0x84, 0x4c, 0x43, 0x5d, 0x93, 0xc4, 0xda, 0x4b, 0x62, 0x62, 0xec,
+	0x56, 0xc4, 0x46, 0x24, 0x4e, 0x9d, 0xc7, 0x82, 0xcf, 0xcf, 0x6f, 0xcc, 0x5e, 0x14, 0xf3, 0x49,
+	0xee, 0xdb, 0x01, 0x49, 0xd4, 0xab, 0xa2, 0x7e, 0xfa, 0x2c, 0xbc, 0x18, 0x88, 0x5a, 0x99, 0x0c,
+	0x60, 0xee, 0xae, 0xb8, 0xe2, 0x7c, 0x79, 0x03, 0xfc, 0x1c, 0x34, 0x23, 0x8a, 0x02, 0x2c, 0x14,
+	0x8b, 0x49, 0xa8, 0xb6, 0xb8, 0xfd, 0x9f, 0x2d, 0x7e, 0xa2, 0xde, 0x16, 0xa7, 0x2e, 0x6e, 0x7c,
+	0xf9, 0x46, 0x6c, 0x81, 0x0c, 0x1c, 0xcb, 0x38, 0xf8, 0x01, 0x00, 0x2c, 0x40, 0xa9, 0x1a, 0xbb,
+	0x72, 0x96, 0x76, 0x04, 0x22, 0x35, 0x5a, 0xab, 0xf9, 0x27, 0xb1, 0x8b, 0xb7, 0xe4, 0xb3, 0x40,
+	0x73, 0xd5, 0x90, 0x9c, 0x4e, 0xcb, 0xc7, 0xc7, 0x05, 0x4a, 0xe3, 0xa7, 0x74, 0xba, 0x79, 0x66,
+	0xef, 0x6d, 0x9a, 0xd9, 0x0d, 0x7b, 0x53, 0xdd, 0xb0, 0x37, 0x47, 0x35, 0xc1, 0xcd, 0x19, 0xbd,
+	0x5a, 0x18, 0xda, 0xf5, 0xc2, 0xd0, 0x7e, 0x5f, 0x18, 0xda, 0x8f, 0x37, 0x46, 0xe5, 0xfa, 0xc6,
+	0xa8, 0xfc, 0x76, 0x63, 0x54, 0xbe, 0xfb, 0xf0, 0x7f, 0xf5, 0x9d, 0x95, 0x7f, 0x02, 0x52, 0x66,
+	0x7f, 0x4b, 0x6a, 0xf6, 0xf1, 0xbf, 0x03, 0x00, 0x9d, 0xbe, 0x3f, 0x64, 0x20, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -267,6 +455,85 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.MaxMsgsPerTx != that1.MaxMsgsPerTx {
+		return false
+	}
+	if len(this.MsgTypeLimits) != len(that1.MsgTypeLimits) {
+		return false
+	}
+	for i := range this.MsgTypeLimits {
+		if !this.MsgTypeLimits[i].Equal(&that1.MsgTypeLimits[i]) {
+			return false
+		}
+	}
+	if this.PubKeyChangeCost != that1.PubKeyChangeCost {
+		return false
+	}
+	return true
+}
+func (this *ReapParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReapParams)
+	if !ok {
+		that2, ok := that.(ReapParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.DustThreshold) != len(that1.DustThreshold) {
+		return false
+	}
+	for i := range this.DustThreshold {
+		if !this.DustThreshold[i].Equal(&that1.DustThreshold[i]) {
+			return false
+		}
+	}
+	if this.GracePeriod != that1.GracePeriod {
+		return false
+	}
+	if this.ScanLimit != that1.ScanLimit {
+		return false
+	}
+	return true
+}
+func (this *MsgTypeLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgTypeLimits)
+	if !ok {
+		that2, ok := that.(MsgTypeLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	if this.TxSizeCostPerByte != that1.TxSizeCostPerByte {
+		return false
+	}
+	if this.MaxMemoCharacters != that1.MaxMemoCharacters {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -392,6 +659,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PubKeyChangeCost != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.PubKeyChangeCost))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.MsgTypeLimits) > 0 {
+		for iNdEx := len(m.MsgTypeLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgTypeLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MaxMsgsPerTx != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MaxMsgsPerTx))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -420,6 +711,96 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReapParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReapParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReapParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScanLimit != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.ScanLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.GracePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.GracePeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuth(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.DustThreshold) > 0 {
+		for iNdEx := len(m.DustThreshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DustThreshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTypeLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTypeLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTypeLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxMemoCharacters != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MaxMemoCharacters))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TxSizeCostPerByte != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.TxSizeCostPerByte))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -498,6 +879,57 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.MaxMsgsPerTx != 0 {
+		n += 1 + sovAuth(uint64(m.MaxMsgsPerTx))
+	}
+	if len(m.MsgTypeLimits) > 0 {
+		for _, e := range m.MsgTypeLimits {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.PubKeyChangeCost != 0 {
+		n += 1 + sovAuth(uint64(m.PubKeyChangeCost))
+	}
+	return n
+}
+
+func (m *ReapParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DustThreshold) > 0 {
+		for _, e := range m.DustThreshold {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.GracePeriod)
+	n += 1 + l + sovAuth(uint64(l))
+	if m.ScanLimit != 0 {
+		n += 1 + sovAuth(uint64(m.ScanLimit))
+	}
+	return n
+}
+
+func (m *MsgTypeLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.TxSizeCostPerByte != 0 {
+		n += 1 + sovAuth(uint64(m.TxSizeCostPerByte))
+	}
+	if m.MaxMemoCharacters != 0 {
+		n += 1 + sovAuth(uint64(m.MaxMemoCharacters))
+	}
 	return n
 }
 
@@ -937,6 +1369,334 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgsPerTx", wireType)
+			}
+			m.MaxMsgsPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgsPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeLimits = append(m.MsgTypeLimits, MsgTypeLimits{})
+			if err := m.MsgTypeLimits[len(m.MsgTypeLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyChangeCost", wireType)
+			}
+			m.PubKeyChangeCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PubKeyChangeCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReapParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReapParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReapParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustThreshold = append(m.DustThreshold, types1.Coin{})
+			if err := m.DustThreshold[len(m.DustThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.GracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanLimit", wireType)
+			}
+			m.ScanLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScanLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTypeLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTypeLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTypeLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+			}
+			m.TxSizeCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSizeCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+			}
+			m.MaxMemoCharacters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoCharacters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
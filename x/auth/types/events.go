@@ -0,0 +1,12 @@
+package types
+
+// auth module event types
+const (
+	EventTypeChangePubKey = "change_pub_key"
+	EventTypeReapAccount  = "reap_account"
+
+	AttributeKeyAddress   = "address"
+	AttributeKeyOldPubKey = "old_pub_key"
+	AttributeKeyNewPubKey = "new_pub_key"
+	AttributeKeyAmount    = "amount"
+)
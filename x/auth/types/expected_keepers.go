@@ -1,5 +1,7 @@
 package types
 
+//go:generate go run github.com/golang/mock/mockgen -source=expected_keepers.go -package testutil -destination ../testutil/expected_keepers_mocks.go
+
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -9,3 +11,15 @@ type BankKeeper interface {
 	SendCoins(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
+
+// ReapBankKeeper defines the bank keeper methods needed to reap the dust
+// accounts (noalias)
+type ReapBankKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// DistributionKeeper defines the distribution keeper methods needed to roll the
+// balances of the reaped accounts into the community pool (noalias)
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
@@ -43,7 +43,9 @@ func (g GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), GenesisAccounts{})
+	genState := NewGenesisState(DefaultParams(), GenesisAccounts{})
+	genState.ReapParams = DefaultReapParams()
+	return genState
 }
 
 // GetGenesisStateFromAppState returns x/auth GenesisState given raw application
@@ -64,6 +66,9 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
+	if err := data.ReapParams.Validate(); err != nil {
+		return err
+	}
 
 	genAccs, err := UnpackAccounts(data.Accounts)
 	if err != nil {
@@ -30,6 +30,8 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// reap_params defines the parameters of the reaping of the dust accounts.
+	ReapParams ReapParams `protobuf:"bytes,3,opt,name=reap_params,json=reapParams,proto3" json:"reap_params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +81,13 @@ func (m *GenesisState) GetAccounts() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetReapParams() ReapParams {
+	if m != nil {
+		return m.ReapParams
+	}
+	return ReapParams{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
 }
@@ -86,23 +95,25 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x82, 0xe5, 0x95,
-	0xaa, 0xb9, 0x78, 0xdc, 0x21, 0xd6, 0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x59, 0x72, 0xb1, 0x15,
-	0x24, 0x16, 0x25, 0xe6, 0x16, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x49, 0xeb, 0x61, 0x71,
-	0x86, 0x5e, 0x00, 0x58, 0x89, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x0d, 0x42, 0x06,
-	0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc,
-	0x46, 0x22, 0x7a, 0x10, 0xe7, 0xea, 0xc1, 0x9c, 0xab, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57, 0xe5,
-	0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
-	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99, 0x25,
-	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x1f, 0x40, 0x28, 0xdd, 0xe2, 0x94, 0x6c,
-	0xfd, 0x0a, 0x88, 0x77, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x86, 0x1b, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xac, 0xe2, 0xe7, 0xb6, 0x53, 0x01, 0x00, 0x00,
+	// 276 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
+	0x14, 0x45, 0x63, 0x8a, 0x2a, 0xe4, 0x30, 0x85, 0x0e, 0xa5, 0x48, 0x6e, 0x61, 0x2a, 0x03, 0x36,
+	0x2d, 0x13, 0x23, 0x20, 0xc1, 0x8a, 0xc2, 0xc6, 0x82, 0x9c, 0x60, 0xdc, 0x0a, 0x92, 0x67, 0xc5,
+	0x0e, 0x22, 0x7f, 0xc1, 0x3f, 0xb1, 0x74, 0xec, 0xc8, 0x84, 0x50, 0xf2, 0x23, 0x28, 0xb6, 0x01,
+	0x55, 0xca, 0xe4, 0xa7, 0xe7, 0x63, 0x9f, 0xab, 0x8b, 0x0f, 0x53, 0xd0, 0x19, 0x68, 0xc6, 0x4b,
+	0xb3, 0x60, 0xaf, 0xb3, 0x44, 0x18, 0x3e, 0x63, 0x52, 0xe4, 0x42, 0x2f, 0x35, 0x55, 0x05, 0x18,
+	0x88, 0xf6, 0x1c, 0x42, 0x5b, 0x84, 0x7a, 0x64, 0xb4, 0x2f, 0x01, 0xe4, 0x8b, 0x60, 0x16, 0x49,
+	0xca, 0x27, 0xc6, 0xf3, 0xca, 0xf1, 0xa3, 0x81, 0x04, 0x09, 0x76, 0x64, 0xed, 0xe4, 0xb7, 0xa4,
+	0x4b, 0x64, 0xbf, 0xb4, 0xf7, 0x47, 0x1f, 0x08, 0xef, 0xde, 0x38, 0xef, 0x9d, 0xe1, 0x46, 0x44,
+	0xe7, 0xb8, 0xaf, 0x78, 0xc1, 0x33, 0x3d, 0x44, 0x13, 0x34, 0x0d, 0xe7, 0x07, 0xb4, 0x23, 0x07,
+	0xbd, 0xb5, 0xc8, 0xe5, 0xf6, 0xea, 0x6b, 0x1c, 0xc4, 0xfe, 0x41, 0x74, 0x8a, 0x77, 0x78, 0x9a,
+	0x42, 0x99, 0x1b, 0x3d, 0xdc, 0x9a, 0xf4, 0xa6, 0xe1, 0x7c, 0x40, 0x5d, 0x5e, 0xfa, 0x9b, 0x97,
+	0x5e, 0xe4, 0x55, 0xfc, 0x47, 0x45, 0xd7, 0x38, 0x2c, 0x04, 0x57, 0x0f, 0xde, 0xd8, 0xb3, 0xc6,
+	0x71, 0xa7, 0x31, 0x16, 0x5c, 0x6d, 0x58, 0x71, 0xf1, 0xbf, 0xb9, 0x5a, 0xd5, 0x04, 0xad, 0x6b,
+	0x82, 0xbe, 0x6b, 0x82, 0xde, 0x1b, 0x12, 0xac, 0x1b, 0x12, 0x7c, 0x36, 0x24, 0xb8, 0x3f, 0x96,
+	0x4b, 0xb3, 0x28, 0x13, 0x9a, 0x42, 0xc6, 0x7c, 0x15, 0xee, 0x38, 0xd1, 0x8f, 0xcf, 0xec, 0xcd,
+	0xf5, 0x62, 0x2a, 0x25, 0x74, 0xd2, 0xb7, 0x21, 0xcf, 0x7e, 0x06, 0x00, 0xaa, 0x47, 0x59, 0x6f,
+	0x9c, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +136,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ReapParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -177,6 +198,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.ReapParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -282,6 +305,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReapParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReapParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
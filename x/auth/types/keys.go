@@ -16,6 +16,9 @@ const (
 
 	// QuerierRoute is the querier route for auth
 	QuerierRoute = ModuleName
+
+	// RouterKey is the message route for auth
+	RouterKey = ModuleName
 )
 
 var (
@@ -24,9 +27,22 @@ var (
 
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
+
+	// ReapCandidatePrefix prefix for the time the dust accounts were first
+	// found dust, by address
+	ReapCandidatePrefix = []byte{0x02}
+
+	// ReapCursorKey key for the address of the last account checked for reaping
+	ReapCursorKey = []byte{0x03}
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// ReapCandidateKey returns the key of the time the dust account addr was
+// first found dust.
+func ReapCandidateKey(addr sdk.AccAddress) []byte {
+	return append(ReapCandidatePrefix, addr.Bytes()...)
+}
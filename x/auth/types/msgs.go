@@ -0,0 +1,82 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+// TypeMsgChangePubKey defines the type value for a MsgChangePubKey.
+const TypeMsgChangePubKey = "change_pub_key"
+
+var (
+	_ sdk.Msg                            = &MsgChangePubKey{}
+	_ legacytx.LegacyMsg                 = &MsgChangePubKey{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgChangePubKey)(nil)
+)
+
+// NewMsgChangePubKey creates a new MsgChangePubKey instance.
+//nolint:interfacer
+func NewMsgChangePubKey(address sdk.AccAddress, pubKey cryptotypes.PubKey) (*MsgChangePubKey, error) {
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgChangePubKey{
+		Address: address.String(),
+		PubKey:  pkAny,
+	}, nil
+}
+
+// Route implements the legacytx.LegacyMsg interface.
+func (msg MsgChangePubKey) Route() string { return RouterKey }
+
+// Type implements the legacytx.LegacyMsg interface.
+func (msg MsgChangePubKey) Type() string { return TypeMsgChangePubKey }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgChangePubKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if _, err := msg.GetPubKey(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the legacytx.LegacyMsg interface.
+func (msg MsgChangePubKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgChangePubKey) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// GetPubKey returns the new public key of the account.
+func (msg MsgChangePubKey) GetPubKey() (cryptotypes.PubKey, error) {
+	if msg.PubKey == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "empty public key")
+	}
+
+	pubKey, ok := msg.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "expecting cryptotypes.PubKey, got %T", msg.PubKey.GetCachedValue())
+	}
+
+	return pubKey, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgChangePubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.PubKey, &pubKey)
+}
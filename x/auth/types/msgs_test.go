@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgChangePubKeyValidateBasic(t *testing.T) {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+
+	valid, err := NewMsgChangePubKey(addr, pubKey)
+	require.NoError(t, err)
+	require.NoError(t, valid.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr}, valid.GetSigners())
+
+	invalidAddr := *valid
+	invalidAddr.Address = "invalid"
+	require.Error(t, invalidAddr.ValidateBasic())
+
+	noPubKey := *valid
+	noPubKey.PubKey = nil
+	require.Error(t, noPubKey.ValidateBasic())
+
+	notPubKey, err := codectypes.NewAnyWithValue(&BaseAccount{})
+	require.NoError(t, err)
+	invalidPubKey := *valid
+	invalidPubKey.PubKey = notPubKey
+	require.Error(t, invalidPubKey.ValidateBasic())
+}
@@ -5,6 +5,7 @@ import (
 
 	"sigs.k8s.io/yaml"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -15,6 +16,7 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+	DefaultPubKeyChangeCost       uint64 = 5000
 )
 
 // Parameter keys
@@ -24,6 +26,10 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyMaxMsgsPerTx           = []byte("MaxMsgsPerTx")
+	KeyMsgTypeLimits          = []byte("MsgTypeLimits")
+	KeyPubKeyChangeCost       = []byte("PubKeyChangeCost")
+	KeyReapParams             = []byte("ReapParams")
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -41,9 +47,12 @@ func NewParams(
 	}
 }
 
-// ParamKeyTable for auth module
+// ParamKeyTable for auth module, the ReapParams being stored under their own
+// key apart from the Params.
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+	return paramtypes.NewKeyTable(
+		paramtypes.NewParamSetPair(KeyReapParams, ReapParams{}, validateReapParams),
+	).RegisterParamSet(&Params{})
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
@@ -55,6 +64,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyTxSizeCostPerByte, &p.TxSizeCostPerByte, validateTxSizeCostPerByte),
 		paramtypes.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validateSigVerifyCostED25519),
 		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1),
+		paramtypes.NewParamSetPair(KeyMaxMsgsPerTx, &p.MaxMsgsPerTx, validateMaxMsgsPerTx),
+		paramtypes.NewParamSetPair(KeyMsgTypeLimits, &p.MsgTypeLimits, validateMsgTypeLimits),
+		paramtypes.NewParamSetPair(KeyPubKeyChangeCost, &p.PubKeyChangeCost, validatePubKeyChangeCost),
 	}
 }
 
@@ -66,6 +78,7 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		PubKeyChangeCost:       DefaultPubKeyChangeCost,
 	}
 }
 
@@ -79,6 +92,62 @@ func (p Params) SigVerifyCostSecp256r1() uint64 {
 	return p.SigVerifyCostSecp256k1 / 2
 }
 
+// TxSizeCostPerByteFor returns the tx size cost per byte of a tx holding msgs,
+// the highest one of the types of msgs.
+func (p Params) TxSizeCostPerByteFor(msgs []sdk.Msg) uint64 {
+	cost := uint64(0)
+	for _, limits := range p.msgTypeLimitsFor(msgs) {
+		if limits.TxSizeCostPerByte > cost {
+			cost = limits.TxSizeCostPerByte
+		}
+	}
+	if cost == 0 {
+		return p.TxSizeCostPerByte
+	}
+
+	return cost
+}
+
+// MaxMemoCharactersFor returns the max memo characters of a tx holding msgs,
+// the lowest one of the types of msgs.
+func (p Params) MaxMemoCharactersFor(msgs []sdk.Msg) uint64 {
+	max := p.MaxMemoCharacters
+	for _, limits := range p.msgTypeLimitsFor(msgs) {
+		if limits.MaxMemoCharacters < max {
+			max = limits.MaxMemoCharacters
+		}
+	}
+
+	return max
+}
+
+// msgTypeLimitsFor returns the limits of each type of msgs, the zero limits
+// and the ones of the types without overrides being the default ones.
+func (p Params) msgTypeLimitsFor(msgs []sdk.Msg) []MsgTypeLimits {
+	if len(p.MsgTypeLimits) == 0 {
+		return nil
+	}
+
+	overrides := make(map[string]MsgTypeLimits, len(p.MsgTypeLimits))
+	for _, limits := range p.MsgTypeLimits {
+		overrides[limits.MsgTypeUrl] = limits
+	}
+
+	limits := make([]MsgTypeLimits, 0, len(msgs))
+	for _, msg := range msgs {
+		l := overrides[sdk.MsgTypeURL(msg)]
+		if l.TxSizeCostPerByte == 0 {
+			l.TxSizeCostPerByte = p.TxSizeCostPerByte
+		}
+		if l.MaxMemoCharacters == 0 {
+			l.MaxMemoCharacters = p.MaxMemoCharacters
+		}
+		limits = append(limits, l)
+	}
+
+	return limits
+}
+
 // String implements the stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -150,6 +219,42 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validateMaxMsgsPerTx(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMsgTypeLimits(i interface{}) error {
+	v, ok := i.([]MsgTypeLimits)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, limits := range v {
+		if limits.MsgTypeUrl == "" {
+			return fmt.Errorf("invalid msg type limits: empty msg type url")
+		}
+		if seen[limits.MsgTypeUrl] {
+			return fmt.Errorf("duplicate msg type limits for %s", limits.MsgTypeUrl)
+		}
+		seen[limits.MsgTypeUrl] = true
+	}
+
+	return nil
+}
+
+func validatePubKeyChangeCost(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -167,6 +272,15 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := validateMaxMsgsPerTx(p.MaxMsgsPerTx); err != nil {
+		return err
+	}
+	if err := validateMsgTypeLimits(p.MsgTypeLimits); err != nil {
+		return err
+	}
+	if err := validatePubKeyChangeCost(p.PubKeyChangeCost); err != nil {
+		return err
+	}
 
 	return nil
 }
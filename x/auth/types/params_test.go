@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -35,6 +37,10 @@ func TestParams_Validate(t *testing.T) {
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx size cost per byte: 0")},
+		{"empty msg type url", withMsgTypeLimits(types.MsgTypeLimits{TxSizeCostPerByte: 20}),
+			fmt.Errorf("invalid msg type limits: empty msg type url")},
+		{"duplicate msg type limits", withMsgTypeLimits(types.MsgTypeLimits{MsgTypeUrl: "/a"}, types.MsgTypeLimits{MsgTypeUrl: "/a"}),
+			fmt.Errorf("duplicate msg type limits for /a")},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -48,3 +54,39 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func withMsgTypeLimits(limits ...types.MsgTypeLimits) types.Params {
+	params := types.DefaultParams()
+	params.MsgTypeLimits = limits
+	return params
+}
+
+func TestParams_MsgTypeLimits(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	testMsg, dogMsg := testdata.NewTestMsg(addr), &testdata.MsgCreateDog{}
+	testMsgLimits := types.MsgTypeLimits{MsgTypeUrl: sdk.MsgTypeURL(testMsg), TxSizeCostPerByte: 30, MaxMemoCharacters: 100}
+	dogMsgLimits := types.MsgTypeLimits{MsgTypeUrl: sdk.MsgTypeURL(dogMsg), TxSizeCostPerByte: 5}
+
+	tests := []struct {
+		name                  string
+		params                types.Params
+		msgs                  []sdk.Msg
+		wantTxSizeCostPerByte uint64
+		wantMaxMemoCharacters uint64
+	}{
+		{"no overrides", types.DefaultParams(), []sdk.Msg{testMsg}, types.DefaultTxSizeCostPerByte, types.DefaultMaxMemoCharacters},
+		{"no msgs", withMsgTypeLimits(testMsgLimits), nil, types.DefaultTxSizeCostPerByte, types.DefaultMaxMemoCharacters},
+		{"overridden msg type", withMsgTypeLimits(testMsgLimits), []sdk.Msg{testMsg}, 30, 100},
+		{"partially overridden msg type", withMsgTypeLimits(dogMsgLimits), []sdk.Msg{dogMsg}, 5, types.DefaultMaxMemoCharacters},
+		{"msg type without overrides", withMsgTypeLimits(testMsgLimits), []sdk.Msg{dogMsg}, types.DefaultTxSizeCostPerByte, types.DefaultMaxMemoCharacters},
+		{"several msg types", withMsgTypeLimits(testMsgLimits, dogMsgLimits), []sdk.Msg{dogMsg, testMsg}, 30, 100},
+		{"lower cost than the default one", withMsgTypeLimits(dogMsgLimits), []sdk.Msg{dogMsg, testMsg}, types.DefaultTxSizeCostPerByte, types.DefaultMaxMemoCharacters},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantTxSizeCostPerByte, tt.params.TxSizeCostPerByteFor(tt.msgs))
+			require.Equal(t, tt.wantMaxMemoCharacters, tt.params.MaxMemoCharactersFor(tt.msgs))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,6 +15,9 @@ const (
 	Staking = "staking"
 )
 
+// DefaultPermissions are the module account permissions known by the SDK.
+var DefaultPermissions = []string{Minter, Burner, Staking}
+
 // PermissionsForAddress defines all the registered permissions for an address
 type PermissionsForAddress struct {
 	permissions []string
@@ -57,3 +61,99 @@ func validatePermissions(permissions ...string) error {
 	}
 	return nil
 }
+
+// ModulePermissions defines the permissions granted to the account of a module.
+type ModulePermissions struct {
+	Module      string
+	Permissions []string
+}
+
+// NewModulePermissions creates a new ModulePermissions object.
+func NewModulePermissions(module string, permissions ...string) ModulePermissions {
+	return ModulePermissions{
+		Module:      module,
+		Permissions: permissions,
+	}
+}
+
+// ModulePermissionsRegistry is a typed registry of the module accounts of an
+// app and their permissions. It replaces the loose map passed to the
+// AccountKeeper and can be validated when the app is wired.
+type ModulePermissionsRegistry struct {
+	modules     []ModulePermissions
+	permissions map[string]bool
+}
+
+// NewModulePermissionsRegistry creates a new ModulePermissionsRegistry which
+// accepts the DefaultPermissions.
+func NewModulePermissionsRegistry(modules ...ModulePermissions) *ModulePermissionsRegistry {
+	r := &ModulePermissionsRegistry{
+		modules:     modules,
+		permissions: make(map[string]bool, len(DefaultPermissions)),
+	}
+	r.RegisterPermissions(DefaultPermissions...)
+
+	return r
+}
+
+// RegisterPermissions adds custom permissions to the ones accepted by the
+// registry.
+func (r *ModulePermissionsRegistry) RegisterPermissions(permissions ...string) {
+	for _, perm := range permissions {
+		r.permissions[perm] = true
+	}
+}
+
+// Modules returns the registered module accounts in registration order.
+func (r *ModulePermissionsRegistry) Modules() []ModulePermissions {
+	return r.modules
+}
+
+// Map returns the registered module accounts as the map expected by
+// NewAccountKeeper.
+func (r *ModulePermissionsRegistry) Map() map[string][]string {
+	m := make(map[string][]string, len(r.modules))
+	for _, mp := range r.modules {
+		m[mp.Module] = mp.Permissions
+	}
+
+	return m
+}
+
+// Validate checks that every module account is registered only once, has a
+// valid name and is only granted known permissions. The modules in required
+// must have a registered module account.
+func (r *ModulePermissionsRegistry) Validate(required ...string) error {
+	seen := make(map[string]bool, len(r.modules))
+	for _, mp := range r.modules {
+		if strings.TrimSpace(mp.Module) == "" {
+			return fmt.Errorf("module account name cannot be empty")
+		}
+		if seen[mp.Module] {
+			return fmt.Errorf("duplicate module account %s", mp.Module)
+		}
+		seen[mp.Module] = true
+
+		if err := validatePermissions(mp.Permissions...); err != nil {
+			return fmt.Errorf("module account %s: %w", mp.Module, err)
+		}
+		for _, perm := range mp.Permissions {
+			if !r.permissions[perm] {
+				return fmt.Errorf("module account %s: unknown permission %s", mp.Module, perm)
+			}
+		}
+	}
+
+	var missing []string
+	for _, module := range required {
+		if !seen[module] {
+			missing = append(missing, module)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("missing module accounts: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
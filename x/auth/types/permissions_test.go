@@ -53,3 +53,44 @@ func TestValidatePermissions(t *testing.T) {
 		})
 	}
 }
+
+func TestModulePermissionsRegistryValidate(t *testing.T) {
+	cases := []struct {
+		name       string
+		modules    []ModulePermissions
+		custom     []string
+		required   []string
+		expectPass bool
+	}{
+		{"no modules", nil, nil, nil, true},
+		{"valid modules", []ModulePermissions{NewModulePermissions("fee"), NewModulePermissions("mint", Minter)}, nil, []string{"mint"}, true},
+		{"empty module name", []ModulePermissions{NewModulePermissions(" ")}, nil, nil, false},
+		{"duplicate module", []ModulePermissions{NewModulePermissions("mint", Minter), NewModulePermissions("mint")}, nil, nil, false},
+		{"empty permission", []ModulePermissions{NewModulePermissions("mint", "")}, nil, nil, false},
+		{"unknown permission", []ModulePermissions{NewModulePermissions("mint", "random")}, nil, nil, false},
+		{"custom permission", []ModulePermissions{NewModulePermissions("mint", "random")}, []string{"random"}, nil, true},
+		{"missing module account", []ModulePermissions{NewModulePermissions("mint", Minter)}, nil, []string{"mint", "gov"}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			r := NewModulePermissionsRegistry(tc.modules...)
+			r.RegisterPermissions(tc.custom...)
+			err := r.Validate(tc.required...)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestModulePermissionsRegistryMap(t *testing.T) {
+	r := NewModulePermissionsRegistry(NewModulePermissions("fee"), NewModulePermissions("staking", Burner, Staking))
+	require.Equal(t, map[string][]string{
+		"fee":     nil,
+		"staking": {Burner, Staking},
+	}, r.Map())
+}
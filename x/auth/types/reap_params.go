@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Default reap parameter values
+const (
+	DefaultReapGracePeriod        = 30 * 24 * time.Hour
+	DefaultReapScanLimit   uint64 = 100
+)
+
+// NewReapParams creates a new ReapParams object
+func NewReapParams(dustThreshold sdk.Coins, gracePeriod time.Duration, scanLimit uint64) ReapParams {
+	return ReapParams{
+		DustThreshold: dustThreshold,
+		GracePeriod:   gracePeriod,
+		ScanLimit:     scanLimit,
+	}
+}
+
+// DefaultReapParams returns the default reap parameters, the dust accounts not
+// being reaped until a dust threshold is set.
+func DefaultReapParams() ReapParams {
+	return NewReapParams(nil, DefaultReapGracePeriod, DefaultReapScanLimit)
+}
+
+// Enabled returns true if the dust accounts are reaped.
+func (p ReapParams) Enabled() bool {
+	return !p.DustThreshold.Empty() && p.ScanLimit > 0
+}
+
+// IsDust returns true if balances are dust, i.e. there is at least one balance
+// and each of them is below the dust threshold of its denom. No balance is not
+// dust, as the accounts without balance may hold funds elsewhere, e.g. their
+// delegations or their rewards withdrawn to another address.
+func (p ReapParams) IsDust(balances sdk.Coins) bool {
+	if balances.Empty() {
+		return false
+	}
+
+	for _, balance := range balances {
+		if !balance.Amount.LT(p.DustThreshold.AmountOf(balance.Denom)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String implements the stringer interface.
+func (p ReapParams) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate checks that the reap parameters have valid values.
+func (p ReapParams) Validate() error {
+	if err := p.DustThreshold.Validate(); err != nil {
+		return fmt.Errorf("invalid reap dust threshold: %w", err)
+	}
+
+	if p.GracePeriod < 0 {
+		return fmt.Errorf("reap grace period must not be negative: %s", p.GracePeriod)
+	}
+
+	return nil
+}
+
+func validateReapParams(i interface{}) error {
+	v, ok := i.(ReapParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestReapParams_Validate(t *testing.T) {
+	threshold := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	tests := []struct {
+		name    string
+		params  types.ReapParams
+		wantErr error
+	}{
+		{"default params", types.DefaultReapParams(), nil},
+		{"zero params", types.ReapParams{}, nil},
+		{"enabled", types.NewReapParams(threshold, time.Hour, 10), nil},
+		{"negative grace period", types.NewReapParams(threshold, -time.Second, 10),
+			fmt.Errorf("reap grace period must not be negative: -1s")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.params.Validate()
+			if tt.wantErr == nil {
+				require.NoError(t, got)
+				return
+			}
+			require.Equal(t, tt.wantErr, got)
+		})
+	}
+
+	invalidThreshold := types.NewReapParams(sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}, time.Hour, 10)
+	require.Error(t, invalidThreshold.Validate())
+}
+
+func TestReapParams_IsDust(t *testing.T) {
+	params := types.NewReapParams(sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 10)), time.Hour, 10)
+	require.True(t, params.Enabled())
+	require.False(t, types.DefaultReapParams().Enabled())
+
+	tests := []struct {
+		name     string
+		balances sdk.Coins
+		wantDust bool
+	}{
+		{"no balance", sdk.NewCoins(), false},
+		{"below the threshold", sdk.NewCoins(sdk.NewInt64Coin("stake", 9)), true},
+		{"all below the threshold", sdk.NewCoins(sdk.NewInt64Coin("atom", 4), sdk.NewInt64Coin("stake", 9)), true},
+		{"at the threshold", sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), false},
+		{"one above the threshold", sdk.NewCoins(sdk.NewInt64Coin("atom", 6), sdk.NewInt64Coin("stake", 9)), false},
+		{"denom without threshold", sdk.NewCoins(sdk.NewInt64Coin("photon", 1)), false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantDust, params.IsDust(tt.balances))
+		})
+	}
+}
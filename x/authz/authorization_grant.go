@@ -58,5 +58,10 @@ func (g Grant) ValidateBasic() error {
 	if !ok {
 		return sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (Authorization)(nil), av)
 	}
+	if g.Limits != nil {
+		if err := g.Limits.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	return a.ValidateBasic()
 }
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -29,6 +30,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryGrants(),
 		GetQueryGranterGrants(),
 		GetQueryGranteeGrants(),
+		GetQueryExpiringGrants(),
+		GetCmdQueryGrantLimits(),
 	)
 
 	return authorizationQueryCmd
@@ -98,13 +101,14 @@ func GetQueryGranterGrants() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "granter-grants [granter-addr]",
 		Args:  cobra.ExactArgs(1),
-		Short: "query authorization grants granted by granter",
+		Short: "query authorization grants granted by granter, optionally filtered by msg type",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query authorization grants granted by granter.
 Examples:
 $ %s q %s granter-grants cosmos1skj..
+$ %s q %s granter-grants cosmos1skj.. --msg-type=/cosmos.bank.v1beta1.MsgSend
 `,
-				version.AppName, authz.ModuleName),
+				version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -122,11 +126,17 @@ $ %s q %s granter-grants cosmos1skj..
 				return err
 			}
 
+			msgType, err := cmd.Flags().GetString(FlagMsgType)
+			if err != nil {
+				return err
+			}
+
 			queryClient := authz.NewQueryClient(clientCtx)
 			res, err := queryClient.GranterGrants(
 				cmd.Context(),
 				&authz.QueryGranterGrantsRequest{
 					Granter:    granter.String(),
+					MsgTypeUrl: msgType,
 					Pagination: pageReq,
 				},
 			)
@@ -139,6 +149,7 @@ $ %s q %s granter-grants cosmos1skj..
 	}
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "granter-grants")
+	cmd.Flags().String(FlagMsgType, "", "only list the grants of the given Msg type URL")
 	return cmd
 }
 
@@ -146,13 +157,14 @@ func GetQueryGranteeGrants() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "grantee-grants [grantee-addr]",
 		Args:  cobra.ExactArgs(1),
-		Short: "query authorization grants granted to a grantee",
+		Short: "query authorization grants granted to a grantee, optionally filtered by msg type",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query authorization grants granted to a grantee.
 Examples:
 $ %s q %s grantee-grants cosmos1skj..
+$ %s q %s grantee-grants cosmos1skj.. --msg-type=/cosmos.bank.v1beta1.MsgSend
 `,
-				version.AppName, authz.ModuleName),
+				version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -170,11 +182,17 @@ $ %s q %s grantee-grants cosmos1skj..
 				return err
 			}
 
+			msgType, err := cmd.Flags().GetString(FlagMsgType)
+			if err != nil {
+				return err
+			}
+
 			queryClient := authz.NewQueryClient(clientCtx)
 			res, err := queryClient.GranteeGrants(
 				cmd.Context(),
 				&authz.QueryGranteeGrantsRequest{
 					Grantee:    grantee.String(),
+					MsgTypeUrl: msgType,
 					Pagination: pageReq,
 				},
 			)
@@ -187,5 +205,110 @@ $ %s q %s grantee-grants cosmos1skj..
 	}
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "grantee-grants")
+	cmd.Flags().String(FlagMsgType, "", "only list the grants of the given Msg type URL")
+	return cmd
+}
+
+func GetQueryExpiringGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expiring-grants [start-time] [end-time]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query authorization grants expiring within a time window",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query authorization grants expiring within a time window, ordered by expiration time.
+Both bounds are inclusive and must be RFC3339 timestamps.
+Examples:
+$ %s q %s expiring-grants 2022-01-01T00:00:00Z 2022-02-01T00:00:00Z
+`,
+				version.AppName, authz.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			start, err := time.Parse(time.RFC3339, args[0])
+			if err != nil {
+				return err
+			}
+
+			end, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := authz.NewQueryClient(clientCtx)
+			res, err := queryClient.ExpiringGrants(
+				cmd.Context(),
+				&authz.QueryExpiringGrantsRequest{
+					Start:      start,
+					End:        end,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "expiring-grants")
+	return cmd
+}
+
+// GetCmdQueryGrantLimits implements the query grant limits command.
+func GetCmdQueryGrantLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-limits [granter-addr] [grantee-addr] [msg-type-url]",
+		Args:  cobra.ExactArgs(3),
+		Short: "query the limits of a grant, along with the executions and coins it still allows",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the limits of a grant, along with the executions and coins it still allows.
+Examples:
+$ %s q %s grant-limits cosmos1skjw.. cosmos1skjwj.. %s
+`,
+				version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := authz.NewQueryClient(clientCtx)
+			res, err := queryClient.GrantLimits(
+				cmd.Context(),
+				&authz.QueryGrantLimitsRequest{
+					Granter:    granter.String(),
+					Grantee:    grantee.String(),
+					MsgTypeUrl: args[2],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -26,6 +26,10 @@ const (
 	FlagExpiration        = "expiration"
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
+	FlagMaxExecutions     = "max-executions"
+	FlagExecutionPeriod   = "execution-period"
+	FlagGrantSpendLimit   = "grant-spend-limit"
+	FlagAmountField       = "amount-field"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -61,7 +65,8 @@ func NewCmdGrantAuthorization() *cobra.Command {
 Examples:
  $ %s tx %s grant cosmos1skjw.. send %s --spend-limit=1000stake --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.bank.v1beta1.MsgSend --max-executions=10 --execution-period=24h --grant-spend-limit=1000stake --from=cosmos1sk..
+	`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -165,6 +170,11 @@ Examples:
 				return err
 			}
 
+			msg.Grant.Limits, err = getGrantLimits(cmd)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -174,9 +184,57 @@ Examples:
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
 	cmd.Flags().Int64(FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
+	cmd.Flags().Uint64(FlagMaxExecutions, 0, "Maximum number of executions of the grant, per execution period if set. Set zero (0) for no limit.")
+	cmd.Flags().Duration(FlagExecutionPeriod, 0, "Period after which the executions counter of the grant is reset, e.g. 24h")
+	cmd.Flags().String(FlagGrantSpendLimit, "", "Maximum cumulative amount of coins which can be spent through the grant")
+	cmd.Flags().String(FlagAmountField, "", "Msg field holding the spent coins, used with --grant-spend-limit. Default is amount.")
 	return cmd
 }
 
+// getGrantLimits returns the grant limits set by the flags, or nil if none
+// is set.
+func getGrantLimits(cmd *cobra.Command) (*authz.GrantLimits, error) {
+	maxExecutions, err := cmd.Flags().GetUint64(FlagMaxExecutions)
+	if err != nil {
+		return nil, err
+	}
+
+	period, err := cmd.Flags().GetDuration(FlagExecutionPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := cmd.Flags().GetString(FlagGrantSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	amountField, err := cmd.Flags().GetString(FlagAmountField)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxExecutions == 0 && period == 0 && limit == "" {
+		return nil, nil
+	}
+
+	spendLimit, err := sdk.ParseCoinsNormalized(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := &authz.GrantLimits{
+		MaxExecutions: maxExecutions,
+		SpendLimit:    spendLimit,
+		AmountField:   amountField,
+	}
+	if period != 0 {
+		limits.Period = &period
+	}
+
+	return limits, nil
+}
+
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	exp, err := cmd.Flags().GetInt64(FlagExpiration)
 	if err != nil {
@@ -0,0 +1,216 @@
+package authz
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ Authorization = &ConstrainedAuthorization{}
+)
+
+// gasCostPerMsgByte is the gas charged per byte of the JSON representation of
+// the Msgs evaluated against the constraints.
+const gasCostPerMsgByte = uint64(3)
+
+// NewConstrainedAuthorization creates a new ConstrainedAuthorization object.
+func NewConstrainedAuthorization(msgTypeURL string, constraints ...FieldConstraint) *ConstrainedAuthorization {
+	return &ConstrainedAuthorization{
+		Msg:         msgTypeURL,
+		Constraints: constraints,
+	}
+}
+
+// NewAllowedValuesConstraint creates a FieldConstraint restricting field to the
+// given values.
+func NewAllowedValuesConstraint(field string, values ...string) FieldConstraint {
+	return FieldConstraint{Field: field, AllowedValues: values}
+}
+
+// NewMaxAmountConstraint creates a FieldConstraint capping the coins held by
+// field.
+func NewMaxAmountConstraint(field string, maxAmount sdk.Coins) FieldConstraint {
+	return FieldConstraint{Field: field, MaxAmount: maxAmount}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ConstrainedAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept. The Msg is accepted only if it
+// satisfies every field constraint.
+func (a ConstrainedAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.Msg {
+		return AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("expected %s, got %s", a.Msg, sdk.MsgTypeURL(msg))
+	}
+
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot evaluate constraints: %s", err)
+	}
+	ctx.GasMeter().ConsumeGas(gasCostPerMsgByte*uint64(len(bz)), "constrained authorization")
+
+	fields, err := decodeMsgFields(bz)
+	if err != nil {
+		return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot evaluate constraints: %s", err)
+	}
+
+	for _, c := range a.Constraints {
+		if err := c.check(fields); err != nil {
+			return AcceptResponse{}, err
+		}
+	}
+
+	return AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ConstrainedAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return sdkerrors.ErrInvalidType.Wrap("msg type URL cannot be empty")
+	}
+	if len(a.Constraints) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("at least one field constraint is required, use GenericAuthorization otherwise")
+	}
+
+	seen := make(map[string]bool, len(a.Constraints))
+	for _, c := range a.Constraints {
+		if err := c.ValidateBasic(); err != nil {
+			return err
+		}
+		if seen[c.Field] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate constraint on field %s", c.Field)
+		}
+		seen[c.Field] = true
+	}
+
+	return nil
+}
+
+// ValidateBasic performs a stateless validation of the constraint.
+func (c FieldConstraint) ValidateBasic() error {
+	if c.Field == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("constraint field cannot be empty")
+	}
+	for _, part := range strings.Split(c.Field, ".") {
+		if part == "" {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid constraint field path %s", c.Field)
+		}
+	}
+	if len(c.AllowedValues) == 0 && c.MaxAmount.Empty() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("constraint on field %s must set allowed values or a max amount", c.Field)
+	}
+	if !c.MaxAmount.Empty() && !c.MaxAmount.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid max amount for field %s: %s", c.Field, c.MaxAmount)
+	}
+
+	return nil
+}
+
+// check evaluates the constraint against the proto JSON representation of a
+// Msg.
+func (c FieldConstraint) check(fields map[string]interface{}) error {
+	value, err := lookupField(fields, c.Field)
+	if err != nil {
+		return err
+	}
+
+	if len(c.AllowedValues) > 0 {
+		values, ok := value.([]interface{})
+		if !ok {
+			values = []interface{}{value}
+		}
+		for _, v := range values {
+			if !c.isAllowed(v) {
+				return sdkerrors.ErrUnauthorized.Wrapf("value %v of field %s is not allowed", v, c.Field)
+			}
+		}
+	}
+
+	if !c.MaxAmount.Empty() {
+		amount, err := coinsFromJSONValue(value)
+		if err != nil {
+			return sdkerrors.ErrUnauthorized.Wrapf("field %s: %s", c.Field, err)
+		}
+		if !amount.IsAllLTE(c.MaxAmount) {
+			return sdkerrors.ErrUnauthorized.Wrapf("amount %s of field %s exceeds the max amount %s", amount, c.Field, c.MaxAmount)
+		}
+	}
+
+	return nil
+}
+
+// isAllowed returns whether v is one of the allowed values, the numbers being
+// compared as they are written in the JSON representation of the Msg.
+func (c FieldConstraint) isAllowed(v interface{}) bool {
+	s := fmt.Sprint(v)
+	for _, allowed := range c.AllowedValues {
+		if s == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeMsgFields decodes the JSON representation of a Msg. The numbers are
+// decoded as json.Number rather than float64, so that they keep their exact
+// value, e.g. 1000000000000000000000 is not written 1e+21.
+func decodeMsgFields(bz []byte) (map[string]interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	var fields map[string]interface{}
+	if err := dec.Decode(&fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// lookupField walks the dot separated path through the JSON representation of
+// a Msg.
+func lookupField(fields map[string]interface{}, path string) (interface{}, error) {
+	var value interface{} = fields
+	for _, part := range strings.Split(path, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("field %s not found in msg", path)
+		}
+		value, ok = m[part]
+		if !ok {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("field %s not found in msg", path)
+		}
+	}
+	return value, nil
+}
+
+// coinsFromJSONValue converts a JSON encoded Coin or list of Coins into
+// sdk.Coins.
+func coinsFromJSONValue(value interface{}) (sdk.Coins, error) {
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := value.([]interface{}); !ok {
+		bz = append(append([]byte{'['}, bz...), ']')
+	}
+
+	var coins sdk.Coins
+	if err := json.Unmarshal(bz, &coins); err != nil {
+		return nil, fmt.Errorf("not a coin amount: %w", err)
+	}
+
+	coins = coins.Sort()
+	if err := coins.Validate(); err != nil {
+		return nil, err
+	}
+
+	return coins, nil
+}
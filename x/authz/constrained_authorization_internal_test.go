@@ -0,0 +1,16 @@
+package authz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldConstraintExactNumbers(t *testing.T) {
+	fields, err := decodeMsgFields([]byte(`{"n":1000000000000000000001,"f":0.1}`))
+	require.NoError(t, err)
+
+	require.NoError(t, NewAllowedValuesConstraint("n", "1000000000000000000001").check(fields))
+	require.Error(t, NewAllowedValuesConstraint("n", "1e+21").check(fields))
+	require.NoError(t, NewAllowedValuesConstraint("f", "0.1").check(fields))
+}
@@ -0,0 +1,93 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestConstrainedAuthorization(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	from, to, other := sdk.AccAddress("from"), sdk.AccAddress("to"), sdk.AccAddress("other")
+	msgTypeURL := banktypes.SendAuthorization{}.MsgTypeURL()
+
+	a := authz.NewConstrainedAuthorization(
+		msgTypeURL,
+		authz.NewAllowedValuesConstraint("to_address", to.String()),
+		authz.NewMaxAmountConstraint("amount", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+	)
+	require.NoError(t, a.ValidateBasic())
+	require.Equal(t, msgTypeURL, a.MsgTypeURL())
+
+	t.Log("verify a msg satisfying all constraints is accepted, charging gas for its evaluation")
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	resp, err := a.Accept(ctx, banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.NoError(t, err)
+	require.NotZero(t, ctx.GasMeter().GasConsumed())
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	t.Log("verify a msg to a recipient that is not allowed is rejected")
+	_, err = a.Accept(ctx, banktypes.NewMsgSend(from, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	require.Error(t, err)
+
+	t.Log("verify a msg exceeding the max amount is rejected")
+	_, err = a.Accept(ctx, banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", 101))))
+	require.Error(t, err)
+
+	t.Log("verify a msg with a denom not covered by the max amount is rejected")
+	_, err = a.Accept(ctx, banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("atom", 1))))
+	require.Error(t, err)
+
+	t.Log("verify a constraint on an unknown field rejects every msg")
+	a = authz.NewConstrainedAuthorization(msgTypeURL, authz.NewAllowedValuesConstraint("recipient", to.String()))
+	_, err = a.Accept(ctx, banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+	require.Error(t, err)
+}
+
+func TestConstrainedAuthorizationValidateBasic(t *testing.T) {
+	msgTypeURL := banktypes.SendAuthorization{}.MsgTypeURL()
+	maxAmount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	testCases := []struct {
+		name   string
+		a      *authz.ConstrainedAuthorization
+		expErr bool
+	}{
+		{"valid", authz.NewConstrainedAuthorization(msgTypeURL, authz.NewMaxAmountConstraint("amount", maxAmount)), false},
+		{"empty msg", authz.NewConstrainedAuthorization("", authz.NewMaxAmountConstraint("amount", maxAmount)), true},
+		{"no constraints", authz.NewConstrainedAuthorization(msgTypeURL), true},
+		{"empty field", authz.NewConstrainedAuthorization(msgTypeURL, authz.NewMaxAmountConstraint("", maxAmount)), true},
+		{"invalid field path", authz.NewConstrainedAuthorization(msgTypeURL, authz.NewMaxAmountConstraint("amount.", maxAmount)), true},
+		{"empty constraint", authz.NewConstrainedAuthorization(msgTypeURL, authz.FieldConstraint{Field: "amount"}), true},
+		{
+			"duplicate field",
+			authz.NewConstrainedAuthorization(
+				msgTypeURL,
+				authz.NewMaxAmountConstraint("amount", maxAmount),
+				authz.NewAllowedValuesConstraint("amount", "1stake"),
+			),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.a.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
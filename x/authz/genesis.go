@@ -13,6 +13,13 @@ func NewGenesisState(entries []GrantAuthorization) *GenesisState {
 
 // ValidateGenesis check the given genesis state has no integrity issues
 func ValidateGenesis(data GenesisState) error {
+	for _, a := range data.Authorization {
+		if a.Limits != nil {
+			if err := a.Limits.ValidateBasic(); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,129 @@
+package authz
+
+import (
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultAmountField is the Msg field holding the spent coins when
+// GrantLimits.AmountField is not set.
+const DefaultAmountField = "amount"
+
+// ValidateBasic performs a stateless validation of the limits.
+func (l GrantLimits) ValidateBasic() error {
+	if l.MaxExecutions == 0 && l.SpendLimit.Empty() {
+		return sdkerrors.ErrInvalidRequest.Wrap("grant limits must set max executions or a spend limit")
+	}
+	if l.Period != nil {
+		if *l.Period <= 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("grant limits period must be positive")
+		}
+		if l.MaxExecutions == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("grant limits period requires max executions")
+		}
+	}
+	if !l.SpendLimit.Empty() && !l.SpendLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid spend limit: %s", l.SpendLimit)
+	}
+	for _, part := range strings.Split(l.amountField(), ".") {
+		if part == "" {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid amount field path %s", l.AmountField)
+		}
+	}
+	if !l.Spent.Empty() && !l.Spent.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid spent amount: %s", l.Spent)
+	}
+
+	return nil
+}
+
+// HasUsage returns true if any of the fields tracked by the module is set.
+func (l GrantLimits) HasUsage() bool {
+	return l.Executions != 0 || l.PeriodReset != nil || !l.Spent.Empty()
+}
+
+// Consume accounts for the execution of msg at the given time, returning an
+// error if it would exceed the limits.
+func (l *GrantLimits) Consume(now time.Time, msg sdk.Msg) error {
+	if l.MaxExecutions > 0 {
+		if l.Period != nil && (l.PeriodReset == nil || !now.Before(*l.PeriodReset)) {
+			periodReset := now.Add(*l.Period)
+			l.PeriodReset = &periodReset
+			l.Executions = 0
+		}
+		if l.Executions >= l.MaxExecutions {
+			return sdkerrors.ErrUnauthorized.Wrapf("grant execution limit of %d reached", l.MaxExecutions)
+		}
+		l.Executions++
+	}
+
+	if !l.SpendLimit.Empty() {
+		amount, err := l.spentCoins(msg)
+		if err != nil {
+			return err
+		}
+		spent := l.Spent.Add(amount...)
+		if !spent.IsAllLTE(l.SpendLimit) {
+			return sdkerrors.ErrUnauthorized.Wrapf("spending %s exceeds the grant spend limit, %s remaining", amount, l.remainingSpend())
+		}
+		l.Spent = spent
+	}
+
+	return nil
+}
+
+// Remaining returns the number of executions still allowed at the given time
+// and the amount of coins which can still be spent.
+func (l GrantLimits) Remaining(now time.Time) (uint64, sdk.Coins) {
+	executions := l.MaxExecutions
+	if l.Period == nil || (l.PeriodReset != nil && now.Before(*l.PeriodReset)) {
+		if l.Executions < executions {
+			executions -= l.Executions
+		} else {
+			executions = 0
+		}
+	}
+
+	return executions, l.remainingSpend()
+}
+
+func (l GrantLimits) remainingSpend() sdk.Coins {
+	remaining, _ := l.SpendLimit.SafeSub(l.Spent...)
+	return remaining
+}
+
+func (l GrantLimits) amountField() string {
+	if l.AmountField == "" {
+		return DefaultAmountField
+	}
+	return l.AmountField
+}
+
+// spentCoins reads the coins spent by msg from its amount field.
+func (l GrantLimits) spentCoins(msg sdk.Msg) (sdk.Coins, error) {
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("cannot evaluate spent amount: %s", err)
+	}
+
+	fields, err := decodeMsgFields(bz)
+	if err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("cannot evaluate spent amount: %s", err)
+	}
+
+	value, err := lookupField(fields, l.amountField())
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := coinsFromJSONValue(value)
+	if err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("field %s: %s", l.amountField(), err)
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,82 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGrantLimitsValidateBasic(t *testing.T) {
+	period := time.Hour
+	zero := time.Duration(0)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	testCases := []struct {
+		msg    string
+		limits authz.GrantLimits
+		expErr bool
+	}{
+		{"no limit", authz.GrantLimits{}, true},
+		{"max executions", authz.GrantLimits{MaxExecutions: 1}, false},
+		{"max executions per period", authz.GrantLimits{MaxExecutions: 1, Period: &period}, false},
+		{"period without max executions", authz.GrantLimits{SpendLimit: coins, Period: &period}, true},
+		{"zero period", authz.GrantLimits{MaxExecutions: 1, Period: &zero}, true},
+		{"spend limit", authz.GrantLimits{SpendLimit: coins}, false},
+		{"invalid spend limit", authz.GrantLimits{SpendLimit: sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}}, true},
+		{"invalid amount field", authz.GrantLimits{SpendLimit: coins, AmountField: "amount."}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			err := tc.limits.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGrantLimitsConsume(t *testing.T) {
+	from, to := sdk.AccAddress("from"), sdk.AccAddress("to")
+	now := time.Now().UTC()
+	period := time.Hour
+	send := func(amount int64) sdk.Msg {
+		return banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+	}
+
+	t.Log("verify executions are limited per period")
+	limits := authz.GrantLimits{MaxExecutions: 2, Period: &period}
+	require.NoError(t, limits.Consume(now, send(1)))
+	require.NoError(t, limits.Consume(now, send(1)))
+	require.Error(t, limits.Consume(now, send(1)))
+
+	remaining, _ := limits.Remaining(now)
+	require.Zero(t, remaining)
+	remaining, _ = limits.Remaining(now.Add(period))
+	require.Equal(t, uint64(2), remaining)
+
+	t.Log("verify the executions counter is reset with the period")
+	require.NoError(t, limits.Consume(now.Add(period), send(1)))
+	require.Equal(t, uint64(1), limits.Executions)
+
+	t.Log("verify the cumulative amount spent is limited")
+	limits = authz.GrantLimits{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}
+	require.NoError(t, limits.Consume(now, send(60)))
+	require.Error(t, limits.Consume(now, send(41)))
+	require.NoError(t, limits.Consume(now, send(40)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), limits.Spent)
+
+	_, spend := limits.Remaining(now)
+	require.True(t, spend.IsZero())
+
+	t.Log("verify a msg without the amount field is rejected")
+	limits = authz.GrantLimits{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), AmountField: "value"}
+	require.Error(t, limits.Consume(now, send(1)))
+}
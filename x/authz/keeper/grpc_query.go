@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 
 	"google.golang.org/grpc/codes"
@@ -55,6 +56,7 @@ func (k Keeper) Grants(c context.Context, req *authz.QueryGrantsRequest) (*authz
 			Grants: []*authz.Grant{{
 				Authorization: authorizationAny,
 				Expiration:    grant.Expiration,
+				Limits:        grant.Limits,
 			}},
 		}, nil
 	}
@@ -88,6 +90,7 @@ func (k Keeper) Grants(c context.Context, req *authz.QueryGrantsRequest) (*authz
 			authorizations = append(authorizations, &authz.Grant{
 				Authorization: authorizationAny,
 				Expiration:    auth.Expiration,
+				Limits:        auth.Limits,
 			})
 		}
 		return true, nil
@@ -114,6 +117,19 @@ func (k Keeper) GranterGrants(c context.Context, req *authz.QueryGranterGrantsRe
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
+	if req.MsgTypeUrl != "" {
+		grants, pageRes, err := k.paginateGrantIndex(ctx, GranterMsgTypeIndexPrefix, granter, req.MsgTypeUrl, req.Pagination,
+			func(granter, grantee sdk.AccAddress) (sdk.AccAddress, sdk.AccAddress) { return granter, grantee })
+		if err != nil {
+			return nil, err
+		}
+
+		return &authz.QueryGranterGrantsResponse{
+			Grants:     grants,
+			Pagination: pageRes,
+		}, nil
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	authzStore := prefix.NewStore(store, grantStoreKey(nil, granter, ""))
 
@@ -142,6 +158,7 @@ func (k Keeper) GranterGrants(c context.Context, req *authz.QueryGranterGrantsRe
 				Grantee:       grantee.String(),
 				Authorization: any,
 				Expiration:    auth.Expiration,
+				Limits:        auth.Limits,
 			})
 		}
 		return true, nil
@@ -168,47 +185,179 @@ func (k Keeper) GranteeGrants(c context.Context, req *authz.QueryGranteeGrantsRe
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), GrantKey)
+	authorizations, pageRes, err := k.paginateGrantIndex(ctx, GranteeIndexPrefix, grantee, req.MsgTypeUrl, req.Pagination,
+		func(grantee, granter sdk.AccAddress) (sdk.AccAddress, sdk.AccAddress) { return granter, grantee })
+	if err != nil {
+		return nil, err
+	}
 
-	var authorizations []*authz.GrantAuthorization
-	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte,
-		accumulate bool) (bool, error) {
-		auth, err := unmarshalAuthorization(k.cdc, value)
+	return &authz.QueryGranteeGrantsResponse{
+		Grants:     authorizations,
+		Pagination: pageRes,
+	}, nil
+}
+
+// paginateGrantIndex paginates over the grants referenced by the index keys
+// starting with addr, and msgType if set. toGranterGrantee maps the two
+// addresses of an index key to the grant granter and grantee.
+func (k Keeper) paginateGrantIndex(ctx sdk.Context, indexPrefix []byte, addr sdk.AccAddress, msgType string,
+	pageReq *query.PageRequest, toGranterGrantee func(first, second sdk.AccAddress) (sdk.AccAddress, sdk.AccAddress),
+) ([]*authz.GrantAuthorization, *query.PageResponse, error) {
+	keyPrefix, err := grantIndexPrefix(indexPrefix, addr, msgType)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	var grants []*authz.GrantAuthorization
+	pageRes, err := query.Paginate(indexStore, pageReq, func(key []byte, _ []byte) error {
+		first, typeURL, second := parseGrantIndexKey(append(append([]byte{}, keyPrefix...), key...))
+		granter, grantee := toGranterGrantee(first, second)
+
+		grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, typeURL))
+		if !found {
+			return status.Errorf(codes.Internal, "grant index refers to a missing grant")
+		}
+
+		auth, err := grant.GetAuthorization()
 		if err != nil {
-			return false, err
+			return err
 		}
 
-		granter, g, _ := parseGrantStoreKey(append(GrantKey, key...))
-		if !g.Equals(grantee) {
-			return false, nil
+		any, err := codectypes.NewAnyWithValue(auth)
+		if err != nil {
+			return status.Errorf(codes.Internal, err.Error())
 		}
 
-		auth1, err := auth.GetAuthorization()
+		grants = append(grants, &authz.GrantAuthorization{
+			Granter:       granter.String(),
+			Grantee:       grantee.String(),
+			Authorization: any,
+			Expiration:    grant.Expiration,
+			Limits:        grant.Limits,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return grants, pageRes, nil
+}
+
+// ExpiringGrants implements the Query/ExpiringGrants gRPC method.
+func (k Keeper) ExpiringGrants(c context.Context, req *authz.QueryExpiringGrantsRequest) (*authz.QueryExpiringGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.End.Before(req.Start) {
+		return nil, status.Errorf(codes.InvalidArgument, "end of the time window must not be before its start")
+	}
+
+	limit := uint64(query.DefaultLimit)
+	var startKey []byte
+	if req.Pagination != nil {
+		if req.Pagination.Offset > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "offset pagination is not supported, use key pagination")
+		}
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+		startKey = req.Pagination.Key
+	}
+
+	if startKey != nil {
+		if err := validateGrantQueueStartKey(req.Start, req.End, startKey); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var (
+		grants  []*authz.GrantAuthorization
+		nextKey []byte
+		lastKey []byte
+		iterErr error
+	)
+	err := k.IterateExpiringGrants(ctx, req.Start, req.End, startKey, func(queueKey []byte, granter, grantee sdk.AccAddress, grant authz.Grant) bool {
+		// grants sharing a queue item are always returned in the same page
+		if uint64(len(grants)) >= limit && !bytes.Equal(queueKey, lastKey) {
+			nextKey = queueKey
+			return true
+		}
+		lastKey = queueKey
+
+		auth, err := grant.GetAuthorization()
 		if err != nil {
-			return false, err
+			iterErr = err
+			return true
 		}
-		if accumulate {
-			any, err := codectypes.NewAnyWithValue(auth1)
-			if err != nil {
-				return false, status.Errorf(codes.Internal, err.Error())
-			}
 
-			authorizations = append(authorizations, &authz.GrantAuthorization{
-				Authorization: any,
-				Expiration:    auth.Expiration,
-				Granter:       granter.String(),
-				Grantee:       grantee.String(),
-			})
+		any, err := codectypes.NewAnyWithValue(auth)
+		if err != nil {
+			iterErr = status.Errorf(codes.Internal, err.Error())
+			return true
 		}
-		return true, nil
+
+		grants = append(grants, &authz.GrantAuthorization{
+			Granter:       granter.String(),
+			Grantee:       grantee.String(),
+			Authorization: any,
+			Expiration:    grant.Expiration,
+			Limits:        grant.Limits,
+		})
+		return false
 	})
 	if err != nil {
 		return nil, err
 	}
+	if iterErr != nil {
+		return nil, iterErr
+	}
 
-	return &authz.QueryGranteeGrantsResponse{
-		Grants:     authorizations,
-		Pagination: pageRes,
+	return &authz.QueryExpiringGrantsResponse{
+		Grants:     grants,
+		Pagination: &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
+// GrantLimits implements the Query/GrantLimits gRPC method.
+func (k Keeper) GrantLimits(c context.Context, req *authz.QueryGrantLimitsRequest) (*authz.QueryGrantLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	granter, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.MsgTypeUrl == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty msg type url")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, req.MsgTypeUrl))
+	if !found {
+		return nil, sdkerrors.Wrapf(authz.ErrNoAuthorizationFound, "authorization not found for %s type", req.MsgTypeUrl)
+	}
+
+	if grant.Limits == nil {
+		return &authz.QueryGrantLimitsResponse{}, nil
+	}
+
+	executions, spend := grant.Limits.Remaining(ctx.BlockTime())
+	return &authz.QueryGrantLimitsResponse{
+		Limits:              grant.Limits,
+		RemainingExecutions: executions,
+		RemainingSpend:      spend,
 	}, nil
 }
 
@@ -6,10 +6,13 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -156,6 +159,8 @@ func (suite *TestSuite) TestGRPCQueryAuthorizations() {
 	}
 }
 
+var multiSendMsgType = sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+
 func (suite *TestSuite) TestGRPCQueryGranterGrants() {
 	require := suite.Require()
 	queryClient, addrs := suite.queryClient, suite.addrs
@@ -208,6 +213,32 @@ func (suite *TestSuite) TestGRPCQueryGranterGrants() {
 			},
 			1,
 		},
+		{
+			"valid case, filter by msg type",
+			func() {
+				err := suite.app.AuthzKeeper.SaveGrant(suite.ctx, addrs[1], addrs[0], authz.NewGenericAuthorization(multiSendMsgType), nil)
+				require.NoError(err)
+			},
+			false,
+			authz.QueryGranterGrantsRequest{
+				Granter:    addrs[0].String(),
+				MsgTypeUrl: bankSendAuthMsgType,
+			},
+			2,
+		},
+		{
+			"valid case, filter by msg type with pagination",
+			func() {},
+			false,
+			authz.QueryGranterGrantsRequest{
+				Granter:    addrs[0].String(),
+				MsgTypeUrl: multiSendMsgType,
+				Pagination: &query.PageRequest{
+					Limit: 1,
+				},
+			},
+			1,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -276,6 +307,45 @@ func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
 			},
 			1,
 		},
+		{
+			"valid case, filter by msg type",
+			func() {
+				err := suite.app.AuthzKeeper.SaveGrant(suite.ctx, addrs[0], addrs[1], authz.NewGenericAuthorization(multiSendMsgType), nil)
+				require.NoError(err)
+			},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee:    addrs[0].String(),
+				MsgTypeUrl: bankSendAuthMsgType,
+			},
+			2,
+		},
+		{
+			"valid case, filter by msg type with pagination",
+			func() {},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee:    addrs[0].String(),
+				MsgTypeUrl: multiSendMsgType,
+				Pagination: &query.PageRequest{
+					Limit: 1,
+				},
+			},
+			1,
+		},
+		{
+			"valid case, revoked grant is not listed",
+			func() {
+				err := suite.app.AuthzKeeper.DeleteGrant(suite.ctx, addrs[0], addrs[2], bankSendAuthMsgType)
+				require.NoError(err)
+			},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee:    addrs[0].String(),
+				MsgTypeUrl: bankSendAuthMsgType,
+			},
+			1,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -292,6 +362,140 @@ func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
 	}
 }
 
+func (suite *TestSuite) TestGRPCQueryExpiringGrants() {
+	require := suite.Require()
+	queryClient, addrs := suite.queryClient, suite.addrs
+	now := suite.ctx.BlockTime()
+
+	// createSendAuthorization grants expire one hour from now
+	suite.createSendAuthorization(addrs[0], addrs[1])
+	suite.createSendAuthorization(addrs[0], addrs[2])
+	exp := now.Add(48 * time.Hour)
+	err := suite.app.AuthzKeeper.SaveGrant(suite.ctx, addrs[2], addrs[1], &banktypes.SendAuthorization{SpendLimit: coins10}, &exp)
+	require.NoError(err)
+
+	testCases := []struct {
+		msg      string
+		expError bool
+		request  authz.QueryExpiringGrantsRequest
+		numItems int
+	}{
+		{
+			"fail end before start",
+			true,
+			authz.QueryExpiringGrantsRequest{Start: now.Add(time.Hour), End: now},
+			0,
+		},
+		{
+			"fail offset pagination",
+			true,
+			authz.QueryExpiringGrantsRequest{Start: now, End: exp, Pagination: &query.PageRequest{Offset: 1}},
+			0,
+		},
+		{
+			"valid case, grants within the window",
+			false,
+			authz.QueryExpiringGrantsRequest{Start: now, End: now.Add(2 * time.Hour)},
+			2,
+		},
+		{
+			"valid case, window bounds are inclusive",
+			false,
+			authz.QueryExpiringGrantsRequest{Start: now.Add(time.Hour), End: exp},
+			3,
+		},
+		{
+			"valid case, no grant within the window",
+			false,
+			authz.QueryExpiringGrantsRequest{Start: now.Add(2 * time.Hour), End: now.Add(3 * time.Hour)},
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			result, err := queryClient.ExpiringGrants(gocontext.Background(), &tc.request)
+			if tc.expError {
+				require.Error(err)
+			} else {
+				require.NoError(err)
+				require.Len(result.Grants, tc.numItems)
+			}
+		})
+	}
+
+	suite.T().Log("verify key pagination")
+	res, err := queryClient.ExpiringGrants(gocontext.Background(), &authz.QueryExpiringGrantsRequest{
+		Start: now, End: exp, Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(err)
+	require.Len(res.Grants, 2)
+	require.NotNil(res.Pagination.NextKey)
+
+	res, err = queryClient.ExpiringGrants(gocontext.Background(), &authz.QueryExpiringGrantsRequest{
+		Start: now, End: exp, Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(err)
+	require.Len(res.Grants, 1)
+	require.Nil(res.Pagination.NextKey)
+	require.Equal(addrs[1].String(), res.Grants[0].Granter)
+
+	suite.T().Log("verify the invalid pagination keys are rejected")
+	for _, key := range [][]byte{
+		{0x01},
+		keeper.GrantQueueTimePrefix(now),
+		append(keeper.GrantQueueTimePrefix(now.Add(time.Hour)), 0xff),
+		keeper.GrantQueueKey(exp.Add(time.Hour), addrs[0], addrs[1]),
+		keeper.GrantKey,
+	} {
+		_, err = queryClient.ExpiringGrants(gocontext.Background(), &authz.QueryExpiringGrantsRequest{
+			Start: now, End: exp, Pagination: &query.PageRequest{Key: key},
+		})
+		require.Equal(codes.InvalidArgument, status.Code(err), "key %X", key)
+	}
+}
+
+func (suite *TestSuite) TestGRPCQueryGrantLimits() {
+	require := suite.Require()
+	queryClient, addrs := suite.queryClient, suite.addrs
+
+	suite.createSendAuthorization(addrs[1], addrs[0])
+	err := suite.app.AuthzKeeper.SaveGrantWithLimits(suite.ctx, addrs[1], addrs[0], authz.NewGenericAuthorization(multiSendMsgType), nil,
+		&authz.GrantLimits{MaxExecutions: 3, SpendLimit: coins10})
+	require.NoError(err)
+
+	_, err = queryClient.GrantLimits(gocontext.Background(), &authz.QueryGrantLimitsRequest{
+		Granter: addrs[0].String(),
+		Grantee: addrs[1].String(),
+	})
+	require.Error(err, "msg type url is required")
+
+	_, err = queryClient.GrantLimits(gocontext.Background(), &authz.QueryGrantLimitsRequest{
+		Granter:    addrs[1].String(),
+		Grantee:    addrs[0].String(),
+		MsgTypeUrl: bankSendAuthMsgType,
+	})
+	require.Error(err, "grant not found")
+
+	res, err := queryClient.GrantLimits(gocontext.Background(), &authz.QueryGrantLimitsRequest{
+		Granter:    addrs[0].String(),
+		Grantee:    addrs[1].String(),
+		MsgTypeUrl: bankSendAuthMsgType,
+	})
+	require.NoError(err)
+	require.Nil(res.Limits)
+
+	res, err = queryClient.GrantLimits(gocontext.Background(), &authz.QueryGrantLimitsRequest{
+		Granter:    addrs[0].String(),
+		Grantee:    addrs[1].String(),
+		MsgTypeUrl: multiSendMsgType,
+	})
+	require.NoError(err)
+	require.NotNil(res.Limits)
+	require.Equal(uint64(3), res.RemainingExecutions)
+	require.Equal(coins10, res.RemainingSpend)
+}
+
 func (suite *TestSuite) createSendAuthorization(a1, a2 sdk.AccAddress) authz.Authorization {
 	exp := suite.ctx.BlockHeader().Time.Add(time.Hour)
 	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
@@ -56,24 +57,29 @@ func (k Keeper) getGrant(ctx sdk.Context, skey []byte) (grant authz.Grant, found
 	return grant, true
 }
 
-func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, updated authz.Authorization) error {
-	skey := grantStoreKey(grantee, granter, updated.MsgTypeURL())
+func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string,
+	updated authz.Authorization, limits *authz.GrantLimits) error {
+	skey := grantStoreKey(grantee, granter, msgType)
 	grant, found := k.getGrant(ctx, skey)
 	if !found {
 		return authz.ErrNoAuthorizationFound
 	}
 
-	msg, ok := updated.(proto.Message)
-	if !ok {
-		return sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", updated)
-	}
+	if updated != nil {
+		msg, ok := updated.(proto.Message)
+		if !ok {
+			return sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", updated)
+		}
 
-	any, err := codectypes.NewAnyWithValue(msg)
-	if err != nil {
-		return err
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return err
+		}
+
+		grant.Authorization = any
 	}
+	grant.Limits = limits
 
-	grant.Authorization = any
 	store := ctx.KVStore(k.storeKey)
 	store.Set(skey, k.cdc.MustMarshal(&grant))
 
@@ -105,6 +111,12 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 				return nil, authz.ErrAuthorizationExpired
 			}
 
+			if grant.Limits != nil {
+				if err := grant.Limits.Consume(now, msg); err != nil {
+					return nil, err
+				}
+			}
+
 			authorization, err := grant.GetAuthorization()
 			if err != nil {
 				return nil, err
@@ -116,8 +128,8 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 			}
 			if resp.Delete {
 				err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
-			} else if resp.Updated != nil {
-				err = k.update(ctx, grantee, granter, resp.Updated)
+			} else if resp.Updated != nil || grant.Limits != nil {
+				err = k.update(ctx, grantee, granter, sdk.MsgTypeURL(msg), resp.Updated, grant.Limits)
 			}
 			if err != nil {
 				return nil, err
@@ -132,7 +144,7 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 			return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
 		}
 
-		msgResp, err := handler(ctx, msg)
+		msgResp, err := sdk.ExecNestedMsg(ctx, msg, handler)
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "failed to execute message; message %v", msg)
 		}
@@ -154,6 +166,13 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 // with the provided expiration time and insert authorization key into the grants queue. If there is an existing authorization grant for the
 // same `sdk.Msg` type, this grant overwrites that.
 func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error {
+	return k.SaveGrantWithLimits(ctx, grantee, granter, authorization, expiration, nil)
+}
+
+// SaveGrantWithLimits works like SaveGrant, additionally restricting the
+// executions of the grant with the provided limits if not nil.
+func (k Keeper) SaveGrantWithLimits(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization,
+	expiration *time.Time, limits *authz.GrantLimits) error {
 	store := ctx.KVStore(k.storeKey)
 	msgType := authorization.MsgTypeURL()
 	skey := grantStoreKey(grantee, granter, msgType)
@@ -162,6 +181,7 @@ func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, auth
 	if err != nil {
 		return err
 	}
+	grant.Limits = limits
 
 	var oldExp *time.Time
 	if oldGrant, found := k.getGrant(ctx, skey); found {
@@ -181,6 +201,7 @@ func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, auth
 
 	bz := k.cdc.MustMarshal(&grant)
 	store.Set(skey, bz)
+	k.setGrantIndexes(ctx, granter, grantee, msgType)
 
 	return ctx.EventManager().EmitTypedEvent(&authz.EventGrant{
 		MsgTypeUrl: authorization.MsgTypeURL(),
@@ -200,6 +221,7 @@ func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk
 	}
 
 	store.Delete(skey)
+	k.deleteGrantIndexes(ctx, granter, grantee, msgType)
 
 	if grant.Expiration != nil {
 		err := k.removeFromGrantQueue(ctx, skey, granter, grantee, *grant.Expiration)
@@ -268,6 +290,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *authz.GenesisState {
 			Grantee:       grantee.String(),
 			Expiration:    grant.Expiration,
 			Authorization: grant.Authorization,
+			Limits:        grant.Limits,
 		})
 		return false
 	})
@@ -299,13 +322,27 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *authz.GenesisState) {
 			panic("expected authorization")
 		}
 
-		err = k.SaveGrant(ctx, grantee, granter, a, entry.Expiration)
+		err = k.SaveGrantWithLimits(ctx, grantee, granter, a, entry.Expiration, entry.Limits)
 		if err != nil {
 			panic(err)
 		}
 	}
 }
 
+// setGrantIndexes adds a grant to the grantee and granter msg type indexes
+func (k Keeper) setGrantIndexes(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(granteeIndexKey(grantee, msgType, granter), []byte{})
+	store.Set(granterMsgTypeIndexKey(granter, msgType, grantee), []byte{})
+}
+
+// deleteGrantIndexes removes a grant from the grantee and granter msg type indexes
+func (k Keeper) deleteGrantIndexes(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(granteeIndexKey(grantee, msgType, granter))
+	store.Delete(granterMsgTypeIndexKey(granter, msgType, grantee))
+}
+
 func (keeper Keeper) getGrantQueueItem(ctx sdk.Context, expiration time.Time, granter, grantee sdk.AccAddress) (*authz.GrantQueueItem, error) {
 	store := ctx.KVStore(keeper.storeKey)
 	bz := store.Get(GrantQueueKey(expiration, granter, grantee))
@@ -390,13 +427,20 @@ func (keeper Keeper) removeFromGrantQueue(ctx sdk.Context, grantKey []byte, gran
 }
 
 // DequeueAndDeleteExpiredGrants deletes expired grants from the state and grant queue.
-func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context) error {
+// At most limit grant queue items are processed, the remaining ones being left
+// for subsequent calls. A non-positive limit means no limit.
+func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context, limit int) error {
 	store := ctx.KVStore(k.storeKey)
 
 	iterator := store.Iterator(GrantQueuePrefix, sdk.InclusiveEndBytes(GrantQueueTimePrefix(ctx.BlockTime())))
 	defer iterator.Close()
 
-	for ; iterator.Valid(); iterator.Next() {
+	for count := 0; iterator.Valid(); iterator.Next() {
+		if limit > 0 && count >= limit {
+			break
+		}
+		count++
+
 		var queueItem authz.GrantQueueItem
 		if err := k.cdc.Unmarshal(iterator.Value(), &queueItem); err != nil {
 			return err
@@ -411,8 +455,68 @@ func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context) error {
 
 		for _, typeUrl := range queueItem.MsgTypeUrls {
 			store.Delete(grantStoreKey(grantee, granter, typeUrl))
+			k.deleteGrantIndexes(ctx, granter, grantee, typeUrl)
 		}
 	}
 
 	return nil
 }
+
+// IterateExpiringGrants iterates over the grants expiring between start and end
+// (both inclusive), in expiration order, starting at the grant queue key
+// startKey if set. Iteration stops when the handler returns true. An error is
+// returned if startKey is not a grant queue key of the time window.
+func (k Keeper) IterateExpiringGrants(ctx sdk.Context, start, end time.Time, startKey []byte,
+	handler func(queueKey []byte, granter, grantee sdk.AccAddress, grant authz.Grant) bool) error {
+	store := ctx.KVStore(k.storeKey)
+
+	from := GrantQueueTimePrefix(start)
+	if startKey != nil {
+		if err := validateGrantQueueStartKey(start, end, startKey); err != nil {
+			return err
+		}
+		from = startKey
+	}
+
+	iterator := store.Iterator(from, sdk.PrefixEndBytes(GrantQueueTimePrefix(end)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var queueItem authz.GrantQueueItem
+		if err := k.cdc.Unmarshal(iterator.Value(), &queueItem); err != nil {
+			return err
+		}
+
+		_, granter, grantee, err := parseGrantQueueKey(iterator.Key())
+		if err != nil {
+			return err
+		}
+
+		for _, typeURL := range queueItem.MsgTypeUrls {
+			grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, typeURL))
+			if !found {
+				continue
+			}
+			if handler(iterator.Key(), granter, grantee, grant) {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateGrantQueueStartKey returns an error if key is not a grant queue key
+// of the grants expiring between start and end (both inclusive).
+func validateGrantQueueStartKey(start, end time.Time, key []byte) error {
+	if bytes.Compare(key, GrantQueueTimePrefix(start)) < 0 ||
+		bytes.Compare(key, sdk.PrefixEndBytes(GrantQueueTimePrefix(end))) >= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "grant queue key %X out of the time window", key)
+	}
+
+	if _, _, _, err := parseGrantQueueKey(key); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	gocontext "context"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -270,6 +272,49 @@ func (s *TestSuite) TestDispatchAction() {
 
 // Tests that all msg events included in an authz MsgExec tx
 // Ref: https://github.com/cosmos/cosmos-sdk/issues/9501
+func (s *TestSuite) TestDispatchActionWithLimits() {
+	app, addrs := s.app, s.addrs
+	require := s.Require()
+	granterAddr, granteeAddr, recipientAddr := addrs[0], addrs[1], addrs[2]
+	require.NoError(testutil.FundAccount(app.BankKeeper, s.ctx, granterAddr, coins1000))
+
+	limits := &authz.GrantLimits{MaxExecutions: 2, SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 15))}
+	err := app.AuthzKeeper.SaveGrantWithLimits(s.ctx, granteeAddr, granterAddr, authz.NewGenericAuthorization(bankSendAuthMsgType), nil, limits)
+	require.NoError(err)
+
+	send := func(amount int64) []sdk.Msg {
+		return []sdk.Msg{banktypes.NewMsgSend(granterAddr, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))}
+	}
+
+	s.T().Log("verify an execution within the limits is accounted")
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, send(10))
+	require.NoError(err)
+
+	s.T().Log("verify an execution exceeding the spend limit is rejected")
+	cacheCtx, _ := s.ctx.CacheContext()
+	_, err = app.AuthzKeeper.DispatchActions(cacheCtx, granteeAddr, send(10))
+	require.ErrorContains(err, "exceeds the grant spend limit")
+
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, send(5))
+	require.NoError(err)
+
+	s.T().Log("verify an execution exceeding the max executions is rejected")
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, send(0))
+	require.ErrorContains(err, "execution limit of 2 reached")
+
+	s.T().Log("verify the usage is tracked in state")
+	res, err := s.queryClient.GrantLimits(gocontext.Background(), &authz.QueryGrantLimitsRequest{
+		Granter:    granterAddr.String(),
+		Grantee:    granteeAddr.String(),
+		MsgTypeUrl: bankSendAuthMsgType,
+	})
+	require.NoError(err)
+	require.Equal(uint64(2), res.Limits.Executions)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 15)), res.Limits.Spent)
+	require.Zero(res.RemainingExecutions)
+	require.True(res.RemainingSpend.IsZero())
+}
+
 func (s *TestSuite) TestDispatchedEvents() {
 	require := s.Require()
 	app, addrs := s.app, s.addrs
@@ -319,6 +364,38 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestDispatchNestedActions() {
+	require := s.Require()
+	app, addrs := s.app, s.addrs
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+
+	// the grantee executes its own send nested in a MsgExec, implicitly accepted
+	exec := authz.NewMsgExec(granteeAddr, []sdk.Msg{
+		&banktypes.MsgSend{
+			Amount:      coins10,
+			FromAddress: granteeAddr.String(),
+			ToAddress:   recipientAddr.String(),
+		},
+	})
+
+	// the send is executed at depth 2
+	_, err := app.AuthzKeeper.DispatchActions(s.ctx.WithMaxExecDepth(1), granteeAddr, []sdk.Msg{&exec})
+	require.ErrorIs(err, sdkerrors.ErrMaxExecDepth)
+
+	ctx := s.ctx.WithMaxExecDepth(2).WithEventManager(sdk.NewEventManager())
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, []sdk.Msg{&exec})
+	require.NoError(err)
+
+	var depths []string
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == sdk.EventTypeNestedMsg {
+			depths = append(depths, string(e.Attributes[1].Value))
+		}
+	}
+	require.ElementsMatch([]string{"1", "2"}, depths)
+}
+
 func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require := s.Require()
 	app, addrs := s.app, s.addrs
@@ -344,7 +421,7 @@ func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require.NoError(err)
 
 	newCtx := s.ctx.WithBlockTime(exp.AddDate(1, 0, 0))
-	err = app.AuthzKeeper.DequeueAndDeleteExpiredGrants(newCtx)
+	err = app.AuthzKeeper.DequeueAndDeleteExpiredGrants(newCtx, 0)
 	require.NoError(err)
 
 	s.T().Log("verify expired grants are pruned from the state")
@@ -365,6 +442,39 @@ func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require.Len(authzs, 1)
 }
 
+func (s *TestSuite) TestDequeueGrantsQueueWithLimit() {
+	require := s.Require()
+	app, addrs := s.app, s.addrs
+	granter := addrs[0]
+	a := banktypes.SendAuthorization{SpendLimit: coins100}
+
+	exp := s.ctx.BlockTime().AddDate(0, 0, 1)
+	for i, grantee := range addrs[1:] {
+		grantExp := exp.Add(time.Duration(i) * time.Hour)
+		require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, grantee, granter, &a, &grantExp))
+	}
+
+	newCtx := s.ctx.WithBlockTime(exp.AddDate(1, 0, 0))
+
+	s.T().Log("verify only the earliest grant is pruned when the limit is reached")
+	require.NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(newCtx, 1))
+
+	authzs, err := app.AuthzKeeper.GetAuthorizations(newCtx, addrs[1], granter)
+	require.NoError(err)
+	require.Len(authzs, 0)
+
+	authzs, err = app.AuthzKeeper.GetAuthorizations(newCtx, addrs[2], granter)
+	require.NoError(err)
+	require.Len(authzs, 1)
+
+	s.T().Log("verify the remaining grants are pruned by the next call")
+	require.NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(newCtx, 1))
+
+	authzs, err = app.AuthzKeeper.GetAuthorizations(newCtx, addrs[2], granter)
+	require.NoError(err)
+	require.Len(authzs, 0)
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
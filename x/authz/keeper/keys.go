@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/internal/conv"
@@ -15,10 +17,14 @@ import (
 //
 // - 0x01<grant_Bytes>: Grant
 // - 0x02<grant_expiration_Bytes>: GrantQueueItem
+// - 0x03<grantee_Bytes><msgType_Bytes><granter_Bytes>: []byte{}
+// - 0x04<granter_Bytes><msgType_Bytes><grantee_Bytes>: []byte{}
 //
 var (
-	GrantKey         = []byte{0x01} // prefix for each key
-	GrantQueuePrefix = []byte{0x02}
+	GrantKey                  = []byte{0x01} // prefix for each key
+	GrantQueuePrefix          = []byte{0x02}
+	GranteeIndexPrefix        = []byte{0x03}
+	GranterMsgTypeIndexPrefix = []byte{0x04}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -54,10 +60,17 @@ func parseGrantStoreKey(key []byte) (granterAddr, granteeAddr sdk.AccAddress, ms
 	return granterAddr, granteeAddr, conv.UnsafeBytesToStr(key[(granteeAddrEndIndex + 1):])
 }
 
-// parseGrantQueueKey split expiration time, granter and grantee from the grant queue key
+// parseGrantQueueKey split expiration time, granter and grantee from the grant queue key,
+// returning an error if key is not a grant queue key.
 func parseGrantQueueKey(key []byte) (time.Time, sdk.AccAddress, sdk.AccAddress, error) {
 	// key is of format:
-	// 0x02<grant_expiration_Bytes><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes>
+	// 0x02<grant_expiration_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes>
+	if !bytes.HasPrefix(key, GrantQueuePrefix) {
+		return time.Time{}, nil, nil, fmt.Errorf("invalid grant queue key %X: expected prefix %X", key, GrantQueuePrefix)
+	}
+	if err := checkGrantQueueKeyLength(key, 1+lenTime); err != nil {
+		return time.Time{}, nil, nil, err
+	}
 
 	expBytes, expEndIndex := sdk.ParseLengthPrefixedBytes(key, 1, lenTime)
 
@@ -66,15 +79,47 @@ func parseGrantQueueKey(key []byte) (time.Time, sdk.AccAddress, sdk.AccAddress,
 		return exp, nil, nil, err
 	}
 
-	granterAddrLen, granterAddrLenEndIndex := sdk.ParseLengthPrefixedBytes(key, expEndIndex+1, 1)
-	granter, granterEndIndex := sdk.ParseLengthPrefixedBytes(key, granterAddrLenEndIndex+1, int(granterAddrLen[0]))
+	granter, granterEndIndex, err := parseGrantQueueKeyAddress(key, expEndIndex+1)
+	if err != nil {
+		return exp, nil, nil, err
+	}
 
-	granteeAddrLen, granteeAddrLenEndIndex := sdk.ParseLengthPrefixedBytes(key, granterEndIndex+1, 1)
-	grantee, _ := sdk.ParseLengthPrefixedBytes(key, granteeAddrLenEndIndex+1, int(granteeAddrLen[0]))
+	grantee, granteeEndIndex, err := parseGrantQueueKeyAddress(key, granterEndIndex+1)
+	if err != nil {
+		return exp, nil, nil, err
+	}
+
+	if granteeEndIndex+1 != len(key) {
+		return exp, nil, nil, fmt.Errorf("invalid grant queue key %X: unexpected bytes after the grantee", key)
+	}
 
 	return exp, granter, grantee, nil
 }
 
+// parseGrantQueueKeyAddress parses the length prefixed address of the grant queue
+// key starting at startIndex, returning the address and its end index.
+func parseGrantQueueKeyAddress(key []byte, startIndex int) (sdk.AccAddress, int, error) {
+	if err := checkGrantQueueKeyLength(key, startIndex+1); err != nil {
+		return nil, 0, err
+	}
+	addrLen := int(key[startIndex])
+	if err := checkGrantQueueKeyLength(key, startIndex+1+addrLen); err != nil {
+		return nil, 0, err
+	}
+
+	addr, endIndex := sdk.ParseLengthPrefixedBytes(key, startIndex+1, addrLen)
+	return addr, endIndex, nil
+}
+
+// checkGrantQueueKeyLength returns an error instead of panicking like
+// kv.AssertKeyAtLeastLength if the grant queue key is shorter than length.
+func checkGrantQueueKeyLength(key []byte, length int) error {
+	if len(key) < length {
+		return fmt.Errorf("invalid grant queue key %X: expected length at least %d, got %d", key, length, len(key))
+	}
+	return nil
+}
+
 // GrantQueueKey - return grant queue store key. If a given grant doesn't have a defined
 // expiration, then it should not be used in the pruning queue.
 // Key format is:
@@ -97,3 +142,59 @@ func firstAddressFromGrantStoreKey(key []byte) sdk.AccAddress {
 	addrLen := key[0]
 	return sdk.AccAddress(key[1 : 1+addrLen])
 }
+
+// granteeIndexKey - return the grantee index store key
+// Key format is:
+//     0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgTypeLen (1 Byte)><msgType_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes>: []byte{}
+func granteeIndexKey(grantee sdk.AccAddress, msgType string, granter sdk.AccAddress) []byte {
+	return grantIndexKey(GranteeIndexPrefix, grantee, msgType, granter)
+}
+
+// granterMsgTypeIndexKey - return the granter msg type index store key
+// Key format is:
+//     0x04<granterAddressLen (1 Byte)><granterAddress_Bytes><msgTypeLen (1 Byte)><msgType_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes>: []byte{}
+func granterMsgTypeIndexKey(granter sdk.AccAddress, msgType string, grantee sdk.AccAddress) []byte {
+	return grantIndexKey(GranterMsgTypeIndexPrefix, granter, msgType, grantee)
+}
+
+func grantIndexKey(prefix []byte, first sdk.AccAddress, msgType string, second sdk.AccAddress) []byte {
+	m := address.MustLengthPrefix(conv.UnsafeStrToBytes(msgType))
+	first = address.MustLengthPrefix(first)
+	second = address.MustLengthPrefix(second)
+
+	return sdk.AppendLengthPrefixedBytes(prefix, first, m, second)
+}
+
+// grantIndexPrefix - return the prefix of the index keys starting with addr,
+// restricted to msgType if it is not empty.
+func grantIndexPrefix(prefix []byte, addr sdk.AccAddress, msgType string) ([]byte, error) {
+	key := sdk.AppendLengthPrefixedBytes(prefix, address.MustLengthPrefix(addr))
+	if msgType == "" {
+		return key, nil
+	}
+
+	m, err := address.LengthPrefix(conv.UnsafeStrToBytes(msgType))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(key, m...), nil
+}
+
+// parseGrantIndexKey - split the two addresses and the msg type from a grantee
+// or granter msg type index key
+func parseGrantIndexKey(key []byte) (first sdk.AccAddress, msgType string, second sdk.AccAddress) {
+	// key is of format:
+	// <prefix (1 Byte)><firstAddressLen (1 Byte)><firstAddress_Bytes><msgTypeLen (1 Byte)><msgType_Bytes><secondAddressLen (1 Byte)><secondAddress_Bytes>
+
+	firstLen, firstLenEndIndex := sdk.ParseLengthPrefixedBytes(key, 1, 1) // ignore key[0] since it is a prefix key
+	first, firstEndIndex := sdk.ParseLengthPrefixedBytes(key, firstLenEndIndex+1, int(firstLen[0]))
+
+	msgTypeLen, msgTypeLenEndIndex := sdk.ParseLengthPrefixedBytes(key, firstEndIndex+1, 1)
+	m, msgTypeEndIndex := sdk.ParseLengthPrefixedBytes(key, msgTypeLenEndIndex+1, int(msgTypeLen[0]))
+
+	secondLen, secondLenEndIndex := sdk.ParseLengthPrefixedBytes(key, msgTypeEndIndex+1, 1)
+	second, _ = sdk.ParseLengthPrefixedBytes(key, secondLenEndIndex+1, int(secondLen[0]))
+
+	return first, string(m), second
+}
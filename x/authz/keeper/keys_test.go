@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 	"time"
 
@@ -36,4 +38,37 @@ func TestGrantQueueKey(t *testing.T) {
 	require.Equal(t, blockTime, expiration)
 	require.Equal(t, granter, granter1)
 	require.Equal(t, grantee, grantee1)
+
+	for _, key := range [][]byte{
+		nil,
+		grantStoreKey(grantee, granter, msgType),
+		GrantQueueTimePrefix(blockTime),
+		append(GrantQueueTimePrefix(blockTime), byte(len(granter)+1)),
+		queueKey[:len(queueKey)-1],
+		append(queueKey, 0x00),
+	} {
+		_, _, _, err = parseGrantQueueKey(key)
+		require.Error(t, err, "key %X", key)
+	}
+}
+
+func TestGrantIndexKeys(t *testing.T) {
+	require := require.New(t)
+
+	grantee1, msgType1, granter1 := parseGrantIndexKey(granteeIndexKey(grantee, msgType, granter))
+	require.Equal(grantee, grantee1)
+	require.Equal(msgType, msgType1)
+	require.Equal(granter, granter1)
+
+	granter1, msgType1, grantee1 = parseGrantIndexKey(granterMsgTypeIndexKey(granter, msgType, grantee))
+	require.Equal(granter, granter1)
+	require.Equal(msgType, msgType1)
+	require.Equal(grantee, grantee1)
+
+	prefix, err := grantIndexPrefix(GranteeIndexPrefix, grantee, msgType)
+	require.NoError(err)
+	require.True(bytes.HasPrefix(granteeIndexKey(grantee, msgType, granter), prefix))
+
+	_, err = grantIndexPrefix(GranteeIndexPrefix, grantee, strings.Repeat("a", 256))
+	require.Error(err)
 }
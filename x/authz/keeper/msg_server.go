@@ -38,7 +38,7 @@ func (k Keeper) Grant(goCtx context.Context, msg *authz.MsgGrant) (*authz.MsgGra
 		return nil, sdkerrors.ErrInvalidType.Wrapf("%s doesn't exist.", t)
 	}
 
-	err = k.SaveGrant(ctx, grantee, granter, authorization, msg.Grant.Expiration)
+	err = k.SaveGrantWithLimits(ctx, grantee, granter, authorization, msg.Grant.Expiration, msg.Grant.Limits)
 	if err != nil {
 		return nil, err
 	}
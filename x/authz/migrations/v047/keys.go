@@ -0,0 +1,44 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/internal/conv"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// Keys for store prefixes
+// Items are stored with the following key: values
+//
+// - 0x01<grant_Bytes>: Grant
+// - 0x03<grantee_Bytes><msgType_Bytes><granter_Bytes>: []byte{}
+// - 0x04<granter_Bytes><msgType_Bytes><grantee_Bytes>: []byte{}
+//
+var (
+	GrantPrefix               = []byte{0x01}
+	GranteeIndexPrefix        = []byte{0x03}
+	GranterMsgTypeIndexPrefix = []byte{0x04}
+)
+
+// GranteeIndexKey - return the grantee index store key
+// Key format is
+//
+// - 0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgTypeLen (1 Byte)><msgType_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes>: []byte{}
+func GranteeIndexKey(grantee sdk.AccAddress, msgType string, granter sdk.AccAddress) []byte {
+	return grantIndexKey(GranteeIndexPrefix, grantee, msgType, granter)
+}
+
+// GranterMsgTypeIndexKey - return the granter msg type index store key
+// Key format is
+//
+// - 0x04<granterAddressLen (1 Byte)><granterAddress_Bytes><msgTypeLen (1 Byte)><msgType_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes>: []byte{}
+func GranterMsgTypeIndexKey(granter sdk.AccAddress, msgType string, grantee sdk.AccAddress) []byte {
+	return grantIndexKey(GranterMsgTypeIndexPrefix, granter, msgType, grantee)
+}
+
+func grantIndexKey(prefix []byte, first sdk.AccAddress, msgType string, second sdk.AccAddress) []byte {
+	m := address.MustLengthPrefix(conv.UnsafeStrToBytes(msgType))
+	first = address.MustLengthPrefix(first)
+	second = address.MustLengthPrefix(second)
+
+	return sdk.AppendLengthPrefixedBytes(prefix, first, m, second)
+}
@@ -0,0 +1,38 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046 "github.com/cosmos/cosmos-sdk/x/authz/migrations/v046"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47. The
+// migration includes:
+//
+// - create secondary index of the grants by grantee and msg type
+// - create secondary index of the grants by granter and msg type
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+	addGrantIndexes(store)
+
+	return nil
+}
+
+func addGrantIndexes(store storetypes.KVStore) {
+	grantsIter := prefix.NewStore(store, GrantPrefix).Iterator(nil, nil)
+	defer grantsIter.Close()
+
+	var indexKeys [][]byte
+	for ; grantsIter.Valid(); grantsIter.Next() {
+		granter, grantee, msgType := v046.ParseGrantKey(grantsIter.Key())
+		indexKeys = append(indexKeys,
+			GranteeIndexKey(grantee, msgType, granter),
+			GranterMsgTypeIndexKey(granter, msgType, grantee),
+		)
+	}
+
+	for _, key := range indexKeys {
+		store.Set(key, []byte{})
+	}
+}
@@ -0,0 +1,49 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	v046 "github.com/cosmos/cosmos-sdk/x/authz/migrations/v046"
+	v047 "github.com/cosmos/cosmos-sdk/x/authz/migrations/v047"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+func TestMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	cdc := encCfg.Codec
+	authzKey := sdk.NewKVStoreKey("authz")
+	ctx := testutil.DefaultContext(authzKey, sdk.NewTransientStoreKey("transient_test"))
+	granter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	sendMsgType := banktypes.SendAuthorization{}.MsgTypeURL()
+	genericMsgType := sdk.MsgTypeURL(&govtypes.MsgVote{})
+	oneDay := ctx.BlockTime().AddDate(0, 0, 1)
+
+	sendAny, err := codectypes.NewAnyWithValue(banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 100))))
+	require.NoError(t, err)
+	genericAny, err := codectypes.NewAnyWithValue(authz.NewGenericAuthorization(genericMsgType))
+	require.NoError(t, err)
+
+	store := ctx.KVStore(authzKey)
+	store.Set(v046.GrantStoreKey(grantee1, granter, sendMsgType), cdc.MustMarshal(&authz.Grant{Authorization: sendAny, Expiration: &oneDay}))
+	store.Set(v046.GrantStoreKey(grantee2, granter, genericMsgType), cdc.MustMarshal(&authz.Grant{Authorization: genericAny}))
+
+	require.NoError(t, v047.MigrateStore(ctx, authzKey))
+
+	require.NotNil(t, store.Get(v047.GranteeIndexKey(grantee1, sendMsgType, granter)))
+	require.NotNil(t, store.Get(v047.GranterMsgTypeIndexKey(granter, sendMsgType, grantee1)))
+	require.NotNil(t, store.Get(v047.GranteeIndexKey(grantee2, genericMsgType, granter)))
+	require.NotNil(t, store.Get(v047.GranterMsgTypeIndexKey(granter, genericMsgType, grantee2)))
+	require.Nil(t, store.Get(v047.GranteeIndexKey(grantee1, genericMsgType, granter)))
+}
@@ -5,11 +5,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
 )
 
+// maxPrunedGrantQueueItems bounds the number of grant queue items pruned in a
+// single block, remaining expired grants being pruned in the following blocks.
+const maxPrunedGrantQueueItems = 200
+
 // BeginBlocker is called at the begining of every block
 func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 
-	// delete all the mature grants
-	if err := keeper.DequeueAndDeleteExpiredGrants(ctx); err != nil {
+	// delete the mature grants
+	if err := keeper.DequeueAndDeleteExpiredGrants(ctx, maxPrunedGrantQueueItems); err != nil {
 		panic(err)
 	}
 }
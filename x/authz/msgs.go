@@ -61,6 +61,9 @@ func (msg MsgGrant) ValidateBasic() error {
 	if granter.Equals(grantee) {
 		return ErrGranteeIsGranter
 	}
+	if msg.Grant.Limits != nil && msg.Grant.Limits.HasUsage() {
+		return sdkerrors.ErrInvalidRequest.Wrap("grant limits usage is tracked by the module and cannot be set")
+	}
 	return msg.Grant.ValidateBasic()
 }
 
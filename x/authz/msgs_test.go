@@ -118,6 +118,20 @@ func TestMsgGrantAuthorization(t *testing.T) {
 	}
 }
 
+func TestMsgGrantLimits(t *testing.T) {
+	msg, err := authz.NewMsgGrant(granter, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, nil)
+	require.NoError(t, err)
+
+	msg.Grant.Limits = &authz.GrantLimits{MaxExecutions: 1}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Grant.Limits = &authz.GrantLimits{}
+	require.Error(t, msg.ValidateBasic())
+
+	msg.Grant.Limits = &authz.GrantLimits{MaxExecutions: 1, Executions: 1}
+	require.Error(t, msg.ValidateBasic(), "usage must not be set by the granter")
+}
+
 func TestMsgGrantGetAuthorization(t *testing.T) {
 	require := require.New(t)
 
@@ -257,9 +257,20 @@ func TestMsgMultiSendMultipleInOut(t *testing.T) {
 
 	app.Commit()
 
+	// multi-sends with multiple inputs are rejected, and must be split into
+	// single-input multi-sends
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	txGen := simapp.MakeTestEncodingConfig().TxConfig
+	_, _, err := simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{multiSendMsg3}, "", []uint64{0, 2}, []uint64{0, 0}, false, false, priv1, priv4)
+	require.ErrorIs(t, err, types.ErrMultipleSenders)
+
+	splitMsgs, err := types.SplitMultiSend(multiSendMsg3)
+	require.NoError(t, err)
+	require.Len(t, splitMsgs, 2)
+
 	testCases := []appTestCase{
 		{
-			msgs:       []sdk.Msg{multiSendMsg3},
+			msgs:       []sdk.Msg{splitMsgs[0], splitMsgs[1]},
 			accNums:    []uint64{0, 2},
 			accSeqs:    []uint64{0, 0},
 			expSimPass: true,
@@ -32,6 +32,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetBalancesCmd(),
 		GetCmdQueryTotalSupply(),
+		GetCmdQuerySupplyOffset(),
 		GetCmdDenomsMetadata(),
 	)
 
@@ -213,3 +214,39 @@ To query for the total supply of a specific coin denomination use:
 
 	return cmd
 }
+
+func GetCmdQuerySupplyOffset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-offset [denom]",
+		Short: "Query the supply of a coin along with its supply offset",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the raw supply of a coin denomination, its supply offset and the supply
+adjusted by the offset, which excludes the tokens not counting towards the circulating supply.
+
+Example:
+  $ %s query %s supply-offset [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SupplyOffset(cmd.Context(), &types.QuerySupplyOffsetRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,9 +1,15 @@
 package keeper
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -39,6 +45,12 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, offset := range genState.SupplyOffsets {
+		k.setSupplyOffset(ctx, offset.Denom, offset.Amount)
+	}
+
+	k.SetBurnEnabledDenoms(ctx, genState.BurnEnabledDenoms)
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -48,10 +60,236 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(fmt.Errorf("unable to fetch total supply %v", err))
 	}
 
-	return types.NewGenesisState(
+	genState := types.NewGenesisState(
 		k.GetParams(ctx),
 		k.GetAccountsBalances(ctx),
 		totalSupply,
 		k.GetAllDenomMetaData(ctx),
 	)
+
+	k.IterateSupplyOffsets(ctx, func(offset sdk.Coin) bool {
+		genState.SupplyOffsets = append(genState.SupplyOffsets, offset)
+		return false
+	})
+	genState.BurnEnabledDenoms = k.GetBurnEnabledDenoms(ctx)
+
+	return genState
+}
+
+// InitGenesisFromReader initializes the bank module's state from the JSON genesis state
+// read from r, setting the balances as they are read instead of holding them in memory.
+func (k BaseKeeper) InitGenesisFromReader(ctx sdk.Context, cdc codec.JSONCodec, r io.Reader) error {
+	dec := json.NewDecoder(r)
+	decode := func(msg proto.Message) error {
+		var bz json.RawMessage
+		if err := dec.Decode(&bz); err != nil {
+			return err
+		}
+		return cdc.UnmarshalJSON(bz, msg)
+	}
+
+	var genSupply, totalSupply sdk.Coins
+	err := module.DecodeJSONObject(dec, func(key string) error {
+		switch key {
+		case "params":
+			var params types.Params
+			if err := decode(&params); err != nil {
+				return err
+			}
+			k.SetParams(ctx, params)
+			return nil
+
+		case "balances":
+			return module.DecodeJSONArray(dec, func() error {
+				var balance types.Balance
+				if err := decode(&balance); err != nil {
+					return err
+				}
+				addr, err := sdk.AccAddressFromBech32(balance.Address)
+				if err != nil {
+					return err
+				}
+				if err := k.initBalances(ctx, addr, balance.Coins); err != nil {
+					return fmt.Errorf("error on setting balances %w", err)
+				}
+
+				totalSupply = totalSupply.Add(balance.Coins...)
+				return nil
+			})
+
+		case "supply":
+			return module.DecodeJSONArray(dec, func() error {
+				var supply sdk.Coin
+				if err := decode(&supply); err != nil {
+					return err
+				}
+				genSupply = append(genSupply, supply)
+				return nil
+			})
+
+		case "denom_metadata":
+			return module.DecodeJSONArray(dec, func() error {
+				var meta types.Metadata
+				if err := decode(&meta); err != nil {
+					return err
+				}
+				k.SetDenomMetaData(ctx, meta)
+				return nil
+			})
+
+		case "supply_offsets":
+			return module.DecodeJSONArray(dec, func() error {
+				var offset sdk.Coin
+				if err := decode(&offset); err != nil {
+					return err
+				}
+				k.setSupplyOffset(ctx, offset.Denom, offset.Amount)
+				return nil
+			})
+
+		case "burn_enabled_denoms":
+			var denoms []string
+			if err := module.DecodeJSONArray(dec, func() error {
+				var denom string
+				if err := dec.Decode(&denom); err != nil {
+					return err
+				}
+				denoms = append(denoms, denom)
+				return nil
+			}); err != nil {
+				return err
+			}
+			k.SetBurnEnabledDenoms(ctx, denoms)
+			return nil
+
+		default:
+			return fmt.Errorf("unknown field %q in bank genesis state", key)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if !genSupply.Empty() && !genSupply.IsEqual(totalSupply) {
+		return fmt.Errorf("genesis supply is incorrect, expected %v, got %v", genSupply, totalSupply)
+	}
+
+	for _, supply := range totalSupply {
+		k.setSupply(ctx, supply)
+	}
+
+	return nil
+}
+
+// ExportGenesisToWriter writes the bank module's JSON genesis state to w, iterating over
+// the balances instead of holding them in memory.
+func (k BaseKeeper) ExportGenesisToWriter(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error {
+	ow := module.NewJSONObjectWriter(w)
+
+	params := k.GetParams(ctx)
+	bz, err := cdc.MarshalJSON(&params)
+	if err != nil {
+		return err
+	}
+	if err := ow.WriteField("params", bz); err != nil {
+		return err
+	}
+
+	err = ow.WriteArrayField("balances", func(writeElem func(json.RawMessage) error) error {
+		// the balances are iterated by address, group them into one Balance per address
+		var (
+			balance types.Balance
+			err     error
+		)
+		writeBalance := func() error {
+			bz, err := cdc.MarshalJSON(&balance)
+			if err != nil {
+				return err
+			}
+			return writeElem(bz)
+		}
+
+		k.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+			if address := addr.String(); address != balance.Address {
+				if balance.Address != "" {
+					if err = writeBalance(); err != nil {
+						return true
+					}
+				}
+				balance = types.Balance{Address: address}
+			}
+			balance.Coins = append(balance.Coins, coin)
+			return false
+		})
+		if err != nil || balance.Address == "" {
+			return err
+		}
+		return writeBalance()
+	})
+	if err != nil {
+		return err
+	}
+
+	err = ow.WriteArrayField("supply", func(writeElem func(json.RawMessage) error) error {
+		var err error
+		k.IterateTotalSupply(ctx, func(supply sdk.Coin) bool {
+			var bz []byte
+			if bz, err = cdc.MarshalJSON(&supply); err == nil {
+				err = writeElem(bz)
+			}
+			return err != nil
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	err = ow.WriteArrayField("denom_metadata", func(writeElem func(json.RawMessage) error) error {
+		var err error
+		k.IterateAllDenomMetaData(ctx, func(meta types.Metadata) bool {
+			var bz []byte
+			if bz, err = cdc.MarshalJSON(&meta); err == nil {
+				err = writeElem(bz)
+			}
+			return err != nil
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	err = ow.WriteArrayField("supply_offsets", func(writeElem func(json.RawMessage) error) error {
+		var err error
+		k.IterateSupplyOffsets(ctx, func(offset sdk.Coin) bool {
+			var bz []byte
+			if bz, err = cdc.MarshalJSON(&offset); err == nil {
+				err = writeElem(bz)
+			}
+			return err != nil
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	err = ow.WriteArrayField("burn_enabled_denoms", func(writeElem func(json.RawMessage) error) error {
+		for _, denom := range k.GetBurnEnabledDenoms(ctx) {
+			bz, err := json.Marshal(denom)
+			if err != nil {
+				return err
+			}
+			if err := writeElem(bz); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return ow.Close()
 }
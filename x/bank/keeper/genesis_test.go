@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -43,6 +45,36 @@ func (suite *IntegrationTestSuite) TestExportGenesis() {
 	suite.Require().Equal(expectedMetadata, exportGenesis.DenomMetadata)
 }
 
+func (suite *IntegrationTestSuite) TestExportGenesisToWriter() {
+	app, ctx := suite.app, suite.ctx
+
+	expectedMetadata := suite.getTestMetadata()
+	expectedBalances, _ := suite.getTestBalancesAndSupply()
+	for i := range []int{0, 1} {
+		app.BankKeeper.SetDenomMetaData(ctx, expectedMetadata[i])
+		accAddr, err := sdk.AccAddressFromBech32(expectedBalances[i].Address)
+		suite.Require().NoError(err)
+		suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, expectedBalances[i].Coins))
+		suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, accAddr, expectedBalances[i].Coins))
+	}
+	app.BankKeeper.AddSupplyOffset(ctx, "foocoin", sdk.NewInt(-3))
+
+	var buf bytes.Buffer
+	suite.Require().NoError(app.BankKeeper.ExportGenesisToWriter(ctx, app.AppCodec(), &buf))
+
+	suite.Require().Equal(string(app.AppCodec().MustMarshalJSON(app.BankKeeper.ExportGenesis(ctx))), buf.String())
+
+	var streamed types.GenesisState
+	suite.Require().NoError(app.AppCodec().UnmarshalJSON(buf.Bytes(), &streamed))
+	suite.Require().Subset(streamed.Balances, expectedBalances)
+	suite.Require().Equal(sdk.SignedCoins{{Denom: "foocoin", Amount: sdk.NewInt(-3)}}, streamed.SupplyOffsets)
+
+	initCtx, _ := ctx.CacheContext()
+	app.BankKeeper.AddSupplyOffset(initCtx, "foocoin", sdk.NewInt(3))
+	suite.Require().NoError(app.BankKeeper.InitGenesisFromReader(initCtx, app.AppCodec(), &buf))
+	suite.Require().Equal(sdk.NewInt(-3), app.BankKeeper.GetSupplyOffset(initCtx, "foocoin"))
+}
+
 func (suite *IntegrationTestSuite) getTestBalancesAndSupply() ([]types.Balance, sdk.Coins) {
 	addr2, _ := sdk.AccAddressFromBech32("cosmos1f9xjhxm0plzrh9cskf4qee4pc2xwp0n0556gh0")
 	addr1, _ := sdk.AccAddressFromBech32("cosmos1t5u0jfg3ljsjrh2m9e47d4ny2hea7eehxrzdgd")
@@ -124,3 +156,68 @@ func (suite *IntegrationTestSuite) TestTotalSupply() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestInitGenesisFromReader() {
+	defaultGenesis := types.DefaultGenesisState()
+	m := types.Metadata{Description: sdk.DefaultBondDenom, Base: sdk.DefaultBondDenom, Display: sdk.DefaultBondDenom}
+	balances := []types.Balance{
+		{Coins: sdk.NewCoins(sdk.NewCoin("foocoin", sdk.NewInt(1))), Address: "cosmos1f9xjhxm0plzrh9cskf4qee4pc2xwp0n0556gh0"},
+		{Coins: sdk.NewCoins(sdk.NewCoin("foocoin", sdk.NewInt(10)), sdk.NewCoin("barcoin", sdk.NewInt(20))), Address: "cosmos1m3h30wlvsf8llruxtpukdvsy0km2kum8g38c8q"},
+	}
+	totalSupply := sdk.NewCoins(sdk.NewCoin("foocoin", sdk.NewInt(11)), sdk.NewCoin("barcoin", sdk.NewInt(20)))
+
+	genesisSupply, _, err := suite.app.BankKeeper.GetPaginatedTotalSupply(suite.ctx, &query.PageRequest{Limit: query.MaxLimit})
+	suite.Require().NoError(err)
+
+	testcases := []struct {
+		name      string
+		genesis   *types.GenesisState
+		expSupply sdk.Coins
+		expErrMsg string
+	}{
+		{
+			"calculation NOT matching genesis Supply field",
+			types.NewGenesisState(defaultGenesis.Params, balances, sdk.NewCoins(sdk.NewCoin("wrongcoin", sdk.NewInt(1))), defaultGenesis.DenomMetadata),
+			nil, "genesis supply is incorrect, expected 1wrongcoin, got 20barcoin,11foocoin",
+		},
+		{
+			"calculation matches genesis Supply field",
+			types.NewGenesisState(defaultGenesis.Params, balances, totalSupply, []types.Metadata{m}),
+			totalSupply, "",
+		},
+		{
+			"calculation is correct, empty genesis Supply field",
+			types.NewGenesisState(defaultGenesis.Params, balances, nil, defaultGenesis.DenomMetadata),
+			totalSupply, "",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			bz := suite.app.AppCodec().MustMarshalJSON(tc.genesis)
+			err := suite.app.BankKeeper.InitGenesisFromReader(ctx, suite.app.AppCodec(), bytes.NewReader(bz))
+			if tc.expErrMsg != "" {
+				suite.Require().EqualError(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			for _, balance := range tc.genesis.Balances {
+				addr, err := sdk.AccAddressFromBech32(balance.Address)
+				suite.Require().NoError(err)
+				suite.Require().Equal(balance.Coins, suite.app.BankKeeper.GetAllBalances(ctx, addr))
+			}
+			for _, meta := range tc.genesis.DenomMetadata {
+				found, ok := suite.app.BankKeeper.GetDenomMetaData(ctx, meta.Base)
+				suite.Require().True(ok)
+				suite.Require().Equal(meta, found)
+			}
+
+			totalSupply, _, err := suite.app.BankKeeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: query.MaxLimit})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expSupply.Add(genesisSupply...), totalSupply)
+		})
+	}
+}
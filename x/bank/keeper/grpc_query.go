@@ -138,6 +138,27 @@ func (k BaseKeeper) SupplyOf(c context.Context, req *types.QuerySupplyOfRequest)
 	return &types.QuerySupplyOfResponse{Amount: sdk.NewCoin(req.Denom, supply.Amount)}, nil
 }
 
+// SupplyOffset implements the Query/SupplyOffset gRPC method
+func (k BaseKeeper) SupplyOffset(c context.Context, req *types.QuerySupplyOffsetRequest) (*types.QuerySupplyOffsetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	supply := k.GetSupply(ctx, req.Denom)
+	offset := k.GetSupplyOffset(ctx, req.Denom)
+
+	return &types.QuerySupplyOffsetResponse{
+		Supply:           supply,
+		Offset:           offset,
+		SupplyWithOffset: sdk.Coin{Denom: req.Denom, Amount: supply.Amount.Add(offset)},
+	}, nil
+}
+
 // Params implements the gRPC service handler for querying x/bank parameters.
 func (k BaseKeeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
@@ -147,7 +168,7 @@ func (k BaseKeeper) Params(ctx context.Context, req *types.QueryParamsRequest) (
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	params := k.GetParams(sdkCtx)
 
-	return &types.QueryParamsResponse{Params: params}, nil
+	return &types.QueryParamsResponse{Params: params, BurnEnabledDenoms: k.GetBurnEnabledDenoms(sdkCtx)}, nil
 }
 
 // DenomsMetadata implements Query/DenomsMetadata gRPC method.
@@ -246,3 +267,37 @@ func (k BaseKeeper) DenomOwners(
 
 	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
 }
+
+// AllBalancesStream implements the Query/AllBalancesStream gRPC method
+func (k BaseKeeper) AllBalancesStream(req *types.QueryAllBalancesStreamRequest, stream types.Query_AllBalancesStreamServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := stream.Context()
+	return query.StreamPages(ctx, req.Pagination, func(pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := k.AllBalances(ctx, &types.QueryAllBalancesRequest{Address: req.Address, Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Pagination, stream.Send(&types.QueryAllBalancesStreamResponse{Balances: res.Balances, Pagination: res.Pagination})
+	})
+}
+
+// DenomOwnersStream implements the Query/DenomOwnersStream gRPC method
+func (k BaseKeeper) DenomOwnersStream(req *types.QueryDenomOwnersStreamRequest, stream types.Query_DenomOwnersStreamServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := stream.Context()
+	return query.StreamPages(ctx, req.Pagination, func(pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := k.DenomOwners(ctx, &types.QueryDenomOwnersRequest{Denom: req.Denom, Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Pagination, stream.Send(&types.QueryDenomOwnersStreamResponse{DenomOwners: res.DenomOwners, Pagination: res.Pagination})
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"google.golang.org/grpc"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -179,6 +181,29 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
 	suite.Require().Equal(test1Supply, res.Amount)
 }
 
+func (suite *IntegrationTestSuite) TestQuerySupplyOffset() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	supply := sdk.NewInt64Coin("test1", 4000000)
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(supply)))
+	app.BankKeeper.AddSupplyOffset(ctx, supply.Denom, sdk.NewInt(-1000000))
+
+	_, err := queryClient.SupplyOffset(gocontext.Background(), &types.QuerySupplyOffsetRequest{})
+	suite.Require().Error(err)
+
+	res, err := queryClient.SupplyOffset(gocontext.Background(), &types.QuerySupplyOffsetRequest{Denom: supply.Denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(supply, res.Supply)
+	suite.Require().Equal(sdk.NewInt(-1000000), res.Offset)
+	suite.Require().Equal(sdk.NewInt64Coin(supply.Denom, 3000000), res.SupplyWithOffset)
+
+	res, err = queryClient.SupplyOffset(gocontext.Background(), &types.QuerySupplyOffsetRequest{Denom: "test2"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("test2", 0), res.Supply)
+	suite.Require().Equal(sdk.ZeroInt(), res.Offset)
+	suite.Require().Equal(sdk.NewInt64Coin("test2", 0), res.SupplyWithOffset)
+}
+
 func (suite *IntegrationTestSuite) TestQueryParams() {
 	res, err := suite.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
 	suite.Require().NoError(err)
@@ -453,3 +478,61 @@ func (suite *IntegrationTestSuite) TestGRPCDenomOwners() {
 
 	suite.Require().True(true)
 }
+
+// denomOwnersStream is a Query/DenomOwnersStream server stream collecting the
+// streamed pages.
+type denomOwnersStream struct {
+	grpc.ServerStream
+	ctx   gocontext.Context
+	pages []*types.QueryDenomOwnersStreamResponse
+}
+
+func (s *denomOwnersStream) Context() gocontext.Context { return s.ctx }
+
+func (s *denomOwnersStream) Send(res *types.QueryDenomOwnersStreamResponse) error {
+	s.pages = append(s.pages, res)
+	return nil
+}
+
+func (suite *IntegrationTestSuite) TestGRPCDenomOwnersStream() {
+	ctx := suite.ctx
+
+	authKeeper, keeper := suite.initKeepersWithmAccPerms(make(map[string]bool))
+	suite.Require().NoError(keeper.MintCoins(ctx, minttypes.ModuleName, initCoins))
+
+	for i := 0; i < 10; i++ {
+		acc := authKeeper.NewAccountWithAddress(ctx, authtypes.NewModuleAddress(fmt.Sprintf("account-%d", i)))
+		authKeeper.SetAccount(ctx, acc)
+
+		bal := sdk.NewCoins(sdk.NewCoin(
+			sdk.DefaultBondDenom,
+			sdk.TokensFromConsensusPower(initialPower/10, sdk.DefaultPowerReduction),
+		))
+		suite.Require().NoError(keeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, acc.GetAddress(), bal))
+	}
+
+	owners, err := keeper.DenomOwners(sdk.WrapSDKContext(ctx), &types.QueryDenomOwnersRequest{Denom: sdk.DefaultBondDenom})
+	suite.Require().NoError(err)
+	suite.Require().Len(owners.DenomOwners, 13)
+
+	stream := &denomOwnersStream{ctx: sdk.WrapSDKContext(ctx)}
+	err = keeper.DenomOwnersStream(
+		&types.QueryDenomOwnersStreamRequest{Denom: sdk.DefaultBondDenom, Pagination: &query.PageRequest{Limit: 5}},
+		stream,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(stream.pages, 3)
+
+	var streamed []*types.DenomOwner
+	for i, page := range stream.pages {
+		streamed = append(streamed, page.DenomOwners...)
+		// only the last page has no next key
+		suite.Require().Equal(i == len(stream.pages)-1, len(page.Pagination.NextKey) == 0)
+	}
+	suite.Require().Equal(owners.DenomOwners, streamed)
+
+	// the errors of the query fail the stream
+	stream = &denomOwnersStream{ctx: sdk.WrapSDKContext(ctx)}
+	suite.Require().Error(keeper.DenomOwnersStream(&types.QueryDenomOwnersStreamRequest{}, stream))
+	suite.Require().Empty(stream.pages)
+}
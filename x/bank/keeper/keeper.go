@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/internal/conv"
@@ -25,11 +26,17 @@ type Keeper interface {
 
 	InitGenesis(sdk.Context, *types.GenesisState)
 	ExportGenesis(sdk.Context) *types.GenesisState
+	InitGenesisFromReader(sdk.Context, codec.JSONCodec, io.Reader) error
+	ExportGenesisToWriter(sdk.Context, codec.JSONCodec, io.Writer) error
 
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	HasSupply(ctx sdk.Context, denom string) bool
 	GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 	IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool)
+	GetSupplyOffset(ctx sdk.Context, denom string) sdk.Int
+	AddSupplyOffset(ctx sdk.Context, denom string, offsetAmount sdk.Int)
+	GetSupplyWithOffset(ctx sdk.Context, denom string) sdk.Coin
+	IterateSupplyOffsets(ctx sdk.Context, cb func(sdk.Coin) bool)
 	GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool)
 	HasDenomMetaData(ctx sdk.Context, denom string) bool
 	SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata)
@@ -42,6 +49,11 @@ type Keeper interface {
 	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnAccountCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error
+
+	GetBurnEnabledDenoms(ctx sdk.Context) []string
+	SetBurnEnabledDenoms(ctx sdk.Context, denoms []string)
+	IsBurnEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
@@ -142,6 +154,13 @@ func (k BaseKeeper) WithMintCoinsRestriction(check MintingRestrictionFn) BaseKee
 // address to a ModuleAccount address. If any of the delegation amounts are negative,
 // an error is returned.
 func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.delegateCoins(ctx, delegatorAddr, moduleAccAddr, amt)
+}
+
+// delegateCoins performs a delegation like DelegateCoins, the extra attributes,
+// e.g. the name of the receiving module, labelling its coin_spent and
+// coin_received events.
+func (k BaseKeeper) delegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins, attrs ...sdk.Attribute) error {
 	moduleAcc := k.ak.GetAccount(ctx, moduleAccAddr)
 	if moduleAcc == nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleAccAddr)
@@ -173,10 +192,10 @@ func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr
 	}
 	// emit coin spent event
 	ctx.EventManager().EmitEvent(
-		types.NewCoinSpentEvent(delegatorAddr, amt),
+		types.NewCoinSpentEvent(delegatorAddr, amt).AppendAttributes(attrs...),
 	)
 
-	err := k.addCoins(ctx, moduleAccAddr, amt)
+	err := k.addCoins(ctx, moduleAccAddr, amt, attrs...)
 	if err != nil {
 		return err
 	}
@@ -190,6 +209,13 @@ func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr
 // address to the delegator address. If any of the undelegation amounts are
 // negative, an error is returned.
 func (k BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.undelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt)
+}
+
+// undelegateCoins performs an undelegation like UndelegateCoins, the extra
+// attributes, e.g. the name of the sending module, labelling its coin_spent and
+// coin_received events.
+func (k BaseKeeper) undelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins, attrs ...sdk.Attribute) error {
 	moduleAcc := k.ak.GetAccount(ctx, moduleAccAddr)
 	if moduleAcc == nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleAccAddr)
@@ -199,7 +225,7 @@ func (k BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAdd
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
-	err := k.subUnlockedCoins(ctx, moduleAccAddr, amt)
+	err := k.subUnlockedCoins(ctx, moduleAccAddr, amt, attrs...)
 	if err != nil {
 		return err
 	}
@@ -208,7 +234,7 @@ func (k BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAdd
 		return sdkerrors.Wrap(err, "failed to track undelegation")
 	}
 
-	err = k.addCoins(ctx, delegatorAddr, amt)
+	err = k.addCoins(ctx, delegatorAddr, amt, attrs...)
 	if err != nil {
 		return err
 	}
@@ -248,6 +274,61 @@ func (k BaseKeeper) HasSupply(ctx sdk.Context, denom string) bool {
 	return supplyStore.Has(conv.UnsafeStrToBytes(denom))
 }
 
+// GetSupplyOffset retrieves the supply offset of a denom, zero if it has none.
+func (k BaseKeeper) GetSupplyOffset(ctx sdk.Context, denom string) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	offsetStore := prefix.NewStore(store, types.SupplyOffsetPrefix)
+
+	bz := offsetStore.Get(conv.UnsafeStrToBytes(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("unable to unmarshal supply offset value %v", err))
+	}
+
+	return amount
+}
+
+// AddSupplyOffset adds offsetAmount, which can be negative, to the supply
+// offset of a denom. Modules minting tokens that exist in state but must not
+// count towards the circulating supply, e.g. shadow-minted vesting or bridged
+// assets, record them with a negative offset.
+func (k BaseKeeper) AddSupplyOffset(ctx sdk.Context, denom string, offsetAmount sdk.Int) {
+	k.setSupplyOffset(ctx, denom, k.GetSupplyOffset(ctx, denom).Add(offsetAmount))
+}
+
+// GetSupplyWithOffset retrieves the supply of a denom adjusted by its supply
+// offset.
+func (k BaseKeeper) GetSupplyWithOffset(ctx sdk.Context, denom string) sdk.Coin {
+	supply := k.GetSupply(ctx, denom)
+	supply.Amount = supply.Amount.Add(k.GetSupplyOffset(ctx, denom))
+	return supply
+}
+
+// IterateSupplyOffsets iterates over the non-zero supply offsets calling the
+// given cb (callback) function with the offset of each denom.
+func (k BaseKeeper) IterateSupplyOffsets(ctx sdk.Context, cb func(sdk.Coin) bool) {
+	store := ctx.KVStore(k.storeKey)
+	offsetStore := prefix.NewStore(store, types.SupplyOffsetPrefix)
+
+	iterator := offsetStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(fmt.Errorf("unable to unmarshal supply offset value %v", err))
+		}
+
+		if cb(sdk.Coin{Denom: string(iterator.Key()), Amount: amount}) {
+			break
+		}
+	}
+}
+
 // GetDenomMetaData retrieves the denomination metadata. returns the metadata and true if the denom exists,
 // false otherwise.
 func (k BaseKeeper) GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool) {
@@ -328,7 +409,7 @@ func (k BaseKeeper) SendCoinsFromModuleToAccount(
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientAddr)
 	}
 
-	return k.SendCoins(ctx, senderAddr, recipientAddr, amt)
+	return k.sendCoins(ctx, senderAddr, recipientAddr, amt, senderModule, "")
 }
 
 // SendCoinsFromModuleToModule transfers coins from a ModuleAccount to another.
@@ -347,7 +428,7 @@ func (k BaseKeeper) SendCoinsFromModuleToModule(
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
 	}
 
-	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+	return k.sendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt, senderModule, recipientModule)
 }
 
 // SendCoinsFromAccountToModule transfers coins from an AccAddress to a ModuleAccount.
@@ -361,7 +442,7 @@ func (k BaseKeeper) SendCoinsFromAccountToModule(
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
 	}
 
-	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+	return k.sendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt, "", recipientModule)
 }
 
 // DelegateCoinsFromAccountToModule delegates coins and transfers them from a
@@ -380,7 +461,7 @@ func (k BaseKeeper) DelegateCoinsFromAccountToModule(
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to receive delegated coins", recipientModule))
 	}
 
-	return k.DelegateCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+	return k.delegateCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt, sdk.NewAttribute(types.AttributeKeyRecipientModule, recipientModule))
 }
 
 // UndelegateCoinsFromModuleToAccount undelegates the unbonding coins and transfers
@@ -399,7 +480,7 @@ func (k BaseKeeper) UndelegateCoinsFromModuleToAccount(
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to undelegate coins", senderModule))
 	}
 
-	return k.UndelegateCoins(ctx, acc.GetAddress(), recipientAddr, amt)
+	return k.undelegateCoins(ctx, acc.GetAddress(), recipientAddr, amt, sdk.NewAttribute(types.AttributeKeySenderModule, senderModule))
 }
 
 // MintCoins creates new coins from thin air and adds it to the module account.
@@ -435,7 +516,8 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 
 	// emit mint event
 	ctx.EventManager().EmitEvent(
-		types.NewCoinMintEvent(acc.GetAddress(), amounts),
+		types.NewCoinMintEvent(acc.GetAddress(), amounts).
+			AppendAttributes(sdk.NewAttribute(types.AttributeKeyMinterModule, moduleName)),
 	)
 
 	return nil
@@ -453,7 +535,27 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to burn tokens", moduleName))
 	}
 
-	err := k.subUnlockedCoins(ctx, acc.GetAddress(), amounts)
+	if err := k.burnCoins(ctx, acc.GetAddress(), amounts, moduleName); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("burned tokens from module account", "amount", amounts.String(), "from", moduleName)
+
+	return nil
+}
+
+// BurnAccountCoins burns coins from the balance of an account, decreasing the
+// supply. Unlike BurnCoins, the account doesn't need to be a module account
+// with the burner permission, and the burn enabled denoms are not checked.
+func (k BaseKeeper) BurnAccountCoins(ctx sdk.Context, addr sdk.AccAddress, amounts sdk.Coins) error {
+	return k.burnCoins(ctx, addr, amounts, "")
+}
+
+// burnCoins deletes coins from the balance of addr and from the supply. The
+// name of the burning module, if any, labels the burn event.
+func (k BaseKeeper) burnCoins(ctx sdk.Context, addr sdk.AccAddress, amounts sdk.Coins, moduleName string) error {
+	err := k.subUnlockedCoins(ctx, addr, amounts)
 	if err != nil {
 		return err
 	}
@@ -464,13 +566,36 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 		k.setSupply(ctx, supply)
 	}
 
-	logger := k.Logger(ctx)
-	logger.Info("burned tokens from module account", "amount", amounts.String(), "from", moduleName)
-
 	// emit burn event
-	ctx.EventManager().EmitEvent(
-		types.NewCoinBurnEvent(acc.GetAddress(), amounts),
-	)
+	burnEvent := types.NewCoinBurnEvent(addr, amounts)
+	if moduleName != "" {
+		burnEvent = burnEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyBurnerModule, moduleName))
+	}
+	ctx.EventManager().EmitEvent(burnEvent)
+
+	return nil
+}
+
+// GetBurnEnabledDenoms returns the denoms the accounts can burn with MsgBurn,
+// none if unset.
+func (k BaseKeeper) GetBurnEnabledDenoms(ctx sdk.Context) []string {
+	var denoms []string
+	k.paramSpace.GetIfExists(ctx, types.KeyBurnEnabledDenoms, &denoms)
+	return denoms
+}
+
+// SetBurnEnabledDenoms sets the denoms the accounts can burn with MsgBurn.
+func (k BaseKeeper) SetBurnEnabledDenoms(ctx sdk.Context, denoms []string) {
+	k.paramSpace.Set(ctx, types.KeyBurnEnabledDenoms, denoms)
+}
+
+// IsBurnEnabledCoins returns an error if the denom of any of the coins is not
+// enabled for burning with MsgBurn.
+func (k BaseKeeper) IsBurnEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error {
+	disabled := sdk.Coins(coins).DenyDenoms(k.GetBurnEnabledDenoms(ctx)...)
+	if len(disabled) > 0 {
+		return sdkerrors.Wrapf(types.ErrBurnDisabled, "%s is not enabled for burning", disabled[0].Denom)
+	}
 
 	return nil
 }
@@ -493,6 +618,23 @@ func (k BaseKeeper) setSupply(ctx sdk.Context, coin sdk.Coin) {
 	}
 }
 
+// setSupplyOffset sets the supply offset of a denom, removing it when zero.
+func (k BaseKeeper) setSupplyOffset(ctx sdk.Context, denom string, offsetAmount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	offsetStore := prefix.NewStore(store, types.SupplyOffsetPrefix)
+
+	if offsetAmount.IsZero() {
+		offsetStore.Delete(conv.UnsafeStrToBytes(denom))
+		return
+	}
+
+	intBytes, err := offsetAmount.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("unable to marshal amount value %v", err))
+	}
+	offsetStore.Set([]byte(denom), intBytes)
+}
+
 // trackDelegation tracks the delegation of the given account if it is a vesting account
 func (k BaseKeeper) trackDelegation(ctx sdk.Context, addr sdk.AccAddress, balance, amt sdk.Coins) error {
 	acc := k.ak.GetAccount(ctx, addr)
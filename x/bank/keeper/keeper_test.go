@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmtime "github.com/tendermint/tendermint/libs/time"
@@ -13,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -144,6 +146,39 @@ func (suite *IntegrationTestSuite) TestSupply() {
 	require.Equal(total, genesisSupply)
 }
 
+func (suite *IntegrationTestSuite) TestSupplyOffset() {
+	ctx, keeper := suite.ctx, suite.app.BankKeeper
+	require := suite.Require()
+
+	denom := "shadow"
+	require.NoError(keeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	require.Equal(sdk.ZeroInt(), keeper.GetSupplyOffset(ctx, denom))
+	require.Equal(sdk.NewInt64Coin(denom, 100), keeper.GetSupplyWithOffset(ctx, denom))
+
+	keeper.AddSupplyOffset(ctx, denom, sdk.NewInt(-60))
+	keeper.AddSupplyOffset(ctx, denom, sdk.NewInt(-10))
+	require.Equal(sdk.NewInt(-70), keeper.GetSupplyOffset(ctx, denom))
+	require.Equal(sdk.NewInt64Coin(denom, 30), keeper.GetSupplyWithOffset(ctx, denom))
+	require.Equal(sdk.NewInt64Coin(denom, 100), keeper.GetSupply(ctx, denom))
+
+	var offsets sdk.SignedCoins
+	keeper.IterateSupplyOffsets(ctx, func(offset sdk.Coin) bool {
+		offsets = append(offsets, offset)
+		return false
+	})
+	require.Equal(sdk.SignedCoins{{Denom: denom, Amount: sdk.NewInt(-70)}}, offsets)
+
+	// a zero offset is removed from the store
+	keeper.AddSupplyOffset(ctx, denom, sdk.NewInt(70))
+	offsets = nil
+	keeper.IterateSupplyOffsets(ctx, func(offset sdk.Coin) bool {
+		offsets = append(offsets, offset)
+		return false
+	})
+	require.Empty(offsets)
+	require.Equal(sdk.NewInt64Coin(denom, 100), keeper.GetSupplyWithOffset(ctx, denom))
+}
+
 func (suite *IntegrationTestSuite) TestSendCoinsFromModuleToAccount_Blocklist() {
 	ctx := suite.ctx
 
@@ -307,6 +342,51 @@ func (suite *IntegrationTestSuite) TestSupply_BurnCoins() {
 	suite.Require().Equal(supplyAfterInflation.Sub(initCoins...), supplyAfterBurn)
 }
 
+func (suite *IntegrationTestSuite) TestMsgBurn() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(100))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr, balances))
+	supply := app.BankKeeper.GetSupply(ctx, fooDenom)
+
+	// no denom is enabled for burning by default
+	burn := sdk.NewCoins(newFooCoin(40))
+	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, burn))
+	suite.Require().ErrorIs(err, types.ErrBurnDisabled)
+
+	app.BankKeeper.SetBurnEnabledDenoms(ctx, []string{fooDenom})
+	suite.Require().Equal([]string{fooDenom}, app.BankKeeper.GetBurnEnabledDenoms(ctx))
+
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, sdk.NewCoins(newFooCoin(40), newBarCoin(40))))
+	suite.Require().ErrorIs(err, types.ErrBurnDisabled)
+
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, sdk.NewCoins(newFooCoin(101))))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, burn))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(balances.Sub(burn...), app.BankKeeper.GetAllBalances(ctx, addr))
+	suite.Require().Equal(supply.Sub(newFooCoin(40)), app.BankKeeper.GetSupply(ctx, fooDenom))
+
+	events := ctx.EventManager().ABCIEvents()
+	suite.Require().Contains(events, abci.Event(types.NewCoinBurnEvent(addr, burn)))
+
+	var burnEvent *types.EventBurn
+	for _, event := range events {
+		if event.Type == proto.MessageName(&types.EventBurn{}) {
+			msg, err := sdk.ParseTypedEvent(event)
+			suite.Require().NoError(err)
+			burnEvent = msg.(*types.EventBurn)
+		}
+	}
+	suite.Require().Equal(&types.EventBurn{Burner: addr.String(), Amount: burn}, burnEvent)
+}
+
 func (suite *IntegrationTestSuite) TestSendCoinsNewAccount() {
 	app, ctx := suite.app, suite.ctx
 	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
@@ -361,7 +441,7 @@ func (suite *IntegrationTestSuite) TestInputOutputNewAccount() {
 		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
 	}
 
-	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs[0], outputs))
 
 	expected := sdk.NewCoins(newFooCoin(30), newBarCoin(10))
 	acc2Balances := app.BankKeeper.GetAllBalances(ctx, addr2)
@@ -385,30 +465,32 @@ func (suite *IntegrationTestSuite) TestInputOutputCoins() {
 	acc3 := app.AccountKeeper.NewAccountWithAddress(ctx, addr3)
 	app.AccountKeeper.SetAccount(ctx, acc3)
 
-	inputs := []types.Input{
-		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
-		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
-	}
+	input := types.Input{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(60), newBarCoin(20))}
 	outputs := []types.Output{
 		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
 		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
 	}
 
-	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, []types.Output{}))
-	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, input, []types.Output{}))
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, input, outputs))
 
 	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
 
-	insufficientInputs := []types.Input{
-		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(300), newBarCoin(100))},
-		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(300), newBarCoin(100))},
+	mismatchedOutputs := []types.Output{
+		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
+		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(20), newBarCoin(10))},
 	}
+	err := app.BankKeeper.InputOutputCoins(ctx, input, mismatchedOutputs)
+	suite.Require().ErrorIs(err, types.ErrInputOutputMismatch)
+	suite.Require().EqualError(err, "sum inputs != sum outputs: foo: 60 in, 50 out")
+
+	insufficientInput := types.Input{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(600), newBarCoin(200))}
 	insufficientOutputs := []types.Output{
 		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(300), newBarCoin(100))},
 		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(300), newBarCoin(100))},
 	}
-	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, insufficientInputs, insufficientOutputs))
-	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, insufficientInput, insufficientOutputs))
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, input, outputs))
 
 	acc1Balances := app.BankKeeper.GetAllBalances(ctx, addr1)
 	expected := sdk.NewCoins(newFooCoin(30), newBarCoin(10))
@@ -587,43 +669,164 @@ func (suite *IntegrationTestSuite) TestMsgSendEvents() {
 	suite.Require().Equal(abci.Event(event2), events[9])
 }
 
+func (suite *IntegrationTestSuite) TestModuleTransferEvents() {
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	authKeeper, keeper := suite.initKeepersWithmAccPerms(make(map[string]bool))
+	authKeeper.SetModuleAccount(ctx, minterAcc)
+	authKeeper.SetModuleAccount(ctx, burnerAcc)
+	authKeeper.SetModuleAccount(ctx, holderAcc)
+
+	addr := sdk.AccAddress("addr1_______________")
+	coins := sdk.NewCoins(newFooCoin(100))
+
+	suite.Require().NoError(keeper.MintCoins(ctx, authtypes.Minter, coins))
+	suite.Require().NoError(keeper.SendCoinsFromModuleToModule(ctx, authtypes.Minter, holder, coins))
+	suite.Require().NoError(keeper.SendCoinsFromModuleToAccount(ctx, holder, addr, coins))
+	suite.Require().NoError(keeper.SendCoinsFromAccountToModule(ctx, addr, authtypes.Burner, coins))
+	suite.Require().NoError(keeper.BurnCoins(ctx, authtypes.Burner, coins))
+
+	// the attributes of the events by type, the sender and recipient modules
+	// labelling the transfers
+	attrsByType := make(map[string][]map[string]string)
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		attrs := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		attrsByType[event.Type] = append(attrsByType[event.Type], attrs)
+	}
+
+	suite.Require().Equal([]map[string]string{{
+		types.AttributeKeyMinter:       minterAcc.GetAddress().String(),
+		sdk.AttributeKeyAmount:         coins.String(),
+		types.AttributeKeyMinterModule: authtypes.Minter,
+	}}, attrsByType[types.EventTypeCoinMint])
+
+	suite.Require().Equal([]map[string]string{
+		{
+			types.AttributeKeySender:          minterAcc.GetAddress().String(),
+			types.AttributeKeyRecipient:       holderAcc.GetAddress().String(),
+			sdk.AttributeKeyAmount:            coins.String(),
+			types.AttributeKeySenderModule:    authtypes.Minter,
+			types.AttributeKeyRecipientModule: holder,
+		},
+		{
+			types.AttributeKeySender:       holderAcc.GetAddress().String(),
+			types.AttributeKeyRecipient:    addr.String(),
+			sdk.AttributeKeyAmount:         coins.String(),
+			types.AttributeKeySenderModule: holder,
+		},
+		{
+			types.AttributeKeySender:          addr.String(),
+			types.AttributeKeyRecipient:       burnerAcc.GetAddress().String(),
+			sdk.AttributeKeyAmount:            coins.String(),
+			types.AttributeKeyRecipientModule: authtypes.Burner,
+		},
+	}, attrsByType[types.EventTypeTransfer])
+
+	suite.Require().Equal([]map[string]string{{
+		types.AttributeKeyBurner:       burnerAcc.GetAddress().String(),
+		sdk.AttributeKeyAmount:         coins.String(),
+		types.AttributeKeyBurnerModule: authtypes.Burner,
+	}}, attrsByType[types.EventTypeCoinBurn])
+
+	// and so are the delegations to and from a module account
+	authKeeper.SetModuleAccount(ctx, multiPermAcc)
+	suite.Require().NoError(testutil.FundAccount(keeper, ctx, addr, coins))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(keeper.DelegateCoinsFromAccountToModule(ctx, addr, multiPerm, coins))
+	suite.Require().NoError(keeper.UndelegateCoinsFromModuleToAccount(ctx, multiPerm, addr, coins))
+
+	var spent, received []map[string]string
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		attrs := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		switch event.Type {
+		case types.EventTypeCoinSpent:
+			spent = append(spent, attrs)
+		case types.EventTypeCoinReceived:
+			received = append(received, attrs)
+		}
+	}
+
+	suite.Require().Equal([]map[string]string{
+		{
+			types.AttributeKeySpender:         addr.String(),
+			sdk.AttributeKeyAmount:            coins.String(),
+			types.AttributeKeyRecipientModule: multiPerm,
+		},
+		{
+			types.AttributeKeySpender:      multiPermAcc.GetAddress().String(),
+			sdk.AttributeKeyAmount:         coins.String(),
+			types.AttributeKeySenderModule: multiPerm,
+		},
+	}, spent)
+	suite.Require().Equal([]map[string]string{
+		{
+			types.AttributeKeyReceiver:        multiPermAcc.GetAddress().String(),
+			sdk.AttributeKeyAmount:            coins.String(),
+			types.AttributeKeyRecipientModule: multiPerm,
+		},
+		{
+			types.AttributeKeyReceiver:     addr.String(),
+			sdk.AttributeKeyAmount:         coins.String(),
+			types.AttributeKeySenderModule: multiPerm,
+		},
+	}, received)
+
+	// the transfers between accounts are not labelled
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(testutil.FundAccount(keeper, ctx, addr, coins))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(keeper.SendCoins(ctx, addr, holderAcc.GetAddress(), coins))
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		for _, attr := range event.Attributes {
+			suite.Require().NotEqual(types.AttributeKeySenderModule, string(attr.Key))
+			suite.Require().NotEqual(types.AttributeKeyRecipientModule, string(attr.Key))
+		}
+	}
+}
+
 func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 	app, ctx := suite.app, suite.ctx
 
 	app.BankKeeper.SetParams(ctx, types.DefaultParams())
 
 	addr := sdk.AccAddress([]byte("addr1_______________"))
-	addr2 := sdk.AccAddress([]byte("addr2_______________"))
 	addr3 := sdk.AccAddress([]byte("addr3_______________"))
 	addr4 := sdk.AccAddress([]byte("addr4_______________"))
 	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
-	acc2 := app.AccountKeeper.NewAccountWithAddress(ctx, addr2)
 
 	app.AccountKeeper.SetAccount(ctx, acc)
-	app.AccountKeeper.SetAccount(ctx, acc2)
 
 	newCoins := sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 50))
 	newCoins2 := sdk.NewCoins(sdk.NewInt64Coin(barDenom, 100))
-	inputs := []types.Input{
-		{Address: addr.String(), Coins: newCoins},
-		{Address: addr2.String(), Coins: newCoins2},
-	}
+	input := types.Input{Address: addr.String(), Coins: newCoins.Add(newCoins2...)}
 	outputs := []types.Output{
 		{Address: addr3.String(), Coins: newCoins},
 		{Address: addr4.String(), Coins: newCoins2},
 	}
 
-	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, input, outputs))
 
 	events := ctx.EventManager().ABCIEvents()
 	suite.Require().Equal(0, len(events))
 
-	// Set addr's coins but not addr2's coins
-	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 50))))
-	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	// Set addr's foo coins but not its bar coins
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr, newCoins))
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, input, outputs))
 
 	events = ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(8, len(events)) // 7 events because account funding causes extra minting + coin_spent + coin_recv events
+	suite.Require().Equal(6, len(events)) // 6 events because account funding causes extra minting + coin_spent + coin_recv events
+
+	// Set addr's bar coins
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr, newCoins2))
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, input, outputs))
+
+	events = ctx.EventManager().ABCIEvents()
+	suite.Require().Equal(18, len(events)) // 18 due to account funding + message + coin_spent + coin_recv and transfer events per output
 
 	event1 := sdk.Event{
 		Type:       sdk.EventTypeMessage,
@@ -633,56 +836,33 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 		event1.Attributes,
 		abci.EventAttribute{Key: types.AttributeKeySender, Value: addr.String()},
 	)
-	suite.Require().Equal(abci.Event(event1), events[7])
-
-	// Set addr's coins and addr2's coins
-	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 50))))
-	newCoins = sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 50))
-
-	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr2, sdk.NewCoins(sdk.NewInt64Coin(barDenom, 100))))
-	newCoins2 = sdk.NewCoins(sdk.NewInt64Coin(barDenom, 100))
-
-	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
-
-	events = ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(28, len(events)) // 25 due to account funding + coin_spent + coin_recv events
-
 	event2 := sdk.Event{
-		Type:       sdk.EventTypeMessage,
+		Type:       types.EventTypeTransfer,
 		Attributes: []abci.EventAttribute{},
 	}
 	event2.Attributes = append(
 		event2.Attributes,
-		abci.EventAttribute{Key: types.AttributeKeySender, Value: addr2.String()},
+		abci.EventAttribute{Key: types.AttributeKeyRecipient, Value: addr3.String()},
 	)
+	event2.Attributes = append(
+		event2.Attributes,
+		abci.EventAttribute{Key: sdk.AttributeKeyAmount, Value: newCoins.String()})
 	event3 := sdk.Event{
 		Type:       types.EventTypeTransfer,
 		Attributes: []abci.EventAttribute{},
 	}
 	event3.Attributes = append(
 		event3.Attributes,
-		abci.EventAttribute{Key: types.AttributeKeyRecipient, Value: addr3.String()},
+		abci.EventAttribute{Key: types.AttributeKeyRecipient, Value: addr4.String()},
 	)
 	event3.Attributes = append(
 		event3.Attributes,
-		abci.EventAttribute{Key: sdk.AttributeKeyAmount, Value: newCoins.String()})
-	event4 := sdk.Event{
-		Type:       types.EventTypeTransfer,
-		Attributes: []abci.EventAttribute{},
-	}
-	event4.Attributes = append(
-		event4.Attributes,
-		abci.EventAttribute{Key: types.AttributeKeyRecipient, Value: addr4.String()},
-	)
-	event4.Attributes = append(
-		event4.Attributes,
 		abci.EventAttribute{Key: sdk.AttributeKeyAmount, Value: newCoins2.String()},
 	)
 	// events are shifted due to the funding account events
-	suite.Require().Equal(abci.Event(event1), events[21])
-	suite.Require().Equal(abci.Event(event2), events[23])
-	suite.Require().Equal(abci.Event(event3), events[25])
-	suite.Require().Equal(abci.Event(event4), events[27])
+	suite.Require().Equal(abci.Event(event1), events[13])
+	suite.Require().Equal(abci.Event(event2), events[15])
+	suite.Require().Equal(abci.Event(event3), events[17])
 }
 
 func (suite *IntegrationTestSuite) TestSpendableCoins() {
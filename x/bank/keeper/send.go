@@ -17,7 +17,7 @@ import (
 type SendKeeper interface {
 	ViewKeeper
 
-	InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error
+	InputOutputCoins(ctx sdk.Context, input types.Input, outputs []types.Output) error
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 
 	GetParams(ctx sdk.Context) types.Params
@@ -70,35 +70,33 @@ func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
-// InputOutputCoins performs multi-send functionality. It accepts a series of
-// inputs that correspond to a series of outputs. It returns an error if the
-// inputs and outputs don't lineup or if any single transfer of tokens fails.
-func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
+// InputOutputCoins performs multi-send functionality. It accepts a single
+// input that corresponds to a series of outputs. It returns an error if the
+// input and outputs don't lineup or if any single transfer of tokens fails.
+func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, input types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
-	if err := types.ValidateInputsOutputs(inputs, outputs); err != nil {
+	if err := types.ValidateInputOutputs(input, outputs); err != nil {
 		return err
 	}
 
-	for _, in := range inputs {
-		inAddress, err := sdk.AccAddressFromBech32(in.Address)
-		if err != nil {
-			return err
-		}
-
-		err = k.subUnlockedCoins(ctx, inAddress, in.Coins)
-		if err != nil {
-			return err
-		}
+	inAddress, err := sdk.AccAddressFromBech32(input.Address)
+	if err != nil {
+		return err
+	}
 
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				sdk.EventTypeMessage,
-				sdk.NewAttribute(types.AttributeKeySender, in.Address),
-			),
-		)
+	err = k.subUnlockedCoins(ctx, inAddress, input.Coins)
+	if err != nil {
+		return err
 	}
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, input.Address),
+		),
+	)
+
 	for _, out := range outputs {
 		outAddress, err := sdk.AccAddressFromBech32(out.Address)
 		if err != nil {
@@ -134,6 +132,15 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 // SendCoins transfers amt coins from a sending account to a receiving account.
 // An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.sendCoins(ctx, fromAddr, toAddr, amt, "", "")
+}
+
+// sendCoins transfers amt coins from a sending account to a receiving account.
+// The names of the sending and receiving modules, if any, label the transfer
+// event so that the flows of funds between module accounts can be tracked.
+func (k BaseSendKeeper) sendCoins(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, senderModule, recipientModule string,
+) error {
 	err := k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
@@ -156,13 +163,20 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 
 	// bech32 encoding is expensive! Only do it once for fromAddr
 	fromAddrString := fromAddr.String()
+	transferEvent := sdk.NewEvent(
+		types.EventTypeTransfer,
+		sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
+		sdk.NewAttribute(types.AttributeKeySender, fromAddrString),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+	)
+	if senderModule != "" {
+		transferEvent = transferEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeySenderModule, senderModule))
+	}
+	if recipientModule != "" {
+		transferEvent = transferEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyRecipientModule, recipientModule))
+	}
 	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeTransfer,
-			sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
-			sdk.NewAttribute(types.AttributeKeySender, fromAddrString),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
-		),
+		transferEvent,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(types.AttributeKeySender, fromAddrString),
@@ -174,8 +188,8 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 
 // subUnlockedCoins removes the unlocked amt coins of the given account. An error is
 // returned if the resulting balance is negative or the initial amount is invalid.
-// A coin_spent event is emitted after.
-func (k BaseSendKeeper) subUnlockedCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+// A coin_spent event is emitted after, with the given extra attributes.
+func (k BaseSendKeeper) subUnlockedCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins, attrs ...sdk.Attribute) error {
 	if !amt.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
@@ -202,14 +216,14 @@ func (k BaseSendKeeper) subUnlockedCoins(ctx sdk.Context, addr sdk.AccAddress, a
 
 	// emit coin spent event
 	ctx.EventManager().EmitEvent(
-		types.NewCoinSpentEvent(addr, amt),
+		types.NewCoinSpentEvent(addr, amt).AppendAttributes(attrs...),
 	)
 	return nil
 }
 
 // addCoins increase the addr balance by the given amt. Fails if the provided amt is invalid.
-// It emits a coin received event.
-func (k BaseSendKeeper) addCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+// It emits a coin received event, with the given extra attributes.
+func (k BaseSendKeeper) addCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins, attrs ...sdk.Attribute) error {
 	if !amt.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
@@ -226,7 +240,7 @@ func (k BaseSendKeeper) addCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.C
 
 	// emit coin received event
 	ctx.EventManager().EmitEvent(
-		types.NewCoinReceivedEvent(addr, amt),
+		types.NewCoinReceivedEvent(addr, amt).AppendAttributes(attrs...),
 	)
 
 	return nil
@@ -305,10 +319,10 @@ func (k BaseSendKeeper) setBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 // any of the coins are not configured for sending.  Returns nil if sending is enabled
 // for all provided coin
 func (k BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error {
-	for _, coin := range coins {
-		if !k.IsSendEnabledCoin(ctx, coin) {
-			return sdkerrors.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", coin.Denom)
-		}
+	params := k.GetParams(ctx)
+	disabled := sdk.Coins(coins).Filter(func(coin sdk.Coin) bool { return !params.SendEnabledDenom(coin.Denom) })
+	if len(disabled) > 0 {
+		return sdkerrors.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", disabled[0].Denom)
 	}
 	return nil
 }
@@ -72,6 +72,7 @@ func TestMigrateJSON(t *testing.T) {
 			]
 		}
 	],
+	"burn_enabled_denoms": [],
 	"denom_metadata": [],
 	"params": {
 		"default_send_enabled": false,
@@ -86,7 +87,8 @@ func TestMigrateJSON(t *testing.T) {
 			"amount": "10",
 			"denom": "foo"
 		}
-	]
+	],
+	"supply_offsets": []
 }`
 
 	require.Equal(t, expected, string(indentedBz))
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math/rand"
 	"time"
 
@@ -29,6 +30,7 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasStreamingGenesis = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the bank module.
@@ -157,6 +159,20 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// InitGenesisFromReader performs genesis initialization for the bank module from the
+// genesis state read from r. It returns no validator updates.
+func (am AppModule) InitGenesisFromReader(ctx sdk.Context, cdc codec.JSONCodec, r io.Reader) ([]abci.ValidatorUpdate, error) {
+	if err := am.keeper.InitGenesisFromReader(ctx, cdc, r); err != nil {
+		return nil, err
+	}
+	return []abci.ValidatorUpdate{}, nil
+}
+
+// ExportGenesisToWriter writes the exported genesis state of the bank module to w.
+func (am AppModule) ExportGenesisToWriter(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error {
+	return am.keeper.ExportGenesisToWriter(ctx, cdc, w)
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 3 }
 
@@ -160,7 +160,8 @@ func sendMsgSend(
 	return nil
 }
 
-// SimulateMsgMultiSend tests and runs a single msg multisend, with randomized, capped number of inputs/outputs.
+// SimulateMsgMultiSend tests and runs a single msg multisend, with a single input
+// and a randomized, capped number of outputs.
 // all accounts in msg fields exist in state
 func SimulateMsgMultiSend(ak types.AccountKeeper, bk keeper.Keeper) simtypes.Operation {
 	return func(
@@ -168,40 +169,20 @@ func SimulateMsgMultiSend(ak types.AccountKeeper, bk keeper.Keeper) simtypes.Ope
 		accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 
-		// random number of inputs/outputs between [1, 3]
-		inputs := make([]types.Input, r.Intn(3)+1)
+		// random number of outputs between [1, 3]
 		outputs := make([]types.Output, r.Intn(3)+1)
 
-		// collect signer privKeys
-		privs := make([]cryptotypes.PrivKey, len(inputs))
-
-		// use map to check if address already exists as input
-		usedAddrs := make(map[string]bool)
-
-		var totalSentCoins sdk.Coins
-		for i := range inputs {
-			// generate random input fields, ignore to address
-			from, _, coins, skip := randomSendFields(r, ctx, accs, bk, ak)
-
-			// make sure account is fresh and not used in previous input
-			for usedAddrs[from.Address.String()] {
-				from, _, coins, skip = randomSendFields(r, ctx, accs, bk, ak)
-			}
-
-			if skip {
-				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMultiSend, "skip all transfers"), nil, nil
-			}
-
-			// set input address in used address map
-			usedAddrs[from.Address.String()] = true
+		// generate random input fields, ignore to address
+		from, _, coins, skip := randomSendFields(r, ctx, accs, bk, ak)
+		if skip {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMultiSend, "skip all transfers"), nil, nil
+		}
 
-			// set signer privkey
-			privs[i] = from.PrivKey
+		// set signer privkey
+		privs := []cryptotypes.PrivKey{from.PrivKey}
 
-			// set next input and accumulate total sent coins
-			inputs[i] = types.NewInput(from.Address, coins)
-			totalSentCoins = totalSentCoins.Add(coins...)
-		}
+		inputs := []types.Input{types.NewInput(from.Address, coins)}
+		totalSentCoins := coins
 
 		// Check send_enabled status of each sent coin denom
 		if err := bk.IsSendEnabledCoins(ctx, totalSentCoins...); err != nil {
@@ -257,21 +238,18 @@ func SimulateMsgMultiSendToModuleAccount(ak types.AccountKeeper, bk keeper.Keepe
 		accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 
-		inputs := make([]types.Input, 2)
 		outputs := make([]types.Output, moduleAccCount)
-		// collect signer privKeys
-		privs := make([]cryptotypes.PrivKey, len(inputs))
-
-		var totalSentCoins sdk.Coins
-		for i := range inputs {
-			sender := accs[i]
-			privs[i] = sender.PrivKey
-			spendable := bk.SpendableCoins(ctx, sender.Address)
-			coins := simtypes.RandSubsetCoins(r, spendable)
-			inputs[i] = types.NewInput(sender.Address, coins)
-			totalSentCoins = totalSentCoins.Add(coins...)
+
+		sender := accs[0]
+		privs := []cryptotypes.PrivKey{sender.PrivKey}
+		spendable := bk.SpendableCoins(ctx, sender.Address)
+		totalSentCoins := simtypes.RandSubsetCoins(r, spendable)
+		if totalSentCoins.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMultiSend, "empty coins slice"), nil, nil
 		}
 
+		inputs := []types.Input{types.NewInput(sender.Address, totalSentCoins)}
+
 		if err := bk.IsSendEnabledCoins(ctx, totalSentCoins...); err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMultiSend, err.Error()), nil, nil
 		}
@@ -1,6 +1,11 @@
 package types
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -12,4 +17,70 @@ var (
 	ErrSendDisabled          = sdkerrors.Register(ModuleName, 5, "send transactions are disabled")
 	ErrDenomMetadataNotFound = sdkerrors.Register(ModuleName, 6, "client denom metadata not found")
 	ErrInvalidKey            = sdkerrors.Register(ModuleName, 7, "invalid key")
+	ErrBurnDisabled          = sdkerrors.Register(ModuleName, 8, "burn disabled for denom")
+	ErrMultipleSenders       = sdkerrors.Register(ModuleName, 9, "multiple senders not allowed")
 )
+
+// DenomMismatch holds the totals of a denom whose amount sent by the input of
+// a multi-send differs from its amount received by the outputs.
+type DenomMismatch struct {
+	Denom string
+	In    sdk.Int
+	Out   sdk.Int
+}
+
+// InputOutputMismatchError is returned when the coins sent by the input of a
+// multi-send differ from the coins received by its outputs. It reports every
+// mismatched denom, and is an ErrInputOutputMismatch, whose ABCI code it is
+// reported with.
+type InputOutputMismatchError struct {
+	Mismatches []DenomMismatch
+}
+
+// NewInputOutputMismatchError returns the error reporting the denoms whose
+// totals differ between totalIn and totalOut, or nil if none does.
+func NewInputOutputMismatchError(totalIn, totalOut sdk.Coins) error {
+	denoms := make(map[string]struct{}, len(totalIn)+len(totalOut))
+	for _, coin := range totalIn {
+		denoms[coin.Denom] = struct{}{}
+	}
+	for _, coin := range totalOut {
+		denoms[coin.Denom] = struct{}{}
+	}
+
+	var mismatches []DenomMismatch
+	for denom := range denoms {
+		in, out := totalIn.AmountOf(denom), totalOut.AmountOf(denom)
+		if !in.Equal(out) {
+			mismatches = append(mismatches, DenomMismatch{Denom: denom, In: in, Out: out})
+		}
+	}
+	if len(mismatches) == 0 {
+		return nil
+	}
+
+	sort.Slice(mismatches, func(i, j int) bool { return mismatches[i].Denom < mismatches[j].Denom })
+
+	return &InputOutputMismatchError{Mismatches: mismatches}
+}
+
+// Error implements error.
+func (e *InputOutputMismatchError) Error() string {
+	reports := make([]string, len(e.Mismatches))
+	for i, m := range e.Mismatches {
+		reports[i] = fmt.Sprintf("%s: %s in, %s out", m.Denom, m.In, m.Out)
+	}
+
+	return fmt.Sprintf("%s: %s", ErrInputOutputMismatch, strings.Join(reports, "; "))
+}
+
+// Cause returns ErrInputOutputMismatch, so that the error is reported with its
+// codespace and ABCI code.
+func (e *InputOutputMismatchError) Cause() error {
+	return ErrInputOutputMismatch
+}
+
+// Unwrap returns ErrInputOutputMismatch.
+func (e *InputOutputMismatchError) Unwrap() error {
+	return ErrInputOutputMismatch
+}
@@ -8,8 +8,10 @@ import (
 const (
 	EventTypeTransfer = "transfer"
 
-	AttributeKeyRecipient = "recipient"
-	AttributeKeySender    = "sender"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeySender          = "sender"
+	AttributeKeyRecipientModule = "recipient_module"
+	AttributeKeySenderModule    = "sender_module"
 
 	AttributeValueCategory = ModuleName
 
@@ -19,10 +21,12 @@ const (
 	EventTypeCoinMint     = "coinbase" // NOTE(fdymylja): using mint clashes with mint module event
 	EventTypeCoinBurn     = "burn"
 
-	AttributeKeySpender  = "spender"
-	AttributeKeyReceiver = "receiver"
-	AttributeKeyMinter   = "minter"
-	AttributeKeyBurner   = "burner"
+	AttributeKeySpender      = "spender"
+	AttributeKeyReceiver     = "receiver"
+	AttributeKeyMinter       = "minter"
+	AttributeKeyBurner       = "burner"
+	AttributeKeyMinterModule = "minter_module"
+	AttributeKeyBurnerModule = "burner_module"
 )
 
 // NewCoinSpentEvent constructs a new coin spent sdk.Event
@@ -58,6 +58,14 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	if err := gs.SupplyOffsets.Validate(); err != nil {
+		return fmt.Errorf("invalid supply offsets: %w", err)
+	}
+
+	if err := ValidateBurnEnabledDenoms(gs.BurnEnabledDenoms); err != nil {
+		return fmt.Errorf("invalid burn enabled denoms: %w", err)
+	}
+
 	return nil
 }
 
@@ -44,6 +44,34 @@ func TestGenesisStateValidate(t *testing.T) {
 			false,
 		},
 		{"empty genesisState", GenesisState{}, false},
+		{
+			"valid supply offsets",
+			GenesisState{
+				SupplyOffsets: sdk.SignedCoins{sdk.Coin{Denom: "uatom", Amount: sdk.NewInt(-10)}, sdk.NewInt64Coin("uosmo", 5)},
+			},
+			false,
+		},
+		{
+			"invalid supply offsets",
+			GenesisState{
+				SupplyOffsets: sdk.SignedCoins{sdk.NewInt64Coin("uosmo", 5), sdk.Coin{Denom: "uatom", Amount: sdk.NewInt(-10)}},
+			},
+			true,
+		},
+		{
+			"valid burn enabled denoms",
+			GenesisState{
+				BurnEnabledDenoms: []string{"uatom", "uosmo"},
+			},
+			false,
+		},
+		{
+			"duplicate burn enabled denoms",
+			GenesisState{
+				BurnEnabledDenoms: []string{"uatom", "uatom"},
+			},
+			true,
+		},
 		{
 			"invalid params ",
 			GenesisState{
@@ -9,6 +9,7 @@ import (
 const (
 	TypeMsgSend      = "send"
 	TypeMsgMultiSend = "multisend"
+	TypeMsgBurn      = "burn"
 )
 
 var _ sdk.Msg = &MsgSend{}
@@ -59,7 +60,8 @@ func (msg MsgSend) GetSigners() []sdk.AccAddress {
 
 var _ sdk.Msg = &MsgMultiSend{}
 
-// NewMsgMultiSend - construct arbitrary multi-in, multi-out send msg.
+// NewMsgMultiSend - construct a single-in, multi-out send msg. The msg is only
+// valid with a single input, see SplitMultiSend.
 func NewMsgMultiSend(in []Input, out []Output) *MsgMultiSend {
 	return &MsgMultiSend{Inputs: in, Outputs: out}
 }
@@ -78,11 +80,15 @@ func (msg MsgMultiSend) ValidateBasic() error {
 		return ErrNoInputs
 	}
 
+	if len(msg.Inputs) != 1 {
+		return ErrMultipleSenders.Wrapf("got %d inputs, expected 1", len(msg.Inputs))
+	}
+
 	if len(msg.Outputs) == 0 {
 		return ErrNoOutputs
 	}
 
-	return ValidateInputsOutputs(msg.Inputs, msg.Outputs)
+	return ValidateInputOutputs(msg.Inputs[0], msg.Outputs)
 }
 
 // GetSignBytes Implements Msg.
@@ -153,31 +159,133 @@ func NewOutput(addr sdk.AccAddress, coins sdk.Coins) Output {
 	}
 }
 
-// ValidateInputsOutputs validates that each respective input and output is
-// valid and that the sum of inputs is equal to the sum of outputs.
-func ValidateInputsOutputs(inputs []Input, outputs []Output) error {
-	var totalIn, totalOut sdk.Coins
+// ValidateInputOutputs validates that the input and each output are valid and
+// that the coins of the input are equal to the sum of the outputs. A mismatch
+// is reported by an InputOutputMismatchError.
+func ValidateInputOutputs(input Input, outputs []Output) error {
+	if err := input.ValidateBasic(); err != nil {
+		return err
+	}
 
-	for _, in := range inputs {
-		if err := in.ValidateBasic(); err != nil {
+	var totalOut sdk.Coins
+	for _, out := range outputs {
+		if err := out.ValidateBasic(); err != nil {
 			return err
 		}
 
+		totalOut = totalOut.Add(out.Coins...)
+	}
+
+	// make sure input and outputs match
+	if !input.Coins.IsEqual(totalOut) {
+		return NewInputOutputMismatchError(input.Coins, totalOut)
+	}
+
+	return nil
+}
+
+// SplitMultiSend splits a multi-send with multiple inputs, which is no longer
+// valid, into the single-input multi-sends moving the same coins, one per
+// input in order. The outputs are paid in order, every input paying the
+// outputs still owed its denoms, so that the clients still building
+// multi-input multi-sends can migrate by sending the returned msgs instead.
+func SplitMultiSend(msg *MsgMultiSend) ([]*MsgMultiSend, error) {
+	if len(msg.Inputs) == 0 {
+		return nil, ErrNoInputs
+	}
+
+	if len(msg.Outputs) == 0 {
+		return nil, ErrNoOutputs
+	}
+
+	var totalIn, totalOut sdk.Coins
+	for _, in := range msg.Inputs {
+		if err := in.ValidateBasic(); err != nil {
+			return nil, err
+		}
+
 		totalIn = totalIn.Add(in.Coins...)
 	}
 
-	for _, out := range outputs {
+	owed := make([]sdk.Coins, len(msg.Outputs))
+	for i, out := range msg.Outputs {
 		if err := out.ValidateBasic(); err != nil {
-			return err
+			return nil, err
 		}
 
 		totalOut = totalOut.Add(out.Coins...)
+		owed[i] = out.Coins
 	}
 
-	// make sure inputs and outputs match
 	if !totalIn.IsEqual(totalOut) {
-		return ErrInputOutputMismatch
+		return nil, NewInputOutputMismatchError(totalIn, totalOut)
+	}
+
+	msgs := make([]*MsgMultiSend, len(msg.Inputs))
+	for i, in := range msg.Inputs {
+		paid := make([]sdk.Coins, len(msg.Outputs))
+		for _, coin := range in.Coins {
+			remaining := coin.Amount
+			for j := 0; j < len(owed) && remaining.IsPositive(); j++ {
+				amt := sdk.MinInt(remaining, owed[j].AmountOf(coin.Denom))
+				if !amt.IsPositive() {
+					continue
+				}
+
+				payment := sdk.NewCoin(coin.Denom, amt)
+				owed[j] = owed[j].Sub(payment)
+				paid[j] = paid[j].Add(payment)
+				remaining = remaining.Sub(amt)
+			}
+		}
+
+		var outputs []Output
+		for j, coins := range paid {
+			if !coins.Empty() {
+				outputs = append(outputs, Output{Address: msg.Outputs[j].Address, Coins: coins})
+			}
+		}
+
+		msgs[i] = NewMsgMultiSend([]Input{in}, outputs)
+	}
+
+	return msgs, nil
+}
+
+var _ sdk.Msg = &MsgBurn{}
+
+// NewMsgBurn - construct a msg to burn coins from an account.
+//nolint:interfacer
+func NewMsgBurn(fromAddr sdk.AccAddress, amount sdk.Coins) *MsgBurn {
+	return &MsgBurn{FromAddress: fromAddr.String(), Amount: amount}
+}
+
+// Route Implements Msg.
+func (msg MsgBurn) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgBurn) Type() string { return TypeMsgBurn }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
 	}
 
 	return nil
 }
+
+// GetSignBytes Implements Msg.
+func (msg MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
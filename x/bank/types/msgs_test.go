@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestMsgSendRoute(t *testing.T) {
@@ -171,6 +172,7 @@ func TestMsgMultiSendValidation(t *testing.T) {
 
 	input1 := NewInput(addr1, atom123)
 	input2 := NewInput(addr1, eth123)
+	inputMulti := NewInput(addr1, atom123eth123)
 	output1 := NewOutput(addr2, atom123)
 	output2 := NewOutput(addr2, atom124)
 	outputMulti := NewOutput(addr2, atom123eth123)
@@ -199,10 +201,18 @@ func TestMsgMultiSendValidation(t *testing.T) {
 			Inputs:  []Input{input1},
 			Outputs: []Output{output1}},
 		},
-		{true, MsgMultiSend{
+		{false, MsgMultiSend{
 			Inputs:  []Input{input1, input2},
+			Outputs: []Output{outputMulti}}, // multiple senders
+		},
+		{true, MsgMultiSend{
+			Inputs:  []Input{inputMulti},
 			Outputs: []Output{outputMulti}},
 		},
+		{true, MsgMultiSend{
+			Inputs:  []Input{inputMulti},
+			Outputs: []Output{output1, NewOutput(addr1, eth123)}},
+		},
 	}
 
 	for i, tc := range cases {
@@ -215,6 +225,83 @@ func TestMsgMultiSendValidation(t *testing.T) {
 	}
 }
 
+func TestMsgMultiSendValidationErrors(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("_______alice________"))
+	addr2 := sdk.AccAddress([]byte("________bob_________"))
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	eth123 := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+
+	msg := NewMsgMultiSend([]Input{NewInput(addr1, atom123), NewInput(addr2, eth123)}, []Output{NewOutput(addr2, atom123)})
+	require.ErrorIs(t, msg.ValidateBasic(), ErrMultipleSenders)
+
+	msg = NewMsgMultiSend(
+		[]Input{NewInput(addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 123), sdk.NewInt64Coin("btc", 5)))},
+		[]Output{NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 120), sdk.NewInt64Coin("btc", 5), sdk.NewInt64Coin("eth", 7)))},
+	)
+	err := msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInputOutputMismatch)
+	require.EqualError(t, err, "sum inputs != sum outputs: atom: 123 in, 120 out; eth: 0 in, 7 out")
+
+	var mismatchErr *InputOutputMismatchError
+	require.ErrorAs(t, err, &mismatchErr)
+	require.Equal(t, []DenomMismatch{
+		{Denom: "atom", In: sdk.NewInt(123), Out: sdk.NewInt(120)},
+		{Denom: "eth", In: sdk.ZeroInt(), Out: sdk.NewInt(7)},
+	}, mismatchErr.Mismatches)
+
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+	require.Equal(t, ErrInputOutputMismatch.Codespace(), codespace)
+	require.Equal(t, ErrInputOutputMismatch.ABCICode(), code)
+}
+
+func TestSplitMultiSend(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("_______alice________"))
+	addr2 := sdk.AccAddress([]byte("________bob_________"))
+	addr3 := sdk.AccAddress([]byte("_______carol________"))
+	addr4 := sdk.AccAddress([]byte("_______dave_________"))
+
+	msg := NewMsgMultiSend(
+		[]Input{
+			NewInput(addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("eth", 5))),
+			NewInput(addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 20))),
+		},
+		[]Output{
+			NewOutput(addr3, sdk.NewCoins(sdk.NewInt64Coin("atom", 15))),
+			NewOutput(addr4, sdk.NewCoins(sdk.NewInt64Coin("atom", 15), sdk.NewInt64Coin("eth", 5))),
+		},
+	)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrMultipleSenders)
+
+	msgs, err := SplitMultiSend(msg)
+	require.NoError(t, err)
+	require.Equal(t, []*MsgMultiSend{
+		NewMsgMultiSend(
+			[]Input{msg.Inputs[0]},
+			[]Output{
+				NewOutput(addr3, sdk.NewCoins(sdk.NewInt64Coin("atom", 10))),
+				NewOutput(addr4, sdk.NewCoins(sdk.NewInt64Coin("eth", 5))),
+			},
+		),
+		NewMsgMultiSend(
+			[]Input{msg.Inputs[1]},
+			[]Output{
+				NewOutput(addr3, sdk.NewCoins(sdk.NewInt64Coin("atom", 5))),
+				NewOutput(addr4, sdk.NewCoins(sdk.NewInt64Coin("atom", 15))),
+			},
+		),
+	}, msgs)
+	for _, m := range msgs {
+		require.NoError(t, m.ValidateBasic())
+	}
+
+	msg.Outputs[0].Coins = sdk.NewCoins(sdk.NewInt64Coin("atom", 14))
+	_, err = SplitMultiSend(msg)
+	require.ErrorIs(t, err, ErrInputOutputMismatch)
+
+	_, err = SplitMultiSend(NewMsgMultiSend(nil, msg.Outputs))
+	require.ErrorIs(t, err, ErrNoInputs)
+}
+
 func TestMsgMultiSendGetSignBytes(t *testing.T) {
 	addr1 := sdk.AccAddress([]byte("input"))
 	addr2 := sdk.AccAddress([]byte("output"))
@@ -252,3 +339,43 @@ func TestMsgSendGetSigners(t *testing.T) {
 	require.Equal(t, 1, len(res))
 	require.True(t, from.Equals(res[0]))
 }
+
+func TestMsgBurnValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from________________"))
+	addrEmpty := sdk.AccAddress([]byte(""))
+
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom0 := sdk.NewCoins(sdk.NewInt64Coin("atom", 0))
+	atom123eth0 := sdk.Coins{sdk.NewInt64Coin("atom", 123), sdk.NewInt64Coin("eth", 0)}
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *MsgBurn
+	}{
+		{"", NewMsgBurn(addr1, atom123)},
+		{": invalid coins", NewMsgBurn(addr1, atom0)},
+		{": invalid coins", NewMsgBurn(addr1, sdk.Coins{})},
+		{"123atom,0eth: invalid coins", NewMsgBurn(addr1, atom123eth0)},
+		{"invalid from address: empty address string is not allowed: invalid address", NewMsgBurn(addrEmpty, atom123)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgBurnGetSignBytes(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("input"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	var msg = NewMsgBurn(addr1, coins)
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"cosmos-sdk/MsgBurn","value":{"amount":[{"amount":"10","denom":"atom"}],"from_address":"cosmos1d9h8qat57ljhcm"}}`
+	require.Equal(t, expected, string(res))
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+}
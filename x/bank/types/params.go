@@ -20,11 +20,16 @@ var (
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyDefaultSendEnabled is store's key for the DefaultSendEnabled option
 	KeyDefaultSendEnabled = []byte("DefaultSendEnabled")
+	// KeyBurnEnabledDenoms is store's key for the denoms enabled for MsgBurn
+	KeyBurnEnabledDenoms = []byte("BurnEnabledDenoms")
 )
 
-// ParamKeyTable for bank module.
+// ParamKeyTable for bank module, the burn enabled denoms being stored under
+// their own key apart from the Params.
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+	return paramtypes.NewKeyTable(
+		paramtypes.NewParamSetPair(KeyBurnEnabledDenoms, []string{}, validateBurnEnabledDenoms),
+	).RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new parameter configuration for the bank module
@@ -143,6 +148,33 @@ func validateSendEnabled(i interface{}) error {
 	return sdk.ValidateDenom(param.Denom)
 }
 
+// ValidateBurnEnabledDenoms checks that the denoms enabled for MsgBurn are
+// valid and not duplicated.
+func ValidateBurnEnabledDenoms(denoms []string) error {
+	seen := make(map[string]bool)
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+
+		if seen[denom] {
+			return fmt.Errorf("duplicate burn enabled denom: '%s'", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+func validateBurnEnabledDenoms(i interface{}) error {
+	denoms, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateBurnEnabledDenoms(denoms)
+}
+
 func validateIsBool(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/capability"
 	"github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	"github.com/cosmos/cosmos-sdk/x/capability/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type CapabilityTestSuite struct {
@@ -92,6 +93,64 @@ func (suite *CapabilityTestSuite) TestInitializeMemStore() {
 	suite.Require().True(newKeeper.IsInitialized(ctx), "memstore initialized flag not set")
 }
 
+// TestRestart ensures that the capabilities are rebuilt from the persistent state
+// after a restart, and that they can't be updated before the rebuild.
+func (suite *CapabilityTestSuite) TestRestart() {
+	sk1 := suite.keeper.ScopeToModule(banktypes.ModuleName)
+	sk2 := suite.keeper.ScopeToModule(stakingtypes.ModuleName)
+
+	cap1, err := sk1.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+	suite.Require().NoError(sk2.ClaimCapability(suite.ctx, cap1, "transfer"))
+
+	cap2, err := sk2.NewCapability(suite.ctx, "ica")
+	suite.Require().NoError(err)
+
+	// mock a restart by creating a new keeper that shares persistent state but
+	// loses the in-memory state
+	newKeeper := keeper.NewKeeper(suite.cdc, suite.app.GetKey(types.StoreKey), suite.app.GetMemKey("testingkey"))
+	newSk1 := newKeeper.ScopeToModule(banktypes.ModuleName)
+	newSk2 := newKeeper.ScopeToModule(stakingtypes.ModuleName)
+	newKeeper.Seal()
+
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.Require().False(newKeeper.IsInitialized(ctx))
+
+	// the capabilities can't be updated before the memory store is initialized
+	_, ok := newSk1.GetCapability(ctx, "transfer")
+	suite.Require().False(ok)
+	_, err = newSk1.NewCapability(ctx, "transfer")
+	suite.Require().ErrorIs(err, types.ErrMemStoreNotInitialized)
+	suite.Require().ErrorIs(newSk1.ClaimCapability(ctx, cap2, "ica"), types.ErrMemStoreNotInitialized)
+	suite.Require().ErrorIs(newSk2.ReleaseCapability(ctx, cap2), types.ErrMemStoreNotInitialized)
+
+	newKeeper.InitMemStore(ctx)
+	suite.Require().True(newKeeper.IsInitialized(ctx))
+
+	newCap1, ok := newSk1.GetCapability(ctx, "transfer")
+	suite.Require().True(ok)
+	suite.Require().Equal(cap1.GetIndex(), newCap1.GetIndex())
+
+	sk2Cap1, ok := newSk2.GetCapability(ctx, "transfer")
+	suite.Require().True(ok)
+	suite.Require().Same(newCap1, sk2Cap1, "owners got different capabilities")
+	suite.Require().True(newSk2.AuthenticateCapability(ctx, newCap1, "transfer"))
+
+	newCap2, ok := newSk2.GetCapability(ctx, "ica")
+	suite.Require().True(ok)
+	suite.Require().Equal(cap2.GetIndex(), newCap2.GetIndex())
+
+	// the rebuilt capabilities can be updated
+	cap3, err := newSk1.NewCapability(ctx, "ica")
+	suite.Require().NoError(err)
+	suite.Require().Equal(cap2.GetIndex()+1, cap3.GetIndex())
+	suite.Require().NoError(newSk1.ReleaseCapability(ctx, newCap1))
+	suite.Require().NoError(newSk2.ReleaseCapability(ctx, newCap1))
+
+	_, ok = newSk2.GetCapability(ctx, "transfer")
+	suite.Require().False(ok)
+}
+
 func TestCapabilityTestSuite(t *testing.T) {
 	suite.Run(t, new(CapabilityTestSuite))
 }
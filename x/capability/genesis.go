@@ -13,11 +13,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		panic(err)
 	}
 
-	// set owners for each index
+	// set owners for each index and initialize the in-memory capabilities, the
+	// memory store may already be initialized on app startup
 	for _, genOwner := range genState.Owners {
 		k.SetOwners(ctx, genOwner.Index, genOwner.IndexOwners)
+		k.InitializeCapability(ctx, genOwner.Index, genOwner.IndexOwners)
 	}
-	// initialize in-memory capabilities
 	k.InitMemStore(ctx)
 }
 
@@ -27,11 +27,17 @@ type (
 	//
 	// The keeper allows the ability to create scoped sub-keepers which are tied to
 	// a single specific module.
+	//
+	// The in-memory capabilities are only ever derived from the persistent state:
+	// InitMemStore rebuilds them on startup, and the capabilities released by a
+	// transaction are only dropped from memory once the release is committed, so
+	// that the memory store and the go map can't get out of sync.
 	Keeper struct {
 		cdc           codec.BinaryCodec
 		storeKey      storetypes.StoreKey
 		memKey        storetypes.StoreKey
 		capMap        map[uint64]*types.Capability
+		released      map[uint64]struct{}
 		scopedModules map[string]struct{}
 		sealed        bool
 	}
@@ -47,18 +53,20 @@ type (
 		storeKey storetypes.StoreKey
 		memKey   storetypes.StoreKey
 		capMap   map[uint64]*types.Capability
+		released map[uint64]struct{}
 		module   string
 	}
 )
 
 // NewKeeper constructs a new CapabilityKeeper instance and initializes maps
-// for capability map and scopedModules map.
+// for capability map, released capabilities and scopedModules map.
 func NewKeeper(cdc codec.BinaryCodec, storeKey, memKey storetypes.StoreKey) *Keeper {
 	return &Keeper{
 		cdc:           cdc,
 		storeKey:      storeKey,
 		memKey:        memKey,
 		capMap:        make(map[uint64]*types.Capability),
+		released:      make(map[uint64]struct{}),
 		scopedModules: make(map[string]struct{}),
 		sealed:        false,
 	}
@@ -86,6 +94,7 @@ func (k *Keeper) ScopeToModule(moduleName string) ScopedKeeper {
 		storeKey: k.storeKey,
 		memKey:   k.memKey,
 		capMap:   k.capMap,
+		released: k.released,
 		module:   moduleName,
 	}
 }
@@ -102,9 +111,15 @@ func (k *Keeper) Seal() {
 
 // InitMemStore will assure that the module store is a memory store (it will panic if it's not)
 // and willl initialize it. The function is safe to be called multiple times.
-// InitMemStore must be called every time the app starts before the keeper is used (so
-// `BeginBlock` or `InitChain` - whichever is first). We need access to the store so we
-// can't initialize it in a constructor.
+// InitMemStore must be called every time the app starts before the keeper is used, ideally
+// right after the latest version is loaded with a context writing directly to the root
+// multistore, and otherwise in `BeginBlock` or `InitChain` - whichever is first. We need
+// access to the store so we can't initialize it in a constructor. Until it is called, the
+// scoped keepers can't create, claim nor release capabilities.
+//
+// The capabilities are rebuilt from the persistent state in ascending order of their
+// indexes, the capabilities still held in memory being reused, so that the rebuild is
+// deterministic and doesn't invalidate the references held by the modules.
 func (k *Keeper) InitMemStore(ctx sdk.Context) {
 	memStore := ctx.KVStore(k.memKey)
 	memStoreType := memStore.GetStoreType()
@@ -141,8 +156,23 @@ func (k *Keeper) InitMemStore(ctx sdk.Context) {
 
 // IsInitialized returns true if the keeper is properly initialized, and false otherwise.
 func (k *Keeper) IsInitialized(ctx sdk.Context) bool {
-	memStore := ctx.KVStore(k.memKey)
-	return memStore.Get(types.KeyMemInitialized) != nil
+	return isInitialized(ctx, k.memKey)
+}
+
+// PruneReleasedCapabilities drops from memory the capabilities released since the
+// last call whose release got committed, i.e. which have no more owners in the
+// persistent state. It must be called on a context reading the committed state,
+// before any transaction is executed on top of it, e.g. in `BeginBlock`.
+func (k *Keeper) PruneReleasedCapabilities(ctx sdk.Context) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+
+	for index := range k.released {
+		// the release may have been reverted, the capability being still owned
+		if !prefixStore.Has(types.IndexToKey(index)) {
+			delete(k.capMap, index)
+		}
+		delete(k.released, index)
+	}
 }
 
 // InitializeIndex sets the index to one (or greater) in InitChain according
@@ -193,14 +223,17 @@ func (k Keeper) GetOwners(ctx sdk.Context, index uint64) (types.CapabilityOwners
 	return owners, true
 }
 
-// InitializeCapability takes in an index and an owners array. It creates the capability in memory
-// and sets the fwd and reverse keys for each owner in the memstore.
+// InitializeCapability takes in an index and an owners array. It creates the capability in memory,
+// unless it is already there, and sets the fwd and reverse keys for each owner in the memstore.
 // It is used during initialization from genesis.
 func (k Keeper) InitializeCapability(ctx sdk.Context, index uint64, owners types.CapabilityOwners) {
-
 	memStore := ctx.KVStore(k.memKey)
 
-	cap := types.NewCapability(index)
+	cap, ok := k.capMap[index]
+	if !ok {
+		cap = types.NewCapability(index)
+	}
+
 	for _, owner := range owners.Owners {
 		// Set the forward mapping between the module and capability tuple and the
 		// capability name in the memKVStore
@@ -215,7 +248,6 @@ func (k Keeper) InitializeCapability(ctx sdk.Context, index uint64, owners types
 		// Set the mapping from index from index to in-memory capability in the go map
 		k.capMap[index] = cap
 	}
-
 }
 
 // NewCapability attempts to create a new capability with a given name. If the
@@ -231,6 +263,9 @@ func (sk ScopedKeeper) NewCapability(ctx sdk.Context, name string) (*types.Capab
 	if strings.TrimSpace(name) == "" {
 		return nil, sdkerrors.Wrap(types.ErrInvalidCapabilityName, "capability name cannot be empty")
 	}
+	if !isInitialized(ctx, sk.memKey) {
+		return nil, types.ErrMemStoreNotInitialized
+	}
 	store := ctx.KVStore(sk.storeKey)
 
 	if _, ok := sk.GetCapability(ctx, name); ok {
@@ -296,6 +331,9 @@ func (sk ScopedKeeper) ClaimCapability(ctx sdk.Context, cap *types.Capability, n
 	if strings.TrimSpace(name) == "" {
 		return sdkerrors.Wrap(types.ErrInvalidCapabilityName, "capability name cannot be empty")
 	}
+	if !isInitialized(ctx, sk.memKey) {
+		return types.ErrMemStoreNotInitialized
+	}
 	// update capability owner set
 	if err := sk.addOwner(ctx, cap, name); err != nil {
 		return err
@@ -320,11 +358,15 @@ func (sk ScopedKeeper) ClaimCapability(ctx sdk.Context, cap *types.Capability, n
 
 // ReleaseCapability allows a scoped module to release a capability which it had
 // previously claimed or created. After releasing the capability, if no more
-// owners exist, the capability will be globally removed.
+// owners exist, the capability will be globally removed. It is only dropped from
+// memory by PruneReleasedCapabilities, since the transaction may still be reverted.
 func (sk ScopedKeeper) ReleaseCapability(ctx sdk.Context, cap *types.Capability) error {
 	if cap == nil {
 		return sdkerrors.Wrap(types.ErrNilCapability, "cannot release nil capability")
 	}
+	if !isInitialized(ctx, sk.memKey) {
+		return types.ErrMemStoreNotInitialized
+	}
 	name := sk.GetCapabilityName(ctx, cap)
 	if len(name) == 0 {
 		return sdkerrors.Wrap(types.ErrCapabilityNotOwned, sk.module)
@@ -350,8 +392,9 @@ func (sk ScopedKeeper) ReleaseCapability(ctx sdk.Context, cap *types.Capability)
 	if len(capOwners.Owners) == 0 {
 		// remove capability owner set
 		prefixStore.Delete(indexKey)
-		// since no one owns capability, we can delete capability from map
-		delete(sk.capMap, cap.GetIndex())
+		// since no one owns capability, it can be deleted from the map once the
+		// release is committed
+		sk.released[cap.GetIndex()] = struct{}{}
 	} else {
 		// update capability owner set
 		prefixStore.Set(indexKey, sk.cdc.MustMarshal(capOwners))
@@ -386,7 +429,7 @@ func (sk ScopedKeeper) GetCapability(ctx sdk.Context, name string) (*types.Capab
 
 	cap := sk.capMap[index]
 	if cap == nil {
-		panic("capability found in memstore is missing from map")
+		panic(fmt.Sprintf("capability %d found in memstore is missing from map", index))
 	}
 
 	return cap, true
@@ -486,6 +529,10 @@ func (sk ScopedKeeper) getOwners(ctx sdk.Context, cap *types.Capability) *types.
 	return &capOwners
 }
 
+func isInitialized(ctx sdk.Context, memKey storetypes.StoreKey) bool {
+	return ctx.KVStore(memKey).Has(types.KeyMemInitialized)
+}
+
 func logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
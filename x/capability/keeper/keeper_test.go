@@ -305,6 +305,39 @@ func (suite KeeperTestSuite) TestRevertCapability() {
 	suite.Require().Equal(cap, got, "did not get correct capability from context")
 }
 
+// TestRevertReleaseCapability ensures that the in-memory capability of a reverted
+// release is still available, and that it is only dropped once released for good.
+func (suite *KeeperTestSuite) TestRevertReleaseCapability() {
+	sk := suite.keeper.ScopeToModule(banktypes.ModuleName)
+
+	cap, err := sk.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+
+	// release the capability on a cached context and discard the writes
+	cacheCtx, _ := suite.ctx.CacheContext()
+	suite.Require().NoError(sk.ReleaseCapability(cacheCtx, cap))
+	_, ok := sk.GetCapability(cacheCtx, "transfer")
+	suite.Require().False(ok)
+
+	suite.keeper.PruneReleasedCapabilities(suite.ctx)
+
+	got, ok := sk.GetCapability(suite.ctx, "transfer")
+	suite.Require().True(ok, "reverted release dropped the capability")
+	suite.Require().Same(cap, got)
+	suite.Require().True(sk.AuthenticateCapability(suite.ctx, got, "transfer"))
+
+	// release the capability for good
+	suite.Require().NoError(sk.ReleaseCapability(suite.ctx, cap))
+	suite.keeper.PruneReleasedCapabilities(suite.ctx)
+
+	_, ok = sk.GetCapability(suite.ctx, "transfer")
+	suite.Require().False(ok)
+
+	newCap, err := sk.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(cap.GetIndex(), newCap.GetIndex())
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -140,12 +140,14 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
-// BeginBlocker calls InitMemStore to assert that the memory store is initialized.
-// It's safe to run multiple times.
+// BeginBlocker calls InitMemStore to assert that the memory store is initialized,
+// and drops from memory the capabilities whose release was committed in the
+// previous block. It's safe to run multiple times.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	am.keeper.InitMemStore(ctx)
+	am.keeper.PruneReleasedCapabilities(ctx)
 }
 
 // EndBlock executes all ABCI EndBlock logic respective to the capability module. It
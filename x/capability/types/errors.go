@@ -15,4 +15,5 @@ var (
 	ErrCapabilityNotOwned       = sdkerrors.Register(ModuleName, 6, "capability not owned by module")
 	ErrCapabilityNotFound       = sdkerrors.Register(ModuleName, 7, "capability not found")
 	ErrCapabilityOwnersNotFound = sdkerrors.Register(ModuleName, 8, "owners not found for capability")
+	ErrMemStoreNotInitialized   = sdkerrors.Register(ModuleName, 9, "capability memory store not initialized")
 )
@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        circuit.ModuleName,
+		Short:                      "circuit transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCmdAuthorizeCircuitBreaker(),
+		NewCmdTripCircuitBreaker(),
+		NewCmdResetCircuitBreaker(),
+	)
+
+	return txCmd
+}
+
+func NewCmdAuthorizeCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorize [grantee] [level] [limit-type-urls] --from [granter]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "grant or revoke circuit breaker permissions of an account",
+		Long: strings.TrimSpace(fmt.Sprintf(`Grant circuit breaker permissions to an account, overwriting its existing permissions.
+The level is one of LEVEL_NONE_UNSPECIFIED (revoke), LEVEL_SOME_MSGS, LEVEL_ALL_MSGS or LEVEL_SUPER_ADMIN.
+With LEVEL_SOME_MSGS, limit-type-urls is a comma-separated list of Msg type URLs or package wildcards.
+
+Example:
+			$ %s tx %s authorize <grantee> LEVEL_SOME_MSGS /cosmos.bank.v1beta1.MsgSend,/cosmos.staking.v1beta1.* --from <granter>`, version.AppName, circuit.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			level, ok := circuit.Permissions_Level_value[args[1]]
+			if !ok {
+				return fmt.Errorf("unknown permission level %s", args[1])
+			}
+
+			perms := circuit.Permissions{Level: circuit.Permissions_Level(level)}
+			if len(args) == 3 {
+				perms.LimitTypeUrls = strings.Split(args[2], ",")
+			}
+
+			msg := circuit.MsgAuthorizeCircuitBreaker{
+				Granter:     clientCtx.GetFromAddress().String(),
+				Grantee:     args[0],
+				Permissions: &perms,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdTripCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip [msg-type-url]... --from [authority]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "disable Msg type URLs or all Msgs of a proto package",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s trip /cosmos.bank.v1beta1.MsgSend /cosmos.staking.v1beta1.* --from <authority>`, version.AppName, circuit.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := circuit.MsgTripCircuitBreaker{
+				Authority:   clientCtx.GetFromAddress().String(),
+				MsgTypeUrls: args,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdResetCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset [msg-type-url]... --from [authority]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "enable again disabled Msg type URLs or package wildcards",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s reset /cosmos.bank.v1beta1.MsgSend --from <authority>`, version.AppName, circuit.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := circuit.MsgResetCircuitBreaker{
+				Authority:   clientCtx.GetFromAddress().String(),
+				MsgTypeUrls: args,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,12 @@
+package circuit
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/circuit module sentinel errors
+var (
+	ErrInvalidTypeURL  = sdkerrors.Register(ModuleName, 2, "invalid msg type url")
+	ErrAlreadyDisabled = sdkerrors.Register(ModuleName, 3, "msg type url already disabled")
+	ErrNotDisabled     = sdkerrors.Register(ModuleName, 4, "msg type url not disabled")
+)
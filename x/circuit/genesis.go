@@ -0,0 +1,35 @@
+package circuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateGenesis check the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	for _, account := range data.AccountPermissions {
+		if _, err := sdk.AccAddressFromBech32(account.Address); err != nil {
+			return err
+		}
+		if account.Permissions == nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("permissions of %s cannot be nil", account.Address)
+		}
+		if err := account.Permissions.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, typeURL := range data.DisabledTypeUrls {
+		if err := ValidateTypeURL(typeURL); err != nil {
+			return err
+		}
+		if PackageWildcard(typeURL) == ModuleWildcard {
+			return sdkerrors.Wrapf(ErrInvalidTypeURL, "%s cannot be disabled", typeURL)
+		}
+	}
+	return nil
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+// InitGenesis new circuit genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *circuit.GenesisState) {
+	for _, account := range data.AccountPermissions {
+		addr, err := sdk.AccAddressFromBech32(account.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.SetPermissions(ctx, addr, *account.Permissions)
+	}
+
+	for _, typeURL := range data.DisabledTypeUrls {
+		k.DisableMsg(ctx, typeURL)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *circuit.GenesisState {
+	var accounts []*circuit.GenesisAccountPermissions
+	k.IteratePermissions(ctx, func(addr sdk.AccAddress, perms circuit.Permissions) bool {
+		accounts = append(accounts, &circuit.GenesisAccountPermissions{
+			Address:     addr.String(),
+			Permissions: &perms,
+		})
+		return false
+	})
+
+	var disabledTypeURLs []string
+	k.IterateDisableList(ctx, func(typeURL string) bool {
+		disabledTypeURLs = append(disabledTypeURLs, typeURL)
+		return false
+	})
+
+	return &circuit.GenesisState{
+		AccountPermissions: accounts,
+		DisabledTypeUrls:   disabledTypeURLs,
+	}
+}
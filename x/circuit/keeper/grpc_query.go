@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+var _ circuit.QueryServer = Keeper{}
+
+// Account returns the circuit breaker permissions of an account
+func (k Keeper) Account(goCtx context.Context, r *circuit.QueryAccountRequest) (*circuit.QueryAccountResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(r.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	perms, _ := k.GetPermissions(ctx, addr)
+	return &circuit.QueryAccountResponse{Permission: &perms}, nil
+}
+
+// Accounts returns the circuit breaker permissions of all accounts
+func (k Keeper) Accounts(goCtx context.Context, r *circuit.QueryAccountsRequest) (*circuit.QueryAccountsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), AccountPermissionPrefix)
+
+	var accounts []*circuit.GenesisAccountPermissions
+	pageRes, err := query.Paginate(store, r.Pagination, func(key []byte, value []byte) error {
+		var perms circuit.Permissions
+		if err := k.cdc.Unmarshal(value, &perms); err != nil {
+			return err
+		}
+		// skip the address length prefix
+		accounts = append(accounts, &circuit.GenesisAccountPermissions{
+			Address:     sdk.AccAddress(key[1:]).String(),
+			Permissions: &perms,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &circuit.QueryAccountsResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// DisabledList returns the disabled Msg type URLs and package wildcards
+func (k Keeper) DisabledList(goCtx context.Context, r *circuit.QueryDisabledListRequest) (*circuit.QueryDisabledListResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var disabledList []string
+	k.IterateDisableList(ctx, func(typeURL string) bool {
+		disabledList = append(disabledList, typeURL)
+		return false
+	})
+	return &circuit.QueryDisabledListResponse{DisabledList: disabledList}, nil
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+var _ middleware.CircuitBreaker = Keeper{}
+
+// Keeper of the circuit store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	// the address capable of granting circuit breaker permissions and of tripping or resetting
+	// the circuit breaker of any Msg. Usually the gov module account
+	authority string
+}
+
+// NewKeeper creates a new circuit Keeper instance
+func NewKeeper(key storetypes.StoreKey, cdc codec.BinaryCodec, authority string) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  key,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the address of the circuit module authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// IsAllowed returns whether the Msg with the given type URL can be executed, i.e. neither the type URL
+// nor the wildcard of its proto package is disabled. Msgs of the circuit module are always allowed.
+func (k Keeper) IsAllowed(ctx sdk.Context, typeURL string) (bool, error) {
+	wildcard := circuit.PackageWildcard(typeURL)
+	if wildcard == circuit.ModuleWildcard {
+		return true, nil
+	}
+	return !k.IsDisabled(ctx, typeURL) && !k.IsDisabled(ctx, wildcard), nil
+}
+
+// GetPermissions returns the circuit breaker permissions of an account
+func (k Keeper) GetPermissions(ctx sdk.Context, addr sdk.AccAddress) (circuit.Permissions, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(accountPermissionKey(addr))
+
+	var perms circuit.Permissions
+	if len(bz) == 0 {
+		return perms, false
+	}
+	k.cdc.MustUnmarshal(bz, &perms)
+	return perms, true
+}
+
+// SetPermissions sets the circuit breaker permissions of an account, LEVEL_NONE_UNSPECIFIED
+// permissions remove the permissions of the account
+func (k Keeper) SetPermissions(ctx sdk.Context, addr sdk.AccAddress, perms circuit.Permissions) {
+	store := ctx.KVStore(k.storeKey)
+	if perms.Level == circuit.Permissions_LEVEL_NONE_UNSPECIFIED {
+		store.Delete(accountPermissionKey(addr))
+		return
+	}
+	store.Set(accountPermissionKey(addr), k.cdc.MustMarshal(&perms))
+}
+
+// IteratePermissions iterates over the permissions of all accounts, until cb returns true
+func (k Keeper) IteratePermissions(ctx sdk.Context, cb func(addr sdk.AccAddress, perms circuit.Permissions) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), AccountPermissionPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var perms circuit.Permissions
+		k.cdc.MustUnmarshal(iterator.Value(), &perms)
+		// skip the address length prefix
+		if cb(sdk.AccAddress(iterator.Key()[1:]), perms) {
+			break
+		}
+	}
+}
+
+// IsDisabled returns whether the given Msg type URL or package wildcard is in the disable list
+func (k Keeper) IsDisabled(ctx sdk.Context, typeURL string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(disableListKey(typeURL))
+}
+
+// DisableMsg adds the given Msg type URL or package wildcard to the disable list
+func (k Keeper) DisableMsg(ctx sdk.Context, typeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(disableListKey(typeURL), []byte{0x01})
+}
+
+// EnableMsg removes the given Msg type URL or package wildcard from the disable list
+func (k Keeper) EnableMsg(ctx sdk.Context, typeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(disableListKey(typeURL))
+}
+
+// IterateDisableList iterates over the disabled Msg type URLs and package wildcards, until cb returns true
+func (k Keeper) IterateDisableList(ctx sdk.Context, cb func(typeURL string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), DisableListPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key())) {
+			break
+		}
+	}
+}
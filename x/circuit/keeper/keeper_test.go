@@ -0,0 +1,211 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	msgSendURL     = "/cosmos.bank.v1beta1.MsgSend"
+	msgMultiURL    = "/cosmos.bank.v1beta1.MsgMultiSend"
+	bankWildcard   = "/cosmos.bank.v1beta1.*"
+	msgDelegateURL = "/cosmos.staking.v1beta1.MsgDelegate"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	authority   string
+	queryClient circuit.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	circuit.RegisterQueryServer(queryHelper, app.CircuitKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = circuit.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) authorize(granter string, grantee sdk.AccAddress, perms circuit.Permissions) error {
+	_, err := s.app.CircuitKeeper.AuthorizeCircuitBreaker(s.ctx, &circuit.MsgAuthorizeCircuitBreaker{
+		Granter:     granter,
+		Grantee:     grantee.String(),
+		Permissions: &perms,
+	})
+	return err
+}
+
+func (s *TestSuite) trip(authority string, typeURLs ...string) error {
+	_, err := s.app.CircuitKeeper.TripCircuitBreaker(s.ctx, &circuit.MsgTripCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: typeURLs,
+	})
+	return err
+}
+
+func (s *TestSuite) reset(authority string, typeURLs ...string) error {
+	_, err := s.app.CircuitKeeper.ResetCircuitBreaker(s.ctx, &circuit.MsgResetCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: typeURLs,
+	})
+	return err
+}
+
+func (s *TestSuite) isAllowed(typeURL string) bool {
+	allowed, err := s.app.CircuitKeeper.IsAllowed(s.ctx, typeURL)
+	s.Require().NoError(err)
+	return allowed
+}
+
+func (s *TestSuite) TestAuthorizeCircuitBreaker() {
+	superAdmin, member, other := s.addrs[0], s.addrs[1], s.addrs[2]
+
+	// only the authority or a super admin can grant permissions
+	err := s.authorize(other.String(), member, circuit.Permissions{Level: circuit.Permissions_LEVEL_ALL_MSGS})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	err = s.authorize(s.authority, superAdmin, circuit.Permissions{Level: circuit.Permissions_LEVEL_SUPER_ADMIN})
+	s.Require().NoError(err)
+
+	expPerms := circuit.Permissions{Level: circuit.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSendURL}}
+	err = s.authorize(superAdmin.String(), member, expPerms)
+	s.Require().NoError(err)
+
+	perms, found := s.app.CircuitKeeper.GetPermissions(s.ctx, member)
+	s.Require().True(found)
+	s.Require().Equal(expPerms, perms)
+
+	res, err := s.queryClient.Accounts(s.ctx, &circuit.QueryAccountsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), res.Pagination.Total)
+
+	// revoke
+	err = s.authorize(superAdmin.String(), member, circuit.Permissions{})
+	s.Require().NoError(err)
+	_, found = s.app.CircuitKeeper.GetPermissions(s.ctx, member)
+	s.Require().False(found)
+
+	accRes, err := s.queryClient.Account(s.ctx, &circuit.QueryAccountRequest{Address: member.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(circuit.Permissions_LEVEL_NONE_UNSPECIFIED, accRes.Permission.Level)
+}
+
+func (s *TestSuite) TestTripAndResetCircuitBreaker() {
+	member, other := s.addrs[0], s.addrs[1]
+	err := s.authorize(s.authority, member, circuit.Permissions{
+		Level:         circuit.Permissions_LEVEL_SOME_MSGS,
+		LimitTypeUrls: []string{bankWildcard},
+	})
+	s.Require().NoError(err)
+
+	// no permissions
+	err = s.trip(other.String(), msgSendURL)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// out of the limit type urls
+	err = s.trip(member.String(), msgSendURL, msgDelegateURL)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	s.Require().True(s.isAllowed(msgSendURL))
+
+	err = s.trip(member.String(), msgSendURL)
+	s.Require().NoError(err)
+	s.Require().False(s.isAllowed(msgSendURL))
+	s.Require().True(s.isAllowed(msgMultiURL))
+
+	err = s.trip(member.String(), msgSendURL)
+	s.Require().ErrorIs(err, circuit.ErrAlreadyDisabled)
+
+	// all messages of the bank module
+	err = s.trip(member.String(), bankWildcard)
+	s.Require().NoError(err)
+	s.Require().False(s.isAllowed(msgMultiURL))
+	s.Require().True(s.isAllowed(msgDelegateURL))
+
+	res, err := s.queryClient.DisabledList(s.ctx, &circuit.QueryDisabledListRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{bankWildcard, msgSendURL}, res.DisabledList)
+
+	err = s.reset(member.String(), bankWildcard)
+	s.Require().NoError(err)
+	s.Require().True(s.isAllowed(msgMultiURL))
+	s.Require().False(s.isAllowed(msgSendURL))
+
+	err = s.reset(member.String(), bankWildcard)
+	s.Require().ErrorIs(err, circuit.ErrNotDisabled)
+
+	// the authority can trip and reset any message
+	err = s.trip(s.authority, msgDelegateURL)
+	s.Require().NoError(err)
+	s.Require().False(s.isAllowed(msgDelegateURL))
+	err = s.reset(s.authority, msgDelegateURL, msgSendURL)
+	s.Require().NoError(err)
+	s.Require().True(s.isAllowed(msgDelegateURL))
+	s.Require().True(s.isAllowed(msgSendURL))
+}
+
+func (s *TestSuite) TestCircuitMsgsCannotBeDisabled() {
+	err := s.trip(s.authority, sdk.MsgTypeURL(&circuit.MsgResetCircuitBreaker{}))
+	s.Require().ErrorIs(err, circuit.ErrInvalidTypeURL)
+
+	err = s.trip(s.authority, circuit.ModuleWildcard)
+	s.Require().ErrorIs(err, circuit.ErrInvalidTypeURL)
+
+	// even if disabled through the keeper, the circuit Msgs stay allowed
+	s.app.CircuitKeeper.DisableMsg(s.ctx, circuit.ModuleWildcard)
+	s.Require().True(s.isAllowed(sdk.MsgTypeURL(&circuit.MsgResetCircuitBreaker{})))
+}
+
+func (s *TestSuite) TestMsgServiceRouter() {
+	msg := banktypes.NewMsgSend(s.addrs[0], s.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	s.app.CircuitKeeper.DisableMsg(s.ctx, bankWildcard)
+
+	handler := s.app.MsgServiceRouter().Handler(msg)
+	_, err := handler(s.ctx, msg)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	s.app.CircuitKeeper.EnableMsg(s.ctx, bankWildcard)
+	_, err = handler(s.ctx, msg)
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestGenesis() {
+	expGenesis := &circuit.GenesisState{
+		AccountPermissions: []*circuit.GenesisAccountPermissions{
+			{
+				Address:     s.addrs[0].String(),
+				Permissions: &circuit.Permissions{Level: circuit.Permissions_LEVEL_SUPER_ADMIN},
+			},
+		},
+		DisabledTypeUrls: []string{bankWildcard, msgDelegateURL},
+	}
+	s.Require().NoError(circuit.ValidateGenesis(*expGenesis))
+
+	s.app.CircuitKeeper.InitGenesis(s.ctx, expGenesis)
+	s.Require().False(s.isAllowed(msgSendURL))
+	s.Require().Equal(expGenesis, s.app.CircuitKeeper.ExportGenesis(s.ctx))
+}
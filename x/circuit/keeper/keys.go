@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+var (
+	AccountPermissionPrefix = []byte{0x01}
+	DisableListPrefix       = []byte{0x02}
+)
+
+// accountPermissionKey returns the byte representation of the permissions key of an account
+// Items are stored with the following key: values
+// 0x01<address length (1 Byte)><address>
+func accountPermissionKey(addr sdk.AccAddress) []byte {
+	return append(AccountPermissionPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// disableListKey returns the byte representation of the key of a disabled Msg type URL
+// Items are stored with the following key: values
+// 0x02<typeURL>
+func disableListKey(typeURL string) []byte {
+	return append(DisableListPrefix, typeURL...)
+}
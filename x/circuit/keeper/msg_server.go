@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+var _ circuit.MsgServer = Keeper{}
+
+// AuthorizeCircuitBreaker implements the AuthorizeCircuitBreaker method of the circuit.MsgServer.
+func (k Keeper) AuthorizeCircuitBreaker(goCtx context.Context, msg *circuit.MsgAuthorizeCircuitBreaker) (*circuit.MsgAuthorizeCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Granter != k.authority {
+		granter, err := sdk.AccAddressFromBech32(msg.Granter)
+		if err != nil {
+			return nil, err
+		}
+
+		perms, _ := k.GetPermissions(ctx, granter)
+		if perms.Level != circuit.Permissions_LEVEL_SUPER_ADMIN {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is neither the authority nor a super admin", msg.Granter)
+		}
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Permissions == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("permissions cannot be nil")
+	}
+	k.SetPermissions(ctx, grantee, *msg.Permissions)
+
+	if err := ctx.EventManager().EmitTypedEvent(&circuit.EventAuthorizeCircuitBreaker{
+		Granter: msg.Granter,
+		Grantee: msg.Grantee,
+		Level:   msg.Permissions.Level,
+	}); err != nil {
+		return nil, err
+	}
+	return &circuit.MsgAuthorizeCircuitBreakerResponse{}, nil
+}
+
+// TripCircuitBreaker implements the TripCircuitBreaker method of the circuit.MsgServer.
+func (k Keeper) TripCircuitBreaker(goCtx context.Context, msg *circuit.MsgTripCircuitBreaker) (*circuit.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkPermissions(ctx, msg.Authority, msg.MsgTypeUrls); err != nil {
+		return nil, err
+	}
+
+	for _, typeURL := range msg.MsgTypeUrls {
+		if circuit.PackageWildcard(typeURL) == circuit.ModuleWildcard {
+			return nil, sdkerrors.Wrapf(circuit.ErrInvalidTypeURL, "%s cannot be disabled", typeURL)
+		}
+		if k.IsDisabled(ctx, typeURL) {
+			return nil, sdkerrors.Wrap(circuit.ErrAlreadyDisabled, typeURL)
+		}
+		k.DisableMsg(ctx, typeURL)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&circuit.EventTripCircuitBreaker{
+		Authority:   msg.Authority,
+		MsgTypeUrls: msg.MsgTypeUrls,
+	}); err != nil {
+		return nil, err
+	}
+	return &circuit.MsgTripCircuitBreakerResponse{}, nil
+}
+
+// ResetCircuitBreaker implements the ResetCircuitBreaker method of the circuit.MsgServer.
+func (k Keeper) ResetCircuitBreaker(goCtx context.Context, msg *circuit.MsgResetCircuitBreaker) (*circuit.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkPermissions(ctx, msg.Authority, msg.MsgTypeUrls); err != nil {
+		return nil, err
+	}
+
+	for _, typeURL := range msg.MsgTypeUrls {
+		if !k.IsDisabled(ctx, typeURL) {
+			return nil, sdkerrors.Wrap(circuit.ErrNotDisabled, typeURL)
+		}
+		k.EnableMsg(ctx, typeURL)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&circuit.EventResetCircuitBreaker{
+		Authority:   msg.Authority,
+		MsgTypeUrls: msg.MsgTypeUrls,
+	}); err != nil {
+		return nil, err
+	}
+	return &circuit.MsgResetCircuitBreakerResponse{}, nil
+}
+
+// checkPermissions returns an error unless addr is the authority or has the permissions to trip and
+// reset the circuit breaker of all the given type URLs
+func (k Keeper) checkPermissions(ctx sdk.Context, addr string, typeURLs []string) error {
+	if addr == k.authority {
+		return nil
+	}
+
+	acc, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return err
+	}
+
+	perms, _ := k.GetPermissions(ctx, acc)
+	for _, typeURL := range typeURLs {
+		if !perms.CanManage(typeURL) {
+			return sdkerrors.ErrUnauthorized.Wrapf("%s cannot trip or reset the circuit breaker of %s", addr, typeURL)
+		}
+	}
+	return nil
+}
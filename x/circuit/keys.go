@@ -0,0 +1,12 @@
+package circuit
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "circuit"
+
+	// StoreKey is the store key string for circuit
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for circuit
+	RouterKey = ModuleName
+)
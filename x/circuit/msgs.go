@@ -0,0 +1,80 @@
+package circuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgAuthorizeCircuitBreaker{}
+	_ sdk.Msg = &MsgTripCircuitBreaker{}
+	_ sdk.Msg = &MsgResetCircuitBreaker{}
+)
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgAuthorizeCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Granter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid granter address (%s)", m.Granter)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Grantee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid grantee address (%s)", m.Grantee)
+	}
+
+	if m.Permissions == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("permissions cannot be nil")
+	}
+	return m.Permissions.Validate()
+}
+
+// GetSigners implements Msg
+func (m MsgAuthorizeCircuitBreaker) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Granter)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgTripCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", m.Authority)
+	}
+	return validateMsgTypeURLs(m.MsgTypeUrls)
+}
+
+// GetSigners implements Msg
+func (m MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgResetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", m.Authority)
+	}
+	return validateMsgTypeURLs(m.MsgTypeUrls)
+}
+
+// GetSigners implements Msg
+func (m MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+func validateMsgTypeURLs(typeURLs []string) error {
+	if len(typeURLs) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("msg type urls cannot be empty")
+	}
+
+	seen := make(map[string]struct{}, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		if err := ValidateTypeURL(typeURL); err != nil {
+			return err
+		}
+		if _, ok := seen[typeURL]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate msg type url %s", typeURL)
+		}
+		seen[typeURL] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package circuit
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// wildcardSuffix is the suffix of a type URL entry covering all Msgs of a proto package
+const wildcardSuffix = ".*"
+
+// ModuleWildcard is the wildcard covering the Msgs of the circuit module itself, which can never be disabled
+const ModuleWildcard = "/cosmos.circuit.v1" + wildcardSuffix
+
+// ValidateTypeURL checks that typeURL is either a Msg type URL, e.g. "/cosmos.bank.v1beta1.MsgSend", or
+// a wildcard covering all Msgs of a proto package, e.g. "/cosmos.bank.v1beta1.*".
+func ValidateTypeURL(typeURL string) error {
+	if !strings.HasPrefix(typeURL, "/") {
+		return sdkerrors.Wrapf(ErrInvalidTypeURL, "%q must start with /", typeURL)
+	}
+
+	name := strings.TrimSuffix(typeURL[1:], wildcardSuffix)
+	for _, part := range strings.Split(name, ".") {
+		if len(part) == 0 || strings.ContainsAny(part, "*/ ") {
+			return sdkerrors.Wrapf(ErrInvalidTypeURL, "%q", typeURL)
+		}
+	}
+	return nil
+}
+
+// PackageWildcard returns the wildcard covering the proto package of the given Msg type URL.
+// The wildcard of a wildcard is itself.
+func PackageWildcard(typeURL string) string {
+	i := strings.LastIndex(typeURL, ".")
+	if i < 0 {
+		return ""
+	}
+	return typeURL[:i] + wildcardSuffix
+}
+
+// Validate checks the level and the limit type URLs of the permissions
+func (p Permissions) Validate() error {
+	if _, ok := Permissions_Level_name[int32(p.Level)]; !ok {
+		return sdkerrors.ErrInvalidRequest.Wrapf("unknown permission level %d", p.Level)
+	}
+
+	if p.Level == Permissions_LEVEL_SOME_MSGS && len(p.LimitTypeUrls) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("limit type urls cannot be empty with LEVEL_SOME_MSGS")
+	}
+	for _, typeURL := range p.LimitTypeUrls {
+		if err := ValidateTypeURL(typeURL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CanManage returns whether the permissions allow to trip or reset the circuit breaker of the given
+// Msg type URL or package wildcard
+func (p Permissions) CanManage(typeURL string) bool {
+	switch p.Level {
+	case Permissions_LEVEL_SUPER_ADMIN, Permissions_LEVEL_ALL_MSGS:
+		return true
+	case Permissions_LEVEL_SOME_MSGS:
+		for _, limit := range p.LimitTypeUrls {
+			if limit == typeURL || limit == PackageWildcard(typeURL) {
+				return true
+			}
+		}
+	}
+	return false
+}
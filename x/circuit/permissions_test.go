@@ -0,0 +1,43 @@
+package circuit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+func TestValidateTypeURL(t *testing.T) {
+	for _, typeURL := range []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.*"} {
+		require.NoError(t, circuit.ValidateTypeURL(typeURL), typeURL)
+	}
+	for _, typeURL := range []string{"", "/", "cosmos.bank.v1beta1.MsgSend", "/cosmos..MsgSend", "/cosmos.*.MsgSend", "/*"} {
+		require.ErrorIs(t, circuit.ValidateTypeURL(typeURL), circuit.ErrInvalidTypeURL, typeURL)
+	}
+}
+
+func TestPermissionsCanManage(t *testing.T) {
+	some := circuit.Permissions{
+		Level:         circuit.Permissions_LEVEL_SOME_MSGS,
+		LimitTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.*"},
+	}
+	require.True(t, some.CanManage("/cosmos.bank.v1beta1.MsgSend"))
+	require.False(t, some.CanManage("/cosmos.bank.v1beta1.MsgMultiSend"))
+	require.False(t, some.CanManage("/cosmos.bank.v1beta1.*"))
+	require.True(t, some.CanManage("/cosmos.staking.v1beta1.MsgDelegate"))
+	require.True(t, some.CanManage("/cosmos.staking.v1beta1.*"))
+
+	none := circuit.Permissions{}
+	require.False(t, none.CanManage("/cosmos.bank.v1beta1.MsgSend"))
+
+	all := circuit.Permissions{Level: circuit.Permissions_LEVEL_ALL_MSGS}
+	require.True(t, all.CanManage("/cosmos.bank.v1beta1.*"))
+}
+
+func TestPermissionsValidate(t *testing.T) {
+	require.NoError(t, circuit.Permissions{Level: circuit.Permissions_LEVEL_ALL_MSGS}.Validate())
+	require.Error(t, circuit.Permissions{Level: circuit.Permissions_LEVEL_SOME_MSGS}.Validate())
+	require.Error(t, circuit.Permissions{Level: 9}.Validate())
+	require.Error(t, circuit.Permissions{Level: circuit.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{"MsgSend"}}.Validate())
+}
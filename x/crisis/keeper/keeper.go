@@ -87,7 +87,43 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	}
 
 	diff := time.Since(start)
-	logger.Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
+	logger.Info("asserted all invariants", "duration", diff)
+}
+
+// CheckInvariants runs the registered invariants, starting with the route at
+// index offset, without panicking when one of them is broken. Execution stops
+// once the given budget is exhausted, a zero budget meaning no limit. The
+// returned index is the offset at which a subsequent call should resume so that
+// all invariants are eventually covered in a round-robin fashion.
+func (k Keeper) CheckInvariants(ctx sdk.Context, offset int, budget time.Duration) ([]types.InvariantResult, int) {
+	invarRoutes := k.Routes()
+	n := len(invarRoutes)
+	if n == 0 {
+		return nil, 0
+	}
+
+	start := time.Now()
+	results := make([]types.InvariantResult, 0, n)
+	next := offset % n
+	for i := 0; i < n; i++ {
+		if budget > 0 && i > 0 && time.Since(start) >= budget {
+			break
+		}
+
+		ir := invarRoutes[next]
+		invStart := time.Now()
+		res, broken := ir.Invar(ctx)
+		results = append(results, types.InvariantResult{
+			ModuleName: ir.ModuleName,
+			Route:      ir.Route,
+			Msg:        res,
+			Broken:     broken,
+			Duration:   time.Since(invStart),
+		})
+		next = (next + 1) % n
+	}
+
+	return results, next
 }
 
 // InvCheckPeriod returns the invariant checks period.
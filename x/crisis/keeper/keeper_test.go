@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -9,6 +11,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
@@ -48,3 +52,71 @@ func TestAssertInvariants(t *testing.T) {
 	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
 }
+
+func TestCheckInvariants(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	ctx := app.NewContext(true, tmproto.Header{})
+
+	n := len(app.CrisisKeeper.Routes())
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute", func(sdk.Context) (string, bool) { return "broken", true })
+
+	require.NotPanics(t, func() {
+		results, next := app.CrisisKeeper.CheckInvariants(ctx, 0, 0)
+		require.Len(t, results, n+1)
+		require.Equal(t, 0, next)
+		require.True(t, results[n].Broken)
+		require.Equal(t, "broken", results[n].Msg)
+		require.Equal(t, "testModule/testRoute", results[n].FullRoute())
+	})
+
+	// resuming from an offset wraps around the registered routes
+	results, next := app.CrisisKeeper.CheckInvariants(ctx, n, 0)
+	require.Len(t, results, n+1)
+	require.Equal(t, n, next)
+	require.True(t, results[0].Broken)
+}
+
+func TestInvariantRunner(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+
+	var broken []types.InvariantResult
+	runner := keeper.NewInvariantRunner(
+		&app.CrisisKeeper,
+		func() (sdk.Context, error) { return app.CreateQueryContext(0, false) },
+		time.Hour, 0, app.Logger(),
+	)
+	// the invariants registered after the creation of the runner are checked
+	isBroken := true
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute", func(sdk.Context) (string, bool) { return "broken", isBroken })
+	runner.SetBrokenInvariantHandler(func(_ int64, res types.InvariantResult) { broken = append(broken, res) })
+
+	results := runner.RunOnce()
+	require.Len(t, results, len(app.CrisisKeeper.Routes()))
+	require.Len(t, broken, 1)
+	require.Equal(t, "testModule/testRoute", broken[0].FullRoute())
+
+	// the broken invariants are kept and can be queried
+	expected := []types.BrokenInvariant{{Route: "testModule/testRoute", Msg: "broken", Height: app.LastBlockHeight()}}
+	require.Equal(t, expected, runner.BrokenInvariants())
+
+	querier := runner.Querier()
+	bz, err := querier(sdk.Context{}, []string{types.QueryBrokenInvariants}, abci.RequestQuery{})
+	require.NoError(t, err)
+	var queried []types.BrokenInvariant
+	require.NoError(t, json.Unmarshal(bz, &queried))
+	require.Equal(t, expected, queried)
+	_, err = querier(sdk.Context{}, []string{"unknown"}, abci.RequestQuery{})
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownRequest)
+
+	// until they hold again
+	isBroken = false
+	runner.RunOnce()
+	require.Empty(t, runner.BrokenInvariants())
+
+	runner.Start()
+	runner.Stop()
+}
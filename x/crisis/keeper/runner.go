@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/util/collection"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// ContextFn returns a read-only context pinned to a committed version of the
+// application state, e.g. the latest height.
+type ContextFn func() (sdk.Context, error)
+
+// BrokenInvariantHandler is called by the InvariantRunner for every invariant
+// found to be broken.
+type BrokenInvariantHandler func(height int64, res types.InvariantResult)
+
+// InvariantRunner asserts the registered invariants in the background against
+// a read-only version of the state, so that large chains do not need to block
+// consensus while invariants are being checked. Broken invariants are logged,
+// reported through telemetry and kept until they are found to hold again, to be
+// returned by BrokenInvariants and the runner Querier; they never halt the node.
+// The on-demand MsgVerifyInvariant path is left untouched.
+type InvariantRunner struct {
+	keeper   *Keeper
+	ctxFn    ContextFn
+	interval time.Duration
+	budget   time.Duration
+	logger   log.Logger
+	onBroken BrokenInvariantHandler
+
+	mtx     sync.Mutex
+	offset  int
+	broken  map[string]types.BrokenInvariant
+	running bool
+	quit    chan struct{}
+	done    chan struct{}
+}
+
+// NewInvariantRunner creates a new InvariantRunner which checks invariants every
+// interval. Each round runs invariants until budget is exhausted (zero meaning
+// no limit) and the next round resumes where the previous one stopped. The
+// keeper is shared, so that the invariants registered after the creation of the
+// runner are checked as well.
+func NewInvariantRunner(k *Keeper, ctxFn ContextFn, interval, budget time.Duration, logger log.Logger) *InvariantRunner {
+	return &InvariantRunner{
+		keeper:   k,
+		ctxFn:    ctxFn,
+		interval: interval,
+		budget:   budget,
+		logger:   logger.With("module", "x/"+types.ModuleName),
+		broken:   make(map[string]types.BrokenInvariant),
+	}
+}
+
+// SetBrokenInvariantHandler sets an optional handler called for every broken
+// invariant, e.g. to alert operators.
+func (r *InvariantRunner) SetBrokenInvariantHandler(h BrokenInvariantHandler) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.onBroken = h
+}
+
+// Start launches the background goroutine. It is a no-op if the runner is
+// already running or if its interval is not positive.
+func (r *InvariantRunner) Start() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.running || r.interval <= 0 {
+		return
+	}
+
+	r.running = true
+	r.quit = make(chan struct{})
+	r.done = make(chan struct{})
+	go r.loop(r.quit, r.done)
+}
+
+// Stop stops the background goroutine and waits for the current round to
+// finish.
+func (r *InvariantRunner) Stop() {
+	r.mtx.Lock()
+	if !r.running {
+		r.mtx.Unlock()
+		return
+	}
+	r.running = false
+	close(r.quit)
+	done := r.done
+	r.mtx.Unlock()
+
+	<-done
+}
+
+func (r *InvariantRunner) loop(quit, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+			r.RunOnce()
+		}
+	}
+}
+
+// RunOnce performs a single round of invariant checks and returns its results.
+func (r *InvariantRunner) RunOnce() []types.InvariantResult {
+	ctx, err := r.ctxFn()
+	if err != nil {
+		r.logger.Error("failed to create context for background invariant checks", "err", err)
+		return nil
+	}
+	// the state is only initialized once the genesis block is committed
+	if ctx.BlockHeight() == 0 {
+		return nil
+	}
+
+	r.mtx.Lock()
+	offset, onBroken := r.offset, r.onBroken
+	r.mtx.Unlock()
+
+	start := time.Now()
+	results, next := r.keeper.CheckInvariants(ctx, offset, r.budget)
+
+	r.mtx.Lock()
+	r.offset = next
+	for _, res := range results {
+		if res.Broken {
+			r.broken[res.FullRoute()] = types.BrokenInvariant{Route: res.FullRoute(), Msg: res.Msg, Height: ctx.BlockHeight()}
+		} else {
+			delete(r.broken, res.FullRoute())
+		}
+	}
+	r.mtx.Unlock()
+
+	for _, res := range results {
+		labels := []metrics.Label{telemetry.NewLabel("route", res.FullRoute())}
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, "invariant", "duration_ms"}, float32(res.Duration.Milliseconds()), labels,
+		)
+
+		if !res.Broken {
+			continue
+		}
+
+		telemetry.IncrCounterWithLabels([]string{types.ModuleName, "invariant", "broken"}, 1, labels)
+		r.logger.Error("invariant broken", "name", res.FullRoute(), "height", ctx.BlockHeight(), "msg", res.Msg)
+		if onBroken != nil {
+			onBroken(ctx.BlockHeight(), res)
+		}
+	}
+
+	r.logger.Info(
+		"checked invariants in the background",
+		"count", len(results), "total", len(r.keeper.Routes()),
+		"height", ctx.BlockHeight(), "duration", time.Since(start),
+	)
+
+	return results
+}
+
+// BrokenInvariants returns the invariants found to be broken by the runner, by
+// route, which were not found to hold since.
+func (r *InvariantRunner) BrokenInvariants() []types.BrokenInvariant {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	broken := make([]types.BrokenInvariant, 0, len(r.broken))
+	for _, route := range collection.SortedKeys(r.broken) {
+		broken = append(broken, r.broken[route])
+	}
+
+	return broken
+}
+
+// Querier returns a querier of the broken invariants, to be registered under
+// types.QuerierRoute, e.g. queried with the /custom/crisis/broken_invariants
+// ABCI query path. As the runner is node-local, so are its results.
+func (r *InvariantRunner) Querier() sdk.Querier {
+	return func(_ sdk.Context, path []string, _ abci.RequestQuery) ([]byte, error) {
+		if len(path) == 0 || path[0] != types.QueryBrokenInvariants {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %v", types.ModuleName, path)
+		}
+
+		bz, err := json.Marshal(r.BrokenInvariants())
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+
+		return bz, nil
+	}
+}
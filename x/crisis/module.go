@@ -28,6 +28,13 @@ var (
 // Module init related flags
 const (
 	FlagSkipGenesisInvariants = "x-crisis-skip-assert-invariants"
+
+	// FlagBackgroundInvariantsInterval sets how often invariants are asserted
+	// in the background. Zero disables the background invariant runner.
+	FlagBackgroundInvariantsInterval = "x-crisis-background-invariants-interval"
+	// FlagBackgroundInvariantsBudget caps the time spent on a single round of
+	// background invariant checks. Zero means no limit.
+	FlagBackgroundInvariantsBudget = "x-crisis-background-invariants-budget"
 )
 
 // AppModuleBasic defines the basic application module used by the crisis module.
@@ -104,6 +111,8 @@ func NewAppModule(keeper *keeper.Keeper, skipGenesisInvariants bool) AppModule {
 // AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
 func AddModuleInitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().Bool(FlagSkipGenesisInvariants, false, "Skip x/crisis invariants check on startup")
+	startCmd.Flags().Duration(FlagBackgroundInvariantsInterval, 0, "Interval at which x/crisis invariants are asserted in the background against the latest committed state (0 to disable)")
+	startCmd.Flags().Duration(FlagBackgroundInvariantsBudget, 0, "Maximum time spent per round of background x/crisis invariant checks (0 for no limit)")
 }
 
 // Name returns the crisis module's name.
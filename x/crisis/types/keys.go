@@ -3,4 +3,13 @@ package types
 const (
 	// module name
 	ModuleName = "crisis"
+
+	// QuerierRoute is the querier route of the broken invariants reported by
+	// the InvariantRunner
+	QuerierRoute = ModuleName
+)
+
+// query endpoints supported by the InvariantRunner querier
+const (
+	QueryBrokenInvariants = "broken_invariants"
 )
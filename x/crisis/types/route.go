@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -24,3 +26,29 @@ func NewInvarRoute(moduleName, route string, invar sdk.Invariant) InvarRoute {
 func (i InvarRoute) FullRoute() string {
 	return i.ModuleName + "/" + i.Route
 }
+
+// InvariantResult is the outcome of a single invariant check that was run
+// without halting the chain.
+type InvariantResult struct {
+	ModuleName string
+	Route      string
+	Msg        string
+	Broken     bool
+	Duration   time.Duration
+}
+
+// FullRoute returns the full invariant route of the result.
+func (r InvariantResult) FullRoute() string {
+	return r.ModuleName + "/" + r.Route
+}
+
+// BrokenInvariant is an invariant found to be broken by the InvariantRunner.
+type BrokenInvariant struct {
+	// Route is the full route of the invariant.
+	Route string `json:"route"`
+	// Msg is the message returned by the invariant.
+	Msg string `json:"msg"`
+	// Height is the height of the state the invariant was last found broken
+	// at.
+	Height int64 `json:"height"`
+}
@@ -20,6 +20,7 @@ import (
 var (
 	FlagCommission       = "commission"
 	FlagMaxMessagesPerTx = "max-msgs"
+	FlagSingleMsg        = "single-msg"
 )
 
 const (
@@ -127,11 +128,13 @@ func NewWithdrawAllRewardsCmd() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Withdraw all rewards for a single delegator.
 Note that if you use this command with --%[2]s=%[3]s or --%[2]s=%[4]s, the %[5]s flag will automatically be set to 0.
+With --%[6]s, the rewards are withdrawn with a single message, without querying the validators of the delegator.
 
 Example:
 $ %[1]s tx distribution withdraw-all-rewards --from mykey
+$ %[1]s tx distribution withdraw-all-rewards --from mykey --%[6]s
 `,
-				version.AppName, flags.FlagBroadcastMode, flags.BroadcastSync, flags.BroadcastAsync, FlagMaxMessagesPerTx,
+				version.AppName, flags.FlagBroadcastMode, flags.BroadcastSync, flags.BroadcastAsync, FlagMaxMessagesPerTx, FlagSingleMsg,
 			),
 		),
 		Args: cobra.NoArgs,
@@ -142,6 +145,11 @@ $ %[1]s tx distribution withdraw-all-rewards --from mykey
 			}
 			delAddr := clientCtx.GetFromAddress()
 
+			if singleMsg, _ := cmd.Flags().GetBool(FlagSingleMsg); singleMsg {
+				msg := types.NewMsgWithdrawAllDelegatorRewards(delAddr)
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
+
 			// The transaction cannot be generated offline since it requires a query
 			// to get all the validators.
 			if clientCtx.Offline {
@@ -178,6 +186,7 @@ $ %[1]s tx distribution withdraw-all-rewards --from mykey
 	}
 
 	cmd.Flags().Int(FlagMaxMessagesPerTx, MaxMessagesPerTxDefault, "Limit the number of messages per tx (0 for unlimited)")
+	cmd.Flags().Bool(FlagSingleMsg, false, fmt.Sprintf("Withdraw the rewards with a single message, from at most %d validators", types.MaxWithdrawAllDelegations))
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
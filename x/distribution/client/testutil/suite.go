@@ -584,6 +584,19 @@ func (s *IntegrationTestSuite) TestNewWithdrawAllRewardsCmd() {
 				"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorRewardResponse",
 			},
 		},
+		{
+			"valid transaction (single msg)",
+			[]string{
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", cli.FlagSingleMsg),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0, &sdk.TxResponse{}, []string{
+				"/cosmos.distribution.v1beta1.MsgWithdrawAllDelegatorRewardsResponse",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -622,6 +635,13 @@ func (s *IntegrationTestSuite) TestNewWithdrawAllRewardsCmd() {
 						s.Require().NoError(err)
 						s.Require().True(resp.Amount.IsAllGT(sdk.NewCoins(sdk.NewCoin("stake", sdk.OneInt()))),
 							fmt.Sprintf("expected a positive coin value, got %v", resp.Amount))
+					case "/cosmos.distribution.v1beta1.MsgWithdrawAllDelegatorRewardsResponse":
+						var resp distrtypes.MsgWithdrawAllDelegatorRewardsResponse
+						// can't use unpackAny as response types are not registered.
+						err = s.cfg.Codec.Unmarshal(msgResponse.Value, &resp)
+						s.Require().NoError(err)
+						s.Require().True(resp.Amount.IsAllGT(sdk.NewCoins(sdk.NewCoin("stake", sdk.OneInt()))),
+							fmt.Sprintf("expected a positive coin value, got %v", resp.Amount))
 					case "/cosmos.distribution.v1beta1.MsgWithdrawValidatorCommissionResponse":
 						var resp distrtypes.MsgWithdrawValidatorCommissionResponse
 						// can't use unpackAny as response types are not registered.
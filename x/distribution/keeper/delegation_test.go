@@ -6,9 +6,11 @@ import (
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -345,6 +347,93 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	)
 }
 
+func TestWithdrawAllDelegationRewards(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	delAddr := addr[2]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// a delegator without delegations has no rewards to withdraw
+	_, err := app.DistrKeeper.WithdrawAllDelegationRewards(ctx, delAddr)
+	require.ErrorIs(t, err, types.ErrNoDelegationExists)
+
+	// create two validators with 50% commission, both delegated to
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.CreateValidator(valAddrs[1], valConsPk2, sdk.NewInt(100), true)
+	tstaking.Delegate(delAddr, valAddrs[0], sdk.NewInt(100))
+	tstaking.Delegate(delAddr, valAddrs[1], sdk.NewInt(300))
+
+	// end block to bond the validators and start new block
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	for _, valAddr := range valAddrs[:2] {
+		val := app.StakingKeeper.Validator(ctx, valAddr)
+		app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(100))})
+	}
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(200)))))
+
+	// the rewards are the ones withdrawn from each validator
+	cacheCtx, _ := ctx.CacheContext()
+	expected := sdk.NewCoins()
+	for _, valAddr := range valAddrs[:2] {
+		rewards, err := app.DistrKeeper.WithdrawDelegationRewards(cacheCtx, delAddr, valAddr)
+		require.NoError(t, err)
+		expected = expected.Add(rewards...)
+	}
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(62))), expected)
+
+	balance := app.BankKeeper.GetAllBalances(ctx, delAddr)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	rewards, err := app.DistrKeeper.WithdrawAllDelegationRewards(ctx, delAddr)
+	require.NoError(t, err)
+	require.Equal(t, expected, rewards)
+	require.Equal(t, balance.Add(expected...), app.BankKeeper.GetAllBalances(ctx, delAddr))
+
+	// a single event aggregates the withdrawals
+	var withdrawEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, types.EventTypeWithdrawRewards, event.Type)
+		if event.Type == types.EventTypeWithdrawAllRewards {
+			withdrawEvents = append(withdrawEvents, event)
+		}
+	}
+	require.Equal(t, []sdk.Event{sdk.NewEvent(
+		types.EventTypeWithdrawAllRewards,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, expected.String()),
+		sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyValidatorCount, "2"),
+	)}, withdrawEvents)
+
+	// the delegations are reinitialized
+	for _, valAddr := range valAddrs[:2] {
+		val := app.StakingKeeper.Validator(ctx, valAddr)
+		del := app.StakingKeeper.Delegation(ctx, delAddr, valAddr)
+		endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
+		require.True(t, app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod).IsZero())
+	}
+}
+
+func TestWithdrawAllDelegationRewardsTooManyDelegations(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	delAddr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000000))[0]
+	for i := 0; i <= types.MaxWithdrawAllDelegations; i++ {
+		valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+		app.StakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddr, valAddr, sdk.OneDec()))
+	}
+
+	_, err := app.DistrKeeper.WithdrawAllDelegationRewards(ctx, delAddr)
+	require.ErrorIs(t, err, types.ErrTooManyDelegations)
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -11,6 +12,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Keeper of the distribution store
@@ -118,6 +120,70 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// WithdrawAllDelegationRewards withdraws the rewards of a delegator from all
+// the validators it delegates to, at most types.MaxWithdrawAllDelegations. The
+// rewards are sent to the withdraw address in a single transfer, and a single
+// event aggregating the withdrawals is emitted.
+func (k Keeper) WithdrawAllDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress) (sdk.Coins, error) {
+	var delegations []stakingtypes.DelegationI
+	k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+		delegations = append(delegations, del)
+		return len(delegations) > types.MaxWithdrawAllDelegations
+	})
+
+	if len(delegations) == 0 {
+		return nil, types.ErrNoDelegationExists
+	}
+	if len(delegations) > types.MaxWithdrawAllDelegations {
+		return nil, sdkerrors.Wrapf(
+			types.ErrTooManyDelegations,
+			"cannot withdraw the rewards of more than %d delegations at once", types.MaxWithdrawAllDelegations,
+		)
+	}
+
+	rewards := sdk.NewCoins()
+	for _, del := range delegations {
+		valAddr := del.GetValidatorAddr()
+		val := k.stakingKeeper.Validator(ctx, valAddr)
+		if val == nil {
+			return nil, types.ErrNoValidatorDistInfo
+		}
+
+		coins, err := k.settleDelegationRewards(ctx, val, del)
+		if err != nil {
+			return nil, err
+		}
+		rewards = rewards.Add(coins...)
+
+		// reinitialize the delegation
+		k.initializeDelegation(ctx, valAddr, delAddr)
+	}
+
+	if !rewards.IsZero() {
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, rewards); err != nil {
+			return nil, err
+		}
+	} else {
+		baseDenom, _ := sdk.GetBaseDenom()
+		rewards = sdk.Coins{sdk.Coin{
+			Denom:  baseDenom,
+			Amount: sdk.ZeroInt(),
+		}}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawAllRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidatorCount, strconv.Itoa(len(delegations))),
+		),
+	)
+
+	return rewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
@@ -54,6 +54,25 @@ func TestMsgWithdrawDelegatorReward(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgWithdrawAllDelegatorRewards
+func TestMsgWithdrawAllDelegatorRewards(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		expectPass    bool
+	}{
+		{delAddr1, true},
+		{emptyDelAddr, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgWithdrawAllDelegatorRewards(tc.delegatorAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgWithdrawValidatorCommission
 func TestMsgWithdrawValidatorCommission(t *testing.T) {
 	tests := []struct {
@@ -49,7 +49,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	if p.CommunityTax.IsNegative() || p.CommunityTax.GT(sdk.OneDec()) {
+	if !p.CommunityTax.IsBetween(sdk.ZeroDec(), sdk.OneDec(), true) {
 		return fmt.Errorf(
 			"community tax should be non-negative and less than one: %s", p.CommunityTax,
 		)
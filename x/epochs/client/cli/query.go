@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        epochs.ModuleName,
+		Short:                      "Querying commands for the epochs module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryEpochInfos(),
+		GetCmdQueryCurrentEpoch(),
+	)
+	return queryCmd
+}
+
+// GetCmdQueryEpochInfos implements the query epoch-infos command.
+func GetCmdQueryEpochInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "epoch-infos",
+		Short:   "query the running epoch timers",
+		Example: fmt.Sprintf(`$ %s query %s epoch-infos`, version.AppName, epochs.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := epochs.NewQueryClient(clientCtx)
+			res, err := queryClient.EpochInfos(cmd.Context(), &epochs.QueryEpochInfosRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCurrentEpoch implements the query current-epoch command.
+func GetCmdQueryCurrentEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "current-epoch [identifier]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the current epoch number of an epoch timer",
+		Example: fmt.Sprintf(`$ %s query %s current-epoch week`, version.AppName, epochs.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := epochs.NewQueryClient(clientCtx)
+			res, err := queryClient.CurrentEpoch(cmd.Context(), &epochs.QueryCurrentEpochRequest{
+				Identifier: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,12 @@
+package epochs
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/epochs module sentinel errors
+var (
+	ErrInvalidEpochInfo = sdkerrors.Register(ModuleName, 2, "invalid epoch info")
+	ErrEpochExists      = sdkerrors.Register(ModuleName, 3, "epoch already exists")
+	ErrEpochNotFound    = sdkerrors.Register(ModuleName, 4, "epoch not found")
+)
@@ -0,0 +1,67 @@
+package epochs
+
+import (
+	"strings"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Identifiers of the epochs registered in the default genesis state
+const (
+	HourEpochID = "hour"
+	DayEpochID  = "day"
+	WeekEpochID = "week"
+)
+
+// NewGenesisEpochInfo returns an EpochInfo with the given identifier and duration that
+// has not started yet, its start time is set to the genesis block time
+func NewGenesisEpochInfo(identifier string, duration time.Duration) EpochInfo {
+	return EpochInfo{
+		Identifier: identifier,
+		Duration:   duration,
+	}
+}
+
+// Validate performs a stateless validation of the epoch info
+func (e EpochInfo) Validate() error {
+	if strings.TrimSpace(e.Identifier) == "" {
+		return sdkerrors.Wrap(ErrInvalidEpochInfo, "identifier cannot be empty")
+	}
+	if e.Duration <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidEpochInfo, "duration of %s must be positive", e.Identifier)
+	}
+	if e.CurrentEpoch < 0 {
+		return sdkerrors.Wrapf(ErrInvalidEpochInfo, "current epoch of %s cannot be negative", e.Identifier)
+	}
+	if e.CurrentEpochStartHeight < 0 {
+		return sdkerrors.Wrapf(ErrInvalidEpochInfo, "current epoch start height of %s cannot be negative", e.Identifier)
+	}
+	return nil
+}
+
+// ValidateGenesis check the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	identifiers := make(map[string]bool, len(data.Epochs))
+	for _, epoch := range data.Epochs {
+		if err := epoch.Validate(); err != nil {
+			return err
+		}
+		if identifiers[epoch.Identifier] {
+			return sdkerrors.Wrapf(ErrEpochExists, "duplicate epoch identifier %s", epoch.Identifier)
+		}
+		identifiers[epoch.Identifier] = true
+	}
+	return nil
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Epochs: []EpochInfo{
+			NewGenesisEpochInfo(HourEpochID, time.Hour),
+			NewGenesisEpochInfo(DayEpochID, 24*time.Hour),
+			NewGenesisEpochInfo(WeekEpochID, 7*24*time.Hour),
+		},
+	}
+}
@@ -0,0 +1,27 @@
+package epochs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, epochs.ValidateGenesis(*epochs.DefaultGenesisState()))
+
+	duplicate := epochs.GenesisState{Epochs: []epochs.EpochInfo{
+		epochs.NewGenesisEpochInfo("day", 24*time.Hour),
+		epochs.NewGenesisEpochInfo("day", time.Hour),
+	}}
+	require.ErrorIs(t, epochs.ValidateGenesis(duplicate), epochs.ErrEpochExists)
+
+	invalid := epochs.GenesisState{Epochs: []epochs.EpochInfo{epochs.NewGenesisEpochInfo(" ", time.Hour)}}
+	require.ErrorIs(t, epochs.ValidateGenesis(invalid), epochs.ErrInvalidEpochInfo)
+
+	negative := epochs.NewGenesisEpochInfo("day", 24*time.Hour)
+	negative.CurrentEpoch = -1
+	require.ErrorIs(t, epochs.ValidateGenesis(epochs.GenesisState{Epochs: []epochs.EpochInfo{negative}}), epochs.ErrInvalidEpochInfo)
+}
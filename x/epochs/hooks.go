@@ -0,0 +1,46 @@
+package epochs
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochHooks event hooks for epoch timers, other modules subscribe to them to run
+// logic once per epoch of a given identifier
+type EpochHooks interface {
+	// AfterEpochEnd is called when the epoch with the given identifier and number ends,
+	// in the same block the next epoch starts.
+	AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error
+	// BeforeEpochStart is called when the epoch with the given identifier and number
+	// starts, right after the previous epoch ended.
+	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error
+}
+
+var _ EpochHooks = MultiEpochHooks{}
+
+// MultiEpochHooks combines multiple epoch hooks, all hook functions are run in array sequence
+type MultiEpochHooks []EpochHooks
+
+// NewMultiEpochHooks creates a new MultiEpochHooks from the given hooks
+func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
+	return hooks
+}
+
+// AfterEpochEnd runs the AfterEpochEnd hook of each subscriber, stopping at the first error
+func (h MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	for i := range h {
+		if err := h[i].AfterEpochEnd(ctx, epochIdentifier, epochNumber); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BeforeEpochStart runs the BeforeEpochStart hook of each subscriber, stopping at the first error
+func (h MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	for i := range h {
+		if err := h[i].BeforeEpochStart(ctx, epochIdentifier, epochNumber); err != nil {
+			return err
+		}
+	}
+	return nil
+}
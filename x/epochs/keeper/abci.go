@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+)
+
+// BeginBlocker ticks the epoch timers whose current epoch has elapsed. At most one epoch
+// transition happens per block for each identifier: the AfterEpochEnd hooks of the ending
+// epoch run before the BeforeEpochStart hooks of the next one. The very first epoch of a
+// timer starts in the first block whose time is not before the timer start time, without
+// any AfterEpochEnd call.
+func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	defer telemetry.ModuleMeasureSince(epochs.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	// collect the timers first so that hooks can safely write to the epochs store
+	var due []epochs.EpochInfo
+	k.IterateEpochInfo(ctx, func(epoch epochs.EpochInfo) bool {
+		if shouldEpochStart(ctx.BlockTime(), epoch) {
+			due = append(due, epoch)
+		}
+		return false
+	})
+
+	for _, epoch := range due {
+		epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+		if !epoch.EpochCountingStarted {
+			epoch.EpochCountingStarted = true
+			epoch.CurrentEpoch = 1
+			epoch.CurrentEpochStartTime = epoch.StartTime
+			k.Logger(ctx).Info("starting epoch", "identifier", epoch.Identifier)
+		} else {
+			ctx.EventManager().EmitTypedEvent(&epochs.EventEpochEnd{
+				Identifier:  epoch.Identifier,
+				EpochNumber: epoch.CurrentEpoch,
+			})
+			k.afterEpochEnd(ctx, epoch.Identifier, epoch.CurrentEpoch)
+			epoch.CurrentEpoch++
+			epoch.CurrentEpochStartTime = epoch.CurrentEpochStartTime.Add(epoch.Duration)
+		}
+
+		ctx.EventManager().EmitTypedEvent(&epochs.EventEpochStart{
+			Identifier:     epoch.Identifier,
+			EpochNumber:    epoch.CurrentEpoch,
+			EpochStartTime: epoch.CurrentEpochStartTime,
+		})
+		k.setEpochInfo(ctx, epoch)
+		k.beforeEpochStart(ctx, epoch.Identifier, epoch.CurrentEpoch)
+	}
+}
+
+// shouldEpochStart returns whether a new epoch of the timer starts at the given block time
+func shouldEpochStart(blockTime time.Time, epoch epochs.EpochInfo) bool {
+	if blockTime.Before(epoch.StartTime) {
+		return false
+	}
+	if !epoch.EpochCountingStarted {
+		return true
+	}
+	return blockTime.After(epoch.CurrentEpochStartTime.Add(epoch.Duration))
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+)
+
+// InitGenesis new epochs genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *epochs.GenesisState) {
+	for _, epoch := range data.Epochs {
+		if err := k.AddEpochInfo(ctx, epoch); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *epochs.GenesisState {
+	return &epochs.GenesisState{
+		Epochs: k.AllEpochInfos(ctx),
+	}
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+)
+
+var _ epochs.QueryServer = Keeper{}
+
+// EpochInfos returns all the epoch timers
+func (k Keeper) EpochInfos(goCtx context.Context, r *epochs.QueryEpochInfosRequest) (*epochs.QueryEpochInfosResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &epochs.QueryEpochInfosResponse{Epochs: k.AllEpochInfos(ctx)}, nil
+}
+
+// CurrentEpoch returns the current epoch number of the timer with the given identifier
+func (k Keeper) CurrentEpoch(goCtx context.Context, r *epochs.QueryCurrentEpochRequest) (*epochs.QueryCurrentEpochResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if strings.TrimSpace(r.Identifier) == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty identifier")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	epoch, found := k.GetEpochInfo(ctx, r.Identifier)
+	if !found {
+		return nil, sdkerrors.Wrapf(epochs.ErrEpochNotFound, "epoch with identifier %s", r.Identifier)
+	}
+	return &epochs.QueryCurrentEpochResponse{CurrentEpoch: epoch.CurrentEpoch}, nil
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+)
+
+// Keeper of the epochs store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	hooks    epochs.EpochHooks
+}
+
+// NewKeeper creates a new epochs Keeper instance
+func NewKeeper(key storetypes.StoreKey, cdc codec.BinaryCodec) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: key,
+	}
+}
+
+// SetHooks sets the epoch hooks
+func (k *Keeper) SetHooks(eh epochs.EpochHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set epochs hooks twice")
+	}
+
+	k.hooks = eh
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+epochs.ModuleName)
+}
+
+// GetEpochInfo returns the epoch info of the given identifier
+func (k Keeper) GetEpochInfo(ctx sdk.Context, identifier string) (epochs.EpochInfo, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(epochInfoKey(identifier))
+
+	var epoch epochs.EpochInfo
+	if len(bz) == 0 {
+		return epoch, false
+	}
+	k.cdc.MustUnmarshal(bz, &epoch)
+	return epoch, true
+}
+
+// AddEpochInfo registers a new epoch timer. If the timer has not started yet, an empty start
+// time defaults to the current block time and its start height is set to the current block
+// height, otherwise the epoch info is stored as is.
+func (k Keeper) AddEpochInfo(ctx sdk.Context, epoch epochs.EpochInfo) error {
+	if err := epoch.Validate(); err != nil {
+		return err
+	}
+	if _, found := k.GetEpochInfo(ctx, epoch.Identifier); found {
+		return sdkerrors.Wrapf(epochs.ErrEpochExists, "epoch with identifier %s", epoch.Identifier)
+	}
+	if !epoch.EpochCountingStarted {
+		if epoch.StartTime.IsZero() {
+			epoch.StartTime = ctx.BlockTime()
+		}
+		epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+	}
+	k.setEpochInfo(ctx, epoch)
+	return nil
+}
+
+// DeleteEpochInfo removes the epoch timer of the given identifier
+func (k Keeper) DeleteEpochInfo(ctx sdk.Context, identifier string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(epochInfoKey(identifier))
+}
+
+// IterateEpochInfo iterates over all epoch timers, ordered by identifier, until cb returns true
+func (k Keeper) IterateEpochInfo(ctx sdk.Context, cb func(epoch epochs.EpochInfo) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), EpochInfoPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var epoch epochs.EpochInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &epoch)
+		if cb(epoch) {
+			break
+		}
+	}
+}
+
+// AllEpochInfos returns all epoch timers
+func (k Keeper) AllEpochInfos(ctx sdk.Context) []epochs.EpochInfo {
+	var infos []epochs.EpochInfo
+	k.IterateEpochInfo(ctx, func(epoch epochs.EpochInfo) bool {
+		infos = append(infos, epoch)
+		return false
+	})
+	return infos
+}
+
+// NumBlocksSinceEpochStart returns the number of blocks since the epoch of the given
+// identifier started
+func (k Keeper) NumBlocksSinceEpochStart(ctx sdk.Context, identifier string) (int64, error) {
+	epoch, found := k.GetEpochInfo(ctx, identifier)
+	if !found {
+		return 0, sdkerrors.Wrapf(epochs.ErrEpochNotFound, "epoch with identifier %s", identifier)
+	}
+	return ctx.BlockHeight() - epoch.CurrentEpochStartHeight, nil
+}
+
+func (k Keeper) setEpochInfo(ctx sdk.Context, epoch epochs.EpochInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(epochInfoKey(epoch.Identifier), k.cdc.MustMarshal(&epoch))
+}
+
+// afterEpochEnd runs the AfterEpochEnd hook in a cached context, a failing hook is logged
+// and its state changes are discarded so that it cannot halt the chain
+func (k Keeper) afterEpochEnd(ctx sdk.Context, identifier string, number int64) {
+	if k.hooks == nil {
+		return
+	}
+	k.runHook(ctx, "AfterEpochEnd", identifier, number, k.hooks.AfterEpochEnd)
+}
+
+// beforeEpochStart runs the BeforeEpochStart hook in a cached context, a failing hook is
+// logged and its state changes are discarded so that it cannot halt the chain
+func (k Keeper) beforeEpochStart(ctx sdk.Context, identifier string, number int64) {
+	if k.hooks == nil {
+		return
+	}
+	k.runHook(ctx, "BeforeEpochStart", identifier, number, k.hooks.BeforeEpochStart)
+}
+
+func (k Keeper) runHook(ctx sdk.Context, name, identifier string, number int64, hook func(sdk.Context, string, int64) error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := hook(cacheCtx, identifier, number); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("%s hook failed", name), "identifier", identifier, "epoch", number, "err", err)
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
@@ -0,0 +1,228 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	"github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+)
+
+type hookCall struct {
+	hook       string
+	identifier string
+	number     int64
+}
+
+// mockHooks records the hook calls and writes a marker to the store on each call, so that
+// discarded state changes of failing hooks can be detected
+type mockHooks struct {
+	key   storetypes.StoreKey
+	calls *[]hookCall
+	fail  bool
+}
+
+func (h mockHooks) record(ctx sdk.Context, hook, identifier string, number int64) error {
+	*h.calls = append(*h.calls, hookCall{hook, identifier, number})
+	ctx.KVStore(h.key).Set([]byte("hook/"+hook+"/"+identifier), []byte{1})
+	if h.fail {
+		return errors.New("hook failure")
+	}
+	return nil
+}
+
+func (h mockHooks) AfterEpochEnd(ctx sdk.Context, identifier string, number int64) error {
+	return h.record(ctx, "AfterEpochEnd", identifier, number)
+}
+
+func (h mockHooks) BeforeEpochStart(ctx sdk.Context, identifier string, number int64) error {
+	return h.record(ctx, "BeforeEpochStart", identifier, number)
+}
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	calls       []hookCall
+	queryClient epochs.QueryClient
+	startTime   time.Time
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	s.startTime = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: s.startTime})
+
+	s.calls = nil
+	k := keeper.NewKeeper(app.GetKey(epochs.StoreKey), app.AppCodec())
+	k.SetHooks(mockHooks{key: app.GetKey(epochs.StoreKey), calls: &s.calls})
+	// drop the epochs of the default genesis state so that each test controls its timers
+	for _, epoch := range k.AllEpochInfos(ctx) {
+		k.DeleteEpochInfo(ctx, epoch.Identifier)
+	}
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	epochs.RegisterQueryServer(queryHelper, k)
+
+	s.app = app
+	s.ctx = ctx
+	s.keeper = k
+	s.queryClient = epochs.NewQueryClient(queryHelper)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) advance(d time.Duration) {
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(s.ctx.BlockTime().Add(d))
+	s.keeper.BeginBlocker(s.ctx)
+}
+
+func (s *TestSuite) TestAddEpochInfo() {
+	require := s.Require()
+
+	require.NoError(s.keeper.AddEpochInfo(s.ctx, epochs.NewGenesisEpochInfo("monthly", 30*24*time.Hour)))
+	epoch, found := s.keeper.GetEpochInfo(s.ctx, "monthly")
+	require.True(found)
+	require.Equal(s.startTime, epoch.StartTime)
+	require.Equal(int64(1), epoch.CurrentEpochStartHeight)
+	require.False(epoch.EpochCountingStarted)
+
+	err := s.keeper.AddEpochInfo(s.ctx, epochs.NewGenesisEpochInfo("monthly", time.Hour))
+	require.ErrorIs(err, epochs.ErrEpochExists)
+
+	err = s.keeper.AddEpochInfo(s.ctx, epochs.NewGenesisEpochInfo("", time.Hour))
+	require.ErrorIs(err, epochs.ErrInvalidEpochInfo)
+	err = s.keeper.AddEpochInfo(s.ctx, epochs.NewGenesisEpochInfo("zero", 0))
+	require.ErrorIs(err, epochs.ErrInvalidEpochInfo)
+
+	s.keeper.DeleteEpochInfo(s.ctx, "monthly")
+	_, found = s.keeper.GetEpochInfo(s.ctx, "monthly")
+	require.False(found)
+}
+
+func (s *TestSuite) TestBeginBlocker() {
+	require := s.Require()
+
+	require.NoError(s.keeper.AddEpochInfo(s.ctx, epochs.NewGenesisEpochInfo("minute", time.Minute)))
+
+	// the first epoch starts without ending any epoch
+	s.keeper.BeginBlocker(s.ctx)
+	epoch, _ := s.keeper.GetEpochInfo(s.ctx, "minute")
+	require.True(epoch.EpochCountingStarted)
+	require.Equal(int64(1), epoch.CurrentEpoch)
+	require.Equal(s.startTime, epoch.CurrentEpochStartTime)
+	require.Equal([]hookCall{{"BeforeEpochStart", "minute", 1}}, s.calls)
+
+	// nothing happens until the epoch duration has elapsed
+	s.calls = nil
+	s.advance(time.Minute)
+	epoch, _ = s.keeper.GetEpochInfo(s.ctx, "minute")
+	require.Equal(int64(1), epoch.CurrentEpoch)
+	require.Empty(s.calls)
+
+	s.advance(time.Second)
+	epoch, _ = s.keeper.GetEpochInfo(s.ctx, "minute")
+	require.Equal(int64(2), epoch.CurrentEpoch)
+	require.Equal(s.startTime.Add(time.Minute), epoch.CurrentEpochStartTime)
+	require.Equal(s.ctx.BlockHeight(), epoch.CurrentEpochStartHeight)
+	require.Equal([]hookCall{{"AfterEpochEnd", "minute", 1}, {"BeforeEpochStart", "minute", 2}}, s.calls)
+
+	// at most one epoch transition happens per block, even after a long halt
+	s.calls = nil
+	s.advance(time.Hour)
+	epoch, _ = s.keeper.GetEpochInfo(s.ctx, "minute")
+	require.Equal(int64(3), epoch.CurrentEpoch)
+	require.Equal(s.startTime.Add(2*time.Minute), epoch.CurrentEpochStartTime)
+	require.Len(s.calls, 2)
+
+	n, err := s.keeper.NumBlocksSinceEpochStart(s.ctx, "minute")
+	require.NoError(err)
+	require.Equal(int64(0), n)
+	_, err = s.keeper.NumBlocksSinceEpochStart(s.ctx, "unknown")
+	require.ErrorIs(err, epochs.ErrEpochNotFound)
+}
+
+func (s *TestSuite) TestBeginBlockerFutureStartTime() {
+	require := s.Require()
+
+	info := epochs.NewGenesisEpochInfo("later", time.Minute)
+	info.StartTime = s.startTime.Add(time.Hour)
+	require.NoError(s.keeper.AddEpochInfo(s.ctx, info))
+
+	s.advance(time.Minute)
+	epoch, _ := s.keeper.GetEpochInfo(s.ctx, "later")
+	require.False(epoch.EpochCountingStarted)
+	require.Empty(s.calls)
+
+	s.advance(time.Hour)
+	epoch, _ = s.keeper.GetEpochInfo(s.ctx, "later")
+	require.True(epoch.EpochCountingStarted)
+	require.Equal(info.StartTime, epoch.CurrentEpochStartTime)
+}
+
+func (s *TestSuite) TestBeginBlockerFailingHook() {
+	require := s.Require()
+
+	key := s.app.GetKey(epochs.StoreKey)
+	k := keeper.NewKeeper(key, s.app.AppCodec())
+	k.SetHooks(mockHooks{key: key, calls: &s.calls, fail: true})
+
+	require.NoError(k.AddEpochInfo(s.ctx, epochs.NewGenesisEpochInfo("minute", time.Minute)))
+	k.BeginBlocker(s.ctx)
+
+	// the epoch still starts, but the state changes of the failing hook are discarded
+	epoch, _ := k.GetEpochInfo(s.ctx, "minute")
+	require.Equal(int64(1), epoch.CurrentEpoch)
+	require.Len(s.calls, 1)
+	require.False(s.ctx.KVStore(key).Has([]byte("hook/BeforeEpochStart/minute")))
+}
+
+func (s *TestSuite) TestQueries() {
+	require := s.Require()
+
+	require.NoError(s.keeper.AddEpochInfo(s.ctx, epochs.NewGenesisEpochInfo("minute", time.Minute)))
+	s.keeper.BeginBlocker(s.ctx)
+
+	res, err := s.queryClient.EpochInfos(s.ctx, &epochs.QueryEpochInfosRequest{})
+	require.NoError(err)
+	require.Equal(s.keeper.AllEpochInfos(s.ctx), res.Epochs)
+
+	current, err := s.queryClient.CurrentEpoch(s.ctx, &epochs.QueryCurrentEpochRequest{Identifier: "minute"})
+	require.NoError(err)
+	require.Equal(int64(1), current.CurrentEpoch)
+
+	_, err = s.queryClient.CurrentEpoch(s.ctx, &epochs.QueryCurrentEpochRequest{Identifier: "unknown"})
+	require.ErrorIs(err, epochs.ErrEpochNotFound)
+	_, err = s.queryClient.CurrentEpoch(s.ctx, &epochs.QueryCurrentEpochRequest{})
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func (s *TestSuite) TestGenesis() {
+	require := s.Require()
+
+	require.NoError(s.keeper.AddEpochInfo(s.ctx, epochs.NewGenesisEpochInfo("minute", time.Minute)))
+	s.advance(time.Second)
+	s.advance(time.Minute)
+
+	genesis := s.keeper.ExportGenesis(s.ctx)
+	require.NoError(epochs.ValidateGenesis(*genesis))
+
+	for _, epoch := range genesis.Epochs {
+		s.keeper.DeleteEpochInfo(s.ctx, epoch.Identifier)
+	}
+	s.keeper.InitGenesis(s.ctx.WithBlockHeight(100), genesis)
+	require.Equal(genesis, s.keeper.ExportGenesis(s.ctx))
+}
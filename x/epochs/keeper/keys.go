@@ -0,0 +1,12 @@
+package keeper
+
+var (
+	EpochInfoPrefix = []byte{0x01}
+)
+
+// epochInfoKey returns the byte representation of the key of an epoch info
+// Items are stored with the following key: values
+// 0x01<identifier>
+func epochInfoKey(identifier string) []byte {
+	return append(EpochInfoPrefix, identifier...)
+}
@@ -0,0 +1,12 @@
+package epochs
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "epochs"
+
+	// StoreKey is the store key string for epochs
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for epochs
+	RouterKey = ModuleName
+)
@@ -1,5 +1,7 @@
 package types
 
+//go:generate go run github.com/golang/mock/mockgen -source=expected_keepers.go -package testutil -destination ../testutil/expected_keepers_mocks.go
+
 import (
 	"time"
 
@@ -0,0 +1,64 @@
+package feegrant
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// UpdateAllowanceLimits adds increase to and removes decrease from the spend
+// limit of allowance and, if expiration is not nil, replaces its expiration.
+// Allowances wrapping another allowance update the wrapped one. The allowance
+// is updated in place.
+func UpdateAllowanceLimits(allowance FeeAllowanceI, increase, decrease sdk.Coins, expiration *time.Time) error {
+	switch a := allowance.(type) {
+	case *BasicAllowance:
+		return a.updateLimits(increase, decrease, expiration)
+	case *PeriodicAllowance:
+		return a.Basic.updateLimits(increase, decrease, expiration)
+	case *AllowedMsgAllowance:
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return err
+		}
+		if err := UpdateAllowanceLimits(inner, increase, decrease, expiration); err != nil {
+			return err
+		}
+		return a.SetAllowance(inner)
+	case *FilteredAllowance:
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return err
+		}
+		if err := UpdateAllowanceLimits(inner, increase, decrease, expiration); err != nil {
+			return err
+		}
+		return a.SetAllowance(inner)
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot update allowance of type %T", allowance)
+	}
+}
+
+func (a *BasicAllowance) updateLimits(increase, decrease sdk.Coins, expiration *time.Time) error {
+	if !increase.Empty() || !decrease.Empty() {
+		if a.SpendLimit.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "allowance has no spend limit")
+		}
+
+		limit, isNeg := a.SpendLimit.Add(increase...).SafeSub(decrease...)
+		if isNeg {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit decrease %s exceeds the spend limit %s", decrease, a.SpendLimit.Add(increase...))
+		}
+		if limit.IsZero() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "spend limit decrease exhausts the allowance, revoke it instead")
+		}
+		a.SpendLimit = limit
+	}
+
+	if expiration != nil {
+		a.Expiration = expiration
+	}
+
+	return nil
+}
@@ -25,6 +25,10 @@ func (a *BasicAllowance) Accept(ctx sdk.Context, fee sdk.Coins, _ []sdk.Msg) (bo
 	}
 
 	if a.SpendLimit != nil {
+		if denied := fee.DenyDenoms(a.SpendLimit.Denoms()...); len(denied) > 0 {
+			return false, sdkerrors.Wrapf(ErrFeeLimitExceeded, "basic allowance: %s fees are not allowed", denied[0].Denom)
+		}
+
 		left, invalid := a.SpendLimit.SafeSub(fee...)
 		if invalid {
 			return false, sdkerrors.Wrap(ErrFeeLimitExceeded, "basic allowance")
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -27,6 +28,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryFeeGrant(),
 		GetCmdQueryFeeGrantsByGrantee(),
 		GetCmdQueryFeeGrantsByGranter(),
+		GetCmdQueryExpiringFeeGrants(),
 	)
 
 	return feegrantQueryCmd
@@ -178,3 +180,59 @@ $ %s query feegrant grants [granter]
 
 	return cmd
 }
+
+// GetCmdQueryExpiringFeeGrants returns cmd to query for all grants expiring within a time window.
+func GetCmdQueryExpiringFeeGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expiring-grants [start-time] [end-time]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query all grants expiring within a time window",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries all the grants expiring within a time window, ordered by expiration time.
+Both bounds are inclusive and must be RFC3339 timestamps.
+
+Example:
+$ %s query feegrant expiring-grants 2022-01-01T00:00:00Z 2022-02-01T00:00:00Z
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := feegrant.NewQueryClient(clientCtx)
+
+			start, err := time.Parse(time.RFC3339, args[0])
+			if err != nil {
+				return err
+			}
+
+			end, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExpiringAllowances(
+				cmd.Context(),
+				&feegrant.QueryExpiringAllowancesRequest{
+					Start:      start,
+					End:        end,
+					Pagination: pageReq,
+				},
+			)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "expiring-grants")
+
+	return cmd
+}
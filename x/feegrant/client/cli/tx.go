@@ -17,11 +17,15 @@ import (
 
 // flag for feegrant module
 const (
-	FlagExpiration  = "expiration"
-	FlagPeriod      = "period"
-	FlagPeriodLimit = "period-limit"
-	FlagSpendLimit  = "spend-limit"
-	FlagAllowedMsgs = "allowed-messages"
+	FlagExpiration         = "expiration"
+	FlagPeriod             = "period"
+	FlagPeriodLimit        = "period-limit"
+	FlagSpendLimit         = "spend-limit"
+	FlagAllowedMsgs        = "allowed-messages"
+	FlagMsgSpendLimit      = "msg-spend-limit"
+	FlagMsgSpendPeriod     = "msg-spend-period"
+	FlagSpendLimitIncrease = "spend-limit-increase"
+	FlagSpendLimitDecrease = "spend-limit-decrease"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -38,6 +42,7 @@ func GetTxCmd() *cobra.Command {
 	feegrantTxCmd.AddCommand(
 		NewCmdFeeGrant(),
 		NewCmdRevokeFeegrant(),
+		NewCmdUpdateFeegrant(),
 	)
 
 	return feegrantTxCmd
@@ -57,8 +62,11 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --msg-spend-period 3600
+	--msg-spend-limit /cosmos.bank.v1beta1.MsgSend=10stake --allowed-messages /cosmos.gov.v1beta1.MsgVote
 				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -154,7 +162,37 @@ Examples:
 				return err
 			}
 
-			if len(allowedMsgs) > 0 {
+			msgSpendLimits, err := cmd.Flags().GetStringArray(FlagMsgSpendLimit)
+			if err != nil {
+				return err
+			}
+
+			msgSpendPeriod, err := cmd.Flags().GetInt64(FlagMsgSpendPeriod)
+			if err != nil {
+				return err
+			}
+
+			// Per message spend limits turn the grant into a filtered allowance, the
+			// allowed messages are then accepted without a spend limit of their own.
+			if len(msgSpendLimits) > 0 {
+				limits, err := parseMsgSpendLimits(msgSpendLimits, allowedMsgs)
+				if err != nil {
+					return err
+				}
+
+				var period *time.Duration
+				if msgSpendPeriod > 0 {
+					p := getPeriod(msgSpendPeriod)
+					period = &p
+				}
+
+				grant, err = feegrant.NewFilteredAllowance(grant, limits, period)
+				if err != nil {
+					return err
+				}
+			} else if msgSpendPeriod > 0 {
+				return fmt.Errorf("--%s requires --%s", FlagMsgSpendPeriod, FlagMsgSpendLimit)
+			} else if len(allowedMsgs) > 0 {
 				grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
 				if err != nil {
 					return err
@@ -176,6 +214,8 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
 	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().StringArray(FlagMsgSpendLimit, []string{}, "Maximum fee that can be spent on a message type, as <msg_type_url>=<coins> (repeatable)")
+	cmd.Flags().Int64(FlagMsgSpendPeriod, 0, "time duration(in seconds) after which the message spend limits are reset, if not mentioned they are never reset")
 
 	return cmd
 }
@@ -216,6 +256,113 @@ Example:
 	return cmd
 }
 
+// NewCmdUpdateFeegrant returns a CLI command handler for creating a MsgUpdateAllowance transaction.
+func NewCmdUpdateFeegrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [granter] [grantee]",
+		Short: "update the spend limit and expiration of a fee-grant",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`update the spend limit and expiration of the fee grant from a granter to a grantee
+			without revoking it. Note, the'--from' flag is ignored as it is implied from [granter].
+
+Example:
+ $ %s tx %s update cosmos1skj.. cosmos1skj.. --spend-limit-increase 100stake --expiration 2022-01-30T15:04:05Z
+ $ %s tx %s update cosmos1skj.. cosmos1skj.. --spend-limit-decrease 50stake
+			`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			increaseVal, err := cmd.Flags().GetString(FlagSpendLimitIncrease)
+			if err != nil {
+				return err
+			}
+
+			increase, err := sdk.ParseCoinsNormalized(increaseVal)
+			if err != nil {
+				return err
+			}
+
+			decreaseVal, err := cmd.Flags().GetString(FlagSpendLimitDecrease)
+			if err != nil {
+				return err
+			}
+
+			decrease, err := sdk.ParseCoinsNormalized(decreaseVal)
+			if err != nil {
+				return err
+			}
+
+			exp, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			var expiration *time.Time
+			if exp != "" {
+				expiresAtTime, err := time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return err
+				}
+				expiration = &expiresAtTime
+			}
+
+			msg := feegrant.NewMsgUpdateAllowance(clientCtx.GetFromAddress(), grantee, increase, decrease, expiration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagSpendLimitIncrease, "", "Coins added to the spend limit of the allowance")
+	cmd.Flags().String(FlagSpendLimitDecrease, "", "Coins removed from the spend limit of the allowance")
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp replacing the expiration of the allowance")
+	return cmd
+}
+
+// parseMsgSpendLimits parses <msg_type_url>=<coins> entries and appends the
+// allowedMsgs not already listed without a spend limit.
+func parseMsgSpendLimits(entries []string, allowedMsgs []string) ([]feegrant.MsgSpendLimit, error) {
+	limits := make([]feegrant.MsgSpendLimit, 0, len(entries)+len(allowedMsgs))
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid message spend limit %q, expected <msg_type_url>=<coins>", entry)
+		}
+
+		spendLimit, err := sdk.ParseCoinsNormalized(parts[1])
+		if err != nil {
+			return nil, err
+		}
+
+		limits = append(limits, feegrant.NewMsgSpendLimit(parts[0], spendLimit))
+		seen[parts[0]] = true
+	}
+
+	for _, msgType := range allowedMsgs {
+		if !seen[msgType] {
+			limits = append(limits, feegrant.NewMsgSpendLimit(msgType, nil))
+			seen[msgType] = true
+		}
+	}
+
+	return limits, nil
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
@@ -568,6 +568,48 @@ func (s *IntegrationTestSuite) TestNewCmdFeeGrant() {
 			),
 			false, 0, &sdk.TxResponse{},
 		},
+		{
+			"valid filtered fee grant",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1dpaa2k3eltkfgps2ww6nkkj7zxzzeprvj2a82n",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%s", cli.FlagMsgSpendLimit, "/cosmos.bank.v1beta1.MsgSend=10stake"),
+					fmt.Sprintf("--%s=%d", cli.FlagMsgSpendPeriod, oneHour),
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedMsgs, "/cosmos.gov.v1beta1.MsgVote"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"invalid msg spend limit",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1hdwlr8dqrmrhkk4e3hw684e04e98ku9dl2rjkg",
+					fmt.Sprintf("--%s=%s", cli.FlagMsgSpendLimit, "/cosmos.bank.v1beta1.MsgSend"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
+		{
+			"msg spend period without msg spend limit",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1hdwlr8dqrmrhkk4e3hw684e04e98ku9dl2rjkg",
+					fmt.Sprintf("--%s=%d", cli.FlagMsgSpendPeriod, oneHour),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
 		{
 			"invalid expiration",
 			append(
@@ -7,8 +7,12 @@ const (
 	EventTypeSetFeeGrant    = "set_feegrant"
 	EventTypeUpdateFeeGrant = "update_feegrant"
 
-	AttributeKeyGranter = "granter"
-	AttributeKeyGrantee = "grantee"
+	AttributeKeyGranter            = "granter"
+	AttributeKeyGrantee            = "grantee"
+	AttributeKeySpendLimitIncrease = "spend_limit_increase"
+	AttributeKeySpendLimitDecrease = "spend_limit_decrease"
+	AttributeKeyPrevExpiration     = "previous_expiration"
+	AttributeKeyExpiration         = "expiration"
 
 	AttributeValueCategory = ModuleName
 )
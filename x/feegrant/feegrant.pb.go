@@ -218,6 +218,129 @@ func (m *AllowedMsgAllowance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AllowedMsgAllowance proto.InternalMessageInfo
 
+// FilteredAllowance wraps an allowance, restricting it to the given message
+// types, each with an optional spend limit. The fee of a transaction counts
+// against the spend limit of every message type it contains.
+//
+// Since: cosmos-sdk 0.46
+type FilteredAllowance struct {
+	// allowance can be any of basic, periodic or allowed msg fee allowance.
+	Allowance *types1.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// msg_spend_limits are the messages for which the grantee has the access,
+	// along with their spend limits.
+	MsgSpendLimits []MsgSpendLimit `protobuf:"bytes,2,rep,name=msg_spend_limits,json=msgSpendLimits,proto3" json:"msg_spend_limits"`
+	// period, if set, specifies the time duration after which the amounts spent
+	// for each message type are reset. Otherwise the spend limits apply over the
+	// lifetime of the allowance.
+	Period *time.Duration `protobuf:"bytes,3,opt,name=period,proto3,stdduration" json:"period,omitempty"`
+	// period_reset is the time at which the current period ends and the amounts
+	// spent are reset.
+	PeriodReset time.Time `protobuf:"bytes,4,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *FilteredAllowance) Reset()         { *m = FilteredAllowance{} }
+func (m *FilteredAllowance) String() string { return proto.CompactTextString(m) }
+func (*FilteredAllowance) ProtoMessage()    {}
+func (*FilteredAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{3}
+}
+func (m *FilteredAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FilteredAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FilteredAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FilteredAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilteredAllowance.Merge(m, src)
+}
+func (m *FilteredAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *FilteredAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilteredAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilteredAllowance proto.InternalMessageInfo
+
+// MsgSpendLimit defines the fees which can be spent for transactions containing
+// a given message type.
+//
+// Since: cosmos-sdk 0.46
+type MsgSpendLimit struct {
+	// msg_type_url is the type URL of the allowed message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// spend_limit specifies the maximum amount of fees, possibly in several
+	// denoms, which can be spent in a period for transactions containing the
+	// message. If it is empty, there is no spend limit.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// period_spent is the amount of fees spent in the current period for
+	// transactions containing the message.
+	PeriodSpent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=period_spent,json=periodSpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spent"`
+}
+
+func (m *MsgSpendLimit) Reset()         { *m = MsgSpendLimit{} }
+func (m *MsgSpendLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSpendLimit) ProtoMessage()    {}
+func (*MsgSpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *MsgSpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSpendLimit.Merge(m, src)
+}
+func (m *MsgSpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSpendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSpendLimit proto.InternalMessageInfo
+
+func (m *MsgSpendLimit) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgSpendLimit) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *MsgSpendLimit) GetPeriodSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpent
+	}
+	return nil
+}
+
 // Grant is stored in the KVStore to record a grant with full context
 type Grant struct {
 	// granter is the address of the user granting an allowance of their funds.
@@ -232,7 +355,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{3}
+	return fileDescriptor_7279582900c30aea, []int{5}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,6 +409,8 @@ func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
+	proto.RegisterType((*FilteredAllowance)(nil), "cosmos.feegrant.v1beta1.FilteredAllowance")
+	proto.RegisterType((*MsgSpendLimit)(nil), "cosmos.feegrant.v1beta1.MsgSpendLimit")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
 }
 
@@ -294,44 +419,50 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 578 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0x9b, 0xa6, 0x90, 0x0b, 0x94, 0xd6, 0x04, 0xe1, 0x64, 0x70, 0xa2, 0x0e, 0x34, 0x0c,
-	0xb1, 0x69, 0xd8, 0xca, 0x42, 0x1c, 0xa0, 0x42, 0xa2, 0x12, 0x72, 0x99, 0x58, 0xa2, 0xb3, 0xfd,
-	0x6a, 0x4e, 0xc4, 0x3e, 0xcb, 0x77, 0x81, 0xe6, 0x1b, 0x30, 0x76, 0x64, 0x42, 0xcc, 0xcc, 0x15,
-	0x9f, 0xa1, 0x62, 0xaa, 0x60, 0x61, 0xa2, 0x28, 0xf9, 0x22, 0xc8, 0x77, 0xe7, 0x24, 0x24, 0xfc,
-	0x13, 0xea, 0x14, 0xdf, 0xbb, 0xf7, 0xfb, 0xf7, 0xde, 0x29, 0xe8, 0x96, 0x4f, 0x59, 0x44, 0x99,
-	0x7d, 0x08, 0x10, 0xa6, 0x38, 0xe6, 0xf6, 0xab, 0x1d, 0x0f, 0x38, 0xde, 0x99, 0x16, 0xac, 0x24,
-	0xa5, 0x9c, 0xea, 0x37, 0x65, 0x9f, 0x35, 0x2d, 0xab, 0xbe, 0x7a, 0x35, 0xa4, 0x21, 0x15, 0x3d,
-	0x76, 0xf6, 0x25, 0xdb, 0xeb, 0xb5, 0x90, 0xd2, 0x70, 0x00, 0xb6, 0x38, 0x79, 0xc3, 0x43, 0x1b,
-	0xc7, 0xa3, 0xfc, 0x4a, 0x32, 0xf5, 0x25, 0x46, 0xd1, 0xca, 0x2b, 0x53, 0x99, 0xf1, 0x30, 0x83,
-	0xa9, 0x11, 0x9f, 0x92, 0x58, 0xdd, 0x37, 0x16, 0x59, 0x39, 0x89, 0x80, 0x71, 0x1c, 0x25, 0x39,
-	0xc1, 0x62, 0x43, 0x30, 0x4c, 0x31, 0x27, 0x54, 0x11, 0x6c, 0x7d, 0xd1, 0xd0, 0xba, 0x83, 0x19,
-	0xf1, 0xbb, 0x83, 0x01, 0x7d, 0x8d, 0x63, 0x1f, 0xf4, 0x01, 0xaa, 0xb0, 0x04, 0xe2, 0xa0, 0x3f,
-	0x20, 0x11, 0xe1, 0x86, 0xd6, 0x2c, 0xb6, 0x2a, 0x9d, 0x9a, 0xa5, 0x7c, 0x65, 0x4e, 0xf2, 0xa8,
-	0x56, 0x8f, 0x92, 0xd8, 0xb9, 0x73, 0xfa, 0xad, 0x51, 0xf8, 0x70, 0xde, 0x68, 0x85, 0x84, 0xbf,
-	0x18, 0x7a, 0x96, 0x4f, 0x23, 0x15, 0x42, 0xfd, 0xb4, 0x59, 0xf0, 0xd2, 0xe6, 0xa3, 0x04, 0x98,
-	0x00, 0x30, 0x17, 0x09, 0xfe, 0x27, 0x19, 0xbd, 0x7e, 0x1f, 0x21, 0x38, 0x4a, 0x88, 0x34, 0x65,
-	0xac, 0x34, 0xb5, 0x56, 0xa5, 0x53, 0xb7, 0xa4, 0x6b, 0x2b, 0x77, 0x6d, 0x3d, 0xcb, 0x63, 0x39,
-	0xab, 0xc7, 0xe7, 0x0d, 0xcd, 0x9d, 0xc3, 0xec, 0x6e, 0x7e, 0x3a, 0x69, 0x5f, 0x7d, 0x04, 0x30,
-	0x4d, 0xf0, 0x78, 0x6b, 0x52, 0x44, 0x9b, 0x4f, 0x21, 0x25, 0x34, 0x98, 0x0f, 0xd6, 0x43, 0x25,
-	0x2f, 0x8b, 0x6a, 0x68, 0x42, 0x65, 0xdb, 0xfa, 0xcd, 0x06, 0xad, 0x9f, 0x07, 0xe2, 0xac, 0x66,
-	0x01, 0x5d, 0x89, 0xd5, 0xef, 0xa1, 0xb5, 0x44, 0x30, 0x2b, 0xaf, 0xb5, 0x25, 0xaf, 0x0f, 0xd4,
-	0x84, 0x9d, 0xcb, 0x19, 0xee, 0x6d, 0x66, 0x57, 0x41, 0xf4, 0x11, 0xd2, 0xe5, 0x57, 0x7f, 0x7e,
-	0xc2, 0xc5, 0x8b, 0x9f, 0xf0, 0x86, 0x94, 0x39, 0x98, 0xcd, 0x79, 0x88, 0x54, 0xad, 0xef, 0xe3,
-	0x58, 0xca, 0x1b, 0xab, 0x17, 0x2f, 0xbc, 0x2e, 0x45, 0x7a, 0x38, 0x16, 0xda, 0xfa, 0x1e, 0xba,
-	0xa2, 0x64, 0x53, 0x60, 0xc0, 0x8d, 0xd2, 0x5f, 0x17, 0x2c, 0xa6, 0x26, 0x96, 0x5c, 0x91, 0x48,
-	0x37, 0x03, 0xfe, 0x6a, 0xcb, 0xef, 0x34, 0x74, 0x5d, 0x1c, 0x21, 0xd8, 0x67, 0xe1, 0x6c, 0xcf,
-	0x0f, 0x51, 0x19, 0xe7, 0x07, 0xb5, 0xeb, 0xea, 0x92, 0x60, 0x37, 0x1e, 0x39, 0xcb, 0x9c, 0xee,
-	0x0c, 0xa9, 0xdf, 0x46, 0x1b, 0x58, 0xb2, 0xf7, 0x23, 0x60, 0x0c, 0x87, 0xc0, 0x8c, 0x95, 0x66,
-	0xb1, 0x55, 0x76, 0xaf, 0xa9, 0xfa, 0xbe, 0x2a, 0xef, 0xde, 0x78, 0xf3, 0xbe, 0x51, 0x58, 0x36,
-	0xf8, 0x51, 0x43, 0xa5, 0xbd, 0xec, 0x65, 0xe9, 0x1d, 0x74, 0x49, 0x3c, 0x31, 0x48, 0x85, 0xa1,
-	0xb2, 0x63, 0x7c, 0x3e, 0x69, 0x57, 0xd5, 0xdc, 0xbb, 0x41, 0x90, 0x02, 0x63, 0x07, 0x3c, 0x25,
-	0x71, 0xe8, 0xe6, 0x8d, 0x33, 0x0c, 0x88, 0xa7, 0xf6, 0x0f, 0x98, 0x85, 0xe8, 0xc5, 0xff, 0x8d,
-	0xee, 0x74, 0x4f, 0xc7, 0xa6, 0x76, 0x36, 0x36, 0xb5, 0xef, 0x63, 0x53, 0x3b, 0x9e, 0x98, 0x85,
-	0xb3, 0x89, 0x59, 0xf8, 0x3a, 0x31, 0x0b, 0xcf, 0xb7, 0xff, 0xf8, 0x12, 0x8e, 0xa6, 0x7f, 0x92,
-	0xde, 0x9a, 0x90, 0xbb, 0xfb, 0x23, 0x00, 0x00, 0xff, 0xff, 0x41, 0xd8, 0x15, 0xc6, 0x4f, 0x05,
-	0x00, 0x00,
+	// 688 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0xb6, 0x05, 0x65, 0x0a, 0x08, 0x2b, 0xc6, 0x85, 0xc3, 0xb6, 0xe1, 0x00, 0xf5, 0xc0,
+	0x56, 0xea, 0xc1, 0x04, 0x2f, 0x76, 0x51, 0x88, 0x89, 0x24, 0x66, 0x41, 0x0f, 0x5e, 0x9a, 0x69,
+	0xf7, 0xb1, 0x6e, 0xdc, 0xdd, 0xd9, 0xec, 0x4c, 0x95, 0xfe, 0x07, 0xde, 0xe4, 0xe8, 0xc9, 0x78,
+	0xf6, 0x4c, 0xfc, 0x1b, 0x88, 0x5e, 0x88, 0x5e, 0x3c, 0x89, 0xa1, 0xff, 0x88, 0x99, 0x1f, 0xbb,
+	0x2d, 0xad, 0x88, 0x21, 0x70, 0xea, 0xce, 0x9b, 0xf7, 0xbd, 0xf7, 0xbd, 0xef, 0x7d, 0xbb, 0x45,
+	0x4b, 0x6d, 0x42, 0x43, 0x42, 0x6b, 0xbb, 0x00, 0x5e, 0x82, 0x23, 0x56, 0x7b, 0xb3, 0xda, 0x02,
+	0x86, 0x57, 0xb3, 0x80, 0x15, 0x27, 0x84, 0x11, 0xfd, 0xb6, 0xcc, 0xb3, 0xb2, 0xb0, 0xca, 0x5b,
+	0x98, 0xf3, 0x88, 0x47, 0x44, 0x4e, 0x8d, 0x3f, 0xc9, 0xf4, 0x85, 0x79, 0x8f, 0x10, 0x2f, 0x80,
+	0x9a, 0x38, 0xb5, 0x3a, 0xbb, 0x35, 0x1c, 0x75, 0xd3, 0x2b, 0x59, 0xa9, 0x29, 0x31, 0xaa, 0xac,
+	0xbc, 0x32, 0x15, 0x99, 0x16, 0xa6, 0x90, 0x11, 0x69, 0x13, 0x3f, 0x52, 0xf7, 0xe5, 0xe1, 0xaa,
+	0xcc, 0x0f, 0x81, 0x32, 0x1c, 0xc6, 0x69, 0x81, 0xe1, 0x04, 0xb7, 0x93, 0x60, 0xe6, 0x13, 0x55,
+	0x60, 0xf1, 0x87, 0x86, 0xa6, 0x6d, 0x4c, 0xfd, 0x76, 0x23, 0x08, 0xc8, 0x5b, 0x1c, 0xb5, 0x41,
+	0x0f, 0x50, 0x89, 0xc6, 0x10, 0xb9, 0xcd, 0xc0, 0x0f, 0x7d, 0x66, 0x68, 0x95, 0x42, 0xb5, 0x54,
+	0x9f, 0xb7, 0x14, 0x2f, 0xce, 0x24, 0x1d, 0xd5, 0x5a, 0x27, 0x7e, 0x64, 0xdf, 0x3d, 0xfc, 0x55,
+	0xce, 0x7d, 0x3e, 0x2e, 0x57, 0x3d, 0x9f, 0xbd, 0xea, 0xb4, 0xac, 0x36, 0x09, 0xd5, 0x10, 0xea,
+	0x67, 0x85, 0xba, 0xaf, 0x6b, 0xac, 0x1b, 0x03, 0x15, 0x00, 0xea, 0x20, 0x51, 0xff, 0x29, 0x2f,
+	0xaf, 0x3f, 0x44, 0x08, 0xf6, 0x62, 0x5f, 0x92, 0x32, 0xf2, 0x15, 0xad, 0x5a, 0xaa, 0x2f, 0x58,
+	0x92, 0xb5, 0x95, 0xb2, 0xb6, 0x76, 0xd2, 0xb1, 0xec, 0xe2, 0xfe, 0x71, 0x59, 0x73, 0x06, 0x30,
+	0x6b, 0xb3, 0x5f, 0x0f, 0x56, 0xa6, 0x36, 0x00, 0xb2, 0x09, 0x9e, 0x2c, 0xf6, 0x0a, 0x68, 0xf6,
+	0x19, 0x24, 0x3e, 0x71, 0x07, 0x07, 0x5b, 0x47, 0x63, 0x2d, 0x3e, 0xaa, 0xa1, 0x89, 0x2e, 0xcb,
+	0xd6, 0x19, 0x1b, 0xb4, 0x4e, 0x0b, 0x62, 0x17, 0xf9, 0x80, 0x8e, 0xc4, 0xea, 0x0f, 0xd0, 0x78,
+	0x2c, 0x2a, 0x2b, 0xae, 0xf3, 0x23, 0x5c, 0x1f, 0x29, 0x85, 0xed, 0xeb, 0x1c, 0xf7, 0x81, 0xd3,
+	0x55, 0x10, 0xbd, 0x8b, 0x74, 0xf9, 0xd4, 0x1c, 0x54, 0xb8, 0x70, 0xf9, 0x0a, 0xcf, 0xc8, 0x36,
+	0xdb, 0x7d, 0x9d, 0x3b, 0x48, 0xc5, 0x9a, 0x6d, 0x1c, 0xc9, 0xf6, 0x46, 0xf1, 0xf2, 0x1b, 0x4f,
+	0xcb, 0x26, 0xeb, 0x38, 0x12, 0xbd, 0xf5, 0x4d, 0x34, 0xa9, 0xda, 0x26, 0x40, 0x81, 0x19, 0x63,
+	0xe7, 0x2e, 0x58, 0xa8, 0x26, 0x96, 0x5c, 0x92, 0x48, 0x87, 0x03, 0xff, 0xb6, 0xe5, 0x8f, 0x1a,
+	0xba, 0x29, 0x8e, 0xe0, 0x6e, 0x51, 0xaf, 0xbf, 0xe7, 0xc7, 0x68, 0x02, 0xa7, 0x07, 0xb5, 0xeb,
+	0xb9, 0x91, 0x86, 0x8d, 0xa8, 0x6b, 0x8f, 0xd6, 0x74, 0xfa, 0x48, 0xfd, 0x0e, 0x9a, 0xc1, 0xb2,
+	0x7a, 0x33, 0x04, 0x4a, 0xb1, 0x07, 0xd4, 0xc8, 0x57, 0x0a, 0xd5, 0x09, 0xe7, 0x86, 0x8a, 0x6f,
+	0xa9, 0xf0, 0xda, 0xad, 0x77, 0x9f, 0xca, 0xb9, 0x51, 0x82, 0xdf, 0xf2, 0x68, 0x76, 0xc3, 0x0f,
+	0x18, 0x24, 0xe0, 0x5e, 0x3a, 0xbd, 0x17, 0x68, 0x26, 0xa4, 0xde, 0xa0, 0x91, 0x24, 0xbd, 0x52,
+	0x7d, 0xe9, 0x4c, 0x63, 0x6f, 0x51, 0xaf, 0x6f, 0x09, 0xe5, 0xeb, 0xe9, 0x70, 0x30, 0x48, 0xf5,
+	0xfb, 0x99, 0xc1, 0x0b, 0xe7, 0x19, 0xbc, 0x78, 0xca, 0xdc, 0xc3, 0xab, 0x2e, 0x5e, 0x74, 0xd5,
+	0x67, 0xa8, 0xf9, 0x3e, 0x8f, 0xa6, 0x4e, 0x0d, 0xa0, 0x57, 0xd0, 0x24, 0x97, 0x80, 0xfb, 0xaf,
+	0xd9, 0x49, 0x02, 0x21, 0xe6, 0x84, 0x83, 0x42, 0xea, 0xed, 0x74, 0x63, 0x78, 0x9e, 0x04, 0xc3,
+	0xdf, 0xb2, 0xfc, 0xd5, 0x7e, 0xcb, 0xa2, 0x4c, 0x01, 0x1e, 0xbc, 0x92, 0x17, 0xbb, 0xd4, 0x7f,
+	0xb1, 0xd9, 0xe2, 0x17, 0x0d, 0x8d, 0x6d, 0xf2, 0x05, 0xeb, 0x75, 0x74, 0x4d, 0x6c, 0x1a, 0x12,
+	0x29, 0x82, 0x6d, 0x7c, 0x3f, 0x58, 0x99, 0x53, 0x7d, 0x1b, 0xae, 0x9b, 0x00, 0xa5, 0xdb, 0x2c,
+	0xf1, 0x23, 0xcf, 0x49, 0x13, 0xfb, 0x18, 0x30, 0xf2, 0xff, 0x87, 0x19, 0xf2, 0x6e, 0xe1, 0xa2,
+	0xde, 0xb5, 0x1b, 0x87, 0x27, 0xa6, 0x76, 0x74, 0x62, 0x6a, 0xbf, 0x4f, 0x4c, 0x6d, 0xbf, 0x67,
+	0xe6, 0x8e, 0x7a, 0x66, 0xee, 0x67, 0xcf, 0xcc, 0xbd, 0x5c, 0xfe, 0xa7, 0x12, 0x7b, 0xd9, 0x9f,
+	0x70, 0x6b, 0x5c, 0xb4, 0xbb, 0xf7, 0x67, 0x00, 0x21, 0xa4, 0x1e, 0x3d, 0xaf, 0x07, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -502,6 +633,131 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FilteredAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FilteredAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FilteredAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintFeegrant(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if m.Period != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Period):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintFeegrant(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgSpendLimits) > 0 {
+		for iNdEx := len(m.MsgSpendLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgSpendLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSpendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSpendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PeriodSpent) > 0 {
+		for iNdEx := len(m.PeriodSpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -627,6 +883,56 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 	return n
 }
 
+func (m *FilteredAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.MsgSpendLimits) > 0 {
+		for _, e := range m.MsgSpendLimits {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.Period != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Period)
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovFeegrant(uint64(l))
+	return n
+}
+
+func (m *MsgSpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.PeriodSpent) > 0 {
+		for _, e := range m.PeriodSpent {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1109,6 +1415,345 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FilteredAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FilteredAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FilteredAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSpendLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgSpendLimits = append(m.MsgSpendLimits, MsgSpendLimit{})
+			if err := m.MsgSpendLimits[len(m.MsgSpendLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Period == nil {
+				m.Period = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSpendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSpendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpent = append(m.PeriodSpent, types.Coin{})
+			if err := m.PeriodSpent[len(m.PeriodSpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
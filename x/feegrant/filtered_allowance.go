@@ -0,0 +1,206 @@
+package feegrant
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ FeeAllowanceI = (*FilteredAllowance)(nil)
+var _ types.UnpackInterfacesMessage = (*FilteredAllowance)(nil)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *FilteredAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewFilteredAllowance creates a new fee allowance restricted to the messages
+// of msgSpendLimits. If period is not nil, the amounts spent are reset every
+// period.
+func NewFilteredAllowance(allowance FeeAllowanceI, msgSpendLimits []MsgSpendLimit, period *time.Duration) (*FilteredAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FilteredAllowance{
+		Allowance:      any,
+		MsgSpendLimits: msgSpendLimits,
+		Period:         period,
+	}, nil
+}
+
+// NewMsgSpendLimit creates a new MsgSpendLimit for msgTypeURL. An empty
+// spendLimit means no limit.
+func NewMsgSpendLimit(msgTypeURL string, spendLimit sdk.Coins) MsgSpendLimit {
+	return MsgSpendLimit{
+		MsgTypeUrl: msgTypeURL,
+		SpendLimit: spendLimit,
+	}
+}
+
+// GetAllowance returns the wrapped fee allowance.
+func (a *FilteredAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets the wrapped fee allowance.
+func (a *FilteredAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	var err error
+	a.Allowance, err = types.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	return nil
+}
+
+// Accept checks that all the messages are allowed and that the fee fits in the
+// spend limit of each of their types, before delegating to the wrapped
+// allowance.
+func (a *FilteredAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	allowed := a.allowedMsgsToMap(ctx)
+
+	used := make(map[string]bool, len(msgs))
+	for _, msg := range msgs {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+		typeURL := sdk.MsgTypeURL(msg)
+		if !allowed[typeURL] {
+			return false, sdkerrors.Wrapf(ErrMessageNotAllowed, "message %s does not exist in allowed messages", typeURL)
+		}
+		used[typeURL] = true
+	}
+
+	a.tryResetPeriod(ctx.BlockTime())
+
+	spent := make([]sdk.Coins, len(a.MsgSpendLimits))
+	for i, limit := range a.MsgSpendLimits {
+		if !used[limit.MsgTypeUrl] {
+			continue
+		}
+
+		spent[i] = limit.PeriodSpent.Add(fee...)
+		if !limit.SpendLimit.Empty() && !spent[i].IsAllLTE(limit.SpendLimit) {
+			return false, sdkerrors.Wrapf(ErrFeeLimitExceeded, "%s spend limit", limit.MsgTypeUrl)
+		}
+	}
+	for i := range a.MsgSpendLimits {
+		if spent[i] != nil {
+			a.MsgSpendLimits[i].PeriodSpent = spent[i]
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+// tryResetPeriod resets the amounts spent if the PeriodReset has been hit. It
+// follows the same stepping as PeriodicAllowance.tryResetPeriod.
+func (a *FilteredAllowance) tryResetPeriod(blockTime time.Time) {
+	if a.Period == nil || blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	for i := range a.MsgSpendLimits {
+		a.MsgSpendLimits[i].PeriodSpent = nil
+	}
+
+	a.PeriodReset = a.PeriodReset.Add(*a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(*a.Period)
+	}
+}
+
+func (a *FilteredAllowance) allowedMsgsToMap(ctx sdk.Context) map[string]bool {
+	msgsMap := make(map[string]bool, len(a.MsgSpendLimits))
+	for _, limit := range a.MsgSpendLimits {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+		msgsMap[limit.MsgTypeUrl] = true
+	}
+
+	return msgsMap
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *FilteredAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.MsgSpendLimits) == 0 {
+		return sdkerrors.Wrap(ErrNoMessages, "allowed messages shouldn't be empty")
+	}
+
+	seen := make(map[string]bool, len(a.MsgSpendLimits))
+	for _, limit := range a.MsgSpendLimits {
+		if err := limit.ValidateBasic(); err != nil {
+			return err
+		}
+		if seen[limit.MsgTypeUrl] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate allowed message %s", limit.MsgTypeUrl)
+		}
+		seen[limit.MsgTypeUrl] = true
+	}
+
+	if a.Period != nil && *a.Period <= 0 {
+		return sdkerrors.Wrap(ErrInvalidDuration, "period must be positive")
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// ValidateBasic performs a stateless validation of the message spend limit.
+func (l MsgSpendLimit) ValidateBasic() error {
+	if l.MsgTypeUrl == "" {
+		return sdkerrors.Wrap(ErrNoMessages, "allowed message type url cannot be empty")
+	}
+	if !l.SpendLimit.Empty() {
+		if !l.SpendLimit.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit of %s is invalid: %s", l.MsgTypeUrl, l.SpendLimit)
+		}
+		if !l.SpendLimit.IsAllPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit of %s must be positive", l.MsgTypeUrl)
+		}
+	}
+	if !l.PeriodSpent.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spent amount of %s is invalid: %s", l.MsgTypeUrl, l.PeriodSpent)
+	}
+
+	return nil
+}
+
+func (a *FilteredAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
@@ -0,0 +1,159 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ocproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+func TestFilteredAllowanceValidateBasic(t *testing.T) {
+	send := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	hour := time.Hour
+	negative := -time.Hour
+
+	cases := map[string]struct {
+		limits []feegrant.MsgSpendLimit
+		period *time.Duration
+		valid  bool
+	}{
+		"valid": {
+			limits: []feegrant.MsgSpendLimit{feegrant.NewMsgSpendLimit(send, atom)},
+			period: &hour,
+			valid:  true,
+		},
+		"no spend limit": {
+			limits: []feegrant.MsgSpendLimit{feegrant.NewMsgSpendLimit(send, nil)},
+			valid:  true,
+		},
+		"no messages": {
+			valid: false,
+		},
+		"empty msg type": {
+			limits: []feegrant.MsgSpendLimit{feegrant.NewMsgSpendLimit("", atom)},
+			valid:  false,
+		},
+		"duplicate msg type": {
+			limits: []feegrant.MsgSpendLimit{
+				feegrant.NewMsgSpendLimit(send, atom),
+				feegrant.NewMsgSpendLimit(send, nil),
+			},
+			valid: false,
+		},
+		"zero spend limit": {
+			limits: []feegrant.MsgSpendLimit{feegrant.NewMsgSpendLimit(send, sdk.Coins{sdk.NewInt64Coin("atom", 0)})},
+			valid:  false,
+		},
+		"negative period": {
+			limits: []feegrant.MsgSpendLimit{feegrant.NewMsgSpendLimit(send, atom)},
+			period: &negative,
+			valid:  false,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewFilteredAllowance(&feegrant.BasicAllowance{}, tc.limits, tc.period)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestFilteredAllowanceAccept(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, ocproto.Header{}).WithBlockTime(now)
+
+	send := &banktypes.MsgSend{}
+	vote := &govtypes.MsgVote{}
+	deposit := &govtypes.MsgDeposit{}
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 4))
+	hour := time.Hour
+
+	allowance, err := feegrant.NewFilteredAllowance(
+		&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100))},
+		[]feegrant.MsgSpendLimit{
+			feegrant.NewMsgSpendLimit(sdk.MsgTypeURL(send), sdk.NewCoins(sdk.NewInt64Coin("atom", 10))),
+			feegrant.NewMsgSpendLimit(sdk.MsgTypeURL(vote), nil),
+		},
+		&hour,
+	)
+	require.NoError(t, err)
+	require.NoError(t, allowance.ValidateBasic())
+
+	// messages which are not listed are rejected
+	_, err = allowance.Accept(ctx, fee, []sdk.Msg{deposit})
+	require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+
+	// the send limit allows two fees within the period
+	for i := 0; i < 2; i++ {
+		remove, err := allowance.Accept(ctx, fee, []sdk.Msg{send})
+		require.NoError(t, err)
+		require.False(t, remove)
+	}
+	require.Equal(t, now.Add(hour), allowance.PeriodReset)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 8)), allowance.MsgSpendLimits[0].PeriodSpent)
+
+	_, err = allowance.Accept(ctx, fee, []sdk.Msg{send})
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+
+	// a tx mixing messages must fit in the limit of each of them
+	_, err = allowance.Accept(ctx, fee, []sdk.Msg{vote, send})
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+
+	// messages without a spend limit are only bound by the wrapped allowance
+	remove, err := allowance.Accept(ctx, fee, []sdk.Msg{vote})
+	require.NoError(t, err)
+	require.False(t, remove)
+
+	inner, err := allowance.GetAllowance()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 88)), inner.(*feegrant.BasicAllowance).SpendLimit)
+
+	// the spent amounts are reset after the period
+	ctx = ctx.WithBlockTime(now.Add(hour))
+	remove, err = allowance.Accept(ctx, fee, []sdk.Msg{send})
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, now.Add(2*hour), allowance.PeriodReset)
+	require.Equal(t, fee, allowance.MsgSpendLimits[0].PeriodSpent)
+	require.True(t, allowance.MsgSpendLimits[1].PeriodSpent.Empty())
+
+	// the allowance survives a save and load round trip
+	var granter, grantee sdk.AccAddress
+	grant, err := feegrant.NewGrant(granter, grantee, allowance)
+	require.NoError(t, err)
+
+	cdc := simapp.MakeTestEncodingConfig().Codec
+	bz, err := cdc.Marshal(&grant)
+	require.NoError(t, err)
+
+	var loadedGrant feegrant.Grant
+	require.NoError(t, cdc.Unmarshal(bz, &loadedGrant))
+
+	loaded, err := loadedGrant.GetGrant()
+	require.NoError(t, err)
+	filtered := loaded.(*feegrant.FilteredAllowance)
+	require.Equal(t, allowance.MsgSpendLimits, filtered.MsgSpendLimits)
+	require.Equal(t, allowance.PeriodReset, filtered.PeriodReset)
+
+	inner, err = filtered.GetAllowance()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 84)), inner.(*feegrant.BasicAllowance).SpendLimit)
+}
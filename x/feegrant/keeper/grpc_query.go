@@ -134,3 +134,50 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// ExpiringAllowances queries the allowances expiring within a time window.
+func (q Keeper) ExpiringAllowances(c context.Context, req *feegrant.QueryExpiringAllowancesRequest) (*feegrant.QueryExpiringAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.End.Before(req.Start) {
+		return nil, status.Error(codes.InvalidArgument, "end of the time window must not be before its start")
+	}
+
+	limit := uint64(query.DefaultLimit)
+	var startKey []byte
+	if req.Pagination != nil {
+		if req.Pagination.Offset > 0 {
+			return nil, status.Error(codes.InvalidArgument, "offset pagination is not supported, use key pagination")
+		}
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+		startKey = req.Pagination.Key
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var (
+		grants  []*feegrant.Grant
+		nextKey []byte
+	)
+	err := q.IterateExpiringAllowances(ctx, req.Start, req.End, startKey, func(queueKey []byte, grant feegrant.Grant) bool {
+		if uint64(len(grants)) >= limit {
+			nextKey = queueKey
+			return true
+		}
+
+		grants = append(grants, &grant)
+		return false
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryExpiringAllowancesResponse{
+		Allowances: grants,
+		Pagination: &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
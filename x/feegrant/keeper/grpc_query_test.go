@@ -1,7 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
@@ -214,6 +217,59 @@ func (suite *KeeperTestSuite) TestFeeAllowancesByGranter() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestExpiringAllowances() {
+	now := suite.sdkCtx.BlockTime()
+	oneDay := now.AddDate(0, 0, 1)
+	twoDays := now.AddDate(0, 0, 2)
+	oneYear := now.AddDate(1, 0, 0)
+
+	for i, exp := range []*time.Time{&twoDays, &oneDay, &oneYear} {
+		err := suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[3], suite.addrs[i], &feegrant.BasicAllowance{Expiration: exp})
+		suite.Require().NoError(err)
+	}
+	err := suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{})
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.ExpiringAllowances(suite.ctx, nil)
+	suite.Require().Error(err)
+
+	_, err = suite.keeper.ExpiringAllowances(suite.ctx, &feegrant.QueryExpiringAllowancesRequest{Start: oneYear, End: now})
+	suite.Require().Error(err)
+
+	_, err = suite.keeper.ExpiringAllowances(suite.ctx, &feegrant.QueryExpiringAllowancesRequest{
+		Start:      now,
+		End:        oneYear,
+		Pagination: &query.PageRequest{Offset: 1},
+	})
+	suite.Require().Error(err)
+
+	resp, err := suite.keeper.ExpiringAllowances(suite.ctx, &feegrant.QueryExpiringAllowancesRequest{Start: now, End: twoDays})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Allowances, 2)
+	suite.Require().Equal(suite.addrs[1].String(), resp.Allowances[0].Grantee)
+	suite.Require().Equal(suite.addrs[0].String(), resp.Allowances[1].Grantee)
+	suite.Require().Nil(resp.Pagination.NextKey)
+
+	resp, err = suite.keeper.ExpiringAllowances(suite.ctx, &feegrant.QueryExpiringAllowancesRequest{
+		Start:      now,
+		End:        oneYear,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Allowances, 2)
+	suite.Require().NotNil(resp.Pagination.NextKey)
+
+	resp, err = suite.keeper.ExpiringAllowances(suite.ctx, &feegrant.QueryExpiringAllowancesRequest{
+		Start:      now,
+		End:        oneYear,
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Allowances, 1)
+	suite.Require().Equal(suite.addrs[2].String(), resp.Allowances[0].Grantee)
+	suite.Require().Nil(resp.Pagination.NextKey)
+}
+
 func grantFeeAllowance(suite *KeeperTestSuite) {
 	exp := suite.sdkCtx.BlockTime().AddDate(1, 0, 0)
 	err := suite.app.FeeGrantKeeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{
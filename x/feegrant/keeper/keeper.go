@@ -52,8 +52,8 @@ func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress,
 	key := feegrant.FeeAllowanceKey(granter, grantee)
 
 	var oldExp *time.Time
-	existingGrant, err := k.getGrant(ctx, grantee, granter)
-	if err != nil && existingGrant != nil && existingGrant.GetAllowance() != nil {
+	existingGrant, err := k.getGrant(ctx, granter, grantee)
+	if err == nil && existingGrant.GetAllowance() != nil {
 		grantInfo, err := existingGrant.GetGrant()
 		if err != nil {
 			return err
@@ -145,9 +145,91 @@ func (k Keeper) UpdateAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress
 	return nil
 }
 
-// revokeAllowance removes an existing grant
+// UpdateAllowanceLimits adds increase to and removes decrease from the spend
+// limit of the existing grant and, if expiration is not nil, replaces its
+// expiration, keeping the grant pruning queue in sync.
+func (k Keeper) UpdateAllowanceLimits(ctx sdk.Context, granter, grantee sdk.AccAddress, increase, decrease sdk.Coins, expiration *time.Time) error {
+	existingGrant, err := k.getGrant(ctx, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	allowance, err := existingGrant.GetGrant()
+	if err != nil {
+		return err
+	}
+
+	oldExp, err := allowance.ExpiresAt()
+	if err != nil {
+		return err
+	}
+
+	if expiration != nil && expiration.Before(ctx.BlockTime()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "expiration is before current block time")
+	}
+
+	if err := feegrant.UpdateAllowanceLimits(allowance, increase, decrease, expiration); err != nil {
+		return err
+	}
+
+	if err := allowance.ValidateBasic(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := feegrant.FeeAllowanceKey(granter, grantee)
+
+	if expiration != nil && (oldExp == nil || !oldExp.Equal(*expiration)) {
+		// `key` formed here with the prefix of `FeeAllowanceKeyPrefix` (which is `0x00`)
+		// remove the 1st byte and reuse the remaining key as it is.
+		if oldExp != nil {
+			k.removeFromGrantQueue(ctx, oldExp, key[1:])
+		}
+		k.addToFeeAllowanceQueue(ctx, key[1:], expiration)
+	}
+
+	grant, err := feegrant.NewGrant(granter, grantee, allowance)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&grant)
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+
+	newExp := oldExp
+	if expiration != nil {
+		newExp = expiration
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			feegrant.EventTypeUpdateFeeGrant,
+			sdk.NewAttribute(feegrant.AttributeKeyGranter, grant.Granter),
+			sdk.NewAttribute(feegrant.AttributeKeyGrantee, grant.Grantee),
+			sdk.NewAttribute(feegrant.AttributeKeySpendLimitIncrease, increase.String()),
+			sdk.NewAttribute(feegrant.AttributeKeySpendLimitDecrease, decrease.String()),
+			sdk.NewAttribute(feegrant.AttributeKeyPrevExpiration, formatExpiration(oldExp)),
+			sdk.NewAttribute(feegrant.AttributeKeyExpiration, formatExpiration(newExp)),
+		),
+	)
+
+	return nil
+}
+
+func formatExpiration(exp *time.Time) string {
+	if exp == nil {
+		return ""
+	}
+	return exp.UTC().Format(time.RFC3339Nano)
+}
+
+// revokeAllowance removes an existing grant and its pruning queue entry
 func (k Keeper) revokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
-	_, err := k.getGrant(ctx, granter, grantee)
+	grant, err := k.getGrant(ctx, granter, grantee)
 	if err != nil {
 		return err
 	}
@@ -156,6 +238,10 @@ func (k Keeper) revokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress
 	key := feegrant.FeeAllowanceKey(granter, grantee)
 	store.Delete(key)
 
+	if exp, err := grantExpiration(grant); err == nil && exp != nil {
+		k.removeFromGrantQueue(ctx, exp, key[1:])
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			feegrant.EventTypeRevokeFeeGrant,
@@ -314,17 +400,93 @@ func (k Keeper) addToFeeAllowanceQueue(ctx sdk.Context, grantKey []byte, exp *ti
 }
 
 // RemoveExpiredAllowances iterates grantsByExpiryQueue and deletes the expired grants.
-func (k Keeper) RemoveExpiredAllowances(ctx sdk.Context) {
+// At most limit queue entries are processed, the remaining ones being left for
+// subsequent calls. A non-positive limit means no limit.
+func (k Keeper) RemoveExpiredAllowances(ctx sdk.Context, limit int) {
 	exp := ctx.BlockTime()
 	store := ctx.KVStore(k.storeKey)
 	iterator := store.Iterator(feegrant.FeeAllowanceQueueKeyPrefix, sdk.InclusiveEndBytes(feegrant.AllowanceByExpTimeKey(&exp)))
 	defer iterator.Close()
 
-	for ; iterator.Valid(); iterator.Next() {
+	expLen := len(sdk.FormatTimeBytes(exp))
+	for count := 0; iterator.Valid(); iterator.Next() {
+		if limit > 0 && count >= limit {
+			break
+		}
+		count++
+
 		store.Delete(iterator.Key())
-		expLen := len(sdk.FormatTimeBytes(ctx.BlockTime()))
 
 		// extract the fee allowance key by removing the allowance queue prefix length, expiration length from key.
-		store.Delete(append(feegrant.FeeAllowanceKeyPrefix, iterator.Key()[1+expLen:]...))
+		key := append(feegrant.FeeAllowanceKeyPrefix, iterator.Key()[1+expLen:]...)
+
+		// only remove the grant if it still expires at the queued time, the queue
+		// entry may predate a re-grant of the allowance.
+		queuedExp, err := sdk.ParseTimeBytes(iterator.Key()[1 : 1+expLen])
+		if err != nil {
+			continue
+		}
+		if grantExp, err := k.grantExpirationByKey(ctx, key); err == nil && grantExp != nil && grantExp.Equal(queuedExp) {
+			store.Delete(key)
+		}
+	}
+}
+
+// IterateExpiringAllowances iterates over the grants expiring between start and
+// end (both inclusive), in expiration order, starting at the queue key startKey
+// if set. Iteration stops when the handler returns true.
+func (k Keeper) IterateExpiringAllowances(ctx sdk.Context, start, end time.Time, startKey []byte,
+	handler func(queueKey []byte, grant feegrant.Grant) bool) error {
+	store := ctx.KVStore(k.storeKey)
+
+	from := feegrant.AllowanceByExpTimeKey(&start)
+	if startKey != nil {
+		from = startKey
+	}
+
+	iterator := store.Iterator(from, sdk.PrefixEndBytes(feegrant.AllowanceByExpTimeKey(&end)))
+	defer iterator.Close()
+
+	expLen := len(sdk.FormatTimeBytes(end))
+	for ; iterator.Valid(); iterator.Next() {
+		bz := store.Get(append(feegrant.FeeAllowanceKeyPrefix, iterator.Key()[1+expLen:]...))
+		if len(bz) == 0 {
+			continue
+		}
+
+		var grant feegrant.Grant
+		if err := k.cdc.Unmarshal(bz, &grant); err != nil {
+			return err
+		}
+
+		if handler(iterator.Key(), grant) {
+			return nil
+		}
 	}
+
+	return nil
+}
+
+// grantExpirationByKey returns the expiration of the grant stored under key.
+func (k Keeper) grantExpirationByKey(ctx sdk.Context, key []byte) (*time.Time, error) {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if len(bz) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "fee-grant not found")
+	}
+
+	var grant feegrant.Grant
+	if err := k.cdc.Unmarshal(bz, &grant); err != nil {
+		return nil, err
+	}
+
+	return grantExpiration(&grant)
+}
+
+func grantExpiration(grant *feegrant.Grant) (*time.Time, error) {
+	allowance, err := grant.GetGrant()
+	if err != nil {
+		return nil, err
+	}
+
+	return allowance.ExpiresAt()
 }
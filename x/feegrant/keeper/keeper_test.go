@@ -332,7 +332,7 @@ func (suite *KeeperTestSuite) TestPruneGrants() {
 		tc := tc
 		suite.Run(tc.name, func() {
 			suite.keeper.GrantAllowance(suite.sdkCtx, tc.granter, tc.grantee, tc.allowance)
-			suite.app.FeeGrantKeeper.RemoveExpiredAllowances(tc.ctx)
+			suite.app.FeeGrantKeeper.RemoveExpiredAllowances(tc.ctx, 0)
 			grant, err := suite.keeper.GetAllowance(tc.ctx, tc.granter, tc.grantee)
 			if tc.expErrMsg != "" {
 				suite.Error(err)
@@ -343,3 +343,54 @@ func (suite *KeeperTestSuite) TestPruneGrants() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestPruneGrantsLimit() {
+	now := suite.sdkCtx.BlockTime()
+	oneDay := now.AddDate(0, 0, 1)
+	oneYear := now.AddDate(1, 0, 0)
+
+	for _, grantee := range suite.addrs[1:] {
+		err := suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], grantee, &feegrant.BasicAllowance{
+			SpendLimit: suite.atom,
+			Expiration: &now,
+		})
+		suite.Require().NoError(err)
+	}
+
+	countGrants := func() int {
+		count := 0
+		suite.keeper.IterateAllFeeAllowances(suite.sdkCtx, func(feegrant.Grant) bool {
+			count++
+			return false
+		})
+		return count
+	}
+
+	ctx := suite.sdkCtx.WithBlockTime(oneDay)
+	suite.keeper.RemoveExpiredAllowances(ctx, 2)
+	suite.Require().Equal(1, countGrants())
+	suite.keeper.RemoveExpiredAllowances(ctx, 2)
+	suite.Require().Equal(0, countGrants())
+
+	// a revoked and re-granted allowance is not pruned at its previous expiration
+	err := suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{Expiration: &oneDay})
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{
+		Granter: suite.addrs[0].String(),
+		Grantee: suite.addrs[1].String(),
+	})
+	suite.Require().NoError(err)
+	err = suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{Expiration: &oneYear})
+	suite.Require().NoError(err)
+
+	suite.keeper.RemoveExpiredAllowances(suite.sdkCtx.WithBlockTime(oneDay.AddDate(0, 0, 1)), 0)
+	_, err = suite.keeper.GetAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+
+	// re-granting an allowance moves it in the pruning queue
+	err = suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{Expiration: &oneDay})
+	suite.Require().NoError(err)
+	suite.keeper.RemoveExpiredAllowances(suite.sdkCtx.WithBlockTime(oneDay.AddDate(0, 0, 1)), 0)
+	_, err = suite.keeper.GetAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[1])
+	suite.Require().Error(err)
+}
@@ -76,3 +76,25 @@ func (k msgServer) RevokeAllowance(goCtx context.Context, msg *feegrant.MsgRevok
 
 	return &feegrant.MsgRevokeAllowanceResponse{}, nil
 }
+
+// UpdateAllowance updates the spend limit and expiration of a fee allowance between a granter and grantee.
+func (k msgServer) UpdateAllowance(goCtx context.Context, msg *feegrant.MsgUpdateAllowance) (*feegrant.MsgUpdateAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.UpdateAllowanceLimits(ctx, granter, grantee, msg.SpendLimitIncrease, msg.SpendLimitDecrease, msg.Expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgUpdateAllowanceResponse{}, nil
+}
@@ -1,7 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
@@ -220,3 +223,109 @@ func (suite *KeeperTestSuite) TestRevokeAllowance() {
 	}
 
 }
+
+func (suite *KeeperTestSuite) TestUpdateAllowance() {
+	ctx := suite.sdkCtx.WithBlockTime(time.Now().UTC()).WithEventManager(sdk.NewEventManager())
+	goCtx := sdk.WrapSDKContext(ctx)
+	oneYear := ctx.BlockTime().AddDate(1, 0, 0)
+	twoYears := ctx.BlockTime().AddDate(2, 0, 0)
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 100))
+
+	allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.PeriodicAllowance{
+		Basic: feegrant.BasicAllowance{
+			SpendLimit: suite.atom,
+			Expiration: &oneYear,
+		},
+		Period:           time.Hour,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 10)),
+	}, []string{"/cosmos.bank.v1beta1.MsgSend"})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[1], allowance))
+	suite.Require().NoError(suite.keeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[2], &feegrant.BasicAllowance{}))
+
+	testCases := []struct {
+		name    string
+		request *feegrant.MsgUpdateAllowance
+		errMsg  string
+	}{
+		{
+			"error: invalid granter",
+			&feegrant.MsgUpdateAllowance{
+				Granter:            "invalid-granter",
+				Grantee:            suite.addrs[1].String(),
+				SpendLimitIncrease: suite.atom,
+			},
+			"decoding bech32 failed",
+		},
+		{
+			"error: fee allowance not found",
+			feegrant.NewMsgUpdateAllowance(suite.addrs[1], suite.addrs[0], suite.atom, nil, nil),
+			"fee-grant not found",
+		},
+		{
+			"error: allowance without spend limit",
+			feegrant.NewMsgUpdateAllowance(suite.addrs[0], suite.addrs[2], suite.atom, nil, nil),
+			"allowance has no spend limit",
+		},
+		{
+			"error: decrease exceeds the spend limit",
+			feegrant.NewMsgUpdateAllowance(suite.addrs[0], suite.addrs[1], nil, suite.atom.Add(suite.atom...), nil),
+			"exceeds the spend limit",
+		},
+		{
+			"error: decrease exhausts the spend limit",
+			feegrant.NewMsgUpdateAllowance(suite.addrs[0], suite.addrs[1], nil, suite.atom, nil),
+			"revoke it instead",
+		},
+		{
+			"error: expiration before block time",
+			feegrant.NewMsgUpdateAllowance(suite.addrs[0], suite.addrs[1], nil, nil, &time.Time{}),
+			"expiration is before current block time",
+		},
+		{
+			"error: period spend limit denom not in spend limit",
+			feegrant.NewMsgUpdateAllowance(suite.addrs[0], suite.addrs[1], eth, suite.atom, nil),
+			"period spend limit has different currency",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.UpdateAllowance(goCtx, tc.request)
+			suite.Require().Error(err)
+			suite.Require().Contains(err.Error(), tc.errMsg)
+		})
+	}
+
+	_, err = suite.msgSrvr.UpdateAllowance(goCtx, feegrant.NewMsgUpdateAllowance(suite.addrs[0], suite.addrs[1], eth, sdk.NewCoins(sdk.NewInt64Coin("atom", 55)), &twoYears))
+	suite.Require().NoError(err)
+
+	updated, err := suite.keeper.GetAllowance(ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+	inner, err := updated.(*feegrant.AllowedMsgAllowance).GetAllowance()
+	suite.Require().NoError(err)
+	periodic := inner.(*feegrant.PeriodicAllowance)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 500), sdk.NewInt64Coin("eth", 100)), periodic.Basic.SpendLimit)
+	suite.Require().Equal(twoYears, *periodic.Basic.Expiration)
+
+	events := ctx.EventManager().Events()
+	event := events[len(events)-1]
+	suite.Require().Equal(feegrant.EventTypeUpdateFeeGrant, event.Type)
+	attrs := make(map[string]string)
+	for _, attr := range event.Attributes {
+		attrs[string(attr.Key)] = string(attr.Value)
+	}
+	suite.Require().Equal("100eth", attrs[feegrant.AttributeKeySpendLimitIncrease])
+	suite.Require().Equal("55atom", attrs[feegrant.AttributeKeySpendLimitDecrease])
+	suite.Require().Equal(oneYear.Format(time.RFC3339Nano), attrs[feegrant.AttributeKeyPrevExpiration])
+	suite.Require().Equal(twoYears.Format(time.RFC3339Nano), attrs[feegrant.AttributeKeyExpiration])
+
+	// the grant is no longer pruned at its previous expiration
+	suite.keeper.RemoveExpiredAllowances(ctx.WithBlockTime(oneYear.Add(time.Hour)), 0)
+	_, err = suite.keeper.GetAllowance(ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+
+	suite.keeper.RemoveExpiredAllowances(ctx.WithBlockTime(twoYears.Add(time.Hour)), 0)
+	_, err = suite.keeper.GetAllowance(ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().Error(err)
+}
@@ -5,6 +5,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 )
 
+// maxPrunedAllowanceQueueItems bounds the number of expired allowances pruned
+// in a single block, remaining expired allowances being pruned in the
+// following blocks.
+const maxPrunedAllowanceQueueItems = 200
+
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
-	k.RemoveExpiredAllowances(ctx)
+	k.RemoveExpiredAllowances(ctx, maxPrunedAllowanceQueueItems)
 }
@@ -1,6 +1,8 @@
 package feegrant
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -10,8 +12,8 @@ import (
 )
 
 var (
-	_, _ sdk.Msg            = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
-	_, _ legacytx.LegacyMsg = &MsgGrantAllowance{}, &MsgRevokeAllowance{} // For amino support.
+	_, _, _ sdk.Msg            = &MsgGrantAllowance{}, &MsgRevokeAllowance{}, &MsgUpdateAllowance{}
+	_, _, _ legacytx.LegacyMsg = &MsgGrantAllowance{}, &MsgRevokeAllowance{}, &MsgUpdateAllowance{} // For amino support.
 
 	_ types.UnpackInterfacesMessage = &MsgGrantAllowance{}
 )
@@ -134,3 +136,68 @@ func (msg MsgRevokeAllowance) Route() string {
 func (msg MsgRevokeAllowance) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
+
+// NewMsgUpdateAllowance returns a message to update the spend limit and
+// expiration of the fee allowance of a given granter and grantee.
+//nolint:interfacer
+func NewMsgUpdateAllowance(granter, grantee sdk.AccAddress, increase, decrease sdk.Coins, expiration *time.Time) *MsgUpdateAllowance {
+	return &MsgUpdateAllowance{
+		Granter:            granter.String(),
+		Grantee:            grantee.String(),
+		SpendLimitIncrease: increase,
+		SpendLimitDecrease: decrease,
+		Expiration:         expiration,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+	if msg.Grantee == msg.Granter {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "addresses must be different")
+	}
+	if msg.SpendLimitIncrease.Empty() && msg.SpendLimitDecrease.Empty() && msg.Expiration == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "nothing to update")
+	}
+	if !msg.SpendLimitIncrease.Empty() && (!msg.SpendLimitIncrease.IsValid() || !msg.SpendLimitIncrease.IsAllPositive()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid spend limit increase: %s", msg.SpendLimitIncrease)
+	}
+	if !msg.SpendLimitDecrease.Empty() && (!msg.SpendLimitDecrease.IsValid() || !msg.SpendLimitDecrease.IsAllPositive()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid spend limit decrease: %s", msg.SpendLimitDecrease)
+	}
+	if both := msg.SpendLimitIncrease.AllowDenoms(msg.SpendLimitDecrease.Denoms()...); len(both) > 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "cannot both increase and decrease the %s spend limit", both[0].Denom)
+	}
+	if msg.Expiration != nil && msg.Expiration.Unix() < 0 {
+		return sdkerrors.Wrap(ErrInvalidDuration, "expiration time cannot be negative")
+	}
+
+	return nil
+}
+
+// GetSigners gets the granter address associated with an Allowance
+// to update.
+func (msg MsgUpdateAllowance) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgUpdateAllowance) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgUpdateAllowance) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgUpdateAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
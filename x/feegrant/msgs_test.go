@@ -161,3 +161,77 @@ func TestAminoJSON(t *testing.T) {
 		string(legacytx.StdSignBytes("foo", 1, 1, 1, legacytx.StdFee{}, []sdk.Msg{msg}, "memo", nil)),
 	)
 }
+
+func TestMsgUpdateAllowance(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32("cosmos1aeuqja06474dfrj7uqsvukm6rael982kk89mqr")
+	addr2, _ := sdk.AccAddressFromBech32("cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl")
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 10))
+	threeHours := time.Now().Add(3 * time.Hour)
+
+	cases := map[string]struct {
+		grantee    sdk.AccAddress
+		granter    sdk.AccAddress
+		increase   sdk.Coins
+		decrease   sdk.Coins
+		expiration *time.Time
+		valid      bool
+	}{
+		"valid": {
+			grantee:    addr,
+			granter:    addr2,
+			increase:   atom,
+			decrease:   eth,
+			expiration: &threeHours,
+			valid:      true,
+		},
+		"only expiration": {
+			grantee:    addr,
+			granter:    addr2,
+			expiration: &threeHours,
+			valid:      true,
+		},
+		"no grantee": {
+			granter:  addr2,
+			grantee:  sdk.AccAddress{},
+			increase: atom,
+			valid:    false,
+		},
+		"grantee == granter": {
+			grantee:  addr,
+			granter:  addr,
+			increase: atom,
+			valid:    false,
+		},
+		"nothing to update": {
+			grantee: addr,
+			granter: addr2,
+			valid:   false,
+		},
+		"invalid increase": {
+			grantee:  addr,
+			granter:  addr2,
+			increase: sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}},
+			valid:    false,
+		},
+		"increase and decrease same denom": {
+			grantee:  addr,
+			granter:  addr2,
+			increase: atom,
+			decrease: atom,
+			valid:    false,
+		},
+	}
+
+	for _, tc := range cases {
+		msg := feegrant.NewMsgUpdateAllowance(tc.granter, tc.grantee, tc.increase, tc.decrease, tc.expiration)
+		err := msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err)
+			addrSlice := msg.GetSigners()
+			require.True(t, tc.granter.Equals(addrSlice[0]))
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
@@ -8,21 +8,26 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -349,6 +354,126 @@ func (m *QueryAllowancesByGranterResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryExpiringAllowancesRequest is the request type for the Query/ExpiringAllowances RPC method.
+type QueryExpiringAllowancesRequest struct {
+	// start of the time window, inclusive.
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// end of the time window, inclusive.
+	End time.Time `protobuf:"bytes,2,opt,name=end,proto3,stdtime" json:"end"`
+	// pagination defines an pagination for the request. Only key based pagination
+	// is supported.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExpiringAllowancesRequest) Reset()         { *m = QueryExpiringAllowancesRequest{} }
+func (m *QueryExpiringAllowancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringAllowancesRequest) ProtoMessage()    {}
+func (*QueryExpiringAllowancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{6}
+}
+func (m *QueryExpiringAllowancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringAllowancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringAllowancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiringAllowancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringAllowancesRequest.Merge(m, src)
+}
+func (m *QueryExpiringAllowancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringAllowancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringAllowancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiringAllowancesRequest proto.InternalMessageInfo
+
+func (m *QueryExpiringAllowancesRequest) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *QueryExpiringAllowancesRequest) GetEnd() time.Time {
+	if m != nil {
+		return m.End
+	}
+	return time.Time{}
+}
+
+func (m *QueryExpiringAllowancesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExpiringAllowancesResponse is the response type for the Query/ExpiringAllowances RPC method.
+type QueryExpiringAllowancesResponse struct {
+	// allowances expiring within the requested time window, ordered by expiration time.
+	Allowances []*Grant `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances,omitempty"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExpiringAllowancesResponse) Reset()         { *m = QueryExpiringAllowancesResponse{} }
+func (m *QueryExpiringAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringAllowancesResponse) ProtoMessage()    {}
+func (*QueryExpiringAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{7}
+}
+func (m *QueryExpiringAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiringAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringAllowancesResponse.Merge(m, src)
+}
+func (m *QueryExpiringAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringAllowancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiringAllowancesResponse proto.InternalMessageInfo
+
+func (m *QueryExpiringAllowancesResponse) GetAllowances() []*Grant {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
+func (m *QueryExpiringAllowancesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAllowanceRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceRequest")
 	proto.RegisterType((*QueryAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceResponse")
@@ -356,6 +481,8 @@ func init() {
 	proto.RegisterType((*QueryAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesResponse")
 	proto.RegisterType((*QueryAllowancesByGranterRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterRequest")
 	proto.RegisterType((*QueryAllowancesByGranterResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterResponse")
+	proto.RegisterType((*QueryExpiringAllowancesRequest)(nil), "cosmos.feegrant.v1beta1.QueryExpiringAllowancesRequest")
+	proto.RegisterType((*QueryExpiringAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryExpiringAllowancesResponse")
 }
 
 func init() {
@@ -363,40 +490,48 @@ func init() {
 }
 
 var fileDescriptor_59efc303945de53f = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xbd, 0x8b, 0x13, 0x41,
-	0x18, 0xc6, 0x33, 0xe7, 0x17, 0x79, 0xaf, 0x1b, 0x3f, 0x2e, 0x2e, 0xb2, 0x86, 0x15, 0xee, 0xfc,
-	0x20, 0x3b, 0x26, 0xa2, 0x9c, 0x20, 0x07, 0x49, 0x61, 0x5a, 0x8d, 0x60, 0x61, 0x23, 0x93, 0xe4,
-	0x75, 0x5d, 0xcc, 0xed, 0xe4, 0x76, 0x36, 0xea, 0x21, 0x87, 0xe0, 0x5f, 0x20, 0x68, 0x2b, 0x82,
-	0x85, 0x8d, 0x96, 0xb6, 0xf6, 0x96, 0x87, 0x36, 0x96, 0x92, 0xf8, 0x87, 0x48, 0xe6, 0x63, 0x37,
-	0xe6, 0xb2, 0x64, 0x51, 0x0b, 0xab, 0x64, 0x76, 0x9f, 0xe7, 0xdd, 0xdf, 0xf3, 0xbe, 0x33, 0x03,
-	0xe7, 0x7a, 0x42, 0x6e, 0x0b, 0xc9, 0x1e, 0x20, 0x06, 0x31, 0x8f, 0x12, 0xf6, 0xb8, 0xde, 0xc5,
-	0x84, 0xd7, 0xd9, 0xce, 0x08, 0xe3, 0x5d, 0x7f, 0x18, 0x8b, 0x44, 0xd0, 0x35, 0x2d, 0xf2, 0xad,
-	0xc8, 0x37, 0x22, 0x67, 0x3d, 0xcf, 0x9d, 0x2a, 0x55, 0x01, 0xe7, 0xa2, 0xd1, 0x75, 0xb9, 0x44,
-	0x5d, 0x39, 0x55, 0x0e, 0x79, 0x10, 0x46, 0x3c, 0x09, 0x45, 0x64, 0xb4, 0x67, 0x02, 0x21, 0x82,
-	0x01, 0x32, 0x3e, 0x0c, 0x19, 0x8f, 0x22, 0x91, 0xa8, 0x97, 0xd2, 0xbc, 0x3d, 0xad, 0x2b, 0xdd,
-	0x57, 0x2b, 0x66, 0xb8, 0xd4, 0xc2, 0x7b, 0x0e, 0x27, 0x6f, 0x4f, 0x4b, 0x37, 0x07, 0x03, 0xf1,
-	0x84, 0x47, 0x3d, 0xec, 0xe0, 0xce, 0x08, 0x65, 0x42, 0x1b, 0x70, 0x4c, 0xc1, 0x60, 0x5c, 0x21,
-	0x55, 0x72, 0xbe, 0xdc, 0xaa, 0x7c, 0xfd, 0x54, 0x3b, 0x61, 0xbc, 0xcd, 0x7e, 0x3f, 0x46, 0x29,
-	0xef, 0x24, 0x71, 0x18, 0x05, 0x1d, 0x2b, 0xcc, 0x3c, 0x58, 0x59, 0x29, 0xe6, 0x41, 0xef, 0x2e,
-	0x9c, 0x9a, 0x07, 0x90, 0x43, 0x11, 0x49, 0xa4, 0x37, 0xa0, 0xcc, 0xed, 0x43, 0xc5, 0xb0, 0xda,
-	0x70, 0xfd, 0x9c, 0xa6, 0xfa, 0xed, 0xe9, 0xaa, 0x93, 0x19, 0xbc, 0xd7, 0x64, 0xbe, 0xb0, 0x3c,
-	0x10, 0x0d, 0x8b, 0x46, 0x43, 0x7a, 0x13, 0x20, 0x6b, 0xba, 0x4a, 0xb7, 0xda, 0x58, 0xb7, 0x34,
-	0xd3, 0x09, 0xf9, 0x7a, 0xf6, 0x96, 0xe7, 0x16, 0x0f, 0x6c, 0x2b, 0x3b, 0x33, 0x4e, 0xef, 0x1d,
-	0x81, 0xb5, 0x03, 0x58, 0x26, 0xf0, 0x16, 0x40, 0xca, 0x2f, 0x2b, 0xa4, 0x7a, 0xa8, 0x40, 0xe2,
-	0x19, 0x07, 0x6d, 0x2f, 0x60, 0xdc, 0x58, 0xca, 0xa8, 0x3f, 0xfe, 0x1b, 0xe4, 0x1b, 0x02, 0x67,
-	0xe7, 0x20, 0x5b, 0xbb, 0x6d, 0x3d, 0xe4, 0xbf, 0xd9, 0x1f, 0xff, 0xaa, 0x89, 0x1f, 0x08, 0x54,
-	0xf3, 0xf9, 0xfe, 0xb3, 0x6e, 0x36, 0xde, 0x1e, 0x86, 0x23, 0x8a, 0x96, 0x7e, 0x24, 0x50, 0x4e,
-	0x91, 0xa9, 0x9f, 0x0b, 0xb3, 0xf0, 0x44, 0x3a, 0xac, 0xb0, 0x5e, 0x43, 0x78, 0x5b, 0x2f, 0xbe,
-	0xfd, 0x7c, 0xb5, 0xb2, 0x49, 0xaf, 0xb1, 0xbc, 0x1b, 0x27, 0x8d, 0xcb, 0x9e, 0x99, 0x19, 0xed,
-	0xd9, 0x7f, 0xb8, 0x47, 0xdf, 0x13, 0x80, 0xac, 0xc3, 0xb4, 0xe8, 0xf7, 0xed, 0x39, 0x73, 0x2e,
-	0x17, 0x37, 0x18, 0xe2, 0xab, 0x8a, 0x98, 0xd1, 0xda, 0x72, 0x62, 0x39, 0x03, 0xfa, 0x99, 0xc0,
-	0xf1, 0x05, 0x5b, 0x81, 0x6e, 0x16, 0x05, 0x98, 0xdf, 0xdd, 0xce, 0xf5, 0x3f, 0x70, 0x9a, 0x0c,
-	0x75, 0x95, 0xe1, 0x12, 0xbd, 0x90, 0x9b, 0x21, 0x94, 0x72, 0x84, 0xfd, 0xac, 0xe5, 0xad, 0xe6,
-	0x97, 0xb1, 0x4b, 0xf6, 0xc7, 0x2e, 0xf9, 0x31, 0x76, 0xc9, 0xcb, 0x89, 0x5b, 0xda, 0x9f, 0xb8,
-	0xa5, 0xef, 0x13, 0xb7, 0x74, 0x6f, 0x23, 0x08, 0x93, 0x87, 0xa3, 0xae, 0xdf, 0x13, 0xdb, 0xb6,
-	0x9c, 0xfe, 0xa9, 0xc9, 0xfe, 0x23, 0xf6, 0x34, 0xad, 0xdd, 0x3d, 0xaa, 0xae, 0xf3, 0x2b, 0xbf,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x67, 0xde, 0x8f, 0xb5, 0x9b, 0x06, 0x00, 0x00,
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x3d, 0x6f, 0x13, 0x31,
+	0x18, 0xc7, 0xe3, 0x56, 0x05, 0xea, 0x6e, 0xa6, 0xd0, 0x70, 0x42, 0x97, 0x92, 0x4a, 0x2d, 0x05,
+	0xf5, 0x4c, 0x83, 0x28, 0x01, 0xa1, 0x4a, 0x89, 0x04, 0x59, 0x21, 0x20, 0x06, 0x16, 0xe4, 0x24,
+	0xee, 0x71, 0x22, 0x39, 0x5f, 0xcf, 0x0e, 0xb4, 0x42, 0x15, 0x12, 0x9f, 0xa0, 0x12, 0xac, 0x2c,
+	0x0c, 0x0c, 0xc0, 0x84, 0x58, 0xd9, 0x3b, 0x56, 0xc0, 0xc0, 0x80, 0x00, 0x25, 0x7c, 0x10, 0x74,
+	0x7e, 0xb9, 0x84, 0x24, 0x47, 0xaf, 0x85, 0xa1, 0x53, 0xce, 0xf6, 0xf3, 0x7f, 0xfc, 0x7b, 0xde,
+	0x1c, 0x38, 0x57, 0x67, 0xbc, 0xc5, 0x38, 0x5e, 0xa3, 0xd4, 0x0d, 0x89, 0x2f, 0xf0, 0xa3, 0xe5,
+	0x1a, 0x15, 0x64, 0x19, 0xaf, 0xb7, 0x69, 0xb8, 0xe9, 0x04, 0x21, 0x13, 0x0c, 0xcd, 0x28, 0x23,
+	0xc7, 0x18, 0x39, 0xda, 0xc8, 0x9a, 0x4f, 0x52, 0xc7, 0x96, 0xd2, 0x81, 0x75, 0x4e, 0xdb, 0xd5,
+	0x08, 0xa7, 0xca, 0x73, 0x6c, 0x19, 0x10, 0xd7, 0xf3, 0x89, 0xf0, 0x98, 0xaf, 0x6d, 0x4f, 0xbb,
+	0x8c, 0xb9, 0x4d, 0x8a, 0x49, 0xe0, 0x61, 0xe2, 0xfb, 0x4c, 0xc8, 0x43, 0xae, 0x4f, 0x4f, 0x29,
+	0x4f, 0xf7, 0xe5, 0x0a, 0x6b, 0x2e, 0x75, 0x34, 0xed, 0x32, 0x97, 0xa9, 0xfd, 0xe8, 0x4b, 0xef,
+	0xe6, 0xb4, 0x3b, 0xb9, 0xaa, 0xb5, 0xd7, 0xb0, 0xf0, 0x5a, 0x94, 0x0b, 0xd2, 0x0a, 0x94, 0x41,
+	0xfe, 0x29, 0x3c, 0x71, 0x2b, 0x22, 0x2a, 0x35, 0x9b, 0xec, 0x31, 0xf1, 0xeb, 0xb4, 0x4a, 0xd7,
+	0xdb, 0x94, 0x0b, 0x54, 0x80, 0x47, 0x65, 0x0c, 0x34, 0xcc, 0x82, 0x59, 0x70, 0x76, 0xb2, 0x9c,
+	0xfd, 0xf4, 0x61, 0x69, 0x5a, 0x5f, 0x59, 0x6a, 0x34, 0x42, 0xca, 0xf9, 0x6d, 0x11, 0x7a, 0xbe,
+	0x5b, 0x35, 0x86, 0x3d, 0x0d, 0xcd, 0x8e, 0xa5, 0xd3, 0xd0, 0xfc, 0x5d, 0x78, 0x72, 0x10, 0x80,
+	0x07, 0xcc, 0xe7, 0x14, 0x5d, 0x83, 0x93, 0xc4, 0x6c, 0x4a, 0x86, 0xa9, 0x82, 0xed, 0x24, 0xd4,
+	0xc2, 0xa9, 0x44, 0xab, 0x6a, 0x4f, 0x90, 0x7f, 0x01, 0x06, 0x1d, 0xf3, 0xa1, 0xd0, 0x68, 0xda,
+	0xd0, 0x28, 0xba, 0x01, 0x61, 0xaf, 0x56, 0x32, 0xba, 0xa9, 0xc2, 0xbc, 0xa1, 0x89, 0x0a, 0xeb,
+	0xa8, 0x96, 0x31, 0x3c, 0x37, 0x89, 0x6b, 0x52, 0x59, 0xed, 0x53, 0xe6, 0x5f, 0x01, 0x38, 0x33,
+	0x84, 0xa5, 0x03, 0x5e, 0x85, 0x30, 0xe6, 0xe7, 0x59, 0x30, 0x3b, 0x9e, 0x22, 0xe2, 0x3e, 0x05,
+	0xaa, 0x8c, 0x60, 0x5c, 0xd8, 0x93, 0x51, 0x5d, 0xfe, 0x07, 0xe4, 0x4b, 0x00, 0x73, 0x03, 0x90,
+	0xe5, 0xcd, 0x8a, 0x2a, 0xf2, 0xbf, 0xf4, 0xc7, 0xff, 0x4a, 0xe2, 0x5b, 0x00, 0x67, 0x93, 0xf9,
+	0x0e, 0x5b, 0x36, 0xbf, 0x01, 0x68, 0x4b, 0xda, 0xeb, 0x1b, 0x81, 0x17, 0x25, 0x64, 0xb8, 0x23,
+	0xaf, 0xc2, 0x09, 0x2e, 0x48, 0x28, 0x74, 0x9b, 0x5b, 0x8e, 0x1a, 0x5b, 0xc7, 0x8c, 0xad, 0x73,
+	0xc7, 0x8c, 0x6d, 0xf9, 0xd8, 0xce, 0xf7, 0x5c, 0x66, 0xfb, 0x47, 0x0e, 0x54, 0x95, 0x04, 0xad,
+	0xc0, 0x71, 0xea, 0x37, 0xb2, 0x63, 0xfb, 0x50, 0x46, 0x82, 0x81, 0x62, 0x8c, 0x1f, 0xb8, 0x18,
+	0x6f, 0x4c, 0xb3, 0x8c, 0x0a, 0xef, 0x90, 0xd5, 0xa2, 0xf0, 0x65, 0x02, 0x4e, 0x48, 0x58, 0xf4,
+	0x0e, 0xc0, 0xc9, 0x98, 0x14, 0x39, 0x89, 0x30, 0x23, 0x5f, 0x47, 0x0b, 0xa7, 0xb6, 0x57, 0x10,
+	0xf9, 0xd5, 0x67, 0x9f, 0x7f, 0x3d, 0x1f, 0x2b, 0xa2, 0x15, 0x9c, 0xf4, 0xa7, 0x11, 0x87, 0x8b,
+	0x9f, 0xe8, 0x79, 0xd9, 0x32, 0x5f, 0x74, 0x0b, 0xbd, 0x06, 0x10, 0xf6, 0x12, 0x8b, 0xd2, 0xde,
+	0x6f, 0x3a, 0xcc, 0xba, 0x90, 0x5e, 0xa0, 0x89, 0x2f, 0x49, 0x62, 0x8c, 0x96, 0xf6, 0x26, 0xe6,
+	0x7d, 0xa0, 0x1f, 0x01, 0x3c, 0x3e, 0x62, 0x2c, 0x51, 0x31, 0x2d, 0xc0, 0xe0, 0x4b, 0x63, 0x5d,
+	0x39, 0x80, 0x52, 0xc7, 0xb0, 0x2c, 0x63, 0x38, 0x8f, 0x16, 0x13, 0x63, 0xf0, 0x38, 0x6f, 0xd3,
+	0x46, 0x2f, 0xe5, 0xe8, 0x3d, 0x80, 0x68, 0xb8, 0x93, 0xd1, 0xe5, 0xbf, 0x43, 0x24, 0x8e, 0xb6,
+	0x55, 0xdc, 0xbf, 0x50, 0xc3, 0x2f, 0x4a, 0xf8, 0x39, 0x74, 0x26, 0x11, 0x9e, 0x6a, 0x71, 0xb9,
+	0xb4, 0xd3, 0xb1, 0xc1, 0x6e, 0xc7, 0x06, 0x3f, 0x3b, 0x36, 0xd8, 0xee, 0xda, 0x99, 0xdd, 0xae,
+	0x9d, 0xf9, 0xda, 0xb5, 0x33, 0xf7, 0x16, 0x5c, 0x4f, 0x3c, 0x68, 0xd7, 0x9c, 0x3a, 0x6b, 0x19,
+	0x37, 0xea, 0x67, 0x89, 0x37, 0x1e, 0xe2, 0x8d, 0xd8, 0x67, 0xed, 0x88, 0x7c, 0x31, 0x2e, 0xfe,
+	0x1e, 0x00, 0x14, 0xc5, 0x62, 0x66, 0x13, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -418,6 +553,10 @@ type QueryClient interface {
 	// AllowancesByGranter returns all the grants given by an address
 	// Since v0.46
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// ExpiringAllowances returns the grants expiring within a time window.
+	//
+	// Since: cosmos-sdk 0.47
+	ExpiringAllowances(ctx context.Context, in *QueryExpiringAllowancesRequest, opts ...grpc.CallOption) (*QueryExpiringAllowancesResponse, error)
 }
 
 type queryClient struct {
@@ -455,6 +594,15 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) ExpiringAllowances(ctx context.Context, in *QueryExpiringAllowancesRequest, opts ...grpc.CallOption) (*QueryExpiringAllowancesResponse, error) {
+	out := new(QueryExpiringAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/ExpiringAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Allowance returns fee granted to the grantee by the granter.
@@ -464,6 +612,10 @@ type QueryServer interface {
 	// AllowancesByGranter returns all the grants given by an address
 	// Since v0.46
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// ExpiringAllowances returns the grants expiring within a time window.
+	//
+	// Since: cosmos-sdk 0.47
+	ExpiringAllowances(context.Context, *QueryExpiringAllowancesRequest) (*QueryExpiringAllowancesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -479,6 +631,9 @@ func (*UnimplementedQueryServer) Allowances(ctx context.Context, req *QueryAllow
 func (*UnimplementedQueryServer) AllowancesByGranter(ctx context.Context, req *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (*UnimplementedQueryServer) ExpiringAllowances(ctx context.Context, req *QueryExpiringAllowancesRequest) (*QueryExpiringAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringAllowances not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -538,6 +693,24 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExpiringAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiringAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiringAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/ExpiringAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiringAllowances(ctx, req.(*QueryExpiringAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -554,6 +727,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "ExpiringAllowances",
+			Handler:    _Query_ExpiringAllowances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -813,6 +990,106 @@ func (m *QueryAllowancesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryExpiringAllowancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.End):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpiringAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -926,6 +1203,42 @@ func (m *QueryAllowancesByGranterResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryExpiringAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpiringAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1608,6 +1921,278 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryExpiringAllowancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpiringAllowancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpiringAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpiringAllowancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpiringAllowancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpiringAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, &Grant{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8,21 +8,28 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -224,41 +231,178 @@ func (m *MsgRevokeAllowanceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeAllowanceResponse proto.InternalMessageInfo
 
+// MsgUpdateAllowance updates the spend limit and expiration of the existing
+// Allowance from Granter to Grantee.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateAllowance struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the user being granted an allowance of another user's funds.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// spend_limit_increase is added to the spend limit of the allowance.
+	SpendLimitIncrease github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spend_limit_increase,json=spendLimitIncrease,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit_increase"`
+	// spend_limit_decrease is removed from the spend limit of the allowance.
+	SpendLimitDecrease github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spend_limit_decrease,json=spendLimitDecrease,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit_decrease"`
+	// expiration, if set, replaces the expiration of the allowance.
+	Expiration *time.Time `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *MsgUpdateAllowance) Reset()         { *m = MsgUpdateAllowance{} }
+func (m *MsgUpdateAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowance) ProtoMessage()    {}
+func (*MsgUpdateAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{4}
+}
+func (m *MsgUpdateAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowance.Merge(m, src)
+}
+func (m *MsgUpdateAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAllowance proto.InternalMessageInfo
+
+func (m *MsgUpdateAllowance) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *MsgUpdateAllowance) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *MsgUpdateAllowance) GetSpendLimitIncrease() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimitIncrease
+	}
+	return nil
+}
+
+func (m *MsgUpdateAllowance) GetSpendLimitDecrease() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimitDecrease
+	}
+	return nil
+}
+
+func (m *MsgUpdateAllowance) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+// MsgUpdateAllowanceResponse defines the Msg/UpdateAllowanceResponse response type.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateAllowanceResponse struct {
+}
+
+func (m *MsgUpdateAllowanceResponse) Reset()         { *m = MsgUpdateAllowanceResponse{} }
+func (m *MsgUpdateAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowanceResponse) ProtoMessage()    {}
+func (*MsgUpdateAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{5}
+}
+func (m *MsgUpdateAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowanceResponse.Merge(m, src)
+}
+func (m *MsgUpdateAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAllowanceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrantAllowance)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowance")
 	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowanceResponse")
 	proto.RegisterType((*MsgRevokeAllowance)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowance")
 	proto.RegisterType((*MsgRevokeAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowanceResponse")
+	proto.RegisterType((*MsgUpdateAllowance)(nil), "cosmos.feegrant.v1beta1.MsgUpdateAllowance")
+	proto.RegisterType((*MsgUpdateAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgUpdateAllowanceResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/feegrant/v1beta1/tx.proto", fileDescriptor_dd44ad7946dad783) }
 
 var fileDescriptor_dd44ad7946dad783 = []byte{
-	// 376 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x07,
-	0x2b, 0x4b, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xe8, 0x91, 0x92, 0x84, 0xe8, 0x89, 0x07,
-	0xf3, 0xf4, 0xa1, 0x06, 0x40, 0xa4, 0xa0, 0xc6, 0xe9, 0xe7, 0x16, 0xa7, 0xeb, 0x97, 0x19, 0x82,
-	0x28, 0x88, 0x84, 0xd2, 0x05, 0x46, 0x2e, 0x41, 0xdf, 0xe2, 0x74, 0x77, 0x90, 0x1d, 0x8e, 0x39,
-	0x39, 0xf9, 0xe5, 0x89, 0x79, 0xc9, 0xa9, 0x42, 0x46, 0x5c, 0xec, 0x60, 0x5b, 0x53, 0x8b, 0x24,
-	0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x24, 0x2e, 0x6d, 0xd1, 0x15, 0x81, 0x9a, 0xe8, 0x98, 0x92,
-	0x52, 0x94, 0x5a, 0x5c, 0x1c, 0x5c, 0x52, 0x94, 0x99, 0x97, 0x1e, 0x04, 0x53, 0x88, 0xd0, 0x93,
-	0x2a, 0xc1, 0x44, 0x9c, 0x9e, 0x54, 0x21, 0x57, 0x2e, 0xce, 0x44, 0x98, 0xa5, 0x12, 0xcc, 0x0a,
-	0x8c, 0x1a, 0xdc, 0x46, 0x22, 0x7a, 0x10, 0x0f, 0xea, 0xc1, 0x3c, 0xa8, 0xe7, 0x98, 0x57, 0xe9,
-	0x24, 0x78, 0x6a, 0x8b, 0x2e, 0xaf, 0x5b, 0x6a, 0x2a, 0xdc, 0x89, 0x9e, 0x41, 0x08, 0x9d, 0x56,
-	0x3c, 0x4d, 0xcf, 0x37, 0x68, 0xc1, 0x1c, 0xa2, 0x24, 0xcd, 0x25, 0x89, 0xe1, 0xa3, 0xa0, 0xd4,
-	0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0xa5, 0x2e, 0x46, 0x2e, 0x21, 0xdf, 0xe2, 0xf4, 0xa0, 0xd4,
-	0xb2, 0xfc, 0xec, 0x54, 0xba, 0x7b, 0x18, 0xcd, 0xa5, 0x32, 0x5c, 0x52, 0x98, 0x6e, 0x81, 0x39,
-	0xd5, 0xe8, 0x0d, 0x23, 0x17, 0xb3, 0x6f, 0x71, 0xba, 0x50, 0x01, 0x17, 0x1f, 0x5a, 0xf4, 0x68,
-	0xe9, 0xe1, 0x48, 0x1d, 0x7a, 0x18, 0x1e, 0x97, 0x32, 0x22, 0x5e, 0x2d, 0xcc, 0x66, 0xa1, 0x62,
-	0x2e, 0x7e, 0xf4, 0x00, 0xd2, 0xc6, 0x67, 0x0c, 0x9a, 0x62, 0x29, 0x63, 0x12, 0x14, 0xc3, 0x2c,
-	0x75, 0x72, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27,
-	0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xf5, 0xf4, 0xcc,
-	0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x68, 0xaa, 0x86, 0x52, 0xba, 0xc5, 0x29, 0xd9,
-	0xfa, 0x15, 0xf0, 0x5c, 0x94, 0xc4, 0x06, 0x4e, 0x33, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xc0, 0xb7, 0x32, 0x99, 0x5f, 0x03, 0x00, 0x00,
+	// 551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xe3, 0xa6, 0x80, 0x7a, 0xe5, 0x8f, 0x6a, 0x45, 0x22, 0x35, 0xc8, 0x89, 0xb2, 0x10,
+	0x15, 0xe5, 0x4c, 0x92, 0x8d, 0x89, 0x84, 0x7f, 0xaa, 0x44, 0x16, 0x03, 0x0b, 0x4b, 0xe4, 0xd8,
+	0x6f, 0x8f, 0x53, 0xe3, 0x3b, 0xcb, 0x77, 0x0d, 0xc9, 0xc0, 0xc2, 0xc8, 0xd4, 0xcf, 0xc1, 0xc4,
+	0xd0, 0x0f, 0x51, 0x75, 0x8a, 0x98, 0x98, 0x28, 0x24, 0x03, 0x5f, 0x03, 0xd9, 0xbe, 0x4b, 0x2b,
+	0x07, 0xa2, 0x76, 0xa0, 0x93, 0x13, 0xbd, 0xcf, 0x7b, 0xbf, 0xe7, 0xee, 0x7d, 0xee, 0x50, 0xd5,
+	0xe7, 0x22, 0xe4, 0xc2, 0xd9, 0x03, 0x20, 0xb1, 0xc7, 0xa4, 0x33, 0x6a, 0x0e, 0x40, 0x7a, 0x4d,
+	0x47, 0x8e, 0x71, 0x14, 0x73, 0xc9, 0xcd, 0xbb, 0x99, 0x02, 0x6b, 0x05, 0x56, 0x0a, 0xab, 0x44,
+	0x38, 0xe1, 0xa9, 0xc6, 0x49, 0x7e, 0x65, 0x72, 0x6b, 0x9b, 0x70, 0x4e, 0x86, 0xe0, 0xa4, 0xff,
+	0x06, 0x07, 0x7b, 0x8e, 0xc7, 0x26, 0xaa, 0x54, 0xc9, 0x97, 0x24, 0x0d, 0x41, 0x48, 0x2f, 0x8c,
+	0x74, 0x6f, 0x86, 0xea, 0x67, 0x8b, 0x2a, 0x6e, 0x56, 0xb2, 0x95, 0xcf, 0x81, 0x27, 0x60, 0xe1,
+	0xd1, 0xe7, 0x94, 0xa9, 0xba, 0x72, 0xe9, 0x84, 0x82, 0x38, 0xa3, 0x66, 0xf2, 0xc9, 0x0a, 0xb5,
+	0xa9, 0x81, 0xb6, 0x7a, 0x82, 0xbc, 0x4c, 0xac, 0x77, 0x86, 0x43, 0xfe, 0xc1, 0x63, 0x3e, 0x98,
+	0x2d, 0x74, 0x23, 0xdd, 0x0c, 0xc4, 0x65, 0xa3, 0x6a, 0xd4, 0x37, 0xba, 0xe5, 0x6f, 0x47, 0x8d,
+	0x92, 0x22, 0x76, 0x82, 0x20, 0x06, 0x21, 0x5e, 0xcb, 0x98, 0x32, 0xe2, 0x6a, 0xe1, 0x59, 0x0f,
+	0x94, 0xd7, 0x2e, 0xd6, 0x03, 0xe6, 0x73, 0xb4, 0xe1, 0x69, 0x68, 0xb9, 0x58, 0x35, 0xea, 0x9b,
+	0xad, 0x12, 0xce, 0x8e, 0x01, 0xeb, 0x63, 0xc0, 0x1d, 0x36, 0xe9, 0x6e, 0x9d, 0x1c, 0x35, 0x6e,
+	0xbd, 0x00, 0x58, 0x58, 0xdc, 0x75, 0xcf, 0x3a, 0x1f, 0xdf, 0xfc, 0xf4, 0xfb, 0xeb, 0x8e, 0x36,
+	0x52, 0xbb, 0x87, 0xb6, 0x97, 0x76, 0xe4, 0x82, 0x88, 0x38, 0x13, 0x50, 0xfb, 0x6c, 0x20, 0xb3,
+	0x27, 0x88, 0x0b, 0x23, 0xbe, 0x0f, 0x57, 0xbe, 0xe1, 0x9c, 0xd3, 0xfb, 0xc8, 0x5a, 0xf6, 0xb2,
+	0xb0, 0x7a, 0x52, 0x4c, 0xad, 0xbe, 0x8d, 0x02, 0x4f, 0x5e, 0xbd, 0x55, 0xf3, 0x23, 0x2a, 0x89,
+	0x08, 0x58, 0xd0, 0x1f, 0xd2, 0x90, 0xca, 0x3e, 0x65, 0x7e, 0x0c, 0x9e, 0x48, 0xc6, 0x54, 0xac,
+	0x6f, 0xb6, 0xb6, 0xb1, 0xea, 0x4e, 0x12, 0xa7, 0x33, 0x8f, 0x9f, 0x72, 0xca, 0xba, 0x8f, 0x8e,
+	0x7f, 0x54, 0x0a, 0x5f, 0x4e, 0x2b, 0x75, 0x42, 0xe5, 0xfb, 0x83, 0x01, 0xf6, 0x79, 0xa8, 0xc2,
+	0xaa, 0x3e, 0x0d, 0x11, 0xec, 0x3b, 0x72, 0x12, 0x81, 0x48, 0x1b, 0x84, 0x6b, 0xa6, 0xa0, 0x57,
+	0x09, 0x67, 0x57, 0x61, 0xf2, 0xf8, 0x00, 0x14, 0x7e, 0xfd, 0xbf, 0xe2, 0x9f, 0x29, 0x8c, 0xf9,
+	0x04, 0x21, 0x18, 0x47, 0x34, 0xf6, 0x24, 0xe5, 0xac, 0x7c, 0x2d, 0x8d, 0xa6, 0xb5, 0x14, 0xcd,
+	0x37, 0xfa, 0x86, 0x76, 0xd7, 0x0f, 0x4f, 0x2b, 0x86, 0x7b, 0xae, 0xe7, 0xaf, 0xa3, 0xce, 0xcd,
+	0x52, 0x8f, 0xba, 0xf5, 0x6b, 0x0d, 0x15, 0x7b, 0x82, 0x98, 0x11, 0xba, 0x9d, 0xbb, 0x89, 0x3b,
+	0xf8, 0x1f, 0xef, 0x0b, 0x5e, 0xca, 0xb8, 0xd5, 0xba, 0xb8, 0x56, 0x93, 0x4d, 0x81, 0xee, 0xe4,
+	0xef, 0xc2, 0xc3, 0x55, 0xcb, 0xe4, 0xc4, 0x56, 0xfb, 0x12, 0xe2, 0xf3, 0xd0, 0x7c, 0xaa, 0x57,
+	0x42, 0x73, 0x62, 0xab, 0x7d, 0x09, 0xb1, 0x86, 0x76, 0x3b, 0xc7, 0x33, 0xdb, 0x98, 0xce, 0x6c,
+	0xe3, 0xe7, 0xcc, 0x36, 0x0e, 0xe7, 0x76, 0x61, 0x3a, 0xb7, 0x0b, 0xdf, 0xe7, 0x76, 0xe1, 0xdd,
+	0x83, 0x95, 0x49, 0x19, 0x2f, 0x1e, 0xff, 0xc1, 0xf5, 0x74, 0xf0, 0xed, 0x3f, 0x03, 0x00, 0x42,
+	0x46, 0x36, 0xd4, 0x16, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -279,6 +423,11 @@ type MsgClient interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
+	// UpdateAllowance increases or decreases the spend limit and changes the
+	// expiration of an existing fee allowance in place.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateAllowance(ctx context.Context, in *MsgUpdateAllowance, opts ...grpc.CallOption) (*MsgUpdateAllowanceResponse, error)
 }
 
 type msgClient struct {
@@ -307,6 +456,15 @@ func (c *msgClient) RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance,
 	return out, nil
 }
 
+func (c *msgClient) UpdateAllowance(ctx context.Context, in *MsgUpdateAllowance, opts ...grpc.CallOption) (*MsgUpdateAllowanceResponse, error) {
+	out := new(MsgUpdateAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/UpdateAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// GrantAllowance grants fee allowance to the grantee on the granter's
@@ -315,6 +473,11 @@ type MsgServer interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
+	// UpdateAllowance increases or decreases the spend limit and changes the
+	// expiration of an existing fee allowance in place.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateAllowance(context.Context, *MsgUpdateAllowance) (*MsgUpdateAllowanceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -327,6 +490,9 @@ func (*UnimplementedMsgServer) GrantAllowance(ctx context.Context, req *MsgGrant
 func (*UnimplementedMsgServer) RevokeAllowance(ctx context.Context, req *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllowance not implemented")
 }
+func (*UnimplementedMsgServer) UpdateAllowance(ctx context.Context, req *MsgUpdateAllowance) (*MsgUpdateAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAllowance not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -368,6 +534,24 @@ func _Msg_RevokeAllowance_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/UpdateAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAllowance(ctx, req.(*MsgUpdateAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -380,6 +564,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeAllowance",
 			Handler:    _Msg_RevokeAllowance_Handler,
 		},
+		{
+			MethodName: "UpdateAllowance",
+			Handler:    _Msg_UpdateAllowance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
@@ -517,6 +705,104 @@ func (m *MsgRevokeAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SpendLimitDecrease) > 0 {
+		for iNdEx := len(m.SpendLimitDecrease) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimitDecrease[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SpendLimitIncrease) > 0 {
+		for iNdEx := len(m.SpendLimitIncrease) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimitIncrease[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -584,6 +870,48 @@ func (m *MsgRevokeAllowanceResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SpendLimitIncrease) > 0 {
+		for _, e := range m.SpendLimitIncrease {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.SpendLimitDecrease) > 0 {
+		for _, e := range m.SpendLimitDecrease {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -954,6 +1282,274 @@ func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimitIncrease", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimitIncrease = append(m.SpendLimitIncrease, types1.Coin{})
+			if err := m.SpendLimitIncrease[len(m.SpendLimitIncrease)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimitDecrease", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimitDecrease = append(m.SpendLimitDecrease, types1.Coin{})
+			if err := m.SpendLimitDecrease[len(m.SpendLimitDecrease)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
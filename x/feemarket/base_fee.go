@@ -0,0 +1,43 @@
+package feemarket
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextBaseFee returns the base fee of the block following a block which used
+// gasUsed out of maxBlockGas, following EIP-1559: the base fee increases when the
+// block used more than its target gas, maxBlockGas / ElasticityMultiplier, and
+// decreases when it used less, by at most 1 / BaseFeeChangeDenominator. The base
+// fee never goes below MinBaseFee, and is unchanged if the block gas is unlimited,
+// i.e. maxBlockGas is not positive.
+func NextBaseFee(params Params, baseFee sdk.Dec, gasUsed uint64, maxBlockGas int64) sdk.Dec {
+	if maxBlockGas <= 0 {
+		return sdk.MaxDec(baseFee, params.MinBaseFee)
+	}
+
+	target := uint64(maxBlockGas) / uint64(params.ElasticityMultiplier)
+	if target == 0 || gasUsed == target {
+		return sdk.MaxDec(baseFee, params.MinBaseFee)
+	}
+
+	var delta uint64
+	if gasUsed > target {
+		delta = gasUsed - target
+	} else {
+		delta = target - gasUsed
+	}
+
+	// change = baseFee * |gasUsed - target| / target / denominator
+	change := baseFee.
+		Mul(sdk.NewDecFromInt(sdk.NewIntFromUint64(delta))).
+		QuoInt(sdk.NewIntFromUint64(target)).
+		QuoInt64(int64(params.BaseFeeChangeDenominator))
+
+	next := baseFee.Sub(change)
+	if gasUsed > target {
+		// like in EIP-1559, the base fee increases by at least the smallest
+		// amount so that it can grow back from zero
+		next = baseFee.Add(sdk.MaxDec(change, sdk.SmallestDec()))
+	}
+	return sdk.MaxDec(next, params.MinBaseFee)
+}
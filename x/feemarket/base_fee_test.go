@@ -0,0 +1,58 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	params := feemarket.DefaultParams()
+	withMin := params
+	withMin.MinBaseFee = sdk.NewDecWithPrec(9, 1)
+
+	testCases := []struct {
+		name        string
+		params      feemarket.Params
+		baseFee     sdk.Dec
+		gasUsed     uint64
+		maxBlockGas int64
+		expected    sdk.Dec
+	}{
+		{"target gas", params, sdk.OneDec(), 500, 1000, sdk.OneDec()},
+		{"full block", params, sdk.OneDec(), 1000, 1000, sdk.NewDecWithPrec(1125, 3)},
+		{"above target", params, sdk.OneDec(), 750, 1000, sdk.NewDecWithPrec(10625, 4)},
+		{"empty block", params, sdk.OneDec(), 0, 1000, sdk.NewDecWithPrec(875, 3)},
+		{"below target", params, sdk.OneDec(), 250, 1000, sdk.NewDecWithPrec(9375, 4)},
+		{"unlimited block gas", params, sdk.OneDec(), 1000, -1, sdk.OneDec()},
+		{"grows from zero", params, sdk.ZeroDec(), 1000, 1000, sdk.SmallestDec()},
+		{"min base fee", withMin, sdk.OneDec(), 0, 1000, sdk.NewDecWithPrec(9, 1)},
+		{"below min base fee", withMin, sdk.NewDecWithPrec(5, 1), 500, 1000, sdk.NewDecWithPrec(9, 1)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			next := feemarket.NextBaseFee(tc.params, tc.baseFee, tc.gasUsed, tc.maxBlockGas)
+			require.True(t, tc.expected.Equal(next), "expected %s, got %s", tc.expected, next)
+		})
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, feemarket.ValidateGenesis(*feemarket.DefaultGenesisState()))
+
+	gs := feemarket.DefaultGenesisState()
+	gs.BaseFee = sdk.NewDec(-1)
+	require.Error(t, feemarket.ValidateGenesis(*gs))
+
+	gs = feemarket.DefaultGenesisState()
+	gs.Params.ElasticityMultiplier = 0
+	require.Error(t, feemarket.ValidateGenesis(*gs))
+
+	gs = feemarket.DefaultGenesisState()
+	gs.Params.Denom = ""
+	require.Error(t, feemarket.ValidateGenesis(*gs))
+}
@@ -0,0 +1,24 @@
+package feemarket
+
+import (
+	"fmt"
+)
+
+// ValidateGenesis check the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	if data.BaseFee.IsNil() || data.BaseFee.IsNegative() {
+		return fmt.Errorf("base fee must be non-negative: %s", data.BaseFee)
+	}
+	return nil
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:  DefaultParams(),
+		BaseFee: DefaultBaseFee,
+	}
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+// EndBlocker burns, or sends to the base fee recipient, the base fees paid by the
+// txs of the block, i.e. the base fee times the gas they used, and sets the base
+// fee of the next block from the gas used by the block. It does nothing when the
+// fee market is disabled.
+func (k Keeper) EndBlocker(ctx sdk.Context) error {
+	defer telemetry.ModuleMeasureSince(feemarket.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		return nil
+	}
+
+	var gasUsed uint64
+	if meter := ctx.BlockGasMeter(); meter != nil {
+		gasUsed = meter.GasConsumedToLimit()
+	}
+	maxBlockGas := int64(-1)
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil {
+		maxBlockGas = cp.Block.MaxGas
+	}
+
+	baseFee := k.GetBaseFee(ctx)
+	baseFees, err := k.collectBaseFees(ctx, params.Denom, baseFee, gasUsed)
+	if err != nil {
+		return err
+	}
+
+	next := feemarket.NextBaseFee(params, baseFee, gasUsed, maxBlockGas)
+	k.SetBaseFee(ctx, next)
+
+	return ctx.EventManager().EmitTypedEvent(&feemarket.EventUpdateBaseFee{
+		BaseFee:      next.String(),
+		BlockGasUsed: gasUsed,
+		BaseFees:     baseFees.String(),
+	})
+}
+
+// collectBaseFees takes the base fees of the block from the fee collector, and
+// burns them or sends them to the base fee recipient. The txs paid at least the
+// base fee for their gas limit, the base fees are capped to the balance of the fee
+// collector nonetheless.
+func (k Keeper) collectBaseFees(ctx sdk.Context, denom string, baseFee sdk.Dec, gasUsed uint64) (sdk.Coins, error) {
+	amount := baseFee.MulInt(sdk.NewIntFromUint64(gasUsed)).TruncateInt()
+	balance := k.bk.GetBalance(ctx, k.ak.GetModuleAddress(k.feeCollectorName), denom)
+	amount = sdk.MinInt(amount, balance.Amount)
+	if !amount.IsPositive() {
+		return sdk.NewCoins(), nil
+	}
+
+	baseFees := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	if k.baseFeeRecipient != "" {
+		return baseFees, k.bk.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, k.baseFeeRecipient, baseFees)
+	}
+
+	if err := k.bk.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, feemarket.ModuleName, baseFees); err != nil {
+		return nil, err
+	}
+	return baseFees, k.bk.BurnCoins(ctx, feemarket.ModuleName, baseFees)
+}
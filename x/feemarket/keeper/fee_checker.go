@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+)
+
+var _ middleware.TxFeeChecker = Keeper{}.CheckTxFee
+
+// CheckTxFee implements middleware.TxFeeChecker, where the minimum gas price of the
+// txs is the base fee, in CheckTx as well as in DeliverTx. In CheckTx, the minimum
+// gas price of the validator in the base fee denom applies instead if it is higher.
+// The fee must be paid in the base fee denom, and the tx priority is the tip per
+// unit of gas, i.e. the gas price paid above the base fee.
+//
+// When the fee market is disabled, and for the gentxs delivered at genesis, it
+// falls back to middleware.CheckTxFeeWithValidatorMinGasPrices. The params are
+// read on an infinite gas meter, so that the txs don't pay for the fee market
+// when it is disabled.
+func (k Keeper) CheckTxFee(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	params := k.GetParams(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+	if !params.Enabled || ctx.BlockHeight() == 0 {
+		return middleware.CheckTxFeeWithValidatorMinGasPrices(ctx, tx)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	baseFee := k.GetBaseFee(ctx)
+	// the minimum gas prices are only set in CheckTx
+	minGasPrice := sdk.MaxDec(baseFee, ctx.MinGasPrices().AmountOf(params.Denom))
+
+	fee := feeTx.GetFee()
+	gas := sdk.NewIntFromUint64(feeTx.GetGas())
+	required := sdk.NewCoin(params.Denom, minGasPrice.MulInt(gas).Ceil().RoundInt())
+	paid := fee.AmountOf(params.Denom)
+	if paid.LT(required.Amount) {
+		return nil, 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", fee, required)
+	}
+
+	return fee, tipPriority(paid, baseFee, gas), nil
+}
+
+// tipPriority returns the tip per unit of gas of a tx paying paid for gas, in the
+// smallest unit of the base fee denom.
+func tipPriority(paid sdk.Int, baseFee sdk.Dec, gas sdk.Int) int64 {
+	if gas.IsZero() {
+		return 0
+	}
+
+	tip := sdk.NewDecFromInt(paid).QuoInt(gas).Sub(baseFee).TruncateInt()
+	switch {
+	case !tip.IsPositive():
+		return 0
+	case !tip.IsInt64():
+		return math.MaxInt64
+	default:
+		return tip.Int64()
+	}
+}
@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+// InitGenesis new feemarket genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *feemarket.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	k.SetBaseFee(ctx, data.BaseFee)
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *feemarket.GenesisState {
+	return &feemarket.GenesisState{
+		Params:  k.GetParams(ctx),
+		BaseFee: k.GetBaseFee(ctx),
+	}
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+var _ feemarket.QueryServer = Keeper{}
+
+// Params returns the parameters of the module
+func (k Keeper) Params(goCtx context.Context, r *feemarket.QueryParamsRequest) (*feemarket.QueryParamsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &feemarket.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// BaseFee returns the current base fee, zero if the fee market is disabled
+func (k Keeper) BaseFee(goCtx context.Context, r *feemarket.QueryBaseFeeRequest) (*feemarket.QueryBaseFeeResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	baseFee := sdk.ZeroDec()
+	if params.Enabled {
+		baseFee = k.GetBaseFee(ctx)
+	}
+	return &feemarket.QueryBaseFeeResponse{BaseFee: sdk.NewDecCoinFromDec(params.Denom, baseFee)}, nil
+}
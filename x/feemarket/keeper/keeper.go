@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc              codec.BinaryCodec
+	storeKey         storetypes.StoreKey
+	ak               feemarket.AccountKeeper
+	bk               feemarket.BankKeeper
+	feeCollectorName string
+
+	// the module account receiving the base fees paid by the txs, they are burnt
+	// if empty
+	baseFeeRecipient string
+
+	// the address capable of updating the module parameters. Usually the gov module
+	// account
+	authority string
+}
+
+// NewKeeper creates a new feemarket Keeper instance. The base fees are burnt by
+// the feemarket module account, which must have the burner permission, unless
+// baseFeeRecipient is the name of the module account receiving them.
+func NewKeeper(key storetypes.StoreKey, cdc codec.BinaryCodec, ak feemarket.AccountKeeper, bk feemarket.BankKeeper,
+	feeCollectorName, baseFeeRecipient, authority string,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(err)
+	}
+
+	// ensure the module account burning or receiving the base fees is set
+	recipient := baseFeeRecipient
+	if recipient == "" {
+		recipient = feemarket.ModuleName
+	}
+	if addr := ak.GetModuleAddress(recipient); addr == nil {
+		panic(fmt.Sprintf("the %s module account has not been set", recipient))
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		ak:               ak,
+		bk:               bk,
+		feeCollectorName: feeCollectorName,
+		baseFeeRecipient: baseFeeRecipient,
+		authority:        authority,
+	}
+}
+
+// GetAuthority returns the address of the feemarket module authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+feemarket.ModuleName)
+}
+
+// GetParams returns the parameters of the module
+func (k Keeper) GetParams(ctx sdk.Context) feemarket.Params {
+	var params feemarket.Params
+	bz := ctx.KVStore(k.storeKey).Get(ParamsKey)
+	if len(bz) == 0 {
+		return params
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the parameters of the module
+func (k Keeper) SetParams(ctx sdk.Context, params feemarket.Params) {
+	ctx.KVStore(k.storeKey).Set(ParamsKey, k.cdc.MustMarshal(&params))
+}
+
+// GetBaseFee returns the base fee of the current block, per unit of gas
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(BaseFeeKey)
+	if len(bz) == 0 {
+		return sdk.ZeroDec()
+	}
+
+	var baseFee sdk.Dec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return baseFee
+}
+
+// SetBaseFee sets the base fee of the next block, per unit of gas
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(BaseFeeKey, bz)
+}
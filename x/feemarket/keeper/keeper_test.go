@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	msgServer   feemarket.MsgServer
+	queryClient feemarket.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	feemarket.RegisterQueryServer(queryHelper, app.FeeMarketKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.msgServer = keeper.NewMsgServerImpl(app.FeeMarketKeeper)
+	s.queryClient = feemarket.NewQueryClient(queryHelper)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+// enable enables the fee market with the given base fee
+func (s *TestSuite) enable(baseFee sdk.Dec) {
+	params := feemarket.DefaultParams()
+	params.Enabled = true
+	_, err := s.msgServer.UpdateParams(s.ctx, &feemarket.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:    params,
+	})
+	s.Require().NoError(err)
+	s.app.FeeMarketKeeper.SetBaseFee(s.ctx, baseFee)
+}
+
+func (s *TestSuite) newTx(fee sdk.Coins, gas uint64) sdk.Tx {
+	txBuilder := simapp.MakeTestEncodingConfig().TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(sdk.AccAddress("addr________________"))))
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(gas)
+	return txBuilder.GetTx()
+}
+
+func (s *TestSuite) TestUpdateParams() {
+	require := s.Require()
+
+	params := feemarket.DefaultParams()
+	params.Enabled = true
+	_, err := s.msgServer.UpdateParams(s.ctx, &feemarket.MsgUpdateParams{
+		Authority: sdk.AccAddress("addr________________").String(),
+		Params:    params,
+	})
+	require.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	res, err := s.queryClient.Params(s.ctx, &feemarket.QueryParamsRequest{})
+	require.NoError(err)
+	require.False(res.Params.Enabled)
+
+	// the base fee is zero while the fee market is disabled
+	baseFee, err := s.queryClient.BaseFee(s.ctx, &feemarket.QueryBaseFeeRequest{})
+	require.NoError(err)
+	require.Equal(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.ZeroInt()), baseFee.BaseFee)
+
+	s.enable(sdk.NewDecWithPrec(5, 1))
+	res, err = s.queryClient.Params(s.ctx, &feemarket.QueryParamsRequest{})
+	require.NoError(err)
+	require.Equal(params, res.Params)
+
+	baseFee, err = s.queryClient.BaseFee(s.ctx, &feemarket.QueryBaseFeeRequest{})
+	require.NoError(err)
+	require.Equal(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(5, 1)), baseFee.BaseFee)
+}
+
+func (s *TestSuite) TestCheckTxFee() {
+	require := s.Require()
+	k := s.app.FeeMarketKeeper
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)) }
+
+	// the validator min gas prices apply while the fee market is disabled, the
+	// txs not paying any gas for it
+	ctx := s.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, _, err := k.CheckTxFee(ctx, s.newTx(nil, 1000))
+	require.NoError(err)
+	require.Zero(ctx.GasMeter().GasConsumed())
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(2)))
+	_, _, err = k.CheckTxFee(s.ctx.WithMinGasPrices(minGasPrices), s.newTx(stake(1000), 1000))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	s.enable(sdk.OneDec())
+
+	// the txs must pay the base fee for their gas limit
+	_, _, err = k.CheckTxFee(s.ctx, s.newTx(stake(999), 1000))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	_, _, err = k.CheckTxFee(s.ctx, s.newTx(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)), 1000))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	fee, priority, err := k.CheckTxFee(s.ctx, s.newTx(stake(1000), 1000))
+	require.NoError(err)
+	require.Equal(stake(1000), fee)
+	require.Equal(int64(0), priority)
+
+	// the priority is the tip per unit of gas
+	_, priority, err = k.CheckTxFee(s.ctx, s.newTx(stake(3500), 1000))
+	require.NoError(err)
+	require.Equal(int64(2), priority)
+
+	// a higher validator min gas price applies in CheckTx
+	_, _, err = k.CheckTxFee(s.ctx.WithMinGasPrices(minGasPrices), s.newTx(stake(1500), 1000))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	_, priority, err = k.CheckTxFee(s.ctx.WithMinGasPrices(minGasPrices), s.newTx(stake(2000), 1000))
+	require.NoError(err)
+	require.Equal(int64(1), priority)
+
+	// the gentxs are delivered without fees at genesis
+	_, _, err = k.CheckTxFee(s.ctx.WithBlockHeight(0), s.newTx(nil, 1000))
+	require.NoError(err)
+}
+
+func (s *TestSuite) TestEndBlocker() {
+	require := s.Require()
+	k := s.app.FeeMarketKeeper
+
+	// the base fee doesn't change while the fee market is disabled
+	require.NoError(k.EndBlocker(s.ctx))
+	require.Equal(feemarket.DefaultBaseFee, k.GetBaseFee(s.ctx))
+
+	s.enable(sdk.OneDec())
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	require.NoError(banktestutil.FundModuleAccount(s.app.BankKeeper, s.ctx, authtypes.FeeCollectorName, fees))
+	supply := s.app.BankKeeper.GetSupply(s.ctx, sdk.DefaultBondDenom)
+
+	blockGasMeter := sdk.NewGasMeter(1000)
+	blockGasMeter.ConsumeGas(750, "txs")
+	ctx := s.ctx.
+		WithBlockGasMeter(blockGasMeter).
+		WithConsensusParams(&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 1000}}).
+		WithEventManager(sdk.NewEventManager())
+	require.NoError(k.EndBlocker(ctx))
+
+	// the base fees of the gas used are burnt
+	collector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(int64(250), s.app.BankKeeper.GetBalance(ctx, collector, sdk.DefaultBondDenom).Amount.Int64())
+	require.Equal(supply.Amount.SubRaw(750), s.app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount)
+	require.Equal(sdk.NewDecWithPrec(10625, 4), k.GetBaseFee(ctx))
+
+	events := ctx.EventManager().ABCIEvents()
+	require.Equal("cosmos.feemarket.v1beta1.EventUpdateBaseFee", events[len(events)-1].Type)
+
+	// the base fees are capped to the fees collected
+	blockGasMeter = sdk.NewGasMeter(1000)
+	blockGasMeter.ConsumeGas(1000, "txs")
+	require.NoError(k.EndBlocker(ctx.WithBlockGasMeter(blockGasMeter)))
+	require.True(s.app.BankKeeper.GetBalance(ctx, collector, sdk.DefaultBondDenom).IsZero())
+	require.Equal(supply.Amount.SubRaw(1000), s.app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount)
+}
+
+func (s *TestSuite) TestGenesis() {
+	require := s.Require()
+
+	s.enable(sdk.NewDecWithPrec(25, 2))
+	exported := s.app.FeeMarketKeeper.ExportGenesis(s.ctx)
+	require.True(exported.Params.Enabled)
+	require.Equal(sdk.NewDecWithPrec(25, 2), exported.BaseFee)
+
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.FeeMarketKeeper.InitGenesis(ctx, exported)
+	require.Equal(exported, app.FeeMarketKeeper.ExportGenesis(ctx))
+}
+
@@ -0,0 +1,6 @@
+package keeper
+
+var (
+	ParamsKey  = []byte{0x01}
+	BaseFeeKey = []byte{0x02}
+)
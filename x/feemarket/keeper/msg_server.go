@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ feemarket.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the feemarket MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) feemarket.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// UpdateParams updates the module parameters
+func (k msgServer) UpdateParams(goCtx context.Context, msg *feemarket.MsgUpdateParams) (*feemarket.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+	return &feemarket.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,40 @@
+package feemarket
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultBaseFee is the default base fee of the genesis block, per unit of gas
+var DefaultBaseFee = sdk.NewDecWithPrec(1, 3)
+
+// DefaultParams returns the default parameters of the module. The fee market is
+// disabled by default, so that chains opt in by enabling it in genesis or through
+// governance.
+func DefaultParams() Params {
+	return Params{
+		Enabled:                  false,
+		Denom:                    sdk.DefaultBondDenom,
+		MinBaseFee:               sdk.ZeroDec(),
+		BaseFeeChangeDenominator: 8,
+		ElasticityMultiplier:     2,
+	}
+}
+
+// Validate performs a basic validation of the parameters
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return fmt.Errorf("invalid base fee denom: %w", err)
+	}
+	if p.MinBaseFee.IsNil() || p.MinBaseFee.IsNegative() {
+		return fmt.Errorf("min base fee must be non-negative: %s", p.MinBaseFee)
+	}
+	if p.BaseFeeChangeDenominator == 0 {
+		return fmt.Errorf("base fee change denominator must be positive")
+	}
+	if p.ElasticityMultiplier == 0 {
+		return fmt.Errorf("elasticity multiplier must be positive")
+	}
+	return nil
+}
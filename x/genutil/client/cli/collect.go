@@ -2,6 +2,8 @@ package cli
 
 import (
 	"encoding/json"
+	"fmt"
+	"os"
 	"path/filepath"
 
 	"github.com/pkg/errors"
@@ -15,13 +17,23 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
-const flagGenTxDir = "gentx-dir"
+const (
+	flagGenTxDir    = "gentx-dir"
+	flagReportFile  = "report-file"
+	flagSkipInvalid = "skip-invalid"
+)
 
 // CollectGenTxsCmd - return the cobra command to collect genesis transactions
 func CollectGenTxsCmd(genBalIterator types.GenesisBalancesIterator, defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "collect-gentxs",
 		Short: "Collect genesis txs and output a genesis.json file",
+		Long: `Collect the genesis txs and output a genesis.json file. The genesis txs are
+validated against the genesis balances and ordered by validator operator address.
+
+The command fails if any genesis tx is rejected, unless --skip-invalid is set, in
+which case only the valid ones are collected. The JSON report of the accepted and
+rejected genesis txs is written to --report-file if set.`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			serverCtx := server.GetServerContextFromCmd(cmd)
 			config := serverCtx.Config
@@ -50,9 +62,32 @@ func CollectGenTxsCmd(genBalIterator types.GenesisBalancesIterator, defaultNodeH
 			toPrint := newPrintInfo(config.Moniker, genDoc.ChainID, nodeID, genTxsDir, json.RawMessage(""))
 			initCfg := types.NewInitConfig(genDoc.ChainID, genTxsDir, nodeID, valPubKey)
 
-			appMessage, err := genutil.GenAppStateFromConfig(cdc,
-				clientCtx.TxConfig,
-				config, initCfg, *genDoc, genBalIterator)
+			appGenTxs, persistentPeers, report, err := genutil.CollectGenTxs(
+				cdc, clientCtx.TxConfig.TxJSONDecoder(), config.Moniker, initCfg.GenTxsDir, *genDoc, genBalIterator,
+			)
+			if err != nil {
+				return errors.Wrap(err, "failed to collect genesis txs")
+			}
+
+			if reportFile, _ := cmd.Flags().GetString(flagReportFile); reportFile != "" {
+				bz, err := json.MarshalIndent(report, "", "  ")
+				if err != nil {
+					return err
+				}
+
+				if err := os.WriteFile(reportFile, bz, 0o644); err != nil {
+					return errors.Wrap(err, "failed to write the genesis txs report")
+				}
+			}
+
+			if skipInvalid, _ := cmd.Flags().GetBool(flagSkipInvalid); len(report.Rejected) > 0 && !skipInvalid {
+				rejected := report.Rejected[0]
+				return fmt.Errorf(
+					"%d invalid genesis txs, the first one being %s: %s", len(report.Rejected), rejected.File, rejected.Reason,
+				)
+			}
+
+			appMessage, err := genutil.GenAppStateFromGenTxs(cdc, clientCtx.TxConfig, config, *genDoc, appGenTxs, persistentPeers)
 			if err != nil {
 				return errors.Wrap(err, "failed to get genesis app state from config")
 			}
@@ -65,6 +100,8 @@ func CollectGenTxsCmd(genBalIterator types.GenesisBalancesIterator, defaultNodeH
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.Flags().String(flagGenTxDir, "", "override default \"gentx\" directory from which collect and execute genesis transactions; default [--home]/config/gentx/")
+	cmd.Flags().String(flagReportFile, "", "Write the JSON report of the accepted and rejected genesis transactions to the given file")
+	cmd.Flags().Bool(flagSkipInvalid, false, "Collect the valid genesis transactions only, instead of failing on the invalid ones")
 
 	return cmd
 }
@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"runtime"
 	"sort"
 	"strings"
 
@@ -36,6 +35,15 @@ func GenAppStateFromConfig(cdc codec.JSONCodec, txEncodingConfig client.TxEncodi
 		return appState, err
 	}
 
+	return GenAppStateFromGenTxs(cdc, txEncodingConfig, config, genDoc, appGenTxs, persistentPeers)
+}
+
+// GenAppStateFromGenTxs sets the collected genesis txs in the genesis app
+// state and the persistent peers in the config, and writes both the config and
+// the genesis file.
+func GenAppStateFromGenTxs(cdc codec.JSONCodec, txEncodingConfig client.TxEncodingConfig,
+	config *cfg.Config, genDoc tmtypes.GenesisDoc, appGenTxs []sdk.Tx, persistentPeers string,
+) (appState json.RawMessage, err error) {
 	config.P2P.PersistentPeers = persistentPeers
 	cfg.WriteConfigFile(config.RootDir, config)
 
@@ -66,22 +74,86 @@ func GenAppStateFromConfig(cdc codec.JSONCodec, txEncodingConfig client.TxEncodi
 	return appState, err
 }
 
+// GenTxReportEntry is a genesis tx of a GenTxsReport.
+type GenTxReportEntry struct {
+	// File is the name of the file of the genesis tx in the gentxs directory.
+	File string `json:"file"`
+	// ValidatorAddress and Moniker are the operator address and the moniker
+	// of the validator created, empty if the genesis tx can't be decoded.
+	ValidatorAddress string `json:"validator_address,omitempty"`
+	Moniker          string `json:"moniker,omitempty"`
+	// Reason is the reason why the genesis tx is rejected.
+	Reason string `json:"reason,omitempty"`
+}
+
+// GenTxsReport is the machine-readable report of the genesis txs collected by
+// CollectGenTxs.
+type GenTxsReport struct {
+	// Accepted are the genesis txs collected, in their genesis order.
+	Accepted []GenTxReportEntry `json:"accepted"`
+	// Rejected are the genesis txs rejected, by file name.
+	Rejected []GenTxReportEntry `json:"rejected"`
+}
+
 // CollectTxs processes and validates application's genesis Txs and returns
 // the list of appGenTxs, and persistent peers required to generate genesis.json.
+// It fails on the first genesis tx rejected by CollectGenTxs.
 func CollectTxs(cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, moniker, genTxsDir string,
 	genDoc tmtypes.GenesisDoc, genBalIterator types.GenesisBalancesIterator,
 ) (appGenTxs []sdk.Tx, persistentPeers string, err error) {
+	appGenTxs, persistentPeers, report, err := CollectGenTxs(cdc, txJSONDecoder, moniker, genTxsDir, genDoc, genBalIterator)
+	if err != nil {
+		return appGenTxs, persistentPeers, err
+	}
+
+	if len(report.Rejected) > 0 {
+		rejected := report.Rejected[0]
+		return appGenTxs, persistentPeers, fmt.Errorf("invalid genesis tx %s: %s", rejected.File, rejected.Reason)
+	}
+
+	return appGenTxs, persistentPeers, nil
+}
+
+// genTxCandidate is a decoded genesis tx being validated by CollectGenTxs.
+type genTxCandidate struct {
+	file string
+	tx   sdk.Tx
+	msg  *stakingtypes.MsgCreateValidator
+	memo string
+}
+
+// CollectGenTxs processes and validates the genesis txs of genTxsDir, and
+// returns the valid ones, the persistent peers required to generate
+// genesis.json, and the report of the accepted and rejected genesis txs. The
+// genesis txs are ordered by validator operator address, independently of
+// their file names. A genesis tx is rejected if:
+//
+//   - it can't be decoded, doesn't hold a single valid MsgCreateValidator, or
+//     has no memo holding the node's address and IP,
+//   - it creates a validator, or uses a consensus key, already created, or used,
+//     by a preceding genesis tx,
+//   - the self-delegation isn't in the bond denom of the genesis staking params,
+//   - the delegator or the validator operator has no balance in the genesis
+//     state, or the delegator balance left by the preceding genesis txs doesn't
+//     cover the self-delegation.
+//
+// An error is only returned if the genesis state or the genesis txs can't be
+// read.
+func CollectGenTxs(cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, moniker, genTxsDir string,
+	genDoc tmtypes.GenesisDoc, genBalIterator types.GenesisBalancesIterator,
+) (appGenTxs []sdk.Tx, persistentPeers string, report GenTxsReport, err error) {
+	report = GenTxsReport{Accepted: []GenTxReportEntry{}, Rejected: []GenTxReportEntry{}}
+
 	// prepare a map of all balances in genesis state to then validate
 	// against the validators addresses
 	var appState map[string]json.RawMessage
 	if err := json.Unmarshal(genDoc.AppState, &appState); err != nil {
-		return appGenTxs, persistentPeers, err
+		return appGenTxs, persistentPeers, report, err
 	}
 
-	var fos []os.DirEntry
-	fos, err = os.ReadDir(genTxsDir)
+	fos, err := os.ReadDir(genTxsDir)
 	if err != nil {
-		return appGenTxs, persistentPeers, err
+		return appGenTxs, persistentPeers, report, err
 	}
 
 	balancesMap := make(map[string]bankexported.GenesisBalance)
@@ -94,9 +166,9 @@ func CollectTxs(cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, moniker, genTx
 		},
 	)
 
-	// addresses and IPs (and port) validator server info
-	var addressesIPs []string
+	bondDenom := stakingtypes.GetGenesisStateFromAppState(cdc, appState).Params.BondDenom
 
+	var candidates []genTxCandidate
 	for _, fo := range fos {
 		if fo.IsDir() {
 			continue
@@ -108,76 +180,143 @@ func CollectTxs(cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, moniker, genTx
 		// get the genTx
 		jsonRawTx, err := os.ReadFile(filepath.Join(genTxsDir, fo.Name()))
 		if err != nil {
-			return appGenTxs, persistentPeers, err
+			return appGenTxs, persistentPeers, report, err
 		}
 
 		genTx, err := types.ValidateAndGetGenTx(jsonRawTx, txJSONDecoder)
 		if err != nil {
-			return appGenTxs, persistentPeers, err
+			report.Rejected = append(report.Rejected, GenTxReportEntry{File: fo.Name(), Reason: err.Error()})
+			continue
 		}
 
-		appGenTxs = append(appGenTxs, genTx)
+		// genesis transactions must be single-message
+		msg := genTx.GetMsgs()[0].(*stakingtypes.MsgCreateValidator)
+		candidate := genTxCandidate{file: fo.Name(), tx: genTx, msg: msg}
 
 		// the memo flag is used to store
 		// the ip and node-id, for example this may be:
 		// "528fd3df22b31f4969b05652bfe8f0fe921321d5@192.168.2.37:26656"
-
 		memoTx, ok := genTx.(sdk.TxWithMemo)
 		if !ok {
-			return appGenTxs, persistentPeers, fmt.Errorf("expected TxWithMemo, got %T", genTx)
+			report.Rejected = append(report.Rejected, candidate.entry(fmt.Sprintf("expected TxWithMemo, got %T", genTx)))
+			continue
 		}
-		nodeAddrIP := memoTx.GetMemo()
-		if len(nodeAddrIP) == 0 {
-			return appGenTxs, persistentPeers, fmt.Errorf("failed to find node's address and IP in %s", fo.Name())
+		candidate.memo = memoTx.GetMemo()
+		if len(candidate.memo) == 0 {
+			report.Rejected = append(report.Rejected, candidate.entry("failed to find node's address and IP in the memo"))
+			continue
 		}
 
-		// genesis transactions must be single-message
-		msgs := genTx.GetMsgs()
+		candidates = append(candidates, candidate)
+	}
 
-		// TODO abstract out staking message validation back to staking
-		msg := msgs[0].(*stakingtypes.MsgCreateValidator)
+	// order the genesis txs deterministically, the file names being chosen by
+	// their authors
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].msg.ValidatorAddress < candidates[j].msg.ValidatorAddress
+	})
 
-		// validate delegator and validator addresses and funds against the accounts in the state
-		delAddr := msg.DelegatorAddress
-		valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
-		if err != nil {
-			return appGenTxs, persistentPeers, err
-		}
+	// the balances left to the delegators by the preceding genesis txs
+	spent := make(map[string]sdk.Int)
+	validators := make(map[string]bool)
+	consPubKeys := make(map[string]bool)
 
-		delBal, delOk := balancesMap[delAddr]
-		if !delOk {
-			_, file, no, ok := runtime.Caller(1)
-			if ok {
-				fmt.Printf("CollectTxs-1, called from %s#%d\n", file, no)
-			}
+	// addresses and IPs (and port) validator server info
+	var addressesIPs []string
 
-			return appGenTxs, persistentPeers, fmt.Errorf("account %s balance not in genesis state: %+v", delAddr, balancesMap)
+	for _, candidate := range candidates {
+		msg := candidate.msg
+		consPubKey := string(msg.Pubkey.GetValue())
+
+		var err error
+		switch {
+		case validators[msg.ValidatorAddress]:
+			err = fmt.Errorf("duplicate validator %s", msg.ValidatorAddress)
+		case consPubKeys[consPubKey]:
+			err = errors.New("duplicate consensus public key")
+		default:
+			err = validateGenTxFunds(msg, balancesMap, spent, bondDenom)
 		}
-
-		_, valOk := balancesMap[sdk.AccAddress(valAddr).String()]
-		if !valOk {
-			_, file, no, ok := runtime.Caller(1)
-			if ok {
-				fmt.Printf("CollectTxs-2, called from %s#%d - %s\n", file, no, sdk.AccAddress(msg.ValidatorAddress).String())
-			}
-			return appGenTxs, persistentPeers, fmt.Errorf("account %s balance not in genesis state: %+v", valAddr, balancesMap)
+		if err != nil {
+			report.Rejected = append(report.Rejected, candidate.entry(err.Error()))
+			continue
 		}
 
-		if delBal.GetCoins().AmountOf(msg.Value.Denom).LT(msg.Value.Amount) {
-			return appGenTxs, persistentPeers, fmt.Errorf(
-				"insufficient fund for delegation %v: %v < %v",
-				delBal.GetAddress().String(), delBal.GetCoins().AmountOf(msg.Value.Denom), msg.Value.Amount,
-			)
-		}
+		validators[msg.ValidatorAddress] = true
+		consPubKeys[consPubKey] = true
+		spent[msg.DelegatorAddress] = spentAmount(spent, msg.DelegatorAddress).Add(msg.Value.Amount)
+
+		appGenTxs = append(appGenTxs, candidate.tx)
+		report.Accepted = append(report.Accepted, candidate.entry(""))
 
 		// exclude itself from persistent peers
 		if msg.Description.Moniker != moniker {
-			addressesIPs = append(addressesIPs, nodeAddrIP)
+			addressesIPs = append(addressesIPs, candidate.memo)
 		}
 	}
 
+	sort.SliceStable(report.Rejected, func(i, j int) bool {
+		return report.Rejected[i].File < report.Rejected[j].File
+	})
+
 	sort.Strings(addressesIPs)
 	persistentPeers = strings.Join(addressesIPs, ",")
 
-	return appGenTxs, persistentPeers, nil
+	return appGenTxs, persistentPeers, report, nil
+}
+
+// entry returns the report entry of the genesis tx, rejected for reason if not
+// empty.
+func (c genTxCandidate) entry(reason string) GenTxReportEntry {
+	return GenTxReportEntry{
+		File:             c.file,
+		ValidatorAddress: c.msg.ValidatorAddress,
+		Moniker:          c.msg.Description.Moniker,
+		Reason:           reason,
+	}
+}
+
+// validateGenTxFunds checks the self-delegation of a genesis tx against the
+// genesis balances, less the amounts spent by the preceding genesis txs.
+func validateGenTxFunds(msg *stakingtypes.MsgCreateValidator, balancesMap map[string]bankexported.GenesisBalance,
+	spent map[string]sdk.Int, bondDenom string,
+) error {
+	if msg.Value.Denom != bondDenom {
+		return fmt.Errorf("invalid self-delegation denom %s, expected the bond denom %s", msg.Value.Denom, bondDenom)
+	}
+
+	// validate delegator and validator addresses and funds against the accounts in the state
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	delBal, ok := balancesMap[msg.DelegatorAddress]
+	if !ok {
+		return fmt.Errorf("account %s balance not in genesis state", msg.DelegatorAddress)
+	}
+
+	if _, ok := balancesMap[sdk.AccAddress(valAddr).String()]; !ok {
+		return fmt.Errorf("account %s balance not in genesis state", sdk.AccAddress(valAddr))
+	}
+
+	available := delBal.GetCoins().AmountOf(bondDenom).Sub(spentAmount(spent, msg.DelegatorAddress))
+	if available.LT(msg.Value.Amount) {
+		return fmt.Errorf(
+			"insufficient fund for delegation %v: %v < %v",
+			msg.DelegatorAddress, available, msg.Value.Amount,
+		)
+	}
+
+	return nil
+}
+
+// spentAmount returns the amount spent by the delegator in the preceding
+// genesis txs.
+func spentAmount(spent map[string]sdk.Int, delAddr string) sdk.Int {
+	if amount, ok := spent[delAddr]; ok {
+		return amount
+	}
+
+	return sdk.ZeroInt()
 }
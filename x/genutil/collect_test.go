@@ -4,19 +4,25 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"sort"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
-
+	"github.com/stretchr/testify/require"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/types"
 	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	gtypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type doNothingUnmarshalJSON struct {
@@ -65,3 +71,96 @@ func TestCollectTxsHandlesDirectories(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestCollectGenTxs(t *testing.T) {
+	encodingConfig := simapp.MakeTestEncodingConfig()
+	testDir := t.TempDir()
+
+	accAddrs := []types.AccAddress{types.AccAddress("addr1_______________"), types.AccAddress("addr2_______________"), types.AccAddress("addr3_______________")}
+	consPubKeys := []cryptotypes.PubKey{ed25519.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey()}
+
+	// the first two accounts are funded with 100stake, and the third one with
+	// 1stake only
+	bankGenesis := banktypes.DefaultGenesisState()
+	for i, addr := range accAddrs {
+		amount := int64(100)
+		if i == 2 {
+			amount = 1
+		}
+		bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+			Address: addr.String(),
+			Coins:   types.NewCoins(types.NewInt64Coin(types.DefaultBondDenom, amount)),
+		})
+	}
+	appState, err := json.Marshal(map[string]json.RawMessage{
+		banktypes.ModuleName:    encodingConfig.Codec.MustMarshalJSON(bankGenesis),
+		stakingtypes.ModuleName: encodingConfig.Codec.MustMarshalJSON(stakingtypes.DefaultGenesisState()),
+	})
+	require.NoError(t, err)
+
+	writeGenTx := func(file string, valAddr types.ValAddress, consPubKey cryptotypes.PubKey, value types.Coin, moniker, memo string) {
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			valAddr, consPubKey, value, stakingtypes.NewDescription(moniker, "", "", "", ""),
+			stakingtypes.NewCommissionRates(types.ZeroDec(), types.ZeroDec(), types.ZeroDec()), types.OneInt(),
+		)
+		require.NoError(t, err)
+
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		txBuilder.SetMemo(memo)
+		bz, err := encodingConfig.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(testDir, file), bz, 0o600))
+	}
+
+	stake := func(amount int64) types.Coin { return types.NewInt64Coin(types.DefaultBondDenom, amount) }
+	val := func(i int) types.ValAddress { return types.ValAddress(accAddrs[i]) }
+
+	// valid gentxs, whose file names are in the reverse validator order
+	writeGenTx("gentx-a.json", val(1), consPubKeys[1], stake(60), "val1", "id1@127.0.0.1:26656")
+	writeGenTx("gentx-b.json", val(0), consPubKeys[0], stake(60), "val0", "id0@127.0.0.1:26656")
+	// invalid gentxs
+	writeGenTx("gentx-c.json", val(1), consPubKeys[3], stake(10), "dup", "id3@127.0.0.1:26656")
+	writeGenTx("gentx-d.json", val(2), consPubKeys[0], stake(1), "dupkey", "id2@127.0.0.1:26656")
+	writeGenTx("gentx-e.json", val(2), consPubKeys[2], stake(2), "poor", "id2@127.0.0.1:26656")
+	writeGenTx("gentx-f.json", val(2), consPubKeys[2], types.NewInt64Coin("foo", 1), "foo", "id2@127.0.0.1:26656")
+	writeGenTx("gentx-g.json", val(2), consPubKeys[2], stake(1), "nomemo", "")
+	require.NoError(t, os.WriteFile(filepath.Join(testDir, "gentx-h.json"), []byte("{}"), 0o600))
+
+	genDoc := tmtypes.GenesisDoc{AppState: appState}
+	appGenTxs, persistentPeers, report, err := genutil.CollectGenTxs(
+		encodingConfig.Codec, encodingConfig.TxConfig.TxJSONDecoder(), "val0", testDir, genDoc, banktypes.GenesisBalancesIterator{},
+	)
+	require.NoError(t, err)
+
+	// the valid gentxs are ordered by validator address
+	validators := []string{val(0).String(), val(1).String()}
+	sort.Strings(validators)
+	require.Len(t, appGenTxs, 2)
+	require.Len(t, report.Accepted, 2)
+	for i, entry := range report.Accepted {
+		require.Equal(t, validators[i], entry.ValidatorAddress)
+		require.Empty(t, entry.Reason)
+		require.Equal(t, validators[i], appGenTxs[i].GetMsgs()[0].(*stakingtypes.MsgCreateValidator).ValidatorAddress)
+	}
+	require.Equal(t, "id1@127.0.0.1:26656", persistentPeers)
+
+	reasons := make(map[string]string)
+	for _, entry := range report.Rejected {
+		reasons[entry.File] = entry.Reason
+	}
+	require.Len(t, reasons, 6)
+	require.Contains(t, reasons["gentx-c.json"], "duplicate validator")
+	require.Contains(t, reasons["gentx-d.json"], "duplicate consensus public key")
+	require.Contains(t, reasons["gentx-e.json"], "insufficient fund")
+	require.Contains(t, reasons["gentx-f.json"], "expected the bond denom stake")
+	require.Contains(t, reasons["gentx-g.json"], "failed to find node's address and IP")
+	require.Contains(t, reasons["gentx-h.json"], "unexpected number of GenTx messages")
+	require.Equal(t, "gentx-c.json", report.Rejected[0].File)
+
+	// CollectTxs fails on the first rejected gentx
+	_, _, err = genutil.CollectTxs(
+		encodingConfig.Codec, encodingConfig.TxConfig.TxJSONDecoder(), "val0", testDir, genDoc, banktypes.GenesisBalancesIterator{},
+	)
+	require.ErrorContains(t, err, "invalid genesis tx gentx-c.json: duplicate validator")
+}
@@ -14,7 +14,9 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var (
@@ -107,3 +109,10 @@ func (am AppModule) ExportGenesis(_ sdk.Context, cdc codec.JSONCodec) json.RawMe
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
+
+// OrderingDependencies implements module.HasOrderingDependencies. The genutil InitGenesis
+// delivers the genesis transactions, which need the params of x/auth and the pools of
+// x/staking to be initialized.
+func (AppModule) OrderingDependencies() module.OrderingDependencies {
+	return module.OrderingDependencies{InitGenesis: []string{authtypes.ModuleName, stakingtypes.ModuleName}}
+}
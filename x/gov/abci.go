@@ -70,7 +70,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 			if err == nil {
 				for idx, msg = range messages {
 					handler := keeper.Router().Handler(msg)
-					_, err = handler(cacheCtx, msg)
+					_, err = sdk.ExecNestedMsg(cacheCtx, msg, handler)
 					if err != nil {
 						break
 					}
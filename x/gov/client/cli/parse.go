@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -80,19 +81,21 @@ type proposal struct {
 	Messages []json.RawMessage
 	Metadata string
 	Deposit  string
+	// ContentHash is the hex encoded hash of the long-form text of the proposal.
+	ContentHash string `json:"content_hash"`
 }
 
-func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, string, sdk.Coins, error) {
+func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, string, []byte, sdk.Coins, error) {
 	var proposal proposal
 
 	contents, err := os.ReadFile(path)
 	if err != nil {
-		return nil, "", nil, err
+		return nil, "", nil, nil, err
 	}
 
 	err = json.Unmarshal(contents, &proposal)
 	if err != nil {
-		return nil, "", nil, err
+		return nil, "", nil, nil, err
 	}
 
 	msgs := make([]sdk.Msg, len(proposal.Messages))
@@ -100,16 +103,21 @@ func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, string, sdk.C
 		var msg sdk.Msg
 		err := cdc.UnmarshalInterfaceJSON(anyJSON, &msg)
 		if err != nil {
-			return nil, "", nil, err
+			return nil, "", nil, nil, err
 		}
 
 		msgs[i] = msg
 	}
 
+	contentHash, err := hex.DecodeString(proposal.ContentHash)
+	if err != nil {
+		return nil, "", nil, nil, fmt.Errorf("invalid content hash: %w", err)
+	}
+
 	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
 	if err != nil {
-		return nil, "", nil, err
+		return nil, "", nil, nil, err
 	}
 
-	return msgs, proposal.Metadata, deposit, nil
+	return msgs, proposal.Metadata, contentHash, deposit, nil
 }
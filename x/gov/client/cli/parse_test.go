@@ -154,25 +154,27 @@ func TestParseSubmitProposal(t *testing.T) {
 		}
   	],
 	"metadata": "%s",
+	"content_hash": "%X",
 	"deposit": "1000test"
 }
-`, addr, addr, addr, addr, addr, base64.StdEncoding.EncodeToString(expectedMetadata)))
+`, addr, addr, addr, addr, addr, base64.StdEncoding.EncodeToString(expectedMetadata), v1.ContentHash([]byte("text"))))
 
 	badJSON := testutil.WriteToNewTempFile(t, "bad json")
 
 	// nonexistent json
-	_, _, _, err := parseSubmitProposal(cdc, "fileDoesNotExist")
+	_, _, _, _, err := parseSubmitProposal(cdc, "fileDoesNotExist")
 	require.Error(t, err)
 
 	// invalid json
-	_, _, _, err = parseSubmitProposal(cdc, badJSON.Name())
+	_, _, _, _, err = parseSubmitProposal(cdc, badJSON.Name())
 	require.Error(t, err)
 
 	// ok json
-	msgs, metadata, deposit, err := parseSubmitProposal(cdc, okJSON.Name())
+	msgs, metadata, contentHash, deposit, err := parseSubmitProposal(cdc, okJSON.Name())
 	require.NoError(t, err, "unexpected error")
 	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(1000))), deposit)
 	require.Equal(t, base64.StdEncoding.EncodeToString(expectedMetadata), metadata)
+	require.Equal(t, v1.ContentHash([]byte("text")), contentHash)
 	require.Len(t, msgs, 3)
 	msg1, ok := msgs[0].(*banktypes.MsgSend)
 	require.True(t, ok)
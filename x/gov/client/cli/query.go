@@ -38,6 +38,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryContent(),
+		GetCmdQueryConstitution(),
 	)
 
 	return govQueryCmd
@@ -512,6 +514,83 @@ $ %s query gov tally 1
 	return cmd
 }
 
+// GetCmdQueryContent implements the query content command.
+func GetCmdQueryContent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "content [hash]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a content of the content store by hash",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a content, e.g. the text of a proposal, stored in the
+content store by its hex encoded SHA-256 hash.
+
+Example:
+$ %s query gov content 9F86D081884C7D659A2FEAA0C55AD015A3BF4F1B2B0B822CD15D6C15B0F00A08
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Content(
+				cmd.Context(),
+				&v1.QueryContentRequest{Hash: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryConstitution implements the query constitution command.
+func GetCmdQueryConstitution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "constitution",
+		Args:  cobra.NoArgs,
+		Short: "Query the hash of the constitution, and its text if stored in the content store",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the hash of the constitution, and its text if stored in the
+content store.
+
+Example:
+$ %s query gov constitution
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Constitution(cmd.Context(), &v1.QueryConstitutionRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -560,10 +639,19 @@ $ %s query gov params
 				return err
 			}
 
+			contentRes, err := queryClient.Params(
+				ctx,
+				&v1.QueryParamsRequest{ParamsType: "content"},
+			)
+			if err != nil {
+				return err
+			}
+
 			params := v1.NewParams(
 				*votingRes.GetVotingParams(),
 				*tallyRes.GetTallyParams(),
 				*depositRes.GetDepositParams(),
+				*contentRes.GetContentParams(),
 			)
 
 			return clientCtx.PrintObjectLegacy(params)
@@ -580,7 +668,7 @@ func GetCmdQueryParam() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "param [param-type]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Query the parameters (voting|tallying|deposit) of the governance process",
+		Short: "Query the parameters (voting|tallying|deposit|content) of the governance process",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query the all the parameters for the governance process.
 
@@ -588,8 +676,9 @@ Example:
 $ %s query gov param voting
 $ %s query gov param tallying
 $ %s query gov param deposit
+$ %s query gov param content
 `,
-				version.AppName, version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -616,8 +705,10 @@ $ %s query gov param deposit
 				out = res.GetTallyParams()
 			case "deposit":
 				out = res.GetDepositParams()
+			case "content":
+				out = res.GetContentParams()
 			default:
-				return fmt.Errorf("argument must be one of (voting|tallying|deposit), was %s", args[0])
+				return fmt.Errorf("argument must be one of (voting|tallying|deposit|content), was %s", args[0])
 			}
 
 			return clientCtx.PrintObjectLegacy(out)
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
@@ -70,6 +71,7 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 		NewCmdVote(),
 		NewCmdWeightedVote(),
 		NewCmdSubmitProposal(),
+		NewCmdStoreContent(),
 
 		// Deprecated
 		cmdSubmitLegacyProp,
@@ -104,6 +106,7 @@ Where proposal.json contains:
     }
   ],
   "metadata: "4pIMOgIGx1vZGU=", // base64-encoded metadata
+  "content_hash": "9F86D0...", // optional hex-encoded SHA-256 hash of the proposal text
   "deposit": "10stake"
 }
 `,
@@ -116,7 +119,7 @@ Where proposal.json contains:
 				return err
 			}
 
-			msgs, metadata, deposit, err := parseSubmitProposal(clientCtx.Codec, args[0])
+			msgs, metadata, contentHash, deposit, err := parseSubmitProposal(clientCtx.Codec, args[0])
 			if err != nil {
 				return err
 			}
@@ -125,6 +128,7 @@ Where proposal.json contains:
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.ContentHash = contentHash
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -200,6 +204,45 @@ $ %s tx gov submit-legacy-proposal --title="Test Proposal" --description="My awe
 	return cmd
 }
 
+// NewCmdStoreContent implements storing a content in the content store.
+func NewCmdStoreContent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-content [path/to/content]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Store a long-form text, e.g. of a proposal or of the constitution, in the content store",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Store the content of a file in the content store, if enabled by
+the content params. The content is addressed by its SHA-256 hash, which proposals
+and the constitution reference it by.
+
+Example:
+$ %s tx gov store-content path/to/proposal.md --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgStoreContent(clientCtx.GetFromAddress(), content)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdDeposit implements depositing tokens for an active proposal.
 func NewCmdDeposit() *cobra.Command {
 	cmd := &cobra.Command{
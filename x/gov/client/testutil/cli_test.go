@@ -17,7 +17,7 @@ func TestIntegrationTestSuite(t *testing.T) {
 	cfg.NumValidators = 1
 	suite.Run(t, NewIntegrationTestSuite(cfg))
 
-	dp := v1.NewDepositParams(sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, v1.DefaultMinDepositTokens)), time.Duration(15)*time.Second)
+	dp := v1.NewDepositParams(sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, v1.DefaultMinDepositTokens)), time.Duration(15)*time.Second, v1.DefaultMinInitialDepositRatio)
 	vp := v1.NewVotingParams(time.Duration(5) * time.Second)
 	genesisState := v1.DefaultGenesisState()
 	genesisState.DepositParams = &dp
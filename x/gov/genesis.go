@@ -16,6 +16,20 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	k.SetVotingParams(ctx, *data.VotingParams)
 	k.SetTallyParams(ctx, *data.TallyParams)
 
+	contentParams := v1.DefaultContentParams()
+	if data.ContentParams != nil {
+		contentParams = *data.ContentParams
+	}
+	k.SetContentParams(ctx, contentParams)
+
+	if len(data.ConstitutionHash) > 0 {
+		k.SetConstitution(ctx, data.ConstitutionHash)
+	}
+
+	for _, content := range data.Contents {
+		k.SetContent(ctx, content)
+	}
+
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
 	if moduleAcc == nil {
@@ -60,6 +74,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *v1.GenesisState {
 	depositParams := k.GetDepositParams(ctx)
 	votingParams := k.GetVotingParams(ctx)
 	tallyParams := k.GetTallyParams(ctx)
+	contentParams := k.GetContentParams(ctx)
 	proposals := k.GetProposals(ctx)
 
 	var proposalsDeposits v1.Deposits
@@ -80,5 +95,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *v1.GenesisState {
 		DepositParams:      &depositParams,
 		VotingParams:       &votingParams,
 		TallyParams:        &tallyParams,
+		ContentParams:      &contentParams,
+		ConstitutionHash:   k.GetConstitution(ctx),
+		Contents:           k.GetContents(ctx),
 	}
 }
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// StoreContent stores a content, e.g. the text of a proposal or of the
+// constitution, in the content store and returns the hash it is addressed by.
+// The content must not be larger than the max content size param, the content
+// store being disabled if it is zero.
+func (keeper Keeper) StoreContent(ctx sdk.Context, content []byte) ([]byte, error) {
+	maxSize := keeper.GetContentParams(ctx).MaxContentSize
+	if maxSize == 0 {
+		return nil, types.ErrContentStoreDisabled
+	}
+	if uint64(len(content)) > maxSize {
+		return nil, types.ErrContentTooLarge.Wrapf("got %d bytes, max %d", len(content), maxSize)
+	}
+
+	hash := v1.ContentHash(content)
+	keeper.SetContent(ctx, content)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStoreContent,
+			sdk.NewAttribute(types.AttributeKeyContentHash, fmt.Sprintf("%X", hash)),
+		),
+	)
+
+	return hash, nil
+}
+
+// SetContent sets a content in the content store, regardless of the content
+// params.
+func (keeper Keeper) SetContent(ctx sdk.Context, content []byte) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.ContentKey(v1.ContentHash(content)), content)
+}
+
+// GetContent gets a content from the content store by hash.
+func (keeper Keeper) GetContent(ctx sdk.Context, hash []byte) ([]byte, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	content := store.Get(types.ContentKey(hash))
+	return content, content != nil
+}
+
+// IterateContents iterates over all the contents of the content store, by
+// hash, and performs a callback function.
+func (keeper Keeper) IterateContents(ctx sdk.Context, cb func(hash, content []byte) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ContentsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(iterator.Key()[len(types.ContentsKeyPrefix):], iterator.Value()) {
+			break
+		}
+	}
+}
+
+// GetContents returns all the contents of the content store, by hash.
+func (keeper Keeper) GetContents(ctx sdk.Context) (contents [][]byte) {
+	keeper.IterateContents(ctx, func(_, content []byte) bool {
+		contents = append(contents, content)
+		return false
+	})
+	return
+}
+
+// SetConstitution sets the hash of the constitution. The constitution itself
+// is stored off chain or in the content store.
+func (keeper Keeper) SetConstitution(ctx sdk.Context, hash []byte) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.ConstitutionKey, hash)
+}
+
+// GetConstitution returns the hash of the constitution, nil if not set.
+func (keeper Keeper) GetConstitution(ctx sdk.Context) []byte {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Get(types.ConstitutionKey)
+}
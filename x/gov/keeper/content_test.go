@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func (suite *KeeperTestSuite) TestStoreContent() {
+	app, ctx := suite.app, suite.ctx
+	content := []byte("constitution")
+
+	// the content store is disabled by default
+	_, err := app.GovKeeper.StoreContent(ctx, content)
+	suite.Require().ErrorIs(err, types.ErrContentStoreDisabled)
+
+	app.GovKeeper.SetContentParams(ctx, v1.NewContentParams(uint64(len(content))))
+
+	_, err = app.GovKeeper.StoreContent(ctx, append(content, '!'))
+	suite.Require().ErrorIs(err, types.ErrContentTooLarge)
+
+	hash, err := app.GovKeeper.StoreContent(ctx, content)
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.ContentHash(content), hash)
+
+	stored, found := app.GovKeeper.GetContent(ctx, hash)
+	suite.Require().True(found)
+	suite.Require().Equal(content, stored)
+	suite.Require().Equal([][]byte{content}, app.GovKeeper.GetContents(ctx))
+
+	_, found = app.GovKeeper.GetContent(ctx, v1.ContentHash([]byte("other")))
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestConstitution() {
+	app, ctx := suite.app, suite.ctx
+	suite.Require().Nil(app.GovKeeper.GetConstitution(ctx))
+
+	hash := v1.ContentHash([]byte("constitution"))
+	app.GovKeeper.SetConstitution(ctx, hash)
+	suite.Require().Equal(hash, app.GovKeeper.GetConstitution(ctx))
+}
+
+func (suite *KeeperTestSuite) TestMsgStoreContent() {
+	app, ctx := suite.app, suite.ctx
+	content := []byte("proposal text")
+
+	_, err := suite.msgSrvr.StoreContent(ctx, v1.NewMsgStoreContent(suite.addrs[0], content))
+	suite.Require().ErrorIs(err, types.ErrContentStoreDisabled)
+
+	app.GovKeeper.SetContentParams(ctx, v1.NewContentParams(1024))
+	res, err := suite.msgSrvr.StoreContent(ctx, v1.NewMsgStoreContent(suite.addrs[0], content))
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.ContentHash(content), res.Hash)
+}
+
+func (suite *KeeperTestSuite) TestMsgSetConstitution() {
+	govAcct := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress().String()
+	hash := v1.ContentHash([]byte("constitution"))
+
+	cases := map[string]struct {
+		authority string
+		expErr    bool
+	}{
+		"invalid authority": {
+			authority: suite.addrs[0].String(),
+			expErr:    true,
+		},
+		"governance account": {
+			authority: govAcct,
+			expErr:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			_, err := suite.msgSrvr.SetConstitution(suite.ctx, v1.NewMsgSetConstitution(tc.authority, hash))
+			if tc.expErr {
+				suite.Require().ErrorIs(err, types.ErrInvalidSigner)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(hash, suite.app.GovKeeper.GetConstitution(suite.ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSubmitProposalContentHash() {
+	hash := v1.ContentHash([]byte("proposal text"))
+
+	_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, nil, "", []byte("invalid"))
+	suite.Require().ErrorIs(err, types.ErrInvalidContentHash)
+
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, nil, "", hash)
+	suite.Require().NoError(err)
+	suite.Require().Equal(hash, proposal.ContentHash)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryContent() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	content := []byte("proposal text")
+	app.GovKeeper.SetContent(ctx, content)
+
+	testCases := []struct {
+		msg     string
+		req     *v1.QueryContentRequest
+		expPass bool
+	}{
+		{
+			"invalid hex",
+			&v1.QueryContentRequest{Hash: "zz"},
+			false,
+		},
+		{
+			"invalid hash length",
+			&v1.QueryContentRequest{Hash: "abcd"},
+			false,
+		},
+		{
+			"non existing content",
+			&v1.QueryContentRequest{Hash: fmt.Sprintf("%X", v1.ContentHash([]byte("other")))},
+			false,
+		},
+		{
+			"valid request",
+			&v1.QueryContentRequest{Hash: fmt.Sprintf("%X", v1.ContentHash(content))},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			res, err := queryClient.Content(gocontext.Background(), testCase.req)
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(content, res.Content)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryConstitution() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	res, err := queryClient.Constitution(gocontext.Background(), &v1.QueryConstitutionRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Hash)
+	suite.Require().Empty(res.Content)
+
+	// the constitution text is only returned when stored in the content store
+	content := []byte("constitution")
+	hash := v1.ContentHash(content)
+	app.GovKeeper.SetConstitution(ctx, hash)
+
+	res, err = queryClient.Constitution(gocontext.Background(), &v1.QueryConstitutionRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(hash, res.Hash)
+	suite.Require().Empty(res.Content)
+
+	app.GovKeeper.SetContent(ctx, content)
+
+	res, err = queryClient.Constitution(gocontext.Background(), &v1.QueryConstitutionRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(hash, res.Hash)
+	suite.Require().Equal(content, res.Content)
+}
@@ -55,14 +55,17 @@ func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID uint64) (deposits v
 }
 
 // DeleteAndBurnDeposits deletes and burn all the deposits on a specific proposal.
+// An event records the amount burned.
 func (keeper Keeper) DeleteAndBurnDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
+	burned := sdk.NewCoins()
 
 	keeper.IterateDeposits(ctx, proposalID, func(deposit v1.Deposit) bool {
 		err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, deposit.Amount)
 		if err != nil {
 			panic(err)
 		}
+		burned = burned.Add(deposit.Amount...)
 
 		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
 		if err != nil {
@@ -71,6 +74,14 @@ func (keeper Keeper) DeleteAndBurnDeposits(ctx sdk.Context, proposalID uint64) {
 		store.Delete(types.DepositKey(proposalID, depositor))
 		return false
 	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnDeposits,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyBurnedDeposits, burned.String()),
+		),
+	)
 }
 
 // IterateAllDeposits iterates over all the stored deposits and performs a callback function
@@ -123,6 +134,10 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 		return false, sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
+	if err := keeper.validateDepositDenoms(ctx, depositAmount); err != nil {
+		return false, err
+	}
+
 	// update the governance module's account coins pool
 	err := keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, depositorAddr, types.ModuleName, depositAmount)
 	if err != nil {
@@ -186,3 +201,26 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64)
 		return false
 	})
 }
+
+// validateDepositDenoms checks that a deposit is only made of the denoms of the
+// minimum deposit.
+func (keeper Keeper) validateDepositDenoms(ctx sdk.Context, depositAmount sdk.Coins) error {
+	minDeposit := sdk.Coins(keeper.GetDepositParams(ctx).MinDeposit)
+	if denied := depositAmount.DenyDenoms(minDeposit.Denoms()...); len(denied) > 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidDepositDenom, "%s, accepted denoms are %v", denied, minDeposit.Denoms())
+	}
+
+	return nil
+}
+
+// validateInitialDeposit checks that the deposit made when submitting a
+// proposal is at least the minimum deposit times the minimum initial deposit
+// ratio.
+func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins) error {
+	minInitialDeposit := keeper.GetDepositParams(ctx).MinInitialDeposit()
+	if !initialDeposit.IsAllGTE(minInitialDeposit) {
+		return sdkerrors.Wrapf(types.ErrMinDepositTooSmall, "was (%s), need (%s)", initialDeposit, minInitialDeposit)
+	}
+
+	return nil
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestDeposits(t *testing.T) {
@@ -17,7 +19,7 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", nil)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 
@@ -101,13 +103,42 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr1Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 
 	// Test delete and burn deposits
-	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp, "", nil)
 	require.NoError(t, err)
 	proposalID = proposal.Id
 	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
 	require.NoError(t, err)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	app.GovKeeper.DeleteAndBurnDeposits(ctx, proposalID)
 	deposits = app.GovKeeper.GetDeposits(ctx, proposalID)
 	require.Len(t, deposits, 0)
 	require.Equal(t, addr0Initial.Sub(fourStake...), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
+
+	// The burned amount is recorded in an event.
+	events := ctx.EventManager().Events()
+	event := events[len(events)-1]
+	require.Equal(t, types.EventTypeBurnDeposits, event.Type)
+	require.Equal(t, types.AttributeKeyBurnedDeposits, string(event.Attributes[1].Key))
+	require.Equal(t, fourStake.String(), string(event.Attributes[1].Value))
+}
+
+func TestDepositDenoms(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000000))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, addrs[0], sdk.NewCoins(sdk.NewInt64Coin("foo", 100))))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", nil)
+	require.NoError(t, err)
+
+	// only the denoms of the min deposit are accepted
+	stake := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	_, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[0], sdk.NewCoins(stake, sdk.NewInt64Coin("foo", 100)))
+	require.ErrorIs(t, err, types.ErrInvalidDepositDenom)
+	_, found := app.GovKeeper.GetDeposit(ctx, proposal.Id, addrs[0])
+	require.False(t, found)
+
+	_, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[0], sdk.NewCoins(stake))
+	require.NoError(t, err)
 }
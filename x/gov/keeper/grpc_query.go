@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -178,6 +179,10 @@ func (q Keeper) Params(c context.Context, req *v1.QueryParamsRequest) (*v1.Query
 		tallyParams := q.GetTallyParams(ctx)
 		return &v1.QueryParamsResponse{TallyParams: &tallyParams}, nil
 
+	case v1.ParamContent:
+		contentParams := q.GetContentParams(ctx)
+		return &v1.QueryParamsResponse{ContentParams: &contentParams}, nil
+
 	default:
 		return nil, status.Errorf(codes.InvalidArgument,
 			"%s is not a valid parameter type", req.ParamsType)
@@ -454,3 +459,45 @@ func (q legacyQueryServer) TallyResult(c context.Context, req *v1beta1.QueryTall
 
 	return &v1beta1.QueryTallyResultResponse{Tally: tally}, nil
 }
+
+// Content queries a content of the content store by hash.
+func (q Keeper) Content(c context.Context, req *v1.QueryContentRequest) (*v1.QueryContentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hash: %s", err)
+	}
+	if err := v1.ValidateContentHash(hash); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	content, found := q.GetContent(ctx, hash)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "content %X doesn't exist", hash)
+	}
+
+	return &v1.QueryContentResponse{Content: content}, nil
+}
+
+// Constitution queries the hash of the constitution, and its text if stored in
+// the content store.
+func (q Keeper) Constitution(c context.Context, req *v1.QueryConstitutionRequest) (*v1.QueryConstitutionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	hash := q.GetConstitution(ctx)
+	if hash == nil {
+		return &v1.QueryConstitutionResponse{}, nil
+	}
+
+	content, _ := q.GetContent(ctx, hash)
+	return &v1.QueryConstitutionResponse{Hash: hash, Content: content}, nil
+}
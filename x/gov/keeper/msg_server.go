@@ -31,12 +31,16 @@ var _ v1.MsgServer = msgServer{}
 func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitProposal) (*v1.MsgSubmitProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.validateInitialDeposit(ctx, msg.GetInitialDeposit()); err != nil {
+		return nil, err
+	}
+
 	proposalMsgs, err := msg.GetMsgs()
 	if err != nil {
 		return nil, err
 	}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata)
+	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.ContentHash)
 	if err != nil {
 		return nil, err
 	}
@@ -207,6 +211,45 @@ func (k msgServer) Deposit(goCtx context.Context, msg *v1.MsgDeposit) (*v1.MsgDe
 	return &v1.MsgDepositResponse{}, nil
 }
 
+func (k msgServer) StoreContent(goCtx context.Context, msg *v1.MsgStoreContent) (*v1.MsgStoreContentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hash, err := k.Keeper.StoreContent(ctx, msg.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &v1.MsgStoreContentResponse{Hash: hash}, nil
+}
+
+func (k msgServer) SetConstitution(goCtx context.Context, msg *v1.MsgSetConstitution) (*v1.MsgSetConstitutionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	govAcct := k.GetGovernanceAccount(ctx).GetAddress().String()
+	if govAcct != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSigner, "expected %s got %s", govAcct, msg.Authority)
+	}
+
+	k.Keeper.SetConstitution(ctx, msg.ContentHash)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetConstitution,
+			sdk.NewAttribute(types.AttributeKeyContentHash, fmt.Sprintf("%X", msg.ContentHash)),
+		),
+	)
+
+	return &v1.MsgSetConstitutionResponse{}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
@@ -117,6 +118,57 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitProposalMinInitialDeposit() {
+	govAcct := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	proposer := suite.addrs[0]
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   proposer.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+	}
+
+	depositParams := suite.app.GovKeeper.GetDepositParams(suite.ctx)
+	depositParams.MinDeposit = sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000)))
+	depositParams.MinInitialDepositRatio = sdk.NewDecWithPrec(5, 1).String()
+	suite.app.GovKeeper.SetDepositParams(suite.ctx, depositParams)
+
+	cases := map[string]struct {
+		initialDeposit sdk.Coins
+		expErr         bool
+	}{
+		"no deposit": {
+			initialDeposit: sdk.NewCoins(),
+			expErr:         true,
+		},
+		"deposit below the minimum initial deposit": {
+			initialDeposit: sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(499))),
+			expErr:         true,
+		},
+		"deposit of another denom": {
+			initialDeposit: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(500))),
+			expErr:         true,
+		},
+		"minimum initial deposit": {
+			initialDeposit: sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(500))),
+			expErr:         false,
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			msg, err := v1.NewMsgSubmitProposal([]sdk.Msg{bankMsg}, tc.initialDeposit, proposer.String(), "")
+			suite.Require().NoError(err)
+			res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+			if tc.expErr {
+				suite.Require().ErrorIs(err, types.ErrMinDepositTooSmall)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res.ProposalId)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestVoteReq() {
 	govAcct := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
 	addrs := suite.addrs
@@ -26,6 +26,13 @@ func (keeper Keeper) GetTallyParams(ctx sdk.Context) v1.TallyParams {
 	return tallyParams
 }
 
+// GetContentParams returns the current ContentParams from the global param store
+func (keeper Keeper) GetContentParams(ctx sdk.Context) v1.ContentParams {
+	var contentParams v1.ContentParams
+	keeper.paramSpace.Get(ctx, v1.ParamStoreKeyContentParams, &contentParams)
+	return contentParams
+}
+
 // SetDepositParams sets DepositParams to the global param store
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams v1.DepositParams) {
 	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
@@ -40,3 +47,8 @@ func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams v1.VotingPara
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams v1.TallyParams) {
 	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
 }
+
+// SetContentParams sets ContentParams to the global param store
+func (keeper Keeper) SetContentParams(ctx sdk.Context, contentParams v1.ContentParams) {
+	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyContentParams, &contentParams)
+}
@@ -165,8 +165,9 @@ func convertToNewVotes(oldVotes v1beta1.Votes) (v1.Votes, error) {
 
 func convertToNewDepParams(oldDepParams v1beta1.DepositParams) v1.DepositParams {
 	return v1.DepositParams{
-		MinDeposit:       oldDepParams.MinDeposit,
-		MaxDepositPeriod: &oldDepParams.MaxDepositPeriod,
+		MinDeposit:             oldDepParams.MinDeposit,
+		MaxDepositPeriod:       &oldDepParams.MaxDepositPeriod,
+		MinInitialDepositRatio: v1.DefaultMinInitialDepositRatio.String(),
 	}
 }
 
@@ -178,9 +179,11 @@ func convertToNewVotingParams(oldVoteParams v1beta1.VotingParams) v1.VotingParam
 
 func convertToNewTallyParams(oldTallyParams v1beta1.TallyParams) v1.TallyParams {
 	return v1.TallyParams{
-		Quorum:        oldTallyParams.Quorum.String(),
-		Threshold:     oldTallyParams.Threshold.String(),
-		VetoThreshold: oldTallyParams.VetoThreshold.String(),
+		Quorum:         oldTallyParams.Quorum.String(),
+		Threshold:      oldTallyParams.Threshold.String(),
+		VetoThreshold:  oldTallyParams.VetoThreshold.String(),
+		BurnVoteVeto:   v1.DefaultBurnVoteVeto,
+		BurnVoteQuorum: v1.DefaultBurnVoteQuorum,
 	}
 }
 
@@ -21,6 +21,7 @@ func MigrateJSON(oldState *v1beta1.GenesisState) (*v1.GenesisState, error) {
 	}
 
 	depParams, votingParms, tallyParams := convertToNewDepParams(oldState.DepositParams), convertToNewVotingParams(oldState.VotingParams), convertToNewTallyParams(oldState.TallyParams)
+	contentParams := v1.DefaultContentParams()
 
 	return &v1.GenesisState{
 		StartingProposalId: oldState.StartingProposalId,
@@ -30,5 +31,6 @@ func MigrateJSON(oldState *v1beta1.GenesisState) (*v1.GenesisState, error) {
 		DepositParams:      &depParams,
 		VotingParams:       &votingParms,
 		TallyParams:        &tallyParams,
+		ContentParams:      &contentParams,
 	}, nil
 }
@@ -73,6 +73,11 @@ func TestMigrateJSON(t *testing.T) {
 	// Make sure about:
 	// - Proposals use MsgExecLegacyContent
 	expected := `{
+	"constitution_hash": null,
+	"content_params": {
+		"max_content_size": "0"
+	},
+	"contents": [],
 	"deposit_params": {
 		"max_deposit_period": "172800s",
 		"min_deposit": [
@@ -80,11 +85,13 @@ func TestMigrateJSON(t *testing.T) {
 				"amount": "10000000",
 				"denom": "stake"
 			}
-		]
+		],
+		"min_initial_deposit_ratio": "0.000000000000000000"
 	},
 	"deposits": [],
 	"proposals": [
 		{
+			"content_hash": null,
 			"deposit_end_time": "2001-09-09T01:46:40Z",
 			"final_tally_result": {
 				"abstain_count": "0",
@@ -119,6 +126,8 @@ func TestMigrateJSON(t *testing.T) {
 	],
 	"starting_proposal_id": "1",
 	"tally_params": {
+		"burn_vote_quorum": false,
+		"burn_vote_veto": true,
 		"quorum": "0.334000000000000000",
 		"threshold": "0.500000000000000000",
 		"veto_threshold": "0.334000000000000000"
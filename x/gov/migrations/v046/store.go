@@ -6,6 +6,8 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v042 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v042"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
@@ -40,12 +42,33 @@ func migrateProposals(store sdk.KVStore, cdc codec.BinaryCodec) error {
 	return nil
 }
 
+// migrateParams sets the deposit, tally and content params introduced in v0.46
+// to their default values.
+func migrateParams(ctx sdk.Context, paramSpace types.ParamSubspace) {
+	var depositParams v1.DepositParams
+	paramSpace.Get(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+	depositParams.MinInitialDepositRatio = v1.DefaultMinInitialDepositRatio.String()
+	paramSpace.Set(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+
+	var tallyParams v1.TallyParams
+	paramSpace.Get(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+	tallyParams.BurnVoteVeto = v1.DefaultBurnVoteVeto
+	tallyParams.BurnVoteQuorum = v1.DefaultBurnVoteQuorum
+	paramSpace.Set(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+
+	contentParams := v1.DefaultContentParams()
+	paramSpace.Set(ctx, v1.ParamStoreKeyContentParams, &contentParams)
+}
+
 // MigrateStore performs in-place store migrations from v0.43 to v0.46. The
 // migration includes:
 //
 // - Migrate proposals to be Msg-based.
-func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+// - Set the minimum initial deposit ratio, deposit burning and content params.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramSpace types.ParamSubspace) error {
 	store := ctx.KVStore(storeKey)
 
+	migrateParams(ctx, paramSpace)
+
 	return migrateProposals(store, cdc)
 }
@@ -13,14 +13,26 @@ import (
 	v046gov "github.com/cosmos/cosmos-sdk/x/gov/migrations/v046"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 func TestMigrateStore(t *testing.T) {
-	cdc := simapp.MakeTestEncodingConfig().Codec
+	encCfg := simapp.MakeTestEncodingConfig()
+	cdc := encCfg.Codec
 	govKey := sdk.NewKVStoreKey("gov")
-	ctx := testutil.DefaultContext(govKey, sdk.NewTransientStoreKey("transient_test"))
+	tGovKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(govKey, tGovKey)
 	store := ctx.KVStore(govKey)
+	paramSpace := paramtypes.NewSubspace(cdc, encCfg.Amino, govKey, tGovKey, "gov").WithKeyTable(v1.ParamKeyTable())
+
+	// Set the params as stored before the introduction of the new fields.
+	depositParams := v1.DefaultDepositParams()
+	depositParams.MinInitialDepositRatio = ""
+	paramSpace.Set(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+	tallyParams := v1.DefaultTallyParams()
+	tallyParams.BurnVoteVeto = false
+	paramSpace.Set(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
 
 	propTime := time.Unix(1e9, 0)
 
@@ -40,9 +52,15 @@ func TestMigrateStore(t *testing.T) {
 	store.Set(v042gov.ProposalKey(prop2.ProposalId), prop2Bz)
 
 	// Run migrations.
-	err = v046gov.MigrateStore(ctx, govKey, cdc)
+	err = v046gov.MigrateStore(ctx, govKey, cdc, paramSpace)
 	require.NoError(t, err)
 
+	// Make sure the new params are set.
+	paramSpace.Get(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+	require.Equal(t, v1.DefaultDepositParams(), depositParams)
+	paramSpace.Get(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+	require.Equal(t, v1.DefaultTallyParams(), tallyParams)
+
 	var newProp1 v1.Proposal
 	err = cdc.Unmarshal(store.Get(v042gov.ProposalKey(prop1.ProposalId)), &newProp1)
 	require.NoError(t, err)
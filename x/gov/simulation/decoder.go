@@ -48,6 +48,12 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &voteB)
 			return fmt.Sprintf("%v\n%v", voteA, voteB)
 
+		case bytes.Equal(kvA.Key[:1], types.ContentsKeyPrefix):
+			return fmt.Sprintf("%q\n%q", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.ConstitutionKey):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
 		default:
 			panic(fmt.Sprintf("invalid governance key prefix %X", kvA.Key[:1]))
 		}
@@ -97,9 +97,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	govGenesis := v1.NewGenesisState(
 		startingProposalID,
-		v1.NewDepositParams(minDeposit, depositPeriod),
+		v1.NewDepositParams(minDeposit, depositPeriod, v1.DefaultMinInitialDepositRatio),
 		v1.NewVotingParams(votingPeriod),
-		v1.NewTallyParams(quorum, threshold, veto),
+		v1.NewTallyParams(quorum, threshold, veto, v1.DefaultBurnVoteVeto, v1.DefaultBurnVoteQuorum),
+		v1.DefaultContentParams(),
 	)
 
 	bz, err := json.MarshalIndent(&govGenesis, "", " ")
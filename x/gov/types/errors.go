@@ -21,4 +21,9 @@ var (
 	ErrInvalidSigner           = sdkerrors.Register(ModuleName, 13, "expected gov account as only signer for proposal message")
 	ErrInvalidSignalMsg        = sdkerrors.Register(ModuleName, 14, "signal message is invalid")
 	ErrMetadataTooLong         = sdkerrors.Register(ModuleName, 15, "metadata too long")
+	ErrMinDepositTooSmall      = sdkerrors.Register(ModuleName, 16, "minimum deposit is too small")
+	ErrContentStoreDisabled    = sdkerrors.Register(ModuleName, 17, "content store is disabled")
+	ErrContentTooLarge         = sdkerrors.Register(ModuleName, 18, "content too large")
+	ErrInvalidContentHash      = sdkerrors.Register(ModuleName, 19, "invalid content hash")
+	ErrInvalidDepositDenom     = sdkerrors.Register(ModuleName, 20, "invalid deposit denom")
 )
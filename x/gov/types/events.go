@@ -8,12 +8,17 @@ const (
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeSignalProposal   = "signal_proposal"
+	EventTypeBurnDeposits     = "burn_deposits"
+	EventTypeStoreContent     = "store_content"
+	EventTypeSetConstitution  = "set_constitution"
 
 	AttributeKeyProposalResult     = "proposal_result"
 	AttributeKeyOption             = "option"
 	AttributeKeyProposalID         = "proposal_id"
 	AttributeKeyProposalMessages   = "proposal_messages" // Msg type_urls in the proposal
 	AttributeKeyVotingPeriodStart  = "voting_period_start"
+	AttributeKeyBurnedDeposits     = "burned_deposits"
+	AttributeKeyContentHash        = "content_hash"
 	AttributeValueCategory         = "governance"
 	AttributeValueProposalDropped  = "proposal_dropped"  // didn't meet min deposit
 	AttributeValueProposalPassed   = "proposal_passed"   // met vote quorum
@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"crypto/sha256"
+
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ContentHash returns the hash a content of the content store is addressed by,
+// that is its SHA-256 hash.
+func ContentHash(content []byte) []byte {
+	hash := sha256.Sum256(content)
+	return hash[:]
+}
+
+// ValidateContentHash returns an error if hash is not a SHA-256 hash.
+func ValidateContentHash(hash []byte) error {
+	if len(hash) != sha256.Size {
+		return types.ErrInvalidContentHash.Wrapf("expected %d bytes, got %d", sha256.Size, len(hash))
+	}
+
+	return nil
+}
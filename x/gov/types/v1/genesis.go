@@ -8,12 +8,13 @@ import (
 )
 
 // NewGenesisState creates a new genesis state for the governance module
-func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParams, tp TallyParams) *GenesisState {
+func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParams, tp TallyParams, cp ContentParams) *GenesisState {
 	return &GenesisState{
 		StartingProposalId: startingProposalID,
 		DepositParams:      &dp,
 		VotingParams:       &vp,
 		TallyParams:        &tp,
+		ContentParams:      &cp,
 	}
 }
 
@@ -24,6 +25,7 @@ func DefaultGenesisState() *GenesisState {
 		DefaultDepositParams(),
 		DefaultVotingParams(),
 		DefaultTallyParams(),
+		DefaultContentParams(),
 	)
 }
 
@@ -53,6 +55,33 @@ func ValidateGenesis(data *GenesisState) error {
 		return fmt.Errorf("invalid deposit params: %w", err)
 	}
 
+	// The content params, introduced after the other params, default to
+	// DefaultContentParams if not set.
+	if data.ContentParams != nil {
+		if err := validateContentParams(*data.ContentParams); err != nil {
+			return fmt.Errorf("invalid content params: %w", err)
+		}
+	}
+
+	if len(data.ConstitutionHash) > 0 {
+		if err := ValidateContentHash(data.ConstitutionHash); err != nil {
+			return fmt.Errorf("invalid constitution hash: %w", err)
+		}
+	}
+
+	seenContents := make(map[string]bool, len(data.Contents))
+	for i, content := range data.Contents {
+		if len(content) == 0 {
+			return fmt.Errorf("empty content at index %d", i)
+		}
+
+		hash := string(ContentHash(content))
+		if seenContents[hash] {
+			return fmt.Errorf("duplicate content %X", hash)
+		}
+		seenContents[hash] = true
+	}
+
 	return nil
 }
 
@@ -38,6 +38,12 @@ type GenesisState struct {
 	VotingParams *VotingParams `protobuf:"bytes,6,opt,name=voting_params,json=votingParams,proto3" json:"voting_params,omitempty"`
 	// params defines all the paramaters of related to tally.
 	TallyParams *TallyParams `protobuf:"bytes,7,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params,omitempty"`
+	// content_params defines the parameters related to the content store.
+	ContentParams *ContentParams `protobuf:"bytes,8,opt,name=content_params,json=contentParams,proto3" json:"content_params,omitempty"`
+	// constitution_hash is the SHA-256 hash of the constitution.
+	ConstitutionHash []byte `protobuf:"bytes,9,opt,name=constitution_hash,json=constitutionHash,proto3" json:"constitution_hash,omitempty"`
+	// contents are the contents of the content store.
+	Contents [][]byte `protobuf:"bytes,10,rep,name=contents,proto3" json:"contents,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -122,6 +128,27 @@ func (m *GenesisState) GetTallyParams() *TallyParams {
 	return nil
 }
 
+func (m *GenesisState) GetContentParams() *ContentParams {
+	if m != nil {
+		return m.ContentParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConstitutionHash() []byte {
+	if m != nil {
+		return m.ConstitutionHash
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContents() [][]byte {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -129,29 +156,33 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcd, 0x4e, 0xc2, 0x40,
-	0x14, 0x85, 0xa9, 0xfc, 0xa8, 0x03, 0xb8, 0x18, 0x8d, 0x34, 0x60, 0x1a, 0xe2, 0x0a, 0x63, 0x6c,
-	0x05, 0xe3, 0xd2, 0xc4, 0xf8, 0x13, 0xe3, 0x8e, 0x54, 0xe3, 0xc2, 0x0d, 0x29, 0x74, 0x52, 0x1b,
-	0x81, 0xdb, 0xf4, 0x5e, 0x27, 0xf2, 0x16, 0x3e, 0x80, 0x0f, 0xe4, 0x92, 0xa5, 0x4b, 0x03, 0x2f,
-	0x62, 0x98, 0x69, 0x05, 0xab, 0xab, 0xc9, 0xcd, 0xf9, 0xce, 0x99, 0x93, 0x7b, 0x59, 0x63, 0x00,
-	0x38, 0x02, 0x74, 0x02, 0x90, 0x8e, 0x6c, 0x3b, 0x81, 0x18, 0x0b, 0x0c, 0xd1, 0x8e, 0x62, 0x20,
-	0xe0, 0x55, 0x2d, 0xda, 0x01, 0x48, 0x5b, 0xb6, 0xeb, 0xb5, 0x0c, 0x0b, 0x52, 0x73, 0xfb, 0xef,
-	0x79, 0x56, 0xb9, 0xd1, 0xce, 0x3b, 0xf2, 0x48, 0xf0, 0x63, 0xb6, 0x83, 0xe4, 0xc5, 0x14, 0x8e,
-	0x83, 0x5e, 0x14, 0x43, 0x04, 0xe8, 0x0d, 0x7b, 0xa1, 0x6f, 0x1a, 0x4d, 0xa3, 0x55, 0x70, 0x79,
-	0xaa, 0x75, 0x13, 0xe9, 0xd6, 0xe7, 0x1d, 0xb6, 0xe1, 0x8b, 0x08, 0x30, 0x24, 0x34, 0xd7, 0x9a,
-	0xf9, 0x56, 0xb9, 0xb3, 0x6b, 0xff, 0xfa, 0xdd, 0xbe, 0xd2, 0xb2, 0xfb, 0xc3, 0xf1, 0x03, 0x56,
-	0x94, 0x40, 0x02, 0xcd, 0xbc, 0x32, 0x6c, 0x67, 0x0c, 0x0f, 0x40, 0xc2, 0xd5, 0x04, 0x3f, 0x65,
-	0x9b, 0x69, 0x0f, 0x34, 0x0b, 0x0a, 0xaf, 0x65, 0xf0, 0xb4, 0x8c, 0xbb, 0x24, 0xf9, 0x25, 0xdb,
-	0x4a, 0x7e, 0xeb, 0x45, 0x5e, 0xec, 0x8d, 0xd0, 0x2c, 0x36, 0x8d, 0x56, 0xb9, 0xb3, 0xf7, 0x7f,
-	0xb7, 0xae, 0x62, 0xdc, 0xaa, 0xbf, 0x3a, 0xf2, 0x73, 0x56, 0x95, 0xa0, 0x57, 0xa1, 0x33, 0x4a,
-	0x2a, 0xa3, 0xf1, 0xb7, 0xee, 0x62, 0x25, 0x3a, 0xa2, 0x22, 0x57, 0x26, 0x7e, 0xc6, 0x2a, 0xe4,
-	0x0d, 0x87, 0x93, 0x34, 0x60, 0x5d, 0x05, 0xd4, 0x33, 0x01, 0xf7, 0x0b, 0x24, 0xf1, 0x97, 0x69,
-	0x39, 0x5c, 0x5c, 0x7f, 0xcc, 0x2c, 0x63, 0x3a, 0xb3, 0x8c, 0xaf, 0x99, 0x65, 0xbc, 0xcd, 0xad,
-	0xdc, 0x74, 0x6e, 0xe5, 0x3e, 0xe7, 0x56, 0xee, 0xf1, 0x30, 0x08, 0xe9, 0xe9, 0xa5, 0x6f, 0x0f,
-	0x60, 0xe4, 0x24, 0xc7, 0xd5, 0xcf, 0x11, 0xfa, 0xcf, 0xce, 0xab, 0xba, 0x34, 0x4d, 0x22, 0x81,
-	0x8e, 0x6c, 0xf7, 0x4b, 0xea, 0xd8, 0x27, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xec, 0x74,
-	0x03, 0x33, 0x02, 0x00, 0x00,
+	// 401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x8e, 0x93, 0x50,
+	0x14, 0x86, 0x8b, 0xed, 0x8c, 0x9d, 0x5b, 0x6a, 0xf4, 0x6a, 0x1c, 0xd2, 0x31, 0x84, 0xb8, 0xc2,
+	0x4c, 0x04, 0x5b, 0xe3, 0xd2, 0xc4, 0x38, 0x1a, 0x75, 0x37, 0x41, 0xe3, 0xc2, 0x0d, 0xb9, 0x03,
+	0x37, 0x40, 0x6c, 0x39, 0x84, 0x73, 0x7a, 0x63, 0xdf, 0xc2, 0xe7, 0xf0, 0x49, 0x5c, 0x76, 0xe9,
+	0xd2, 0xb4, 0x2f, 0x62, 0xca, 0x85, 0x16, 0x99, 0xae, 0xc8, 0x9f, 0xff, 0xfb, 0x7f, 0xce, 0xb9,
+	0x39, 0xec, 0x22, 0x02, 0x5c, 0x00, 0xfa, 0x09, 0x28, 0x5f, 0x4d, 0xfd, 0x44, 0xe6, 0x12, 0x33,
+	0xf4, 0x8a, 0x12, 0x08, 0xf8, 0x58, 0x9b, 0x5e, 0x02, 0xca, 0x53, 0xd3, 0xc9, 0x79, 0x87, 0x05,
+	0xa5, 0xb9, 0xa7, 0xbf, 0x06, 0xcc, 0xfc, 0xa0, 0x93, 0x9f, 0x49, 0x90, 0xe4, 0x2f, 0xd8, 0x23,
+	0x24, 0x51, 0x52, 0x96, 0x27, 0x61, 0x51, 0x42, 0x01, 0x28, 0xe6, 0x61, 0x16, 0x5b, 0x86, 0x63,
+	0xb8, 0x83, 0x80, 0x37, 0xde, 0x75, 0x6d, 0x7d, 0x8a, 0xf9, 0x8c, 0x0d, 0x63, 0x59, 0x00, 0x66,
+	0x84, 0xd6, 0x1d, 0xa7, 0xef, 0x8e, 0x66, 0x8f, 0xbd, 0xff, 0xfe, 0xee, 0xbd, 0xd3, 0x76, 0xb0,
+	0xe7, 0xf8, 0x33, 0x76, 0xa2, 0x80, 0x24, 0x5a, 0xfd, 0x2a, 0xf0, 0xb0, 0x13, 0xf8, 0x0a, 0x24,
+	0x03, 0x4d, 0xf0, 0x57, 0xec, 0xac, 0x99, 0x03, 0xad, 0x41, 0x85, 0x9f, 0x77, 0xf0, 0x66, 0x98,
+	0xe0, 0x40, 0xf2, 0x2b, 0x76, 0xaf, 0xfe, 0x5b, 0x58, 0x88, 0x52, 0x2c, 0xd0, 0x3a, 0x71, 0x0c,
+	0x77, 0x34, 0x7b, 0x72, 0x7c, 0xb6, 0xeb, 0x8a, 0x09, 0xc6, 0x71, 0x5b, 0xf2, 0x37, 0x6c, 0xac,
+	0x40, 0x3f, 0x85, 0xee, 0x38, 0xad, 0x3a, 0x2e, 0x6e, 0x8f, 0xbb, 0x7b, 0x12, 0x5d, 0x61, 0xaa,
+	0x96, 0xe2, 0xaf, 0x99, 0x49, 0x62, 0x3e, 0x5f, 0x35, 0x05, 0x77, 0xab, 0x82, 0x49, 0xa7, 0xe0,
+	0xcb, 0x0e, 0xa9, 0xf3, 0x23, 0x3a, 0x88, 0xdd, 0x16, 0x11, 0xe4, 0x24, 0xf3, 0xfd, 0x16, 0xc3,
+	0xa3, 0x5b, 0x5c, 0x69, 0xa8, 0xd9, 0x22, 0x6a, 0x4b, 0x7e, 0xc9, 0x1e, 0x44, 0x90, 0x23, 0x65,
+	0xb4, 0xa4, 0x0c, 0xf2, 0x30, 0x15, 0x98, 0x5a, 0x67, 0x8e, 0xe1, 0x9a, 0xc1, 0xfd, 0xb6, 0xf1,
+	0x51, 0x60, 0xca, 0x27, 0x6c, 0x58, 0xa7, 0xd1, 0x62, 0x4e, 0xdf, 0x35, 0x83, 0xbd, 0x7e, 0xfb,
+	0xfe, 0xf7, 0xc6, 0x36, 0xd6, 0x1b, 0xdb, 0xf8, 0xbb, 0xb1, 0x8d, 0x9f, 0x5b, 0xbb, 0xb7, 0xde,
+	0xda, 0xbd, 0x3f, 0x5b, 0xbb, 0xf7, 0xed, 0x32, 0xc9, 0x28, 0x5d, 0xde, 0x78, 0x11, 0x2c, 0xfc,
+	0xfa, 0xd4, 0xf4, 0xe7, 0x39, 0xc6, 0xdf, 0xfd, 0x1f, 0xd5, 0xdd, 0xd1, 0xaa, 0x90, 0xe8, 0xab,
+	0xe9, 0xcd, 0x69, 0x75, 0x7a, 0x2f, 0xff, 0x0d, 0x00, 0x81, 0xf0, 0x05, 0xf4, 0xc1, 0x02, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -174,6 +205,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Contents) > 0 {
+		for iNdEx := len(m.Contents) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contents[iNdEx])
+			copy(dAtA[i:], m.Contents[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Contents[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ConstitutionHash) > 0 {
+		i -= len(m.ConstitutionHash)
+		copy(dAtA[i:], m.ConstitutionHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConstitutionHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ContentParams != nil {
+		{
+			size, err := m.ContentParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.TallyParams != nil {
 		{
 			size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
@@ -310,6 +369,20 @@ func (m *GenesisState) Size() (n int) {
 		l = m.TallyParams.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.ContentParams != nil {
+		l = m.ContentParams.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ConstitutionHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Contents) > 0 {
+		for _, b := range m.Contents {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -577,6 +650,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContentParams == nil {
+				m.ContentParams = &ContentParams{}
+			}
+			if err := m.ContentParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConstitutionHash = append(m.ConstitutionHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConstitutionHash == nil {
+				m.ConstitutionHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contents", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contents = append(m.Contents, make([]byte, postIndex-iNdEx))
+			copy(m.Contents[len(m.Contents)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -59,6 +59,53 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "invalid MinInitialDepositRatio",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams: &v1.DepositParams{
+					MinDeposit:             depositParams.MinDeposit,
+					MaxDepositPeriod:       depositParams.MaxDepositPeriod,
+					MinInitialDepositRatio: "1.1",
+				},
+				VotingParams: &votingParams,
+				TallyParams:  &tallyParams,
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid ConstitutionHash",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+				ConstitutionHash:   []byte("constitution"),
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate Contents",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+				Contents:           [][]byte{[]byte("constitution"), []byte("constitution")},
+			},
+			expErr: true,
+		},
+		{
+			name: "valid ConstitutionHash and Contents",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+				ConstitutionHash:   v1.ContentHash([]byte("constitution")),
+				Contents:           [][]byte{[]byte("constitution")},
+			},
+		},
 		{
 			name: "invalid DepositParams",
 			genesisState: &v1.GenesisState{
@@ -251,6 +251,9 @@ type Proposal struct {
 	VotingEndTime    *time.Time   `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time,omitempty"`
 	// metadata is any arbitrary metadata attached to the proposal.
 	Metadata string `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// content_hash is the SHA-256 hash of the long-form text of the proposal,
+	// which is stored off chain or in the content store of the module.
+	ContentHash []byte `protobuf:"bytes,11,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -356,6 +359,13 @@ func (m *Proposal) GetMetadata() string {
 	return ""
 }
 
+func (m *Proposal) GetContentHash() []byte {
+	if m != nil {
+		return m.ContentHash
+	}
+	return nil
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	YesCount        string `protobuf:"bytes,1,opt,name=yes_count,json=yesCount,proto3" json:"yes_count,omitempty"`
@@ -503,6 +513,9 @@ type DepositParams struct {
 	//  Maximum period for Atom holders to deposit on a proposal. Initial value: 2
 	//  months.
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
+	//  Minimum proportion of the minimum deposit which must be deposited when
+	//  submitting a proposal. Default value: 0 (disabled).
+	MinInitialDepositRatio string `protobuf:"bytes,3,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 }
 
 func (m *DepositParams) Reset()         { *m = DepositParams{} }
@@ -552,6 +565,13 @@ func (m *DepositParams) GetMaxDepositPeriod() *time.Duration {
 	return nil
 }
 
+func (m *DepositParams) GetMinInitialDepositRatio() string {
+	if m != nil {
+		return m.MinInitialDepositRatio
+	}
+	return ""
+}
+
 // VotingParams defines the params for voting on governance proposals.
 type VotingParams struct {
 	//  Length of the voting period.
@@ -608,6 +628,11 @@ type TallyParams struct {
 	//  Minimum value of Veto votes to Total votes ratio for proposal to be
 	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	//  Whether the deposits of a proposal vetoed are burned. Default value: true.
+	BurnVoteVeto bool `protobuf:"varint,4,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+	//  Whether the deposits of a proposal not reaching quorum are burned.
+	//  Default value: false.
+	BurnVoteQuorum bool `protobuf:"varint,5,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
 }
 
 func (m *TallyParams) Reset()         { *m = TallyParams{} }
@@ -664,6 +689,68 @@ func (m *TallyParams) GetVetoThreshold() string {
 	return ""
 }
 
+func (m *TallyParams) GetBurnVoteVeto() bool {
+	if m != nil {
+		return m.BurnVoteVeto
+	}
+	return false
+}
+
+func (m *TallyParams) GetBurnVoteQuorum() bool {
+	if m != nil {
+		return m.BurnVoteQuorum
+	}
+	return false
+}
+
+// ContentParams defines the params for the content store of the module, which
+// stores long-form texts such as the constitution or proposal texts by hash.
+type ContentParams struct {
+	//  Maximum size in bytes of a content stored on chain. The content store is
+	//  disabled if zero. Default value: 0.
+	MaxContentSize uint64 `protobuf:"varint,1,opt,name=max_content_size,json=maxContentSize,proto3" json:"max_content_size,omitempty"`
+}
+
+func (m *ContentParams) Reset()         { *m = ContentParams{} }
+func (m *ContentParams) String() string { return proto.CompactTextString(m) }
+func (*ContentParams) ProtoMessage()    {}
+func (*ContentParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
+}
+func (m *ContentParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContentParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContentParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContentParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContentParams.Merge(m, src)
+}
+func (m *ContentParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContentParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContentParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContentParams proto.InternalMessageInfo
+
+func (m *ContentParams) GetMaxContentSize() uint64 {
+	if m != nil {
+		return m.MaxContentSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -675,81 +762,92 @@ func init() {
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
+	proto.RegisterType((*ContentParams)(nil), "cosmos.gov.v1.ContentParams")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1104 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x53, 0xdb, 0x46,
-	0x14, 0x47, 0xb6, 0x30, 0xe6, 0x19, 0x1b, 0x75, 0xa1, 0x45, 0x90, 0x60, 0x13, 0x4f, 0xdb, 0xa1,
-	0xa4, 0xd8, 0x25, 0x99, 0xb6, 0x33, 0xcd, 0xc9, 0xc6, 0x4a, 0x31, 0xc3, 0x60, 0x57, 0x52, 0xcc,
-	0xa4, 0x17, 0x8d, 0x8c, 0x14, 0x5b, 0x53, 0x4b, 0xeb, 0x6a, 0xd7, 0x0e, 0xfe, 0x08, 0xbd, 0xe5,
-	0xd8, 0x99, 0x7e, 0x8d, 0x4c, 0x3f, 0x43, 0x4e, 0x9d, 0x4c, 0x0e, 0xfd, 0x73, 0x71, 0x5b, 0xb8,
-	0xf1, 0x29, 0x32, 0x5a, 0xad, 0xb0, 0x2d, 0xc8, 0xc0, 0x49, 0xd2, 0x7b, 0xbf, 0xdf, 0xef, 0xbd,
-	0xdd, 0xf7, 0xd3, 0x4a, 0xb0, 0x76, 0x8a, 0x89, 0x8b, 0x49, 0xb9, 0x83, 0x87, 0xe5, 0xe1, 0x5e,
-	0x70, 0x29, 0xf5, 0x7d, 0x4c, 0x31, 0xca, 0x86, 0x89, 0x52, 0x10, 0x19, 0xee, 0x6d, 0xe4, 0x39,
-	0xae, 0x6d, 0x12, 0xbb, 0x3c, 0xdc, 0x6b, 0xdb, 0xd4, 0xdc, 0x2b, 0x9f, 0x62, 0xc7, 0x0b, 0xe1,
-	0x1b, 0xab, 0x1d, 0xdc, 0xc1, 0xec, 0xb6, 0x1c, 0xdc, 0xf1, 0x68, 0xa1, 0x83, 0x71, 0xa7, 0x67,
-	0x97, 0xd9, 0x53, 0x7b, 0xf0, 0xa2, 0x4c, 0x1d, 0xd7, 0x26, 0xd4, 0x74, 0xfb, 0x1c, 0xb0, 0x1e,
-	0x07, 0x98, 0xde, 0x88, 0xa7, 0xf2, 0xf1, 0x94, 0x35, 0xf0, 0x4d, 0xea, 0xe0, 0xa8, 0xe2, 0x7a,
-	0xd8, 0x91, 0x11, 0x16, 0xe5, 0xdd, 0xb2, 0x87, 0x22, 0x06, 0x74, 0x62, 0x3b, 0x9d, 0x2e, 0xb5,
-	0xad, 0x16, 0xa6, 0x76, 0xa3, 0x1f, 0xd0, 0xd0, 0x1e, 0xa4, 0x30, 0xbb, 0x93, 0x85, 0x2d, 0x61,
-	0x3b, 0xf7, 0x68, 0xbd, 0x34, 0xb3, 0xc4, 0xd2, 0x04, 0xaa, 0x72, 0x20, 0xfa, 0x1c, 0x52, 0x2f,
-	0x99, 0x90, 0x9c, 0xd8, 0x12, 0xb6, 0x17, 0xab, 0xb9, 0x77, 0xaf, 0x77, 0x81, 0xb3, 0x6a, 0xf6,
-	0xa9, 0xca, 0xb3, 0xc5, 0xdf, 0x04, 0x58, 0xa8, 0xd9, 0x7d, 0x4c, 0x1c, 0x8a, 0x0a, 0x90, 0xe9,
-	0xfb, 0xb8, 0x8f, 0x89, 0xd9, 0x33, 0x1c, 0x8b, 0xd5, 0x12, 0x55, 0x88, 0x42, 0x75, 0x0b, 0x7d,
-	0x03, 0x8b, 0x56, 0x88, 0xc5, 0x3e, 0xd7, 0x95, 0xdf, 0xbd, 0xde, 0x5d, 0xe5, 0xba, 0x15, 0xcb,
-	0xf2, 0x6d, 0x42, 0x34, 0xea, 0x3b, 0x5e, 0x47, 0x9d, 0x40, 0xd1, 0xb7, 0x90, 0x32, 0x5d, 0x3c,
-	0xf0, 0xa8, 0x9c, 0xdc, 0x4a, 0x6e, 0x67, 0x26, 0xfd, 0x07, 0x33, 0x29, 0xf1, 0x99, 0x94, 0xf6,
-	0xb1, 0xe3, 0x55, 0xc5, 0x37, 0xe3, 0xc2, 0x9c, 0xca, 0xe1, 0xc5, 0x3f, 0x45, 0x48, 0x37, 0x79,
-	0x7d, 0x94, 0x83, 0xc4, 0x55, 0x57, 0x09, 0xc7, 0x42, 0x5f, 0x41, 0xda, 0xb5, 0x09, 0x31, 0x3b,
-	0x36, 0x91, 0x13, 0x4c, 0x77, 0xb5, 0x14, 0xee, 0x7c, 0x29, 0xda, 0xf9, 0x52, 0xc5, 0x1b, 0xa9,
-	0x57, 0x28, 0xf4, 0x35, 0xa4, 0x08, 0x35, 0xe9, 0x80, 0xc8, 0x49, 0xb6, 0x8f, 0x9b, 0xb1, 0x7d,
-	0x8c, 0x4a, 0x69, 0x0c, 0xa4, 0x72, 0x30, 0x3a, 0x00, 0xf4, 0xc2, 0xf1, 0xcc, 0x9e, 0x41, 0xcd,
-	0x5e, 0x6f, 0x64, 0xf8, 0x36, 0x19, 0xf4, 0xa8, 0x2c, 0x6e, 0x09, 0xdb, 0x99, 0x47, 0x1b, 0x31,
-	0x09, 0x3d, 0x80, 0xa8, 0x0c, 0xa1, 0x4a, 0x8c, 0x35, 0x15, 0x41, 0x15, 0xc8, 0x90, 0x41, 0xdb,
-	0x75, 0xa8, 0x11, 0xd8, 0x49, 0x9e, 0xe7, 0x12, 0xf1, 0xae, 0xf5, 0xc8, 0x6b, 0x55, 0xf1, 0xd5,
-	0xbf, 0x05, 0x41, 0x85, 0x90, 0x14, 0x84, 0xd1, 0x21, 0x48, 0x7c, 0x63, 0x0d, 0xdb, 0xb3, 0x42,
-	0x9d, 0xd4, 0x1d, 0x75, 0x72, 0x9c, 0xa9, 0x78, 0x16, 0xd3, 0xaa, 0x41, 0x96, 0x62, 0x6a, 0xf6,
-	0x0c, 0x1e, 0x97, 0x17, 0xee, 0x36, 0x9e, 0x25, 0xc6, 0x8a, 0x6c, 0x73, 0x04, 0x1f, 0x0d, 0x31,
-	0x75, 0xbc, 0x8e, 0x41, 0xa8, 0xe9, 0xf3, 0xa5, 0xa5, 0xef, 0xd8, 0xd2, 0x72, 0x48, 0xd5, 0x02,
-	0x26, 0xeb, 0xe9, 0x00, 0x78, 0x68, 0xb2, 0xbc, 0xc5, 0x3b, 0x6a, 0x65, 0x43, 0x62, 0xb4, 0xba,
-	0x8d, 0xc0, 0x1f, 0xd4, 0xb4, 0x4c, 0x6a, 0xca, 0x10, 0x98, 0x55, 0xbd, 0x7a, 0x2e, 0xfe, 0x25,
-	0x40, 0x66, 0x7a, 0x30, 0x0f, 0x61, 0x71, 0x64, 0x13, 0xe3, 0x94, 0x99, 0x54, 0xb8, 0xf6, 0xc6,
-	0xd4, 0x3d, 0xaa, 0xa6, 0x47, 0x36, 0xd9, 0x0f, 0xf2, 0xe8, 0x31, 0x64, 0xcd, 0x36, 0xa1, 0xa6,
-	0xe3, 0x71, 0x42, 0xe2, 0x46, 0xc2, 0x12, 0x07, 0x85, 0xa4, 0x2f, 0x20, 0xed, 0x61, 0x8e, 0x4f,
-	0xde, 0x88, 0x5f, 0xf0, 0x70, 0x08, 0x7d, 0x02, 0xc8, 0xc3, 0xc6, 0x4b, 0x87, 0x76, 0x8d, 0xa1,
-	0x4d, 0x23, 0x92, 0x78, 0x23, 0x69, 0xd9, 0xc3, 0x27, 0x0e, 0xed, 0xb6, 0x6c, 0x1a, 0x92, 0x8b,
-	0xbf, 0x0b, 0x20, 0x06, 0xe7, 0xc1, 0xed, 0x6f, 0x73, 0x09, 0xe6, 0x87, 0x98, 0xda, 0xb7, 0xbf,
-	0xc9, 0x21, 0x0c, 0x3d, 0x81, 0x85, 0xf0, 0x70, 0x21, 0xb2, 0xc8, 0x7c, 0xf2, 0x20, 0xe6, 0xfd,
-	0xeb, 0x27, 0x97, 0x1a, 0x31, 0x66, 0x86, 0x31, 0x3f, 0x3b, 0x8c, 0x43, 0x31, 0x9d, 0x94, 0xc4,
-	0xe2, 0x3f, 0x02, 0x64, 0xb9, 0xa5, 0x9a, 0xa6, 0x6f, 0xba, 0x04, 0x3d, 0x87, 0x8c, 0xeb, 0x78,
-	0x57, 0xe6, 0x14, 0x6e, 0x33, 0xe7, 0x66, 0x60, 0xce, 0xcb, 0x71, 0xe1, 0xe3, 0x29, 0xd6, 0x97,
-	0xd8, 0x75, 0xa8, 0xed, 0xf6, 0xe9, 0x48, 0x05, 0xd7, 0xf1, 0x22, 0xcf, 0xba, 0x80, 0x5c, 0xf3,
-	0x2c, 0x02, 0x19, 0x7d, 0xdb, 0x77, 0xb0, 0xc5, 0x36, 0x22, 0xa8, 0x10, 0x37, 0x5a, 0x8d, 0x9f,
-	0xdf, 0xd5, 0x4f, 0x2f, 0xc7, 0x85, 0xfb, 0xd7, 0x89, 0x93, 0x22, 0xbf, 0x06, 0x3e, 0x94, 0x5c,
-	0xf3, 0x2c, 0x5a, 0x09, 0xcb, 0x17, 0x75, 0x58, 0x6a, 0x31, 0x6f, 0xf2, 0x95, 0xd5, 0x80, 0x7b,
-	0x35, 0xaa, 0x2c, 0xdc, 0x56, 0x59, 0x64, 0xca, 0x4b, 0x21, 0x8b, 0xab, 0xfe, 0x1f, 0x99, 0x98,
-	0xab, 0x7e, 0x07, 0xa9, 0x9f, 0x07, 0xd8, 0x1f, 0xb8, 0xdc, 0xc1, 0xc5, 0xcb, 0x71, 0x41, 0x0a,
-	0x23, 0x93, 0x0e, 0xe3, 0xdf, 0x81, 0x30, 0x8f, 0xf6, 0x61, 0x91, 0x76, 0x7d, 0x9b, 0x74, 0x71,
-	0xcf, 0xe2, 0x86, 0xf8, 0xec, 0x72, 0x5c, 0x58, 0xb9, 0x0a, 0x7e, 0x50, 0x61, 0xc2, 0x43, 0x3f,
-	0x40, 0x8e, 0x19, 0x76, 0xa2, 0x14, 0x3a, 0x7d, 0xe7, 0x72, 0x5c, 0x90, 0x67, 0x33, 0x1f, 0x94,
-	0xcb, 0x06, 0x38, 0x3d, 0x82, 0xed, 0xfc, 0x22, 0x00, 0x4c, 0x7d, 0x09, 0xef, 0xc1, 0x5a, 0xab,
-	0xa1, 0x2b, 0x46, 0xa3, 0xa9, 0xd7, 0x1b, 0xc7, 0xc6, 0xb3, 0x63, 0xad, 0xa9, 0xec, 0xd7, 0x9f,
-	0xd6, 0x95, 0x9a, 0x34, 0x87, 0x56, 0x60, 0x79, 0x3a, 0xf9, 0x5c, 0xd1, 0x24, 0x01, 0xad, 0xc1,
-	0xca, 0x74, 0xb0, 0x52, 0xd5, 0xf4, 0x4a, 0xfd, 0x58, 0x4a, 0x20, 0x04, 0xb9, 0xe9, 0xc4, 0x71,
-	0x43, 0x4a, 0xa2, 0xfb, 0x20, 0xcf, 0xc6, 0x8c, 0x93, 0xba, 0x7e, 0x60, 0xb4, 0x14, 0xbd, 0x21,
-	0x89, 0x3b, 0x7f, 0x08, 0x90, 0x9b, 0xfd, 0x44, 0xa0, 0x02, 0xdc, 0x6b, 0xaa, 0x8d, 0x66, 0x43,
-	0xab, 0x1c, 0x19, 0x9a, 0x5e, 0xd1, 0x9f, 0x69, 0xb1, 0x9e, 0x8a, 0x90, 0x8f, 0x03, 0x6a, 0x4a,
-	0xb3, 0xa1, 0xd5, 0x75, 0xa3, 0xa9, 0xa8, 0xf5, 0x46, 0x4d, 0x12, 0xd0, 0x03, 0xd8, 0x8c, 0x63,
-	0x5a, 0x0d, 0xbd, 0x7e, 0xfc, 0x7d, 0x04, 0x49, 0xa0, 0x0d, 0xf8, 0x24, 0x0e, 0x69, 0x56, 0x34,
-	0x4d, 0xa9, 0x85, 0x4d, 0xc7, 0x73, 0xaa, 0x72, 0xa8, 0xec, 0xeb, 0x4a, 0x4d, 0x12, 0x6f, 0x62,
-	0x3e, 0xad, 0xd4, 0x8f, 0x94, 0x9a, 0x34, 0x5f, 0x55, 0xde, 0x9c, 0xe7, 0x85, 0xb7, 0xe7, 0x79,
-	0xe1, 0xbf, 0xf3, 0xbc, 0xf0, 0xea, 0x22, 0x3f, 0xf7, 0xf6, 0x22, 0x3f, 0xf7, 0xf7, 0x45, 0x7e,
-	0xee, 0xc7, 0x87, 0x1d, 0x87, 0x76, 0x07, 0xed, 0xd2, 0x29, 0x76, 0xf9, 0x0f, 0x0a, 0xbf, 0xec,
-	0x12, 0xeb, 0xa7, 0xf2, 0x19, 0xfb, 0xe9, 0xa2, 0xa3, 0xbe, 0x4d, 0x82, 0x3f, 0xaa, 0x14, 0xb3,
-	0xeb, 0xe3, 0xf7, 0x01, 0x00, 0x00, 0xff, 0xff, 0x73, 0xa8, 0x09, 0x8c, 0x92, 0x09, 0x00, 0x00,
+	// 1255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x6f, 0x13, 0xc7,
+	0x1b, 0xce, 0x3a, 0x4e, 0xe2, 0xbc, 0xfe, 0xc3, 0xfe, 0x06, 0x7e, 0xb0, 0x09, 0x60, 0x07, 0xf7,
+	0x8f, 0x52, 0x28, 0x76, 0x03, 0xa2, 0x95, 0xca, 0xc9, 0x8e, 0x97, 0xc6, 0x08, 0xc5, 0x66, 0xbd,
+	0x04, 0xd1, 0xcb, 0x6a, 0x9d, 0x1d, 0xec, 0x51, 0xbd, 0x3b, 0xae, 0x67, 0x6c, 0x62, 0xbe, 0x41,
+	0x6f, 0x1c, 0x2b, 0xf5, 0x6b, 0xa0, 0x7e, 0x06, 0xa4, 0x4a, 0x15, 0xe2, 0xd2, 0x9e, 0xdc, 0x0a,
+	0x6e, 0xbe, 0xf4, 0xdc, 0x5b, 0x35, 0xb3, 0xb3, 0xb1, 0xbd, 0x24, 0x4a, 0x4e, 0xde, 0x7d, 0xdf,
+	0xe7, 0x79, 0xe6, 0x9d, 0x77, 0x9e, 0x77, 0xbc, 0x70, 0xe5, 0x90, 0x32, 0x9f, 0xb2, 0x72, 0x87,
+	0x8e, 0xca, 0xa3, 0x1d, 0xf1, 0x53, 0xea, 0x0f, 0x28, 0xa7, 0x28, 0x1b, 0x26, 0x4a, 0x22, 0x32,
+	0xda, 0xd9, 0xcc, 0x2b, 0x5c, 0xdb, 0x65, 0xb8, 0x3c, 0xda, 0x69, 0x63, 0xee, 0xee, 0x94, 0x0f,
+	0x29, 0x09, 0x42, 0xf8, 0xe6, 0xa5, 0x0e, 0xed, 0x50, 0xf9, 0x58, 0x16, 0x4f, 0x2a, 0x5a, 0xe8,
+	0x50, 0xda, 0xe9, 0xe1, 0xb2, 0x7c, 0x6b, 0x0f, 0x9f, 0x97, 0x39, 0xf1, 0x31, 0xe3, 0xae, 0xdf,
+	0x57, 0x80, 0x8d, 0x38, 0xc0, 0x0d, 0xc6, 0x2a, 0x95, 0x8f, 0xa7, 0xbc, 0xe1, 0xc0, 0xe5, 0x84,
+	0x46, 0x2b, 0x6e, 0x84, 0x15, 0x39, 0xe1, 0xa2, 0xaa, 0x5a, 0xf9, 0x52, 0xa4, 0x80, 0x9e, 0x62,
+	0xd2, 0xe9, 0x72, 0xec, 0x1d, 0x50, 0x8e, 0x1b, 0x7d, 0x41, 0x43, 0x3b, 0xb0, 0x4a, 0xe5, 0x93,
+	0xa1, 0x6d, 0x69, 0xdb, 0xb9, 0x3b, 0x1b, 0xa5, 0x85, 0x2d, 0x96, 0x66, 0x50, 0x4b, 0x01, 0xd1,
+	0xe7, 0xb0, 0xfa, 0x42, 0x0a, 0x19, 0x89, 0x2d, 0x6d, 0x7b, 0xbd, 0x9a, 0x7b, 0xf7, 0xfa, 0x36,
+	0x28, 0x56, 0x0d, 0x1f, 0x5a, 0x2a, 0x5b, 0xfc, 0x45, 0x83, 0xb5, 0x1a, 0xee, 0x53, 0x46, 0x38,
+	0x2a, 0x40, 0xba, 0x3f, 0xa0, 0x7d, 0xca, 0xdc, 0x9e, 0x43, 0x3c, 0xb9, 0x56, 0xd2, 0x82, 0x28,
+	0x54, 0xf7, 0xd0, 0xd7, 0xb0, 0xee, 0x85, 0x58, 0x3a, 0x50, 0xba, 0xc6, 0xbb, 0xd7, 0xb7, 0x2f,
+	0x29, 0xdd, 0x8a, 0xe7, 0x0d, 0x30, 0x63, 0x2d, 0x3e, 0x20, 0x41, 0xc7, 0x9a, 0x41, 0xd1, 0x37,
+	0xb0, 0xea, 0xfa, 0x74, 0x18, 0x70, 0x63, 0x79, 0x6b, 0x79, 0x3b, 0x3d, 0xab, 0x5f, 0x9c, 0x49,
+	0x49, 0x9d, 0x49, 0x69, 0x97, 0x92, 0xa0, 0x9a, 0x7c, 0x33, 0x29, 0x2c, 0x59, 0x0a, 0x5e, 0xfc,
+	0x37, 0x09, 0xa9, 0xa6, 0x5a, 0x1f, 0xe5, 0x20, 0x71, 0x5c, 0x55, 0x82, 0x78, 0xe8, 0x2b, 0x48,
+	0xf9, 0x98, 0x31, 0xb7, 0x83, 0x99, 0x91, 0x90, 0xba, 0x97, 0x4a, 0x61, 0xe7, 0x4b, 0x51, 0xe7,
+	0x4b, 0x95, 0x60, 0x6c, 0x1d, 0xa3, 0xd0, 0x3d, 0x58, 0x65, 0xdc, 0xe5, 0x43, 0x66, 0x2c, 0xcb,
+	0x3e, 0x5e, 0x8f, 0xf5, 0x31, 0x5a, 0xaa, 0x25, 0x41, 0x96, 0x02, 0xa3, 0x3d, 0x40, 0xcf, 0x49,
+	0xe0, 0xf6, 0x1c, 0xee, 0xf6, 0x7a, 0x63, 0x67, 0x80, 0xd9, 0xb0, 0xc7, 0x8d, 0xe4, 0x96, 0xb6,
+	0x9d, 0xbe, 0xb3, 0x19, 0x93, 0xb0, 0x05, 0xc4, 0x92, 0x08, 0x4b, 0x97, 0xac, 0xb9, 0x08, 0xaa,
+	0x40, 0x9a, 0x0d, 0xdb, 0x3e, 0xe1, 0x8e, 0xb0, 0x93, 0xb1, 0xa2, 0x24, 0xe2, 0x55, 0xdb, 0x91,
+	0xd7, 0xaa, 0xc9, 0x57, 0x7f, 0x15, 0x34, 0x0b, 0x42, 0x92, 0x08, 0xa3, 0x87, 0xa0, 0xab, 0xc6,
+	0x3a, 0x38, 0xf0, 0x42, 0x9d, 0xd5, 0x73, 0xea, 0xe4, 0x14, 0xd3, 0x0c, 0x3c, 0xa9, 0x55, 0x83,
+	0x2c, 0xa7, 0xdc, 0xed, 0x39, 0x2a, 0x6e, 0xac, 0x9d, 0xef, 0x78, 0x32, 0x92, 0x15, 0xd9, 0xe6,
+	0x11, 0xfc, 0x6f, 0x44, 0x39, 0x09, 0x3a, 0x0e, 0xe3, 0xee, 0x40, 0x6d, 0x2d, 0x75, 0xce, 0x92,
+	0x2e, 0x84, 0xd4, 0x96, 0x60, 0xca, 0x9a, 0xf6, 0x40, 0x85, 0x66, 0xdb, 0x5b, 0x3f, 0xa7, 0x56,
+	0x36, 0x24, 0x46, 0xbb, 0xdb, 0x14, 0xfe, 0xe0, 0xae, 0xe7, 0x72, 0xd7, 0x00, 0x61, 0x56, 0xeb,
+	0xf8, 0x1d, 0xdd, 0x80, 0xcc, 0x21, 0x0d, 0x38, 0x0e, 0xb8, 0xd3, 0x75, 0x59, 0xd7, 0x48, 0x6f,
+	0x69, 0xdb, 0x19, 0x2b, 0xad, 0x62, 0x7b, 0x2e, 0xeb, 0x16, 0xff, 0xd0, 0x20, 0x3d, 0x7f, 0x76,
+	0xb7, 0x60, 0x7d, 0x8c, 0x99, 0x73, 0x28, 0x7d, 0xac, 0x7d, 0x34, 0x54, 0xf5, 0x80, 0x5b, 0xa9,
+	0x31, 0x66, 0xbb, 0x22, 0x8f, 0xee, 0x42, 0xd6, 0x6d, 0x33, 0xee, 0x92, 0x40, 0x11, 0x12, 0x27,
+	0x12, 0x32, 0x0a, 0x14, 0x92, 0xbe, 0x80, 0x54, 0x40, 0x15, 0x7e, 0xf9, 0x44, 0xfc, 0x5a, 0x40,
+	0x43, 0xe8, 0x7d, 0x40, 0x01, 0x75, 0x5e, 0x10, 0xde, 0x75, 0x46, 0x98, 0x47, 0xa4, 0xe4, 0x89,
+	0xa4, 0x0b, 0x01, 0x7d, 0x4a, 0x78, 0xf7, 0x00, 0xf3, 0x90, 0x5c, 0xfc, 0x55, 0x83, 0xa4, 0xb8,
+	0x32, 0xce, 0x1e, 0xf8, 0x12, 0xac, 0x8c, 0x28, 0xc7, 0x67, 0x0f, 0x7b, 0x08, 0x43, 0xf7, 0x61,
+	0x2d, 0xbc, 0x7f, 0x98, 0x91, 0x94, 0x56, 0xba, 0x11, 0x1b, 0x8f, 0x8f, 0x2f, 0x37, 0x2b, 0x62,
+	0x2c, 0x9c, 0xd7, 0xca, 0xe2, 0x79, 0x3d, 0x4c, 0xa6, 0x96, 0xf5, 0x64, 0xf1, 0xb7, 0x04, 0x64,
+	0x95, 0xeb, 0x9a, 0xee, 0xc0, 0xf5, 0x19, 0x7a, 0x06, 0x69, 0x9f, 0x04, 0xc7, 0xfe, 0xd5, 0xce,
+	0xf2, 0xef, 0x75, 0xe1, 0xdf, 0xe9, 0xa4, 0xf0, 0xff, 0x39, 0xd6, 0x97, 0xd4, 0x27, 0x1c, 0xfb,
+	0x7d, 0x3e, 0xb6, 0xc0, 0x27, 0x41, 0x64, 0x6b, 0x1f, 0x90, 0xef, 0x1e, 0x45, 0x20, 0xa7, 0x8f,
+	0x07, 0x84, 0x7a, 0xb2, 0x11, 0x62, 0x85, 0xb8, 0x17, 0x6b, 0xea, 0x8a, 0xaf, 0x7e, 0x3a, 0x9d,
+	0x14, 0xae, 0x7d, 0x4c, 0x9c, 0x2d, 0xf2, 0xb3, 0xb0, 0xaa, 0xee, 0xbb, 0x47, 0xd1, 0x4e, 0x64,
+	0x1e, 0xf5, 0x61, 0x43, 0xd4, 0x44, 0x02, 0xc2, 0xc9, 0x6c, 0x22, 0x1d, 0xa9, 0xaa, 0xdc, 0x70,
+	0x6f, 0x3a, 0x29, 0x7c, 0x72, 0x2a, 0x68, 0xb6, 0x42, 0xec, 0xaa, 0xbf, 0xec, 0x93, 0xa0, 0x1e,
+	0x32, 0xd4, 0x92, 0x96, 0xc0, 0x17, 0x6d, 0xc8, 0x1c, 0xc8, 0x81, 0x51, 0xbd, 0xac, 0x81, 0x1a,
+	0xa0, 0x68, 0xaf, 0xda, 0x59, 0x7b, 0x4d, 0xca, 0xbd, 0x64, 0x42, 0x56, 0xb8, 0x8f, 0xe2, 0x3f,
+	0x09, 0x35, 0x36, 0x4a, 0xf5, 0x5b, 0x58, 0xfd, 0x71, 0x48, 0x07, 0x43, 0x5f, 0xcd, 0x4c, 0x71,
+	0x3a, 0x29, 0xe8, 0x61, 0xe4, 0xd4, 0x8a, 0x15, 0x03, 0xed, 0xc2, 0x3a, 0xef, 0x0e, 0x30, 0xeb,
+	0xd2, 0x9e, 0xa7, 0x2c, 0xf8, 0xd9, 0x74, 0x52, 0xb8, 0x78, 0x1c, 0x3c, 0x55, 0x61, 0xc6, 0x43,
+	0x8f, 0x21, 0x27, 0x47, 0x64, 0xa6, 0x14, 0x76, 0xf3, 0xe6, 0x74, 0x52, 0x30, 0x16, 0x33, 0xa7,
+	0xca, 0x65, 0x05, 0xce, 0x3e, 0x96, 0xac, 0x42, 0xae, 0x3d, 0x1c, 0x04, 0x8e, 0x30, 0xbd, 0x9c,
+	0x3f, 0x39, 0x79, 0xa9, 0xea, 0x35, 0x21, 0xb9, 0x98, 0x99, 0x33, 0x57, 0x46, 0x64, 0x84, 0xf1,
+	0xc5, 0x24, 0xa2, 0x3d, 0xd0, 0x67, 0x48, 0xd5, 0xa1, 0x15, 0xa9, 0x92, 0x9f, 0x4e, 0x0a, 0x9b,
+	0xf1, 0xdc, 0x9c, 0x4e, 0x2e, 0xd2, 0x79, 0x2c, 0x33, 0xc5, 0x67, 0x90, 0xdd, 0x0d, 0xef, 0x2d,
+	0xd5, 0xf2, 0x3d, 0x10, 0xf6, 0x72, 0xa2, 0x0b, 0x8e, 0x91, 0x97, 0x38, 0x9c, 0xed, 0x50, 0x3a,
+	0x9e, 0x9b, 0x97, 0xf6, 0xdd, 0x23, 0xa5, 0xd5, 0x22, 0x2f, 0xf1, 0xcd, 0x9f, 0x34, 0x80, 0xb9,
+	0xef, 0x90, 0xab, 0x70, 0xe5, 0xa0, 0x61, 0x9b, 0x4e, 0xa3, 0x69, 0xd7, 0x1b, 0xfb, 0xce, 0x93,
+	0xfd, 0x56, 0xd3, 0xdc, 0xad, 0x3f, 0xa8, 0x9b, 0x35, 0x7d, 0x09, 0x5d, 0x84, 0x0b, 0xf3, 0xc9,
+	0x67, 0x66, 0x4b, 0xd7, 0xd0, 0x15, 0xb8, 0x38, 0x1f, 0xac, 0x54, 0x5b, 0x76, 0xa5, 0xbe, 0xaf,
+	0x27, 0x10, 0x82, 0xdc, 0x7c, 0x62, 0xbf, 0xa1, 0x2f, 0xa3, 0x6b, 0x60, 0x2c, 0xc6, 0x9c, 0xa7,
+	0x75, 0x7b, 0xcf, 0x39, 0x30, 0xed, 0x86, 0x9e, 0xbc, 0xf9, 0xbb, 0x06, 0xb9, 0xc5, 0x3f, 0x68,
+	0x54, 0x80, 0xab, 0x4d, 0xab, 0xd1, 0x6c, 0xb4, 0x2a, 0x8f, 0x9c, 0x96, 0x5d, 0xb1, 0x9f, 0xb4,
+	0x62, 0x35, 0x15, 0x21, 0x1f, 0x07, 0xd4, 0xcc, 0x66, 0xa3, 0x55, 0xb7, 0x9d, 0xa6, 0x69, 0xd5,
+	0x1b, 0x35, 0x5d, 0x43, 0x37, 0xe0, 0x7a, 0x1c, 0x73, 0xd0, 0xb0, 0xeb, 0xfb, 0xdf, 0x45, 0x90,
+	0x04, 0xda, 0x84, 0xcb, 0x71, 0x48, 0xb3, 0xd2, 0x6a, 0x99, 0xb5, 0xb0, 0xe8, 0x78, 0xce, 0x32,
+	0x1f, 0x9a, 0xbb, 0xb6, 0x59, 0xd3, 0x93, 0x27, 0x31, 0x1f, 0x54, 0xea, 0x8f, 0xcc, 0x9a, 0xbe,
+	0x52, 0x35, 0xdf, 0xbc, 0xcf, 0x6b, 0x6f, 0xdf, 0xe7, 0xb5, 0xbf, 0xdf, 0xe7, 0xb5, 0x57, 0x1f,
+	0xf2, 0x4b, 0x6f, 0x3f, 0xe4, 0x97, 0xfe, 0xfc, 0x90, 0x5f, 0xfa, 0xfe, 0x56, 0x87, 0xf0, 0xee,
+	0xb0, 0x5d, 0x3a, 0xa4, 0xbe, 0xfa, 0x3c, 0x54, 0x3f, 0xb7, 0x99, 0xf7, 0x43, 0xf9, 0x48, 0x7e,
+	0xf2, 0xf2, 0x71, 0x1f, 0x33, 0xf1, 0x3d, 0xbb, 0x2a, 0xe7, 0xf2, 0xee, 0x7f, 0x03, 0x00, 0xfe,
+	0xc4, 0x2a, 0x77, 0x10, 0x0b, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -856,6 +954,13 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1083,6 +1188,13 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinInitialDepositRatio) > 0 {
+		i -= len(m.MinInitialDepositRatio)
+		copy(dAtA[i:], m.MinInitialDepositRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MinInitialDepositRatio)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.MaxDepositPeriod != nil {
 		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
 		if err6 != nil {
@@ -1163,6 +1275,26 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BurnVoteQuorum {
+		i--
+		if m.BurnVoteQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BurnVoteVeto {
+		i--
+		if m.BurnVoteVeto {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.VetoThreshold) > 0 {
 		i -= len(m.VetoThreshold)
 		copy(dAtA[i:], m.VetoThreshold)
@@ -1187,6 +1319,34 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContentParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContentParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContentParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxContentSize != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxContentSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1284,6 +1444,10 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1354,6 +1518,10 @@ func (m *DepositParams) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod)
 		n += 1 + l + sovGov(uint64(l))
 	}
+	l = len(m.MinInitialDepositRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1388,6 +1556,24 @@ func (m *TallyParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.BurnVoteVeto {
+		n += 2
+	}
+	if m.BurnVoteQuorum {
+		n += 2
+	}
+	return n
+}
+
+func (m *ContentParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxContentSize != 0 {
+		n += 1 + sovGov(uint64(m.MaxContentSize))
+	}
 	return n
 }
 
@@ -1980,6 +2166,40 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = append(m.ContentHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContentHash == nil {
+				m.ContentHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2445,6 +2665,38 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2677,6 +2929,115 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			}
 			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnVoteVeto = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnVoteQuorum = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContentParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContentParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContentParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContentSize", wireType)
+			}
+			m.MaxContentSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContentSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -12,8 +12,8 @@ import (
 )
 
 var (
-	_, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}
-	_, _          codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgStoreContent{}, &MsgSetConstitution{}
+	_, _                codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -60,9 +60,15 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, deposit.String())
 	}
 
-	// Check that either metadata or Msgs length is non nil.
-	if len(m.Messages) == 0 && len(m.Metadata) == 0 {
-		return sdkerrors.Wrap(types.ErrNoProposalMsgs, "either metadata or Msgs length must be non-nil")
+	// Check that either metadata, content hash or Msgs length is non nil.
+	if len(m.Messages) == 0 && len(m.Metadata) == 0 && len(m.ContentHash) == 0 {
+		return sdkerrors.Wrap(types.ErrNoProposalMsgs, "either metadata, content hash or Msgs length must be non-nil")
+	}
+
+	if len(m.ContentHash) > 0 {
+		if err := ValidateContentHash(m.ContentHash); err != nil {
+			return err
+		}
 	}
 
 	msgs, err := m.GetMsgs()
@@ -260,3 +266,71 @@ func (m MsgExecLegacyContent) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 	var content v1beta1.Content
 	return unpacker.UnpackAny(m.Content, &content)
 }
+
+// NewMsgStoreContent creates a message to store a content in the content store
+//nolint:interfacer
+func NewMsgStoreContent(sender sdk.AccAddress, content []byte) *MsgStoreContent {
+	return &MsgStoreContent{Sender: sender.String(), Content: content}
+}
+
+// Route implements Msg
+func (msg MsgStoreContent) Route() string { return types.RouterKey }
+
+// Type implements Msg
+func (msg MsgStoreContent) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements Msg
+func (msg MsgStoreContent) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if len(msg.Content) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty content")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgStoreContent) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgStoreContent) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgSetConstitution creates a message to set the hash of the constitution
+func NewMsgSetConstitution(authority string, contentHash []byte) *MsgSetConstitution {
+	return &MsgSetConstitution{Authority: authority, ContentHash: contentHash}
+}
+
+// Route implements Msg
+func (msg MsgSetConstitution) Route() string { return types.RouterKey }
+
+// Type implements Msg
+func (msg MsgSetConstitution) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements Msg
+func (msg MsgSetConstitution) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return ValidateContentHash(msg.ContentHash)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgSetConstitution) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgSetConstitution) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
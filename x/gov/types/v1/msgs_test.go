@@ -165,6 +165,57 @@ func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
 			require.NoError(t, msg.ValidateBasic(), "test: %s", tc.name)
 		}
 	}
+
+	// a content hash can replace the msgs and metadata
+	msg, err := v1.NewMsgSubmitProposal(nil, coinsPos, addrs[0].String(), "")
+	require.NoError(t, err)
+	msg.ContentHash = []byte("text")
+	require.Error(t, msg.ValidateBasic())
+	msg.ContentHash = v1.ContentHash([]byte("text"))
+	require.NoError(t, msg.ValidateBasic())
+}
+
+func TestMsgStoreContent(t *testing.T) {
+	tests := []struct {
+		sender     sdk.AccAddress
+		content    []byte
+		expectPass bool
+	}{
+		{addrs[0], []byte("text"), true},
+		{sdk.AccAddress{}, []byte("text"), false},
+		{addrs[0], nil, false},
+	}
+
+	for i, tc := range tests {
+		msg := v1.NewMsgStoreContent(tc.sender, tc.content)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgSetConstitution(t *testing.T) {
+	tests := []struct {
+		authority  string
+		hash       []byte
+		expectPass bool
+	}{
+		{addrs[0].String(), v1.ContentHash([]byte("text")), true},
+		{"", v1.ContentHash([]byte("text")), false},
+		{addrs[0].String(), []byte("text"), false},
+		{addrs[0].String(), nil, false},
+	}
+
+	for i, tc := range tests {
+		msg := v1.NewMsgSetConstitution(tc.authority, tc.hash)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
 }
 
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
@@ -20,6 +20,12 @@ var (
 	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
 	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
 	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+
+	DefaultMinInitialDepositRatio = sdk.ZeroDec()
+	DefaultBurnVoteVeto           = true
+	DefaultBurnVoteQuorum         = false
+
+	DefaultMaxContentSize uint64 = 0
 )
 
 // Parameter store key
@@ -27,6 +33,7 @@ var (
 	ParamStoreKeyDepositParams = []byte("depositparams")
 	ParamStoreKeyVotingParams  = []byte("votingparams")
 	ParamStoreKeyTallyParams   = []byte("tallyparams")
+	ParamStoreKeyContentParams = []byte("contentparams")
 )
 
 // ParamKeyTable - Key declaration for parameters
@@ -35,14 +42,16 @@ func ParamKeyTable() paramtypes.KeyTable {
 		paramtypes.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyContentParams, ContentParams{}, validateContentParams),
 	)
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration, minInitialDepositRatio sdk.Dec) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: &maxDepositPeriod,
+		MinDeposit:             minDeposit,
+		MaxDepositPeriod:       &maxDepositPeriod,
+		MinInitialDepositRatio: minInitialDepositRatio.String(),
 	}
 }
 
@@ -51,12 +60,39 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		DefaultMinInitialDepositRatio,
 	)
 }
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return sdk.Coins(dp.MinDeposit).IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return sdk.Coins(dp.MinDeposit).IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.MinInitialDepositRatio == dp2.MinInitialDepositRatio
+}
+
+// minInitialDepositRatio returns the minimum initial deposit ratio, an unset
+// ratio, as in the params stored before its introduction, being zero.
+func (dp DepositParams) minInitialDepositRatio() sdk.Dec {
+	if dp.MinInitialDepositRatio == "" {
+		return sdk.ZeroDec()
+	}
+
+	return sdk.MustNewDecFromStr(dp.MinInitialDepositRatio)
+}
+
+// MinInitialDeposit returns the minimum deposit required when submitting a
+// proposal, that is the minimum deposit multiplied by the minimum initial
+// deposit ratio.
+func (dp DepositParams) MinInitialDeposit() sdk.Coins {
+	ratio := dp.minInitialDepositRatio()
+
+	minInitialDeposit := sdk.NewCoins()
+	for _, coin := range dp.MinDeposit {
+		amount := coin.Amount.ToDec().Mul(ratio).RoundInt()
+		minInitialDeposit = minInitialDeposit.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	return minInitialDeposit
 }
 
 func validateDepositParams(i interface{}) error {
@@ -71,27 +107,42 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod == nil || v.MaxDepositPeriod.Seconds() <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if v.MinInitialDepositRatio != "" {
+		ratio, err := sdk.NewDecFromStr(v.MinInitialDepositRatio)
+		if err != nil {
+			return fmt.Errorf("invalid minimum initial deposit ratio string: %w", err)
+		}
+		if ratio.IsNegative() {
+			return fmt.Errorf("minimum initial deposit ratio cannot be negative: %s", ratio)
+		}
+		if ratio.GT(sdk.OneDec()) {
+			return fmt.Errorf("minimum initial deposit ratio too large: %s", ratio)
+		}
+	}
 
 	return nil
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, vetoThreshold sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, vetoThreshold sdk.Dec, burnVoteVeto, burnVoteQuorum bool) TallyParams {
 	return TallyParams{
-		Quorum:        quorum.String(),
-		Threshold:     threshold.String(),
-		VetoThreshold: vetoThreshold.String(),
+		Quorum:         quorum.String(),
+		Threshold:      threshold.String(),
+		VetoThreshold:  vetoThreshold.String(),
+		BurnVoteVeto:   burnVoteVeto,
+		BurnVoteQuorum: burnVoteQuorum,
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, DefaultBurnVoteVeto, DefaultBurnVoteQuorum)
 }
 
 // Equal checks equality of TallyParams
 func (tp TallyParams) Equal(other TallyParams) bool {
-	return tp.Quorum == other.Quorum && tp.Threshold == other.Threshold && tp.VetoThreshold == other.VetoThreshold
+	return tp.Quorum == other.Quorum && tp.Threshold == other.Threshold && tp.VetoThreshold == other.VetoThreshold &&
+		tp.BurnVoteVeto == other.BurnVoteVeto && tp.BurnVoteQuorum == other.BurnVoteQuorum
 }
 
 func validateTallyParams(i interface{}) error {
@@ -108,7 +159,7 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("quorom cannot be negative: %s", quorum)
 	}
 	if quorum.GT(sdk.OneDec()) {
-		return fmt.Errorf("quorom too large: %s", v)
+		return fmt.Errorf("quorom too large: %s", quorum)
 	}
 
 	threshold, err := sdk.NewDecFromStr(v.Threshold)
@@ -119,7 +170,7 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("vote threshold must be positive: %s", threshold)
 	}
 	if threshold.GT(sdk.OneDec()) {
-		return fmt.Errorf("vote threshold too large: %s", v)
+		return fmt.Errorf("vote threshold too large: %s", threshold)
 	}
 
 	vetoThreshold, err := sdk.NewDecFromStr(v.VetoThreshold)
@@ -130,7 +181,7 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("veto threshold must be positive: %s", vetoThreshold)
 	}
 	if vetoThreshold.GT(sdk.OneDec()) {
-		return fmt.Errorf("veto threshold too large: %s", v)
+		return fmt.Errorf("veto threshold too large: %s", vetoThreshold)
 	}
 
 	return nil
@@ -170,28 +221,51 @@ func validateVotingParams(i interface{}) error {
 	return nil
 }
 
+// NewContentParams creates a new ContentParams object
+func NewContentParams(maxContentSize uint64) ContentParams {
+	return ContentParams{
+		MaxContentSize: maxContentSize,
+	}
+}
+
+// DefaultContentParams default parameters for the content store
+func DefaultContentParams() ContentParams {
+	return NewContentParams(DefaultMaxContentSize)
+}
+
+func validateContentParams(i interface{}) error {
+	if _, ok := i.(ContentParams); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 // Params returns all of the governance params
 type Params struct {
 	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
 	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
 	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_params"`
+	ContentParams ContentParams `json:"content_params" yaml:"content_params"`
 }
 
 func (gp Params) String() string {
 	return gp.VotingParams.String() + "\n" +
-		gp.TallyParams.String() + "\n" + gp.DepositParams.String()
+		gp.TallyParams.String() + "\n" + gp.DepositParams.String() + "\n" +
+		gp.ContentParams.String()
 }
 
 // NewParams creates a new gov Params instance
-func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
+func NewParams(vp VotingParams, tp TallyParams, dp DepositParams, cp ContentParams) Params {
 	return Params{
 		VotingParams:  vp,
 		DepositParams: dp,
 		TallyParams:   tp,
+		ContentParams: cp,
 	}
 }
 
 // DefaultParams default governance params
 func DefaultParams() Params {
-	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams())
+	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams(), DefaultContentParams())
 }
@@ -20,6 +20,7 @@ const (
 	ParamDeposit  = "deposit"
 	ParamVoting   = "voting"
 	ParamTallying = "tallying"
+	ParamContent  = "content"
 )
 
 // QueryProposalParams Params for queries:
@@ -463,7 +463,7 @@ func (m *QueryVotesResponse) GetPagination() *query.PageResponse {
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 	// params_type defines which parameters to query for, can be one of "voting",
-	// "tallying" or "deposit".
+	// "tallying", "deposit" or "content".
 	ParamsType string `protobuf:"bytes,1,opt,name=params_type,json=paramsType,proto3" json:"params_type,omitempty"`
 }
 
@@ -515,6 +515,8 @@ type QueryParamsResponse struct {
 	DepositParams *DepositParams `protobuf:"bytes,2,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params,omitempty"`
 	// tally_params defines the parameters related to tally.
 	TallyParams *TallyParams `protobuf:"bytes,3,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params,omitempty"`
+	// content_params defines the parameters related to the content store.
+	ContentParams *ContentParams `protobuf:"bytes,4,opt,name=content_params,json=contentParams,proto3" json:"content_params,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -571,6 +573,13 @@ func (m *QueryParamsResponse) GetTallyParams() *TallyParams {
 	return nil
 }
 
+func (m *QueryParamsResponse) GetContentParams() *ContentParams {
+	if m != nil {
+		return m.ContentParams
+	}
+	return nil
+}
+
 // QueryDepositRequest is the request type for the Query/Deposit RPC method.
 type QueryDepositRequest struct {
 	// proposal_id defines the unique id of the proposal.
@@ -873,6 +882,193 @@ func (m *QueryTallyResultResponse) GetTally() *TallyResult {
 	return nil
 }
 
+// QueryContentRequest is the request type for the Query/Content RPC method.
+type QueryContentRequest struct {
+	// hash is the hex encoded SHA-256 hash of the content.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *QueryContentRequest) Reset()         { *m = QueryContentRequest{} }
+func (m *QueryContentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContentRequest) ProtoMessage()    {}
+func (*QueryContentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{16}
+}
+func (m *QueryContentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContentRequest.Merge(m, src)
+}
+func (m *QueryContentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContentRequest proto.InternalMessageInfo
+
+func (m *QueryContentRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// QueryContentResponse is the response type for the Query/Content RPC method.
+type QueryContentResponse struct {
+	// content is the stored content.
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (m *QueryContentResponse) Reset()         { *m = QueryContentResponse{} }
+func (m *QueryContentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContentResponse) ProtoMessage()    {}
+func (*QueryContentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{17}
+}
+func (m *QueryContentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContentResponse.Merge(m, src)
+}
+func (m *QueryContentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContentResponse proto.InternalMessageInfo
+
+func (m *QueryContentResponse) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+// QueryConstitutionRequest is the request type for the Query/Constitution RPC
+// method.
+type QueryConstitutionRequest struct {
+}
+
+func (m *QueryConstitutionRequest) Reset()         { *m = QueryConstitutionRequest{} }
+func (m *QueryConstitutionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConstitutionRequest) ProtoMessage()    {}
+func (*QueryConstitutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{18}
+}
+func (m *QueryConstitutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConstitutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConstitutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConstitutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConstitutionRequest.Merge(m, src)
+}
+func (m *QueryConstitutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConstitutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConstitutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConstitutionRequest proto.InternalMessageInfo
+
+// QueryConstitutionResponse is the response type for the Query/Constitution RPC
+// method.
+type QueryConstitutionResponse struct {
+	// hash is the SHA-256 hash of the constitution, empty if not set.
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// content is the text of the constitution, empty if not stored in the
+	// content store.
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (m *QueryConstitutionResponse) Reset()         { *m = QueryConstitutionResponse{} }
+func (m *QueryConstitutionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConstitutionResponse) ProtoMessage()    {}
+func (*QueryConstitutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{19}
+}
+func (m *QueryConstitutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConstitutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConstitutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConstitutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConstitutionResponse.Merge(m, src)
+}
+func (m *QueryConstitutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConstitutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConstitutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConstitutionResponse proto.InternalMessageInfo
+
+func (m *QueryConstitutionResponse) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *QueryConstitutionResponse) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.gov.v1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1.QueryProposalResponse")
@@ -890,72 +1086,84 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryContentRequest)(nil), "cosmos.gov.v1.QueryContentRequest")
+	proto.RegisterType((*QueryContentResponse)(nil), "cosmos.gov.v1.QueryContentResponse")
+	proto.RegisterType((*QueryConstitutionRequest)(nil), "cosmos.gov.v1.QueryConstitutionRequest")
+	proto.RegisterType((*QueryConstitutionResponse)(nil), "cosmos.gov.v1.QueryConstitutionResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 945 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5d, 0x6f, 0xdc, 0x44,
-	0x14, 0xcd, 0x6c, 0x92, 0x76, 0xf7, 0xa6, 0x09, 0x70, 0x5b, 0x9a, 0xc5, 0x94, 0x6d, 0x70, 0x68,
-	0x12, 0x28, 0xf5, 0xb0, 0xe9, 0x97, 0x04, 0xad, 0x04, 0x2d, 0x04, 0x90, 0x78, 0x08, 0xdb, 0x8a,
-	0x07, 0x5e, 0x22, 0x27, 0x6b, 0x19, 0x8b, 0x8d, 0xc7, 0xdd, 0x99, 0x5d, 0x11, 0xd2, 0x08, 0xa9,
-	0x12, 0x82, 0x27, 0x40, 0xa2, 0x12, 0xfc, 0x10, 0x7e, 0x04, 0x8f, 0x15, 0xbc, 0x20, 0x78, 0x41,
-	0x09, 0x3f, 0x04, 0x79, 0xe6, 0xda, 0x6b, 0x3b, 0xde, 0xcd, 0xa6, 0x8a, 0xfa, 0x14, 0x8d, 0x7d,
-	0xee, 0xb9, 0xe7, 0x7e, 0xf8, 0x4c, 0x16, 0x5e, 0xda, 0x12, 0x72, 0x5b, 0x48, 0xee, 0x8b, 0x3e,
-	0xef, 0x37, 0xf9, 0x83, 0x9e, 0xd7, 0xdd, 0x71, 0xa2, 0xae, 0x50, 0x02, 0x67, 0xcd, 0x2b, 0xc7,
-	0x17, 0x7d, 0xa7, 0xdf, 0xb4, 0xde, 0x20, 0xe4, 0xa6, 0x2b, 0x3d, 0x83, 0xe3, 0xfd, 0xe6, 0xa6,
-	0xa7, 0xdc, 0x26, 0x8f, 0x5c, 0x3f, 0x08, 0x5d, 0x15, 0x88, 0xd0, 0x84, 0x5a, 0x17, 0x7c, 0x21,
-	0xfc, 0x8e, 0xc7, 0xdd, 0x28, 0xe0, 0x6e, 0x18, 0x0a, 0xa5, 0x5f, 0x4a, 0x7a, 0x3b, 0x9f, 0xcf,
-	0x19, 0xf3, 0x9b, 0x17, 0x24, 0x66, 0x43, 0x9f, 0x38, 0xa5, 0xd7, 0x07, 0xfb, 0x26, 0x9c, 0xfb,
-	0x34, 0xce, 0xb9, 0xde, 0x15, 0x91, 0x90, 0x6e, 0xa7, 0xe5, 0x3d, 0xe8, 0x79, 0x52, 0xe1, 0x45,
-	0x98, 0x89, 0xe8, 0xd1, 0x46, 0xd0, 0xae, 0xb3, 0x05, 0xb6, 0x32, 0xd5, 0x82, 0xe4, 0xd1, 0xc7,
-	0x6d, 0xfb, 0x13, 0x78, 0xb1, 0x10, 0x28, 0x23, 0x11, 0x4a, 0x0f, 0xaf, 0x42, 0x35, 0x81, 0xe9,
-	0xb0, 0x99, 0xd5, 0x79, 0x27, 0x57, 0xb1, 0x93, 0x86, 0xa4, 0x40, 0xfb, 0xc7, 0x4a, 0x81, 0x4e,
-	0x26, 0x42, 0xd6, 0xe0, 0xb9, 0x54, 0x88, 0x54, 0xae, 0xea, 0x49, 0xcd, 0x3a, 0xb7, 0xfa, 0xca,
-	0x10, 0xd6, 0x7b, 0x1a, 0xd4, 0x9a, 0x8b, 0x72, 0x67, 0x74, 0x60, 0xba, 0x2f, 0x94, 0xd7, 0xad,
-	0x57, 0x16, 0xd8, 0x4a, 0xed, 0x4e, 0xfd, 0x8f, 0xdf, 0xae, 0x9c, 0x23, 0x82, 0xf7, 0xda, 0xed,
-	0xae, 0x27, 0xe5, 0x3d, 0xd5, 0x0d, 0x42, 0xbf, 0x65, 0x60, 0x78, 0x03, 0x6a, 0x6d, 0x2f, 0x12,
-	0x32, 0x50, 0xa2, 0x5b, 0x9f, 0x3c, 0x22, 0x66, 0x00, 0xc5, 0x35, 0x80, 0xc1, 0xd8, 0xea, 0x53,
-	0xba, 0x01, 0x4b, 0x89, 0xd4, 0x78, 0xc6, 0x8e, 0xd9, 0x05, 0x9a, 0xb1, 0xb3, 0xee, 0xfa, 0x1e,
-	0xd5, 0xda, 0xca, 0x44, 0xda, 0xbf, 0x32, 0x38, 0x5f, 0xec, 0x08, 0x75, 0xf8, 0x3a, 0xd4, 0x92,
-	0xe2, 0xe2, 0x66, 0x4c, 0x8e, 0x6a, 0xf1, 0x00, 0x89, 0x1f, 0xe6, 0x94, 0x55, 0xb4, 0xb2, 0xe5,
-	0x23, 0x95, 0x99, 0x9c, 0x39, 0x69, 0x5b, 0xf0, 0xbc, 0x56, 0xf6, 0x99, 0x50, 0xde, 0xb8, 0xfb,
-	0x72, 0xdc, 0xfe, 0xdb, 0xb7, 0xe0, 0x85, 0x4c, 0x12, 0xaa, 0x7c, 0x19, 0xa6, 0xe2, 0xb7, 0xb4,
-	0x57, 0x67, 0x0b, 0x45, 0x6b, 0xa8, 0x06, 0xd8, 0x0f, 0x33, 0xd1, 0x72, 0x6c, 0x8d, 0x6b, 0x25,
-	0x1d, 0x7a, 0x9a, 0xd9, 0x7d, 0xcf, 0x00, 0xb3, 0xe9, 0x49, 0xfd, 0xeb, 0xa6, 0x05, 0xc9, 0xcc,
-	0x4a, 0xe5, 0x1b, 0xc4, 0xc9, 0xcd, 0xea, 0x3a, 0x29, 0x59, 0x77, 0xbb, 0xee, 0x76, 0xae, 0x13,
-	0xfa, 0xc1, 0x86, 0xda, 0x89, 0x4c, 0x3b, 0x6b, 0x71, 0x58, 0xfc, 0xe8, 0xfe, 0x4e, 0xe4, 0xd9,
-	0xff, 0x30, 0x38, 0x9b, 0x8b, 0xa3, 0x12, 0xde, 0x85, 0xd9, 0xbe, 0x50, 0x41, 0xe8, 0x6f, 0x18,
-	0x30, 0x4d, 0xe2, 0xe5, 0xc3, 0xa5, 0x04, 0xa1, 0x4f, 0xb1, 0x67, 0xfa, 0x99, 0x13, 0xde, 0x85,
-	0x39, 0xfa, 0x58, 0x12, 0x0a, 0x53, 0xdd, 0x85, 0x02, 0xc5, 0xfb, 0x06, 0x44, 0x1c, 0xb3, 0xed,
-	0xec, 0x11, 0x6f, 0xc3, 0x19, 0xe5, 0x76, 0x3a, 0x3b, 0x09, 0xc5, 0xa4, 0xa6, 0xb0, 0x0a, 0x14,
-	0xf7, 0x63, 0x08, 0x11, 0xcc, 0xa8, 0xc1, 0xc1, 0x0e, 0xa9, 0x38, 0xca, 0x31, 0xf6, 0x7e, 0xe4,
-	0x3c, 0xa1, 0x32, 0xb6, 0x27, 0xd8, 0x1f, 0x91, 0xc9, 0xa6, 0xf9, 0xa8, 0x9b, 0x6f, 0xc1, 0x69,
-	0x02, 0x51, 0x1f, 0xcf, 0x97, 0x37, 0xa1, 0x95, 0xc0, 0xec, 0x6f, 0xf2, 0x4c, 0xcf, 0x7e, 0xb5,
-	0x1f, 0x33, 0x32, 0xea, 0x81, 0x02, 0x2a, 0x66, 0x15, 0xaa, 0xa4, 0x32, 0x59, 0xf0, 0x61, 0xd5,
-	0xa4, 0xb8, 0x93, 0x5b, 0xf3, 0xb7, 0x61, 0x5e, 0xab, 0xd2, 0x23, 0x6f, 0x79, 0xb2, 0xd7, 0x51,
-	0xc7, 0xb8, 0xc9, 0xea, 0x87, 0x63, 0xd3, 0x09, 0x4d, 0xeb, 0xc5, 0xa1, 0xf9, 0x94, 0x6e, 0x18,
-	0x85, 0x18, 0xe0, 0xea, 0xdf, 0x55, 0x98, 0xd6, 0x74, 0xf8, 0x2d, 0x83, 0x6a, 0xe2, 0xc3, 0xb8,
-	0x58, 0x88, 0x2c, 0xbb, 0x74, 0xad, 0xd7, 0x46, 0x83, 0x8c, 0x26, 0xdb, 0x79, 0xf4, 0xe7, 0x7f,
-	0x3f, 0x57, 0x56, 0x70, 0x89, 0xe7, 0xef, 0xfb, 0xd4, 0xe9, 0xf9, 0x6e, 0xa6, 0xe0, 0x3d, 0xfc,
-	0x1a, 0x6a, 0xe9, 0x1d, 0x82, 0x23, 0x53, 0x24, 0xeb, 0x64, 0x5d, 0x3a, 0x02, 0x45, 0x4a, 0x16,
-	0xb4, 0x12, 0x0b, 0xeb, 0xc3, 0x94, 0xe0, 0x77, 0x0c, 0xa6, 0x62, 0x5f, 0xc3, 0x8b, 0x65, 0x8c,
-	0x99, 0x0b, 0xc4, 0x5a, 0x18, 0x0e, 0xa0, 0x6c, 0xb7, 0x74, 0xb6, 0x1b, 0x78, 0x6d, 0xbc, 0xba,
-	0xb9, 0x76, 0x52, 0xbe, 0xab, 0xaf, 0x93, 0x3d, 0x7c, 0xc4, 0x60, 0x5a, 0xdb, 0x31, 0x0e, 0xcd,
-	0x94, 0x96, 0xff, 0xea, 0x08, 0x04, 0x89, 0xb9, 0xa6, 0xc5, 0x38, 0xf8, 0xe6, 0x71, 0xc4, 0xe0,
-	0x43, 0x38, 0x45, 0x0e, 0x56, 0x9a, 0x22, 0x67, 0xd2, 0x96, 0x3d, 0x0a, 0x42, 0x32, 0x2e, 0x6b,
-	0x19, 0x97, 0x70, 0xb1, 0x28, 0x43, 0xc3, 0xf8, 0x6e, 0xc6, 0xe5, 0xf7, 0xf0, 0x17, 0x06, 0xa7,
-	0xe9, 0x1b, 0xc4, 0x52, 0xf2, 0xbc, 0x1f, 0x5a, 0x8b, 0x23, 0x31, 0xa4, 0xe0, 0xae, 0x56, 0x70,
-	0x1b, 0xdf, 0x19, 0xb3, 0x11, 0xc9, 0xb7, 0xcf, 0x77, 0x53, 0x7f, 0xdc, 0xc3, 0x1f, 0x18, 0x54,
-	0x13, 0x43, 0xc1, 0x51, 0x69, 0xe5, 0xc8, 0x4f, 0xa5, 0xe8, 0x49, 0xf6, 0x4d, 0x2d, 0xae, 0x89,
-	0xfc, 0x98, 0xe2, 0xf0, 0x31, 0x83, 0x99, 0xcc, 0xc7, 0x8d, 0x4b, 0x65, 0xe9, 0x0e, 0x9b, 0x8d,
-	0xb5, 0x7c, 0x24, 0xee, 0x29, 0xf7, 0x47, 0x9b, 0xcb, 0x9d, 0x0f, 0x7e, 0xdf, 0x6f, 0xb0, 0x27,
-	0xfb, 0x0d, 0xf6, 0xef, 0x7e, 0x83, 0xfd, 0x74, 0xd0, 0x98, 0x78, 0x72, 0xd0, 0x98, 0xf8, 0xeb,
-	0xa0, 0x31, 0xf1, 0xf9, 0x65, 0x3f, 0x50, 0x5f, 0xf4, 0x36, 0x9d, 0x2d, 0xb1, 0x9d, 0x30, 0x9a,
-	0x3f, 0x57, 0x64, 0xfb, 0x4b, 0xfe, 0x95, 0xa6, 0x8f, 0xb7, 0x40, 0xc6, 0x3f, 0x2e, 0x4e, 0xe9,
-	0xff, 0xfd, 0xaf, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xce, 0x57, 0x85, 0xcf, 0xa5, 0x0c, 0x00,
-	0x00,
+	// 1085 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5b, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x3a, 0x4e, 0x93, 0x9c, 0x5c, 0xfe, 0x7f, 0x4e, 0xd3, 0xc6, 0xdd, 0xb6, 0xae, 0x59,
+	0xd3, 0x24, 0xa5, 0x74, 0xb7, 0x4e, 0x6f, 0x12, 0xb4, 0x12, 0x34, 0x10, 0xa8, 0xc4, 0x43, 0x70,
+	0x2b, 0x1e, 0x78, 0x89, 0x36, 0xf6, 0x6a, 0xb3, 0xc2, 0xd9, 0xd9, 0x7a, 0xc6, 0x16, 0xa9, 0x6b,
+	0x21, 0x55, 0xe2, 0xf2, 0x04, 0x48, 0x54, 0x82, 0x47, 0x3e, 0x04, 0x1f, 0x82, 0xc7, 0x0a, 0x5e,
+	0x78, 0x03, 0x25, 0x7c, 0x10, 0xb4, 0x33, 0x67, 0x37, 0xbb, 0x9b, 0xf5, 0x25, 0x55, 0xc5, 0x93,
+	0x3d, 0x33, 0xbf, 0xf3, 0x3b, 0xbf, 0x73, 0x99, 0x33, 0x36, 0x9c, 0x6b, 0x30, 0xbe, 0xc7, 0xb8,
+	0xe5, 0xb2, 0xae, 0xd5, 0xad, 0x59, 0x8f, 0x3b, 0x4e, 0x7b, 0xdf, 0x0c, 0xda, 0x4c, 0x30, 0x5c,
+	0x50, 0x47, 0xa6, 0xcb, 0xba, 0x66, 0xb7, 0xa6, 0xbf, 0x49, 0xc8, 0x1d, 0x9b, 0x3b, 0x0a, 0x67,
+	0x75, 0x6b, 0x3b, 0x8e, 0xb0, 0x6b, 0x56, 0x60, 0xbb, 0x9e, 0x6f, 0x0b, 0x8f, 0xf9, 0xca, 0x54,
+	0xbf, 0xe0, 0x32, 0xe6, 0xb6, 0x1c, 0xcb, 0x0e, 0x3c, 0xcb, 0xf6, 0x7d, 0x26, 0xe4, 0x21, 0xa7,
+	0xd3, 0xe5, 0xb4, 0xcf, 0x90, 0x5f, 0x1d, 0x90, 0x98, 0x6d, 0xb9, 0xb2, 0xc8, 0xbd, 0x5c, 0x18,
+	0x77, 0x60, 0xe9, 0x93, 0xd0, 0xe7, 0x56, 0x9b, 0x05, 0x8c, 0xdb, 0xad, 0xba, 0xf3, 0xb8, 0xe3,
+	0x70, 0x81, 0x97, 0x60, 0x2e, 0xa0, 0xad, 0x6d, 0xaf, 0x59, 0xd2, 0x2a, 0xda, 0x5a, 0xb1, 0x0e,
+	0xd1, 0xd6, 0x83, 0xa6, 0xf1, 0x31, 0x9c, 0xc9, 0x18, 0xf2, 0x80, 0xf9, 0xdc, 0xc1, 0x1b, 0x30,
+	0x13, 0xc1, 0xa4, 0xd9, 0xdc, 0xfa, 0xb2, 0x99, 0x8a, 0xd8, 0x8c, 0x4d, 0x62, 0xa0, 0xf1, 0x7d,
+	0x21, 0x43, 0xc7, 0x23, 0x21, 0x9b, 0xf0, 0xbf, 0x58, 0x08, 0x17, 0xb6, 0xe8, 0x70, 0xc9, 0xba,
+	0xb8, 0x7e, 0x71, 0x00, 0xeb, 0x43, 0x09, 0xaa, 0x2f, 0x06, 0xa9, 0x35, 0x9a, 0x30, 0xd5, 0x65,
+	0xc2, 0x69, 0x97, 0x0a, 0x15, 0x6d, 0x6d, 0xf6, 0x7e, 0xe9, 0xf7, 0x5f, 0xaf, 0x2d, 0x11, 0xc1,
+	0x7b, 0xcd, 0x66, 0xdb, 0xe1, 0xfc, 0xa1, 0x68, 0x7b, 0xbe, 0x5b, 0x57, 0x30, 0xbc, 0x0d, 0xb3,
+	0x4d, 0x27, 0x60, 0xdc, 0x13, 0xac, 0x5d, 0x9a, 0x1c, 0x61, 0x73, 0x04, 0xc5, 0x4d, 0x80, 0xa3,
+	0xb2, 0x95, 0x8a, 0x32, 0x01, 0x2b, 0x91, 0xd4, 0xb0, 0xc6, 0xa6, 0xea, 0x05, 0xaa, 0xb1, 0xb9,
+	0x65, 0xbb, 0x0e, 0xc5, 0x5a, 0x4f, 0x58, 0x1a, 0x3f, 0x6b, 0x70, 0x36, 0x9b, 0x11, 0xca, 0xf0,
+	0x2d, 0x98, 0x8d, 0x82, 0x0b, 0x93, 0x31, 0x39, 0x2c, 0xc5, 0x47, 0x48, 0xfc, 0x30, 0xa5, 0xac,
+	0x20, 0x95, 0xad, 0x8e, 0x54, 0xa6, 0x7c, 0xa6, 0xa4, 0x35, 0xe0, 0xff, 0x52, 0xd9, 0xa7, 0x4c,
+	0x38, 0xe3, 0xf6, 0xcb, 0x49, 0xf3, 0x6f, 0xdc, 0x85, 0xd7, 0x12, 0x4e, 0x28, 0xf2, 0x55, 0x28,
+	0x86, 0xa7, 0xd4, 0x57, 0xa7, 0x33, 0x41, 0x4b, 0xa8, 0x04, 0x18, 0x4f, 0x13, 0xd6, 0x7c, 0x6c,
+	0x8d, 0x9b, 0x39, 0x19, 0x7a, 0x99, 0xda, 0x7d, 0xab, 0x01, 0x26, 0xdd, 0x93, 0xfa, 0x2b, 0x2a,
+	0x05, 0x51, 0xcd, 0x72, 0xe5, 0x2b, 0xc4, 0xab, 0xab, 0xd5, 0x2d, 0x52, 0xb2, 0x65, 0xb7, 0xed,
+	0xbd, 0x54, 0x26, 0xe4, 0xc6, 0xb6, 0xd8, 0x0f, 0x54, 0x3a, 0x67, 0xeb, 0xa0, 0xb6, 0x1e, 0xed,
+	0x07, 0x8e, 0xf1, 0x4b, 0x01, 0x4e, 0xa7, 0xec, 0x28, 0x84, 0x77, 0x61, 0xa1, 0xcb, 0x84, 0xe7,
+	0xbb, 0xdb, 0x0a, 0x4c, 0x95, 0x38, 0x7f, 0x3c, 0x14, 0xcf, 0x77, 0xc9, 0x76, 0xbe, 0x9b, 0x58,
+	0xe1, 0x06, 0x2c, 0xd2, 0x65, 0x89, 0x28, 0x54, 0x74, 0x17, 0x32, 0x14, 0xef, 0x2b, 0x10, 0x71,
+	0x2c, 0x34, 0x93, 0x4b, 0xbc, 0x07, 0xf3, 0xc2, 0x6e, 0xb5, 0xf6, 0x23, 0x8a, 0x49, 0x49, 0xa1,
+	0x67, 0x28, 0x1e, 0x85, 0x10, 0x22, 0x98, 0x13, 0x47, 0x8b, 0x50, 0x43, 0x83, 0xf9, 0xc2, 0xf1,
+	0x63, 0x0d, 0xc5, 0x5c, 0x0d, 0x1b, 0x0a, 0x14, 0x69, 0x68, 0x24, 0x97, 0x86, 0x4f, 0x19, 0x22,
+	0xa1, 0x63, 0x37, 0x59, 0x6a, 0xb0, 0x14, 0xc6, 0x1e, 0x2c, 0xc6, 0x47, 0xb0, 0x94, 0xf6, 0x47,
+	0x25, 0xb9, 0x0e, 0xd3, 0x04, 0xa2, 0x62, 0x9c, 0xcd, 0xcf, 0x64, 0x3d, 0x82, 0x19, 0x5f, 0xa6,
+	0x99, 0xfe, 0xfb, 0xfb, 0xf1, 0x5c, 0x83, 0x33, 0x19, 0x05, 0x14, 0xcc, 0x3a, 0xcc, 0x90, 0xca,
+	0xe8, 0x96, 0x0c, 0x8a, 0x26, 0xc6, 0xbd, 0xba, 0xbb, 0xf2, 0x36, 0x2c, 0x4b, 0x55, 0xb2, 0x6f,
+	0xea, 0x0e, 0xef, 0xb4, 0xc4, 0x09, 0x9e, 0xc3, 0xd2, 0x71, 0xdb, 0xb8, 0x42, 0x53, 0xb2, 0xfb,
+	0x4a, 0xda, 0xe0, 0x36, 0x25, 0x13, 0x05, 0x34, 0xae, 0x50, 0x6f, 0x51, 0x03, 0x46, 0x2a, 0x10,
+	0x8a, 0xbb, 0x36, 0xdf, 0xa5, 0xfb, 0x2a, 0xbf, 0x1b, 0xd7, 0x61, 0x29, 0x0d, 0x25, 0xa7, 0x25,
+	0x98, 0xa6, 0x7e, 0x95, 0xf0, 0xf9, 0x7a, 0xb4, 0x34, 0x74, 0x92, 0xba, 0xc1, 0x7c, 0x2e, 0x3c,
+	0xd1, 0x09, 0x63, 0x27, 0x0f, 0xc6, 0x03, 0x38, 0x97, 0x73, 0x46, 0x94, 0x49, 0xf7, 0xf3, 0xca,
+	0x7d, 0xd2, 0x4d, 0x21, 0xe5, 0x66, 0xfd, 0x2f, 0x80, 0x29, 0xc9, 0x85, 0x5f, 0x69, 0x30, 0x13,
+	0x3d, 0x48, 0x58, 0xcd, 0x44, 0x9f, 0xf7, 0xeb, 0x43, 0x7f, 0x63, 0x38, 0x48, 0xe9, 0x31, 0xcc,
+	0x67, 0x7f, 0xfc, 0xf3, 0x63, 0x61, 0x0d, 0x57, 0xac, 0xf4, 0x0f, 0x9f, 0xf8, 0xc9, 0xb3, 0x7a,
+	0x89, 0xa2, 0xf5, 0xf1, 0x09, 0xcc, 0x46, 0x1c, 0x1c, 0x87, 0xba, 0x88, 0xae, 0x84, 0x7e, 0x79,
+	0x04, 0x8a, 0x94, 0x54, 0xa4, 0x12, 0x1d, 0x4b, 0x83, 0x94, 0xe0, 0x37, 0x1a, 0x14, 0xc3, 0x01,
+	0x8f, 0x97, 0xf2, 0x18, 0x13, 0x2f, 0xa9, 0x5e, 0x19, 0x0c, 0x20, 0x6f, 0x77, 0xa5, 0xb7, 0xdb,
+	0x78, 0x73, 0xbc, 0xb8, 0x2d, 0xf9, 0xa4, 0x58, 0xbd, 0xf0, 0xa3, 0xdd, 0xc7, 0x67, 0x1a, 0x4c,
+	0x85, 0x74, 0x1c, 0x07, 0x7a, 0x8a, 0xc3, 0x7f, 0x7d, 0x08, 0x82, 0xc4, 0xdc, 0x94, 0x62, 0x4c,
+	0x7c, 0xeb, 0x24, 0x62, 0xf0, 0x29, 0x9c, 0xa2, 0x59, 0x9c, 0xeb, 0x22, 0xf5, 0x5a, 0xe9, 0xc6,
+	0x30, 0x08, 0xc9, 0xb8, 0x2a, 0x65, 0x5c, 0xc6, 0x6a, 0x56, 0x86, 0x84, 0x59, 0xbd, 0xc4, 0x73,
+	0xd7, 0xc7, 0x9f, 0x34, 0x98, 0xa6, 0x39, 0x82, 0xb9, 0xe4, 0xe9, 0x99, 0xae, 0x57, 0x87, 0x62,
+	0x48, 0xc1, 0x86, 0x54, 0x70, 0x0f, 0xdf, 0x19, 0x33, 0x11, 0xd1, 0xfc, 0xb2, 0x7a, 0xf1, 0x8c,
+	0xef, 0xe3, 0x77, 0x1a, 0xcc, 0x10, 0x31, 0xc7, 0x61, 0x6e, 0xf9, 0xd0, 0xab, 0x92, 0x9d, 0xab,
+	0xc6, 0x1d, 0x29, 0xae, 0x86, 0xd6, 0x09, 0xc5, 0xe1, 0x73, 0x0d, 0xe6, 0x12, 0x03, 0x0a, 0x57,
+	0xf2, 0xdc, 0x1d, 0x1f, 0x98, 0xfa, 0xea, 0x48, 0xdc, 0x4b, 0xf6, 0x8f, 0x1c, 0x90, 0xf8, 0x04,
+	0xa6, 0x69, 0xe0, 0xe5, 0x17, 0x30, 0x3d, 0x38, 0xf5, 0xea, 0x50, 0x0c, 0x29, 0x59, 0x91, 0x4a,
+	0x2a, 0x58, 0xce, 0x28, 0xa1, 0x81, 0xc6, 0xad, 0x5e, 0x38, 0xf1, 0xfa, 0xf8, 0xb5, 0x06, 0xf3,
+	0xc9, 0xf9, 0x88, 0xab, 0x03, 0xd8, 0xb3, 0xd3, 0x55, 0x5f, 0x1b, 0x0d, 0x24, 0x2d, 0x55, 0xa9,
+	0xe5, 0x22, 0x9e, 0x3f, 0xae, 0x25, 0x06, 0xdf, 0xff, 0xe0, 0xb7, 0x83, 0xb2, 0xf6, 0xe2, 0xa0,
+	0xac, 0xfd, 0x7d, 0x50, 0xd6, 0x7e, 0x38, 0x2c, 0x4f, 0xbc, 0x38, 0x2c, 0x4f, 0xfc, 0x79, 0x58,
+	0x9e, 0xf8, 0xec, 0xaa, 0xeb, 0x89, 0xdd, 0xce, 0x8e, 0xd9, 0x60, 0x7b, 0x11, 0x81, 0xfa, 0xb8,
+	0xc6, 0x9b, 0x9f, 0x5b, 0x5f, 0x48, 0xb6, 0xf0, 0x2a, 0xf0, 0xf0, 0xaf, 0xe6, 0x29, 0xf9, 0x4f,
+	0xf0, 0xc6, 0xbf, 0x03, 0x00, 0x4e, 0x91, 0x8e, 0x0c, 0xb3, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -986,6 +1194,11 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// Content queries a content of the content store by hash.
+	Content(ctx context.Context, in *QueryContentRequest, opts ...grpc.CallOption) (*QueryContentResponse, error)
+	// Constitution queries the hash of the constitution, and its text if stored
+	// in the content store.
+	Constitution(ctx context.Context, in *QueryConstitutionRequest, opts ...grpc.CallOption) (*QueryConstitutionResponse, error)
 }
 
 type queryClient struct {
@@ -1068,6 +1281,24 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) Content(ctx context.Context, in *QueryContentRequest, opts ...grpc.CallOption) (*QueryContentResponse, error) {
+	out := new(QueryContentResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/Content", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Constitution(ctx context.Context, in *QueryConstitutionRequest, opts ...grpc.CallOption) (*QueryConstitutionResponse, error) {
+	out := new(QueryConstitutionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/Constitution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1086,6 +1317,11 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// Content queries a content of the content store by hash.
+	Content(context.Context, *QueryContentRequest) (*QueryContentResponse, error)
+	// Constitution queries the hash of the constitution, and its text if stored
+	// in the content store.
+	Constitution(context.Context, *QueryConstitutionRequest) (*QueryConstitutionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1116,6 +1352,12 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) Content(ctx context.Context, req *QueryContentRequest) (*QueryContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Content not implemented")
+}
+func (*UnimplementedQueryServer) Constitution(ctx context.Context, req *QueryConstitutionRequest) (*QueryConstitutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Constitution not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1265,6 +1507,42 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Content_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Content(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/Content",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Content(ctx, req.(*QueryContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Constitution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConstitutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Constitution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/Constitution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Constitution(ctx, req.(*QueryConstitutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1301,6 +1579,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "Content",
+			Handler:    _Query_Content_Handler,
+		},
+		{
+			MethodName: "Constitution",
+			Handler:    _Query_Constitution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -1681,6 +1967,18 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContentParams != nil {
+		{
+			size, err := m.ContentParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.TallyParams != nil {
 		{
 			size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
@@ -1942,67 +2240,187 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryContentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryContentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryContentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Proposal != nil {
-		l = m.Proposal.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConstitutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConstitutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConstitutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalStatus != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalStatus))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalsResponse) Size() (n int) {
+func (m *QueryConstitutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConstitutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConstitutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalStatus != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalStatus))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2116,6 +2534,10 @@ func (m *QueryParamsResponse) Size() (n int) {
 		l = m.TallyParams.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.ContentParams != nil {
+		l = m.ContentParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2208,6 +2630,58 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryContentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConstitutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConstitutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3289,6 +3763,42 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContentParams == nil {
+				m.ContentParams = &ContentParams{}
+			}
+			if err := m.ContentParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3877,6 +4387,340 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryContentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
+			if m.Content == nil {
+				m.Content = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConstitutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConstitutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConstitutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConstitutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConstitutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConstitutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
+			if m.Content == nil {
+				m.Content = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -33,6 +33,8 @@ func QueryCmd(name string) *cobra.Command {
 		QueryVotesByVoterCmd(),
 		QueryGroupsByMemberCmd(),
 		QueryTallyResultCmd(),
+		QueryProposalExecutionLogsCmd(),
+		QueryWeightDelegationsByGroupCmd(),
 	)
 
 	return queryCmd
@@ -504,3 +506,87 @@ func QueryVotesByVoterCmd() *cobra.Command {
 
 	return cmd
 }
+
+// QueryProposalExecutionLogsCmd creates a CLI command for Query/ProposalExecutionLogs.
+func QueryProposalExecutionLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal-execution-logs [proposal-id]",
+		Short: "Query for the execution attempts of a proposal with pagination flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProposalExecutionLogs(cmd.Context(), &group.QueryProposalExecutionLogsRequest{
+				ProposalId: proposalID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "proposal-execution-logs")
+
+	return cmd
+}
+
+// QueryWeightDelegationsByGroupCmd creates a CLI command for Query/WeightDelegationsByGroup.
+func QueryWeightDelegationsByGroupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "weight-delegations-by-group [group-id]",
+		Short: "Query for the voting weight delegations of a group with pagination flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.WeightDelegationsByGroup(cmd.Context(), &group.QueryWeightDelegationsByGroupRequest{
+				GroupId:    groupID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "weight-delegations-by-group")
+
+	return cmd
+}
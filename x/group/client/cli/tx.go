@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -45,6 +46,8 @@ func TxCmd(name string) *cobra.Command {
 		MsgVoteCmd(),
 		MsgExecCmd(),
 		MsgLeaveGroupCmd(),
+		MsgDelegateWeightCmd(),
+		MsgUndelegateWeightCmd(),
 	)
 
 	return txCmd
@@ -384,6 +387,9 @@ $ %s tx group create-group-policy [admin] [group-id] [metadata] \
 
 Here, we can use percentage decision policy when needed, where 0 < percentage <= 1.
 Ex: '{"@type":"/cosmos.group.v1.PercentageDecisionPolicy", "percentage":"0.5", "windows": {"voting_period": "120h", "min_execution_period": "0s"}}
+
+Or a percentage of the non-abstaining votes with a quorum of the total weight, where 0 < quorum <= 1.
+Ex: '{"@type":"/cosmos.group.v1.PercentageQuorumDecisionPolicy", "quorum":"0.4", "percentage":"0.5", "windows": {"voting_period": "120h", "min_execution_period": "0s"}}
 `,
 				version.AppName,
 			),
@@ -826,3 +832,101 @@ Parameters:
 
 	return cmd
 }
+
+// MsgDelegateWeightCmd creates a CLI command for Msg/DelegateWeight.
+func MsgDelegateWeightCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-weight [delegator] [group-id] [delegatee] [expiration]",
+		Short: "Delegate the voting weight of a group member to another member",
+		Long: `Delegate the voting weight of a group member to another member of the group until an expiration time.
+The delegatee votes with its own weight and the delegated weight, unless the delegator voted on the proposal
+before delegating. Delegating again replaces the previous delegation.
+
+Parameters:
+		   delegator: account address of the group member delegating its weight
+		   group-id: unique id of the group
+		   delegatee: account address of the group member receiving the weight
+		   expiration: RFC3339 time after which the delegation is no longer effective
+		   Note, the '--from' flag is
+				ignored as it is implied from [delegator]
+`,
+		Example: fmt.Sprintf("%s tx group delegate-weight cosmos1... 1 cosmos1... 2023-01-01T00:00:00Z", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			expiration, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgDelegateWeight{
+				Delegator:  clientCtx.GetFromAddress().String(),
+				GroupId:    groupID,
+				Delegatee:  args[2],
+				Expiration: expiration,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MsgUndelegateWeightCmd creates a CLI command for Msg/UndelegateWeight.
+func MsgUndelegateWeightCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate-weight [delegator] [group-id]",
+		Short: "Revoke the voting weight delegation of a group member",
+		Long: `Revoke the voting weight delegation of a group member.
+
+Parameters:
+		   delegator: account address of the group member which delegated its weight
+		   group-id: unique id of the group
+		   Note, the '--from' flag is
+				ignored as it is implied from [delegator]
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgUndelegateWeight{
+				Delegator: clientCtx.GetFromAddress().String(),
+				GroupId:   groupID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -17,6 +17,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*DecisionPolicy)(nil), nil)
 	cdc.RegisterConcrete(&ThresholdDecisionPolicy{}, "cosmos-sdk/ThresholdDecisionPolicy", nil)
 	cdc.RegisterConcrete(&PercentageDecisionPolicy{}, "cosmos-sdk/PercentageDecisionPolicy", nil)
+	cdc.RegisterConcrete(&PercentageQuorumDecisionPolicy{}, "cosmos-sdk/PercentageQuorumDecisionPolicy", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgCreateGroup{}, "cosmos-sdk/MsgCreateGroup")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupMembers{}, "cosmos-sdk/MsgUpdateGroupMembers")
@@ -32,6 +33,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "cosmos-sdk/group/MsgVote")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "cosmos-sdk/group/MsgExec")
 	legacy.RegisterAminoMsg(cdc, &MsgLeaveGroup{}, "cosmos-sdk/group/MsgLeaveGroup")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateWeight{}, "cosmos-sdk/group/MsgDelegateWeight")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateWeight{}, "cosmos-sdk/group/MsgUndelegateWeight")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -50,6 +53,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgVote{},
 		&MsgExec{},
 		&MsgLeaveGroup{},
+		&MsgDelegateWeight{},
+		&MsgUndelegateWeight{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -59,9 +64,29 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*DecisionPolicy)(nil),
 		&ThresholdDecisionPolicy{},
 		&PercentageDecisionPolicy{},
+		&PercentageQuorumDecisionPolicy{},
 	)
 }
 
+// RegisterDecisionPolicyImplementations registers custom DecisionPolicy
+// implementations with the interface registry, making them selectable when
+// creating or updating group policies. It is meant to be called from the
+// RegisterInterfaces method of the module defining the policies.
+func RegisterDecisionPolicyImplementations(registry cdctypes.InterfaceRegistry, policies ...DecisionPolicy) {
+	for _, policy := range policies {
+		registry.RegisterImplementations((*DecisionPolicy)(nil), policy)
+	}
+}
+
+// RegisterLegacyAminoDecisionPolicy registers a custom DecisionPolicy
+// implementation with the Amino codecs used to sign the group Msgs, required
+// for the policy to be used in Msgs signed with SIGN_MODE_LEGACY_AMINO_JSON. It
+// must be called only once per policy, typically from an init function.
+func RegisterLegacyAminoDecisionPolicy(policy DecisionPolicy, name string) {
+	amino.RegisterConcrete(policy, name, nil)
+	authzcodec.Amino.RegisterConcrete(policy, name, nil)
+}
+
 var (
 	amino     = codec.NewLegacyAmino()
 	ModuleCdc = codec.NewAminoCodec(amino)
@@ -9,6 +9,9 @@ type Config struct {
 	MaxExecutionPeriod time.Duration
 	// MaxMetadataLen defines the max length of the metadata bytes field for various entities within the group module. Defaults to 255 if not explicitly set.
 	MaxMetadataLen uint64
+	// MaxExecutionLogs defines the max number of execution attempts logged per
+	// proposal, the oldest ones being pruned. Defaults to 10 if not explicitly set.
+	MaxExecutionLogs uint64
 }
 
 // DefaultConfig returns the default config for group.
@@ -16,5 +19,6 @@ func DefaultConfig() Config {
 	return Config{
 		MaxExecutionPeriod: 2 * time.Hour * 24 * 7, // Two weeks.
 		MaxMetadataLen:     255,
+		MaxExecutionLogs:   10,
 	}
 }
@@ -75,6 +75,30 @@ func (s GenesisState) Validate() error {
 			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", v.ProposalId))
 		}
 	}
+
+	for _, l := range s.ExecutionLogs {
+
+		if err := l.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "ProposalExecutionLog validation failed")
+		}
+
+		// check that proposal exists
+		if _, exists := proposals[l.ProposalId]; !exists {
+			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", l.ProposalId))
+		}
+	}
+
+	for _, d := range s.WeightDelegations {
+
+		if err := d.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "WeightDelegation validation failed")
+		}
+
+		// check that group with weight delegation's GroupId exists
+		if _, exists := groups[d.GroupId]; !exists {
+			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("weight delegation with GroupId %d doesn't exist", d.GroupId))
+		}
+	}
 	return nil
 }
 
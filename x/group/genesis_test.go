@@ -733,6 +733,145 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid execution log",
+			GenesisState{
+				Groups: []*GroupInfo{
+					{
+						Id:          1,
+						Admin:       accAddr.String(),
+						Metadata:    "1",
+						Version:     1,
+						TotalWeight: "1",
+					},
+				},
+				GroupPolicies: []*GroupPolicyInfo{
+					groupPolicy,
+				},
+				Proposals: []*Proposal{
+					proposal,
+				},
+				ExecutionLogs: []*ProposalExecutionLog{
+					{
+						ProposalId: proposal.Id,
+						Attempt:    1,
+						Executor:   memberAddr.String(),
+						ExecutedAt: timeout,
+						Result:     PROPOSAL_EXECUTOR_RESULT_FAILURE,
+						Error:      "insufficient funds",
+					},
+				},
+			},
+			false,
+		},
+		{
+			"execution log of proposal that doesn't exist",
+			GenesisState{
+				Groups: []*GroupInfo{
+					{
+						Id:          1,
+						Admin:       accAddr.String(),
+						Metadata:    "1",
+						Version:     1,
+						TotalWeight: "1",
+					},
+				},
+				GroupPolicies: []*GroupPolicyInfo{
+					groupPolicy,
+				},
+				Proposals: []*Proposal{
+					proposal,
+				},
+				ExecutionLogs: []*ProposalExecutionLog{
+					{
+						ProposalId: 2,
+						Attempt:    1,
+						Executor:   memberAddr.String(),
+						ExecutedAt: timeout,
+						Result:     PROPOSAL_EXECUTOR_RESULT_FAILURE,
+						Error:      "insufficient funds",
+					},
+				},
+			},
+			true,
+		},
+		{
+			"failed execution log without error",
+			GenesisState{
+				Groups: []*GroupInfo{
+					{
+						Id:          1,
+						Admin:       accAddr.String(),
+						Metadata:    "1",
+						Version:     1,
+						TotalWeight: "1",
+					},
+				},
+				GroupPolicies: []*GroupPolicyInfo{
+					groupPolicy,
+				},
+				Proposals: []*Proposal{
+					proposal,
+				},
+				ExecutionLogs: []*ProposalExecutionLog{
+					{
+						ProposalId: proposal.Id,
+						Attempt:    1,
+						Executor:   memberAddr.String(),
+						ExecutedAt: timeout,
+						Result:     PROPOSAL_EXECUTOR_RESULT_FAILURE,
+						Error:      "",
+					},
+				},
+			},
+			true,
+		},
+		{
+			"weight delegation of group that doesn't exist",
+			GenesisState{
+				Groups: []*GroupInfo{
+					{
+						Id:          1,
+						Admin:       accAddr.String(),
+						Metadata:    "1",
+						Version:     1,
+						TotalWeight: "1",
+					},
+				},
+				WeightDelegations: []*WeightDelegation{
+					{
+						GroupId:    2,
+						Delegator:  memberAddr.String(),
+						Delegatee:  accAddr.String(),
+						Expiration: timeout,
+					},
+				},
+			},
+			true,
+		},
+		{
+			"weight delegation to self",
+			GenesisState{
+				Groups: []*GroupInfo{
+					{
+						Id:          1,
+						Admin:       accAddr.String(),
+						Metadata:    "1",
+						Version:     1,
+						TotalWeight: "1",
+					},
+				},
+				WeightDelegations: []*WeightDelegation{
+					{
+						GroupId:    1,
+						Delegator:  memberAddr.String(),
+						Delegatee:  memberAddr.String(),
+						Expiration: timeout,
+					},
+				},
+			},
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
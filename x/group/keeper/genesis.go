@@ -39,6 +39,14 @@ func (k Keeper) InitGenesis(ctx types.Context, cdc codec.JSONCodec, data json.Ra
 		panic(errors.Wrap(err, "votes"))
 	}
 
+	if err := k.executionLogTable.Import(ctx.KVStore(k.key), genesisState.ExecutionLogs, 0); err != nil {
+		panic(errors.Wrap(err, "execution logs"))
+	}
+
+	if err := k.weightDelegationTable.Import(ctx.KVStore(k.key), genesisState.WeightDelegations, 0); err != nil {
+		panic(errors.Wrap(err, "weight delegations"))
+	}
+
 	return []abci.ValidatorUpdate{}
 
 }
@@ -85,5 +93,19 @@ func (k Keeper) ExportGenesis(ctx types.Context, cdc codec.JSONCodec) *group.Gen
 	}
 	genesisState.Votes = votes
 
+	var executionLogs []*group.ProposalExecutionLog
+	_, err = k.executionLogTable.Export(ctx.KVStore(k.key), &executionLogs)
+	if err != nil {
+		panic(errors.Wrap(err, "execution logs"))
+	}
+	genesisState.ExecutionLogs = executionLogs
+
+	var weightDelegations []*group.WeightDelegation
+	_, err = k.weightDelegationTable.Export(ctx.KVStore(k.key), &weightDelegations)
+	if err != nil {
+		panic(errors.Wrap(err, "weight delegations"))
+	}
+	genesisState.WeightDelegations = weightDelegations
+
 	return genesisState
 }
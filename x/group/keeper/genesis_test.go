@@ -115,6 +115,21 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 		ProposalSeq:    1,
 		Proposals:      []*group.Proposal{proposal},
 		Votes:          []*group.Vote{{ProposalId: proposal.Id, Voter: memberAddr.String(), SubmitTime: submittedAt, Option: group.VOTE_OPTION_YES}},
+		ExecutionLogs: []*group.ProposalExecutionLog{{
+			ProposalId:       proposal.Id,
+			Attempt:          1,
+			Executor:         memberAddr.String(),
+			ExecutedAt:       timeout,
+			Result:           group.PROPOSAL_EXECUTOR_RESULT_FAILURE,
+			FailedMsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}),
+			Error:            "insufficient funds",
+		}},
+		WeightDelegations: []*group.WeightDelegation{{
+			GroupId:    2,
+			Delegator:  memberAddr.String(),
+			Delegatee:  accAddr.String(),
+			Expiration: timeout,
+		}},
 	}
 	genesisBytes, err := cdc.MarshalJSON(genesisState)
 	s.Require().NoError(err)
@@ -161,8 +176,20 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 		s.Require().NoError(err)
 		s.Require().Equal(len(votesRes.Votes), 1)
 		s.Require().Equal(votesRes.Votes[0], genesisState.Votes[0])
+
+		logsRes, err := s.keeper.ProposalExecutionLogs(ctx, &group.QueryProposalExecutionLogsRequest{
+			ProposalId: g.Id,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(genesisState.ExecutionLogs, logsRes.Logs)
 	}
 
+	delegationsRes, err := s.keeper.WeightDelegationsByGroup(ctx, &group.QueryWeightDelegationsByGroupRequest{
+		GroupId: 2,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(genesisState.WeightDelegations, delegationsRes.Delegations)
+
 	exported := s.keeper.ExportGenesis(sdkCtx, cdc)
 	bz, err := cdc.MarshalJSON(exported)
 	s.Require().NoError(err)
@@ -188,6 +215,8 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 		s.assertProposalsEqual(g, res)
 	}
 	s.Require().Equal(genesisState.Votes, exportedGenesisState.Votes)
+	s.Require().Equal(genesisState.ExecutionLogs, exportedGenesisState.ExecutionLogs)
+	s.Require().Equal(genesisState.WeightDelegations, exportedGenesisState.WeightDelegations)
 
 	s.Require().Equal(genesisState.GroupSeq, exportedGenesisState.GroupSeq)
 	s.Require().Equal(genesisState.GroupPolicySeq, exportedGenesisState.GroupPolicySeq)
@@ -346,3 +346,50 @@ func (k Keeper) TallyResult(goCtx context.Context, request *group.QueryTallyResu
 		Tally: tallyResult,
 	}, nil
 }
+
+// ProposalExecutionLogs queries the execution attempts of a proposal.
+func (k Keeper) ProposalExecutionLogs(goCtx context.Context, request *group.QueryProposalExecutionLogsRequest) (*group.QueryProposalExecutionLogsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposalID := request.ProposalId
+
+	if _, err := k.getProposal(ctx, proposalID); err != nil {
+		return nil, err
+	}
+
+	it, err := k.executionLogByProposalIndex.GetPaginated(ctx.KVStore(k.key), proposalID, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []*group.ProposalExecutionLog
+	pageRes, err := orm.Paginate(it, request.Pagination, &logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryProposalExecutionLogsResponse{
+		Logs:       logs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// WeightDelegationsByGroup queries the voting weight delegations of a group.
+func (k Keeper) WeightDelegationsByGroup(goCtx context.Context, request *group.QueryWeightDelegationsByGroupRequest) (*group.QueryWeightDelegationsByGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	it, err := k.weightDelegationByGroupIndex.GetPaginated(ctx.KVStore(k.key), request.GroupId, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var delegations []*group.WeightDelegation
+	pageRes, err := orm.Paginate(it, request.Pagination, &delegations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryWeightDelegationsByGroupResponse{
+		Delegations: delegations,
+		Pagination:  pageRes,
+	}, nil
+}
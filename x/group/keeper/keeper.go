@@ -43,6 +43,16 @@ const (
 	VoteTablePrefix           byte = 0x40
 	VoteByProposalIndexPrefix byte = 0x41
 	VoteByVoterIndexPrefix    byte = 0x42
+
+	// Proposal Execution Log Table
+	ExecutionLogTablePrefix           byte = 0x50
+	ExecutionLogByProposalIndexPrefix byte = 0x51
+
+	// Weight Delegation Table
+	WeightDelegationTablePrefix            byte = 0x60
+	WeightDelegationByGroupIndexPrefix     byte = 0x61
+	WeightDelegationByDelegateeIndexPrefix byte = 0x62
+	WeightDelegationByExpirationPrefix     byte = 0x63
 )
 
 type Keeper struct {
@@ -75,6 +85,16 @@ type Keeper struct {
 	voteByProposalIndex orm.Index
 	voteByVoterIndex    orm.Index
 
+	// Proposal Execution Log Table
+	executionLogTable           orm.PrimaryKeyTable
+	executionLogByProposalIndex orm.Index
+
+	// Weight Delegation Table
+	weightDelegationTable            orm.PrimaryKeyTable
+	weightDelegationByGroupIndex     orm.Index
+	weightDelegationByDelegateeIndex orm.Index
+	weightDelegationsByExpiration    orm.Index
+
 	router *authmiddleware.MsgServiceRouter
 
 	config group.Config
@@ -202,9 +222,55 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router *authmiddle
 	}
 	k.voteTable = *voteTable
 
+	// Proposal Execution Log Table
+	executionLogTable, err := orm.NewPrimaryKeyTable([2]byte{ExecutionLogTablePrefix}, &group.ProposalExecutionLog{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.executionLogByProposalIndex, err = orm.NewIndex(executionLogTable, ExecutionLogByProposalIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{value.(*group.ProposalExecutionLog).ProposalId}, nil
+	}, group.ProposalExecutionLog{}.ProposalId)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.executionLogTable = *executionLogTable
+
+	// Weight Delegation Table
+	weightDelegationTable, err := orm.NewPrimaryKeyTable([2]byte{WeightDelegationTablePrefix}, &group.WeightDelegation{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.weightDelegationByGroupIndex, err = orm.NewIndex(weightDelegationTable, WeightDelegationByGroupIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{value.(*group.WeightDelegation).GroupId}, nil
+	}, group.WeightDelegation{}.GroupId)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.weightDelegationByDelegateeIndex, err = orm.NewIndex(weightDelegationTable, WeightDelegationByDelegateeIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		addr, err := sdk.AccAddressFromBech32(value.(*group.WeightDelegation).Delegatee)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{addr.Bytes()}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
+	k.weightDelegationsByExpiration, err = orm.NewIndex(weightDelegationTable, WeightDelegationByExpirationPrefix, func(value interface{}) ([]interface{}, error) {
+		expiration := value.(*group.WeightDelegation).Expiration
+		return []interface{}{sdk.FormatTimeBytes(expiration)}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
+	k.weightDelegationTable = *weightDelegationTable
+
 	if config.MaxMetadataLen == 0 {
 		config.MaxMetadataLen = group.DefaultConfig().MaxMetadataLen
 	}
+	if config.MaxExecutionLogs == 0 {
+		config.MaxExecutionLogs = group.DefaultConfig().MaxExecutionLogs
+	}
 	if config.MaxExecutionPeriod == 0 {
 		config.MaxExecutionPeriod = group.DefaultConfig().MaxExecutionPeriod
 	}
@@ -274,6 +340,10 @@ func (k Keeper) pruneProposal(ctx sdk.Context, proposalID uint64) error {
 		return err
 	}
 
+	if err := k.pruneExecutionLogs(ctx, proposalID); err != nil {
+		return err
+	}
+
 	k.Logger(ctx).Debug(fmt.Sprintf("Pruned proposal %d", proposalID))
 	return nil
 }
@@ -337,6 +407,189 @@ func (k Keeper) pruneVotes(ctx sdk.Context, proposalID uint64) error {
 	return nil
 }
 
+// pruneExecutionLogs prunes all execution logs of a proposal from state.
+func (k Keeper) pruneExecutionLogs(ctx sdk.Context, proposalID uint64) error {
+	logs, err := k.getExecutionLogs(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.key)
+	for _, log := range logs {
+		if err := k.executionLogTable.Delete(store, log); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getExecutionLogs returns all execution logs of a proposal, ordered by
+// attempt.
+func (k Keeper) getExecutionLogs(ctx sdk.Context, proposalID uint64) ([]*group.ProposalExecutionLog, error) {
+	it, err := k.executionLogByProposalIndex.Get(ctx.KVStore(k.key), proposalID)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var logs []*group.ProposalExecutionLog
+	if _, err := orm.ReadAll(it, &logs); err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+// addExecutionLog stores the execution log of an attempt to execute a proposal,
+// numbering the attempt. Only the last MaxExecutionLogs attempts of a proposal
+// are kept, so that retrying MsgExec doesn't grow the state without bound.
+func (k Keeper) addExecutionLog(ctx sdk.Context, executionLog *group.ProposalExecutionLog) error {
+	logs, err := k.getExecutionLogs(ctx, executionLog.ProposalId)
+	if err != nil {
+		return err
+	}
+
+	executionLog.Attempt = 1
+	if len(logs) > 0 {
+		executionLog.Attempt = logs[len(logs)-1].Attempt + 1
+	}
+
+	store := ctx.KVStore(k.key)
+	for uint64(len(logs)) >= k.config.MaxExecutionLogs {
+		if err := k.executionLogTable.Delete(store, logs[0]); err != nil {
+			return err
+		}
+		logs = logs[1:]
+	}
+
+	return k.executionLogTable.Create(store, executionLog)
+}
+
+// getWeightDelegation returns the weight delegation of a group member,
+// whether it expired or not.
+func (k Keeper) getWeightDelegation(ctx sdk.Context, groupID uint64, delegator string) (group.WeightDelegation, error) {
+	var delegation group.WeightDelegation
+	err := k.weightDelegationTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.WeightDelegation{
+		GroupId:   groupID,
+		Delegator: delegator,
+	}), &delegation)
+	return delegation, err
+}
+
+// getActiveWeightDelegation returns the weight delegation of a group member if
+// it has not expired yet.
+func (k Keeper) getActiveWeightDelegation(ctx sdk.Context, groupID uint64, delegator string) (*group.WeightDelegation, error) {
+	delegation, err := k.getWeightDelegation(ctx, groupID, delegator)
+	switch {
+	case sdkerrors.ErrNotFound.Is(err):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	case !delegation.IsActive(ctx.BlockTime()):
+		return nil, nil
+	}
+
+	return &delegation, nil
+}
+
+// getActiveWeightDelegationsTo returns the unexpired weight delegations whose
+// delegatee is the given group member.
+func (k Keeper) getActiveWeightDelegationsTo(ctx sdk.Context, groupID uint64, delegatee string) ([]*group.WeightDelegation, error) {
+	addr, err := sdk.AccAddressFromBech32(delegatee)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.weightDelegationByDelegateeIndex.Get(ctx.KVStore(k.key), addr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var delegations []*group.WeightDelegation
+	for {
+		var delegation group.WeightDelegation
+		_, err := it.LoadNext(&delegation)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if delegation.GroupId == groupID && delegation.IsActive(ctx.BlockTime()) {
+			delegations = append(delegations, &delegation)
+		}
+	}
+
+	return delegations, nil
+}
+
+// removeWeightDelegations deletes the weight delegations from and to a group
+// member, used when the member is removed from the group.
+func (k Keeper) removeWeightDelegations(ctx sdk.Context, groupID uint64, member string) error {
+	store := ctx.KVStore(k.key)
+
+	delegation, err := k.getWeightDelegation(ctx, groupID, member)
+	switch {
+	case err == nil:
+		if err := k.weightDelegationTable.Delete(store, &delegation); err != nil {
+			return err
+		}
+	case !sdkerrors.ErrNotFound.Is(err):
+		return err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(member)
+	if err != nil {
+		return err
+	}
+	it, err := k.weightDelegationByDelegateeIndex.Get(store, addr.Bytes())
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	var delegations []*group.WeightDelegation
+	if _, err := orm.ReadAll(it, &delegations); err != nil {
+		return err
+	}
+	for _, d := range delegations {
+		if d.GroupId != groupID {
+			continue
+		}
+		if err := k.weightDelegationTable.Delete(store, d); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PruneWeightDelegations prunes all weight delegations which expired at the
+// current block time.
+func (k Keeper) PruneWeightDelegations(ctx sdk.Context) error {
+	store := ctx.KVStore(k.key)
+	it, err := k.weightDelegationsByExpiration.PrefixScan(store, nil, sdk.FormatTimeBytes(ctx.BlockTime().Add(time.Nanosecond)))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	var delegations []*group.WeightDelegation
+	if _, err := orm.ReadAll(it, &delegations); err != nil {
+		return err
+	}
+	for _, d := range delegations {
+		if err := k.weightDelegationTable.Delete(store, d); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // PruneProposals prunes all proposals that are expired, i.e. whose
 // `voting_period + max_execution_period` is greater than the current block
 // time.
@@ -15,9 +15,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/errors"
 	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
 	"github.com/cosmos/cosmos-sdk/x/group/keeper"
 	"github.com/cosmos/cosmos-sdk/x/group/module"
@@ -904,6 +907,18 @@ func (s *TestSuite) TestCreateGroupPolicy() {
 				0,
 			),
 		},
+		"all good with percentage quorum decision policy": {
+			req: &group.MsgCreateGroupPolicy{
+				Admin:   addr1.String(),
+				GroupId: myGroupID,
+			},
+			policy: group.NewPercentageQuorumDecisionPolicy(
+				"0.4",
+				"0.5",
+				time.Second,
+				0,
+			),
+		},
 		"decision policy threshold > total group weight": {
 			req: &group.MsgCreateGroupPolicy{
 				Admin:   addr1.String(),
@@ -981,6 +996,20 @@ func (s *TestSuite) TestCreateGroupPolicy() {
 			expErr:    true,
 			expErrMsg: "percentage must be > 0 and <= 1",
 		},
+		"percentage quorum decision policy with quorum greater than 1": {
+			req: &group.MsgCreateGroupPolicy{
+				Admin:   addr1.String(),
+				GroupId: myGroupID,
+			},
+			policy: group.NewPercentageQuorumDecisionPolicy(
+				"1.5",
+				"0.5",
+				time.Second,
+				0,
+			),
+			expErr:    true,
+			expErrMsg: "quorum must be > 0 and <= 1",
+		},
 	}
 	for msg, spec := range specs {
 		spec := spec
@@ -1007,16 +1036,9 @@ func (s *TestSuite) TestCreateGroupPolicy() {
 			s.Assert().Equal(spec.req.Admin, groupPolicy.Admin)
 			s.Assert().Equal(spec.req.Metadata, groupPolicy.Metadata)
 			s.Assert().Equal(uint64(1), groupPolicy.Version)
-			percentageDecisionPolicy, ok := spec.policy.(*group.PercentageDecisionPolicy)
-			if ok {
-				dp, err := groupPolicy.GetDecisionPolicy()
-				s.Assert().NoError(err)
-				s.Assert().Equal(percentageDecisionPolicy, dp)
-			} else {
-				dp, err := groupPolicy.GetDecisionPolicy()
-				s.Assert().NoError(err)
-				s.Assert().Equal(spec.policy.(*group.ThresholdDecisionPolicy), dp)
-			}
+			dp, err := groupPolicy.GetDecisionPolicy()
+			s.Assert().NoError(err)
+			s.Assert().Equal(spec.policy, dp)
 		})
 	}
 }
@@ -2516,6 +2538,97 @@ func (s *TestSuite) TestExecPrunedProposalsAndVotes() {
 	}
 }
 
+func (s *TestSuite) TestProposalExecutionLogs() {
+	addrs := s.addrs
+	addr1 := addrs[0]
+	addr2 := addrs[1]
+
+	msgSend1 := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyAddr.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	msgSend2 := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyAddr.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 10001)},
+	}
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	proposalID := submitProposalAndVote(ctx, s, []sdk.Msg{msgSend1, msgSend2}, []string{addr2.String()}, group.VOTE_OPTION_YES)
+
+	// no execution attempt yet
+	res, err := s.keeper.ProposalExecutionLogs(ctx, &group.QueryProposalExecutionLogsRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Logs)
+
+	// failed attempts are logged with the message which failed
+	for i := 0; i < 2; i++ {
+		execRes, err := s.keeper.Exec(ctx, &group.MsgExec{Executor: addr1.String(), ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, execRes.Result)
+	}
+
+	res, err = s.keeper.ProposalExecutionLogs(ctx, &group.QueryProposalExecutionLogsRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Len(res.Logs, 2)
+	for i, log := range res.Logs {
+		s.Require().Equal(proposalID, log.ProposalId)
+		s.Require().Equal(uint64(i+1), log.Attempt)
+		s.Require().Equal(addr1.String(), log.Executor)
+		s.Require().Equal(s.blockTime, log.ExecutedAt)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, log.Result)
+		s.Require().Equal(uint64(1), log.FailedMsgIndex)
+		s.Require().Equal(sdk.MsgTypeURL(msgSend2), log.FailedMsgTypeUrl)
+		s.Require().Contains(log.Error, "insufficient funds")
+	}
+
+	// pagination is supported
+	res, err = s.keeper.ProposalExecutionLogs(ctx, &group.QueryProposalExecutionLogsRequest{
+		ProposalId: proposalID,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Logs, 1)
+	s.Require().Equal(uint64(1), res.Logs[0].Attempt)
+	s.Require().Equal(uint64(2), res.Pagination.Total)
+
+	// only the last attempts are kept
+	maxLogs := int(group.DefaultConfig().MaxExecutionLogs)
+	for i := 2; i < maxLogs+2; i++ {
+		_, err := s.keeper.Exec(ctx, &group.MsgExec{Executor: addr1.String(), ProposalId: proposalID})
+		s.Require().NoError(err)
+	}
+	res, err = s.keeper.ProposalExecutionLogs(ctx, &group.QueryProposalExecutionLogsRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Len(res.Logs, maxLogs)
+	for i, log := range res.Logs {
+		s.Require().Equal(uint64(i+3), log.Attempt)
+	}
+
+	// attempts are rejected once the proposal expired
+	expiredCtx := sdkCtx.WithBlockTime(s.blockTime.Add(time.Second).Add(group.DefaultConfig().MaxExecutionPeriod).Add(time.Nanosecond))
+	_, err = s.keeper.Exec(sdk.WrapSDKContext(expiredCtx), &group.MsgExec{Executor: addr1.String(), ProposalId: proposalID})
+	s.Require().ErrorIs(err, errors.ErrExpired)
+
+	// and the expired proposal is pruned along with its logs
+	prunedCtx, _ := expiredCtx.CacheContext()
+	s.Require().NoError(s.keeper.PruneProposals(prunedCtx))
+	_, err = s.keeper.ProposalExecutionLogs(sdk.WrapSDKContext(prunedCtx), &group.QueryProposalExecutionLogsRequest{ProposalId: proposalID})
+	s.Require().Error(err)
+	s.Require().Empty(s.keeper.ExportGenesis(prunedCtx, s.app.AppCodec()).ExecutionLogs)
+
+	// a successful retry prunes the proposal along with its logs
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, sdkCtx, s.groupPolicyAddr, sdk.Coins{sdk.NewInt64Coin("test", 10002)}))
+	execRes, err := s.keeper.Exec(ctx, &group.MsgExec{Executor: addr1.String(), ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, execRes.Result)
+
+	_, err = s.keeper.ProposalExecutionLogs(ctx, &group.QueryProposalExecutionLogsRequest{ProposalId: proposalID})
+	s.Require().Error(err)
+}
+
 func (s *TestSuite) TestProposalsByVPEnd() {
 	addrs := s.addrs
 	addr2 := addrs[1]
@@ -2834,6 +2947,114 @@ func (s *TestSuite) TestLeaveGroup() {
 	}
 }
 
+func (s *TestSuite) TestWeightDelegation() {
+	addrs := s.addrs
+	admin, delegator, delegatee, other := addrs[0], addrs[1], addrs[2], addrs[3]
+	expiration := s.blockTime.Add(time.Hour)
+
+	members := []group.Member{
+		{Address: delegator.String(), Weight: "1"},
+		{Address: delegatee.String(), Weight: "2"},
+		{Address: other.String(), Weight: "3"},
+	}
+	policyAddr, groupID := s.createGroupAndGroupPolicy(admin, members, group.NewThresholdDecisionPolicy("4", time.Second, 0))
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	delegate := func(delegator, delegatee sdk.AccAddress, expiration time.Time) error {
+		_, err := s.keeper.DelegateWeight(ctx, &group.MsgDelegateWeight{
+			Delegator:  delegator.String(),
+			GroupId:    groupID,
+			Delegatee:  delegatee.String(),
+			Expiration: expiration,
+		})
+		return err
+	}
+	tally := func(ctx sdk.Context, proposalID uint64) group.TallyResult {
+		res, err := s.keeper.Proposal(sdk.WrapSDKContext(ctx), &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		result, err := s.keeper.Tally(ctx, *res.Proposal, groupID)
+		s.Require().NoError(err)
+		return result
+	}
+
+	// invalid delegations
+	s.Require().ErrorIs(delegate(delegator, addrs[4], expiration), sdkerrors.ErrNotFound)
+	s.Require().ErrorIs(delegate(delegator, delegatee, s.blockTime), errors.ErrExpired)
+
+	s.Require().NoError(delegate(delegator, delegatee, expiration))
+
+	// delegations are not transitive
+	s.Require().ErrorIs(delegate(other, delegator, expiration), errors.ErrInvalid)
+	s.Require().ErrorIs(delegate(delegatee, other, expiration), errors.ErrInvalid)
+
+	res, err := s.keeper.WeightDelegationsByGroup(ctx, &group.QueryWeightDelegationsByGroupRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Equal([]*group.WeightDelegation{{
+		GroupId:    groupID,
+		Delegator:  delegator.String(),
+		Delegatee:  delegatee.String(),
+		Expiration: expiration,
+	}}, res.Delegations)
+
+	proposalReq := &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{other.String()},
+	}
+	s.Require().NoError(proposalReq.SetMsgs([]sdk.Msg{&banktypes.MsgSend{
+		FromAddress: policyAddr,
+		ToAddress:   other.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}}))
+	proposalRes, err := s.keeper.SubmitProposal(ctx, proposalReq)
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+
+	// the delegator votes through the delegatee
+	_, err = s.keeper.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: delegator.String(), Option: group.VOTE_OPTION_YES})
+	s.Require().ErrorIs(err, errors.ErrInvalid)
+	_, err = s.keeper.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: delegatee.String(), Option: group.VOTE_OPTION_YES})
+	s.Require().NoError(err)
+	s.Require().Equal("3", tally(sdkCtx, proposalID).YesCount)
+
+	// delegated weights are not counted once expired
+	s.Require().Equal("2", tally(sdkCtx.WithBlockTime(expiration), proposalID).YesCount)
+
+	// the delegation is reversible
+	_, err = s.keeper.UndelegateWeight(ctx, &group.MsgUndelegateWeight{Delegator: delegator.String(), GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Equal("2", tally(sdkCtx, proposalID).YesCount)
+	_, err = s.keeper.UndelegateWeight(ctx, &group.MsgUndelegateWeight{Delegator: delegator.String(), GroupId: groupID})
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	// a delegator who voted before delegating keeps its own vote
+	_, err = s.keeper.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: delegator.String(), Option: group.VOTE_OPTION_NO})
+	s.Require().NoError(err)
+	s.Require().NoError(delegate(delegator, delegatee, expiration))
+	result := tally(sdkCtx, proposalID)
+	s.Require().Equal("2", result.YesCount)
+	s.Require().Equal("1", result.NoCount)
+
+	// expired delegations are pruned
+	s.Require().NoError(s.keeper.PruneWeightDelegations(sdkCtx.WithBlockTime(expiration.Add(-time.Second))))
+	res, err = s.keeper.WeightDelegationsByGroup(ctx, &group.QueryWeightDelegationsByGroupRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Len(res.Delegations, 1)
+	s.Require().NoError(s.keeper.PruneWeightDelegations(sdkCtx.WithBlockTime(expiration)))
+	res, err = s.keeper.WeightDelegationsByGroup(ctx, &group.QueryWeightDelegationsByGroupRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Delegations)
+
+	// delegations are removed with the group member
+	s.Require().NoError(delegate(other, delegatee, expiration))
+	_, err = s.keeper.LeaveGroup(ctx, &group.MsgLeaveGroup{Address: delegatee.String(), GroupId: groupID})
+	s.Require().NoError(err)
+	res, err = s.keeper.WeightDelegationsByGroup(ctx, &group.QueryWeightDelegationsByGroupRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Delegations)
+}
+
 func submitProposal(
 	ctx context.Context, s *TestSuite, msgs []sdk.Msg,
 	proposers []string) uint64 {
@@ -152,6 +152,9 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, req *group.MsgUpdateGr
 				if err := k.groupMemberTable.Delete(ctx.KVStore(k.key), &groupMember); err != nil {
 					return sdkerrors.Wrap(err, "delete member")
 				}
+				if err := k.removeWeightDelegations(ctx, req.GroupId, groupMember.Member.Address); err != nil {
+					return sdkerrors.Wrap(err, "delete member weight delegations")
+				}
 				continue
 			}
 			// If group member already exists, handle update
@@ -634,6 +637,15 @@ func (k Keeper) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgVoteR
 	if err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&voter), &voter); err != nil {
 		return nil, sdkerrors.Wrapf(err, "voter address: %s", voterAddr)
 	}
+
+	// A member delegating its weight votes through its delegatee.
+	delegation, err := k.getActiveWeightDelegation(ctx, electorate.Id, voterAddr)
+	if err != nil {
+		return nil, err
+	}
+	if delegation != nil {
+		return nil, sdkerrors.Wrapf(errors.ErrInvalid, "voting weight delegated to %s until %s", delegation.Delegatee, delegation.Expiration)
+	}
 	newVote := group.Vote{
 		ProposalId: id,
 		Voter:      voterAddr,
@@ -742,22 +754,45 @@ func (k Keeper) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecR
 	// Execute proposal payload.
 	var logs string
 	if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+		// A failed execution can be retried until the proposal expires.
+		expiryDate := proposal.VotingPeriodEnd.Add(k.config.MaxExecutionPeriod)
+		if expiryDate.Before(ctx.BlockTime()) {
+			return nil, errors.ErrExpired.Wrapf("proposal execution expired on %s", expiryDate)
+		}
+
+		executionLog := group.ProposalExecutionLog{
+			ProposalId: id,
+			Executor:   req.Executor,
+			ExecutedAt: ctx.BlockTime(),
+		}
+
 		// Caching context so that we don't update the store in case of failure.
-		ctx, flush := ctx.CacheContext()
+		cacheCtx, flush := ctx.CacheContext()
 
 		addr, err := sdk.AccAddressFromBech32(policyInfo.Address)
 		if err != nil {
 			return nil, err
 		}
-		_, err = k.doExecuteMsgs(ctx, k.router, proposal, addr)
+		_, err = k.doExecuteMsgs(cacheCtx, k.router, proposal, addr)
 		if err != nil {
 			proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
 			logs = fmt.Sprintf("proposal execution failed on proposal %d, because of error %s", id, err.Error())
 			k.Logger(ctx).Info("proposal execution failed", "cause", err, "proposalID", id)
+
+			executionLog.Error = err.Error()
+			if msgErr, ok := err.(*msgExecutionError); ok {
+				executionLog.FailedMsgIndex = uint64(msgErr.index)
+				executionLog.FailedMsgTypeUrl = msgErr.typeURL
+			}
 		} else {
 			proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_SUCCESS
 			flush()
 		}
+
+		executionLog.Result = proposal.ExecutorResult
+		if err := k.addExecutionLog(ctx, &executionLog); err != nil {
+			return nil, sdkerrors.Wrap(err, "create execution log")
+		}
 	}
 
 	// Update proposal in proposalTable
@@ -828,6 +863,10 @@ func (k Keeper) LeaveGroup(goCtx context.Context, req *group.MsgLeaveGroup) (*gr
 		return nil, sdkerrors.Wrap(err, "group member")
 	}
 
+	if err := k.removeWeightDelegations(ctx, req.GroupId, req.Address); err != nil {
+		return nil, sdkerrors.Wrap(err, "group member weight delegations")
+	}
+
 	// update group weight
 	groupInfo.TotalWeight = updatedWeight.String()
 	groupInfo.Version++
@@ -848,6 +887,91 @@ func (k Keeper) LeaveGroup(goCtx context.Context, req *group.MsgLeaveGroup) (*gr
 	return &group.MsgLeaveGroupResponse{}, nil
 }
 
+// DelegateWeight implements the MsgServer/DelegateWeight method.
+func (k Keeper) DelegateWeight(goCtx context.Context, req *group.MsgDelegateWeight) (*group.MsgDelegateWeightResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !req.Expiration.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrap(errors.ErrExpired, "expiration must be after the current block time")
+	}
+
+	if _, err := k.getGroupInfo(ctx, req.GroupId); err != nil {
+		return nil, sdkerrors.Wrap(err, "group")
+	}
+
+	for _, addr := range []string{req.Delegator, req.Delegatee} {
+		if _, err := k.getGroupMember(ctx, &group.GroupMember{
+			GroupId: req.GroupId,
+			Member:  &group.Member{Address: addr},
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	// Delegations are not transitive, so a member can't both delegate its
+	// weight and receive weight from other members.
+	delegateeDelegation, err := k.getActiveWeightDelegation(ctx, req.GroupId, req.Delegatee)
+	if err != nil {
+		return nil, err
+	}
+	if delegateeDelegation != nil {
+		return nil, sdkerrors.Wrapf(errors.ErrInvalid, "delegatee %s delegated its weight to %s", req.Delegatee, delegateeDelegation.Delegatee)
+	}
+	received, err := k.getActiveWeightDelegationsTo(ctx, req.GroupId, req.Delegator)
+	if err != nil {
+		return nil, err
+	}
+	if len(received) > 0 {
+		return nil, sdkerrors.Wrapf(errors.ErrInvalid, "delegator %s received the weight of %d members", req.Delegator, len(received))
+	}
+
+	delegation := group.WeightDelegation{
+		GroupId:    req.GroupId,
+		Delegator:  req.Delegator,
+		Delegatee:  req.Delegatee,
+		Expiration: req.Expiration,
+	}
+	if err := k.weightDelegationTable.Set(ctx.KVStore(k.key), &delegation); err != nil {
+		return nil, sdkerrors.Wrap(err, "weight delegation")
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&group.EventDelegateWeight{
+		GroupId:   req.GroupId,
+		Delegator: req.Delegator,
+		Delegatee: req.Delegatee,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgDelegateWeightResponse{}, nil
+}
+
+// UndelegateWeight implements the MsgServer/UndelegateWeight method.
+func (k Keeper) UndelegateWeight(goCtx context.Context, req *group.MsgUndelegateWeight) (*group.MsgUndelegateWeightResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegation, err := k.getWeightDelegation(ctx, req.GroupId, req.Delegator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "weight delegation")
+	}
+
+	if err := k.weightDelegationTable.Delete(ctx.KVStore(k.key), &delegation); err != nil {
+		return nil, sdkerrors.Wrap(err, "weight delegation")
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&group.EventUndelegateWeight{
+		GroupId:   req.GroupId,
+		Delegator: req.Delegator,
+		Delegatee: delegation.Delegatee,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUndelegateWeightResponse{}, nil
+}
+
 func (k Keeper) getGroupMember(ctx sdk.Context, member *group.GroupMember) (*group.GroupMember, error) {
 	var groupMember group.GroupMember
 	switch err := k.groupMemberTable.GetOne(ctx.KVStore(k.key),
@@ -35,15 +35,15 @@ func (s Keeper) doExecuteMsgs(ctx sdk.Context, router *authmiddleware.MsgService
 	for i, msg := range msgs {
 		handler := s.router.Handler(msg)
 		if handler == nil {
-			return nil, errors.Wrapf(grouperrors.ErrInvalid, "no message handler found for %q", sdk.MsgTypeURL(msg))
+			return nil, newMsgExecutionError(i, msg, errors.Wrapf(grouperrors.ErrInvalid, "no message handler found for %q", sdk.MsgTypeURL(msg)))
 		}
-		r, err := handler(ctx, msg)
+		r, err := sdk.ExecNestedMsg(ctx, msg, handler)
 		if err != nil {
-			return nil, errors.Wrapf(err, "message %s at position %d", sdk.MsgTypeURL(msg), i)
+			return nil, newMsgExecutionError(i, msg, errors.Wrapf(err, "message %s at position %d", sdk.MsgTypeURL(msg), i))
 		}
 		// Handler should always return non-nil sdk.Result.
 		if r == nil {
-			return nil, fmt.Errorf("got nil sdk.Result for message %q at position %d", msg, i)
+			return nil, newMsgExecutionError(i, msg, fmt.Errorf("got nil sdk.Result for message %q at position %d", msg, i))
 		}
 
 		results[i] = *r
@@ -67,3 +67,23 @@ func ensureMsgAuthZ(msgs []sdk.Msg, groupPolicyAcc sdk.AccAddress) error {
 	}
 	return nil
 }
+
+// msgExecutionError is returned by doExecuteMsgs when a proposal message
+// fails, so that the failing message can be recorded in the execution log.
+type msgExecutionError struct {
+	index   int
+	typeURL string
+	err     error
+}
+
+func newMsgExecutionError(index int, msg sdk.Msg, err error) *msgExecutionError {
+	return &msgExecutionError{index: index, typeURL: sdk.MsgTypeURL(msg), err: err}
+}
+
+func (e *msgExecutionError) Error() string {
+	return e.err.Error()
+}
+
+func (e *msgExecutionError) Unwrap() error {
+	return e.err
+}
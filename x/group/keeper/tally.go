@@ -57,7 +57,46 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupId uint64) (group.
 		if err := tallyResult.Add(vote, member.Member.Weight); err != nil {
 			return group.TallyResult{}, sdkerrors.Wrap(err, "add new vote")
 		}
+
+		if err := k.tallyDelegatedWeights(ctx, &tallyResult, vote, groupId); err != nil {
+			return group.TallyResult{}, err
+		}
 	}
 
 	return tallyResult, nil
 }
+
+// tallyDelegatedWeights adds to the tally result the weights delegated to the
+// voter by members who did not vote themselves on the proposal.
+func (k Keeper) tallyDelegatedWeights(ctx sdk.Context, tallyResult *group.TallyResult, vote group.Vote, groupId uint64) error {
+	delegations, err := k.getActiveWeightDelegationsTo(ctx, groupId, vote.Voter)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.key)
+	for _, delegation := range delegations {
+		// A delegator who voted before delegating keeps its own vote.
+		if k.voteTable.Has(store, orm.PrimaryKey(&group.Vote{ProposalId: vote.ProposalId, Voter: delegation.Delegator})) {
+			continue
+		}
+
+		var delegator group.GroupMember
+		err := k.groupMemberTable.GetOne(store, orm.PrimaryKey(&group.GroupMember{
+			GroupId: groupId,
+			Member:  &group.Member{Address: delegation.Delegator},
+		}), &delegator)
+		switch {
+		case sdkerrors.ErrNotFound.Is(err):
+			continue
+		case err != nil:
+			return err
+		}
+
+		if err := tallyResult.Add(vote, delegator.Member.Weight); err != nil {
+			return sdkerrors.Wrap(err, "add delegated vote")
+		}
+	}
+
+	return nil
+}
@@ -10,6 +10,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 		panic(err)
 	}
 	pruneProposals(ctx, k)
+	if err := k.PruneWeightDelegations(ctx); err != nil {
+		panic(err)
+	}
 }
 
 func pruneProposals(ctx sdk.Context, k keeper.Keeper) {
@@ -823,6 +823,80 @@ func (m MsgLeaveGroup) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgDelegateWeight{}
+
+// Route Implements Msg
+func (m MsgDelegateWeight) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Type Implements Msg
+func (m MsgDelegateWeight) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg
+func (m MsgDelegateWeight) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgDelegateWeight
+func (m MsgDelegateWeight) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Delegator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgDelegateWeight) ValidateBasic() error {
+	delegation := WeightDelegation{
+		GroupId:    m.GroupId,
+		Delegator:  m.Delegator,
+		Delegatee:  m.Delegatee,
+		Expiration: m.Expiration,
+	}
+	return delegation.ValidateBasic()
+}
+
+var _ sdk.Msg = &MsgUndelegateWeight{}
+
+// Route Implements Msg
+func (m MsgUndelegateWeight) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Type Implements Msg
+func (m MsgUndelegateWeight) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg
+func (m MsgUndelegateWeight) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUndelegateWeight
+func (m MsgUndelegateWeight) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Delegator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgUndelegateWeight) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Delegator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "delegator")
+	}
+
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "group-id")
+	}
+	return nil
+}
+
 // strictValidateMembers performs ValidateBasic on Members, but also checks
 // that all members weights are positive (whereas `Members{members}.ValidateBasic()`
 // only checks that they are non-negative.
@@ -1165,3 +1165,140 @@ func TestMsgLeaveGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgDelegateWeight(t *testing.T) {
+	expiration := time.Now().Add(time.Hour)
+	testCases := []struct {
+		name   string
+		msg    *group.MsgDelegateWeight
+		expErr bool
+		errMsg string
+	}{
+		{
+			"group id is required",
+			&group.MsgDelegateWeight{
+				Delegator:  member1.String(),
+				Delegatee:  member2.String(),
+				Expiration: expiration,
+			},
+			true,
+			"group id: value is empty",
+		},
+		{
+			"invalid delegator address",
+			&group.MsgDelegateWeight{
+				Delegator:  "member",
+				GroupId:    1,
+				Delegatee:  member2.String(),
+				Expiration: expiration,
+			},
+			true,
+			"delegator: decoding bech32 failed",
+		},
+		{
+			"invalid delegatee address",
+			&group.MsgDelegateWeight{
+				Delegator:  member1.String(),
+				GroupId:    1,
+				Delegatee:  "member",
+				Expiration: expiration,
+			},
+			true,
+			"delegatee: decoding bech32 failed",
+		},
+		{
+			"delegating to self",
+			&group.MsgDelegateWeight{
+				Delegator:  member1.String(),
+				GroupId:    1,
+				Delegatee:  member1.String(),
+				Expiration: expiration,
+			},
+			true,
+			"delegator and delegatee must be different",
+		},
+		{
+			"expiration is required",
+			&group.MsgDelegateWeight{
+				Delegator: member1.String(),
+				GroupId:   1,
+				Delegatee: member2.String(),
+			},
+			true,
+			"expiration: value is empty",
+		},
+		{
+			"valid testcase",
+			&group.MsgDelegateWeight{
+				Delegator:  member1.String(),
+				GroupId:    1,
+				Delegatee:  member2.String(),
+				Expiration: expiration,
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := tc.msg
+			err := msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, msg.Type(), sdk.MsgTypeURL(&group.MsgDelegateWeight{}))
+			}
+		})
+	}
+}
+
+func TestMsgUndelegateWeight(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *group.MsgUndelegateWeight
+		expErr bool
+		errMsg string
+	}{
+		{
+			"invalid delegator address",
+			&group.MsgUndelegateWeight{
+				Delegator: "member",
+				GroupId:   1,
+			},
+			true,
+			"delegator: decoding bech32 failed",
+		},
+		{
+			"group id is required",
+			&group.MsgUndelegateWeight{
+				Delegator: member1.String(),
+			},
+			true,
+			"group-id: value is empty",
+		},
+		{
+			"valid testcase",
+			&group.MsgUndelegateWeight{
+				Delegator: member1.String(),
+				GroupId:   1,
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := tc.msg
+			err := msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, msg.Type(), sdk.MsgTypeURL(&group.MsgUndelegateWeight{}))
+			}
+		})
+	}
+}
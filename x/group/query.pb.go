@@ -1380,6 +1380,235 @@ func (m *QueryTallyResultResponse) GetTally() TallyResult {
 	return TallyResult{}
 }
 
+// QueryProposalExecutionLogsRequest is the Query/ProposalExecutionLogs request type.
+//
+// Since: cosmos-sdk 0.47
+type QueryProposalExecutionLogsRequest struct {
+	// proposal_id is the unique id of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalExecutionLogsRequest) Reset()         { *m = QueryProposalExecutionLogsRequest{} }
+func (m *QueryProposalExecutionLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalExecutionLogsRequest) ProtoMessage()    {}
+func (*QueryProposalExecutionLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{26}
+}
+func (m *QueryProposalExecutionLogsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalExecutionLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalExecutionLogsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalExecutionLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalExecutionLogsRequest.Merge(m, src)
+}
+func (m *QueryProposalExecutionLogsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalExecutionLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalExecutionLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalExecutionLogsRequest proto.InternalMessageInfo
+
+func (m *QueryProposalExecutionLogsRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *QueryProposalExecutionLogsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProposalExecutionLogsResponse is the Query/ProposalExecutionLogs response type.
+//
+// Since: cosmos-sdk 0.47
+type QueryProposalExecutionLogsResponse struct {
+	// logs are the execution attempts of the proposal, ordered by attempt.
+	Logs []*ProposalExecutionLog `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalExecutionLogsResponse) Reset()         { *m = QueryProposalExecutionLogsResponse{} }
+func (m *QueryProposalExecutionLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalExecutionLogsResponse) ProtoMessage()    {}
+func (*QueryProposalExecutionLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{27}
+}
+func (m *QueryProposalExecutionLogsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalExecutionLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalExecutionLogsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalExecutionLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalExecutionLogsResponse.Merge(m, src)
+}
+func (m *QueryProposalExecutionLogsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalExecutionLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalExecutionLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalExecutionLogsResponse proto.InternalMessageInfo
+
+func (m *QueryProposalExecutionLogsResponse) GetLogs() []*ProposalExecutionLog {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *QueryProposalExecutionLogsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryWeightDelegationsByGroupRequest is the Query/WeightDelegationsByGroup request type.
+//
+// Since: cosmos-sdk 0.47
+type QueryWeightDelegationsByGroupRequest struct {
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWeightDelegationsByGroupRequest) Reset()         { *m = QueryWeightDelegationsByGroupRequest{} }
+func (m *QueryWeightDelegationsByGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWeightDelegationsByGroupRequest) ProtoMessage()    {}
+func (*QueryWeightDelegationsByGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{28}
+}
+func (m *QueryWeightDelegationsByGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWeightDelegationsByGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWeightDelegationsByGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWeightDelegationsByGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWeightDelegationsByGroupRequest.Merge(m, src)
+}
+func (m *QueryWeightDelegationsByGroupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWeightDelegationsByGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWeightDelegationsByGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWeightDelegationsByGroupRequest proto.InternalMessageInfo
+
+func (m *QueryWeightDelegationsByGroupRequest) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *QueryWeightDelegationsByGroupRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryWeightDelegationsByGroupResponse is the Query/WeightDelegationsByGroup response type.
+//
+// Since: cosmos-sdk 0.47
+type QueryWeightDelegationsByGroupResponse struct {
+	// delegations are the weight delegations of the group, including expired
+	// ones which are not pruned yet.
+	Delegations []*WeightDelegation `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWeightDelegationsByGroupResponse) Reset()         { *m = QueryWeightDelegationsByGroupResponse{} }
+func (m *QueryWeightDelegationsByGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWeightDelegationsByGroupResponse) ProtoMessage()    {}
+func (*QueryWeightDelegationsByGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{29}
+}
+func (m *QueryWeightDelegationsByGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWeightDelegationsByGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWeightDelegationsByGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWeightDelegationsByGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWeightDelegationsByGroupResponse.Merge(m, src)
+}
+func (m *QueryWeightDelegationsByGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWeightDelegationsByGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWeightDelegationsByGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWeightDelegationsByGroupResponse proto.InternalMessageInfo
+
+func (m *QueryWeightDelegationsByGroupResponse) GetDelegations() []*WeightDelegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *QueryWeightDelegationsByGroupResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGroupInfoRequest)(nil), "cosmos.group.v1.QueryGroupInfoRequest")
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "cosmos.group.v1.QueryGroupInfoResponse")
@@ -1407,91 +1636,104 @@ func init() {
 	proto.RegisterType((*QueryGroupsByMemberResponse)(nil), "cosmos.group.v1.QueryGroupsByMemberResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.group.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.group.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryProposalExecutionLogsRequest)(nil), "cosmos.group.v1.QueryProposalExecutionLogsRequest")
+	proto.RegisterType((*QueryProposalExecutionLogsResponse)(nil), "cosmos.group.v1.QueryProposalExecutionLogsResponse")
+	proto.RegisterType((*QueryWeightDelegationsByGroupRequest)(nil), "cosmos.group.v1.QueryWeightDelegationsByGroupRequest")
+	proto.RegisterType((*QueryWeightDelegationsByGroupResponse)(nil), "cosmos.group.v1.QueryWeightDelegationsByGroupResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0xc7, 0x33, 0x25, 0x69, 0x92, 0x97, 0xb6, 0x11, 0x43, 0x5a, 0x12, 0x37, 0xda, 0x04, 0x03,
-	0xf9, 0x1d, 0x3b, 0xbb, 0x49, 0xd3, 0x8a, 0x9f, 0xea, 0x4a, 0x10, 0x72, 0x28, 0x4a, 0x97, 0x88,
-	0x03, 0x97, 0xc8, 0x9b, 0x75, 0x8c, 0xc5, 0xae, 0x67, 0xbb, 0x76, 0x22, 0x56, 0xd1, 0x5e, 0x90,
-	0xca, 0x01, 0x71, 0x00, 0x8a, 0x50, 0x89, 0x38, 0xf4, 0x80, 0x04, 0x7f, 0x00, 0x08, 0x89, 0x5b,
-	0x6f, 0x3d, 0x56, 0x70, 0xe1, 0x84, 0x50, 0xc2, 0x1f, 0x82, 0x3c, 0xf3, 0xbc, 0xeb, 0xdf, 0xeb,
-	0x15, 0x2b, 0xc8, 0xa9, 0x5d, 0xfb, 0xbd, 0x79, 0x9f, 0xf9, 0xbe, 0xe7, 0xf1, 0xd7, 0x81, 0xeb,
-	0xfb, 0xcc, 0xae, 0x31, 0x5b, 0x35, 0x1a, 0xec, 0xb0, 0xae, 0x1e, 0xe5, 0xd5, 0x7b, 0x87, 0x7a,
-	0xa3, 0xa9, 0xd4, 0x1b, 0xcc, 0x61, 0x74, 0x5c, 0xdc, 0x54, 0xf8, 0x4d, 0xe5, 0x28, 0x2f, 0x4d,
-	0x18, 0xcc, 0x60, 0xfc, 0x9e, 0xea, 0xfe, 0x4f, 0x84, 0x49, 0xd3, 0x06, 0x63, 0x46, 0x55, 0x57,
-	0xb5, 0xba, 0xa9, 0x6a, 0x96, 0xc5, 0x1c, 0xcd, 0x31, 0x99, 0x65, 0xe3, 0xdd, 0x48, 0x05, 0xa7,
-	0x59, 0xd7, 0xbd, 0x9b, 0x4b, 0x78, 0xb3, 0xac, 0xd9, 0xba, 0x28, 0xad, 0x1e, 0xe5, 0xcb, 0xba,
-	0xa3, 0xe5, 0xd5, 0xba, 0x66, 0x98, 0x16, 0x5f, 0x09, 0x63, 0xa7, 0x44, 0xec, 0x9e, 0xa8, 0x8f,
-	0x68, 0xfc, 0x87, 0x5c, 0x80, 0xab, 0x77, 0xdd, 0xe4, 0x2d, 0xb7, 0xc6, 0xb6, 0x75, 0xc0, 0x4a,
-	0xfa, 0xbd, 0x43, 0xdd, 0x76, 0xe8, 0x14, 0x8c, 0xf0, 0xba, 0x7b, 0x66, 0x65, 0x92, 0xcc, 0x92,
-	0x85, 0xc1, 0xd2, 0x30, 0xff, 0xbd, 0x5d, 0x91, 0xdf, 0x81, 0x6b, 0xe1, 0x1c, 0xbb, 0xce, 0x2c,
-	0x5b, 0xa7, 0x0a, 0x0c, 0x9a, 0xd6, 0x01, 0xe3, 0x09, 0x63, 0x05, 0x49, 0x09, 0xa9, 0xa0, 0x74,
-	0x32, 0x78, 0x9c, 0x7c, 0x17, 0xae, 0x77, 0x56, 0xda, 0x61, 0x55, 0x73, 0xbf, 0xe9, 0x67, 0x28,
-	0xc0, 0xb0, 0x56, 0xa9, 0x34, 0x74, 0xdb, 0xe6, 0x2b, 0x8e, 0x16, 0x27, 0x7f, 0xfb, 0x69, 0x75,
-	0x02, 0x17, 0xbd, 0x2d, 0xee, 0xbc, 0xe7, 0x34, 0x4c, 0xcb, 0x28, 0x79, 0x81, 0xf2, 0x2e, 0x4c,
-	0xc7, 0x2f, 0x89, 0x88, 0x1b, 0x01, 0xc4, 0xd9, 0x78, 0x44, 0x5f, 0x9e, 0x00, 0x6d, 0xc1, 0x64,
-	0x67, 0xd5, 0x3b, 0x7a, 0xad, 0xac, 0x37, 0xec, 0xee, 0x4a, 0xd1, 0xb7, 0x01, 0x3a, 0xcd, 0x98,
-	0xbc, 0xc0, 0x4b, 0xce, 0x79, 0x25, 0xdd, 0xce, 0x29, 0x62, 0x68, 0xb0, 0x73, 0xca, 0x8e, 0x66,
-	0xe8, 0xb8, 0x6c, 0xc9, 0x97, 0x29, 0x7f, 0x47, 0x60, 0x2a, 0xa6, 0x3e, 0x6e, 0x69, 0x13, 0x86,
-	0x6b, 0xe2, 0xd2, 0x24, 0x99, 0x7d, 0x66, 0x61, 0xac, 0x30, 0x1d, 0xbf, 0x2b, 0x91, 0x57, 0xf2,
-	0x82, 0xe9, 0x56, 0x0c, 0xdd, 0x7c, 0x57, 0x3a, 0x51, 0x34, 0x80, 0xf7, 0x20, 0x80, 0x67, 0x17,
-	0x9b, 0xb7, 0x2b, 0x35, 0xd3, 0xf2, 0xf4, 0x51, 0x60, 0x48, 0x73, 0x7f, 0x77, 0xed, 0xa1, 0x08,
-	0xeb, 0x9b, 0x68, 0xdf, 0x12, 0x90, 0xe2, 0xa8, 0x50, 0xb5, 0x02, 0x5c, 0xe4, 0xf2, 0x78, 0xa2,
-	0xa5, 0x4d, 0x2b, 0x46, 0xf6, 0x4f, 0xb1, 0xfb, 0x04, 0x66, 0x43, 0x63, 0x6a, 0xea, 0x76, 0x51,
-	0xfc, 0xfc, 0x0f, 0x07, 0xeb, 0x67, 0x02, 0x2f, 0xa4, 0x70, 0xa0, 0x54, 0x5b, 0x70, 0x45, 0x80,
-	0xd4, 0x31, 0x00, 0x25, 0xeb, 0xfe, 0xf4, 0x5c, 0x36, 0xfc, 0xeb, 0xf6, 0x4f, 0xbf, 0x93, 0x04,
-	0xfd, 0xce, 0xc5, 0xe0, 0x25, 0x89, 0x1a, 0x9c, 0xbf, 0xf3, 0x27, 0xea, 0x4d, 0x98, 0xe0, 0xd8,
-	0x3b, 0x0d, 0x56, 0x67, 0xb6, 0x56, 0xf5, 0x74, 0x9c, 0x81, 0xb1, 0x3a, 0x5e, 0xea, 0x8c, 0x22,
-	0x78, 0x97, 0xb6, 0x2b, 0xf2, 0xbb, 0xf8, 0x12, 0xe9, 0x24, 0xe2, 0x1e, 0x6f, 0xc0, 0x88, 0x17,
-	0x86, 0x07, 0xee, 0x54, 0x64, 0x77, 0xed, 0xa4, 0x76, 0xa8, 0xfc, 0x88, 0x80, 0x1c, 0x58, 0xd0,
-	0x9b, 0x48, 0x21, 0xc2, 0xbf, 0x78, 0x3d, 0xf4, 0xad, 0xc7, 0x3f, 0x10, 0x78, 0x31, 0x15, 0x11,
-	0x15, 0xb8, 0x09, 0xa3, 0xde, 0xb6, 0xbc, 0x06, 0xa7, 0x48, 0xd0, 0x89, 0xed, 0x5f, 0x57, 0x1b,
-	0x30, 0xc3, 0x41, 0xdf, 0x67, 0x8e, 0x5e, 0x6c, 0xe3, 0xba, 0xbf, 0x1a, 0x59, 0x1b, 0xec, 0x3e,
-	0x49, 0x47, 0x6e, 0x02, 0xe7, 0x48, 0x7d, 0x92, 0x78, 0x98, 0x7c, 0x07, 0x9f, 0xce, 0xd8, 0x9a,
-	0xa8, 0xcc, 0x22, 0x0c, 0xba, 0xc1, 0x38, 0x17, 0x57, 0x23, 0xa2, 0xb8, 0xd1, 0x25, 0x1e, 0x22,
-	0x7f, 0x4a, 0xd0, 0x27, 0xb8, 0xd7, 0xec, 0x62, 0xcf, 0x03, 0xda, 0xb7, 0xae, 0x7f, 0x4d, 0xd0,
-	0x5d, 0x44, 0x40, 0x70, 0x53, 0xcb, 0x42, 0x28, 0xaf, 0xd5, 0x09, 0xbb, 0x12, 0x31, 0xfd, 0x6b,
-	0xf1, 0x57, 0x04, 0xed, 0x09, 0x62, 0x05, 0x9a, 0xdb, 0xee, 0x1d, 0xc9, 0xd4, 0xbb, 0xbe, 0x69,
-	0xf5, 0xa5, 0x67, 0x0a, 0x82, 0x50, 0xff, 0xab, 0x50, 0x0f, 0xc3, 0x96, 0x00, 0x2d, 0xd1, 0x39,
-	0x38, 0x50, 0x4e, 0x88, 0xdf, 0x0b, 0xfb, 0xd0, 0xce, 0x83, 0x5d, 0x79, 0x05, 0x9e, 0xe7, 0x6c,
-	0xbb, 0x5a, 0xb5, 0xea, 0x9e, 0x6d, 0x87, 0x55, 0x27, 0xf3, 0xcb, 0x61, 0x17, 0x67, 0x33, 0x90,
-	0x8b, 0x9b, 0xba, 0x05, 0x43, 0x8e, 0x7b, 0x19, 0x0f, 0x81, 0xa8, 0x6f, 0xf5, 0x25, 0x15, 0x07,
-	0x9f, 0xfc, 0x39, 0x33, 0x50, 0x12, 0x09, 0x85, 0xfb, 0xcf, 0xc2, 0x10, 0x5f, 0x96, 0x7e, 0x4e,
-	0x60, 0xb4, 0xbd, 0x75, 0x3a, 0x17, 0x59, 0x22, 0xf6, 0xf3, 0x46, 0x9a, 0xef, 0x1a, 0x27, 0x10,
-	0x65, 0xe5, 0x93, 0xdf, 0xff, 0x7e, 0x70, 0x61, 0x81, 0xce, 0xa9, 0xe1, 0xaf, 0x31, 0xf4, 0x66,
-	0xd6, 0x01, 0x53, 0x8f, 0x3d, 0x9f, 0xd6, 0xa2, 0xdf, 0x13, 0x18, 0x0f, 0xbd, 0xb0, 0xe9, 0x4a,
-	0x4a, 0xb1, 0xc8, 0x57, 0x8f, 0xb4, 0x9a, 0x31, 0x1a, 0x01, 0x37, 0x38, 0xa0, 0x42, 0x57, 0x12,
-	0x00, 0xb9, 0xbd, 0x68, 0x22, 0x27, 0x4e, 0x6d, 0x8b, 0x3e, 0x24, 0x70, 0xc9, 0xff, 0x31, 0x41,
-	0x17, 0x53, 0xaa, 0x06, 0x3f, 0x78, 0xa4, 0xa5, 0x2c, 0xa1, 0x48, 0x97, 0xe7, 0x74, 0xcb, 0x74,
-	0x31, 0x81, 0x0e, 0xbf, 0x45, 0xfc, 0x0a, 0x9e, 0x10, 0xb8, 0x1c, 0xb0, 0xec, 0x34, 0xad, 0x60,
-	0xc8, 0xf4, 0x49, 0xcb, 0x99, 0x62, 0x91, 0x6e, 0x8d, 0xd3, 0x2d, 0xd1, 0x85, 0x78, 0x3a, 0x7b,
-	0xaf, 0xdc, 0xdc, 0xe3, 0xde, 0xd0, 0x55, 0xae, 0x66, 0x5a, 0x2d, 0xfa, 0x2b, 0x81, 0x89, 0x38,
-	0xaf, 0x4c, 0xf3, 0xdd, 0xba, 0x16, 0xf1, 0xf7, 0x52, 0xa1, 0x97, 0x14, 0x24, 0x7e, 0x95, 0x13,
-	0xdf, 0xa0, 0xeb, 0x69, 0xdd, 0x36, 0x75, 0x4e, 0x2e, 0x6e, 0xf9, 0x94, 0xfd, 0x25, 0x0a, 0x2f,
-	0x04, 0xce, 0x06, 0x1f, 0xd0, 0xb9, 0xd0, 0x4b, 0x0a, 0xc2, 0xdf, 0xe2, 0xf0, 0x05, 0xba, 0x96,
-	0x01, 0x3e, 0x28, 0xfb, 0x67, 0x04, 0x46, 0xbc, 0x97, 0x2d, 0x7d, 0x39, 0xbe, 0x74, 0xc8, 0x15,
-	0x48, 0x73, 0xdd, 0xc2, 0x90, 0x4a, 0xe5, 0x54, 0x8b, 0x74, 0x3e, 0x42, 0xe5, 0x9d, 0x62, 0xea,
-	0xb1, 0xef, 0x88, 0x6b, 0xd1, 0xc7, 0x04, 0xae, 0xc5, 0xdb, 0x3e, 0xba, 0x9e, 0x5e, 0x33, 0xd6,
-	0xc7, 0x4a, 0x1b, 0xbd, 0x25, 0x21, 0xf6, 0x6b, 0x1c, 0x7b, 0x93, 0x6e, 0x24, 0x62, 0x77, 0x86,
-	0x00, 0x0f, 0x01, 0xdf, 0xf3, 0xff, 0x98, 0xc0, 0x73, 0x31, 0xee, 0x8c, 0xae, 0xc5, 0xb3, 0x24,
-	0x9b, 0x47, 0x29, 0xdf, 0x43, 0x06, 0xa2, 0xbf, 0xc5, 0xd1, 0xdf, 0xa4, 0xaf, 0x47, 0xd0, 0xdd,
-	0xf7, 0xbd, 0x4b, 0xdd, 0xd6, 0x9b, 0x7b, 0x92, 0xa0, 0xfe, 0xea, 0x31, 0xbf, 0xd8, 0xa2, 0x3f,
-	0x12, 0x18, 0x0f, 0x19, 0xb1, 0xa4, 0xa3, 0x36, 0xde, 0x38, 0x26, 0x1d, 0xb5, 0x09, 0xee, 0x2e,
-	0x65, 0x7e, 0xb9, 0x4f, 0xf1, 0x83, 0x87, 0x46, 0xe6, 0x1b, 0x02, 0x97, 0xfc, 0x3e, 0x28, 0xe9,
-	0xb8, 0x8d, 0x31, 0x70, 0x49, 0xc7, 0x6d, 0x9c, 0xad, 0x4a, 0x99, 0xe5, 0x36, 0x21, 0x2a, 0x8a,
-	0x1a, 0x3e, 0x22, 0x70, 0x25, 0xe8, 0x38, 0x68, 0x97, 0x13, 0x34, 0x60, 0x99, 0xa4, 0x95, 0x6c,
-	0xc1, 0x88, 0xb7, 0xce, 0xf1, 0x56, 0xe9, 0x72, 0xca, 0x79, 0x2b, 0xde, 0x08, 0xbe, 0x51, 0x3d,
-	0x21, 0x30, 0xe6, 0xf3, 0x01, 0x74, 0x21, 0xbe, 0x64, 0xd4, 0x9b, 0x48, 0x8b, 0x19, 0x22, 0x91,
-	0x6c, 0x93, 0x93, 0xad, 0x51, 0x25, 0xf9, 0x69, 0x0a, 0x4d, 0x21, 0xf7, 0x21, 0xc5, 0x37, 0x9e,
-	0x9c, 0xe6, 0xc8, 0xd3, 0xd3, 0x1c, 0xf9, 0xeb, 0x34, 0x47, 0xbe, 0x38, 0xcb, 0x0d, 0x3c, 0x3d,
-	0xcb, 0x0d, 0xfc, 0x71, 0x96, 0x1b, 0xf8, 0xe0, 0x25, 0xc3, 0x74, 0x3e, 0x3c, 0x2c, 0x2b, 0xfb,
-	0xac, 0xe6, 0xad, 0x29, 0xfe, 0x59, 0xb5, 0x2b, 0x1f, 0xa9, 0x1f, 0x8b, 0x02, 0xe5, 0x8b, 0xfc,
-	0xcf, 0xb0, 0xeb, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x11, 0xfe, 0x04, 0xa9, 0x4e, 0x16, 0x00,
-	0x00,
+	// 1400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcb, 0x6f, 0x13, 0xd7,
+	0x17, 0xc7, 0x73, 0xf9, 0x85, 0xd7, 0x09, 0x0f, 0xe9, 0xfe, 0x12, 0xea, 0x0c, 0x91, 0x09, 0x53,
+	0x08, 0x09, 0x21, 0x33, 0xb1, 0x13, 0x02, 0xf4, 0x85, 0x70, 0x4b, 0x29, 0x52, 0xa9, 0xc0, 0x8d,
+	0x5a, 0xa9, 0x1b, 0xcb, 0x8e, 0x27, 0xc3, 0xa8, 0xb6, 0xc7, 0x78, 0xc6, 0x29, 0x56, 0xe4, 0x4d,
+	0xa5, 0x56, 0x6a, 0xc5, 0xa2, 0x2d, 0x15, 0xa2, 0x51, 0x17, 0x2c, 0x2a, 0x95, 0x3f, 0xa0, 0x55,
+	0xa5, 0xee, 0xd8, 0xa1, 0xae, 0x50, 0xbb, 0xe9, 0xaa, 0xaa, 0x92, 0xfe, 0x21, 0xd5, 0xdc, 0x7b,
+	0xc6, 0x9e, 0xc7, 0x9d, 0x87, 0x55, 0x0b, 0xb2, 0x02, 0xcf, 0x3d, 0xe7, 0x9e, 0xcf, 0xfd, 0x9e,
+	0xfb, 0x38, 0x07, 0xe0, 0xf8, 0x9a, 0x69, 0xd5, 0x4d, 0x4b, 0xd5, 0x5b, 0x66, 0xbb, 0xa9, 0x6e,
+	0xe4, 0xd4, 0x3b, 0x6d, 0xad, 0xd5, 0x51, 0x9a, 0x2d, 0xd3, 0x36, 0xe9, 0x51, 0x3e, 0xa8, 0xb0,
+	0x41, 0x65, 0x23, 0x27, 0x8d, 0xeb, 0xa6, 0x6e, 0xb2, 0x31, 0xd5, 0xf9, 0x1b, 0x37, 0x93, 0xa6,
+	0x74, 0xd3, 0xd4, 0x6b, 0x9a, 0x5a, 0x6e, 0x1a, 0x6a, 0xb9, 0xd1, 0x30, 0xed, 0xb2, 0x6d, 0x98,
+	0x0d, 0x0b, 0x47, 0x43, 0x11, 0xec, 0x4e, 0x53, 0x73, 0x07, 0xcf, 0xe2, 0x60, 0xa5, 0x6c, 0x69,
+	0x3c, 0xb4, 0xba, 0x91, 0xab, 0x68, 0x76, 0x39, 0xa7, 0x36, 0xcb, 0xba, 0xd1, 0x60, 0x33, 0xa1,
+	0xed, 0x24, 0xb7, 0x2d, 0xf1, 0xf8, 0x88, 0xc6, 0x7e, 0xc8, 0x79, 0x98, 0xb8, 0xe5, 0x38, 0x5f,
+	0x73, 0x62, 0x5c, 0x6f, 0xac, 0x9b, 0x45, 0xed, 0x4e, 0x5b, 0xb3, 0x6c, 0x3a, 0x09, 0x07, 0x58,
+	0xdc, 0x92, 0x51, 0xcd, 0x90, 0x69, 0x32, 0x3b, 0x5a, 0xdc, 0xcf, 0x7e, 0x5f, 0xaf, 0xca, 0xef,
+	0xc0, 0xb1, 0xa0, 0x8f, 0xd5, 0x34, 0x1b, 0x96, 0x46, 0x15, 0x18, 0x35, 0x1a, 0xeb, 0x26, 0x73,
+	0x18, 0xcb, 0x4b, 0x4a, 0x40, 0x05, 0xa5, 0xef, 0xc1, 0xec, 0xe4, 0x5b, 0x70, 0xbc, 0x3f, 0xd3,
+	0x4d, 0xb3, 0x66, 0xac, 0x75, 0xbc, 0x0c, 0x79, 0xd8, 0x5f, 0xae, 0x56, 0x5b, 0x9a, 0x65, 0xb1,
+	0x19, 0x0f, 0x16, 0x32, 0xbf, 0xff, 0xb4, 0x30, 0x8e, 0x93, 0x5e, 0xe1, 0x23, 0xef, 0xdb, 0x2d,
+	0xa3, 0xa1, 0x17, 0x5d, 0x43, 0x79, 0x15, 0xa6, 0xc4, 0x53, 0x22, 0xe2, 0xb2, 0x0f, 0x71, 0x5a,
+	0x8c, 0xe8, 0xf1, 0xe3, 0xa0, 0x5d, 0xc8, 0xf4, 0x67, 0xbd, 0xa1, 0xd5, 0x2b, 0x5a, 0xcb, 0x4a,
+	0x56, 0x8a, 0xbe, 0x0d, 0xd0, 0x4f, 0x46, 0x66, 0x0f, 0x0b, 0x39, 0xe3, 0x86, 0x74, 0x32, 0xa7,
+	0xf0, 0x4d, 0x83, 0x99, 0x53, 0x6e, 0x96, 0x75, 0x0d, 0xa7, 0x2d, 0x7a, 0x3c, 0xe5, 0xef, 0x09,
+	0x4c, 0x0a, 0xe2, 0xe3, 0x92, 0x56, 0x60, 0x7f, 0x9d, 0x7f, 0xca, 0x90, 0xe9, 0xff, 0xcd, 0x8e,
+	0xe5, 0xa7, 0xc4, 0xab, 0xe2, 0x7e, 0x45, 0xd7, 0x98, 0x5e, 0x13, 0xd0, 0x9d, 0x49, 0xa4, 0xe3,
+	0x41, 0x7d, 0x78, 0xf7, 0x7d, 0x78, 0x56, 0xa1, 0x73, 0xa5, 0x5a, 0x37, 0x1a, 0xae, 0x3e, 0x0a,
+	0xec, 0x2d, 0x3b, 0xbf, 0x13, 0x73, 0xc8, 0xcd, 0x86, 0x26, 0xda, 0x77, 0x04, 0x24, 0x11, 0x15,
+	0xaa, 0x96, 0x87, 0x7d, 0x4c, 0x1e, 0x57, 0xb4, 0xb8, 0xdd, 0x8a, 0x96, 0xc3, 0x53, 0xec, 0x33,
+	0x02, 0xd3, 0x81, 0x6d, 0x6a, 0x68, 0x56, 0x81, 0xff, 0x7c, 0x8e, 0x1b, 0xeb, 0x67, 0x02, 0x27,
+	0x63, 0x38, 0x50, 0xaa, 0x6b, 0x70, 0x84, 0x83, 0x34, 0xd1, 0x00, 0x25, 0x4b, 0x3e, 0x3d, 0x87,
+	0x75, 0xef, 0xbc, 0xc3, 0xd3, 0x6f, 0x2b, 0x42, 0xbf, 0x5d, 0xb1, 0xf1, 0xa2, 0x44, 0xf5, 0xef,
+	0xbf, 0xdd, 0x27, 0xea, 0x05, 0x18, 0x67, 0xd8, 0x37, 0x5b, 0x66, 0xd3, 0xb4, 0xca, 0x35, 0x57,
+	0xc7, 0x13, 0x30, 0xd6, 0xc4, 0x4f, 0xfd, 0xad, 0x08, 0xee, 0xa7, 0xeb, 0x55, 0xf9, 0x3d, 0x98,
+	0x08, 0x38, 0xe2, 0x1a, 0xcf, 0xc3, 0x01, 0xd7, 0x0c, 0x2f, 0xdc, 0xc9, 0xd0, 0xea, 0x7a, 0x4e,
+	0x3d, 0x53, 0xf9, 0x11, 0x01, 0xd9, 0x37, 0xa1, 0xbb, 0x23, 0xb9, 0x08, 0xff, 0xe1, 0x79, 0x18,
+	0x5a, 0x8e, 0x7f, 0x24, 0xf0, 0x72, 0x2c, 0x22, 0x2a, 0x70, 0x01, 0x0e, 0xba, 0xcb, 0x72, 0x13,
+	0x1c, 0x23, 0x41, 0xdf, 0x76, 0x78, 0x59, 0x6d, 0xc1, 0x09, 0x06, 0xfa, 0x81, 0x69, 0x6b, 0x85,
+	0x1e, 0xae, 0xf3, 0xab, 0x95, 0x36, 0xc1, 0xce, 0x49, 0xda, 0x70, 0x1c, 0x32, 0x7b, 0x12, 0x74,
+	0xe6, 0x66, 0xf2, 0x0d, 0x3c, 0x9d, 0xc2, 0x98, 0xa8, 0xcc, 0x1c, 0x8c, 0x3a, 0xc6, 0xb8, 0x2f,
+	0x26, 0x42, 0xa2, 0x38, 0xd6, 0x45, 0x66, 0x22, 0x7f, 0x4e, 0xb0, 0x4e, 0x70, 0xbe, 0x59, 0x85,
+	0x81, 0x37, 0xe8, 0xd0, 0xb2, 0xfe, 0x2d, 0x81, 0x29, 0x31, 0x08, 0x2e, 0x6a, 0x9e, 0x0b, 0xe5,
+	0xa6, 0x3a, 0x62, 0x55, 0xdc, 0x66, 0x78, 0x29, 0xfe, 0x86, 0x60, 0x79, 0x82, 0x58, 0xbe, 0xe4,
+	0xf6, 0x72, 0x47, 0x52, 0xe5, 0x6e, 0x68, 0x5a, 0x7d, 0xed, 0x16, 0x05, 0x7e, 0xa8, 0x17, 0x2a,
+	0xd4, 0xc3, 0x60, 0x49, 0x80, 0x25, 0xd1, 0x2e, 0xb8, 0x50, 0xb6, 0x08, 0x1c, 0x17, 0xa2, 0xed,
+	0x86, 0x72, 0xe5, 0x15, 0x78, 0x89, 0xb1, 0xad, 0x96, 0x6b, 0x35, 0xe7, 0x6e, 0x6b, 0xd7, 0xec,
+	0xd4, 0x8f, 0xc3, 0x2a, 0x64, 0xc2, 0xbe, 0xb8, 0xa8, 0x8b, 0xb0, 0xd7, 0x76, 0x3e, 0xe3, 0x25,
+	0x10, 0xae, 0x5b, 0x3d, 0x4e, 0x85, 0xd1, 0xa7, 0x7f, 0x9d, 0x18, 0x29, 0x72, 0x07, 0xf9, 0x9e,
+	0xfb, 0xc6, 0xba, 0x47, 0xf0, 0xea, 0x5d, 0x6d, 0xad, 0xed, 0xc0, 0xbe, 0x6b, 0xea, 0xd6, 0x73,
+	0xbf, 0x18, 0x1e, 0x07, 0x5f, 0xac, 0x00, 0x0e, 0xae, 0xf7, 0x12, 0x8c, 0xd6, 0x4c, 0xdd, 0x4d,
+	0xe1, 0xe9, 0xc8, 0x87, 0xc0, 0xeb, 0x5d, 0x64, 0x2e, 0xc3, 0xcb, 0xe5, 0x17, 0x04, 0x4e, 0x31,
+	0xd4, 0x0f, 0x35, 0x43, 0xbf, 0x6d, 0xbf, 0xa5, 0xd5, 0x34, 0x9d, 0x8d, 0xbc, 0x98, 0xf2, 0xf3,
+	0x74, 0x02, 0x0b, 0x2a, 0xf7, 0x26, 0x8c, 0x55, 0xfb, 0xa3, 0x28, 0xe0, 0xc9, 0x90, 0x80, 0xc1,
+	0x79, 0x8a, 0x5e, 0xaf, 0xa1, 0x69, 0x98, 0x7f, 0x30, 0x0e, 0x7b, 0x19, 0x37, 0xbd, 0x47, 0xe0,
+	0x60, 0xef, 0xe0, 0xd1, 0x99, 0x10, 0x90, 0xb0, 0xb9, 0x96, 0xce, 0x24, 0xda, 0xf1, 0xa0, 0xb2,
+	0xf2, 0xe9, 0x1f, 0xff, 0xdc, 0xdf, 0x33, 0x4b, 0x67, 0xd4, 0xe0, 0xbf, 0x05, 0x60, 0x6a, 0x1a,
+	0xeb, 0xa6, 0xba, 0xe9, 0xa6, 0xa9, 0x4b, 0x7f, 0x20, 0x70, 0x34, 0x50, 0x2e, 0xd2, 0x73, 0x31,
+	0xc1, 0x42, 0x3d, 0xb7, 0xb4, 0x90, 0xd2, 0x1a, 0x01, 0x97, 0x19, 0xa0, 0x42, 0xcf, 0x45, 0x00,
+	0xb2, 0xe2, 0xb6, 0x83, 0x9c, 0x78, 0x67, 0x76, 0xe9, 0x43, 0x02, 0x87, 0xbc, 0xad, 0x2c, 0x9d,
+	0x8b, 0x89, 0xea, 0x6f, 0xb7, 0xa5, 0xb3, 0x69, 0x4c, 0x91, 0x2e, 0xc7, 0xe8, 0xe6, 0xe9, 0x5c,
+	0x04, 0x1d, 0x76, 0xc2, 0x5e, 0x05, 0xb7, 0x08, 0x1c, 0xf6, 0x35, 0x8c, 0x34, 0x2e, 0x60, 0xa0,
+	0xe5, 0x90, 0xe6, 0x53, 0xd9, 0x22, 0xdd, 0x22, 0xa3, 0x3b, 0x4b, 0x67, 0xc5, 0x74, 0x56, 0xa9,
+	0xd2, 0x29, 0xb1, 0xce, 0xc4, 0x51, 0xae, 0x6e, 0x34, 0xba, 0xf4, 0x57, 0x02, 0xe3, 0xa2, 0x4e,
+	0x8d, 0xe6, 0x92, 0xb2, 0x16, 0xea, 0x2e, 0xa5, 0xfc, 0x20, 0x2e, 0x48, 0xfc, 0x2a, 0x23, 0x3e,
+	0x4f, 0x97, 0xe2, 0xb2, 0x6d, 0x68, 0x8c, 0x9c, 0x0f, 0x79, 0x94, 0xfd, 0x25, 0x0c, 0xcf, 0x05,
+	0x4e, 0x07, 0xef, 0xd3, 0x39, 0x3f, 0x88, 0x0b, 0xc2, 0x5f, 0x64, 0xf0, 0x79, 0xba, 0x98, 0x02,
+	0xde, 0x2f, 0xfb, 0x97, 0x04, 0x0e, 0xb8, 0x57, 0x33, 0x3d, 0x2d, 0x0e, 0x1d, 0xa8, 0x49, 0xa5,
+	0x99, 0x24, 0x33, 0xa4, 0x52, 0x19, 0xd5, 0x1c, 0x3d, 0x13, 0xa2, 0x72, 0x9f, 0x29, 0x75, 0xd3,
+	0xf3, 0x86, 0x75, 0xe9, 0x13, 0x02, 0xc7, 0xc4, 0x4d, 0x07, 0x5d, 0x8a, 0x8f, 0x29, 0xec, 0xa2,
+	0xa4, 0xe5, 0xc1, 0x9c, 0x10, 0xfb, 0x35, 0x86, 0xbd, 0x42, 0x97, 0x23, 0xb1, 0xfb, 0x9b, 0x00,
+	0x2f, 0x01, 0xcf, 0xf9, 0x7f, 0x42, 0xe0, 0xff, 0x82, 0xde, 0x80, 0x2e, 0x8a, 0x59, 0xa2, 0x5b,
+	0x17, 0x29, 0x37, 0x80, 0x07, 0xa2, 0x5f, 0x65, 0xe8, 0x97, 0xe9, 0xeb, 0x21, 0x74, 0xa7, 0xda,
+	0x74, 0xa8, 0x7b, 0x7a, 0x3b, 0x1f, 0x5a, 0x7e, 0xfd, 0xd5, 0x4d, 0xf6, 0xb1, 0x4b, 0x1f, 0x13,
+	0x38, 0x1a, 0x68, 0x03, 0xa2, 0xae, 0x5a, 0x71, 0xdb, 0x22, 0x2d, 0xa4, 0xb4, 0x4e, 0xdc, 0xbf,
+	0x0e, 0x91, 0xe5, 0x05, 0x0f, 0x6c, 0x99, 0x07, 0x04, 0x0e, 0x79, 0xab, 0xf0, 0xa8, 0xeb, 0x56,
+	0xd0, 0x3e, 0x44, 0x5d, 0xb7, 0xa2, 0xa2, 0x3e, 0x66, 0x2f, 0xf7, 0x08, 0x51, 0x51, 0xd4, 0xf0,
+	0x11, 0x81, 0x23, 0xfe, 0x7a, 0x97, 0x26, 0xdc, 0xa0, 0xbe, 0x82, 0x5d, 0x3a, 0x97, 0xce, 0x18,
+	0xf1, 0x96, 0x18, 0xde, 0x02, 0x9d, 0x8f, 0xb9, 0x6f, 0xf9, 0x8b, 0xe0, 0xd9, 0xaa, 0x5b, 0x04,
+	0xc6, 0x3c, 0x55, 0x28, 0x9d, 0x15, 0x87, 0x0c, 0x57, 0xc6, 0xd2, 0x5c, 0x0a, 0x4b, 0x24, 0x5b,
+	0x61, 0x64, 0x8b, 0x54, 0x89, 0x3e, 0x4d, 0x81, 0x5d, 0xc8, 0xaa, 0x60, 0xe7, 0x1c, 0x4d, 0x08,
+	0x2b, 0x4e, 0x9a, 0x8f, 0x3f, 0xd5, 0xa2, 0x6a, 0x59, 0x5a, 0x1a, 0xc8, 0x07, 0xd1, 0x2f, 0x33,
+	0xf4, 0x4b, 0xf4, 0x42, 0x6a, 0x74, 0xcd, 0x9d, 0xa7, 0xc4, 0x0a, 0xdb, 0xdf, 0x08, 0x64, 0xa2,
+	0xca, 0x3f, 0x7a, 0x5e, 0x8c, 0x94, 0x50, 0xba, 0x4a, 0x2b, 0x83, 0xba, 0x25, 0x2e, 0xe6, 0x13,
+	0xe6, 0x5a, 0xf2, 0x54, 0x93, 0xa2, 0x37, 0xae, 0xf0, 0xc6, 0xd3, 0xed, 0x2c, 0x79, 0xb6, 0x9d,
+	0x25, 0x7f, 0x6f, 0x67, 0xc9, 0x57, 0x3b, 0xd9, 0x91, 0x67, 0x3b, 0xd9, 0x91, 0x3f, 0x77, 0xb2,
+	0x23, 0x1f, 0x9d, 0xd2, 0x0d, 0xfb, 0x76, 0xbb, 0xa2, 0xac, 0x99, 0x75, 0x77, 0x72, 0xfe, 0xc7,
+	0x82, 0x55, 0xfd, 0x58, 0xbd, 0xcb, 0x23, 0x55, 0xf6, 0xb1, 0xff, 0x95, 0x59, 0xfa, 0x77, 0x00,
+	0xbd, 0xd0, 0xb9, 0x6a, 0x5d, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1536,6 +1778,15 @@ type QueryClient interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ProposalExecutionLogs queries the execution attempts of a proposal, which
+	// include the error of each failed attempt.
+	//
+	// Since: cosmos-sdk 0.47
+	ProposalExecutionLogs(ctx context.Context, in *QueryProposalExecutionLogsRequest, opts ...grpc.CallOption) (*QueryProposalExecutionLogsResponse, error)
+	// WeightDelegationsByGroup queries the voting weight delegations of a group.
+	//
+	// Since: cosmos-sdk 0.47
+	WeightDelegationsByGroup(ctx context.Context, in *QueryWeightDelegationsByGroupRequest, opts ...grpc.CallOption) (*QueryWeightDelegationsByGroupResponse, error)
 }
 
 type queryClient struct {
@@ -1663,6 +1914,24 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ProposalExecutionLogs(ctx context.Context, in *QueryProposalExecutionLogsRequest, opts ...grpc.CallOption) (*QueryProposalExecutionLogsResponse, error) {
+	out := new(QueryProposalExecutionLogsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/ProposalExecutionLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WeightDelegationsByGroup(ctx context.Context, in *QueryWeightDelegationsByGroupRequest, opts ...grpc.CallOption) (*QueryWeightDelegationsByGroupResponse, error) {
+	out := new(QueryWeightDelegationsByGroupResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/WeightDelegationsByGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1695,6 +1964,15 @@ type QueryServer interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ProposalExecutionLogs queries the execution attempts of a proposal, which
+	// include the error of each failed attempt.
+	//
+	// Since: cosmos-sdk 0.47
+	ProposalExecutionLogs(context.Context, *QueryProposalExecutionLogsRequest) (*QueryProposalExecutionLogsResponse, error)
+	// WeightDelegationsByGroup queries the voting weight delegations of a group.
+	//
+	// Since: cosmos-sdk 0.47
+	WeightDelegationsByGroup(context.Context, *QueryWeightDelegationsByGroupRequest) (*QueryWeightDelegationsByGroupResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1740,6 +2018,12 @@ func (*UnimplementedQueryServer) GroupsByMember(ctx context.Context, req *QueryG
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) ProposalExecutionLogs(ctx context.Context, req *QueryProposalExecutionLogsRequest) (*QueryProposalExecutionLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalExecutionLogs not implemented")
+}
+func (*UnimplementedQueryServer) WeightDelegationsByGroup(ctx context.Context, req *QueryWeightDelegationsByGroupRequest) (*QueryWeightDelegationsByGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WeightDelegationsByGroup not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1979,6 +2263,42 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalExecutionLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalExecutionLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalExecutionLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/ProposalExecutionLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalExecutionLogs(ctx, req.(*QueryProposalExecutionLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WeightDelegationsByGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWeightDelegationsByGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WeightDelegationsByGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/WeightDelegationsByGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WeightDelegationsByGroup(ctx, req.(*QueryWeightDelegationsByGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2035,6 +2355,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ProposalExecutionLogs",
+			Handler:    _Query_ProposalExecutionLogs_Handler,
+		},
+		{
+			MethodName: "WeightDelegationsByGroup",
+			Handler:    _Query_WeightDelegationsByGroup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -3084,53 +3412,231 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryGroupInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovQuery(uint64(m.GroupId))
+func (m *QueryProposalExecutionLogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGroupInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryProposalExecutionLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupPolicyInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProposalExecutionLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalExecutionLogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalExecutionLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalExecutionLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWeightDelegationsByGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWeightDelegationsByGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWeightDelegationsByGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWeightDelegationsByGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWeightDelegationsByGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWeightDelegationsByGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGroupInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *QueryGroupInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupPolicyInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryGroupPolicyInfoResponse) Size() (n int) {
@@ -3508,6 +4014,76 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProposalExecutionLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalExecutionLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWeightDelegationsByGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWeightDelegationsByGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6196,6 +6772,456 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProposalExecutionLogsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalExecutionLogsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalExecutionLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalExecutionLogsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalExecutionLogsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalExecutionLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &ProposalExecutionLog{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWeightDelegationsByGroupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWeightDelegationsByGroupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWeightDelegationsByGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWeightDelegationsByGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWeightDelegationsByGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWeightDelegationsByGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, &WeightDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
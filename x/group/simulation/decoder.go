@@ -50,6 +50,20 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &voteB)
 
 			return fmt.Sprintf("%v\n%v", voteA, voteB)
+		case bytes.Equal(kvA.Key[:1], []byte{keeper.ExecutionLogTablePrefix}):
+			var logA, logB group.ProposalExecutionLog
+
+			cdc.MustUnmarshal(kvA.Value, &logA)
+			cdc.MustUnmarshal(kvB.Value, &logB)
+
+			return fmt.Sprintf("%v\n%v", logA, logB)
+		case bytes.Equal(kvA.Key[:1], []byte{keeper.WeightDelegationTablePrefix}):
+			var delegationA, delegationB group.WeightDelegation
+
+			cdc.MustUnmarshal(kvA.Value, &delegationA)
+			cdc.MustUnmarshal(kvB.Value, &delegationB)
+
+			return fmt.Sprintf("%v\n%v", delegationA, delegationB)
 		default:
 			panic(fmt.Sprintf("invalid group key %X", kvA.Key))
 		}
@@ -1097,6 +1097,9 @@ func SimulateMsgExec(ak group.AccountKeeper,
 			if strings.Contains(err.Error(), "group was modified") || strings.Contains(err.Error(), "group policy was modified") {
 				return simtypes.NoOpMsg(group.ModuleName, msg.Type(), "no-op:group/group-policy was modified"), nil, nil
 			}
+			if strings.Contains(err.Error(), "proposal execution expired") {
+				return simtypes.NoOpMsg(group.ModuleName, msg.Type(), "no-op:proposal execution expired"), nil, nil
+			}
 			return simtypes.NoOpMsg(group.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
 		}
 
@@ -12,18 +12,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1460,6 +1464,216 @@ func (m *MsgLeaveGroupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLeaveGroupResponse proto.InternalMessageInfo
 
+// MsgDelegateWeight is the Msg/DelegateWeight request type.
+//
+// Since: cosmos-sdk 0.47
+type MsgDelegateWeight struct {
+	// delegator is the account address of the group member delegating its weight.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// delegatee is the account address of the group member receiving the weight.
+	Delegatee string `protobuf:"bytes,3,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	// expiration is the time after which the delegation is no longer effective.
+	Expiration time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *MsgDelegateWeight) Reset()         { *m = MsgDelegateWeight{} }
+func (m *MsgDelegateWeight) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateWeight) ProtoMessage()    {}
+func (*MsgDelegateWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{28}
+}
+func (m *MsgDelegateWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateWeight.Merge(m, src)
+}
+func (m *MsgDelegateWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateWeight proto.InternalMessageInfo
+
+func (m *MsgDelegateWeight) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *MsgDelegateWeight) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *MsgDelegateWeight) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+func (m *MsgDelegateWeight) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+// MsgDelegateWeightResponse is the Msg/DelegateWeight response type.
+//
+// Since: cosmos-sdk 0.47
+type MsgDelegateWeightResponse struct {
+}
+
+func (m *MsgDelegateWeightResponse) Reset()         { *m = MsgDelegateWeightResponse{} }
+func (m *MsgDelegateWeightResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateWeightResponse) ProtoMessage()    {}
+func (*MsgDelegateWeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{29}
+}
+func (m *MsgDelegateWeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateWeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateWeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateWeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateWeightResponse.Merge(m, src)
+}
+func (m *MsgDelegateWeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateWeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateWeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateWeightResponse proto.InternalMessageInfo
+
+// MsgUndelegateWeight is the Msg/UndelegateWeight request type.
+//
+// Since: cosmos-sdk 0.47
+type MsgUndelegateWeight struct {
+	// delegator is the account address of the group member revoking its delegation.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (m *MsgUndelegateWeight) Reset()         { *m = MsgUndelegateWeight{} }
+func (m *MsgUndelegateWeight) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateWeight) ProtoMessage()    {}
+func (*MsgUndelegateWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{30}
+}
+func (m *MsgUndelegateWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateWeight.Merge(m, src)
+}
+func (m *MsgUndelegateWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateWeight proto.InternalMessageInfo
+
+func (m *MsgUndelegateWeight) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *MsgUndelegateWeight) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+// MsgUndelegateWeightResponse is the Msg/UndelegateWeight response type.
+//
+// Since: cosmos-sdk 0.47
+type MsgUndelegateWeightResponse struct {
+}
+
+func (m *MsgUndelegateWeightResponse) Reset()         { *m = MsgUndelegateWeightResponse{} }
+func (m *MsgUndelegateWeightResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateWeightResponse) ProtoMessage()    {}
+func (*MsgUndelegateWeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{31}
+}
+func (m *MsgUndelegateWeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateWeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateWeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateWeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateWeightResponse.Merge(m, src)
+}
+func (m *MsgUndelegateWeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateWeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateWeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateWeightResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.Exec", Exec_name, Exec_value)
 	proto.RegisterType((*MsgCreateGroup)(nil), "cosmos.group.v1.MsgCreateGroup")
@@ -1490,92 +1704,104 @@ func init() {
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1.MsgExecResponse")
 	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1.MsgLeaveGroup")
 	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "cosmos.group.v1.MsgLeaveGroupResponse")
+	proto.RegisterType((*MsgDelegateWeight)(nil), "cosmos.group.v1.MsgDelegateWeight")
+	proto.RegisterType((*MsgDelegateWeightResponse)(nil), "cosmos.group.v1.MsgDelegateWeightResponse")
+	proto.RegisterType((*MsgUndelegateWeight)(nil), "cosmos.group.v1.MsgUndelegateWeight")
+	proto.RegisterType((*MsgUndelegateWeightResponse)(nil), "cosmos.group.v1.MsgUndelegateWeightResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1271 bytes of a gzipped FileDescriptorProto
+	// 1398 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xda, 0x6e, 0xe2, 0x3c, 0xb7, 0x4e, 0xb2, 0x71, 0x1a, 0x67, 0xd3, 0xda, 0x66, 0x49,
-	0x93, 0xd4, 0x6a, 0xd6, 0xc4, 0xa1, 0x42, 0x0a, 0x08, 0x94, 0x3f, 0x06, 0x05, 0x61, 0x88, 0x36,
-	0x2d, 0x05, 0x2e, 0x66, 0xe3, 0xdd, 0x6e, 0x57, 0xd8, 0x1e, 0x6b, 0x67, 0x9d, 0x38, 0x47, 0x10,
-	0x07, 0xfe, 0x5c, 0x90, 0xfa, 0x05, 0x40, 0x7c, 0x01, 0x0e, 0xf9, 0x04, 0x70, 0xa9, 0x38, 0x55,
-	0x9c, 0x10, 0x07, 0x84, 0x92, 0x03, 0x57, 0x3e, 0x02, 0xda, 0x99, 0xdd, 0xb1, 0xd7, 0x5e, 0x67,
-	0x37, 0x96, 0x0b, 0xa7, 0xd6, 0xfb, 0x7e, 0xef, 0xbd, 0xdf, 0xef, 0xcd, 0x9b, 0x79, 0x33, 0x81,
-	0x4c, 0x0d, 0xe1, 0x06, 0xc2, 0x45, 0xdd, 0x44, 0xed, 0x56, 0xf1, 0x78, 0xa3, 0x68, 0x75, 0xa4,
-	0x96, 0x89, 0x2c, 0xc4, 0x4f, 0x53, 0x8b, 0x44, 0x2c, 0xd2, 0xf1, 0x86, 0x90, 0xd6, 0x91, 0x8e,
-	0x88, 0xad, 0x68, 0xff, 0x8f, 0xc2, 0x84, 0x45, 0x0a, 0xab, 0x52, 0x83, 0xe3, 0xe3, 0x98, 0x74,
-	0x84, 0xf4, 0xba, 0x56, 0x24, 0xbf, 0x8e, 0xda, 0x8f, 0x8b, 0x4a, 0xf3, 0xd4, 0x31, 0x2d, 0x0d,
-	0xa4, 0x3d, 0x6d, 0x69, 0xae, 0xdf, 0x82, 0x63, 0x6c, 0x60, 0xdd, 0x36, 0x35, 0xb0, 0x4e, 0x0d,
-	0xe2, 0x0f, 0x1c, 0xa4, 0x2a, 0x58, 0xdf, 0x35, 0x35, 0xc5, 0xd2, 0xde, 0xb1, 0x5d, 0x79, 0x09,
-	0xae, 0x29, 0x6a, 0xc3, 0x68, 0x66, 0xb8, 0x3c, 0xb7, 0x36, 0xb5, 0x93, 0xf9, 0xed, 0x6c, 0x3d,
-	0xed, 0x90, 0xd8, 0x56, 0x55, 0x53, 0xc3, 0xf8, 0xd0, 0x32, 0x8d, 0xa6, 0x2e, 0x53, 0x18, 0xff,
-	0x1a, 0x4c, 0x36, 0xb4, 0xc6, 0x91, 0x66, 0xe2, 0x4c, 0x34, 0x1f, 0x5b, 0x4b, 0x96, 0x16, 0xa4,
-	0x3e, 0x9d, 0x52, 0x85, 0xd8, 0x77, 0xe2, 0xcf, 0xfe, 0xcc, 0x45, 0x64, 0x17, 0xcd, 0x0b, 0x90,
-	0x68, 0x68, 0x96, 0xa2, 0x2a, 0x96, 0x92, 0x89, 0xd9, 0xb9, 0x64, 0xf6, 0x7b, 0x0b, 0xbe, 0xf8,
-	0xfb, 0xa7, 0x02, 0x4d, 0x20, 0x6e, 0xc2, 0x4d, 0x2f, 0x45, 0x59, 0xc3, 0x2d, 0xd4, 0xc4, 0x1a,
-	0xbf, 0x08, 0x09, 0x92, 0xa3, 0x6a, 0xa8, 0x84, 0x6d, 0x5c, 0x9e, 0x24, 0xbf, 0xf7, 0x55, 0xf1,
-	0x8c, 0x83, 0xf9, 0x0a, 0xd6, 0x1f, 0xb6, 0x54, 0xd7, 0xab, 0xe2, 0xa4, 0xbd, 0xaa, 0xbe, 0xde,
-	0x24, 0x51, 0x4f, 0x12, 0x7e, 0x0f, 0x52, 0x54, 0x4c, 0xb5, 0x4d, 0xf2, 0xe0, 0x4c, 0x2c, 0x4c,
-	0x05, 0x6e, 0x50, 0x27, 0xca, 0x0d, 0x7b, 0xb4, 0xe6, 0xe0, 0xb6, 0x2f, 0x6b, 0x57, 0xb2, 0xf8,
-	0x23, 0x07, 0x73, 0x5e, 0xc4, 0x36, 0x61, 0x39, 0x46, 0x55, 0xf7, 0x61, 0xaa, 0xa9, 0x9d, 0x54,
-	0x69, 0xb8, 0x58, 0x40, 0xb8, 0x44, 0x53, 0x3b, 0x21, 0x0c, 0x3c, 0x32, 0x6e, 0xc3, 0x92, 0x0f,
-	0x49, 0x26, 0xe2, 0x5b, 0x8e, 0x2c, 0xa9, 0x47, 0x26, 0x5d, 0xf8, 0x71, 0xea, 0x08, 0xdb, 0x5f,
-	0x79, 0xc8, 0xfa, 0x93, 0x61, 0x7c, 0xff, 0xe1, 0x20, 0xed, 0x6d, 0xc1, 0x03, 0x54, 0x37, 0x6a,
-	0xa7, 0xff, 0x11, 0x5b, 0x5e, 0x81, 0x69, 0x55, 0xab, 0x19, 0xd8, 0x40, 0xcd, 0x6a, 0x8b, 0x64,
-	0xce, 0xc4, 0xf3, 0xdc, 0x5a, 0xb2, 0x94, 0x96, 0xe8, 0x81, 0x20, 0xb9, 0x07, 0x82, 0xb4, 0xdd,
-	0x3c, 0xdd, 0x11, 0x7f, 0x3d, 0x5b, 0xcf, 0xf6, 0x77, 0xe0, 0x9e, 0x13, 0x80, 0x32, 0x97, 0x53,
-	0xaa, 0xe7, 0xf7, 0x56, 0xea, 0xab, 0xef, 0x73, 0x91, 0x9e, 0xa2, 0xc8, 0x70, 0xcb, 0x4f, 0x31,
-	0xdb, 0x7a, 0x25, 0x98, 0x54, 0xa8, 0xc2, 0x40, 0xed, 0x2e, 0x50, 0xfc, 0x83, 0x83, 0x45, 0x6f,
-	0xa5, 0x69, 0xd0, 0xd1, 0x3a, 0xf8, 0x5d, 0x48, 0xd3, 0x5a, 0xd2, 0x8a, 0x54, 0x5d, 0x3a, 0xd1,
-	0x00, 0x77, 0x5e, 0xef, 0xcd, 0x4c, 0x2c, 0xe3, 0x68, 0xf9, 0x2f, 0x63, 0x90, 0xf1, 0x56, 0xec,
-	0x91, 0x61, 0x3d, 0x19, 0xb1, 0x4f, 0x46, 0x3e, 0x53, 0xef, 0x40, 0x8a, 0x16, 0xa5, 0xaf, 0x97,
-	0x6e, 0xe8, 0x9e, 0x5d, 0x56, 0x82, 0x79, 0x4f, 0xed, 0x18, 0x3a, 0x4e, 0xd0, 0x73, 0x3d, 0x25,
-	0x62, 0x3e, 0x1b, 0x7d, 0x3e, 0x0a, 0x76, 0xea, 0x75, 0x2d, 0xcf, 0xad, 0x25, 0xbc, 0x65, 0xc5,
-	0x74, 0x49, 0x7d, 0xfa, 0x76, 0xe2, 0x05, 0xf7, 0xed, 0xd7, 0x1c, 0xe4, 0x87, 0x2d, 0x43, 0x88,
-	0xb9, 0x31, 0xce, 0xae, 0x12, 0x5f, 0x86, 0x97, 0x86, 0xb6, 0x3b, 0x3b, 0x5b, 0x9e, 0x46, 0x41,
-	0xf4, 0x43, 0x79, 0x75, 0xff, 0xaf, 0xbb, 0xc3, 0x67, 0x19, 0x63, 0x2f, 0x78, 0x19, 0xef, 0x41,
-	0x21, 0xb8, 0x28, 0xac, 0x86, 0x3f, 0x73, 0xe4, 0xb4, 0x1a, 0x80, 0x8f, 0x3c, 0x55, 0xc6, 0x59,
-	0xbd, 0xb0, 0x63, 0x68, 0x05, 0x96, 0x2f, 0xd3, 0xc0, 0xc4, 0x7e, 0x13, 0x85, 0xd9, 0x0a, 0xd6,
-	0x0f, 0xdb, 0x47, 0x0d, 0xc3, 0x3a, 0x30, 0x51, 0x0b, 0x61, 0xa5, 0x3e, 0x94, 0x31, 0x37, 0x02,
-	0xe3, 0x5b, 0x30, 0xd5, 0x22, 0x71, 0xdd, 0xf3, 0x67, 0x4a, 0xee, 0x7e, 0xb8, 0x74, 0x50, 0xbd,
-	0x62, 0xdb, 0x30, 0x56, 0x74, 0x0d, 0x67, 0xe2, 0xe4, 0xe0, 0xf2, 0x6d, 0x11, 0x99, 0xa1, 0xf8,
-	0xbb, 0x10, 0xd7, 0x3a, 0x5a, 0x8d, 0x1c, 0x22, 0xa9, 0xd2, 0xfc, 0xc0, 0x31, 0x57, 0xee, 0x68,
-	0x35, 0x99, 0x40, 0xb6, 0x78, 0xb7, 0x47, 0xba, 0x64, 0xc4, 0x37, 0xc8, 0x44, 0xf1, 0xd6, 0x82,
-	0x6d, 0xf3, 0x1c, 0x24, 0x5b, 0xce, 0xb7, 0xee, 0x4e, 0x07, 0xf7, 0xd3, 0xbe, 0x2a, 0x76, 0xc8,
-	0x5d, 0xca, 0x3e, 0x20, 0x54, 0x53, 0x39, 0x61, 0xb5, 0x0c, 0xf2, 0xeb, 0x1d, 0x7e, 0xd1, 0x90,
-	0xc3, 0x6f, 0xeb, 0xba, 0xcd, 0x9c, 0x8d, 0x42, 0x7a, 0x41, 0xea, 0xcf, 0xcc, 0xd6, 0xf8, 0x9c,
-	0x83, 0xc9, 0x0a, 0xd6, 0x3f, 0x44, 0x56, 0xb0, 0x0a, 0xbb, 0xb9, 0x8f, 0x91, 0xa5, 0x99, 0x81,
-	0x5c, 0x28, 0x8c, 0xdf, 0x84, 0x09, 0xd4, 0xb2, 0x0c, 0x44, 0x27, 0x5d, 0xaa, 0xb4, 0x34, 0x50,
-	0x74, 0x3b, 0xef, 0x07, 0x04, 0x22, 0x3b, 0x50, 0xcf, 0xaa, 0xc7, 0xfb, 0x56, 0xfd, 0x0a, 0x6b,
-	0x48, 0x1b, 0x9e, 0xf0, 0x10, 0x67, 0x61, 0xda, 0xd1, 0xc8, 0x74, 0x37, 0x88, 0x6c, 0x1b, 0x1f,
-	0x2c, 0xfb, 0x55, 0x48, 0xd8, 0x21, 0xdb, 0x16, 0x0a, 0x56, 0xce, 0x90, 0x5b, 0x49, 0x9b, 0xc0,
-	0x04, 0x36, 0xf4, 0xa6, 0x66, 0x8a, 0x32, 0x61, 0x40, 0xe8, 0xb9, 0x3d, 0xf3, 0x16, 0x4c, 0x98,
-	0x1a, 0x6e, 0xd7, 0x2d, 0x12, 0x33, 0x55, 0x5a, 0x1d, 0x50, 0xe3, 0x2e, 0x56, 0xd9, 0x09, 0x29,
-	0x13, 0xb8, 0xec, 0xb8, 0x89, 0x75, 0xb8, 0x51, 0xc1, 0xfa, 0x7b, 0x9a, 0x72, 0xec, 0xbc, 0xa7,
-	0x46, 0xb8, 0x29, 0x5d, 0x72, 0x4f, 0xec, 0xeb, 0xa3, 0x05, 0xf2, 0xca, 0xe9, 0x66, 0x73, 0x75,
-	0x14, 0x0a, 0x10, 0x27, 0x65, 0x4c, 0xc3, 0x4c, 0xf9, 0xa3, 0xf2, 0x6e, 0xf5, 0xe1, 0xfb, 0x87,
-	0x07, 0xe5, 0xdd, 0xfd, 0xb7, 0xf7, 0xcb, 0x7b, 0x33, 0x11, 0xfe, 0x3a, 0x24, 0xc8, 0xd7, 0x07,
-	0xf2, 0xc7, 0x33, 0x5c, 0xe9, 0x97, 0x24, 0xc4, 0x2a, 0x58, 0xe7, 0x1f, 0x41, 0xb2, 0xf7, 0x21,
-	0x98, 0x1b, 0xbc, 0x73, 0x78, 0x06, 0xab, 0xb0, 0x1a, 0x00, 0x60, 0x45, 0xad, 0x03, 0xef, 0xf3,
-	0x10, 0x5b, 0xf1, 0x73, 0x1f, 0xc4, 0x09, 0x52, 0x38, 0x1c, 0xcb, 0xf6, 0x18, 0x66, 0x06, 0x9e,
-	0x47, 0xcb, 0x01, 0x31, 0x08, 0x4a, 0xb8, 0x17, 0x06, 0xc5, 0xf2, 0x20, 0x98, 0xf3, 0x7b, 0xc1,
-	0xac, 0x06, 0xd2, 0xa5, 0x40, 0xa1, 0x18, 0x12, 0xc8, 0x12, 0x1a, 0x30, 0x3b, 0xf8, 0x04, 0xb9,
-	0x13, 0xb0, 0x08, 0x14, 0x26, 0xac, 0x87, 0x82, 0xb1, 0x54, 0x6d, 0x98, 0xf7, 0xbf, 0xc9, 0xde,
-	0x0d, 0x88, 0xd3, 0x85, 0x0a, 0x1b, 0xa1, 0xa1, 0x2c, 0x6d, 0x07, 0x6e, 0x0e, 0x79, 0x1d, 0x14,
-	0x02, 0x8a, 0xd5, 0x83, 0x15, 0x4a, 0xe1, 0xb1, 0x2c, 0xf3, 0x53, 0x0e, 0x72, 0x41, 0x77, 0xb0,
-	0xcd, 0x50, 0x71, 0xbd, 0x4e, 0xc2, 0xeb, 0x23, 0x38, 0x31, 0x56, 0x9f, 0x73, 0xb0, 0x38, 0xfc,
-	0x56, 0xb3, 0x1e, 0x2a, 0x34, 0xeb, 0xb7, 0xfb, 0x57, 0x82, 0x33, 0x0e, 0x9f, 0x42, 0xaa, 0xef,
-	0xae, 0x21, 0xfa, 0x05, 0xf2, 0x62, 0x84, 0x42, 0x30, 0xa6, 0x77, 0xc3, 0x0e, 0xcc, 0x60, 0xdf,
-	0x0d, 0xdb, 0x8f, 0xf2, 0xdf, 0xb0, 0xc3, 0xa6, 0x2a, 0xbf, 0x03, 0x71, 0x32, 0x51, 0x33, 0x7e,
-	0x5e, 0xb6, 0x45, 0xc8, 0x0f, 0xb3, 0xf4, 0xc6, 0x20, 0xe7, 0xaa, 0x6f, 0x0c, 0xdb, 0xe2, 0x1f,
-	0xc3, 0x33, 0x63, 0x1e, 0x00, 0xf4, 0xcc, 0x87, 0xac, 0x1f, 0xbe, 0x6b, 0x17, 0x56, 0x2e, 0xb7,
-	0xbb, 0x51, 0x77, 0xde, 0x7c, 0x76, 0x9e, 0xe5, 0x9e, 0x9f, 0x67, 0xb9, 0xbf, 0xce, 0xb3, 0xdc,
-	0x77, 0x17, 0xd9, 0xc8, 0xf3, 0x8b, 0x6c, 0xe4, 0xf7, 0x8b, 0x6c, 0xe4, 0x93, 0x65, 0xdd, 0xb0,
-	0x9e, 0xb4, 0x8f, 0xa4, 0x1a, 0x6a, 0x38, 0x7f, 0x4e, 0x74, 0xfe, 0x59, 0xc7, 0xea, 0x67, 0xc5,
-	0x0e, 0xfd, 0x93, 0xe1, 0xd1, 0x04, 0xb9, 0xa1, 0x6d, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xf1,
-	0xa7, 0x43, 0xdc, 0xc0, 0x14, 0x00, 0x00,
+	0x14, 0xcf, 0xc6, 0x6e, 0xe2, 0xbc, 0x34, 0x4e, 0xba, 0x75, 0x5a, 0x7b, 0xd3, 0xda, 0x66, 0xe9,
+	0x9f, 0xd4, 0x4a, 0x6c, 0xe2, 0x50, 0x90, 0x0c, 0x02, 0xd5, 0x8d, 0x41, 0x41, 0x18, 0xaa, 0x6d,
+	0x4b, 0x81, 0x8b, 0xd9, 0x78, 0xa7, 0xdb, 0x15, 0xb6, 0x77, 0xb5, 0xb3, 0x4e, 0x9d, 0x23, 0x88,
+	0x03, 0x7f, 0x84, 0x54, 0xa9, 0x5f, 0x00, 0xc4, 0x17, 0xe0, 0xd0, 0x4f, 0xc0, 0xa9, 0xe2, 0x54,
+	0x71, 0x42, 0x1c, 0x00, 0xa5, 0x07, 0xae, 0x1c, 0xf8, 0x00, 0x68, 0x67, 0x76, 0xc7, 0xfb, 0xcf,
+	0xd9, 0xad, 0x95, 0xc2, 0x29, 0x99, 0x7d, 0xbf, 0xf7, 0xde, 0xef, 0xbd, 0x79, 0xf3, 0xe6, 0x8d,
+	0x21, 0xdf, 0xd5, 0x71, 0x5f, 0xc7, 0x35, 0xd5, 0xd4, 0x87, 0x46, 0x6d, 0x7f, 0xab, 0x66, 0x8d,
+	0xaa, 0x86, 0xa9, 0x5b, 0x3a, 0xbf, 0x4c, 0x25, 0x55, 0x22, 0xa9, 0xee, 0x6f, 0x09, 0x39, 0x55,
+	0x57, 0x75, 0x22, 0xab, 0xd9, 0xff, 0x51, 0x98, 0x50, 0xa0, 0xb0, 0x0e, 0x15, 0x38, 0x3a, 0x8e,
+	0x48, 0xd5, 0x75, 0xb5, 0x87, 0x6a, 0x64, 0xb5, 0x37, 0xbc, 0x5b, 0x93, 0x07, 0x07, 0x8e, 0xa8,
+	0x14, 0x14, 0x59, 0x5a, 0x1f, 0x61, 0x4b, 0xee, 0x1b, 0x0e, 0x60, 0x2d, 0xc4, 0xeb, 0xc0, 0x40,
+	0xae, 0xe1, 0xb3, 0x8e, 0xb0, 0x8f, 0x55, 0x5b, 0xd4, 0xc7, 0x2a, 0x15, 0x88, 0xdf, 0x73, 0x90,
+	0x6d, 0x63, 0xf5, 0xba, 0x89, 0x64, 0x0b, 0xbd, 0x6d, 0xab, 0xf2, 0x55, 0x38, 0x21, 0x2b, 0x7d,
+	0x6d, 0x90, 0xe7, 0xca, 0xdc, 0xfa, 0x42, 0x33, 0xff, 0xcb, 0xa3, 0xcd, 0x9c, 0xc3, 0xf2, 0x9a,
+	0xa2, 0x98, 0x08, 0xe3, 0x9b, 0x96, 0xa9, 0x0d, 0x54, 0x89, 0xc2, 0xf8, 0x57, 0x61, 0xbe, 0x8f,
+	0xfa, 0x7b, 0xc8, 0xc4, 0xf9, 0xd9, 0x72, 0x6a, 0x7d, 0xb1, 0x7e, 0xb6, 0x1a, 0x48, 0x44, 0xb5,
+	0x4d, 0xe4, 0xcd, 0xf4, 0xe3, 0xdf, 0x4b, 0x33, 0x92, 0x8b, 0xe6, 0x05, 0xc8, 0xf4, 0x91, 0x25,
+	0x2b, 0xb2, 0x25, 0xe7, 0x53, 0xb6, 0x2f, 0x89, 0xad, 0x1b, 0xf0, 0xf9, 0x5f, 0x3f, 0x56, 0xa8,
+	0x03, 0x71, 0x1b, 0xce, 0xf8, 0x29, 0x4a, 0x08, 0x1b, 0xfa, 0x00, 0x23, 0xbe, 0x00, 0x19, 0xe2,
+	0xa3, 0xa3, 0x29, 0x84, 0x6d, 0x5a, 0x9a, 0x27, 0xeb, 0x5d, 0x45, 0x7c, 0xc4, 0xc1, 0x6a, 0x1b,
+	0xab, 0xb7, 0x0d, 0xc5, 0xd5, 0x6a, 0x3b, 0x6e, 0x9f, 0x35, 0x3e, 0xaf, 0x93, 0x59, 0x9f, 0x13,
+	0x7e, 0x07, 0xb2, 0x34, 0x98, 0xce, 0x90, 0xf8, 0xc1, 0xf9, 0x54, 0x92, 0x0c, 0x2c, 0x51, 0x25,
+	0xca, 0x0d, 0xfb, 0x62, 0x2d, 0xc1, 0xf9, 0x48, 0xd6, 0x6e, 0xc8, 0xe2, 0x0f, 0x1c, 0x9c, 0xf6,
+	0x23, 0xae, 0x11, 0x96, 0xc7, 0x18, 0xd5, 0x55, 0x58, 0x18, 0xa0, 0xfb, 0x1d, 0x6a, 0x2e, 0x15,
+	0x63, 0x2e, 0x33, 0x40, 0xf7, 0x09, 0x03, 0x5f, 0x18, 0xe7, 0x61, 0x2d, 0x82, 0x24, 0x0b, 0xe2,
+	0x1b, 0x0e, 0xce, 0xf8, 0xe5, 0x6d, 0x67, 0xe3, 0x8f, 0x33, 0x8e, 0xa4, 0xf5, 0x55, 0x86, 0x62,
+	0x34, 0x19, 0xc6, 0xf7, 0x6f, 0x0e, 0x72, 0xfe, 0x12, 0xbc, 0xa1, 0xf7, 0xb4, 0xee, 0xc1, 0x7f,
+	0xc4, 0x96, 0x97, 0x61, 0x59, 0x41, 0x5d, 0x0d, 0x6b, 0xfa, 0xa0, 0x63, 0x10, 0xcf, 0xf9, 0x74,
+	0x99, 0x5b, 0x5f, 0xac, 0xe7, 0xaa, 0xb4, 0x2d, 0x54, 0xdd, 0xb6, 0x50, 0xbd, 0x36, 0x38, 0x68,
+	0x8a, 0x3f, 0x3f, 0xda, 0x2c, 0x06, 0x2b, 0x70, 0xc7, 0x31, 0x40, 0x99, 0x4b, 0x59, 0xc5, 0xb7,
+	0x6e, 0x64, 0xbf, 0xfc, 0xae, 0x34, 0xe3, 0x49, 0x8a, 0x04, 0xe7, 0xa2, 0x22, 0x66, 0x47, 0xaf,
+	0x0e, 0xf3, 0x32, 0x8d, 0x30, 0x36, 0x76, 0x17, 0x28, 0xfe, 0xc6, 0x41, 0xc1, 0x9f, 0x69, 0x6a,
+	0x74, 0xba, 0x0a, 0x7e, 0x07, 0x72, 0x34, 0x97, 0x34, 0x23, 0x1d, 0x97, 0xce, 0x6c, 0x8c, 0x3a,
+	0xaf, 0x7a, 0x3d, 0x13, 0xc9, 0x71, 0x94, 0xfc, 0x17, 0x29, 0xc8, 0xfb, 0x33, 0x76, 0x47, 0xb3,
+	0xee, 0x4d, 0x59, 0x27, 0x53, 0xf7, 0xd4, 0x8b, 0x90, 0xa5, 0x49, 0x09, 0xd4, 0xd2, 0x92, 0xea,
+	0x3b, 0x65, 0x75, 0x58, 0xf5, 0xe5, 0x8e, 0xa1, 0xd3, 0x04, 0x7d, 0xda, 0x93, 0x22, 0xa6, 0xb3,
+	0x15, 0xd0, 0x91, 0xb1, 0x93, 0xaf, 0x13, 0x65, 0x6e, 0x3d, 0xe3, 0x4f, 0x2b, 0xa6, 0x5b, 0x1a,
+	0x51, 0xb7, 0x73, 0xcf, 0xb9, 0x6e, 0xbf, 0xe2, 0xa0, 0x3c, 0x69, 0x1b, 0x12, 0xdc, 0x1b, 0xc7,
+	0x59, 0x55, 0xe2, 0x8b, 0xf0, 0xc2, 0xc4, 0x72, 0x67, 0xbd, 0xe5, 0xe1, 0x2c, 0x88, 0x51, 0x28,
+	0x7f, 0xdc, 0xff, 0xeb, 0xe9, 0x88, 0xd8, 0xc6, 0xd4, 0x73, 0xde, 0xc6, 0x0d, 0xa8, 0xc4, 0x27,
+	0x85, 0xe5, 0xf0, 0x27, 0x0e, 0xce, 0x45, 0xc1, 0xa7, 0xbe, 0x55, 0x8e, 0x33, 0x7b, 0x49, 0xaf,
+	0xa1, 0x4b, 0x70, 0xe1, 0xa8, 0x18, 0x58, 0xb0, 0x5f, 0xcf, 0xc2, 0xa9, 0x36, 0x56, 0x6f, 0x0e,
+	0xf7, 0xfa, 0x9a, 0x75, 0xc3, 0xd4, 0x0d, 0x1d, 0xcb, 0xbd, 0x89, 0x8c, 0xb9, 0x29, 0x18, 0x9f,
+	0x83, 0x05, 0x83, 0xd8, 0x75, 0xfb, 0xcf, 0x82, 0x34, 0xfe, 0x70, 0xe4, 0x45, 0xf5, 0x92, 0x2d,
+	0xc3, 0x58, 0x56, 0x11, 0xce, 0xa7, 0xcb, 0xa9, 0x49, 0x25, 0x22, 0x31, 0x14, 0x7f, 0x05, 0xd2,
+	0x68, 0x84, 0xba, 0xa4, 0x89, 0x64, 0xeb, 0xab, 0xa1, 0x36, 0xd7, 0x1a, 0xa1, 0xae, 0x44, 0x20,
+	0x0d, 0xde, 0xad, 0x91, 0x31, 0x19, 0xf1, 0x75, 0x28, 0x84, 0x72, 0xc1, 0x8e, 0x79, 0x09, 0x16,
+	0x0d, 0xe7, 0xdb, 0xf8, 0xa4, 0x83, 0xfb, 0x69, 0x57, 0x11, 0x47, 0x64, 0x96, 0xb2, 0x1b, 0x84,
+	0x62, 0xca, 0xf7, 0x59, 0x2e, 0xe3, 0xf4, 0xbc, 0x97, 0xdf, 0x6c, 0xc2, 0xcb, 0xaf, 0x71, 0xd2,
+	0x66, 0xee, 0xae, 0x9c, 0x01, 0x29, 0xe8, 0x99, 0xed, 0xf1, 0x21, 0x07, 0xf3, 0x6d, 0xac, 0x7e,
+	0xa0, 0x5b, 0xf1, 0x51, 0xd8, 0xc5, 0xbd, 0xaf, 0x5b, 0xc8, 0x8c, 0xe5, 0x42, 0x61, 0xfc, 0x36,
+	0xcc, 0xe9, 0x86, 0xa5, 0xe9, 0xf4, 0xa6, 0xcb, 0xd6, 0xd7, 0x42, 0x49, 0xb7, 0xfd, 0xbe, 0x4f,
+	0x20, 0x92, 0x03, 0xf5, 0xed, 0x7a, 0x3a, 0xb0, 0xeb, 0xcf, 0xb0, 0x87, 0xb4, 0xe0, 0x09, 0x0f,
+	0xf1, 0x14, 0x2c, 0x3b, 0x31, 0xb2, 0xb8, 0x75, 0x12, 0xb6, 0x8d, 0x8f, 0x0f, 0xfb, 0x65, 0xc8,
+	0xd8, 0x26, 0x87, 0x96, 0x1e, 0x1f, 0x39, 0x43, 0x36, 0x96, 0x6c, 0x02, 0x6c, 0x29, 0x4a, 0xb0,
+	0xec, 0x38, 0x64, 0x55, 0xf3, 0x26, 0xcc, 0x99, 0x08, 0x0f, 0x7b, 0x16, 0xb1, 0x9a, 0xad, 0x5f,
+	0x0e, 0xc5, 0xe3, 0x6e, 0x57, 0xcb, 0xb1, 0x22, 0x11, 0xb8, 0xe4, 0xa8, 0x89, 0x3d, 0x58, 0x6a,
+	0x63, 0xf5, 0x5d, 0x24, 0xef, 0x3b, 0x2f, 0xaa, 0x29, 0x66, 0xa5, 0x23, 0x26, 0xc5, 0x40, 0x25,
+	0x9d, 0x85, 0x55, 0x9f, 0x37, 0x96, 0xcb, 0x7f, 0x38, 0xd2, 0x27, 0x76, 0x50, 0x0f, 0xa9, 0xb2,
+	0x85, 0xee, 0x20, 0x4d, 0xbd, 0x67, 0xf1, 0xaf, 0xc0, 0x82, 0x42, 0xbf, 0xe8, 0x66, 0x2c, 0x9b,
+	0x31, 0xf4, 0xa8, 0xc9, 0x75, 0x6c, 0x12, 0xa1, 0xd8, 0xe1, 0x69, 0x0c, 0xe5, 0x77, 0x00, 0xd0,
+	0xc8, 0xd0, 0x4c, 0x99, 0xd4, 0x22, 0x1d, 0x68, 0x85, 0x50, 0xbb, 0xb8, 0xe5, 0xbe, 0x73, 0x9b,
+	0x19, 0x7b, 0xd4, 0x79, 0xf0, 0x47, 0x89, 0x93, 0x3c, 0x7a, 0x8d, 0x2c, 0xe9, 0x08, 0x8c, 0xa8,
+	0xb8, 0x06, 0x85, 0x50, 0xd4, 0x2c, 0x27, 0xf4, 0xc0, 0xdf, 0x1e, 0x28, 0xcf, 0x3b, 0x29, 0x21,
+	0x5a, 0xce, 0x8b, 0x68, 0xa0, 0x44, 0x12, 0xab, 0x54, 0x20, 0x4d, 0xaa, 0x3e, 0x07, 0x2b, 0xad,
+	0x0f, 0x5b, 0xd7, 0x3b, 0xb7, 0xdf, 0xbb, 0x79, 0xa3, 0x75, 0x7d, 0xf7, 0xad, 0xdd, 0xd6, 0xce,
+	0xca, 0x0c, 0x7f, 0x12, 0x32, 0xe4, 0xeb, 0x2d, 0xe9, 0xa3, 0x15, 0xae, 0xfe, 0xed, 0x12, 0xa4,
+	0xda, 0x58, 0xe5, 0xef, 0xc0, 0xa2, 0xf7, 0xdd, 0x5e, 0x0a, 0x8f, 0x88, 0xbe, 0x39, 0x48, 0xb8,
+	0x1c, 0x03, 0x60, 0x27, 0xa0, 0x07, 0x7c, 0xc4, 0xbb, 0xf9, 0x52, 0x94, 0x7a, 0x18, 0x27, 0x54,
+	0x93, 0xe1, 0x98, 0xb7, 0xbb, 0xb0, 0x12, 0x7a, 0xcd, 0x5e, 0x88, 0xb1, 0x41, 0x50, 0xc2, 0x46,
+	0x12, 0x14, 0xf3, 0xa3, 0xc3, 0xe9, 0xa8, 0x07, 0xe7, 0xe5, 0x58, 0xba, 0x14, 0x28, 0xd4, 0x12,
+	0x02, 0x99, 0x43, 0x0d, 0x4e, 0x85, 0x5f, 0x8c, 0x17, 0x63, 0x36, 0x81, 0xc2, 0x84, 0xcd, 0x44,
+	0x30, 0xe6, 0x6a, 0x08, 0xab, 0xd1, 0x0f, 0x8f, 0x2b, 0x31, 0x76, 0xc6, 0x50, 0x61, 0x2b, 0x31,
+	0x94, 0xb9, 0x1d, 0xc1, 0x99, 0x09, 0x8f, 0xb9, 0x4a, 0x4c, 0xb2, 0x3c, 0x58, 0xa1, 0x9e, 0x1c,
+	0xcb, 0x3c, 0x3f, 0xe4, 0xa0, 0x14, 0x37, 0x32, 0x6f, 0x27, 0xb2, 0xeb, 0x57, 0x12, 0x5e, 0x9b,
+	0x42, 0x89, 0xb1, 0xfa, 0x8c, 0x83, 0xc2, 0xe4, 0x21, 0x74, 0x33, 0x91, 0x69, 0x56, 0x6f, 0x57,
+	0x9f, 0x09, 0xce, 0x38, 0x7c, 0x02, 0xd9, 0xc0, 0x68, 0x28, 0x46, 0x19, 0xf2, 0x63, 0x84, 0x4a,
+	0x3c, 0xc6, 0x7b, 0x60, 0x43, 0x23, 0x53, 0xe4, 0x81, 0x0d, 0xa2, 0x84, 0x8d, 0x24, 0x28, 0xe6,
+	0xa7, 0x09, 0x69, 0x32, 0x00, 0xe5, 0xa3, 0xb4, 0x6c, 0x89, 0x50, 0x9e, 0x24, 0xf1, 0xda, 0x20,
+	0x7d, 0x35, 0xd2, 0x86, 0x2d, 0x11, 0xca, 0x93, 0x24, 0xcc, 0xc6, 0x2d, 0x00, 0xcf, 0x65, 0x5e,
+	0x8c, 0xc2, 0x8f, 0xe5, 0xc2, 0xa5, 0xa3, 0xe5, 0xde, 0x7d, 0x0a, 0x5c, 0xcd, 0x91, 0xfb, 0xe4,
+	0xc7, 0x08, 0x95, 0x78, 0x8c, 0xaf, 0xb1, 0x06, 0x6f, 0xba, 0xe8, 0xc6, 0x1a, 0x40, 0x09, 0x1b,
+	0x49, 0x50, 0xae, 0x9f, 0xe6, 0x1b, 0x8f, 0x0f, 0x8b, 0xdc, 0x93, 0xc3, 0x22, 0xf7, 0xe7, 0x61,
+	0x91, 0x7b, 0xf0, 0xb4, 0x38, 0xf3, 0xe4, 0x69, 0x71, 0xe6, 0xd7, 0xa7, 0xc5, 0x99, 0x8f, 0x2f,
+	0xa8, 0x9a, 0x75, 0x6f, 0xb8, 0x57, 0xed, 0xea, 0x7d, 0xe7, 0x87, 0x6e, 0xe7, 0xcf, 0x26, 0x56,
+	0x3e, 0xad, 0x8d, 0xe8, 0x6f, 0xd5, 0x7b, 0x73, 0xe4, 0xae, 0xdf, 0xfe, 0x77, 0x00, 0x1f, 0xbe,
+	0x14, 0xb7, 0x5a, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1618,6 +1844,15 @@ type MsgClient interface {
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// DelegateWeight delegates the voting weight of a group member to another
+	// member of the group until an expiration time.
+	//
+	// Since: cosmos-sdk 0.47
+	DelegateWeight(ctx context.Context, in *MsgDelegateWeight, opts ...grpc.CallOption) (*MsgDelegateWeightResponse, error)
+	// UndelegateWeight revokes the voting weight delegation of a group member.
+	//
+	// Since: cosmos-sdk 0.47
+	UndelegateWeight(ctx context.Context, in *MsgUndelegateWeight, opts ...grpc.CallOption) (*MsgUndelegateWeightResponse, error)
 }
 
 type msgClient struct {
@@ -1754,6 +1989,24 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) DelegateWeight(ctx context.Context, in *MsgDelegateWeight, opts ...grpc.CallOption) (*MsgDelegateWeightResponse, error) {
+	out := new(MsgDelegateWeightResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/DelegateWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateWeight(ctx context.Context, in *MsgUndelegateWeight, opts ...grpc.CallOption) (*MsgUndelegateWeightResponse, error) {
+	out := new(MsgUndelegateWeightResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/UndelegateWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateGroup creates a new group with an admin account address, a list of members and some optional metadata.
@@ -1784,6 +2037,15 @@ type MsgServer interface {
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// DelegateWeight delegates the voting weight of a group member to another
+	// member of the group until an expiration time.
+	//
+	// Since: cosmos-sdk 0.47
+	DelegateWeight(context.Context, *MsgDelegateWeight) (*MsgDelegateWeightResponse, error)
+	// UndelegateWeight revokes the voting weight delegation of a group member.
+	//
+	// Since: cosmos-sdk 0.47
+	UndelegateWeight(context.Context, *MsgUndelegateWeight) (*MsgUndelegateWeightResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1832,6 +2094,12 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (*UnimplementedMsgServer) DelegateWeight(ctx context.Context, req *MsgDelegateWeight) (*MsgDelegateWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateWeight not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateWeight(ctx context.Context, req *MsgUndelegateWeight) (*MsgUndelegateWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateWeight not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2089,6 +2357,42 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateWeight)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/DelegateWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateWeight(ctx, req.(*MsgDelegateWeight))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateWeight)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/UndelegateWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateWeight(ctx, req.(*MsgUndelegateWeight))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2149,6 +2453,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "DelegateWeight",
+			Handler:    _Msg_DelegateWeight_Handler,
+		},
+		{
+			MethodName: "UndelegateWeight",
+			Handler:    _Msg_UndelegateWeight_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
@@ -3187,61 +3499,192 @@ func (m *MsgLeaveGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgDelegateWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgDelegateWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Delegatee) > 0 {
+		i -= len(m.Delegatee)
+		copy(dAtA[i:], m.Delegatee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegatee)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.GroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelegateWeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateWeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateWeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovTx(uint64(m.GroupId))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateGroupMembers) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUndelegateWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.GroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateWeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateWeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateWeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupMembers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.GroupId != 0 {
 		n += 1 + sovTx(uint64(m.GroupId))
@@ -3656,6 +4099,62 @@ func (m *MsgLeaveGroupResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgDelegateWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDelegateWeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegateWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *MsgUndelegateWeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6571,6 +7070,373 @@ func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDelegateWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegatee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateWeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateWeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateWeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateWeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateWeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateWeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -227,6 +227,146 @@ func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string, si
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &PercentageQuorumDecisionPolicy{}
+
+// NewPercentageQuorumDecisionPolicy creates a new percentage with quorum DecisionPolicy
+func NewPercentageQuorumDecisionPolicy(quorum, percentage string, votingPeriod time.Duration, executionPeriod time.Duration) DecisionPolicy {
+	return &PercentageQuorumDecisionPolicy{quorum, percentage, &DecisionPolicyWindows{votingPeriod, executionPeriod}}
+}
+
+func (p PercentageQuorumDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+func (p PercentageQuorumDecisionPolicy) ValidateBasic() error {
+	quorum, err := math.NewPositiveDecFromString(p.Quorum)
+	if err != nil {
+		return sdkerrors.Wrap(err, "quorum")
+	}
+	if quorum.Cmp(math.NewDecFromInt64(1)) == 1 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "quorum must be > 0 and <= 1")
+	}
+
+	percentage, err := math.NewPositiveDecFromString(p.Percentage)
+	if err != nil {
+		return sdkerrors.Wrap(err, "percentage threshold")
+	}
+	if percentage.Cmp(math.NewDecFromInt64(1)) == 1 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "percentage must be > 0 and <= 1")
+	}
+
+	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "voting period cannot be 0")
+	}
+
+	return nil
+}
+
+func (p *PercentageQuorumDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
+		return sdkerrors.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
+	}
+	return nil
+}
+
+// Allow allows a proposal to pass when the votes cast reach the quorum and the
+// tally of yes votes equals or exceeds the percentage threshold of the
+// non-abstaining votes. The result is final only if the remaining undecided
+// votes cannot change it.
+func (p PercentageQuorumDecisionPolicy) Allow(tally TallyResult, totalPower string, sinceSubmission time.Duration) (DecisionPolicyResult, error) {
+	if sinceSubmission < p.Windows.MinExecutionPeriod {
+		return DecisionPolicyResult{}, errors.ErrUnauthorized.Wrapf("must wait %s after submission before execution, currently at %s", p.Windows.MinExecutionPeriod, sinceSubmission)
+	}
+
+	quorum, err := math.NewPositiveDecFromString(p.Quorum)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "quorum")
+	}
+	percentage, err := math.NewPositiveDecFromString(p.Percentage)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "percentage")
+	}
+	yesCount, err := tally.GetYesCount()
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "yes count")
+	}
+	abstainCount, err := tally.GetAbstainCount()
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "abstain count")
+	}
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "total power")
+	}
+
+	totalCounts, err := tally.TotalCounts()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	nonAbstainCounts, err := math.SubNonNegative(totalCounts, abstainCount)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	undecided, err := math.SubNonNegative(totalPowerDec, totalCounts)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	quorumReached, err := isRatioReached(totalCounts, totalPowerDec, quorum)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	percentageReached, err := isRatioReached(yesCount, nonAbstainCounts, percentage)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	allow := quorumReached && percentageReached
+
+	// the proposal passes whatever the undecided votes if it still reaches the
+	// percentage threshold when all of them vote no.
+	maxNonAbstainCounts, err := nonAbstainCounts.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	minPercentageReached, err := isRatioReached(yesCount, maxNonAbstainCounts, percentage)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if quorumReached && minPercentageReached {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	// the proposal is rejected whatever the undecided votes if it doesn't reach
+	// the percentage threshold when all of them vote yes, the quorum then
+	// being reached.
+	maxYesCount, err := yesCount.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	maxPercentageReached, err := isRatioReached(maxYesCount, maxNonAbstainCounts, percentage)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if !maxPercentageReached {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return DecisionPolicyResult{Allow: allow, Final: false}, nil
+}
+
+// isRatioReached returns true if x / y >= ratio, false if y is zero.
+func isRatioReached(x, y, ratio math.Dec) (bool, error) {
+	if y.IsZero() {
+		return false, nil
+	}
+	quo, err := x.Quo(y)
+	if err != nil {
+		return false, err
+	}
+	return quo.Cmp(ratio) >= 0, nil
+}
+
 var _ orm.Validateable = GroupPolicyInfo{}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
@@ -417,6 +557,68 @@ func (v Vote) ValidateBasic() error {
 	return nil
 }
 
+func (l ProposalExecutionLog) PrimaryKeyFields() []interface{} {
+	return []interface{}{l.ProposalId, l.Attempt}
+}
+
+var _ orm.Validateable = ProposalExecutionLog{}
+
+func (l ProposalExecutionLog) ValidateBasic() error {
+	if l.ProposalId == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "execution log proposal id")
+	}
+	if l.Attempt == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "execution log attempt")
+	}
+	_, err := sdk.AccAddressFromBech32(l.Executor)
+	if err != nil {
+		return sdkerrors.Wrap(err, "execution log executor")
+	}
+	if l.Result != PROPOSAL_EXECUTOR_RESULT_SUCCESS && l.Result != PROPOSAL_EXECUTOR_RESULT_FAILURE {
+		return sdkerrors.Wrapf(errors.ErrInvalid, "execution log result %s", l.Result)
+	}
+	if l.Result == PROPOSAL_EXECUTOR_RESULT_FAILURE && l.Error == "" {
+		return sdkerrors.Wrap(errors.ErrEmpty, "execution log error")
+	}
+	return nil
+}
+
+func (d WeightDelegation) PrimaryKeyFields() []interface{} {
+	addr, err := sdk.AccAddressFromBech32(d.Delegator)
+	if err != nil {
+		panic(err)
+	}
+	return []interface{}{d.GroupId, addr.Bytes()}
+}
+
+var _ orm.Validateable = WeightDelegation{}
+
+func (d WeightDelegation) ValidateBasic() error {
+	if d.GroupId == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "weight delegation group id")
+	}
+	delegator, err := sdk.AccAddressFromBech32(d.Delegator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "weight delegation delegator")
+	}
+	delegatee, err := sdk.AccAddressFromBech32(d.Delegatee)
+	if err != nil {
+		return sdkerrors.Wrap(err, "weight delegation delegatee")
+	}
+	if delegator.Equals(delegatee) {
+		return sdkerrors.Wrap(errors.ErrInvalid, "weight delegation delegator and delegatee must be different")
+	}
+	if d.Expiration.IsZero() {
+		return sdkerrors.Wrap(errors.ErrEmpty, "weight delegation expiration")
+	}
+	return nil
+}
+
+// IsActive returns true if the delegation has not expired at the given time.
+func (d WeightDelegation) IsActive(now time.Time) bool {
+	return now.Before(d.Expiration)
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (q QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpackGroupPolicies(unpacker, q.GroupPolicies)
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
 
 	"github.com/stretchr/testify/require"
@@ -429,3 +430,133 @@ func TestThresholdDecisionPolicyAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestPercentageQuorumDecisionPolicyValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy group.DecisionPolicy
+		expErr bool
+	}{
+		{"all good", group.NewPercentageQuorumDecisionPolicy("0.4", "0.5", time.Hour, 0), false},
+		{"zero quorum", group.NewPercentageQuorumDecisionPolicy("0", "0.5", time.Hour, 0), true},
+		{"quorum > 1", group.NewPercentageQuorumDecisionPolicy("1.1", "0.5", time.Hour, 0), true},
+		{"invalid percentage", group.NewPercentageQuorumDecisionPolicy("0.4", "-0.5", time.Hour, 0), true},
+		{"percentage > 1", group.NewPercentageQuorumDecisionPolicy("0.4", "2", time.Hour, 0), true},
+		{"zero voting period", group.NewPercentageQuorumDecisionPolicy("0.4", "0.5", 0, 0), true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPercentageQuorumDecisionPolicyAllow(t *testing.T) {
+	policy := group.NewPercentageQuorumDecisionPolicy("0.4", "0.5", time.Second*100, time.Second*10)
+
+	testCases := []struct {
+		name           string
+		tally          group.TallyResult
+		totalPower     string
+		votingDuration time.Duration
+		result         group.DecisionPolicyResult
+		expErr         bool
+	}{
+		{
+			"before min execution period",
+			group.TallyResult{YesCount: "10", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			"10",
+			time.Second,
+			group.DecisionPolicyResult{},
+			true,
+		},
+		{
+			"quorum not reached, percentage reached",
+			group.TallyResult{YesCount: "3", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			"10",
+			time.Second * 50,
+			group.DecisionPolicyResult{Allow: false, Final: false},
+			false,
+		},
+		{
+			"quorum and percentage reached, may change",
+			group.TallyResult{YesCount: "3", NoCount: "1", AbstainCount: "0", NoWithVetoCount: "0"},
+			"10",
+			time.Second * 50,
+			group.DecisionPolicyResult{Allow: true, Final: false},
+			false,
+		},
+		{
+			"quorum and percentage reached whatever the undecided votes",
+			group.TallyResult{YesCount: "6", NoCount: "1", AbstainCount: "0", NoWithVetoCount: "0"},
+			"10",
+			time.Second * 50,
+			group.DecisionPolicyResult{Allow: true, Final: true},
+			false,
+		},
+		{
+			"abstain votes count for quorum only",
+			group.TallyResult{YesCount: "1", NoCount: "0", AbstainCount: "9", NoWithVetoCount: "0"},
+			"10",
+			time.Second * 50,
+			group.DecisionPolicyResult{Allow: true, Final: true},
+			false,
+		},
+		{
+			"percentage not reachable",
+			group.TallyResult{YesCount: "1", NoCount: "4", AbstainCount: "0", NoWithVetoCount: "2"},
+			"10",
+			time.Second * 50,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+			false,
+		},
+		{
+			"only abstain votes",
+			group.TallyResult{YesCount: "0", NoCount: "0", AbstainCount: "10", NoWithVetoCount: "0"},
+			"10",
+			time.Second * 50,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+			false,
+		},
+		{
+			"empty group",
+			group.TallyResult{YesCount: "0", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			"0",
+			time.Second * 50,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policyResult, err := policy.Allow(tc.tally, tc.totalPower, tc.votingDuration)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.result, policyResult)
+			}
+		})
+	}
+}
+
+func TestRegisterDecisionPolicyImplementations(t *testing.T) {
+	policy := group.NewPercentageQuorumDecisionPolicy("0.4", "0.5", time.Hour, 0)
+	packed, err := codectypes.NewAnyWithValue(policy)
+	require.NoError(t, err)
+	any := &codectypes.Any{TypeUrl: packed.TypeUrl, Value: packed.Value}
+
+	registry := codectypes.NewInterfaceRegistry()
+	var dp group.DecisionPolicy
+	require.Error(t, registry.UnpackAny(any, &dp))
+
+	group.RegisterDecisionPolicyImplementations(registry, &group.PercentageQuorumDecisionPolicy{})
+	require.NoError(t, registry.UnpackAny(any, &dp))
+	require.Equal(t, policy, dp)
+}
@@ -17,6 +17,18 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ic types.InflationCalculatio
 	minter := k.GetMinter(ctx)
 	params := k.GetParams(ctx)
 
+	// burn a percentage of the fees collected in the previous block, before the
+	// minted coins are added to them
+	burnedFees, err := k.BurnCollectedFees(ctx, params.FeeBurnPercentage)
+	if err != nil {
+		panic(err)
+	}
+	for _, fee := range burnedFees {
+		if fee.Amount.IsInt64() {
+			defer telemetry.ModuleSetGauge(types.ModuleName, float32(fee.Amount.Int64()), "burned_fees", fee.Denom)
+		}
+	}
+
 	// recalculate inflation rate
 	totalStakingSupply := k.StakingTokenSupply(ctx)
 	bondedRatio := k.BondedRatio(ctx)
@@ -28,7 +40,7 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ic types.InflationCalculatio
 	mintedCoin := minter.BlockProvision(params)
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
-	err := k.MintCoins(ctx, mintedCoins)
+	err = k.MintCoins(ctx, mintedCoins)
 	if err != nil {
 		panic(err)
 	}
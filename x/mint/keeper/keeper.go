@@ -16,6 +16,7 @@ type Keeper struct {
 	storeKey         storetypes.StoreKey
 	paramSpace       paramtypes.Subspace
 	stakingKeeper    types.StakingKeeper
+	authKeeper       types.AccountKeeper
 	bankKeeper       types.BankKeeper
 	feeCollectorName string
 }
@@ -41,6 +42,7 @@ func NewKeeper(
 		storeKey:         key,
 		paramSpace:       paramSpace,
 		stakingKeeper:    sk,
+		authKeeper:       ak,
 		bankKeeper:       bk,
 		feeCollectorName: feeCollectorName,
 	}
@@ -109,3 +111,35 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
 func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
+
+// BurnCollectedFees burns the feeBurnPercentage of the fees collected by the
+// fee collector account, before they are distributed, and returns the burned
+// fees.
+func (k Keeper) BurnCollectedFees(ctx sdk.Context, feeBurnPercentage sdk.Dec) (sdk.Coins, error) {
+	if !feeBurnPercentage.IsPositive() {
+		return sdk.NewCoins(), nil
+	}
+
+	feeCollector := k.authKeeper.GetModuleAddress(k.feeCollectorName)
+	burned := sdk.NewCoins()
+	for _, fee := range k.bankKeeper.GetAllBalances(ctx, feeCollector) {
+		amount := feeBurnPercentage.MulInt(fee.Amount).TruncateInt()
+		burned = burned.Add(sdk.NewCoin(fee.Denom, amount))
+	}
+	if burned.Empty() {
+		return burned, nil
+	}
+
+	// the fees are burned from the fee collector account, which doesn't need
+	// the burner permission
+	if err := k.bankKeeper.BurnAccountCoins(ctx, feeCollector, burned); err != nil {
+		return nil, err
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventFeeBurn{
+		FeeCollector:      feeCollector.String(),
+		Amount:            burned,
+		FeeBurnPercentage: feeBurnPercentage,
+	})
+	return burned, err
+}
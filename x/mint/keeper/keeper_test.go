@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	minttestutil "github.com/cosmos/cosmos-sdk/x/mint/testutil"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+type mockedKeepers struct {
+	staking *minttestutil.MockStakingKeeper
+	account *minttestutil.MockAccountKeeper
+	bank    *minttestutil.MockBankKeeper
+}
+
+// setupMockedKeeper returns a mint keeper whose dependencies are mocks.
+func setupMockedKeeper(t *testing.T) (keeper.Keeper, sdk.Context, mockedKeepers) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	tkey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(key, tkey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, key, tkey, types.ModuleName)
+
+	ctrl := gomock.NewController(t)
+	mocks := mockedKeepers{
+		staking: minttestutil.NewMockStakingKeeper(ctrl),
+		account: minttestutil.NewMockAccountKeeper(ctrl),
+		bank:    minttestutil.NewMockBankKeeper(ctrl),
+	}
+	mocks.account.EXPECT().GetModuleAddress(types.ModuleName).Return(authtypes.NewModuleAddress(types.ModuleName))
+
+	k := keeper.NewKeeper(encCfg.Codec, key, paramSpace, mocks.staking, mocks.account, mocks.bank, authtypes.FeeCollectorName)
+	return k, ctx, mocks
+}
+
+func TestNewKeeperRequiresModuleAccount(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	tkey := sdk.NewTransientStoreKey("transient_test")
+	paramSpace := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, key, tkey, types.ModuleName)
+
+	ctrl := gomock.NewController(t)
+	ak := minttestutil.NewMockAccountKeeper(ctrl)
+	ak.EXPECT().GetModuleAddress(types.ModuleName).Return(nil)
+
+	require.Panics(t, func() {
+		keeper.NewKeeper(encCfg.Codec, key, paramSpace, minttestutil.NewMockStakingKeeper(ctrl), ak, minttestutil.NewMockBankKeeper(ctrl), authtypes.FeeCollectorName)
+	})
+}
+
+func TestKeeperStakingAliases(t *testing.T) {
+	k, ctx, mocks := setupMockedKeeper(t)
+
+	mocks.staking.EXPECT().StakingTokenSupply(ctx).Return(sdk.NewInt(1000))
+	mocks.staking.EXPECT().BondedRatio(ctx).Return(sdk.NewDecWithPrec(67, 2))
+
+	require.Equal(t, sdk.NewInt(1000), k.StakingTokenSupply(ctx))
+	require.Equal(t, sdk.NewDecWithPrec(67, 2), k.BondedRatio(ctx))
+}
+
+func TestKeeperMintCoins(t *testing.T) {
+	k, ctx, mocks := setupMockedKeeper(t)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	// no coins are minted without calling the bank keeper
+	require.NoError(t, k.MintCoins(ctx, sdk.NewCoins()))
+
+	mocks.bank.EXPECT().MintCoins(ctx, types.ModuleName, coins).Return(nil)
+	require.NoError(t, k.MintCoins(ctx, coins))
+
+	mocks.bank.EXPECT().MintCoins(ctx, types.ModuleName, coins).Return(errors.New("mint failed"))
+	require.EqualError(t, k.MintCoins(ctx, coins), "mint failed")
+}
+
+func TestKeeperAddCollectedFees(t *testing.T) {
+	k, ctx, mocks := setupMockedKeeper(t)
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	mocks.bank.EXPECT().SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, fees).Return(nil)
+	require.NoError(t, k.AddCollectedFees(ctx, fees))
+}
+
+func TestKeeperBurnCollectedFees(t *testing.T) {
+	k, ctx, mocks := setupMockedKeeper(t)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	// nothing is burned without a fee burn percentage
+	burned, err := k.BurnCollectedFees(ctx, sdk.ZeroDec())
+	require.NoError(t, err)
+	require.True(t, burned.Empty())
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("atom", 3), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	expected := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 250))
+	mocks.account.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(feeCollector)
+	mocks.bank.EXPECT().GetAllBalances(ctx, feeCollector).Return(fees)
+	mocks.bank.EXPECT().BurnAccountCoins(ctx, feeCollector, expected).Return(nil)
+
+	burned, err = k.BurnCollectedFees(ctx, sdk.NewDecWithPrec(25, 2))
+	require.NoError(t, err)
+	require.Equal(t, expected, burned)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "cosmos.mint.v1beta1.EventFeeBurn", events[0].Type)
+}
@@ -0,0 +1,34 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046mint "github.com/cosmos/cosmos-sdk/x/mint/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	mintKey := sdk.NewKVStoreKey("mint")
+	tMintKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(mintKey, tMintKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, mintKey, tMintKey, types.ModuleName)
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.KeyFeeBurnPercentage))
+
+	// Run migrations.
+	err := v046mint.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	var feeBurnPercentage sdk.Dec
+	paramstore.Get(ctx, types.KeyFeeBurnPercentage, &feeBurnPercentage)
+	require.True(t, feeBurnPercentage.IsZero())
+}
@@ -19,6 +19,7 @@ const (
 	InflationMax        = "inflation_max"
 	InflationMin        = "inflation_min"
 	GoalBonded          = "goal_bonded"
+	FeeBurnPercentage   = "fee_burn_percentage"
 )
 
 // GenInflation randomized Inflation
@@ -46,6 +47,11 @@ func GenGoalBonded(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(67, 2)
 }
 
+// GenFeeBurnPercentage randomized FeeBurnPercentage
+func GenFeeBurnPercentage(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(51)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for mint
 func RandomizedGenState(simState *module.SimulationState) {
 	// minter
@@ -80,9 +86,15 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { goalBonded = GenGoalBonded(r) },
 	)
 
+	var feeBurnPercentage sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FeeBurnPercentage, &feeBurnPercentage, simState.Rand,
+		func(r *rand.Rand) { feeBurnPercentage = GenFeeBurnPercentage(r) },
+	)
+
 	mintDenom := sdk.DefaultBondDenom
 	blocksPerYear := uint64(60 * 60 * 8766 / 5)
-	params := types.NewParams(mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear)
+	params := types.NewParams(mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear, feeBurnPercentage)
 
 	mintGenesis := types.NewGenesisState(types.InitialMinter(inflation), params)
 
@@ -17,6 +17,7 @@ const (
 	keyInflationMax        = "InflationMax"
 	keyInflationMin        = "InflationMin"
 	keyGoalBonded          = "GoalBonded"
+	keyFeeBurnPercentage   = "FeeBurnPercentage"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -43,5 +44,10 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenGoalBonded(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyFeeBurnPercentage,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenFeeBurnPercentage(r))
+			},
+		),
 	}
 }
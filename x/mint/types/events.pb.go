@@ -0,0 +1,443 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/mint/v1beta1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventFeeBurn is emitted when a percentage of the collected tx fees is burned
+// at the beginning of a block.
+type EventFeeBurn struct {
+	// fee_collector is the address of the account the fees are burned from.
+	FeeCollector string `protobuf:"bytes,1,opt,name=fee_collector,json=feeCollector,proto3" json:"fee_collector,omitempty"`
+	// amount is the amount of fees burned.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// fee_burn_percentage is the percentage of the collected fees burned.
+	FeeBurnPercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=fee_burn_percentage,json=feeBurnPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_burn_percentage"`
+}
+
+func (m *EventFeeBurn) Reset()         { *m = EventFeeBurn{} }
+func (m *EventFeeBurn) String() string { return proto.CompactTextString(m) }
+func (*EventFeeBurn) ProtoMessage()    {}
+func (*EventFeeBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9a371c07098db30, []int{0}
+}
+func (m *EventFeeBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeBurn.Merge(m, src)
+}
+func (m *EventFeeBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeBurn proto.InternalMessageInfo
+
+func (m *EventFeeBurn) GetFeeCollector() string {
+	if m != nil {
+		return m.FeeCollector
+	}
+	return ""
+}
+
+func (m *EventFeeBurn) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventFeeBurn)(nil), "cosmos.mint.v1beta1.EventFeeBurn")
+}
+
+func init() { proto.RegisterFile("cosmos/mint/v1beta1/events.proto", fileDescriptor_c9a371c07098db30) }
+
+var fileDescriptor_c9a371c07098db30 = []byte{
+	// 334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x31, 0x4f, 0xc2, 0x40,
+	0x18, 0x86, 0x5b, 0x48, 0x48, 0xac, 0x38, 0x58, 0x18, 0x0a, 0xc3, 0x41, 0x1c, 0x0c, 0x0e, 0xb4,
+	0xa2, 0xab, 0x0e, 0x16, 0x74, 0x36, 0xb8, 0xb9, 0x90, 0xf6, 0xf8, 0xa8, 0x8d, 0xf4, 0x3e, 0x72,
+	0x77, 0x25, 0xfa, 0x2f, 0xfc, 0x09, 0xce, 0xce, 0xfc, 0x08, 0x46, 0xc2, 0x64, 0x1c, 0xd0, 0xd0,
+	0x3f, 0x62, 0xda, 0x3b, 0x18, 0x8d, 0x53, 0x7b, 0xf9, 0xde, 0xf7, 0x79, 0xdf, 0xfb, 0xce, 0x6a,
+	0x53, 0x14, 0x09, 0x0a, 0x2f, 0x89, 0x99, 0xf4, 0xe6, 0xbd, 0x10, 0x64, 0xd0, 0xf3, 0x60, 0x0e,
+	0x4c, 0x0a, 0x77, 0xc6, 0x51, 0xa2, 0x5d, 0x53, 0x0a, 0x37, 0x57, 0xb8, 0x5a, 0xd1, 0xac, 0x47,
+	0x18, 0x61, 0x31, 0xf7, 0xf2, 0x3f, 0x25, 0x6d, 0x12, 0x0d, 0x0b, 0x03, 0x01, 0x7b, 0x18, 0xc5,
+	0x98, 0xe9, 0x79, 0x43, 0xcd, 0x47, 0xca, 0xa8, 0xb9, 0xc5, 0xe1, 0xe4, 0xbd, 0x64, 0x55, 0x6f,
+	0xf3, 0xd8, 0x3b, 0x00, 0x3f, 0xe5, 0xcc, 0xbe, 0xb6, 0x8e, 0x26, 0x00, 0x23, 0x8a, 0xd3, 0x29,
+	0x50, 0x89, 0xdc, 0x31, 0xdb, 0x66, 0xe7, 0xc0, 0x77, 0xd6, 0x8b, 0x6e, 0x5d, 0x3b, 0x6f, 0xc6,
+	0x63, 0x0e, 0x42, 0x3c, 0x48, 0x1e, 0xb3, 0x68, 0x58, 0x9d, 0x00, 0xf4, 0x77, 0x6a, 0x9b, 0x5a,
+	0x95, 0x20, 0xc1, 0x94, 0x49, 0xa7, 0xd4, 0x2e, 0x77, 0x0e, 0x2f, 0x1a, 0xae, 0x36, 0xe5, 0xdd,
+	0x76, 0xd7, 0x70, 0xfb, 0x18, 0x33, 0xff, 0x7c, 0xb9, 0x69, 0x19, 0x1f, 0xdf, 0xad, 0x4e, 0x14,
+	0xcb, 0xa7, 0x34, 0x74, 0x29, 0x26, 0xba, 0x9b, 0xfe, 0x74, 0xc5, 0xf8, 0xd9, 0x93, 0xaf, 0x33,
+	0x10, 0x85, 0x41, 0x0c, 0x35, 0xda, 0x9e, 0x5a, 0xb5, 0xbc, 0x63, 0x98, 0x72, 0x36, 0x9a, 0x01,
+	0xa7, 0xc0, 0x64, 0x10, 0x81, 0x53, 0x2e, 0x9a, 0x5e, 0xe5, 0xd8, 0xaf, 0x4d, 0xeb, 0xf4, 0x1f,
+	0xd8, 0x01, 0xd0, 0xf5, 0xa2, 0x6b, 0xe9, 0x8a, 0x03, 0xa0, 0xc3, 0xe3, 0x89, 0xda, 0xc3, 0xfd,
+	0x1e, 0xeb, 0xf7, 0x97, 0x5b, 0x62, 0xae, 0xb6, 0xc4, 0xfc, 0xd9, 0x12, 0xf3, 0x2d, 0x23, 0xc6,
+	0x2a, 0x23, 0xc6, 0x67, 0x46, 0x8c, 0xc7, 0xb3, 0x3f, 0x23, 0x5e, 0xd4, 0xe3, 0x16, 0x49, 0x61,
+	0xa5, 0x58, 0xf7, 0xe5, 0xef, 0x00, 0x56, 0x06, 0x9e, 0x64, 0xf8, 0x01, 0x00, 0x00,
+}
+
+func (m *EventFeeBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeBurnPercentage.Size()
+		i -= size
+		if _, err := m.FeeBurnPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeeCollector) > 0 {
+		i -= len(m.FeeCollector)
+		copy(dAtA[i:], m.FeeCollector)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FeeCollector)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventFeeBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeeCollector)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.FeeBurnPercentage.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventFeeBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeeBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeeBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
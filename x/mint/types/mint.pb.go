@@ -80,6 +80,10 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// percentage of the collected tx fees burned before their distribution
+	//
+	// Since: cosmos-sdk 0.46
+	FeeBurnPercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=fee_burn_percentage,json=feeBurnPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_burn_percentage"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -136,32 +140,34 @@ func init() {
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0xaa, 0xda, 0x40,
-	0x18, 0x85, 0x93, 0x6a, 0x03, 0x4e, 0x2b, 0x6d, 0xc7, 0x16, 0x52, 0xa1, 0x51, 0x5c, 0x88, 0x5d,
-	0x98, 0x20, 0xdd, 0x95, 0xae, 0xd4, 0xad, 0x20, 0xd9, 0x55, 0x28, 0x61, 0x92, 0x4c, 0xe3, 0x60,
-	0x32, 0x13, 0x66, 0x46, 0xd1, 0xb7, 0xe8, 0xb2, 0xcb, 0x3e, 0x44, 0x1f, 0xc2, 0x5d, 0xa5, 0xab,
-	0xd2, 0x85, 0x5c, 0x74, 0x7b, 0x1f, 0xe2, 0x92, 0x99, 0x10, 0xe1, 0x2e, 0xee, 0x2a, 0xab, 0x64,
-	0xce, 0x99, 0xff, 0x3b, 0x27, 0x81, 0x1f, 0x38, 0x11, 0x13, 0x19, 0x13, 0x5e, 0x46, 0xa8, 0xf4,
-	0x76, 0x93, 0x10, 0x4b, 0x34, 0x51, 0x07, 0x37, 0xe7, 0x4c, 0x32, 0xd8, 0xd1, 0xbe, 0xab, 0xa4,
-	0xd2, 0xef, 0xbe, 0x4d, 0x58, 0xc2, 0x94, 0xef, 0x15, 0x6f, 0xfa, 0x6a, 0xf7, 0xbd, 0xbe, 0x1a,
-	0x68, 0xa3, 0x9c, 0x53, 0x87, 0xc1, 0x1f, 0x13, 0x58, 0x0b, 0x42, 0x25, 0xe6, 0x70, 0x05, 0x5a,
-	0x84, 0x7e, 0x4f, 0x91, 0x24, 0x8c, 0xda, 0x66, 0xdf, 0x1c, 0xb5, 0xa6, 0x5f, 0x8e, 0xe7, 0x9e,
-	0xf1, 0xff, 0xdc, 0x1b, 0x26, 0x44, 0xae, 0xb7, 0xa1, 0x1b, 0xb1, 0xac, 0x1c, 0x2f, 0x1f, 0x63,
-	0x11, 0x6f, 0x3c, 0x79, 0xc8, 0xb1, 0x70, 0xe7, 0x38, 0xfa, 0xfb, 0x7b, 0x0c, 0x4a, 0xfa, 0x1c,
-	0x47, 0xfe, 0x0d, 0x07, 0x09, 0x78, 0x83, 0x28, 0xdd, 0xa2, 0xb4, 0xe8, 0xb0, 0x23, 0x82, 0x30,
-	0x2a, 0xec, 0x67, 0x35, 0x64, 0xbc, 0xd6, 0xd8, 0x65, 0x45, 0x1d, 0xdc, 0x37, 0x80, 0xb5, 0x44,
-	0x1c, 0x65, 0x02, 0x7e, 0x00, 0xa0, 0xf8, 0x3b, 0x41, 0x8c, 0x29, 0xcb, 0xf4, 0x27, 0xf9, 0xad,
-	0x42, 0x99, 0x17, 0x02, 0xcc, 0xc1, 0xbb, 0xaa, 0x61, 0xc0, 0x91, 0xc4, 0x41, 0xb4, 0x46, 0x34,
-	0xc1, 0xb5, 0x14, 0xeb, 0x54, 0x68, 0x1f, 0x49, 0x3c, 0x53, 0x60, 0x88, 0x40, 0xfb, 0x96, 0x98,
-	0xa1, 0xbd, 0xdd, 0xa8, 0x21, 0xe9, 0x65, 0x85, 0x5c, 0xa0, 0xfd, 0xa3, 0x08, 0x42, 0xed, 0x66,
-	0xbd, 0x11, 0x84, 0xc2, 0x6f, 0xe0, 0x45, 0xc2, 0x50, 0x1a, 0x84, 0x8c, 0xc6, 0x38, 0xb6, 0x9f,
-	0xd7, 0x10, 0x00, 0x0a, 0xe0, 0x54, 0xf1, 0xe0, 0x10, 0xbc, 0x0a, 0x53, 0x16, 0x6d, 0x44, 0x90,
-	0x63, 0x1e, 0x1c, 0x30, 0xe2, 0xb6, 0xd5, 0x37, 0x47, 0x4d, 0xbf, 0xad, 0xe5, 0x25, 0xe6, 0x5f,
-	0x31, 0xe2, 0x9f, 0x9b, 0x3f, 0x7f, 0xf5, 0x8c, 0xe9, 0xec, 0x78, 0x71, 0xcc, 0xd3, 0xc5, 0x31,
-	0xef, 0x2e, 0x8e, 0xf9, 0xe3, 0xea, 0x18, 0xa7, 0xab, 0x63, 0xfc, 0xbb, 0x3a, 0xc6, 0xea, 0xe3,
-	0x93, 0x4d, 0xf6, 0x7a, 0xb1, 0x54, 0xa1, 0xd0, 0x52, 0xcb, 0xf0, 0xe9, 0x21, 0x00, 0x00, 0xff,
-	0xff, 0x83, 0xde, 0x9f, 0x75, 0x74, 0x03, 0x00, 0x00,
+	// 418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x13, 0x5d, 0x57, 0x76, 0xb4, 0x68, 0x67, 0x15, 0x62, 0xc1, 0x6c, 0xe9, 0xa1, 0xd4,
+	0x43, 0x77, 0x29, 0xde, 0xc4, 0x53, 0xba, 0xd7, 0x42, 0xc8, 0xcd, 0x82, 0x0c, 0x2f, 0xc9, 0xdb,
+	0x74, 0x68, 0x32, 0x13, 0x66, 0x26, 0x65, 0xfb, 0x2d, 0x3c, 0x7a, 0xf4, 0x43, 0xf8, 0x21, 0x7a,
+	0xb3, 0x78, 0x92, 0x1e, 0x8a, 0xec, 0x7e, 0x11, 0xc9, 0x4c, 0x48, 0xc1, 0x43, 0x4f, 0x39, 0x25,
+	0xf3, 0xde, 0x9b, 0xdf, 0xef, 0x9f, 0xc0, 0x23, 0x61, 0x26, 0x75, 0x25, 0xf5, 0xa2, 0xe2, 0xc2,
+	0x2c, 0xae, 0x4e, 0x52, 0x34, 0x70, 0x62, 0x0f, 0xf3, 0x5a, 0x49, 0x23, 0xe9, 0xd4, 0xf5, 0xe7,
+	0xb6, 0xd4, 0xf5, 0xf7, 0xde, 0x14, 0xb2, 0x90, 0xb6, 0xbf, 0x68, 0xdf, 0xdc, 0xe8, 0xde, 0x3b,
+	0x37, 0xca, 0x5c, 0xa3, 0xbb, 0x67, 0x0f, 0x07, 0xbf, 0x7c, 0x32, 0x3e, 0xe3, 0xc2, 0xa0, 0xa2,
+	0xe7, 0x64, 0xc2, 0xc5, 0xaa, 0x04, 0xc3, 0xa5, 0x08, 0xfc, 0x7d, 0xff, 0x68, 0x12, 0x7d, 0xbe,
+	0xb9, 0x9f, 0x79, 0x77, 0xf7, 0xb3, 0xc3, 0x82, 0x9b, 0x8b, 0x26, 0x9d, 0x67, 0xb2, 0xea, 0xae,
+	0x77, 0x8f, 0x63, 0x9d, 0x5f, 0x2e, 0xcc, 0x75, 0x8d, 0x7a, 0xbe, 0xc4, 0xec, 0xf7, 0xcf, 0x63,
+	0xd2, 0xd1, 0x97, 0x98, 0x25, 0x0f, 0x38, 0xca, 0xc9, 0x2e, 0x08, 0xd1, 0x40, 0xd9, 0x66, 0xb8,
+	0xe2, 0x9a, 0x4b, 0xa1, 0x83, 0x27, 0x03, 0x38, 0x5e, 0x3b, 0x6c, 0xdc, 0x53, 0x0f, 0xee, 0x46,
+	0x64, 0x1c, 0x83, 0x82, 0x4a, 0xd3, 0xf7, 0x84, 0xb4, 0x7f, 0x87, 0xe5, 0x28, 0x64, 0xe5, 0x3e,
+	0x29, 0x99, 0xb4, 0x95, 0x65, 0x5b, 0xa0, 0x35, 0x79, 0xdb, 0x27, 0x64, 0x0a, 0x0c, 0xb2, 0xec,
+	0x02, 0x44, 0x81, 0x83, 0x04, 0x9b, 0xf6, 0xe8, 0x04, 0x0c, 0x9e, 0x5a, 0x30, 0x05, 0xb2, 0xf3,
+	0x60, 0xac, 0x60, 0x1d, 0x3c, 0x1d, 0xc0, 0xf4, 0xb2, 0x47, 0x9e, 0xc1, 0xfa, 0x3f, 0x05, 0x17,
+	0xc1, 0x68, 0x58, 0x05, 0x17, 0xf4, 0x2b, 0x79, 0x51, 0x48, 0x28, 0x59, 0x2a, 0x45, 0x8e, 0x79,
+	0xf0, 0x6c, 0x00, 0x01, 0x69, 0x81, 0x91, 0xe5, 0xd1, 0x43, 0xf2, 0x2a, 0x2d, 0x65, 0x76, 0xa9,
+	0x59, 0x8d, 0x8a, 0x5d, 0x23, 0xa8, 0x60, 0xbc, 0xef, 0x1f, 0x8d, 0x92, 0x1d, 0x57, 0x8e, 0x51,
+	0x7d, 0x41, 0x50, 0xb4, 0x24, 0xd3, 0x15, 0x22, 0x4b, 0x1b, 0x25, 0xda, 0xc9, 0x0c, 0x85, 0x81,
+	0x02, 0x83, 0xe7, 0x03, 0xc4, 0xd9, 0x5d, 0x21, 0x46, 0x8d, 0x12, 0x71, 0x8f, 0xfd, 0x34, 0xfa,
+	0xfe, 0x63, 0xe6, 0x45, 0xa7, 0x37, 0x9b, 0xd0, 0xbf, 0xdd, 0x84, 0xfe, 0xdf, 0x4d, 0xe8, 0x7f,
+	0xdb, 0x86, 0xde, 0xed, 0x36, 0xf4, 0xfe, 0x6c, 0x43, 0xef, 0xfc, 0xc3, 0xa3, 0xa2, 0xb5, 0x5b,
+	0x63, 0xeb, 0x4b, 0xc7, 0x76, 0xf5, 0x3e, 0xfe, 0x1b, 0x00, 0x35, 0x9c, 0x66, 0x19, 0xe2, 0x03,
+	0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -227,6 +233,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.FeeBurnPercentage.Size()
+		i -= size
+		if _, err := m.FeeBurnPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -327,6 +343,8 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	l = m.FeeBurnPercentage.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -670,6 +688,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
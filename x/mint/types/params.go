@@ -19,6 +19,7 @@ var (
 	KeyInflationMin        = []byte("InflationMin")
 	KeyGoalBonded          = []byte("GoalBonded")
 	KeyBlocksPerYear       = []byte("BlocksPerYear")
+	KeyFeeBurnPercentage   = []byte("FeeBurnPercentage")
 )
 
 // ParamTable for minting module.
@@ -28,6 +29,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 func NewParams(
 	mintDenom string, inflationRateChange, inflationMax, inflationMin, goalBonded sdk.Dec, blocksPerYear uint64,
+	feeBurnPercentage sdk.Dec,
 ) Params {
 
 	return Params{
@@ -37,6 +39,7 @@ func NewParams(
 		InflationMin:        inflationMin,
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
+		FeeBurnPercentage:   feeBurnPercentage,
 	}
 }
 
@@ -49,6 +52,7 @@ func DefaultParams() Params {
 		InflationMin:        sdk.NewDecWithPrec(7, 2),
 		GoalBonded:          sdk.NewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		FeeBurnPercentage:   sdk.ZeroDec(),
 	}
 }
 
@@ -72,6 +76,9 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateFeeBurnPercentage(p.FeeBurnPercentage); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -98,6 +105,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
 		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
 		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		paramtypes.NewParamSetPair(KeyFeeBurnPercentage, &p.FeeBurnPercentage, validateFeeBurnPercentage),
 	}
 }
 
@@ -193,3 +201,22 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateFeeBurnPercentage(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("fee burn percentage cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("fee burn percentage cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee burn percentage too large: %s", v)
+	}
+
+	return nil
+}
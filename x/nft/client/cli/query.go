@@ -39,6 +39,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryOwner(),
 		GetCmdQueryBalance(),
 		GetCmdQuerySupply(),
+		GetCmdQueryClassMetadata(),
+		GetCmdQueryNFTAttributes(),
+		GetCmdQueryRoyaltyInfo(),
 	)
 	return nftQueryCmd
 }
@@ -274,3 +277,93 @@ func GetCmdQuerySupply() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryClassMetadata implements the query class-metadata command.
+func GetCmdQueryClassMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "class-metadata [class-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the extended metadata of an NFT class",
+		Example: fmt.Sprintf(`$ %s query %s class-metadata <class-id>`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.ClassMetadata(cmd.Context(), &nft.QueryClassMetadataRequest{
+				ClassId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryNFTAttributes implements the query nft-attributes command.
+func GetCmdQueryNFTAttributes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "nft-attributes [class-id] [nft-id]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query the attributes of an NFT based on its class and id.",
+		Example: fmt.Sprintf(`$ %s query %s nft-attributes <class-id> <nft-id>`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := nft.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.NFTAttributes(cmd.Context(), &nft.QueryNFTAttributesRequest{
+				ClassId:    args[0],
+				Id:         args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nft-attributes")
+	return cmd
+}
+
+// GetCmdQueryRoyaltyInfo implements the query royalty-info command.
+func GetCmdQueryRoyaltyInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "royalty-info [class-id] [sale-price]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query the royalty owed for a sale of an NFT of the given class",
+		Example: fmt.Sprintf(`$ %s query %s royalty-info <class-id> 1000stake`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			salePrice, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.RoyaltyInfo(cmd.Context(), &nft.QueryRoyaltyInfoRequest{
+				ClassId:   args[0],
+				SalePrice: salePrice,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
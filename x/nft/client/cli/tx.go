@@ -13,6 +13,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
+// FlagAtomic is the flag making a batch tx fail as soon as one of its entries fails
+const FlagAtomic = "atomic"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	nftTxCmd := &cobra.Command{
@@ -26,6 +29,8 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdBatchSend(),
+		NewCmdBatchBurn(),
 	)
 
 	return nftTxCmd
@@ -58,3 +63,88 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewCmdBatchSend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-send [class-id] [receiver] [nft-id]... --from [sender]",
+		Args:  cobra.MinimumNArgs(3),
+		Short: "transfer ownership of many nfts of a class to a receiver",
+		Long: strings.TrimSpace(fmt.Sprintf(`Transfer ownership of many nfts of a class to a receiver in one tx.
+By default the entries are processed independently and failed entries are reported in the response,
+use --%s to fail the whole tx as soon as one entry fails.
+
+Example:
+			$ %s tx %s batch-send <class-id> <receiver> <nft-id-1> <nft-id-2> --from <sender> --chain-id <chain-id>`, FlagAtomic, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			atomic, err := cmd.Flags().GetBool(FlagAtomic)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgBatchSend{
+				Sender: clientCtx.GetFromAddress().String(),
+				Atomic: atomic,
+			}
+			for _, id := range args[2:] {
+				msg.Entries = append(msg.Entries, nft.SendEntry{
+					ClassId:  args[0],
+					Id:       id,
+					Receiver: args[1],
+				})
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagAtomic, false, "Fail the whole tx as soon as one entry fails")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdBatchBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-burn [class-id] [nft-id]... --from [owner]",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "burn many nfts of a class",
+		Long: strings.TrimSpace(fmt.Sprintf(`Burn many nfts of a class owned by the sender in one tx.
+By default the entries are processed independently and failed entries are reported in the response,
+use --%s to fail the whole tx as soon as one entry fails.
+
+Example:
+			$ %s tx %s batch-burn <class-id> <nft-id-1> <nft-id-2> --from <owner> --chain-id <chain-id>`, FlagAtomic, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			atomic, err := cmd.Flags().GetBool(FlagAtomic)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgBatchBurn{
+				Owner:  clientCtx.GetFromAddress().String(),
+				Atomic: atomic,
+			}
+			for _, id := range args[1:] {
+				msg.Entries = append(msg.Entries, nft.BurnEntry{
+					ClassId: args[0],
+					Id:      id,
+				})
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagAtomic, false, "Fail the whole tx as soon as one entry fails")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -21,6 +21,16 @@ func ValidateGenesis(data GenesisState) error {
 			}
 		}
 	}
+	for _, metadata := range data.ClassMetadata {
+		if err := metadata.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	for _, metadata := range data.NftMetadata {
+		if err := metadata.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
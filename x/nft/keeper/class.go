@@ -25,6 +25,10 @@ func (k Keeper) UpdateClass(ctx sdk.Context, class nft.Class) error {
 	if !k.HasClass(ctx, class.Id) {
 		return sdkerrors.Wrap(nft.ErrClassNotExists, class.Id)
 	}
+
+	if k.isMetadataFrozen(ctx, class.Id) {
+		return sdkerrors.Wrap(nft.ErrMetadataImmutable, class.Id)
+	}
 	bz, err := k.cdc.Marshal(&class)
 	if err != nil {
 		return sdkerrors.Wrap(err, "Marshal nft.Class failed")
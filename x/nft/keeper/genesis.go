@@ -1,9 +1,9 @@
 package keeper
 
 import (
-	"sort"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/util/collection"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
@@ -27,15 +27,43 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
 			}
 		}
 	}
+	for _, metadata := range data.ClassMetadata {
+		if !k.HasClass(ctx, metadata.ClassId) {
+			panic(sdkerrors.Wrap(nft.ErrClassNotExists, metadata.ClassId))
+		}
+		if err := k.setClassMetadata(ctx, *metadata); err != nil {
+			panic(err)
+		}
+	}
+	for _, metadata := range data.NftMetadata {
+		if !k.HasNFT(ctx, metadata.ClassId, metadata.Id) {
+			panic(sdkerrors.Wrap(nft.ErrNFTNotExists, metadata.Id))
+		}
+		k.setNFTAttributes(ctx, metadata.ClassId, metadata.Id, metadata.Attributes)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
 	classes := k.GetClasses(ctx)
 	nftMap := make(map[string][]*nft.NFT)
+	var classMetadata []*nft.ClassMetadata
+	var nftMetadata []*nft.NFTMetadata
 	for _, class := range classes {
+		if metadata, has := k.GetClassMetadata(ctx, class.Id); has {
+			classMetadata = append(classMetadata, &metadata)
+		}
+
 		nfts := k.GetNFTsOfClass(ctx, class.Id)
 		for i, n := range nfts {
+			if attrs := k.GetNFTAttributes(ctx, n.ClassId, n.Id); len(attrs) > 0 {
+				nftMetadata = append(nftMetadata, &nft.NFTMetadata{
+					ClassId:    n.ClassId,
+					Id:         n.Id,
+					Attributes: attrs,
+				})
+			}
+
 			owner := k.GetOwner(ctx, n.ClassId, n.Id)
 			nftArr, ok := nftMap[owner.String()]
 			if !ok {
@@ -45,21 +73,17 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
 		}
 	}
 
-	owners := make([]string, 0, len(nftMap))
-	for owner := range nftMap {
-		owners = append(owners, owner)
-	}
-	sort.Strings(owners)
-
 	entries := make([]*nft.Entry, 0, len(nftMap))
-	for _, owner := range owners {
+	for _, owner := range collection.SortedKeys(nftMap) {
 		entries = append(entries, &nft.Entry{
 			Owner: owner,
 			Nfts:  nftMap[owner],
 		})
 	}
 	return &nft.GenesisState{
-		Classes: classes,
-		Entries: entries,
+		Classes:       classes,
+		Entries:       entries,
+		ClassMetadata: classMetadata,
+		NftMetadata:   nftMetadata,
 	}
 }
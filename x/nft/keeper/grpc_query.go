@@ -200,3 +200,80 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// ClassMetadata return the extended metadata of an NFT class
+func (k Keeper) ClassMetadata(goCtx context.Context, r *nft.QueryClassMetadataRequest) (*nft.QueryClassMetadataResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	metadata, has := k.GetClassMetadata(ctx, r.ClassId)
+	if !has {
+		return nil, sdkerrors.ErrNotFound.Wrapf("not found class metadata: %s", r.ClassId)
+	}
+	return &nft.QueryClassMetadataResponse{Metadata: &metadata}, nil
+}
+
+// NFTAttributes return the structured attributes of an NFT, ordered by key
+func (k Keeper) NFTAttributes(goCtx context.Context, r *nft.QueryNFTAttributesRequest) (*nft.QueryNFTAttributesResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, err
+	}
+	if err := nft.ValidateNFTID(r.Id); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.HasNFT(ctx, r.ClassId, r.Id) {
+		return nil, nft.ErrNFTNotExists.Wrapf("not found nft: class: %s, id: %s", r.ClassId, r.Id)
+	}
+
+	var attrs []nft.Attribute
+	attrStore := k.getNFTAttributeStore(ctx, r.ClassId, r.Id)
+	pageRes, err := query.Paginate(attrStore, r.Pagination, func(key []byte, value []byte) error {
+		attrs = append(attrs, nft.Attribute{Key: string(key), Value: string(value)})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nft.QueryNFTAttributesResponse{
+		Attributes: attrs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// RoyaltyInfo return the royalty receiver and amount owed for a sale of an NFT of the given class, similar to royaltyInfo in ERC2981
+func (k Keeper) RoyaltyInfo(goCtx context.Context, r *nft.QueryRoyaltyInfoRequest) (*nft.QueryRoyaltyInfoResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, err
+	}
+	if err := r.SalePrice.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid sale price: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	receiver, amount, err := k.GetRoyaltyInfo(ctx, r.ClassId, r.SalePrice)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &nft.QueryRoyaltyInfoResponse{Amount: amount}
+	if receiver != nil {
+		res.Receiver = receiver.String()
+	}
+	return res, nil
+}
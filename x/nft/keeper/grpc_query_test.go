@@ -8,7 +8,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
 )
 
 func TestGRPCQuery(t *testing.T) {
@@ -593,3 +596,137 @@ func (s *TestSuite) TestClasses() {
 		})
 	}
 }
+
+func (s *TestSuite) TestQueryNFTAttributes() {
+	var req *nft.QueryNFTAttributesRequest
+	expAttrs := []nft.Attribute{
+		{Key: "color", Value: "red"},
+		{Key: "eyes", Value: "green"},
+	}
+	testCases := []struct {
+		msg      string
+		malleate func(index int, require *require.Assertions)
+		expError string
+		postTest func(index int, require *require.Assertions, res *nft.QueryNFTAttributesResponse)
+	}{
+		{
+			"fail empty ClassId",
+			func(index int, require *require.Assertions) {
+				req = &nft.QueryNFTAttributesRequest{}
+			},
+			"invalid class id",
+			func(index int, require *require.Assertions, res *nft.QueryNFTAttributesResponse) {},
+		},
+		{
+			"fail nft not exist",
+			func(index int, require *require.Assertions) {
+				req = &nft.QueryNFTAttributesRequest{
+					ClassId: testClassID,
+					Id:      testID,
+				}
+			},
+			"not found nft",
+			func(index int, require *require.Assertions, res *nft.QueryNFTAttributesResponse) {},
+		},
+		{
+			"success",
+			func(index int, require *require.Assertions) {
+				s.TestMint()
+				err := keeper.NewMetadataKeeper(s.app.NFTKeeper).SetNFTAttributes(s.ctx, testClassID, testID, expAttrs)
+				require.NoError(err)
+				req = &nft.QueryNFTAttributesRequest{
+					ClassId:    testClassID,
+					Id:         testID,
+					Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+				}
+			},
+			"",
+			func(index int, require *require.Assertions, res *nft.QueryNFTAttributesResponse) {
+				require.Equal(expAttrs[:1], res.Attributes, "the error occurred on:%d", index)
+				require.Equal(uint64(2), res.Pagination.Total, "the error occurred on:%d", index)
+			},
+		},
+	}
+	for index, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			require := s.Require()
+			tc.malleate(index, require)
+			result, err := s.queryClient.NFTAttributes(gocontext.Background(), req)
+			if tc.expError == "" {
+				require.NoError(err)
+			} else {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expError)
+			}
+			tc.postTest(index, require, result)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryRoyaltyInfo() {
+	var req *nft.QueryRoyaltyInfoRequest
+	salePrice := sdk.NewInt64Coin("stake", 1000)
+	testCases := []struct {
+		msg      string
+		malleate func(index int, require *require.Assertions)
+		expError string
+		postTest func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse)
+	}{
+		{
+			"fail invalid sale price",
+			func(index int, require *require.Assertions) {
+				req = &nft.QueryRoyaltyInfoRequest{ClassId: testClassID}
+			},
+			"invalid sale price",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse) {},
+		},
+		{
+			"fail class not exist",
+			func(index int, require *require.Assertions) {
+				req = &nft.QueryRoyaltyInfoRequest{ClassId: testClassID, SalePrice: salePrice}
+			},
+			"nft class does not exist",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse) {},
+		},
+		{
+			"success no royalty",
+			func(index int, require *require.Assertions) {
+				s.TestSaveClass()
+			},
+			"",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse) {
+				require.Empty(res.Receiver, "the error occurred on:%d", index)
+				require.Equal(sdk.NewInt64Coin("stake", 0), res.Amount, "the error occurred on:%d", index)
+			},
+		},
+		{
+			"success",
+			func(index int, require *require.Assertions) {
+				err := keeper.NewMetadataKeeper(s.app.NFTKeeper).SetClassMetadata(s.ctx, nft.ClassMetadata{
+					ClassId: testClassID,
+					Royalty: &nft.Royalty{Receiver: s.addrs[1].String(), Rate: sdk.NewDecWithPrec(25, 3)},
+				})
+				require.NoError(err)
+			},
+			"",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse) {
+				require.Equal(s.addrs[1].String(), res.Receiver, "the error occurred on:%d", index)
+				require.Equal(sdk.NewInt64Coin("stake", 25), res.Amount, "the error occurred on:%d", index)
+			},
+		},
+	}
+	for index, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			require := s.Require()
+			tc.malleate(index, require)
+			result, err := s.queryClient.RoyaltyInfo(gocontext.Background(), req)
+			if tc.expError == "" {
+				require.NoError(err)
+			} else {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expError)
+			}
+			tc.postTest(index, require, result)
+		})
+	}
+}
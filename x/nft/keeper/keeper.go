@@ -11,11 +11,14 @@ type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 	bk       nft.BankKeeper
+
+	// the address capable of executing a MsgBatchMint. Usually the gov module account
+	authority string
 }
 
 // NewKeeper creates a new nft Keeper instance
 func NewKeeper(key storetypes.StoreKey,
-	cdc codec.BinaryCodec, ak nft.AccountKeeper, bk nft.BankKeeper,
+	cdc codec.BinaryCodec, ak nft.AccountKeeper, bk nft.BankKeeper, authority string,
 ) Keeper {
 	// ensure nft module account is set
 	if addr := ak.GetModuleAddress(nft.ModuleName); addr == nil {
@@ -23,8 +26,9 @@ func NewKeeper(key storetypes.StoreKey,
 	}
 
 	return Keeper{
-		cdc:      cdc,
-		storeKey: key,
-		bk:       bk,
+		cdc:       cdc,
+		storeKey:  key,
+		bk:        bk,
+		authority: authority,
 	}
 }
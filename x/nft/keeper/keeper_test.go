@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
 )
 
 const (
@@ -318,12 +319,29 @@ func (s *TestSuite) TestExportGenesis() {
 	err = s.app.NFTKeeper.Mint(s.ctx, expNFT, s.addrs[0])
 	s.Require().NoError(err)
 
+	classMetadata := nft.ClassMetadata{
+		ClassId: testClassID,
+		Royalty: &nft.Royalty{Receiver: s.addrs[1].String(), Rate: sdk.NewDecWithPrec(5, 2)},
+	}
+	nftMetadata := nft.NFTMetadata{
+		ClassId:    testClassID,
+		Id:         testID,
+		Attributes: []nft.Attribute{{Key: "color", Value: "red"}},
+	}
+	metadataKeeper := keeper.NewMetadataKeeper(s.app.NFTKeeper)
+	err = metadataKeeper.SetNFTAttributes(s.ctx, testClassID, testID, nftMetadata.Attributes)
+	s.Require().NoError(err)
+	err = metadataKeeper.SetClassMetadata(s.ctx, classMetadata)
+	s.Require().NoError(err)
+
 	expGenesis := &nft.GenesisState{
 		Classes: []*nft.Class{&class},
 		Entries: []*nft.Entry{{
 			Owner: s.addrs[0].String(),
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		ClassMetadata: []*nft.ClassMetadata{&classMetadata},
+		NftMetadata:   []*nft.NFTMetadata{&nftMetadata},
 	}
 	genesis := s.app.NFTKeeper.ExportGenesis(s.ctx)
 	s.Require().Equal(expGenesis, genesis)
@@ -343,12 +361,23 @@ func (s *TestSuite) TestInitGenesis() {
 		Id:      testID,
 		Uri:     testURI,
 	}
+	expClassMetadata := nft.ClassMetadata{
+		ClassId: testClassID,
+		Royalty: &nft.Royalty{Receiver: s.addrs[1].String(), Rate: sdk.NewDecWithPrec(5, 2)},
+	}
+	expAttrs := []nft.Attribute{{Key: "color", Value: "red"}}
 	expGenesis := &nft.GenesisState{
 		Classes: []*nft.Class{&expClass},
 		Entries: []*nft.Entry{{
 			Owner: s.addrs[0].String(),
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		ClassMetadata: []*nft.ClassMetadata{&expClassMetadata},
+		NftMetadata: []*nft.NFTMetadata{{
+			ClassId:    testClassID,
+			Id:         testID,
+			Attributes: expAttrs,
+		}},
 	}
 	s.app.NFTKeeper.InitGenesis(s.ctx, expGenesis)
 
@@ -360,4 +389,127 @@ func (s *TestSuite) TestInitGenesis() {
 	actNFT, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
 	s.Require().True(has)
 	s.Require().EqualValues(expNFT, actNFT)
+
+	actClassMetadata, has := s.app.NFTKeeper.GetClassMetadata(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(expClassMetadata, actClassMetadata)
+	s.Require().Equal(expAttrs, s.app.NFTKeeper.GetNFTAttributes(s.ctx, testClassID, testID))
+}
+
+func (s *TestSuite) TestClassMetadata() {
+	class := nft.Class{
+		Id:  testClassID,
+		Uri: testClassURI,
+	}
+	err := s.app.NFTKeeper.SaveClass(s.ctx, class)
+	s.Require().NoError(err)
+
+	metadataKeeper := keeper.NewMetadataKeeper(s.app.NFTKeeper)
+
+	// class does not exist
+	err = metadataKeeper.SetClassMetadata(s.ctx, nft.ClassMetadata{ClassId: "kitty2"})
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+
+	// royalty rate above 1
+	err = metadataKeeper.SetClassMetadata(s.ctx, nft.ClassMetadata{
+		ClassId: testClassID,
+		Royalty: &nft.Royalty{Receiver: s.addrs[1].String(), Rate: sdk.NewDec(2)},
+	})
+	s.Require().ErrorIs(err, nft.ErrInvalidMetadata)
+
+	expMetadata := nft.ClassMetadata{
+		ClassId:         testClassID,
+		MutableMetadata: true,
+		Royalty:         &nft.Royalty{Receiver: s.addrs[1].String(), Rate: sdk.NewDecWithPrec(5, 2)},
+	}
+	err = metadataKeeper.SetClassMetadata(s.ctx, expMetadata)
+	s.Require().NoError(err)
+
+	actual, has := s.app.NFTKeeper.GetClassMetadata(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(expMetadata, actual)
+
+	receiver, amount, err := s.app.NFTKeeper.GetRoyaltyInfo(s.ctx, testClassID, sdk.NewInt64Coin("stake", 1010))
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[1], receiver)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 50), amount)
+
+	// freeze the metadata
+	expMetadata.MutableMetadata = false
+	expMetadata.Royalty = nil
+	err = metadataKeeper.SetClassMetadata(s.ctx, expMetadata)
+	s.Require().NoError(err)
+
+	receiver, amount, err = s.app.NFTKeeper.GetRoyaltyInfo(s.ctx, testClassID, sdk.NewInt64Coin("stake", 1010))
+	s.Require().NoError(err)
+	s.Require().Nil(receiver)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 0), amount)
+
+	err = metadataKeeper.SetClassMetadata(s.ctx, expMetadata)
+	s.Require().ErrorIs(err, nft.ErrMetadataImmutable)
+
+	class.Uri = "updated"
+	err = s.app.NFTKeeper.UpdateClass(s.ctx, class)
+	s.Require().ErrorIs(err, nft.ErrMetadataImmutable)
+}
+
+func (s *TestSuite) TestNFTAttributes() {
+	class := nft.Class{
+		Id:  testClassID,
+		Uri: testClassURI,
+	}
+	err := s.app.NFTKeeper.SaveClass(s.ctx, class)
+	s.Require().NoError(err)
+
+	myNFT := nft.NFT{
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     testURI,
+	}
+	err = s.app.NFTKeeper.Mint(s.ctx, myNFT, s.addrs[0])
+	s.Require().NoError(err)
+
+	metadataKeeper := keeper.NewMetadataKeeper(s.app.NFTKeeper)
+
+	// nft does not exist
+	err = metadataKeeper.SetNFTAttributes(s.ctx, testClassID, "kitty2", nil)
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+
+	// duplicate key
+	err = metadataKeeper.SetNFTAttributes(s.ctx, testClassID, testID, []nft.Attribute{
+		{Key: "color", Value: "red"},
+		{Key: "color", Value: "blue"},
+	})
+	s.Require().ErrorIs(err, nft.ErrInvalidMetadata)
+
+	err = metadataKeeper.SetNFTAttributes(s.ctx, testClassID, testID, []nft.Attribute{
+		{Key: "eyes", Value: "green"},
+		{Key: "color", Value: "red"},
+	})
+	s.Require().NoError(err)
+
+	// attributes are replaced and ordered by key
+	expAttrs := []nft.Attribute{
+		{Key: "color", Value: "blue"},
+		{Key: "tail", Value: "long"},
+	}
+	err = metadataKeeper.SetNFTAttributes(s.ctx, testClassID, testID, []nft.Attribute{expAttrs[1], expAttrs[0]})
+	s.Require().NoError(err)
+	s.Require().Equal(expAttrs, s.app.NFTKeeper.GetNFTAttributes(s.ctx, testClassID, testID))
+
+	// freeze the metadata, attributes are already set
+	err = metadataKeeper.SetClassMetadata(s.ctx, nft.ClassMetadata{ClassId: testClassID})
+	s.Require().NoError(err)
+
+	err = metadataKeeper.SetNFTAttributes(s.ctx, testClassID, testID, nil)
+	s.Require().ErrorIs(err, nft.ErrMetadataImmutable)
+
+	myNFT.Uri = "updated"
+	err = s.app.NFTKeeper.Update(s.ctx, myNFT)
+	s.Require().ErrorIs(err, nft.ErrMetadataImmutable)
+
+	// attributes are removed on burn
+	err = s.app.NFTKeeper.Burn(s.ctx, testClassID, testID)
+	s.Require().NoError(err)
+	s.Require().Empty(s.app.NFTKeeper.GetNFTAttributes(s.ctx, testClassID, testID))
 }
@@ -15,6 +15,8 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	ClassMetadataKey     = []byte{0x06}
+	NFTAttributeKey      = []byte{0x07}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -104,3 +106,27 @@ func ownerStoreKey(classID, nftID string) []byte {
 	copy(key[len(OwnerKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
 	return key
 }
+
+// classMetadataStoreKey returns the byte representation of the class metadata key
+func classMetadataStoreKey(classID string) []byte {
+	key := make([]byte, len(ClassMetadataKey)+len(classID))
+	copy(key, ClassMetadataKey)
+	copy(key[len(ClassMetadataKey):], classID)
+	return key
+}
+
+// nftAttributeStoreKey returns the prefix of the attributes of a nft
+// Items are stored with the following key: values
+// 0x07<classID><Delimiter(1 Byte)><nftID><Delimiter(1 Byte)><attributeKey>
+func nftAttributeStoreKey(classID, nftID string) []byte {
+	classIDBz := conv.UnsafeStrToBytes(classID)
+	nftIDBz := conv.UnsafeStrToBytes(nftID)
+
+	var key = make([]byte, len(NFTAttributeKey)+len(classIDBz)+len(Delimiter)+len(nftIDBz)+len(Delimiter))
+	copy(key, NFTAttributeKey)
+	copy(key[len(NFTAttributeKey):], classIDBz)
+	copy(key[len(NFTAttributeKey)+len(classIDBz):], Delimiter)
+	copy(key[len(NFTAttributeKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
+	copy(key[len(NFTAttributeKey)+len(classIDBz)+len(Delimiter)+len(nftIDBz):], Delimiter)
+	return key
+}
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// MetadataKeeper is an optional extension of Keeper managing the extended metadata of classes and nfts:
+// structured nft attributes, mutable or immutable metadata and per-class royalties.
+// It shares the store of the Keeper it extends, so the extended metadata can always be queried.
+type MetadataKeeper struct {
+	Keeper
+}
+
+// NewMetadataKeeper returns a MetadataKeeper extending the given Keeper
+func NewMetadataKeeper(k Keeper) MetadataKeeper {
+	return MetadataKeeper{Keeper: k}
+}
+
+// SetClassMetadata defines a method for setting the extended metadata of an exist nft class
+// Note: When the upper module uses this method, it needs to authenticate the class
+func (k MetadataKeeper) SetClassMetadata(ctx sdk.Context, metadata nft.ClassMetadata) error {
+	if err := metadata.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !k.HasClass(ctx, metadata.ClassId) {
+		return sdkerrors.Wrap(nft.ErrClassNotExists, metadata.ClassId)
+	}
+
+	if k.isMetadataFrozen(ctx, metadata.ClassId) {
+		return sdkerrors.Wrap(nft.ErrMetadataImmutable, metadata.ClassId)
+	}
+
+	if err := k.setClassMetadata(ctx, metadata); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventSetClassMetadata{
+		ClassId: metadata.ClassId,
+	})
+	return nil
+}
+
+// SetNFTAttributes defines a method for replacing the attributes of an exist nft.
+// If the metadata of the class is immutable, the attributes can only be set once.
+// Note: When the upper module uses this method, it needs to authenticate nft
+func (k MetadataKeeper) SetNFTAttributes(ctx sdk.Context, classID, nftID string, attrs []nft.Attribute) error {
+	if err := nft.ValidateAttributes(attrs); err != nil {
+		return err
+	}
+
+	if !k.HasClass(ctx, classID) {
+		return sdkerrors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if !k.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	if k.isMetadataFrozen(ctx, classID) && len(k.GetNFTAttributes(ctx, classID, nftID)) > 0 {
+		return sdkerrors.Wrapf(nft.ErrMetadataImmutable, "attributes of nft %s already set", nftID)
+	}
+
+	k.deleteNFTAttributes(ctx, classID, nftID)
+	k.setNFTAttributes(ctx, classID, nftID, attrs)
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventSetNFTAttributes{
+		ClassId: classID,
+		Id:      nftID,
+	})
+	return nil
+}
+
+// GetClassMetadata returns the extended metadata of the specified classID
+func (k Keeper) GetClassMetadata(ctx sdk.Context, classID string) (nft.ClassMetadata, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(classMetadataStoreKey(classID))
+
+	var metadata nft.ClassMetadata
+	if len(bz) == 0 {
+		return metadata, false
+	}
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return metadata, true
+}
+
+// GetNFTAttributes returns all attributes of the specified nft, ordered by key
+func (k Keeper) GetNFTAttributes(ctx sdk.Context, classID, nftID string) (attrs []nft.Attribute) {
+	attrStore := k.getNFTAttributeStore(ctx, classID, nftID)
+	iterator := attrStore.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		attrs = append(attrs, nft.Attribute{
+			Key:   string(iterator.Key()),
+			Value: string(iterator.Value()),
+		})
+	}
+	return attrs
+}
+
+// GetRoyaltyInfo returns the royalty receiver and the amount owed to it for a sale of a nft of the specified
+// class at the given price, similar to royaltyInfo in ERC2981. If the class has no royalty configured,
+// the receiver is nil and the amount is zero.
+func (k Keeper) GetRoyaltyInfo(ctx sdk.Context, classID string, salePrice sdk.Coin) (sdk.AccAddress, sdk.Coin, error) {
+	if !k.HasClass(ctx, classID) {
+		return nil, sdk.Coin{}, sdkerrors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	metadata, has := k.GetClassMetadata(ctx, classID)
+	if !has || metadata.Royalty == nil {
+		return nil, sdk.NewCoin(salePrice.Denom, sdk.ZeroInt()), nil
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(metadata.Royalty.Receiver)
+	if err != nil {
+		return nil, sdk.Coin{}, err
+	}
+	return receiver, metadata.Royalty.Amount(salePrice), nil
+}
+
+// isMetadataFrozen returns whether the class has immutable extended metadata
+func (k Keeper) isMetadataFrozen(ctx sdk.Context, classID string) bool {
+	metadata, has := k.GetClassMetadata(ctx, classID)
+	return has && !metadata.MutableMetadata
+}
+
+func (k Keeper) setClassMetadata(ctx sdk.Context, metadata nft.ClassMetadata) error {
+	bz, err := k.cdc.Marshal(&metadata)
+	if err != nil {
+		return sdkerrors.Wrap(err, "Marshal nft.ClassMetadata failed")
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(classMetadataStoreKey(metadata.ClassId), bz)
+	return nil
+}
+
+func (k Keeper) setNFTAttributes(ctx sdk.Context, classID, nftID string, attrs []nft.Attribute) {
+	attrStore := k.getNFTAttributeStore(ctx, classID, nftID)
+	for _, attr := range attrs {
+		attrStore.Set([]byte(attr.Key), []byte(attr.Value))
+	}
+}
+
+func (k Keeper) deleteNFTAttributes(ctx sdk.Context, classID, nftID string) {
+	attrStore := k.getNFTAttributeStore(ctx, classID, nftID)
+	iterator := attrStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		attrStore.Delete(key)
+	}
+}
+
+func (k Keeper) getNFTAttributeStore(ctx sdk.Context, classID, nftID string) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, nftAttributeStoreKey(classID, nftID))
+}
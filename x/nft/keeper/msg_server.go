@@ -18,25 +18,137 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 		return nil, err
 	}
 
-	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
-	if !owner.Equals(sender) {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, msg.Id)
+	if err := k.send(ctx, sender, msg.ClassId, msg.Id, msg.Receiver); err != nil {
+		return nil, err
+	}
+	return &nft.MsgSendResponse{}, nil
+}
+
+// BatchMint implement BatchMint method of the types.MsgServer.
+func (k Keeper) BatchMint(goCtx context.Context, msg *nft.MsgBatchMint) (*nft.MsgBatchMintResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	results := make([]nft.BatchItemResult, len(msg.Entries))
+	for i, entry := range msg.Entries {
+		results[i] = nft.BatchItemResult{ClassId: entry.Nft.ClassId, Id: entry.Nft.Id}
+	}
+
+	err := k.execBatch(ctx, msg, msg.Atomic, results, func(ctx sdk.Context, i int) error {
+		receiver, err := sdk.AccAddressFromBech32(msg.Entries[i].Receiver)
+		if err != nil {
+			return err
+		}
+		return k.Mint(ctx, msg.Entries[i].Nft, receiver)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nft.MsgBatchMintResponse{Results: results}, nil
+}
+
+// BatchSend implement BatchSend method of the types.MsgServer.
+func (k Keeper) BatchSend(goCtx context.Context, msg *nft.MsgBatchSend) (*nft.MsgBatchSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]nft.BatchItemResult, len(msg.Entries))
+	for i, entry := range msg.Entries {
+		results[i] = nft.BatchItemResult{ClassId: entry.ClassId, Id: entry.Id}
+	}
+
+	err = k.execBatch(ctx, msg, msg.Atomic, results, func(ctx sdk.Context, i int) error {
+		entry := msg.Entries[i]
+		return k.send(ctx, sender, entry.ClassId, entry.Id, entry.Receiver)
+	})
+	if err != nil {
+		return nil, err
 	}
+	return &nft.MsgBatchSendResponse{Results: results}, nil
+}
 
-	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+// BatchBurn implement BatchBurn method of the types.MsgServer.
+func (k Keeper) BatchBurn(goCtx context.Context, msg *nft.MsgBatchBurn) (*nft.MsgBatchBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := k.Transfer(ctx, msg.ClassId, msg.Id, receiver); err != nil {
+	results := make([]nft.BatchItemResult, len(msg.Entries))
+	for i, entry := range msg.Entries {
+		results[i] = nft.BatchItemResult{ClassId: entry.ClassId, Id: entry.Id}
+	}
+
+	err = k.execBatch(ctx, msg, msg.Atomic, results, func(ctx sdk.Context, i int) error {
+		entry := msg.Entries[i]
+		if !k.GetOwner(ctx, entry.ClassId, entry.Id).Equals(owner) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", owner, entry.Id)
+		}
+		return k.Burn(ctx, entry.ClassId, entry.Id)
+	})
+	if err != nil {
 		return nil, err
 	}
+	return &nft.MsgBatchBurnResponse{Results: results}, nil
+}
+
+// send transfers a nft owned by the sender to the receiver and emits an EventSend
+func (k Keeper) send(ctx sdk.Context, sender sdk.AccAddress, classID, nftID, receiverAddr string) error {
+	owner := k.GetOwner(ctx, classID, nftID)
+	if !owner.Equals(sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, nftID)
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(receiverAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Transfer(ctx, classID, nftID, receiver); err != nil {
+		return err
+	}
 
 	ctx.EventManager().EmitTypedEvent(&nft.EventSend{
-		ClassId:  msg.ClassId,
-		Id:       msg.Id,
-		Sender:   msg.Sender,
-		Receiver: msg.Receiver,
+		ClassId:  classID,
+		Id:       nftID,
+		Sender:   sender.String(),
+		Receiver: receiverAddr,
 	})
-	return &nft.MsgSendResponse{}, nil
+	return nil
+}
+
+// execBatch runs fn for every item of a batch Msg. In atomic mode the first failing item fails the
+// whole Msg. Otherwise every item runs in its own cached context: the state changes and events of a
+// failing item are discarded, its error is recorded in results and an EventBatchItemFailed is emitted.
+func (k Keeper) execBatch(ctx sdk.Context, msg sdk.Msg, atomic bool, results []nft.BatchItemResult, fn func(ctx sdk.Context, i int) error) error {
+	for i := range results {
+		if atomic {
+			if err := fn(ctx, i); err != nil {
+				return sdkerrors.Wrapf(err, "entry %d", i)
+			}
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := fn(cacheCtx, i); err != nil {
+			results[i].Error = err.Error()
+			ctx.EventManager().EmitTypedEvent(&nft.EventBatchItemFailed{
+				MsgTypeUrl: sdk.MsgTypeURL(msg),
+				Index:      uint32(i),
+				ClassId:    results[i].ClassId,
+				Id:         results[i].Id,
+				Error:      results[i].Error,
+			})
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	return nil
 }
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+func (s *TestSuite) TestBatchMint() {
+	err := s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID})
+	s.Require().NoError(err)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	entries := []nft.MintEntry{
+		{Nft: nft.NFT{ClassId: testClassID, Id: "kitty1"}, Receiver: s.addrs[0].String()},
+		{Nft: nft.NFT{ClassId: "kitty2", Id: "kitty2"}, Receiver: s.addrs[0].String()},
+		{Nft: nft.NFT{ClassId: testClassID, Id: "kitty3"}, Receiver: s.addrs[1].String()},
+	}
+
+	// unauthorized
+	_, err = s.app.NFTKeeper.BatchMint(s.ctx, &nft.MsgBatchMint{Authority: s.addrs[0].String(), Entries: entries})
+	s.Require().Error(err)
+
+	// atomic, the second entry fails
+	cacheCtx, _ := s.ctx.CacheContext()
+	_, err = s.app.NFTKeeper.BatchMint(cacheCtx, &nft.MsgBatchMint{Authority: authority, Entries: entries, Atomic: true})
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+	s.Require().Contains(err.Error(), "entry 1")
+
+	// best-effort
+	res, err := s.app.NFTKeeper.BatchMint(s.ctx, &nft.MsgBatchMint{Authority: authority, Entries: entries})
+	s.Require().NoError(err)
+	s.Require().Len(res.Results, 3)
+	s.Require().Empty(res.Results[0].Error)
+	s.Require().Contains(res.Results[1].Error, nft.ErrClassNotExists.Error())
+	s.Require().Empty(res.Results[2].Error)
+
+	s.Require().Equal(s.addrs[0], s.app.NFTKeeper.GetOwner(s.ctx, testClassID, "kitty1"))
+	s.Require().Equal(s.addrs[1], s.app.NFTKeeper.GetOwner(s.ctx, testClassID, "kitty3"))
+	s.Require().Equal(uint64(2), s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID))
+
+	var minted, failed int
+	for _, event := range s.ctx.EventManager().Events() {
+		switch event.Type {
+		case "cosmos.nft.v1beta1.EventMint":
+			minted++
+		case "cosmos.nft.v1beta1.EventBatchItemFailed":
+			failed++
+		}
+	}
+	s.Require().Equal(2, minted)
+	s.Require().Equal(1, failed)
+}
+
+func (s *TestSuite) TestBatchSend() {
+	s.mintKitties(3)
+
+	entries := []nft.SendEntry{
+		{ClassId: testClassID, Id: "kitty1", Receiver: s.addrs[1].String()},
+		{ClassId: testClassID, Id: "kitty2", Receiver: s.addrs[1].String()},
+		{ClassId: testClassID, Id: "kitty3", Receiver: s.addrs[1].String()},
+	}
+
+	// kitty2 is not owned by the sender anymore
+	err := s.app.NFTKeeper.Transfer(s.ctx, testClassID, "kitty2", s.addrs[2])
+	s.Require().NoError(err)
+
+	cacheCtx, _ := s.ctx.CacheContext()
+	_, err = s.app.NFTKeeper.BatchSend(cacheCtx, &nft.MsgBatchSend{Sender: s.addrs[0].String(), Entries: entries, Atomic: true})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "entry 1")
+	s.Require().Equal(s.addrs[0], s.app.NFTKeeper.GetOwner(s.ctx, testClassID, "kitty1"))
+
+	res, err := s.app.NFTKeeper.BatchSend(s.ctx, &nft.MsgBatchSend{Sender: s.addrs[0].String(), Entries: entries})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Results[0].Error)
+	s.Require().Contains(res.Results[1].Error, "is not the owner of nft kitty2")
+	s.Require().Empty(res.Results[2].Error)
+
+	s.Require().Equal(s.addrs[1], s.app.NFTKeeper.GetOwner(s.ctx, testClassID, "kitty1"))
+	s.Require().Equal(s.addrs[2], s.app.NFTKeeper.GetOwner(s.ctx, testClassID, "kitty2"))
+	s.Require().Equal(s.addrs[1], s.app.NFTKeeper.GetOwner(s.ctx, testClassID, "kitty3"))
+}
+
+func (s *TestSuite) TestBatchBurn() {
+	s.mintKitties(2)
+
+	entries := []nft.BurnEntry{
+		{ClassId: testClassID, Id: "kitty1"},
+		{ClassId: testClassID, Id: "kitty9"},
+		{ClassId: testClassID, Id: "kitty2"},
+	}
+
+	cacheCtx, _ := s.ctx.CacheContext()
+	_, err := s.app.NFTKeeper.BatchBurn(cacheCtx, &nft.MsgBatchBurn{Owner: s.addrs[0].String(), Entries: entries, Atomic: true})
+	s.Require().Error(err)
+	s.Require().Equal(uint64(2), s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID))
+
+	res, err := s.app.NFTKeeper.BatchBurn(s.ctx, &nft.MsgBatchBurn{Owner: s.addrs[0].String(), Entries: entries})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Results[0].Error)
+	s.Require().NotEmpty(res.Results[1].Error)
+	s.Require().Empty(res.Results[2].Error)
+	s.Require().Equal(uint64(0), s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID))
+}
+
+// mintKitties saves the test class and mints n nfts kitty1..kittyn to the first address
+func (s *TestSuite) mintKitties(n int) {
+	err := s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID})
+	s.Require().NoError(err)
+	for i := 1; i <= n; i++ {
+		err = s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: fmt.Sprintf("kitty%d", i)}, s.addrs[0])
+		s.Require().NoError(err)
+	}
+}
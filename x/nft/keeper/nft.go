@@ -43,6 +43,7 @@ func (k Keeper) Burn(ctx sdk.Context, classID string, nftID string) error {
 	owner := k.GetOwner(ctx, classID, nftID)
 	nftStore := k.getNFTStore(ctx, classID)
 	nftStore.Delete([]byte(nftID))
+	k.deleteNFTAttributes(ctx, classID, nftID)
 
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.decrTotalSupply(ctx, classID)
@@ -64,6 +65,10 @@ func (k Keeper) Update(ctx sdk.Context, token nft.NFT) error {
 	if !k.HasNFT(ctx, token.ClassId, token.Id) {
 		return sdkerrors.Wrap(nft.ErrNFTNotExists, token.Id)
 	}
+
+	if k.isMetadataFrozen(ctx, token.ClassId) {
+		return sdkerrors.Wrap(nft.ErrMetadataImmutable, token.ClassId)
+	}
 	k.setNFT(ctx, token)
 	return nil
 }
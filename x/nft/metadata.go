@@ -0,0 +1,59 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic checks that the royalty has a valid receiver and a rate between 0 and 1
+func (r Royalty) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(r.Receiver); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "invalid royalty receiver: %s", err)
+	}
+	if r.Rate.IsNil() || !r.Rate.IsBetween(sdk.ZeroDec(), sdk.OneDec(), true) {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "royalty rate must be between 0 and 1, got %s", r.Rate)
+	}
+	return nil
+}
+
+// Amount returns the royalty owed on a sale at the given price, rounded down
+func (r Royalty) Amount(salePrice sdk.Coin) sdk.Coin {
+	return sdk.NewCoin(salePrice.Denom, r.Rate.MulInt(salePrice.Amount).TruncateInt())
+}
+
+// ValidateBasic checks the class id and the royalty of the class metadata
+func (m ClassMetadata) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return err
+	}
+	if m.Royalty != nil {
+		return m.Royalty.ValidateBasic()
+	}
+	return nil
+}
+
+// ValidateBasic checks the class id, the nft id and the attributes of the nft metadata
+func (m NFTMetadata) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return err
+	}
+	if err := ValidateNFTID(m.Id); err != nil {
+		return err
+	}
+	return ValidateAttributes(m.Attributes)
+}
+
+// ValidateAttributes checks that every attribute has a non-empty key and that keys are unique
+func ValidateAttributes(attrs []Attribute) error {
+	keys := make(map[string]struct{}, len(attrs))
+	for _, attr := range attrs {
+		if len(attr.Key) == 0 {
+			return sdkerrors.Wrap(ErrInvalidMetadata, "empty attribute key")
+		}
+		if _, ok := keys[attr.Key]; ok {
+			return sdkerrors.Wrapf(ErrInvalidMetadata, "duplicate attribute key: %s", attr.Key)
+		}
+		keys[attr.Key] = struct{}{}
+	}
+	return nil
+}
@@ -7,11 +7,17 @@ import (
 
 const (
 	// TypeMsgSend nft message types
-	TypeMsgSend = "send"
+	TypeMsgSend      = "send"
+	TypeMsgBatchMint = "batch_mint"
+	TypeMsgBatchSend = "batch_send"
+	TypeMsgBatchBurn = "batch_burn"
 )
 
 var (
 	_ sdk.Msg = &MsgSend{}
+	_ sdk.Msg = &MsgBatchMint{}
+	_ sdk.Msg = &MsgBatchSend{}
+	_ sdk.Msg = &MsgBatchBurn{}
 )
 
 // ValidateBasic implements the Msg.ValidateBasic method.
@@ -41,3 +47,93 @@ func (m MsgSend) GetSigners() []sdk.AccAddress {
 	signer, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{signer}
 }
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgBatchMint) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", m.Authority)
+	}
+
+	if len(m.Entries) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "entries cannot be empty")
+	}
+	for i, entry := range m.Entries {
+		if err := validateEntry(entry.Nft.ClassId, entry.Nft.Id); err != nil {
+			return sdkerrors.Wrapf(err, "entry %d", i)
+		}
+		if _, err := sdk.AccAddressFromBech32(entry.Receiver); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "entry %d: Invalid receiver address (%s)", i, entry.Receiver)
+		}
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgBatchMint) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgBatchSend) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", m.Sender)
+	}
+
+	if len(m.Entries) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "entries cannot be empty")
+	}
+	for i, entry := range m.Entries {
+		if err := validateEntry(entry.ClassId, entry.Id); err != nil {
+			return sdkerrors.Wrapf(err, "entry %d", i)
+		}
+		if _, err := sdk.AccAddressFromBech32(entry.Receiver); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "entry %d: Invalid receiver address (%s)", i, entry.Receiver)
+		}
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgBatchSend) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgBatchBurn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", m.Owner)
+	}
+
+	if len(m.Entries) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "entries cannot be empty")
+	}
+	for i, entry := range m.Entries {
+		if err := validateEntry(entry.ClassId, entry.Id); err != nil {
+			return sdkerrors.Wrapf(err, "entry %d", i)
+		}
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgBatchBurn) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+// validateEntry checks the class id and the nft id of a batch entry
+func validateEntry(classID, nftID string) error {
+	if err := ValidateClassID(classID); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid class id (%s)", classID)
+	}
+
+	if err := ValidateNFTID(nftID); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", nftID)
+	}
+	return nil
+}
@@ -38,6 +38,13 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			supplyA = sdk.BigEndianToUint64(kvA.Value)
 			supplyB = sdk.BigEndianToUint64(kvB.Value)
 			return fmt.Sprintf("%v\n%v", supplyA, supplyB)
+		case bytes.Equal(kvA.Key[:1], keeper.ClassMetadataKey):
+			var metadataA, metadataB nft.ClassMetadata
+			cdc.MustUnmarshal(kvA.Value, &metadataA)
+			cdc.MustUnmarshal(kvB.Value, &metadataB)
+			return fmt.Sprintf("%v\n%v", metadataA, metadataB)
+		case bytes.Equal(kvA.Key[:1], keeper.NFTAttributeKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
 		default:
 			panic(fmt.Sprintf("invalid nft key %X", kvA.Key))
 		}
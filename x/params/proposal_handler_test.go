@@ -72,8 +72,9 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 				depositParams := suite.app.GovKeeper.GetDepositParams(suite.ctx)
 				defaultPeriod := govv1.DefaultPeriod
 				suite.Require().Equal(govv1.DepositParams{
-					MinDeposit:       sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
-					MaxDepositPeriod: &defaultPeriod,
+					MinDeposit:             sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
+					MaxDepositPeriod:       &defaultPeriod,
+					MinInitialDepositRatio: govv1.DefaultMinInitialDepositRatio.String(),
 				}, depositParams)
 			},
 			false,
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        protocolpool.ModuleName,
+		Short:                      "Querying commands for the protocolpool module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryContinuousFund(),
+		GetCmdQueryContinuousFunds(),
+	)
+	return queryCmd
+}
+
+// GetCmdQueryContinuousFund implements the query continuous-fund command.
+func GetCmdQueryContinuousFund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "continuous-fund [id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query a continuous fund and its remaining budget",
+		Example: fmt.Sprintf(`$ %s query %s continuous-fund 1`, version.AppName, protocolpool.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("id %s not a valid uint, please input a valid continuous fund id", args[0])
+			}
+			queryClient := protocolpool.NewQueryClient(clientCtx)
+			res, err := queryClient.ContinuousFund(cmd.Context(), &protocolpool.QueryContinuousFundRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryContinuousFunds implements the query continuous-funds command.
+func GetCmdQueryContinuousFunds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "continuous-funds",
+		Short:   "query all the active continuous funds",
+		Example: fmt.Sprintf(`$ %s query %s continuous-funds`, version.AppName, protocolpool.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := protocolpool.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.ContinuousFunds(cmd.Context(), &protocolpool.QueryContinuousFundsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "continuous funds")
+	return cmd
+}
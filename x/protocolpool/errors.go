@@ -0,0 +1,13 @@
+package protocolpool
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/protocolpool module sentinel errors
+var (
+	ErrInvalidContinuousFund  = sdkerrors.Register(ModuleName, 2, "invalid continuous fund")
+	ErrContinuousFundNotFound = sdkerrors.Register(ModuleName, 3, "continuous fund not found")
+	ErrInvalidEpoch           = sdkerrors.Register(ModuleName, 4, "invalid epoch identifier")
+	ErrPercentageExceeded     = sdkerrors.Register(ModuleName, 5, "total continuous fund percentage exceeds 100%")
+)
@@ -0,0 +1,74 @@
+package protocolpool
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// HasPercentage returns whether the fund pays a share of the community pool rather than a
+// fixed amount
+func (f ContinuousFund) HasPercentage() bool {
+	return !f.Percentage.IsNil() && !f.Percentage.IsZero()
+}
+
+// RemainingBudget returns the amount the fund can still pay, it is empty if the fund has
+// no max budget
+func (f ContinuousFund) RemainingBudget() sdk.Coins {
+	if f.MaxBudget.Empty() {
+		return sdk.Coins{}
+	}
+	remaining := sdk.Coins{}
+	for _, budget := range f.MaxBudget {
+		if left := budget.Amount.Sub(f.TotalPaid.AmountOf(budget.Denom)); left.IsPositive() {
+			remaining = append(remaining, sdk.NewCoin(budget.Denom, left))
+		}
+	}
+	return remaining
+}
+
+// IsExhausted returns whether the fund has paid its whole max budget
+func (f ContinuousFund) IsExhausted() bool {
+	return !f.MaxBudget.Empty() && f.RemainingBudget().Empty()
+}
+
+// IsExpired returns whether the fund is expired at the given time
+func (f ContinuousFund) IsExpired(blockTime time.Time) bool {
+	return f.Expiry != nil && !blockTime.Before(*f.Expiry)
+}
+
+// Validate performs a stateless validation of the continuous fund
+func (f ContinuousFund) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(f.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", f.Recipient)
+	}
+	if err := validateFundTerms(f.Amount, f.Percentage, f.EpochIdentifier, f.MaxBudget); err != nil {
+		return err
+	}
+	if !f.TotalPaid.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidContinuousFund, "invalid total paid %s", f.TotalPaid)
+	}
+	return nil
+}
+
+func validateFundTerms(amount sdk.Coins, percentage sdk.Dec, epochIdentifier string, maxBudget sdk.Coins) error {
+	hasPercentage := !percentage.IsNil() && !percentage.IsZero()
+	if amount.Empty() == !hasPercentage {
+		return sdkerrors.Wrap(ErrInvalidContinuousFund, "exactly one of amount and percentage must be set")
+	}
+	if !amount.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidContinuousFund, "invalid amount %s", amount)
+	}
+	if hasPercentage && !percentage.IsBetween(sdk.ZeroDec(), sdk.OneDec(), true) {
+		return sdkerrors.Wrapf(ErrInvalidContinuousFund, "percentage must be in (0, 1], got %s", percentage)
+	}
+	if strings.TrimSpace(epochIdentifier) == "" {
+		return sdkerrors.Wrap(ErrInvalidEpoch, "epoch identifier cannot be empty")
+	}
+	if !maxBudget.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidContinuousFund, "invalid max budget %s", maxBudget)
+	}
+	return nil
+}
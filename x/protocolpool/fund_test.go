@@ -0,0 +1,44 @@
+package protocolpool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+func TestContinuousFundValidate(t *testing.T) {
+	recipient := sdk.AccAddress("recipient").String()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	require.NoError(t, protocolpool.ContinuousFund{Recipient: recipient, Amount: coins, EpochIdentifier: "day"}.Validate())
+	require.NoError(t, protocolpool.ContinuousFund{Recipient: recipient, Percentage: sdk.OneDec(), EpochIdentifier: "day"}.Validate())
+
+	for name, fund := range map[string]protocolpool.ContinuousFund{
+		"neither amount nor percentage": {Recipient: recipient, EpochIdentifier: "day"},
+		"both amount and percentage":    {Recipient: recipient, Amount: coins, Percentage: sdk.OneDec(), EpochIdentifier: "day"},
+		"percentage above one":          {Recipient: recipient, Percentage: sdk.NewDec(2), EpochIdentifier: "day"},
+		"negative percentage":           {Recipient: recipient, Percentage: sdk.NewDec(-1), EpochIdentifier: "day"},
+		"no epoch":                      {Recipient: recipient, Amount: coins},
+	} {
+		require.Error(t, fund.Validate(), name)
+	}
+	require.ErrorIs(t, protocolpool.ContinuousFund{Recipient: "bad", Amount: coins, EpochIdentifier: "day"}.Validate(), sdkerrors.ErrInvalidAddress)
+}
+
+func TestContinuousFundRemainingBudget(t *testing.T) {
+	fund := protocolpool.ContinuousFund{}
+	require.True(t, fund.RemainingBudget().Empty())
+	require.False(t, fund.IsExhausted())
+
+	fund.MaxBudget = sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 100))
+	fund.TotalPaid = sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 40))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), fund.RemainingBudget())
+	require.False(t, fund.IsExhausted())
+
+	fund.TotalPaid = fund.MaxBudget
+	require.True(t, fund.IsExhausted())
+}
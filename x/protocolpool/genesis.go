@@ -0,0 +1,36 @@
+package protocolpool
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateGenesis check the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	ids := make(map[uint64]bool, len(data.ContinuousFunds))
+	totalPercentage := sdk.ZeroDec()
+	for _, fund := range data.ContinuousFunds {
+		if err := fund.Validate(); err != nil {
+			return err
+		}
+		if ids[fund.Id] {
+			return sdkerrors.Wrapf(ErrInvalidContinuousFund, "duplicate continuous fund id %d", fund.Id)
+		}
+		if fund.Id >= data.NextFundId {
+			return sdkerrors.Wrapf(ErrInvalidContinuousFund, "continuous fund id %d must be lower than the next fund id %d", fund.Id, data.NextFundId)
+		}
+		ids[fund.Id] = true
+		if fund.HasPercentage() {
+			totalPercentage = totalPercentage.Add(fund.Percentage)
+		}
+	}
+	if totalPercentage.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrPercentageExceeded, "got %s", totalPercentage)
+	}
+	return nil
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{NextFundId: 1}
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+// InitGenesis new protocolpool genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *protocolpool.GenesisState) {
+	for _, fund := range data.ContinuousFunds {
+		k.setContinuousFund(ctx, fund)
+	}
+	k.setNextFundID(ctx, data.NextFundId)
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *protocolpool.GenesisState {
+	var funds []protocolpool.ContinuousFund
+	k.IterateContinuousFunds(ctx, func(fund protocolpool.ContinuousFund) bool {
+		funds = append(funds, fund)
+		return false
+	})
+
+	return &protocolpool.GenesisState{
+		ContinuousFunds: funds,
+		NextFundId:      k.nextFundID(ctx),
+	}
+}
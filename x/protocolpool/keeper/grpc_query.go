@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+var _ protocolpool.QueryServer = Keeper{}
+
+// ContinuousFund returns a continuous fund and its remaining budget
+func (k Keeper) ContinuousFund(goCtx context.Context, r *protocolpool.QueryContinuousFundRequest) (*protocolpool.QueryContinuousFundResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	fund, found := k.GetContinuousFund(ctx, r.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(protocolpool.ErrContinuousFundNotFound, "id %d", r.Id)
+	}
+	return &protocolpool.QueryContinuousFundResponse{
+		ContinuousFund:  fund,
+		RemainingBudget: fund.RemainingBudget(),
+	}, nil
+}
+
+// ContinuousFunds returns all the active continuous funds
+func (k Keeper) ContinuousFunds(goCtx context.Context, r *protocolpool.QueryContinuousFundsRequest) (*protocolpool.QueryContinuousFundsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), ContinuousFundPrefix)
+
+	var funds []protocolpool.ContinuousFund
+	pageRes, err := query.Paginate(store, r.Pagination, func(_ []byte, value []byte) error {
+		var fund protocolpool.ContinuousFund
+		if err := k.cdc.Unmarshal(value, &fund); err != nil {
+			return err
+		}
+		funds = append(funds, fund)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &protocolpool.QueryContinuousFundsResponse{
+		ContinuousFunds: funds,
+		Pagination:      pageRes,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+)
+
+// Hooks wrapper struct for the protocolpool keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ epochs.EpochHooks = Hooks{}
+
+// Hooks returns the epoch hooks paying the continuous funds
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterEpochEnd pays the continuous funds of the ended epoch timer
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64) error {
+	h.k.PayContinuousFunds(ctx, epochIdentifier)
+	return nil
+}
+
+// BeforeEpochStart does nothing
+func (h Hooks) BeforeEpochStart(_ sdk.Context, _ string, _ int64) error {
+	return nil
+}
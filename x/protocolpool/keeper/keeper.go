@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+// Keeper of the protocolpool store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	dk       protocolpool.DistributionKeeper
+	ek       protocolpool.EpochsKeeper
+
+	// the address capable of creating and canceling continuous funds. Usually the gov
+	// module account
+	authority string
+}
+
+// NewKeeper creates a new protocolpool Keeper instance
+func NewKeeper(key storetypes.StoreKey, cdc codec.BinaryCodec, dk protocolpool.DistributionKeeper,
+	ek protocolpool.EpochsKeeper, authority string,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  key,
+		dk:        dk,
+		ek:        ek,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the address of the protocolpool module authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+protocolpool.ModuleName)
+}
+
+// AddContinuousFund validates and stores a new continuous fund, assigning it the next
+// fund id
+func (k Keeper) AddContinuousFund(ctx sdk.Context, fund protocolpool.ContinuousFund) (uint64, error) {
+	if err := fund.Validate(); err != nil {
+		return 0, err
+	}
+	if _, found := k.ek.GetEpochInfo(ctx, fund.EpochIdentifier); !found {
+		return 0, sdkerrors.Wrapf(protocolpool.ErrInvalidEpoch, "epoch %s does not exist", fund.EpochIdentifier)
+	}
+	if fund.IsExpired(ctx.BlockTime()) {
+		return 0, sdkerrors.Wrapf(protocolpool.ErrInvalidContinuousFund, "expiry %s is not after the block time", fund.Expiry)
+	}
+	if fund.HasPercentage() {
+		total := k.TotalPercentage(ctx).Add(fund.Percentage)
+		if total.GT(sdk.OneDec()) {
+			return 0, sdkerrors.Wrapf(protocolpool.ErrPercentageExceeded, "got %s", total)
+		}
+	}
+
+	fund.Id = k.nextFundID(ctx)
+	fund.TotalPaid = sdk.Coins{}
+	k.setContinuousFund(ctx, fund)
+	k.setNextFundID(ctx, fund.Id+1)
+	return fund.Id, nil
+}
+
+// GetContinuousFund returns the continuous fund with the given id
+func (k Keeper) GetContinuousFund(ctx sdk.Context, id uint64) (protocolpool.ContinuousFund, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(continuousFundKey(id))
+
+	var fund protocolpool.ContinuousFund
+	if len(bz) == 0 {
+		return fund, false
+	}
+	k.cdc.MustUnmarshal(bz, &fund)
+	return fund, true
+}
+
+// RemoveContinuousFund deletes the continuous fund with the given id
+func (k Keeper) RemoveContinuousFund(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(continuousFundKey(id))
+}
+
+// IterateContinuousFunds iterates over all continuous funds, ordered by id, until cb returns true
+func (k Keeper) IterateContinuousFunds(ctx sdk.Context, cb func(fund protocolpool.ContinuousFund) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), ContinuousFundPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var fund protocolpool.ContinuousFund
+		k.cdc.MustUnmarshal(iterator.Value(), &fund)
+		if cb(fund) {
+			break
+		}
+	}
+}
+
+// TotalPercentage returns the sum of the percentages of the continuous funds paying a share
+// of the community pool
+func (k Keeper) TotalPercentage(ctx sdk.Context) sdk.Dec {
+	total := sdk.ZeroDec()
+	k.IterateContinuousFunds(ctx, func(fund protocolpool.ContinuousFund) bool {
+		if fund.HasPercentage() {
+			total = total.Add(fund.Percentage)
+		}
+		return false
+	})
+	return total
+}
+
+func (k Keeper) setContinuousFund(ctx sdk.Context, fund protocolpool.ContinuousFund) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(continuousFundKey(fund.Id), k.cdc.MustMarshal(&fund))
+}
+
+func (k Keeper) nextFundID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(NextFundIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextFundID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(NextFundIDKey, sdk.Uint64ToBigEndian(id))
+}
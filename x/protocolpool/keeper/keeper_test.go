@@ -0,0 +1,247 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	authority   string
+	queryClient protocolpool.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	protocolpool.RegisterQueryServer(queryHelper, app.ProtocolPoolKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = protocolpool.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	s.Require().NoError(app.DistrKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), s.addrs[0]))
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) create(msg protocolpool.MsgCreateContinuousFund) (uint64, error) {
+	msg.Authority = s.authority
+	if msg.Recipient == "" {
+		msg.Recipient = s.addrs[1].String()
+	}
+	if msg.EpochIdentifier == "" {
+		msg.EpochIdentifier = epochs.DayEpochID
+	}
+	res, err := s.app.ProtocolPoolKeeper.CreateContinuousFund(s.ctx, &msg)
+	if err != nil {
+		return 0, err
+	}
+	return res.Id, nil
+}
+
+func (s *TestSuite) balance(addr sdk.AccAddress) sdk.Int {
+	return s.app.BankKeeper.GetBalance(s.ctx, addr, sdk.DefaultBondDenom).Amount
+}
+
+func (s *TestSuite) communityPool() sdk.Int {
+	return s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx).AmountOf(sdk.DefaultBondDenom).TruncateInt()
+}
+
+func (s *TestSuite) TestCreateContinuousFund() {
+	require := s.Require()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	_, err := s.app.ProtocolPoolKeeper.CreateContinuousFund(s.ctx, &protocolpool.MsgCreateContinuousFund{
+		Authority:       s.addrs[0].String(),
+		Recipient:       s.addrs[1].String(),
+		Amount:          coins,
+		EpochIdentifier: epochs.DayEpochID,
+	})
+	require.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.create(protocolpool.MsgCreateContinuousFund{Amount: coins, EpochIdentifier: "fortnight"})
+	require.ErrorIs(err, protocolpool.ErrInvalidEpoch)
+
+	past := s.ctx.BlockTime().Add(-time.Hour)
+	_, err = s.create(protocolpool.MsgCreateContinuousFund{Amount: coins, Expiry: &past})
+	require.ErrorIs(err, protocolpool.ErrInvalidContinuousFund)
+
+	id, err := s.create(protocolpool.MsgCreateContinuousFund{Amount: coins})
+	require.NoError(err)
+	require.Equal(uint64(1), id)
+
+	_, err = s.create(protocolpool.MsgCreateContinuousFund{Percentage: sdk.NewDecWithPrec(6, 1)})
+	require.NoError(err)
+	_, err = s.create(protocolpool.MsgCreateContinuousFund{Percentage: sdk.NewDecWithPrec(5, 1)})
+	require.ErrorIs(err, protocolpool.ErrPercentageExceeded)
+
+	id, err = s.create(protocolpool.MsgCreateContinuousFund{Percentage: sdk.NewDecWithPrec(4, 1)})
+	require.NoError(err)
+	require.Equal(uint64(3), id)
+}
+
+func (s *TestSuite) TestCancelContinuousFund() {
+	require := s.Require()
+
+	id, err := s.create(protocolpool.MsgCreateContinuousFund{Percentage: sdk.OneDec()})
+	require.NoError(err)
+
+	_, err = s.app.ProtocolPoolKeeper.CancelContinuousFund(s.ctx, &protocolpool.MsgCancelContinuousFund{Authority: s.addrs[0].String(), Id: id})
+	require.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.app.ProtocolPoolKeeper.CancelContinuousFund(s.ctx, &protocolpool.MsgCancelContinuousFund{Authority: s.authority, Id: id})
+	require.NoError(err)
+	_, found := s.app.ProtocolPoolKeeper.GetContinuousFund(s.ctx, id)
+	require.False(found)
+
+	_, err = s.app.ProtocolPoolKeeper.CancelContinuousFund(s.ctx, &protocolpool.MsgCancelContinuousFund{Authority: s.authority, Id: id})
+	require.ErrorIs(err, protocolpool.ErrContinuousFundNotFound)
+
+	// the canceled percentage can be allocated again
+	_, err = s.create(protocolpool.MsgCreateContinuousFund{Percentage: sdk.OneDec()})
+	require.NoError(err)
+}
+
+func (s *TestSuite) TestPayContinuousFunds() {
+	require := s.Require()
+	recipient, other := s.addrs[1], s.addrs[2]
+	before, otherBefore := s.balance(recipient), s.balance(other)
+	poolBefore := s.communityPool()
+
+	fixed, err := s.create(protocolpool.MsgCreateContinuousFund{
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		MaxBudget: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 250)),
+	})
+	require.NoError(err)
+	share, err := s.create(protocolpool.MsgCreateContinuousFund{
+		Recipient:       other.String(),
+		Percentage:      sdk.NewDecWithPrec(1, 1),
+		EpochIdentifier: epochs.WeekEpochID,
+	})
+	require.NoError(err)
+
+	// only the funds of the ended epoch timer are paid
+	hooks := s.app.ProtocolPoolKeeper.Hooks()
+	require.NoError(hooks.AfterEpochEnd(s.ctx, epochs.DayEpochID, 1))
+	require.Equal(before.AddRaw(100), s.balance(recipient))
+	require.Equal(otherBefore, s.balance(other))
+
+	res, err := s.queryClient.ContinuousFund(s.ctx, &protocolpool.QueryContinuousFundRequest{Id: fixed})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), res.ContinuousFund.TotalPaid)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150)), res.RemainingBudget)
+
+	require.NoError(hooks.AfterEpochEnd(s.ctx, epochs.WeekEpochID, 1))
+	pool := poolBefore.SubRaw(100)
+	require.Equal(otherBefore.Add(pool.QuoRaw(10)), s.balance(other))
+
+	// the last payout is capped by the remaining budget and completes the fund
+	require.NoError(hooks.AfterEpochEnd(s.ctx, epochs.DayEpochID, 2))
+	require.NoError(hooks.AfterEpochEnd(s.ctx, epochs.DayEpochID, 3))
+	require.Equal(before.AddRaw(250), s.balance(recipient))
+	_, found := s.app.ProtocolPoolKeeper.GetContinuousFund(s.ctx, fixed)
+	require.False(found)
+
+	funds, err := s.queryClient.ContinuousFunds(s.ctx, &protocolpool.QueryContinuousFundsRequest{})
+	require.NoError(err)
+	require.Len(funds.ContinuousFunds, 1)
+	require.Equal(share, funds.ContinuousFunds[0].Id)
+}
+
+func (s *TestSuite) TestPayContinuousFundsInsufficientPool() {
+	require := s.Require()
+	before := s.balance(s.addrs[1])
+
+	id, err := s.create(protocolpool.MsgCreateContinuousFund{
+		Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000000000000)),
+	})
+	require.NoError(err)
+
+	// the payout is skipped but the fund stays active
+	s.app.ProtocolPoolKeeper.PayContinuousFunds(s.ctx, epochs.DayEpochID)
+	require.Equal(before, s.balance(s.addrs[1]))
+	fund, found := s.app.ProtocolPoolKeeper.GetContinuousFund(s.ctx, id)
+	require.True(found)
+	require.True(fund.TotalPaid.Empty())
+}
+
+func (s *TestSuite) TestPayContinuousFundsExpiry() {
+	require := s.Require()
+	before := s.balance(s.addrs[1])
+
+	expiry := s.ctx.BlockTime().Add(time.Hour)
+	id, err := s.create(protocolpool.MsgCreateContinuousFund{
+		Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+		Expiry: &expiry,
+	})
+	require.NoError(err)
+
+	s.ctx = s.ctx.WithBlockTime(expiry)
+	s.app.ProtocolPoolKeeper.PayContinuousFunds(s.ctx, epochs.DayEpochID)
+	require.Equal(before, s.balance(s.addrs[1]))
+	_, found := s.app.ProtocolPoolKeeper.GetContinuousFund(s.ctx, id)
+	require.False(found)
+}
+
+func (s *TestSuite) TestEpochHooksIntegration() {
+	require := s.Require()
+
+	// catch up with the epoch timers of the genesis block before creating the fund
+	s.app.EpochsKeeper.BeginBlocker(s.ctx)
+	before := s.balance(s.addrs[1])
+
+	_, err := s.create(protocolpool.MsgCreateContinuousFund{
+		Amount:          sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+		EpochIdentifier: epochs.HourEpochID,
+	})
+	require.NoError(err)
+
+	// end the current hour epoch
+	epoch, _ := s.app.EpochsKeeper.GetEpochInfo(s.ctx, epochs.HourEpochID)
+	s.ctx = s.ctx.WithBlockTime(epoch.CurrentEpochStartTime.Add(time.Hour + time.Second))
+	s.app.EpochsKeeper.BeginBlocker(s.ctx)
+	require.Equal(before.AddRaw(10), s.balance(s.addrs[1]))
+}
+
+func (s *TestSuite) TestGenesis() {
+	require := s.Require()
+
+	_, err := s.create(protocolpool.MsgCreateContinuousFund{Percentage: sdk.NewDecWithPrec(5, 1)})
+	require.NoError(err)
+	s.app.ProtocolPoolKeeper.PayContinuousFunds(s.ctx, epochs.DayEpochID)
+
+	genesis := s.app.ProtocolPoolKeeper.ExportGenesis(s.ctx)
+	require.NoError(protocolpool.ValidateGenesis(*genesis))
+	require.Equal(uint64(2), genesis.NextFundId)
+
+	s.app.ProtocolPoolKeeper.RemoveContinuousFund(s.ctx, 1)
+	s.app.ProtocolPoolKeeper.InitGenesis(s.ctx, genesis)
+	require.Equal(genesis, s.app.ProtocolPoolKeeper.ExportGenesis(s.ctx))
+
+	_, err = s.queryClient.ContinuousFund(s.ctx, &protocolpool.QueryContinuousFundRequest{Id: 7})
+	require.ErrorIs(err, protocolpool.ErrContinuousFundNotFound)
+	_, err = s.app.ProtocolPoolKeeper.ContinuousFund(s.ctx, nil)
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
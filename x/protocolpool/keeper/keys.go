@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	ContinuousFundPrefix = []byte{0x01}
+	NextFundIDKey        = []byte{0x02}
+)
+
+// continuousFundKey returns the byte representation of the key of a continuous fund
+// Items are stored with the following key: values
+// 0x01<id (8 Bytes)>
+func continuousFundKey(id uint64) []byte {
+	return append(ContinuousFundPrefix, sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+var _ protocolpool.MsgServer = Keeper{}
+
+// CreateContinuousFund creates a continuous fund paid from the community pool every epoch
+func (k Keeper) CreateContinuousFund(goCtx context.Context, msg *protocolpool.MsgCreateContinuousFund) (*protocolpool.MsgCreateContinuousFundResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := k.AddContinuousFund(ctx, protocolpool.ContinuousFund{
+		Recipient:       msg.Recipient,
+		Amount:          msg.Amount,
+		Percentage:      msg.Percentage,
+		EpochIdentifier: msg.EpochIdentifier,
+		Expiry:          msg.Expiry,
+		MaxBudget:       msg.MaxBudget,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&protocolpool.EventCreateContinuousFund{
+		Id:        id,
+		Recipient: msg.Recipient,
+	}); err != nil {
+		return nil, err
+	}
+	return &protocolpool.MsgCreateContinuousFundResponse{Id: id}, nil
+}
+
+// CancelContinuousFund cancels a continuous fund, it is not paid anymore
+func (k Keeper) CancelContinuousFund(goCtx context.Context, msg *protocolpool.MsgCancelContinuousFund) (*protocolpool.MsgCancelContinuousFundResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetContinuousFund(ctx, msg.Id); !found {
+		return nil, sdkerrors.Wrapf(protocolpool.ErrContinuousFundNotFound, "id %d", msg.Id)
+	}
+	k.RemoveContinuousFund(ctx, msg.Id)
+
+	if err := ctx.EventManager().EmitTypedEvent(&protocolpool.EventCancelContinuousFund{Id: msg.Id}); err != nil {
+		return nil, err
+	}
+	return &protocolpool.MsgCancelContinuousFundResponse{}, nil
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+// PayContinuousFunds pays the continuous funds of the given epoch timer from the community
+// pool, and removes the funds that expired or exhausted their max budget. A payout that
+// cannot be made, e.g. because the community pool is short of funds, is skipped until the
+// next epoch.
+func (k Keeper) PayContinuousFunds(ctx sdk.Context, epochIdentifier string) {
+	var funds []protocolpool.ContinuousFund
+	k.IterateContinuousFunds(ctx, func(fund protocolpool.ContinuousFund) bool {
+		if fund.EpochIdentifier == epochIdentifier {
+			funds = append(funds, fund)
+		}
+		return false
+	})
+
+	for _, fund := range funds {
+		if fund.IsExpired(ctx.BlockTime()) {
+			k.completeContinuousFund(ctx, fund.Id)
+			continue
+		}
+
+		payout := k.payoutAmount(ctx, fund)
+		if payout.Empty() {
+			continue
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(fund.Recipient)
+		if err != nil {
+			panic(err)
+		}
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.dk.DistributeFromFeePool(cacheCtx, payout, recipient); err != nil {
+			k.Logger(ctx).Error("continuous fund payout failed", "id", fund.Id, "amount", payout, "err", err)
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		fund.TotalPaid = fund.TotalPaid.Add(payout...)
+		ctx.EventManager().EmitTypedEvent(&protocolpool.EventContinuousFundPayout{
+			Id:        fund.Id,
+			Recipient: fund.Recipient,
+			Amount:    payout,
+		})
+
+		if fund.IsExhausted() {
+			k.completeContinuousFund(ctx, fund.Id)
+			continue
+		}
+		k.setContinuousFund(ctx, fund)
+	}
+}
+
+// payoutAmount returns the amount the fund pays this epoch, capped by its remaining budget
+func (k Keeper) payoutAmount(ctx sdk.Context, fund protocolpool.ContinuousFund) sdk.Coins {
+	payout := fund.Amount
+	if fund.HasPercentage() {
+		payout, _ = k.dk.GetFeePoolCommunityCoins(ctx).MulDecTruncate(fund.Percentage).TruncateDecimal()
+	}
+	if !fund.MaxBudget.Empty() {
+		payout = payout.Min(fund.RemainingBudget())
+	}
+	return payout
+}
+
+func (k Keeper) completeContinuousFund(ctx sdk.Context, id uint64) {
+	k.RemoveContinuousFund(ctx, id)
+	ctx.EventManager().EmitTypedEvent(&protocolpool.EventContinuousFundCompleted{Id: id})
+}
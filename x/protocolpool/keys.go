@@ -0,0 +1,12 @@
+package protocolpool
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "protocolpool"
+
+	// StoreKey is the store key string for protocolpool
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for protocolpool
+	RouterKey = ModuleName
+)
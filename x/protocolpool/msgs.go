@@ -0,0 +1,44 @@
+package protocolpool
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateContinuousFund{}
+	_ sdk.Msg = &MsgCancelContinuousFund{}
+)
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgCreateContinuousFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", m.Authority)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", m.Recipient)
+	}
+
+	return validateFundTerms(m.Amount, m.Percentage, m.EpochIdentifier, m.MaxBudget)
+}
+
+// GetSigners implements Msg
+func (m MsgCreateContinuousFund) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgCancelContinuousFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", m.Authority)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgCancelContinuousFund) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: expected_keepers.go
+
+// Package testutil is a generated GoMock package.
+package testutil
+
+import (
+	reflect "reflect"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+	epochs "github.com/cosmos/cosmos-sdk/x/epochs"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDistributionKeeper is a mock of DistributionKeeper interface.
+type MockDistributionKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDistributionKeeperMockRecorder
+}
+
+// MockDistributionKeeperMockRecorder is the mock recorder for MockDistributionKeeper.
+type MockDistributionKeeperMockRecorder struct {
+	mock *MockDistributionKeeper
+}
+
+// NewMockDistributionKeeper creates a new mock instance.
+func NewMockDistributionKeeper(ctrl *gomock.Controller) *MockDistributionKeeper {
+	mock := &MockDistributionKeeper{ctrl: ctrl}
+	mock.recorder = &MockDistributionKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDistributionKeeper) EXPECT() *MockDistributionKeeperMockRecorder {
+	return m.recorder
+}
+
+// DistributeFromFeePool mocks base method.
+func (m *MockDistributionKeeper) DistributeFromFeePool(ctx types.Context, amount types.Coins, receiveAddr types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DistributeFromFeePool", ctx, amount, receiveAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeFromFeePool indicates an expected call of DistributeFromFeePool.
+func (mr *MockDistributionKeeperMockRecorder) DistributeFromFeePool(ctx, amount, receiveAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeFromFeePool", reflect.TypeOf((*MockDistributionKeeper)(nil).DistributeFromFeePool), ctx, amount, receiveAddr)
+}
+
+// GetFeePoolCommunityCoins mocks base method.
+func (m *MockDistributionKeeper) GetFeePoolCommunityCoins(ctx types.Context) types.DecCoins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeePoolCommunityCoins", ctx)
+	ret0, _ := ret[0].(types.DecCoins)
+	return ret0
+}
+
+// GetFeePoolCommunityCoins indicates an expected call of GetFeePoolCommunityCoins.
+func (mr *MockDistributionKeeperMockRecorder) GetFeePoolCommunityCoins(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeePoolCommunityCoins", reflect.TypeOf((*MockDistributionKeeper)(nil).GetFeePoolCommunityCoins), ctx)
+}
+
+// MockEpochsKeeper is a mock of EpochsKeeper interface.
+type MockEpochsKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockEpochsKeeperMockRecorder
+}
+
+// MockEpochsKeeperMockRecorder is the mock recorder for MockEpochsKeeper.
+type MockEpochsKeeperMockRecorder struct {
+	mock *MockEpochsKeeper
+}
+
+// NewMockEpochsKeeper creates a new mock instance.
+func NewMockEpochsKeeper(ctrl *gomock.Controller) *MockEpochsKeeper {
+	mock := &MockEpochsKeeper{ctrl: ctrl}
+	mock.recorder = &MockEpochsKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEpochsKeeper) EXPECT() *MockEpochsKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetEpochInfo mocks base method.
+func (m *MockEpochsKeeper) GetEpochInfo(ctx types.Context, identifier string) (epochs.EpochInfo, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEpochInfo", ctx, identifier)
+	ret0, _ := ret[0].(epochs.EpochInfo)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetEpochInfo indicates an expected call of GetEpochInfo.
+func (mr *MockEpochsKeeperMockRecorder) GetEpochInfo(ctx, identifier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEpochInfo", reflect.TypeOf((*MockEpochsKeeper)(nil).GetEpochInfo), ctx, identifier)
+}
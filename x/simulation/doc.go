@@ -112,6 +112,23 @@ To export the simulation app state (i.e genesis) to a file:
 	-ExportStatePath=/path/to/genesis.json \
 	 v -timeout 24h
 
+To shrink the operations of a failing simulation, which breaks an invariant or
+panics, to a minimal sequence reproducing its failure:
+
+ $ go test -mod=readonly github.com/cosmos/cosmos-sdk/simapp \
+ 	-run=TestAppSimulationShrink \
+ 	-Enabled=true \
+ 	-NumBlocks=100 \
+ 	-BlockSize=200 \
+ 	-Commit=true \
+ 	-Seed=99 \
+ 	-ShrinkRuns=200 \
+ 	-v -timeout 24h
+
+The simulation is replayed against fresh applications without subsets of its
+operations, keeping the ones needed to reproduce the failure. The invariants are
+checked at the end of every replayed simulation. See ShrinkFailure.
+
 Params
 
 Params that are provided to simulation from a JSON file are used to used to set
@@ -0,0 +1,336 @@
+/*
+Package importexport implements a determinism test of the import and export of
+the genesis state of an app: it runs a simulation of the app, exports its
+state, imports it into a fresh app and compares the stores of both apps key by
+key, reporting the differing key-value pairs decoded by the store decoders of
+the app.
+
+It only depends on the constructor of the app, so that the chains built with
+the Cosmos SDK can run it against their own app:
+
+	result, err := importexport.Run(t, config, importexport.Options{
+		NewApp: func(logger log.Logger, db dbm.DB) (importexport.App, *baseapp.BaseApp) {
+			app := NewMyApp(logger, db, ...)
+			return app, app.BaseApp
+		},
+		AppStateFn: func(app importexport.App) simtypes.AppStateFn { ... },
+		Operations: func(app importexport.App, config simtypes.Config) []simtypes.WeightedOperation { ... },
+	})
+*/
+package importexport
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// ErrStoresMismatch is returned by Run when the stores of the imported app
+// differ from the ones of the simulated app.
+var ErrStoresMismatch = errors.New("stores mismatch after import")
+
+// emptyValidatorSet is the panic message of the staking module when the
+// imported genesis has no bonded validator.
+const emptyValidatorSet = "validator set is empty after InitGenesis"
+
+// App is the app under test, e.g. simapp.SimApp.
+type App interface {
+	// InitChainer initializes the state of the app from the genesis state of
+	// the request.
+	InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain
+
+	// ExportAppStateAndValidators exports the state of the app.
+	ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs []string) (servertypes.ExportedApp, error)
+
+	// ModuleAccountAddrs returns the module account addresses, which the
+	// simulation doesn't send funds to.
+	ModuleAccountAddrs() map[string]bool
+
+	// SimulationManager returns the simulation manager of the app, whose store
+	// decoders decode the differing key-value pairs.
+	SimulationManager() *module.SimulationManager
+
+	// AppCodec returns the codec of the app.
+	AppCodec() codec.Codec
+}
+
+// AppConstructor returns a new app using db, along with its BaseApp.
+type AppConstructor func(logger log.Logger, db dbm.DB) (App, *baseapp.BaseApp)
+
+// Options defines the app under test and how its stores are compared.
+type Options struct {
+	// NewApp is the constructor of the app, called for the simulated app and
+	// for the app importing its state.
+	NewApp AppConstructor
+
+	// AppStateFn returns the function generating the genesis state of the
+	// simulation of the app.
+	AppStateFn func(app App) simtypes.AppStateFn
+
+	// Operations returns the weighted operations of the simulation of the app.
+	Operations func(app App, config simtypes.Config) []simtypes.WeightedOperation
+
+	// RandomAccounts generates the accounts of the simulation,
+	// simtypes.RandomAccounts if nil.
+	RandomAccounts simtypes.RandomAccountFn
+
+	// NewDB returns the db of the simulated app, named "simulated", and of the
+	// importing app, named "imported". In-memory dbs are used if nil.
+	NewDB func(name string) (dbm.DB, error)
+
+	// Logger is the logger of the simulated app, a nop logger if nil. The
+	// importing app always uses a nop logger.
+	Logger log.Logger
+
+	// Output is where the simulation writes its progress, discarded if nil.
+	Output io.Writer
+
+	// SkippedStores are the names of the stores which aren't compared, e.g.
+	// the stores whose state isn't exported.
+	SkippedStores []string
+
+	// SkippedPrefixes are the key prefixes which aren't compared, by store
+	// name, e.g. the keys whose ordering may change on import.
+	SkippedPrefixes map[string][][]byte
+}
+
+// StoreDiff defines the key-value pairs of a store which differ between the
+// simulated app and the importing app.
+type StoreDiff struct {
+	Store string
+	// A and B are the differing key-value pairs of the simulated app and of
+	// the importing app respectively.
+	A, B []kv.Pair
+	// Log are the differing key-value pairs, decoded by the store decoder of
+	// the store if it has one.
+	Log string
+}
+
+// Result defines the result of Run.
+type Result struct {
+	// App is the simulated app, e.g. to export its state on failure.
+	App App
+	// SimParams are the parameters of the simulation.
+	SimParams simtypes.Params
+	// Skipped is set if the state couldn't be imported, because the
+	// simulation stopped early or unbonded all the validators, in which case
+	// no store is compared.
+	Skipped bool
+	// ComparedStores are the names of the compared stores, sorted.
+	ComparedStores []string
+	// Diffs are the differences of the compared stores, empty if they match.
+	Diffs []StoreDiff
+}
+
+// DiffLog returns the readable differences of the stores of the result.
+func (r Result) DiffLog() string {
+	var sb strings.Builder
+	for _, diff := range r.Diffs {
+		fmt.Fprintf(&sb, "store %s: %d different key/value pairs\n%s", diff.Store, len(diff.A), diff.Log)
+	}
+	return sb.String()
+}
+
+// Run runs the simulation of a new app with the provided config, exports its
+// state, imports it into another new app and compares every KV store of both
+// apps, except the skipped ones. It returns an error wrapping
+// ErrStoresMismatch, with the readable differences of the stores, if they
+// don't match.
+//
+// The result is returned along with any error, with the simulated app and the
+// simulation params set once the simulation has run.
+func Run(tb testing.TB, config simtypes.Config, opts Options) (result Result, err error) {
+	newDB := opts.NewDB
+	if newDB == nil {
+		newDB = func(string) (dbm.DB, error) { return dbm.NewMemDB(), nil }
+	}
+	randomAccounts := opts.RandomAccounts
+	if randomAccounts == nil {
+		randomAccounts = simtypes.RandomAccounts
+	}
+	logger := opts.Logger
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+	output := opts.Output
+	if output == nil {
+		output = io.Discard
+	}
+
+	dbA, err := newDB("simulated")
+	if err != nil {
+		return result, err
+	}
+	appA, bappA := opts.NewApp(logger, dbA)
+	result.App = appA
+
+	stopEarly, simParams, err := simulation.SimulateFromSeed(
+		tb,
+		output,
+		bappA,
+		opts.AppStateFn(appA),
+		randomAccounts,
+		opts.Operations(appA, config),
+		appA.ModuleAccountAddrs(),
+		config,
+		appA.AppCodec(),
+	)
+	result.SimParams = simParams
+	if err != nil {
+		return result, fmt.Errorf("simulation failed: %w", err)
+	}
+	if stopEarly {
+		result.Skipped = true
+		return result, nil
+	}
+
+	exported, err := appA.ExportAppStateAndValidators(false, nil)
+	if err != nil {
+		return result, fmt.Errorf("failed to export the app state: %w", err)
+	}
+
+	dbB, err := newDB("imported")
+	if err != nil {
+		return result, err
+	}
+	appB, bappB := opts.NewApp(log.NewNopLogger(), dbB)
+
+	header := tmproto.Header{ChainID: config.ChainID, Height: bappA.LastBlockHeight()}
+	ctxA := bappA.NewContext(true, header)
+	ctxB := bappB.NewContext(true, header)
+
+	if skipped, err := importState(ctxB, appB, bappB, exported, config.ChainID); err != nil || skipped {
+		result.Skipped = skipped
+		return result, err
+	}
+
+	result.ComparedStores, result.Diffs = compareStores(ctxA, ctxB, bappA, bappB, appA.SimulationManager().StoreDecoders, opts)
+	if len(result.Diffs) > 0 {
+		return result, fmt.Errorf("%w:\n%s", ErrStoresMismatch, result.DiffLog())
+	}
+
+	return result, nil
+}
+
+// importState initializes the state of app from the exported state. It
+// returns true, without error, if the exported state has no bonded validator.
+func importState(ctx sdk.Context, app App, bapp *baseapp.BaseApp, exported servertypes.ExportedApp, chainID string) (skipped bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if msg := fmt.Sprint(r); strings.Contains(msg, emptyValidatorSet) {
+				skipped = true
+				return
+			}
+			panic(r)
+		}
+	}()
+
+	app.InitChainer(ctx, abci.RequestInitChain{ChainId: chainID, AppStateBytes: exported.AppState})
+	bapp.StoreConsensusParams(ctx, exported.ConsensusParams)
+	return false, nil
+}
+
+// compareStores compares the KV stores mounted by the simulated app which
+// aren't skipped with the same stores of the importing app.
+func compareStores(ctxA, ctxB sdk.Context, bappA, bappB *baseapp.BaseApp, decoders sdk.StoreDecoderRegistry, opts Options) (compared []string, diffs []StoreDiff) {
+	skipped := make(map[string]bool, len(opts.SkippedStores))
+	for _, name := range opts.SkippedStores {
+		skipped[name] = true
+	}
+
+	keysA, keysB := kvStoreKeys(bappA), kvStoreKeys(bappB)
+	for name := range keysA {
+		if !skipped[name] {
+			compared = append(compared, name)
+		}
+	}
+	sort.Strings(compared)
+
+	for _, name := range compared {
+		keyB, ok := keysB[name]
+		if !ok {
+			diffs = append(diffs, StoreDiff{Store: name, Log: "store not mounted by the importing app\n"})
+			continue
+		}
+
+		kvAs, kvBs := sdk.DiffKVStores(ctxA.KVStore(keysA[name]), ctxB.KVStore(keyB), opts.SkippedPrefixes[name])
+		if len(kvAs) == 0 && len(kvBs) == 0 {
+			continue
+		}
+		diffs = append(diffs, StoreDiff{
+			Store: name,
+			A:     kvAs,
+			B:     kvBs,
+			Log:   DecodeDiff(name, decoders, kvAs, kvBs),
+		})
+	}
+
+	return compared, diffs
+}
+
+// kvStoreKeys returns the keys of the KV stores mounted by the app, by name.
+func kvStoreKeys(bapp *baseapp.BaseApp) map[string]*storetypes.KVStoreKey {
+	keys := make(map[string]*storetypes.KVStoreKey)
+	rs, ok := bapp.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		panic(fmt.Errorf("expected %T, got %T", &rootmulti.Store{}, bapp.CommitMultiStore()))
+	}
+	for name, key := range rs.StoreKeysByName() {
+		if kvKey, ok := key.(*storetypes.KVStoreKey); ok {
+			keys[name] = kvKey
+		}
+	}
+	return keys
+}
+
+// DecodeDiff returns the differing key-value pairs kvAs and kvBs of a store,
+// decoded by the store decoder of the store if it has one and if it can decode
+// them, and in hex otherwise. The pairs without value on both sides are
+// skipped.
+func DecodeDiff(storeName string, decoders sdk.StoreDecoderRegistry, kvAs, kvBs []kv.Pair) (log string) {
+	for i := 0; i < len(kvAs) && i < len(kvBs); i++ {
+		if len(kvAs[i].Value) == 0 && len(kvBs[i].Value) == 0 {
+			// skip if the value doesn't have any bytes
+			continue
+		}
+
+		log += decodePairs(decoders[storeName], kvAs[i], kvBs[i])
+	}
+
+	return log
+}
+
+// decodePairs decodes the key-value pairs kvA and kvB with decoder, or in hex
+// if nil or if it fails to decode them, e.g. for an unknown key prefix.
+func decodePairs(decoder func(kvA, kvB kv.Pair) string, kvA, kvB kv.Pair) (log string) {
+	hex := fmt.Sprintf("store A %X => %X\nstore B %X => %X\n", kvA.Key, kvA.Value, kvB.Key, kvB.Value)
+	if decoder == nil {
+		return hex
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			log = hex
+		}
+	}()
+	return decoder(kvA, kvB)
+}
@@ -0,0 +1,38 @@
+package importexport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+func TestDecodeDiff(t *testing.T) {
+	decoders := sdk.StoreDecoderRegistry{
+		"decoded": func(kvA, kvB kv.Pair) string {
+			return string(kvA.Value) + " != " + string(kvB.Value) + "\n"
+		},
+		"panicking": func(kvA, kvB kv.Pair) string {
+			panic("invalid key prefix")
+		},
+	}
+	kvAs := []kv.Pair{{Key: []byte{0x01}, Value: []byte("a")}, {Key: []byte{0x02}}}
+	kvBs := []kv.Pair{{Key: []byte{0x01}, Value: []byte("b")}, {Key: []byte{0x02}}}
+
+	require.Equal(t, "a != b\n", DecodeDiff("decoded", decoders, kvAs, kvBs))
+
+	// the pairs are logged in hex if they can't be decoded
+	hex := "store A 01 => 61\nstore B 01 => 62\n"
+	require.Equal(t, hex, DecodeDiff("panicking", decoders, kvAs, kvBs))
+	require.Equal(t, hex, DecodeDiff("unknown", decoders, kvAs, kvBs))
+}
+
+func TestResultDiffLog(t *testing.T) {
+	result := Result{Diffs: []StoreDiff{
+		{Store: "bank", A: make([]kv.Pair, 2), Log: "balances\n"},
+		{Store: "staking", A: make([]kv.Pair, 1), Log: "validator\n"},
+	}}
+	require.Equal(t, "store bank: 2 different key/value pairs\nbalances\nstore staking: 1 different key/value pairs\nvalidator\n", result.DiffLog())
+}
@@ -3,7 +3,6 @@ package simulation
 import (
 	"fmt"
 	"math/rand"
-	"sort"
 	"testing"
 	"time"
 
@@ -11,6 +10,8 @@ import (
 	cryptoenc "github.com/tendermint/tendermint/crypto/encoding"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/util/collection"
 )
 
 type mockValidator struct {
@@ -46,17 +47,7 @@ func newMockValidators(r *rand.Rand, abciVals []abci.ValidatorUpdate, params Par
 
 // TODO describe usage
 func (vals mockValidators) getKeys() []string {
-	keys := make([]string, len(vals))
-	i := 0
-
-	for key := range vals {
-		keys[i] = key
-		i++
-	}
-
-	sort.Strings(keys)
-
-	return keys
+	return collection.SortedKeys(vals)
 }
 
 // randomProposer picks a random proposer from the current validator set
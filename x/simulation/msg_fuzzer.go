@@ -0,0 +1,647 @@
+package simulation
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	gogoproto "github.com/gogo/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// maxFuzzAttempts is the number of msgs a fuzzer generates before giving up on
+// finding one passing ValidateBasic.
+const maxFuzzAttempts = 10
+
+// FieldGenerator returns a random value for a field of a fuzzed msg, nil to leave
+// the field unset. The value is converted to the type of the field: a string
+// field accepts strings, fmt.Stringers such as sdk.AccAddress and sdk.Dec, and
+// integers, a message field accepts a gogoproto message of its type, such as
+// sdk.Coin, and a repeated field accepts a slice, such as sdk.Coins.
+type FieldGenerator func(r *rand.Rand, fc *FuzzContext) (interface{}, error)
+
+// FuzzContext is the context in which the fields of a fuzzed msg are generated.
+type FuzzContext struct {
+	Context  sdk.Context
+	Accounts []simtypes.Account
+	// Signer is the account signing the msg.
+	Signer simtypes.Account
+	// Spendable are the spendable coins of the signer which are not spent by
+	// the fields generated so far.
+	Spendable sdk.Coins
+	// Path is the path of the generated field, e.g. "params.min_base_fee".
+	Path string
+
+	spent sdk.Coins
+}
+
+// Spend marks coins of the signer as spent by the msg, so that they are not
+// used by the other fields of the msg nor by the fees of the tx.
+func (fc *FuzzContext) Spend(coins sdk.Coins) {
+	fc.Spendable = fc.Spendable.Sub(coins...)
+	fc.spent = fc.spent.Add(coins...)
+}
+
+// MsgFuzzer generates random msgs of the type of Msg from its proto descriptor,
+// so that a module only supplies the constraints its msgs must satisfy instead
+// of writing a simulation operation per msg. Every field of the msg is generated
+// by the generator of its path in Fields if any, or else by a default generator
+// depending on its proto type:
+//   - a string field with the cosmos.AddressString scalar is the address of a
+//     random account, or of the signer if it is the Signer field,
+//   - a string field with the cosmos.Dec scalar is a Dec between 0 and 1, and one
+//     with the cosmos.Int scalar an Int between 0 and 1000000,
+//   - a Coin field, single or repeated, holds coins within the spendable balance
+//     of the signer,
+//   - a message field is generated field by field, except Any fields which are
+//     left unset,
+//   - the other scalar fields are random values of their kind, and one field of
+//     every oneof is set.
+type MsgFuzzer struct {
+	// Msg is a msg of the generated type, its field values are ignored.
+	Msg sdk.Msg
+	// Signer is the path of the field holding the address of the signer of the
+	// msg, e.g. "from_address". The signer is a random simulation account.
+	Signer string
+	// Fields are the generators of the fields of the msg by path, overriding the
+	// default generators.
+	Fields map[string]FieldGenerator
+	// Weight is the default weight of the operation delivering the msgs.
+	Weight int
+}
+
+// AppParamsKey returns the key of the weight of the operation delivering the
+// msgs of the fuzzer in the simulation app params, e.g. op_weight_msg_send.
+func (f MsgFuzzer) AppParamsKey() string {
+	name := gogoproto.MessageName(f.Msg)
+	return "op_weight_" + toSnakeCase(name[strings.LastIndex(name, ".")+1:])
+}
+
+// Generate generates a random msg signed by signer, which has spendable coins.
+// It returns the msg passing ValidateBasic and the coins it spends, or an error
+// if no valid msg was generated after a few attempts.
+func (f MsgFuzzer) Generate(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, signer simtypes.Account, spendable sdk.Coins) (sdk.Msg, sdk.Coins, error) {
+	desc, err := msgDescriptor(f.Msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var lastErr error
+	for i := 0; i < maxFuzzAttempts; i++ {
+		fc := &FuzzContext{Context: ctx, Accounts: accs, Signer: signer, Spendable: spendable}
+		dynMsg := dynamicpb.NewMessage(desc)
+		if err := f.fill(r, fc, dynMsg, ""); err != nil {
+			return nil, nil, err
+		}
+
+		msg, err := toGogoMsg(dynMsg, f.Msg)
+		if err != nil {
+			return nil, nil, err
+		}
+		if lastErr = msg.ValidateBasic(); lastErr == nil {
+			return msg, fc.spent, nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("no valid %s generated after %d attempts: %w", sdk.MsgTypeURL(f.Msg), maxFuzzAttempts, lastErr)
+}
+
+// Operation returns an operation delivering a msg generated by the fuzzer, signed
+// by a random account paying random fees.
+func (f MsgFuzzer) Operation(cdc *codec.ProtoCodec, txGen client.TxConfig, ak AccountKeeper, bk BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(f.Msg)
+		signer, _ := simtypes.RandomAcc(r, accs)
+		msg, spent, err := f.Generate(r, ctx, accs, signer, bk.SpendableCoins(ctx, signer.Address))
+		if err != nil {
+			return simtypes.NoOpMsg(msgType, msgType, err.Error()), nil, nil
+		}
+
+		return GenAndDeliverTxWithRandFees(OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             cdc,
+			Msg:             msg,
+			MsgType:         msgType,
+			CoinsSpentInMsg: spent,
+			Context:         ctx,
+			SimAccount:      signer,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      msgType,
+		})
+	}
+}
+
+// FuzzedOperations returns the operations delivering the msgs of the fuzzers,
+// weighted by the value of their AppParamsKey in the app params, or their
+// Weight by default.
+func FuzzedOperations(
+	appParams simtypes.AppParams, cdc *codec.ProtoCodec, txGen client.TxConfig, ak AccountKeeper, bk BankKeeper, fuzzers ...MsgFuzzer,
+) WeightedOperations {
+	ops := make(WeightedOperations, 0, len(fuzzers))
+	for _, f := range fuzzers {
+		var weight int
+		appParams.GetOrGenerate(cdc, f.AppParamsKey(), &weight, nil,
+			func(_ *rand.Rand) {
+				weight = f.Weight
+			},
+		)
+		ops = append(ops, NewWeightedOperation(weight, f.Operation(cdc, txGen, ak, bk)))
+	}
+
+	return ops
+}
+
+// fill sets the fields of msg, at path prefix in the fuzzed msg.
+func (f MsgFuzzer) fill(r *rand.Rand, fc *FuzzContext, msg protoreflect.Message, prefix string) error {
+	fields := msg.Descriptor().Fields()
+	oneofs := msg.Descriptor().Oneofs()
+	chosen := make(map[protoreflect.FullName]protoreflect.Name, oneofs.Len())
+	for i := 0; i < oneofs.Len(); i++ {
+		if oneof := oneofs.Get(i); !oneof.IsSynthetic() {
+			chosen[oneof.FullName()] = oneof.Fields().Get(r.Intn(oneof.Fields().Len())).Name()
+		}
+	}
+
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if oneof := fd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() && chosen[oneof.FullName()] != fd.Name() {
+			continue
+		}
+
+		fc.Path = prefix + string(fd.Name())
+		v, err := f.generate(r, fc, fd)
+		if err != nil {
+			return fmt.Errorf("%s: %w", fc.Path, err)
+		}
+
+		if v == nil {
+			continue
+		}
+		if _, ok := v.(nestedMsg); ok {
+			if err := f.fill(r, fc, msg.Mutable(fd).Message(), fc.Path+"."); err != nil {
+				return err
+			}
+			continue
+		}
+
+		value, err := toValue(msg, fd, v)
+		if err != nil {
+			return fmt.Errorf("%s: %w", fc.Path, err)
+		}
+		msg.Set(fd, value)
+	}
+
+	return nil
+}
+
+// nestedMsg is the value of the message fields generated field by field.
+type nestedMsg struct{}
+
+// generate returns the value of the field fd, at fc.Path in the fuzzed msg.
+func (f MsgFuzzer) generate(r *rand.Rand, fc *FuzzContext, fd protoreflect.FieldDescriptor) (interface{}, error) {
+	if gen, ok := f.Fields[fc.Path]; ok {
+		return gen(r, fc)
+	}
+	if fc.Path == f.Signer {
+		return fc.Signer.Address.String(), nil
+	}
+	if fd.IsMap() {
+		return nil, nil
+	}
+
+	if md := fd.Message(); md != nil {
+		switch md.FullName() {
+		case "cosmos.base.v1beta1.Coin":
+			coins, err := CoinsWithinBalance()(r, fc)
+			if err != nil || fd.IsList() {
+				return coins, err
+			}
+			if coins := coins.(sdk.Coins); len(coins) > 0 {
+				return coins[0], nil
+			}
+			return nil, nil
+		case "google.protobuf.Any":
+			return nil, nil
+		case "google.protobuf.Timestamp":
+			return fc.Context.BlockTime().Add(time.Duration(r.Int63n(int64(365 * 24 * time.Hour)))), nil
+		case "google.protobuf.Duration":
+			return time.Duration(r.Int63n(int64(365 * 24 * time.Hour))), nil
+		}
+		if fd.IsList() {
+			// the elements of repeated message fields are only generated by
+			// the generators of the module
+			return nil, nil
+		}
+		return nestedMsg{}, nil
+	}
+
+	if fd.IsList() {
+		n := r.Intn(4)
+		values := make([]interface{}, 0, n)
+		for i := 0; i < n; i++ {
+			v, err := randomScalar(r, fc, fd)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+		}
+		return values, nil
+	}
+
+	return randomScalar(r, fc, fd)
+}
+
+// randomScalar returns a random value of the scalar field fd.
+func randomScalar(r *rand.Rand, fc *FuzzContext, fd protoreflect.FieldDescriptor) (interface{}, error) {
+	switch scalar, _ := proto.GetExtension(fd.Options(), cosmos_proto.E_Scalar).(string); scalar {
+	case "cosmos.AddressString":
+		return RandomAccountAddress()(r, fc)
+	case "cosmos.Dec":
+		return DecBetween(sdk.ZeroDec(), sdk.OneDec())(r, fc)
+	case "cosmos.Int":
+		return IntBetween(0, 1000000)(r, fc)
+	}
+
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return r.Intn(2) == 1, nil
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		return values.Get(r.Intn(values.Len())).Number(), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return r.Int63n(1000000), nil
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return r.Float64(), nil
+	case protoreflect.StringKind:
+		return simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, 20)), nil
+	case protoreflect.BytesKind:
+		bz := make([]byte, r.Intn(32))
+		r.Read(bz)
+		return bz, nil
+	default:
+		return nil, fmt.Errorf("unsupported field kind %s", fd.Kind())
+	}
+}
+
+// RandomAccountAddress returns a generator of the address of a random account.
+func RandomAccountAddress() FieldGenerator {
+	return func(r *rand.Rand, fc *FuzzContext) (interface{}, error) {
+		acc, _ := simtypes.RandomAcc(r, fc.Accounts)
+		return acc.Address.String(), nil
+	}
+}
+
+// CoinsWithinBalance returns a generator of random coins within the spendable
+// balance of the signer, which are marked as spent by the msg.
+func CoinsWithinBalance() FieldGenerator {
+	return func(r *rand.Rand, fc *FuzzContext) (interface{}, error) {
+		coins := simtypes.RandSubsetCoins(r, fc.Spendable)
+		fc.Spend(coins)
+		return coins, nil
+	}
+}
+
+// DecBetween returns a generator of a random Dec between min and max.
+func DecBetween(min, max sdk.Dec) FieldGenerator {
+	return func(r *rand.Rand, _ *FuzzContext) (interface{}, error) {
+		return min.Add(simtypes.RandomDecAmount(r, max.Sub(min))), nil
+	}
+}
+
+// IntBetween returns a generator of a random integer between min and max.
+func IntBetween(min, max int64) FieldGenerator {
+	return func(r *rand.Rand, _ *FuzzContext) (interface{}, error) {
+		return min + r.Int63n(max-min+1), nil
+	}
+}
+
+// StringOfLength returns a generator of a random alphanumeric string with a
+// length between min and max.
+func StringOfLength(min, max int) FieldGenerator {
+	return func(r *rand.Rand, _ *FuzzContext) (interface{}, error) {
+		return simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, min, max+1)), nil
+	}
+}
+
+// OneOf returns a generator of one of values.
+func OneOf(values ...interface{}) FieldGenerator {
+	return func(r *rand.Rand, _ *FuzzContext) (interface{}, error) {
+		return values[r.Intn(len(values))], nil
+	}
+}
+
+// Constant returns a generator of value, nil to leave the field unset.
+func Constant(value interface{}) FieldGenerator {
+	return func(*rand.Rand, *FuzzContext) (interface{}, error) {
+		return value, nil
+	}
+}
+
+// toValue converts v to a value of the field fd of msg.
+func toValue(msg protoreflect.Message, fd protoreflect.FieldDescriptor, v interface{}) (protoreflect.Value, error) {
+	if !fd.IsList() {
+		return toScalarValue(fd, v)
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return protoreflect.Value{}, fmt.Errorf("expected a slice for a repeated field, got %T", v)
+	}
+	list := msg.NewField(fd).List()
+	for i := 0; i < rv.Len(); i++ {
+		elem, err := toScalarValue(fd, rv.Index(i).Interface())
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		list.Append(elem)
+	}
+	return protoreflect.ValueOfList(list), nil
+}
+
+// toScalarValue converts v to a value of the kind of fd.
+func toScalarValue(fd protoreflect.FieldDescriptor, v interface{}) (protoreflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return toMessageValue(fd.Message(), v)
+	case protoreflect.StringKind:
+		// gogoproto custom types such as sdk.Dec are encoded by their Marshal
+		// method, which differs from their String method
+		if m, ok := v.(interface{ Marshal() ([]byte, error) }); ok && hasCustomType(fd) {
+			bz, err := m.Marshal()
+			if err != nil {
+				return protoreflect.Value{}, err
+			}
+			return protoreflect.ValueOfString(string(bz)), nil
+		}
+		switch v := v.(type) {
+		case string:
+			return protoreflect.ValueOfString(v), nil
+		case fmt.Stringer:
+			return protoreflect.ValueOfString(v.String()), nil
+		}
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return protoreflect.ValueOfString(strconv.FormatInt(rv.Int(), 10)), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return protoreflect.ValueOfString(strconv.FormatUint(rv.Uint(), 10)), nil
+		}
+	case protoreflect.BytesKind:
+		if bz, ok := v.([]byte); ok {
+			return protoreflect.ValueOfBytes(bz), nil
+		}
+	case protoreflect.BoolKind:
+		if rv.Kind() == reflect.Bool {
+			return protoreflect.ValueOfBool(rv.Bool()), nil
+		}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		if rv.Kind() == reflect.Float32 || rv.Kind() == reflect.Float64 {
+			if fd.Kind() == protoreflect.FloatKind {
+				return protoreflect.ValueOfFloat32(float32(rv.Float())), nil
+			}
+			return protoreflect.ValueOfFloat64(rv.Float()), nil
+		}
+	default:
+		var i int64
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			i = rv.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			i = int64(rv.Uint())
+		default:
+			return protoreflect.Value{}, fmt.Errorf("cannot use %T as %s", v, fd.Kind())
+		}
+
+		switch fd.Kind() {
+		case protoreflect.EnumKind:
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(i)), nil
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+			return protoreflect.ValueOfInt32(int32(i)), nil
+		case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+			return protoreflect.ValueOfInt64(i), nil
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+			return protoreflect.ValueOfUint32(uint32(i)), nil
+		case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			return protoreflect.ValueOfUint64(uint64(i)), nil
+		}
+	}
+
+	return protoreflect.Value{}, fmt.Errorf("cannot use %T as %s", v, fd.Kind())
+}
+
+// toMessageValue converts v, a gogoproto message or a well-known type, to a
+// message of descriptor md.
+func toMessageValue(md protoreflect.MessageDescriptor, v interface{}) (protoreflect.Value, error) {
+	msg := dynamicpb.NewMessage(md)
+	switch v := v.(type) {
+	case time.Time:
+		msg.Set(md.Fields().ByName("seconds"), protoreflect.ValueOfInt64(v.Unix()))
+		msg.Set(md.Fields().ByName("nanos"), protoreflect.ValueOfInt32(int32(v.Nanosecond())))
+		return protoreflect.ValueOfMessage(msg), nil
+	case time.Duration:
+		msg.Set(md.Fields().ByName("seconds"), protoreflect.ValueOfInt64(int64(v/time.Second)))
+		msg.Set(md.Fields().ByName("nanos"), protoreflect.ValueOfInt32(int32(v%time.Second)))
+		return protoreflect.ValueOfMessage(msg), nil
+	}
+
+	// the generated values are gogoproto messages, often non-pointer structs
+	// such as sdk.Coin
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr {
+		ptr := reflect.New(rv.Type())
+		ptr.Elem().Set(rv)
+		rv = ptr
+	}
+	gogoMsg, ok := rv.Interface().(gogoproto.Message)
+	if !ok {
+		return protoreflect.Value{}, fmt.Errorf("cannot use %T as %s", v, md.FullName())
+	}
+	if name := gogoproto.MessageName(gogoMsg); name != string(md.FullName()) {
+		return protoreflect.Value{}, fmt.Errorf("cannot use %s as %s", name, md.FullName())
+	}
+
+	bz, err := gogoproto.Marshal(gogoMsg)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	if err := proto.Unmarshal(bz, msg); err != nil {
+		return protoreflect.Value{}, err
+	}
+	return protoreflect.ValueOfMessage(msg), nil
+}
+
+// toGogoMsg converts the dynamic msg to a gogoproto msg of the type of template.
+func toGogoMsg(msg *dynamicpb.Message, template sdk.Msg) (sdk.Msg, error) {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	gogoMsg := reflect.New(reflect.TypeOf(template).Elem()).Interface().(sdk.Msg)
+	if err := gogoproto.Unmarshal(bz, gogoMsg); err != nil {
+		return nil, err
+	}
+	return gogoMsg, nil
+}
+
+// customTypeFieldNumber is the field number of the gogoproto.customtype option.
+const customTypeFieldNumber = 65003
+
+// hasCustomType returns whether the field has the gogoproto.customtype option,
+// which is an unknown field of its options as gogoproto extensions are not
+// registered to the protobuf registry.
+func hasCustomType(fd protoreflect.FieldDescriptor) bool {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	if !ok || opts == nil {
+		return false
+	}
+
+	b := opts.ProtoReflect().GetUnknown()
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return false
+		}
+		if num == customTypeFieldNumber {
+			return true
+		}
+		b = b[n:]
+		if n = protowire.ConsumeFieldValue(num, typ, b); n < 0 {
+			return false
+		}
+		b = b[n:]
+	}
+	return false
+}
+
+var (
+	descriptorsMtx sync.Mutex
+	descriptors    = new(protoregistry.Files)
+)
+
+// msgDescriptor returns the descriptor of the gogoproto msg, resolved from the
+// file descriptors registered to gogoproto, or else to the protobuf registry.
+func msgDescriptor(msg sdk.Msg) (protoreflect.MessageDescriptor, error) {
+	descMsg, ok := msg.(interface{ Descriptor() ([]byte, []int) })
+	if !ok {
+		return nil, fmt.Errorf("%T has no proto descriptor", msg)
+	}
+	gzipped, _ := descMsg.Descriptor()
+	fdProto, err := unzipFileDescriptor(gzipped)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptorsMtx.Lock()
+	defer descriptorsMtx.Unlock()
+
+	fd, err := loadFile(fdProto)
+	if err != nil {
+		return nil, err
+	}
+	desc := fd.Messages().ByName(protoreflect.FullName(gogoproto.MessageName(msg)).Name())
+	if desc == nil {
+		return nil, fmt.Errorf("no descriptor of %s", gogoproto.MessageName(msg))
+	}
+	return desc, nil
+}
+
+// loadFile registers the file descriptor and its dependencies in descriptors.
+func loadFile(fdProto *descriptorpb.FileDescriptorProto) (protoreflect.FileDescriptor, error) {
+	if fd, err := descriptors.FindFileByPath(fdProto.GetName()); err == nil {
+		return fd, nil
+	}
+
+	for _, dep := range fdProto.Dependency {
+		if _, err := descriptors.FindFileByPath(dep); err == nil {
+			continue
+		}
+		if gzipped := gogoproto.FileDescriptor(dep); gzipped != nil {
+			depProto, err := unzipFileDescriptor(gzipped)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := loadFile(depProto); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		// the files registered under another path to gogoproto, like
+		// gogoproto/gogo.proto, are resolved from the protobuf registry, or
+		// left unresolved as they only define options
+		if fd, err := protoregistry.GlobalFiles.FindFileByPath(dep); err == nil {
+			if err := descriptors.RegisterFile(fd); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	fd, err := protodesc.FileOptions{AllowUnresolvable: true}.New(fdProto, descriptors)
+	if err != nil {
+		return nil, err
+	}
+	if err := descriptors.RegisterFile(fd); err != nil {
+		return nil, err
+	}
+	return fd, nil
+}
+
+func unzipFileDescriptor(gzipped []byte) (*descriptorpb.FileDescriptorProto, error) {
+	r, err := gzip.NewReader(bytes.NewReader(gzipped))
+	if err != nil {
+		return nil, err
+	}
+	bz, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	fdProto := &descriptorpb.FileDescriptorProto{}
+	if err := proto.Unmarshal(bz, fdProto); err != nil {
+		return nil, err
+	}
+	return fdProto, nil
+}
+
+// toSnakeCase converts a CamelCase name to snake_case.
+func toSnakeCase(name string) string {
+	var sb strings.Builder
+	for i, c := range name {
+		if unicode.IsUpper(c) {
+			if i > 0 {
+				sb.WriteByte('_')
+			}
+			c = unicode.ToLower(c)
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
@@ -0,0 +1,107 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+func setupFuzzer(t *testing.T) (*simapp.SimApp, sdk.Context, []simtypes.Account) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	accs := simtypes.RandomAccounts(rand.New(rand.NewSource(1)), 3)
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 200)))
+	for _, acc := range accs {
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, acc.Address))
+		require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, acc.Address, initCoins))
+	}
+
+	return app, ctx, accs
+}
+
+func TestMsgFuzzerGenerate(t *testing.T) {
+	app, ctx, accs := setupFuzzer(t)
+	r := rand.New(rand.NewSource(1))
+	fuzzer := simulation.MsgFuzzer{Msg: &banktypes.MsgSend{}, Signer: "from_address"}
+	require.Equal(t, "op_weight_msg_send", fuzzer.AppParamsKey())
+
+	for i := 0; i < 20; i++ {
+		spendable := app.BankKeeper.SpendableCoins(ctx, accs[0].Address)
+		msg, spent, err := fuzzer.Generate(r, ctx, accs, accs[0], spendable)
+		require.NoError(t, err)
+
+		send, ok := msg.(*banktypes.MsgSend)
+		require.True(t, ok)
+		require.Equal(t, accs[0].Address.String(), send.FromAddress)
+		require.Equal(t, send.Amount, spent)
+		require.True(t, spendable.IsAllGTE(send.Amount))
+		_, err = sdk.AccAddressFromBech32(send.ToAddress)
+		require.NoError(t, err)
+	}
+}
+
+func TestMsgFuzzerGenerateWithConstraints(t *testing.T) {
+	app, ctx, accs := setupFuzzer(t)
+	r := rand.New(rand.NewSource(1))
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	fuzzer := simulation.MsgFuzzer{
+		Msg: &feemarket.MsgUpdateParams{},
+		Fields: map[string]simulation.FieldGenerator{
+			"authority":                          simulation.Constant(authority),
+			"params.denom":                       simulation.OneOf(sdk.DefaultBondDenom, "atom"),
+			"params.min_base_fee":                simulation.DecBetween(sdk.ZeroDec(), sdk.NewDecWithPrec(1, 2)),
+			"params.base_fee_change_denominator": simulation.IntBetween(1, 16),
+			"params.elasticity_multiplier":       simulation.IntBetween(1, 4),
+		},
+	}
+
+	for i := 0; i < 20; i++ {
+		msg, spent, err := fuzzer.Generate(r, ctx, accs, accs[0], app.BankKeeper.SpendableCoins(ctx, accs[0].Address))
+		require.NoError(t, err)
+		require.Empty(t, spent)
+
+		update := msg.(*feemarket.MsgUpdateParams)
+		require.Equal(t, authority, update.Authority)
+		require.Contains(t, []string{sdk.DefaultBondDenom, "atom"}, update.Params.Denom)
+		require.True(t, update.Params.MinBaseFee.LTE(sdk.NewDecWithPrec(1, 2)))
+		require.True(t, update.Params.BaseFeeChangeDenominator >= 1 && update.Params.BaseFeeChangeDenominator <= 16)
+		require.True(t, update.Params.ElasticityMultiplier >= 1 && update.Params.ElasticityMultiplier <= 4)
+	}
+}
+
+func TestFuzzedOperations(t *testing.T) {
+	app, ctx, accs := setupFuzzer(t)
+	r := rand.New(rand.NewSource(1))
+	cdc := codec.NewProtoCodec(app.InterfaceRegistry())
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	appParams := simtypes.AppParams{}
+
+	ops := simulation.FuzzedOperations(appParams, cdc, txGen, app.AccountKeeper, app.BankKeeper,
+		simulation.MsgFuzzer{Msg: &banktypes.MsgSend{}, Signer: "from_address", Weight: 100},
+	)
+	require.Len(t, ops, 1)
+	require.Equal(t, 100, ops[0].Weight())
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+	operationMsg, futureOps, err := ops[0].Op()(r, app.BaseApp, ctx, accs, "")
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+	require.Equal(t, banktypes.TypeMsgSend, operationMsg.Name)
+	require.Empty(t, futureOps)
+}
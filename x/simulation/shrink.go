@@ -0,0 +1,260 @@
+package simulation
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// OperationKey identifies a standard operation of a simulation by the height of
+// its block and its index in the block.
+type OperationKey struct {
+	Height int64 `json:"height" yaml:"height"`
+	Index  int   `json:"index" yaml:"index"`
+}
+
+// OperationRecord is a standard operation run by a simulation.
+type OperationRecord struct {
+	OperationKey
+
+	Route string `json:"route" yaml:"route"`
+	Name  string `json:"name" yaml:"name"`
+	OK    bool   `json:"ok" yaml:"ok"`
+}
+
+func (r OperationRecord) String() string {
+	return fmt.Sprintf("block %d, operation %d: %s/%s (ok: %t)", r.Height, r.Index, r.Route, r.Name, r.OK)
+}
+
+// ShrinkApp is a fresh application, and what it is simulated with, against
+// which the operations of a failing simulation are replayed while shrinking it.
+type ShrinkApp struct {
+	App          *baseapp.BaseApp
+	AppStateFn   simulation.AppStateFn
+	RandAccFn    simulation.RandomAccountFn
+	Ops          WeightedOperations
+	BlockedAddrs map[string]bool
+	Cdc          codec.JSONCodec
+	// Invariants are checked as the post-condition of the simulation.
+	Invariants []crisistypes.InvarRoute
+}
+
+// Failure is the failure of a simulation, either a broken invariant or a
+// panic, including the ones of the crisis module and the fatal errors of the
+// operations.
+type Failure struct {
+	// Invariant is the full route of the broken invariant, empty for a panic.
+	Invariant string `json:"invariant,omitempty" yaml:"invariant,omitempty"`
+	Msg       string `json:"msg" yaml:"msg"`
+}
+
+func (f Failure) String() string {
+	if f.Invariant == "" {
+		return fmt.Sprintf("panic: %s", f.Msg)
+	}
+
+	return fmt.Sprintf("broken invariant %s: %s", f.Invariant, f.Msg)
+}
+
+// same reports whether f and other are the same failure, regardless of their
+// messages which depend on the state.
+func (f *Failure) same(other *Failure) bool {
+	return other != nil && f.Invariant == other.Invariant
+}
+
+// Reproduction is a minimal sequence of operations of a simulation reproducing
+// its failure.
+type Reproduction struct {
+	Seed    int64   `json:"seed" yaml:"seed"`
+	Failure Failure `json:"failure" yaml:"failure"`
+	// Skipped are the standard operations of the simulation of Seed not run to
+	// reproduce the failure.
+	Skipped []OperationKey `json:"skipped" yaml:"skipped"`
+	// Operations are the standard operations run to reproduce the failure.
+	Operations []OperationRecord `json:"operations" yaml:"operations"`
+	// Runs is the number of simulations run to shrink the operations.
+	Runs int `json:"runs" yaml:"runs"`
+}
+
+func (r Reproduction) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s\nreproduced with seed %d by %d operations, shrunk in %d runs:\n", r.Failure, r.Seed, len(r.Operations), r.Runs)
+	for _, op := range r.Operations {
+		fmt.Fprintf(&sb, "\t%s\n", op)
+	}
+
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+// ShrinkFailure runs the simulation of config against a fresh application
+// returned by newApp and, if it fails, shrinks its standard operations to a
+// minimal sequence that still fails the same way: it repeatedly replays the
+// simulation against a fresh application without a subset of the operations,
+// discarding the subset when the simulation still fails. The simulations are
+// deterministic, so the failure is reproduced by the seed and the skipped
+// operations of the returned Reproduction. At most maxRuns simulations are run, a
+// zero maxRuns meaning no limit. It returns nil if the simulation does not
+// fail.
+func ShrinkFailure(tb testing.TB, w io.Writer, newApp func() ShrinkApp, config simulation.Config, maxRuns int) *Reproduction {
+	tb.Helper()
+
+	records, failure := replay(tb, newApp, config, nil)
+	if failure == nil {
+		return nil
+	}
+	fmt.Fprintf(w, "Shrinking %d operations of seed %d failing with %s\n", len(records), config.Seed, failure)
+
+	repro := &Reproduction{Seed: config.Seed, Failure: *failure, Operations: records, Runs: 1}
+	kept := operationKeys(records)
+
+	// try removes the operations of kept in [start, end), keeping the
+	// reproduction if the simulation still fails the same way
+	try := func(start, end int) bool {
+		skip := make(map[OperationKey]bool, len(repro.Skipped)+end-start)
+		for _, key := range repro.Skipped {
+			skip[key] = true
+		}
+		for _, key := range kept[start:end] {
+			skip[key] = true
+		}
+
+		repro.Runs++
+		records, f := replay(tb, newApp, config, skip)
+		if !failure.same(f) {
+			return false
+		}
+
+		repro.Failure, repro.Operations = *f, records
+		repro.Skipped = append(repro.Skipped, kept[start:end]...)
+		// skipping operations can change the ones queued by them, and thus the
+		// randomness and the operations of the following blocks
+		kept = operationKeys(records)
+		fmt.Fprintf(w, "\rShrunk to %d operations in %d runs. ", len(kept), repro.Runs)
+
+		return true
+	}
+
+	// delta debugging: remove chunks of operations, halving their size when
+	// none of them can be removed
+	chunks := 2
+	if len(kept) > 0 && !try(0, len(kept)) {
+		for len(kept) > 0 && (maxRuns == 0 || repro.Runs < maxRuns) {
+			size := (len(kept) + chunks - 1) / chunks
+			removed := false
+			for start := 0; start < len(kept) && (maxRuns == 0 || repro.Runs < maxRuns); start += size {
+				end := start + size
+				if end > len(kept) {
+					end = len(kept)
+				}
+				if try(start, end) {
+					removed = true
+					break
+				}
+			}
+
+			switch {
+			case removed:
+				if chunks > 2 {
+					chunks--
+				}
+			case size == 1:
+				fmt.Fprintln(w)
+				return repro
+			default:
+				chunks *= 2
+				if chunks > len(kept) {
+					chunks = len(kept)
+				}
+			}
+		}
+	}
+
+	fmt.Fprintln(w)
+	return repro
+}
+
+func operationKeys(records []OperationRecord) []OperationKey {
+	keys := make([]OperationKey, len(records))
+	for i, record := range records {
+		keys[i] = record.OperationKey
+	}
+
+	return keys
+}
+
+// failNow is the value panicked with by shrinkTB to stop a simulation.
+type failNow struct {
+	msg string
+}
+
+// shrinkTB stops the simulations replayed while shrinking by panicking instead
+// of failing the test.
+type shrinkTB struct {
+	testing.TB
+}
+
+func (tb shrinkTB) Fatalf(format string, args ...interface{}) {
+	panic(failNow{msg: fmt.Sprintf(format, args...)})
+}
+
+func (tb shrinkTB) FailNow() {
+	panic(failNow{msg: "simulation failed"})
+}
+
+// replay runs the simulation of config against a fresh application, without
+// the skipped standard operations, and returns the operations run and the
+// failure of the simulation, nil if it did not fail.
+func replay(tb testing.TB, newApp func() ShrinkApp, config simulation.Config, skip map[OperationKey]bool) (records []OperationRecord, failure *Failure) {
+	sa := newApp()
+	run := &simRun{replay: true, skip: skip}
+
+	defer func() {
+		if r := recover(); r != nil {
+			msg := fmt.Sprint(r)
+			if fn, ok := r.(failNow); ok {
+				msg = fn.msg
+			}
+			records, failure = run.records, &Failure{Msg: msg}
+		}
+	}()
+
+	_, _, err := simulateFromSeed(
+		shrinkTB{tb}, io.Discard, sa.App, sa.AppStateFn, sa.RandAccFn, sa.Ops, sa.BlockedAddrs, config, sa.Cdc, run,
+	)
+	if err != nil {
+		return run.records, &Failure{Msg: err.Error()}
+	}
+
+	// the committed state is read from the check state, which is reset on commit
+	ctx := sa.App.NewContext(config.Commit, run.lastHeader)
+	for _, ir := range sa.Invariants {
+		if msg, broken := ir.Invar(ctx); broken {
+			return run.records, &Failure{Invariant: ir.FullRoute(), Msg: msg}
+		}
+	}
+
+	return run.records, nil
+}
+
+// Reproduce replays the operations of the reproduction, with the config of the
+// shrunk simulation, against a fresh application returned by newApp, e.g. to
+// debug it. It returns the failure of the simulation, nil if it did not fail.
+func Reproduce(tb testing.TB, newApp func() ShrinkApp, config simulation.Config, repro *Reproduction) *Failure {
+	tb.Helper()
+
+	skip := make(map[OperationKey]bool, len(repro.Skipped))
+	for _, key := range repro.Skipped {
+		skip[key] = true
+	}
+
+	config.Seed = repro.Seed
+	_, failure := replay(tb, newApp, config, skip)
+
+	return failure
+}
@@ -0,0 +1,113 @@
+package simulation_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+var counterKey = []byte("shrink_test_counter")
+
+// newCounterApp returns a SimApp whose only operations are no-ops and
+// increments of a counter, and whose invariant breaks once the counter reaches
+// limit.
+func newCounterApp(limit uint64) func() simulation.ShrinkApp {
+	return func() simulation.ShrinkApp {
+		encCfg := simapp.MakeTestEncodingConfig()
+		app := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, simapp.DefaultNodeHome, 0, encCfg, simapp.EmptyAppOptions{})
+		storeKey := app.GetKey(minttypes.StoreKey)
+
+		counter := func(ctx sdk.Context) uint64 {
+			bz := ctx.KVStore(storeKey).Get(counterKey)
+			if bz == nil {
+				return 0
+			}
+			return binary.BigEndian.Uint64(bz)
+		}
+		increment := func(_ *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, _ []simtypes.Account, _ string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+			ctx.KVStore(storeKey).Set(counterKey, sdk.Uint64ToBigEndian(counter(ctx)+1))
+			return simtypes.OperationMsg{Route: "test", Name: "increment", OK: true}, nil, nil
+		}
+		noop := func(_ *rand.Rand, _ *baseapp.BaseApp, _ sdk.Context, _ []simtypes.Account, _ string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+			return simtypes.NoOpMsg("test", "noop", ""), nil, nil
+		}
+
+		return simulation.ShrinkApp{
+			App:        app.BaseApp,
+			AppStateFn: simapp.AppStateFn(app.AppCodec(), app.SimulationManager()),
+			// a few accounts keep the genesis, replayed by every run, fast
+			RandAccFn: func(r *rand.Rand, _ int) []simtypes.Account {
+				return simtypes.RandomAccounts(r, 30)
+			},
+			Ops: simulation.WeightedOperations{
+				simulation.NewWeightedOperation(80, noop),
+				simulation.NewWeightedOperation(20, increment),
+			},
+			BlockedAddrs: app.ModuleAccountAddrs(),
+			Cdc:          app.AppCodec(),
+			Invariants: []crisistypes.InvarRoute{
+				crisistypes.NewInvarRoute("test", "counter", func(ctx sdk.Context) (string, bool) {
+					n := counter(ctx)
+					return fmt.Sprintf("counter is %d", n), n >= limit
+				}),
+			},
+		}
+	}
+}
+
+func shrinkConfig() simtypes.Config {
+	return simtypes.Config{
+		Seed:               7,
+		InitialBlockHeight: 1,
+		NumBlocks:          10,
+		BlockSize:          10,
+		Commit:             true,
+		ChainID:            "shrink-test",
+	}
+}
+
+func TestShrinkFailure(t *testing.T) {
+	newApp := newCounterApp(3)
+	config := shrinkConfig()
+
+	repro := simulation.ShrinkFailure(t, io.Discard, newApp, config, 0)
+	require.NotNil(t, repro)
+	require.Equal(t, "test/counter", repro.Failure.Invariant)
+	require.Equal(t, "counter is 3", repro.Failure.Msg)
+	require.NotEmpty(t, repro.Skipped)
+
+	// only the increments needed to break the invariant are kept
+	require.Len(t, repro.Operations, 3)
+	for _, op := range repro.Operations {
+		require.Equal(t, "increment", op.Name)
+	}
+
+	failure := simulation.Reproduce(t, newApp, config, repro)
+	require.NotNil(t, failure)
+	require.Equal(t, repro.Failure, *failure)
+}
+
+func TestShrinkFailureMaxRuns(t *testing.T) {
+	repro := simulation.ShrinkFailure(t, io.Discard, newCounterApp(3), shrinkConfig(), 2)
+	require.NotNil(t, repro)
+	require.Equal(t, 2, repro.Runs)
+	require.Greater(t, len(repro.Operations), 3)
+}
+
+func TestShrinkFailureNoFailure(t *testing.T) {
+	require.Nil(t, simulation.ShrinkFailure(t, io.Discard, newCounterApp(1000), shrinkConfig(), 0))
+}
@@ -59,9 +59,44 @@ func SimulateFromSeed(
 	blockedAddrs map[string]bool,
 	config simulation.Config,
 	cdc codec.JSONCodec,
+) (stopEarly bool, exportedParams Params, err error) {
+	return simulateFromSeed(tb, w, app, appStateFn, randAccFn, ops, blockedAddrs, config, cdc, &simRun{})
+}
+
+// simRun holds the options and the records of a run of the simulation.
+type simRun struct {
+	// replay is set when the run replays a sequence of operations while
+	// shrinking it, in which case no logs are written and tb is not a
+	// *testing.T or *testing.B.
+	replay bool
+	// skip holds the standard operations that are not run.
+	skip map[OperationKey]bool
+	// records are the standard operations run.
+	records []OperationRecord
+	// lastHeader is the header of the block following the last simulated one.
+	lastHeader tmproto.Header
+}
+
+func simulateFromSeed(
+	tb testing.TB,
+	w io.Writer,
+	app *baseapp.BaseApp,
+	appStateFn simulation.AppStateFn,
+	randAccFn simulation.RandomAccountFn,
+	ops WeightedOperations,
+	blockedAddrs map[string]bool,
+	config simulation.Config,
+	cdc codec.JSONCodec,
+	run *simRun,
 ) (stopEarly bool, exportedParams Params, err error) {
 	// in case we have to end early, don't os.Exit so that we can run cleanup code.
-	testingMode, _, b := getTestingMode(tb)
+	var (
+		testingMode = true
+		b           *testing.B
+	)
+	if !run.replay {
+		testingMode, _, b = getTestingMode(tb)
+	}
 
 	fmt.Fprintf(w, "Starting SimulateFromSeed with randomness created with seed %d\n", int(config.Seed))
 	r := rand.New(rand.NewSource(config.Seed))
@@ -81,7 +116,7 @@ func SimulateFromSeed(
 
 	config.ChainID = chainID
 
-	fmt.Printf(
+	fmt.Fprintf(w,
 		"Starting the simulation from time %v (unixtime %v)\n",
 		genesisTimestamp.UTC().Format(time.UnixDate), genesisTimestamp.Unix(),
 	)
@@ -110,11 +145,20 @@ func SimulateFromSeed(
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
 
+	done := make(chan struct{})
+	defer func() {
+		signal.Stop(c)
+		close(done)
+	}()
+
 	go func() {
-		receivedSignal := <-c
-		fmt.Fprintf(w, "\nExiting early due to %s, on block %d, operation %d\n", receivedSignal, header.Height, opCount)
-		err = fmt.Errorf("exited due to %s", receivedSignal)
-		stopEarly = true
+		select {
+		case receivedSignal := <-c:
+			fmt.Fprintf(w, "\nExiting early due to %s, on block %d, operation %d\n", receivedSignal, header.Height, opCount)
+			err = fmt.Errorf("exited due to %s", receivedSignal)
+			stopEarly = true
+		case <-done:
+		}
 	}()
 
 	var (
@@ -130,11 +174,11 @@ func SimulateFromSeed(
 
 	var timeOperationQueue []simulation.FutureOperation
 
-	logWriter := NewLogWriter(testingMode)
+	logWriter := NewLogWriter(testingMode && !run.replay)
 
 	blockSimulator := createBlockSimulator(
 		testingMode, tb, w, params, eventStats.Tally,
-		ops, operationQueue, timeOperationQueue, logWriter, config)
+		ops, operationQueue, timeOperationQueue, logWriter, config, run)
 
 	if !testingMode {
 		b.ResetTimer()
@@ -221,6 +265,8 @@ func SimulateFromSeed(
 		}
 	}
 
+	run.lastHeader = header
+
 	if stopEarly {
 		if config.ExportStatsPath != "" {
 			fmt.Println("Exporting simulation statistics...")
@@ -256,7 +302,7 @@ type blockSimFn func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 func createBlockSimulator(testingMode bool, tb testing.TB, w io.Writer, params Params,
 	event func(route, op, evResult string), ops WeightedOperations,
 	operationQueue OperationQueue, timeOperationQueue []simulation.FutureOperation,
-	logWriter LogWriter, config simulation.Config) blockSimFn {
+	logWriter LogWriter, config simulation.Config, run *simRun) blockSimFn {
 
 	lastBlockSizeState := 0 // state for [4 * uniform distribution]
 	blocksize := 0
@@ -289,10 +335,16 @@ func createBlockSimulator(testingMode bool, tb testing.TB, w io.Writer, params P
 
 		for i := 0; i < blocksize; i++ {
 			// NOTE: the Rand 'r' should not be used here.
+			key := OperationKey{Height: header.Height, Index: i}
+			if run.skip[key] {
+				continue
+			}
+
 			opAndR := opAndRz[i]
 			op, r2 := opAndR.op, opAndR.rand
 			opMsg, futureOps, err := op(r2, app, ctx, accounts, config.ChainID)
 			opMsg.LogEvent(event)
+			run.records = append(run.records, OperationRecord{OperationKey: key, Route: opMsg.Route, Name: opMsg.Name, OK: opMsg.OK})
 
 			if !config.Lean || opMsg.OK {
 				logWriter.AddEntry(MsgEntry(header.Height, int64(i), opMsg))
@@ -20,7 +20,7 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	slashingTxCmd.AddCommand(NewUnjailTxCmd())
+	slashingTxCmd.AddCommand(NewUnjailTxCmd(), NewExpeditedUnjailTxCmd())
 	return slashingTxCmd
 }
 
@@ -50,3 +50,36 @@ $ <appd> tx slashing unjail --from mykey
 
 	return cmd
 }
+
+func NewExpeditedUnjailTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expedited-unjail [max-fee]",
+		Args:  cobra.ExactArgs(1),
+		Short: "unjail validator jailed for downtime before the end of its jail period, paying the expedited unjail fee",
+		Long: `unjail a validator jailed for downtime before the end of its jail period, paying
+the expedited unjail fee, which must not exceed max-fee:
+
+$ <appd> tx slashing expedited-unjail 1000stake --from mykey
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := clientCtx.GetFromAddress()
+
+			maxFee, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExpeditedUnjail(sdk.ValAddress(valAddr), maxFee)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,8 @@
 package slashing
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -39,7 +41,16 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, stakingKeeper types.Stak
 		}
 	}
 
+	for _, entry := range data.AutoUnjailQueue {
+		address, err := sdk.ConsAddressFromBech32(entry.Address)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetAutoUnjail(ctx, entry.UnjailTime, address)
+	}
+
 	keeper.SetParams(ctx, data.Params)
+	keeper.SetUnjailParams(ctx, data.UnjailParams)
 }
 
 // ExportGenesis writes the current store values
@@ -66,5 +77,18 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	autoUnjailQueue := make([]types.AutoUnjail, 0)
+	keeper.IterateAutoUnjailQueue(ctx, func(unjailTime time.Time, address sdk.ConsAddress) (stop bool) {
+		autoUnjailQueue = append(autoUnjailQueue, types.AutoUnjail{
+			Address:    address.String(),
+			UnjailTime: unjailTime,
+		})
+		return false
+	})
+
+	genState := types.NewGenesisState(params, signingInfos, missedBlocks)
+	genState.UnjailParams = keeper.GetUnjailParams(ctx)
+	genState.AutoUnjailQueue = autoUnjailQueue
+
+	return genState
 }
@@ -57,3 +57,31 @@ func TestExportAndInitGenesis(t *testing.T) {
 	require.Equal(t, info1, newInfo1)
 	require.Equal(t, info2, newInfo2)
 }
+
+func TestExportAndInitGenesisAutoUnjailQueue(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	unjailParams := types.NewUnjailParams(time.Hour, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	app.SlashingKeeper.SetUnjailParams(ctx, unjailParams)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	unjailTime := time.Unix(1000, 0).UTC()
+	app.SlashingKeeper.SetAutoUnjail(ctx, unjailTime.Add(time.Hour), sdk.ConsAddress(addrDels[0]))
+	app.SlashingKeeper.SetAutoUnjail(ctx, unjailTime, sdk.ConsAddress(addrDels[1]))
+
+	genesisState := slashing.ExportGenesis(ctx, app.SlashingKeeper)
+	require.Equal(t, unjailParams, genesisState.UnjailParams)
+	require.Equal(t, []types.AutoUnjail{
+		{Address: sdk.ConsAddress(addrDels[1]).String(), UnjailTime: unjailTime},
+		{Address: sdk.ConsAddress(addrDels[0]).String(), UnjailTime: unjailTime.Add(time.Hour)},
+	}, genesisState.AutoUnjailQueue)
+	require.NoError(t, types.ValidateGenesis(*genesisState))
+
+	app = simapp.Setup(t, false)
+	ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	slashing.InitGenesis(ctx, app.SlashingKeeper, app.StakingKeeper, genesisState)
+
+	require.Equal(t, unjailParams, app.SlashingKeeper.GetUnjailParams(ctx))
+	require.Equal(t, genesisState.AutoUnjailQueue, slashing.ExportGenesis(ctx, app.SlashingKeeper).AutoUnjailQueue)
+}
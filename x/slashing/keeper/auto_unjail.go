@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// ScheduleAutoUnjail schedules the automatic unjailing of a validator jailed
+// for downtime, once the auto unjail period is over and not before the end of
+// its jail period. It does nothing if the automatic unjailing is disabled.
+func (k Keeper) ScheduleAutoUnjail(ctx sdk.Context, consAddr sdk.ConsAddress, jailedUntil time.Time) {
+	params := k.GetUnjailParams(ctx)
+	if !params.AutoUnjailEnabled() {
+		return
+	}
+
+	unjailTime := ctx.BlockHeader().Time.Add(params.AutoUnjailPeriod)
+	if unjailTime.Before(jailedUntil) {
+		unjailTime = jailedUntil
+	}
+
+	k.SetAutoUnjail(ctx, unjailTime, consAddr)
+}
+
+// SetAutoUnjail inserts a validator in the auto unjail queue.
+func (k Keeper) SetAutoUnjail(ctx sdk.Context, unjailTime time.Time, consAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoUnjailQueueKey(unjailTime, consAddr), []byte{})
+}
+
+// IterateAutoUnjailQueue iterates over the auto unjail queue, by unjail time.
+func (k Keeper) IterateAutoUnjailQueue(ctx sdk.Context, cb func(unjailTime time.Time, consAddr sdk.ConsAddress) (stop bool)) {
+	k.iterateAutoUnjailQueue(ctx, types.AutoUnjailQueueKeyPrefix, sdk.PrefixEndBytes(types.AutoUnjailQueueKeyPrefix), cb)
+}
+
+func (k Keeper) iterateAutoUnjailQueue(ctx sdk.Context, start, end []byte, cb func(unjailTime time.Time, consAddr sdk.ConsAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, end)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		unjailTime, consAddr, err := types.ParseAutoUnjailQueueKey(iter.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		if cb(unjailTime, consAddr) {
+			break
+		}
+	}
+}
+
+// ProcessAutoUnjailQueue unjails the validators of the auto unjail queue whose
+// unjail time is over, removing them from the queue. The validators which
+// can't be unjailed, e.g. because they were unjailed or tombstoned since, are
+// skipped, and none is unjailed if the automatic unjailing was disabled since.
+func (k Keeper) ProcessAutoUnjailQueue(ctx sdk.Context) {
+	blockTime := ctx.BlockHeader().Time
+
+	type entry struct {
+		unjailTime time.Time
+		consAddr   sdk.ConsAddress
+	}
+	var matured []entry
+	k.iterateAutoUnjailQueue(ctx, types.AutoUnjailQueueKeyPrefix, sdk.PrefixEndBytes(types.AutoUnjailQueueTimeKey(blockTime)),
+		func(unjailTime time.Time, consAddr sdk.ConsAddress) bool {
+			matured = append(matured, entry{unjailTime, consAddr})
+			return false
+		},
+	)
+	if len(matured) == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	enabled := k.GetUnjailParams(ctx).AutoUnjailEnabled()
+	for _, e := range matured {
+		store.Delete(types.AutoUnjailQueueKey(e.unjailTime, e.consAddr))
+		if !enabled {
+			continue
+		}
+
+		if err := k.autoUnjail(ctx, e.consAddr); err != nil {
+			k.Logger(ctx).Info("validator not unjailed automatically", "validator", e.consAddr.String(), "err", err)
+		}
+	}
+}
+
+// autoUnjail unjails a validator automatically, the state changes being
+// discarded if it can't be unjailed.
+func (k Keeper) autoUnjail(ctx sdk.Context, consAddr sdk.ConsAddress) error {
+	validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil {
+		return types.ErrNoValidatorForAddress
+	}
+	valAddr := validator.GetOperator()
+
+	cacheCtx, write := ctx.CacheContext()
+	if _, err := k.canUnjail(cacheCtx, valAddr, true); err != nil {
+		return err
+	}
+	if err := k.unjail(cacheCtx, consAddr, valAddr, types.UnjailTypeAuto, nil); err != nil {
+		return err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
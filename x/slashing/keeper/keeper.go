@@ -14,27 +14,47 @@ import (
 
 // Keeper of the slashing store
 type Keeper struct {
-	storeKey   storetypes.StoreKey
-	cdc        codec.BinaryCodec
-	sk         types.StakingKeeper
-	paramspace types.ParamSubspace
+	storeKey         storetypes.StoreKey
+	cdc              codec.BinaryCodec
+	sk               types.StakingKeeper
+	paramspace       types.ParamSubspace
+	bankKeeper       types.BankKeeper
+	feeCollectorName string
+	hooks            types.SlashingHooks
 }
 
-// NewKeeper creates a slashing keeper
-func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, sk types.StakingKeeper, paramspace types.ParamSubspace) Keeper {
+// NewKeeper creates a slashing keeper. The expedited unjail fees are sent to
+// the feeCollectorName module account.
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, sk types.StakingKeeper, paramspace types.ParamSubspace,
+	bk types.BankKeeper, feeCollectorName string,
+) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramspace.HasKeyTable() {
 		paramspace = paramspace.WithKeyTable(types.ParamKeyTable())
 	}
 
 	return Keeper{
-		storeKey:   key,
-		cdc:        cdc,
-		sk:         sk,
-		paramspace: paramspace,
+		storeKey:         key,
+		cdc:              cdc,
+		sk:               sk,
+		paramspace:       paramspace,
+		bankKeeper:       bk,
+		feeCollectorName: feeCollectorName,
 	}
 }
 
+// SetHooks sets the slashing hooks.
+func (k *Keeper) SetHooks(sh types.SlashingHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set slashing hooks twice")
+	}
+
+	k.hooks = sh
+
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -44,3 +44,29 @@ func (k msgServer) Unjail(goCtx context.Context, msg *types.MsgUnjail) (*types.M
 
 	return &types.MsgUnjailResponse{}, nil
 }
+
+// ExpeditedUnjail implements MsgServer.ExpeditedUnjail method.
+// Validators jailed for downtime can pay the expedited unjail fee to unjail
+// themselves before the end of their jail period.
+func (k msgServer) ExpeditedUnjail(goCtx context.Context, msg *types.MsgExpeditedUnjail) (*types.MsgExpeditedUnjailResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := k.Keeper.ExpeditedUnjail(ctx, valAddr, msg.MaxFee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddr),
+		),
+	)
+
+	return &types.MsgExpeditedUnjailResponse{Fee: fee}, nil
+}
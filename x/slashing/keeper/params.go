@@ -52,3 +52,16 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramspace.SetParamSet(ctx, &params)
 }
+
+// GetUnjailParams returns the parameters of the automatic and the expedited
+// unjailing, the zero ones disabling both if unset.
+func (k Keeper) GetUnjailParams(ctx sdk.Context) (params types.UnjailParams) {
+	k.paramspace.GetIfExists(ctx, types.KeyUnjailParams, &params)
+	return params
+}
+
+// SetUnjailParams sets the parameters of the automatic and the expedited
+// unjailing.
+func (k Keeper) SetUnjailParams(ctx sdk.Context, params types.UnjailParams) {
+	k.paramspace.Set(ctx, types.KeyUnjailParams, params)
+}
@@ -9,33 +9,74 @@ import (
 // Unjail calls the staking Unjail function to unjail a validator if the
 // jailed period has concluded
 func (k Keeper) Unjail(ctx sdk.Context, validatorAddr sdk.ValAddress) error {
+	consAddr, err := k.canUnjail(ctx, validatorAddr, true)
+	if err != nil {
+		return err
+	}
+
+	return k.unjail(ctx, consAddr, validatorAddr, types.UnjailTypeManual, nil)
+}
+
+// ExpeditedUnjail unjails a validator jailed for downtime before the end of its
+// jail period, the validator operator paying the expedited unjail fee to the
+// fee collector. It fails if the fee exceeds maxFee, and returns the fee paid.
+func (k Keeper) ExpeditedUnjail(ctx sdk.Context, validatorAddr sdk.ValAddress, maxFee sdk.Coins) (sdk.Coins, error) {
+	params := k.GetUnjailParams(ctx)
+	if !params.ExpeditedUnjailEnabled() {
+		return nil, types.ErrExpeditedUnjailDisabled
+	}
+
+	fee := params.ExpeditedUnjailFee
+	if !fee.IsAllLTE(maxFee) {
+		return nil, sdkerrors.Wrapf(types.ErrExpeditedUnjailFeeTooHigh, "%s > %s", fee, maxFee)
+	}
+
+	consAddr, err := k.canUnjail(ctx, validatorAddr, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(validatorAddr), k.feeCollectorName, fee); err != nil {
+		return nil, err
+	}
+
+	if err := k.unjail(ctx, consAddr, validatorAddr, types.UnjailTypeExpedited, fee); err != nil {
+		return nil, err
+	}
+
+	return fee, nil
+}
+
+// canUnjail checks that a validator can be unjailed, before the end of its jail
+// period unless checkJailPeriod is set, and returns its consensus address.
+func (k Keeper) canUnjail(ctx sdk.Context, validatorAddr sdk.ValAddress, checkJailPeriod bool) (sdk.ConsAddress, error) {
 	validator := k.sk.Validator(ctx, validatorAddr)
 	if validator == nil {
-		return types.ErrNoValidatorForAddress
+		return nil, types.ErrNoValidatorForAddress
 	}
 
 	// cannot be unjailed if no self-delegation exists
 	selfDel := k.sk.Delegation(ctx, sdk.AccAddress(validatorAddr), validatorAddr)
 	if selfDel == nil {
-		return types.ErrMissingSelfDelegation
+		return nil, types.ErrMissingSelfDelegation
 	}
 
 	tokens := validator.TokensFromShares(selfDel.GetShares()).TruncateInt()
 	minSelfBond := validator.GetMinSelfDelegation()
 	if tokens.LT(minSelfBond) {
-		return sdkerrors.Wrapf(
+		return nil, sdkerrors.Wrapf(
 			types.ErrSelfDelegationTooLowToUnjail, "%s less than %s", tokens, minSelfBond,
 		)
 	}
 
 	// cannot be unjailed if not jailed
 	if !validator.IsJailed() {
-		return types.ErrValidatorNotJailed
+		return nil, types.ErrValidatorNotJailed
 	}
 
 	consAddr, err := validator.GetConsAddr()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	// If the validator has a ValidatorSigningInfo object that signals that the
 	// validator was bonded and so we must check that the validator is not tombstoned
@@ -49,15 +90,35 @@ func (k Keeper) Unjail(ctx sdk.Context, validatorAddr sdk.ValAddress) error {
 	if found {
 		// cannot be unjailed if tombstoned
 		if info.Tombstoned {
-			return types.ErrValidatorJailed
+			return nil, types.ErrValidatorJailed
 		}
 
 		// cannot be unjailed until out of jail
-		if ctx.BlockHeader().Time.Before(info.JailedUntil) {
-			return types.ErrValidatorJailed
+		if checkJailPeriod && ctx.BlockHeader().Time.Before(info.JailedUntil) {
+			return nil, types.ErrValidatorJailed
 		}
 	}
 
+	return consAddr, nil
+}
+
+// unjail unjails a validator, emitting the unjail event and calling the
+// AfterValidatorUnjailed hook with the path through which it was unjailed.
+func (k Keeper) unjail(ctx sdk.Context, consAddr sdk.ConsAddress, validatorAddr sdk.ValAddress, unjailType types.UnjailType, fee sdk.Coins) error {
 	k.sk.Unjail(ctx, consAddr)
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyUnjailType, string(unjailType)),
+	}
+	if fee != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyFee, fee.String()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeUnjail, attributes...))
+
+	if k.hooks != nil {
+		return k.hooks.AfterValidatorUnjailed(ctx, consAddr, validatorAddr, unjailType)
+	}
+
 	return nil
 }
@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+// unjailHooks records the paths through which the validators are unjailed.
+type unjailHooks struct {
+	unjailTypes []types.UnjailType
+}
+
+func (h *unjailHooks) AfterValidatorUnjailed(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress, unjailType types.UnjailType) error {
+	h.unjailTypes = append(h.unjailTypes, unjailType)
+	return nil
+}
+
+// setupJailedValidator returns a slashing keeper with recording hooks, and a
+// validator it jailed for downtime.
+func setupJailedValidator(t *testing.T, unjailParams types.UnjailParams) (*simapp.SimApp, sdk.Context, keeper.Keeper, *unjailHooks, sdk.ValAddress) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0).UTC()})
+
+	hooks := &unjailHooks{}
+	k := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.StakingKeeper, app.GetSubspace(types.ModuleName),
+		app.BankKeeper, authtypes.FeeCollectorName,
+	)
+	k = *k.SetHooks(hooks)
+	k.SetUnjailParams(ctx, unjailParams)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	power := int64(100)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(addr, val, power, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	height := int64(0)
+	for ; height < k.SignedBlocksWindow(ctx); height++ {
+		ctx = ctx.WithBlockHeight(height)
+		k.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+	for ; height < k.SignedBlocksWindow(ctx)+(k.SignedBlocksWindow(ctx)-k.MinSignedPerWindow(ctx))+1; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		k.HandleValidatorSignature(ctx, val.Address(), power, false)
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	require.True(t, app.StakingKeeper.Validator(ctx, addr).IsJailed())
+
+	return app, ctx, k, hooks, addr
+}
+
+func TestAutoUnjail(t *testing.T) {
+	period := 2 * types.DefaultDowntimeJailDuration
+	app, ctx, k, hooks, addr := setupJailedValidator(t, types.NewUnjailParams(period, nil))
+	consAddr := sdk.ConsAddress(simapp.CreateTestPubKeys(1)[0].Address())
+
+	// the validator is scheduled to be unjailed once the period is over
+	unjailTime := ctx.BlockTime().Add(period)
+	var queue []time.Time
+	k.IterateAutoUnjailQueue(ctx, func(unjailTime time.Time, address sdk.ConsAddress) bool {
+		require.Equal(t, consAddr, address)
+		queue = append(queue, unjailTime)
+		return false
+	})
+	require.Equal(t, []time.Time{unjailTime}, queue)
+
+	// it is still jailed at the end of its jail period
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultDowntimeJailDuration))
+	k.ProcessAutoUnjailQueue(ctx)
+	require.True(t, app.StakingKeeper.Validator(ctx, addr).IsJailed())
+
+	ctx = ctx.WithBlockTime(unjailTime).WithEventManager(sdk.NewEventManager())
+	k.ProcessAutoUnjailQueue(ctx)
+	require.False(t, app.StakingKeeper.Validator(ctx, addr).IsJailed())
+	require.Equal(t, []types.UnjailType{types.UnjailTypeAuto}, hooks.unjailTypes)
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeUnjail,
+		sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyUnjailType, string(types.UnjailTypeAuto)),
+	))
+
+	k.IterateAutoUnjailQueue(ctx, func(time.Time, sdk.ConsAddress) bool {
+		t.Fatal("the auto unjail queue should be empty")
+		return false
+	})
+}
+
+func TestAutoUnjailAfterManualUnjail(t *testing.T) {
+	period := 2 * types.DefaultDowntimeJailDuration
+	app, ctx, k, hooks, addr := setupJailedValidator(t, types.NewUnjailParams(period, nil))
+
+	// the validator unjails itself at the end of its jail period
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultDowntimeJailDuration))
+	require.NoError(t, k.Unjail(ctx, addr))
+	require.False(t, app.StakingKeeper.Validator(ctx, addr).IsJailed())
+
+	// and is skipped once its auto unjail period is over
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(period))
+	k.ProcessAutoUnjailQueue(ctx)
+	require.Equal(t, []types.UnjailType{types.UnjailTypeManual}, hooks.unjailTypes)
+
+	k.IterateAutoUnjailQueue(ctx, func(time.Time, sdk.ConsAddress) bool {
+		t.Fatal("the auto unjail queue should be empty")
+		return false
+	})
+}
+
+func TestAutoUnjailDisabled(t *testing.T) {
+	app, ctx, k, hooks, addr := setupJailedValidator(t, types.DefaultUnjailParams())
+
+	k.IterateAutoUnjailQueue(ctx, func(time.Time, sdk.ConsAddress) bool {
+		t.Fatal("the auto unjail queue should be empty")
+		return false
+	})
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(365 * 24 * time.Hour))
+	k.ProcessAutoUnjailQueue(ctx)
+	require.True(t, app.StakingKeeper.Validator(ctx, addr).IsJailed())
+	require.Empty(t, hooks.unjailTypes)
+}
+
+func TestExpeditedUnjail(t *testing.T) {
+	app, ctx, k, hooks, addr := setupJailedValidator(t, types.DefaultUnjailParams())
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	fee := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))
+
+	// the validator can't unjail itself before the end of its jail period
+	require.ErrorIs(t, k.Unjail(ctx, addr), types.ErrValidatorJailed)
+
+	_, err := k.ExpeditedUnjail(ctx, addr, fee)
+	require.ErrorIs(t, err, types.ErrExpeditedUnjailDisabled)
+
+	k.SetUnjailParams(ctx, types.NewUnjailParams(0, fee))
+	_, err = k.ExpeditedUnjail(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 99)))
+	require.ErrorIs(t, err, types.ErrExpeditedUnjailFeeTooHigh)
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	balance := app.BankKeeper.GetAllBalances(ctx, sdk.AccAddress(addr))
+	collected := app.BankKeeper.GetAllBalances(ctx, feeCollector)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	paid, err := k.ExpeditedUnjail(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)))
+	require.NoError(t, err)
+	require.Equal(t, fee, paid)
+	require.False(t, app.StakingKeeper.Validator(ctx, addr).IsJailed())
+	require.Equal(t, []types.UnjailType{types.UnjailTypeExpedited}, hooks.unjailTypes)
+
+	require.Equal(t, balance.Sub(fee...), app.BankKeeper.GetAllBalances(ctx, sdk.AccAddress(addr)))
+	require.Equal(t, collected.Add(fee...), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+
+	consAddr := sdk.ConsAddress(simapp.CreateTestPubKeys(1)[0].Address())
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeUnjail,
+		sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyUnjailType, string(types.UnjailTypeExpedited)),
+		sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+	))
+
+	_, err = k.ExpeditedUnjail(ctx, addr, fee)
+	require.ErrorIs(t, err, types.ErrValidatorNotJailed)
+}
@@ -16,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	"github.com/cosmos/cosmos-sdk/x/slashing/simulation"
@@ -156,6 +157,14 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 2 }
 
+// OrderingDependencies implements module.HasOrderingDependencies. The slashing BeginBlock
+// must run after the distribution BeginBlock so that there is nothing left over in the
+// fee pool of the validators slashed in the block, keeping the distribution
+// CanWithdrawInvariant invariant.
+func (AppModule) OrderingDependencies() module.OrderingDependencies {
+	return module.OrderingDependencies{BeginBlock: []string{distrtypes.ModuleName}}
+}
+
 // BeginBlock returns the begin blocker for the slashing module.
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	BeginBlocker(ctx, req, am.keeper)
@@ -13,4 +13,6 @@ var (
 	ErrMissingSelfDelegation        = sdkerrors.Register(ModuleName, 6, "validator has no self-delegation; cannot be unjailed")
 	ErrSelfDelegationTooLowToUnjail = sdkerrors.Register(ModuleName, 7, "validator's self delegation less than minimum; cannot be unjailed")
 	ErrNoSigningInfoFound           = sdkerrors.Register(ModuleName, 8, "no validator signing info found")
+	ErrExpeditedUnjailDisabled      = sdkerrors.Register(ModuleName, 9, "expedited unjail disabled")
+	ErrExpeditedUnjailFeeTooHigh    = sdkerrors.Register(ModuleName, 10, "expedited unjail fee exceeds the max fee")
 )
@@ -4,6 +4,7 @@ package types
 const (
 	EventTypeSlash    = "slash"
 	EventTypeLiveness = "liveness"
+	EventTypeUnjail   = "unjail"
 
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
@@ -12,6 +13,8 @@ const (
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
 	AttributeKeyBurnedCoins  = "burned_coins"
+	AttributeKeyUnjailType   = "unjail_type"
+	AttributeKeyFee          = "fee"
 
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
@@ -30,26 +30,28 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:          DefaultParams(),
+		SigningInfos:    []SigningInfo{},
+		MissedBlocks:    []ValidatorMissedBlocks{},
+		UnjailParams:    DefaultUnjailParams(),
+		AutoUnjailQueue: []AutoUnjail{},
 	}
 }
 
 // ValidateGenesis validates the slashing genesis parameters
 func ValidateGenesis(data GenesisState) error {
 	downtime := data.Params.SlashFractionDowntime
-	if downtime.IsNegative() || downtime.GT(sdk.OneDec()) {
+	if !downtime.IsBetween(sdk.ZeroDec(), sdk.OneDec(), true) {
 		return fmt.Errorf("slashing fraction downtime should be less than or equal to one and greater than zero, is %s", downtime.String())
 	}
 
 	dblSign := data.Params.SlashFractionDoubleSign
-	if dblSign.IsNegative() || dblSign.GT(sdk.OneDec()) {
+	if !dblSign.IsBetween(sdk.ZeroDec(), sdk.OneDec(), true) {
 		return fmt.Errorf("slashing fraction double sign should be less than or equal to one and greater than zero, is %s", dblSign.String())
 	}
 
 	minSign := data.Params.MinSignedPerWindow
-	if minSign.IsNegative() || minSign.GT(sdk.OneDec()) {
+	if !minSign.IsBetween(sdk.ZeroDec(), sdk.OneDec(), true) {
 		return fmt.Errorf("min signed per window should be less than or equal to one and greater than zero, is %s", minSign.String())
 	}
 
@@ -63,5 +65,15 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := data.UnjailParams.Validate(); err != nil {
+		return err
+	}
+
+	for _, entry := range data.AutoUnjailQueue {
+		if _, err := sdk.ConsAddressFromBech32(entry.Address); err != nil {
+			return fmt.Errorf("invalid auto unjail address %s: %w", entry.Address, err)
+		}
+	}
+
 	return nil
 }
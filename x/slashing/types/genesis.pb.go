@@ -8,15 +8,19 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -34,6 +38,11 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// unjail_params defines the parameters of the automatic and the expedited
+	// unjailing.
+	UnjailParams UnjailParams `protobuf:"bytes,4,opt,name=unjail_params,json=unjailParams,proto3" json:"unjail_params"`
+	// auto_unjail_queue holds the validators to unjail automatically.
+	AutoUnjailQueue []AutoUnjail `protobuf:"bytes,5,rep,name=auto_unjail_queue,json=autoUnjailQueue,proto3" json:"auto_unjail_queue"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,6 +99,76 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetUnjailParams() UnjailParams {
+	if m != nil {
+		return m.UnjailParams
+	}
+	return UnjailParams{}
+}
+
+func (m *GenesisState) GetAutoUnjailQueue() []AutoUnjail {
+	if m != nil {
+		return m.AutoUnjailQueue
+	}
+	return nil
+}
+
+// AutoUnjail schedules the automatic unjailing of a validator jailed for
+// downtime.
+type AutoUnjail struct {
+	// address is the consensus address of the validator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// unjail_time is the time from which the validator is unjailed.
+	UnjailTime time.Time `protobuf:"bytes,2,opt,name=unjail_time,json=unjailTime,proto3,stdtime" json:"unjail_time"`
+}
+
+func (m *AutoUnjail) Reset()         { *m = AutoUnjail{} }
+func (m *AutoUnjail) String() string { return proto.CompactTextString(m) }
+func (*AutoUnjail) ProtoMessage()    {}
+func (*AutoUnjail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1923b9188b635394, []int{1}
+}
+func (m *AutoUnjail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoUnjail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoUnjail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoUnjail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoUnjail.Merge(m, src)
+}
+func (m *AutoUnjail) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoUnjail) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoUnjail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoUnjail proto.InternalMessageInfo
+
+func (m *AutoUnjail) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AutoUnjail) GetUnjailTime() time.Time {
+	if m != nil {
+		return m.UnjailTime
+	}
+	return time.Time{}
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -102,7 +181,7 @@ func (m *SigningInfo) Reset()         { *m = SigningInfo{} }
 func (m *SigningInfo) String() string { return proto.CompactTextString(m) }
 func (*SigningInfo) ProtoMessage()    {}
 func (*SigningInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1923b9188b635394, []int{1}
+	return fileDescriptor_1923b9188b635394, []int{2}
 }
 func (m *SigningInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,7 +237,7 @@ func (m *ValidatorMissedBlocks) Reset()         { *m = ValidatorMissedBlocks{} }
 func (m *ValidatorMissedBlocks) String() string { return proto.CompactTextString(m) }
 func (*ValidatorMissedBlocks) ProtoMessage()    {}
 func (*ValidatorMissedBlocks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1923b9188b635394, []int{2}
+	return fileDescriptor_1923b9188b635394, []int{3}
 }
 func (m *ValidatorMissedBlocks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,7 +292,7 @@ func (m *MissedBlock) Reset()         { *m = MissedBlock{} }
 func (m *MissedBlock) String() string { return proto.CompactTextString(m) }
 func (*MissedBlock) ProtoMessage()    {}
 func (*MissedBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1923b9188b635394, []int{3}
+	return fileDescriptor_1923b9188b635394, []int{4}
 }
 func (m *MissedBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,6 +337,7 @@ func (m *MissedBlock) GetMissed() bool {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.slashing.v1beta1.GenesisState")
+	proto.RegisterType((*AutoUnjail)(nil), "cosmos.slashing.v1beta1.AutoUnjail")
 	proto.RegisterType((*SigningInfo)(nil), "cosmos.slashing.v1beta1.SigningInfo")
 	proto.RegisterType((*ValidatorMissedBlocks)(nil), "cosmos.slashing.v1beta1.ValidatorMissedBlocks")
 	proto.RegisterType((*MissedBlock)(nil), "cosmos.slashing.v1beta1.MissedBlock")
@@ -268,34 +348,41 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 420 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6e, 0xd4, 0x30,
-	0x10, 0x86, 0xe3, 0x2e, 0x2c, 0xe0, 0xb4, 0x17, 0x2b, 0x94, 0xd0, 0x43, 0x5a, 0xad, 0x00, 0xf5,
-	0x92, 0x44, 0x5d, 0x8e, 0x88, 0x03, 0xb9, 0x54, 0x1c, 0x50, 0x51, 0x56, 0x42, 0x82, 0x4b, 0xe4,
-	0x6c, 0x5c, 0xd7, 0xea, 0xc6, 0x5e, 0x65, 0xcc, 0xaa, 0xbc, 0x05, 0x0f, 0xc0, 0x23, 0x70, 0xe4,
-	0x21, 0x7a, 0xac, 0x38, 0x71, 0x42, 0x68, 0xf7, 0x29, 0xb8, 0x21, 0x6c, 0x87, 0x8d, 0x60, 0xa3,
-	0x95, 0x38, 0x25, 0x63, 0x7f, 0xf3, 0xcf, 0xcc, 0xef, 0xc1, 0x8f, 0xa7, 0x0a, 0x6a, 0x05, 0x29,
-	0xcc, 0x28, 0x5c, 0x08, 0xc9, 0xd3, 0xc5, 0x49, 0xc9, 0x34, 0x3d, 0x49, 0x39, 0x93, 0x0c, 0x04,
-	0x24, 0xf3, 0x46, 0x69, 0x45, 0x1e, 0x58, 0x2c, 0x69, 0xb1, 0xc4, 0x61, 0x07, 0x01, 0x57, 0x5c,
-	0x19, 0x26, 0xfd, 0xfd, 0x67, 0xf1, 0x83, 0x27, 0x7d, 0xaa, 0x7f, 0xf2, 0x2d, 0xf7, 0xd0, 0x72,
-	0x85, 0x15, 0x70, 0x35, 0x4c, 0x30, 0xfa, 0x89, 0xf0, 0xee, 0xa9, 0xed, 0x61, 0xa2, 0xa9, 0x66,
-	0xe4, 0x39, 0x1e, 0xce, 0x69, 0x43, 0x6b, 0x08, 0xd1, 0x11, 0x3a, 0xf6, 0xc7, 0x87, 0x49, 0x4f,
-	0x4f, 0xc9, 0x6b, 0x83, 0x65, 0xb7, 0xae, 0xbf, 0x1f, 0x7a, 0xb9, 0x4b, 0x22, 0x67, 0x78, 0x0f,
-	0x04, 0x97, 0x42, 0xf2, 0x42, 0xc8, 0x73, 0x05, 0xe1, 0xce, 0xd1, 0xe0, 0xd8, 0x1f, 0x3f, 0xea,
-	0x55, 0x99, 0x58, 0xfa, 0xa5, 0x3c, 0x57, 0x4e, 0x6a, 0x17, 0xd6, 0x47, 0x40, 0xde, 0xe2, 0xbd,
-	0x5a, 0x00, 0xb0, 0xaa, 0x28, 0x67, 0x6a, 0x7a, 0x09, 0xe1, 0xc0, 0x08, 0x26, 0xbd, 0x82, 0x6f,
-	0xe8, 0x4c, 0x54, 0x54, 0xab, 0xe6, 0x95, 0x49, 0xcb, 0x4c, 0x56, 0x2b, 0x5d, 0x77, 0xce, 0x46,
-	0x9f, 0x11, 0xf6, 0x3b, 0xe5, 0xc9, 0x18, 0xdf, 0xa1, 0x55, 0xd5, 0x30, 0xb0, 0xb3, 0xdf, 0xcb,
-	0xc2, 0xaf, 0x5f, 0xe2, 0xc0, 0xd5, 0x79, 0x61, 0x6f, 0x26, 0xba, 0x11, 0x92, 0xe7, 0x2d, 0x48,
-	0x04, 0xde, 0x5f, 0xb4, 0x05, 0x8b, 0xee, 0xe4, 0xe1, 0x8e, 0xb1, 0x2f, 0xde, 0xde, 0xe7, 0xbf,
-	0x0e, 0x04, 0x8b, 0x0d, 0x77, 0xa3, 0x4f, 0x08, 0xdf, 0xdf, 0x38, 0xdc, 0x7f, 0x35, 0x7e, 0xf6,
-	0xb7, 0xaf, 0xdb, 0x1e, 0xaa, 0x53, 0x71, 0xa3, 0x9b, 0xcf, 0xb0, 0xdf, 0x41, 0x48, 0x80, 0x6f,
-	0x0b, 0x59, 0xb1, 0x2b, 0xd3, 0xd1, 0x20, 0xb7, 0x01, 0xd9, 0xc7, 0x43, 0x9b, 0x64, 0xec, 0xb9,
-	0x9b, 0xbb, 0x28, 0x3b, 0xbd, 0x5e, 0x46, 0xe8, 0x66, 0x19, 0xa1, 0x1f, 0xcb, 0x08, 0x7d, 0x5c,
-	0x45, 0xde, 0xcd, 0x2a, 0xf2, 0xbe, 0xad, 0x22, 0xef, 0x5d, 0xcc, 0x85, 0xbe, 0x78, 0x5f, 0x26,
-	0x53, 0x55, 0xbb, 0xcd, 0x75, 0x9f, 0x18, 0xaa, 0xcb, 0xf4, 0x6a, 0xbd, 0xfb, 0xfa, 0xc3, 0x9c,
-	0x41, 0x39, 0x34, 0x6b, 0xfd, 0xf4, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xac, 0xc1, 0x45, 0xfe,
-	0x71, 0x03, 0x00, 0x00,
+	// 538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0x75, 0x2b, 0xc3, 0x6d, 0x85, 0xb0, 0xca, 0x08, 0x3d, 0xa4, 0x53, 0x61, 0x68,
+	0x97, 0x3a, 0x5a, 0x39, 0x22, 0x0e, 0xab, 0x84, 0x26, 0x0e, 0x68, 0xa3, 0x65, 0x48, 0x70, 0x89,
+	0x9c, 0xc6, 0xf5, 0xcc, 0x92, 0xb8, 0xc4, 0x4e, 0x35, 0x9e, 0x80, 0xeb, 0x1e, 0x80, 0x13, 0x67,
+	0x8e, 0x3c, 0xc4, 0x8e, 0x13, 0x27, 0x4e, 0x80, 0xda, 0x17, 0x41, 0xb1, 0x9d, 0x35, 0x40, 0xa3,
+	0x49, 0x3b, 0xb5, 0xf6, 0xf7, 0xff, 0x7e, 0xf9, 0x7f, 0xf9, 0xfe, 0x0a, 0xd8, 0x19, 0x73, 0x11,
+	0x71, 0xe1, 0x8a, 0x10, 0x8b, 0x13, 0x16, 0x53, 0x77, 0xb6, 0xe7, 0x13, 0x89, 0xf7, 0x5c, 0x4a,
+	0x62, 0x22, 0x98, 0x40, 0xd3, 0x84, 0x4b, 0x0e, 0xef, 0x6b, 0x19, 0xca, 0x65, 0xc8, 0xc8, 0xda,
+	0x2d, 0xca, 0x29, 0x57, 0x1a, 0x37, 0xfb, 0xa7, 0xe5, 0xed, 0xc7, 0x65, 0xd4, 0xab, 0x7e, 0xad,
+	0x7b, 0xa0, 0x75, 0x9e, 0x06, 0x98, 0x67, 0xe8, 0x52, 0x87, 0x72, 0x4e, 0x43, 0xe2, 0xaa, 0x93,
+	0x9f, 0x4e, 0x5c, 0xc9, 0x22, 0x22, 0x24, 0x8e, 0xa6, 0x5a, 0xd0, 0xfd, 0x52, 0x05, 0x8d, 0x03,
+	0x6d, 0x72, 0x24, 0xb1, 0x24, 0xf0, 0x19, 0xa8, 0x4d, 0x71, 0x82, 0x23, 0x61, 0x5b, 0xdb, 0xd6,
+	0x6e, 0xbd, 0xdf, 0x41, 0x25, 0xa6, 0xd1, 0x91, 0x92, 0x0d, 0xd6, 0x2f, 0x7e, 0x76, 0x2a, 0x43,
+	0xd3, 0x04, 0x0f, 0x41, 0x53, 0x30, 0x1a, 0xb3, 0x98, 0x7a, 0x2c, 0x9e, 0x70, 0x61, 0xaf, 0x6d,
+	0x57, 0x77, 0xeb, 0xfd, 0x47, 0xa5, 0x94, 0x91, 0x56, 0xbf, 0x88, 0x27, 0xdc, 0xa0, 0x1a, 0x62,
+	0x79, 0x25, 0xe0, 0x5b, 0xd0, 0x8c, 0x98, 0x10, 0x24, 0xf0, 0xfc, 0x90, 0x8f, 0x4f, 0x85, 0x5d,
+	0x55, 0x40, 0x54, 0x0a, 0x7c, 0x83, 0x43, 0x16, 0x60, 0xc9, 0x93, 0x97, 0xaa, 0x6d, 0xa0, 0xba,
+	0x72, 0x74, 0x54, 0xb8, 0x83, 0x47, 0xa0, 0x99, 0xc6, 0xef, 0x31, 0x0b, 0x3d, 0x33, 0xf1, 0xba,
+	0x9a, 0x78, 0xa7, 0x14, 0x7d, 0xac, 0xd4, 0x7f, 0xcd, 0xdd, 0x48, 0x0b, 0x77, 0xf0, 0x18, 0xdc,
+	0xc5, 0xa9, 0xe4, 0x9e, 0xc1, 0x7e, 0x48, 0x49, 0x4a, 0xec, 0x0d, 0x65, 0xf8, 0x61, 0x29, 0x75,
+	0x3f, 0x95, 0x5c, 0x93, 0x0d, 0xf3, 0x0e, 0xbe, 0xba, 0x79, 0x95, 0x11, 0xba, 0x9f, 0x2c, 0x00,
+	0x96, 0x2a, 0xd8, 0x07, 0xb7, 0x70, 0x10, 0x24, 0x44, 0xe8, 0x1d, 0xdd, 0x1e, 0xd8, 0xdf, 0xbf,
+	0xf5, 0x5a, 0x06, 0xbf, 0xaf, 0x2b, 0x23, 0x99, 0xb0, 0x98, 0x0e, 0x73, 0x21, 0x7c, 0x0e, 0xea,
+	0xc6, 0x54, 0x96, 0x00, 0x7b, 0x4d, 0x4d, 0xda, 0x46, 0x3a, 0x1e, 0x28, 0x8f, 0x07, 0x7a, 0x9d,
+	0xc7, 0x63, 0xb0, 0x99, 0x59, 0x39, 0xff, 0xd5, 0xb1, 0x86, 0x40, 0x37, 0x66, 0xa5, 0xee, 0x57,
+	0x0b, 0xd4, 0x0b, 0x1b, 0xbb, 0x91, 0x15, 0x06, 0xb6, 0x66, 0xf9, 0x8e, 0xbc, 0x62, 0x58, 0x8c,
+	0xab, 0xde, 0xf5, 0xab, 0xfd, 0x3f, 0x34, 0xad, 0xd9, 0x8a, 0x5a, 0xf7, 0xb3, 0x05, 0xee, 0xad,
+	0xcc, 0xc3, 0x8d, 0x8c, 0x1f, 0xfe, 0x1b, 0xc5, 0xeb, 0xb2, 0x5d, 0x78, 0xe2, 0xaa, 0x00, 0x76,
+	0x9f, 0x82, 0x7a, 0x41, 0x02, 0x5b, 0x60, 0x83, 0xc5, 0x01, 0x39, 0x53, 0x8e, 0xaa, 0x43, 0x7d,
+	0x80, 0x5b, 0xa0, 0xa6, 0x9b, 0xd4, 0xeb, 0xd9, 0x1c, 0x9a, 0xd3, 0xe0, 0xe0, 0x62, 0xee, 0x58,
+	0x97, 0x73, 0xc7, 0xfa, 0x3d, 0x77, 0xac, 0xf3, 0x85, 0x53, 0xb9, 0x5c, 0x38, 0x95, 0x1f, 0x0b,
+	0xa7, 0xf2, 0xae, 0x47, 0x99, 0x3c, 0x49, 0x7d, 0x34, 0xe6, 0x91, 0xf9, 0x1a, 0x98, 0x9f, 0x9e,
+	0x08, 0x4e, 0xdd, 0xb3, 0xe5, 0xf7, 0x44, 0x7e, 0x9c, 0x12, 0xe1, 0xd7, 0xd4, 0xf6, 0x9f, 0xfc,
+	0x19, 0x00, 0x71, 0x74, 0xec, 0x30, 0xc5, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -318,6 +405,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoUnjailQueue) > 0 {
+		for iNdEx := len(m.AutoUnjailQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoUnjailQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.UnjailParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -359,6 +470,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoUnjail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoUnjail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnjailTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnjailTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SigningInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -512,6 +661,29 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.UnjailParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AutoUnjailQueue) > 0 {
+		for _, e := range m.AutoUnjailQueue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AutoUnjail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnjailTime)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -700,6 +872,188 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnjailParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoUnjailQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoUnjailQueue = append(m.AutoUnjailQueue, AutoUnjail{})
+			if err := m.AutoUnjailQueue[len(m.AutoUnjailQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoUnjail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoUnjail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnjailTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
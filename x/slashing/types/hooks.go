@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UnjailType is the path through which a validator is unjailed.
+type UnjailType string
+
+const (
+	// UnjailTypeManual is the unjailing with a MsgUnjail, once the jail period
+	// is over.
+	UnjailTypeManual UnjailType = "manual"
+	// UnjailTypeAuto is the automatic unjailing, once the auto unjail period is
+	// over.
+	UnjailTypeAuto UnjailType = "auto"
+	// UnjailTypeExpedited is the unjailing with a MsgExpeditedUnjail, paying
+	// the expedited unjail fee before the end of the jail period.
+	UnjailTypeExpedited UnjailType = "expedited"
+)
+
+// SlashingHooks defines the hooks called by the slashing module.
+type SlashingHooks interface {
+	// AfterValidatorUnjailed is called after a validator is unjailed, with the
+	// path through which it was unjailed.
+	AfterValidatorUnjailed(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress, unjailType UnjailType) error
+}
+
+// combine multiple slashing hooks, all hook functions are run in array sequence
+var _ SlashingHooks = MultiSlashingHooks{}
+
+type MultiSlashingHooks []SlashingHooks
+
+func NewMultiSlashingHooks(hooks ...SlashingHooks) MultiSlashingHooks {
+	return hooks
+}
+
+func (h MultiSlashingHooks) AfterValidatorUnjailed(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress, unjailType UnjailType) error {
+	for i := range h {
+		if err := h[i].AfterValidatorUnjailed(ctx, consAddr, valAddr, unjailType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -30,10 +31,13 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<unjailTime_Bytes><consAddrLen (1 Byte)><consAddress_Bytes>: []byte{}
 var (
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	AutoUnjailQueueKeyPrefix              = []byte{0x04} // Prefix for the auto unjail queue
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -67,3 +71,32 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// AutoUnjailQueueTimeKey returns the prefix of the auto unjail queue keys of
+// the validators unjailed at unjailTime.
+func AutoUnjailQueueTimeKey(unjailTime time.Time) []byte {
+	return append(AutoUnjailQueueKeyPrefix, sdk.FormatTimeBytes(unjailTime)...)
+}
+
+// AutoUnjailQueueKey returns the auto unjail queue key of a validator unjailed
+// at unjailTime.
+func AutoUnjailQueueKey(unjailTime time.Time, v sdk.ConsAddress) []byte {
+	return append(AutoUnjailQueueTimeKey(unjailTime), address.MustLengthPrefix(v.Bytes())...)
+}
+
+// ParseAutoUnjailQueueKey returns the unjail time and the consensus address of
+// an auto unjail queue key.
+func ParseAutoUnjailQueueKey(key []byte) (time.Time, sdk.ConsAddress, error) {
+	timeLen := len(sdk.FormatTimeBytes(time.Time{}))
+	kv.AssertKeyAtLeastLength(key, 1+timeLen+1)
+
+	unjailTime, err := sdk.ParseTimeBytes(key[1 : 1+timeLen])
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	addrLen := int(key[1+timeLen])
+	kv.AssertKeyLength(key[2+timeLen:], addrLen)
+
+	return unjailTime, sdk.ConsAddress(key[2+timeLen:]), nil
+}
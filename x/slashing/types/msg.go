@@ -7,11 +7,15 @@ import (
 
 // slashing message types
 const (
-	TypeMsgUnjail = "unjail"
+	TypeMsgUnjail          = "unjail"
+	TypeMsgExpeditedUnjail = "expedited_unjail"
 )
 
 // verify interface at compile time
-var _ sdk.Msg = &MsgUnjail{}
+var (
+	_ sdk.Msg = &MsgUnjail{}
+	_ sdk.Msg = &MsgExpeditedUnjail{}
+)
 
 // NewMsgUnjail creates a new MsgUnjail instance
 //nolint:interfacer
@@ -41,3 +45,35 @@ func (msg MsgUnjail) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgExpeditedUnjail creates a new MsgExpeditedUnjail instance
+func NewMsgExpeditedUnjail(validatorAddr sdk.ValAddress, maxFee sdk.Coins) *MsgExpeditedUnjail {
+	return &MsgExpeditedUnjail{
+		ValidatorAddr: validatorAddr.String(),
+		MaxFee:        maxFee,
+	}
+}
+
+func (msg MsgExpeditedUnjail) Route() string { return RouterKey }
+func (msg MsgExpeditedUnjail) Type() string  { return TypeMsgExpeditedUnjail }
+func (msg MsgExpeditedUnjail) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddr)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgExpeditedUnjail) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgExpeditedUnjail) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddr); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
+	}
+	if !msg.MaxFee.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid max fee: %s", msg.MaxFee)
+	}
+	return nil
+}
@@ -18,3 +18,23 @@ func TestMsgUnjailGetSignBytes(t *testing.T) {
 		string(bytes),
 	)
 }
+
+func TestMsgExpeditedUnjailGetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress("abcd")
+	msg := NewMsgExpeditedUnjail(sdk.ValAddress(addr), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	bytes := msg.GetSignBytes()
+	require.Equal(
+		t,
+		`{"type":"cosmos-sdk/MsgExpeditedUnjail","value":{"address":"cosmosvaloper1v93xxeqhg9nn6","max_fee":[{"amount":"10","denom":"stake"}]}}`,
+		string(bytes),
+	)
+}
+
+func TestMsgExpeditedUnjailValidateBasic(t *testing.T) {
+	valAddr := sdk.ValAddress("abcd")
+
+	require.NoError(t, NewMsgExpeditedUnjail(valAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))).ValidateBasic())
+	require.NoError(t, NewMsgExpeditedUnjail(valAddr, nil).ValidateBasic())
+	require.Error(t, (&MsgExpeditedUnjail{ValidatorAddr: "invalid"}).ValidateBasic())
+	require.Error(t, NewMsgExpeditedUnjail(valAddr, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}).ValidateBasic())
+}
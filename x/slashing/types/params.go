@@ -27,11 +27,15 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+	KeyUnjailParams            = []byte("UnjailParams")
 )
 
-// ParamKeyTable for slashing module
+// ParamKeyTable for slashing module, the UnjailParams being stored under their
+// own key apart from the Params.
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+	return paramtypes.NewKeyTable(
+		paramtypes.NewParamSetPair(KeyUnjailParams, UnjailParams{}, validateUnjailParams),
+	).RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params object
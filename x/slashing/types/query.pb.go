@@ -71,6 +71,9 @@ var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 // QueryParamsResponse is the response type for the Query/Params RPC method
 type QueryParamsResponse struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// unjail_params defines the parameters of the automatic and the expedited
+	// unjailing.
+	UnjailParams UnjailParams `protobuf:"bytes,2,opt,name=unjail_params,json=unjailParams,proto3" json:"unjail_params"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -113,6 +116,13 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+func (m *QueryParamsResponse) GetUnjailParams() UnjailParams {
+	if m != nil {
+		return m.UnjailParams
+	}
+	return UnjailParams{}
+}
+
 // QuerySigningInfoRequest is the request type for the Query/SigningInfo RPC
 // method
 type QuerySigningInfoRequest struct {
@@ -322,42 +332,43 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6b, 0x13, 0x4f,
-	0x14, 0xc7, 0xb3, 0x6d, 0x7f, 0x81, 0xdf, 0xa4, 0x88, 0x8c, 0x81, 0xa6, 0x41, 0x36, 0xba, 0x42,
-	0x5a, 0xd4, 0xec, 0x9a, 0x88, 0x78, 0x90, 0x1e, 0xcc, 0xc1, 0xe0, 0x4d, 0x53, 0xe9, 0x41, 0x90,
-	0x30, 0x9b, 0x4c, 0xa7, 0x83, 0x9b, 0x99, 0xed, 0xce, 0x24, 0x18, 0xc4, 0x8b, 0x67, 0x0f, 0x82,
-	0x7f, 0x83, 0x47, 0x0f, 0x82, 0x7f, 0x44, 0x8f, 0x45, 0x2f, 0x9e, 0x44, 0x12, 0xff, 0x02, 0xff,
-	0x02, 0xc9, 0xcc, 0x4b, 0xb2, 0x25, 0xae, 0xa6, 0x9e, 0x32, 0x79, 0xf3, 0xbe, 0xdf, 0xf7, 0x79,
-	0x33, 0x6f, 0x16, 0x5d, 0xeb, 0x4a, 0xd5, 0x97, 0x2a, 0x50, 0x11, 0x51, 0x47, 0x5c, 0xb0, 0x60,
-	0x58, 0x0f, 0xa9, 0x26, 0xf5, 0xe0, 0x78, 0x40, 0x93, 0x91, 0x1f, 0x27, 0x52, 0x4b, 0xbc, 0x65,
-	0x93, 0xfc, 0x59, 0x92, 0x0f, 0x49, 0xe5, 0xeb, 0xa0, 0x0e, 0x89, 0xa2, 0x56, 0x31, 0xd7, 0xc7,
-	0x84, 0x71, 0x41, 0x34, 0x97, 0xc2, 0x9a, 0x94, 0x8b, 0x4c, 0x32, 0x69, 0x96, 0xc1, 0x74, 0x05,
-	0xd1, 0xcb, 0x4c, 0x4a, 0x16, 0xd1, 0x80, 0xc4, 0x3c, 0x20, 0x42, 0x48, 0x6d, 0x24, 0x0a, 0x76,
-	0xab, 0x59, 0x74, 0x73, 0x12, 0x9b, 0xb7, 0x6d, 0xf3, 0x3a, 0xd6, 0x1e, 0x68, 0xcd, 0x1f, 0xaf,
-	0x88, 0xf0, 0xe3, 0x29, 0xd8, 0x23, 0x92, 0x90, 0xbe, 0x6a, 0xd3, 0xe3, 0x01, 0x55, 0xda, 0x7b,
-	0x82, 0x2e, 0x9d, 0x89, 0xaa, 0x58, 0x0a, 0x45, 0xf1, 0x1e, 0xca, 0xc7, 0x26, 0x52, 0x72, 0xae,
-	0x38, 0xbb, 0x85, 0x46, 0xc5, 0xcf, 0xe8, 0xdc, 0xb7, 0xc2, 0xe6, 0xc6, 0xc9, 0xb7, 0x4a, 0xae,
-	0x0d, 0x22, 0xef, 0x00, 0x6d, 0x19, 0xd7, 0x7d, 0xce, 0x04, 0x17, 0xec, 0xa1, 0x38, 0x94, 0x50,
-	0x10, 0xdf, 0x43, 0x9b, 0x5d, 0x29, 0x54, 0x87, 0xf4, 0x7a, 0x09, 0x55, 0xd6, 0xff, 0xff, 0x66,
-	0xe9, 0xf3, 0xa7, 0x5a, 0x11, 0x4a, 0xdc, 0xb7, 0x3b, 0xfb, 0x3a, 0xe1, 0x82, 0xb5, 0x0b, 0xd3,
-	0x6c, 0x08, 0x79, 0x23, 0x54, 0x5a, 0xf6, 0x05, 0xe4, 0x67, 0xe8, 0xe2, 0x90, 0x44, 0x1d, 0x65,
-	0xb7, 0x3a, 0x5c, 0x1c, 0x4a, 0x80, 0xaf, 0x65, 0xc2, 0x1f, 0x90, 0x88, 0xf7, 0x88, 0x96, 0x49,
-	0xca, 0x10, 0x5a, 0xb9, 0x30, 0x24, 0x51, 0x2a, 0xea, 0x85, 0xcb, 0xa5, 0x67, 0x87, 0x88, 0x1f,
-	0x20, 0xb4, 0xb8, 0x65, 0x28, 0x5a, 0x9d, 0x15, 0x9d, 0x8e, 0x84, 0x6f, 0x87, 0x68, 0x71, 0x66,
-	0x8c, 0x82, 0xb6, 0x9d, 0x52, 0x7a, 0x1f, 0x1c, 0xb4, 0xfd, 0x9b, 0x22, 0xd0, 0x60, 0x0b, 0x6d,
-	0x40, 0x53, 0xeb, 0xff, 0xda, 0x94, 0x31, 0xc0, 0xad, 0x33, 0xb8, 0x6b, 0x06, 0x77, 0xe7, 0xaf,
-	0xb8, 0x96, 0x22, 0xcd, 0xdb, 0xf8, 0xb9, 0x8e, 0xfe, 0x33, 0xbc, 0xf8, 0x8d, 0x83, 0xf2, 0x76,
-	0x12, 0xf0, 0x8d, 0x4c, 0xb0, 0xe5, 0xf1, 0x2b, 0xdf, 0x5c, 0x2d, 0xd9, 0xd6, 0xf6, 0x76, 0x5e,
-	0x7f, 0xf9, 0xf1, 0x6e, 0xed, 0x2a, 0xae, 0x04, 0x59, 0xcf, 0xc1, 0xce, 0x1f, 0xfe, 0xe8, 0xa0,
-	0x42, 0xaa, 0x7b, 0x7c, 0xeb, 0xcf, 0x65, 0x96, 0xc7, 0xb4, 0x5c, 0x3f, 0x87, 0x02, 0xe8, 0xf6,
-	0x0c, 0xdd, 0x5d, 0x7c, 0x27, 0x93, 0x2e, 0x3d, 0x9b, 0x2a, 0x78, 0x99, 0x7e, 0x07, 0xaf, 0xf0,
-	0x7b, 0x07, 0x6d, 0xa6, 0xef, 0x1d, 0xaf, 0x8e, 0x30, 0x3f, 0xce, 0xc6, 0x79, 0x24, 0x80, 0xed,
-	0x1b, 0xec, 0x5d, 0x5c, 0x5d, 0x0d, 0xbb, 0xd9, 0x3a, 0x19, 0xbb, 0xce, 0xe9, 0xd8, 0x75, 0xbe,
-	0x8f, 0x5d, 0xe7, 0xed, 0xc4, 0xcd, 0x9d, 0x4e, 0xdc, 0xdc, 0xd7, 0x89, 0x9b, 0x7b, 0x5a, 0x63,
-	0x5c, 0x1f, 0x0d, 0x42, 0xbf, 0x2b, 0xfb, 0x33, 0x2f, 0xfb, 0x53, 0x53, 0xbd, 0xe7, 0xc1, 0x8b,
-	0x85, 0xb1, 0x1e, 0xc5, 0x54, 0x85, 0x79, 0xf3, 0x5d, 0xba, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff,
-	0xb9, 0x03, 0x61, 0xef, 0x7a, 0x05, 0x00, 0x00,
+	// 574 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6f, 0x12, 0x41,
+	0x14, 0xc7, 0xd9, 0xb6, 0x36, 0x71, 0x40, 0x63, 0x46, 0x92, 0x52, 0x62, 0x16, 0x5d, 0x23, 0x6d,
+	0x54, 0x76, 0x05, 0x63, 0x3c, 0x98, 0x1e, 0xe4, 0x20, 0xf1, 0x56, 0x69, 0xec, 0xc1, 0xc4, 0x90,
+	0x01, 0xa6, 0xd3, 0xd1, 0x65, 0x66, 0xbb, 0xb3, 0x4b, 0x24, 0xc6, 0x8b, 0x67, 0x0f, 0x26, 0x7e,
+	0x06, 0xbd, 0x79, 0x30, 0xf1, 0x43, 0xf4, 0xd8, 0xe8, 0xc5, 0x93, 0x31, 0xe0, 0x27, 0xf0, 0x13,
+	0x18, 0x66, 0x1e, 0xb0, 0x04, 0x57, 0xa9, 0x27, 0x96, 0x37, 0xef, 0xff, 0x7f, 0xbf, 0x37, 0xef,
+	0xed, 0xa2, 0xab, 0x1d, 0xa9, 0x7a, 0x52, 0x79, 0xca, 0x27, 0xea, 0x90, 0x0b, 0xe6, 0xf5, 0xab,
+	0x6d, 0x1a, 0x91, 0xaa, 0x77, 0x14, 0xd3, 0x70, 0xe0, 0x06, 0xa1, 0x8c, 0x24, 0xde, 0x30, 0x49,
+	0xee, 0x24, 0xc9, 0x85, 0xa4, 0xe2, 0x75, 0x50, 0xb7, 0x89, 0xa2, 0x46, 0x31, 0xd5, 0x07, 0x84,
+	0x71, 0x41, 0x22, 0x2e, 0x85, 0x31, 0x29, 0xe6, 0x99, 0x64, 0x52, 0x3f, 0x7a, 0xe3, 0x27, 0x88,
+	0x5e, 0x62, 0x52, 0x32, 0x9f, 0x7a, 0x24, 0xe0, 0x1e, 0x11, 0x42, 0x46, 0x5a, 0xa2, 0xe0, 0xb4,
+	0x9c, 0x46, 0x37, 0x25, 0x31, 0x79, 0x9b, 0x26, 0xaf, 0x65, 0xec, 0x81, 0x56, 0xff, 0x71, 0xf2,
+	0x08, 0x3f, 0x1a, 0x83, 0xed, 0x92, 0x90, 0xf4, 0x54, 0x93, 0x1e, 0xc5, 0x54, 0x45, 0xce, 0x07,
+	0x0b, 0x5d, 0x9c, 0x0b, 0xab, 0x40, 0x0a, 0x45, 0xf1, 0x0e, 0x5a, 0x0f, 0x74, 0xa4, 0x60, 0x5d,
+	0xb6, 0xb6, 0xb3, 0xb5, 0x92, 0x9b, 0xd2, 0xba, 0x6b, 0x84, 0xf5, 0xb5, 0xe3, 0xef, 0xa5, 0x4c,
+	0x13, 0x44, 0x78, 0x17, 0x9d, 0x8b, 0xc5, 0x33, 0xc2, 0xfd, 0x16, 0xb8, 0xac, 0x68, 0x97, 0x6b,
+	0xa9, 0x2e, 0x8f, 0x75, 0xf6, 0x9c, 0x57, 0x2e, 0x4e, 0xc4, 0x9c, 0x7d, 0xb4, 0xa1, 0x39, 0xf7,
+	0x38, 0x13, 0x5c, 0xb0, 0x87, 0xe2, 0x40, 0x42, 0x0f, 0xf8, 0x1e, 0xca, 0x75, 0xa4, 0x50, 0x2d,
+	0xd2, 0xed, 0x86, 0x54, 0x19, 0xe2, 0xb3, 0xf5, 0xc2, 0x97, 0xcf, 0x95, 0x3c, 0x94, 0xbb, 0x6f,
+	0x4e, 0xf6, 0xa2, 0x90, 0x0b, 0xd6, 0xcc, 0x8e, 0xb3, 0x21, 0xe4, 0x0c, 0x50, 0x61, 0xd1, 0x17,
+	0x2e, 0xe1, 0x29, 0xba, 0xd0, 0x27, 0x7e, 0x4b, 0x99, 0xa3, 0x16, 0x17, 0x07, 0x12, 0xae, 0xa3,
+	0x92, 0xda, 0xc8, 0x3e, 0xf1, 0x79, 0x97, 0x44, 0x32, 0x4c, 0x18, 0x42, 0x43, 0xe7, 0xfb, 0xc4,
+	0x4f, 0x44, 0x9d, 0xf6, 0x62, 0xe9, 0xc9, 0x5c, 0xf0, 0x03, 0x84, 0x66, 0x8b, 0x03, 0x45, 0xcb,
+	0x93, 0xa2, 0xe3, 0x2d, 0x73, 0xcd, 0x5e, 0xce, 0xa6, 0xc0, 0x28, 0x68, 0x9b, 0x09, 0xa5, 0xf3,
+	0xd1, 0x42, 0x9b, 0x7f, 0x28, 0x02, 0x0d, 0x36, 0xd0, 0x1a, 0x34, 0xb5, 0xfa, 0xbf, 0x4d, 0x69,
+	0x03, 0xdc, 0x98, 0xc3, 0x35, 0xc3, 0xde, 0xfa, 0x27, 0xae, 0xa1, 0x48, 0xf2, 0xd6, 0x7e, 0xad,
+	0xa2, 0x33, 0x9a, 0x17, 0xbf, 0xb1, 0xd0, 0xba, 0x99, 0x3d, 0xbe, 0x91, 0x0a, 0xb6, 0xb8, 0xd1,
+	0xc5, 0x9b, 0xcb, 0x25, 0x9b, 0xda, 0xce, 0xd6, 0xeb, 0xaf, 0x3f, 0xdf, 0xad, 0x5c, 0xc1, 0x25,
+	0x2f, 0xed, 0x0d, 0x83, 0x8d, 0xfe, 0x64, 0xa1, 0x6c, 0xa2, 0x7b, 0x7c, 0xeb, 0xef, 0x65, 0x16,
+	0xd7, 0xb4, 0x58, 0x3d, 0x85, 0x02, 0xe8, 0x76, 0x34, 0xdd, 0x5d, 0x7c, 0x27, 0x95, 0x2e, 0xb9,
+	0x9b, 0xca, 0x7b, 0x99, 0x7c, 0x0f, 0x5e, 0xe1, 0xf7, 0x16, 0xca, 0x25, 0xe7, 0x8e, 0x97, 0x47,
+	0x98, 0x5e, 0x67, 0xed, 0x34, 0x12, 0xc0, 0x76, 0x35, 0xf6, 0x36, 0x2e, 0x2f, 0x87, 0x5d, 0x6f,
+	0x1c, 0x0f, 0x6d, 0xeb, 0x64, 0x68, 0x5b, 0x3f, 0x86, 0xb6, 0xf5, 0x76, 0x64, 0x67, 0x4e, 0x46,
+	0x76, 0xe6, 0xdb, 0xc8, 0xce, 0x3c, 0xa9, 0x30, 0x1e, 0x1d, 0xc6, 0x6d, 0xb7, 0x23, 0x7b, 0x13,
+	0x2f, 0xf3, 0x53, 0x51, 0xdd, 0xe7, 0xde, 0x8b, 0x99, 0x71, 0x34, 0x08, 0xa8, 0x6a, 0xaf, 0xeb,
+	0x4f, 0xdd, 0xed, 0xdf, 0x03, 0x00, 0xa7, 0x5d, 0xad, 0x4b, 0xcd, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -561,6 +572,16 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.UnjailParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -749,6 +770,8 @@ func (m *QueryParamsResponse) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = m.UnjailParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -926,6 +949,39 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnjailParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
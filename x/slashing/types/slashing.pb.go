@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -180,9 +181,72 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+// UnjailParams defines the parameters of the automatic and the expedited
+// unjailing of the validators jailed for downtime, the alternatives to the
+// MsgUnjail sent once the jail period is over. They are stored apart from
+// Params.
+type UnjailParams struct {
+	// auto_unjail_period is the penalty period after which the validators jailed
+	// for downtime are unjailed automatically, if they can be unjailed, and not
+	// before the end of their jail period. Zero disables the automatic unjailing.
+	AutoUnjailPeriod time.Duration `protobuf:"bytes,1,opt,name=auto_unjail_period,json=autoUnjailPeriod,proto3,stdduration" json:"auto_unjail_period"`
+	// expedited_unjail_fee is the fee a validator jailed for downtime pays to the
+	// fee collector to be unjailed before the end of its jail period. Empty
+	// disables the expedited unjailing.
+	ExpeditedUnjailFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=expedited_unjail_fee,json=expeditedUnjailFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"expedited_unjail_fee"`
+}
+
+func (m *UnjailParams) Reset()         { *m = UnjailParams{} }
+func (m *UnjailParams) String() string { return proto.CompactTextString(m) }
+func (*UnjailParams) ProtoMessage()    {}
+func (*UnjailParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *UnjailParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnjailParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnjailParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnjailParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnjailParams.Merge(m, src)
+}
+func (m *UnjailParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnjailParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnjailParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnjailParams proto.InternalMessageInfo
+
+func (m *UnjailParams) GetAutoUnjailPeriod() time.Duration {
+	if m != nil {
+		return m.AutoUnjailPeriod
+	}
+	return 0
+}
+
+func (m *UnjailParams) GetExpeditedUnjailFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ExpeditedUnjailFee
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*UnjailParams)(nil), "cosmos.slashing.v1beta1.UnjailParams")
 }
 
 func init() {
@@ -190,43 +254,50 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0x1b, 0x08, 0xe1, 0x92, 0xe9, 0x48, 0x89, 0x9b, 0xc1, 0x0e, 0x1d, 0xaa, 0x2c, 0xb1,
-	0x69, 0xd8, 0xd8, 0x08, 0x11, 0x7f, 0x07, 0x2a, 0x87, 0x3f, 0x82, 0xc5, 0x3a, 0xfb, 0xce, 0xce,
-	0x11, 0xfb, 0x2e, 0xf2, 0x9d, 0x49, 0xf9, 0x16, 0x1d, 0x3b, 0x76, 0xe4, 0x03, 0x20, 0xf1, 0x15,
-	0xba, 0x20, 0x55, 0x4c, 0x88, 0xa1, 0xa0, 0x64, 0xe1, 0x63, 0x20, 0xdf, 0xd9, 0x2d, 0x6a, 0xa5,
-	0x4a, 0x9d, 0x12, 0xbf, 0xf7, 0x7e, 0xef, 0xf9, 0xf7, 0xee, 0x0c, 0x76, 0x42, 0x2e, 0x52, 0x2e,
-	0x5c, 0x91, 0x20, 0x31, 0xa3, 0x2c, 0x76, 0x3f, 0xed, 0x06, 0x44, 0xa2, 0xdd, 0x33, 0xc0, 0x59,
-	0x64, 0x5c, 0x72, 0xd8, 0xd5, 0x3a, 0xe7, 0x0c, 0x2e, 0x75, 0xbd, 0x4e, 0xcc, 0x63, 0xae, 0x34,
-	0x6e, 0xf1, 0x4f, 0xcb, 0x7b, 0x56, 0xcc, 0x79, 0x9c, 0x10, 0x57, 0x3d, 0x05, 0x79, 0xe4, 0xe2,
-	0x3c, 0x43, 0x92, 0x72, 0x56, 0xf2, 0xf6, 0x45, 0x5e, 0xd2, 0x94, 0x08, 0x89, 0xd2, 0x45, 0x29,
-	0xd8, 0xd2, 0x79, 0xbe, 0x76, 0x2e, 0xc3, 0xd5, 0xc3, 0xf6, 0xb7, 0x0d, 0xd0, 0x79, 0x8b, 0x12,
-	0x8a, 0x91, 0xe4, 0xd9, 0x94, 0xc6, 0x8c, 0xb2, 0xf8, 0x39, 0x8b, 0x38, 0x1c, 0x81, 0x5b, 0x08,
-	0xe3, 0x8c, 0x08, 0x61, 0x1a, 0x7d, 0x63, 0x70, 0x7b, 0x6c, 0xfe, 0xf8, 0x3a, 0xec, 0x94, 0xb3,
-	0x8f, 0x34, 0x33, 0x95, 0x19, 0x65, 0xb1, 0x57, 0x09, 0xe1, 0x3d, 0xd0, 0x16, 0x12, 0x65, 0xd2,
-	0x9f, 0x11, 0x1a, 0xcf, 0xa4, 0xb9, 0xd1, 0x37, 0x06, 0x75, 0xaf, 0xa5, 0xb0, 0x67, 0x0a, 0x2a,
-	0x24, 0x94, 0x61, 0xb2, 0xef, 0xf3, 0x28, 0x12, 0x44, 0x9a, 0x75, 0x2d, 0x51, 0xd8, 0x2b, 0x05,
-	0xc1, 0xa7, 0xa0, 0xfd, 0x11, 0xd1, 0x84, 0x60, 0x3f, 0x67, 0x92, 0x26, 0xe6, 0x8d, 0xbe, 0x31,
-	0x68, 0x8d, 0x7a, 0x8e, 0xde, 0xd2, 0xa9, 0xb6, 0x74, 0x5e, 0x57, 0x5b, 0x8e, 0x9b, 0xc7, 0xa7,
-	0x76, 0xed, 0xe0, 0xb7, 0x6d, 0x78, 0x2d, 0x3d, 0xf9, 0xa6, 0x18, 0x84, 0x16, 0x00, 0x92, 0xa7,
-	0x81, 0x90, 0x9c, 0x11, 0x6c, 0xde, 0xec, 0x1b, 0x83, 0xa6, 0xf7, 0x1f, 0x02, 0x47, 0x60, 0x33,
-	0xa5, 0x42, 0x10, 0xec, 0x07, 0x09, 0x0f, 0xe7, 0xc2, 0x0f, 0x79, 0xce, 0x24, 0xc9, 0xcc, 0x86,
-	0x7a, 0xa9, 0x3b, 0x9a, 0x1c, 0x2b, 0xee, 0xb1, 0xa6, 0x1e, 0x36, 0x0f, 0x8f, 0xec, 0xda, 0xdf,
-	0x23, 0xdb, 0xd8, 0xfe, 0x5e, 0x07, 0x8d, 0x3d, 0x94, 0xa1, 0x54, 0xc0, 0xfb, 0xa0, 0x23, 0x68,
-	0xcc, 0xce, 0x8d, 0x96, 0x94, 0x61, 0xbe, 0x54, 0xc5, 0xd5, 0x3d, 0xa8, 0x39, 0xed, 0xf3, 0x4e,
-	0x31, 0x10, 0x15, 0xd1, 0xcc, 0x2f, 0xa7, 0x16, 0x24, 0xab, 0x46, 0x8a, 0xca, 0xda, 0x63, 0xa7,
-	0x58, 0xe8, 0xd7, 0xa9, 0xbd, 0x13, 0x53, 0x39, 0xcb, 0x03, 0x27, 0xe4, 0x69, 0x79, 0x6c, 0xe5,
-	0xcf, 0x50, 0xe0, 0xb9, 0x2b, 0x3f, 0x2f, 0x88, 0x70, 0x26, 0x24, 0xf4, 0x60, 0x4a, 0xd9, 0x54,
-	0x79, 0xed, 0x91, 0xac, 0x8c, 0x78, 0x0f, 0xee, 0x62, 0xbe, 0x64, 0xc5, 0x5d, 0xf0, 0x8b, 0x56,
-	0xfc, 0xea, 0xd6, 0xa8, 0xce, 0x5b, 0xa3, 0xad, 0x4b, 0x85, 0x4e, 0x4a, 0x81, 0xee, 0xf3, 0xb0,
-	0xe8, 0xb3, 0x53, 0x59, 0xbc, 0x40, 0x34, 0xa9, 0x78, 0x38, 0x07, 0x3d, 0x75, 0x75, 0xfd, 0x28,
-	0x43, 0x61, 0x81, 0xf8, 0x98, 0xe7, 0x41, 0x42, 0xd4, 0x3e, 0xea, 0xbc, 0xae, 0xbf, 0x42, 0x57,
-	0x39, 0x3e, 0x29, 0x0d, 0x27, 0xca, 0xaf, 0x58, 0x09, 0x46, 0xa0, 0x7b, 0x29, 0x4c, 0xbf, 0x93,
-	0x3a, 0xd2, 0xeb, 0x27, 0x6d, 0x5e, 0x48, 0xd2, 0x66, 0xe3, 0x97, 0x5f, 0x56, 0x96, 0x71, 0xbc,
-	0xb2, 0x8c, 0x93, 0x95, 0x65, 0xfc, 0x59, 0x59, 0xc6, 0xc1, 0xda, 0xaa, 0x9d, 0xac, 0xad, 0xda,
-	0xcf, 0xb5, 0x55, 0xfb, 0x30, 0xbc, 0xd2, 0x7c, 0xff, 0xfc, 0x93, 0x57, 0x39, 0x41, 0x43, 0x95,
-	0xfa, 0xe0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xbe, 0xf2, 0xba, 0x12, 0x04, 0x00, 0x00,
+	// 677 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x3d, 0x4f, 0x1b, 0x4b,
+	0x14, 0xf5, 0x62, 0xe0, 0xf1, 0xc6, 0x2e, 0x9e, 0xe6, 0x99, 0xb0, 0xb8, 0x58, 0x3b, 0x14, 0xc8,
+	0x0d, 0x6b, 0x70, 0x3a, 0xba, 0x18, 0x44, 0xbe, 0x8a, 0x10, 0x13, 0x12, 0x25, 0xcd, 0x6a, 0x76,
+	0x67, 0x76, 0x3d, 0x61, 0x77, 0xc6, 0xda, 0x99, 0x0d, 0xa4, 0xc8, 0x7f, 0xa0, 0xa4, 0xa4, 0x8c,
+	0x52, 0x47, 0xca, 0x5f, 0xa0, 0x89, 0x84, 0x52, 0x45, 0x29, 0x20, 0x32, 0x52, 0x94, 0x9f, 0x11,
+	0xcd, 0xc7, 0x9a, 0x08, 0xa4, 0x08, 0x51, 0xd9, 0x7b, 0xcf, 0xb9, 0xe7, 0xcc, 0x3d, 0x73, 0x77,
+	0xc1, 0x72, 0xc4, 0x45, 0xc6, 0x45, 0x57, 0xa4, 0x48, 0x0c, 0x29, 0x4b, 0xba, 0x6f, 0xd7, 0x42,
+	0x22, 0xd1, 0xda, 0xa4, 0xe0, 0x8f, 0x72, 0x2e, 0x39, 0x5c, 0x30, 0x3c, 0x7f, 0x52, 0xb6, 0xbc,
+	0x66, 0x23, 0xe1, 0x09, 0xd7, 0x9c, 0xae, 0xfa, 0x67, 0xe8, 0x4d, 0x2f, 0xe1, 0x3c, 0x49, 0x49,
+	0x57, 0x3f, 0x85, 0x45, 0xdc, 0xc5, 0x45, 0x8e, 0x24, 0xe5, 0xcc, 0xe2, 0xad, 0xab, 0xb8, 0xa4,
+	0x19, 0x11, 0x12, 0x65, 0x23, 0x4b, 0x58, 0x34, 0x7e, 0x81, 0x51, 0xb6, 0xe6, 0x56, 0xdb, 0x1e,
+	0x39, 0x44, 0x82, 0x4c, 0x8e, 0x1b, 0x71, 0x6a, 0xb5, 0x97, 0x3e, 0x4f, 0x81, 0xc6, 0x0b, 0x94,
+	0x52, 0x8c, 0x24, 0xcf, 0x77, 0x68, 0xc2, 0x28, 0x4b, 0x1e, 0xb1, 0x98, 0xc3, 0x1e, 0xf8, 0x07,
+	0x61, 0x9c, 0x13, 0x21, 0x5c, 0xa7, 0xed, 0x74, 0xfe, 0xed, 0xbb, 0x5f, 0x3f, 0xad, 0x34, 0xac,
+	0xf6, 0x7d, 0x83, 0xec, 0xc8, 0x9c, 0xb2, 0x64, 0x50, 0x12, 0xe1, 0x5d, 0x50, 0x17, 0x12, 0xe5,
+	0x32, 0x18, 0x12, 0x9a, 0x0c, 0xa5, 0x3b, 0xd5, 0x76, 0x3a, 0xd5, 0x41, 0x4d, 0xd7, 0x1e, 0xea,
+	0x92, 0xa2, 0x50, 0x86, 0xc9, 0x41, 0xc0, 0xe3, 0x58, 0x10, 0xe9, 0x56, 0x0d, 0x45, 0xd7, 0x9e,
+	0xea, 0x12, 0x7c, 0x00, 0xea, 0x6f, 0x10, 0x4d, 0x09, 0x0e, 0x0a, 0x26, 0x69, 0xea, 0x4e, 0xb7,
+	0x9d, 0x4e, 0xad, 0xd7, 0xf4, 0x4d, 0x0a, 0x7e, 0x99, 0x82, 0xff, 0xbc, 0x4c, 0xa1, 0x3f, 0x77,
+	0x72, 0xd6, 0xaa, 0x1c, 0x9e, 0xb7, 0x9c, 0x41, 0xcd, 0x74, 0xee, 0xaa, 0x46, 0xe8, 0x01, 0x20,
+	0x79, 0x16, 0x0a, 0xc9, 0x19, 0xc1, 0xee, 0x4c, 0xdb, 0xe9, 0xcc, 0x0d, 0xfe, 0xa8, 0xc0, 0x1e,
+	0x98, 0xcf, 0xa8, 0x10, 0x04, 0x07, 0x61, 0xca, 0xa3, 0x3d, 0x11, 0x44, 0xbc, 0x60, 0x92, 0xe4,
+	0xee, 0xac, 0x3e, 0xd4, 0xff, 0x06, 0xec, 0x6b, 0x6c, 0xc3, 0x40, 0xeb, 0x73, 0x47, 0xc7, 0xad,
+	0xca, 0xaf, 0xe3, 0x96, 0xb3, 0xf4, 0xa5, 0x0a, 0x66, 0xb7, 0x51, 0x8e, 0x32, 0x01, 0x57, 0x41,
+	0x43, 0xd0, 0x84, 0x5d, 0x0a, 0xed, 0x53, 0x86, 0xf9, 0xbe, 0x0e, 0xae, 0x3a, 0x80, 0x06, 0x33,
+	0x3a, 0x2f, 0x35, 0x02, 0x91, 0xb2, 0x66, 0x81, 0xed, 0x1a, 0x91, 0xbc, 0x6c, 0x51, 0x91, 0xd5,
+	0xfb, 0xbe, 0x1a, 0xe8, 0xfb, 0x59, 0x6b, 0x39, 0xa1, 0x72, 0x58, 0x84, 0x7e, 0xc4, 0x33, 0x7b,
+	0xad, 0xf6, 0x67, 0x45, 0xe0, 0xbd, 0xae, 0x7c, 0x37, 0x22, 0xc2, 0xdf, 0x24, 0xd1, 0x00, 0x66,
+	0x94, 0xed, 0x68, 0xad, 0x6d, 0x92, 0x5b, 0x8b, 0x57, 0xe0, 0x0e, 0xe6, 0xfb, 0x4c, 0xed, 0x4a,
+	0xa0, 0x52, 0x09, 0xca, 0xad, 0xd2, 0x99, 0xd7, 0x7a, 0x8b, 0xd7, 0x02, 0xdd, 0xb4, 0x04, 0x93,
+	0xe7, 0x91, 0xca, 0xb3, 0x51, 0x4a, 0x3c, 0x46, 0x34, 0x2d, 0x71, 0xb8, 0x07, 0x9a, 0x7a, 0xb5,
+	0x83, 0x38, 0x47, 0x91, 0xaa, 0x04, 0x98, 0x17, 0x61, 0x4a, 0xf4, 0x3c, 0xee, 0xf4, 0xad, 0x46,
+	0x58, 0xd0, 0x8a, 0x5b, 0x56, 0x70, 0x53, 0xeb, 0xa9, 0x91, 0x60, 0x0c, 0x16, 0xae, 0x99, 0x99,
+	0x33, 0xb9, 0x33, 0xb7, 0x72, 0x9a, 0xbf, 0xe2, 0x64, 0xc4, 0x96, 0x7e, 0x3a, 0xa0, 0xbe, 0xcb,
+	0x54, 0x52, 0xf6, 0x56, 0x9f, 0x01, 0x88, 0x0a, 0xc9, 0x83, 0x42, 0x17, 0xd5, 0x25, 0x51, 0x8e,
+	0x5d, 0xe7, 0xe6, 0xe1, 0xfd, 0xa7, 0xda, 0xad, 0xa4, 0x6e, 0x86, 0xef, 0x41, 0x83, 0x1c, 0x8c,
+	0x08, 0xa6, 0x52, 0x6f, 0xb7, 0xd6, 0x8d, 0x09, 0x71, 0xa7, 0xda, 0x55, 0x2d, 0x6a, 0x5f, 0x2f,
+	0xf5, 0xb2, 0x96, 0xdf, 0x0c, 0x7f, 0x83, 0x53, 0xd6, 0x5f, 0x55, 0xa2, 0x1f, 0xcf, 0x5b, 0x9d,
+	0x1b, 0xcc, 0xa8, 0x1a, 0xc4, 0x00, 0x4e, 0x8c, 0xcc, 0x09, 0xb6, 0x08, 0x59, 0x9f, 0x56, 0x8b,
+	0xdb, 0x7f, 0xf2, 0x61, 0xec, 0x39, 0x27, 0x63, 0xcf, 0x39, 0x1d, 0x7b, 0xce, 0x8f, 0xb1, 0xe7,
+	0x1c, 0x5e, 0x78, 0x95, 0xd3, 0x0b, 0xaf, 0xf2, 0xed, 0xc2, 0xab, 0xbc, 0x5e, 0xf9, 0xab, 0xc3,
+	0xc1, 0xe5, 0xb7, 0x4f, 0x9b, 0x85, 0xb3, 0x3a, 0x80, 0x7b, 0xbf, 0x07, 0x00, 0xf9, 0xb0, 0x0c,
+	0xca, 0x1b, 0x05, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -304,6 +375,38 @@ func (this *Params) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *UnjailParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnjailParams)
+	if !ok {
+		that2, ok := that.(UnjailParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AutoUnjailPeriod != that1.AutoUnjailPeriod {
+		return false
+	}
+	if len(this.ExpeditedUnjailFee) != len(that1.ExpeditedUnjailFee) {
+		return false
+	}
+	for i := range this.ExpeditedUnjailFee {
+		if !this.ExpeditedUnjailFee[i].Equal(&that1.ExpeditedUnjailFee[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -433,6 +536,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnjailParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnjailParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnjailParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpeditedUnjailFee) > 0 {
+		for iNdEx := len(m.ExpeditedUnjailFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpeditedUnjailFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AutoUnjailPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AutoUnjailPeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -491,6 +639,23 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *UnjailParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AutoUnjailPeriod)
+	n += 1 + l + sovSlashing(uint64(l))
+	if len(m.ExpeditedUnjailFee) > 0 {
+		for _, e := range m.ExpeditedUnjailFee {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovSlashing(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -890,6 +1055,123 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UnjailParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnjailParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnjailParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoUnjailPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.AutoUnjailPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedUnjailFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedUnjailFee = append(m.ExpeditedUnjailFee, types.Coin{})
+			if err := m.ExpeditedUnjailFee[len(m.ExpeditedUnjailFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSlashing(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -105,34 +107,132 @@ func (m *MsgUnjailResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnjailResponse proto.InternalMessageInfo
 
+// MsgExpeditedUnjail defines the Msg/ExpeditedUnjail request type
+type MsgExpeditedUnjail struct {
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"address"`
+	// max_fee is the maximum fee the validator operator agrees to pay, the
+	// unjailing failing if the expedited unjail fee exceeds it.
+	MaxFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=max_fee,json=maxFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_fee"`
+}
+
+func (m *MsgExpeditedUnjail) Reset()         { *m = MsgExpeditedUnjail{} }
+func (m *MsgExpeditedUnjail) String() string { return proto.CompactTextString(m) }
+func (*MsgExpeditedUnjail) ProtoMessage()    {}
+func (*MsgExpeditedUnjail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{2}
+}
+func (m *MsgExpeditedUnjail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExpeditedUnjail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExpeditedUnjail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExpeditedUnjail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExpeditedUnjail.Merge(m, src)
+}
+func (m *MsgExpeditedUnjail) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExpeditedUnjail) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExpeditedUnjail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExpeditedUnjail proto.InternalMessageInfo
+
+// MsgExpeditedUnjailResponse defines the Msg/ExpeditedUnjail response type
+type MsgExpeditedUnjailResponse struct {
+	// fee is the fee paid.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *MsgExpeditedUnjailResponse) Reset()         { *m = MsgExpeditedUnjailResponse{} }
+func (m *MsgExpeditedUnjailResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExpeditedUnjailResponse) ProtoMessage()    {}
+func (*MsgExpeditedUnjailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{3}
+}
+func (m *MsgExpeditedUnjailResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExpeditedUnjailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExpeditedUnjailResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExpeditedUnjailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExpeditedUnjailResponse.Merge(m, src)
+}
+func (m *MsgExpeditedUnjailResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExpeditedUnjailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExpeditedUnjailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExpeditedUnjailResponse proto.InternalMessageInfo
+
+func (m *MsgExpeditedUnjailResponse) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos.slashing.v1beta1.MsgUnjail")
 	proto.RegisterType((*MsgUnjailResponse)(nil), "cosmos.slashing.v1beta1.MsgUnjailResponse")
+	proto.RegisterType((*MsgExpeditedUnjail)(nil), "cosmos.slashing.v1beta1.MsgExpeditedUnjail")
+	proto.RegisterType((*MsgExpeditedUnjailResponse)(nil), "cosmos.slashing.v1beta1.MsgExpeditedUnjailResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/slashing/v1beta1/tx.proto", fileDescriptor_3c5611c0c4a59d9d) }
 
 var fileDescriptor_3c5611c0c4a59d9d = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xc8, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0x24, 0x21, 0xca, 0xe3, 0x21, 0x12, 0x50, 0xbd, 0x10, 0x29, 0xa8, 0x49,
-	0xfa, 0xb9, 0xc5, 0x20, 0x6b, 0x40, 0x14, 0x44, 0x42, 0xa9, 0x84, 0x8b, 0xd3, 0xb7, 0x38, 0x3d,
-	0x34, 0x2f, 0x2b, 0x31, 0x33, 0x47, 0xc8, 0x8b, 0x8b, 0xaf, 0x2c, 0x31, 0x27, 0x33, 0x25, 0xb1,
-	0x24, 0xbf, 0x28, 0x3e, 0x31, 0x25, 0xa5, 0x48, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xf9,
-	0xd5, 0x3d, 0x79, 0x76, 0x10, 0x3f, 0xb5, 0xb8, 0xf8, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0xd1, 0x8e,
-	0x10, 0x91, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0xf4, 0x20, 0x5e, 0xb8, 0x56, 0x90, 0xb8, 0x95, 0x74,
-	0xc7, 0x02, 0x79, 0x86, 0x19, 0x0b, 0xe4, 0x19, 0x9b, 0x9e, 0x6f, 0xd0, 0x42, 0x33, 0x56, 0x49,
-	0x98, 0x4b, 0x10, 0x6e, 0x6b, 0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x51, 0x3c, 0x17,
-	0xb3, 0x6f, 0x71, 0xba, 0x50, 0x04, 0x17, 0x1b, 0xd4, 0x39, 0x4a, 0x7a, 0x38, 0xfc, 0xaf, 0x07,
-	0xd7, 0x2c, 0xa5, 0x45, 0x58, 0x0d, 0xcc, 0x02, 0x27, 0xef, 0x15, 0x8f, 0xe4, 0x18, 0x4f, 0x3c,
-	0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e,
-	0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x37, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49,
-	0x2f, 0x39, 0x3f, 0x17, 0x1a, 0x76, 0x50, 0x4a, 0xb7, 0x38, 0x25, 0x5b, 0xbf, 0x02, 0x11, 0x4d,
-	0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xf0, 0x33, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0xc5, 0xe4, 0xf3, 0x05, 0xc6, 0x01, 0x00, 0x00,
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x41, 0x6b, 0xd4, 0x40,
+	0x18, 0xcd, 0xb8, 0xb0, 0xa5, 0x23, 0x2a, 0xc6, 0x42, 0xdb, 0x08, 0x93, 0x25, 0x5e, 0x96, 0xca,
+	0x4e, 0x6c, 0x7b, 0xeb, 0xcd, 0x15, 0x3d, 0x28, 0x7b, 0x59, 0x11, 0x44, 0x90, 0x65, 0xb2, 0x19,
+	0xa7, 0xa3, 0x9b, 0x4c, 0xc8, 0x37, 0x96, 0x88, 0x37, 0x4f, 0x1e, 0x3d, 0x7a, 0xec, 0x51, 0x3c,
+	0x79, 0xf0, 0x47, 0xf4, 0x58, 0xc4, 0x83, 0xa7, 0x56, 0xb2, 0x07, 0xc1, 0x5f, 0x21, 0x93, 0x4c,
+	0x22, 0xac, 0x5a, 0x29, 0xec, 0x69, 0x92, 0x79, 0xef, 0x7d, 0xdf, 0xf7, 0xde, 0xcc, 0xe0, 0xde,
+	0x54, 0x41, 0xa2, 0x20, 0x84, 0x19, 0x83, 0x7d, 0x99, 0x8a, 0xf0, 0x60, 0x3b, 0xe2, 0x9a, 0x6d,
+	0x87, 0xba, 0xa0, 0x59, 0xae, 0xb4, 0x72, 0xd7, 0x6b, 0x06, 0x6d, 0x18, 0xd4, 0x32, 0xbc, 0x35,
+	0xa1, 0x84, 0xaa, 0x38, 0xa1, 0xf9, 0xaa, 0xe9, 0xde, 0x66, 0x4d, 0x9f, 0xd4, 0x80, 0xd5, 0xd6,
+	0x90, 0xad, 0x14, 0x26, 0x60, 0xda, 0x98, 0xc5, 0x02, 0xc4, 0x02, 0x11, 0x03, 0xde, 0x0e, 0x30,
+	0x55, 0x32, 0xad, 0xf1, 0x40, 0xe3, 0xd5, 0x11, 0x88, 0x47, 0xe9, 0x73, 0x26, 0x67, 0xee, 0x7d,
+	0x7c, 0xf9, 0x80, 0xcd, 0x64, 0xcc, 0xb4, 0xca, 0x27, 0x2c, 0x8e, 0xf3, 0x0d, 0xd4, 0x43, 0xfd,
+	0xd5, 0xe1, 0x8d, 0x9f, 0x27, 0xfe, 0x8a, 0xf9, 0xe7, 0x00, 0x5f, 0x3e, 0x0f, 0xd6, 0x6c, 0xeb,
+	0xdb, 0xf5, 0xce, 0x43, 0x9d, 0xcb, 0x54, 0x8c, 0x2f, 0xb5, 0x52, 0xb3, 0xbf, 0x77, 0xfd, 0xed,
+	0xa1, 0xef, 0xbc, 0x3f, 0xf4, 0xd1, 0x9b, 0x1f, 0x9f, 0xb6, 0x16, 0xca, 0x06, 0xd7, 0xf0, 0xd5,
+	0xb6, 0xeb, 0x98, 0x43, 0xa6, 0x52, 0xe0, 0xc1, 0x29, 0xc2, 0xee, 0x08, 0xc4, 0xdd, 0x22, 0xe3,
+	0xb1, 0xd4, 0x3c, 0x5e, 0xfe, 0x50, 0x6e, 0x8c, 0x57, 0x12, 0x56, 0x4c, 0x9e, 0x71, 0xbe, 0x71,
+	0xa1, 0xd7, 0xe9, 0x5f, 0xdc, 0xd9, 0xa4, 0x56, 0x66, 0xf2, 0x69, 0xe2, 0xa7, 0x77, 0x94, 0x4c,
+	0x87, 0xb7, 0x8e, 0x4e, 0x7c, 0xe7, 0xe3, 0xa9, 0xdf, 0x17, 0x52, 0xef, 0xbf, 0x8c, 0xe8, 0x54,
+	0x25, 0x36, 0x73, 0xbb, 0x0c, 0x20, 0x7e, 0x11, 0xea, 0x57, 0x19, 0x87, 0x4a, 0x00, 0xe3, 0x6e,
+	0xc2, 0x8a, 0x7b, 0x9c, 0xef, 0xad, 0x1b, 0xeb, 0x7f, 0xb3, 0xfd, 0x1a, 0x7b, 0x7f, 0x1a, 0x6c,
+	0xfc, 0xbb, 0x4f, 0x71, 0xc7, 0x0c, 0x86, 0x96, 0x3f, 0x98, 0xa9, 0xbb, 0xf3, 0x15, 0xe1, 0xce,
+	0x08, 0x84, 0xfb, 0x18, 0x77, 0x6d, 0xb2, 0x01, 0xfd, 0xc7, 0xfd, 0xa3, 0xed, 0xe1, 0x78, 0x5b,
+	0xff, 0xe7, 0xb4, 0x06, 0x00, 0x5f, 0x59, 0x3c, 0xbc, 0x9b, 0x67, 0xc9, 0x17, 0xc8, 0xde, 0xee,
+	0x39, 0xc8, 0x4d, 0xd3, 0xe1, 0x83, 0x0f, 0x25, 0x41, 0x47, 0x25, 0x41, 0xc7, 0x25, 0x41, 0xdf,
+	0x4b, 0x82, 0xde, 0xcd, 0x89, 0x73, 0x3c, 0x27, 0xce, 0xb7, 0x39, 0x71, 0x9e, 0x0c, 0xce, 0xcc,
+	0xa8, 0xf8, 0xfd, 0x36, 0xab, 0xb8, 0xa2, 0x6e, 0xf5, 0x28, 0x76, 0x7f, 0x0d, 0x00, 0x6a, 0x05,
+	0x04, 0x1f, 0xbb, 0x03, 0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -180,6 +280,67 @@ func (this *MsgUnjailResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgExpeditedUnjail) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgExpeditedUnjail)
+	if !ok {
+		that2, ok := that.(MsgExpeditedUnjail)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddr != that1.ValidatorAddr {
+		return false
+	}
+	if len(this.MaxFee) != len(that1.MaxFee) {
+		return false
+	}
+	for i := range this.MaxFee {
+		if !this.MaxFee[i].Equal(&that1.MaxFee[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgExpeditedUnjailResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgExpeditedUnjailResponse)
+	if !ok {
+		that2, ok := that.(MsgExpeditedUnjailResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
+			return false
+		}
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -197,6 +358,10 @@ type MsgClient interface {
 	// them into the bonded validator set, so they can begin receiving provisions
 	// and rewards again.
 	Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error)
+	// ExpeditedUnjail defines a method for unjailing a validator jailed for
+	// downtime before the end of its jail period, paying the expedited unjail
+	// fee.
+	ExpeditedUnjail(ctx context.Context, in *MsgExpeditedUnjail, opts ...grpc.CallOption) (*MsgExpeditedUnjailResponse, error)
 }
 
 type msgClient struct {
@@ -216,12 +381,25 @@ func (c *msgClient) Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) ExpeditedUnjail(ctx context.Context, in *MsgExpeditedUnjail, opts ...grpc.CallOption) (*MsgExpeditedUnjailResponse, error) {
+	out := new(MsgExpeditedUnjailResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/ExpeditedUnjail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Unjail defines a method for unjailing a jailed validator, thus returning
 	// them into the bonded validator set, so they can begin receiving provisions
 	// and rewards again.
 	Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error)
+	// ExpeditedUnjail defines a method for unjailing a validator jailed for
+	// downtime before the end of its jail period, paying the expedited unjail
+	// fee.
+	ExpeditedUnjail(context.Context, *MsgExpeditedUnjail) (*MsgExpeditedUnjailResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -231,6 +409,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*MsgUnjailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unjail not implemented")
 }
+func (*UnimplementedMsgServer) ExpeditedUnjail(ctx context.Context, req *MsgExpeditedUnjail) (*MsgExpeditedUnjailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpeditedUnjail not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -254,6 +435,24 @@ func _Msg_Unjail_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ExpeditedUnjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExpeditedUnjail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExpeditedUnjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/ExpeditedUnjail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExpeditedUnjail(ctx, req.(*MsgExpeditedUnjail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -262,6 +461,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Unjail",
 			Handler:    _Msg_Unjail_Handler,
 		},
+		{
+			MethodName: "ExpeditedUnjail",
+			Handler:    _Msg_ExpeditedUnjail_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -320,6 +523,87 @@ func (m *MsgUnjailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgExpeditedUnjail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExpeditedUnjail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExpeditedUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxFee) > 0 {
+		for iNdEx := len(m.MaxFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExpeditedUnjailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExpeditedUnjailResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExpeditedUnjailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -353,6 +637,40 @@ func (m *MsgUnjailResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgExpeditedUnjail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MaxFee) > 0 {
+		for _, e := range m.MaxFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExpeditedUnjailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -491,6 +809,206 @@ func (m *MsgUnjailResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgExpeditedUnjail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExpeditedUnjail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExpeditedUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFee = append(m.MaxFee, types.Coin{})
+			if err := m.MaxFee[len(m.MaxFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExpeditedUnjailResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExpeditedUnjailResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExpeditedUnjailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewUnjailParams creates a new UnjailParams object
+func NewUnjailParams(autoUnjailPeriod time.Duration, expeditedUnjailFee sdk.Coins) UnjailParams {
+	return UnjailParams{
+		AutoUnjailPeriod:   autoUnjailPeriod,
+		ExpeditedUnjailFee: expeditedUnjailFee,
+	}
+}
+
+// DefaultUnjailParams returns the default unjail parameters, disabling both the
+// automatic and the expedited unjailing.
+func DefaultUnjailParams() UnjailParams {
+	return NewUnjailParams(0, nil)
+}
+
+// AutoUnjailEnabled returns true if the validators jailed for downtime are
+// unjailed automatically.
+func (p UnjailParams) AutoUnjailEnabled() bool {
+	return p.AutoUnjailPeriod > 0
+}
+
+// ExpeditedUnjailEnabled returns true if the validators jailed for downtime
+// can pay to be unjailed before the end of their jail period.
+func (p UnjailParams) ExpeditedUnjailEnabled() bool {
+	return !p.ExpeditedUnjailFee.Empty()
+}
+
+// Validate checks that the unjail parameters have valid values.
+func (p UnjailParams) Validate() error {
+	if p.AutoUnjailPeriod < 0 {
+		return fmt.Errorf("auto unjail period must not be negative: %s", p.AutoUnjailPeriod)
+	}
+
+	if err := p.ExpeditedUnjailFee.Validate(); err != nil {
+		return fmt.Errorf("invalid expedited unjail fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateUnjailParams(i interface{}) error {
+	v, ok := i.(UnjailParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
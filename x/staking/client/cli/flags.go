@@ -22,6 +22,10 @@ const (
 	FlagSecurityContact = "security-contact"
 	FlagDetails         = "details"
 
+	FlagLogoURI             = "logo-uri"
+	FlagLogoHash            = "logo-hash"
+	FlagSecurityContactHash = "security-contact-hash"
+
 	FlagCommissionRate          = "commission-rate"
 	FlagCommissionMaxRate       = "commission-max-rate"
 	FlagCommissionMaxChangeRate = "commission-max-change-rate"
@@ -88,6 +92,9 @@ func flagSetDescriptionEdit() *flag.FlagSet {
 	fs.String(FlagWebsite, types.DoNotModifyDesc, "The validator's (optional) website")
 	fs.String(FlagSecurityContact, types.DoNotModifyDesc, "The validator's (optional) security contact email")
 	fs.String(FlagDetails, types.DoNotModifyDesc, "The validator's (optional) details")
+	fs.String(FlagLogoURI, types.DoNotModifyDesc, "The URI of the validator's (optional) logo")
+	fs.String(FlagLogoHash, types.DoNotModifyDesc, "The hex encoded SHA-256 hash of the validator's (optional) logo")
+	fs.String(FlagSecurityContactHash, types.DoNotModifyDesc, "The hex encoded SHA-256 hash of the (optional) security contact verification")
 
 	return fs
 }
@@ -108,6 +115,9 @@ func flagSetDescriptionCreate() *flag.FlagSet {
 	fs.String(FlagWebsite, "", "The validator's (optional) website")
 	fs.String(FlagSecurityContact, "", "The validator's (optional) security contact email")
 	fs.String(FlagDetails, "", "The validator's (optional) details")
+	fs.String(FlagLogoURI, "", "The URI of the validator's (optional) logo")
+	fs.String(FlagLogoHash, "", "The hex encoded SHA-256 hash of the validator's (optional) logo")
+	fs.String(FlagSecurityContactHash, "", "The hex encoded SHA-256 hash of the (optional) security contact verification")
 
 	return fs
 }
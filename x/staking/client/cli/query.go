@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -36,9 +37,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorDelegations(),
 		GetCmdQueryValidatorUnbondingDelegations(),
 		GetCmdQueryValidatorRedelegations(),
+		GetCmdQueryUnbondingQueue(),
+		GetCmdQueryRedelegationQueue(),
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryTotalLiquidStaked(),
+		GetCmdQueryValidatorLiquidStaking(),
 	)
 
 	return stakingQueryCmd
@@ -631,6 +636,152 @@ $ %s query staking redelegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 	return cmd
 }
 
+// GetCmdQueryUnbondingQueue implements the command to query the unbonding
+// delegation entries completing within a time window.
+func GetCmdQueryUnbondingQueue() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "unbonding-queue [start-time] [end-time]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the unbonding delegation entries completing within a time window",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the unbonding delegation entries completing within a time window, ordered by completion time.
+Both bounds are inclusive and must be RFC3339 timestamps.
+
+Example:
+$ %s query staking unbonding-queue 2022-01-01T00:00:00Z 2022-01-02T00:00:00Z --%s=%s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, FlagAddressValidator, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			start, end, err := parseTimeWindow(args)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := cmd.Flags().GetString(FlagAddressValidator)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UnbondingQueue(cmd.Context(), &types.QueryUnbondingQueueRequest{
+				StartTime:     start,
+				EndTime:       end,
+				ValidatorAddr: valAddr,
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagAddressValidator, "", "only list the unbonding delegations from the given validator")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbonding queue")
+
+	return cmd
+}
+
+// GetCmdQueryRedelegationQueue implements the command to query the
+// redelegation entries completing within a time window.
+func GetCmdQueryRedelegationQueue() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "redelegation-queue [start-time] [end-time]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the redelegation entries completing within a time window",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the redelegation entries completing within a time window, ordered by completion time.
+Both bounds are inclusive and must be RFC3339 timestamps.
+
+Example:
+$ %s query staking redelegation-queue 2022-01-01T00:00:00Z 2022-01-02T00:00:00Z --%s=%s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, FlagAddressValidatorSrc, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			start, end, err := parseTimeWindow(args)
+			if err != nil {
+				return err
+			}
+
+			valSrcAddr, err := cmd.Flags().GetString(FlagAddressValidatorSrc)
+			if err != nil {
+				return err
+			}
+
+			valDstAddr, err := cmd.Flags().GetString(FlagAddressValidatorDst)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RedelegationQueue(cmd.Context(), &types.QueryRedelegationQueueRequest{
+				StartTime:        start,
+				EndTime:          end,
+				SrcValidatorAddr: valSrcAddr,
+				DstValidatorAddr: valDstAddr,
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagAddressValidatorSrc, "", "only list the redelegations from the given validator")
+	cmd.Flags().String(FlagAddressValidatorDst, "", "only list the redelegations to the given validator")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "redelegation queue")
+
+	return cmd
+}
+
+// parseTimeWindow parses the RFC3339 start and end times of a time window.
+func parseTimeWindow(args []string) (time.Time, time.Time, error) {
+	start, err := time.Parse(time.RFC3339, args[0])
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	end, err := time.Parse(time.RFC3339, args[1])
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return start, end, nil
+}
+
 // GetCmdQueryHistoricalInfo implements the historical info query command
 func GetCmdQueryHistoricalInfo() *cobra.Command {
 	cmd := &cobra.Command{
@@ -744,3 +895,83 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryTotalLiquidStaked implements the total liquid staked query command.
+func GetCmdQueryTotalLiquidStaked() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-liquid-staked",
+		Args:  cobra.NoArgs,
+		Short: "Query the tokens delegated by liquid staking providers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokens delegated by liquid staking providers and their ratio of the bonded tokens.
+
+Example:
+$ %s query staking total-liquid-staked
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalLiquidStaked(cmd.Context(), &types.QueryTotalLiquidStakedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorLiquidStaking implements the validator liquid staking query command.
+func GetCmdQueryValidatorLiquidStaking() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-liquid-staking [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the shares of a validator delegated by liquid staking providers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the shares of a validator delegated by liquid staking providers, its validator bond
+shares and its liquid staking utilization.
+
+Example:
+$ %s query staking validator-liquid-staking %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorLiquidStaking(cmd.Context(), &types.QueryValidatorLiquidStakingRequest{ValidatorAddr: valAddr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
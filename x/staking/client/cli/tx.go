@@ -42,6 +42,7 @@ func NewTxCmd() *cobra.Command {
 	stakingTxCmd.AddCommand(
 		NewCreateValidatorCmd(),
 		NewEditValidatorCmd(),
+		NewEditValidatorDescriptionCmd(),
 		NewDelegateCmd(),
 		NewRedelegateCmd(),
 		NewUnbondCmd(),
@@ -105,7 +106,12 @@ func NewEditValidatorCmd() *cobra.Command {
 			website, _ := cmd.Flags().GetString(FlagWebsite)
 			security, _ := cmd.Flags().GetString(FlagSecurityContact)
 			details, _ := cmd.Flags().GetString(FlagDetails)
-			description := types.NewDescription(moniker, identity, website, security, details)
+			logoURI, _ := cmd.Flags().GetString(FlagLogoURI)
+			logoHash, _ := cmd.Flags().GetString(FlagLogoHash)
+			securityHash, _ := cmd.Flags().GetString(FlagSecurityContactHash)
+			description := types.NewDescription(moniker, identity, website, security, details).
+				WithLogo(logoURI, logoHash).
+				WithSecurityContactHash(securityHash)
 
 			var newRate *sdk.Dec
 
@@ -145,6 +151,73 @@ func NewEditValidatorCmd() *cobra.Command {
 	return cmd
 }
 
+// descriptionFieldFlags maps the flags of the validator's description to the
+// names of the description fields they set.
+var descriptionFieldFlags = []struct{ flag, field string }{
+	{FlagMoniker, types.DescriptionFieldMoniker},
+	{FlagIdentity, types.DescriptionFieldIdentity},
+	{FlagWebsite, types.DescriptionFieldWebsite},
+	{FlagSecurityContact, types.DescriptionFieldSecurityContact},
+	{FlagDetails, types.DescriptionFieldDetails},
+	{FlagLogoURI, types.DescriptionFieldLogoURI},
+	{FlagLogoHash, types.DescriptionFieldLogoHash},
+	{FlagSecurityContactHash, types.DescriptionFieldSecurityContactHash},
+}
+
+func NewEditValidatorDescriptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-validator-description",
+		Short: "edit only the given fields of an existing validator's description",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Edit only the fields of an existing validator's description given as flags,
+leaving the other ones untouched. A field is cleared by setting its flag to an empty value.
+
+Example:
+$ %s tx staking edit-validator-description --logo-uri=https://validator.io/logo.png --logo-hash=<sha256> --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var fields []string
+			values := make(map[string]string)
+			for _, f := range descriptionFieldFlags {
+				if !cmd.Flags().Changed(f.flag) {
+					continue
+				}
+
+				values[f.field], _ = cmd.Flags().GetString(f.flag)
+				fields = append(fields, f.field)
+			}
+
+			description := types.NewDescription(
+				values[types.DescriptionFieldMoniker],
+				values[types.DescriptionFieldIdentity],
+				values[types.DescriptionFieldWebsite],
+				values[types.DescriptionFieldSecurityContact],
+				values[types.DescriptionFieldDetails],
+			).WithLogo(
+				values[types.DescriptionFieldLogoURI],
+				values[types.DescriptionFieldLogoHash],
+			).WithSecurityContactHash(values[types.DescriptionFieldSecurityContactHash])
+
+			msg := types.NewMsgEditValidatorDescription(sdk.ValAddress(clientCtx.GetFromAddress()), description, fields)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetDescriptionCreate())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewDelegateCmd() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
 
@@ -352,13 +425,16 @@ func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *fl
 	website, _ := fs.GetString(FlagWebsite)
 	security, _ := fs.GetString(FlagSecurityContact)
 	details, _ := fs.GetString(FlagDetails)
+	logoURI, _ := fs.GetString(FlagLogoURI)
+	logoHash, _ := fs.GetString(FlagLogoHash)
+	securityHash, _ := fs.GetString(FlagSecurityContactHash)
 	description := types.NewDescription(
 		moniker,
 		identity,
 		website,
 		security,
 		details,
-	)
+	).WithLogo(logoURI, logoHash).WithSecurityContactHash(securityHash)
 
 	// get the initial validator commission parameters
 	rateStr, _ := fs.GetString(FlagCommissionRate)
@@ -837,6 +837,123 @@ func (s *IntegrationTestSuite) TestGetCmdQueryValidatorRedelegations() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryUnbondingQueue() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr bool
+	}{
+		{
+			"invalid start time",
+			[]string{
+				"yesterday",
+				"2100-01-01T00:00:00Z",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"end before start",
+			[]string{
+				"2100-01-01T00:00:00Z",
+				"2000-01-01T00:00:00Z",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"valid request",
+			[]string{
+				"2000-01-01T00:00:00Z",
+				"2100-01-01T00:00:00Z",
+				fmt.Sprintf("--%s=%s", cli.FlagAddressValidator, val.ValAddress),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryUnbondingQueue()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expErr {
+				s.Require().Error(err)
+			} else {
+				var res types.QueryUnbondingQueueResponse
+				err = val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res)
+
+				s.Require().NoError(err)
+				s.Require().NotEmpty(res.UnbondingResponses)
+				for _, ubd := range res.UnbondingResponses {
+					s.Require().Equal(val.Address.String(), ubd.DelegatorAddress)
+					s.Require().Equal(val.ValAddress.String(), ubd.ValidatorAddress)
+				}
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryRedelegationQueue() {
+	val := s.network.Validators[0]
+	val2 := s.network.Validators[1]
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr bool
+	}{
+		{
+			"wrong source validator address",
+			[]string{
+				"2000-01-01T00:00:00Z",
+				"2100-01-01T00:00:00Z",
+				fmt.Sprintf("--%s=%s", cli.FlagAddressValidatorSrc, "wrongValAddr"),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"valid request",
+			[]string{
+				"2000-01-01T00:00:00Z",
+				"2100-01-01T00:00:00Z",
+				fmt.Sprintf("--%s=%s", cli.FlagAddressValidatorSrc, val.ValAddress),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryRedelegationQueue()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expErr {
+				s.Require().Error(err)
+			} else {
+				var res types.QueryRedelegationQueueResponse
+				err = val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res)
+
+				s.Require().NoError(err)
+				s.Require().Len(res.RedelegationResponses, 1)
+				s.Require().Equal(val.Address.String(), res.RedelegationResponses[0].Redelegation.DelegatorAddress)
+				s.Require().Equal(val2.ValAddress.String(), res.RedelegationResponses[0].Redelegation.ValidatorDstAddress)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryHistoricalInfo() {
 	val := s.network.Validators[0]
 
@@ -894,16 +1011,19 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 			"with text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`bond_denom: stake
+global_liquid_staking_cap: "1.000000000000000000"
 historical_entries: 10000
 max_entries: 7
 max_validators: 100
 min_commission_rate: "0.000000000000000000"
-unbonding_time: 1814400s`,
+unbonding_time: 1814400s
+validator_bond_factor: "-1.000000000000000000"
+validator_liquid_staking_cap: "1.000000000000000000"`,
 		},
 		{
 			"with json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"unbonding_time":"1814400s","max_validators":100,"max_entries":7,"historical_entries":10000,"bond_denom":"stake","min_commission_rate":"0.000000000000000000"}`,
+			`{"unbonding_time":"1814400s","max_validators":100,"max_entries":7,"historical_entries":10000,"bond_denom":"stake","min_commission_rate":"0.000000000000000000","validator_bond_factor":"-1.000000000000000000","global_liquid_staking_cap":"1.000000000000000000","validator_liquid_staking_cap":"1.000000000000000000"}`,
 		},
 	}
 	for _, tc := range testCases {
@@ -1071,6 +1191,85 @@ func (s *IntegrationTestSuite) TestNewEditValidatorCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewEditValidatorDescriptionCmd() {
+	val := s.network.Validators[0]
+
+	logoURI := "https://test.com/logo.png"
+	logoHash := strings.Repeat("ab", 32)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		{
+			"with no edit flag",
+			[]string{
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, 0, nil,
+		},
+		{
+			"logo hash without logo URI",
+			[]string{
+				fmt.Sprintf("--%s=%s", cli.FlagLogoHash, logoHash),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, sdkerrors.ErrInvalidRequest.ABCICode(), &sdk.TxResponse{},
+		},
+		{
+			"edit validator logo",
+			[]string{
+				fmt.Sprintf("--%s=%s", cli.FlagLogoURI, logoURI),
+				fmt.Sprintf("--%s=%s", cli.FlagLogoHash, logoHash),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewEditValidatorDescriptionCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err, out.String())
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryValidator(), []string{
+		val.ValAddress.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+
+	var validator types.Validator
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &validator))
+	s.Require().Equal(logoURI, validator.Description.LogoUri)
+	s.Require().Equal(logoHash, validator.Description.LogoHash)
+}
+
 func (s *IntegrationTestSuite) TestNewDelegateCmd() {
 	val := s.network.Validators[0]
 
@@ -86,6 +86,9 @@ func InitGenesis(
 		}
 	}
 
+	// the liquid staking tracking is derived from the delegations
+	keeper.InitLiquidStaking(ctx)
+
 	for _, ubd := range data.UnbondingDelegations {
 		keeper.SetUnbondingDelegation(ctx, ubd)
 
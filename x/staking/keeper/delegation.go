@@ -401,6 +401,77 @@ func (k Keeper) DequeueAllMatureUBDQueue(ctx sdk.Context, currTime time.Time) (m
 	return matureUnbonds
 }
 
+// IterateUBDQueue iterates over the unbonding delegations completing between
+// start and end (both inclusive), in completion order, starting at the
+// unbonding queue key startKey if set. Each unbonding delegation is passed to
+// the handler with its entries completing at the time of its queue key only.
+// Iteration stops when the handler returns true.
+func (k Keeper) IterateUBDQueue(ctx sdk.Context, start, end time.Time, startKey []byte,
+	handler func(queueKey []byte, ubd types.UnbondingDelegation) bool) error {
+	store := ctx.KVStore(k.storeKey)
+
+	from := types.GetUnbondingDelegationTimeKey(start)
+	if startKey != nil {
+		from = startKey
+	}
+
+	iterator := store.Iterator(from, sdk.InclusiveEndBytes(types.GetUnbondingDelegationTimeKey(end)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		completionTime, err := sdk.ParseTimeBytes(iterator.Key()[len(types.UnbondingQueueKey):])
+		if err != nil {
+			return err
+		}
+
+		timeslice := types.DVPairs{}
+		if err := k.cdc.Unmarshal(iterator.Value(), &timeslice); err != nil {
+			return err
+		}
+
+		// a pair is queued once per entry, so that it may be queued several
+		// times at the same completion time
+		seen := make(map[types.DVPair]bool, len(timeslice.Pairs))
+		for _, pair := range timeslice.Pairs {
+			if seen[pair] {
+				continue
+			}
+			seen[pair] = true
+
+			delAddr, err := sdk.AccAddressFromBech32(pair.DelegatorAddress)
+			if err != nil {
+				return err
+			}
+			valAddr, err := sdk.ValAddressFromBech32(pair.ValidatorAddress)
+			if err != nil {
+				return err
+			}
+
+			ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+			if !found {
+				continue
+			}
+
+			entries := make([]types.UnbondingDelegationEntry, 0, len(ubd.Entries))
+			for _, entry := range ubd.Entries {
+				if entry.CompletionTime.Equal(completionTime) {
+					entries = append(entries, entry)
+				}
+			}
+			if len(entries) == 0 {
+				continue
+			}
+			ubd.Entries = entries
+
+			if handler(iterator.Key(), ubd) {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
 // GetRedelegations returns a given amount of all the delegator redelegations.
 func (k Keeper) GetRedelegations(ctx sdk.Context, delegator sdk.AccAddress,
 	maxRetrieve uint16) (redelegations []types.Redelegation) {
@@ -634,11 +705,105 @@ func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currTime time
 	return matureRedelegations
 }
 
+// IterateRedelegationQueue iterates over the redelegations completing between
+// start and end (both inclusive), in completion order, starting at the
+// redelegation queue key startKey if set. Each redelegation is passed to the
+// handler with its entries completing at the time of its queue key only.
+// Iteration stops when the handler returns true.
+func (k Keeper) IterateRedelegationQueue(ctx sdk.Context, start, end time.Time, startKey []byte,
+	handler func(queueKey []byte, red types.Redelegation) bool) error {
+	store := ctx.KVStore(k.storeKey)
+
+	from := types.GetRedelegationTimeKey(start)
+	if startKey != nil {
+		from = startKey
+	}
+
+	iterator := store.Iterator(from, sdk.InclusiveEndBytes(types.GetRedelegationTimeKey(end)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		completionTime, err := sdk.ParseTimeBytes(iterator.Key()[len(types.RedelegationQueueKey):])
+		if err != nil {
+			return err
+		}
+
+		timeslice := types.DVVTriplets{}
+		if err := k.cdc.Unmarshal(iterator.Value(), &timeslice); err != nil {
+			return err
+		}
+
+		// a triplet is queued once per entry, so that it may be queued several
+		// times at the same completion time
+		seen := make(map[types.DVVTriplet]bool, len(timeslice.Triplets))
+		for _, triplet := range timeslice.Triplets {
+			if seen[triplet] {
+				continue
+			}
+			seen[triplet] = true
+
+			delAddr, err := sdk.AccAddressFromBech32(triplet.DelegatorAddress)
+			if err != nil {
+				return err
+			}
+			valSrcAddr, err := sdk.ValAddressFromBech32(triplet.ValidatorSrcAddress)
+			if err != nil {
+				return err
+			}
+			valDstAddr, err := sdk.ValAddressFromBech32(triplet.ValidatorDstAddress)
+			if err != nil {
+				return err
+			}
+
+			red, found := k.GetRedelegation(ctx, delAddr, valSrcAddr, valDstAddr)
+			if !found {
+				continue
+			}
+
+			entries := make([]types.RedelegationEntry, 0, len(red.Entries))
+			for _, entry := range red.Entries {
+				if entry.CompletionTime.Equal(completionTime) {
+					entries = append(entries, entry)
+				}
+			}
+			if len(entries) == 0 {
+				continue
+			}
+			red.Entries = entries
+
+			if handler(iterator.Key(), red) {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
 // Delegate performs a delegation, set/update everything necessary within the store.
-// tokenSrc indicates the bond status of the incoming funds.
+// tokenSrc indicates the bond status of the incoming funds. The delegations of
+// liquid staking providers are tracked and checked against the liquid staking
+// caps.
 func (k Keeper) Delegate(
 	ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, tokenSrc types.BondStatus,
 	validator types.Validator, subtractAccount bool,
+) (newShares sdk.Dec, err error) {
+	newShares, err = k.delegate(ctx, delAddr, bondAmt, tokenSrc, validator, subtractAccount)
+	if err != nil {
+		return newShares, err
+	}
+
+	if err := k.trackLiquidDelegation(ctx, delAddr, validator.GetOperator(), bondAmt, newShares); err != nil {
+		return newShares, err
+	}
+
+	return newShares, nil
+}
+
+// delegate performs a delegation without tracking liquid staking.
+func (k Keeper) delegate(
+	ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, tokenSrc types.BondStatus,
+	validator types.Validator, subtractAccount bool,
 ) (newShares sdk.Dec, err error) {
 	// In some situations, the exchange rate becomes invalid, e.g. if
 	// Validator loses all tokens due to slashing. In this case,
@@ -725,8 +890,26 @@ func (k Keeper) Delegate(
 }
 
 // Unbond unbonds a particular delegation and perform associated store operations.
+// The undelegations of liquid staking providers are tracked, and the validator
+// bond is checked when the validator unbonds from itself.
 func (k Keeper) Unbond(
 	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
+) (amount sdk.Int, err error) {
+	amount, err = k.unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return amount, err
+	}
+
+	if err := k.trackLiquidUndelegation(ctx, delAddr, valAddr, amount, shares); err != nil {
+		return amount, err
+	}
+
+	return amount, nil
+}
+
+// unbond unbonds a particular delegation without tracking liquid staking.
+func (k Keeper) unbond(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
 ) (amount sdk.Int, err error) {
 	// check if a delegation object exists in the store
 	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
@@ -930,7 +1113,9 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, types.ErrMaxRedelegationEntries
 	}
 
-	returnAmount, err := k.Unbond(ctx, delAddr, valSrcAddr, sharesAmount)
+	// the liquid staking tracking moves the shares between the validators,
+	// leaving the total liquid staked tokens untouched
+	returnAmount, err := k.unbond(ctx, delAddr, valSrcAddr, sharesAmount)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -939,11 +1124,15 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, types.ErrTinyRedelegationAmount
 	}
 
-	sharesCreated, err := k.Delegate(ctx, delAddr, returnAmount, srcValidator.GetStatus(), dstValidator, false)
+	sharesCreated, err := k.delegate(ctx, delAddr, returnAmount, srcValidator.GetStatus(), dstValidator, false)
 	if err != nil {
 		return time.Time{}, err
 	}
 
+	if err := k.trackLiquidRedelegation(ctx, delAddr, valSrcAddr, valDstAddr, sharesAmount, sharesCreated); err != nil {
+		return time.Time{}, err
+	}
+
 	// create the unbonding delegation
 	completionTime, height, completeNow := k.getBeginInfo(ctx, valSrcAddr)
 
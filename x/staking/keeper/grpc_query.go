@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"strings"
 
@@ -406,6 +407,132 @@ func (k Querier) Redelegations(c context.Context, req *types.QueryRedelegationsR
 	return &types.QueryRedelegationsResponse{RedelegationResponses: redelResponses, Pagination: pageRes}, nil
 }
 
+// UnbondingQueue queries the unbonding delegation entries completing within a
+// time window
+func (k Querier) UnbondingQueue(c context.Context, req *types.QueryUnbondingQueueRequest) (*types.QueryUnbondingQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.EndTime.Before(req.StartTime) {
+		return nil, status.Error(codes.InvalidArgument, "end of the time window must not be before its start")
+	}
+
+	limit, startKey, err := queuePageRequest(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var valAddr sdk.ValAddress
+	if req.ValidatorAddr != "" {
+		valAddr, err = sdk.ValAddressFromBech32(req.ValidatorAddr)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var (
+		ubds    types.UnbondingDelegations
+		nextKey []byte
+		lastKey []byte
+	)
+	err = k.IterateUBDQueue(ctx, req.StartTime, req.EndTime, startKey, func(queueKey []byte, ubd types.UnbondingDelegation) bool {
+		if valAddr != nil && ubd.ValidatorAddress != valAddr.String() {
+			return false
+		}
+
+		// entries completing at the same time are always returned in the same page
+		if uint64(len(ubds)) >= limit && !bytes.Equal(queueKey, lastKey) {
+			nextKey = queueKey
+			return true
+		}
+		lastKey = queueKey
+
+		ubds = append(ubds, ubd)
+		return false
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnbondingQueueResponse{
+		UnbondingResponses: ubds,
+		Pagination:         &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
+// RedelegationQueue queries the redelegation entries completing within a time
+// window
+func (k Querier) RedelegationQueue(c context.Context, req *types.QueryRedelegationQueueRequest) (*types.QueryRedelegationQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.EndTime.Before(req.StartTime) {
+		return nil, status.Error(codes.InvalidArgument, "end of the time window must not be before its start")
+	}
+
+	limit, startKey, err := queuePageRequest(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var srcValAddr, dstValAddr sdk.ValAddress
+	if req.SrcValidatorAddr != "" {
+		srcValAddr, err = sdk.ValAddressFromBech32(req.SrcValidatorAddr)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if req.DstValidatorAddr != "" {
+		dstValAddr, err = sdk.ValAddressFromBech32(req.DstValidatorAddr)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var (
+		redels  types.Redelegations
+		nextKey []byte
+		lastKey []byte
+	)
+	err = k.IterateRedelegationQueue(ctx, req.StartTime, req.EndTime, startKey, func(queueKey []byte, red types.Redelegation) bool {
+		if srcValAddr != nil && red.ValidatorSrcAddress != srcValAddr.String() {
+			return false
+		}
+		if dstValAddr != nil && red.ValidatorDstAddress != dstValAddr.String() {
+			return false
+		}
+
+		// entries completing at the same time are always returned in the same page
+		if uint64(len(redels)) >= limit && !bytes.Equal(queueKey, lastKey) {
+			nextKey = queueKey
+			return true
+		}
+		lastKey = queueKey
+
+		redels = append(redels, red)
+		return false
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	redelResponses, err := RedelegationsToRedelegationResponses(ctx, k.Keeper, redels)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedelegationQueueResponse{
+		RedelegationResponses: redelResponses,
+		Pagination:            &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
 // DelegatorValidators queries all validators info for given delegator address
 func (k Querier) DelegatorValidators(c context.Context, req *types.QueryDelegatorValidatorsRequest) (*types.QueryDelegatorValidatorsResponse, error) {
 	if req == nil {
@@ -539,3 +666,108 @@ func queryAllRedelegations(store sdk.KVStore, k Querier, req *types.QueryRedeleg
 
 	return redels, res, err
 }
+
+// TotalLiquidStaked queries the tokens delegated by liquid staking providers
+func (k Querier) TotalLiquidStaked(c context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	tokens := k.GetTotalLiquidStakedTokens(ctx)
+	bonded := k.TotalBondedTokens(ctx)
+
+	utilization := sdk.ZeroDec()
+	if bonded.IsPositive() {
+		utilization = sdk.NewDecFromInt(tokens).QuoInt(bonded)
+	}
+
+	return &types.QueryTotalLiquidStakedResponse{
+		Tokens:       tokens,
+		BondedTokens: bonded,
+		Utilization:  utilization,
+	}, nil
+}
+
+// ValidatorLiquidStaking queries the shares of a validator delegated by liquid staking providers
+func (k Querier) ValidatorLiquidStaking(c context.Context, req *types.QueryValidatorLiquidStakingRequest) (*types.QueryValidatorLiquidStakingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr)
+	utilization := sdk.ZeroDec()
+	if validator.DelegatorShares.IsPositive() {
+		utilization = liquidShares.Quo(validator.DelegatorShares)
+	}
+
+	return &types.QueryValidatorLiquidStakingResponse{
+		LiquidShares:        liquidShares,
+		ValidatorBondShares: k.GetValidatorBondShares(ctx, valAddr),
+		DelegatorShares:     validator.DelegatorShares,
+		Utilization:         utilization,
+	}, nil
+}
+
+// queuePageRequest returns the limit and the start key of the key based
+// pagination of a queue query.
+func queuePageRequest(pageReq *query.PageRequest) (uint64, []byte, error) {
+	limit := uint64(query.DefaultLimit)
+	if pageReq == nil {
+		return limit, nil, nil
+	}
+
+	if pageReq.Offset > 0 {
+		return 0, nil, status.Error(codes.InvalidArgument, "offset pagination is not supported, use key pagination")
+	}
+	if pageReq.Limit > 0 {
+		limit = pageReq.Limit
+	}
+
+	return limit, pageReq.Key, nil
+}
+
+// ValidatorsStream streams all the validators that match the given status
+func (k Querier) ValidatorsStream(req *types.QueryValidatorsStreamRequest, stream types.Query_ValidatorsStreamServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := stream.Context()
+	return query.StreamPages(ctx, req.Pagination, func(pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := k.Validators(ctx, &types.QueryValidatorsRequest{Status: req.Status, Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Pagination, stream.Send(&types.QueryValidatorsStreamResponse{Validators: res.Validators, Pagination: res.Pagination})
+	})
+}
+
+// ValidatorDelegationsStream streams the delegations of a validator
+func (k Querier) ValidatorDelegationsStream(req *types.QueryValidatorDelegationsStreamRequest, stream types.Query_ValidatorDelegationsStreamServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := stream.Context()
+	return query.StreamPages(ctx, req.Pagination, func(pageReq *query.PageRequest) (*query.PageResponse, error) {
+		res, err := k.ValidatorDelegations(ctx, &types.QueryValidatorDelegationsRequest{ValidatorAddr: req.ValidatorAddr, Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Pagination, stream.Send(&types.QueryValidatorDelegationsStreamResponse{DelegationResponses: res.DelegationResponses, Pagination: res.Pagination})
+	})
+}
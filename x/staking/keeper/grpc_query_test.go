@@ -4,6 +4,7 @@ import (
 	gocontext "context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -717,6 +718,202 @@ func (suite *KeeperTestSuite) TestGRPCQueryRedelegations() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryUnbondingQueue() {
+	app, ctx, queryClient, addrs, vals := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.vals
+	addrAcc, addrAcc1 := addrs[0], addrs[1]
+	valAddr1, valAddr2 := vals[0].GetOperator(), vals[1].GetOperator()
+	unbondingTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1).ToDec()
+
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	completionTime1, err := app.StakingKeeper.Undelegate(ctx, addrAcc, valAddr1, unbondingTokens)
+	suite.Require().NoError(err)
+	_, err = app.StakingKeeper.Undelegate(ctx, addrAcc, valAddr2, unbondingTokens)
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	completionTime2, err := app.StakingKeeper.Undelegate(ctx, addrAcc1, valAddr2, unbondingTokens)
+	suite.Require().NoError(err)
+	_, err = app.StakingKeeper.Undelegate(ctx, addrAcc, valAddr1, unbondingTokens)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		msg      string
+		request  types.QueryUnbondingQueueRequest
+		expErr   bool
+		numItems int
+	}{
+		{
+			"fail end before start",
+			types.QueryUnbondingQueueRequest{StartTime: completionTime2, EndTime: completionTime1},
+			true,
+			0,
+		},
+		{
+			"fail offset pagination",
+			types.QueryUnbondingQueueRequest{StartTime: completionTime1, EndTime: completionTime2, Pagination: &query.PageRequest{Offset: 1}},
+			true,
+			0,
+		},
+		{
+			"fail invalid validator address",
+			types.QueryUnbondingQueueRequest{StartTime: completionTime1, EndTime: completionTime2, ValidatorAddr: addrAcc.String()},
+			true,
+			0,
+		},
+		{
+			"valid case, window bounds are inclusive",
+			types.QueryUnbondingQueueRequest{StartTime: completionTime1, EndTime: completionTime1},
+			false,
+			2,
+		},
+		{
+			"valid case, entries within the window",
+			types.QueryUnbondingQueueRequest{StartTime: completionTime1.Add(-time.Hour), EndTime: completionTime2},
+			false,
+			4,
+		},
+		{
+			"valid case, entries from a validator",
+			types.QueryUnbondingQueueRequest{StartTime: completionTime1, EndTime: completionTime2, ValidatorAddr: valAddr1.String()},
+			false,
+			2,
+		},
+		{
+			"valid case, no entry within the window",
+			types.QueryUnbondingQueueRequest{StartTime: completionTime2.Add(time.Second), EndTime: completionTime2.Add(time.Hour)},
+			false,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := queryClient.UnbondingQueue(gocontext.Background(), &tc.request)
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.UnbondingResponses, tc.numItems)
+			}
+		})
+	}
+
+	suite.T().Log("verify the entries are restricted to their completion time")
+	res, err := queryClient.UnbondingQueue(gocontext.Background(), &types.QueryUnbondingQueueRequest{
+		StartTime: completionTime2, EndTime: completionTime2, ValidatorAddr: valAddr1.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.UnbondingResponses, 1)
+	suite.Require().Equal(addrAcc.String(), res.UnbondingResponses[0].DelegatorAddress)
+	suite.Require().Len(res.UnbondingResponses[0].Entries, 1)
+	suite.Require().True(completionTime2.Equal(res.UnbondingResponses[0].Entries[0].CompletionTime))
+
+	suite.T().Log("verify key pagination")
+	res, err = queryClient.UnbondingQueue(gocontext.Background(), &types.QueryUnbondingQueueRequest{
+		StartTime: completionTime1, EndTime: completionTime2, Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	// entries completing at the same time are returned in the same page
+	suite.Require().Len(res.UnbondingResponses, 2)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = queryClient.UnbondingQueue(gocontext.Background(), &types.QueryUnbondingQueueRequest{
+		StartTime: completionTime1, EndTime: completionTime2, Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.UnbondingResponses, 2)
+	suite.Require().Nil(res.Pagination.NextKey)
+	suite.Require().Equal(addrAcc1.String(), res.UnbondingResponses[0].DelegatorAddress)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryRedelegationQueue() {
+	app, ctx, queryClient, addrs, vals := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.vals
+	addrAcc, addrAcc1 := addrs[0], addrs[1]
+	valAddr1, valAddr2 := vals[0].GetOperator(), vals[1].GetOperator()
+	rdAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 1).ToDec()
+
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	completionTime1, err := app.StakingKeeper.BeginRedelegation(ctx, addrAcc, valAddr1, valAddr2, rdAmount)
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	completionTime2, err := app.StakingKeeper.BeginRedelegation(ctx, addrAcc1, valAddr2, valAddr1, rdAmount)
+	suite.Require().NoError(err)
+	_, err = app.StakingKeeper.BeginRedelegation(ctx, addrAcc, valAddr1, valAddr2, rdAmount)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		msg      string
+		request  types.QueryRedelegationQueueRequest
+		expErr   bool
+		numItems int
+	}{
+		{
+			"fail end before start",
+			types.QueryRedelegationQueueRequest{StartTime: completionTime2, EndTime: completionTime1},
+			true,
+			0,
+		},
+		{
+			"fail invalid destination validator address",
+			types.QueryRedelegationQueueRequest{StartTime: completionTime1, EndTime: completionTime2, DstValidatorAddr: addrAcc.String()},
+			true,
+			0,
+		},
+		{
+			"valid case, window bounds are inclusive",
+			types.QueryRedelegationQueueRequest{StartTime: completionTime1, EndTime: completionTime1},
+			false,
+			1,
+		},
+		{
+			"valid case, entries within the window",
+			types.QueryRedelegationQueueRequest{StartTime: completionTime1, EndTime: completionTime2},
+			false,
+			3,
+		},
+		{
+			"valid case, entries from a validator",
+			types.QueryRedelegationQueueRequest{StartTime: completionTime1, EndTime: completionTime2, SrcValidatorAddr: valAddr1.String()},
+			false,
+			2,
+		},
+		{
+			"valid case, entries to a validator",
+			types.QueryRedelegationQueueRequest{StartTime: completionTime1, EndTime: completionTime2, DstValidatorAddr: valAddr1.String()},
+			false,
+			1,
+		},
+		{
+			"valid case, no entry within the window",
+			types.QueryRedelegationQueueRequest{StartTime: completionTime1.Add(-time.Hour), EndTime: completionTime1.Add(-time.Second)},
+			false,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := queryClient.RedelegationQueue(gocontext.Background(), &tc.request)
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.RedelegationResponses, tc.numItems)
+			}
+		})
+	}
+
+	res, err := queryClient.RedelegationQueue(gocontext.Background(), &types.QueryRedelegationQueueRequest{
+		StartTime: completionTime1, EndTime: completionTime1,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RedelegationResponses, 1)
+	suite.Require().Equal(addrAcc.String(), res.RedelegationResponses[0].Redelegation.DelegatorAddress)
+	suite.Require().Len(res.RedelegationResponses[0].Entries, 1)
+	suite.Require().Equal(rdAmount.TruncateInt(), res.RedelegationResponses[0].Entries[0].Balance)
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryValidatorUnbondingDelegations() {
 	app, ctx, queryClient, addrs, vals := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.vals
 	addrAcc1, _ := addrs[0], addrs[1]
@@ -0,0 +1,281 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// IsLiquidStakingProvider returns whether the delegations of an account are
+// liquid staking ones, i.e. whether the account is a module account.
+func (k Keeper) IsLiquidStakingProvider(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, ok := k.authKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return ok
+}
+
+// GetTotalLiquidStakedTokens returns the total amount of tokens delegated by
+// liquid staking providers, net of the slashes of their delegations.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) sdk.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.TotalLiquidStakedTokensKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var tokens sdk.Int
+	if err := tokens.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return tokens
+}
+
+// SetTotalLiquidStakedTokens sets the total amount of tokens delegated by
+// liquid staking providers.
+func (k Keeper) SetTotalLiquidStakedTokens(ctx sdk.Context, tokens sdk.Int) {
+	bz, err := tokens.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// GetValidatorLiquidShares returns the shares of a validator delegated by
+// liquid staking providers.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var shares sdk.Dec
+	if err := shares.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return shares
+}
+
+// SetValidatorLiquidShares sets the shares of a validator delegated by liquid
+// staking providers, removing them when zero.
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	if shares.IsZero() {
+		store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+		return
+	}
+
+	bz, err := shares.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.GetValidatorLiquidSharesKey(valAddr), bz)
+}
+
+// GetValidatorBondShares returns the self-delegation shares of a validator,
+// which bound its liquid staking shares through the validator bond factor.
+func (k Keeper) GetValidatorBondShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	delegation, found := k.GetDelegation(ctx, sdk.AccAddress(valAddr), valAddr)
+	if !found {
+		return sdk.ZeroDec()
+	}
+
+	return delegation.Shares
+}
+
+// SafelyIncreaseTotalLiquidStakedTokens adds tokens to the total liquid staked
+// tokens. It returns an error if the total exceeds the global liquid staking
+// cap of the bonded tokens.
+func (k Keeper) SafelyIncreaseTotalLiquidStakedTokens(ctx sdk.Context, tokens sdk.Int) error {
+	total := k.GetTotalLiquidStakedTokens(ctx).Add(tokens)
+
+	if liquidCap := k.GlobalLiquidStakingCap(ctx); liquidCap.LT(sdk.OneDec()) {
+		bonded := k.TotalBondedTokens(ctx)
+		if sdk.NewDecFromInt(total).GT(liquidCap.MulInt(bonded)) {
+			return types.ErrGlobalLiquidStakingCapExceeded.Wrapf(
+				"%s liquid staked tokens out of %s bonded tokens, cap %s", total, bonded, liquidCap,
+			)
+		}
+	}
+
+	k.SetTotalLiquidStakedTokens(ctx, total)
+	return nil
+}
+
+// DecreaseTotalLiquidStakedTokens removes tokens from the total liquid staked
+// tokens, down to zero.
+func (k Keeper) DecreaseTotalLiquidStakedTokens(ctx sdk.Context, tokens sdk.Int) {
+	k.SetTotalLiquidStakedTokens(ctx, sdk.MaxInt(k.GetTotalLiquidStakedTokens(ctx).Sub(tokens), sdk.ZeroInt()))
+}
+
+// SafelyIncreaseValidatorLiquidShares adds shares to the liquid shares of a
+// validator, which must already include them in its delegator shares. It
+// returns an error if the liquid shares exceed the validator bond or the
+// validator liquid staking cap of the delegator shares.
+func (k Keeper) SafelyIncreaseValidatorLiquidShares(ctx sdk.Context, validator types.Validator, shares sdk.Dec) error {
+	valAddr := validator.GetOperator()
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr).Add(shares)
+
+	if err := k.checkValidatorBond(ctx, valAddr, liquidShares); err != nil {
+		return err
+	}
+
+	if liquidCap := k.ValidatorLiquidStakingCap(ctx); liquidCap.LT(sdk.OneDec()) {
+		if liquidShares.GT(validator.DelegatorShares.Mul(liquidCap)) {
+			return types.ErrValidatorLiquidStakingCapExceeded.Wrapf(
+				"%s liquid shares out of %s shares, cap %s", liquidShares, validator.DelegatorShares, liquidCap,
+			)
+		}
+	}
+
+	k.SetValidatorLiquidShares(ctx, valAddr, liquidShares)
+	return nil
+}
+
+// DecreaseValidatorLiquidShares removes shares from the liquid shares of a
+// validator, down to zero.
+func (k Keeper) DecreaseValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	k.SetValidatorLiquidShares(ctx, valAddr, sdk.MaxDec(k.GetValidatorLiquidShares(ctx, valAddr).Sub(shares), sdk.ZeroDec()))
+}
+
+// ValidateValidatorBond returns an error if the liquid shares of a validator
+// exceed its self-delegation shares multiplied by the validator bond factor,
+// e.g. after the validator unbonded from itself.
+func (k Keeper) ValidateValidatorBond(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	return k.checkValidatorBond(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr))
+}
+
+func (k Keeper) checkValidatorBond(ctx sdk.Context, valAddr sdk.ValAddress, liquidShares sdk.Dec) error {
+	factor := k.ValidatorBondFactor(ctx)
+	if factor.IsNegative() || liquidShares.IsZero() {
+		return nil
+	}
+
+	bondShares := k.GetValidatorBondShares(ctx, valAddr)
+	if liquidShares.GT(bondShares.Mul(factor)) {
+		return types.ErrInsufficientValidatorBond.Wrapf(
+			"%s liquid shares for %s validator bond shares, factor %s", liquidShares, bondShares, factor,
+		)
+	}
+
+	return nil
+}
+
+// trackLiquidDelegation records a new delegation of tokens for shares if the
+// delegator is a liquid staking provider, checking the liquid staking caps.
+func (k Keeper) trackLiquidDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, tokens sdk.Int, shares sdk.Dec) error {
+	if !k.IsLiquidStakingProvider(ctx, delAddr) {
+		return nil
+	}
+
+	if err := k.SafelyIncreaseTotalLiquidStakedTokens(ctx, tokens); err != nil {
+		return err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	return k.SafelyIncreaseValidatorLiquidShares(ctx, validator, shares)
+}
+
+// trackLiquidUndelegation records the undelegation of shares worth tokens. The
+// liquid staking tracking is decreased if the delegator is a liquid staking
+// provider, and the validator bond is checked if it is the validator itself.
+func (k Keeper) trackLiquidUndelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, tokens sdk.Int, shares sdk.Dec) error {
+	if k.untrackLiquidDelegation(ctx, delAddr, valAddr, tokens, shares) {
+		return nil
+	}
+
+	if delAddr.Equals(valAddr) {
+		return k.ValidateValidatorBond(ctx, valAddr)
+	}
+
+	return nil
+}
+
+// untrackLiquidDelegation decreases the liquid staking tracking by shares worth
+// tokens if the delegator is a liquid staking provider, and returns whether it
+// is one.
+func (k Keeper) untrackLiquidDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, tokens sdk.Int, shares sdk.Dec) bool {
+	if !k.IsLiquidStakingProvider(ctx, delAddr) {
+		return false
+	}
+
+	k.DecreaseTotalLiquidStakedTokens(ctx, tokens)
+	k.DecreaseValidatorLiquidShares(ctx, valAddr, shares)
+	return true
+}
+
+// slashLiquidStakedTokens removes from the total liquid staked tokens the share
+// of the tokens burned from a validator which were delegated by liquid staking
+// providers. The liquid shares of the validator are left untouched, as slashing
+// doesn't change the delegator shares.
+func (k Keeper) slashLiquidStakedTokens(ctx sdk.Context, validator types.Validator, tokensToBurn sdk.Int) {
+	liquidShares := k.GetValidatorLiquidShares(ctx, validator.GetOperator())
+	if liquidShares.IsZero() || validator.DelegatorShares.IsZero() {
+		return
+	}
+
+	liquidTokens := tokensToBurn.ToDec().Mul(liquidShares).Quo(validator.DelegatorShares).TruncateInt()
+	k.DecreaseTotalLiquidStakedTokens(ctx, liquidTokens)
+}
+
+// trackLiquidRedelegation records the redelegation of srcShares of a source
+// validator for dstShares of a destination validator, leaving the total liquid
+// staked tokens untouched.
+func (k Keeper) trackLiquidRedelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, srcShares, dstShares sdk.Dec,
+) error {
+	if !k.IsLiquidStakingProvider(ctx, delAddr) {
+		if delAddr.Equals(valSrcAddr) {
+			return k.ValidateValidatorBond(ctx, valSrcAddr)
+		}
+		return nil
+	}
+
+	k.DecreaseValidatorLiquidShares(ctx, valSrcAddr, srcShares)
+
+	validator, found := k.GetValidator(ctx, valDstAddr)
+	if !found {
+		return types.ErrBadRedelegationDst
+	}
+
+	return k.SafelyIncreaseValidatorLiquidShares(ctx, validator, dstShares)
+}
+
+// InitLiquidStaking rebuilds the liquid staking tracking from the delegations
+// of the liquid staking providers, e.g. at genesis.
+func (k Keeper) InitLiquidStaking(ctx sdk.Context) {
+	total := sdk.ZeroInt()
+	liquidShares := make(map[string]sdk.Dec)
+	var valAddrs []sdk.ValAddress
+
+	k.IterateAllDelegations(ctx, func(delegation types.Delegation) bool {
+		if !k.IsLiquidStakingProvider(ctx, delegation.GetDelegatorAddr()) {
+			return false
+		}
+
+		valAddr := delegation.GetValidatorAddr()
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			return false
+		}
+
+		total = total.Add(validator.TokensFromShares(delegation.Shares).TruncateInt())
+		shares, ok := liquidShares[valAddr.String()]
+		if !ok {
+			shares = sdk.ZeroDec()
+			valAddrs = append(valAddrs, valAddr)
+		}
+		liquidShares[valAddr.String()] = shares.Add(delegation.Shares)
+		return false
+	})
+
+	k.SetTotalLiquidStakedTokens(ctx, total)
+	for _, valAddr := range valAddrs {
+		k.SetValidatorLiquidShares(ctx, valAddr, liquidShares[valAddr.String()])
+	}
+}
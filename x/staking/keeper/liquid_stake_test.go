@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestLiquidStakingCaps(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	querier := keeper.Querier{Keeper: app.StakingKeeper}
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	validators := app.StakingKeeper.GetValidators(ctx, 10)
+	require.Len(t, validators, 1)
+	valAddr := validators[0].GetOperator()
+	bonded := app.StakingKeeper.TotalBondedTokens(ctx)
+	quarter := bonded.QuoRaw(4)
+
+	// the delegations of module accounts are liquid staking ones
+	provider := authtypes.NewEmptyModuleAccount("liquidprovider")
+	app.AccountKeeper.SetModuleAccount(ctx, provider)
+	operator := sdk.AccAddress(valAddr)
+	funds := sdk.NewCoins(sdk.NewCoin(bondDenom, bonded))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, provider.GetAddress(), funds))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, operator, funds))
+	require.True(t, app.StakingKeeper.IsLiquidStakingProvider(ctx, provider.GetAddress()))
+	require.False(t, app.StakingKeeper.IsLiquidStakingProvider(ctx, operator))
+
+	setParams := func(factor, globalCap, validatorCap sdk.Dec) {
+		params := app.StakingKeeper.GetParams(ctx)
+		params.ValidatorBondFactor = factor
+		params.GlobalLiquidStakingCap = globalCap
+		params.ValidatorLiquidStakingCap = validatorCap
+		app.StakingKeeper.SetParams(ctx, params)
+	}
+	delegate := func(ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.Int) error {
+		_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, sdk.NewCoin(bondDenom, amount)))
+		return err
+	}
+	undelegate := func(ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.Int) error {
+		_, err := msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(delAddr, valAddr, sdk.NewCoin(bondDenom, amount)))
+		return err
+	}
+	sharesOf := func(tokens sdk.Int) sdk.Dec {
+		validator, _ := app.StakingKeeper.GetValidator(ctx, valAddr)
+		shares, err := validator.SharesFromTokens(tokens)
+		require.NoError(t, err)
+		return shares
+	}
+	requireLiquid := func(tokens sdk.Int) {
+		require.Equal(t, tokens, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+		require.Equal(t, sharesOf(tokens), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddr))
+	}
+
+	// liquid staking 20% of the bonded tokens and validator shares
+	setParams(sdk.NewDec(-1), sdk.NewDecWithPrec(25, 2), sdk.NewDecWithPrec(5, 1))
+	require.NoError(t, delegate(ctx, provider.GetAddress(), quarter))
+	requireLiquid(quarter)
+
+	// regular delegations are not tracked
+	require.NoError(t, delegate(ctx, operator, quarter))
+	requireLiquid(quarter)
+
+	// 28% of the bonded tokens exceeds the global cap
+	cacheCtx, _ := ctx.CacheContext()
+	require.ErrorIs(t, delegate(cacheCtx, provider.GetAddress(), quarter), types.ErrGlobalLiquidStakingCapExceeded)
+
+	// 28% of the validator shares exceeds the validator cap
+	setParams(sdk.NewDec(-1), sdk.OneDec(), sdk.NewDecWithPrec(25, 2))
+	cacheCtx, _ = ctx.CacheContext()
+	require.ErrorIs(t, delegate(cacheCtx, provider.GetAddress(), quarter), types.ErrValidatorLiquidStakingCapExceeded)
+
+	// the liquid shares can be twice the validator bond of a quarter
+	setParams(sdk.NewDec(2), sdk.OneDec(), sdk.OneDec())
+	require.Equal(t, sharesOf(quarter), app.StakingKeeper.GetValidatorBondShares(ctx, valAddr))
+	cacheCtx, _ = ctx.CacheContext()
+	require.ErrorIs(t, delegate(cacheCtx, provider.GetAddress(), quarter.AddRaw(1)), types.ErrInsufficientValidatorBond)
+	require.NoError(t, delegate(ctx, provider.GetAddress(), quarter))
+	requireLiquid(quarter.MulRaw(2))
+
+	// the validator can't unbond from itself below the validator bond
+	cacheCtx, _ = ctx.CacheContext()
+	require.ErrorIs(t, undelegate(cacheCtx, operator, sdk.OneInt()), types.ErrInsufficientValidatorBond)
+
+	// undelegations decrease the liquid staking tracking
+	require.NoError(t, undelegate(ctx, provider.GetAddress(), quarter))
+	requireLiquid(quarter)
+	require.NoError(t, undelegate(ctx, operator, sdk.OneInt()))
+
+	totalRes, err := querier.TotalLiquidStaked(sdk.WrapSDKContext(ctx), &types.QueryTotalLiquidStakedRequest{})
+	require.NoError(t, err)
+	require.Equal(t, quarter, totalRes.Tokens)
+	require.Equal(t, app.StakingKeeper.TotalBondedTokens(ctx), totalRes.BondedTokens)
+	require.Equal(t, sdk.NewDecFromInt(quarter).QuoInt(totalRes.BondedTokens), totalRes.Utilization)
+
+	valRes, err := querier.ValidatorLiquidStaking(sdk.WrapSDKContext(ctx), &types.QueryValidatorLiquidStakingRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(t, err)
+	validator, _ := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.Equal(t, sharesOf(quarter), valRes.LiquidShares)
+	require.Equal(t, sharesOf(quarter.SubRaw(1)), valRes.ValidatorBondShares)
+	require.Equal(t, validator.DelegatorShares, valRes.DelegatorShares)
+	require.Equal(t, sharesOf(quarter).Quo(validator.DelegatorShares), valRes.Utilization)
+
+	_, err = querier.ValidatorLiquidStaking(sdk.WrapSDKContext(ctx), &types.QueryValidatorLiquidStakingRequest{})
+	require.Error(t, err)
+
+	// the tracking is rebuilt from the delegations
+	app.StakingKeeper.SetTotalLiquidStakedTokens(ctx, sdk.ZeroInt())
+	app.StakingKeeper.SetValidatorLiquidShares(ctx, valAddr, sdk.ZeroDec())
+	app.StakingKeeper.InitLiquidStaking(ctx)
+	requireLiquid(quarter)
+}
+
+func TestLiquidStakingKeeperTracking(t *testing.T) {
+	app, ctx, _, addrVals := bootstrapSlashTest(t, 10)
+	ctx = ctx.WithBlockHeight(1)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	amt := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+
+	provider := authtypes.NewEmptyModuleAccount("liquidprovider")
+	app.AccountKeeper.SetModuleAccount(ctx, provider)
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, provider.GetAddress(), sdk.NewCoins(sdk.NewCoin(bondDenom, amt))))
+
+	getValidator := func(valAddr sdk.ValAddress) types.Validator {
+		validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.True(t, found)
+		return validator
+	}
+	// the total liquid staked tokens are the value of the liquid shares
+	requireLiquidValue := func() {
+		total := sdk.ZeroInt()
+		for _, valAddr := range addrVals[:2] {
+			liquidShares := app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddr)
+			total = total.Add(getValidator(valAddr).TokensFromShares(liquidShares).TruncateInt())
+		}
+		require.Equal(t, total, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	}
+
+	// the delegations through the keeper are tracked and capped
+	half := amt.QuoRaw(2)
+	shares, err := app.StakingKeeper.Delegate(ctx, provider.GetAddress(), half, types.Unbonded, getValidator(addrVals[0]), true)
+	require.NoError(t, err)
+	require.Equal(t, half, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	require.Equal(t, shares, app.StakingKeeper.GetValidatorLiquidShares(ctx, addrVals[0]))
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(1, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = app.StakingKeeper.Delegate(cacheCtx, provider.GetAddress(), half, types.Unbonded, getValidator(addrVals[0]), true)
+	require.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+
+	// the redelegations move the liquid shares between the validators
+	redelegated := shares.QuoInt64(2)
+	_, err = app.StakingKeeper.BeginRedelegation(ctx, provider.GetAddress(), addrVals[0], addrVals[1], redelegated)
+	require.NoError(t, err)
+	require.Equal(t, half, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	require.Equal(t, shares.Sub(redelegated), app.StakingKeeper.GetValidatorLiquidShares(ctx, addrVals[0]))
+	require.Equal(t, redelegated, app.StakingKeeper.GetValidatorLiquidShares(ctx, addrVals[1]))
+
+	// slashing the source validator deducts the slashed liquid tokens, and the
+	// liquid shares unbonded from the destination validator of the redelegation
+	ctx = ctx.WithBlockHeight(2)
+	slashFactor := sdk.NewDecWithPrec(1, 1)
+	validator := getValidator(addrVals[0])
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	app.StakingKeeper.Slash(ctx, consAddr, 1, validator.GetConsensusPower(app.StakingKeeper.PowerReduction(ctx)), slashFactor)
+
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).LT(half))
+	require.Equal(t, shares.Sub(redelegated), app.StakingKeeper.GetValidatorLiquidShares(ctx, addrVals[0]))
+	require.Equal(t, redelegated.Sub(redelegated.Mul(slashFactor)), app.StakingKeeper.GetValidatorLiquidShares(ctx, addrVals[1]))
+	requireLiquidValue()
+
+	// the undelegations through the keeper are tracked
+	_, err = app.StakingKeeper.Undelegate(ctx, provider.GetAddress(), addrVals[1], app.StakingKeeper.GetValidatorLiquidShares(ctx, addrVals[1]))
+	require.NoError(t, err)
+	require.True(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, addrVals[1]).IsZero())
+	requireLiquidValue()
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -144,3 +145,63 @@ func TestCancelUnbondingDelegation(t *testing.T) {
 		})
 	}
 }
+
+func TestEditValidatorDescription(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	validators := app.StakingKeeper.GetValidators(ctx, 10)
+	require.Equal(t, len(validators), 1)
+	valAddr := validators[0].GetOperator()
+	description := validators[0].Description
+
+	logoURI := "https://validator.cosmos/logo.png"
+	logoHash := strings.Repeat("ab", 32)
+
+	testCases := []struct {
+		Name      string
+		ExceptErr bool
+		req       *types.MsgEditValidatorDescription
+		expected  types.Description
+	}{
+		{
+			Name:      "unknown validator",
+			ExceptErr: true,
+			req: types.NewMsgEditValidatorDescription(
+				sdk.ValAddress(sdk.AccAddress("unknown")), types.Description{}.WithLogo(logoURI, logoHash),
+				[]string{types.DescriptionFieldLogoURI, types.DescriptionFieldLogoHash},
+			),
+		},
+		{
+			Name:      "invalid description",
+			ExceptErr: true,
+			req: types.NewMsgEditValidatorDescription(
+				valAddr, types.Description{}.WithLogo("", logoHash), []string{types.DescriptionFieldLogoHash},
+			),
+		},
+		{
+			Name:      "success",
+			ExceptErr: false,
+			req: types.NewMsgEditValidatorDescription(
+				valAddr, types.Description{Moniker: "ignored"}.WithLogo(logoURI, logoHash),
+				[]string{types.DescriptionFieldLogoURI, types.DescriptionFieldLogoHash},
+			),
+			expected: description.WithLogo(logoURI, logoHash),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := msgServer.EditValidatorDescription(sdk.WrapSDKContext(ctx), testCase.req)
+			if testCase.ExceptErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+				require.True(t, found)
+				require.Equal(t, testCase.expected, validator.Description)
+			}
+		})
+	}
+}
@@ -117,6 +117,9 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		k.BeforeValidatorSlashed(ctx, operatorAddress, effectiveFraction)
 	}
 
+	// Deduct the liquid staked share of the slashed tokens.
+	k.slashLiquidStakedTokens(ctx, validator, tokensToBurn)
+
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
@@ -266,10 +269,12 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 			sharesToUnbond = delegation.Shares
 		}
 
-		tokensToBurn, err := k.Unbond(ctx, delegatorAddress, valDstAddr, sharesToUnbond)
+		// the validator bond is not checked, slashing can't fail
+		tokensToBurn, err := k.unbond(ctx, delegatorAddress, valDstAddr, sharesToUnbond)
 		if err != nil {
 			panic(fmt.Errorf("error unbonding delegator: %v", err))
 		}
+		k.untrackLiquidDelegation(ctx, delegatorAddress, valDstAddr, tokensToBurn, sharesToUnbond)
 
 		dstValidator, found := k.GetValidator(ctx, valDstAddr)
 		if !found {
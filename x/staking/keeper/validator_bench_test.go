@@ -1,6 +1,18 @@
 package keeper_test
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/fixture"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
 
 func BenchmarkGetValidator(b *testing.B) {
 	// 900 is the max number we are allowed to use in order to avoid simapp.CreateTestPubKeys
@@ -27,3 +39,53 @@ func BenchmarkGetValidator(b *testing.B) {
 		}
 	}
 }
+
+// benchmarkSizes are the sizes of the states the end blocker and queries are
+// benchmarked with.
+var benchmarkSizes = []struct {
+	accounts, validators, delegations int
+}{
+	{1000, 100, 2},
+	{10000, 300, 3},
+}
+
+func BenchmarkEndBlocker(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		b.Run(fmt.Sprintf("accounts=%d/validators=%d", size.accounts, size.validators), func(b *testing.B) {
+			cfg := fixture.DefaultConfig()
+			cfg.Accounts, cfg.Validators, cfg.DelegationsPerAccount = size.accounts, size.validators, size.delegations
+			app, _ := fixture.Setup(b, cfg)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				cacheCtx, _ := ctx.CacheContext()
+				staking.EndBlocker(cacheCtx, app.StakingKeeper)
+			}
+		})
+	}
+}
+
+func BenchmarkValidatorDelegationsQuery(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		b.Run(fmt.Sprintf("accounts=%d/validators=%d", size.accounts, size.validators), func(b *testing.B) {
+			cfg := fixture.DefaultConfig()
+			cfg.Accounts, cfg.Validators, cfg.DelegationsPerAccount = size.accounts, size.validators, size.delegations
+			app, _ := fixture.Setup(b, cfg)
+			ctx := sdk.WrapSDKContext(app.BaseApp.NewContext(false, tmproto.Header{}))
+			querier := keeper.Querier{Keeper: app.StakingKeeper}
+			top := app.StakingKeeper.GetBondedValidatorsByPower(app.BaseApp.NewContext(false, tmproto.Header{}))[0]
+
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				_, err := querier.ValidatorDelegations(ctx, &types.QueryValidatorDelegationsRequest{
+					ValidatorAddr: top.OperatorAddress,
+					Pagination:    &query.PageRequest{Limit: 100},
+				})
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
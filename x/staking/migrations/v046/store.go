@@ -12,6 +12,7 @@ import (
 // The migration includes:
 //
 // - Setting the MinCommissionRate param in the paramstore
+// - Setting the liquid staking params in the paramstore
 func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore paramtypes.Subspace) error {
 	migrateParamsStore(ctx, paramstore)
 
@@ -21,4 +22,7 @@ func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Binar
 func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
 	paramstore.WithKeyTable(types.ParamKeyTable())
 	paramstore.Set(ctx, types.KeyMinCommissionRate, types.DefaultMinCommissionRate)
+	paramstore.Set(ctx, types.KeyValidatorBondFactor, types.DefaultValidatorBondFactor)
+	paramstore.Set(ctx, types.KeyGlobalLiquidStakingCap, types.DefaultGlobalLiquidStakingCap)
+	paramstore.Set(ctx, types.KeyValidatorLiquidStakingCap, types.DefaultValidatorLiquidStakingCap)
 }
@@ -29,4 +29,7 @@ func TestStoreMigration(t *testing.T) {
 
 	// Make sure the new params are set.
 	require.True(t, paramstore.Has(ctx, types.KeyMinCommissionRate))
+	require.True(t, paramstore.Has(ctx, types.KeyValidatorBondFactor))
+	require.True(t, paramstore.Has(ctx, types.KeyGlobalLiquidStakingCap))
+	require.True(t, paramstore.Has(ctx, types.KeyValidatorLiquidStakingCap))
 }
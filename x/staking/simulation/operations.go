@@ -1,6 +1,7 @@
 package simulation
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"math/rand"
 
@@ -22,6 +23,7 @@ const (
 	OpWeightMsgUndelegate                = "op_weight_msg_undelegate"
 	OpWeightMsgBeginRedelegate           = "op_weight_msg_begin_redelegate"
 	OpWeightMsgCancelUnbondingDelegation = "op_weight_msg_cancel_unbonding_delegation"
+	OpWeightMsgEditValidatorDescription  = "op_weight_msg_edit_validator_description"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -36,6 +38,7 @@ func WeightedOperations(
 		weightMsgUndelegate                int
 		weightMsgBeginRedelegate           int
 		weightMsgCancelUnbondingDelegation int
+		weightMsgEditValidatorDescription  int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
@@ -74,6 +77,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgEditValidatorDescription, &weightMsgEditValidatorDescription, nil,
+		func(_ *rand.Rand) {
+			weightMsgEditValidatorDescription = simappparams.DefaultWeightMsgEditValidatorDescription
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateValidator,
@@ -99,6 +108,10 @@ func WeightedOperations(
 			weightMsgCancelUnbondingDelegation,
 			SimulateMsgCancelUnbondingDelegate(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgEditValidatorDescription,
+			SimulateMsgEditValidatorDescription(ak, bk, k),
+		),
 	}
 }
 
@@ -239,6 +252,61 @@ func SimulateMsgEditValidator(ak types.AccountKeeper, bk types.BankKeeper, k kee
 	}
 }
 
+// SimulateMsgEditValidatorDescription generates a MsgEditValidatorDescription
+// editing the logo of a random validator
+func SimulateMsgEditValidatorDescription(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if len(k.GetAllValidators(ctx)) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEditValidatorDescription, "number of validators equal zero"), nil, nil
+		}
+
+		val, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEditValidatorDescription, "unable to pick a validator"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(val.GetOperator()))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEditValidatorDescription, "unable to find account"), nil, fmt.Errorf("validator %s not found", val.GetOperator())
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		if account == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEditValidatorDescription, "account not found"), nil, nil
+		}
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		logo := []byte(simtypes.RandStringOfLength(r, 10))
+		description := types.Description{}.WithLogo(
+			fmt.Sprintf("https://%s.com/logo.png", simtypes.RandStringOfLength(r, 10)),
+			fmt.Sprintf("%x", sha256.Sum256(logo)),
+		)
+
+		msg := types.NewMsgEditValidatorDescription(
+			val.GetOperator(), description, []string{types.DescriptionFieldLogoURI, types.DescriptionFieldLogoHash},
+		)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
 // SimulateMsgDelegate generates a MsgDelegate with random values
 func SimulateMsgDelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
 	return func(
@@ -52,6 +52,7 @@ func TestWeightedOperations(t *testing.T) {
 		{simappparams.DefaultWeightMsgUndelegate, types.ModuleName, types.TypeMsgUndelegate},
 		{simappparams.DefaultWeightMsgBeginRedelegate, types.ModuleName, types.TypeMsgBeginRedelegate},
 		{simappparams.DefaultWeightMsgCancelUnbondingDelegation, types.ModuleName, types.TypeMsgCancelUnbondingDelegation},
+		{simappparams.DefaultWeightMsgEditValidatorDescription, types.ModuleName, types.TypeMsgEditValidatorDescription},
 	}
 
 	for i, w := range weightesOps {
@@ -181,6 +182,41 @@ func TestSimulateMsgEditValidator(t *testing.T) {
 	require.Len(t, futureOperations, 0)
 }
 
+// TestSimulateMsgEditValidatorDescription tests the normal scenario of a valid message of type TypeMsgEditValidatorDescription.
+// Abonormal scenarios, where the message is created by an errors are not tested here.
+func TestSimulateMsgEditValidatorDescription(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	app, ctx, accounts := createTestApp(t, false, r, 3)
+	blockTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// remove genesis validator account
+	accounts = accounts[1:]
+
+	// setup accounts[0] as validator
+	_ = getTestingValidator0(t, app, ctx, accounts)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgEditValidatorDescription(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgEditValidatorDescription
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, "https://yNhYFmBZHe.com/logo.png", msg.Description.LogoUri)
+	require.Equal(t, "6772f42fdadd2e79a95a80cece9807082316d5d68bb4df9bb592066a10ef6258", msg.Description.LogoHash)
+	require.Equal(t, []string{types.DescriptionFieldLogoURI, types.DescriptionFieldLogoHash}, msg.Fields)
+	require.Equal(t, types.TypeMsgEditValidatorDescription, msg.Type())
+	require.Equal(t, "cosmosvaloper1p8wcgrjr4pjju90xg6u9cgq55dxwq8j7epjs3u", msg.ValidatorAddress)
+	require.Len(t, futureOperations, 0)
+}
+
 // TestSimulateMsgDelegate tests the normal scenario of a valid message of type TypeMsgDelegate.
 // Abonormal scenarios, where the message is created by an errors are not tested here.
 func TestSimulateMsgDelegate(t *testing.T) {
@@ -6,6 +6,7 @@ const (
 	EventTypeCompleteRedelegation      = "complete_redelegation"
 	EventTypeCreateValidator           = "create_validator"
 	EventTypeEditValidator             = "edit_validator"
+	EventTypeEditValidatorDescription  = "edit_validator_description"
 	EventTypeDelegate                  = "delegate"
 	EventTypeUnbond                    = "unbond"
 	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
@@ -20,5 +21,6 @@ const (
 	AttributeKeyCreationHeight    = "creation_height"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyFields            = "fields"
 	AttributeValueCategory        = ModuleName
 )
@@ -48,6 +48,9 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	TotalLiquidStakedTokensKey = []byte{0x61} // key for the total tokens delegated by liquid staking providers
+	ValidatorLiquidSharesKey   = []byte{0x62} // prefix for each key to the shares of a validator delegated by liquid staking providers
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -56,6 +59,13 @@ func GetValidatorKey(operatorAddr sdk.ValAddress) []byte {
 	return append(ValidatorsKey, address.MustLengthPrefix(operatorAddr)...)
 }
 
+// GetValidatorLiquidSharesKey creates the key for the liquid staking shares of
+// the validator with address
+// VALUE: sdk.Dec
+func GetValidatorLiquidSharesKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
 // GetValidatorByConsAddrKey creates the key for the validator with pubkey
 // VALUE: validator operator address ([]byte)
 func GetValidatorByConsAddrKey(addr sdk.ConsAddress) []byte {
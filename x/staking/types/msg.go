@@ -12,6 +12,7 @@ const (
 	TypeMsgUndelegate                = "begin_unbonding"
 	TypeMsgCancelUnbondingDelegation = "cancel_unbond"
 	TypeMsgEditValidator             = "edit_validator"
+	TypeMsgEditValidatorDescription  = "edit_validator_description"
 	TypeMsgCreateValidator           = "create_validator"
 	TypeMsgDelegate                  = "delegate"
 	TypeMsgBeginRedelegate           = "begin_redelegate"
@@ -22,6 +23,7 @@ var (
 	_ codectypes.UnpackInterfacesMessage = (*MsgCreateValidator)(nil)
 	_ sdk.Msg                            = &MsgCreateValidator{}
 	_ sdk.Msg                            = &MsgEditValidator{}
+	_ sdk.Msg                            = &MsgEditValidatorDescription{}
 	_ sdk.Msg                            = &MsgDelegate{}
 	_ sdk.Msg                            = &MsgUndelegate{}
 	_ sdk.Msg                            = &MsgBeginRedelegate{}
@@ -184,7 +186,7 @@ func (msg MsgEditValidator) ValidateBasic() error {
 	}
 
 	if msg.CommissionRate != nil {
-		if msg.CommissionRate.GT(sdk.OneDec()) || msg.CommissionRate.IsNegative() {
+		if !msg.CommissionRate.IsBetween(sdk.ZeroDec(), sdk.OneDec(), true) {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "commission rate must be between 0 and 1 (inclusive)")
 		}
 	}
@@ -192,6 +194,62 @@ func (msg MsgEditValidator) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgEditValidatorDescription creates a new MsgEditValidatorDescription instance
+//nolint:interfacer
+func NewMsgEditValidatorDescription(valAddr sdk.ValAddress, description Description, fields []string) *MsgEditValidatorDescription {
+	return &MsgEditValidatorDescription{
+		ValidatorAddress: valAddr.String(),
+		Description:      description,
+		Fields:           fields,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgEditValidatorDescription) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgEditValidatorDescription) Type() string { return TypeMsgEditValidatorDescription }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgEditValidatorDescription) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgEditValidatorDescription) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgEditValidatorDescription) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if len(msg.Fields) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no description field to edit")
+	}
+
+	seen := make(map[string]bool, len(msg.Fields))
+	for _, field := range msg.Fields {
+		if _, ok := descriptionFieldSetters[field]; !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown description field: %s", field)
+		}
+
+		if seen[field] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate description field: %s", field)
+		}
+		seen[field] = true
+	}
+
+	if _, err := msg.Description.EnsureLength(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // NewMsgDelegate creates a new MsgDelegate instance.
 //nolint:interfacer
 func NewMsgDelegate(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) *MsgDelegate {
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -117,6 +118,35 @@ func TestMsgEditValidator(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgEditValidatorDescription
+func TestMsgEditValidatorDescription(t *testing.T) {
+	logoFields := []string{types.DescriptionFieldLogoURI, types.DescriptionFieldLogoHash}
+	tests := []struct {
+		name          string
+		validatorAddr sdk.ValAddress
+		description   types.Description
+		fields        []string
+		expectPass    bool
+	}{
+		{"basic good", valAddr1, types.Description{}.WithLogo("https://validator.cosmos/logo.png", ""), logoFields, true},
+		{"clearing a field", valAddr1, types.Description{}, []string{types.DescriptionFieldDetails}, true},
+		{"empty address", emptyAddr, types.Description{}, logoFields, false},
+		{"no field", valAddr1, types.NewDescription("a", "", "", "", ""), nil, false},
+		{"unknown field", valAddr1, types.Description{}, []string{"logo"}, false},
+		{"duplicate field", valAddr1, types.Description{}, []string{types.DescriptionFieldDetails, types.DescriptionFieldDetails}, false},
+		{"too long moniker", valAddr1, types.NewDescription(strings.Repeat("a", types.MaxMonikerLength+1), "", "", "", ""), []string{types.DescriptionFieldMoniker}, false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgEditValidatorDescription(tc.validatorAddr, tc.description, tc.fields)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
 // test ValidateBasic for MsgDelegate
 func TestMsgDelegate(t *testing.T) {
 	tests := []struct {
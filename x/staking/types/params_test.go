@@ -36,3 +36,32 @@ func Test_validateParams(t *testing.T) {
 	params.MinCommissionRate = sdk.NewDec(2)
 	require.Error(t, params.Validate())
 }
+
+func TestValidateLiquidStakingParams(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(*types.Params)
+		expErr bool
+	}{
+		{"disabled validator bond factor", func(p *types.Params) { p.ValidatorBondFactor = sdk.NewDec(-1) }, false},
+		{"zero validator bond factor", func(p *types.Params) { p.ValidatorBondFactor = sdk.ZeroDec() }, false},
+		{"validator bond factor", func(p *types.Params) { p.ValidatorBondFactor = sdk.NewDec(250) }, false},
+		{"negative validator bond factor", func(p *types.Params) { p.ValidatorBondFactor = sdk.NewDecWithPrec(-5, 1) }, true},
+		{"zero global cap", func(p *types.Params) { p.GlobalLiquidStakingCap = sdk.ZeroDec() }, false},
+		{"negative global cap", func(p *types.Params) { p.GlobalLiquidStakingCap = sdk.NewDecWithPrec(-1, 1) }, true},
+		{"global cap above 100%", func(p *types.Params) { p.GlobalLiquidStakingCap = sdk.NewDecWithPrec(11, 1) }, true},
+		{"validator cap", func(p *types.Params) { p.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(5, 1) }, false},
+		{"validator cap above 100%", func(p *types.Params) { p.ValidatorLiquidStakingCap = sdk.NewDec(2) }, true},
+	}
+
+	for _, tc := range testCases {
+		params := types.DefaultParams()
+		tc.modify(&params)
+		err := params.Validate()
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -200,6 +200,89 @@ func (m *MsgEditValidatorResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEditValidatorResponse proto.InternalMessageInfo
 
+// MsgEditValidatorDescription defines a SDK message for editing only the given
+// fields of the description of an existing validator.
+type MsgEditValidatorDescription struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// description holds the new values of the edited fields, the values of the
+	// other fields being ignored.
+	Description Description `protobuf:"bytes,2,opt,name=description,proto3" json:"description"`
+	// fields defines the names of the description fields to edit, e.g.
+	// "logo_uri" or "security_contact_hash".
+	Fields []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (m *MsgEditValidatorDescription) Reset()         { *m = MsgEditValidatorDescription{} }
+func (m *MsgEditValidatorDescription) String() string { return proto.CompactTextString(m) }
+func (*MsgEditValidatorDescription) ProtoMessage()    {}
+func (*MsgEditValidatorDescription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{4}
+}
+func (m *MsgEditValidatorDescription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEditValidatorDescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEditValidatorDescription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEditValidatorDescription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEditValidatorDescription.Merge(m, src)
+}
+func (m *MsgEditValidatorDescription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEditValidatorDescription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEditValidatorDescription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEditValidatorDescription proto.InternalMessageInfo
+
+// MsgEditValidatorDescriptionResponse defines the Msg/EditValidatorDescription
+// response type.
+type MsgEditValidatorDescriptionResponse struct {
+}
+
+func (m *MsgEditValidatorDescriptionResponse) Reset()         { *m = MsgEditValidatorDescriptionResponse{} }
+func (m *MsgEditValidatorDescriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEditValidatorDescriptionResponse) ProtoMessage()    {}
+func (*MsgEditValidatorDescriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{5}
+}
+func (m *MsgEditValidatorDescriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEditValidatorDescriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEditValidatorDescriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEditValidatorDescriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEditValidatorDescriptionResponse.Merge(m, src)
+}
+func (m *MsgEditValidatorDescriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEditValidatorDescriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEditValidatorDescriptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEditValidatorDescriptionResponse proto.InternalMessageInfo
+
 // MsgDelegate defines a SDK message for performing a delegation of coins
 // from a delegator to a validator.
 type MsgDelegate struct {
@@ -212,7 +295,7 @@ func (m *MsgDelegate) Reset()         { *m = MsgDelegate{} }
 func (m *MsgDelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegate) ProtoMessage()    {}
 func (*MsgDelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{4}
+	return fileDescriptor_0926ef28816b35ab, []int{6}
 }
 func (m *MsgDelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,7 +332,7 @@ func (m *MsgDelegateResponse) Reset()         { *m = MsgDelegateResponse{} }
 func (m *MsgDelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateResponse) ProtoMessage()    {}
 func (*MsgDelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{5}
+	return fileDescriptor_0926ef28816b35ab, []int{7}
 }
 func (m *MsgDelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +374,7 @@ func (m *MsgBeginRedelegate) Reset()         { *m = MsgBeginRedelegate{} }
 func (m *MsgBeginRedelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgBeginRedelegate) ProtoMessage()    {}
 func (*MsgBeginRedelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{6}
+	return fileDescriptor_0926ef28816b35ab, []int{8}
 }
 func (m *MsgBeginRedelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -329,7 +412,7 @@ func (m *MsgBeginRedelegateResponse) Reset()         { *m = MsgBeginRedelegateRe
 func (m *MsgBeginRedelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBeginRedelegateResponse) ProtoMessage()    {}
 func (*MsgBeginRedelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{7}
+	return fileDescriptor_0926ef28816b35ab, []int{9}
 }
 func (m *MsgBeginRedelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -377,7 +460,7 @@ func (m *MsgUndelegate) Reset()         { *m = MsgUndelegate{} }
 func (m *MsgUndelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgUndelegate) ProtoMessage()    {}
 func (*MsgUndelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{8}
+	return fileDescriptor_0926ef28816b35ab, []int{10}
 }
 func (m *MsgUndelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,7 +498,7 @@ func (m *MsgUndelegateResponse) Reset()         { *m = MsgUndelegateResponse{} }
 func (m *MsgUndelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUndelegateResponse) ProtoMessage()    {}
 func (*MsgUndelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{9}
+	return fileDescriptor_0926ef28816b35ab, []int{11}
 }
 func (m *MsgUndelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -465,7 +548,7 @@ func (m *MsgCancelUnbondingDelegation) Reset()         { *m = MsgCancelUnbonding
 func (m *MsgCancelUnbondingDelegation) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelUnbondingDelegation) ProtoMessage()    {}
 func (*MsgCancelUnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{10}
+	return fileDescriptor_0926ef28816b35ab, []int{12}
 }
 func (m *MsgCancelUnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -502,7 +585,7 @@ func (m *MsgCancelUnbondingDelegationResponse) Reset()         { *m = MsgCancelU
 func (m *MsgCancelUnbondingDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelUnbondingDelegationResponse) ProtoMessage()    {}
 func (*MsgCancelUnbondingDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{11}
+	return fileDescriptor_0926ef28816b35ab, []int{13}
 }
 func (m *MsgCancelUnbondingDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -536,6 +619,8 @@ func init() {
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgCreateValidatorResponse")
 	proto.RegisterType((*MsgEditValidator)(nil), "cosmos.staking.v1beta1.MsgEditValidator")
 	proto.RegisterType((*MsgEditValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgEditValidatorResponse")
+	proto.RegisterType((*MsgEditValidatorDescription)(nil), "cosmos.staking.v1beta1.MsgEditValidatorDescription")
+	proto.RegisterType((*MsgEditValidatorDescriptionResponse)(nil), "cosmos.staking.v1beta1.MsgEditValidatorDescriptionResponse")
 	proto.RegisterType((*MsgDelegate)(nil), "cosmos.staking.v1beta1.MsgDelegate")
 	proto.RegisterType((*MsgDelegateResponse)(nil), "cosmos.staking.v1beta1.MsgDelegateResponse")
 	proto.RegisterType((*MsgBeginRedelegate)(nil), "cosmos.staking.v1beta1.MsgBeginRedelegate")
@@ -549,66 +634,70 @@ func init() {
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 937 bytes of a gzipped FileDescriptorProto
+	// 996 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xf6, 0xc6, 0x4e, 0x28, 0x13, 0xb5, 0x69, 0x37, 0x09, 0x38, 0xab, 0xca, 0xae, 0xdc, 0xd2,
-	0x46, 0x85, 0xac, 0x69, 0x28, 0x02, 0x55, 0xbd, 0xd4, 0x75, 0x2b, 0xaa, 0x62, 0x09, 0x6d, 0x28,
-	0x07, 0x84, 0x64, 0xcd, 0xee, 0x4e, 0x26, 0x23, 0xef, 0xce, 0xb8, 0x3b, 0xe3, 0xa8, 0xbe, 0x72,
-	0xe2, 0x46, 0x25, 0xfe, 0x40, 0x7f, 0x00, 0x42, 0x1c, 0xfa, 0x23, 0x2a, 0x4e, 0x51, 0x4f, 0x88,
-	0x43, 0x81, 0xe4, 0x00, 0x3f, 0x00, 0x71, 0x46, 0x3b, 0x3b, 0x3b, 0xfe, 0x58, 0x7b, 0xb3, 0x41,
-	0xe9, 0x01, 0x71, 0xf2, 0x6a, 0xe6, 0x79, 0x9f, 0x99, 0x79, 0xde, 0x67, 0xde, 0x77, 0x0c, 0xea,
-	0x1e, 0xe3, 0x21, 0xe3, 0x4d, 0x2e, 0x60, 0x8f, 0x50, 0xdc, 0xdc, 0xbf, 0xe1, 0x22, 0x01, 0x6f,
-	0x34, 0xc5, 0x13, 0xbb, 0x1f, 0x31, 0xc1, 0xcc, 0xb7, 0x12, 0x80, 0xad, 0x00, 0xb6, 0x02, 0x58,
-	0x1b, 0x98, 0x31, 0x1c, 0xa0, 0xa6, 0x44, 0xb9, 0x83, 0xdd, 0x26, 0xa4, 0xc3, 0x24, 0xc4, 0xaa,
-	0x4f, 0x4f, 0x09, 0x12, 0x22, 0x2e, 0x60, 0xd8, 0x57, 0x80, 0x35, 0xcc, 0x30, 0x93, 0x9f, 0xcd,
-	0xf8, 0x4b, 0x8d, 0x6e, 0x24, 0x2b, 0x75, 0x93, 0x09, 0xb5, 0x6c, 0x32, 0x55, 0x53, 0xbb, 0x74,
-	0x21, 0x47, 0x7a, 0x8b, 0x1e, 0x23, 0x54, 0xcd, 0x5f, 0x99, 0x73, 0x8a, 0x74, 0xd3, 0x09, 0xea,
-	0x6d, 0x85, 0x0a, 0x79, 0x8c, 0x88, 0x7f, 0x92, 0x89, 0xc6, 0xef, 0x15, 0x60, 0x76, 0x38, 0xbe,
-	0x1b, 0x21, 0x28, 0xd0, 0x17, 0x30, 0x20, 0x3e, 0x14, 0x2c, 0x32, 0x1f, 0x82, 0x65, 0x1f, 0x71,
-	0x2f, 0x22, 0x7d, 0x41, 0x18, 0xad, 0x1a, 0x97, 0x8c, 0xcd, 0xe5, 0xed, 0xcb, 0xf6, 0x6c, 0x41,
-	0xec, 0xf6, 0x08, 0xda, 0xaa, 0xbc, 0x78, 0x55, 0x2f, 0x39, 0xe3, 0xd1, 0x66, 0x07, 0x00, 0x8f,
-	0x85, 0x21, 0xe1, 0x3c, 0xe6, 0x5a, 0x90, 0x5c, 0xd7, 0xe6, 0x71, 0xdd, 0xd5, 0x48, 0x07, 0x0a,
-	0xc4, 0x15, 0xdf, 0x18, 0x81, 0x19, 0x80, 0xd5, 0x90, 0xd0, 0x2e, 0x47, 0xc1, 0x6e, 0xd7, 0x47,
-	0x01, 0xc2, 0x50, 0xee, 0xb1, 0x7c, 0xc9, 0xd8, 0x7c, 0xb3, 0x75, 0x3b, 0x86, 0xff, 0xf2, 0xaa,
-	0x7e, 0x15, 0x13, 0xb1, 0x37, 0x70, 0x6d, 0x8f, 0x85, 0x4a, 0x4f, 0xf5, 0xb3, 0xc5, 0xfd, 0x5e,
-	0x53, 0x0c, 0xfb, 0x88, 0xdb, 0x0f, 0xa8, 0x78, 0xf9, 0x7c, 0x0b, 0xa8, 0x8d, 0x3c, 0xa0, 0xc2,
-	0xb9, 0x10, 0x12, 0xba, 0x83, 0x82, 0xdd, 0xb6, 0xa6, 0x35, 0xef, 0x81, 0x0b, 0x6a, 0x11, 0x16,
-	0x75, 0xa1, 0xef, 0x47, 0x88, 0xf3, 0x6a, 0x45, 0xae, 0x55, 0x7d, 0xf9, 0x7c, 0x6b, 0x4d, 0x45,
-	0xdf, 0x49, 0x66, 0x76, 0x44, 0x44, 0x28, 0x76, 0xce, 0xeb, 0x10, 0x35, 0x1e, 0xd3, 0xec, 0xa7,
-	0xea, 0x6a, 0x9a, 0xc5, 0xe3, 0x68, 0x74, 0x48, 0x4a, 0x73, 0x1f, 0x2c, 0xf5, 0x07, 0x6e, 0x0f,
-	0x0d, 0xab, 0x4b, 0x52, 0xc6, 0x35, 0x3b, 0x31, 0x9c, 0x9d, 0x1a, 0xce, 0xbe, 0x43, 0x87, 0xad,
-	0xea, 0x4f, 0x23, 0x46, 0x2f, 0x1a, 0xf6, 0x05, 0xb3, 0x3f, 0x1b, 0xb8, 0x0f, 0xd1, 0xd0, 0x51,
-	0xd1, 0xe6, 0x87, 0x60, 0x71, 0x1f, 0x06, 0x03, 0x54, 0x7d, 0x43, 0xd2, 0x6c, 0xa4, 0xd9, 0x88,
-	0x5d, 0x36, 0x96, 0x0a, 0x92, 0xe6, 0x33, 0x41, 0xdf, 0xba, 0xf9, 0xcd, 0xb3, 0x7a, 0xe9, 0xcf,
-	0x67, 0xf5, 0xd2, 0xd7, 0x7f, 0xfc, 0x78, 0x3d, 0xab, 0x8b, 0x1c, 0xcd, 0x1c, 0xb3, 0x71, 0x11,
-	0x58, 0x59, 0x8b, 0x39, 0x88, 0xf7, 0x19, 0xe5, 0xa8, 0xf1, 0x5d, 0x19, 0x9c, 0xef, 0x70, 0x7c,
-	0xcf, 0x27, 0xe2, 0x35, 0xf9, 0x6f, 0xa6, 0xf6, 0x0b, 0x27, 0xd6, 0x1e, 0x82, 0x95, 0x91, 0x0b,
-	0xbb, 0x11, 0x14, 0x48, 0x79, 0xee, 0xe3, 0x82, 0x7e, 0x6b, 0x23, 0x6f, 0xcc, 0x6f, 0x6d, 0xe4,
-	0x39, 0xe7, 0xbc, 0x09, 0xb7, 0x9b, 0x7b, 0xb3, 0xad, 0x5d, 0x39, 0xd1, 0x32, 0x45, 0x6c, 0x7d,
-	0xab, 0x36, 0x91, 0xc9, 0x6c, 0xce, 0x2c, 0x50, 0x9d, 0x4e, 0x8a, 0xce, 0xd8, 0x5f, 0x06, 0x58,
-	0xee, 0x70, 0xac, 0xd8, 0xd0, 0xec, 0x2b, 0x62, 0x9c, 0xce, 0x15, 0x39, 0x79, 0x9a, 0x3e, 0x02,
-	0x4b, 0x30, 0x64, 0x03, 0x2a, 0x64, 0x76, 0x0a, 0x78, 0x5b, 0xc1, 0xa7, 0x24, 0xc9, 0x9c, 0xa8,
-	0xb1, 0x0e, 0x56, 0xc7, 0x4e, 0xad, 0xd5, 0x38, 0x58, 0x90, 0x15, 0xb4, 0x85, 0x30, 0xa1, 0x0e,
-	0xf2, 0x4f, 0x59, 0x94, 0x4f, 0xc1, 0xfa, 0x48, 0x14, 0x1e, 0x79, 0x85, 0x85, 0x59, 0xd5, 0x61,
-	0x3b, 0x91, 0x37, 0x93, 0xcd, 0xe7, 0x42, 0xb3, 0x95, 0x0b, 0xb3, 0xb5, 0xb9, 0xc8, 0x2a, 0x5d,
-	0x39, 0x5d, 0xa5, 0x7b, 0xb2, 0x60, 0x4c, 0x29, 0x9a, 0x0a, 0x6e, 0x76, 0xe4, 0x3d, 0xec, 0x07,
-	0x28, 0x36, 0x72, 0x37, 0x6e, 0xb0, 0xaa, 0x3e, 0x58, 0x99, 0x62, 0xf8, 0x79, 0xda, 0x7d, 0x5b,
-	0x67, 0xe2, 0x0d, 0x3c, 0xfd, 0xb5, 0x6e, 0xc8, 0x3b, 0xa7, 0x82, 0xe3, 0xe9, 0xc6, 0xdf, 0x06,
-	0x38, 0xdb, 0xe1, 0xf8, 0x11, 0xf5, 0xff, 0x67, 0x7e, 0xde, 0x05, 0xeb, 0x13, 0xe7, 0x7e, 0x5d,
-	0x02, 0xff, 0xb0, 0x00, 0x2e, 0xc6, 0xf5, 0x1f, 0x52, 0x0f, 0x05, 0x8f, 0xa8, 0xcb, 0xa8, 0x4f,
-	0x28, 0x3e, 0xae, 0xc5, 0xfe, 0xe7, 0xf4, 0x36, 0xaf, 0x81, 0x15, 0x2f, 0xee, 0x71, 0xb1, 0x68,
-	0x7b, 0x88, 0xe0, 0xbd, 0xe4, 0x5e, 0x94, 0x9d, 0x73, 0xe9, 0xf0, 0x27, 0x72, 0xf4, 0xd8, 0xc4,
-	0x5c, 0x05, 0x57, 0xf2, 0xf4, 0x4a, 0xf3, 0xb4, 0xfd, 0xfd, 0x22, 0x28, 0x77, 0x38, 0x36, 0x1f,
-	0x83, 0x95, 0xe9, 0xf7, 0xdb, 0xf5, 0x79, 0xad, 0x32, 0xdb, 0x88, 0xad, 0xed, 0xe2, 0x58, 0x6d,
-	0x91, 0x1e, 0x38, 0x3b, 0xd9, 0xb0, 0x37, 0x73, 0x48, 0x26, 0x90, 0xd6, 0xfb, 0x45, 0x91, 0x7a,
-	0xb1, 0xaf, 0xc0, 0x19, 0xdd, 0x6b, 0x2e, 0xe7, 0x44, 0xa7, 0x20, 0xeb, 0xdd, 0x02, 0x20, 0xcd,
-	0xfe, 0x18, 0xac, 0x4c, 0xd7, 0xee, 0x3c, 0xf5, 0xa6, 0xb0, 0xb9, 0xea, 0xcd, 0xab, 0x60, 0x2e,
-	0x00, 0x63, 0xe5, 0xe6, 0x9d, 0x1c, 0x86, 0x11, 0xcc, 0xda, 0x2a, 0x04, 0xd3, 0x6b, 0x7c, 0x6b,
-	0x80, 0x8d, 0xf9, 0x57, 0xee, 0x66, 0x5e, 0xce, 0xe7, 0x45, 0x59, 0xb7, 0xff, 0x4d, 0x54, 0xba,
-	0xa3, 0xd6, 0xfd, 0x17, 0x87, 0x35, 0xe3, 0xe0, 0xb0, 0x66, 0xfc, 0x76, 0x58, 0x33, 0x9e, 0x1e,
-	0xd5, 0x4a, 0x07, 0x47, 0xb5, 0xd2, 0xcf, 0x47, 0xb5, 0xd2, 0x97, 0xef, 0xe5, 0xbe, 0x6a, 0x9e,
-	0xe8, 0xff, 0x36, 0xf2, 0x7d, 0xe3, 0x2e, 0xc9, 0xea, 0xf3, 0xc1, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x48, 0x6a, 0x50, 0xaf, 0xc0, 0x0d, 0x00, 0x00,
+	0x18, 0xf6, 0xda, 0xae, 0x69, 0xdf, 0xa8, 0x49, 0xbb, 0x49, 0xca, 0x66, 0xa9, 0xec, 0xc8, 0xe9,
+	0x47, 0x54, 0xc8, 0x9a, 0xa6, 0x45, 0xa0, 0xd2, 0x4b, 0x5d, 0xb7, 0xa2, 0x2a, 0x96, 0xd0, 0x86,
+	0x72, 0x40, 0x48, 0xd6, 0x7e, 0x8c, 0x37, 0x2b, 0xef, 0xce, 0xb8, 0x3b, 0xe3, 0xa8, 0xbe, 0x72,
+	0xe2, 0x80, 0x44, 0x25, 0xfe, 0x40, 0x7f, 0x01, 0xe2, 0xd0, 0x1b, 0x7f, 0xa0, 0xe2, 0x14, 0xf5,
+	0x84, 0x38, 0x14, 0x48, 0x0e, 0xc0, 0x1d, 0x71, 0x46, 0xbb, 0x3b, 0x3b, 0xfe, 0x58, 0x7f, 0x6c,
+	0x4a, 0x72, 0x40, 0x9c, 0xec, 0xcc, 0x3c, 0xef, 0x33, 0xef, 0x3c, 0xef, 0xe3, 0x79, 0xdf, 0x40,
+	0xc5, 0x22, 0xd4, 0x27, 0xb4, 0x46, 0x99, 0xd1, 0x71, 0xb1, 0x53, 0xdb, 0xbb, 0x6e, 0x22, 0x66,
+	0x5c, 0xaf, 0xb1, 0x27, 0x5a, 0x37, 0x20, 0x8c, 0xc8, 0x17, 0x62, 0x80, 0xc6, 0x01, 0x1a, 0x07,
+	0xa8, 0x6b, 0x0e, 0x21, 0x8e, 0x87, 0x6a, 0x11, 0xca, 0xec, 0xb5, 0x6b, 0x06, 0xee, 0xc7, 0x21,
+	0x6a, 0x65, 0x7c, 0x8b, 0xb9, 0x3e, 0xa2, 0xcc, 0xf0, 0xbb, 0x1c, 0xb0, 0xe2, 0x10, 0x87, 0x44,
+	0x5f, 0x6b, 0xe1, 0x37, 0xbe, 0xba, 0x16, 0x9f, 0xd4, 0x8a, 0x37, 0xf8, 0xb1, 0xf1, 0x56, 0x99,
+	0x67, 0x69, 0x1a, 0x14, 0x89, 0x14, 0x2d, 0xe2, 0x62, 0xbe, 0x7f, 0x69, 0xca, 0x2d, 0x92, 0xa4,
+	0x63, 0xd4, 0x9b, 0x1c, 0xe5, 0xd3, 0x10, 0x11, 0x7e, 0xc4, 0x1b, 0xd5, 0xdf, 0x8a, 0x20, 0x37,
+	0xa9, 0x73, 0x37, 0x40, 0x06, 0x43, 0x9f, 0x19, 0x9e, 0x6b, 0x1b, 0x8c, 0x04, 0xf2, 0x43, 0x58,
+	0xb0, 0x11, 0xb5, 0x02, 0xb7, 0xcb, 0x5c, 0x82, 0x15, 0x69, 0x5d, 0xda, 0x5c, 0xd8, 0xde, 0xd0,
+	0x26, 0x0b, 0xa2, 0x35, 0x06, 0xd0, 0x7a, 0xf1, 0xc5, 0xab, 0x4a, 0x4e, 0x1f, 0x8e, 0x96, 0x9b,
+	0x00, 0x16, 0xf1, 0x7d, 0x97, 0xd2, 0x90, 0x2b, 0x1f, 0x71, 0x5d, 0x9d, 0xc6, 0x75, 0x57, 0x20,
+	0x75, 0x83, 0x21, 0xca, 0xf9, 0x86, 0x08, 0x64, 0x0f, 0x96, 0x7d, 0x17, 0xb7, 0x28, 0xf2, 0xda,
+	0x2d, 0x1b, 0x79, 0xc8, 0x31, 0xa2, 0x1c, 0x0b, 0xeb, 0xd2, 0xe6, 0x99, 0xfa, 0xed, 0x10, 0xfe,
+	0xf3, 0xab, 0xca, 0x15, 0xc7, 0x65, 0xbb, 0x3d, 0x53, 0xb3, 0x88, 0xcf, 0xf5, 0xe4, 0x1f, 0x5b,
+	0xd4, 0xee, 0xd4, 0x58, 0xbf, 0x8b, 0xa8, 0xf6, 0x00, 0xb3, 0x97, 0xcf, 0xb7, 0x80, 0x27, 0xf2,
+	0x00, 0x33, 0xfd, 0xbc, 0xef, 0xe2, 0x1d, 0xe4, 0xb5, 0x1b, 0x82, 0x56, 0xbe, 0x07, 0xe7, 0xf9,
+	0x21, 0x24, 0x68, 0x19, 0xb6, 0x1d, 0x20, 0x4a, 0x95, 0x62, 0x74, 0x96, 0xf2, 0xf2, 0xf9, 0xd6,
+	0x0a, 0x8f, 0xbe, 0x13, 0xef, 0xec, 0xb0, 0xc0, 0xc5, 0x8e, 0x7e, 0x4e, 0x84, 0xf0, 0xf5, 0x90,
+	0x66, 0x2f, 0x51, 0x57, 0xd0, 0x9c, 0x9a, 0x47, 0x23, 0x42, 0x12, 0x9a, 0xfb, 0x50, 0xea, 0xf6,
+	0xcc, 0x0e, 0xea, 0x2b, 0xa5, 0x48, 0xc6, 0x15, 0x2d, 0x36, 0x9c, 0x96, 0x18, 0x4e, 0xbb, 0x83,
+	0xfb, 0x75, 0xe5, 0xc7, 0x01, 0xa3, 0x15, 0xf4, 0xbb, 0x8c, 0x68, 0x9f, 0xf4, 0xcc, 0x87, 0xa8,
+	0xaf, 0xf3, 0x68, 0xf9, 0x3d, 0x38, 0xb5, 0x67, 0x78, 0x3d, 0xa4, 0xbc, 0x11, 0xd1, 0xac, 0x25,
+	0xd5, 0x08, 0x5d, 0x36, 0x54, 0x0a, 0x37, 0xa9, 0x67, 0x8c, 0xbe, 0x75, 0xf3, 0xab, 0x67, 0x95,
+	0xdc, 0x1f, 0xcf, 0x2a, 0xb9, 0x2f, 0x7f, 0xff, 0xfe, 0x5a, 0x5a, 0x97, 0x68, 0x35, 0x75, 0xcd,
+	0xea, 0x45, 0x50, 0xd3, 0x16, 0xd3, 0x11, 0xed, 0x12, 0x4c, 0x51, 0xf5, 0xdb, 0x02, 0x9c, 0x6b,
+	0x52, 0xe7, 0x9e, 0xed, 0xb2, 0x13, 0xf2, 0xdf, 0x44, 0xed, 0xf3, 0x47, 0xd6, 0xde, 0x80, 0xa5,
+	0x81, 0x0b, 0x5b, 0x81, 0xc1, 0x10, 0xf7, 0xdc, 0x07, 0x19, 0xfd, 0xd6, 0x40, 0xd6, 0x90, 0xdf,
+	0x1a, 0xc8, 0xd2, 0x17, 0xad, 0x11, 0xb7, 0xcb, 0xbb, 0x93, 0xad, 0x5d, 0x3c, 0xd2, 0x31, 0x59,
+	0x6c, 0x7d, 0xab, 0x3c, 0x52, 0xc9, 0x74, 0xcd, 0x54, 0x50, 0xc6, 0x8b, 0x22, 0x2a, 0xf6, 0xa7,
+	0x04, 0x6f, 0x8d, 0x6f, 0x36, 0xe6, 0xe9, 0x2d, 0x1d, 0x59, 0xef, 0x31, 0x0f, 0xe4, 0xff, 0x95,
+	0x07, 0x2e, 0x40, 0xa9, 0xed, 0x22, 0xcf, 0xa6, 0x4a, 0x61, 0xbd, 0xb0, 0x79, 0x46, 0xe7, 0x7f,
+	0xcd, 0xd5, 0xe1, 0x32, 0x6c, 0xcc, 0xb8, 0xaa, 0x90, 0xe4, 0x2f, 0x09, 0x16, 0x9a, 0xd4, 0xe1,
+	0x02, 0xa3, 0xc9, 0xaf, 0x86, 0x74, 0x3c, 0xaf, 0xc6, 0xd1, 0x9d, 0xfb, 0x3e, 0x94, 0x0c, 0x9f,
+	0xf4, 0x30, 0x53, 0x0a, 0xd9, 0x7e, 0xee, 0x1c, 0x3e, 0xa6, 0x4e, 0xea, 0x46, 0xd5, 0x55, 0x58,
+	0x1e, 0xba, 0xb5, 0x50, 0x63, 0x3f, 0x1f, 0x35, 0x95, 0x3a, 0x72, 0x5c, 0xac, 0x23, 0xfb, 0x98,
+	0x45, 0xf9, 0x18, 0x56, 0x07, 0xa2, 0xd0, 0xc0, 0xca, 0x2c, 0xcc, 0xb2, 0x08, 0xdb, 0x09, 0xac,
+	0x89, 0x6c, 0x36, 0x65, 0x82, 0xad, 0x90, 0x99, 0xad, 0x41, 0x59, 0x5a, 0xe9, 0xe2, 0xf1, 0x2a,
+	0xdd, 0x01, 0x35, 0xad, 0x68, 0x22, 0xb8, 0xdc, 0x8c, 0x9e, 0xa6, 0xae, 0x87, 0x42, 0x53, 0xb6,
+	0xc2, 0x99, 0x83, 0x3f, 0x99, 0x6a, 0xaa, 0x3f, 0x7c, 0x9a, 0x0c, 0x24, 0xf5, 0xd3, 0x61, 0x02,
+	0x4f, 0x7f, 0xa9, 0x48, 0xfa, 0xe2, 0x20, 0x38, 0xdc, 0xae, 0xfe, 0x2d, 0xc1, 0xd9, 0x26, 0x75,
+	0x1e, 0x61, 0xfb, 0x7f, 0xe6, 0xe7, 0x36, 0xac, 0x8e, 0xdc, 0xfb, 0xa4, 0x04, 0xfe, 0x2e, 0x0f,
+	0x17, 0xc3, 0x96, 0x68, 0x60, 0x0b, 0x79, 0x8f, 0xb0, 0x49, 0xb0, 0xed, 0x62, 0x67, 0xde, 0xd4,
+	0xf1, 0x9f, 0xd3, 0x5b, 0xbe, 0x0a, 0x4b, 0x56, 0xd8, 0xf6, 0x43, 0xd1, 0x76, 0x91, 0xeb, 0xec,
+	0xc6, 0xbf, 0x8b, 0x82, 0xbe, 0x98, 0x2c, 0x7f, 0x14, 0xad, 0xce, 0x2d, 0xcc, 0x15, 0xb8, 0x34,
+	0x4b, 0xaf, 0xa4, 0x4e, 0xdb, 0x3f, 0x94, 0xa0, 0xd0, 0xa4, 0x8e, 0xfc, 0x18, 0x96, 0xc6, 0x47,
+	0xda, 0x6b, 0xd3, 0x3a, 0x47, 0x7a, 0x36, 0x51, 0xb7, 0xb3, 0x63, 0x85, 0x45, 0x3a, 0x70, 0x76,
+	0x74, 0x86, 0xd9, 0x9c, 0x41, 0x32, 0x82, 0x54, 0xdf, 0xcd, 0x8a, 0x14, 0x87, 0x7d, 0x2d, 0x81,
+	0x32, 0xb5, 0xff, 0xde, 0xc8, 0x4a, 0x37, 0x14, 0xa4, 0x7e, 0xf8, 0x1a, 0x41, 0x22, 0x9d, 0x2f,
+	0xe0, 0xb4, 0x68, 0x7d, 0x1b, 0x33, 0x88, 0x12, 0x90, 0xfa, 0x76, 0x06, 0x90, 0x60, 0x7f, 0x0c,
+	0x4b, 0xe3, 0xad, 0x64, 0x56, 0x31, 0xc7, 0xb0, 0xea, 0x76, 0x76, 0xac, 0x38, 0xd2, 0x04, 0x18,
+	0x7a, 0xfd, 0x2e, 0xcf, 0x60, 0x18, 0xc0, 0xd4, 0xad, 0x4c, 0x30, 0x71, 0xc6, 0x37, 0x12, 0xac,
+	0x4d, 0x7f, 0x01, 0x6e, 0xce, 0xb2, 0xe0, 0xb4, 0x28, 0xf5, 0xf6, 0xeb, 0x44, 0x25, 0x19, 0xd5,
+	0xef, 0xbf, 0x38, 0x28, 0x4b, 0xfb, 0x07, 0x65, 0xe9, 0xd7, 0x83, 0xb2, 0xf4, 0xf4, 0xb0, 0x9c,
+	0xdb, 0x3f, 0x2c, 0xe7, 0x7e, 0x3a, 0x2c, 0xe7, 0x3e, 0x7f, 0x67, 0xe6, 0xdc, 0xf9, 0x44, 0xfc,
+	0xf7, 0x19, 0x4d, 0xa0, 0x66, 0x29, 0x7a, 0x0c, 0x6f, 0xfc, 0x33, 0x00, 0xce, 0xac, 0x46, 0x14,
+	0x62, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -627,6 +716,9 @@ type MsgClient interface {
 	CreateValidator(ctx context.Context, in *MsgCreateValidator, opts ...grpc.CallOption) (*MsgCreateValidatorResponse, error)
 	// EditValidator defines a method for editing an existing validator.
 	EditValidator(ctx context.Context, in *MsgEditValidator, opts ...grpc.CallOption) (*MsgEditValidatorResponse, error)
+	// EditValidatorDescription defines a method for editing only the given fields
+	// of the description of an existing validator.
+	EditValidatorDescription(ctx context.Context, in *MsgEditValidatorDescription, opts ...grpc.CallOption) (*MsgEditValidatorDescriptionResponse, error)
 	// Delegate defines a method for performing a delegation of coins
 	// from a delegator to a validator.
 	Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.CallOption) (*MsgDelegateResponse, error)
@@ -667,6 +759,15 @@ func (c *msgClient) EditValidator(ctx context.Context, in *MsgEditValidator, opt
 	return out, nil
 }
 
+func (c *msgClient) EditValidatorDescription(ctx context.Context, in *MsgEditValidatorDescription, opts ...grpc.CallOption) (*MsgEditValidatorDescriptionResponse, error) {
+	out := new(MsgEditValidatorDescriptionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/EditValidatorDescription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.CallOption) (*MsgDelegateResponse, error) {
 	out := new(MsgDelegateResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/Delegate", in, out, opts...)
@@ -709,6 +810,9 @@ type MsgServer interface {
 	CreateValidator(context.Context, *MsgCreateValidator) (*MsgCreateValidatorResponse, error)
 	// EditValidator defines a method for editing an existing validator.
 	EditValidator(context.Context, *MsgEditValidator) (*MsgEditValidatorResponse, error)
+	// EditValidatorDescription defines a method for editing only the given fields
+	// of the description of an existing validator.
+	EditValidatorDescription(context.Context, *MsgEditValidatorDescription) (*MsgEditValidatorDescriptionResponse, error)
 	// Delegate defines a method for performing a delegation of coins
 	// from a delegator to a validator.
 	Delegate(context.Context, *MsgDelegate) (*MsgDelegateResponse, error)
@@ -733,6 +837,9 @@ func (*UnimplementedMsgServer) CreateValidator(ctx context.Context, req *MsgCrea
 func (*UnimplementedMsgServer) EditValidator(ctx context.Context, req *MsgEditValidator) (*MsgEditValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditValidator not implemented")
 }
+func (*UnimplementedMsgServer) EditValidatorDescription(ctx context.Context, req *MsgEditValidatorDescription) (*MsgEditValidatorDescriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditValidatorDescription not implemented")
+}
 func (*UnimplementedMsgServer) Delegate(ctx context.Context, req *MsgDelegate) (*MsgDelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delegate not implemented")
 }
@@ -786,6 +893,24 @@ func _Msg_EditValidator_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_EditValidatorDescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditValidatorDescription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EditValidatorDescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/EditValidatorDescription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditValidatorDescription(ctx, req.(*MsgEditValidatorDescription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Delegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDelegate)
 	if err := dec(in); err != nil {
@@ -870,6 +995,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EditValidator",
 			Handler:    _Msg_EditValidator_Handler,
 		},
+		{
+			MethodName: "EditValidatorDescription",
+			Handler:    _Msg_EditValidatorDescription_Handler,
+		},
 		{
 			MethodName: "Delegate",
 			Handler:    _Msg_Delegate_Handler,
@@ -1090,6 +1219,78 @@ func (m *MsgEditValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgEditValidatorDescription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditValidatorDescription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditValidatorDescription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditValidatorDescriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditValidatorDescriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditValidatorDescriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1234,12 +1435,12 @@ func (m *MsgBeginRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err8 != nil {
-		return 0, err8
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n8
-	i = encodeVarintTx(dAtA, i, uint64(n8))
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1312,12 +1513,12 @@ func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err10 != nil {
-		return 0, err10
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n10
-	i = encodeVarintTx(dAtA, i, uint64(n10))
+	i -= n11
+	i = encodeVarintTx(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1479,6 +1680,36 @@ func (m *MsgEditValidatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgEditValidatorDescription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Description.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgEditValidatorDescriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgDelegate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2178,6 +2409,203 @@ func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgEditValidatorDescription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditValidatorDescription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditValidatorDescription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditValidatorDescriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditValidatorDescriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditValidatorDescriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,7 +2,9 @@ package types
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
+	"net/url"
 	"sort"
 	"strings"
 	"time"
@@ -26,8 +28,39 @@ const (
 	MaxWebsiteLength         = 140
 	MaxSecurityContactLength = 140
 	MaxDetailsLength         = 280
+	MaxLogoURILength         = 256
+
+	// DescriptionHashLength is the length of the hex encoded SHA-256 hashes of
+	// a validator's description.
+	DescriptionHashLength = 64
+)
+
+// names of the fields of a validator's description, as edited by
+// MsgEditValidatorDescription
+const (
+	DescriptionFieldMoniker             = "moniker"
+	DescriptionFieldIdentity            = "identity"
+	DescriptionFieldWebsite             = "website"
+	DescriptionFieldSecurityContact     = "security_contact"
+	DescriptionFieldDetails             = "details"
+	DescriptionFieldLogoURI             = "logo_uri"
+	DescriptionFieldLogoHash            = "logo_hash"
+	DescriptionFieldSecurityContactHash = "security_contact_hash"
 )
 
+// descriptionFieldSetters maps the names of the fields of a validator's
+// description to the functions copying them from one description to another.
+var descriptionFieldSetters = map[string]func(dst *Description, src Description){
+	DescriptionFieldMoniker:             func(dst *Description, src Description) { dst.Moniker = src.Moniker },
+	DescriptionFieldIdentity:            func(dst *Description, src Description) { dst.Identity = src.Identity },
+	DescriptionFieldWebsite:             func(dst *Description, src Description) { dst.Website = src.Website },
+	DescriptionFieldSecurityContact:     func(dst *Description, src Description) { dst.SecurityContact = src.SecurityContact },
+	DescriptionFieldDetails:             func(dst *Description, src Description) { dst.Details = src.Details },
+	DescriptionFieldLogoURI:             func(dst *Description, src Description) { dst.LogoUri = src.LogoUri },
+	DescriptionFieldLogoHash:            func(dst *Description, src Description) { dst.LogoHash = src.LogoHash },
+	DescriptionFieldSecurityContactHash: func(dst *Description, src Description) { dst.SecurityContactHash = src.SecurityContactHash },
+}
+
 var (
 	BondStatusUnspecified = BondStatus_name[int32(Unspecified)]
 	BondStatusUnbonded    = BondStatus_name[int32(Unbonded)]
@@ -199,6 +232,21 @@ func NewDescription(moniker, identity, website, securityContact, details string)
 	}
 }
 
+// WithLogo returns a copy of the description with the given logo URI and
+// logo hash.
+func (d Description) WithLogo(uri, hash string) Description {
+	d.LogoUri = uri
+	d.LogoHash = hash
+	return d
+}
+
+// WithSecurityContactHash returns a copy of the description with the given
+// security contact verification hash.
+func (d Description) WithSecurityContactHash(hash string) Description {
+	d.SecurityContactHash = hash
+	return d
+}
+
 // String implements the Stringer interface for a Description object.
 func (d Description) String() string {
 	out, _ := yaml.Marshal(d)
@@ -228,13 +276,90 @@ func (d Description) UpdateDescription(d2 Description) (Description, error) {
 		d2.Details = d.Details
 	}
 
+	if d2.LogoUri == DoNotModifyDesc {
+		d2.LogoUri = d.LogoUri
+	}
+
+	if d2.LogoHash == DoNotModifyDesc {
+		d2.LogoHash = d.LogoHash
+	}
+
+	if d2.SecurityContactHash == DoNotModifyDesc {
+		d2.SecurityContactHash = d.SecurityContactHash
+	}
+
 	return NewDescription(
 		d2.Moniker,
 		d2.Identity,
 		d2.Website,
 		d2.SecurityContact,
 		d2.Details,
-	).EnsureLength()
+	).WithLogo(d2.LogoUri, d2.LogoHash).WithSecurityContactHash(d2.SecurityContactHash).Validate()
+}
+
+// UpdateDescriptionFields updates only the given fields of a given description,
+// leaving the other ones untouched. An error is returned if a field is unknown
+// or if the resulting description is invalid.
+func (d Description) UpdateDescriptionFields(d2 Description, fields []string) (Description, error) {
+	for _, field := range fields {
+		set, ok := descriptionFieldSetters[field]
+		if !ok {
+			return d, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown description field: %s", field)
+		}
+
+		set(&d, d2)
+	}
+
+	return d.Validate()
+}
+
+// Validate ensures the length of a validator's description and the format of
+// its logo URI and hashes.
+func (d Description) Validate() (Description, error) {
+	if _, err := d.EnsureLength(); err != nil {
+		return d, err
+	}
+
+	if d.LogoUri != "" {
+		if u, err := url.Parse(d.LogoUri); err != nil || !u.IsAbs() {
+			return d, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid logo URI: %s", d.LogoUri)
+		}
+	}
+
+	if d.LogoHash != "" {
+		if d.LogoUri == "" {
+			return d, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "logo hash without logo URI")
+		}
+
+		if err := validateDescriptionHash(d.LogoHash); err != nil {
+			return d, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid logo hash: %s", err)
+		}
+	}
+
+	if d.SecurityContactHash != "" {
+		if d.SecurityContact == "" {
+			return d, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "security contact hash without security contact")
+		}
+
+		if err := validateDescriptionHash(d.SecurityContactHash); err != nil {
+			return d, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid security contact hash: %s", err)
+		}
+	}
+
+	return d, nil
+}
+
+// validateDescriptionHash checks that hash is a hex encoded SHA-256 hash.
+func validateDescriptionHash(hash string) error {
+	if len(hash) != DescriptionHashLength {
+		return fmt.Errorf("got length %d, expected %d", len(hash), DescriptionHashLength)
+	}
+
+	if _, err := hex.DecodeString(hash); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // EnsureLength ensures the length of a validator's description.
@@ -259,6 +384,18 @@ func (d Description) EnsureLength() (Description, error) {
 		return d, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid details length; got: %d, max: %d", len(d.Details), MaxDetailsLength)
 	}
 
+	if len(d.LogoUri) > MaxLogoURILength {
+		return d, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid logo URI length; got: %d, max: %d", len(d.LogoUri), MaxLogoURILength)
+	}
+
+	if len(d.LogoHash) > DescriptionHashLength {
+		return d, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid logo hash length; got: %d, max: %d", len(d.LogoHash), DescriptionHashLength)
+	}
+
+	if len(d.SecurityContactHash) > DescriptionHashLength {
+		return d, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid security contact hash length; got: %d, max: %d", len(d.SecurityContactHash), DescriptionHashLength)
+	}
+
 	return d, nil
 }
 
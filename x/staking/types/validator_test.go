@@ -3,6 +3,7 @@ package types_test
 import (
 	"math/rand"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -56,6 +57,64 @@ func TestUpdateDescription(t *testing.T) {
 	require.Equal(t, d, d3)
 }
 
+func TestUpdateDescriptionFields(t *testing.T) {
+	logoHash := strings.Repeat("ab", 32)
+	d1 := types.NewDescription("moniker", "", "https://validator.cosmos", "security@validator.cosmos", "")
+
+	d, err := d1.UpdateDescriptionFields(
+		types.Description{Moniker: "ignored"}.WithLogo("https://validator.cosmos/logo.png", logoHash),
+		[]string{types.DescriptionFieldLogoURI, types.DescriptionFieldLogoHash},
+	)
+	require.NoError(t, err)
+	require.Equal(t, d1.WithLogo("https://validator.cosmos/logo.png", logoHash), d)
+
+	// clearing a field
+	d, err = d.UpdateDescriptionFields(types.Description{}, []string{types.DescriptionFieldWebsite})
+	require.NoError(t, err)
+	require.Empty(t, d.Website)
+	require.Equal(t, "moniker", d.Moniker)
+
+	_, err = d1.UpdateDescriptionFields(types.Description{}, []string{"unknown"})
+	require.Error(t, err)
+
+	// the resulting description is invalid
+	_, err = d1.UpdateDescriptionFields(types.Description{LogoHash: logoHash}, []string{types.DescriptionFieldLogoHash})
+	require.Error(t, err)
+}
+
+func TestDescriptionValidate(t *testing.T) {
+	hash := strings.Repeat("ab", 32)
+	base := types.NewDescription("moniker", "", "", "security@validator.cosmos", "")
+
+	testCases := []struct {
+		name        string
+		description types.Description
+		expectErr   bool
+	}{
+		{"no metadata", base, false},
+		{"logo and hashes", base.WithLogo("ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi", hash).WithSecurityContactHash(hash), false},
+		{"logo without hash", base.WithLogo("https://validator.cosmos/logo.png", ""), false},
+		{"relative logo URI", base.WithLogo("logo.png", ""), true},
+		{"too long logo URI", base.WithLogo("https://validator.cosmos/"+strings.Repeat("a", types.MaxLogoURILength), ""), true},
+		{"logo hash without logo URI", base.WithLogo("", hash), true},
+		{"short logo hash", base.WithLogo("https://validator.cosmos/logo.png", hash[2:]), true},
+		{"non hex logo hash", base.WithLogo("https://validator.cosmos/logo.png", strings.Repeat("zz", 32)), true},
+		{"security contact hash without security contact", types.NewDescription("moniker", "", "", "", "").WithSecurityContactHash(hash), true},
+		{"too long security contact hash", base.WithSecurityContactHash(hash + "ab"), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.description.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestABCIValidatorUpdate(t *testing.T) {
 	validator := newValidator(t, valAddr1, pk1)
 	abciVal := validator.ABCIValidatorUpdate(sdk.DefaultPowerReduction)
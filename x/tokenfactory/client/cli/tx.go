@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        tokenfactory.ModuleName,
+		Short:                      "tokenfactory transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCmdCreateDenom(),
+		NewCmdMint(),
+		NewCmdBurn(),
+		NewCmdForceTransfer(),
+		NewCmdChangeAdmin(),
+		NewCmdSetDenomMetadata(),
+	)
+
+	return txCmd
+}
+
+func NewCmdCreateDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-denom [subdenom] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "create the denom factory/{sender}/{subdenom}, administered by the sender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s create-denom <subdenom> --from <sender> --chain-id <chain-id>`, version.AppName, tokenfactory.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := tokenfactory.MsgCreateDenom{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Subdenom: args[0],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [amount] [mint-to-address] --from [admin]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "mint tokens of a factory denom, to the admin by default",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mint 100factory/<creator>/<subdenom> [mint-to-address] --from <admin> --chain-id <chain-id>`, version.AppName, tokenfactory.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := tokenfactory.MsgMint{
+				Sender: clientCtx.GetFromAddress().String(),
+				Amount: amount,
+			}
+			if len(args) > 1 {
+				msg.MintToAddress = args[1]
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [amount] [burn-from-address] --from [admin]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "burn tokens of a factory denom, from the admin by default",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s burn 100factory/<creator>/<subdenom> [burn-from-address] --from <admin> --chain-id <chain-id>`, version.AppName, tokenfactory.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := tokenfactory.MsgBurn{
+				Sender: clientCtx.GetFromAddress().String(),
+				Amount: amount,
+			}
+			if len(args) > 1 {
+				msg.BurnFromAddress = args[1]
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdForceTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-transfer [amount] [transfer-from-address] [transfer-to-address] --from [admin]",
+		Args:  cobra.ExactArgs(3),
+		Short: "transfer tokens of a factory denom between any accounts",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s force-transfer 100factory/<creator>/<subdenom> <from> <to> --from <admin> --chain-id <chain-id>`, version.AppName, tokenfactory.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := tokenfactory.MsgForceTransfer{
+				Sender:              clientCtx.GetFromAddress().String(),
+				Amount:              amount,
+				TransferFromAddress: args[1],
+				TransferToAddress:   args[2],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdChangeAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-admin [denom] [new-admin] --from [admin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "change the admin of a factory denom, an empty new admin renounces the admin role",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s change-admin factory/<creator>/<subdenom> <new-admin> --from <admin> --chain-id <chain-id>`, version.AppName, tokenfactory.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := tokenfactory.MsgChangeAdmin{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Denom:    args[0],
+				NewAdmin: args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdSetDenomMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata-file] --from [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "set the bank metadata of a factory denom from a JSON file",
+		Long: strings.TrimSpace(fmt.Sprintf(`Set the bank metadata of a factory denom, the base of the metadata must be the factory denom.
+
+Example:
+			$ %s tx %s set-denom-metadata metadata.json --from <admin> --chain-id <chain-id>
+
+Where metadata.json contains:
+{
+	"description": "My token",
+	"denom_units": [{"denom": "factory/<creator>/<subdenom>", "exponent": 0}, {"denom": "token", "exponent": 6}],
+	"base": "factory/<creator>/<subdenom>",
+	"display": "token",
+	"name": "Token",
+	"symbol": "TKN"
+}`, version.AppName, tokenfactory.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var metadata banktypes.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &metadata); err != nil {
+				return err
+			}
+
+			msg := tokenfactory.MsgSetDenomMetadata{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Metadata: metadata,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
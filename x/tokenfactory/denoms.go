@@ -0,0 +1,61 @@
+package tokenfactory
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DenomPrefix is the prefix of all the factory denoms
+	DenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of a subdenom
+	MaxSubdenomLength = 44
+
+	// MaxCreatorLength is the maximum length of the creator address in a denom
+	MaxCreatorLength = 75
+)
+
+// GetTokenDenom returns the factory denom created by creator with the given subdenom,
+// of the form factory/{creator}/{subdenom}
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+	if len(creator) > MaxCreatorLength {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "creator too long, max length is %d bytes", MaxCreatorLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "creator %s cannot contain '/'", creator)
+	}
+
+	denom := strings.Join([]string{DenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+	return denom, nil
+}
+
+// DeconstructDenom splits a factory denom into its creator and subdenom. The subdenom
+// may contain '/'.
+func DeconstructDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) < 3 {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "denom %s must be of the form %s/{creator}/{subdenom}", denom, DenomPrefix)
+	}
+	if parts[0] != DenomPrefix {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "denom %s must start with %s/", denom, DenomPrefix)
+	}
+
+	creatorAddr, err := sdk.AccAddressFromBech32(parts[1])
+	if err != nil {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "invalid creator address (%s)", err)
+	}
+	return creatorAddr.String(), parts[2], nil
+}
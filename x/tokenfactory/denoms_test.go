@@ -0,0 +1,57 @@
+package tokenfactory_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+func TestGetTokenDenom(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+
+	denom, err := tokenfactory.GetTokenDenom(creator, "bitcoin")
+	require.NoError(t, err)
+	require.Equal(t, "factory/"+creator+"/bitcoin", denom)
+
+	_, err = tokenfactory.GetTokenDenom(creator, strings.Repeat("a", tokenfactory.MaxSubdenomLength+1))
+	require.ErrorIs(t, err, tokenfactory.ErrInvalidDenom)
+
+	_, err = tokenfactory.GetTokenDenom(creator, "bit coin")
+	require.ErrorIs(t, err, tokenfactory.ErrInvalidDenom)
+
+	_, err = tokenfactory.GetTokenDenom("cosmos/1", "bitcoin")
+	require.ErrorIs(t, err, tokenfactory.ErrInvalidDenom)
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+
+	for _, tc := range []struct {
+		denom    string
+		subdenom string
+		expErr   bool
+	}{
+		{denom: "factory/" + creator + "/bitcoin", subdenom: "bitcoin"},
+		{denom: "factory/" + creator + "/bitcoin/wrapped", subdenom: "bitcoin/wrapped"},
+		{denom: "factory/" + creator + "/", subdenom: ""},
+		{denom: "stake", expErr: true},
+		{denom: "factory/" + creator, expErr: true},
+		{denom: "ibc/" + creator + "/bitcoin", expErr: true},
+		{denom: "factory/invalid/bitcoin", expErr: true},
+	} {
+		t.Run(tc.denom, func(t *testing.T) {
+			gotCreator, subdenom, err := tokenfactory.DeconstructDenom(tc.denom)
+			if tc.expErr {
+				require.ErrorIs(t, err, tokenfactory.ErrInvalidDenom)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, creator, gotCreator)
+			require.Equal(t, tc.subdenom, subdenom)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package tokenfactory
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/tokenfactory module sentinel errors
+var (
+	ErrDenomExists     = sdkerrors.Register(ModuleName, 2, "denom already exists")
+	ErrDenomNotFound   = sdkerrors.Register(ModuleName, 3, "denom not found")
+	ErrInvalidDenom    = sdkerrors.Register(ModuleName, 4, "invalid denom")
+	ErrUnauthorized    = sdkerrors.Register(ModuleName, 5, "unauthorized account")
+	ErrInvalidMetadata = sdkerrors.Register(ModuleName, 6, "invalid denom metadata")
+	ErrBlockedAddress  = sdkerrors.Register(ModuleName, 7, "address is not allowed to send or receive factory denoms")
+)
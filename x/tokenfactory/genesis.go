@@ -0,0 +1,36 @@
+package tokenfactory
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateGenesis check the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	denoms := make(map[string]bool, len(data.FactoryDenoms))
+	for _, denom := range data.FactoryDenoms {
+		if _, _, err := DeconstructDenom(denom.Denom); err != nil {
+			return err
+		}
+		if denoms[denom.Denom] {
+			return sdkerrors.Wrapf(ErrDenomExists, "duplicate denom %s", denom.Denom)
+		}
+		denoms[denom.Denom] = true
+
+		if admin := denom.AuthorityMetadata.Admin; admin != "" {
+			if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid admin address (%s)", admin)
+			}
+		}
+	}
+	return nil
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{Params: DefaultParams()}
+}
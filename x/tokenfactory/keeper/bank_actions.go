@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// MintTo mints amount of a factory denom to addr, through the module account
+func (k Keeper) MintTo(ctx sdk.Context, amount sdk.Coin, addr sdk.AccAddress) error {
+	if k.bk.BlockedAddr(addr) {
+		return sdkerrors.Wrapf(tokenfactory.ErrBlockedAddress, "%s", addr)
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bk.MintCoins(ctx, tokenfactory.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.bk.SendCoinsFromModuleToAccount(ctx, tokenfactory.ModuleName, addr, coins)
+}
+
+// BurnFrom burns amount of a factory denom from addr, through the module account
+func (k Keeper) BurnFrom(ctx sdk.Context, amount sdk.Coin, addr sdk.AccAddress) error {
+	if k.bk.BlockedAddr(addr) {
+		return sdkerrors.Wrapf(tokenfactory.ErrBlockedAddress, "%s", addr)
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, addr, tokenfactory.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.bk.BurnCoins(ctx, tokenfactory.ModuleName, coins)
+}
+
+// ForceTransfer transfers amount of a factory denom from one account to another, without
+// the consent of the sender
+func (k Keeper) ForceTransfer(ctx sdk.Context, amount sdk.Coin, from, to sdk.AccAddress) error {
+	for _, addr := range []sdk.AccAddress{from, to} {
+		if k.bk.BlockedAddr(addr) {
+			return sdkerrors.Wrapf(tokenfactory.ErrBlockedAddress, "%s", addr)
+		}
+	}
+	return k.bk.SendCoins(ctx, from, to, sdk.NewCoins(amount))
+}
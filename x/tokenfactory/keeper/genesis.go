@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// InitGenesis new tokenfactory genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *tokenfactory.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	for _, denom := range data.FactoryDenoms {
+		k.SetAuthorityMetadata(ctx, denom.Denom, denom.AuthorityMetadata)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *tokenfactory.GenesisState {
+	var denoms []tokenfactory.GenesisDenom
+	k.IterateDenoms(ctx, func(denom string, metadata tokenfactory.DenomAuthorityMetadata) bool {
+		denoms = append(denoms, tokenfactory.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: metadata,
+		})
+		return false
+	})
+
+	return &tokenfactory.GenesisState{
+		Params:        k.GetParams(ctx),
+		FactoryDenoms: denoms,
+	}
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+var _ tokenfactory.QueryServer = Keeper{}
+
+// Params returns the parameters of the module
+func (k Keeper) Params(goCtx context.Context, r *tokenfactory.QueryParamsRequest) (*tokenfactory.QueryParamsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &tokenfactory.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// DenomAuthorityMetadata returns the authorities of a factory denom
+func (k Keeper) DenomAuthorityMetadata(goCtx context.Context, r *tokenfactory.QueryDenomAuthorityMetadataRequest) (*tokenfactory.QueryDenomAuthorityMetadataResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	metadata, found := k.GetAuthorityMetadata(ctx, r.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(tokenfactory.ErrDenomNotFound, "%s", r.Denom)
+	}
+	return &tokenfactory.QueryDenomAuthorityMetadataResponse{AuthorityMetadata: metadata}, nil
+}
+
+// DenomsFromCreator returns the factory denoms created by an address
+func (k Keeper) DenomsFromCreator(goCtx context.Context, r *tokenfactory.QueryDenomsFromCreatorRequest) (*tokenfactory.QueryDenomsFromCreatorResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	creator, err := sdk.AccAddressFromBech32(r.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), creatorDenomsPrefix(creator))
+
+	var denoms []string
+	pageRes, err := query.Paginate(store, r.Pagination, func(key []byte, _ []byte) error {
+		denoms = append(denoms, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tokenfactory.QueryDenomsFromCreatorResponse{
+		Denoms:     denoms,
+		Pagination: pageRes,
+	}, nil
+}
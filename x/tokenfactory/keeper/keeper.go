@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// Keeper of the tokenfactory store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	bk       tokenfactory.BankKeeper
+	dk       tokenfactory.DistributionKeeper
+
+	// the address capable of updating the module parameters. Usually the gov module
+	// account
+	authority string
+}
+
+// NewKeeper creates a new tokenfactory Keeper instance
+func NewKeeper(key storetypes.StoreKey, cdc codec.BinaryCodec, bk tokenfactory.BankKeeper,
+	dk tokenfactory.DistributionKeeper, authority string,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  key,
+		bk:        bk,
+		dk:        dk,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the address of the tokenfactory module authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+tokenfactory.ModuleName)
+}
+
+// GetParams returns the parameters of the module
+func (k Keeper) GetParams(ctx sdk.Context) tokenfactory.Params {
+	var params tokenfactory.Params
+	bz := ctx.KVStore(k.storeKey).Get(ParamsKey)
+	if len(bz) == 0 {
+		return params
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the parameters of the module
+func (k Keeper) SetParams(ctx sdk.Context, params tokenfactory.Params) {
+	ctx.KVStore(k.storeKey).Set(ParamsKey, k.cdc.MustMarshal(&params))
+}
+
+// CreateDenom creates the denom factory/{creator}/{subdenom} administered by creator,
+// charging the denom creation fee
+func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	denom, err := tokenfactory.GetTokenDenom(creator.String(), subdenom)
+	if err != nil {
+		return "", err
+	}
+	if _, found := k.GetAuthorityMetadata(ctx, denom); found {
+		return "", sdkerrors.Wrapf(tokenfactory.ErrDenomExists, "%s", denom)
+	}
+	if k.bk.HasDenomMetaData(ctx, denom) {
+		return "", sdkerrors.Wrapf(tokenfactory.ErrDenomExists, "%s has bank metadata", denom)
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.IsZero() {
+		if err := k.dk.FundCommunityPool(ctx, fee, creator); err != nil {
+			return "", sdkerrors.Wrap(err, "denom creation fee")
+		}
+	}
+
+	k.SetAuthorityMetadata(ctx, denom, tokenfactory.DenomAuthorityMetadata{Admin: creator.String()})
+	k.bk.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       denom,
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+	})
+	return denom, nil
+}
+
+// GetAuthorityMetadata returns the authority metadata of a factory denom
+func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (tokenfactory.DenomAuthorityMetadata, bool) {
+	var metadata tokenfactory.DenomAuthorityMetadata
+	store := ctx.KVStore(k.storeKey)
+	// the metadata of a denom without admin is encoded as empty bytes
+	if !store.Has(denomAuthorityKey(denom)) {
+		return metadata, false
+	}
+	k.cdc.MustUnmarshal(store.Get(denomAuthorityKey(denom)), &metadata)
+	return metadata, true
+}
+
+// SetAuthorityMetadata sets the authority metadata of a factory denom, and indexes the
+// denom by its creator
+func (k Keeper) SetAuthorityMetadata(ctx sdk.Context, denom string, metadata tokenfactory.DenomAuthorityMetadata) {
+	creator, _, err := tokenfactory.DeconstructDenom(denom)
+	if err != nil {
+		panic(err)
+	}
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(denomAuthorityKey(denom), k.cdc.MustMarshal(&metadata))
+	store.Set(creatorDenomKey(creatorAddr, denom), []byte{})
+}
+
+// IterateDenoms iterates over all factory denoms, until cb returns true
+func (k Keeper) IterateDenoms(ctx sdk.Context, cb func(denom string, metadata tokenfactory.DenomAuthorityMetadata) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), DenomAuthorityPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata tokenfactory.DenomAuthorityMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &metadata)
+		if cb(string(iterator.Key()), metadata) {
+			break
+		}
+	}
+}
+
+// GetDenomsFromCreator returns the factory denoms created by creator
+func (k Keeper) GetDenomsFromCreator(ctx sdk.Context, creator sdk.AccAddress) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), creatorDenomsPrefix(creator))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var denoms []string
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+	return denoms
+}
+
+// assertAdmin checks addr is the admin of the factory denom
+func (k Keeper) assertAdmin(ctx sdk.Context, denom, addr string) error {
+	metadata, found := k.GetAuthorityMetadata(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(tokenfactory.ErrDenomNotFound, "%s", denom)
+	}
+	if metadata.Admin == "" || metadata.Admin != addr {
+		return sdkerrors.Wrapf(tokenfactory.ErrUnauthorized, "%s is not the admin of %s", addr, denom)
+	}
+	return nil
+}
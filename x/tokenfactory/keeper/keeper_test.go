@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	authority   string
+	msgServer   tokenfactory.MsgServer
+	queryClient tokenfactory.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	tokenfactory.RegisterQueryServer(queryHelper, app.TokenFactoryKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.msgServer = keeper.NewMsgServerImpl(app.TokenFactoryKeeper)
+	s.queryClient = tokenfactory.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) createDenom(creator sdk.AccAddress, subdenom string) string {
+	res, err := s.msgServer.CreateDenom(s.ctx, &tokenfactory.MsgCreateDenom{
+		Sender:   creator.String(),
+		Subdenom: subdenom,
+	})
+	s.Require().NoError(err)
+	return res.NewTokenDenom
+}
+
+func (s *TestSuite) balance(addr sdk.AccAddress, denom string) int64 {
+	return s.app.BankKeeper.GetBalance(s.ctx, addr, denom).Amount.Int64()
+}
+
+func (s *TestSuite) TestCreateDenom() {
+	require := s.Require()
+
+	denom := s.createDenom(s.addrs[0], "bitcoin")
+	require.Equal("factory/"+s.addrs[0].String()+"/bitcoin", denom)
+
+	res, err := s.queryClient.DenomAuthorityMetadata(s.ctx, &tokenfactory.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	require.NoError(err)
+	require.Equal(s.addrs[0].String(), res.AuthorityMetadata.Admin)
+
+	metadata, found := s.app.BankKeeper.GetDenomMetaData(s.ctx, denom)
+	require.True(found)
+	require.Equal(denom, metadata.Base)
+
+	_, err = s.msgServer.CreateDenom(s.ctx, &tokenfactory.MsgCreateDenom{Sender: s.addrs[0].String(), Subdenom: "bitcoin"})
+	require.ErrorIs(err, tokenfactory.ErrDenomExists)
+
+	// the same subdenom can be used by another creator
+	s.createDenom(s.addrs[1], "bitcoin")
+	s.createDenom(s.addrs[0], "litecoin")
+
+	denoms, err := s.queryClient.DenomsFromCreator(s.ctx, &tokenfactory.QueryDenomsFromCreatorRequest{Creator: s.addrs[0].String()})
+	require.NoError(err)
+	require.Equal([]string{denom, "factory/" + s.addrs[0].String() + "/litecoin"}, denoms.Denoms)
+
+	_, err = s.queryClient.DenomAuthorityMetadata(s.ctx, &tokenfactory.QueryDenomAuthorityMetadataRequest{Denom: "factory/" + s.addrs[2].String() + "/bitcoin"})
+	require.ErrorIs(err, tokenfactory.ErrDenomNotFound)
+}
+
+func (s *TestSuite) TestCreateDenomFee() {
+	require := s.Require()
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	_, err := s.msgServer.UpdateParams(s.ctx, &tokenfactory.MsgUpdateParams{
+		Authority: s.addrs[0].String(),
+		Params:    tokenfactory.Params{DenomCreationFee: fee},
+	})
+	require.ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = s.msgServer.UpdateParams(s.ctx, &tokenfactory.MsgUpdateParams{
+		Authority: s.authority,
+		Params:    tokenfactory.Params{DenomCreationFee: fee},
+	})
+	require.NoError(err)
+
+	params, err := s.queryClient.Params(s.ctx, &tokenfactory.QueryParamsRequest{})
+	require.NoError(err)
+	require.Equal(fee, params.Params.DenomCreationFee)
+
+	before := s.balance(s.addrs[0], sdk.DefaultBondDenom)
+	poolBefore := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx)
+	s.createDenom(s.addrs[0], "bitcoin")
+	require.Equal(before-1000, s.balance(s.addrs[0], sdk.DefaultBondDenom))
+	require.Equal(poolBefore.Add(sdk.NewDecCoinsFromCoins(fee...)...), s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx))
+
+	// the creator cannot pay the fee
+	poor := sdk.AccAddress("poor________________")
+	_, err = s.msgServer.CreateDenom(s.ctx, &tokenfactory.MsgCreateDenom{Sender: poor.String(), Subdenom: "bitcoin"})
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+}
+
+func (s *TestSuite) TestMintBurn() {
+	require := s.Require()
+	denom := s.createDenom(s.addrs[0], "bitcoin")
+
+	_, err := s.msgServer.Mint(s.ctx, &tokenfactory.MsgMint{Sender: s.addrs[0].String(), Amount: sdk.NewInt64Coin(denom, 100)})
+	require.NoError(err)
+	_, err = s.msgServer.Mint(s.ctx, &tokenfactory.MsgMint{Sender: s.addrs[0].String(), Amount: sdk.NewInt64Coin(denom, 50), MintToAddress: s.addrs[1].String()})
+	require.NoError(err)
+	require.Equal(int64(100), s.balance(s.addrs[0], denom))
+	require.Equal(int64(50), s.balance(s.addrs[1], denom))
+	require.Equal(int64(150), s.app.BankKeeper.GetSupply(s.ctx, denom).Amount.Int64())
+
+	_, err = s.msgServer.Mint(s.ctx, &tokenfactory.MsgMint{Sender: s.addrs[1].String(), Amount: sdk.NewInt64Coin(denom, 100)})
+	require.ErrorIs(err, tokenfactory.ErrUnauthorized)
+
+	// minting to module accounts is not allowed
+	_, err = s.msgServer.Mint(s.ctx, &tokenfactory.MsgMint{
+		Sender:        s.addrs[0].String(),
+		Amount:        sdk.NewInt64Coin(denom, 100),
+		MintToAddress: authtypes.NewModuleAddress(distrtypes.ModuleName).String(),
+	})
+	require.ErrorIs(err, tokenfactory.ErrBlockedAddress)
+
+	_, err = s.msgServer.Burn(s.ctx, &tokenfactory.MsgBurn{Sender: s.addrs[0].String(), Amount: sdk.NewInt64Coin(denom, 30)})
+	require.NoError(err)
+	_, err = s.msgServer.Burn(s.ctx, &tokenfactory.MsgBurn{Sender: s.addrs[0].String(), Amount: sdk.NewInt64Coin(denom, 20), BurnFromAddress: s.addrs[1].String()})
+	require.NoError(err)
+	require.Equal(int64(70), s.balance(s.addrs[0], denom))
+	require.Equal(int64(30), s.balance(s.addrs[1], denom))
+	require.Equal(int64(100), s.app.BankKeeper.GetSupply(s.ctx, denom).Amount.Int64())
+
+	_, err = s.msgServer.Burn(s.ctx, &tokenfactory.MsgBurn{Sender: s.addrs[1].String(), Amount: sdk.NewInt64Coin(denom, 10)})
+	require.ErrorIs(err, tokenfactory.ErrUnauthorized)
+	_, err = s.msgServer.Burn(s.ctx, &tokenfactory.MsgBurn{Sender: s.addrs[0].String(), Amount: sdk.NewInt64Coin(denom, 1000)})
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+}
+
+func (s *TestSuite) TestForceTransfer() {
+	require := s.Require()
+	denom := s.createDenom(s.addrs[0], "bitcoin")
+
+	_, err := s.msgServer.Mint(s.ctx, &tokenfactory.MsgMint{Sender: s.addrs[0].String(), Amount: sdk.NewInt64Coin(denom, 100), MintToAddress: s.addrs[1].String()})
+	require.NoError(err)
+
+	_, err = s.msgServer.ForceTransfer(s.ctx, &tokenfactory.MsgForceTransfer{
+		Sender:              s.addrs[0].String(),
+		Amount:              sdk.NewInt64Coin(denom, 40),
+		TransferFromAddress: s.addrs[1].String(),
+		TransferToAddress:   s.addrs[2].String(),
+	})
+	require.NoError(err)
+	require.Equal(int64(60), s.balance(s.addrs[1], denom))
+	require.Equal(int64(40), s.balance(s.addrs[2], denom))
+
+	_, err = s.msgServer.ForceTransfer(s.ctx, &tokenfactory.MsgForceTransfer{
+		Sender:              s.addrs[2].String(),
+		Amount:              sdk.NewInt64Coin(denom, 40),
+		TransferFromAddress: s.addrs[1].String(),
+		TransferToAddress:   s.addrs[2].String(),
+	})
+	require.ErrorIs(err, tokenfactory.ErrUnauthorized)
+}
+
+func (s *TestSuite) TestChangeAdmin() {
+	require := s.Require()
+	denom := s.createDenom(s.addrs[0], "bitcoin")
+
+	_, err := s.msgServer.ChangeAdmin(s.ctx, &tokenfactory.MsgChangeAdmin{Sender: s.addrs[1].String(), Denom: denom, NewAdmin: s.addrs[1].String()})
+	require.ErrorIs(err, tokenfactory.ErrUnauthorized)
+
+	_, err = s.msgServer.ChangeAdmin(s.ctx, &tokenfactory.MsgChangeAdmin{Sender: s.addrs[0].String(), Denom: denom, NewAdmin: s.addrs[1].String()})
+	require.NoError(err)
+	_, err = s.msgServer.Mint(s.ctx, &tokenfactory.MsgMint{Sender: s.addrs[0].String(), Amount: sdk.NewInt64Coin(denom, 100)})
+	require.ErrorIs(err, tokenfactory.ErrUnauthorized)
+	_, err = s.msgServer.Mint(s.ctx, &tokenfactory.MsgMint{Sender: s.addrs[1].String(), Amount: sdk.NewInt64Coin(denom, 100)})
+	require.NoError(err)
+
+	// the denom is still indexed by its creator
+	denoms, err := s.queryClient.DenomsFromCreator(s.ctx, &tokenfactory.QueryDenomsFromCreatorRequest{Creator: s.addrs[0].String()})
+	require.NoError(err)
+	require.Equal([]string{denom}, denoms.Denoms)
+
+	// renouncing the admin role
+	_, err = s.msgServer.ChangeAdmin(s.ctx, &tokenfactory.MsgChangeAdmin{Sender: s.addrs[1].String(), Denom: denom})
+	require.NoError(err)
+	_, err = s.msgServer.Mint(s.ctx, &tokenfactory.MsgMint{Sender: s.addrs[1].String(), Amount: sdk.NewInt64Coin(denom, 100)})
+	require.ErrorIs(err, tokenfactory.ErrUnauthorized)
+}
+
+func (s *TestSuite) TestSetDenomMetadata() {
+	require := s.Require()
+	denom := s.createDenom(s.addrs[0], "bitcoin")
+
+	metadata := banktypes.Metadata{
+		Description: "Bitcoin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "btc", Exponent: 8},
+		},
+		Base:    denom,
+		Display: "btc",
+		Name:    "Bitcoin",
+		Symbol:  "BTC",
+	}
+	_, err := s.msgServer.SetDenomMetadata(s.ctx, &tokenfactory.MsgSetDenomMetadata{Sender: s.addrs[1].String(), Metadata: metadata})
+	require.ErrorIs(err, tokenfactory.ErrUnauthorized)
+
+	_, err = s.msgServer.SetDenomMetadata(s.ctx, &tokenfactory.MsgSetDenomMetadata{Sender: s.addrs[0].String(), Metadata: metadata})
+	require.NoError(err)
+	got, found := s.app.BankKeeper.GetDenomMetaData(s.ctx, denom)
+	require.True(found)
+	require.Equal(metadata, got)
+
+	metadata.Base = sdk.DefaultBondDenom
+	msg := tokenfactory.MsgSetDenomMetadata{Sender: s.addrs[0].String(), Metadata: metadata}
+	require.Error(msg.ValidateBasic())
+}
+
+func (s *TestSuite) TestGenesis() {
+	require := s.Require()
+	denom := s.createDenom(s.addrs[0], "bitcoin")
+	_, err := s.msgServer.ChangeAdmin(s.ctx, &tokenfactory.MsgChangeAdmin{Sender: s.addrs[0].String(), Denom: denom, NewAdmin: s.addrs[1].String()})
+	require.NoError(err)
+
+	genesis := s.app.TokenFactoryKeeper.ExportGenesis(s.ctx)
+	require.NoError(tokenfactory.ValidateGenesis(*genesis))
+	require.Len(genesis.FactoryDenoms, 1)
+
+	ctx := s.app.BaseApp.NewContext(false, tmproto.Header{})
+	s.app.TokenFactoryKeeper.InitGenesis(ctx, genesis)
+	require.Equal(genesis, s.app.TokenFactoryKeeper.ExportGenesis(ctx))
+	require.Equal([]string{denom}, s.app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, s.addrs[0]))
+
+	_, err = s.app.TokenFactoryKeeper.Params(s.ctx, nil)
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
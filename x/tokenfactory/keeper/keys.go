@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+var (
+	ParamsKey            = []byte{0x01}
+	DenomAuthorityPrefix = []byte{0x02}
+	CreatorDenomPrefix   = []byte{0x03}
+)
+
+// denomAuthorityKey returns the byte representation of the key of the authority metadata
+// of a denom
+// Items are stored with the following key: values
+// 0x02<denom>
+func denomAuthorityKey(denom string) []byte {
+	return append(DenomAuthorityPrefix, denom...)
+}
+
+// creatorDenomsPrefix returns the prefix of the denoms created by creator
+// 0x03<creator len (1 Byte)><creator>
+func creatorDenomsPrefix(creator sdk.AccAddress) []byte {
+	return append(CreatorDenomPrefix, address.MustLengthPrefix(creator)...)
+}
+
+// creatorDenomKey returns the byte representation of the key indexing a denom by its creator
+// Items are stored with the following key: values
+// 0x03<creator len (1 Byte)><creator><denom>
+func creatorDenomKey(creator sdk.AccAddress, denom string) []byte {
+	return append(creatorDenomsPrefix(creator), denom...)
+}
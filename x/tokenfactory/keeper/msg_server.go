@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the tokenfactory MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) tokenfactory.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ tokenfactory.MsgServer = msgServer{}
+
+// CreateDenom creates the denom factory/{sender}/{subdenom}, administered by the sender
+func (k msgServer) CreateDenom(goCtx context.Context, msg *tokenfactory.MsgCreateDenom) (*tokenfactory.MsgCreateDenomResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denom, err := k.Keeper.CreateDenom(ctx, sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&tokenfactory.EventCreateDenom{
+		Denom:   denom,
+		Creator: msg.Sender,
+	}); err != nil {
+		return nil, err
+	}
+	return &tokenfactory.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+// Mint mints tokens of a factory denom, only the admin of the denom can mint
+func (k msgServer) Mint(goCtx context.Context, msg *tokenfactory.MsgMint) (*tokenfactory.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertAdmin(ctx, msg.Amount.Denom, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	mintTo := msg.MintToAddress
+	if mintTo == "" {
+		mintTo = msg.Sender
+	}
+	addr, err := sdk.AccAddressFromBech32(mintTo)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.MintTo(ctx, msg.Amount, addr); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&tokenfactory.EventMint{
+		Amount:        msg.Amount.String(),
+		MintToAddress: mintTo,
+	}); err != nil {
+		return nil, err
+	}
+	return &tokenfactory.MsgMintResponse{}, nil
+}
+
+// Burn burns tokens of a factory denom, only the admin of the denom can burn
+func (k msgServer) Burn(goCtx context.Context, msg *tokenfactory.MsgBurn) (*tokenfactory.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertAdmin(ctx, msg.Amount.Denom, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	burnFrom := msg.BurnFromAddress
+	if burnFrom == "" {
+		burnFrom = msg.Sender
+	}
+	addr, err := sdk.AccAddressFromBech32(burnFrom)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.BurnFrom(ctx, msg.Amount, addr); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&tokenfactory.EventBurn{
+		Amount:          msg.Amount.String(),
+		BurnFromAddress: burnFrom,
+	}); err != nil {
+		return nil, err
+	}
+	return &tokenfactory.MsgBurnResponse{}, nil
+}
+
+// ForceTransfer transfers tokens of a factory denom between any accounts, only the admin
+// of the denom can force transfer
+func (k msgServer) ForceTransfer(goCtx context.Context, msg *tokenfactory.MsgForceTransfer) (*tokenfactory.MsgForceTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertAdmin(ctx, msg.Amount.Denom, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.TransferFromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.TransferToAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.ForceTransfer(ctx, msg.Amount, from, to); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&tokenfactory.EventForceTransfer{
+		Amount:              msg.Amount.String(),
+		TransferFromAddress: msg.TransferFromAddress,
+		TransferToAddress:   msg.TransferToAddress,
+	}); err != nil {
+		return nil, err
+	}
+	return &tokenfactory.MsgForceTransferResponse{}, nil
+}
+
+// ChangeAdmin changes the admin of a factory denom, only the current admin can change it
+func (k msgServer) ChangeAdmin(goCtx context.Context, msg *tokenfactory.MsgChangeAdmin) (*tokenfactory.MsgChangeAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertAdmin(ctx, msg.Denom, msg.Sender); err != nil {
+		return nil, err
+	}
+	k.SetAuthorityMetadata(ctx, msg.Denom, tokenfactory.DenomAuthorityMetadata{Admin: msg.NewAdmin})
+
+	if err := ctx.EventManager().EmitTypedEvent(&tokenfactory.EventChangeAdmin{
+		Denom:    msg.Denom,
+		NewAdmin: msg.NewAdmin,
+	}); err != nil {
+		return nil, err
+	}
+	return &tokenfactory.MsgChangeAdminResponse{}, nil
+}
+
+// SetDenomMetadata sets the bank metadata of a factory denom, only the admin of the denom
+// can set it
+func (k msgServer) SetDenomMetadata(goCtx context.Context, msg *tokenfactory.MsgSetDenomMetadata) (*tokenfactory.MsgSetDenomMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertAdmin(ctx, msg.Metadata.Base, msg.Sender); err != nil {
+		return nil, err
+	}
+	k.bk.SetDenomMetaData(ctx, msg.Metadata)
+
+	if err := ctx.EventManager().EmitTypedEvent(&tokenfactory.EventSetDenomMetadata{
+		Denom: msg.Metadata.Base,
+	}); err != nil {
+		return nil, err
+	}
+	return &tokenfactory.MsgSetDenomMetadataResponse{}, nil
+}
+
+// UpdateParams updates the module parameters
+func (k msgServer) UpdateParams(goCtx context.Context, msg *tokenfactory.MsgUpdateParams) (*tokenfactory.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+	return &tokenfactory.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,12 @@
+package tokenfactory
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "tokenfactory"
+
+	// StoreKey is the store key string for tokenfactory
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for tokenfactory
+	RouterKey = ModuleName
+)
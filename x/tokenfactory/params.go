@@ -0,0 +1,19 @@
+package tokenfactory
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultParams returns the default parameters of the module, which charge no denom
+// creation fee
+func DefaultParams() Params {
+	return Params{}
+}
+
+// Validate performs a basic validation of the parameters
+func (p Params) Validate() error {
+	if err := p.DenomCreationFee.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom creation fee: %s", err)
+	}
+	return nil
+}
@@ -6,7 +6,6 @@ import (
 	"os"
 	"path"
 	"path/filepath"
-	"sort"
 
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
@@ -20,6 +19,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/util/collection"
 	xp "github.com/cosmos/cosmos-sdk/x/upgrade/exported"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
@@ -94,14 +94,7 @@ func (k Keeper) SetModuleVersionMap(ctx sdk.Context, vm module.VersionMap) {
 		// Even though the underlying store (cachekv) store is sorted, we still
 		// prefer a deterministic iteration order of the map, to avoid undesired
 		// surprises if we ever change stores.
-		sortedModNames := make([]string, 0, len(vm))
-
-		for key := range vm {
-			sortedModNames = append(sortedModNames, key)
-		}
-		sort.Strings(sortedModNames)
-
-		for _, modName := range sortedModNames {
+		for _, modName := range collection.SortedKeys(vm) {
 			ver := vm[modName]
 			nameBytes := []byte(modName)
 			verBytes := make([]byte, 8)
@@ -351,16 +344,23 @@ func (k Keeper) IsSkipHeight(height int64) bool {
 
 // DumpUpgradeInfoToDisk writes upgrade information to UpgradeInfoFileName.
 func (k Keeper) DumpUpgradeInfoToDisk(height int64, p types.Plan) error {
+	return k.dumpUpgradeInfo(types.NewUpgradeInfo(height, p, nil))
+}
+
+// DumpUpgradeInfoWithModuleVersionsToDisk writes the upgrade information of the
+// plan executed at the current height to the upgrade info file, along with the
+// module version map of the chain, from which the new binary can plan its
+// store upgrades.
+func (k Keeper) DumpUpgradeInfoWithModuleVersionsToDisk(ctx sdk.Context, p types.Plan) error {
+	return k.dumpUpgradeInfo(types.NewUpgradeInfo(ctx.BlockHeight(), p, k.GetModuleVersionMap(ctx)))
+}
+
+func (k Keeper) dumpUpgradeInfo(upgradeInfo types.UpgradeInfo) error {
 	upgradeInfoFilePath, err := k.GetUpgradeInfoPath()
 	if err != nil {
 		return err
 	}
 
-	upgradeInfo := types.Plan{
-		Name:   p.Name,
-		Height: height,
-		Info:   p.Info,
-	}
 	info, err := json.Marshal(upgradeInfo)
 	if err != nil {
 		return err
@@ -414,6 +414,50 @@ func (k Keeper) ReadUpgradeInfoFromDisk() (types.Plan, error) {
 	return upgradeInfo, nil
 }
 
+// ReadUpgradeInfoWithModuleVersionsFromDisk returns the upgrade info written
+// to the upgrade info file, with the module version map of the chain before the
+// upgrade if it was recorded. It returns an empty upgrade info if there is no
+// upgrade info file.
+func (k Keeper) ReadUpgradeInfoWithModuleVersionsFromDisk() (types.UpgradeInfo, error) {
+	var upgradeInfo types.UpgradeInfo
+
+	upgradeInfoPath, err := k.GetUpgradeInfoPath()
+	if err != nil {
+		return upgradeInfo, err
+	}
+
+	data, err := os.ReadFile(upgradeInfoPath)
+	if err != nil {
+		// if file does not exist, assume there are no upgrades
+		if os.IsNotExist(err) {
+			return upgradeInfo, nil
+		}
+
+		return upgradeInfo, err
+	}
+
+	if err := json.Unmarshal(data, &upgradeInfo); err != nil {
+		return upgradeInfo, err
+	}
+
+	return upgradeInfo, nil
+}
+
+// PlanStoreUpgrades plans the store upgrades of the upgrade written to the
+// upgrade info file, from the module version map of the chain recorded in it
+// and toVM, the module version map of the new app, see types.PlanStoreUpgrades.
+// It returns the upgrade info along with the store upgrades, which are empty if
+// there is no upgrade info file or if the module version map of the chain
+// wasn't recorded in it.
+func (k Keeper) PlanStoreUpgrades(toVM module.VersionMap, moduleStoreKeys map[string][]string) (types.UpgradeInfo, *storetypes.StoreUpgrades, error) {
+	upgradeInfo, err := k.ReadUpgradeInfoWithModuleVersionsFromDisk()
+	if err != nil {
+		return upgradeInfo, nil, err
+	}
+
+	return upgradeInfo, types.PlanStoreUpgrades(upgradeInfo.ModuleVersions, toVM, moduleStoreKeys), nil
+}
+
 // SetDowngradeVerified updates downgradeVerified.
 func (k *Keeper) SetDowngradeVerified(v bool) {
 	k.downgradeVerified = v
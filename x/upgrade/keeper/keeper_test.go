@@ -64,6 +64,32 @@ func (s *KeeperTestSuite) TestReadUpgradeInfoFromDisk() {
 	s.Require().Equal(expected, ui)
 }
 
+func (s *KeeperTestSuite) TestPlanStoreUpgrades() {
+	// nothing is planned when the upgrade info file does not exist
+	_, storeUpgrades, err := s.app.UpgradeKeeper.PlanStoreUpgrades(module.VersionMap{"nft": 1}, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(storeUpgrades.Added)
+
+	s.app.UpgradeKeeper.SetModuleVersionMap(s.ctx, module.VersionMap{"legacy": 1})
+	fromVM := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	plan := types.Plan{Name: "test_upgrade", Height: 100}
+	s.Require().NoError(s.app.UpgradeKeeper.DumpUpgradeInfoWithModuleVersionsToDisk(s.ctx, plan))
+
+	// the upgrade info file can still be read as a plan by the old API
+	ui, err := s.app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	s.Require().NoError(err)
+	s.Require().Equal(types.Plan{Name: "test_upgrade", Height: s.ctx.BlockHeight()}, ui)
+
+	toVM := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	delete(toVM, "legacy")
+	toVM["new"] = 1
+	upgradeInfo, storeUpgrades, err := s.app.UpgradeKeeper.PlanStoreUpgrades(toVM, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewUpgradeInfo(s.ctx.BlockHeight(), plan, fromVM), upgradeInfo)
+	s.Require().Equal([]string{"new"}, storeUpgrades.Added)
+	s.Require().Equal([]string{"legacy"}, storeUpgrades.Deleted)
+}
+
 func (s *KeeperTestSuite) TestScheduleUpgrade() {
 	cases := []struct {
 		name    string
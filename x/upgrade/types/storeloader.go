@@ -4,6 +4,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/util/collection"
 )
 
 // UpgradeStoreLoader is used to prepare baseapp with a fixed StoreLoader
@@ -21,3 +23,41 @@ func UpgradeStoreLoader(upgradeHeight int64, storeUpgrades *storetypes.StoreUpgr
 		return baseapp.DefaultStoreLoader(ms)
 	}
 }
+
+// PlanStoreUpgrades returns the store upgrades of the modules added and deleted
+// between fromVM, the module version map of the chain before an upgrade, and
+// toVM, the one of the new app: the stores of the modules of toVM missing from
+// fromVM are added, and the ones of the modules of fromVM missing from toVM are
+// deleted. moduleStoreKeys maps the module names to the names of their stores,
+// the modules missing from it having a single store named after them. The
+// stores which aren't mounted by the new app are ignored when loading it, and
+// the stores of the deleted modules must still be mounted to be deleted.
+//
+// No store upgrade is planned if fromVM is empty, e.g. if the module version
+// map of the chain wasn't recorded.
+func PlanStoreUpgrades(fromVM, toVM module.VersionMap, moduleStoreKeys map[string][]string) *storetypes.StoreUpgrades {
+	storeUpgrades := &storetypes.StoreUpgrades{}
+	if len(fromVM) == 0 {
+		return storeUpgrades
+	}
+
+	storeKeys := func(moduleName string) []string {
+		if keys, ok := moduleStoreKeys[moduleName]; ok {
+			return keys
+		}
+		return []string{moduleName}
+	}
+
+	for _, moduleName := range collection.SortedKeys(toVM) {
+		if _, ok := fromVM[moduleName]; !ok {
+			storeUpgrades.Added = append(storeUpgrades.Added, storeKeys(moduleName)...)
+		}
+	}
+	for _, moduleName := range collection.SortedKeys(fromVM) {
+		if _, ok := toVM[moduleName]; !ok {
+			storeUpgrades.Deleted = append(storeUpgrades.Deleted, storeKeys(moduleName)...)
+		}
+	}
+
+	return storeUpgrades
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
 func useUpgradeLoader(height int64, upgrades *storetypes.StoreUpgrades) func(*baseapp.BaseApp) {
@@ -161,3 +162,17 @@ func TestSetLoader(t *testing.T) {
 		})
 	}
 }
+
+func TestPlanStoreUpgrades(t *testing.T) {
+	fromVM := module.VersionMap{"auth": 2, "bank": 2, "crisis": 1, "legacy": 1}
+	toVM := module.VersionMap{"auth": 3, "bank": 2, "crisis": 1, "nft": 1, "accounts": 1}
+	moduleStoreKeys := map[string][]string{"auth": {"acc"}, "accounts": {"_accounts"}, "crisis": {}}
+
+	storeUpgrades := PlanStoreUpgrades(fromVM, toVM, moduleStoreKeys)
+	require.Equal(t, []string{"_accounts", "nft"}, storeUpgrades.Added)
+	require.Equal(t, []string{"legacy"}, storeUpgrades.Deleted)
+	require.Empty(t, storeUpgrades.Renamed)
+
+	// nothing is planned without the module version map of the chain
+	require.Equal(t, &storetypes.StoreUpgrades{}, PlanStoreUpgrades(nil, toVM, moduleStoreKeys))
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// UpgradeInfo is the content of the upgrade info file written when the chain
+// halts for an upgrade, read by the new binary to load the stores of the
+// upgrade. Along with the plan of the upgrade, it records the module version
+// map of the chain before the upgrade, from which the store upgrades can be
+// planned.
+type UpgradeInfo struct {
+	Name   string `json:"name,omitempty"`
+	Height int64  `json:"height,omitempty"`
+	Info   string `json:"info,omitempty"`
+	// ModuleVersions is the module version map of the chain before the
+	// upgrade, unset if written by a binary which didn't record it.
+	ModuleVersions module.VersionMap `json:"module_versions,omitempty"`
+}
+
+// NewUpgradeInfo returns the upgrade info of the plan executed at the given
+// height, with the module version map of the chain before the upgrade.
+func NewUpgradeInfo(height int64, p Plan, vm module.VersionMap) UpgradeInfo {
+	return UpgradeInfo{
+		Name:           p.Name,
+		Height:         height,
+		Info:           p.Info,
+		ModuleVersions: vm,
+	}
+}